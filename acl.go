@@ -0,0 +1,227 @@
+package marionette
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrDestinationDenied is returned when an ACL does not allow a destination.
+var ErrDestinationDenied = errors.New("marionette: destination denied")
+
+// ACL is an ordered list of rules which allow or deny proxy destinations.
+// The first rule matching a destination decides whether it is allowed.
+// Destinations which match no rule are denied.
+type ACL struct {
+	mu sync.RWMutex
+
+	// Rules must only be changed with SetRules() once the ACL is in use.
+	Rules []ACLRule
+}
+
+// ACLRule allows or denies destinations by host and port.
+type ACLRule struct {
+	Allow bool
+
+	// Matches destination IP addresses, if set.
+	Net *net.IPNet
+
+	// Matches host names, if Net is not set. "*" matches all destinations
+	// and a "*." prefix matches any subdomain.
+	Host string
+
+	// Matches destination ports. Matches all ports if empty.
+	Ports []PortRange
+}
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Min, Max int
+}
+
+// Allowed returns true if the first rule matching the destination allows it.
+// The host is the requested host name, if any, and ip is the address to dial.
+func (acl *ACL) Allowed(host string, ip net.IP, port int) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	acl.mu.RLock()
+	defer acl.mu.RUnlock()
+	for i := range acl.Rules {
+		if acl.Rules[i].match(host, ip, port) {
+			return acl.Rules[i].Allow
+		}
+	}
+	return false
+}
+
+// SetRules replaces the rules. Checks already in progress use the old rules.
+func (acl *ACL) SetRules(rules []ACLRule) {
+	acl.mu.Lock()
+	defer acl.mu.Unlock()
+	acl.Rules = rules
+}
+
+// Resolve checks the "host:port" address against the ACL and returns an
+// allowed address to dial. Host names are resolved and each IP is checked so
+// that names cannot be used to reach denied networks. Returns
+// ErrDestinationDenied if no address is allowed.
+func (acl *ACL) Resolve(ctx context.Context, addr string) (string, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid port: %q", portStr)
+	}
+
+	// Check IP addresses directly.
+	if ip := net.ParseIP(host); ip != nil {
+		if !acl.Allowed("", ip, port) {
+			return "", ErrDestinationDenied
+		}
+		return addr, nil
+	}
+
+	// Resolve host names and dial the first allowed address.
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return "", err
+	}
+	for _, a := range addrs {
+		if acl.Allowed(host, a.IP, port) {
+			return net.JoinHostPort(a.IP.String(), portStr), nil
+		}
+	}
+	return "", ErrDestinationDenied
+}
+
+func (r *ACLRule) match(host string, ip net.IP, port int) bool {
+	if len(r.Ports) > 0 {
+		var ok bool
+		for _, pr := range r.Ports {
+			if port >= pr.Min && port <= pr.Max {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	switch {
+	case r.Net != nil:
+		return ip != nil && r.Net.Contains(ip)
+	case r.Host == "*":
+		return true
+	case strings.HasPrefix(r.Host, "*."):
+		return strings.HasSuffix(host, r.Host[1:])
+	default:
+		return host == r.Host
+	}
+}
+
+// ReadACLFile parses an ACL from a file. See ParseACL() for the format.
+func ReadACLFile(path string) (*ACL, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseACL(f)
+}
+
+// ParseACL parses ACL rules, one per line, in the form:
+//
+//	allow|deny HOST [PORTS]
+//
+// HOST is "*", an IP address, a CIDR range, or a host name which may be
+// prefixed with "*." to match subdomains. PORTS is a comma-separated list of
+// ports or ranges, such as "80,443,8000-8080". Blank lines and lines starting
+// with "#" are ignored.
+func ParseACL(r io.Reader) (*ACL, error) {
+	acl := &ACL{}
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule, err := parseACLRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("acl: %s at line %d", err, lineNo)
+		}
+		acl.Rules = append(acl.Rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return acl, nil
+}
+
+func parseACLRule(line string) (rule ACLRule, err error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 || len(fields) > 3 {
+		return rule, errors.New("expected action, host, and optional ports")
+	}
+
+	switch fields[0] {
+	case "allow":
+		rule.Allow = true
+	case "deny":
+	default:
+		return rule, fmt.Errorf("invalid action: %q", fields[0])
+	}
+
+	host := strings.ToLower(fields[1])
+	if _, ipNet, err := net.ParseCIDR(host); err == nil {
+		rule.Net = ipNet
+	} else if ip := net.ParseIP(host); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		rule.Net = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	} else if strings.Contains(host, "/") || (host != "*" && strings.Contains(strings.TrimPrefix(host, "*."), "*")) {
+		return rule, fmt.Errorf("invalid host: %q", fields[1])
+	} else {
+		rule.Host = strings.TrimSuffix(host, ".")
+	}
+
+	if len(fields) == 3 {
+		for _, s := range strings.Split(fields[2], ",") {
+			pr, err := parsePortRange(s)
+			if err != nil {
+				return rule, err
+			}
+			rule.Ports = append(rule.Ports, pr)
+		}
+	}
+	return rule, nil
+}
+
+func parsePortRange(s string) (pr PortRange, err error) {
+	a := strings.SplitN(s, "-", 2)
+	if pr.Min, err = strconv.Atoi(a[0]); err != nil {
+		return pr, fmt.Errorf("invalid port: %q", s)
+	}
+	pr.Max = pr.Min
+	if len(a) == 2 {
+		if pr.Max, err = strconv.Atoi(a[1]); err != nil {
+			return pr, fmt.Errorf("invalid port: %q", s)
+		}
+	}
+	if pr.Min < 0 || pr.Max > 65535 || pr.Min > pr.Max {
+		return pr, fmt.Errorf("invalid port range: %q", s)
+	}
+	return pr, nil
+}
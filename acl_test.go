@@ -0,0 +1,105 @@
+package marionette_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestParseACL(t *testing.T) {
+	acl, err := marionette.ParseACL(strings.NewReader(`
+# Block private networks.
+deny 10.0.0.0/8
+deny 192.168.1.1
+allow *.example.com 80,443
+allow example.org 8000-8080
+allow 2001:db8::/32
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		host    string
+		ip      string
+		port    int
+		allowed bool
+	}{
+		{"", "10.1.2.3", 80, false},
+		{"", "192.168.1.1", 80, false},
+		{"", "192.168.1.2", 80, false},
+		{"www.example.com", "93.184.216.34", 443, true},
+		{"WWW.Example.COM.", "93.184.216.34", 443, true},
+		{"www.example.com", "93.184.216.34", 22, false},
+		{"www.example.com", "10.0.0.1", 443, false},
+		{"example.com", "93.184.216.34", 443, false},
+		{"example.org", "93.184.216.35", 8080, true},
+		{"example.org", "93.184.216.35", 8081, false},
+		{"", "2001:db8::1", 22, true},
+	} {
+		if allowed := acl.Allowed(tt.host, net.ParseIP(tt.ip), tt.port); allowed != tt.allowed {
+			t.Errorf("Allowed(%q, %s, %d)=%v, expected %v", tt.host, tt.ip, tt.port, allowed, tt.allowed)
+		}
+	}
+
+	t.Run("ErrInvalidAction", func(t *testing.T) {
+		if _, err := marionette.ParseACL(strings.NewReader("\npermit *\n")); err == nil || err.Error() != `acl: invalid action: "permit" at line 2` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrInvalidHost", func(t *testing.T) {
+		if _, err := marionette.ParseACL(strings.NewReader("allow foo*.com\n")); err == nil || err.Error() != `acl: invalid host: "foo*.com" at line 1` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrInvalidPortRange", func(t *testing.T) {
+		if _, err := marionette.ParseACL(strings.NewReader("allow * 90-80\n")); err == nil || err.Error() != `acl: invalid port range: "90-80" at line 1` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestACL_Resolve(t *testing.T) {
+	acl, err := marionette.ParseACL(strings.NewReader("deny 127.0.0.0/8\ndeny ::1\nallow *\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if addr, err := acl.Resolve(context.Background(), "93.184.216.34:80"); err != nil {
+		t.Fatal(err)
+	} else if addr != "93.184.216.34:80" {
+		t.Fatalf("unexpected address: %s", addr)
+	}
+
+	// Ensure host names cannot be used to reach denied addresses.
+	if _, err := acl.Resolve(context.Background(), "127.0.0.1:80"); err != marionette.ErrDestinationDenied {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _, err := acl.Resolve(context.Background(), "localhost:80"); err != marionette.ErrDestinationDenied {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestACL_SetRules(t *testing.T) {
+	acl, err := marionette.ParseACL(strings.NewReader("allow *\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := marionette.ParseACL(strings.NewReader("deny 10.0.0.0/8\nallow * 443\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acl.SetRules(other.Rules)
+	if acl.Allowed("", net.ParseIP("10.1.2.3"), 443) {
+		t.Fatal("expected denied network")
+	} else if acl.Allowed("", net.ParseIP("93.184.216.34"), 80) {
+		t.Fatal("expected denied port")
+	} else if !acl.Allowed("", net.ParseIP("93.184.216.34"), 443) {
+		t.Fatal("expected allowed destination")
+	}
+}
@@ -0,0 +1,183 @@
+package marionette
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Default settings of an audit log.
+const (
+	DefaultAuditInterval  = 1 * time.Hour
+	DefaultAuditEpsilon   = 1.0
+	DefaultAuditByteBound = 16 * 1024 * 1024
+)
+
+// AuditLog is an event sink which records only aggregate counters: the number
+// of connections & bytes carried for each format in each interval. No
+// addresses or per-connection data are kept. Laplace noise is added to each
+// counter so that whether any single connection took place cannot be
+// inferred from the log, giving epsilon-differential privacy per counter.
+type AuditLog struct {
+	// Length of each reporting interval.
+	Interval time.Duration
+
+	// Privacy budget of each counter. Smaller values add more noise.
+	Epsilon float64
+
+	// Maximum bytes counted for a single connection, which bounds how much
+	// one connection can change the byte counters.
+	ByteBound int64
+
+	mu     sync.Mutex
+	w      io.WriteCloser
+	start  time.Time
+	conns  map[string]int64
+	bytes  map[string]int64
+	closed chan struct{}
+	wg     sync.WaitGroup
+}
+
+// AuditRecord is a line of an audit log covering one interval. Counters are
+// noised, rounded & never negative.
+type AuditRecord struct {
+	Start       time.Time        `json:"start"`
+	End         time.Time        `json:"end"`
+	Connections map[string]int64 `json:"connections"`
+	Bytes       map[string]int64 `json:"bytes"`
+}
+
+// NewAuditLog returns an audit log appending a JSON line to w per interval.
+func NewAuditLog(w io.WriteCloser) *AuditLog {
+	return &AuditLog{
+		Interval:  DefaultAuditInterval,
+		Epsilon:   DefaultAuditEpsilon,
+		ByteBound: DefaultAuditByteBound,
+
+		w:      w,
+		conns:  make(map[string]int64),
+		bytes:  make(map[string]int64),
+		closed: make(chan struct{}),
+	}
+}
+
+// Open starts the first interval.
+func (l *AuditLog) Open() error {
+	l.start = time.Now().Truncate(time.Second)
+	l.wg.Add(1)
+	go func() { defer l.wg.Done(); l.run() }()
+	return nil
+}
+
+// Close writes the record of the current, partial interval and closes the log.
+func (l *AuditLog) Close() error {
+	close(l.closed)
+	l.wg.Wait()
+
+	err := l.flush()
+	if e := l.w.Close(); e != nil && err == nil {
+		err = e
+	}
+	return err
+}
+
+func (l *AuditLog) run() {
+	ticker := time.NewTicker(l.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.closed:
+			return
+		case <-ticker.C:
+			if err := l.flush(); err != nil {
+				Logger.Warn("cannot write audit log", zap.Error(err))
+			}
+		}
+	}
+}
+
+// WriteEvent adds opened connections and the bytes of closed connections to
+// the counters of their format.
+func (l *AuditLog) WriteEvent(e *Event) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch e.Type {
+	case EventConnOpened:
+		l.conns[e.Format]++
+		if _, ok := l.bytes[e.Format]; !ok {
+			l.bytes[e.Format] = 0
+		}
+	case EventConnClosed:
+		n := e.BytesRead + e.BytesWritten
+		if n > l.ByteBound {
+			n = l.ByteBound
+		}
+		l.bytes[e.Format] += n
+	}
+	return nil
+}
+
+// flush writes a noised record of the current interval and starts the next.
+// Formats seen in earlier intervals are reported even if idle so that their
+// absence does not reveal a zero count.
+func (l *AuditLog) flush() error {
+	l.mu.Lock()
+	now := time.Now().Truncate(time.Second)
+	rec := AuditRecord{
+		Start:       l.start,
+		End:         now,
+		Connections: make(map[string]int64, len(l.conns)),
+		Bytes:       make(map[string]int64, len(l.bytes)),
+	}
+	for format, n := range l.conns {
+		rec.Connections[format] = noisyCount(n, 1/l.Epsilon)
+		l.conns[format] = 0
+	}
+	for format, n := range l.bytes {
+		rec.Bytes[format] = noisyCount(n, float64(l.ByteBound)/l.Epsilon)
+		if _, ok := rec.Connections[format]; !ok {
+			rec.Connections[format] = noisyCount(0, 1/l.Epsilon)
+		}
+		l.bytes[format] = 0
+	}
+	l.start = now
+	l.mu.Unlock()
+
+	buf, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	_, err = l.w.Write(append(buf, '\n'))
+	return err
+}
+
+// noisyCount returns n with Laplace noise of the given scale, rounded and
+// clamped to zero.
+func noisyCount(n int64, scale float64) int64 {
+	v := math.Round(float64(n) + laplaceNoise(scale))
+	if v < 0 {
+		return 0
+	}
+	return int64(v)
+}
+
+// laplaceNoise returns a sample from the Laplace distribution centered at
+// zero, drawn from a cryptographic source so the noise cannot be predicted.
+func laplaceNoise(scale float64) float64 {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	u := (float64(binary.BigEndian.Uint64(b[:])>>11)+0.5)/(1<<53) - 0.5 // (-0.5, 0.5)
+	if u < 0 {
+		return scale * math.Log(1+2*u)
+	}
+	return -scale * math.Log(1-2*u)
+}
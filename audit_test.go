@@ -0,0 +1,40 @@
+package marionette_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestAuditLog(t *testing.T) {
+	var buf nopCloseBuffer
+	l := marionette.NewAuditLog(&buf)
+	l.Epsilon = 1e9 // negligible noise
+	if err := l.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	l.WriteEvent(&marionette.Event{Type: marionette.EventConnOpened, Format: "http_simple_blocking", RemoteAddr: "203.0.113.1:1234"})
+	l.WriteEvent(&marionette.Event{Type: marionette.EventConnOpened, Format: "http_simple_blocking"})
+	l.WriteEvent(&marionette.Event{Type: marionette.EventConnClosed, Format: "http_simple_blocking", BytesRead: 100, BytesWritten: 50})
+	l.WriteEvent(&marionette.Event{Type: marionette.EventConnClosed, Format: "http_simple_blocking", BytesRead: marionette.DefaultAuditByteBound})
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Addresses are never written.
+	if strings.Contains(buf.String(), "203.0.113.1") {
+		t.Fatalf("unexpected address in audit log: %s", buf.String())
+	}
+
+	var rec marionette.AuditRecord
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatal(err)
+	} else if n := rec.Connections["http_simple_blocking"]; n != 2 {
+		t.Fatalf("unexpected connections: %d", n)
+	} else if n := rec.Bytes["http_simple_blocking"]; n != 150+marionette.DefaultAuditByteBound {
+		t.Fatalf("unexpected bytes: %d", n)
+	}
+}
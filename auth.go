@@ -0,0 +1,135 @@
+package marionette
+
+import (
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// AuthSecretSize is the size, in bytes, of a bridge secret.
+	AuthSecretSize = 32
+
+	// AuthNonceSize is the size, in bytes, of the random nonce which makes
+	// each AUTH cell unique.
+	AuthNonceSize = 16
+
+	// AuthEpoch is the period of the time an AUTH cell is bound to. Servers
+	// accept the previous, current & next period so that a client's clock
+	// may be off by up to AuthEpoch.
+	AuthEpoch = time.Hour
+)
+
+// authMACSize is the size, in bytes, of the MAC of an AUTH cell.
+const authMACSize = sha256.Size
+
+// authLabel separates AUTH cell MACs from other uses of the secret.
+const authLabel = "marionette auth v1"
+
+// ErrUnauthenticated is returned by the server when a connection's first cell
+// is not an AUTH cell proving knowledge of the bridge secret.
+var ErrUnauthenticated = errors.New("marionette: handshake not authenticated")
+
+// NewAuthSecret returns a random bridge secret.
+func NewAuthSecret() ([]byte, error) {
+	secret := make([]byte, AuthSecretSize)
+	if _, err := crand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// ParseAuthSecret decodes a bridge secret, or a user's credential, encoded by
+// EncodeAuthSecret().
+func ParseAuthSecret(s string) ([]byte, error) {
+	secret, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("marionette: invalid auth secret: %s", err)
+	} else if len(secret) != AuthSecretSize && len(secret) != AuthCredentialSize {
+		return nil, fmt.Errorf("marionette: auth secret must be %d bytes, or %d for a user credential", AuthSecretSize, AuthCredentialSize)
+	}
+	return secret, nil
+}
+
+// EncodeAuthSecret returns secret as unpadded, URL-safe base64.
+func EncodeAuthSecret(secret []byte) string {
+	return base64.RawURLEncoding.EncodeToString(secret)
+}
+
+// newAuthCell returns an AUTH cell proving knowledge of secret for the format
+// with uuid at time t. The payload is a random nonce followed by its MAC and
+// padding random bytes. If secret is a user's credential then the payload
+// begins with the user's ID.
+func newAuthCell(secret []byte, uuid int, t time.Time, padding int) (*Cell, error) {
+	var payload []byte
+	if len(secret) == AuthCredentialSize {
+		payload = append(payload, secret[:authUserIDSize]...)
+		secret = secret[authUserIDSize:]
+	}
+
+	nonce := make([]byte, AuthNonceSize+padding)
+	if _, err := crand.Read(nonce); err != nil {
+		return nil, err
+	}
+	nonce, pad := nonce[:AuthNonceSize], nonce[AuthNonceSize:]
+	mac := authMAC(secret, uuid, authEpoch(t), nonce)
+	payload = append(append(append(payload, nonce...), mac...), pad...)
+	return &Cell{Type: AUTH, Payload: payload}, nil
+}
+
+// verifyAuthCell returns ErrUnauthenticated unless cell is an AUTH cell for
+// the format with uuid made with secret within one epoch of t. If users is
+// set then the cell must instead be made with the credential of an allowed
+// user. Otherwise the cell's MAC and the time until which the cell would be
+// accepted are returned. Padding of up to MaxAuthPadding bytes is ignored.
+func verifyAuthCell(secret []byte, users *Credentials, uuid int, cell *Cell, t time.Time) ([]byte, time.Time, error) {
+	if cell.Type != AUTH {
+		return nil, time.Time{}, ErrUnauthenticated
+	}
+	payload := cell.Payload
+	if users != nil {
+		if len(payload) < authUserIDSize+AuthNonceSize+authMACSize {
+			return nil, time.Time{}, ErrUnauthenticated
+		}
+		id := binary.BigEndian.Uint32(payload)
+		if !users.Allowed(id, t) {
+			return nil, time.Time{}, ErrUnauthenticated
+		}
+		secret, payload = userSecret(secret, id), payload[authUserIDSize:]
+	}
+	if len(payload) < AuthNonceSize+authMACSize || len(payload) > AuthNonceSize+authMACSize+MaxAuthPadding {
+		return nil, time.Time{}, ErrUnauthenticated
+	}
+	nonce, mac := payload[:AuthNonceSize], payload[AuthNonceSize:AuthNonceSize+authMACSize]
+
+	epoch := authEpoch(t)
+	for _, e := range []int64{epoch, epoch - 1, epoch + 1} {
+		if hmac.Equal(mac, authMAC(secret, uuid, e, nonce)) {
+			return mac, time.Unix((e+2)*int64(AuthEpoch/time.Second), 0), nil
+		}
+	}
+	return nil, time.Time{}, ErrUnauthenticated
+}
+
+// authMAC returns the MAC of nonce for the format with uuid in epoch.
+func authMAC(secret []byte, uuid int, epoch int64, nonce []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(authLabel))
+
+	var buf [12]byte
+	binary.BigEndian.PutUint32(buf[:4], uint32(uuid))
+	binary.BigEndian.PutUint64(buf[4:], uint64(epoch))
+	h.Write(buf[:])
+	h.Write(nonce)
+	return h.Sum(nil)
+}
+
+// authEpoch returns the number of AuthEpoch periods since the Unix epoch.
+func authEpoch(t time.Time) int64 {
+	return t.Unix() / int64(AuthEpoch/time.Second)
+}
@@ -0,0 +1,100 @@
+package marionette_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// Ensure a client with the bridge secret is served.
+func TestAuth(t *testing.T) {
+	secret := mustAuthSecret(t)
+	dialer, ln := marionette.Pipe(
+		mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc)),
+		mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)),
+	)
+	defer ln.Close()
+	ln.AuthSecret, dialer.AuthSecret = secret, secret
+
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientStream.Close()
+	mustWrite(t, clientStream, []byte("foo"))
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverStream.Close()
+	mustRead(t, serverStream, []byte("foo"))
+
+	mustWrite(t, serverStream, []byte("bar"))
+	mustRead(t, clientStream, []byte("bar"))
+}
+
+// Ensure a client without the bridge secret is disconnected.
+func TestAuth_Unauthenticated(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		secret []byte
+	}{
+		{"NoSecret", nil},
+		{"WrongSecret", mustAuthSecret(t)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dialer, ln := marionette.Pipe(
+				mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc)),
+				mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)),
+			)
+			defer ln.Close()
+			ln.AuthSecret, dialer.AuthSecret = mustAuthSecret(t), tt.secret
+
+			failed := make(chan error, 8)
+			dialer.OnError = func(err error) { failed <- err }
+			if err := dialer.Open(); err != nil {
+				t.Fatal(err)
+			}
+			defer dialer.Close()
+
+			if _, err := dialer.Dial(); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case <-failed:
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected connection to be closed")
+			}
+		})
+	}
+}
+
+func TestParseAuthSecret(t *testing.T) {
+	secret := mustAuthSecret(t)
+	if other, err := marionette.ParseAuthSecret(marionette.EncodeAuthSecret(secret)); err != nil {
+		t.Fatal(err)
+	} else if string(other) != string(secret) {
+		t.Fatalf("unexpected secret: %x", other)
+	}
+
+	if _, err := marionette.ParseAuthSecret("AQEB"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func mustAuthSecret(tb testing.TB) []byte {
+	tb.Helper()
+	secret, err := marionette.NewAuthSecret()
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return secret
+}
@@ -0,0 +1,120 @@
+package marionette
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/redjack/marionette/mar"
+)
+
+// BridgeLineScheme is the URL scheme of bridge lines.
+const BridgeLineScheme = "marionette"
+
+// BridgeLine is a single shareable string describing how to connect to a
+// server, in the form:
+//
+//	marionette://HOST[:PORT]?format=NAME[&version=VERSION][&channels=N][&secret=SECRET][#LABEL]
+//
+// The port, if set, is used instead of the format's. The secret, if set, is
+// the server's AuthSecret, or a user's credential, encoded by
+// EncodeAuthSecret() so a line must be shared as carefully as the secret.
+type BridgeLine struct {
+	Host     string
+	Port     string
+	Format   string
+	Version  string
+	Channels int
+	Secret   []byte
+	Label    string
+}
+
+// ParseBridgeLine parses a bridge line returned by BridgeLine.String().
+func ParseBridgeLine(s string) (*BridgeLine, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("marionette: invalid bridge line: %s", err)
+	} else if u.Scheme != BridgeLineScheme {
+		return nil, fmt.Errorf("marionette: invalid bridge line scheme: %q", u.Scheme)
+	} else if u.Hostname() == "" {
+		return nil, errors.New("marionette: bridge line host required")
+	} else if u.Path != "" && u.Path != "/" {
+		return nil, fmt.Errorf("marionette: invalid bridge line path: %q", u.Path)
+	}
+
+	q := u.Query()
+	b := &BridgeLine{
+		Host:    u.Hostname(),
+		Port:    u.Port(),
+		Format:  q.Get("format"),
+		Version: q.Get("version"),
+		Label:   u.Fragment,
+	}
+	if b.Format == "" {
+		return nil, errors.New("marionette: bridge line format required")
+	}
+	if s := q.Get("channels"); s != "" {
+		if b.Channels, err = strconv.Atoi(s); err != nil || b.Channels < 1 {
+			return nil, fmt.Errorf("marionette: invalid bridge line channels: %q", s)
+		}
+	}
+	if s := q.Get("secret"); s != "" {
+		if b.Secret, err = ParseAuthSecret(s); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// NewBridgeLine returns a bridge line for the server at addr, which may
+// include a port, using the named format, such as "http_simple_blocking" or
+// "http_simple_blocking:20150701".
+func NewBridgeLine(addr, format string) *BridgeLine {
+	b := &BridgeLine{Host: addr}
+	if host, port, err := net.SplitHostPort(addr); err == nil {
+		b.Host, b.Port = host, port
+	}
+	b.Format, b.Version = mar.SplitFormat(format)
+	return b
+}
+
+// Server returns the server's host, with its port if set.
+func (b *BridgeLine) Server() string {
+	if b.Port == "" {
+		return b.Host
+	}
+	return net.JoinHostPort(b.Host, b.Port)
+}
+
+// FormatName returns the format name with its version, if set.
+func (b *BridgeLine) FormatName() string {
+	if b.Version == "" {
+		return b.Format
+	}
+	return b.Format + ":" + b.Version
+}
+
+// String returns the bridge line as a URL.
+func (b *BridgeLine) String() string {
+	q := url.Values{"format": {b.Format}}
+	if b.Version != "" {
+		q.Set("version", b.Version)
+	}
+	if b.Channels > 0 {
+		q.Set("channels", strconv.Itoa(b.Channels))
+	}
+	if b.Secret != nil {
+		q.Set("secret", EncodeAuthSecret(b.Secret))
+	}
+
+	host := b.Host
+	if b.Port != "" {
+		host = net.JoinHostPort(b.Host, b.Port)
+	} else if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+		host = "[" + host + "]"
+	}
+	u := &url.URL{Scheme: BridgeLineScheme, Host: host, RawQuery: q.Encode(), Fragment: b.Label}
+	return u.String()
+}
@@ -0,0 +1,56 @@
+package marionette_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestBridgeLine(t *testing.T) {
+	for _, tt := range []struct {
+		b *marionette.BridgeLine
+		s string
+	}{
+		{&marionette.BridgeLine{Host: "192.0.2.1", Format: "http_simple_blocking"}, "marionette://192.0.2.1?format=http_simple_blocking"},
+		{&marionette.BridgeLine{Host: "example.com", Port: "8443", Format: "http_simple_blocking", Version: "20150701", Channels: 2, Label: "home"},
+			"marionette://example.com:8443?channels=2&format=http_simple_blocking&version=20150701#home"},
+		{&marionette.BridgeLine{Host: "2001:db8::1", Format: "ftp_simple_blocking"}, "marionette://[2001:db8::1]?format=ftp_simple_blocking"},
+		{&marionette.BridgeLine{Host: "192.0.2.1", Format: "http_simple_blocking", Secret: bytes.Repeat([]byte{1}, marionette.AuthSecretSize)},
+			"marionette://192.0.2.1?format=http_simple_blocking&secret=AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE"},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			if s := tt.b.String(); s != tt.s {
+				t.Fatalf("unexpected string: %s", s)
+			}
+			if b, err := marionette.ParseBridgeLine(tt.s); err != nil {
+				t.Fatal(err)
+			} else if !reflect.DeepEqual(b, tt.b) {
+				t.Fatalf("unexpected bridge line: %#v", b)
+			}
+		})
+	}
+}
+
+func TestNewBridgeLine(t *testing.T) {
+	b := marionette.NewBridgeLine("192.0.2.1:8443", "http_simple_blocking:20150701")
+	if b.Server() != "192.0.2.1:8443" || b.FormatName() != "http_simple_blocking:20150701" {
+		t.Fatalf("unexpected bridge line: %#v", b)
+	}
+}
+
+func TestParseBridgeLine_Err(t *testing.T) {
+	for _, s := range []string{
+		"http://192.0.2.1?format=http_simple_blocking",
+		"marionette://?format=http_simple_blocking",
+		"marionette://192.0.2.1",
+		"marionette://192.0.2.1/path?format=http_simple_blocking",
+		"marionette://192.0.2.1?format=http_simple_blocking&channels=0",
+		"marionette://192.0.2.1?format=http_simple_blocking&secret=AQEB",
+	} {
+		if _, err := marionette.ParseBridgeLine(s); err == nil {
+			t.Fatalf("expected error: %s", s)
+		}
+	}
+}
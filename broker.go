@@ -0,0 +1,89 @@
+package marionette
+
+import (
+	"bufio"
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BrokerTimeout is the time allowed for each broker request.
+const BrokerTimeout = 30 * time.Second
+
+// MaxBrokerResponseSize is the maximum size of a broker response.
+const MaxBrokerResponseSize = 1 << 20
+
+// BrokerClient fetches bridge lines from a broker so that new users can
+// obtain servers in-band. The broker serves a text document of records
+// returned by SignDiscoveryRecord(), one per line. Records are verified the
+// same as a Discoverer's so that neither the broker's host nor the front can
+// substitute servers.
+type BrokerClient struct {
+	// HTTPS URL of the bridge document.
+	URL string
+
+	// If set, the request is domain fronted: the connection & TLS server
+	// name use this host while the Host header names the broker, such as
+	// when the broker is hosted behind a CDN which also serves the front.
+	Front string
+
+	PublicKey ed25519.PublicKey
+	Client    *http.Client
+
+	// Returns the current time, used to check expiry. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewBrokerClient returns a client fetching bridge lines from rawurl which are
+// signed by key.
+func NewBrokerClient(rawurl string, key ed25519.PublicKey) *BrokerClient {
+	return &BrokerClient{
+		URL:       rawurl,
+		PublicKey: key,
+		Client:    &http.Client{Timeout: BrokerTimeout},
+		Now:       time.Now,
+	}
+}
+
+// Fetch returns the bridge lines of the broker's valid records in the order
+// they were served. Returns ErrNoDiscoveryRecords if none are valid.
+func (c *BrokerClient) Fetch(ctx context.Context) ([]*BridgeLine, error) {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return nil, fmt.Errorf("marionette: invalid broker url: %s", err)
+	}
+	host := u.Host
+	if c.Front != "" {
+		u.Host = c.Front
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Host = host
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("marionette: unexpected broker status: %s", resp.Status)
+	}
+
+	var records []string
+	scanner := bufio.NewScanner(io.LimitReader(resp.Body, MaxBrokerResponseSize))
+	for scanner.Scan() {
+		records = append(records, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return verifyDiscoveryRecords(c.PublicKey, records, c.Now(), host)
+}
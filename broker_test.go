@@ -0,0 +1,67 @@
+package marionette_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestBrokerClient_Fetch(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	b0 := marionette.NewBridgeLine("192.0.2.1:8443", "http_simple_blocking:20150701")
+	b1 := marionette.NewBridgeLine("192.0.2.2", "ftp_simple_blocking")
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "broker.example.com" {
+			http.Error(w, "unexpected host", http.StatusNotFound)
+			return
+		} else if r.URL.Path != "/bridges" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintln(w, marionette.SignDiscoveryRecord(key, b0, now.Add(time.Hour)))
+		fmt.Fprintln(w, "")
+		fmt.Fprintln(w, marionette.SignDiscoveryRecord(key, marionette.NewBridgeLine("192.0.2.3", "ftp_simple_blocking"), now))
+		fmt.Fprintln(w, marionette.SignDiscoveryRecord(key, b1, now.Add(time.Hour)))
+	}))
+	defer srv.Close()
+
+	// Front the request through the test server's address.
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := marionette.NewBrokerClient("https://broker.example.com/bridges", pub)
+	c.Front = u.Host
+	c.Client = srv.Client()
+	c.Now = func() time.Time { return now }
+
+	if lines, err := c.Fetch(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(lines, []*marionette.BridgeLine{b0, b1}) {
+		t.Fatalf("unexpected bridge lines: %v", lines)
+	}
+
+	c.URL = "https://broker.example.com/missing"
+	if _, err := c.Fetch(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+
+	c.URL, c.Now = "https://broker.example.com/bridges", func() time.Time { return now.Add(time.Hour) }
+	if _, err := c.Fetch(context.Background()); err != marionette.ErrNoDiscoveryRecords {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/binary"
 	"io"
+	"math/rand"
+	"sort"
 )
 
 const (
@@ -15,6 +17,13 @@ const (
 	NORMAL        = 0x1
 	END_OF_STREAM = 0x2
 	NEGOTIATE     = 0x3
+	HEARTBEAT     = 0x4
+	DESTINATION   = 0x5
+	SESSION       = 0x6
+	RESUME        = 0x7
+	WINDOW        = 0x8
+	JOIN          = 0x9
+	AUTH          = 0xA
 )
 
 // Cell represents a single unit of data sent between the client & server.
@@ -22,7 +31,7 @@ const (
 // This cell is associated with a specific stream and the encoder/decoders
 // handle ordering based on sequence id.
 type Cell struct {
-	Type       int    // Record type (NORMAL, END_OF_STREAM)
+	Type       int    // Record type (NORMAL, END_OF_STREAM, DESTINATION, SESSION, RESUME, WINDOW, JOIN, AUTH)
 	Payload    []byte // Data
 	Length     int    // Size of marshaled data, if specified.
 	StreamID   int    // Associated stream
@@ -163,6 +172,39 @@ func (c *Cell) UnmarshalBinary(data []byte) (err error) {
 	return nil
 }
 
+// CellLengthsVar is the FSM variable that holds the CellLengthDistribution
+// used to size outgoing cells.
+const CellLengthsVar = "model_cell_lengths"
+
+// CellLengthDistribution maps marshaled cell lengths to their probability.
+type CellLengthDistribution map[int]float64
+
+// Choose returns a random cell length from the distribution.
+// The length is clamped between the cell header size and max.
+func (d CellLengthDistribution) Choose(max int) int {
+	keys := make([]int, 0, len(d))
+	for k := range d {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	n, sum, coin := max, float64(0), rand.Float64()
+	for _, k := range keys {
+		sum += d[k]
+		if n = k; sum >= coin {
+			break
+		}
+	}
+
+	if n < CellHeaderSize {
+		n = CellHeaderSize
+	}
+	if n > max {
+		n = max
+	}
+	return n
+}
+
 type Cells []*Cell
 
 func (a Cells) Len() int           { return len(a) }
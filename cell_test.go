@@ -99,3 +99,30 @@ func TestCell_MarshalBinary(t *testing.T) {
 		t.Fatalf("mismatch: %#v", &other)
 	}
 }
+
+func TestCellLengthDistribution_Choose(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		dist := marionette.CellLengthDistribution{100: 0.5, 200: 0.5}
+		for i := 0; i < 100; i++ {
+			if n := dist.Choose(1000); n != 100 && n != 200 {
+				t.Fatalf("unexpected length: %d", n)
+			}
+		}
+	})
+
+	// Ensure lengths larger than the capacity are truncated.
+	t.Run("Max", func(t *testing.T) {
+		dist := marionette.CellLengthDistribution{5000: 1}
+		if n := dist.Choose(1000); n != 1000 {
+			t.Fatalf("unexpected length: %d", n)
+		}
+	})
+
+	// Ensure lengths smaller than the cell header are extended.
+	t.Run("Min", func(t *testing.T) {
+		dist := marionette.CellLengthDistribution{1: 1}
+		if n := dist.Choose(1000); n != marionette.CellHeaderSize {
+			t.Fatalf("unexpected length: %d", n)
+		}
+	})
+}
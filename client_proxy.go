@@ -1,18 +1,45 @@
 package marionette
 
 import (
-	"io"
+	"context"
+	"errors"
+	"fmt"
 	"net"
 	"sync"
 
 	"go.uber.org/zap"
 )
 
+// ErrTransparentNotSupported is returned when transparent proxying is used on
+// a platform other than Linux.
+var ErrTransparentNotSupported = errors.New("transparent proxying is only supported on linux")
+
 // ClientProxy represents a proxy between incoming connections and a marionette dialer.
 type ClientProxy struct {
 	ln     net.Listener
 	dialer *Dialer
 	wg     sync.WaitGroup
+
+	// Handler used to accept SOCKS5 requests from local applications.
+	// If nil then connections are tunneled as-is.
+	Socks5 *Socks5Handler
+
+	// If true, accept HTTP proxy requests from local applications.
+	// Ignored if a socks5 handler is set.
+	HTTPProxy bool
+
+	// Returns the destination of an incoming connection, such as one that has
+	// been transparently redirected. If nil then no destination is sent.
+	DestinationFunc func(conn net.Conn) (string, error)
+
+	// Options applied to accepted TCP connections, if set.
+	SocketOptions *SocketOptions
+
+	// Called when a local application connects and when handling one of its
+	// connections fails, such as a stream that cannot be created. Server
+	// connection callbacks are set on the dialer. Must not block.
+	OnConnect func(net.Conn)
+	OnError   func(error)
 }
 
 // NewClientProxy returns a new instance of ClientProxy.
@@ -35,16 +62,21 @@ func (p *ClientProxy) Close() error {
 }
 
 func (p *ClientProxy) run() {
-	Logger.Debug("client proxy: listening")
-	defer Logger.Debug("client proxy: closed")
+	proxyLogger().Debug("client proxy: listening")
+	defer proxyLogger().Debug("client proxy: closed")
 
 	for {
 		conn, err := p.ln.Accept()
 		if err != nil {
-			Logger.Debug("client proxy: listener error", zap.Error(err))
+			proxyLogger().Debug("client proxy: listener error", zap.Error(err))
 			return
 		}
 
+		applySocketOptions(p.SocketOptions, conn)
+		if p.OnConnect != nil {
+			p.OnConnect(conn)
+		}
+
 		p.wg.Add(1)
 		go func() { defer p.wg.Done(); p.handleConn(conn) }()
 	}
@@ -53,29 +85,75 @@ func (p *ClientProxy) run() {
 func (p *ClientProxy) handleConn(incomingConn net.Conn) {
 	defer incomingConn.Close()
 
-	Logger.Debug("client proxy: connection open")
-	defer Logger.Debug("client proxy: connection closed")
+	proxyLogger().Debug("client proxy: connection open")
+	defer proxyLogger().Debug("client proxy: connection closed")
+
+	// Hand off to the socks5 handler, if enabled. Streams are created once
+	// the destination is known.
+	if p.Socks5 != nil {
+		if err := p.Socks5.ServeConn(incomingConn); err != nil {
+			proxyLogger().Debug("client proxy: socks5 error", zap.Error(err))
+			p.onError(err)
+		}
+		return
+	}
+
+	// Handle HTTP CONNECT & absolute-URI requests, if enabled.
+	if p.HTTPProxy {
+		if err := serveHTTPProxy(incomingConn, p.DialDestination); err != nil {
+			proxyLogger().Debug("client proxy: http error", zap.Error(err))
+			p.onError(err)
+		}
+		return
+	}
 
 	// Create a new stream.
-	stream, err := p.dialer.Dial()
+	stream, err := p.dial(incomingConn)
 	if err != nil {
-		Logger.Debug("client proxy: cannot connect create new stream", zap.Error(err))
+		proxyLogger().Debug("client proxy: cannot connect create new stream", zap.Error(err))
+		p.onError(err)
 		return
 	}
 	defer stream.Close()
 
-	// Copy between incoming connection and stream until an error occurs.
-	var wg sync.WaitGroup
-	wg.Add(2)
-	go func() {
-		defer wg.Done()
-		io.Copy(incomingConn, stream)
-		incomingConn.Close()
-	}()
-	go func() {
-		defer wg.Done()
-		io.Copy(stream, incomingConn)
-		stream.Close()
-	}()
-	wg.Wait()
+	// Copy between incoming connection and stream until both sides close.
+	relay(incomingConn, nil, stream)
+}
+
+// onError calls OnError with err, if set.
+func (p *ClientProxy) onError(err error) {
+	if p.OnError != nil {
+		p.OnError(err)
+	}
+}
+
+// dial creates a new stream for conn, to its destination if available.
+func (p *ClientProxy) dial(conn net.Conn) (net.Conn, error) {
+	if p.DestinationFunc == nil {
+		return p.dialer.Dial()
+	}
+
+	addr, err := p.DestinationFunc(conn)
+	if err != nil {
+		return nil, fmt.Errorf("cannot determine destination: %s", err)
+	}
+	return p.dialer.DialDestination(addr)
+}
+
+// DialDestination opens a new stream which the server connects to addr. The
+// server must allow per-stream destinations. This is used to dial for local
+// SOCKS5 & HTTP proxy requests so each connection is routed to its own
+// destination.
+func (p *ClientProxy) DialDestination(ctx context.Context, network, addr string) (net.Conn, error) {
+	if network != "tcp" {
+		return nil, fmt.Errorf("unsupported network: %s", network)
+	}
+	proxyLogger().Debug("client proxy: dialing destination", zap.String("addr", addr))
+	return p.dialer.DialDestination(addr)
+}
+
+// DialDatagram opens a new stream which relays datagrams through the server.
+// The server must allow per-stream destinations.
+func (p *ClientProxy) DialDatagram() (net.Conn, error) {
+	return p.dialer.DialDatagram()
 }
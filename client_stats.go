@@ -0,0 +1,200 @@
+package marionette
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrClientNotFound is returned when a client IP has no statistics.
+var ErrClientNotFound = errors.New("marionette: client not found")
+
+const (
+	// DefaultBanWindow is the default period in which handshake failures
+	// are counted towards a ban.
+	DefaultBanWindow = 1 * time.Minute
+
+	// DefaultBanDuration is the default time a client IP stays banned.
+	DefaultBanDuration = 10 * time.Minute
+)
+
+const (
+	// clientStatsRetention is the time the statistics of a client IP with no
+	// connections are kept after it was last seen.
+	clientStatsRetention = 1 * time.Hour
+
+	// clientStatsPruneInterval is the minimum time between removals of
+	// expired client statistics.
+	clientStatsPruneInterval = 1 * time.Minute
+)
+
+// ClientStats describes the connections of a client IP to a listener.
+type ClientStats struct {
+	Addr              string     `json:"addr"`
+	Conns             int64      `json:"conns"`    // connection attempts
+	Rejected          int64      `json:"rejected"` // closed by limits or bans
+	ActiveConns       int        `json:"active_conns"`
+	HandshakeFailures int64      `json:"handshake_failures"`
+	BytesRead         int64      `json:"bytes_read"`
+	BytesWritten      int64      `json:"bytes_written"`
+	LastSeen          time.Time  `json:"last_seen"`
+	BannedUntil       *time.Time `json:"banned_until,omitempty"`
+}
+
+// clientStats tracks a client IP. Bytes of served connections are added once
+// each connection finishes.
+type clientStats struct {
+	conns             int64
+	rejected          int64
+	handshakeFailures int64
+	bytesRead         int64
+	bytesWritten      int64
+	lastSeen          time.Time
+
+	failures    []time.Time // handshake failures within the ban window
+	bannedUntil time.Time
+}
+
+// ClientStats returns the statistics of each client IP seen recently, sorted
+// by address. Bytes include those of connections still being served.
+func (l *Listener) ClientStats() []ClientStats {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	now := time.Now()
+	m := make(map[string]*ClientStats, len(l.stats))
+	for host, s := range l.stats {
+		cs := &ClientStats{
+			Addr:              host,
+			Conns:             s.conns,
+			Rejected:          s.rejected,
+			ActiveConns:       l.hostConns[host],
+			HandshakeFailures: s.handshakeFailures,
+			BytesRead:         s.bytesRead,
+			BytesWritten:      s.bytesWritten,
+			LastSeen:          s.lastSeen,
+		}
+		if now.Before(s.bannedUntil) {
+			t := s.bannedUntil
+			cs.BannedUntil = &t
+		}
+		m[host] = cs
+	}
+
+	for fsm, c := range l.fsms {
+		if cs, conn := m[c.host], fsm.Conn(); cs != nil && conn != nil {
+			cs.BytesRead += conn.BytesRead()
+			cs.BytesWritten += conn.BytesWritten()
+		}
+	}
+
+	a := make([]ClientStats, 0, len(m))
+	for _, cs := range m {
+		a = append(a, *cs)
+	}
+	sort.Slice(a, func(i, j int) bool { return a[i].Addr < a[j].Addr })
+	return a
+}
+
+// Unban lifts the ban of a client IP, if any, and clears its recent
+// handshake failures.
+func (l *Listener) Unban(host string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s := l.stats[trimHostBrackets(host)]
+	if s == nil {
+		return ErrClientNotFound
+	}
+	s.bannedUntil, s.failures = time.Time{}, nil
+	return nil
+}
+
+// recordAttempt counts a connection from host. Returns false if host is
+// banned, in which case the connection is counted as rejected.
+func (l *Listener) recordAttempt(host string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	s := l.stats[host]
+	if s == nil {
+		l.pruneStats(now)
+		s = &clientStats{}
+		l.stats[host] = s
+	}
+	s.conns++
+	s.lastSeen = now
+
+	if now.Before(s.bannedUntil) {
+		s.rejected++
+		return false
+	}
+	return true
+}
+
+// recordRejected counts a connection from host closed by the limits.
+func (l *Listener) recordRejected(host string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if s := l.stats[host]; s != nil {
+		s.rejected++
+	}
+}
+
+// recordConnEnd adds the bytes of a finished connection from host. If the
+// handshake failed and the client reaches the ban threshold then it is banned.
+// Must hold l.mu.
+func (l *Listener) recordConnEnd(host string, fsm *fsm) {
+	s := l.stats[host]
+	if s == nil {
+		return
+	}
+	now := time.Now()
+	s.lastSeen = now
+	if conn := fsm.Conn(); conn != nil {
+		s.bytesRead += conn.BytesRead()
+		s.bytesWritten += conn.BytesWritten()
+	}
+	if fsm.handshook {
+		return
+	}
+	s.handshakeFailures++
+
+	if l.BanThreshold <= 0 {
+		return
+	}
+
+	// Only count failures within the ban window.
+	s.failures = append(s.failures, now)
+	for len(s.failures) > 0 && now.Sub(s.failures[0]) > l.BanWindow {
+		s.failures = s.failures[1:]
+	}
+	if len(s.failures) < l.BanThreshold {
+		return
+	}
+
+	s.bannedUntil, s.failures = now.Add(l.BanDuration), nil
+	Logger.Warn("client banned after repeated handshake failures",
+		zap.String("addr", host),
+		zap.Int("failures", l.BanThreshold),
+		zap.Duration("window", l.BanWindow),
+		zap.Duration("duration", l.BanDuration))
+}
+
+// pruneStats removes the statistics of clients with no connections which
+// have not been seen recently and are not banned. Must hold l.mu.
+func (l *Listener) pruneStats(now time.Time) {
+	if now.Sub(l.pruned) < clientStatsPruneInterval {
+		return
+	}
+	l.pruned = now
+
+	for host, s := range l.stats {
+		if l.hostConns[host] == 0 && now.Sub(s.lastSeen) > clientStatsRetention && !now.Before(s.bannedUntil) {
+			delete(l.stats, host)
+		}
+	}
+}
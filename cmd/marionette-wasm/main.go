@@ -0,0 +1,118 @@
+//go:build js && wasm
+// +build js,wasm
+
+// Command marionette-wasm exposes a marionette client to JavaScript when run
+// by a browser's WebAssembly runtime. The client connects to a server's
+// -websocket address instead of its format's port.
+//
+// The global "marionette" object has the following functions:
+//
+//	probe(format, url)  Promise resolved once a stream is opened to the server.
+//	dial(format, url)   Promise resolved with a stream, which has write(bytes),
+//	                    read() & close() functions. read() resolves with a
+//	                    Uint8Array or with null at the end of the stream.
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"syscall/js"
+
+	"github.com/redjack/marionette"
+	_ "github.com/redjack/marionette/plugins"
+)
+
+var errUsage = errors.New("usage: (format, url)")
+
+func main() {
+	js.Global().Set("marionette", js.ValueOf(map[string]interface{}{
+		"probe": js.FuncOf(probe),
+		"dial":  js.FuncOf(dial),
+	}))
+	select {}
+}
+
+// probe opens & closes a stream to the server.
+func probe(this js.Value, args []js.Value) interface{} {
+	return promise(func() (interface{}, error) {
+		conn, err := dialArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		conn.Close()
+		return true, nil
+	})
+}
+
+// dial opens a stream to the server and returns it as a JavaScript object.
+func dial(this js.Value, args []js.Value) interface{} {
+	return promise(func() (interface{}, error) {
+		conn, err := dialArgs(args)
+		if err != nil {
+			return nil, err
+		}
+		return newStream(conn), nil
+	})
+}
+
+// dialArgs opens a stream using the format & WebSocket URL arguments.
+func dialArgs(args []js.Value) (net.Conn, error) {
+	if len(args) != 2 {
+		return nil, errUsage
+	}
+	format, url := args[0].String(), args[1].String()
+	return marionette.DialFormat(context.Background(), format, url, &marionette.FormatOptions{
+		Dialer: &marionette.WebSocketDialer{URL: url},
+	})
+}
+
+// newStream returns a JavaScript object wrapping conn.
+func newStream(conn net.Conn) js.Value {
+	buf := make([]byte, marionette.MaxCellLength)
+	return js.ValueOf(map[string]interface{}{
+		"write": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			b := make([]byte, args[0].Get("length").Int())
+			js.CopyBytesToGo(b, args[0])
+			return promise(func() (interface{}, error) {
+				_, err := conn.Write(b)
+				return nil, err
+			})
+		}),
+		"read": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			return promise(func() (interface{}, error) {
+				n, err := conn.Read(buf)
+				if err == io.EOF {
+					return nil, nil
+				} else if err != nil {
+					return nil, err
+				}
+				a := js.Global().Get("Uint8Array").New(n)
+				js.CopyBytesToJS(a, buf[:n])
+				return a, nil
+			})
+		}),
+		"close": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			conn.Close()
+			return nil
+		}),
+	})
+}
+
+// promise returns a JavaScript Promise settled by fn, which runs in a new
+// goroutine so that it may block.
+func promise(fn func() (interface{}, error)) js.Value {
+	return js.Global().Get("Promise").New(js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		resolve, reject := args[0], args[1]
+		go func() {
+			v, err := fn()
+			if err != nil {
+				reject.Invoke(js.Global().Get("Error").New(err.Error()))
+				return
+			}
+			resolve.Invoke(v)
+		}()
+		return nil
+	}))
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+// AdminHandler serves a local HTTP API to inspect & control a running client
+// or server. Every request must carry Token as a bearer token:
+//
+//	GET    /conns         list open connections as JSON
+//	DELETE /conns/:id     close a connection
+//	GET    /clients       list per-client IP statistics as JSON (server only)
+//	DELETE /clients/:ip   lift a client IP's ban (server only)
+//	GET    /usage         list each user's usage & quota as JSON (server with -credentials only)
+//	DELETE /usage/:name   reset a user's usage (server with -credentials only)
+//	POST   /reload        re-read & apply the formats
+//	GET    /log-level     show the log levels
+//	PUT    /log-level     set the log levels from the request body
+type AdminHandler struct {
+	Token string
+
+	// Operations on the command's listeners or dialer.
+	Conns     func() []marionette.ConnInfo
+	CloseConn func(id int) error
+	Reload    func() error
+
+	// Operations on per-client IP statistics, if supported.
+	Clients func() []marionette.ClientStats
+	Unban   func(ip string) error
+
+	// Operations on the usage of a private bridge's users, if supported.
+	Usage      func() []marionette.UserUsage
+	ResetUsage func(name string) error
+
+	levels *logLevels
+}
+
+func (h *AdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch path := strings.TrimSuffix(r.URL.Path, "/"); {
+	case path == "/conns" && r.Method == "GET":
+		h.serveConns(w, r)
+	case strings.HasPrefix(path, "/conns/") && r.Method == "DELETE":
+		h.serveCloseConn(w, r, strings.TrimPrefix(path, "/conns/"))
+	case path == "/clients" && r.Method == "GET" && h.Clients != nil:
+		h.serveClients(w, r)
+	case strings.HasPrefix(path, "/clients/") && r.Method == "DELETE" && h.Unban != nil:
+		h.serveUnban(w, r, strings.TrimPrefix(path, "/clients/"))
+	case path == "/usage" && r.Method == "GET" && h.Usage != nil:
+		h.serveUsage(w, r)
+	case strings.HasPrefix(path, "/usage/") && r.Method == "DELETE" && h.ResetUsage != nil:
+		h.serveResetUsage(w, r, strings.TrimPrefix(path, "/usage/"))
+	case path == "/reload" && r.Method == "POST":
+		h.serveReload(w, r)
+	case path == "/log-level" && r.Method == "GET":
+		w.Write([]byte(h.levels.String() + "\n"))
+	case path == "/log-level" && r.Method == "PUT":
+		h.serveSetLogLevel(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// authorized returns true if r carries the admin token.
+func (h *AdminHandler) authorized(r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	if h.Token == "" || !strings.HasPrefix(auth, "Bearer ") {
+		return false
+	}
+	token := strings.TrimPrefix(auth, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.Token)) == 1
+}
+
+func (h *AdminHandler) serveConns(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.Conns())
+}
+
+func (h *AdminHandler) serveCloseConn(w http.ResponseWriter, r *http.Request, s string) {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		http.Error(w, "invalid connection id", http.StatusBadRequest)
+		return
+	} else if err := h.CloseConn(id); err == marionette.ErrConnNotFound {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	marionette.Logger.Info("admin: connection closed", zap.Int("id", id))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AdminHandler) serveClients(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.Clients())
+}
+
+func (h *AdminHandler) serveUnban(w http.ResponseWriter, r *http.Request, ip string) {
+	if err := h.Unban(ip); err == marionette.ErrClientNotFound {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	marionette.Logger.Info("admin: client unbanned", zap.String("addr", ip))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AdminHandler) serveUsage(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.Usage())
+}
+
+func (h *AdminHandler) serveResetUsage(w http.ResponseWriter, r *http.Request, name string) {
+	if err := h.ResetUsage(name); err == marionette.ErrUserNotFound {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	marionette.Logger.Info("admin: user usage reset", zap.String("name", name))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AdminHandler) serveReload(w http.ResponseWriter, r *http.Request) {
+	if err := h.Reload(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	marionette.Logger.Info("admin: formats reloaded")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AdminHandler) serveSetLogLevel(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, 4096))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err := h.levels.Set(strings.TrimSpace(string(body))); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	marionette.Logger.Info("admin: log level changed", zap.String("level", h.levels.String()))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveAdmin starts the admin API on addr, a host & port or "unix:///path"
+// socket, in a separate goroutine.
+func (fs *FlagSet) serveAdmin(addr, token string, h *AdminHandler) error {
+	if token == "" {
+		return errors.New("admin token required")
+	}
+	ln, err := marionette.ListenAddr(addr)
+	if err != nil {
+		return err
+	}
+	h.Token, h.levels = token, fs.logLevels
+
+	fmt.Fprintf(os.Stderr, "admin api listening on %s\n", addr)
+	go func() { http.Serve(ln, h) }()
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestAdminHandler_Authorization(t *testing.T) {
+	h := &AdminHandler{
+		Token: "secret",
+		Conns: func() []marionette.ConnInfo { return nil },
+	}
+
+	for _, tt := range []struct {
+		name   string
+		header string
+		code   int
+	}{
+		{"Missing", "", http.StatusUnauthorized},
+		{"Wrong", "Bearer wrong!", http.StatusUnauthorized},
+		{"Prefix", "Bearer secre", http.StatusUnauthorized},
+		{"Suffix", "Bearer secrets", http.StatusUnauthorized},
+		{"NoScheme", "secret", http.StatusUnauthorized},
+		{"Basic", "Basic secret", http.StatusUnauthorized},
+		{"OK", "Bearer secret", http.StatusOK},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/conns", nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != tt.code {
+				t.Fatalf("unexpected status: %d, want %d", w.Code, tt.code)
+			} else if tt.code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") != "Bearer" {
+				t.Fatalf("unexpected WWW-Authenticate: %q", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+// Ensure an empty token never authorizes requests, even an empty bearer token.
+func TestAdminHandler_Authorization_EmptyToken(t *testing.T) {
+	h := &AdminHandler{Conns: func() []marionette.ConnInfo { return nil }}
+
+	r := httptest.NewRequest("GET", "/conns", nil)
+	r.Header.Set("Authorization", "Bearer ")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+}
+
+func TestAdminHandler_Usage(t *testing.T) {
+	var reset []string
+	h := &AdminHandler{
+		Token: "secret",
+		Usage: func() []marionette.UserUsage {
+			return []marionette.UserUsage{{ID: 1, Name: "alice", Bytes: 100, Quota: 1000}}
+		},
+		ResetUsage: func(name string) error {
+			if name != "alice" {
+				return marionette.ErrUserNotFound
+			}
+			reset = append(reset, name)
+			return nil
+		},
+	}
+
+	for _, tt := range []struct {
+		method, path string
+		code         int
+		body         string
+	}{
+		{"GET", "/usage", http.StatusOK, `[{"id":1,"name":"alice","bytes":100,"total_bytes":0,"window_start":"0001-01-01T00:00:00Z","quota":1000}]` + "\n"},
+		{"DELETE", "/usage/alice", http.StatusNoContent, ""},
+		{"DELETE", "/usage/bob", http.StatusNotFound, "marionette: user not found\n"},
+	} {
+		r := httptest.NewRequest(tt.method, tt.path, nil)
+		r.Header.Set("Authorization", "Bearer secret")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != tt.code {
+			t.Fatalf("%s %s: unexpected status: %d", tt.method, tt.path, w.Code)
+		} else if w.Body.String() != tt.body {
+			t.Fatalf("%s %s: unexpected body: %q", tt.method, tt.path, w.Body.String())
+		}
+	}
+	if len(reset) != 1 {
+		t.Fatalf("unexpected resets: %v", reset)
+	}
+
+	// Usage is not served without credentials.
+	h.Usage, h.ResetUsage = nil, nil
+	r := httptest.NewRequest("GET", "/usage", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redjack/marionette"
+	_ "github.com/redjack/marionette/plugins"
+)
+
+// benchChunkSize is the size of each write when measuring goodput.
+const benchChunkSize = 1024
+
+// BenchCommand measures the performance of one or more formats, either with
+// an in-process server or against a remote server which echoes streams.
+type BenchCommand struct{}
+
+func NewBenchCommand() *BenchCommand {
+	return &BenchCommand{}
+}
+
+// benchOptions are the settings shared by each format's benchmark.
+type benchOptions struct {
+	server   string
+	dest     string
+	duration time.Duration
+	timeout  time.Duration
+	streams  int
+	size     int
+}
+
+// benchResult holds the measurements of a single format.
+type benchResult struct {
+	streamsPerSec float64       // streams opened, echoed & closed per second
+	latency       time.Duration // average time to open a stream & echo a message
+	goodput       float64       // echoed payload bytes per second
+	overhead      float64       // cover bytes per payload byte
+}
+
+func (cmd *BenchCommand) Run(args []string) error {
+	fs := NewFlagSet("marionette-bench", flag.ContinueOnError)
+	var (
+		format   = fs.String("format", "", "Comma-separated list of formats to compare")
+		server   = fs.String("server", "", "Remote server to benchmark, whose -proxy echoes streams (default runs a server in-process)")
+		dest     = fs.String("dest", "", "Echo destination the remote server connects streams to (requires server -tunnel)")
+		duration = fs.Duration("duration", 5*time.Second, "Duration of each measurement")
+		timeout  = fs.Duration("timeout", 10*time.Second, "Time a format may stall before its benchmark is abandoned")
+		streams  = fs.Int("streams", 1, "Number of parallel streams when measuring goodput")
+		size     = fs.Int("size", 16, "Size in bytes of the message echoed when measuring latency")
+		verbose  = fs.Bool("v", false, "Debug logging enabled")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	} else if *format == "" {
+		return errors.New("format required")
+	} else if *streams < 1 {
+		return errors.New("streams must be at least 1")
+	} else if *size < 1 || *size > marionette.MaxCellLength {
+		return fmt.Errorf("size must be between 1 and %d", marionette.MaxCellLength)
+	} else if *dest != "" && *server == "" {
+		return errors.New("dest requires a remote server")
+	} else if err := fs.setupLogging(*verbose); err != nil {
+		return err
+	}
+
+	opt := benchOptions{
+		server:   *server,
+		dest:     *dest,
+		duration: *duration,
+		timeout:  *timeout,
+		streams:  *streams,
+		size:     *size,
+	}
+
+	// Results are printed as each format finishes so columns are sized by
+	// the longest format name.
+	var names []string
+	width := len("FORMAT")
+	for _, name := range strings.Split(*format, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+			if len(name) > width {
+				width = len(name)
+			}
+		}
+	}
+
+	fmt.Printf("%-*s  %10s  %10s  %14s  %8s\n", width, "FORMAT", "STREAMS/S", "LATENCY", "GOODPUT", "OVERHEAD")
+	for _, name := range names {
+		result, err := cmd.benchFormat(name, opt)
+		if err != nil {
+			fmt.Printf("%-*s  error: %s\n", width, name, err)
+			continue
+		}
+		fmt.Printf("%-*s  %10.1f  %10s  %14s  %7.2fx\n", width, name,
+			result.streamsPerSec, roundDuration(result.latency), formatBytes(result.goodput)+"/s", result.overhead)
+	}
+	return nil
+}
+
+// benchFormat connects to a server running the named format and measures it.
+func (cmd *BenchCommand) benchFormat(name string, opt benchOptions) (*benchResult, error) {
+	clientDocs, err := readFormats(marionette.PartyClient, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run the server in-process over an in-memory connection, unless remote.
+	var dialer *marionette.Dialer
+	if opt.server == "" {
+		serverDocs, err := readFormats(marionette.PartyServer, name)
+		if err != nil {
+			return nil, err
+		}
+
+		var ln *marionette.Listener
+		dialer, ln = marionette.Pipe(clientDocs[0], serverDocs[0])
+		defer ln.Close()
+		go serveEcho(ln)
+	} else {
+		dialer = marionette.NewDialer(clientDocs[0], opt.server, marionette.NewStreamSet())
+	}
+	if err := dialer.Open(); err != nil {
+		return nil, err
+	}
+	defer dialer.Close()
+
+	// Abandon formats which stall by closing their streams.
+	var stalled int32
+	timer := time.AfterFunc(2*opt.duration+opt.timeout, func() {
+		atomic.StoreInt32(&stalled, 1)
+		dialer.Close()
+	})
+	defer timer.Stop()
+
+	b := &bench{dialer: dialer, opt: opt}
+	var result benchResult
+	if err := b.measureStreams(&result); err != nil {
+		if atomic.LoadInt32(&stalled) == 1 {
+			return nil, errors.New("timed out")
+		}
+		return nil, err
+	}
+	if err := b.measureGoodput(&result); err != nil {
+		if atomic.LoadInt32(&stalled) == 1 {
+			return nil, errors.New("timed out")
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// bench runs the measurements of a single format over an open dialer.
+type bench struct {
+	dialer *marionette.Dialer
+	opt    benchOptions
+}
+
+// dial opens a stream to the echo service.
+func (b *bench) dial() (net.Conn, error) {
+	if b.opt.dest != "" {
+		return b.dialer.DialDestination(b.opt.dest)
+	}
+	return b.dialer.Dial()
+}
+
+// measureStreams repeatedly opens a stream, echoes a message, and closes it.
+func (b *bench) measureStreams(result *benchResult) error {
+	msg, buf := make([]byte, b.opt.size), make([]byte, b.opt.size)
+
+	var n int
+	var total time.Duration
+	start := time.Now()
+	for n == 0 || time.Since(start) < b.opt.duration {
+		t := time.Now()
+		conn, err := b.dial()
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Write(msg); err != nil {
+			conn.Close()
+			return err
+		} else if _, err := io.ReadFull(conn, buf); err != nil {
+			conn.Close()
+			return fmt.Errorf("echo: %s", err)
+		}
+		conn.Close()
+
+		total += time.Since(t)
+		n++
+	}
+
+	result.streamsPerSec = float64(n) / time.Since(start).Seconds()
+	result.latency = total / time.Duration(n)
+	return nil
+}
+
+// measureGoodput writes to parallel streams and counts the bytes echoed back.
+// The overhead is the cover traffic in both directions per echoed byte.
+func (b *bench) measureGoodput(result *benchResult) error {
+	coverStart := b.coverBytes()
+
+	var conns []net.Conn
+	for i := 0; i < b.opt.streams; i++ {
+		conn, err := b.dial()
+		if err != nil {
+			closeAll(conns)
+			return err
+		}
+		conns = append(conns, conn)
+	}
+
+	var echoed int64
+	var wg sync.WaitGroup
+	start := time.Now()
+	for _, conn := range conns {
+		wg.Add(2)
+		go func(conn net.Conn) {
+			defer wg.Done()
+			buf := make([]byte, benchChunkSize)
+			for {
+				if _, err := conn.Write(buf); err != nil {
+					return
+				}
+			}
+		}(conn)
+		go func(conn net.Conn) {
+			defer wg.Done()
+			buf := make([]byte, marionette.MaxCellLength)
+			for {
+				n, err := conn.Read(buf)
+				atomic.AddInt64(&echoed, int64(n))
+				if err != nil {
+					return
+				}
+			}
+		}(conn)
+	}
+
+	time.Sleep(b.opt.duration)
+	n, elapsed := atomic.LoadInt64(&echoed), time.Since(start)
+	cover := b.coverBytes() - coverStart
+	closeAll(conns)
+	wg.Wait()
+
+	if n == 0 {
+		return errors.New("no data echoed")
+	}
+	result.goodput = float64(n) / elapsed.Seconds()
+	result.overhead = float64(cover) / float64(2*n)
+	return nil
+}
+
+// coverBytes returns the bytes sent & received on the dialer's connections.
+func (b *bench) coverBytes() int64 {
+	var n int64
+	for _, info := range b.dialer.Conns() {
+		n += info.BytesRead + info.BytesWritten
+	}
+	return n
+}
+
+// serveEcho writes the data of each stream accepted from ln back to it.
+func serveEcho(ln net.Listener) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			io.Copy(conn, conn)
+		}()
+	}
+}
+
+// closeAll closes each connection. Streams only close for writes so their
+// reads are also closed, in case the server never closes its side.
+func closeAll(conns []net.Conn) {
+	for _, conn := range conns {
+		conn.Close()
+		if stream, ok := conn.(*marionette.Stream); ok {
+			stream.CloseRead()
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// Ensure a format is measured against an in-process echo server.
+func TestBenchCommand_BenchFormat(t *testing.T) {
+	var cmd BenchCommand
+	result, err := cmd.benchFormat("http_simple_blocking", benchOptions{
+		duration: 100 * time.Millisecond,
+		timeout:  10 * time.Second,
+		streams:  2,
+		size:     16,
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if result.streamsPerSec <= 0 {
+		t.Fatalf("unexpected streams/s: %f", result.streamsPerSec)
+	} else if result.latency <= 0 {
+		t.Fatalf("unexpected latency: %s", result.latency)
+	} else if result.goodput <= 0 {
+		t.Fatalf("unexpected goodput: %f", result.goodput)
+	} else if result.overhead <= 0 {
+		t.Fatalf("unexpected overhead: %f", result.overhead)
+	}
+}
+
+func TestBenchCommand_BenchFormat_ErrUnknownFormat(t *testing.T) {
+	var cmd BenchCommand
+	if _, err := cmd.benchFormat("no_such_format", benchOptions{duration: time.Millisecond, streams: 1, size: 1}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestBenchCommand_Run_ErrInvalidArgs(t *testing.T) {
+	for _, tt := range []struct {
+		args []string
+		err  string
+	}{
+		{nil, "format required"},
+		{[]string{"-format", "http_simple_blocking", "-streams", "0"}, "streams must be at least 1"},
+		{[]string{"-format", "http_simple_blocking", "-size", "0"}, "size must be between 1 and 32768"},
+		{[]string{"-format", "http_simple_blocking", "-dest", "127.0.0.1:7"}, "dest requires a remote server"},
+	} {
+		if err := NewBenchCommand().Run(tt.args); err == nil || err.Error() != tt.err {
+			t.Fatalf("unexpected error for %v: %v", tt.args, err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/redjack/marionette"
+)
+
+// BridgeLineCommand prints a bridge line for a server, which clients pass to
+// -bridge instead of setting -server & -format.
+type BridgeLineCommand struct{}
+
+func NewBridgeLineCommand() *BridgeLineCommand {
+	return &BridgeLineCommand{}
+}
+
+func (cmd *BridgeLineCommand) Run(args []string) error {
+	fs := NewFlagSet("marionette-bridge-line", flag.ContinueOnError)
+	var (
+		server   = fs.String("server", "", "Public server host name or IP address, with a port to use instead of the format's")
+		format   = fs.String("format", "", "Format name and version")
+		channels = fs.Int("channels", 0, "Number of parallel connections clients should open (0 uses the client's default)")
+		label    = fs.String("label", "", "Name of the server shown to users")
+		secret   = fs.String("auth-secret", "", "Server's -auth-secret, which clients of the line prove knowledge of")
+		newKey   = fs.Bool("new-secret", false, "Generate a new -auth-secret for the server and include it")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Validate arguments.
+	if *server == "" {
+		return errors.New("server required")
+	} else if *channels < 0 {
+		return errors.New("channels must not be negative")
+	} else if *newKey && *secret != "" {
+		return errors.New("auth-secret cannot be used with new-secret")
+	}
+
+	key, err := parseAuthSecret(*secret)
+	if err != nil {
+		return err
+	} else if *newKey {
+		if key, err = marionette.NewAuthSecret(); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "start the server with: -auth-secret %s\n", marionette.EncodeAuthSecret(key))
+	}
+
+	// Ensure the format exists so a client can use the line.
+	if _, err := readFormats(marionette.PartyClient, *format); err != nil {
+		return err
+	}
+
+	b := marionette.NewBridgeLine(*server, *format)
+	b.Channels, b.Label, b.Secret = *channels, *label, key
+	fmt.Println(b.String())
+	return nil
+}
+
+// parseAuthSecret decodes a bridge secret passed to -auth-secret, if set.
+func parseAuthSecret(s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return marionette.ParseAuthSecret(s)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/redjack/marionette"
+)
+
+// openCapture creates the pcap file at -pcap, if set, to record the traffic
+// of cover connections. The returned function closes the file and must be
+// called before the command exits.
+func (fs *FlagSet) openCapture() (*marionette.PcapWriter, func(), error) {
+	if fs.PcapPath == "" {
+		return nil, func() {}, nil
+	}
+
+	f, err := os.Create(fs.PcapPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	w, err := marionette.NewPcapWriter(f)
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	fmt.Fprintf(os.Stderr, "capturing cover traffic to %s\n", fs.PcapPath)
+
+	return w, func() { f.Close() }, nil
+}
@@ -1,18 +1,19 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
-	"os/signal"
+	"strings"
+	"time"
 
 	"github.com/redjack/marionette"
-	"github.com/redjack/marionette/fte"
-	"github.com/redjack/marionette/mar"
 	_ "github.com/redjack/marionette/plugins"
-	"go.uber.org/zap"
 )
 
 type ClientCommand struct{}
@@ -25,79 +26,448 @@ func (cmd *ClientCommand) Run(args []string) error {
 	// Parse arguments.
 	fs := NewFlagSet("marionette-client", flag.ContinueOnError)
 	var (
-		bind     = fs.String("bind", "127.0.0.1:8079", "Bind address")
-		serverIP = fs.String("server", "127.0.0.1", "Server IP address")
-		format   = fs.String("format", "", "Format name and version")
-		verbose  = fs.Bool("v", false, "Debug logging enabled")
+		bind             = fs.String("bind", "127.0.0.1:8079", "Bind address or unix:///path socket")
+		bridge           = fs.String("bridge", "", "Bridge line from the bridge-line command, used instead of -server & -format")
+		authKey          = fs.String("auth-secret", "", "Bridge secret of a server started with -auth-secret, if not in the -bridge line")
+		randHello        = fs.Bool("randomize-handshake", false, "Randomize the AUTH cell's padding and the size & timing of each connection's first messages, seeded from -auth-secret")
+		discover         = fs.String("discover", "", "Domain whose signed _marionette TXT records list the servers, used instead of -server & -format")
+		discoverKey      = fs.String("discover-key", "", "Base64 ed25519 public key -discover records must be signed with")
+		discoverInterval = fs.Duration("discover-interval", time.Hour, "Time between -discover lookups for rotated servers (0 disables)")
+		broker           = fs.String("broker", "", "HTTPS URL of a broker's signed bridge list to take the server & format from, used instead of -server & -format")
+		brokerFront      = fs.String("broker-front", "", "Domain to connect to for -broker while requesting the broker's host, such as a CDN front")
+		brokerKey        = fs.String("broker-key", "", "Base64 ed25519 public key -broker records must be signed with")
+		telemetry        = fs.String("telemetry", "", "Opt in to posting noised daily counts of successful & failed handshakes per format, without addresses, to this research collector URL")
+		telemetryASN     = fs.Int("telemetry-asn", 0, "Autonomous system number of your network to include in -telemetry reports (0 omits it)")
+		stdio            = fs.Bool("stdio", false, "Carry the cover connection over stdin & stdout instead of connecting to -server, such as inside another tunnel")
+		serverIP         = fs.String("server", "127.0.0.1", "Server host name or IP address, or a comma-separated or JSON list to fail over between")
+		format           = fs.String("format", "", "Format name and version, or a comma-separated list for -multipath")
+		proxyMode        = fs.String("proxy-mode", "raw", "Local proxy protocol: raw, socks5, http, redirect, or tproxy (all but raw require server -tunnel)")
+		socks5Auth       = fs.String("socks5-auth", "", "Require socks5 username and password (user:pass)")
+		sysproxy         = fs.Bool("sysproxy", false, "Point the system proxy settings at -bind while running (requires -proxy-mode socks5 or http)")
+		channels         = fs.Int("channels", 1, "Number of parallel connections to the server")
+		resume           = fs.Bool("resume", false, "Reconnect and resume open streams when a connection drops")
+		multipath        = fs.Bool("multipath", false, "Stripe streams across all channels, plus one per additional format")
+		duplicate        = fs.Bool("duplicate", false, "Send every cell on each -multipath channel")
+		rateLimit        = fs.Int("rate-limit", 0, "Limit bytes per second to & from the server (0 is unlimited)")
+		streamRate       = fs.Int("stream-rate-limit", 0, "Limit bytes per second for each stream (0 is unlimited)")
+		upstream         = fs.String("upstream-proxy", "", "Connect to the server through a proxy (socks5://, socks4a://, http://, or https:// URL)")
+		udpForward       = fs.String("udp-forward", "", "Forward datagrams from a local UDP address to a destination via the server, as local=host:port (requires server -tunnel), such as for WireGuard or OpenVPN")
+		reverse          = fs.String("reverse", "", "Offer a local service (host:port or unix:///path) on the server's -reverse-bind address instead of listening locally")
+		admin            = fs.String("admin", "", "Admin API bind address or unix:///path socket")
+		adminToken       = fs.String("admin-token", "", "Bearer token required by the admin API")
+		shutdown         = fs.Duration("shutdown-timeout", DefaultShutdownTimeout, "Time open streams may drain after SIGINT or SIGTERM before exiting")
+		verbose          = fs.Bool("v", false, "Debug logging enabled")
 	)
 	if err := fs.Parse(args); err != nil {
 		return err
 	}
 
+	// Take the server, format & channels from the bridge line, if set.
+	var bridgePort string
+	if *bridge != "" {
+		b, err := marionette.ParseBridgeLine(*bridge)
+		if err != nil {
+			return err
+		}
+		*serverIP, *format, bridgePort = b.Host, b.FormatName(), b.Port
+		if b.Channels > 0 {
+			*channels = b.Channels
+		}
+		if b.Secret != nil && *authKey == "" {
+			*authKey = marionette.EncodeAuthSecret(b.Secret)
+		}
+	}
+
+	// Or look them up from the discovery domain's records.
+	var discoverer *marionette.Discoverer
+	if *discover != "" {
+		if *bridge != "" {
+			return errors.New("discover cannot be used with bridge")
+		}
+		d, err := newDiscoverer(*discover, *discoverKey)
+		if err != nil {
+			return err
+		}
+		s, err := discoverServers(d)
+		if err != nil {
+			return err
+		}
+		discoverer = d
+		*serverIP, *format, bridgePort = strings.Join(s.Servers, ","), s.Format, s.Port
+		if s.Channels > 0 {
+			*channels = s.Channels
+		}
+	}
+
+	// Or fetch them from a broker, fronted through another domain if set.
+	if *broker != "" {
+		if *bridge != "" || *discover != "" {
+			return errors.New("broker cannot be used with bridge or discover")
+		}
+		key, err := parseDiscoveryPublicKey("broker key", *brokerKey)
+		if err != nil {
+			return err
+		}
+		c := marionette.NewBrokerClient(*broker, key)
+		c.Front = *brokerFront
+		s, err := fetchBrokerServers(c)
+		if err != nil {
+			return err
+		}
+		*serverIP, *format, bridgePort = strings.Join(s.Servers, ","), s.Format, s.Port
+		if s.Channels > 0 {
+			*channels = s.Channels
+		}
+	}
+
 	// Validate arguments.
 	if *format == "" {
 		return errors.New("format required")
+	} else if !isValidProxyMode(*proxyMode) {
+		return fmt.Errorf("invalid proxy mode: %q", *proxyMode)
+	} else if *channels < 1 {
+		return errors.New("channels must be at least 1")
+	} else if network, _ := marionette.ParseNetworkAddr(*bind); network == "unix" && (*proxyMode == "redirect" || *proxyMode == "tproxy") {
+		return fmt.Errorf("proxy mode %s requires a tcp bind address", *proxyMode)
+	} else if *sysproxy && *proxyMode != "socks5" && *proxyMode != "http" {
+		return errors.New("sysproxy requires -proxy-mode=socks5 or -proxy-mode=http")
+	} else if *socks5Auth != "" && *proxyMode != "socks5" {
+		return errors.New("socks5 auth requires -proxy-mode=socks5")
+	} else if *socks5Auth != "" && !strings.Contains(*socks5Auth, ":") {
+		return errors.New("socks5 auth must be in the form user:pass")
+	} else if *duplicate && !*multipath {
+		return errors.New("duplicate requires -multipath")
+	} else if *stdio && (*channels != 1 || *multipath || *resume) {
+		return errors.New("stdio carries a single connection and cannot be used with -channels, -multipath or -resume")
+	}
+
+	servers, err := parseServerList(*serverIP)
+	if err != nil {
+		return err
+	}
+	secret, err := parseAuthSecret(*authKey)
+	if err != nil {
+		return err
+	} else if *randHello && secret == nil {
+		return errors.New("randomize-handshake requires auth-secret")
+	}
+
+	var proxyDialer *marionette.ProxyDialer
+	if *upstream != "" {
+		u, err := url.Parse(*upstream)
+		if err != nil {
+			return fmt.Errorf("invalid upstream proxy: %s", err)
+		} else if proxyDialer, err = marionette.NewProxyDialer(u, nil); err != nil {
+			return err
+		}
 	}
 
-	// Read MAR file.
-	data, err := mar.ReadFormat(*format)
-	if os.IsNotExist(err) {
-		return fmt.Errorf("MAR document not found: %s", *format)
-	} else if err != nil {
+	// Read & parse MAR files.
+	docs, err := readFormats(marionette.PartyClient, *format)
+	if err != nil {
 		return err
+	} else if len(docs) > 1 && !*multipath {
+		return errors.New("multiple formats require -multipath")
+	} else if bridgePort != "" {
+		docs[0].Port = bridgePort
 	}
 
-	// Parse document.
-	doc, err := mar.Parse(marionette.PartyClient, data)
+	// Set up logging, with debug logging if verbose.
+	closeCrashReport, err := fs.setupCommandLogging(*verbose)
 	if err != nil {
 		return err
 	}
+	defer closeCrashReport()
 
-	// Set logger if debug is on.
-	fte.Verbose = *verbose
-	if *verbose {
-		config := zap.NewDevelopmentConfig()
-		config.DisableStacktrace = true
-		marionette.Logger, _ = config.Build()
-	} else {
-		config := zap.NewProductionConfig()
-		config.DisableStacktrace = true
-		marionette.Logger, _ = config.Build()
+	// Publish connection & stream events to -events sinks, if any.
+	closeEvents, err := fs.setupEvents()
+	if err != nil {
+		return err
 	}
+	defer closeEvents()
+
+	// Report reachability to a research collector, if opted in.
+	if *telemetry != "" {
+		reporter := marionette.NewReachabilityReporter(*telemetry)
+		reporter.ASN = *telemetryASN
+		if err := reporter.Open(); err != nil {
+			return err
+		}
+		marionette.AddEventSink(reporter)
+		defer reporter.Close()
+		defer marionette.RemoveEventSink(reporter)
+	}
+
+	// Export spans to an OpenTelemetry collector, if enabled.
+	shutdownTracing, err := fs.setupTracing()
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing()
+
+	// Record cover traffic to a pcap file, if enabled.
+	capture, closeCapture, err := fs.openCapture()
+	if err != nil {
+		return err
+	}
+	defer closeCapture()
 
 	streamSet := marionette.NewStreamSet()
 	streamSet.TracePath = fs.TracePath
+	streamSet.StreamRateLimit = *streamRate
+	streamSet.StreamIdleTimeout = fs.StreamIdleTimeout
+	streamSet.StreamMaxLifetime = fs.StreamMaxLifetime
 
 	// Create dialer to remote server.
-	dialer := marionette.NewDialer(doc, *serverIP, streamSet)
+	dialer := marionette.NewDialer(docs[0], servers[0], streamSet)
+	dialer.Fallbacks = servers[1:]
+	dialer.SocketOptions = &fs.SocketOptions
+	dialer.Segmentation = fs.segmentation()
+	dialer.Capture = capture
+	if proxyDialer != nil {
+		dialer.Dialer = proxyDialer
+	}
+	dialer.Channels = *channels
+	dialer.Resume = *resume
+	dialer.Multipath = *multipath
+	dialer.Duplicate = *duplicate
+	for _, doc := range docs[1:] {
+		dialer.Paths = append(dialer.Paths, marionette.DialerPath{Doc: doc})
+	}
+	dialer.RateLimit = *rateLimit
+	dialer.AuthSecret = secret
+	if *randHello {
+		dialer.HandshakeProfile = marionette.NewHandshakeProfile(secret)
+	}
+	if *stdio {
+		dialer.DialFunc = marionette.ConnDialFunc(openStdio())
+	}
+
+	// Apply log levels & rate limit from the config file on SIGHUP.
+	handleReload(func() error {
+		if err := fs.reload("rate-limit"); err != nil {
+			return err
+		}
+		dialer.SetRateLimit(*rateLimit)
+		return nil
+	})
+
+	// Serve admin API, if enabled. Only a single format can be reloaded.
+	if *admin != "" {
+		if err := fs.serveAdmin(*admin, *adminToken, &AdminHandler{
+			Conns:     dialer.Conns,
+			CloseConn: dialer.CloseConn,
+			Reload: func() error {
+				docs, err := readFormats(marionette.PartyClient, *format)
+				if err != nil {
+					return err
+				} else if len(docs) > 1 {
+					return errors.New("cannot reload multiple formats")
+				} else if bridgePort != "" {
+					docs[0].Port = bridgePort
+				}
+				dialer.SetDocument(docs[0])
+				return nil
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// In reverse mode, connect streams opened by the server to the local
+	// service instead of accepting local connections.
+	if *reverse != "" {
+		proxy := marionette.NewReverseClientProxy(dialer)
+		proxy.Addr = *reverse
+		proxy.SocketOptions = &fs.SocketOptions
+		if err := dialer.Open(); err != nil {
+			return err
+		} else if err := proxy.Open(); err != nil {
+			return err
+		}
+		if discoverer != nil && *discoverInterval > 0 {
+			go watchDiscovery(discoverer, *discoverInterval, dialer)
+		}
+		fmt.Printf("offering %s, connected to %s\n", *reverse, *serverIP)
+		return waitForShutdown(dialer, nil, streamSet, *verbose, *shutdown)
+	}
+
 	if err := dialer.Open(); err != nil {
 		return err
 	}
+	if discoverer != nil && *discoverInterval > 0 {
+		go watchDiscovery(discoverer, *discoverInterval, dialer)
+	}
+
+	// Forward a local UDP socket through the server, if enabled.
+	if *udpForward != "" {
+		f, err := openUDPForward(*udpForward, *format, dialer)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+	}
 
-	// Start listener.
-	ln, err := net.Listen("tcp", *bind)
+	// Start listener. TPROXY requires a transparent socket to accept
+	// connections for non-local addresses.
+	var ln net.Listener
+	if *proxyMode == "tproxy" {
+		ln, err = marionette.ListenTransparent(*bind)
+	} else {
+		ln, err = marionette.ListenAddr(*bind)
+	}
 	if err != nil {
 		return err
 	}
 
 	// Start proxy.
 	proxy := marionette.NewClientProxy(ln, dialer)
+	proxy.SocketOptions = &fs.SocketOptions
+	switch *proxyMode {
+	case "socks5":
+		proxy.Socks5 = &marionette.Socks5Handler{
+			Dial:         proxy.DialDestination,
+			DialDatagram: proxy.DialDatagram,
+		}
+		if *socks5Auth != "" {
+			a := strings.SplitN(*socks5Auth, ":", 2)
+			proxy.Socks5.Credentials = map[string]string{a[0]: a[1]}
+		}
+	case "http":
+		proxy.HTTPProxy = true
+	case "redirect":
+		proxy.DestinationFunc = marionette.OriginalDestination
+	case "tproxy":
+		proxy.DestinationFunc = func(conn net.Conn) (string, error) { return conn.LocalAddr().String(), nil }
+	}
 	if err := proxy.Open(); err != nil {
 		return err
 	}
 
-	fmt.Printf("listening on %s, connected to %s\n", *bind, *serverIP)
+	// Point the system proxy at the listener until shutdown, if enabled.
+	if *sysproxy {
+		if err := enableSystemProxy(*proxyMode, ln.Addr().String()); err != nil {
+			return err
+		}
+		defer func() {
+			if err := restoreSystemProxy(); err != nil {
+				fmt.Fprintf(os.Stderr, "cannot restore system proxy: %s\n", err)
+			}
+		}()
+	}
+
+	if *proxyMode != "raw" {
+		fmt.Printf("listening on %s via %s, connected to %s\n", *bind, *proxyMode, *serverIP)
+	} else {
+		fmt.Printf("listening on %s, connected to %s\n", *bind, *serverIP)
+	}
+
+	return waitForShutdown(dialer, ln, streamSet, *verbose, *shutdown)
+}
+
+const (
+	// WireGuardOverhead is the bytes added by WireGuard to each packet.
+	WireGuardOverhead = 32
+
+	// MinTunnelMTU is the smallest MTU reported for a tunnel, which is the
+	// minimum MTU of IPv6.
+	MinTunnelMTU = 1280
+)
+
+// openUDPForward forwards datagrams from a local UDP address to a destination
+// through dialer, where s is "local=host:port". Reports the MTU of datagrams
+// which fit in a single message of the format.
+func openUDPForward(s, format string, dialer *marionette.Dialer) (*marionette.UDPForwarder, error) {
+	local, dest, ok := strings.Cut(s, "=")
+	if !ok || local == "" || dest == "" {
+		return nil, errors.New("udp forward must be in the form local=host:port")
+	} else if _, _, err := net.SplitHostPort(dest); err != nil {
+		return nil, fmt.Errorf("invalid udp forward destination: %s", err)
+	}
+
+	pc, err := net.ListenPacket("udp", local)
+	if err != nil {
+		return nil, err
+	}
+	f := marionette.NewUDPForwarder(pc, dest, dialer.DialDatagram)
+	if err := f.Open(); err != nil {
+		pc.Close()
+		return nil, err
+	}
+
+	// Use the smallest capacity of either party's messages of the first format.
+	var capacity int
+	for _, n := range readFormatInfo(strings.Split(format, ",")[0]).Capacity {
+		if capacity == 0 || n < capacity {
+			capacity = n
+		}
+	}
+	// Datagrams may span several messages rather than go below IPv6's minimum MTU.
+	mtu := marionette.DatagramMTU(capacity, dest)
+	if mtu < MinTunnelMTU+WireGuardOverhead {
+		fmt.Fprintln(os.Stderr, "warning: format messages are too small for a 1280 byte MTU, datagrams will span several messages")
+		mtu = MinTunnelMTU + WireGuardOverhead
+	}
+	fmt.Printf("forwarding udp %s to %s, datagram mtu %d (WireGuard MTU %d, OpenVPN --link-mtu %d)\n", f.Addr(), dest, mtu, mtu-WireGuardOverhead, mtu)
+	return f, nil
+}
 
-	// Wait for signal.
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
-	<-c
-	fmt.Fprintln(os.Stderr, "received interrupt, shutting down...")
+// waitForShutdown notifies systemd that the client is ready and blocks until
+// a shutdown signal is received. It then stops accepting local connections on
+// ln, if set, and waits up to timeout for the dialer's streams to close. If
+// verbose is set then the open streams are dumped first.
+func waitForShutdown(dialer *marionette.Dialer, ln net.Listener, streamSet *marionette.StreamSet, verbose bool, timeout time.Duration) error {
+	sdNotify("READY=1")
+	waitForSignal()
+	sdNotify("STOPPING=1")
 
 	// Dump open streams.
-	if *verbose {
+	if verbose {
 		dumpStreams(streamSet.Streams())
 	}
 
+	if ln != nil {
+		ln.Close()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := dialer.Shutdown(ctx); err == context.DeadlineExceeded {
+		fmt.Fprintln(os.Stderr, "shutdown timeout, closing open streams")
+	}
 	return nil
 }
+
+// parseServerList returns the server addresses from a comma-separated list or
+// a JSON array of strings.
+func parseServerList(s string) ([]string, error) {
+	var items []string
+	if s = strings.TrimSpace(s); strings.HasPrefix(s, "[") {
+		if err := json.Unmarshal([]byte(s), &items); err != nil {
+			return nil, fmt.Errorf("invalid server list: %s", err)
+		}
+	} else {
+		items = strings.Split(s, ",")
+	}
+
+	var servers []string
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			servers = append(servers, item)
+		}
+	}
+	if len(servers) == 0 {
+		return nil, errors.New("server required")
+	}
+	return servers, nil
+}
+
+// isValidProxyMode returns true if mode is a supported local proxy protocol.
+func isValidProxyMode(mode string) bool {
+	switch mode {
+	case "raw", "socks5", "http", "redirect", "tproxy":
+		return true
+	default:
+		return false
+	}
+}
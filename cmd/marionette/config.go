@@ -0,0 +1,294 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Config represents a TOML configuration file passed with -config. Each option
+// sets the command line flag of the same name, unless the flag is also passed
+// on the command line. Options for flags a command does not have are rejected.
+type Config struct {
+	// Options tuned together, which the other options override.
+	Preset *string `toml:"preset"`
+
+	// Command started by the run command: client or server.
+	Mode *string `toml:"mode" flag:"-"`
+
+	// Connection to the server & local proxy.
+	Bind        *string  `toml:"bind"`
+	Bridge      *string  `toml:"bridge"`
+	AuthSecret  *string  `toml:"auth-secret"`
+	Decoy       *string  `toml:"decoy"`
+	Credentials *string  `toml:"credentials"`
+	ReplayCache *string  `toml:"replay-cache"`
+	Usage       *string  `toml:"usage"`
+	Discover    *string  `toml:"discover"`
+	DiscoverKey *string  `toml:"discover-key"`
+	Broker      *string  `toml:"broker"`
+	BrokerFront *string  `toml:"broker-front"`
+	BrokerKey   *string  `toml:"broker-key"`
+	Server      []string `toml:"server"`
+	Format      []string `toml:"format"`
+	FormatDir   []string `toml:"format-dir"`
+	ProxyMode   *string  `toml:"proxy-mode"`
+	Socks5Auth  *string  `toml:"socks5-auth"`
+	Channels    *int     `toml:"channels"`
+	Resume      *bool    `toml:"resume"`
+	Multipath   *bool    `toml:"multipath"`
+	Duplicate   *bool    `toml:"duplicate"`
+	Upstream    *string  `toml:"upstream-proxy"`
+	UDPForward  *string  `toml:"udp-forward"`
+	Reverse     *string  `toml:"reverse"`
+	ReverseBind *string  `toml:"reverse-bind"`
+	WebSocket   *string  `toml:"websocket"`
+
+	// Time between lookups of the -discover domain.
+	DiscoverInterval *ConfigDuration `toml:"discover-interval"`
+
+	// Time streams may drain on shutdown.
+	ShutdownTimeout *ConfigDuration `toml:"shutdown-timeout"`
+
+	// Randomizes the first messages of each connection by -auth-secret.
+	RandomizeHandshake *bool `toml:"randomize-handshake"`
+
+	// Server proxying.
+	Socks5        *bool   `toml:"socks5"`
+	Tunnel        *bool   `toml:"tunnel"`
+	Proxy         *string `toml:"proxy"`
+	ACL           *string `toml:"acl"`
+	ProxyProtocol *int    `toml:"proxy-protocol"`
+
+	// Treatment of clients by country & network.
+	Policy *string  `toml:"policy"`
+	GeoIP  []string `toml:"geoip"`
+
+	// Admin API.
+	Admin      *string `toml:"admin"`
+	AdminToken *string `toml:"admin-token"`
+
+	// Health endpoint.
+	Health         *string         `toml:"health"`
+	HealthInterval *ConfigDuration `toml:"health-interval"`
+
+	Logging ConfigLogging `toml:"logging"`
+	Limits  ConfigLimits  `toml:"limits"`
+	TCP     ConfigTCP     `toml:"tcp"`
+	Plugins ConfigPlugins `toml:"plugins"`
+}
+
+// ConfigLogging represents the [logging] section of a configuration file.
+type ConfigLogging struct {
+	Verbose       *bool           `toml:"verbose" flag:"v"`
+	LogFormat     *string         `toml:"log-format"`
+	LogLevel      *string         `toml:"log-level"`
+	LogFile       *string         `toml:"log-file"`
+	LogMaxSize    *int            `toml:"log-max-size"`
+	LogRotate     *ConfigDuration `toml:"log-rotate"`
+	LogMaxBackups *int            `toml:"log-max-backups"`
+	Redact        *bool           `toml:"redact"`
+	Debug         *string         `toml:"debug"`
+	TracePath     *string         `toml:"trace-path"`
+	PcapPath      *string         `toml:"pcap"`
+	CrashDir      *string         `toml:"crash-dir"`
+	Events        []string        `toml:"events"`
+	AuditLog      *string         `toml:"audit-log"`
+	AuditEpsilon  *float64        `toml:"audit-epsilon"`
+	AuditInterval *ConfigDuration `toml:"audit-interval"`
+	Telemetry     *string         `toml:"telemetry"`
+	TelemetryASN  *int            `toml:"telemetry-asn"`
+
+	OTLPEndpoint   *string  `toml:"otlp-endpoint"`
+	OTLPInsecure   *bool    `toml:"otlp-insecure"`
+	OTLPSampleRate *float64 `toml:"otlp-sample-rate"`
+
+	DetectProbes        *bool           `toml:"detect-probes"`
+	ProbeAlertThreshold *int            `toml:"probe-alert-threshold"`
+	ProbeAlertWindow    *ConfigDuration `toml:"probe-alert-window"`
+}
+
+// ConfigLimits represents the [limits] section of a configuration file.
+type ConfigLimits struct {
+	RateLimit         *int            `toml:"rate-limit"`
+	ClientRateLimit   *int            `toml:"client-rate-limit"`
+	StreamRateLimit   *int            `toml:"stream-rate-limit"`
+	MaxConns          *int            `toml:"max-conns"`
+	MaxPendingConns   *int            `toml:"max-pending-conns"`
+	MaxClientConns    *int            `toml:"max-client-conns"`
+	MaxStreams        *int            `toml:"max-streams"`
+	BanThreshold      *int            `toml:"ban-threshold"`
+	BanWindow         *ConfigDuration `toml:"ban-window"`
+	BanDuration       *ConfigDuration `toml:"ban-duration"`
+	DecoyTimeout      *ConfigDuration `toml:"decoy-timeout"`
+	StreamIdleTimeout *ConfigDuration `toml:"stream-idle-timeout"`
+	StreamMaxLifetime *ConfigDuration `toml:"stream-max-lifetime"`
+}
+
+// ConfigTCP represents the [tcp] section of a configuration file.
+type ConfigTCP struct {
+	KeepAlive       *ConfigDuration `toml:"tcp-keepalive"`
+	NoDelay         *bool           `toml:"tcp-nodelay"`
+	ReadBuffer      *int            `toml:"tcp-rcvbuf"`
+	WriteBuffer     *int            `toml:"tcp-sndbuf"`
+	MaxSegmentSize  *int            `toml:"tcp-mss"`
+	SegmentMin      *int            `toml:"segment-min"`
+	SegmentMax      *int            `toml:"segment-max"`
+	SegmentDelay    *ConfigDuration `toml:"segment-delay"`
+	SegmentCoalesce *bool           `toml:"segment-coalesce"`
+}
+
+// ConfigPlugins represents the [plugins] section of a configuration file.
+type ConfigPlugins struct {
+	PluginDir   *string  `toml:"plugin-dir"`
+	ExternAddr  *string  `toml:"extern-addr"`
+	SleepFactor *float64 `toml:"sleep-factor"`
+	SelfTest    *bool    `toml:"selftest"`
+}
+
+// ConfigDuration is a duration written as a string, such as "30s".
+type ConfigDuration struct {
+	time.Duration
+}
+
+func (d *ConfigDuration) UnmarshalText(text []byte) (err error) {
+	d.Duration, err = time.ParseDuration(string(text))
+	return err
+}
+
+// ReadConfigFile reads & decodes the configuration file at path.
+func ReadConfigFile(path string) (*Config, error) {
+	var config Config
+	md, err := toml.DecodeFile(path, &config)
+	if err != nil {
+		return nil, err
+	} else if keys := md.Undecoded(); len(keys) > 0 {
+		return nil, fmt.Errorf("unknown config option: %s", keys[0])
+	}
+	return &config, nil
+}
+
+// EnvPrefix is the prefix of environment variables which set flags. A flag's
+// variable is its name in upper case with dashes replaced by underscores, such
+// as MARIONETTE_SLEEP_FACTOR for -sleep-factor.
+const EnvPrefix = "MARIONETTE_"
+
+// envName returns the environment variable of the named flag.
+func envName(name string) string {
+	return EnvPrefix + strings.ToUpper(strings.Replace(name, "-", "_", -1))
+}
+
+// applyEnv sets the flags which have an environment variable and which were
+// not passed on the command line. Such flags are then treated as passed, so
+// they override the config file & preset.
+func (fs *FlagSet) applyEnv() error {
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		value, ok := os.LookupEnv(envName(f.Name))
+		if !ok || fs.passed[f.Name] || err != nil {
+			return
+		} else if e := fs.Set(f.Name, value); e != nil {
+			err = fmt.Errorf("invalid environment variable %s: %s", envName(f.Name), e)
+			return
+		}
+		fs.passed[f.Name] = true
+	})
+	return err
+}
+
+// applyConfig sets the flags which have a value in config and which were not
+// passed on the command line.
+func (fs *FlagSet) applyConfig(config *Config) error {
+	return fs.applyConfigValue(reflect.ValueOf(config).Elem(), func(name string) bool {
+		return !fs.passed[name]
+	})
+}
+
+// reloadConfig re-reads the config file and applies the named flags. Named
+// flags which were not passed on the command line and no longer have a value
+// in the file are reset to their defaults. If the file is invalid then no
+// flags are changed.
+func (fs *FlagSet) reloadConfig(names ...string) error {
+	if fs.ConfigPath == "" {
+		return errors.New("no config file to reload")
+	}
+	config, err := ReadConfigFile(fs.ConfigPath)
+	if err != nil {
+		return err
+	}
+
+	reload := make(map[string]bool)
+	for _, name := range names {
+		if !fs.passed[name] && fs.Lookup(name) != nil {
+			reload[name] = true
+		}
+	}
+
+	// Save the current values so they can be restored on error.
+	prev := make(map[string]string)
+	for name := range reload {
+		f := fs.Lookup(name)
+		prev[name] = f.Value.String()
+		f.Value.Set(f.DefValue)
+	}
+
+	if err := fs.applyConfigValue(reflect.ValueOf(config).Elem(), func(name string) bool {
+		return reload[name]
+	}); err != nil {
+		for name, value := range prev {
+			fs.Set(name, value)
+		}
+		return err
+	}
+	return nil
+}
+
+// applyConfigValue sets the flags of each option in v for which apply returns true.
+func (fs *FlagSet) applyConfigValue(v reflect.Value, apply func(name string) bool) error {
+	for i := 0; i < v.NumField(); i++ {
+		field, value := v.Type().Field(i), v.Field(i)
+
+		// Sections are applied recursively.
+		if field.Type.Kind() == reflect.Struct {
+			if err := fs.applyConfigValue(value, apply); err != nil {
+				return err
+			}
+			continue
+		} else if value.IsNil() {
+			continue
+		}
+
+		name := field.Tag.Get("flag")
+		if name == "-" {
+			continue
+		} else if name == "" {
+			name = field.Tag.Get("toml")
+		}
+		if fs.Lookup(name) == nil {
+			return fmt.Errorf("config option not supported by this command: %s", field.Tag.Get("toml"))
+		} else if !apply(name) {
+			continue
+		}
+
+		// Lists are passed to flags as comma-separated values.
+		var s string
+		switch v := value.Interface().(type) {
+		case []string:
+			s = strings.Join(v, ",")
+		case *ConfigDuration:
+			s = v.String()
+		default:
+			s = fmt.Sprint(value.Elem().Interface())
+		}
+		if err := fs.Set(name, s); err != nil {
+			return fmt.Errorf("invalid config option %s: %s", field.Tag.Get("toml"), err)
+		}
+	}
+	return nil
+}
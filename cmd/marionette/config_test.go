@@ -0,0 +1,162 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadConfigFile(t *testing.T) {
+	path := writeConfigFile(t, `
+bridge = "example.com"
+format = ["http_simple_blocking", "ftp_simple_blocking"]
+
+[logging]
+log-level = "debug"
+
+[limits]
+ban-window = "1m30s"
+`)
+
+	config, err := ReadConfigFile(path)
+	if err != nil {
+		t.Fatal(err)
+	} else if config.Bridge == nil || *config.Bridge != "example.com" {
+		t.Fatalf("unexpected bridge: %v", config.Bridge)
+	} else if len(config.Format) != 2 || config.Format[1] != "ftp_simple_blocking" {
+		t.Fatalf("unexpected format: %v", config.Format)
+	} else if config.Logging.LogLevel == nil || *config.Logging.LogLevel != "debug" {
+		t.Fatalf("unexpected log level: %v", config.Logging.LogLevel)
+	} else if config.Limits.BanWindow == nil || config.Limits.BanWindow.Duration != 90*time.Second {
+		t.Fatalf("unexpected ban window: %v", config.Limits.BanWindow)
+	} else if config.Bind != nil {
+		t.Fatalf("unexpected bind: %v", *config.Bind)
+	}
+}
+
+func TestReadConfigFile_ErrUnknownOption(t *testing.T) {
+	path := writeConfigFile(t, "bogus = 1\n")
+	if _, err := ReadConfigFile(path); err == nil || err.Error() != "unknown config option: bogus" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReadConfigFile_ErrInvalidDuration(t *testing.T) {
+	path := writeConfigFile(t, "[limits]\nban-window = \"soon\"\n")
+	if _, err := ReadConfigFile(path); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// Ensure config options set flags unless they are passed on the command line.
+func TestFlagSet_Parse_Config(t *testing.T) {
+	path := writeConfigFile(t, `
+[logging]
+log-level = "debug"
+log-file = "/var/log/marionette.log"
+log-rotate = "24h"
+
+[tcp]
+tcp-nodelay = false
+`)
+
+	fs := NewFlagSet("test", flag.ContinueOnError)
+	if err := fs.Parse([]string{"-config", path, "-log-file", "/tmp/other.log"}); err != nil {
+		t.Fatal(err)
+	} else if fs.LogLevel != "debug" {
+		t.Fatalf("unexpected log level: %q", fs.LogLevel)
+	} else if fs.LogFile != "/tmp/other.log" {
+		t.Fatalf("unexpected log file: %q", fs.LogFile)
+	} else if fs.LogRotate != 24*time.Hour {
+		t.Fatalf("unexpected log rotate: %s", fs.LogRotate)
+	} else if !fs.SocketOptions.Nagle {
+		t.Fatal("expected nagle")
+	}
+}
+
+// Ensure options for flags the command does not have are rejected.
+func TestFlagSet_Parse_Config_ErrNotSupported(t *testing.T) {
+	path := writeConfigFile(t, "bind = \"127.0.0.1:8079\"\n")
+
+	fs := NewFlagSet("test", flag.ContinueOnError)
+	if err := fs.Parse([]string{"-config", path}); err == nil || err.Error() != "config option not supported by this command: bind" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure reloading resets options removed from the file & keeps passed flags.
+func TestFlagSet_ReloadConfig(t *testing.T) {
+	path := writeConfigFile(t, "[logging]\nlog-level = \"debug\"\nlog-file = \"a.log\"\n")
+
+	fs := NewFlagSet("test", flag.ContinueOnError)
+	if err := fs.Parse([]string{"-config", path, "-log-file", "b.log"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("[logging]\nlog-file = \"c.log\"\n"), 0666); err != nil {
+		t.Fatal(err)
+	} else if err := fs.reloadConfig("log-level", "log-file"); err != nil {
+		t.Fatal(err)
+	} else if fs.LogLevel != "" {
+		t.Fatalf("unexpected log level: %q", fs.LogLevel)
+	} else if fs.LogFile != "b.log" {
+		t.Fatalf("unexpected log file: %q", fs.LogFile)
+	}
+
+	// An invalid file leaves the flags unchanged.
+	if err := ioutil.WriteFile(path, []byte("[logging]\nlog-level = \"info\"\nbogus = 1\n"), 0666); err != nil {
+		t.Fatal(err)
+	} else if err := fs.reloadConfig("log-level"); err == nil {
+		t.Fatal("expected error")
+	} else if fs.LogLevel != "" {
+		t.Fatalf("unexpected log level: %q", fs.LogLevel)
+	}
+}
+
+// writeConfigFile writes s to a config file in a temporary directory and
+// returns its path.
+func writeConfigFile(tb testing.TB, s string) string {
+	path := filepath.Join(tb.TempDir(), "marionette.toml")
+	if err := ioutil.WriteFile(path, []byte(s), 0666); err != nil {
+		tb.Fatal(err)
+	}
+	return path
+}
+
+// Ensure environment variables set flags, overriding the config file but not
+// the command line.
+func TestFlagSet_Parse_Env(t *testing.T) {
+	path := writeConfigFile(t, "[logging]\nlog-level = \"debug\"\nlog-file = \"a.log\"\n")
+	t.Setenv("MARIONETTE_LOG_LEVEL", "warn")
+	t.Setenv("MARIONETTE_LOG_FILE", "b.log")
+	t.Setenv("MARIONETTE_LOG_MAX_SIZE", "10")
+
+	fs := NewFlagSet("test", flag.ContinueOnError)
+	if err := fs.Parse([]string{"-config", path, "-log-file", "c.log"}); err != nil {
+		t.Fatal(err)
+	} else if fs.LogLevel != "warn" {
+		t.Fatalf("unexpected log level: %q", fs.LogLevel)
+	} else if fs.LogFile != "c.log" {
+		t.Fatalf("unexpected log file: %q", fs.LogFile)
+	} else if fs.LogMaxSize != 10 {
+		t.Fatalf("unexpected log max size: %d", fs.LogMaxSize)
+	}
+}
+
+func TestFlagSet_Parse_Env_ErrInvalid(t *testing.T) {
+	t.Setenv("MARIONETTE_LOG_MAX_SIZE", "big")
+
+	fs := NewFlagSet("test", flag.ContinueOnError)
+	if err := fs.Parse(nil); err == nil || !strings.HasPrefix(err.Error(), "invalid environment variable MARIONETTE_LOG_MAX_SIZE:") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	if s := envName("sleep-factor"); s != "MARIONETTE_SLEEP_FACTOR" {
+		t.Fatalf("unexpected name: %s", s)
+	}
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Size & number of the slots holding the most recent log events in a crash
+// report. Longer events are truncated.
+const (
+	crashEventSize  = 512
+	crashEventCount = 128
+)
+
+// secretFlags are the flags whose values are removed from crash reports.
+var secretFlags = map[string]bool{
+	"admin-token": true,
+	"socks5-auth": true,
+}
+
+// activeCrashReport is the crash report of the running command, if any.
+var activeCrashReport *crashReport
+
+// crashReport is a diagnostic bundle written to a file in -crash-dir. The
+// command's redacted flags are written when it starts, followed by a region
+// holding the most recent log events. If the process crashes then the runtime
+// appends the panic & the stacks of all goroutines. The file is removed when
+// the command exits normally.
+type crashReport struct {
+	mu     sync.Mutex
+	file   *os.File
+	events int64 // offset of the event region
+	next   int   // slot of the next event
+}
+
+// setupCommandLogging sets up logging for commands which run until stopped
+// and writes a crash report to -crash-dir, if set, if the process crashes.
+// The returned function removes the report and must be deferred.
+func (fs *FlagSet) setupCommandLogging(verbose bool) (func(), error) {
+	if err := fs.setupLogging(verbose); err != nil {
+		return nil, err
+	}
+	return fs.setupCrashReport()
+}
+
+// setupCrashReport creates a crash report in -crash-dir, if set, and records
+// log events to it. The returned function removes the report and must be
+// called before the command exits.
+func (fs *FlagSet) setupCrashReport() (func(), error) {
+	if fs.CrashDir == "" {
+		return func() {}, nil
+	} else if err := os.MkdirAll(fs.CrashDir, 0700); err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(fs.CrashDir, fmt.Sprintf("crash-%s-%d.txt", time.Now().UTC().Format("20060102T150405"), os.Getpid()))
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+	report := &crashReport{file: f}
+	if err := report.writeHeader(fs); err != nil {
+		report.remove()
+		return nil, err
+	}
+
+	// Dump all goroutines, not only the panicking one, to the report.
+	debug.SetTraceback("all")
+	if err := debug.SetCrashOutput(f, debug.CrashOptions{}); err != nil {
+		report.remove()
+		return nil, err
+	}
+
+	// Record events at the same levels, and with the same redaction, as the log.
+	var events zapcore.Core = &crashEventCore{report: report, enc: zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())}
+	if fs.Redact {
+		events = &redactCore{Core: events}
+	}
+	marionette.Logger = marionette.Logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(core, &levelCore{Core: events, levels: fs.logLevels})
+	}))
+
+	activeCrashReport = report
+	return removeCrashReport, nil
+}
+
+// removeCrashReport stops recording & removes the active crash report, if any.
+func removeCrashReport() {
+	if activeCrashReport != nil {
+		debug.SetCrashOutput(nil, debug.CrashOptions{})
+		activeCrashReport.remove()
+	}
+}
+
+// writeHeader writes the version, redacted flags & empty event slots. The
+// file offset is left after the slots so the runtime appends crash output.
+func (r *crashReport) writeHeader(fs *FlagSet) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s crash report\n", fs.Name())
+	fmt.Fprintf(&buf, "started: %s\n", time.Now().UTC().Format(time.RFC3339))
+	fmt.Fprintf(&buf, "go: %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	if info, ok := debug.ReadBuildInfo(); ok {
+		fmt.Fprintf(&buf, "module: %s %s\n", info.Main.Path, info.Main.Version)
+	}
+
+	fmt.Fprintln(&buf, "\n# FLAGS")
+	fs.VisitAll(func(f *flag.Flag) {
+		fmt.Fprintf(&buf, "-%s=%s\n", f.Name, redactFlag(f.Name, f.Value.String()))
+	})
+
+	fmt.Fprintln(&buf, "\n# RECENT EVENTS (oldest may be overwritten, ordered by ts)")
+	r.events = int64(buf.Len())
+	empty := append(bytes.Repeat([]byte(" "), crashEventSize-1), '\n')
+	for i := 0; i < crashEventCount; i++ {
+		buf.Write(empty)
+	}
+
+	fmt.Fprintln(&buf, "\n# CRASH")
+	_, err := r.file.Write(buf.Bytes())
+	return err
+}
+
+// writeEvent overwrites the oldest event slot with an encoded event.
+func (r *crashReport) writeEvent(p []byte) error {
+	slot := bytes.Repeat([]byte(" "), crashEventSize)
+	copy(slot[:crashEventSize-1], bytes.TrimRight(p, "\n"))
+	slot[crashEventSize-1] = '\n'
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	_, err := r.file.WriteAt(slot, r.events+int64(r.next*crashEventSize))
+	r.next = (r.next + 1) % crashEventCount
+	return err
+}
+
+// remove closes & removes the report file.
+func (r *crashReport) remove() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file != nil {
+		r.file.Close()
+		os.Remove(r.file.Name())
+		r.file = nil
+	}
+}
+
+// redactFlag returns the value of the named flag with secrets removed. The
+// passwords of URLs, such as -upstream-proxy, are also removed.
+func redactFlag(name, value string) string {
+	if value == "" {
+		return value
+	} else if secretFlags[name] {
+		return "REDACTED"
+	}
+	if u, err := url.Parse(value); err == nil && u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), "REDACTED")
+			return u.String()
+		}
+	}
+	return value
+}
+
+// crashEventCore writes log events to the event slots of a crash report.
+type crashEventCore struct {
+	report *crashReport
+	enc    zapcore.Encoder
+}
+
+func (c *crashEventCore) Enabled(zapcore.Level) bool { return true }
+
+func (c *crashEventCore) With(fields []zapcore.Field) zapcore.Core {
+	enc := c.enc.Clone()
+	for _, field := range fields {
+		field.AddTo(enc)
+	}
+	return &crashEventCore{report: c.report, enc: enc}
+}
+
+func (c *crashEventCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return ce.AddCore(ent, c)
+}
+
+func (c *crashEventCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.enc.EncodeEntry(ent, fields)
+	if err != nil {
+		return err
+	}
+	defer buf.Free()
+	return c.report.writeEvent(buf.Bytes())
+}
+
+func (c *crashEventCore) Sync() error { return nil }
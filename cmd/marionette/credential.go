@@ -0,0 +1,136 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+// CredentialCommand issues & revokes the credentials of a private bridge's
+// users in a database read by the server's -credentials flag.
+type CredentialCommand struct{}
+
+func NewCredentialCommand() *CredentialCommand {
+	return &CredentialCommand{}
+}
+
+func (cmd *CredentialCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("marionette-credential", flag.ContinueOnError)
+	path := fs.String("db", "", "Path to the credential database, created by issue if missing")
+	authKey := fs.String("auth-secret", "", "Server's -auth-secret, which users' credentials are derived from")
+	expires := fs.Duration("expires", 0, "Time until an issued credential expires (0 never expires)")
+	quota := fs.Int64("quota", 0, "Bytes a user may transfer per -quota-window, set by issue & quota (0 unlimited)")
+	window := fs.Duration("quota-window", 0, "Time after which a user's usage towards -quota resets (0 never resets)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, cmd.Usage())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	} else if fs.NArg() == 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	} else if *path == "" {
+		return errors.New("credential database required")
+	} else if *expires < 0 {
+		return errors.New("expires must not be negative")
+	} else if *quota < 0 || *window < 0 {
+		return errors.New("quota & quota window must not be negative")
+	}
+
+	creds, err := marionette.ReadCredentialsFile(*path)
+	if os.IsNotExist(err) && fs.Arg(0) == "issue" {
+		creds = &marionette.Credentials{}
+	} else if err != nil {
+		return err
+	}
+
+	switch fs.Arg(0) {
+	case "issue":
+		if fs.NArg() != 2 {
+			return errors.New("usage: marionette credential issue NAME")
+		}
+		secret, err := parseAuthSecret(*authKey)
+		if err != nil {
+			return err
+		} else if len(secret) != marionette.AuthSecretSize {
+			return errors.New("auth-secret must be the server's bridge secret")
+		}
+
+		var t time.Time
+		if *expires > 0 {
+			t = time.Now().Add(*expires).UTC()
+		}
+		cred, err := creds.Issue(secret, fs.Arg(1), t)
+		if err != nil {
+			return err
+		} else if err := creds.SetQuota(fs.Arg(1), *quota, *window); err != nil {
+			return err
+		} else if err := creds.WriteFile(*path); err != nil {
+			return err
+		}
+		fmt.Println(marionette.EncodeAuthSecret(cred))
+		return nil
+
+	case "revoke":
+		if fs.NArg() != 2 {
+			return errors.New("usage: marionette credential revoke NAME")
+		} else if err := creds.Revoke(fs.Arg(1)); err != nil {
+			return err
+		}
+		return creds.WriteFile(*path)
+
+	case "quota":
+		if fs.NArg() != 2 {
+			return errors.New("usage: marionette credential quota NAME")
+		} else if err := creds.SetQuota(fs.Arg(1), *quota, *window); err != nil {
+			return err
+		}
+		return creds.WriteFile(*path)
+
+	case "list":
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tID\tCREATED\tEXPIRES\tREVOKED\tQUOTA")
+		for _, u := range creds.Users {
+			exp := "never"
+			if !u.Expires.IsZero() {
+				exp = u.Expires.Format(time.RFC3339)
+			}
+			quota := "unlimited"
+			if u.Quota > 0 {
+				quota = formatBytes(float64(u.Quota))
+				if u.QuotaWindow != "" {
+					quota += "/" + u.QuotaWindow
+				}
+			}
+			fmt.Fprintf(w, "%s\t%08x\t%s\t%s\t%t\t%s\n", u.Name, u.ID, u.Created.Format(time.RFC3339), exp, u.Revoked, quota)
+		}
+		return w.Flush()
+
+	default:
+		return fmt.Errorf("unknown credential action: %q", fs.Arg(0))
+	}
+}
+
+func (cmd *CredentialCommand) Usage() string {
+	return `
+Usage:
+
+	marionette credential -db PATH -auth-secret SECRET [-expires DURATION] [-quota BYTES [-quota-window DURATION]] issue NAME
+	marionette credential -db PATH [-quota BYTES [-quota-window DURATION]] quota NAME
+	marionette credential -db PATH revoke NAME
+	marionette credential -db PATH list
+
+Manages the users of a private bridge. A server started with -credentials PATH
+only serves clients with an unrevoked, unexpired credential from issue, which
+is printed for the user's -auth-secret or bridge-line -auth-secret. Servers
+re-read the database on SIGHUP so revocations & quotas apply without a restart.
+Connections of users over their quota are closed until their quota window
+restarts.
+`[1:]
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+// DefaultDiscoveryTTL is the time a signed discovery record remains valid.
+const DefaultDiscoveryTTL = 7 * 24 * time.Hour
+
+// discoveryTimeout is the time to wait for each discovery lookup.
+const discoveryTimeout = 30 * time.Second
+
+// DiscoveryCommand generates a discovery signing key or prints a signed record
+// for a server, which clients look up with -discover or fetch with -broker.
+type DiscoveryCommand struct{}
+
+func NewDiscoveryCommand() *DiscoveryCommand {
+	return &DiscoveryCommand{}
+}
+
+func (cmd *DiscoveryCommand) Run(args []string) error {
+	fs := NewFlagSet("marionette-discovery", flag.ContinueOnError)
+	var (
+		keyPath  = fs.String("key", "", "Path of the base64 ed25519 private key records are signed with")
+		keygen   = fs.Bool("keygen", false, "Write a new private key to -key & print its public key for clients' -discover-key or -broker-key")
+		server   = fs.String("server", "", "Public server host name or IP address, with a port to use instead of the format's")
+		format   = fs.String("format", "", "Format name and version")
+		channels = fs.Int("channels", 0, "Number of parallel connections clients should open (0 uses the client's default)")
+		ttl      = fs.Duration("ttl", DefaultDiscoveryTTL, "Time until the record expires & must be re-signed")
+	)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: marionette discovery -key FILE [-keygen | -server HOST -format NAME]\n\nPrints a signed record to publish as a TXT record at _marionette.DOMAIN for\nclients using -discover DOMAIN, or as a line of a broker's bridge list for -broker.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	} else if *keyPath == "" {
+		return errors.New("key required")
+	}
+
+	if *keygen {
+		pub, key, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			return err
+		}
+		// Never overwrite a key as records signed by it would stop verifying.
+		f, err := os.OpenFile(*keyPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if _, err := fmt.Fprintln(f, base64.StdEncoding.EncodeToString(key)); err != nil {
+			return err
+		} else if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Println(base64.StdEncoding.EncodeToString(pub))
+		return nil
+	}
+
+	// Validate arguments.
+	if *server == "" {
+		return errors.New("server required")
+	} else if *channels < 0 {
+		return errors.New("channels must not be negative")
+	} else if *ttl <= 0 {
+		return errors.New("ttl must be positive")
+	}
+
+	key, err := readDiscoveryKey(*keyPath)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the format exists so a client can use the record.
+	if _, err := readFormats(marionette.PartyClient, *format); err != nil {
+		return err
+	}
+
+	b := marionette.NewBridgeLine(*server, *format)
+	b.Channels = *channels
+	fmt.Println(marionette.SignDiscoveryRecord(key, b, time.Now().Add(*ttl)))
+	return nil
+}
+
+// readDiscoveryKey reads a private key written by the discovery command.
+func readDiscoveryKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key: %s", path)
+	}
+	return ed25519.PrivateKey(key), nil
+}
+
+// parseDiscoveryPublicKey decodes the base64 public key printed by the
+// discovery command's -keygen, where name is the flag it was passed to.
+func parseDiscoveryPublicKey(name, key string) (ed25519.PublicKey, error) {
+	if key == "" {
+		return nil, fmt.Errorf("%s required", name)
+	}
+	pub, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+	return ed25519.PublicKey(pub), nil
+}
+
+// newDiscoverer returns a discoverer for domain with records signed by the
+// base64 public key.
+func newDiscoverer(domain, key string) (*marionette.Discoverer, error) {
+	pub, err := parseDiscoveryPublicKey("discover key", key)
+	if err != nil {
+		return nil, err
+	}
+	return marionette.NewDiscoverer(domain, pub), nil
+}
+
+// discoveredServers are the servers sharing the format & port of the first
+// discovered or brokered record.
+type discoveredServers struct {
+	Servers  []string
+	Format   string
+	Port     string
+	Channels int
+}
+
+// discoverServers looks up the domain's records.
+func discoverServers(d *marionette.Discoverer) (*discoveredServers, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), discoveryTimeout)
+	defer cancel()
+
+	lines, err := d.Discover(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot discover servers of %s: %s", d.Domain, err)
+	}
+	return selectServers(lines), nil
+}
+
+// fetchBrokerServers fetches bridge lines from a broker.
+func fetchBrokerServers(c *marionette.BrokerClient) (*discoveredServers, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), discoveryTimeout)
+	defer cancel()
+
+	lines, err := c.Fetch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch servers from broker: %s", err)
+	}
+	return selectServers(lines), nil
+}
+
+// selectServers returns the servers of lines which share the first line's
+// format & port as a dialer's fallbacks must run the same format.
+func selectServers(lines []*marionette.BridgeLine) *discoveredServers {
+	s := &discoveredServers{Format: lines[0].FormatName(), Port: lines[0].Port, Channels: lines[0].Channels}
+	for _, b := range lines {
+		if b.FormatName() == s.Format && b.Port == s.Port {
+			s.Servers = append(s.Servers, b.Host)
+		}
+	}
+	return s
+}
+
+// watchDiscovery looks up the servers every interval & switches the dialer's
+// new connections to them until the dialer closes.
+func watchDiscovery(d *marionette.Discoverer, interval time.Duration, dialer *marionette.Dialer) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if dialer.Closed() {
+			return
+		}
+
+		s, err := discoverServers(d)
+		if err != nil {
+			marionette.Logger.Warn("discovery failed", zap.Error(err))
+			continue
+		}
+		docs, err := readFormats(marionette.PartyClient, s.Format)
+		if err != nil {
+			marionette.Logger.Warn("discovered format unavailable", zap.String("format", s.Format), zap.Error(err))
+			continue
+		} else if s.Port != "" {
+			docs[0].Port = s.Port
+		}
+		dialer.SetDocument(docs[0])
+		dialer.SetServers(s.Servers)
+		marionette.Logger.Info("discovered servers", zap.Strings("servers", s.Servers), zap.String("format", s.Format))
+	}
+}
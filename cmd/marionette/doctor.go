@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	_ "github.com/redjack/marionette/plugins"
+)
+
+// doctorPollInterval is the time between checks for a reply from the server.
+const doctorPollInterval = 10 * time.Millisecond
+
+// doctorPingN is the number of TCP connections used to measure latency.
+const doctorPingN = 3
+
+// ErrDoctorFailed is returned when any diagnostic check fails.
+var ErrDoctorFailed = errors.New("one or more checks failed")
+
+// DoctorCommand checks connectivity to a server step by step and explains
+// the likely cause of the first failure.
+type DoctorCommand struct {
+	failed bool
+}
+
+func NewDoctorCommand() *DoctorCommand {
+	return &DoctorCommand{}
+}
+
+func (cmd *DoctorCommand) Run(args []string) error {
+	fs := NewFlagSet("marionette-doctor", flag.ContinueOnError)
+	var (
+		serverIP = fs.String("server", "127.0.0.1", "Server host name or IP address, or a comma-separated list to check each")
+		format   = fs.String("format", "", "Format name and version, or a comma-separated list to check each")
+		dest     = fs.String("dest", "", "Destination the server connects the test stream to (requires server -tunnel)")
+		timeout  = fs.Duration("timeout", 10*time.Second, "Time to wait for each network check")
+		duration = fs.Duration("duration", 5*time.Second, "Time to send data when measuring goodput (0 skips)")
+		verbose  = fs.Bool("v", false, "Debug logging enabled")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	} else if *format == "" {
+		return errors.New("format required")
+	} else if err := fs.setupLogging(*verbose); err != nil {
+		return err
+	}
+
+	servers, err := parseServerList(*serverIP)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, name := range strings.Split(*format, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	// Formats are checked first as network checks need the port.
+	docs, err := readFormats(marionette.PartyClient, *format)
+	if err != nil {
+		cmd.fail("format", err.Error(), `run "marionette formats" to list the available formats`)
+		return ErrDoctorFailed
+	}
+
+	for _, server := range servers {
+		for i, doc := range docs {
+			cmd.check(names[i], doc, trimBrackets(server), *dest, *timeout, *duration)
+		}
+	}
+
+	if cmd.failed {
+		return ErrDoctorFailed
+	}
+	return nil
+}
+
+// check runs each check against the server in turn, stopping at the first failure.
+func (cmd *DoctorCommand) check(name string, doc *mar.Document, server, dest string, timeout, duration time.Duration) {
+	fmt.Printf("# %s on %s\n", name, net.JoinHostPort(server, doc.Port))
+	defer fmt.Println()
+
+	if doc.Transport != "tcp" {
+		cmd.fail("format", "transport "+doc.Transport+" is not supported", "choose a tcp format")
+		return
+	}
+
+	ips, ok := cmd.checkDNS(server, timeout)
+	if !ok {
+		return
+	} else if !cmd.checkTCP(ips[0], doc.Port, timeout) {
+		return
+	}
+	cmd.checkHandshake(doc, ips[0], dest, timeout, duration)
+}
+
+// checkDNS resolves the server's host name. IP addresses are returned as-is.
+func (cmd *DoctorCommand) checkDNS(host string, timeout time.Duration) ([]string, bool) {
+	if net.ParseIP(host) != nil {
+		cmd.ok("dns", host+" is an IP address")
+		return []string{host}, true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	t := time.Now()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		cmd.fail("dns", err.Error(), diagnoseDialError(err, ""))
+		return nil, false
+	} else if len(addrs) == 0 {
+		cmd.fail("dns", host+" has no addresses", "check the -server host name")
+		return nil, false
+	}
+
+	var ips []string
+	for _, addr := range addrs {
+		ips = append(ips, addr.IP.String())
+	}
+	cmd.ok("dns", fmt.Sprintf("%s: %s (%s)", host, strings.Join(ips, ", "), roundDuration(time.Since(t))))
+	return ips, true
+}
+
+// checkTCP connects to the format's port several times to measure latency.
+func (cmd *DoctorCommand) checkTCP(ip, port string, timeout time.Duration) bool {
+	addr := net.JoinHostPort(ip, port)
+
+	var min, max, total time.Duration
+	for i := 0; i < doctorPingN; i++ {
+		t := time.Now()
+		conn, err := net.DialTimeout("tcp", addr, timeout)
+		if err != nil {
+			cmd.fail("tcp", err.Error(), diagnoseDialError(err, port))
+			return false
+		}
+		d := time.Since(t)
+		conn.Close()
+
+		if i == 0 || d < min {
+			min = d
+		}
+		if d > max {
+			max = d
+		}
+		total += d
+	}
+
+	cmd.ok("tcp", fmt.Sprintf("%s rtt min %s avg %s max %s", addr,
+		roundDuration(min), roundDuration(total/doctorPingN), roundDuration(max)))
+	return true
+}
+
+// checkHandshake opens a stream over the format and waits for the server to
+// reply. It then writes to the stream for duration to measure goodput.
+func (cmd *DoctorCommand) checkHandshake(doc *mar.Document, ip, dest string, timeout, duration time.Duration) {
+	dialer := marionette.NewDialer(doc, ip, marionette.NewStreamSet())
+	dialer.Dialer = &net.Dialer{Timeout: timeout}
+	if err := dialer.Open(); err != nil {
+		cmd.fail("handshake", err.Error(), diagnoseDialError(err, doc.Port))
+		return
+	}
+	defer dialer.Close()
+
+	var conn net.Conn
+	var err error
+	if dest != "" {
+		conn, err = dialer.DialDestination(dest)
+	} else {
+		conn, err = dialer.Dial()
+	}
+	if err != nil {
+		cmd.fail("handshake", err.Error(), "the connection closed before a stream could be opened; check that the server runs the same format & version")
+		return
+	}
+	defer conn.Close()
+
+	// Send data continuously so that formats which wait for data exchange
+	// messages. Bytes still in the write buffer have not been sent.
+	var written int64
+	writeErr := make(chan error, 1)
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, err := conn.Write(buf)
+			atomic.AddInt64(&written, int64(n))
+			if err != nil {
+				writeErr <- err
+				return
+			}
+		}
+	}()
+	sent := func() int64 {
+		return atomic.LoadInt64(&written) - int64(conn.(*marionette.Stream).WriteBufferLen())
+	}
+
+	// Wait for the server to reply with a valid message.
+	t := time.Now()
+	info, err := awaitReply(dialer, timeout)
+	if err == errConnClosedByServer {
+		cmd.fail("handshake", err.Error(), "the server could not decode the cover traffic; check that both sides run the same format & version")
+		return
+	} else if err == errNoReply {
+		cmd.fail("handshake", fmt.Sprintf("no reply after %s", timeout), "the server accepted the connection but did not respond; check that it runs the same format & version and that no middlebox is holding the traffic")
+		return
+	}
+	cmd.ok("handshake", fmt.Sprintf("first reply after %s (state %s)", roundDuration(time.Since(t)), info.State))
+
+	if duration <= 0 {
+		return
+	}
+
+	// Measure payload bytes sent and the cover bytes it took to send them.
+	start, coverStart := sent(), info.BytesWritten
+	select {
+	case err := <-writeErr:
+		cmd.fail("goodput", err.Error(), "the server closed the stream; check the server's -proxy destination, or -dest and the server's -tunnel & -acl settings")
+		return
+	case <-time.After(duration):
+	}
+
+	payload := sent() - start
+	var cover int64
+	if infos := dialer.Conns(); len(infos) > 0 {
+		cover = infos[0].BytesWritten - coverStart
+	}
+	if payload <= 0 {
+		cmd.fail("goodput", "no data sent", "the format exchanged messages but carried no data; the server may be waiting on its proxy destination")
+		return
+	}
+
+	details := fmt.Sprintf("%s/s up", formatBytes(float64(payload)/duration.Seconds()))
+	if cover > 0 {
+		details += fmt.Sprintf(", %.1fx cover overhead", float64(cover)/float64(payload))
+	}
+	cmd.ok("goodput", details)
+}
+
+func (cmd *DoctorCommand) ok(check, details string) {
+	fmt.Printf("%-10s ok    %s\n", check, details)
+}
+
+// fail reports a failed check and the likely fix.
+func (cmd *DoctorCommand) fail(check, details, diagnosis string) {
+	cmd.failed = true
+	fmt.Printf("%-10s FAIL  %s\n", check, details)
+	if diagnosis != "" {
+		fmt.Printf("%-10s       -> %s\n", "", diagnosis)
+	}
+}
+
+var (
+	errConnClosedByServer = errors.New("connection closed by server")
+	errNoReply            = errors.New("no reply from server")
+)
+
+// awaitReply waits for the server to send a valid message on the dialer's
+// first connection and returns the connection's state at that point.
+func awaitReply(dialer *marionette.Dialer, timeout time.Duration) (marionette.ConnInfo, error) {
+	t := time.Now()
+	for {
+		if infos := dialer.Conns(); len(infos) == 0 {
+			return marionette.ConnInfo{}, errConnClosedByServer
+		} else if infos[0].BytesRead > 0 {
+			return infos[0], nil
+		} else if time.Since(t) > timeout {
+			return infos[0], errNoReply
+		}
+		time.Sleep(doctorPollInterval)
+	}
+}
+
+// diagnoseDialError returns the likely cause of a failed lookup or connection.
+func diagnoseDialError(err error, port string) string {
+	if err, ok := err.(*net.DNSError); ok {
+		if err.IsTimeout {
+			return "the DNS server did not respond; check the system's resolver configuration"
+		}
+		return "the host name could not be resolved; check the -server host name"
+	}
+
+	switch msg := err.Error(); {
+	case strings.Contains(msg, "connection refused"):
+		return "nothing is listening on port " + port + "; check that the server is running with this format"
+	case strings.Contains(msg, "no route to host"), strings.Contains(msg, "network is unreachable"):
+		return "there is no route to the server; check the local network connection"
+	case strings.Contains(msg, "timeout"), strings.Contains(msg, "timed out"):
+		return "no response from the server; check that it is running and that a firewall allows port " + port
+	case strings.Contains(msg, "connection reset"):
+		return "the connection was reset; a firewall or middlebox may be blocking the port"
+	default:
+		return ""
+	}
+}
+
+// trimBrackets removes the brackets of an IPv6 literal.
+func trimBrackets(host string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+}
+
+// roundDuration rounds d to a readable precision.
+func roundDuration(d time.Duration) time.Duration {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond)
+	}
+	return d.Round(time.Millisecond)
+}
+
+// formatBytes returns n with a binary unit suffix.
+func formatBytes(n float64) string {
+	units := []string{"B", "KiB", "MiB", "GiB"}
+	i := 0
+	for ; n >= 1024 && i < len(units)-1; i++ {
+		n /= 1024
+	}
+	return fmt.Sprintf("%.1f %s", n, units[i])
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		marionette.Logger = zap.NewNop()
+	}
+	os.Exit(m.Run())
+}
+
+// Ensure every check passes against a server running the same format.
+func TestDoctorCommand_Check(t *testing.T) {
+	ln, err := marionette.ListenFormat("http_simple_blocking:20150701", &marionette.FormatOptions{Bind: "127.0.0.1:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	// Discard data sent on accepted streams.
+	go func() {
+		for {
+			stream, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(ioutil.Discard, stream)
+		}
+	}()
+
+	doc := mustReadClientFormat(t, "http_simple_blocking:20150701")
+	_, doc.Port, _ = net.SplitHostPort(ln.Addr().String())
+
+	var cmd DoctorCommand
+	if cmd.check("http_simple_blocking", doc, "127.0.0.1", "", 5*time.Second, 0); cmd.failed {
+		t.Fatal("expected checks to pass")
+	}
+}
+
+// Ensure the TCP check fails if nothing is listening.
+func TestDoctorCommand_Check_ErrRefused(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	ln.Close()
+
+	var cmd DoctorCommand
+	if cmd.checkTCP("127.0.0.1", port, time.Second) {
+		t.Fatal("expected tcp check to fail")
+	} else if !cmd.failed {
+		t.Fatal("expected failure")
+	}
+}
+
+func TestDiagnoseDialError(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		want string
+	}{
+		{&net.DNSError{Err: "no such host", Name: "x"}, "the host name could not be resolved; check the -server host name"},
+		{&net.DNSError{Err: "i/o timeout", Name: "x", IsTimeout: true}, "the DNS server did not respond; check the system's resolver configuration"},
+		{errors.New("dial tcp 127.0.0.1:80: connect: connection refused"), "nothing is listening on port 80; check that the server is running with this format"},
+		{errors.New("dial tcp 10.0.0.1:80: i/o timeout"), "no response from the server; check that it is running and that a firewall allows port 80"},
+		{errors.New("read: connection reset by peer"), "the connection was reset; a firewall or middlebox may be blocking the port"},
+		{errors.New("connect: network is unreachable"), "there is no route to the server; check the local network connection"},
+		{errors.New("other"), ""},
+	} {
+		if got := diagnoseDialError(tt.err, "80"); got != tt.want {
+			t.Fatalf("unexpected diagnosis of %q: %q", tt.err, got)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	for n, want := range map[float64]string{
+		0:                      "0.0 B",
+		1023:                   "1023.0 B",
+		1536:                   "1.5 KiB",
+		3 * 1024 * 1024:        "3.0 MiB",
+		5 << 40:                "5120.0 GiB",
+		2 * 1024 * 1024 * 1024: "2.0 GiB",
+	} {
+		if got := formatBytes(n); got != want {
+			t.Fatalf("unexpected format of %v: %q, want %q", n, got, want)
+		}
+	}
+}
+
+// mustReadClientFormat returns the client document of the named format.
+func mustReadClientFormat(tb testing.TB, format string) *mar.Document {
+	data, err := mar.ReadFormat(format)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return mar.MustParse(marionette.PartyClient, data)
+}
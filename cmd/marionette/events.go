@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/redjack/marionette"
+)
+
+// setupEvents publishes connection & stream events to each sink listed in
+// -events, and to the -audit-log, if set. A sink is a JSON lines file path,
+// syslog: for the local syslog daemon, syslog://host:port for a remote daemon
+// over UDP, or an http:// or https:// webhook URL. The returned function
+// closes the sinks and must be called before the command exits.
+func (fs *FlagSet) setupEvents() (func(), error) {
+	var sinks []marionette.EventSink
+	closeSinks := func() {
+		for _, sink := range sinks {
+			marionette.RemoveEventSink(sink)
+			sink.Close()
+		}
+	}
+
+	for _, s := range strings.Split(fs.Events, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		sink, err := openEventSink(s)
+		if err != nil {
+			closeSinks()
+			return nil, fmt.Errorf("cannot open event sink %s: %s", s, err)
+		}
+		marionette.AddEventSink(sink)
+		sinks = append(sinks, sink)
+	}
+
+	// Record noised aggregate counters only, if enabled.
+	if fs.AuditLog != "" {
+		if fs.AuditEpsilon <= 0 || fs.AuditInterval <= 0 {
+			closeSinks()
+			return nil, errors.New("audit epsilon & interval must be positive")
+		}
+		f, err := os.OpenFile(fs.AuditLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+		if err != nil {
+			closeSinks()
+			return nil, err
+		}
+		audit := marionette.NewAuditLog(f)
+		audit.Interval, audit.Epsilon = fs.AuditInterval, fs.AuditEpsilon
+		if err := audit.Open(); err != nil {
+			f.Close()
+			closeSinks()
+			return nil, err
+		}
+		marionette.AddEventSink(audit)
+		sinks = append(sinks, audit)
+	}
+	return closeSinks, nil
+}
+
+// openEventSink opens the sink for an -events entry.
+func openEventSink(s string) (marionette.EventSink, error) {
+	switch {
+	case s == "syslog:":
+		return marionette.NewSyslogEventSink("", "")
+	case strings.HasPrefix(s, "syslog://"):
+		u, err := url.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		return marionette.NewSyslogEventSink("udp", u.Host)
+	case strings.HasPrefix(s, "http://"), strings.HasPrefix(s, "https://"):
+		return marionette.NewWebhookEventSink(s), nil
+	default:
+		f, err := os.OpenFile(s, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, err
+		}
+		return marionette.NewJSONEventSink(f), nil
+	}
+}
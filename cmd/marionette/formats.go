@@ -1,9 +1,14 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 
+	"github.com/redjack/marionette/fte"
 	"github.com/redjack/marionette/mar"
 )
 
@@ -13,14 +18,211 @@ func NewFormatsCommand() *FormatsCommand {
 	return &FormatsCommand{}
 }
 
+// FormatInfo describes a built-in or user format.
+type FormatInfo struct {
+	Name      string   `json:"name"`
+	Source    string   `json:"source"` // "built-in" or the file path
+	Transport string   `json:"transport"`
+	Port      string   `json:"port"`
+	Parties   []string `json:"parties"`
+	States    []string `json:"states"`
+	Plugins   []string `json:"plugins"`
+
+	// Estimated maximum payload bytes per message sent by each party,
+	// based on the message lengths of fte actions.
+	Capacity map[string]int `json:"capacity,omitempty"`
+
+	Error string `json:"error,omitempty"`
+}
+
 func (cmd *FormatsCommand) Run(args []string) error {
-	fs := flag.NewFlagSet("marionette-formats", flag.ContinueOnError)
+	fs := NewFlagSet("marionette-formats", flag.ContinueOnError)
+	var (
+		verbose   = fs.Bool("v", false, "Show the details of each format")
+		asJSON    = fs.Bool("json", false, "Write the details of each format as JSON")
+		transport = fs.String("transport", "", "Only show formats using this transport (tcp or udp)")
+		plugin    = fs.String("plugin", "", "Only show formats using this plugin, such as fte.send")
+	)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: marionette formats [flags] [NAME...]\n\nShows formats whose names contain any NAME.")
+		fs.PrintDefaults()
+	}
 	if err := fs.Parse(args); err != nil {
 		return err
 	}
 
-	for _, format := range mar.Formats() {
-		fmt.Println(format)
+	names, err := cmd.formatNames(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	// Only list names unless details are needed for output or filtering.
+	if !*verbose && !*asJSON && *transport == "" && *plugin == "" {
+		for _, name := range names {
+			fmt.Println(name)
+		}
+		return nil
+	}
+
+	infos := make([]*FormatInfo, 0, len(names))
+	for _, name := range names {
+		info := readFormatInfo(name)
+		if *transport != "" && info.Transport != *transport {
+			continue
+		} else if *plugin != "" && !containsString(info.Plugins, *plugin) {
+			continue
+		}
+		infos = append(infos, info)
+	}
+
+	switch {
+	case *asJSON:
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(infos)
+	case *verbose:
+		for _, info := range infos {
+			printFormatInfo(info)
+		}
+	default:
+		for _, info := range infos {
+			fmt.Println(info.Name)
+		}
 	}
 	return nil
 }
+
+// formatNames returns the built-in & user formats whose names contain any of
+// the filters. All formats are returned if there are no filters.
+func (cmd *FormatsCommand) formatNames(filters []string) ([]string, error) {
+	userFormats, err := mar.UserFormats()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, name := range append(mar.Formats(), userFormats...) {
+		if len(filters) == 0 || containsAny(name, filters) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// readFormatInfo reads & parses the named format and describes it. Errors are
+// recorded on the description so other formats can still be listed.
+func readFormatInfo(name string) *FormatInfo {
+	info := &FormatInfo{Name: name, Source: "built-in"}
+	if formatName, version := mar.SplitFormat(name); mar.Format(formatName, version) == nil {
+		info.Source = mar.FormatPath(name)
+	}
+
+	data, err := mar.ReadFormat(name)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	doc, err := mar.Parse("", data)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.Transport, info.Port = doc.Transport, doc.Port
+
+	states := make(map[string]bool)
+	for _, t := range doc.Transitions {
+		states[t.Source], states[t.Destination] = true, true
+	}
+	info.States = sortedKeys(states)
+
+	parties, plugins := make(map[string]bool), make(map[string]bool)
+	for _, blk := range doc.ActionBlocks {
+		for _, action := range blk.Actions {
+			parties[action.Party], plugins[action.Name()] = true, true
+
+			if n := fteCapacity(action); n > info.Capacity[action.Party] {
+				if info.Capacity == nil {
+					info.Capacity = make(map[string]int)
+				}
+				info.Capacity[action.Party] = n
+			}
+		}
+	}
+	info.Parties, info.Plugins = sortedKeys(parties), sortedKeys(plugins)
+	return info
+}
+
+// fteCapacity returns an upper bound on the payload bytes of a message sent
+// by an fte action. The actual capacity also depends on the action's regex.
+// Returns zero for other actions.
+func fteCapacity(action *mar.Action) int {
+	if action.Module != "fte" || !strings.HasPrefix(action.Method, "send") {
+		return 0
+	}
+	args := action.ArgValues()
+	if len(args) < 2 {
+		return 0
+	}
+	msgLen, ok := args[1].(int)
+	if !ok {
+		return 0
+	}
+	if n := msgLen - fte.COVERTEXT_HEADER_LEN_CIPHERTTEXT - fte.CTXT_EXPANSION; n > 0 {
+		return n
+	}
+	return 0
+}
+
+// printFormatInfo writes a human-readable description of a format.
+func printFormatInfo(info *FormatInfo) {
+	fmt.Println(info.Name)
+	fmt.Printf("  source:    %s\n", info.Source)
+	if info.Error != "" {
+		fmt.Printf("  error:     %s\n\n", info.Error)
+		return
+	}
+	fmt.Printf("  transport: %s %s\n", info.Transport, info.Port)
+	fmt.Printf("  parties:   %s\n", strings.Join(info.Parties, ", "))
+	fmt.Printf("  states:    %s\n", strings.Join(info.States, ", "))
+	fmt.Printf("  plugins:   %s\n", strings.Join(info.Plugins, ", "))
+
+	if len(info.Capacity) > 0 {
+		var a []string
+		for party, n := range info.Capacity {
+			a = append(a, fmt.Sprintf("%s %d bytes", party, n))
+		}
+		sort.Strings(a)
+		fmt.Printf("  capacity:  %s per message (estimated)\n", strings.Join(a, ", "))
+	}
+	fmt.Println()
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]bool) []string {
+	a := make([]string, 0, len(m))
+	for k := range m {
+		a = append(a, k)
+	}
+	sort.Strings(a)
+	return a
+}
+
+// containsString returns true if a contains s.
+func containsString(a []string, s string) bool {
+	for _, v := range a {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAny returns true if s contains any of the substrings.
+func containsAny(s string, substrs []string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// DefaultHealthInterval is the default time between handshake self-tests.
+const DefaultHealthInterval = 30 * time.Second
+
+// healthSelfTestTimeout is the time a self-test waits for the server to reply.
+const healthSelfTestTimeout = 10 * time.Second
+
+// ErrUnhealthy is returned by the healthcheck command if the server is unhealthy.
+var ErrUnhealthy = errors.New("unhealthy")
+
+// Self-test statuses.
+const (
+	SelfTestOK      = "ok"
+	SelfTestNoReply = "no reply"
+	SelfTestFailed  = "failed"
+)
+
+// HealthStatus is the body served by /healthz.
+type HealthStatus struct {
+	Healthy   bool             `json:"healthy"`
+	Listeners []ListenerHealth `json:"listeners"`
+}
+
+// ListenerHealth describes whether a listener is accepting connections and
+// the result of its latest handshake self-test, if any.
+type ListenerHealth struct {
+	Format   string          `json:"format"`
+	Addr     string          `json:"addr"`
+	Alive    bool            `json:"alive"`
+	Error    string          `json:"error,omitempty"`
+	SelfTest *SelfTestResult `json:"self_test,omitempty"`
+}
+
+// SelfTestResult is the outcome of connecting to a listener with the client
+// side of its format. Formats which only send when there is data may not
+// reply to an idle connection so "no reply" does not fail the check.
+type SelfTestResult struct {
+	Status  string    `json:"status"`
+	Error   string    `json:"error,omitempty"`
+	Latency string    `json:"latency,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// HealthHandler serves the health of a server's listeners without
+// authentication so it can be polled by orchestrators & load balancers:
+//
+//	GET /healthz    health as JSON; 200 if healthy, otherwise 503
+type HealthHandler struct {
+	mu        sync.Mutex
+	listeners []*healthListener
+}
+
+// healthListener tracks a listener and its latest self-test.
+type healthListener struct {
+	name      string
+	ln        *marionette.Listener
+	clientDoc *mar.Document
+	selfTest  *SelfTestResult
+}
+
+// NewHealthHandler returns a handler for listeners serving the named formats.
+// The client side of each format is read for the self-tests, which connect to
+// the port each listener is bound to rather than the format's.
+func NewHealthHandler(names []string, listeners []*marionette.Listener) (*HealthHandler, error) {
+	h := &HealthHandler{}
+	for i, ln := range listeners {
+		clientDocs, err := readFormats(marionette.PartyClient, names[i])
+		if err != nil {
+			return nil, err
+		}
+		if _, port, err := net.SplitHostPort(ln.Addr().String()); err == nil {
+			clientDocs[0].Port = port
+		}
+		h.listeners = append(h.listeners, &healthListener{name: names[i], ln: ln, clientDoc: clientDocs[0]})
+	}
+	return h, nil
+}
+
+func (h *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/healthz" {
+		http.NotFound(w, r)
+		return
+	} else if r.Method != "GET" && r.Method != "HEAD" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	status := h.Status()
+	w.Header().Set("Content-Type", "application/json")
+	if !status.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(status)
+}
+
+// Status returns the current health. The server is healthy if every listener
+// is accepting connections and no self-test has failed.
+func (h *HealthHandler) Status() HealthStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	status := HealthStatus{Healthy: true}
+	for _, l := range h.listeners {
+		lh := ListenerHealth{Format: l.name, Addr: l.ln.Addr().String(), Alive: true, SelfTest: l.selfTest}
+		if err := l.ln.Err(); err != nil {
+			lh.Alive, lh.Error = false, err.Error()
+		}
+		if !lh.Alive || (lh.SelfTest != nil && lh.SelfTest.Status == SelfTestFailed) {
+			status.Healthy = false
+		}
+		status.Listeners = append(status.Listeners, lh)
+	}
+	return status
+}
+
+// run self-tests each listener every interval until ctx is done.
+func (h *HealthHandler) run(ctx context.Context, interval time.Duration) {
+	for {
+		for _, l := range h.listeners {
+			result := selfTest(l.clientDoc, l.ln.Addr())
+			h.mu.Lock()
+			l.selfTest = result
+			h.mu.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// selfTest connects to the listener at addr over the loopback interface if it
+// is bound to all interfaces and waits for the server to reply.
+func selfTest(doc *mar.Document, addr net.Addr) *SelfTestResult {
+	result := &SelfTestResult{Time: time.Now().UTC()}
+
+	host, _, _ := net.SplitHostPort(addr.String())
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		host = "127.0.0.1"
+		if ip.To4() == nil {
+			host = "::1"
+		}
+	}
+
+	dialer := marionette.NewDialer(doc, host, marionette.NewStreamSet())
+	dialer.Dialer = &net.Dialer{Timeout: healthSelfTestTimeout}
+	if err := dialer.Open(); err != nil {
+		result.Status, result.Error = SelfTestFailed, err.Error()
+		return result
+	}
+	defer dialer.Close()
+
+	t := time.Now()
+	switch _, err := awaitReply(dialer, healthSelfTestTimeout); err {
+	case nil:
+		result.Status, result.Latency = SelfTestOK, roundDuration(time.Since(t)).String()
+	case errNoReply:
+		result.Status = SelfTestNoReply
+	default:
+		result.Status, result.Error = SelfTestFailed, err.Error()
+	}
+	return result
+}
+
+// serveHealth starts the health endpoint on addr, a host & port or
+// "unix:///path" socket, and runs self-tests every interval unless zero.
+func (fs *FlagSet) serveHealth(ctx context.Context, addr string, interval time.Duration, h *HealthHandler) error {
+	ln, err := marionette.ListenAddr(addr)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "health endpoint listening on %s\n", addr)
+	go func() { http.Serve(ln, h) }()
+	if interval > 0 {
+		go h.run(ctx, interval)
+	}
+	return nil
+}
+
+// HealthcheckCommand queries the health endpoint of a running server and
+// fails if it is unhealthy.
+type HealthcheckCommand struct{}
+
+func NewHealthcheckCommand() *HealthcheckCommand {
+	return &HealthcheckCommand{}
+}
+
+func (cmd *HealthcheckCommand) Run(args []string) error {
+	fs := NewFlagSet("marionette-healthcheck", flag.ContinueOnError)
+	var (
+		timeout = fs.Duration("timeout", 5*time.Second, "Time to wait for the health endpoint")
+		asJSON  = fs.Bool("json", false, "Write the server's health as JSON")
+		quiet   = fs.Bool("q", false, "Only set the exit status")
+	)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: marionette healthcheck [flags] ADDR\n\nQueries the -health endpoint at ADDR, a host & port or unix:///path socket.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	} else if fs.NArg() != 1 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	// Connect to unix sockets directly since they have no host name.
+	network, address := marionette.ParseNetworkAddr(fs.Arg(0))
+	host := address
+	if network == "unix" {
+		host = "localhost"
+	}
+	client := &http.Client{
+		Timeout: *timeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, address)
+			},
+		},
+	}
+
+	resp, err := client.Get("http://" + host + "/healthz")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var status HealthStatus
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return fmt.Errorf("invalid health response (%s): %s", resp.Status, err)
+	}
+
+	switch {
+	case *quiet:
+	case *asJSON:
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(status)
+	default:
+		printHealthStatus(&status)
+	}
+
+	if resp.StatusCode != http.StatusOK || !status.Healthy {
+		return ErrUnhealthy
+	}
+	return nil
+}
+
+// printHealthStatus writes a line for each listener of a server.
+func printHealthStatus(status *HealthStatus) {
+	for _, lh := range status.Listeners {
+		line := fmt.Sprintf("%s on %s: ", lh.Format, lh.Addr)
+		if !lh.Alive {
+			line += "not accepting connections: " + lh.Error
+		} else {
+			line += "accepting connections"
+		}
+
+		if st := lh.SelfTest; st != nil {
+			switch st.Status {
+			case SelfTestOK:
+				line += fmt.Sprintf(", self-test ok (%s)", st.Latency)
+			case SelfTestFailed:
+				line += ", self-test failed: " + st.Error
+			default:
+				line += ", self-test " + st.Status
+			}
+		}
+		fmt.Println(line)
+	}
+
+	if status.Healthy {
+		fmt.Println("healthy")
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestHealthHandler(t *testing.T) {
+	ln := openHealthListener(t)
+	h, err := NewHealthHandler([]string{"http_simple_blocking:20150701"}, []*marionette.Listener{ln})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure a listening server is healthy.
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/healthz", nil))
+	var status HealthStatus
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	} else if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+		t.Fatal(err)
+	} else if !status.Healthy || len(status.Listeners) != 1 || !status.Listeners[0].Alive {
+		t.Fatalf("unexpected status: %+v", status)
+	} else if status.Listeners[0].Addr != ln.Addr().String() {
+		t.Fatalf("unexpected addr: %s", status.Listeners[0].Addr)
+	}
+
+	// Ensure a failed self-test makes the server unhealthy.
+	h.listeners[0].selfTest = &SelfTestResult{Status: SelfTestFailed, Error: "marker"}
+	if status := h.Status(); status.Healthy {
+		t.Fatal("expected unhealthy")
+	}
+	h.listeners[0].selfTest = &SelfTestResult{Status: SelfTestNoReply}
+	if status := h.Status(); !status.Healthy {
+		t.Fatal("expected healthy")
+	}
+
+	// Ensure a closed listener is unhealthy.
+	if err := ln.Close(); err != nil {
+		t.Fatal(err)
+	}
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/healthz", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	} else if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+		t.Fatal(err)
+	} else if status.Healthy || status.Listeners[0].Alive || status.Listeners[0].Error == "" {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+}
+
+func TestHealthHandler_ErrRequest(t *testing.T) {
+	h := &HealthHandler{}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/other", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/healthz", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("unexpected status code: %d", w.Code)
+	}
+}
+
+// Ensure the self-test connects to the listener's port with the client side
+// of the format.
+func TestSelfTest(t *testing.T) {
+	ln := openHealthListener(t)
+	defer ln.Close()
+
+	h, err := NewHealthHandler([]string{"http_simple_blocking:20150701"}, []*marionette.Listener{ln})
+	if err != nil {
+		t.Fatal(err)
+	} else if result := selfTest(h.listeners[0].clientDoc, ln.Addr()); result.Status == SelfTestFailed {
+		t.Fatalf("unexpected self-test failure: %s", result.Error)
+	}
+}
+
+func TestHealthcheckCommand_Run(t *testing.T) {
+	var healthy atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			http.NotFound(w, r)
+			return
+		} else if !healthy.Load().(bool) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(HealthStatus{Healthy: healthy.Load().(bool)})
+	}))
+	defer srv.Close()
+	addr := strings.TrimPrefix(srv.URL, "http://")
+
+	healthy.Store(true)
+	if err := NewHealthcheckCommand().Run([]string{"-q", addr}); err != nil {
+		t.Fatal(err)
+	}
+
+	healthy.Store(false)
+	if err := NewHealthcheckCommand().Run([]string{"-q", addr}); err != ErrUnhealthy {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// openHealthListener returns a listener on a random port whose streams are discarded.
+func openHealthListener(tb testing.TB) *marionette.Listener {
+	ln, err := marionette.ListenFormat("http_simple_blocking:20150701", &marionette.FormatOptions{Bind: "127.0.0.1:0"})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	go func() {
+		for {
+			stream, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(ioutil.Discard, stream)
+		}
+	}()
+	return ln.(*marionette.Listener)
+}
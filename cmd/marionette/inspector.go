@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/redjack/marionette"
+)
+
+// The FSM inspector is served by the -debug server alongside pprof & expvar.
+func init() {
+	http.HandleFunc("/debug/fsms", serveFSMs)
+}
+
+// serveFSMs serves the FSM of each open connection as JSON if requested with
+// ?format=json, otherwise as a page which refreshes from the JSON every second.
+func serveFSMs(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(marionette.FSMs())
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(fsmInspectorPage))
+}
+
+// fsmInspectorPage renders the JSON view client-side so it refreshes live.
+const fsmInspectorPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>marionette FSMs</title>
+<style>
+body { font-family: monospace; margin: 1em; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 2px 6px; text-align: left; vertical-align: top; }
+th { background: #eee; }
+</style>
+</head>
+<body>
+<h1>FSMs <small id="status"></small></h1>
+<div id="fsms"></div>
+<script>
+function esc(s) {
+	var d = document.createElement("div");
+	d.textContent = String(s);
+	return d.innerHTML;
+}
+
+function render(fsms) {
+	if (fsms.length === 0) {
+		return "<p>No open connections.</p>";
+	}
+	return fsms.map(function(f) {
+		var vars = Object.keys(f.vars).sort().map(function(k) {
+			return esc(k) + " = " + esc(f.vars[k]);
+		}).join("<br>");
+		var transitions = f.transitions.slice().reverse().map(function(t) {
+			return esc(t.time) + " " + esc(t.from) + " &rarr; " + esc(t.to);
+		}).join("<br>");
+		return "<table>" +
+			"<tr><th>id</th><td>" + esc(f.id) + "</td></tr>" +
+			"<tr><th>party</th><td>" + esc(f.party) + "</td></tr>" +
+			"<tr><th>format</th><td>" + esc(f.format) + "</td></tr>" +
+			"<tr><th>instance</th><td>" + esc(f.instance_id) + "</td></tr>" +
+			"<tr><th>state</th><td>" + esc(f.state) + "</td></tr>" +
+			"<tr><th>steps</th><td>" + esc(f.steps) + "</td></tr>" +
+			"<tr><th>opened</th><td>" + esc(f.opened_at) + "</td></tr>" +
+			"<tr><th>streams</th><td>" + esc(f.streams.join(", ")) + "</td></tr>" +
+			"<tr><th>vars</th><td>" + vars + "</td></tr>" +
+			"<tr><th>transitions</th><td>" + transitions + "</td></tr>" +
+			"</table>";
+	}).join("");
+}
+
+function refresh() {
+	fetch("?format=json").then(function(resp) {
+		return resp.json();
+	}).then(function(fsms) {
+		document.getElementById("fsms").innerHTML = render(fsms);
+		document.getElementById("status").textContent = "updated " + new Date().toLocaleTimeString();
+	}).catch(function(err) {
+		document.getElementById("status").textContent = "error: " + err;
+	}).then(function() {
+		setTimeout(refresh, 1000);
+	});
+}
+refresh();
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/fte"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// setupLogging replaces the global logger with one built from the logging
+// flags. If verbose is set then the default level is debug and the default
+// encoding is console. Otherwise the default level is info & encoding is json.
+func (fs *FlagSet) setupLogging(verbose bool) error {
+	levels := &logLevels{base: zapcore.InfoLevel}
+	format := "json"
+	if verbose {
+		levels.base, format = zapcore.DebugLevel, "console"
+	}
+	if fs.LogFormat != "" {
+		format = fs.LogFormat
+	}
+	if err := levels.Set(fs.LogLevel); err != nil {
+		return err
+	}
+
+	var encoder zapcore.Encoder
+	switch format {
+	case "json":
+		encoder = zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	case "console":
+		encoder = zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig())
+	default:
+		return fmt.Errorf("invalid log format: %q", format)
+	}
+
+	// Write to stderr unless a log file is specified. Standard library
+	// logging, such as from the PT library, is sent to the same file.
+	var w zapcore.WriteSyncer = zapcore.Lock(os.Stderr)
+	if fs.LogFile != "" {
+		f := &rotatingFile{
+			path:       fs.LogFile,
+			maxSize:    int64(fs.LogMaxSize) * 1024 * 1024,
+			maxAge:     fs.LogRotate,
+			maxBackups: fs.LogMaxBackups,
+		}
+		if err := f.open(); err != nil {
+			return err
+		}
+		log.SetOutput(f)
+		w = f
+	}
+
+	core := zapcore.NewCore(encoder, w, zapcore.DebugLevel)
+	if fs.Redact {
+		core = &redactCore{Core: core}
+		log.SetOutput(&redactWriter{w: log.Writer()})
+	}
+	core = &levelCore{Core: core, levels: levels}
+	marionette.Logger = zap.New(core, zap.AddCaller())
+	fs.logLevels = levels
+	return nil
+}
+
+// logLevels holds the minimum level of each subsystem logger. The levels may
+// be changed while logging, such as through the admin API.
+type logLevels struct {
+	mu    sync.RWMutex
+	base  zapcore.Level // default level if none is set
+	def   zapcore.Level
+	names map[string]zapcore.Level
+}
+
+// Set replaces the levels with a comma-separated list, such as
+// "info,fsm=debug". A level without a subsystem name sets the default level.
+func (l *logLevels) Set(s string) error {
+	def, names := l.base, make(map[string]zapcore.Level)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+
+		name, text := "", item
+		if i := strings.Index(item, "="); i != -1 {
+			name, text = strings.TrimSpace(item[:i]), strings.TrimSpace(item[i+1:])
+		}
+
+		var lvl zapcore.Level
+		if err := lvl.UnmarshalText([]byte(text)); err != nil {
+			return fmt.Errorf("invalid log level: %q", item)
+		}
+
+		if name == "" {
+			def = lvl
+		} else {
+			names[name] = lvl
+		}
+	}
+
+	l.mu.Lock()
+	l.def, l.names = def, names
+	l.mu.Unlock()
+
+	fte.Verbose = l.level(marionette.LoggerFTE) <= zapcore.DebugLevel
+	return nil
+}
+
+// String returns the levels in the format accepted by Set.
+func (l *logLevels) String() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	items := []string{l.def.String()}
+	for name, lvl := range l.names {
+		items = append(items, name+"="+lvl.String())
+	}
+	sort.Strings(items[1:])
+	return strings.Join(items, ",")
+}
+
+// level returns the minimum level of the named logger. Nested names, such as
+// "fsm.fte", use the level of the innermost subsystem with one set.
+func (l *logLevels) level(name string) zapcore.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	parts := strings.Split(name, ".")
+	for i := len(parts) - 1; i >= 0; i-- {
+		if lvl, ok := l.names[parts[i]]; ok {
+			return lvl
+		}
+	}
+	return l.def
+}
+
+// min returns the lowest level of any subsystem.
+func (l *logLevels) min() zapcore.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	min := l.def
+	for _, lvl := range l.names {
+		if lvl < min {
+			min = lvl
+		}
+	}
+	return min
+}
+
+// levelCore filters entries by the level of the logger they were written to.
+type levelCore struct {
+	zapcore.Core
+	levels *logLevels
+}
+
+func (c *levelCore) Enabled(lvl zapcore.Level) bool {
+	return lvl >= c.levels.min()
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), levels: c.levels}
+}
+
+func (c *levelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if ent.Level < c.levels.level(ent.LoggerName) {
+		return ce
+	}
+	return ce.AddCore(ent, c)
+}
+
+// rotatingFile is a log file which is moved aside and reopened once it grows
+// past maxSize bytes or has been open for maxAge. Zero values disable each
+// limit. Only the newest maxBackups moved files are kept, if positive.
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+// open opens the log file for appending.
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	fi, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file, f.size, f.openedAt = file, fi.Size(), time.Now()
+	return nil
+}
+
+func (f *rotatingFile) Write(p []byte) (n int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if (f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize) ||
+		(f.maxAge > 0 && time.Since(f.openedAt) >= f.maxAge) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err = f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *rotatingFile) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Sync()
+}
+
+// rotate moves the current file aside with a timestamp suffix, removes old
+// backups, and opens a new file.
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	} else if err := os.Rename(f.path, f.path+"."+time.Now().UTC().Format("20060102T150405.000")); err != nil {
+		f.open() // keep writing to the current file
+		return err
+	} else if err := f.open(); err != nil {
+		return err
+	}
+
+	if f.maxBackups > 0 {
+		backups, _ := filepath.Glob(f.path + ".*")
+		sort.Strings(backups)
+		for len(backups) > f.maxBackups {
+			os.Remove(backups[0])
+			backups = backups[1:]
+		}
+	}
+	return nil
+}
+
+// redactedFields are the log fields whose values are removed by -redact as
+// they hold payload data, keys, or network addresses.
+var redactedFields = map[string]bool{
+	"addr":    true,
+	"address": true,
+	"bind":    true,
+	"data":    true,
+	"key":     true,
+	"url":     true,
+}
+
+// redacted replaces values removed from logs.
+const redacted = "[redacted]"
+
+// addrPattern matches network addresses in free text, such as error messages:
+// IPv4 & IPv6 addresses, host names with ports, and host names being resolved.
+var addrPattern = regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}(:\d+)?\b` +
+	`|\[[0-9A-Fa-f:.%]+\](:\d+)?` +
+	`|\b([0-9A-Fa-f]{1,4}:){7}[0-9A-Fa-f]{1,4}\b` +
+	`|(\b([0-9A-Fa-f]{1,4}:)*[0-9A-Fa-f]{1,4})?::([0-9A-Fa-f]{1,4}(:[0-9A-Fa-f]{1,4})*\b)?` +
+	`|\b[A-Za-z0-9-]*[A-Za-z][A-Za-z0-9-]*(\.[A-Za-z0-9-]+)*:\d+\b` +
+	`|\blookup [^\s:]+`)
+
+// redactText removes network addresses from s.
+func redactText(s string) string {
+	return addrPattern.ReplaceAllString(s, redacted)
+}
+
+// redactCore removes redactedFields, and addresses in messages & errors,
+// from entries before they are written.
+type redactCore struct {
+	zapcore.Core
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(redactFields(fields))}
+}
+
+func (c *redactCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *redactCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	ent.Message = redactText(ent.Message)
+	return c.Core.Write(ent, redactFields(fields))
+}
+
+// redactFields returns a copy of fields with sensitive values removed.
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	other := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		switch {
+		case redactedFields[f.Key]:
+			other[i] = zap.String(f.Key, redacted)
+		case f.Type == zapcore.ErrorType:
+			err, _ := f.Interface.(error)
+			if err == nil {
+				other[i] = f
+				continue
+			}
+			other[i] = zap.String(f.Key, redactText(err.Error()))
+		case f.Type == zapcore.StringType:
+			other[i] = zap.String(f.Key, redactText(f.String))
+		default:
+			other[i] = f
+		}
+	}
+	return other
+}
+
+// redactWriter removes network addresses from standard library log output.
+type redactWriter struct {
+	w io.Writer
+}
+
+func (w *redactWriter) Write(p []byte) (int, error) {
+	if _, err := w.w.Write([]byte(redactText(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
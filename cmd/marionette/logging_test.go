@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogLevels_Set(t *testing.T) {
+	levels := &logLevels{base: zapcore.InfoLevel}
+	if err := levels.Set("warn, fsm=debug ,fte=error"); err != nil {
+		t.Fatal(err)
+	} else if s := levels.String(); s != "warn,fsm=debug,fte=error" {
+		t.Fatalf("unexpected levels: %s", s)
+	}
+
+	for name, want := range map[string]zapcore.Level{
+		"":        zapcore.WarnLevel,
+		"proxy":   zapcore.WarnLevel,
+		"fsm":     zapcore.DebugLevel,
+		"fsm.fte": zapcore.ErrorLevel,
+		"fsm.io":  zapcore.DebugLevel,
+	} {
+		if lvl := levels.level(name); lvl != want {
+			t.Fatalf("unexpected level of %q: %s, want %s", name, lvl, want)
+		}
+	}
+	if lvl := levels.min(); lvl != zapcore.DebugLevel {
+		t.Fatalf("unexpected min level: %s", lvl)
+	}
+
+	// An empty list resets to the base level.
+	if err := levels.Set(""); err != nil {
+		t.Fatal(err)
+	} else if s := levels.String(); s != "info" {
+		t.Fatalf("unexpected levels: %s", s)
+	}
+}
+
+func TestLogLevels_Set_ErrInvalid(t *testing.T) {
+	levels := &logLevels{base: zapcore.InfoLevel}
+	if err := levels.Set("fsm=debug"); err != nil {
+		t.Fatal(err)
+	} else if err := levels.Set("info,fsm=loud"); err == nil || err.Error() != `invalid log level: "fsm=loud"` {
+		t.Fatalf("unexpected error: %v", err)
+	} else if s := levels.String(); s != "info,fsm=debug" {
+		t.Fatalf("unexpected levels: %s", s)
+	}
+}
+
+// Ensure entries are filtered by the level of the logger they are written to.
+func TestLevelCore(t *testing.T) {
+	levels := &logLevels{base: zapcore.InfoLevel}
+	if err := levels.Set("warn,fsm=debug"); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(&buf), zapcore.DebugLevel)
+	logger := zap.New(&levelCore{Core: core, levels: levels})
+
+	logger.Info("root info")
+	logger.Warn("root warn")
+	logger.Named("fsm").Debug("fsm debug")
+	logger.Named("proxy").Info("proxy info")
+
+	if s := buf.String(); strings.Contains(s, "root info") || strings.Contains(s, "proxy info") {
+		t.Fatalf("unexpected entries: %s", s)
+	} else if !strings.Contains(s, "root warn") || !strings.Contains(s, "fsm debug") {
+		t.Fatalf("missing entries: %s", s)
+	}
+}
+
+// Ensure the log file is moved aside once full & old backups are removed.
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "marionette.log")
+	f := &rotatingFile{path: path, maxSize: 10, maxBackups: 1}
+	if err := f.open(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n"} {
+		if _, err := f.Write([]byte(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.file.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf, err := ioutil.ReadFile(path); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "cccccccc\n" {
+		t.Fatalf("unexpected log file: %q", buf)
+	}
+
+	backups, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatal(err)
+	} else if len(backups) != 1 {
+		t.Fatalf("unexpected backups: %v", backups)
+	} else if buf, err := ioutil.ReadFile(backups[0]); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "bbbbbbbb\n" {
+		t.Fatalf("unexpected backup: %q", buf)
+	}
+}
+
+// Ensure writes to an existing file are appended & count toward its size.
+func TestRotatingFile_Append(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "marionette.log")
+	if err := ioutil.WriteFile(path, []byte("old\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	f := &rotatingFile{path: path}
+	if err := f.open(); err != nil {
+		t.Fatal(err)
+	} else if f.size != 4 {
+		t.Fatalf("unexpected size: %d", f.size)
+	} else if _, err := f.Write([]byte("new\n")); err != nil {
+		t.Fatal(err)
+	} else if err := f.file.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf, err := ioutil.ReadFile(path); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "old\nnew\n" {
+		t.Fatalf("unexpected log file: %q", buf)
+	} else if backups, _ := filepath.Glob(path + ".*"); len(backups) != 0 {
+		t.Fatalf("unexpected backups: %v", backups)
+	}
+}
+
+func TestRedactText(t *testing.T) {
+	for _, tt := range []struct {
+		s, want string
+	}{
+		{"dial tcp 203.0.113.5:443: connection refused", "dial tcp [redacted]: connection refused"},
+		{"read tcp [2001:db8::1]:80->[::1]:1234: reset", "read tcp [redacted]->[redacted]: reset"},
+		{"connect to 2001:db8::1 failed", "connect to [redacted] failed"},
+		{"dial tcp example.com:443: i/o timeout", "dial tcp [redacted]: i/o timeout"},
+		{"lookup example.com: no such host", "[redacted]: no such host"},
+		{"stream closed", "stream closed"},
+	} {
+		if got := redactText(tt.s); got != tt.want {
+			t.Fatalf("unexpected redaction of %q: %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+// Ensure address & payload fields are removed, and addresses within errors.
+func TestRedactCore(t *testing.T) {
+	var buf bytes.Buffer
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(&buf), zapcore.DebugLevel)
+	logger := zap.New(&redactCore{Core: core})
+
+	logger.With(zap.String("addr", "203.0.113.5:443")).Info("connected to 203.0.113.5:443",
+		zap.Binary("data", []byte("payload")),
+		zap.Error(errors.New("dial tcp 198.51.100.7:80: refused")),
+		zap.String("state", "upstream"),
+		zap.Int("n", 7),
+	)
+
+	s := buf.String()
+	for _, secret := range []string{"203.0.113.5", "198.51.100.7", "cGF5bG9hZA", "payload"} {
+		if strings.Contains(s, secret) {
+			t.Fatalf("unexpected %q in log: %s", secret, s)
+		}
+	}
+	for _, field := range []string{`"addr":"[redacted]"`, `"data":"[redacted]"`, `"error":"dial tcp [redacted]: refused"`, `"state":"upstream"`, `"n":7`} {
+		if !strings.Contains(s, field) {
+			t.Fatalf("expected %s in log: %s", field, s)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	_ "expvar"
 	"flag"
@@ -8,16 +9,26 @@ import (
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"os/signal"
 	"sort"
+	"strings"
+	"sync"
 	"text/tabwriter"
 	"time"
 
 	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	"github.com/redjack/marionette/plugins"
+	"github.com/redjack/marionette/plugins/extern"
 	"github.com/redjack/marionette/plugins/model"
+	"go.uber.org/zap"
 )
 
 var ErrUsage = errors.New("usage")
 
+// DefaultShutdownTimeout is the default time open streams may drain on shutdown.
+const DefaultShutdownTimeout = 30 * time.Second
+
 func main() {
 	if err := run(os.Args[1:]); err == ErrUsage {
 		fmt.Fprintln(os.Stderr, Usage())
@@ -36,16 +47,42 @@ func run(args []string) error {
 	}
 
 	switch args[0] {
+	case "bench":
+		return NewBenchCommand().Run(args[1:])
+	case "bridge-line":
+		return NewBridgeLineCommand().Run(args[1:])
 	case "client":
 		return NewClientCommand().Run(args[1:])
+	case "credential":
+		return NewCredentialCommand().Run(args[1:])
+	case "discovery":
+		return NewDiscoveryCommand().Run(args[1:])
+	case "doctor":
+		return NewDoctorCommand().Run(args[1:])
 	case "formats":
 		return NewFormatsCommand().Run(args[1:])
+	case "healthcheck":
+		return NewHealthcheckCommand().Run(args[1:])
+	case "nc":
+		return NewNcCommand().Run(args[1:])
+	case "plugins":
+		return NewPluginsCommand().Run(args[1:])
 	case "pt-client":
 		return NewPTClientCommand().Run(args[1:])
 	case "pt-server":
 		return NewPTServerCommand().Run(args[1:])
+	case "run":
+		return NewRunCommand().Run(args[1:])
 	case "server":
 		return NewServerCommand().Run(args[1:])
+	case "service":
+		return NewServiceCommand().Run(args[1:])
+	case "sip003":
+		return NewSIP003Command().Run(args[1:])
+	case "sysproxy":
+		return NewSysproxyCommand().Run(args[1:])
+	case "update":
+		return NewUpdateCommand().Run(args[1:])
 	default:
 		return ErrUsage
 	}
@@ -62,25 +99,114 @@ Usage:
 
 The commands are:
 
-	client    runs the client proxy
-	formats   show a list of available formats
-	pt-client runs the client proxy as a PT
-	pt-server runs the server proxy as a PT
-	server    runs the server proxy
+	bench       compares the performance of formats
+	bridge-line prints a shareable string for connecting to a server
+	client      runs the client proxy
+	credential  issues & revokes users' credentials for a private bridge
+	discovery   signs DNS records listing servers for client -discover
+	doctor      checks connectivity to a server
+	formats     show a list of available formats
+	healthcheck checks the health endpoint of a server
+	nc          connects stdin & stdout to a stream, such as for ssh
+	plugins     show a list of registered plugins
+	pt-client   runs the client proxy as a PT
+	pt-server   runs the server proxy as a PT
+	run         runs the client or server from a config, such as in a container
+	server      runs the server proxy
+	service     installs & controls a Windows service
+	sip003      runs as a V2Ray, Xray or Shadowsocks transport plugin
+	sysproxy    points the system proxy settings at the client
+	update      replaces this binary with the latest signed release
+
+Flags may also be set with MARIONETTE_* environment variables named after
+the flag, such as MARIONETTE_SLEEP_FACTOR for -sleep-factor.
 `[1:]
 }
 
 type FlagSet struct {
 	*flag.FlagSet
-	Debug     string
-	TracePath string
+	ConfigPath string
+	Preset     string
+	passed     map[string]bool // flags passed on the command line or environment
+	Debug      string
+	TracePath  string
+	PcapPath   string
+	CrashDir   string
+	Events     string
+	PluginDir  string
+	FormatDir  string
+	SelfTest   bool
+
+	// Noised aggregate counters of connections & bytes.
+	AuditLog      string
+	AuditEpsilon  float64
+	AuditInterval time.Duration
+
+	// Logging output, encoding & per-subsystem levels.
+	LogFormat     string
+	LogLevel      string
+	LogFile       string
+	LogMaxSize    int
+	LogRotate     time.Duration
+	LogMaxBackups int
+	Redact        bool
+	logLevels     *logLevels // set by setupLogging()
+
+	// OpenTelemetry span export.
+	OTLPEndpoint   string
+	OTLPInsecure   bool
+	OTLPSampleRate float64
+
+	// Limits on each stream's idle time & total lifetime.
+	StreamIdleTimeout time.Duration
+	StreamMaxLifetime time.Duration
+
+	// Options for cover & proxied TCP connections.
+	SocketOptions marionette.SocketOptions
+	tcpNoDelay    bool
+
+	// Splitting & joining of messages written to cover connections.
+	Segmentation marionette.WriteSegmentation
 }
 
 func NewFlagSet(name string, errorHandling flag.ErrorHandling) *FlagSet {
 	fs := &FlagSet{FlagSet: flag.NewFlagSet(name, errorHandling)}
+	fs.StringVar(&fs.ConfigPath, "config", "", "TOML config file path; flags override its options")
+	fs.StringVar(&fs.Preset, "preset", "", "Tune options together: "+strings.Join(presetNames(), ", ")+"; flags & config options override it")
 	fs.Float64Var(&model.SleepFactor, "sleep-factor", model.SleepFactor, "model.sleep() multipler")
 	fs.StringVar(&fs.Debug, "debug", "", "debug http bind address")
 	fs.StringVar(&fs.TracePath, "trace-path", "", "stream trace directory path")
+	fs.StringVar(&fs.PcapPath, "pcap", "", "Write cover traffic, with synthesized TCP/IP headers, to this pcap file")
+	fs.StringVar(&fs.CrashDir, "crash-dir", "", "Write a diagnostic report with redacted flags, recent log events & goroutine stacks to this directory if the process crashes")
+	fs.StringVar(&fs.Events, "events", "", "Comma-separated connection & stream event sinks: a JSON lines file path, syslog:, syslog://host:port, or a webhook URL")
+	fs.StringVar(&fs.AuditLog, "audit-log", "", "Append noised hourly connection & byte counts per format, without per-user data, to this file")
+	fs.Float64Var(&fs.AuditEpsilon, "audit-epsilon", marionette.DefaultAuditEpsilon, "Differential privacy budget of each -audit-log counter; smaller values add more noise")
+	fs.DurationVar(&fs.AuditInterval, "audit-interval", marionette.DefaultAuditInterval, "Time covered by each -audit-log record")
+	fs.StringVar(&fs.PluginDir, "plugin-dir", "", "directory of Go plugin (.so) files to load")
+	fs.StringVar(&fs.FormatDir, "format-dir", "", "Comma-separated directories of .mar formats to use in addition to the built-in formats")
+	fs.BoolVar(&fs.SelfTest, "selftest", false, "Check every built-in format with an in-memory handshake before starting")
+	fs.StringVar(&fs.LogFormat, "log-format", "", "Log encoding: json or console (default console with -v, otherwise json)")
+	fs.StringVar(&fs.LogLevel, "log-level", "", "Log level, or comma-separated subsystem levels such as info,fsm=debug,fte=warn,proxy=error")
+	fs.StringVar(&fs.LogFile, "log-file", "", "Write logs to this file instead of stderr")
+	fs.IntVar(&fs.LogMaxSize, "log-max-size", 0, "Rotate the log file once it reaches this many megabytes (0 is unlimited)")
+	fs.DurationVar(&fs.LogRotate, "log-rotate", 0, "Rotate the log file at this interval (0 disables)")
+	fs.IntVar(&fs.LogMaxBackups, "log-max-backups", 0, "Number of rotated log files to keep (0 keeps all)")
+	fs.BoolVar(&fs.Redact, "redact", false, "Remove payload data, keys & network addresses from logs so they can be shared")
+	fs.StringVar(&fs.OTLPEndpoint, "otlp-endpoint", "", "OpenTelemetry collector host:port to export traces to over OTLP/HTTP (default disabled)")
+	fs.BoolVar(&fs.OTLPInsecure, "otlp-insecure", false, "Export traces over plain HTTP instead of HTTPS")
+	fs.Float64Var(&fs.OTLPSampleRate, "otlp-sample-rate", 1, "Fraction of cover connections traced, with their streams")
+	fs.StringVar(&extern.Addr, "extern-addr", extern.Addr, "extern plugin sidecar address (host:port or unix:path)")
+	fs.DurationVar(&fs.StreamIdleTimeout, "stream-idle-timeout", 0, "Close streams with no data sent or received for this long (0 is unlimited)")
+	fs.DurationVar(&fs.StreamMaxLifetime, "stream-max-lifetime", 0, "Close streams open for longer than this (0 is unlimited)")
+	fs.DurationVar(&fs.SocketOptions.KeepAlive, "tcp-keepalive", 0, "TCP keepalive period (0 is system default, negative disables)")
+	fs.BoolVar(&fs.tcpNoDelay, "tcp-nodelay", true, "Send small TCP writes immediately (TCP_NODELAY)")
+	fs.IntVar(&fs.SocketOptions.ReadBuffer, "tcp-rcvbuf", 0, "TCP receive buffer size in bytes (0 is system default)")
+	fs.IntVar(&fs.SocketOptions.WriteBuffer, "tcp-sndbuf", 0, "TCP send buffer size in bytes (0 is system default)")
+	fs.IntVar(&fs.SocketOptions.MaxSegmentSize, "tcp-mss", 0, "Clamp the TCP maximum segment size on linux (0 is system default)")
+	fs.IntVar(&fs.Segmentation.MinSize, "segment-min", 0, "Minimum size of each write a cover message is split into")
+	fs.IntVar(&fs.Segmentation.MaxSize, "segment-max", 0, "Maximum size of each write a cover message is split into (0 writes messages whole)")
+	fs.DurationVar(&fs.Segmentation.Delay, "segment-delay", 0, "Delay between the writes of a split cover message")
+	fs.BoolVar(&fs.Segmentation.Coalesce, "segment-coalesce", false, "Join queued cover messages into a single write")
 	return fs
 }
 
@@ -89,6 +215,59 @@ func (fs *FlagSet) Parse(arguments []string) error {
 		return err
 	}
 
+	// Fill in flags not passed on the command line from the environment, the
+	// config file, then from the preset named in any of them.
+	fs.passed = make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { fs.passed[f.Name] = true })
+	if err := fs.applyEnv(); err != nil {
+		return err
+	}
+	if fs.ConfigPath != "" {
+		config, err := ReadConfigFile(fs.ConfigPath)
+		if err != nil {
+			return err
+		} else if err := fs.applyConfig(config); err != nil {
+			return err
+		}
+	} else if defaultConfig != nil {
+		if err := fs.applyConfig(defaultConfig); err != nil {
+			return err
+		}
+	}
+	if err := fs.applyPreset(fs.Preset); err != nil {
+		return err
+	}
+
+	fs.SocketOptions.Nagle = !fs.tcpNoDelay
+	if fs.Segmentation.MinSize < 0 || fs.Segmentation.MaxSize < 0 || (fs.Segmentation.MaxSize > 0 && fs.Segmentation.MinSize > fs.Segmentation.MaxSize) {
+		return errors.New("invalid segment size range")
+	}
+
+	// Search user format directories after the built-in formats.
+	for _, dir := range strings.Split(fs.FormatDir, ",") {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			mar.FormatDirs = append(mar.FormatDirs, dir)
+		}
+	}
+
+	// Load third-party plugins before any formats are parsed.
+	if fs.PluginDir != "" {
+		paths, err := plugins.Load(fs.PluginDir)
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			fmt.Fprintf(os.Stderr, "loaded plugin: %s\n", path)
+		}
+	}
+
+	// Check built-in formats once plugins are registered.
+	if fs.SelfTest {
+		if err := selfTestFormats(); err != nil {
+			return err
+		}
+	}
+
 	// Run pprof-server in the background if requested.
 	if fs.Debug != "" {
 		fmt.Fprintf(os.Stderr, "debug http server listening on %s\n", fs.Debug)
@@ -98,6 +277,77 @@ func (fs *FlagSet) Parse(arguments []string) error {
 	return nil
 }
 
+// selfTestFormats checks every built-in format, writing the result of each to
+// stderr, and returns an error if any failed.
+func selfTestFormats() error {
+	var failed int
+	w := tabwriter.NewWriter(os.Stderr, 0, 0, 1, ' ', 0)
+	for _, result := range marionette.SelfTestFormats(context.Background(), nil) {
+		switch result.Status {
+		case marionette.SelfTestFailed:
+			failed++
+			fmt.Fprintf(w, "%s\t%s\t%s: %s\t\n", result.Format, result.Status, result.Stage, result.Error)
+		case marionette.SelfTestSkipped:
+			fmt.Fprintf(w, "%s\t%s\t%s\t\n", result.Format, result.Status, result.Error)
+		default:
+			fmt.Fprintf(w, "%s\t%s\t%s\t\n", result.Format, result.Status, result.Duration.Truncate(time.Millisecond))
+		}
+	}
+	w.Flush()
+
+	if failed > 0 {
+		return fmt.Errorf("self-test failed for %d format(s)", failed)
+	}
+	return nil
+}
+
+// segmentation returns the write segmentation options, or nil if disabled.
+func (fs *FlagSet) segmentation() *marionette.WriteSegmentation {
+	if fs.Segmentation.MaxSize <= 0 && !fs.Segmentation.Coalesce {
+		return nil
+	}
+	return &fs.Segmentation
+}
+
+// formatNames returns the names in a comma-separated list of formats.
+func formatNames(s string) []string {
+	var names []string
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// readFormats reads & parses a comma-separated list of format names and versions.
+func readFormats(party, s string) ([]*mar.Document, error) {
+	var docs []*mar.Document
+	for _, format := range strings.Split(s, ",") {
+		if format = strings.TrimSpace(format); format == "" {
+			continue
+		}
+
+		data, err := mar.ReadFormat(format)
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("MAR document not found: %s", format)
+		} else if err != nil {
+			return nil, err
+		}
+
+		doc, err := mar.Parse(party, data)
+		if err != nil {
+			return nil, err
+		}
+		doc.Format = mar.StripFormatVersion(format)
+		docs = append(docs, doc)
+	}
+	if len(docs) == 0 {
+		return nil, errors.New("format required")
+	}
+	return docs, nil
+}
+
 // dumpStreams writes out a list of streams ordered by mod time.
 func dumpStreams(streams []*marionette.Stream) {
 	sort.Slice(streams, func(i, j int) bool { return streams[i].ModTime().Before(streams[j].ModTime()) })
@@ -110,3 +360,65 @@ func dumpStreams(streams []*marionette.Stream) {
 	w.Flush()
 	os.Stderr.Write([]byte("\n"))
 }
+
+// handleReload calls fn each time a hangup signal is received. Failures are
+// logged and the previous configuration is kept.
+func handleReload(fn func() error) {
+	if len(reloadSignals) == 0 {
+		return
+	}
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, reloadSignals...)
+	go func() {
+		for range c {
+			sdNotify("RELOADING=1")
+			err := fn()
+			sdNotify("READY=1")
+			if err != nil {
+				marionette.Logger.Error("reload failed", zap.Error(err))
+				continue
+			}
+			marionette.Logger.Info("reloaded configuration")
+		}
+	}()
+}
+
+// reload re-reads the log levels & the named flags from the config file.
+func (fs *FlagSet) reload(names ...string) error {
+	if err := fs.reloadConfig(append(names, "log-level")...); err != nil {
+		return err
+	}
+	return fs.logLevels.Set(fs.LogLevel)
+}
+
+// stopRequested is closed when a service manager asks the command to stop.
+var (
+	stopRequested = make(chan struct{})
+	stopOnce      sync.Once
+)
+
+// requestStop stops the command the same as a shutdown signal.
+func requestStop() {
+	stopOnce.Do(func() { close(stopRequested) })
+}
+
+// waitForSignal blocks until a shutdown signal or stop request is received.
+// A second signal exits immediately.
+func waitForSignal() {
+	c := make(chan os.Signal, 2)
+	signal.Notify(c, shutdownSignals...)
+	select {
+	case <-c:
+		fmt.Fprintln(os.Stderr, "received signal, draining streams...")
+	case <-stopRequested:
+		fmt.Fprintln(os.Stderr, "stop requested, draining streams...")
+	}
+
+	go func() {
+		<-c
+		fmt.Fprintln(os.Stderr, "received second signal, exiting")
+		removeCrashReport()
+		os.Exit(1)
+	}()
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+
+	"github.com/redjack/marionette"
+	_ "github.com/redjack/marionette/plugins"
+)
+
+const ncUsage = `usage: marionette nc [flags] [HOST PORT]
+
+Connects stdin & stdout to HOST:PORT through a server started with -tunnel,
+or to the server's -proxy address if no HOST is given. For example, in
+~/.ssh/config:
+
+	ProxyCommand marionette nc -format http_simple_blocking -server $SERVER_IP %h %p
+
+`
+
+// NcCommand connects stdin & stdout to a single stream, such as for use as an
+// OpenSSH ProxyCommand, instead of running a local listener.
+type NcCommand struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+}
+
+func NewNcCommand() *NcCommand {
+	return &NcCommand{
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+	}
+}
+
+func (cmd *NcCommand) Run(args []string) error {
+	fs := NewFlagSet("marionette-nc", flag.ContinueOnError)
+	var (
+		serverIP = fs.String("server", "127.0.0.1", "Server host name or IP address, or a comma-separated or JSON list to fail over between")
+		format   = fs.String("format", "", "Format name and version")
+		upstream = fs.String("upstream-proxy", "", "Connect to the server through a proxy (socks5://, socks4a://, http://, or https:// URL)")
+		verbose  = fs.Bool("v", false, "Debug logging enabled")
+	)
+	fs.Usage = func() {
+		io.WriteString(os.Stderr, ncUsage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Validate arguments.
+	var dest string
+	switch fs.NArg() {
+	case 0:
+	case 2:
+		dest = net.JoinHostPort(fs.Arg(0), fs.Arg(1))
+	default:
+		return errors.New("host & port required")
+	}
+	if *format == "" {
+		return errors.New("format required")
+	}
+
+	servers, err := parseServerList(*serverIP)
+	if err != nil {
+		return err
+	}
+
+	docs, err := readFormats(marionette.PartyClient, *format)
+	if err != nil {
+		return err
+	} else if len(docs) > 1 {
+		return errors.New("only one format may be used")
+	}
+
+	// Only log warnings by default as stderr is shown by the calling program.
+	if fs.LogLevel == "" && !*verbose {
+		fs.LogLevel = "warn"
+	}
+	closeCrashReport, err := fs.setupCommandLogging(*verbose)
+	if err != nil {
+		return err
+	}
+	defer closeCrashReport()
+
+	streamSet := marionette.NewStreamSet()
+	defer streamSet.Close()
+
+	dialer := marionette.NewDialer(docs[0], servers[0], streamSet)
+	dialer.Fallbacks = servers[1:]
+	dialer.SocketOptions = &fs.SocketOptions
+	dialer.Segmentation = fs.segmentation()
+	if *upstream != "" {
+		u, err := url.Parse(*upstream)
+		if err != nil {
+			return fmt.Errorf("invalid upstream proxy: %s", err)
+		}
+		if dialer.Dialer, err = marionette.NewProxyDialer(u, nil); err != nil {
+			return err
+		}
+	}
+	if err := dialer.Open(); err != nil {
+		return err
+	}
+	defer dialer.Close()
+
+	var conn net.Conn
+	if dest != "" {
+		conn, err = dialer.DialDestination(dest)
+	} else {
+		conn, err = dialer.Dial()
+	}
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Close the stream for writing once stdin ends, then finish once the
+	// peer's data has been written to stdout.
+	go func() {
+		io.Copy(conn, cmd.Stdin)
+		if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+			cw.CloseWrite()
+		}
+	}()
+	_, err = io.Copy(cmd.Stdout, conn)
+	return err
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	"go.uber.org/zap"
+)
+
+// Ensure stdin is sent over a stream and the reply is written to stdout.
+func TestNcCommand_Run(t *testing.T) {
+	defer func(logger *zap.Logger) { marionette.Logger = logger }(marionette.Logger)
+
+	// The client connects to the format's port so write a copy of the
+	// format which uses a free port.
+	port := freePort(t)
+	data, err := mar.ReadFormat("http_simple_blocking:20150701")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "nc_test.mar")
+	data = bytes.Replace(data, []byte("connection(tcp, 8081)"), []byte("connection(tcp, "+port+")"), 1)
+	if err := ioutil.WriteFile(path, data, 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	ln, err := marionette.ListenFormat(path, &marionette.FormatOptions{Bind: "127.0.0.1:" + port})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	// Reply to the stream once the client has finished writing.
+	go func() {
+		stream, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer stream.Close()
+		if buf, err := ioutil.ReadAll(stream); err == nil {
+			stream.Write(append([]byte("re:"), buf...))
+		}
+	}()
+
+	var stdout bytes.Buffer
+	cmd := NewNcCommand()
+	cmd.Stdin, cmd.Stdout = strings.NewReader("hello"), &stdout
+	if err := cmd.Run([]string{"-format", path, "-server", "127.0.0.1"}); err != nil {
+		t.Fatal(err)
+	} else if stdout.String() != "re:hello" {
+		t.Fatalf("unexpected output: %q", stdout.String())
+	}
+}
+
+func TestNcCommand_Run_ErrInvalidArgs(t *testing.T) {
+	for _, tt := range []struct {
+		args []string
+		err  string
+	}{
+		{[]string{"-format", "http_simple_blocking", "example.com"}, "host & port required"},
+		{[]string{"example.com", "22"}, "format required"},
+		{[]string{"-format", "http_simple_blocking,ftp_simple_blocking"}, "only one format may be used"},
+	} {
+		if err := NewNcCommand().Run(tt.args); err == nil || err.Error() != tt.err {
+			t.Fatalf("unexpected error for %v: %v", tt.args, err)
+		}
+	}
+}
+
+// freePort returns a TCP port on the loopback interface which is not in use.
+func freePort(tb testing.TB) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer ln.Close()
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	return port
+}
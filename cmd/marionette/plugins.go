@@ -0,0 +1,99 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	_ "github.com/redjack/marionette/plugins"
+)
+
+type PluginsCommand struct{}
+
+func NewPluginsCommand() *PluginsCommand {
+	return &PluginsCommand{}
+}
+
+func (cmd *PluginsCommand) Run(args []string) error {
+	fs := NewFlagSet("marionette-plugins", flag.ContinueOnError)
+	verbose := fs.Bool("v", false, "Show arguments and formats for each plugin")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	usage, err := pluginUsage()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, info := range marionette.Plugins() {
+		parties := "any"
+		if len(info.Parties) > 0 {
+			parties = strings.Join(info.Parties, ",")
+		}
+
+		if !*verbose {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", info.Name(), parties, info.Description)
+			continue
+		}
+
+		fmt.Fprintf(w, "%s (%s)\n", info.Name(), parties)
+		if info.Description != "" {
+			fmt.Fprintf(w, "  %s\n", info.Description)
+		}
+		for _, arg := range info.Args {
+			optional := ""
+			if arg.Optional {
+				optional = " (optional)"
+			}
+			fmt.Fprintf(w, "  arg: %s %s%s\n", arg.Name, arg.Type, optional)
+		}
+		for _, format := range usage[info.Name()] {
+			fmt.Fprintf(w, "  format: %s\n", format)
+		}
+		fmt.Fprintln(w, "")
+	}
+	return w.Flush()
+}
+
+// pluginUsage returns a lookup of plugin names to the embedded formats that use them.
+func pluginUsage() (map[string][]string, error) {
+	m := make(map[string][]string)
+	for _, name := range mar.AssetNames() {
+		if path.Ext(name) != ".mar" {
+			continue
+		}
+
+		doc, err := mar.Parse("", mar.MustAsset(name))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err)
+		}
+
+		// Convert "formats/VERSION/NAME.mar" to "NAME:VERSION".
+		a := strings.SplitN(strings.TrimSuffix(name, ".mar"), "/", 3)
+		format := a[2] + ":" + a[1]
+
+		seen := make(map[string]bool)
+		for _, blk := range doc.ActionBlocks {
+			for _, action := range blk.Actions {
+				if seen[action.Name()] {
+					continue
+				}
+				seen[action.Name()] = true
+				m[action.Name()] = append(m[action.Name()], format)
+			}
+		}
+	}
+
+	for _, formats := range m {
+		sort.Strings(formats)
+	}
+	return m, nil
+}
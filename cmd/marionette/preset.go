@@ -0,0 +1,92 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// presets are curated values of the options which affect latency, throughput
+// & how closely traffic follows a format. Options interact, such as model
+// sleeps delaying every message and TCP_NODELAY deciding whether split writes
+// reach the wire as separate segments, so they are tuned together. Options a
+// command does not have are ignored.
+var presets = map[string]map[string]string{
+	// Send each message as soon as possible: shorten model sleeps, send
+	// small writes immediately & keep send buffers small so queued data does
+	// not delay interactive streams. A second channel avoids streams waiting
+	// behind one another.
+	"low-latency": {
+		"sleep-factor":     "0.25",
+		"tcp-nodelay":      "true",
+		"tcp-sndbuf":       "65536",
+		"segment-max":      "0",
+		"segment-coalesce": "false",
+		"channels":         "2",
+	},
+
+	// Maximize throughput: skip model sleeps, let the kernel batch writes,
+	// join queued messages into large writes & use large socket buffers
+	// across several channels.
+	"bulk": {
+		"sleep-factor":     "0",
+		"tcp-nodelay":      "false",
+		"tcp-rcvbuf":       "4194304",
+		"tcp-sndbuf":       "4194304",
+		"segment-max":      "0",
+		"segment-coalesce": "true",
+		"channels":         "4",
+	},
+
+	// Follow the format's timing & resemble typical TCP segments: keep model
+	// sleeps as written and split messages into MTU-sized writes, each sent in
+	// its own segment after a short delay, over a single channel.
+	"stealth": {
+		"sleep-factor":     "1",
+		"tcp-nodelay":      "true",
+		"segment-min":      "536",
+		"segment-max":      "1460",
+		"segment-delay":    "2ms",
+		"segment-coalesce": "false",
+		"channels":         "1",
+	},
+}
+
+// presetNames returns the names of the presets, sorted.
+func presetNames() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyPreset sets the options of the named preset which have not been set on
+// the command line or by the config file. The segment size range is only set
+// if neither of its bounds has been set, so that it remains valid.
+func (fs *FlagSet) applyPreset(name string) error {
+	if name == "" {
+		return nil
+	}
+	preset, ok := presets[name]
+	if !ok {
+		return fmt.Errorf("unknown preset %q, must be one of: %s", name, strings.Join(presetNames(), ", "))
+	}
+
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if set["segment-min"] || set["segment-max"] {
+		set["segment-min"], set["segment-max"] = true, true
+	}
+
+	for option, value := range preset {
+		if fs.Lookup(option) == nil || set[option] {
+			continue
+		} else if err := fs.Set(option, value); err != nil {
+			return fmt.Errorf("invalid preset option %s: %s", option, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette/plugins/model"
+)
+
+func TestFlagSet_Parse_Preset(t *testing.T) {
+	defer restoreSleepFactor()()
+
+	fs := NewFlagSet("test", flag.ContinueOnError)
+	if err := fs.Parse([]string{"-preset", "stealth"}); err != nil {
+		t.Fatal(err)
+	} else if fs.Segmentation.MinSize != 536 || fs.Segmentation.MaxSize != 1460 {
+		t.Fatalf("unexpected segment size: %d-%d", fs.Segmentation.MinSize, fs.Segmentation.MaxSize)
+	} else if fs.Segmentation.Delay != 2*time.Millisecond {
+		t.Fatalf("unexpected segment delay: %s", fs.Segmentation.Delay)
+	} else if fs.SocketOptions.Nagle {
+		t.Fatal("unexpected nagle")
+	} else if model.SleepFactor != 1 {
+		t.Fatalf("unexpected sleep factor: %v", model.SleepFactor)
+	}
+}
+
+// Ensure options passed as flags or in the config file override the preset.
+func TestFlagSet_Parse_Preset_Override(t *testing.T) {
+	defer restoreSleepFactor()()
+
+	path := writeConfigFile(t, "[tcp]\ntcp-sndbuf = 1024\n")
+
+	fs := NewFlagSet("test", flag.ContinueOnError)
+	if err := fs.Parse([]string{"-config", path, "-preset", "bulk", "-sleep-factor", "0.5"}); err != nil {
+		t.Fatal(err)
+	} else if fs.SocketOptions.WriteBuffer != 1024 {
+		t.Fatalf("unexpected send buffer: %d", fs.SocketOptions.WriteBuffer)
+	} else if fs.SocketOptions.ReadBuffer != 4194304 {
+		t.Fatalf("unexpected receive buffer: %d", fs.SocketOptions.ReadBuffer)
+	} else if model.SleepFactor != 0.5 {
+		t.Fatalf("unexpected sleep factor: %v", model.SleepFactor)
+	} else if !fs.Segmentation.Coalesce {
+		t.Fatal("expected coalesce")
+	}
+}
+
+// Ensure the segment size range is left alone if either bound is set.
+func TestFlagSet_Parse_Preset_SegmentRange(t *testing.T) {
+	defer restoreSleepFactor()()
+
+	fs := NewFlagSet("test", flag.ContinueOnError)
+	if err := fs.Parse([]string{"-preset", "stealth", "-segment-max", "100"}); err != nil {
+		t.Fatal(err)
+	} else if fs.Segmentation.MinSize != 0 || fs.Segmentation.MaxSize != 100 {
+		t.Fatalf("unexpected segment size: %d-%d", fs.Segmentation.MinSize, fs.Segmentation.MaxSize)
+	}
+}
+
+func TestFlagSet_Parse_Preset_ErrUnknown(t *testing.T) {
+	fs := NewFlagSet("test", flag.ContinueOnError)
+	if err := fs.Parse([]string{"-preset", "fast"}); err == nil || !strings.HasPrefix(err.Error(), `unknown preset "fast", must be one of: bulk, low-latency, stealth`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// restoreSleepFactor returns a function which restores the model sleep
+// factor, which is set by presets.
+func restoreSleepFactor() func() {
+	prev := model.SleepFactor
+	return func() { model.SleepFactor = prev }
+}
@@ -0,0 +1,63 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// dropPrivileges switches the process to the user & group, given by name or
+// id. The group defaults to the user's primary group, and supplementary
+// groups are cleared.
+func dropPrivileges(username, group string) error {
+	uid, gid := -1, -1
+	if username != "" {
+		u, err := user.Lookup(username)
+		if _, ok := err.(user.UnknownUserError); ok {
+			u, err = user.LookupId(username)
+		}
+		if err != nil {
+			return err
+		}
+		if uid, err = strconv.Atoi(u.Uid); err != nil {
+			return fmt.Errorf("invalid uid for user %s: %s", username, u.Uid)
+		} else if gid, err = strconv.Atoi(u.Gid); err != nil {
+			return fmt.Errorf("invalid gid for user %s: %s", username, u.Gid)
+		}
+	}
+	if group != "" {
+		g, err := user.LookupGroup(group)
+		if _, ok := err.(user.UnknownGroupError); ok {
+			g, err = user.LookupGroupId(group)
+		}
+		if err != nil {
+			return err
+		}
+		if gid, err = strconv.Atoi(g.Gid); err != nil {
+			return fmt.Errorf("invalid gid for group %s: %s", group, g.Gid)
+		}
+	}
+
+	// The group must be changed first as the user may not be allowed to.
+	if err := syscall.Setgroups([]int{gid}); err != nil {
+		return fmt.Errorf("cannot set supplementary groups: %s", err)
+	} else if err := syscall.Setgid(gid); err != nil {
+		return fmt.Errorf("cannot switch to gid %d: %s", gid, err)
+	}
+	if uid == -1 {
+		return nil
+	} else if err := syscall.Setuid(uid); err != nil {
+		return fmt.Errorf("cannot switch to uid %d: %s", uid, err)
+	}
+
+	// Ensure root privileges cannot be regained.
+	if uid != 0 && syscall.Setuid(0) == nil {
+		return errors.New("privileges could not be dropped")
+	}
+	return nil
+}
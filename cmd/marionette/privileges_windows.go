@@ -0,0 +1,9 @@
+package main
+
+import "errors"
+
+// dropPrivileges is not supported on Windows. Run the service as a
+// restricted account instead.
+func dropPrivileges(username, group string) error {
+	return errors.New("-user & -group are not supported on windows")
+}
@@ -7,19 +7,28 @@ import (
 	"io"
 	"log"
 	"net"
+	"net/url"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	pt "git.torproject.org/pluggable-transports/goptlib.git"
 	"github.com/redjack/marionette"
 	"github.com/redjack/marionette/mar"
-	"go.uber.org/zap"
 )
 
 type PTClientCommand struct {
-	wg sync.WaitGroup
+	wg            sync.WaitGroup
+	dialer        marionette.NetDialer
+	socketOptions *marionette.SocketOptions
+	segmentation  *marionette.WriteSegmentation
+	capture       *marionette.PcapWriter
+
+	streamIdleTimeout time.Duration
+	streamMaxLifetime time.Duration
 }
 
 func NewPTClientCommand() *PTClientCommand {
@@ -29,52 +38,85 @@ func NewPTClientCommand() *PTClientCommand {
 func (cmd *PTClientCommand) Run(args []string) error {
 	fs := NewFlagSet("marionette-pt-client", flag.ContinueOnError)
 	var (
-		format  = fs.String("format", "", "Format name and version")
-		logFile = fs.String("log-file", "", "Path to log file.")
+		format = fs.String("format", "", "Format name and version used by bridges without a format argument")
 	)
 	if err := fs.Parse(args); err != nil {
 		return err
 	}
 
-	if *logFile != "" {
-		file, err := os.OpenFile(*logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
-		if err != nil {
-			fmt.Errorf("Unable to open log file: %s", err)
-		}
-
-		log.SetOutput(file)
-		defer file.Close()
+	// We always use the production logging defaults when running as a PT.
+	closeCrashReport, err := fs.setupCommandLogging(false)
+	if err != nil {
+		return err
 	}
+	defer closeCrashReport()
 
-	// Validate arguments.
-	if *format == "" {
-		return errors.New("format required")
+	// Publish connection & stream events to -events sinks, if any.
+	closeEvents, err := fs.setupEvents()
+	if err != nil {
+		return err
 	}
+	defer closeEvents()
 
-	// Read MAR file.
-	data, err := mar.ReadFormat(*format)
-	if os.IsNotExist(err) {
-		return fmt.Errorf("MAR document not found: %s", *format)
-	} else if err != nil {
+	// Export spans to an OpenTelemetry collector, if enabled.
+	shutdownTracing, err := fs.setupTracing()
+	if err != nil {
 		return err
 	}
+	defer shutdownTracing()
 
-	// Parse document.
-	doc, err := mar.Parse(marionette.PartyClient, data)
+	// Record cover traffic to a pcap file, if enabled.
+	capture, closeCapture, err := fs.openCapture()
 	if err != nil {
 		return err
 	}
+	defer closeCapture()
+	cmd.capture = capture
+
+	// Read & parse the default MAR file, if specified. Bridges may also
+	// select their format with SOCKS args.
+	var doc *mar.Document
+	if *format != "" {
+		data, err := mar.ReadFormat(*format)
+		if os.IsNotExist(err) {
+			return fmt.Errorf("MAR document not found: %s", *format)
+		} else if err != nil {
+			return err
+		}
+
+		if doc, err = mar.Parse(marionette.PartyClient, data); err != nil {
+			return err
+		}
+	}
 
-	// We always use the production logger when running as a PT.
-	config := zap.NewProductionConfig()
-	config.DisableStacktrace = true
-	marionette.Logger, _ = config.Build()
+	// Read an https proxy from TOR_PT_PROXY before goptlib rejects it.
+	httpsProxyURL, err := ptHTTPSProxyURL()
+	if err != nil {
+		pt.ProxyError(err.Error())
+		return err
+	}
 
 	clientInfo, err := pt.ClientSetup(nil)
 	if err != nil {
 		return err
-	} else if clientInfo.ProxyURL != nil {
-		return errors.New("marionette: PT proxy url not provided")
+	} else if httpsProxyURL != nil {
+		clientInfo.ProxyURL = httpsProxyURL
+	}
+
+	cmd.socketOptions = &fs.SocketOptions
+	cmd.segmentation = fs.segmentation()
+	cmd.streamIdleTimeout, cmd.streamMaxLifetime = fs.StreamIdleTimeout, fs.StreamMaxLifetime
+
+	// Connect to servers through the upstream proxy from TOR_PT_PROXY, if set.
+	cmd.dialer = &marionette.HappyEyeballsDialer{}
+	if clientInfo.ProxyURL != nil {
+		proxyDialer, err := marionette.NewProxyDialer(clientInfo.ProxyURL, nil)
+		if err != nil {
+			pt.ProxyError(err.Error())
+			return err
+		}
+		cmd.dialer = proxyDialer
+		pt.ProxyDone()
 	}
 
 	listeners := make([]net.Listener, 0)
@@ -134,15 +176,29 @@ func (cmd *PTClientCommand) handleConn(connection *pt.SocksConn, doc *mar.Docume
 		return
 	}
 
+	// Use the format from the bridge line's args, if any.
+	doc, err = connDocument(connection.Req.Args, doc)
+	if err != nil {
+		log.Printf("Invalid bridge arguments: %s", err)
+		connection.Reject()
+		return
+	}
+
 	log.Printf("Connecting to Marionette server: %s", host)
 	defer connection.Close()
 	defer log.Printf("Disconnected from Marionette host: %s", host)
 
 	streamSet := marionette.NewStreamSet()
+	streamSet.StreamIdleTimeout = cmd.streamIdleTimeout
+	streamSet.StreamMaxLifetime = cmd.streamMaxLifetime
 	defer streamSet.Close()
 
 	// Create dialer to remote server.
 	dialer := marionette.NewDialer(doc, host, streamSet)
+	dialer.Dialer = cmd.dialer
+	dialer.SocketOptions = cmd.socketOptions
+	dialer.Segmentation = cmd.segmentation
+	dialer.Capture = cmd.capture
 	if err := dialer.Open(); err != nil {
 		log.Printf("Unable to create dialer: %s", err)
 		connection.Reject()
@@ -169,6 +225,52 @@ func (cmd *PTClientCommand) handleConn(connection *pt.SocksConn, doc *mar.Docume
 	proxyConns(stream, connection)
 }
 
+// ptHTTPSProxyURL returns the TOR_PT_PROXY URL if it is an https proxy and
+// removes it from the environment. goptlib only accepts the socks5, socks4a &
+// http schemes listed by the PT spec.
+func ptHTTPSProxyURL() (*url.URL, error) {
+	s := os.Getenv("TOR_PT_PROXY")
+	if !strings.HasPrefix(s, "https://") {
+		return nil, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOR_PT_PROXY: %s", err)
+	}
+	os.Unsetenv("TOR_PT_PROXY")
+	return u, nil
+}
+
+// connDocument returns the client document of the format named by the SOCKS
+// args of a connection, such as "format=http_simple_blocking version=20150701"
+// from a bridge line. Returns doc if no format is named.
+func connDocument(args pt.Args, doc *mar.Document) (*mar.Document, error) {
+	for key := range args {
+		if key != "format" && key != "version" {
+			return nil, fmt.Errorf("unsupported argument: %s", key)
+		}
+	}
+
+	format, ok := args.Get("format")
+	if !ok {
+		if doc == nil {
+			return nil, errors.New("format required")
+		}
+		return doc, nil
+	} else if version, ok := args.Get("version"); ok {
+		format += ":" + version
+	}
+
+	docs, err := readFormats(marionette.PartyClient, format)
+	if err != nil {
+		return nil, err
+	} else if len(docs) != 1 {
+		return nil, fmt.Errorf("invalid format: %s", format)
+	}
+	return docs[0], nil
+}
+
 func proxyConns(a, b net.Conn) {
 	var wg sync.WaitGroup
 	wg.Add(2)
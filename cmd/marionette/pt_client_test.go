@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+
+	pt "git.torproject.org/pluggable-transports/goptlib.git"
+)
+
+func TestConnDocument(t *testing.T) {
+	def := mustReadClientFormat(t, "http_simple_blocking:20150701")
+
+	t.Run("Default", func(t *testing.T) {
+		if doc, err := connDocument(pt.Args{}, def); err != nil {
+			t.Fatal(err)
+		} else if doc != def {
+			t.Fatal("expected default document")
+		}
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		args := pt.Args{}
+		args.Add("format", "ftp_simple_blocking")
+		if doc, err := connDocument(args, def); err != nil {
+			t.Fatal(err)
+		} else if doc.Format != "ftp_simple_blocking" {
+			t.Fatalf("unexpected format: %s", doc.Format)
+		}
+	})
+
+	t.Run("Version", func(t *testing.T) {
+		args := pt.Args{}
+		args.Add("format", "http_simple_blocking")
+		args.Add("version", "20150701")
+		if doc, err := connDocument(args, nil); err != nil {
+			t.Fatal(err)
+		} else if doc.Format != "http_simple_blocking" {
+			t.Fatalf("unexpected format: %s", doc.Format)
+		}
+	})
+
+	t.Run("ErrFormatRequired", func(t *testing.T) {
+		if _, err := connDocument(pt.Args{}, nil); err == nil || err.Error() != "format required" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrUnsupportedArgument", func(t *testing.T) {
+		args := pt.Args{}
+		args.Add("format", "http_simple_blocking")
+		args.Add("cert", "abc")
+		if _, err := connDocument(args, def); err == nil || err.Error() != "unsupported argument: cert" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrUnknownFormat", func(t *testing.T) {
+		args := pt.Args{}
+		args.Add("format", "no_such_format")
+		if _, err := connDocument(args, def); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
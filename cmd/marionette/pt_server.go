@@ -14,7 +14,6 @@ import (
 	pt "git.torproject.org/pluggable-transports/goptlib.git"
 	"github.com/redjack/marionette"
 	"github.com/redjack/marionette/mar"
-	"go.uber.org/zap"
 )
 
 type PTServerCommand struct {
@@ -28,8 +27,7 @@ func NewPTServerCommand() *PTServerCommand {
 func (cmd *PTServerCommand) Run(args []string) error {
 	fs := NewFlagSet("marionette-ptserver", flag.ContinueOnError)
 	var (
-		format  = fs.String("format", "", "Format name and version")
-		logFile = fs.String("log-file", "", "Path to log file.")
+		format = fs.String("format", "", "Format name and version")
 	)
 	if err := fs.Parse(args); err != nil {
 		return err
@@ -39,16 +37,33 @@ func (cmd *PTServerCommand) Run(args []string) error {
 		return errors.New("format required")
 	}
 
-	if *logFile != "" {
-		file, err := os.OpenFile(*logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	// We always use the production logging defaults when running as a PT.
+	closeCrashReport, err := fs.setupCommandLogging(false)
+	if err != nil {
+		return err
+	}
+	defer closeCrashReport()
 
-		if err != nil {
-			fmt.Errorf("Unable to open log file: %s", err)
-		}
+	// Publish connection & stream events to -events sinks, if any.
+	closeEvents, err := fs.setupEvents()
+	if err != nil {
+		return err
+	}
+	defer closeEvents()
+
+	// Export spans to an OpenTelemetry collector, if enabled.
+	shutdownTracing, err := fs.setupTracing()
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing()
 
-		log.SetOutput(file)
-		defer file.Close()
+	// Record cover traffic to a pcap file, if enabled.
+	capture, closeCapture, err := fs.openCapture()
+	if err != nil {
+		return err
 	}
+	defer closeCapture()
 
 	// Read MAR file.
 	data, err := mar.ReadFormat(*format)
@@ -64,18 +79,19 @@ func (cmd *PTServerCommand) Run(args []string) error {
 		return err
 	}
 
-	// We always use the production logger when running as a PT.
-	config := zap.NewProductionConfig()
-	config.DisableStacktrace = true
-	marionette.Logger, _ = config.Build()
-
 	// Setup the PT.
 	serverInfo, err := pt.ServerSetup(nil)
 	if err != nil {
 		return err
 	}
 
-	listeners := make([]net.Listener, 0)
+	// Use sockets passed by systemd socket activation, if any.
+	sockets, err := listenSystemd()
+	if err != nil {
+		return err
+	}
+
+	listeners := make([]*marionette.Listener, 0)
 	for _, bindAddr := range serverInfo.Bindaddrs {
 		if bindAddr.MethodName != "marionette" {
 			pt.SmethodError(bindAddr.MethodName, "no such method")
@@ -99,13 +115,23 @@ func (cmd *PTServerCommand) Run(args []string) error {
 		}
 
 		// Start the listener.
-		listener, err := marionette.Listen(doc, host)
+		var listener *marionette.Listener
+		if sock := sockets.take(port); sock != nil {
+			listener = marionette.NewListener(sock, doc)
+		} else {
+			listener, err = marionette.Listen(doc, host)
+		}
 
 		if err != nil {
 			log.Printf("Unable to create listener: %s", err)
 			pt.SmethodError(bindAddr.MethodName, err.Error())
 			break
 		}
+		listener.SocketOptions = &fs.SocketOptions
+		listener.Segmentation = fs.segmentation()
+		listener.Capture = capture
+		listener.StreamIdleTimeout = fs.StreamIdleTimeout
+		listener.StreamMaxLifetime = fs.StreamMaxLifetime
 
 		cmd.wg.Add(1)
 		go func() { defer cmd.wg.Done(); cmd.acceptLoop(listener, &serverInfo) }()
@@ -114,11 +140,25 @@ func (cmd *PTServerCommand) Run(args []string) error {
 		listeners = append(listeners, listener)
 	}
 	pt.SmethodsDone()
+	sockets.Close()
+
+	// Notify systemd that the PT is ready & keep its watchdog alive while
+	// the listeners are accepting connections.
+	sdNotify("READY=1")
+	startWatchdog(func() error {
+		for _, listener := range listeners {
+			if err := listener.Err(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 
 	// Wait for SIGTERM.
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGTERM)
 	<-sigChan
+	sdNotify("STOPPING=1")
 
 	// Close listeners and wait for connections to close.
 	for _, listener := range listeners {
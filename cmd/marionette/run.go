@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+// Locations used by the run command unless overridden by MARIONETTE_CONFIG &
+// MARIONETTE_HEALTH_FILE.
+const (
+	DefaultRunConfigPath = "/etc/marionette/marionette.toml"
+	DefaultRunHealthFile = "/tmp/marionette.health"
+)
+
+// defaultRunConfig is used by the run command when no config file exists. It
+// runs a server forwarding streams to the destinations clients request.
+const defaultRunConfig = `
+mode = "server"
+format = ["http_simple_blocking"]
+tunnel = true
+shutdown-timeout = "8s"
+`
+
+// defaultConfig is applied in place of a config file by commands started by
+// the run command without one.
+var defaultConfig *Config
+
+// RunCommand starts the client or server from a single config file, or the
+// built-in default, for container deployments. Flags are passed through to
+// the command.
+type RunCommand struct{}
+
+func NewRunCommand() *RunCommand {
+	return &RunCommand{}
+}
+
+func (cmd *RunCommand) Run(args []string) error {
+	// Find the config from -config, the environment, the default location
+	// or else use the built-in one.
+	path := lookupArg(args, "config")
+	if path == "" {
+		path = os.Getenv(envName("config"))
+	}
+	if path == "" {
+		if _, err := os.Stat(DefaultRunConfigPath); err == nil {
+			path = DefaultRunConfigPath
+		}
+	}
+
+	var config *Config
+	if path != "" {
+		var err error
+		if config, err = ReadConfigFile(path); err != nil {
+			return err
+		}
+		if lookupArg(args, "config") == "" {
+			args = append([]string{"-config", path}, args...)
+		}
+	} else {
+		config = &Config{}
+		if _, err := toml.Decode(defaultRunConfig, config); err != nil {
+			return err
+		}
+		defaultConfig = config
+	}
+
+	mode := os.Getenv(envName("mode"))
+	if mode == "" && config.Mode != nil {
+		mode = *config.Mode
+	}
+
+	healthFile.path = DefaultRunHealthFile
+	if s, ok := os.LookupEnv(envName("health-file")); ok {
+		healthFile.path = s
+	}
+	healthFile.mode = mode
+	writeHealthFile("STARTING=1")
+	defer removeHealthFile()
+
+	// Reap orphaned processes, as init would, when run as PID 1.
+	reapChildren()
+
+	switch mode {
+	case "client":
+		return NewClientCommand().Run(args)
+	case "server":
+		return NewServerCommand().Run(args)
+	case "":
+		return fmt.Errorf("mode required: set mode in the config or %s", envName("mode"))
+	default:
+		return fmt.Errorf("invalid mode: %q", mode)
+	}
+}
+
+// lookupArg returns the value of the named flag in args, if passed.
+func lookupArg(args []string, name string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			return ""
+		}
+		arg = strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-")
+		if arg == name && i+1 < len(args) {
+			return args[i+1]
+		} else if strings.HasPrefix(arg, name+"=") {
+			return strings.TrimPrefix(arg, name+"=")
+		}
+	}
+	return ""
+}
+
+// HealthState is the contents of the run command's health file.
+type HealthState struct {
+	Mode  string    `json:"mode"`
+	State string    `json:"state"`
+	PID   int       `json:"pid"`
+	Time  time.Time `json:"time"`
+}
+
+// healthFile is the path the run command writes state changes to, if set.
+var healthFile struct {
+	mu   sync.Mutex
+	path string
+	mode string
+}
+
+// writeHealthFile records a systemd-style state change, such as "READY=1",
+// as "ready" in the health file, if set. Other notifications, such as
+// watchdog keep-alives, are ignored. The file is replaced atomically so
+// readers never see a partial write.
+func writeHealthFile(state string) {
+	healthFile.mu.Lock()
+	defer healthFile.mu.Unlock()
+	if healthFile.path == "" {
+		return
+	}
+
+	name, _, _ := strings.Cut(state, "=")
+	switch name {
+	case "STARTING", "READY", "RELOADING", "STOPPING":
+	default:
+		return
+	}
+	buf, err := json.Marshal(HealthState{
+		Mode:  healthFile.mode,
+		State: strings.ToLower(name),
+		PID:   os.Getpid(),
+		Time:  time.Now().UTC(),
+	})
+	if err != nil {
+		return
+	}
+
+	tmp := filepath.Join(filepath.Dir(healthFile.path), "."+filepath.Base(healthFile.path)+".tmp")
+	if err := os.WriteFile(tmp, append(buf, '\n'), 0644); err != nil {
+		marionette.Logger.Warn("cannot write health file", zap.Error(err))
+		return
+	} else if err := os.Rename(tmp, healthFile.path); err != nil {
+		marionette.Logger.Warn("cannot write health file", zap.Error(err))
+	}
+}
+
+// removeHealthFile removes the health file, if set, once the command exits.
+func removeHealthFile() {
+	healthFile.mu.Lock()
+	defer healthFile.mu.Unlock()
+	if healthFile.path != "" {
+		os.Remove(healthFile.path)
+	}
+}
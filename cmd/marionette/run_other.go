@@ -0,0 +1,33 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// reapChildren waits for orphaned processes re-parented to this process when
+// it runs as PID 1, such as in a container, so they do not remain zombies.
+// Commands started by run do not start child processes of their own so no
+// status is taken from an os/exec caller.
+func reapChildren() {
+	if os.Getpid() != 1 {
+		return
+	}
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGCHLD)
+	go func() {
+		for range c {
+			for {
+				var status syscall.WaitStatus
+				if pid, err := syscall.Wait4(-1, &status, syscall.WNOHANG, nil); pid <= 0 || err != nil {
+					break
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,4 @@
+package main
+
+// reapChildren does nothing as Windows has no zombie processes.
+func reapChildren() {}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// restrictProcess drops the process's privileges to the user & group, if
+// set, then restricts its system calls if sandbox is true. It must be called
+// once all privileged ports & files are open.
+func restrictProcess(username, group string, sandbox bool) error {
+	if username != "" || group != "" {
+		if err := dropPrivileges(username, group); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "running as uid %d, gid %d\n", os.Getuid(), os.Getgid())
+	}
+
+	if sandbox {
+		if err := enableSandbox(); err != nil {
+			return fmt.Errorf("cannot enable sandbox: %s", err)
+		}
+		fmt.Fprintln(os.Stderr, "sandbox enabled")
+	}
+	return nil
+}
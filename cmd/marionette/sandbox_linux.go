@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// sandboxArches are the audit architectures of the platforms on which the
+// seccomp filter is supported, by GOARCH.
+var sandboxArches = map[string]uint32{
+	"amd64": unix.AUDIT_ARCH_X86_64,
+	"arm64": unix.AUDIT_ARCH_AARCH64,
+}
+
+// sandboxDenied are the system calls which fail with EPERM in the sandbox.
+// They are not needed to proxy traffic but are commonly used to escalate a
+// compromise: running programs, inspecting other processes, changing ids
+// or namespaces, and loading kernel code.
+var sandboxDenied = []uintptr{
+	unix.SYS_EXECVE,
+	unix.SYS_EXECVEAT,
+	unix.SYS_PTRACE,
+	unix.SYS_PROCESS_VM_READV,
+	unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_SETUID,
+	unix.SYS_SETGID,
+	unix.SYS_SETREUID,
+	unix.SYS_SETREGID,
+	unix.SYS_SETRESUID,
+	unix.SYS_SETRESGID,
+	unix.SYS_SETFSUID,
+	unix.SYS_SETFSGID,
+	unix.SYS_SETGROUPS,
+	unix.SYS_CAPSET,
+	unix.SYS_UNSHARE,
+	unix.SYS_SETNS,
+	unix.SYS_MOUNT,
+	unix.SYS_UMOUNT2,
+	unix.SYS_PIVOT_ROOT,
+	unix.SYS_CHROOT,
+	unix.SYS_INIT_MODULE,
+	unix.SYS_FINIT_MODULE,
+	unix.SYS_DELETE_MODULE,
+	unix.SYS_KEXEC_LOAD,
+	unix.SYS_KEXEC_FILE_LOAD,
+	unix.SYS_BPF,
+	unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_USERFAULTFD,
+	unix.SYS_KEYCTL,
+	unix.SYS_ADD_KEY,
+	unix.SYS_REQUEST_KEY,
+	unix.SYS_OPEN_BY_HANDLE_AT,
+	unix.SYS_REBOOT,
+	unix.SYS_SWAPON,
+	unix.SYS_SWAPOFF,
+	unix.SYS_ACCT,
+	unix.SYS_SETTIMEOFDAY,
+	unix.SYS_CLOCK_SETTIME,
+	unix.SYS_SETHOSTNAME,
+	unix.SYS_SETDOMAINNAME,
+}
+
+// cloneNewNamespaces are the clone(2) flags which create namespaces.
+const cloneNewNamespaces = unix.CLONE_NEWNS | unix.CLONE_NEWUTS | unix.CLONE_NEWIPC |
+	unix.CLONE_NEWUSER | unix.CLONE_NEWPID | unix.CLONE_NEWNET | unix.CLONE_NEWCGROUP
+
+// x32SyscallBit is set on the system call numbers of the x32 ABI, which would
+// otherwise bypass the filter on amd64.
+const x32SyscallBit = 0x40000000
+
+// Offsets of the fields of struct seccomp_data. Arguments are read from
+// their low 32 bits on the little-endian platforms supported.
+const (
+	seccompDataNR   = 0
+	seccompDataArch = 4
+	seccompDataArg0 = 16
+)
+
+// enableSandbox installs a seccomp filter on every thread which denies
+// sandboxDenied and clone(2) with namespace flags. clone3(2), whose flags
+// cannot be inspected, fails with ENOSYS so that libc falls back to clone(2).
+// The filter cannot be removed.
+func enableSandbox() error {
+	arch, ok := sandboxArches[runtime.GOARCH]
+	if !ok {
+		return fmt.Errorf("not supported on linux/%s", runtime.GOARCH)
+	}
+	filter := sandboxFilter(arch, runtime.GOARCH == "amd64")
+
+	// Required to install a filter without CAP_SYS_ADMIN.
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return err
+	}
+
+	prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+	if _, _, errno := unix.Syscall(unix.SYS_SECCOMP, unix.SECCOMP_SET_MODE_FILTER, unix.SECCOMP_FILTER_FLAG_TSYNC, uintptr(unsafe.Pointer(&prog))); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// sandboxFilter returns the BPF program of the seccomp filter. System calls
+// of other architectures are denied.
+func sandboxFilter(arch uint32, x32 bool) []unix.SockFilter {
+	deny := unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_ERRNO | uint32(syscall.EPERM)}
+	allow := unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_ALLOW}
+
+	// Instructions jump to the deny instruction at the end, so jump offsets
+	// are computed once the program is complete.
+	var prog []unix.SockFilter
+	var denyJumps []int // indexes of instructions which jump to deny if true
+	load := func(offset uint32) {
+		prog = append(prog, unix.SockFilter{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: offset})
+	}
+	jumpIfDeny := func(code uint16, k uint32) {
+		denyJumps = append(denyJumps, len(prog))
+		prog = append(prog, unix.SockFilter{Code: unix.BPF_JMP | code | unix.BPF_K, K: k})
+	}
+
+	// Deny system calls of other architectures.
+	load(seccompDataArch)
+	prog = append(prog, unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, Jt: 1, K: arch})
+	prog = append(prog, deny)
+
+	load(seccompDataNR)
+	if x32 {
+		jumpIfDeny(unix.BPF_JGE, x32SyscallBit)
+	}
+	prog = append(prog,
+		unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, Jt: 0, Jf: 1, K: unix.SYS_CLONE3},
+		unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_ERRNO | uint32(syscall.ENOSYS)},
+	)
+	for _, nr := range sandboxDenied {
+		jumpIfDeny(unix.BPF_JEQ, uint32(nr))
+	}
+
+	// Deny clone(2) creating namespaces.
+	prog = append(prog, unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, Jt: 0, Jf: 2, K: unix.SYS_CLONE})
+	load(seccompDataArg0)
+	jumpIfDeny(unix.BPF_JSET, cloneNewNamespaces)
+
+	prog = append(prog, allow, deny)
+	for _, i := range denyJumps {
+		prog[i].Jt = uint8(len(prog) - 1 - i - 1)
+	}
+	return prog
+}
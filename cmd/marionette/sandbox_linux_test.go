@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/binary"
+	"syscall"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestSandboxFilter(t *testing.T) {
+	const arch = unix.AUDIT_ARCH_X86_64
+	filter := sandboxFilter(arch, true)
+
+	deny := uint32(unix.SECCOMP_RET_ERRNO | uint32(syscall.EPERM))
+	for _, tt := range []struct {
+		name string
+		arch uint32
+		nr   uint32
+		arg0 uint32
+		ret  uint32
+	}{
+		{"Read", arch, unix.SYS_READ, 0, unix.SECCOMP_RET_ALLOW},
+		{"Socket", arch, unix.SYS_SOCKET, 0, unix.SECCOMP_RET_ALLOW},
+		{"Execve", arch, unix.SYS_EXECVE, 0, deny},
+		{"Ptrace", arch, unix.SYS_PTRACE, 0, deny},
+		{"Setuid", arch, unix.SYS_SETUID, 0, deny},
+		{"Hostname", arch, unix.SYS_SETDOMAINNAME, 0, deny},
+		{"Clone", arch, unix.SYS_CLONE, unix.CLONE_VM | unix.CLONE_THREAD, unix.SECCOMP_RET_ALLOW},
+		{"CloneNewUser", arch, unix.SYS_CLONE, unix.CLONE_NEWUSER, deny},
+		{"CloneNewNet", arch, unix.SYS_CLONE, unix.CLONE_VM | unix.CLONE_NEWNET, deny},
+		{"Clone3", arch, unix.SYS_CLONE3, 0, unix.SECCOMP_RET_ERRNO | uint32(syscall.ENOSYS)},
+		{"X32", arch, x32SyscallBit | unix.SYS_READ, 0, deny},
+		{"OtherArch", unix.AUDIT_ARCH_I386, unix.SYS_READ, 0, deny},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if ret := runSeccompFilter(t, filter, tt.arch, tt.nr, tt.arg0); ret != tt.ret {
+				t.Fatalf("unexpected return: %#x, want %#x", ret, tt.ret)
+			}
+		})
+	}
+}
+
+// Ensure every denied system call is denied.
+func TestSandboxFilter_Denied(t *testing.T) {
+	filter := sandboxFilter(unix.AUDIT_ARCH_X86_64, true)
+	for _, nr := range sandboxDenied {
+		if ret := runSeccompFilter(t, filter, unix.AUDIT_ARCH_X86_64, uint32(nr), 0); ret != unix.SECCOMP_RET_ERRNO|uint32(syscall.EPERM) {
+			t.Fatalf("unexpected return for %d: %#x", nr, ret)
+		}
+	}
+}
+
+// Ensure the x32 check is only included on amd64.
+func TestSandboxFilter_NoX32(t *testing.T) {
+	filter := sandboxFilter(unix.AUDIT_ARCH_AARCH64, false)
+	if ret := runSeccompFilter(t, filter, unix.AUDIT_ARCH_AARCH64, x32SyscallBit|unix.SYS_READ, 0); ret != unix.SECCOMP_RET_ALLOW {
+		t.Fatalf("unexpected return: %#x", ret)
+	}
+}
+
+// runSeccompFilter evaluates the instructions used by sandboxFilter against
+// a struct seccomp_data with the given fields and returns the result.
+func runSeccompFilter(tb testing.TB, prog []unix.SockFilter, arch, nr, arg0 uint32) uint32 {
+	data := make([]byte, 64)
+	binary.LittleEndian.PutUint32(data[seccompDataNR:], nr)
+	binary.LittleEndian.PutUint32(data[seccompDataArch:], arch)
+	binary.LittleEndian.PutUint32(data[seccompDataArg0:], arg0)
+
+	var acc uint32
+	for pc := 0; pc < len(prog); pc++ {
+		ins := prog[pc]
+		switch ins.Code {
+		case unix.BPF_LD | unix.BPF_W | unix.BPF_ABS:
+			acc = binary.LittleEndian.Uint32(data[ins.K:])
+		case unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K:
+			pc += jump(acc == ins.K, ins)
+		case unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K:
+			pc += jump(acc >= ins.K, ins)
+		case unix.BPF_JMP | unix.BPF_JSET | unix.BPF_K:
+			pc += jump(acc&ins.K != 0, ins)
+		case unix.BPF_RET | unix.BPF_K:
+			return ins.K
+		default:
+			tb.Fatalf("unexpected instruction at %d: %#v", pc, ins)
+		}
+	}
+	tb.Fatal("program did not return")
+	return 0
+}
+
+// jump returns the offset of a conditional jump instruction.
+func jump(cond bool, ins unix.SockFilter) int {
+	if cond {
+		return int(ins.Jt)
+	}
+	return int(ins.Jf)
+}
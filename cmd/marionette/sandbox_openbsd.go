@@ -0,0 +1,12 @@
+package main
+
+import "golang.org/x/sys/unix"
+
+// sandboxPromises are the pledge(2) promises needed to serve connections,
+// resolve & dial destinations, and read & write formats, logs & traces.
+const sandboxPromises = "stdio rpath wpath cpath inet unix dns"
+
+// enableSandbox restricts the process to sandboxPromises.
+func enableSandbox() error {
+	return unix.Pledge(sandboxPromises, "")
+}
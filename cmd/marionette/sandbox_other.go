@@ -0,0 +1,11 @@
+//go:build !linux && !openbsd
+// +build !linux,!openbsd
+
+package main
+
+import "errors"
+
+// enableSandbox is only supported on Linux & OpenBSD.
+func enableSandbox() error {
+	return errors.New("not supported on this platform")
+}
@@ -2,17 +2,20 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"os"
-	"os/signal"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/armon/go-socks5"
 	"github.com/redjack/marionette"
-	"github.com/redjack/marionette/fte"
 	"github.com/redjack/marionette/mar"
 	_ "github.com/redjack/marionette/plugins"
 	"go.uber.org/zap"
@@ -28,11 +31,51 @@ func (cmd *ServerCommand) Run(args []string) error {
 	// Parse arguments.
 	fs := NewFlagSet("marionette-server", flag.ContinueOnError)
 	var (
-		bind      = fs.String("bind", "", "Bind address")
+		bind      = fs.String("bind", "", "Bind IP address (default all IPv4 & IPv6 interfaces)")
 		useSocks5 = fs.Bool("socks5", false, "Enable socks5 proxying")
-		proxyAddr = fs.String("proxy", "", "Proxy IP and port")
-		format    = fs.String("format", "", "Format name and version")
+		tunnel    = fs.Bool("tunnel", false, "Connect streams to destinations requested by the client")
+		proxyAddr = fs.String("proxy", "", "Proxy IP and port, or unix:///path socket")
+		aclPath   = fs.String("acl", "", "Path to destination ACL file for -tunnel & -socks5")
+		policies  = fs.String("policy", "", "Path to a policy file which denies, decoys, throttles or changes the format of clients by -geoip country & ASN")
+		geoipDBs  = fs.String("geoip", "", "Comma-separated MaxMind country & ASN database files used by -policy")
+		proxyProt = fs.Int("proxy-protocol", 0, "Send a PROXY protocol header of this version (1 or 2) to the -proxy address")
+		format    = fs.String("format", "", "Format name and version, or a comma-separated list sharing client sessions")
+		reverse   = fs.String("reverse-bind", "", "Public bind address whose connections are relayed to services of clients running -reverse")
+		stdio     = fs.Bool("stdio", false, "Accept a single cover connection over stdin & stdout instead of listening, such as inside another tunnel")
+		websocket = fs.String("websocket", "", "Bind address of an HTTP server accepting WebSocket clients, such as browsers, at /<format>")
+		authKey   = fs.String("auth-secret", "", "Bridge secret clients must prove knowledge of before being served, from bridge-line -new-secret")
+		decoy     = fs.String("decoy", "", "Address of a real service, such as a web server, which connections failing -auth-secret are relayed to")
+		credsPath = fs.String("credentials", "", "Credential database of a private bridge's users, from the credential command")
+		replayLog = fs.String("replay-cache", "", "File persisting the handshakes seen so that replays are rejected across restarts")
+		usageLog  = fs.String("usage", "", "File persisting the bytes used by each -credentials user towards their quota across restarts")
+		randHello = fs.Bool("randomize-handshake", false, "Randomize the size & timing of each connection's first messages, seeded from -auth-secret")
+		decoyWait = fs.Duration("decoy-timeout", marionette.DefaultDecoyTimeout, "Time a connection has to authenticate before being relayed to -decoy (0 disables)")
+		admin     = fs.String("admin", "", "Admin API bind address or unix:///path socket")
+		adminTok  = fs.String("admin-token", "", "Bearer token required by the admin API")
+		health    = fs.String("health", "", "Health endpoint bind address or unix:///path socket, serving /healthz")
+		healthInt = fs.Duration("health-interval", DefaultHealthInterval, "Time between handshake self-tests of each format for /healthz (0 disables)")
+		shutdown  = fs.Duration("shutdown-timeout", DefaultShutdownTimeout, "Time open streams may drain after SIGINT or SIGTERM before exiting")
+		username  = fs.String("user", "", "User name or id to run as once listening")
+		group     = fs.String("group", "", "Group name or id to run as once listening (default the -user's group)")
+		sandbox   = fs.Bool("sandbox", false, "Restrict system calls once listening (linux & openbsd only)")
 		verbose   = fs.Bool("v", false, "Debug logging enabled")
+
+		rateLimit       = fs.Int("rate-limit", 0, "Limit bytes per second for all clients (0 is unlimited)")
+		clientRateLimit = fs.Int("client-rate-limit", 0, "Limit bytes per second for each client IP (0 is unlimited)")
+		streamRateLimit = fs.Int("stream-rate-limit", 0, "Limit bytes per second for each stream (0 is unlimited)")
+
+		maxConns        = fs.Int("max-conns", 0, "Maximum client connections served at once (0 is unlimited)")
+		maxPendingConns = fs.Int("max-pending-conns", 0, "Maximum connections waiting for -max-conns before new ones are closed")
+		maxClientConns  = fs.Int("max-client-conns", 0, "Maximum connections from each client IP (0 is unlimited)")
+		maxStreams      = fs.Int("max-streams", 0, "Maximum streams proxied at once (0 is unlimited)")
+
+		detectProbes   = fs.Bool("detect-probes", false, "Publish a probe event for each failed handshake which looks like an active probe")
+		probeThreshold = fs.Int("probe-alert-threshold", 0, "Probes within -probe-alert-window after which a probe.alert event is published (0 disables alerts)")
+		probeWindow    = fs.Duration("probe-alert-window", marionette.DefaultProbeAlertWindow, "Time in which probes count towards -probe-alert-threshold")
+
+		banThreshold = fs.Int("ban-threshold", 0, "Failed handshakes within -ban-window after which a client IP is banned (0 disables bans)")
+		banWindow    = fs.Duration("ban-window", marionette.DefaultBanWindow, "Time in which failed handshakes count towards -ban-threshold")
+		banDuration  = fs.Duration("ban-duration", marionette.DefaultBanDuration, "Time a client IP stays banned")
 	)
 	if err := fs.Parse(args); err != nil {
 		return err
@@ -41,74 +84,446 @@ func (cmd *ServerCommand) Run(args []string) error {
 	// Validate arguments.
 	if *format == "" {
 		return errors.New("format required")
-	} else if !*useSocks5 && *proxyAddr == "" {
+	} else if !*useSocks5 && !*tunnel && *proxyAddr == "" && *reverse == "" {
 		return errors.New("proxy address required")
+	} else if *proxyProt != 0 && *proxyProt != marionette.ProxyProtocolV1 && *proxyProt != marionette.ProxyProtocolV2 {
+		return fmt.Errorf("invalid proxy protocol version: %d", *proxyProt)
+	} else if *maxConns < 0 || *maxPendingConns < 0 || *maxClientConns < 0 || *maxStreams < 0 {
+		return errors.New("connection limits must not be negative")
+	} else if *banThreshold < 0 || (*banThreshold > 0 && (*banWindow <= 0 || *banDuration <= 0)) {
+		return errors.New("ban threshold must not be negative and ban window & duration must be positive")
+	} else if *decoy != "" && *authKey == "" {
+		return errors.New("decoy requires auth-secret")
+	} else if *credsPath != "" && *authKey == "" {
+		return errors.New("credentials requires auth-secret")
+	} else if *usageLog != "" && *credsPath == "" {
+		return errors.New("usage requires credentials")
+	} else if *randHello && *authKey == "" {
+		return errors.New("randomize-handshake requires auth-secret")
+	} else if *probeThreshold < 0 || (*probeThreshold > 0 && (!*detectProbes || *probeWindow <= 0)) {
+		return errors.New("probe alert threshold requires detect-probes and must not be negative, and probe alert window must be positive")
+	} else if *geoipDBs != "" && *policies == "" {
+		return errors.New("geoip requires policy")
+	} else if *decoyWait < 0 {
+		return errors.New("decoy timeout must not be negative")
 	}
 
-	// Read MAR file.
-	data, err := mar.ReadFormat(*format)
-	if os.IsNotExist(err) {
-		return fmt.Errorf("MAR document not found: %s", *format)
-	} else if err != nil {
+	secret, err := parseAuthSecret(*authKey)
+	if err != nil {
 		return err
+	} else if secret != nil && len(secret) != marionette.AuthSecretSize {
+		return errors.New("auth-secret must be a bridge secret, not a user credential")
+	}
+	var profile *marionette.HandshakeProfile
+	if *randHello {
+		profile = marionette.NewHandshakeProfile(secret)
 	}
 
-	// Parse document.
-	doc, err := mar.Parse(marionette.PartyServer, data)
+	// Read the users of a private bridge, if specified, and count their usage
+	// towards their quotas.
+	var creds *marionette.Credentials
+	if *credsPath != "" {
+		if creds, err = marionette.ReadCredentialsFile(*credsPath); err != nil {
+			return err
+		}
+		creds.Usage = marionette.NewUsage()
+		if *usageLog != "" {
+			if creds.Usage, err = marionette.OpenUsage(*usageLog); err != nil {
+				return err
+			}
+			defer creds.Usage.Close()
+		}
+	}
+
+	// Read & parse MAR files.
+	docs, err := readFormats(marionette.PartyServer, *format)
 	if err != nil {
 		return err
+	} else if *stdio && len(docs) > 1 {
+		return errors.New("stdio requires a single format")
 	}
 
-	// Set logger if verbose.
-	fte.Verbose = *verbose
-	if *verbose {
-		config := zap.NewDevelopmentConfig()
-		config.DisableStacktrace = true
-		marionette.Logger, _ = config.Build()
-	} else {
-		config := zap.NewProductionConfig()
-		config.DisableStacktrace = true
-		marionette.Logger, _ = config.Build()
+	// Read the client policy & the databases locating clients, if specified.
+	var policy *marionette.Policy
+	if *policies != "" {
+		if policy, err = readPolicy(*policies, docs); err != nil {
+			return err
+		}
+		if paths := strings.Split(*geoipDBs, ","); *geoipDBs != "" {
+			db, err := marionette.OpenMaxMindDB(paths...)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			policy.Geo = db
+		}
+	}
+
+	// Read destination ACL, if specified.
+	var acl *marionette.ACL
+	if *aclPath != "" {
+		if acl, err = marionette.ReadACLFile(*aclPath); err != nil {
+			return err
+		}
+	}
+
+	// Set up logging, with debug logging if verbose.
+	closeCrashReport, err := fs.setupCommandLogging(*verbose)
+	if err != nil {
+		return err
+	}
+	defer closeCrashReport()
+
+	// Publish connection & stream events to -events sinks, if any.
+	closeEvents, err := fs.setupEvents()
+	if err != nil {
+		return err
+	}
+	defer closeEvents()
+
+	// Export spans to an OpenTelemetry collector, if enabled.
+	shutdownTracing, err := fs.setupTracing()
+	if err != nil {
+		return err
 	}
+	defer shutdownTracing()
 
-	// Start listener.
-	ln, err := marionette.Listen(doc, *bind)
+	// Record cover traffic to a pcap file, if enabled.
+	capture, closeCapture, err := fs.openCapture()
 	if err != nil {
 		return err
 	}
-	ln.TracePath = fs.TracePath
+	defer closeCapture()
 
-	// Start proxy.
-	proxy := marionette.NewServerProxy(ln)
+	// Build socks5 server shared by all formats, if enabled.
+	var socks5Server *socks5.Server
 	if *useSocks5 {
-		if proxy.Socks5Server, err = socks5.New(&socks5.Config{
+		config := &socks5.Config{
 			Logger: log.New(&socks5LogWriter{}, "", 0),
-		}); err != nil {
+			Dial: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				conn, err := (&net.Dialer{}).DialContext(ctx, network, addr)
+				if err == nil {
+					fs.SocketOptions.Apply(conn)
+				}
+				return conn, err
+			},
+		}
+		if acl != nil {
+			config.Rules = &socks5ACLRuleSet{acl: acl}
+		}
+		if socks5Server, err = socks5.New(config); err != nil {
 			return err
 		}
-	} else {
-		proxy.Addr = *proxyAddr
 	}
-	if err := proxy.Open(); err != nil {
+	if acl == nil && (*useSocks5 || *tunnel) {
+		marionette.Logger.Warn("no -acl specified, clients may connect to any destination")
+	}
+
+	// Use sockets passed by systemd socket activation, if any, for formats
+	// and the reverse bind address with matching ports.
+	sockets, err := listenSystemd()
+	if err != nil {
 		return err
 	}
 
-	// Notify user that proxy is ready.
-	if proxy.Socks5Server != nil {
-		fmt.Printf("listening on %s, proxying via socks5\n", ln.Addr().String())
-	} else {
-		fmt.Printf("listening on %s, proxying to %s\n", ln.Addr().String(), *proxyAddr)
+	// Start a listener & proxy for each format. Listeners share sessions so
+	// that multipath clients can join connections across formats, and share
+	// the handshakes seen so that one can't be replayed on another format.
+	sessions := marionette.NewSessionTable()
+	replays := marionette.NewReplayCache()
+	if *replayLog != "" {
+		if replays, err = marionette.OpenReplayCache(*replayLog); err != nil {
+			return err
+		}
+		defer replays.Close()
 	}
 
-	// Wait for signal.
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
-	<-c
-	fmt.Fprintln(os.Stderr, "received interrupt, shutting down...")
+	// Settings which protect the server are passed as options so that they
+	// apply from the first connection accepted.
+	opts := []marionette.Option{
+		marionette.WithAuthSecret(secret),
+		marionette.WithCredentials(creds),
+		marionette.WithDecoy(*decoy, *decoyWait),
+		marionette.WithConnLimits(*maxConns, *maxPendingConns, *maxClientConns),
+		marionette.WithPolicy(policy),
+		marionette.WithHandshakeProfile(profile),
+	}
+	if *detectProbes {
+		opts = append(opts, marionette.WithProbeDetection(*probeThreshold, *probeWindow))
+	}
 
+	var listeners []*marionette.Listener
+	for _, doc := range docs {
+		var ln *marionette.Listener
+		if *stdio {
+			ln = marionette.NewListener(marionette.NewConnListener(openStdio()), doc, opts...)
+		} else if sock := sockets.take(doc.Port); sock != nil {
+			ln = marionette.NewListener(sock, doc, opts...)
+		} else if ln, err = marionette.Listen(doc, *bind, opts...); err != nil {
+			return err
+		}
+		listeners = append(listeners, ln)
+	}
+
+	// Also accept WebSocket clients of each format, if enabled.
+	if *websocket != "" {
+		wsListeners, err := listenWebSocket(*websocket, formatNames(*format), docs, opts...)
+		if err != nil {
+			return err
+		}
+		listeners = append(listeners, wsListeners...)
+	}
+
+	for _, ln := range listeners {
+		ln.Sessions = sessions
+		ln.Replays = replays
+		ln.TracePath = fs.TracePath
+		ln.RateLimit = *rateLimit
+		ln.ClientRateLimit = *clientRateLimit
+		ln.StreamRateLimit = *streamRateLimit
+		ln.StreamIdleTimeout = fs.StreamIdleTimeout
+		ln.StreamMaxLifetime = fs.StreamMaxLifetime
+		ln.SocketOptions = &fs.SocketOptions
+		ln.Segmentation = fs.segmentation()
+		ln.Capture = capture
+		ln.BanThreshold = *banThreshold
+		ln.BanWindow = *banWindow
+		ln.BanDuration = *banDuration
+
+		proxy := marionette.NewServerProxy(ln)
+		if socks5Server != nil {
+			proxy.Socks5Server = socks5Server
+		} else {
+			proxy.Addr = *proxyAddr
+		}
+		proxy.SocketOptions = &fs.SocketOptions
+		proxy.MaxStreams = *maxStreams
+		proxy.ProxyProtocol = *proxyProt
+		proxy.AllowDestinations = *tunnel
+		proxy.ACL = acl
+		if err := proxy.Open(); err != nil {
+			return err
+		}
+
+		// Notify user that proxy is ready.
+		if proxy.Socks5Server != nil {
+			fmt.Printf("listening on %s, proxying via socks5\n", ln.Addr().String())
+		} else if *proxyAddr == "" && *reverse != "" {
+			fmt.Printf("listening on %s\n", ln.Addr().String())
+		} else if *proxyAddr == "" {
+			fmt.Printf("listening on %s, proxying to client destinations\n", ln.Addr().String())
+		} else {
+			fmt.Printf("listening on %s, proxying to %s\n", ln.Addr().String(), *proxyAddr)
+		}
+	}
+
+	// Relay public connections to client services, if enabled.
+	var publicLn net.Listener
+	if *reverse != "" {
+		if _, port, err := net.SplitHostPort(*reverse); err == nil {
+			publicLn = sockets.take(port)
+		}
+		if publicLn == nil {
+			if publicLn, err = marionette.ListenAddr(*reverse); err != nil {
+				return err
+			}
+		}
+		proxy := marionette.NewReverseServerProxy(publicLn, listeners...)
+		proxy.SocketOptions = &fs.SocketOptions
+		if err := proxy.Open(); err != nil {
+			return err
+		}
+		fmt.Printf("listening on %s, relaying to client services\n", publicLn.Addr().String())
+	}
+
+	// Serve admin API, if enabled.
+	if *admin != "" {
+		h := &AdminHandler{
+			Conns: func() []marionette.ConnInfo {
+				var infos []marionette.ConnInfo
+				for _, ln := range listeners {
+					infos = append(infos, ln.Conns()...)
+				}
+				return infos
+			},
+			CloseConn: func(id int) error {
+				for _, ln := range listeners {
+					if err := ln.CloseConn(id); err != marionette.ErrConnNotFound {
+						return err
+					}
+				}
+				return marionette.ErrConnNotFound
+			},
+			Clients: func() []marionette.ClientStats {
+				return mergeClientStats(listeners)
+			},
+			Unban: func(ip string) error {
+				err := marionette.ErrClientNotFound
+				for _, ln := range listeners {
+					if ln.Unban(ip) == nil {
+						err = nil
+					}
+				}
+				return err
+			},
+			Reload: func() error {
+				docs, err := readFormats(marionette.PartyServer, *format)
+				if err != nil {
+					return err
+				}
+				for i, ln := range listeners {
+					if err := ln.SetDocument(docs[i]); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		}
+		if creds != nil {
+			h.Usage = func() []marionette.UserUsage { return creds.UsageStats(time.Now()) }
+			h.ResetUsage = creds.ResetUsage
+		}
+		if err := fs.serveAdmin(*admin, *adminTok, h); err != nil {
+			return err
+		}
+	}
+
+	// Serve health endpoint, if enabled, until shutdown.
+	healthCtx, stopHealth := context.WithCancel(context.Background())
+	defer stopHealth()
+	if *health != "" {
+		h, err := NewHealthHandler(formatNames(*format), listeners[:len(docs)])
+		if err != nil {
+			return err
+		} else if err := fs.serveHealth(healthCtx, *health, *healthInt, h); err != nil {
+			return err
+		}
+	}
+
+	// Apply log levels, the ACL, credentials, policy & rate limits from the
+	// config file on SIGHUP. The ACL, credential & policy files are re-read
+	// even if their paths are unchanged.
+	handleReload(func() error {
+		if err := fs.reload("acl", "credentials", "policy", "rate-limit", "client-rate-limit", "stream-rate-limit"); err != nil {
+			return err
+		}
+
+		if (*aclPath == "") != (acl == nil) {
+			return errors.New("cannot add or remove -acl without restarting")
+		} else if acl != nil {
+			other, err := marionette.ReadACLFile(*aclPath)
+			if err != nil {
+				return err
+			}
+			acl.SetRules(other.Rules)
+		}
+
+		if (*policies == "") != (policy == nil) {
+			return errors.New("cannot add or remove -policy without restarting")
+		} else if policy != nil {
+			other, err := readPolicy(*policies, docs)
+			if err != nil {
+				return err
+			}
+			policy.SetRules(other.Rules)
+		}
+
+		if (*credsPath == "") != (creds == nil) {
+			return errors.New("cannot add or remove -credentials without restarting")
+		} else if creds != nil {
+			other, err := marionette.ReadCredentialsFile(*credsPath)
+			if err != nil {
+				return err
+			}
+			creds.SetUsers(other.Users)
+		}
+
+		for _, ln := range listeners {
+			ln.SetRateLimits(*rateLimit, *clientRateLimit, *streamRateLimit)
+		}
+		return nil
+	})
+
+	for _, sock := range sockets {
+		marionette.Logger.Warn("closing unused systemd socket", zap.String("addr", sock.Addr().String()))
+	}
+	sockets.Close()
+
+	// Drop privileges & restrict system calls, if enabled, now that all
+	// ports are bound.
+	if err := restrictProcess(*username, *group, *sandbox); err != nil {
+		return err
+	}
+
+	// Notify systemd that the server is ready & keep its watchdog alive
+	// while all listeners are accepting connections.
+	sdNotify("READY=1")
+	startWatchdog(func() error {
+		for _, ln := range listeners {
+			if err := ln.Err(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	// Wait for signal, then stop accepting connections and let the streams
+	// of connected clients drain.
+	waitForSignal()
+	sdNotify("STOPPING=1")
+	stopHealth()
+	if publicLn != nil {
+		publicLn.Close()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdown)
+	defer cancel()
+
+	errs := make(chan error, len(listeners))
+	for _, ln := range listeners {
+		go func(ln *marionette.Listener) { errs <- ln.Shutdown(ctx) }(ln)
+	}
+	var timedOut bool
+	for range listeners {
+		if err := <-errs; err == context.DeadlineExceeded {
+			timedOut = true
+		}
+	}
+	if timedOut {
+		fmt.Fprintln(os.Stderr, "shutdown timeout, closing open streams")
+	}
 	return nil
 }
 
+// readPolicy reads a policy file and checks that its formats can be served on
+// the listeners of docs.
+func readPolicy(path string, docs []*mar.Document) (*marionette.Policy, error) {
+	policy, err := marionette.ReadPolicyFile(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range policy.Rules {
+		for _, doc := range docs {
+			if rule.Doc != nil && rule.Doc.Transport != doc.Transport {
+				return nil, fmt.Errorf("policy format %s must use the %s transport of %s", rule.Format, doc.Transport, doc.Format)
+			}
+		}
+	}
+	return policy, nil
+}
+
+// socks5ACLRuleSet allows socks5 CONNECT requests to destinations allowed by an ACL.
+type socks5ACLRuleSet struct {
+	acl *marionette.ACL
+}
+
+func (s *socks5ACLRuleSet) Allow(ctx context.Context, req *socks5.Request) (context.Context, bool) {
+	if req.Command != socks5.ConnectCommand {
+		return ctx, false
+	}
+	return ctx, s.acl.Allowed(req.DestAddr.FQDN, req.DestAddr.IP, req.DestAddr.Port)
+}
+
 // socks5LogWriter converts errors to use zap. Also drops some expected errors.
 type socks5LogWriter struct {
 	w io.Writer
@@ -116,7 +531,7 @@ type socks5LogWriter struct {
 
 func (w *socks5LogWriter) Write(p []byte) (n int, err error) {
 	p = bytes.TrimPrefix(p, []byte("[ERR] socks: Failed to handle request: "))
-	logger := marionette.Logger.With(zap.String("service", "socks5"))
+	logger := marionette.Logger.Named(marionette.LoggerProxy).With(zap.String("service", "socks5"))
 
 	switch {
 	case bytes.Contains(p, []byte("connection reset by peer")),
@@ -127,3 +542,41 @@ func (w *socks5LogWriter) Write(p []byte) (n int, err error) {
 	}
 	return 0, nil
 }
+
+// mergeClientStats combines the statistics of each client IP across listeners,
+// sorted by address.
+func mergeClientStats(listeners []*marionette.Listener) []marionette.ClientStats {
+	m := make(map[string]*marionette.ClientStats)
+	var addrs []string
+	for _, ln := range listeners {
+		for _, cs := range ln.ClientStats() {
+			other := m[cs.Addr]
+			if other == nil {
+				cs := cs
+				m[cs.Addr] = &cs
+				addrs = append(addrs, cs.Addr)
+				continue
+			}
+
+			other.Conns += cs.Conns
+			other.Rejected += cs.Rejected
+			other.ActiveConns += cs.ActiveConns
+			other.HandshakeFailures += cs.HandshakeFailures
+			other.BytesRead += cs.BytesRead
+			other.BytesWritten += cs.BytesWritten
+			if cs.LastSeen.After(other.LastSeen) {
+				other.LastSeen = cs.LastSeen
+			}
+			if cs.BannedUntil != nil && (other.BannedUntil == nil || cs.BannedUntil.After(*other.BannedUntil)) {
+				other.BannedUntil = cs.BannedUntil
+			}
+		}
+	}
+
+	sort.Strings(addrs)
+	a := make([]marionette.ClientStats, len(addrs))
+	for i, addr := range addrs {
+		a[i] = *m[addr]
+	}
+	return a
+}
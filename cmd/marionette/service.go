@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+)
+
+// DefaultServiceName is the default name of the installed Windows service.
+const DefaultServiceName = "marionette"
+
+// ServiceCommand installs & controls marionette as a Windows service which
+// runs another command, such as the client, in the background.
+type ServiceCommand struct{}
+
+func NewServiceCommand() *ServiceCommand {
+	return &ServiceCommand{}
+}
+
+func (cmd *ServiceCommand) Run(args []string) error {
+	name, action, args, err := cmd.parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "install":
+		return installService(name, args)
+	case "uninstall":
+		return removeService(name)
+	case "start":
+		return startService(name)
+	case "stop":
+		return stopService(name)
+	default: // "run"
+		return runService(name, args)
+	}
+}
+
+// parseArgs returns the service name, the action & the arguments following
+// the action, such as the command to install.
+func (cmd *ServiceCommand) parseArgs(args []string) (name, action string, actionArgs []string, err error) {
+	fs := flag.NewFlagSet("marionette-service", flag.ContinueOnError)
+	nameFlag := fs.String("name", DefaultServiceName, "Windows service name")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, cmd.Usage())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return "", "", nil, err
+	} else if fs.NArg() == 0 {
+		fs.Usage()
+		return "", "", nil, flag.ErrHelp
+	}
+
+	action, actionArgs = fs.Arg(0), fs.Args()[1:]
+	switch action {
+	case "install":
+		if len(actionArgs) == 0 {
+			return "", "", nil, errors.New("command required, such as: client -config PATH")
+		}
+	case "uninstall", "start", "stop", "run":
+	default:
+		return "", "", nil, fmt.Errorf("unknown service action: %q", action)
+	}
+	return *nameFlag, action, actionArgs, nil
+}
+
+func (cmd *ServiceCommand) Usage() string {
+	return `
+Usage:
+
+	marionette service [-name NAME] install COMMAND [arguments]
+	marionette service [-name NAME] uninstall|start|stop
+
+Installs a Windows service which runs COMMAND, such as "client", when the
+system starts. Use absolute paths in the arguments as services do not run in
+the current directory.
+`[1:]
+}
@@ -0,0 +1,16 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import "errors"
+
+// errServiceUnsupported is returned by the service command on platforms
+// without Windows services. Use a service manager such as systemd instead.
+var errServiceUnsupported = errors.New("windows services are not supported on this platform")
+
+func installService(name string, args []string) error { return errServiceUnsupported }
+func removeService(name string) error                 { return errServiceUnsupported }
+func startService(name string) error                  { return errServiceUnsupported }
+func stopService(name string) error                   { return errServiceUnsupported }
+func runService(name string, args []string) error     { return errServiceUnsupported }
@@ -0,0 +1,21 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import "testing"
+
+// Ensure every action fails without Windows services once arguments parse.
+func TestServiceCommand_Run_ErrServiceUnsupported(t *testing.T) {
+	for _, args := range [][]string{
+		{"install", "client"},
+		{"uninstall"},
+		{"start"},
+		{"stop"},
+		{"run", "client"},
+	} {
+		if err := NewServiceCommand().Run(args); err != errServiceUnsupported {
+			t.Fatalf("%q: unexpected error: %v", args, err)
+		}
+	}
+}
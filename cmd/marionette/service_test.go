@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+)
+
+func TestServiceCommand_ParseArgs(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		args       []string
+		svcName    string
+		action     string
+		actionArgs []string
+		err        string
+	}{
+		{name: "Install", args: []string{"install", "client", "-config", `C:\marionette.toml`}, svcName: "marionette", action: "install", actionArgs: []string{"client", "-config", `C:\marionette.toml`}},
+		{name: "InstallName", args: []string{"-name", "bridge", "install", "server", "-format", "http_simple_blocking"}, svcName: "bridge", action: "install", actionArgs: []string{"server", "-format", "http_simple_blocking"}},
+		{name: "Uninstall", args: []string{"-name", "bridge", "uninstall"}, svcName: "bridge", action: "uninstall", actionArgs: []string{}},
+		{name: "Start", args: []string{"start"}, svcName: "marionette", action: "start", actionArgs: []string{}},
+		{name: "Stop", args: []string{"stop"}, svcName: "marionette", action: "stop", actionArgs: []string{}},
+		{name: "Run", args: []string{"-name", "bridge", "run", "client", "-v"}, svcName: "bridge", action: "run", actionArgs: []string{"client", "-v"}},
+		{name: "ErrCommandRequired", args: []string{"install"}, err: "command required, such as: client -config PATH"},
+		{name: "ErrUnknownAction", args: []string{"restart"}, err: `unknown service action: "restart"`},
+		{name: "ErrFlag", args: []string{"-names", "bridge", "start"}, err: "flag provided but not defined: -names"},
+		{name: "ErrHelp", args: nil, err: flag.ErrHelp.Error()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			name, action, actionArgs, err := NewServiceCommand().parseArgs(tt.args)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if name != tt.svcName {
+				t.Fatalf("unexpected name: %q", name)
+			} else if action != tt.action {
+				t.Fatalf("unexpected action: %q", action)
+			} else if !reflect.DeepEqual(actionArgs, tt.actionArgs) {
+				t.Fatalf("unexpected args: %q", actionArgs)
+			}
+		})
+	}
+}
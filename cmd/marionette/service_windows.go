@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+// installService registers a service which starts automatically and runs
+// marionette with args.
+func installService(name string, args []string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	m, err := mgr.Connect()
+	if err != nil {
+		return err
+	}
+	defer m.Disconnect()
+
+	if s, err := m.OpenService(name); err == nil {
+		s.Close()
+		return fmt.Errorf("service already installed: %s", name)
+	}
+
+	s, err := m.CreateService(name, exe, mgr.Config{
+		DisplayName: "Marionette (" + name + ")",
+		Description: "Marionette programmable proxy: " + args[0],
+		StartType:   mgr.StartAutomatic,
+	}, append([]string{"service", "-name", name, "run"}, args...)...)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	fmt.Fprintf(os.Stderr, "installed service %s\n", name)
+	return nil
+}
+
+// removeService deletes the service. A running service is removed once it stops.
+func removeService(name string) error {
+	return withService(name, func(s *mgr.Service) error { return s.Delete() })
+}
+
+// startService asks the service manager to start the service.
+func startService(name string) error {
+	return withService(name, func(s *mgr.Service) error { return s.Start() })
+}
+
+// stopService asks the service to stop and waits until it has.
+func stopService(name string) error {
+	return withService(name, func(s *mgr.Service) error {
+		status, err := s.Control(svc.Stop)
+		if err != nil {
+			return err
+		}
+		for status.State != svc.Stopped {
+			time.Sleep(500 * time.Millisecond)
+			if status, err = s.Query(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// withService calls fn with the named service.
+func withService(name string, fn func(s *mgr.Service) error) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return err
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(name)
+	if err != nil {
+		return fmt.Errorf("cannot open service %s: %s", name, err)
+	}
+	defer s.Close()
+	return fn(s)
+}
+
+// runService runs the command in args when started by the service manager.
+func runService(name string, args []string) error {
+	if interactive, err := svc.IsAnInteractiveSession(); err != nil {
+		return err
+	} else if interactive {
+		return errors.New("service run must be started by the service manager")
+	}
+	return svc.Run(name, &serviceHandler{args: args})
+}
+
+// serviceHandler runs a command as a service. A stop request is handled the
+// same as a shutdown signal so that open streams can drain.
+type serviceHandler struct {
+	args []string
+}
+
+func (h *serviceHandler) Execute(_ []string, r <-chan svc.ChangeRequest, changes chan<- svc.Status) (bool, uint32) {
+	changes <- svc.Status{State: svc.StartPending}
+
+	errs := make(chan error, 1)
+	go func() { errs <- run(h.args) }()
+
+	const accepts = svc.AcceptStop | svc.AcceptShutdown
+	changes <- svc.Status{State: svc.Running, Accepts: accepts}
+
+	for {
+		select {
+		case err := <-errs:
+			if err != nil {
+				marionette.Logger.Error("service stopped", zap.Error(err))
+				return true, 1
+			}
+			return false, 0
+
+		case req := <-r:
+			switch req.Cmd {
+			case svc.Interrogate:
+				changes <- req.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				changes <- svc.Status{State: svc.StopPending}
+				requestStop()
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"os"
+	"reflect"
+	"syscall"
+	"testing"
+)
+
+func TestSignals(t *testing.T) {
+	if !reflect.DeepEqual(shutdownSignals, []os.Signal{os.Interrupt, syscall.SIGTERM}) {
+		t.Fatalf("unexpected shutdown signals: %v", shutdownSignals)
+	} else if !reflect.DeepEqual(reloadSignals, []os.Signal{syscall.SIGHUP}) {
+		t.Fatalf("unexpected reload signals: %v", reloadSignals)
+	}
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// shutdownSignals gracefully stop the client & server. Interrupt is sent on
+// Ctrl-C & Ctrl-Break, and SIGTERM when the console window is closed or the
+// user logs off.
+var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// reloadSignals is empty as Windows has no hangup signal. Use the admin API
+// to reload formats instead.
+var reloadSignals []os.Signal
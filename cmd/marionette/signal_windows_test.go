@@ -0,0 +1,22 @@
+package main
+
+import (
+	"os"
+	"reflect"
+	"syscall"
+	"testing"
+)
+
+// Ensure SIGHUP, which Windows never sends, is in neither set.
+func TestSignals(t *testing.T) {
+	if !reflect.DeepEqual(shutdownSignals, []os.Signal{os.Interrupt, syscall.SIGTERM}) {
+		t.Fatalf("unexpected shutdown signals: %v", shutdownSignals)
+	} else if len(reloadSignals) != 0 {
+		t.Fatalf("unexpected reload signals: %v", reloadSignals)
+	}
+	for _, sig := range append(shutdownSignals, reloadSignals...) {
+		if sig == syscall.SIGHUP {
+			t.Fatal("unexpected SIGHUP")
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/redjack/marionette"
+	_ "github.com/redjack/marionette/plugins"
+	"github.com/redjack/marionette/transport"
+	"go.uber.org/zap"
+)
+
+// SIP003Command runs marionette as a transport plugin of V2Ray, Xray or
+// Shadowsocks using the SIP003 plugin interface. The proxy passes addresses
+// & options in SS_* environment variables, which flags may override.
+type SIP003Command struct{}
+
+func NewSIP003Command() *SIP003Command {
+	return &SIP003Command{}
+}
+
+func (cmd *SIP003Command) Run(args []string) error {
+	fs := NewFlagSet("marionette-sip003", flag.ContinueOnError)
+	var (
+		local   = fs.String("local", sip003Addr("SS_LOCAL_HOST", "SS_LOCAL_PORT"), "Address of the proxy's side of the plugin (default from SS_LOCAL_HOST & SS_LOCAL_PORT)")
+		remote  = fs.String("remote", sip003Addr("SS_REMOTE_HOST", "SS_REMOTE_PORT"), "Address of the remote side of the plugin (default from SS_REMOTE_HOST & SS_REMOTE_PORT)")
+		options = fs.String("options", os.Getenv("SS_PLUGIN_OPTIONS"), "JSON object or key=value;... options such as format & server (default from SS_PLUGIN_OPTIONS)")
+		verbose = fs.Bool("v", false, "Debug logging enabled")
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	opts, err := parseSIP003Options(*options)
+	if err != nil {
+		return err
+	}
+	_, isServer := opts["server"]
+	delete(opts, "server")
+
+	// Validate arguments.
+	if *local == "" {
+		return errors.New("local address required")
+	} else if *remote == "" {
+		return errors.New("remote address required")
+	}
+	t, err := transport.NewTransport(opts)
+	if err != nil {
+		return err
+	}
+
+	closeCrashReport, err := fs.setupCommandLogging(*verbose)
+	if err != nil {
+		return err
+	}
+	defer closeCrashReport()
+
+	// Servers accept marionette connections on the remote address & forward
+	// them to the proxy. Clients do the reverse.
+	var ln net.Listener
+	var target string
+	var dial func() (net.Conn, error)
+	if isServer {
+		if ln, err = t.Listen(*remote); err != nil {
+			return err
+		}
+		target = *local
+		dial = func() (net.Conn, error) { return net.Dial("tcp", *local) }
+	} else {
+		if ln, err = net.Listen("tcp", *local); err != nil {
+			return err
+		}
+		target = *remote
+		dial = func() (net.Conn, error) { return t.Dial(*remote) }
+	}
+	defer ln.Close()
+	fmt.Fprintf(os.Stderr, "listening on %s, forwarding to %s\n", ln.Addr(), target)
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				peer, err := dial()
+				if err != nil {
+					marionette.Logger.Info("sip003: cannot connect", zap.Error(err))
+					return
+				}
+				defer peer.Close()
+				proxyConns(conn, peer)
+			}()
+		}
+	}()
+
+	waitForSignal()
+	return nil
+}
+
+// sip003Addr returns the address from the host & port environment variables.
+func sip003Addr(hostKey, portKey string) string {
+	host, port := os.Getenv(hostKey), os.Getenv(portKey)
+	if host == "" || port == "" {
+		return ""
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// parseSIP003Options parses plugin options given either as a JSON object,
+// such as {"format":"http_simple_blocking","server":true}, or in the SIP003
+// form "format=http_simple_blocking;server", where a backslash escapes the
+// next character. A key without a value is set.
+func parseSIP003Options(s string) (map[string]string, error) {
+	m := make(map[string]string)
+	if s = strings.TrimSpace(s); s == "" {
+		return m, nil
+	}
+
+	if strings.HasPrefix(s, "{") {
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(s), &obj); err != nil {
+			return nil, fmt.Errorf("invalid plugin options: %s", err)
+		}
+		for k, v := range obj {
+			if b, ok := v.(bool); ok {
+				if b {
+					m[k] = ""
+				}
+				continue
+			}
+			m[k] = fmt.Sprint(v)
+		}
+		return m, nil
+	}
+
+	var key, value strings.Builder
+	cur, hasValue := &key, false
+	set := func() {
+		if key.Len() > 0 || hasValue {
+			m[key.String()] = value.String()
+		}
+		key.Reset()
+		value.Reset()
+		cur, hasValue = &key, false
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			cur.WriteByte(s[i])
+		case c == ';':
+			set()
+		case c == '=' && !hasValue:
+			cur, hasValue = &value, true
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	set()
+	return m, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSIP003Options(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want map[string]string
+	}{
+		{"", map[string]string{}},
+		{"format=http_simple_blocking;server", map[string]string{"format": "http_simple_blocking", "server": ""}},
+		{`auth-secret=a\;b\=c;format=ftp_simple_blocking`, map[string]string{"auth-secret": "a;b=c", "format": "ftp_simple_blocking"}},
+		{"key=a=b;;", map[string]string{"key": "a=b"}},
+		{`{"format":"http_simple_blocking","server":true}`, map[string]string{"format": "http_simple_blocking", "server": ""}},
+		{`{"server":false,"channels":2}`, map[string]string{"channels": "2"}},
+	} {
+		if got, err := parseSIP003Options(tt.s); err != nil {
+			t.Fatalf("unexpected error for %q: %s", tt.s, err)
+		} else if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("unexpected options for %q: %#v", tt.s, got)
+		}
+	}
+}
+
+func TestParseSIP003Options_ErrInvalidJSON(t *testing.T) {
+	if _, err := parseSIP003Options(`{"format":`); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSIP003Addr(t *testing.T) {
+	t.Setenv("SS_LOCAL_HOST", "::1")
+	t.Setenv("SS_LOCAL_PORT", "1080")
+	if addr := sip003Addr("SS_LOCAL_HOST", "SS_LOCAL_PORT"); addr != "[::1]:1080" {
+		t.Fatalf("unexpected addr: %s", addr)
+	}
+
+	t.Setenv("SS_LOCAL_PORT", "")
+	if addr := sip003Addr("SS_LOCAL_HOST", "SS_LOCAL_PORT"); addr != "" {
+		t.Fatalf("unexpected addr: %s", addr)
+	}
+}
+
+func TestSIP003Command_Run_ErrAddrRequired(t *testing.T) {
+	t.Setenv("SS_LOCAL_HOST", "")
+	t.Setenv("SS_REMOTE_HOST", "")
+	if err := NewSIP003Command().Run([]string{"-remote", "127.0.0.1:8081"}); err == nil || err.Error() != "local address required" {
+		t.Fatalf("unexpected error: %v", err)
+	} else if err := NewSIP003Command().Run([]string{"-local", "127.0.0.1:1080"}); err == nil || err.Error() != "remote address required" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
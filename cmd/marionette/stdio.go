@@ -0,0 +1,21 @@
+package main
+
+import (
+	"os"
+
+	"github.com/redjack/marionette"
+)
+
+// openStdio returns a connection carrying the cover traffic over stdin &
+// stdout. The command's own output is redirected to stderr so that it does
+// not corrupt the cover traffic, and the command stops once the connection
+// ends.
+func openStdio() *marionette.ReadWriterConn {
+	conn := marionette.NewStdioConn()
+	os.Stdout = os.Stderr
+	go func() {
+		<-conn.Done()
+		requestStop()
+	}()
+	return conn
+}
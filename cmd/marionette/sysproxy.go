@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// SysproxyCommand points the operating system's proxy settings at a local
+// client and restores the previous settings afterwards.
+type SysproxyCommand struct{}
+
+func NewSysproxyCommand() *SysproxyCommand {
+	return &SysproxyCommand{}
+}
+
+func (cmd *SysproxyCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("marionette-sysproxy", flag.ContinueOnError)
+	mode := fs.String("mode", "socks5", "Proxy protocol of the client: socks5 or http")
+	addr := fs.String("addr", "127.0.0.1:8079", "Address of the client's -bind")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, cmd.Usage())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	} else if fs.NArg() != 1 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	switch fs.Arg(0) {
+	case "on":
+		if err := enableSystemProxy(*mode, *addr); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "system %s proxy set to %s\n", *mode, *addr)
+		return nil
+	case "off":
+		if err := restoreSystemProxy(); err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stderr, "system proxy settings restored")
+		return nil
+	default:
+		return fmt.Errorf("unknown sysproxy action: %q", fs.Arg(0))
+	}
+}
+
+func (cmd *SysproxyCommand) Usage() string {
+	return `
+Usage:
+
+	marionette sysproxy [-mode socks5|http] [-addr HOST:PORT] on
+	marionette sysproxy off
+
+Points the system proxy at a client started with -proxy-mode socks5 or http:
+network services' proxies on macOS, WinINET on Windows, and GNOME's proxy
+settings elsewhere. The previous settings are saved by "on" and restored by
+"off". The client's -sysproxy flag does both as it starts & exits.
+`[1:]
+}
+
+// systemProxySettings holds the operating system's proxy settings, by key,
+// as read before they are changed. Keys & values are platform specific.
+type systemProxySettings map[string]string
+
+// errSystemProxyEnabled is returned when enabling the system proxy while it
+// is already enabled, so that the original settings are not overwritten.
+var errSystemProxyEnabled = errors.New("system proxy already enabled, run: marionette sysproxy off")
+
+// enableSystemProxy saves the current system proxy settings and then points
+// them at addr, a local client proxy using mode.
+func enableSystemProxy(mode, addr string) error {
+	if mode != "socks5" && mode != "http" {
+		return fmt.Errorf("system proxy requires -proxy-mode socks5 or http: %q", mode)
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid system proxy address: %s", err)
+	} else if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "127.0.0.1"
+	}
+
+	path, err := systemProxyStatePath()
+	if err != nil {
+		return err
+	} else if _, err := os.Stat(path); err == nil {
+		return errSystemProxyEnabled
+	}
+
+	settings, err := readSystemProxy()
+	if err != nil {
+		return fmt.Errorf("cannot read system proxy settings: %s", err)
+	}
+	buf, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	} else if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	} else if err := os.WriteFile(path, buf, 0600); err != nil {
+		return err
+	}
+
+	if err := setSystemProxy(mode, host, port); err != nil {
+		writeSystemProxy(settings)
+		os.Remove(path)
+		return fmt.Errorf("cannot set system proxy: %s", err)
+	}
+	return nil
+}
+
+// restoreSystemProxy restores the settings saved by enableSystemProxy().
+func restoreSystemProxy() error {
+	path, err := systemProxyStatePath()
+	if err != nil {
+		return err
+	}
+	buf, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return errors.New("system proxy not enabled by marionette")
+	} else if err != nil {
+		return err
+	}
+
+	var settings systemProxySettings
+	if err := json.Unmarshal(buf, &settings); err != nil {
+		return fmt.Errorf("cannot parse saved system proxy settings: %s", err)
+	} else if err := writeSystemProxy(settings); err != nil {
+		return fmt.Errorf("cannot restore system proxy: %s", err)
+	}
+	return os.Remove(path)
+}
+
+// systemProxyStatePath returns the path of the settings saved while the
+// system proxy is enabled.
+func systemProxyStatePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "marionette", "sysproxy.json"), nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Proxy kinds of networksetup which are saved & restored, by the name used in
+// its -get, -set & -set...state options.
+var networksetupProxies = []string{"socksfirewallproxy", "webproxy", "securewebproxy"}
+
+// readSystemProxy returns the proxies of each enabled network service, keyed
+// by service & kind, as "Enabled host port".
+func readSystemProxy() (systemProxySettings, error) {
+	services, err := networkServices()
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(systemProxySettings)
+	for _, service := range services {
+		for _, kind := range networksetupProxies {
+			out, err := exec.Command("networksetup", "-get"+kind, service).Output()
+			if err != nil {
+				return nil, fmt.Errorf("networksetup -get%s %q: %s", kind, service, err)
+			}
+
+			fields := make(map[string]string)
+			for _, line := range strings.Split(string(out), "\n") {
+				if k, v, ok := strings.Cut(line, ":"); ok {
+					fields[k] = strings.TrimSpace(v)
+				}
+			}
+			settings[service+"\t"+kind] = strings.Join([]string{fields["Enabled"], fields["Server"], fields["Port"]}, " ")
+		}
+	}
+	return settings, nil
+}
+
+// setSystemProxy points the SOCKS, or HTTP & HTTPS, proxy of every enabled
+// network service at host & port and turns off the other kinds.
+func setSystemProxy(mode, host, port string) error {
+	services, err := networkServices()
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		for _, kind := range networksetupProxies {
+			enabled := (mode == "socks5") == (kind == "socksfirewallproxy")
+			if enabled {
+				if err := networksetup("-set"+kind, service, host, port); err != nil {
+					return err
+				}
+			}
+			if err := networksetup("-set"+kind+"state", service, onOff(enabled)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeSystemProxy restores settings returned by readSystemProxy().
+func writeSystemProxy(settings systemProxySettings) error {
+	for key, value := range settings {
+		service, kind, _ := strings.Cut(key, "\t")
+		fields := strings.Fields(value)
+		if len(fields) == 3 && fields[1] != "" && fields[2] != "0" {
+			if err := networksetup("-set"+kind, service, fields[1], fields[2]); err != nil {
+				return err
+			}
+		}
+		enabled := len(fields) > 0 && fields[0] == "Yes"
+		if err := networksetup("-set"+kind+"state", service, onOff(enabled)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// networkServices returns the names of enabled network services. Disabled
+// services are listed by networksetup with a leading asterisk.
+func networkServices() ([]string, error) {
+	out, err := exec.Command("networksetup", "-listallnetworkservices").Output()
+	if err != nil {
+		return nil, fmt.Errorf("networksetup -listallnetworkservices: %s", err)
+	}
+
+	var services []string
+	for i, line := range strings.Split(string(out), "\n") {
+		// The first line describes how disabled services are marked.
+		if i == 0 || line == "" || strings.HasPrefix(line, "*") {
+			continue
+		}
+		services = append(services, line)
+	}
+	return services, nil
+}
+
+// networksetup runs networksetup with args, returning its output on failure.
+func networksetup(args ...string) error {
+	if out, err := exec.Command("networksetup", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("networksetup %s: %s: %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func onOff(v bool) string {
+	if v {
+		return "on"
+	}
+	return "off"
+}
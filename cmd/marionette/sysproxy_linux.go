@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// GNOME proxy settings which are saved & restored, as "schema key".
+var gnomeProxyKeys = []string{
+	"org.gnome.system.proxy mode",
+	"org.gnome.system.proxy.socks host",
+	"org.gnome.system.proxy.socks port",
+	"org.gnome.system.proxy.http host",
+	"org.gnome.system.proxy.http port",
+	"org.gnome.system.proxy.https host",
+	"org.gnome.system.proxy.https port",
+}
+
+// readSystemProxy returns GNOME's proxy settings as printed by gsettings.
+func readSystemProxy() (systemProxySettings, error) {
+	settings := make(systemProxySettings)
+	for _, key := range gnomeProxyKeys {
+		out, err := exec.Command("gsettings", append([]string{"get"}, strings.Fields(key)...)...).Output()
+		if err != nil {
+			return nil, fmt.Errorf("gsettings get %s: %s", key, err)
+		}
+		settings[key] = strings.TrimSpace(string(out))
+	}
+	return settings, nil
+}
+
+// setSystemProxy sets GNOME's manual SOCKS, or HTTP & HTTPS, proxy to host &
+// port. Unused kinds are cleared so applications only use the client.
+func setSystemProxy(mode, host, port string) error {
+	kinds := map[string]bool{"socks": mode == "socks5", "http": mode == "http", "https": mode == "http"}
+	for _, kind := range []string{"socks", "http", "https"} {
+		h, p := "''", "0"
+		if kinds[kind] {
+			h, p = host, port
+		}
+		if err := gsettings("org.gnome.system.proxy."+kind, "host", h); err != nil {
+			return err
+		} else if err := gsettings("org.gnome.system.proxy."+kind, "port", p); err != nil {
+			return err
+		}
+	}
+	return gsettings("org.gnome.system.proxy", "mode", "manual")
+}
+
+// writeSystemProxy restores settings returned by readSystemProxy(). The mode
+// is restored last so the previous proxy is complete once it's in effect.
+func writeSystemProxy(settings systemProxySettings) error {
+	for _, key := range gnomeProxyKeys[1:] {
+		if value, ok := settings[key]; ok {
+			schema, name, _ := strings.Cut(key, " ")
+			if err := gsettings(schema, name, value); err != nil {
+				return err
+			}
+		}
+	}
+	if value, ok := settings[gnomeProxyKeys[0]]; ok {
+		return gsettings("org.gnome.system.proxy", "mode", value)
+	}
+	return nil
+}
+
+// gsettings sets a key of schema to value, returning its output on failure.
+// Values as printed by "gsettings get", such as quoted strings, are accepted.
+func gsettings(schema, key, value string) error {
+	if out, err := exec.Command("gsettings", "set", schema, key, value).CombinedOutput(); err != nil {
+		return fmt.Errorf("gsettings set %s %s: %s: %s", schema, key, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
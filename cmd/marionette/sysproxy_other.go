@@ -0,0 +1,14 @@
+//go:build !darwin && !linux && !windows
+// +build !darwin,!linux,!windows
+
+package main
+
+import "errors"
+
+// errSystemProxyUnsupported is returned by the sysproxy command on platforms
+// without a supported proxy settings store.
+var errSystemProxyUnsupported = errors.New("system proxy settings are not supported on this platform")
+
+func readSystemProxy() (systemProxySettings, error)       { return nil, errSystemProxyUnsupported }
+func setSystemProxy(mode, host, port string) error        { return errSystemProxyUnsupported }
+func writeSystemProxy(settings systemProxySettings) error { return errSystemProxyUnsupported }
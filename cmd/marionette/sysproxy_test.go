@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSysproxyCommand_Run_ErrInvalidArgs(t *testing.T) {
+	setUserConfigDir(t)
+
+	if err := NewSysproxyCommand().Run(nil); err != flag.ErrHelp {
+		t.Fatalf("unexpected error: %v", err)
+	} else if err := NewSysproxyCommand().Run([]string{"toggle"}); err == nil || err.Error() != `unknown sysproxy action: "toggle"` {
+		t.Fatalf("unexpected error: %v", err)
+	} else if err := NewSysproxyCommand().Run([]string{"off"}); err == nil || err.Error() != "system proxy not enabled by marionette" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEnableSystemProxy(t *testing.T) {
+	t.Run("ErrMode", func(t *testing.T) {
+		setUserConfigDir(t)
+		if err := enableSystemProxy("tcp", "127.0.0.1:8079"); err == nil || err.Error() != `system proxy requires -proxy-mode socks5 or http: "tcp"` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrAddr", func(t *testing.T) {
+		setUserConfigDir(t)
+		if err := enableSystemProxy("socks5", "8079"); err == nil || !strings.HasPrefix(err.Error(), "invalid system proxy address: ") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	// Settings saved by a previous "on" must not be overwritten.
+	t.Run("ErrEnabled", func(t *testing.T) {
+		path := setUserConfigDir(t)
+		writeSystemProxyState(t, path, `{}`)
+		if err := enableSystemProxy("socks5", "127.0.0.1:8079"); err != errSystemProxyEnabled {
+			t.Fatalf("unexpected error: %v", err)
+		} else if buf, err := os.ReadFile(path); err != nil {
+			t.Fatal(err)
+		} else if string(buf) != `{}` {
+			t.Fatalf("saved settings overwritten: %s", buf)
+		}
+	})
+}
+
+func TestRestoreSystemProxy(t *testing.T) {
+	t.Run("ErrNotEnabled", func(t *testing.T) {
+		setUserConfigDir(t)
+		if err := restoreSystemProxy(); err == nil || err.Error() != "system proxy not enabled by marionette" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	// Invalid saved settings are kept so they can be restored by hand.
+	t.Run("ErrInvalidState", func(t *testing.T) {
+		path := setUserConfigDir(t)
+		writeSystemProxyState(t, path, `{`)
+		if err := restoreSystemProxy(); err == nil || !strings.HasPrefix(err.Error(), "cannot parse saved system proxy settings: ") {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, err := os.Stat(path); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// setUserConfigDir points the user's config directory at a temporary
+// directory on each platform. Returns the path of the saved settings.
+func setUserConfigDir(tb testing.TB) string {
+	dir := tb.TempDir()
+	tb.Setenv("XDG_CONFIG_HOME", dir)
+	tb.Setenv("HOME", dir)
+	tb.Setenv("AppData", dir)
+
+	path, err := systemProxyStatePath()
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return path
+}
+
+// writeSystemProxyState writes saved settings to path.
+func writeSystemProxyState(tb testing.TB, path, s string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		tb.Fatal(err)
+	} else if err := os.WriteFile(path, []byte(s), 0600); err != nil {
+		tb.Fatal(err)
+	}
+}
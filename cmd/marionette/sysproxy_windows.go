@@ -0,0 +1,91 @@
+package main
+
+import (
+	"strconv"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+// internetSettingsKey holds the current user's WinINET proxy settings.
+const internetSettingsKey = `Software\Microsoft\Windows\CurrentVersion\Internet Settings`
+
+// InternetSetOption options which apply changed settings to running programs.
+const (
+	internetOptionSettingsChanged = 39
+	internetOptionRefresh         = 37
+)
+
+var procInternetSetOption = windows.NewLazySystemDLL("wininet.dll").NewProc("InternetSetOptionW")
+
+// readSystemProxy returns the WinINET proxy settings. A missing value is
+// saved as empty and deleted on restore.
+func readSystemProxy() (systemProxySettings, error) {
+	k, err := registry.OpenKey(registry.CURRENT_USER, internetSettingsKey, registry.QUERY_VALUE)
+	if err != nil {
+		return nil, err
+	}
+	defer k.Close()
+
+	settings := make(systemProxySettings)
+	if v, _, err := k.GetIntegerValue("ProxyEnable"); err == nil {
+		settings["ProxyEnable"] = strconv.FormatUint(v, 10)
+	} else if err != registry.ErrNotExist {
+		return nil, err
+	}
+	for _, name := range []string{"ProxyServer", "ProxyOverride"} {
+		if v, _, err := k.GetStringValue(name); err == nil {
+			settings[name] = v
+		} else if err != registry.ErrNotExist {
+			return nil, err
+		}
+	}
+	return settings, nil
+}
+
+// setSystemProxy points WinINET at host & port, bypassing local addresses.
+func setSystemProxy(mode, host, port string) error {
+	server := "socks=" + host + ":" + port
+	if mode == "http" {
+		server = "http=" + host + ":" + port + ";https=" + host + ":" + port
+	}
+	return writeSystemProxy(systemProxySettings{
+		"ProxyEnable":   "1",
+		"ProxyServer":   server,
+		"ProxyOverride": "<local>",
+	})
+}
+
+// writeSystemProxy writes settings returned by readSystemProxy() and notifies
+// running programs.
+func writeSystemProxy(settings systemProxySettings) error {
+	k, err := registry.OpenKey(registry.CURRENT_USER, internetSettingsKey, registry.SET_VALUE)
+	if err != nil {
+		return err
+	}
+	defer k.Close()
+
+	if v, ok := settings["ProxyEnable"]; ok {
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return err
+		} else if err := k.SetDWordValue("ProxyEnable", uint32(n)); err != nil {
+			return err
+		}
+	} else if err := k.SetDWordValue("ProxyEnable", 0); err != nil {
+		return err
+	}
+	for _, name := range []string{"ProxyServer", "ProxyOverride"} {
+		if v, ok := settings[name]; ok {
+			if err := k.SetStringValue(name, v); err != nil {
+				return err
+			}
+		} else if err := k.DeleteValue(name); err != nil && err != registry.ErrNotExist {
+			return err
+		}
+	}
+
+	procInternetSetOption.Call(0, internetOptionSettingsChanged, 0, 0)
+	procInternetSetOption.Call(0, internetOptionRefresh, 0, 0)
+	return nil
+}
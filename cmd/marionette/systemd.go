@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+// sdListenFDsStart is the first file descriptor passed by socket activation.
+const sdListenFDsStart = 3
+
+// systemdSockets holds listening sockets passed by systemd socket activation
+// which have not been used yet.
+type systemdSockets []net.Listener
+
+// listenSystemd returns the sockets passed by systemd, if any. The activation
+// environment variables are cleared so they are not inherited by children.
+func listenSystemd() (systemdSockets, error) {
+	defer os.Unsetenv("LISTEN_PID")
+	defer os.Unsetenv("LISTEN_FDS")
+	defer os.Unsetenv("LISTEN_FDNAMES")
+
+	if pid, err := strconv.Atoi(os.Getenv("LISTEN_PID")); err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || n <= 0 {
+		return nil, nil
+	}
+
+	var sockets systemdSockets
+	for fd := sdListenFDsStart; fd < sdListenFDsStart+n; fd++ {
+		f := os.NewFile(uintptr(fd), "LISTEN_FD_"+strconv.Itoa(fd))
+		ln, err := net.FileListener(f)
+		f.Close()
+		if err != nil {
+			sockets.Close()
+			return nil, fmt.Errorf("systemd socket %d: %s", fd, err)
+		}
+		sockets = append(sockets, ln)
+	}
+	return sockets, nil
+}
+
+// take removes & returns the socket listening on port, if any.
+func (a *systemdSockets) take(port string) net.Listener {
+	for i, ln := range *a {
+		if _, p, err := net.SplitHostPort(ln.Addr().String()); err == nil && p == port {
+			*a = append((*a)[:i], (*a)[i+1:]...)
+			return ln
+		}
+	}
+	return nil
+}
+
+// Close closes the remaining sockets.
+func (a systemdSockets) Close() {
+	for _, ln := range a {
+		ln.Close()
+	}
+}
+
+// sdNotify sends a state change, such as "READY=1", to systemd & the run
+// command's health file. Does nothing unless the service is run with a
+// notification socket, such as Type=notify, or by the run command.
+func sdNotify(state string) {
+	writeHealthFile(state)
+
+	path := os.Getenv("NOTIFY_SOCKET")
+	if path == "" {
+		return
+	} else if path[0] == '@' {
+		path = "\x00" + path[1:] // abstract socket
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		marionette.Logger.Warn("systemd notify failed", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(state)); err != nil {
+		marionette.Logger.Warn("systemd notify failed", zap.Error(err))
+	}
+}
+
+// startWatchdog sends keep-alives to systemd at half of WatchdogSec, if set.
+// Keep-alives stop while check returns an error so that systemd restarts the
+// service.
+func startWatchdog(check func() error) {
+	usec, err := strconv.Atoi(os.Getenv("WATCHDOG_USEC"))
+	if err != nil || usec <= 0 {
+		return
+	} else if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return
+	}
+
+	interval := time.Duration(usec) * time.Microsecond / 2
+	go func() {
+		for range time.Tick(interval) {
+			if err := check(); err != nil {
+				marionette.Logger.Error("watchdog check failed", zap.Error(err))
+				continue
+			}
+			sdNotify("WATCHDOG=1")
+		}
+	}()
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+// tracingShutdownTimeout is the time spent exporting the remaining spans on exit.
+const tracingShutdownTimeout = 5 * time.Second
+
+// setupTracing exports spans to the OTLP/HTTP collector at -otlp-endpoint, if
+// set. The returned function flushes the remaining spans and must be called
+// before the command exits.
+func (fs *FlagSet) setupTracing() (func(), error) {
+	if fs.OTLPEndpoint == "" {
+		return func() {}, nil
+	} else if fs.OTLPSampleRate < 0 || fs.OTLPSampleRate > 1 {
+		return nil, errors.New("otlp sample rate must be between 0 and 1")
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(fs.OTLPEndpoint)}
+	if fs.OTLPInsecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Streams & states are sampled with the connection that carries them.
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(fs.OTLPSampleRate))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(fs.Name()))),
+	)
+	otel.SetTracerProvider(tp)
+	fmt.Fprintf(os.Stderr, "exporting traces to %s\n", fs.OTLPEndpoint)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := tp.Shutdown(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "cannot export traces: %s\n", err)
+		}
+	}, nil
+}
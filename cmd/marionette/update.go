@@ -0,0 +1,335 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Release manifest & signing key used by the update command unless -manifest
+// or -key is passed, and the version of this binary. Release builds set them
+// with -ldflags "-X main.NAME=VALUE".
+var (
+	UpdateManifestURL string
+	UpdatePublicKey   string
+	Version           string
+)
+
+// DefaultUpdateChannel is the release channel followed by default.
+const DefaultUpdateChannel = "stable"
+
+// maxUpdateSize is the maximum size of a downloaded manifest or binary.
+const maxUpdateSize = 256 * 1024 * 1024
+
+// UpdateManifest lists the latest release of each channel. It is signed with
+// ed25519 and the base64 signature is published next to it with a ".sig"
+// suffix. The signed expiry stops a stale manifest from being replayed to hold
+// back updates.
+type UpdateManifest struct {
+	Expires  time.Time                 `json:"expires"`
+	Channels map[string]*UpdateRelease `json:"channels"`
+}
+
+// UpdateRelease is a release of a channel with a binary for each platform,
+// keyed by GOOS/GOARCH such as "linux/amd64".
+type UpdateRelease struct {
+	Version  string                       `json:"version"`
+	Binaries map[string]*UpdateBinaryInfo `json:"binaries"`
+}
+
+// UpdateBinaryInfo is the location & SHA-256 hash of a release binary. The URL
+// may be relative to the manifest.
+type UpdateBinaryInfo struct {
+	URL    string `json:"url"`
+	SHA256 string `json:"sha256"`
+}
+
+// UpdateCommand replaces the running binary with the latest release of a
+// channel from a signed manifest.
+type UpdateCommand struct{}
+
+func NewUpdateCommand() *UpdateCommand {
+	return &UpdateCommand{}
+}
+
+func (cmd *UpdateCommand) Run(args []string) error {
+	fs := NewFlagSet("marionette-update", flag.ContinueOnError)
+	var (
+		manifestURL = fs.String("manifest", UpdateManifestURL, "Release manifest URL or file path; its signature is read from the same location with a .sig suffix")
+		key         = fs.String("key", UpdatePublicKey, "Base64 ed25519 public key the manifest must be signed with")
+		channel     = fs.String("channel", DefaultUpdateChannel, "Release channel to follow, such as stable or beta")
+		proxyURL    = fs.String("proxy", "", "Download through a proxy (socks5:// or http:// URL), such as a running client's -proxy-mode=socks5")
+		timeout     = fs.Duration("timeout", 5*time.Minute, "Time to wait for each download")
+		check       = fs.Bool("check", false, "Only report whether an update is available")
+	)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: marionette update [flags]\n\nReplaces this binary with the latest release of -channel from a signed manifest.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	} else if *manifestURL == "" {
+		return errors.New("manifest required")
+	} else if *key == "" {
+		return errors.New("public key required")
+	}
+
+	publicKey, err := base64.StdEncoding.DecodeString(*key)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return errors.New("invalid public key")
+	}
+
+	client := &http.Client{Timeout: *timeout}
+	if *proxyURL != "" {
+		u, err := url.Parse(*proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy url: %s", err)
+		}
+		client.Transport = &http.Transport{Proxy: http.ProxyURL(u)}
+	}
+
+	// Read & verify the manifest before trusting any of its contents.
+	data, err := fetchUpdate(client, *manifestURL)
+	if err != nil {
+		return fmt.Errorf("cannot read manifest: %s", err)
+	}
+	sig, err := fetchUpdate(client, *manifestURL+".sig")
+	if err != nil {
+		return fmt.Errorf("cannot read manifest signature: %s", err)
+	}
+	manifest, err := verifyUpdateManifest(data, sig, ed25519.PublicKey(publicKey), time.Now())
+	if err != nil {
+		return err
+	}
+
+	release := manifest.Channels[*channel]
+	if release == nil {
+		return fmt.Errorf("unknown release channel: %q", *channel)
+	}
+
+	// Only install releases newer than this binary so an old, signed release
+	// with known flaws cannot be reinstalled. Development builds have no version.
+	if Version != "" {
+		if cmp, err := compareVersions(release.Version, Version); err != nil {
+			return err
+		} else if cmp == 0 {
+			fmt.Printf("already up to date with %s release %s\n", *channel, release.Version)
+			return nil
+		} else if cmp < 0 {
+			return fmt.Errorf("%s release %s is older than this version %s", *channel, release.Version, Version)
+		}
+	}
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	info := release.Binaries[platform]
+	if info == nil {
+		return fmt.Errorf("release %s has no binary for %s", release.Version, platform)
+	}
+	want, err := hex.DecodeString(info.SHA256)
+	if err != nil || len(want) != sha256.Size {
+		return fmt.Errorf("invalid sha256 for %s: %q", platform, info.SHA256)
+	}
+
+	// The running binary is up to date if it matches the release's hash.
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	} else if exe, err = filepath.EvalSymlinks(exe); err != nil {
+		return err
+	}
+	if current, err := os.ReadFile(exe); err != nil {
+		return err
+	} else if sum := sha256.Sum256(current); bytes.Equal(sum[:], want) {
+		fmt.Printf("already up to date with %s release %s\n", *channel, release.Version)
+		return nil
+	}
+	if *check {
+		fmt.Printf("%s release %s is available\n", *channel, release.Version)
+		return nil
+	}
+
+	binURL, err := resolveUpdateURL(*manifestURL, info.URL)
+	if err != nil {
+		return err
+	}
+	binary, err := fetchUpdate(client, binURL)
+	if err != nil {
+		return fmt.Errorf("cannot download %s: %s", binURL, err)
+	} else if sum := sha256.Sum256(binary); !bytes.Equal(sum[:], want) {
+		return fmt.Errorf("sha256 mismatch for %s", binURL)
+	}
+
+	if err := replaceExecutable(exe, binary); err != nil {
+		return err
+	}
+	fmt.Printf("updated to %s release %s, restart running commands to use it\n", *channel, release.Version)
+	return nil
+}
+
+// verifyUpdateManifest checks the base64 signature of a manifest, decodes it,
+// and checks that it has not expired at now.
+func verifyUpdateManifest(data, sig []byte, key ed25519.PublicKey, now time.Time) (*UpdateManifest, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig)))
+	if err != nil || !ed25519.Verify(key, data, raw) {
+		return nil, errors.New("invalid manifest signature")
+	}
+
+	var manifest UpdateManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %s", err)
+	} else if manifest.Expires.IsZero() {
+		return nil, errors.New("manifest has no expiry")
+	} else if !now.Before(manifest.Expires) {
+		return nil, fmt.Errorf("manifest expired at %s", manifest.Expires.UTC().Format(time.RFC3339))
+	}
+	return &manifest, nil
+}
+
+// compareVersions returns -1, 0 or 1 if version a is older than, the same as,
+// or newer than b. Versions are "MAJOR.MINOR.PATCH" with an optional "v"
+// prefix and "-PRERELEASE" suffix, which is older than the release itself.
+func compareVersions(a, b string) (int, error) {
+	av, apre, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	bv, bpre, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range av {
+		if av[i] != bv[i] {
+			if av[i] < bv[i] {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	switch {
+	case apre == bpre:
+		return 0, nil
+	case apre == "":
+		return 1, nil
+	case bpre == "":
+		return -1, nil
+	case apre < bpre:
+		return -1, nil
+	default:
+		return 1, nil
+	}
+}
+
+// parseVersion splits a version into its numbers and prerelease suffix.
+func parseVersion(s string) (v [3]int, pre string, err error) {
+	str := strings.TrimPrefix(s, "v")
+	str, pre, _ = strings.Cut(str, "-")
+
+	parts := strings.Split(str, ".")
+	if len(parts) != 3 {
+		return v, "", fmt.Errorf("invalid version: %q", s)
+	}
+	for i, part := range parts {
+		if v[i], err = strconv.Atoi(part); err != nil || v[i] < 0 {
+			return v, "", fmt.Errorf("invalid version: %q", s)
+		}
+	}
+	return v, pre, nil
+}
+
+// resolveUpdateURL returns the location of ref relative to the manifest, which
+// is either a URL or a file path.
+func resolveUpdateURL(manifest, ref string) (string, error) {
+	if isUpdateURL(manifest) {
+		base, err := url.Parse(manifest)
+		if err != nil {
+			return "", err
+		}
+		u, err := base.Parse(ref)
+		if err != nil {
+			return "", err
+		}
+		return u.String(), nil
+	} else if isUpdateURL(ref) || filepath.IsAbs(ref) {
+		return ref, nil
+	}
+	return filepath.Join(filepath.Dir(manifest), ref), nil
+}
+
+// isUpdateURL returns true if s is an HTTP or HTTPS URL rather than a path.
+func isUpdateURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+// fetchUpdate reads a URL or file, up to maxUpdateSize bytes.
+func fetchUpdate(client *http.Client, src string) ([]byte, error) {
+	var r io.ReadCloser
+	if isUpdateURL(src) {
+		resp, err := client.Get(src)
+		if err != nil {
+			return nil, err
+		} else if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(src)
+		if err != nil {
+			return nil, err
+		}
+		r = f
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(io.LimitReader(r, maxUpdateSize+1))
+	if err != nil {
+		return nil, err
+	} else if len(data) > maxUpdateSize {
+		return nil, errors.New("file too large")
+	}
+	return data, nil
+}
+
+// replaceExecutable atomically replaces the binary at path with data. The new
+// binary is written next to it then renamed over it, keeping its permissions.
+func replaceExecutable(path string, data []byte) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".new-*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	} else if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	} else if err := f.Close(); err != nil {
+		return err
+	} else if err := os.Chmod(tmp, fi.Mode().Perm()); err != nil {
+		return err
+	}
+	return renameExecutable(tmp, path)
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import "os"
+
+// renameExecutable moves the binary at src over dst. Running processes keep
+// using the old binary until they are restarted.
+func renameExecutable(src, dst string) error {
+	return os.Rename(src, dst)
+}
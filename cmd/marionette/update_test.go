@@ -0,0 +1,194 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestVerifyUpdateManifest(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("OK", func(t *testing.T) {
+		data, sig := signUpdateManifest(t, priv, &UpdateManifest{
+			Expires:  now.Add(time.Hour),
+			Channels: map[string]*UpdateRelease{"stable": {Version: "1.2.0"}},
+		})
+		if manifest, err := verifyUpdateManifest(data, sig, pub, now); err != nil {
+			t.Fatal(err)
+		} else if v := manifest.Channels["stable"].Version; v != "1.2.0" {
+			t.Fatalf("unexpected version: %q", v)
+		}
+	})
+
+	t.Run("ErrSignature", func(t *testing.T) {
+		data, sig := signUpdateManifest(t, priv, &UpdateManifest{Expires: now.Add(time.Hour)})
+		otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, tt := range []struct {
+			name string
+			data []byte
+			sig  []byte
+			key  ed25519.PublicKey
+		}{
+			{"WrongKey", data, sig, otherPub},
+			{"ModifiedData", append([]byte(" "), data...), sig, pub},
+			{"InvalidBase64", data, []byte("!"), pub},
+			{"Empty", data, nil, pub},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := verifyUpdateManifest(tt.data, tt.sig, tt.key, now); err == nil || err.Error() != "invalid manifest signature" {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			})
+		}
+	})
+
+	t.Run("ErrExpired", func(t *testing.T) {
+		data, sig := signUpdateManifest(t, priv, &UpdateManifest{Expires: now})
+		if _, err := verifyUpdateManifest(data, sig, pub, now); err == nil || err.Error() != "manifest expired at 2026-01-01T00:00:00Z" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrNoExpiry", func(t *testing.T) {
+		data, sig := signUpdateManifest(t, priv, &UpdateManifest{})
+		if _, err := verifyUpdateManifest(data, sig, pub, now); err == nil || err.Error() != "manifest has no expiry" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestCompareVersions(t *testing.T) {
+	for _, tt := range []struct {
+		a, b string
+		exp  int
+	}{
+		{"1.2.0", "1.2.0", 0},
+		{"v1.2.0", "1.2.0", 0},
+		{"1.2.1", "1.2.0", 1},
+		{"1.10.0", "1.9.0", 1},
+		{"1.2.0", "2.0.0", -1},
+		{"1.2.0-beta.1", "1.2.0", -1},
+		{"1.2.0", "1.2.0-beta.1", 1},
+		{"1.2.0-beta.2", "1.2.0-beta.1", 1},
+	} {
+		if cmp, err := compareVersions(tt.a, tt.b); err != nil {
+			t.Fatal(err)
+		} else if cmp != tt.exp {
+			t.Fatalf("compareVersions(%q, %q)=%d, want %d", tt.a, tt.b, cmp, tt.exp)
+		}
+	}
+
+	for _, s := range []string{"", "1.2", "1.2.x", "1.2.-1"} {
+		if _, err := compareVersions(s, "1.0.0"); err == nil {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+}
+
+func TestResolveUpdateURL(t *testing.T) {
+	for _, tt := range []struct {
+		manifest, ref string
+		exp           string
+	}{
+		{"https://example.com/releases/manifest.json", "marionette-linux-amd64", "https://example.com/releases/marionette-linux-amd64"},
+		{"https://example.com/releases/manifest.json", "../bin/marionette", "https://example.com/bin/marionette"},
+		{"https://example.com/releases/manifest.json", "https://cdn.example.com/marionette", "https://cdn.example.com/marionette"},
+		{filepath.FromSlash("/media/usb/manifest.json"), "marionette-linux-amd64", filepath.FromSlash("/media/usb/marionette-linux-amd64")},
+		{filepath.FromSlash("/media/usb/manifest.json"), "https://cdn.example.com/marionette", "https://cdn.example.com/marionette"},
+	} {
+		if u, err := resolveUpdateURL(tt.manifest, tt.ref); err != nil {
+			t.Fatal(err)
+		} else if u != tt.exp {
+			t.Fatalf("resolveUpdateURL(%q, %q)=%q, want %q", tt.manifest, tt.ref, u, tt.exp)
+		}
+	}
+}
+
+// Ensure the binary is replaced in place, keeping its permissions, and that
+// no temporary file is left behind.
+func TestReplaceExecutable(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "marionette")
+	if err := os.WriteFile(path, []byte("old"), 0750); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := replaceExecutable(path, []byte("new")); err != nil {
+		t.Fatal(err)
+	} else if buf, err := os.ReadFile(path); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "new" {
+		t.Fatalf("unexpected contents: %q", buf)
+	}
+
+	if runtime.GOOS != "windows" {
+		if fi, err := os.Stat(path); err != nil {
+			t.Fatal(err)
+		} else if fi.Mode().Perm() != 0750 {
+			t.Fatalf("unexpected mode: %s", fi.Mode())
+		}
+	}
+
+	if entries, err := os.ReadDir(dir); err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 1 {
+		t.Fatalf("unexpected files: %d", len(entries))
+	}
+}
+
+// Ensure a signed release older than the running version is not installed.
+func TestUpdateCommand_Run_ErrOlderRelease(t *testing.T) {
+	defer func(v string) { Version = v }(Version)
+	Version = "2.0.0"
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte("binary"))
+	data, sig := signUpdateManifest(t, priv, &UpdateManifest{
+		Expires: time.Now().Add(time.Hour),
+		Channels: map[string]*UpdateRelease{"stable": {
+			Version:  "1.9.0",
+			Binaries: map[string]*UpdateBinaryInfo{runtime.GOOS + "/" + runtime.GOARCH: {URL: "marionette", SHA256: hex.EncodeToString(sum[:])}},
+		}},
+	})
+
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	} else if err := os.WriteFile(path+".sig", sig, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	args := []string{"-manifest", path, "-key", base64.StdEncoding.EncodeToString(pub)}
+	if err := NewUpdateCommand().Run(args); err == nil || err.Error() != "stable release 1.9.0 is older than this version 2.0.0" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// signUpdateManifest encodes manifest and returns it with its base64 signature.
+func signUpdateManifest(tb testing.TB, key ed25519.PrivateKey, manifest *UpdateManifest) (data, sig []byte) {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return data, []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(key, data)))
+}
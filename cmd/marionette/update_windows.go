@@ -0,0 +1,18 @@
+package main
+
+import "os"
+
+// renameExecutable moves the binary at src over dst. A running binary cannot
+// be replaced on Windows, but it can be renamed, so dst is first moved aside
+// to dst.old, which is removed by the next update.
+func renameExecutable(src, dst string) error {
+	old := dst + ".old"
+	os.Remove(old)
+	if err := os.Rename(dst, old); err != nil {
+		return err
+	} else if err := os.Rename(src, dst); err != nil {
+		os.Rename(old, dst)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// listenWebSocket serves an HTTP server on addr which upgrades requests to
+// /<name> to WebSocket connections. Returns a listener executing each doc on
+// the connections to the path of its name, with opts.
+func listenWebSocket(addr string, names []string, docs []*mar.Document, opts ...marionette.Option) ([]*marionette.Listener, error) {
+	ln, err := marionette.ListenAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	listeners := make([]*marionette.Listener, len(docs))
+	for i, doc := range docs {
+		wsLn := marionette.NewWebSocketListener(ln.Addr())
+		mux.Handle("/"+names[i], wsLn)
+		listeners[i] = marionette.NewListener(wsLn, doc, opts...)
+	}
+
+	go func() { http.Serve(ln, mux) }()
+	return listeners, nil
+}
@@ -1,24 +1,84 @@
 package marionette
 
 import (
+	"errors"
 	"io"
+	"math/rand"
 	"net"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
+// ErrPeekTimeout is returned from a blocking peek when the peek deadline passes.
+var ErrPeekTimeout = errors.New("peek timeout")
+
 type BufferedConn struct {
+	// Bytes read from & written to the underlying connection. Accessed
+	// atomically so they are kept first for 64-bit alignment.
+	bytesRead    int64
+	bytesWritten int64
+
+	// Number of writes made, used to shape the handshake.
+	writeN int32
+
 	net.Conn
 
 	mu  sync.RWMutex
 	buf []byte
 	err error
 
+	peekDeadline time.Time
+
 	closing chan struct{}
 	once    sync.Once
 
 	seekNotify  chan struct{} // sent when seeking forward
 	writeNotify chan struct{} // sent when data has been written to the buffer.
+
+	// Outgoing writes queued by WriteQueued().
+	wmu     sync.Mutex
+	wcond   *sync.Cond
+	wqueue  [][]byte
+	werr    error
+	writing bool
+
+	// Controls how writes are split & joined into segments, if set.
+	Segmentation *WriteSegmentation
+
+	// Randomizes the size & timing of the first writes, if set. Overrides
+	// Segmentation for those writes.
+	Handshake *HandshakeProfile
+}
+
+// WriteSegmentation controls how a BufferedConn splits & joins outgoing
+// messages so that the TCP segments it produces resemble the mimicked
+// protocol instead of each message being sent as one maximum size write.
+type WriteSegmentation struct {
+	// Range of the size of each write a message is split into. A size is
+	// chosen uniformly for every write. Zero MaxSize writes messages whole.
+	MinSize int
+	MaxSize int
+
+	// Delay between the writes of a split message so that each is sent in
+	// its own segment. Most effective with TCP_NODELAY set.
+	Delay time.Duration
+
+	// If true, messages queued by WriteQueued() while a write is in progress
+	// are joined into a single write before being split.
+	Coalesce bool
+}
+
+// nextSize returns the size of the next write of a split message.
+func (s *WriteSegmentation) nextSize() int {
+	min := s.MinSize
+	if min < 1 {
+		min = 1
+	} else if min > s.MaxSize {
+		min = s.MaxSize
+	}
+	return min + rand.Intn(s.MaxSize-min+1)
 }
 
 func NewBufferedConn(conn net.Conn, bufferSize int) *BufferedConn {
@@ -30,12 +90,20 @@ func NewBufferedConn(conn net.Conn, bufferSize int) *BufferedConn {
 		seekNotify:  make(chan struct{}, 1),
 		writeNotify: make(chan struct{}, 1),
 	}
+	c.wcond = sync.NewCond(&c.wmu)
 	go c.monitor()
 	return c
 }
 
-// Close closes the connection.
+// closeFlushTimeout limits the time Close() waits for queued writes so that a
+// peer which stops reading cannot block it.
+const closeFlushTimeout = 2 * time.Second
+
+// Close waits for queued writes to complete, up to closeFlushTimeout, and
+// closes the connection. A failed flush is not reported.
 func (conn *BufferedConn) Close() error {
+	conn.Conn.SetWriteDeadline(time.Now().Add(closeFlushTimeout))
+	conn.Flush()
 	conn.once.Do(func() { close(conn.closing) })
 	return conn.Conn.Close()
 }
@@ -48,11 +116,117 @@ func (conn *BufferedConn) Append(b []byte) {
 	conn.buf = conn.buf[:len(conn.buf)+len(b)]
 }
 
+// BytesRead returns the number of bytes read from the underlying connection.
+func (conn *BufferedConn) BytesRead() int64 { return atomic.LoadInt64(&conn.bytesRead) }
+
+// BytesWritten returns the number of bytes written to the underlying connection.
+func (conn *BufferedConn) BytesWritten() int64 { return atomic.LoadInt64(&conn.bytesWritten) }
+
 // Read is unavailable for BufferedConn.
 func (conn *BufferedConn) Read(p []byte) (int, error) {
 	panic("BufferedConn.Read(): unavailable, use Peek/Seek")
 }
 
+// Write writes b to the connection after all queued writes have completed.
+func (conn *BufferedConn) Write(b []byte) (int, error) {
+	if err := conn.Flush(); err != nil {
+		return 0, err
+	}
+	return conn.write(b)
+}
+
+// write writes b to the underlying connection, split into segments if
+// segmentation is enabled. Handshake writes are delayed & split by the
+// handshake profile instead.
+func (conn *BufferedConn) write(b []byte) (n int, err error) {
+	defer func() { atomic.AddInt64(&conn.bytesWritten, int64(n)) }()
+
+	seg := conn.Segmentation
+	if p := conn.Handshake; p != nil && int(atomic.AddInt32(&conn.writeN, 1)) <= p.Writes {
+		time.Sleep(p.delay())
+		seg = p.segmentation()
+	}
+	if seg == nil || seg.MaxSize <= 0 {
+		return conn.Conn.Write(b)
+	}
+
+	for n < len(b) {
+		if n > 0 && seg.Delay > 0 {
+			time.Sleep(seg.Delay)
+		}
+
+		sz := seg.nextSize()
+		if sz > len(b)-n {
+			sz = len(b) - n
+		}
+		nn, err := conn.Conn.Write(b[n : n+sz])
+		if n += nn; err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// WriteQueued adds b to the outgoing queue and returns immediately. Queued
+// data is written in order by a separate goroutine. Returns the error from a
+// previously failed queued write, if any.
+func (conn *BufferedConn) WriteQueued(b []byte) error {
+	conn.wmu.Lock()
+	defer conn.wmu.Unlock()
+
+	if conn.werr != nil {
+		return conn.werr
+	}
+
+	conn.wqueue = append(conn.wqueue, append([]byte(nil), b...))
+	if !conn.writing {
+		conn.writing = true
+		go conn.writer()
+	}
+	return nil
+}
+
+// Flush blocks until all queued writes have been written to the connection.
+func (conn *BufferedConn) Flush() error {
+	conn.wmu.Lock()
+	defer conn.wmu.Unlock()
+	for conn.writing {
+		conn.wcond.Wait()
+	}
+	return conn.werr
+}
+
+// writer runs in a separate goroutine and writes queued data until the
+// queue is empty or a write fails.
+func (conn *BufferedConn) writer() {
+	for {
+		conn.wmu.Lock()
+		if len(conn.wqueue) == 0 {
+			conn.writing = false
+			conn.wcond.Broadcast()
+			conn.wmu.Unlock()
+			return
+		}
+		b := conn.wqueue[0]
+		conn.wqueue[0], conn.wqueue = nil, conn.wqueue[1:]
+		if seg := conn.Segmentation; seg != nil && seg.Coalesce {
+			for _, other := range conn.wqueue {
+				b = append(b, other...)
+			}
+			conn.wqueue = nil
+		}
+		conn.wmu.Unlock()
+
+		if _, err := conn.write(b); err != nil {
+			conn.wmu.Lock()
+			conn.werr, conn.wqueue, conn.writing = err, nil, false
+			conn.wcond.Broadcast()
+			conn.wmu.Unlock()
+			return
+		}
+	}
+}
+
 // Peek returns the first n bytes of the read buffer.
 // If n is -1 then returns any available data after attempting a read.
 func (conn *BufferedConn) Peek(n int, blocking bool) ([]byte, error) {
@@ -86,7 +260,45 @@ func (conn *BufferedConn) Peek(n int, blocking bool) ([]byte, error) {
 		}
 
 		// Wait for a new write or error from the monitor.
+		if err := conn.waitWrite(); err != nil {
+			return buf, err
+		}
+	}
+}
+
+// SetPeekDeadline sets the time after which blocking peeks return
+// ErrPeekTimeout. A zero value disables the deadline.
+func (conn *BufferedConn) SetPeekDeadline(t time.Time) {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	conn.peekDeadline = t
+}
+
+// waitWrite waits for a write notification from the monitor or until the
+// peek deadline passes.
+func (conn *BufferedConn) waitWrite() error {
+	conn.mu.RLock()
+	deadline := conn.peekDeadline
+	conn.mu.RUnlock()
+
+	if deadline.IsZero() {
 		<-conn.writeNotify
+		return nil
+	}
+
+	d := time.Until(deadline)
+	if d <= 0 {
+		return ErrPeekTimeout
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-conn.writeNotify:
+		return nil
+	case <-timer.C:
+		return ErrPeekTimeout
 	}
 }
 
@@ -94,10 +306,10 @@ func (conn *BufferedConn) Peek(n int, blocking bool) ([]byte, error) {
 // This implementation only supports io.SeekCurrent.
 func (conn *BufferedConn) Seek(offset int64, whence int) (int64, error) {
 	assert(whence == io.SeekCurrent)
-	assert(offset <= int64(len(conn.buf)))
 
 	conn.mu.Lock()
 	defer conn.mu.Unlock()
+	assert(offset <= int64(len(conn.buf)))
 
 	b := conn.buf[offset:]
 	conn.buf = conn.buf[:len(b)]
@@ -118,6 +330,7 @@ func (conn *BufferedConn) monitor() {
 		// Ensure connection is not closed.
 		select {
 		case <-conn.closing:
+			conn.stop(io.ErrClosedPipe)
 			return
 		default:
 		}
@@ -130,6 +343,7 @@ func (conn *BufferedConn) monitor() {
 		if capacity == 0 {
 			select {
 			case <-conn.closing:
+				conn.stop(io.ErrClosedPipe)
 				return
 			case <-conn.seekNotify:
 				continue
@@ -141,19 +355,30 @@ func (conn *BufferedConn) monitor() {
 
 		// Append bytes to connection buffer.
 		if n > 0 {
+			atomic.AddInt64(&conn.bytesRead, int64(n))
 			conn.Append(buf[:n])
 			conn.notifyWrite()
 		}
 
 		// If an error occurred then save on connection and exit.
 		if err != nil && !isTemporaryError(err) {
-			conn.err = err
-			conn.notifyWrite()
+			conn.stop(err)
 			return
 		}
 	}
 }
 
+// stop saves err, unless an error has already occurred, and wakes any blocked
+// peek so that it does not wait on a monitor which has exited.
+func (conn *BufferedConn) stop(err error) {
+	conn.mu.Lock()
+	if conn.err == nil {
+		conn.err = err
+	}
+	conn.mu.Unlock()
+	conn.notifyWrite()
+}
+
 func (conn *BufferedConn) notifySeek() {
 	select {
 	case conn.seekNotify <- struct{}{}:
@@ -0,0 +1,71 @@
+package marionette
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// ErrConnNotFound is returned when closing a connection which is not open.
+var ErrConnNotFound = errors.New("marionette: connection not found")
+
+// lastConnID is the ID of the last connection opened by any listener or dialer.
+var lastConnID int64
+
+// newConnID returns an ID which is unique within the process.
+func newConnID() int { return int(atomic.AddInt64(&lastConnID, 1)) }
+
+// ConnInfo describes an open cover connection for inspection. Connection IDs
+// are unique across all listeners & dialers in the process.
+type ConnInfo struct {
+	ID           int       `json:"id"`
+	Party        string    `json:"party"`
+	LocalAddr    string    `json:"local_addr,omitempty"`
+	RemoteAddr   string    `json:"remote_addr,omitempty"`
+	State        string    `json:"state"`
+	Streams      int       `json:"streams"`
+	BytesRead    int64     `json:"bytes_read"`
+	BytesWritten int64     `json:"bytes_written"`
+	OpenedAt     time.Time `json:"opened_at"`
+}
+
+// newConnInfo returns a description of the connection executed by fsm. The
+// byte counters cover the FSM's current connection only.
+func newConnInfo(id int, fsm FSM, openedAt time.Time) ConnInfo {
+	info := ConnInfo{
+		ID:       id,
+		Party:    fsm.Party(),
+		State:    fsm.State(),
+		Streams:  fsm.StreamSet().target().Len(),
+		OpenedAt: openedAt,
+	}
+	if conn := fsm.Conn(); conn != nil {
+		info.LocalAddr = conn.LocalAddr().String()
+		info.RemoteAddr = conn.RemoteAddr().String()
+		info.BytesRead, info.BytesWritten = conn.BytesRead(), conn.BytesWritten()
+	}
+	return info
+}
+
+// closeFSMConn closes the current connection of fsm. The FSM stops with an
+// error the same as if the peer had reset the connection.
+func closeFSMConn(fsm FSM) error {
+	if conn := fsm.Conn(); conn != nil {
+		return conn.Close()
+	}
+	return nil
+}
+
+// countStreams returns the number of streams carried by fsms. Stream sets
+// shared by multipath connections are counted once.
+func countStreams(fsms []FSM) int {
+	var n int
+	seen := make(map[*StreamSet]bool)
+	for _, fsm := range fsms {
+		if ss := fsm.StreamSet().target(); !seen[ss] {
+			seen[ss] = true
+			n += ss.Len()
+		}
+	}
+	return n
+}
@@ -2,11 +2,14 @@ package marionette_test
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
 )
 
 func TestBufferedConn(t *testing.T) {
@@ -27,12 +30,13 @@ func TestBufferedConn(t *testing.T) {
 	go func() {
 		conn, err := ln.Accept()
 		if err != nil {
-			t.Fatal(err)
+			t.Error(err)
+			return
 		}
 		defer conn.Close()
 
 		if _, err := io.Copy(conn, bytes.NewReader(data)); err != nil {
-			t.Fatal(err)
+			t.Error(err)
 		}
 	}()
 
@@ -67,3 +71,131 @@ func TestBufferedConn(t *testing.T) {
 		t.Fatalf("incorrect bytes read: got=%d, exp=%d", len(b), len(data))
 	}
 }
+
+func TestBufferedConn_WriteQueued(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		var buf bytes.Buffer
+		release := make(chan struct{})
+		conn := mock.DefaultConn()
+		conn.ReadFn = func(b []byte) (int, error) { <-make(chan struct{}); return 0, nil }
+		conn.WriteFn = func(p []byte) (int, error) {
+			<-release
+			return buf.Write(p)
+		}
+		bufConn := marionette.NewBufferedConn(&conn, marionette.MaxCellLength)
+
+		// Queued writes should return before the underlying write completes.
+		if err := bufConn.WriteQueued([]byte("foo")); err != nil {
+			t.Fatal(err)
+		} else if err := bufConn.WriteQueued([]byte("bar")); err != nil {
+			t.Fatal(err)
+		}
+		close(release)
+
+		// Synchronous writes must occur after queued writes.
+		if _, err := bufConn.Write([]byte("baz")); err != nil {
+			t.Fatal(err)
+		} else if got, want := buf.String(), "foobarbaz"; got != want {
+			t.Fatalf("unexpected data: %q, want %q", got, want)
+		}
+	})
+
+	t.Run("ErrWrite", func(t *testing.T) {
+		errMarker := errors.New("marker")
+		conn := mock.DefaultConn()
+		conn.ReadFn = func(b []byte) (int, error) { <-make(chan struct{}); return 0, nil }
+		conn.WriteFn = func(p []byte) (int, error) { return 0, errMarker }
+		bufConn := marionette.NewBufferedConn(&conn, marionette.MaxCellLength)
+
+		if err := bufConn.WriteQueued([]byte("foo")); err != nil {
+			t.Fatal(err)
+		} else if err := bufConn.Flush(); err != errMarker {
+			t.Fatalf("unexpected error: %#v", err)
+		} else if err := bufConn.WriteQueued([]byte("bar")); err != errMarker {
+			t.Fatalf("unexpected error: %#v", err)
+		}
+	})
+}
+
+// Ensure closing doesn't block forever on a peer which stops reading.
+func TestBufferedConn_Close(t *testing.T) {
+	conn, other := net.Pipe()
+	defer other.Close()
+	bufConn := marionette.NewBufferedConn(conn, marionette.MaxCellLength)
+	if err := bufConn.WriteQueued([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+
+	closed := make(chan error, 1)
+	go func() { closed <- bufConn.Close() }()
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected close to return")
+	}
+}
+
+func TestBufferedConn_Segmentation(t *testing.T) {
+	// Ensure messages are split into writes within the size range.
+	t.Run("Split", func(t *testing.T) {
+		conn, other := net.Pipe()
+		defer other.Close()
+
+		bufConn := marionette.NewBufferedConn(conn, marionette.MaxCellLength)
+		defer bufConn.Close()
+		bufConn.Segmentation = &marionette.WriteSegmentation{MinSize: 10, MaxSize: 20}
+
+		data := bytes.Repeat([]byte("x"), 105)
+		go bufConn.Write(data)
+
+		var buf bytes.Buffer
+		for buf.Len() < len(data) {
+			b := make([]byte, 100)
+			n, err := other.Read(b)
+			if err != nil {
+				t.Fatal(err)
+			} else if n > 20 || (n < 10 && buf.Len()+n != len(data)) {
+				t.Fatalf("unexpected write size: %d", n)
+			}
+			buf.Write(b[:n])
+		}
+		if !bytes.Equal(buf.Bytes(), data) {
+			t.Fatal("unexpected data")
+		}
+	})
+
+	// Ensure queued messages are joined into a single write.
+	t.Run("Coalesce", func(t *testing.T) {
+		conn, other := net.Pipe()
+		defer other.Close()
+
+		bufConn := marionette.NewBufferedConn(conn, marionette.MaxCellLength)
+		defer bufConn.Close()
+		bufConn.Segmentation = &marionette.WriteSegmentation{Coalesce: true}
+
+		for _, s := range []string{"foo", "bar", "baz"} {
+			if err := bufConn.WriteQueued([]byte(s)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var buf bytes.Buffer
+		for i := 0; buf.Len() < 9; i++ {
+			if i >= 2 {
+				t.Fatalf("expected at most 2 writes, got: %q", buf.String())
+			}
+			b := make([]byte, 100)
+			n, err := other.Read(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			buf.Write(b[:n])
+		}
+		if buf.String() != "foobarbaz" {
+			t.Fatalf("unexpected data: %q", buf.String())
+		}
+	})
+}
@@ -0,0 +1,252 @@
+package marionette
+
+import (
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// AuthCredentialSize is the size, in bytes, of a user's credential: the
+// user's ID followed by the user's secret.
+const AuthCredentialSize = authUserIDSize + AuthSecretSize
+
+// authUserIDSize is the size, in bytes, of a user ID in an AUTH cell.
+const authUserIDSize = 4
+
+// userLabel separates user secrets from other uses of the bridge secret.
+const userLabel = "marionette user v1"
+
+var (
+	// ErrUserNotFound is returned when a user is not in a credential database.
+	ErrUserNotFound = errors.New("marionette: user not found")
+
+	// ErrUserExists is returned when issuing a credential for a user name
+	// which is already in a credential database.
+	ErrUserExists = errors.New("marionette: user already exists")
+)
+
+// Credentials is the database of users allowed to connect to a private
+// bridge. Each user's secret is derived from the bridge secret & the user's
+// ID so that the database holds no secrets, and a user's access can be
+// revoked without changing the bridge secret of the others.
+type Credentials struct {
+	mu sync.RWMutex
+
+	// Users must only be changed with SetUsers() once the database is in use.
+	Users []CredentialUser
+
+	// Bytes transferred by each user, if set. Users over their quota are
+	// not allowed until their quota window restarts.
+	Usage *Usage
+}
+
+// CredentialUser is a user of a private bridge.
+type CredentialUser struct {
+	ID      uint32    `json:"id"`
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+	Expires time.Time `json:"expires,omitempty"` // never expires if zero
+	Revoked bool      `json:"revoked,omitempty"`
+
+	// Bytes the user may transfer, in both directions, per QuotaWindow, a
+	// duration such as "720h". Unlimited if zero; never resets if empty.
+	Quota       int64  `json:"quota,omitempty"`
+	QuotaWindow string `json:"quota_window,omitempty"`
+}
+
+// window returns the user's quota window, or zero if it never resets.
+func (u *CredentialUser) window() time.Duration {
+	d, _ := time.ParseDuration(u.QuotaWindow)
+	return d
+}
+
+// Allowed returns true if the user with id exists, is not revoked, has not
+// expired at t, and is within quota if Usage is set.
+func (c *Credentials) Allowed(id uint32, t time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, u := range c.Users {
+		if u.ID == id {
+			if u.Revoked || (!u.Expires.IsZero() && !t.Before(u.Expires)) {
+				return false
+			}
+			return c.Usage == nil || u.Quota <= 0 || c.Usage.Get(id, u.window(), t).Bytes < u.Quota
+		}
+	}
+	return false
+}
+
+// SetUsers replaces the users. Handshakes already verified are unaffected.
+func (c *Credentials) SetUsers(users []CredentialUser) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Users = users
+}
+
+// Issue adds a user named name with a random ID and returns the user's
+// credential for the bridge secret. A zero expires never expires.
+func (c *Credentials) Issue(secret []byte, name string, expires time.Time) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := make(map[uint32]bool)
+	for _, u := range c.Users {
+		if u.Name == name {
+			return nil, ErrUserExists
+		}
+		ids[u.ID] = true
+	}
+
+	var buf [authUserIDSize]byte
+	for {
+		if _, err := crand.Read(buf[:]); err != nil {
+			return nil, err
+		}
+		if id := binary.BigEndian.Uint32(buf[:]); !ids[id] {
+			c.Users = append(c.Users, CredentialUser{ID: id, Name: name, Created: time.Now().UTC(), Expires: expires})
+			return UserCredential(secret, id), nil
+		}
+	}
+}
+
+// Revoke revokes the credential of the user named name.
+func (c *Credentials) Revoke(name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range c.Users {
+		if c.Users[i].Name == name {
+			c.Users[i].Revoked = true
+			return nil
+		}
+	}
+	return ErrUserNotFound
+}
+
+// SetQuota sets the quota of the user named name to quota bytes per window.
+// A zero quota is unlimited and a zero window never resets.
+func (c *Credentials) SetQuota(name string, quota int64, window time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range c.Users {
+		if c.Users[i].Name == name {
+			c.Users[i].Quota, c.Users[i].QuotaWindow = quota, ""
+			if window > 0 {
+				c.Users[i].QuotaWindow = window.String()
+			}
+			return nil
+		}
+	}
+	return ErrUserNotFound
+}
+
+// UsageStats returns the usage of each user at t, in database order, or nil
+// if Usage is not set.
+func (c *Credentials) UsageStats(t time.Time) []UserUsage {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.Usage == nil {
+		return nil
+	}
+
+	a := make([]UserUsage, 0, len(c.Users))
+	for _, u := range c.Users {
+		uu := c.Usage.Get(u.ID, u.window(), t)
+		uu.Name, uu.Quota, uu.QuotaWindow = u.Name, u.Quota, u.QuotaWindow
+		a = append(a, uu)
+	}
+	return a
+}
+
+// ResetUsage clears the usage of the user named name.
+func (c *Credentials) ResetUsage(name string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, u := range c.Users {
+		if u.Name == name {
+			if c.Usage != nil {
+				c.Usage.Reset(u.ID)
+			}
+			return nil
+		}
+	}
+	return ErrUserNotFound
+}
+
+// recordUsage adds n bytes to the usage of the user with id at t, if Usage
+// is set.
+func (c *Credentials) recordUsage(id uint32, n int64, t time.Time) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.Usage == nil {
+		return
+	}
+	for _, u := range c.Users {
+		if u.ID == id {
+			c.Usage.Add(id, n, u.window(), t)
+			return
+		}
+	}
+}
+
+// ReadCredentialsFile reads a credential database written by WriteFile().
+func ReadCredentialsFile(path string) (*Credentials, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Credentials
+	if err := json.Unmarshal(buf, &c.Users); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// WriteFile replaces the file at path with the database.
+func (c *Credentials) WriteFile(path string) error {
+	c.mu.RLock()
+	buf, err := json.MarshalIndent(c.Users, "", "\t")
+	c.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := f.Write(append(buf, '\n')); err != nil {
+		return err
+	} else if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// UserCredential returns the credential of the user with id for the bridge
+// secret. Clients use it in place of the bridge secret.
+func UserCredential(secret []byte, id uint32) []byte {
+	cred := make([]byte, authUserIDSize, AuthCredentialSize)
+	binary.BigEndian.PutUint32(cred, id)
+	return append(cred, userSecret(secret, id)...)
+}
+
+// userSecret returns the secret of the user with id for the bridge secret.
+func userSecret(secret []byte, id uint32) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(userLabel))
+
+	var buf [authUserIDSize]byte
+	binary.BigEndian.PutUint32(buf[:], id)
+	h.Write(buf[:])
+	return h.Sum(nil)
+}
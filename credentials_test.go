@@ -0,0 +1,138 @@
+package marionette_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// Ensure only users with a valid credential are served.
+func TestCredentials(t *testing.T) {
+	secret := mustAuthSecret(t)
+	var creds marionette.Credentials
+	alice, err := creds.Issue(secret, "alice", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := creds.Issue(secret, "bob", time.Now().Add(-time.Second))
+	if err != nil {
+		t.Fatal(err)
+	} else if _, err := creds.Issue(secret, "alice", time.Time{}); err != marionette.ErrUserExists {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		if err := dialAuth(t, secret, &creds, alice); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("Expired", func(t *testing.T) {
+		if err := dialAuth(t, secret, &creds, bob); err == nil {
+			t.Fatal("expected connection to be closed")
+		}
+	})
+	t.Run("BridgeSecret", func(t *testing.T) {
+		if err := dialAuth(t, secret, &creds, secret); err == nil {
+			t.Fatal("expected connection to be closed")
+		}
+	})
+	// Bytes of served users are counted and users over quota are rejected.
+	t.Run("Quota", func(t *testing.T) {
+		creds.Usage = marionette.NewUsage()
+		defer func() { creds.Usage = nil }()
+
+		if err := creds.SetQuota("alice", 1, 0); err != nil {
+			t.Fatal(err)
+		} else if err := dialAuth(t, secret, &creds, alice); err != nil {
+			t.Fatal(err)
+		} else if a := creds.UsageStats(time.Now()); a[0].Bytes == 0 {
+			t.Fatalf("expected usage: %+v", a)
+		} else if err := dialAuth(t, secret, &creds, alice); err == nil {
+			t.Fatal("expected connection to be closed")
+		}
+	})
+	t.Run("Revoked", func(t *testing.T) {
+		if err := creds.Revoke("alice"); err != nil {
+			t.Fatal(err)
+		} else if err := dialAuth(t, secret, &creds, alice); err == nil {
+			t.Fatal("expected connection to be closed")
+		}
+	})
+}
+
+func TestCredentials_WriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "marionette-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "users.json")
+
+	var creds marionette.Credentials
+	cred, err := creds.Issue(mustAuthSecret(t), "alice", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(cred) != marionette.AuthCredentialSize {
+		t.Fatalf("unexpected credential size: %d", len(cred))
+	} else if err := creds.WriteFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := marionette.ReadCredentialsFile(path)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(other.Users) != 1 || other.Users[0].Name != "alice" || other.Users[0].ID != creds.Users[0].ID {
+		t.Fatalf("unexpected users: %+v", other.Users)
+	} else if err := other.Revoke("bob"); err != marionette.ErrUserNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// dialAuth opens a stream to a server with the secret & credentials using
+// clientSecret. Returns an error if the server closes the connection.
+func dialAuth(t *testing.T, secret []byte, creds *marionette.Credentials, clientSecret []byte) error {
+	t.Helper()
+	dialer, ln := marionette.Pipe(
+		mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc)),
+		mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)),
+	)
+	defer ln.Close()
+	ln.AuthSecret, ln.Credentials, dialer.AuthSecret = secret, creds, clientSecret
+
+	failed := make(chan error, 8)
+	dialer.OnError = func(err error) { failed <- err }
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientStream.Close()
+	mustWrite(t, clientStream, []byte("foo"))
+
+	accepted := make(chan struct{})
+	go func() {
+		if stream, err := ln.Accept(); err == nil {
+			stream.Close()
+			close(accepted)
+		}
+	}()
+
+	select {
+	case <-accepted:
+		return nil
+	case err := <-failed:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+		return nil
+	}
+}
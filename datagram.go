@@ -0,0 +1,123 @@
+package marionette
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// MaxDatagramSize is the largest datagram payload relayed through a stream.
+const MaxDatagramSize = 16384
+
+// DatagramIdleTimeout is the time a relay waits without traffic in either
+// direction before closing the association.
+var DatagramIdleTimeout = 2 * time.Minute
+
+var (
+	// ErrDatagramTooLarge is returned when writing a datagram larger than MaxDatagramSize.
+	ErrDatagramTooLarge = errors.New("marionette: datagram too large")
+)
+
+// WriteDatagram writes data addressed to or from addr as a single frame so
+// datagram boundaries are preserved over a stream. Each frame is the address
+// length, address, payload length, and payload.
+func WriteDatagram(w io.Writer, addr string, data []byte) error {
+	if len(data) > MaxDatagramSize {
+		return ErrDatagramTooLarge
+	} else if len(addr) > 255 {
+		return errors.New("marionette: datagram address too long")
+	}
+
+	buf := make([]byte, 0, 3+len(addr)+len(data)+2)
+	buf = append(buf, byte(len(addr)))
+	buf = append(buf, addr...)
+	buf = append(buf, byte(len(data)>>8), byte(len(data)))
+	buf = append(buf, data...)
+
+	// Write the frame in one call so it is added to the stream atomically.
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadDatagram reads the next frame written by WriteDatagram from r.
+func ReadDatagram(r io.Reader) (addr string, data []byte, err error) {
+	var n [2]byte
+	if _, err := io.ReadFull(r, n[:1]); err != nil {
+		return "", nil, err
+	}
+
+	buf := make([]byte, int(n[0]))
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", nil, err
+	} else if _, err := io.ReadFull(r, n[:]); err != nil {
+		return "", nil, err
+	}
+	addr = string(buf)
+
+	sz := int(binary.BigEndian.Uint16(n[:]))
+	if sz > MaxDatagramSize {
+		return "", nil, ErrDatagramTooLarge
+	}
+	data = make([]byte, sz)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return "", nil, err
+	}
+	return addr, data, nil
+}
+
+// relayDatagrams sends datagrams framed on conn to their addresses using pc
+// and frames replies received by pc back onto conn. Datagrams to addresses
+// not allowed by acl are dropped, if acl is set. Returns once conn is closed
+// or the association is idle for DatagramIdleTimeout.
+func relayDatagrams(conn net.Conn, pc net.PacketConn, acl *ACL) {
+	var once sync.Once
+	closeAll := func() { once.Do(func() { pc.Close(); conn.Close() }) }
+	defer closeAll()
+
+	pc.SetReadDeadline(time.Now().Add(DatagramIdleTimeout))
+
+	go func() {
+		defer closeAll()
+		for {
+			addr, data, err := ReadDatagram(conn)
+			if err != nil {
+				return
+			}
+
+			dest := addr
+			if acl != nil {
+				if dest, err = acl.Resolve(context.Background(), addr); err != nil {
+					proxyLogger().Debug("datagram relay: destination not allowed", zap.String("address", addr), zap.Error(err))
+					continue
+				}
+			}
+
+			udpAddr, err := net.ResolveUDPAddr("udp", dest)
+			if err != nil {
+				proxyLogger().Debug("datagram relay: cannot resolve address", zap.String("address", addr), zap.Error(err))
+				continue
+			} else if _, err := pc.WriteTo(data, udpAddr); err != nil {
+				proxyLogger().Debug("datagram relay: cannot send", zap.String("address", addr), zap.Error(err))
+				continue
+			}
+			pc.SetReadDeadline(time.Now().Add(DatagramIdleTimeout))
+		}
+	}()
+
+	buf := make([]byte, MaxDatagramSize)
+	for {
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			return
+		} else if err := WriteDatagram(conn, addr.String(), buf[:n]); err != nil {
+			return
+		}
+		pc.SetReadDeadline(time.Now().Add(DatagramIdleTimeout))
+	}
+}
@@ -0,0 +1,35 @@
+package marionette_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestWriteDatagram(t *testing.T) {
+	var buf bytes.Buffer
+	if err := marionette.WriteDatagram(&buf, "8.8.8.8:53", []byte("foo")); err != nil {
+		t.Fatal(err)
+	} else if err := marionette.WriteDatagram(&buf, "[::1]:443", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if addr, data, err := marionette.ReadDatagram(&buf); err != nil {
+		t.Fatal(err)
+	} else if addr != "8.8.8.8:53" || string(data) != "foo" {
+		t.Fatalf("unexpected datagram: addr=%q data=%q", addr, data)
+	}
+	if addr, data, err := marionette.ReadDatagram(&buf); err != nil {
+		t.Fatal(err)
+	} else if addr != "[::1]:443" || len(data) != 0 {
+		t.Fatalf("unexpected datagram: addr=%q data=%q", addr, data)
+	}
+}
+
+func TestWriteDatagram_ErrTooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	if err := marionette.WriteDatagram(&buf, "8.8.8.8:53", make([]byte, marionette.MaxDatagramSize+1)); err != marionette.ErrDatagramTooLarge {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
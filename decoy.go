@@ -0,0 +1,152 @@
+package marionette
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// DefaultDecoyTimeout is the default time an unauthenticated connection is
+// executed before being relayed to a listener's decoy.
+const DefaultDecoyTimeout = 10 * time.Second
+
+// DecoyDialTimeout is the maximum time allowed to connect to a listener's decoy.
+var DecoyDialTimeout = 10 * time.Second
+
+// maxDecoyReplay is the maximum number of bytes recorded from an
+// unauthenticated connection. Connections which send more before failing
+// authentication are closed rather than relayed to the decoy.
+const maxDecoyReplay = 64 * 1024
+
+// decoyConn records the bytes read from a connection until it authenticates so
+// that, if it fails to, the connection can be taken from the FSM and relayed
+// to a decoy service with the bytes replayed.
+type decoyConn struct {
+	net.Conn
+
+	rmu sync.Mutex // held by reads & the relay
+
+	mu        sync.Mutex
+	buf       []byte
+	recording bool // false once authenticated or the replay limit is exceeded
+	hijacked  bool
+}
+
+// newDecoyConn returns a connection which records reads from conn.
+func newDecoyConn(conn net.Conn) *decoyConn {
+	return &decoyConn{Conn: conn, recording: true}
+}
+
+// Read reads from the underlying connection, recording the bytes read until
+// the connection authenticates. Returns io.EOF once hijacked.
+func (c *decoyConn) Read(p []byte) (int, error) {
+	c.rmu.Lock()
+	defer c.rmu.Unlock()
+
+	if c.isHijacked() {
+		return 0, io.EOF
+	}
+	n, err := c.Conn.Read(p)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.recording {
+		if len(c.buf)+n > maxDecoyReplay {
+			c.buf, c.recording = nil, false
+		} else {
+			c.buf = append(c.buf, p[:n]...)
+		}
+	}
+	if c.hijacked {
+		return 0, io.EOF
+	}
+	return n, err
+}
+
+// Write writes to the underlying connection unless hijacked.
+func (c *decoyConn) Write(p []byte) (int, error) {
+	if c.isHijacked() {
+		return 0, io.ErrClosedPipe
+	}
+	return c.Conn.Write(p)
+}
+
+// Close closes the underlying connection unless it has been hijacked, in
+// which case it is closed once relayed.
+func (c *decoyConn) Close() error {
+	if c.isHijacked() {
+		return nil
+	}
+	return c.Conn.Close()
+}
+
+// authenticate stops recording once the connection has authenticated.
+func (c *decoyConn) authenticate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.buf, c.recording = nil, false
+}
+
+// hijack takes the connection from the FSM, interrupting a pending read.
+// Returns false if the connection has authenticated or cannot be replayed.
+func (c *decoyConn) hijack() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.hijacked {
+		return true
+	} else if !c.recording {
+		return false
+	}
+	c.hijacked = true
+	c.Conn.SetReadDeadline(time.Now())
+	return true
+}
+
+func (c *decoyConn) isHijacked() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hijacked
+}
+
+// serveDecoy connects a hijacked connection to the decoy at addr and relays
+// data in both directions, starting with the bytes read so far, until both
+// sides close or ctx is done. The connection is closed on return.
+func (c *decoyConn) serveDecoy(ctx context.Context, addr string) error {
+	// Wait for the FSM's interrupted read to return.
+	c.rmu.Lock()
+	defer c.rmu.Unlock()
+	defer c.Conn.Close()
+
+	if err := c.Conn.SetReadDeadline(time.Time{}); err != nil {
+		return err
+	}
+
+	dialer := net.Dialer{Timeout: DecoyDialTimeout}
+	decoy, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer decoy.Close()
+
+	c.mu.Lock()
+	buf := c.buf
+	c.buf = nil
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.Conn.Close()
+			decoy.Close()
+		case <-done:
+		}
+	}()
+
+	relay(c.Conn, io.MultiReader(bytes.NewReader(buf), c.Conn), decoy)
+	return nil
+}
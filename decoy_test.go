@@ -0,0 +1,93 @@
+package marionette_test
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// decoyTestDoc is pipeTestDoc listening on a random port.
+const decoyTestDoc = `
+connection(tcp, 0):
+  start      upstream   NULL 1.0
+  upstream   downstream up   1.0
+  downstream upstream   down 1.0
+
+action up:
+  client pipetest.send()
+  server pipetest.recv()
+
+action down:
+  server pipetest.send()
+  client pipetest.recv()
+`
+
+// Ensure connections which fail to authenticate are relayed to the decoy with
+// the bytes already received.
+func TestListener_Decoy(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyServer, []byte(decoyTestDoc))
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		cell := marionette.NewCell(0, 0, 0, marionette.NORMAL)
+		cell.UUID, cell.InstanceID = doc.UUID, 1
+		buf, err := cell.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		testListenerDecoy(t, doc, 0, buf)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		testListenerDecoy(t, doc, 50*time.Millisecond, []byte("GET / HTTP/1.0\r\n\r\n"))
+	})
+}
+
+func testListenerDecoy(t *testing.T, doc *mar.Document, timeout time.Duration, data []byte) {
+	t.Helper()
+	decoy := mustEchoServer(t)
+	defer decoy.Close()
+
+	ln := mustListen(t, doc,
+		marionette.WithAuthSecret(mustAuthSecret(t)),
+		marionette.WithDecoy(decoy.Addr().String(), timeout),
+	)
+	defer ln.Close()
+
+	conn := mustDial(t, ln)
+	defer conn.Close()
+	if _, err := conn.Write(data); err != nil {
+		t.Fatal(err)
+	}
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, len(data))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(buf, data) {
+		t.Fatalf("unexpected data from decoy: %q", buf)
+	}
+}
+
+// mustEchoServer returns a TCP listener which writes back all data received.
+func mustEchoServer(tb testing.TB) net.Listener {
+	tb.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() { defer conn.Close(); io.Copy(conn, conn) }()
+		}
+	}()
+	return ln
+}
@@ -2,63 +2,470 @@ package marionette
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"math/rand"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/redjack/marionette/mar"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 var (
 	// ErrDialerClosed is returned when trying to operate on a closed dialer.
 	ErrDialerClosed = errors.New("marionette: dialer closed")
+
+	// ErrNoChannels is returned when dialing while no connections to the
+	// server are open, such as while a reset connection is being replaced.
+	ErrNoChannels = errors.New("marionette: no open channels")
+
+	// ErrRedialLimit is reported when a dialer stops redialing because too
+	// many connections in a row failed right after opening.
+	ErrRedialLimit = errors.New("marionette: too many failed connections")
+)
+
+const (
+	// DefaultResumeTimeout is the default time spent redialing a reset connection.
+	DefaultResumeTimeout = 30 * time.Second
+
+	// ResumeRetryInterval is the default time before redialing a connection
+	// which failed right after opening, doubled for each failure in a row.
+	ResumeRetryInterval = 1 * time.Second
+
+	// MaxRedialInterval is the longest time waited before redialing.
+	MaxRedialInterval = 30 * time.Second
+
+	// DefaultMaxRedialFailures is the default number of connections in a row
+	// which may fail right after opening before the dialer stops redialing.
+	DefaultMaxRedialFailures = 5
+
+	// ServerRetryInterval is the time an unreachable server is skipped while
+	// other servers are available.
+	ServerRetryInterval = 1 * time.Minute
+
+	// channelWaitInterval is the time between checks for an open connection
+	// while DialContext() waits for one to be replaced.
+	channelWaitInterval = 50 * time.Millisecond
 )
 
 // Dialer represents a client-side dialer that communicates over the marionette protocol.
+// Streams are distributed across one or more parallel connections to the server.
 type Dialer struct {
 	mu        sync.RWMutex
 	addr      string
+	servers   []*dialerServer
+	server    int // index of last reachable server
 	doc       *mar.Document
-	fsm       FSM
 	streamSet *StreamSet
+	channels  []*dialerChannel
+	limiter   *RateLimiter
+	opts      *options
 
 	ctx    context.Context
 	cancel func()
 
-	closed bool
-	wg     sync.WaitGroup
+	closed   bool
+	failures int // connections in a row which failed right after opening
+	wg       sync.WaitGroup
+
+	// Additional servers to connect to, in order, when the current server is
+	// unreachable. Each is a host name or IP address like the one passed to
+	// NewDialer(). Servers must run the same format & version.
+	Fallbacks []string
+
+	// Number of parallel connections to open to the server. Defaults to 1.
+	Channels int
+
+	// If true, a connection which is reset is redialed and its streams are
+	// resumed on the new connection instead of being closed. Redialing is
+	// retried until ResumeTimeout elapses.
+	Resume        bool
+	ResumeTimeout time.Duration
+
+	// A connection which fails within RedialInterval of opening is redialed
+	// after an interval which doubles, with jitter, for each one in a row, up
+	// to MaxRedialInterval. Once MaxRedialFailures connections in a row have
+	// failed then the dialer stops redialing and reports ErrRedialLimit.
+	RedialInterval    time.Duration
+	MaxRedialFailures int
+
+	// Limits bytes sent & received, combined, per second across all
+	// connections to the server. Zero disables the limit.
+	RateLimit int
 
-	// Underlying NetDialer used for net connection.
+	// Underlying NetDialer used for net connection. Defaults to a
+	// HappyEyeballsDialer which races IPv6 & IPv4 addresses.
 	Dialer NetDialer
+
+	// If set, used to open connections to the server instead of Dialer.
+	DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+	// Options applied to TCP connections to the server, if set.
+	SocketOptions *SocketOptions
+
+	// Controls how messages are split into segments on connections to the
+	// server, if set.
+	Segmentation *WriteSegmentation
+
+	// Records the traffic of connections to the server, if set.
+	Capture *PcapWriter
+
+	// If true, all connections are bonded into a single session so that the
+	// cells of every stream are striped across them. A connection which is
+	// reset is redialed until ResumeTimeout elapses while its streams
+	// continue on the other connections. If Duplicate is also set then each
+	// cell is sent on every connection so the first copy to arrive is used.
+	Multipath bool
+	Duplicate bool
+
+	// Additional connections bonded by a multipath dialer, such as ones
+	// using a different format or server address.
+	Paths []DialerPath
+
+	// Bridge secret proven by an AUTH cell sent first on each connection, if
+	// set. Must match the server's Listener.AuthSecret, or be the user's
+	// credential if the server has Listener.Credentials.
+	AuthSecret []byte
+
+	// Randomizes the AUTH cell's padding and the size & timing of the first
+	// messages on each connection, if set. Usually NewHandshakeProfile() of
+	// AuthSecret so that each deployment has its own first-packet signature.
+	HandshakeProfile *HandshakeProfile
+
+	// Called when a stream is created in the dialer's stream sets, such as
+	// when the server opens a stream in reverse-tunnel mode. Must not block.
+	OnNewStream func(*Stream)
+
+	// Lifecycle callbacks, such as for a frontend showing connection status.
+	// OnConnect is called when a connection to the server is opened and
+	// OnHandshakeComplete once the server's first message is received.
+	// OnStreamOpen & OnStreamClose are called for every stream, including
+	// ones opened by the server. OnError is called when a connection fails
+	// or cannot be replaced. Each is called synchronously and must not block.
+	OnConnect           func(ConnInfo)
+	OnHandshakeComplete func(ConnInfo)
+	OnStreamOpen        func(*Stream)
+	OnStreamClose       func(*Stream)
+	OnError             func(error)
+}
+
+// DialerPath is an additional connection of a multipath dialer. Blank fields
+// default to the dialer's document & server.
+type DialerPath struct {
+	Doc  *mar.Document
+	Addr string
+}
+
+// dialerChannel is a single connection to the server and the streams it carries.
+type dialerChannel struct {
+	id        int
+	fsm       *fsm
+	span      trace.Span // spans the connection's lifetime
+	streamSet *StreamSet
+	path      *DialerPath
+	openedAt  time.Time
+}
+
+// dialerServer tracks the reachability of a server address.
+type dialerServer struct {
+	addr      string
+	downUntil time.Time // skipped until this time, if other servers are up
 }
 
-// NewDialer returns a new instance of Dialer.
-func NewDialer(doc *mar.Document, addr string, streamSet *StreamSet) *Dialer {
+// NewDialer returns a new instance of Dialer. The addr is a host name or IP
+// address; IPv6 literals may be bracketed. Options are applied to the dialer
+// and to the FSM of each connection.
+func NewDialer(doc *mar.Document, addr string, streamSet *StreamSet, opts ...Option) *Dialer {
 	// Run execution in a separate goroutine.
 	d := &Dialer{
-		addr:      addr,
+		addr:      trimHostBrackets(addr),
 		doc:       doc,
 		streamSet: streamSet,
-		Dialer:    &net.Dialer{},
+		opts:      newOptions(opts),
+		Channels:  1,
+		Dialer:    &HappyEyeballsDialer{},
+
+		ResumeTimeout:     DefaultResumeTimeout,
+		RedialInterval:    ResumeRetryInterval,
+		MaxRedialFailures: DefaultMaxRedialFailures,
 	}
+	d.DialFunc = d.opts.dial
+	d.AuthSecret = d.opts.authSecret
+	d.HandshakeProfile = d.opts.handshakeProfile
 	d.ctx, d.cancel = context.WithCancel(context.Background())
 	return d
 }
 
-// Open initializes the underlying connection.
+// Open initializes the underlying connections. The first connection uses the
+// stream set passed to NewDialer() and each additional connection uses its own.
+// If Multipath is set then every connection, including each of Paths, joins
+// the stream set passed to NewDialer() instead.
 func (d *Dialer) Open() error {
-	conn, err := d.Dialer.DialContext(d.ctx, d.doc.Transport, net.JoinHostPort(d.addr, d.doc.Port))
+	return d.OpenContext(context.Background())
+}
+
+// OpenContext is the same as Open() except that connecting to the server is
+// canceled, and the dialer closed, if ctx is done first. Once opened, the
+// dialer's connections are unaffected by ctx.
+func (d *Dialer) OpenContext(ctx context.Context) error {
+	n := d.Channels
+	if n < 1 {
+		n = 1
+	}
+	d.limiter = NewRateLimiter(d.RateLimit)
+	if d.OnNewStream != nil {
+		d.streamSet.OnNewStream = d.OnNewStream
+	}
+	if d.OnStreamOpen != nil {
+		d.streamSet.OnStreamOpen = d.OnStreamOpen
+	}
+	if d.OnStreamClose != nil {
+		d.streamSet.OnStreamClose = d.OnStreamClose
+	}
+
+	d.servers = []*dialerServer{{addr: d.addr}}
+	for _, addr := range d.Fallbacks {
+		d.servers = append(d.servers, &dialerServer{addr: trimHostBrackets(addr)})
+	}
+
+	if d.Multipath {
+		d.streamSet.mu.Lock()
+		d.streamSet.Duplicate = d.Duplicate
+		d.streamSet.mu.Unlock()
+
+		paths := make([]*DialerPath, n)
+		for i := range d.Paths {
+			paths = append(paths, &d.Paths[i])
+		}
+		for _, path := range paths {
+			if err := d.openChannel(ctx, d.newStreamSet(), path); err != nil {
+				d.Close()
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i := 0; i < n; i++ {
+		streamSet := d.streamSet
+		if i > 0 {
+			streamSet = d.newStreamSet()
+		}
+		if err := d.openChannel(ctx, streamSet, nil); err != nil {
+			d.Close()
+			return err
+		}
+	}
+	return nil
+}
+
+// openChannel connects to the server and begins executing a new FSM. A nil
+// path connects using the dialer's document & servers. Connecting is canceled
+// if either ctx is done or the dialer is closed.
+func (d *Dialer) openChannel(ctx context.Context, streamSet *StreamSet, path *DialerPath) error {
+	if d.Multipath {
+		if err := streamSet.Join(d.streamSet); err != nil {
+			return err
+		}
+	} else if d.Resume {
+		if err := streamSet.StartSession(); err != nil {
+			return err
+		}
+	}
+
+	d.mu.RLock()
+	doc := d.doc
+	d.mu.RUnlock()
+	if path != nil && path.Doc != nil {
+		doc = path.Doc
+	}
+
+	var dialCtx context.Context
+	var cancel context.CancelFunc
+	if d.opts.dialTimeout > 0 {
+		dialCtx, cancel = context.WithTimeout(ctx, d.opts.dialTimeout)
+	} else {
+		dialCtx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	stop := context.AfterFunc(d.ctx, cancel)
+	defer stop()
+
+	id := newConnID()
+	spanCtx, span := startConnSpan(d.ctx, PartyClient, doc.UUID, id)
+	_, dialSpan := tracer.Start(spanCtx, "marionette.dial")
+
+	var conn net.Conn
+	var addr string
+	var err error
+	if path != nil && path.Addr != "" {
+		addr = trimHostBrackets(path.Addr)
+		conn, err = d.dialContext(dialCtx, doc.Transport, net.JoinHostPort(addr, doc.Port))
+	} else {
+		conn, addr, err = d.dialServer(dialCtx, doc)
+	}
+	endSpan(dialSpan, err)
 	if err != nil {
+		endSpan(span, err)
+		if d.Multipath {
+			streamSet.Close()
+		}
 		return err
 	}
-	d.fsm = NewFSM(d.doc, d.addr, PartyClient, conn, d.streamSet)
+	setPeerAddr(span, conn)
+	streamSet.setTraceContext(spanCtx)
+
+	// Prove knowledge of the bridge secret before sending any other cell.
+	if d.AuthSecret != nil {
+		var padding int
+		if d.HandshakeProfile != nil {
+			padding = d.HandshakeProfile.padding()
+		}
+		if err := streamSet.queueAuth(d.AuthSecret, doc.UUID, padding); err != nil {
+			conn.Close()
+			endSpan(span, err)
+			return err
+		}
+	}
+
+	f := newFSM(doc, addr, PartyClient, RateLimitConn(conn, d.limiter), streamSet, d.opts)
+	f.dial = d.dialContext
+	f.setSegmentation(d.Segmentation)
+	f.setHandshakeProfile(d.HandshakeProfile)
+	f.startTracing(spanCtx)
+	ch := &dialerChannel{id: id, fsm: f, span: span, streamSet: streamSet, path: path, openedAt: time.Now()}
+	if d.OnHandshakeComplete != nil {
+		f.onHandshake = func() { d.OnHandshakeComplete(newConnInfo(ch.id, ch.fsm, ch.openedAt)) }
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		ch.fsm.Close()
+		ch.fsm.endTracing(ErrDialerClosed)
+		endSpan(span, ErrDialerClosed)
+		return ErrDialerClosed
+	}
+	d.channels = append(d.channels, ch)
 
 	d.wg.Add(1)
-	go func() { defer d.wg.Done(); d.execute() }()
+	go func() { defer d.wg.Done(); d.execute(ch) }()
 	return nil
 }
 
+// dialServer connects to the first reachable server, starting from the last
+// server which was reachable. Servers which fail are marked down and are only
+// tried once all other servers have failed or ServerRetryInterval has elapsed.
+// Returns the connection and the address of the server.
+func (d *Dialer) dialServer(ctx context.Context, doc *mar.Document) (net.Conn, string, error) {
+	d.mu.RLock()
+	var up, down []*dialerServer
+	now := time.Now()
+	for i := range d.servers {
+		s := d.servers[(d.server+i)%len(d.servers)]
+		if now.Before(s.downUntil) {
+			down = append(down, s)
+		} else {
+			up = append(up, s)
+		}
+	}
+	d.mu.RUnlock()
+
+	var err error
+	for _, s := range append(up, down...) {
+		var conn net.Conn
+		if conn, err = d.dialContext(ctx, doc.Transport, net.JoinHostPort(s.addr, doc.Port)); err == nil {
+			d.markServer(s, true)
+			return conn, s.addr, nil
+		} else if ctx.Err() != nil {
+			return nil, "", err
+		}
+		d.logger().Debug("dialer cannot connect to server", zap.String("addr", s.addr), zap.Error(err))
+		d.markServer(s, false)
+	}
+	return nil, "", err
+}
+
+// dialContext opens a connection using DialFunc, if set, or Dialer, applies
+// the socket options and starts capturing its traffic, if enabled. The
+// connection is wrapped in TLS if a TLS config was passed to NewDialer().
+func (d *Dialer) dialContext(ctx context.Context, network, address string) (conn net.Conn, err error) {
+	if d.DialFunc != nil {
+		conn, err = d.DialFunc(ctx, network, address)
+	} else {
+		conn, err = d.Dialer.DialContext(ctx, network, address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	applySocketOptions(d.SocketOptions, conn)
+	conn = CaptureConn(conn, d.Capture, PartyClient)
+
+	if d.opts.tlsConfig != nil {
+		config := d.opts.tlsConfig
+		if config.ServerName == "" {
+			config = config.Clone()
+			config.ServerName, _, _ = net.SplitHostPort(address)
+		}
+		tlsConn := tls.Client(conn, config)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+	return conn, nil
+}
+
+// logger returns the logger passed to NewDialer(), if any, or Logger.
+func (d *Dialer) logger() *zap.Logger {
+	if d.opts.logger != nil {
+		return d.opts.logger
+	}
+	return Logger
+}
+
+// onError calls OnError with err, if set.
+func (d *Dialer) onError(err error) {
+	if d.OnError != nil {
+		d.OnError(err)
+	}
+}
+
+// markServer records whether s was reachable. Reachable servers are preferred
+// for subsequent connections.
+func (d *Dialer) markServer(s *dialerServer, up bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !up {
+		s.downUntil = time.Now().Add(ServerRetryInterval)
+		return
+	}
+	s.downUntil = time.Time{}
+	for i := range d.servers {
+		if d.servers[i] == s {
+			d.server = i
+		}
+	}
+}
+
+// newStreamSet returns a stream set for an additional channel.
+func (d *Dialer) newStreamSet() *StreamSet {
+	streamSet := NewStreamSet()
+	streamSet.TracePath = d.streamSet.TracePath
+	streamSet.StreamRateLimit = d.streamSet.StreamRateLimit
+	streamSet.StreamIdleTimeout = d.streamSet.StreamIdleTimeout
+	streamSet.StreamMaxLifetime = d.streamSet.StreamMaxLifetime
+	streamSet.OnNewStream = d.OnNewStream
+	streamSet.OnStreamOpen = d.OnStreamOpen
+	streamSet.OnStreamClose = d.OnStreamClose
+	return streamSet
+}
+
 // Close stops the dialer and its underlying connections.
 func (d *Dialer) Close() error {
 	err := d.close()
@@ -69,13 +476,57 @@ func (d *Dialer) Close() error {
 func (d *Dialer) close() (err error) {
 	d.mu.Lock()
 	d.closed = true
-	err = d.fsm.Close()
+	for _, ch := range d.channels {
+		if e := ch.fsm.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
 	d.mu.Unlock()
 
 	d.cancel()
 	return err
 }
 
+// SetRateLimit changes the limit of bytes per second to & from the server,
+// including on open connections. A limit of zero is unlimited.
+func (d *Dialer) SetRateLimit(rate int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.RateLimit = rate
+	if d.limiter != nil {
+		d.limiter.SetRate(rate)
+	}
+}
+
+// Shutdown waits for all streams to close before closing the dialer. If ctx
+// is done first then the dialer is closed along with the remaining streams and
+// ctx's error is returned.
+func (d *Dialer) Shutdown(ctx context.Context) error {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for d.streamN() > 0 {
+		select {
+		case <-ctx.Done():
+			d.Close()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return d.Close()
+}
+
+// streamN returns the number of streams open on the dialer's connections.
+func (d *Dialer) streamN() int {
+	d.mu.RLock()
+	fsms := make([]FSM, 0, len(d.channels))
+	for _, ch := range d.channels {
+		fsms = append(fsms, ch.fsm)
+	}
+	d.mu.RUnlock()
+
+	return countStreams(fsms)
+}
+
 // Closed returns true if the dialer has been closed.
 func (d *Dialer) Closed() bool {
 	d.mu.RLock()
@@ -86,24 +537,313 @@ func (d *Dialer) Closed() bool {
 
 // Dial returns a new stream from the dialer.
 func (d *Dialer) Dial() (net.Conn, error) {
-	if d.Closed() {
+	streamSet, err := d.nextStreamSet()
+	if err != nil {
+		return nil, err
+	}
+	return streamSet.Create(), nil
+}
+
+// DialContext returns a new stream from the dialer. If no connections to the
+// server are open, such as while a reset connection is being replaced, it
+// waits for one until ctx is done.
+func (d *Dialer) DialContext(ctx context.Context) (net.Conn, error) {
+	streamSet, err := d.waitStreamSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return streamSet.Create(), nil
+}
+
+// DialDestination returns a new stream which the server connects to addr.
+func (d *Dialer) DialDestination(addr string) (net.Conn, error) {
+	streamSet, err := d.nextStreamSet()
+	if err != nil {
+		return nil, err
+	}
+	return streamSet.CreateDestination(addr), nil
+}
+
+// DialDatagram returns a new stream which relays datagrams through the server.
+func (d *Dialer) DialDatagram() (net.Conn, error) {
+	streamSet, err := d.nextStreamSet()
+	if err != nil {
+		return nil, err
+	}
+	return streamSet.CreateDatagram(), nil
+}
+
+// Conns returns a description of each open connection to the server, ordered by ID.
+func (d *Dialer) Conns() []ConnInfo {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	infos := make([]ConnInfo, 0, len(d.channels))
+	for _, ch := range d.channels {
+		infos = append(infos, newConnInfo(ch.id, ch.fsm, ch.openedAt))
+	}
+	return infos
+}
+
+// CloseConn closes the connection to the server with the given ID. It is
+// replaced, or resumed, the same as a connection which was reset.
+func (d *Dialer) CloseConn(id int) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for _, ch := range d.channels {
+		if ch.id == id {
+			return closeFSMConn(ch.fsm)
+		}
+	}
+	return ErrConnNotFound
+}
+
+// SetDocument replaces the document used by new connections to the server,
+// such as after a format is edited. Open connections continue with the
+// previous document.
+func (d *Dialer) SetDocument(doc *mar.Document) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.doc = doc
+}
+
+// SetServers replaces the servers used by new connections, in the same form as
+// the addr passed to NewDialer() followed by Fallbacks, such as after servers
+// are rotated. Open connections are unaffected. Must be called after Open().
+func (d *Dialer) SetServers(addrs []string) {
+	if len(addrs) == 0 {
+		return
+	}
+
+	servers := make([]*dialerServer, len(addrs))
+	for i, addr := range addrs {
+		servers[i] = &dialerServer{addr: trimHostBrackets(addr)}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.servers, d.server = servers, 0
+}
+
+// nextStreamSet returns the stream set of the channel with the fewest streams.
+// A multipath dialer always returns the set shared by its channels.
+func (d *Dialer) nextStreamSet() (*StreamSet, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
 		return nil, ErrDialerClosed
+	} else if len(d.channels) == 0 {
+		return nil, ErrNoChannels
+	} else if d.Multipath {
+		return d.streamSet, nil
+	}
+
+	ch, n := d.channels[0], d.channels[0].streamSet.Len()
+	for _, other := range d.channels[1:] {
+		if m := other.streamSet.Len(); m < n {
+			ch, n = other, m
+		}
+	}
+	return ch.streamSet, nil
+}
+
+// waitStreamSet returns the next stream set, waiting for an open connection
+// until ctx is done.
+func (d *Dialer) waitStreamSet(ctx context.Context) (*StreamSet, error) {
+	ticker := time.NewTicker(channelWaitInterval)
+	defer ticker.Stop()
+	for {
+		streamSet, err := d.nextStreamSet()
+		if err != ErrNoChannels {
+			return streamSet, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// resumeChannel redials the server for streamSet until a connection is opened
+// or ResumeTimeout elapses, waiting before each attempt after the failures
+// connections in a row which have failed right after opening. A multipath
+// dialer joins a new set on each attempt.
+func (d *Dialer) resumeChannel(streamSet *StreamSet, path *DialerPath, failures int) error {
+	deadline := time.Now().Add(d.ResumeTimeout)
+	for {
+		if err := d.waitRedial(failures); err != nil {
+			return err
+		}
+		if d.Multipath {
+			streamSet = d.newStreamSet()
+		}
+		err := d.openChannel(d.ctx, streamSet, path)
+		if err == nil || err == ErrDialerClosed || !time.Now().Before(deadline) {
+			return err
+		}
+		d.logger().Debug("dialer cannot reconnect, retrying", zap.Error(err))
+		failures++
+	}
+}
+
+// redialFailed records whether ch failed right after opening and returns the
+// number of connections in a row which have.
+func (d *Dialer) redialFailed(ch *dialerChannel) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if time.Since(ch.openedAt) < d.RedialInterval {
+		d.failures++
+	} else {
+		d.failures = 0
+	}
+	return d.failures
+}
+
+// redialDelay returns the time to wait before redialing after failures
+// connections in a row have failed. The interval doubles for each failure and
+// is jittered by up to half so that clients don't redial in lockstep.
+func (d *Dialer) redialDelay(failures int) time.Duration {
+	if failures <= 0 || d.RedialInterval <= 0 {
+		return 0
+	}
+	delay := d.RedialInterval
+	for i := 1; i < failures && delay < MaxRedialInterval; i++ {
+		delay *= 2
+	}
+	if delay > MaxRedialInterval {
+		delay = MaxRedialInterval
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// waitRedial waits for redialDelay(failures) or until the dialer is closed.
+func (d *Dialer) waitRedial(failures int) error {
+	delay := d.redialDelay(failures)
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-d.ctx.Done():
+		return ErrDialerClosed
+	case <-timer.C:
+		return nil
 	}
-	return d.streamSet.Create(), nil
 }
 
-func (d *Dialer) execute() {
-	defer d.close()
+func (d *Dialer) execute(ch *dialerChannel) {
+	registerFSM(ch.id, ch.fsm, ch.openedAt)
+	defer unregisterFSM(ch.id)
+	publishConnOpened(ch.id, ch.fsm)
+	if d.OnConnect != nil {
+		d.OnConnect(newConnInfo(ch.id, ch.fsm, ch.openedAt))
+	}
 
+	var err error
 	for !d.Closed() {
-		if err := d.fsm.Execute(d.ctx); err == ErrStreamClosed {
+		if err = ch.fsm.Execute(d.ctx); err == ErrStreamClosed {
+			err = nil
 			continue
 		} else if err != nil {
-			Logger.Debug("dialer error", zap.Error(err))
+			d.logger().Debug("dialer error", zap.Error(err))
+			break
+		}
+		ch.fsm.Reset()
+	}
+	if d.Closed() {
+		err = nil
+	}
+	publishConnClosed(ch.id, ch.fsm, err)
+	if err != nil {
+		d.onError(err)
+	}
+	ch.fsm.endTracing(err)
+	endSpan(ch.span, err)
+	d.resetChannel(ch)
+}
+
+// resetChannel removes a failed channel. If resumption is enabled then its
+// streams continue on a new connection. Otherwise its streams are closed since
+// their data cannot be recovered and a replacement connection is opened so that
+// new streams are spread across the same number of channels. A multipath
+// channel is redialed while the streams continue on the other channels.
+// Redialing backs off while connections fail right after opening and stops
+// after MaxRedialFailures in a row. The dialer is closed if no channels remain.
+func (d *Dialer) resetChannel(ch *dialerChannel) {
+	d.mu.Lock()
+	for i := range d.channels {
+		if d.channels[i] == ch {
+			d.channels = append(d.channels[:i], d.channels[i+1:]...)
+			break
+		}
+	}
+	closed := d.closed
+	d.mu.Unlock()
+
+	if closed {
+		return
+	}
+	ch.fsm.Close()
+
+	failures := d.redialFailed(ch)
+	if d.MaxRedialFailures > 0 && failures >= d.MaxRedialFailures {
+		d.logger().Debug("dialer stopped redialing", zap.Int("failures", failures))
+		d.onError(ErrRedialLimit)
+		ch.streamSet.Close()
+		d.closeIfEmpty()
+		return
+	}
+
+	if d.Multipath {
+		ch.streamSet.Close()
+		err := d.resumeChannel(nil, ch.path, failures)
+		if err == nil || err == ErrDialerClosed {
 			return
 		}
-		d.fsm.Reset()
+		d.logger().Debug("dialer cannot rejoin channel", zap.Error(err))
+		d.onError(err)
+		d.closeIfEmpty()
+		return
+	}
+
+	if d.Resume {
+		err := d.resumeChannel(ch.streamSet, nil, failures)
+		if err == nil || err == ErrDialerClosed {
+			return
+		}
+		d.logger().Debug("dialer cannot resume channel", zap.Error(err))
+		d.onError(err)
+	}
+	ch.streamSet.Close()
+
+	if err := d.waitRedial(failures); err != nil {
+		return
+	} else if err := d.openChannel(d.ctx, d.newStreamSet(), nil); err != nil {
+		d.logger().Debug("dialer cannot reopen channel", zap.Error(err))
+		d.onError(err)
+		d.closeIfEmpty()
+	}
+}
+
+// closeIfEmpty closes the dialer if no channels remain. A multipath dialer
+// also closes the streams of its session.
+func (d *Dialer) closeIfEmpty() {
+	d.mu.RLock()
+	n := len(d.channels)
+	d.mu.RUnlock()
+	if n > 0 {
+		return
+	}
+	if d.Multipath {
+		d.streamSet.Close()
 	}
+	d.close()
 }
 
 // NetDialer is an abstract dialer. net.Dialer implements the NetDialer interface.
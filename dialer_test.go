@@ -0,0 +1,535 @@
+package marionette_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// blockingDoc is a client document which waits for data that never arrives.
+const blockingDoc = `connection(tcp, 8080):
+  start end recv 1.0
+
+action recv:
+  client io.gets("foo")
+`
+
+func TestDialer_Channels(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	var pd pipeDialer
+	defer pd.Close()
+
+	streamSet := marionette.NewStreamSet()
+	dialer := marionette.NewDialer(doc, "127.0.0.1", streamSet)
+	dialer.Dialer = &pd
+	dialer.Channels = 3
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	if n := pd.N(); n != 3 {
+		t.Fatalf("unexpected connection count: %d", n)
+	}
+
+	// Ensure streams are spread across all channels.
+	for i := 0; i < 3; i++ {
+		if _, err := dialer.Dial(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := streamSet.Len(); n != 1 {
+		t.Fatalf("unexpected stream count: %d", n)
+	}
+}
+
+// Ensure a reset connection closes its streams and is replaced.
+func TestDialer_ResetChannel(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	var pd pipeDialer
+	defer pd.Close()
+
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+	dialer.Dialer = &pd
+	dialer.Channels = 2
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	// Streams are assigned to the first channel when counts are equal.
+	stream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pd.Conn(0).Close()
+
+	select {
+	case <-stream.(*marionette.Stream).ReadCloseNotify():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected stream close")
+	}
+
+	// Wait for the replacement connection.
+	for i := 0; pd.N() != 3; i++ {
+		if i > 500 {
+			t.Fatal("expected replacement connection")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if dialer.Closed() {
+		t.Fatal("expected dialer to remain open")
+	} else if _, err := dialer.Dial(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// pipeDialer returns in-memory connections and holds the server side of each.
+type pipeDialer struct {
+	mu    sync.Mutex
+	conns []net.Conn
+	addrs []string
+	times []time.Time
+
+	unreachable map[string]bool // addresses which refuse connections
+	hangup      bool            // if true, connections are closed once dialed
+}
+
+func (d *pipeDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+func (d *pipeDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.unreachable[address] {
+		return nil, errors.New("connection refused")
+	}
+
+	client, server := net.Pipe()
+	d.conns = append(d.conns, server)
+	d.addrs = append(d.addrs, address)
+	d.times = append(d.times, time.Now())
+	if d.hangup {
+		server.Close()
+	}
+	return client, nil
+}
+
+// N returns the number of connections dialed.
+func (d *pipeDialer) N() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.conns)
+}
+
+// Conn returns the server side of the i-th connection.
+func (d *pipeDialer) Conn(i int) net.Conn {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.conns[i]
+}
+
+// Addr returns the address of the i-th connection.
+func (d *pipeDialer) Addr(i int) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.addrs[i]
+}
+
+// Time returns the time the i-th connection was dialed.
+func (d *pipeDialer) Time(i int) time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.times[i]
+}
+
+// SetUnreachable sets whether connections to address are refused.
+func (d *pipeDialer) SetUnreachable(address string, v bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.unreachable == nil {
+		d.unreachable = make(map[string]bool)
+	}
+	d.unreachable[address] = v
+}
+
+func (d *pipeDialer) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, conn := range d.conns {
+		conn.Close()
+	}
+	return nil
+}
+
+// Ensure a reset connection is redialed and its streams remain open.
+func TestDialer_Resume(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	var pd pipeDialer
+	defer pd.Close()
+
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+	dialer.Dialer = &pd
+	dialer.Resume = true
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	stream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pd.Conn(0).Close()
+
+	// Wait for the replacement connection.
+	for i := 0; pd.N() != 2; i++ {
+		if i > 500 {
+			t.Fatal("expected replacement connection")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if stream.(*marionette.Stream).ReadClosed() {
+		t.Fatal("expected stream to remain open")
+	}
+}
+
+// Ensure connections which fail right after opening are redialed with backoff
+// until the dialer gives up.
+func TestDialer_RedialBackoff(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	pd := pipeDialer{hangup: true}
+	defer pd.Close()
+
+	errs := make(chan error, 10)
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+	dialer.Dialer = &pd
+	dialer.RedialInterval = 50 * time.Millisecond
+	dialer.MaxRedialFailures = 4
+	dialer.OnError = func(err error) { errs <- err }
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	// Wait for the dialer to give up.
+	timeout := time.After(5 * time.Second)
+	for done := false; !done; {
+		select {
+		case err := <-errs:
+			done = err == marionette.ErrRedialLimit
+		case <-timeout:
+			t.Fatal("expected redial limit")
+		}
+	}
+	if !dialer.Closed() {
+		t.Fatal("expected dialer to close")
+	} else if n := pd.N(); n != 4 {
+		t.Fatalf("unexpected connection count: %d", n)
+	}
+
+	// Each interval is at least half of double the previous one.
+	for i, min := 1, 25*time.Millisecond; i < pd.N(); i, min = i+1, min*2 {
+		if d := pd.Time(i).Sub(pd.Time(i - 1)); d < min {
+			t.Fatalf("redial %d after %s, expected at least %s", i, d, min)
+		}
+	}
+}
+
+// Ensure a dialer lists its connections and that a closed connection is
+// replaced.
+func TestDialer_Conns(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	var pd pipeDialer
+	defer pd.Close()
+
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+	dialer.Dialer = &pd
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	infos := dialer.Conns()
+	if len(infos) != 1 {
+		t.Fatalf("unexpected conn count: %d", len(infos))
+	} else if infos[0].Party != marionette.PartyClient {
+		t.Fatalf("unexpected party: %s", infos[0].Party)
+	}
+
+	id := infos[0].ID
+	if err := dialer.CloseConn(id); err != nil {
+		t.Fatal(err)
+	}
+
+	// Wait for the replacement connection.
+	for i := 0; pd.N() != 2; i++ {
+		if i > 500 {
+			t.Fatal("expected replacement connection")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for i := 0; ; i++ {
+		if infos := dialer.Conns(); len(infos) == 1 && infos[0].ID != id {
+			break
+		} else if i > 500 {
+			t.Fatal("expected replacement connection to be listed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Ensure a dialer shutting down waits for open streams to close.
+func TestDialer_Shutdown(t *testing.T) {
+	dialer, ln, clientStream, serverStream := mustPipeStream(t)
+	defer ln.Close()
+
+	done := make(chan error, 1)
+	go func() { done <- dialer.Shutdown(context.Background()) }()
+
+	select {
+	case err := <-done:
+		t.Fatalf("unexpected shutdown while streams open: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	clientStream.Close()
+	serverStream.Close()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		} else if !dialer.Closed() {
+			t.Fatal("expected dialer to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected shutdown once streams closed")
+	}
+}
+
+// Ensure a multipath dialer shares its streams across all paths and that a
+// reset path is redialed while the streams remain open.
+func TestDialer_Multipath(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	var pd pipeDialer
+	defer pd.Close()
+
+	streamSet := marionette.NewStreamSet()
+	dialer := marionette.NewDialer(doc, "127.0.0.1", streamSet)
+	dialer.Dialer = &pd
+	dialer.Multipath = true
+	dialer.Paths = []marionette.DialerPath{{Addr: "127.0.0.2"}}
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	if n := pd.N(); n != 2 {
+		t.Fatalf("unexpected connection count: %d", n)
+	} else if addr := pd.Addr(1); addr != "127.0.0.2:8080" {
+		t.Fatalf("unexpected path address: %s", addr)
+	}
+
+	stream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	} else if n := streamSet.Len(); n != 1 {
+		t.Fatalf("unexpected stream count: %d", n)
+	}
+
+	pd.Conn(1).Close()
+
+	// Wait for the replacement connection on the same path.
+	for i := 0; pd.N() != 3; i++ {
+		if i > 500 {
+			t.Fatal("expected replacement connection")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if addr := pd.Addr(2); addr != "127.0.0.2:8080" {
+		t.Fatalf("unexpected path address: %s", addr)
+	} else if stream.(*marionette.Stream).ReadClosed() {
+		t.Fatal("expected stream to remain open")
+	}
+}
+
+// Ensure the dialer fails over to the next server when a server is unreachable.
+func TestDialer_Fallbacks(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	t.Run("OK", func(t *testing.T) {
+		var pd pipeDialer
+		defer pd.Close()
+		pd.SetUnreachable("127.0.0.1:8080", true)
+
+		dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+		dialer.Dialer = &pd
+		dialer.Fallbacks = []string{"[::1]", "127.0.0.3"}
+		if err := dialer.Open(); err != nil {
+			t.Fatal(err)
+		}
+		defer dialer.Close()
+
+		if addr := pd.Addr(0); addr != "[::1]:8080" {
+			t.Fatalf("unexpected address: %s", addr)
+		}
+
+		// Replacement connections fail over again when the server goes down.
+		pd.SetUnreachable("[::1]:8080", true)
+		pd.Conn(0).Close()
+		for i := 0; pd.N() != 2; i++ {
+			if i > 500 {
+				t.Fatal("expected replacement connection")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if addr := pd.Addr(1); addr != "127.0.0.3:8080" {
+			t.Fatalf("unexpected address: %s", addr)
+		}
+	})
+
+	t.Run("ErrUnreachable", func(t *testing.T) {
+		var pd pipeDialer
+		defer pd.Close()
+		pd.SetUnreachable("127.0.0.1:8080", true)
+		pd.SetUnreachable("127.0.0.2:8080", true)
+
+		dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+		dialer.Dialer = &pd
+		dialer.Fallbacks = []string{"127.0.0.2"}
+		if err := dialer.Open(); err == nil || err.Error() != "connection refused" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure connecting to the server stops once the context is done.
+func TestDialer_OpenContext(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+	dialer.Dialer = hangingDialer{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := dialer.OpenContext(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("unexpected error: %v", err)
+	} else if !dialer.Closed() {
+		t.Fatal("expected dialer to be closed")
+	}
+}
+
+// Ensure dialing a stream waits for an open connection until the context is done.
+func TestDialer_DialContext(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	var pd pipeDialer
+	defer pd.Close()
+
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+	dialer.Dialer = &pd
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	if conn, err := dialer.DialContext(context.Background()); err != nil {
+		t.Fatal(err)
+	} else {
+		conn.Close()
+	}
+
+	// Closed dialers return immediately.
+	dialer.Close()
+	if _, err := dialer.DialContext(context.Background()); err != marionette.ErrDialerClosed {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// hangingDialer blocks until the context is done.
+type hangingDialer struct{}
+
+func (hangingDialer) Dial(network, address string) (net.Conn, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (hangingDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// Ensure lifecycle callbacks are called as connections & streams open and close.
+func TestDialer_Callbacks(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	var pd pipeDialer
+	defer pd.Close()
+
+	connected, opened, closed, failed := make(chan marionette.ConnInfo, 8), make(chan int, 8), make(chan int, 8), make(chan error, 8)
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+	dialer.Dialer = &pd
+	dialer.OnConnect = func(info marionette.ConnInfo) { connected <- info }
+	dialer.OnStreamOpen = func(s *marionette.Stream) { opened <- s.ID() }
+	dialer.OnStreamClose = func(s *marionette.Stream) { closed <- s.ID() }
+	dialer.OnError = func(err error) { failed <- err }
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	select {
+	case info := <-connected:
+		if info.Party != marionette.PartyClient {
+			t.Fatalf("unexpected party: %s", info.Party)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected connect")
+	}
+
+	stream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := stream.(*marionette.Stream).ID()
+	select {
+	case other := <-opened:
+		if other != id {
+			t.Fatalf("unexpected stream opened: %d", other)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected stream open")
+	}
+
+	// A reset connection reports an error and closes its streams.
+	pd.Conn(0).Close()
+	select {
+	case <-failed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected error")
+	}
+	select {
+	case other := <-closed:
+		if other != id {
+			t.Fatalf("unexpected stream closed: %d", other)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected stream close")
+	}
+}
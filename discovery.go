@@ -0,0 +1,112 @@
+package marionette
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DiscoveryPrefix is prepended to a domain to form the name whose TXT records
+// are looked up by a Discoverer.
+const DiscoveryPrefix = "_marionette."
+
+// ErrNoDiscoveryRecords is returned when a domain has no valid, unexpired
+// discovery records.
+var ErrNoDiscoveryRecords = errors.New("marionette: no valid discovery records")
+
+// Discoverer looks up the current servers of a domain from signed TXT records
+// so that operators can rotate servers without redistributing configs. Each
+// record is returned by SignDiscoveryRecord() and has the form:
+//
+//	BRIDGE-LINE EXPIRES SIGNATURE
+//
+// where EXPIRES is a Unix time and SIGNATURE is the base64 ed25519 signature
+// of the bridge line & expiry. Records which are invalid, expired or signed
+// by another key are ignored as DNS responses may be forged.
+type Discoverer struct {
+	Domain    string
+	PublicKey ed25519.PublicKey
+
+	// Looks up the TXT records of a name. Defaults to net.DefaultResolver.
+	LookupTXT func(ctx context.Context, name string) ([]string, error)
+
+	// Returns the current time, used to check expiry. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewDiscoverer returns a discoverer for domain with records signed by key.
+func NewDiscoverer(domain string, key ed25519.PublicKey) *Discoverer {
+	return &Discoverer{
+		Domain:    domain,
+		PublicKey: key,
+		LookupTXT: net.DefaultResolver.LookupTXT,
+		Now:       time.Now,
+	}
+}
+
+// Discover returns the bridge lines of the domain's valid records in the order
+// they were returned. Returns ErrNoDiscoveryRecords if none are valid.
+func (d *Discoverer) Discover(ctx context.Context) ([]*BridgeLine, error) {
+	name := DiscoveryPrefix + strings.TrimSuffix(d.Domain, ".")
+	records, err := d.LookupTXT(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return verifyDiscoveryRecords(d.PublicKey, records, d.Now(), name)
+}
+
+// verifyDiscoveryRecords returns the bridge lines of the valid records, which
+// were read from source. Returns ErrNoDiscoveryRecords if none are valid.
+func verifyDiscoveryRecords(key ed25519.PublicKey, records []string, now time.Time, source string) ([]*BridgeLine, error) {
+	var lines []*BridgeLine
+	for _, record := range records {
+		b, err := VerifyDiscoveryRecord(key, record, now)
+		if err != nil {
+			Logger.Debug("ignoring discovery record", zap.String("source", source), zap.Error(err))
+			continue
+		}
+		lines = append(lines, b)
+	}
+	if len(lines) == 0 {
+		return nil, ErrNoDiscoveryRecords
+	}
+	return lines, nil
+}
+
+// SignDiscoveryRecord returns a TXT record for b, valid until expires, signed
+// with key.
+func SignDiscoveryRecord(key ed25519.PrivateKey, b *BridgeLine, expires time.Time) string {
+	msg := b.String() + " " + strconv.FormatInt(expires.Unix(), 10)
+	return msg + " " + base64.StdEncoding.EncodeToString(ed25519.Sign(key, []byte(msg)))
+}
+
+// VerifyDiscoveryRecord checks the signature & expiry of a record returned by
+// SignDiscoveryRecord() and returns its bridge line.
+func VerifyDiscoveryRecord(key ed25519.PublicKey, record string, now time.Time) (*BridgeLine, error) {
+	fields := strings.Fields(record)
+	if len(fields) != 3 {
+		return nil, errors.New("marionette: invalid discovery record")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(fields[2])
+	if err != nil || !ed25519.Verify(key, []byte(fields[0]+" "+fields[1]), sig) {
+		return nil, errors.New("marionette: invalid discovery record signature")
+	}
+
+	expires, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("marionette: invalid discovery record expiry: %q", fields[1])
+	} else if !now.Before(time.Unix(expires, 0)) {
+		return nil, errors.New("marionette: discovery record expired")
+	}
+	return ParseBridgeLine(fields[0])
+}
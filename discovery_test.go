@@ -0,0 +1,78 @@
+package marionette_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestDiscoverer_Discover(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	b0 := marionette.NewBridgeLine("192.0.2.1:8443", "http_simple_blocking:20150701")
+	b1 := marionette.NewBridgeLine("192.0.2.2:8443", "http_simple_blocking:20150701")
+	records := []string{
+		marionette.SignDiscoveryRecord(key, b0, now.Add(time.Hour)),
+		marionette.SignDiscoveryRecord(key, marionette.NewBridgeLine("192.0.2.3", "ftp_simple_blocking"), now),
+		marionette.SignDiscoveryRecord(otherKey, marionette.NewBridgeLine("192.0.2.4", "ftp_simple_blocking"), now.Add(time.Hour)),
+		"v=spf1 -all",
+		marionette.SignDiscoveryRecord(key, b1, now.Add(time.Hour)),
+	}
+
+	d := marionette.NewDiscoverer("example.com.", pub)
+	d.Now = func() time.Time { return now }
+	d.LookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		if name != "_marionette.example.com" {
+			t.Fatalf("unexpected name: %s", name)
+		}
+		return records, nil
+	}
+
+	if lines, err := d.Discover(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(lines, []*marionette.BridgeLine{b0, b1}) {
+		t.Fatalf("unexpected bridge lines: %v", lines)
+	}
+
+	records = records[1:4]
+	if _, err := d.Discover(context.Background()); err != marionette.ErrNoDiscoveryRecords {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestVerifyDiscoveryRecord_Err(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	record := marionette.SignDiscoveryRecord(key, marionette.NewBridgeLine("192.0.2.1", "http_simple_blocking"), now.Add(time.Hour))
+
+	if _, err := marionette.VerifyDiscoveryRecord(pub, record, now); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"",
+		record + " extra",
+		"marionette://192.0.2.9?format=http_simple_blocking" + record[len("marionette://192.0.2.1?format=http_simple_blocking"):],
+	} {
+		if _, err := marionette.VerifyDiscoveryRecord(pub, s, now); err == nil {
+			t.Fatalf("expected error: %q", s)
+		}
+	}
+	if _, err := marionette.VerifyDiscoveryRecord(pub, record, now.Add(time.Hour)); err == nil {
+		t.Fatal("expected expiry error")
+	}
+}
@@ -0,0 +1,138 @@
+package marionette
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Types of events published to event sinks.
+const (
+	EventConnOpened   = "conn.opened"
+	EventConnClosed   = "conn.closed"
+	EventHandshake    = "handshake"
+	EventStreamOpened = "stream.opened"
+	EventStreamClosed = "stream.closed"
+	EventFormatError  = "format.error"
+	EventProbe        = "probe"
+	EventProbeAlert   = "probe.alert"
+)
+
+// Event describes a change in the lifecycle of a connection or stream. Fields
+// which do not apply to the event's type are empty. A handshake event with an
+// error is a failed handshake. A format error is an FSM stopping with an error
+// other than the peer closing the connection. A probe event is a connection
+// which looks like an active probe and a probe alert is a burst of them.
+type Event struct {
+	Type         string    `json:"type"`
+	Time         time.Time `json:"time"`
+	Party        string    `json:"party,omitempty"`
+	Format       string    `json:"format,omitempty"`
+	ConnID       int       `json:"conn_id,omitempty"`
+	StreamID     int       `json:"stream_id,omitempty"`
+	RemoteAddr   string    `json:"remote_addr,omitempty"`
+	Destination  string    `json:"destination,omitempty"`
+	BytesRead    int64     `json:"bytes_read,omitempty"`
+	BytesWritten int64     `json:"bytes_written,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	Probe        string    `json:"probe,omitempty"`
+	Pattern      string    `json:"pattern,omitempty"`
+	Probes       int       `json:"probes,omitempty"`
+}
+
+// EventSink receives the events of every listener & dialer in the process.
+// WriteEvent is called synchronously so sinks which may block, such as on
+// the network, must queue events.
+type EventSink interface {
+	WriteEvent(e *Event) error
+	Close() error
+}
+
+// eventSinks holds the sinks events are published to.
+var eventSinks struct {
+	mu    sync.RWMutex
+	sinks []EventSink
+}
+
+// AddEventSink publishes all subsequent events to sink.
+func AddEventSink(sink EventSink) {
+	eventSinks.mu.Lock()
+	defer eventSinks.mu.Unlock()
+	eventSinks.sinks = append(eventSinks.sinks, sink)
+}
+
+// RemoveEventSink stops publishing events to sink. The sink is not closed.
+func RemoveEventSink(sink EventSink) {
+	eventSinks.mu.Lock()
+	defer eventSinks.mu.Unlock()
+	for i := range eventSinks.sinks {
+		if eventSinks.sinks[i] == sink {
+			eventSinks.sinks = append(eventSinks.sinks[:i], eventSinks.sinks[i+1:]...)
+			return
+		}
+	}
+}
+
+// publishEvent writes e to each sink, setting its time if unset. Sink errors
+// are logged and do not affect the connection.
+func publishEvent(e Event) {
+	eventSinks.mu.RLock()
+	defer eventSinks.mu.RUnlock()
+	if len(eventSinks.sinks) == 0 {
+		return
+	}
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	for _, sink := range eventSinks.sinks {
+		if err := sink.WriteEvent(&e); err != nil {
+			Logger.Debug("cannot write event", zap.String("type", e.Type), zap.Error(err))
+		}
+	}
+}
+
+// publishConnOpened publishes the opening of the connection executed by fsm.
+func publishConnOpened(id int, fsm *fsm) {
+	e := Event{Type: EventConnOpened, Party: fsm.party, Format: fsm.doc.Format, ConnID: id}
+	if fsm.conn != nil {
+		e.RemoteAddr = fsm.conn.RemoteAddr().String()
+	}
+	publishEvent(e)
+}
+
+// publishConnClosed publishes the end of the connection executed by fsm and
+// the error which stopped it, if any. A failed handshake or format error is
+// published first.
+func publishConnClosed(id int, fsm *fsm, err error) {
+	e := Event{Type: EventConnClosed, Party: fsm.party, Format: fsm.doc.Format, ConnID: id}
+	if fsm.conn != nil {
+		e.RemoteAddr = fsm.conn.RemoteAddr().String()
+		e.BytesRead, e.BytesWritten = fsm.conn.BytesRead(), fsm.conn.BytesWritten()
+	}
+
+	if !fsm.handshook {
+		reason := errHandshakeIncomplete
+		if err != nil {
+			reason = err
+		}
+		publishEvent(Event{Type: EventHandshake, Party: e.Party, Format: e.Format, ConnID: id, RemoteAddr: e.RemoteAddr, Error: reason.Error()})
+	}
+	if err != nil {
+		e.Error = err.Error()
+		publishEvent(Event{Type: EventFormatError, Party: e.Party, Format: e.Format, ConnID: id, RemoteAddr: e.RemoteAddr, Error: e.Error})
+	}
+	publishEvent(e)
+}
+
+// publishStreamEvent publishes the opening or closing of a stream.
+func publishStreamEvent(typ string, stream *Stream) {
+	stream.mu.RLock()
+	e := Event{Type: typ, StreamID: stream.id, Destination: stream.dest}
+	if typ == EventStreamClosed {
+		e.BytesRead, e.BytesWritten = int64(stream.rconsumed), int64(stream.wsent)
+	}
+	stream.mu.RUnlock()
+	publishEvent(e)
+}
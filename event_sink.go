@@ -0,0 +1,139 @@
+package marionette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WebhookQueueSize is the number of events a webhook sink buffers while
+// posting. Events are dropped while the queue is full.
+const WebhookQueueSize = 1024
+
+// WebhookTimeout is the time allowed for each webhook request.
+const WebhookTimeout = 10 * time.Second
+
+// ErrEventQueueFull is returned when an event is dropped by a full queue.
+var ErrEventQueueFull = errors.New("marionette: event queue full")
+
+// ErrEventSinkClosed is returned when an event is written to a closed sink.
+var ErrEventSinkClosed = errors.New("marionette: event sink closed")
+
+// JSONEventSink writes each event as a line of JSON.
+type JSONEventSink struct {
+	mu sync.Mutex
+	w  io.WriteCloser
+}
+
+// NewJSONEventSink returns a sink writing JSON lines to w, which is closed
+// with the sink.
+func NewJSONEventSink(w io.WriteCloser) *JSONEventSink {
+	return &JSONEventSink{w: w}
+}
+
+func (s *JSONEventSink) WriteEvent(e *Event) error {
+	buf, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(buf, '\n'))
+	return err
+}
+
+func (s *JSONEventSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w.Close()
+}
+
+// WebhookEventSink posts each event as JSON to a URL. Events are posted in
+// order from a queue so slow endpoints do not delay connections.
+type WebhookEventSink struct {
+	URL    string
+	Client *http.Client
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan *Event
+	done   chan struct{}
+}
+
+// NewWebhookEventSink returns a sink posting events to url.
+func NewWebhookEventSink(url string) *WebhookEventSink {
+	s := &WebhookEventSink{
+		URL:    url,
+		Client: &http.Client{Timeout: WebhookTimeout},
+		queue:  make(chan *Event, WebhookQueueSize),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+func (s *WebhookEventSink) WriteEvent(e *Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrEventSinkClosed
+	}
+
+	other := *e
+	select {
+	case s.queue <- &other:
+		return nil
+	default:
+		return ErrEventQueueFull
+	}
+}
+
+// Close posts the queued events and stops the sink.
+func (s *WebhookEventSink) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+
+	<-s.done
+	return nil
+}
+
+func (s *WebhookEventSink) run() {
+	defer close(s.done)
+	for e := range s.queue {
+		if err := s.post(e); err != nil {
+			Logger.Debug("cannot post event to webhook", zap.Error(err))
+		}
+	}
+}
+
+// post sends a single event to the webhook URL.
+func (s *WebhookEventSink) post(e *Event) error {
+	buf, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.Client.Post(s.URL, "application/json", bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
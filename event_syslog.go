@@ -0,0 +1,39 @@
+//go:build !windows && !plan9 && !js
+// +build !windows,!plan9,!js
+
+package marionette
+
+import (
+	"encoding/json"
+	"log/syslog"
+)
+
+// SyslogEventSink writes each event as JSON to syslog. Failed handshakes,
+// format errors & probes are written as warnings, other events as info.
+type SyslogEventSink struct {
+	w *syslog.Writer
+}
+
+// NewSyslogEventSink connects to the syslog daemon at raddr over network, or
+// to the local daemon if raddr is empty.
+func NewSyslogEventSink(network, raddr string) (*SyslogEventSink, error) {
+	w, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_DAEMON, "marionette")
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogEventSink{w: w}, nil
+}
+
+func (s *SyslogEventSink) WriteEvent(e *Event) error {
+	buf, err := json.Marshal(e)
+	if err != nil {
+		return err
+	} else if e.Error != "" || e.Type == EventProbe || e.Type == EventProbeAlert {
+		return s.w.Warning(string(buf))
+	}
+	return s.w.Info(string(buf))
+}
+
+func (s *SyslogEventSink) Close() error {
+	return s.w.Close()
+}
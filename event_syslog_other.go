@@ -0,0 +1,17 @@
+//go:build windows || plan9 || js
+// +build windows plan9 js
+
+package marionette
+
+import "errors"
+
+// SyslogEventSink is not supported on this platform.
+type SyslogEventSink struct{}
+
+// NewSyslogEventSink returns an error as syslog is not supported on this platform.
+func NewSyslogEventSink(network, raddr string) (*SyslogEventSink, error) {
+	return nil, errors.New("marionette: syslog is not supported on this platform")
+}
+
+func (s *SyslogEventSink) WriteEvent(e *Event) error { return nil }
+func (s *SyslogEventSink) Close() error              { return nil }
@@ -0,0 +1,131 @@
+package marionette_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+func TestJSONEventSink(t *testing.T) {
+	var buf nopCloseBuffer
+	sink := marionette.NewJSONEventSink(&buf)
+	if err := sink.WriteEvent(&marionette.Event{Type: marionette.EventConnOpened, ConnID: 1}); err != nil {
+		t.Fatal(err)
+	} else if err := sink.WriteEvent(&marionette.Event{Type: marionette.EventConnClosed, ConnID: 1, Error: "EOF"}); err != nil {
+		t.Fatal(err)
+	} else if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("unexpected line count: %d", len(lines))
+	}
+	var e marionette.Event
+	if err := json.Unmarshal([]byte(lines[1]), &e); err != nil {
+		t.Fatal(err)
+	} else if e.Type != marionette.EventConnClosed || e.ConnID != 1 || e.Error != "EOF" {
+		t.Fatalf("unexpected event: %+v", e)
+	}
+}
+
+func TestWebhookEventSink(t *testing.T) {
+	var mu sync.Mutex
+	var types []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e marionette.Event
+		if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		types = append(types, e.Type)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	sink := marionette.NewWebhookEventSink(srv.URL)
+	sink.WriteEvent(&marionette.Event{Type: marionette.EventStreamOpened})
+	sink.WriteEvent(&marionette.Event{Type: marionette.EventStreamClosed})
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Queued events are posted, in order, before Close returns.
+	mu.Lock()
+	defer mu.Unlock()
+	if len(types) != 2 || types[0] != marionette.EventStreamOpened || types[1] != marionette.EventStreamClosed {
+		t.Fatalf("unexpected events: %v", types)
+	}
+	if err := sink.WriteEvent(&marionette.Event{}); err != marionette.ErrEventSinkClosed {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure listeners publish the opening & handshake of connections.
+func TestListener_Events(t *testing.T) {
+	sink := &eventRecorder{}
+	marionette.AddEventSink(sink)
+	defer marionette.RemoveEventSink(sink)
+
+	doc := mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc))
+	ln := mustListen(t, doc)
+	defer ln.Close()
+
+	conn := mustDial(t, ln)
+	defer conn.Close()
+	mustWrite(t, conn, []byte("fo"))
+	assertConnOpen(t, conn)
+
+	id := ln.Conns()[0].ID
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if e := sink.find(marionette.EventConnOpened, id); e != nil {
+			if e.Party != marionette.PartyServer || e.RemoteAddr != conn.LocalAddr().String() {
+				t.Fatalf("unexpected event: %+v", e)
+			}
+			return
+		}
+	}
+	t.Fatal("expected conn.opened event")
+}
+
+// eventRecorder is an event sink which keeps every event.
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []marionette.Event
+}
+
+func (r *eventRecorder) WriteEvent(e *marionette.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, *e)
+	return nil
+}
+
+func (r *eventRecorder) Close() error { return nil }
+
+// find returns the first event of the given type & connection, if any.
+func (r *eventRecorder) find(typ string, connID int) *marionette.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.events {
+		if r.events[i].Type == typ && r.events[i].ConnID == connID {
+			return &r.events[i]
+		}
+	}
+	return nil
+}
+
+// nopCloseBuffer is a bytes.Buffer with a Close method.
+type nopCloseBuffer struct {
+	bytes.Buffer
+}
+
+func (b *nopCloseBuffer) Close() error { return nil }
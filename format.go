@@ -0,0 +1,236 @@
+package marionette
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/redjack/marionette/mar"
+)
+
+// FormatOptions configures the dialers & listeners created by DialFormat()
+// and ListenFormat(). A nil *FormatOptions uses the defaults.
+type FormatOptions struct {
+	// Interface address a listener binds to, which may include a port to use
+	// instead of the format's. A blank address listens on all interfaces.
+	Bind string
+
+	// Number of parallel connections a dialer opens to the server.
+	// Defaults to 1.
+	Channels int
+
+	// Additional servers a dialer tries, in order, when the server is
+	// unreachable.
+	Fallbacks []string
+
+	// Limits bytes sent & received, combined, per second. Zero disables the limit.
+	RateLimit int
+
+	// Options applied to TCP connections, if set.
+	SocketOptions *SocketOptions
+
+	// Controls how messages are split into segments on connections, if set.
+	Segmentation *WriteSegmentation
+
+	// Underlying NetDialer used to connect to the server, if set.
+	Dialer NetDialer
+
+	// Additional options passed to the dialer or listener.
+	Options []Option
+}
+
+// formatDialers holds the dialers shared by streams from DialFormat(),
+// keyed by format & server address.
+var formatDialers = struct {
+	mu sync.Mutex
+	m  map[string]*formatDialer
+}{m: make(map[string]*formatDialer)}
+
+// formatDialer is a dialer shared by the open streams of DialFormat().
+type formatDialer struct {
+	key    string
+	dialer *Dialer
+	refs   int
+	ready  chan struct{} // closed once the dialer has opened or failed
+	err    error
+}
+
+// DialFormat returns a new stream to the server at serverAddr using the named
+// format, such as "http_simple_blocking" or "http_simple_blocking:20150701".
+// The serverAddr is a host name or IP address and may include a port to use
+// instead of the format's.
+//
+// Streams to the same server & format are multiplexed over a shared dialer
+// which is opened by the first call, using opts, and closed once its last
+// stream is closed. The plugins used by the format must be registered, such
+// as by importing the plugins package.
+func DialFormat(ctx context.Context, format, serverAddr string, opts *FormatOptions) (net.Conn, error) {
+	return dialFormat(ctx, format, serverAddr, "", opts)
+}
+
+// DialFormatDestination returns a new stream which the server at serverAddr
+// connects to addr, the same as DialFormat() otherwise. The server must allow
+// destinations, such as by running with -tunnel.
+func DialFormatDestination(ctx context.Context, format, serverAddr, addr string, opts *FormatOptions) (net.Conn, error) {
+	return dialFormat(ctx, format, serverAddr, addr, opts)
+}
+
+// dialFormat returns a stream from the shared dialer of the format & server.
+// The stream connects to dest, if set, or to the server's proxy address.
+func dialFormat(ctx context.Context, format, serverAddr, dest string, opts *FormatOptions) (net.Conn, error) {
+	key := format + "|" + serverAddr
+
+	formatDialers.mu.Lock()
+	fd := formatDialers.m[key]
+	if fd == nil || fd.dialer.Closed() {
+		doc, err := readFormatDocument(PartyClient, format)
+		if err != nil {
+			formatDialers.mu.Unlock()
+			return nil, err
+		}
+		host := splitFormatAddr(doc, serverAddr)
+
+		fd = &formatDialer{key: key, ready: make(chan struct{})}
+		if opts != nil {
+			fd.dialer = NewDialer(doc, host, NewStreamSet(), opts.Options...)
+			opts.applyDialer(fd.dialer)
+		} else {
+			fd.dialer = NewDialer(doc, host, NewStreamSet())
+		}
+		formatDialers.m[key] = fd
+		go func() {
+			fd.err = fd.dialer.Open()
+			close(fd.ready)
+		}()
+	}
+	fd.refs++
+	formatDialers.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		fd.release()
+		return nil, ctx.Err()
+	case <-fd.ready:
+	}
+	if fd.err != nil {
+		fd.release()
+		return nil, fd.err
+	}
+
+	var conn net.Conn
+	var err error
+	if dest != "" {
+		conn, err = fd.dialer.DialDestination(dest)
+	} else {
+		conn, err = fd.dialer.DialContext(ctx)
+	}
+	if err != nil {
+		fd.release()
+		return nil, err
+	}
+	return &formatConn{Stream: conn.(*Stream), release: fd.release}, nil
+}
+
+// release removes a reference to the dialer and closes it once unreferenced.
+func (fd *formatDialer) release() {
+	formatDialers.mu.Lock()
+	fd.refs--
+	if fd.refs > 0 {
+		formatDialers.mu.Unlock()
+		return
+	}
+	if formatDialers.m[fd.key] == fd {
+		delete(formatDialers.m, fd.key)
+	}
+	formatDialers.mu.Unlock()
+
+	// A dialer which failed to open has already closed itself.
+	go func() {
+		<-fd.ready
+		if fd.err == nil {
+			fd.dialer.Close()
+		}
+	}()
+}
+
+// formatConn is a stream returned by DialFormat().
+type formatConn struct {
+	*Stream
+	once    sync.Once
+	release func()
+}
+
+// Close closes the stream and releases its dialer.
+func (c *formatConn) Close() error {
+	err := c.Stream.Close()
+	c.once.Do(c.release)
+	return err
+}
+
+// ListenFormat returns a listener serving the named format. Each accepted
+// net.Conn is a stream multiplexed over a client's connections. The listener
+// must be closed by the caller. The plugins used by the format must be
+// registered, such as by importing the plugins package.
+func ListenFormat(format string, opts *FormatOptions) (net.Listener, error) {
+	doc, err := readFormatDocument(PartyServer, format)
+	if err != nil {
+		return nil, err
+	}
+
+	var bind string
+	var options []Option
+	if opts != nil {
+		bind = splitFormatAddr(doc, opts.Bind)
+		options = opts.Options
+	}
+	ln, err := listen(doc, bind, newOptions(options))
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil {
+		ln.RateLimit = opts.RateLimit
+		ln.SocketOptions = opts.SocketOptions
+		ln.Segmentation = opts.Segmentation
+	}
+	ln.start()
+	return ln, nil
+}
+
+// applyDialer copies the options which apply to a dialer.
+func (opts *FormatOptions) applyDialer(d *Dialer) {
+	if opts.Channels > 0 {
+		d.Channels = opts.Channels
+	}
+	d.Fallbacks = opts.Fallbacks
+	d.RateLimit = opts.RateLimit
+	d.SocketOptions = opts.SocketOptions
+	d.Segmentation = opts.Segmentation
+	if opts.Dialer != nil {
+		d.Dialer = opts.Dialer
+	}
+}
+
+// readFormatDocument reads & parses the named format for party.
+func readFormatDocument(party, format string) (*mar.Document, error) {
+	data, err := mar.ReadFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := mar.Parse(party, data)
+	if err != nil {
+		return nil, err
+	}
+	doc.Format = mar.StripFormatVersion(format)
+	return doc, nil
+}
+
+// splitFormatAddr returns the host of addr. If addr includes a port then it
+// replaces the port of doc.
+func splitFormatAddr(doc *mar.Document, addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	doc.Port = port
+	return host
+}
@@ -0,0 +1,35 @@
+package marionette
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+// NewHTTPTransport returns an HTTP transport which sends each request through
+// the server at serverAddr using the named format. Every connection to a web
+// server is a stream which the marionette server connects to the request's
+// host, so the server must allow destinations, such as by running with
+// -tunnel. The transport may be further configured before use:
+//
+//	client := &http.Client{Transport: marionette.NewHTTPTransport("http_simple_blocking", "example.com", nil)}
+func NewHTTPTransport(format, serverAddr string, opts *FormatOptions) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = nil
+	t.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return DialFormatDestination(ctx, format, serverAddr, addr, opts)
+	}
+	return t
+}
+
+// GRPCDialer returns a dial function for grpc.WithContextDialer() which
+// connects to each gRPC target through the server at serverAddr using the
+// named format. As with NewHTTPTransport(), the server must allow
+// destinations:
+//
+//	conn, err := grpc.Dial("backend:443", grpc.WithContextDialer(marionette.GRPCDialer("http_simple_blocking", "example.com", nil)))
+func GRPCDialer(format, serverAddr string, opts *FormatOptions) func(ctx context.Context, addr string) (net.Conn, error) {
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		return DialFormatDestination(ctx, format, serverAddr, addr, opts)
+	}
+}
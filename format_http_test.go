@@ -0,0 +1,46 @@
+package marionette_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestNewHTTPTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	ln, err := marionette.ListenFormat("http_simple_blocking:20150701", &marionette.FormatOptions{Bind: "127.0.0.1:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	proxy := marionette.NewServerProxy(ln.(*marionette.Listener))
+	proxy.AllowDestinations = true
+	if err := proxy.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer proxy.Close()
+
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	transport := marionette.NewHTTPTransport("http_simple_blocking:20150701", net.JoinHostPort("127.0.0.1", port), nil)
+	defer transport.CloseIdleConnections()
+
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if body, err := io.ReadAll(resp.Body); err != nil {
+		t.Fatal(err)
+	} else if string(body) != "ok" {
+		t.Fatalf("unexpected body: %q", body)
+	}
+}
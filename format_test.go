@@ -0,0 +1,61 @@
+package marionette_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestDialFormat(t *testing.T) {
+	ln, err := marionette.ListenFormat("http_simple_blocking:20150701", &marionette.FormatOptions{Bind: "127.0.0.1:0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	// Echo the first message of each accepted stream.
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 5)
+				if _, err := io.ReadFull(conn, buf); err == nil {
+					conn.Write(buf)
+				}
+			}()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	conn, err := marionette.DialFormat(ctx, "http_simple_blocking:20150701", net.JoinHostPort("127.0.0.1", port), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "hello" {
+		t.Fatalf("unexpected echo: %q", buf)
+	}
+}
+
+func TestDialFormat_NotFound(t *testing.T) {
+	if _, err := marionette.DialFormat(context.Background(), "no_such_format", "127.0.0.1", nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
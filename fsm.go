@@ -11,9 +11,11 @@ import (
 	"regexp"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/redjack/marionette/fte"
 	"github.com/redjack/marionette/mar"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -25,6 +27,10 @@ var (
 	ErrRetryTransition = errors.New("retry transition")
 
 	ErrUUIDMismatch = errors.New("uuid mismatch")
+
+	// ErrActionTimeout is returned when an action does not complete within
+	// its timeout and no error transition is available.
+	ErrActionTimeout = errors.New("action timeout")
 )
 
 // FSM represents an interface for the Marionette state machine.
@@ -65,7 +71,9 @@ type FSM interface {
 	Conn() *BufferedConn
 
 	// Listen opens a new listener to accept data and drains into the buffer.
-	Listen() (int, error)
+	// The network may be "tcp" or "udp". If minPort is non-zero then a port
+	// is chosen between minPort and maxPort, inclusive.
+	Listen(network string, minPort, maxPort int) (int, error)
 
 	// Returns the stream set attached to the FSM.
 	StreamSet() *StreamSet
@@ -74,9 +82,16 @@ type FSM interface {
 	SetVar(key string, value interface{})
 	Var(key string) interface{}
 
+	// Returns a copy of all variables set on the FSM.
+	Vars() map[string]interface{}
+
 	// Returns a copy of the FSM with a different format.
 	Clone(doc *mar.Document) FSM
 
+	// Returns the number of transitions taken. Both parties take the same
+	// transitions so they are at the same step in each state.
+	Step() int
+
 	Logger() *zap.Logger
 }
 
@@ -90,15 +105,42 @@ type fsm struct {
 	party    string
 	fteCache *fte.Cache
 
-	conn       *BufferedConn
-	streamSet  *StreamSet
-	listeners  map[int]net.Listener
-	closeFuncs []func() error
+	conn        *BufferedConn
+	streamSet   *StreamSet
+	listeners   map[int]net.Listener
+	packetConns map[int]*packetListener
+	portsMu     *sync.Mutex // guards listeners & packetConns, shared by clones
+	closeFuncs  []func() error
+
+	// Opens client connections when the port changes. Uses a net.Dialer if nil.
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
+
+	// Segmentation applied to writes on each connection, if set.
+	segmentation *WriteSegmentation
+
+	// Randomizes the first writes on each connection, if set.
+	handshakeProfile *HandshakeProfile
+
+	// Records the traffic of connections accepted when the port changes, if set.
+	capture *PcapWriter
+
+	// Options passed to NewFSM(), and the timer which closes the FSM if the
+	// handshake does not complete within the handshake timeout.
+	opts           *options
+	handshakeTimer *time.Timer
 
 	state string
 	stepN int
 	rand  *rand.Rand
 
+	// Guards the state, step count, vars & history while the FSM is
+	// inspected from other goroutines. Only the FSM's goroutine writes them.
+	infoMu  sync.Mutex
+	history []FSMTransition // most recent transitions, oldest first
+
+	// Time when the current state's action times out, if it has a timeout.
+	deadline time.Time
+
 	mu     sync.Mutex
 	closed bool
 	ctx    context.Context
@@ -111,24 +153,56 @@ type fsm struct {
 
 	// Set by the first sender and used to seed PRNG.
 	instanceID int
+
+	// True once a message from the peer has been received.
+	handshook bool
+
+	// Called once the handshake completes, if set by the FSM's dialer.
+	onHandshake func()
+
+	// ID of the connection executed by the FSM, set by its listener or dialer.
+	connID int
+
+	// Parent of the FSM's spans, and the spans of the handshake & the
+	// current state while they are open.
+	traceCtx      context.Context
+	handshakeSpan trace.Span
+	stateSpan     trace.Span
 }
 
 // NewFSM returns a new FSM. If party is the first sender then the instance id is set.
-func NewFSM(doc *mar.Document, host, party string, conn net.Conn, streamSet *StreamSet) FSM {
+func NewFSM(doc *mar.Document, host, party string, conn net.Conn, streamSet *StreamSet, opts ...Option) FSM {
+	return newFSM(doc, host, party, conn, streamSet, newOptions(opts))
+}
+
+func newFSM(doc *mar.Document, host, party string, conn net.Conn, streamSet *StreamSet, opts *options) *fsm {
 	fsm := &fsm{
-		state:     "start",
-		vars:      make(map[string]interface{}),
-		doc:       doc,
-		host:      host,
-		party:     party,
-		fteCache:  fte.NewCache(),
-		conn:      NewBufferedConn(conn, MaxCellLength),
-		streamSet: streamSet,
-		listeners: make(map[int]net.Listener),
+		state:       "start",
+		vars:        make(map[string]interface{}),
+		doc:         doc,
+		host:        trimHostBrackets(host),
+		party:       party,
+		fteCache:    opts.fteCache,
+		streamSet:   streamSet,
+		portsMu:     &sync.Mutex{},
+		listeners:   make(map[int]net.Listener),
+		packetConns: make(map[int]*packetListener),
+		dial:        opts.dial,
+		opts:        opts,
 	}
+	if fsm.fteCache == nil {
+		fsm.fteCache = fte.NewCache()
+	}
+	fsm.conn = fsm.newBufferedConn(conn)
 	fsm.ctx, fsm.cancel = context.WithCancel(context.TODO())
 	fsm.buildTransitions()
 	fsm.initFirstSender()
+	if opts.handshakeTimeout > 0 {
+		fsm.handshakeTimer = time.AfterFunc(opts.handshakeTimeout, func() {
+			fsm.Logger().Debug("handshake timeout")
+			fsm.Close()
+		})
+	}
 	return fsm
 }
 
@@ -143,7 +217,11 @@ func (fsm *fsm) initFirstSender() {
 	if fsm.party != fsm.doc.FirstSender() {
 		return
 	}
-	fsm.instanceID = int(rand.Int31())
+	if fsm.opts.rand != nil {
+		fsm.instanceID = int(fsm.opts.rand.Int31())
+	} else {
+		fsm.instanceID = int(rand.Int31())
+	}
 	fsm.rand = rand.New(rand.NewSource(int64(fsm.instanceID)))
 }
 
@@ -152,6 +230,9 @@ func (fsm *fsm) Close() error {
 	defer fsm.mu.Unlock()
 	fsm.closed = true
 	fsm.cancel()
+	if fsm.handshakeTimer != nil {
+		fsm.handshakeTimer.Stop()
+	}
 	return fsm.Conn().Close()
 }
 
@@ -162,8 +243,11 @@ func (fsm *fsm) Closed() bool {
 }
 
 func (fsm *fsm) Reset() {
+	fsm.infoMu.Lock()
 	fsm.state = "start"
 	fsm.vars = make(map[string]interface{})
+	fsm.infoMu.Unlock()
+	fsm.deadline = time.Time{}
 
 	for _, fn := range fsm.closeFuncs {
 		if err := fn(); err != nil {
@@ -180,10 +264,18 @@ func (fsm *fsm) UUID() int { return fsm.doc.UUID }
 func (fsm *fsm) InstanceID() int { return fsm.instanceID }
 
 // SetInstanceID sets the ID for the FSM.
-func (fsm *fsm) SetInstanceID(id int) { fsm.instanceID = id }
+func (fsm *fsm) SetInstanceID(id int) {
+	fsm.infoMu.Lock()
+	fsm.instanceID = id
+	fsm.infoMu.Unlock()
+}
 
 // State returns the current state of the FSM.
-func (fsm *fsm) State() string { return fsm.state }
+func (fsm *fsm) State() string {
+	fsm.infoMu.Lock()
+	defer fsm.infoMu.Unlock()
+	return fsm.state
+}
 
 // Conn returns the connection the FSM was initialized with.
 func (fsm *fsm) Conn() *BufferedConn { return fsm.conn }
@@ -212,6 +304,14 @@ func (fsm *fsm) Port() int {
 	return 0
 }
 
+// setState moves to state without evaluating actions, such as when replaying
+// the steps taken before the PRNG was seeded.
+func (fsm *fsm) setState(state string) {
+	fsm.infoMu.Lock()
+	fsm.state = state
+	fsm.infoMu.Unlock()
+}
+
 // Dead returns true when the FSM is complete.
 func (fsm *fsm) Dead() bool { return fsm.state == "dead" }
 
@@ -244,15 +344,57 @@ func (fsm *fsm) Next(ctx context.Context) (err error) {
 		return err
 	}
 
+	// Record the time spent in each state, including retries, as a span.
+	// Idle cover traffic is not recorded so only states during the handshake
+	// or while streams are open have spans.
+	if fsm.stateSpan == nil && (fsm.handshakeSpan != nil || (fsm.streamSet != nil && fsm.streamSet.target().Len() > 0)) {
+		_, fsm.stateSpan = tracer.Start(fsm.traceContext(), "marionette.state", trace.WithAttributes(attrState.String(fsm.state)))
+	}
+
 	// If we have a successful transition, update our state info.
 	// Exit if no transitions were successful.
 	nextState, err := fsm.next(true)
-	if err != nil {
+	if err == ErrRetryTransition {
+		return err
+	} else if err != nil {
+		fsm.endStateSpan(err)
 		return err
 	}
+	if fsm.stateSpan != nil {
+		fsm.stateSpan.SetAttributes(attrNextState.String(nextState))
+		fsm.endStateSpan(nil)
+	}
 
+	fsm.infoMu.Lock()
+	fsm.history = append(fsm.history, FSMTransition{From: fsm.state, To: nextState, Time: time.Now()})
+	if len(fsm.history) > MaxFSMHistory {
+		fsm.history = fsm.history[len(fsm.history)-MaxFSMHistory:]
+	}
 	fsm.stepN += 1
 	fsm.state = nextState
+	fsm.infoMu.Unlock()
+	fsm.deadline = time.Time{}
+
+	// The handshake completes with the first transition after a message is
+	// received from the peer. Bytes may be buffered before the message is
+	// decoded so the peer's instance ID must also be known.
+	if !fsm.handshook && fsm.instanceID != 0 && fsm.conn != nil && fsm.conn.BytesRead() > 0 {
+		fsm.handshook = true
+		if fsm.handshakeTimer != nil {
+			fsm.handshakeTimer.Stop()
+		}
+		publishEvent(Event{Type: EventHandshake, Party: fsm.party, Format: fsm.doc.Format, ConnID: fsm.connID, RemoteAddr: fsm.conn.RemoteAddr().String()})
+		if fsm.onHandshake != nil {
+			fsm.onHandshake()
+		}
+		if fsm.handshakeSpan != nil {
+			attr := attrInstanceID.Int(fsm.instanceID)
+			fsm.handshakeSpan.SetAttributes(attr)
+			fsm.handshakeSpan.End()
+			fsm.handshakeSpan = nil
+			trace.SpanFromContext(fsm.traceCtx).SetAttributes(attr)
+		}
+	}
 
 	return nil
 }
@@ -284,9 +426,13 @@ func (fsm *fsm) next(eval bool) (nextState string, err error) {
 		}
 		actions := mar.FilterActionsByParty(blk.Actions, fsm.party)
 
-		// Attempt to execute each action.
+		// Attempt to execute each action. Move to the error state, if
+		// available, when an action times out.
 		if eval {
-			if err := fsm.evalActions(actions); err != nil {
+			if err := fsm.evalActions(actions); err == ErrActionTimeout && len(errorTransitions) > 0 {
+				fsm.Logger().Debug("action timeout", zap.String("state", fsm.state), zap.String("error_state", errorTransitions[0].Destination))
+				return errorTransitions[0].Destination, nil
+			} else if err != nil {
 				return "", err
 			}
 		}
@@ -305,13 +451,14 @@ func (fsm *fsm) init() (err error) {
 	fsm.rand = rand.New(rand.NewSource(int64(fsm.instanceID)))
 
 	// Restart FSM from the beginning and iterate until the current step.
-	fsm.state = "start"
+	state := "start"
+	fsm.setState(state)
 	for i := 0; i < fsm.stepN; i++ {
-		fsm.state, err = fsm.next(false)
-		if err != nil {
+		if state, err = fsm.next(false); err != nil {
 			return err
 		}
-		assert(fsm.state != "")
+		assert(state != "")
+		fsm.setState(state)
 	}
 	return nil
 }
@@ -330,18 +477,28 @@ func (fsm *fsm) evalActions(actions []*mar.Action) error {
 				return err
 			}
 
-			// Only evaluate action if buffer matches.
-			buf, err := fsm.conn.Peek(-1, false)
-			if err != nil {
-				return err
-			} else if !re.Match(buf) {
-				continue
+			// Only evaluate action if the variable, if set, or buffer matches.
+			if action.Var != "" {
+				if v := fsm.Var(action.Var); v == nil || !re.MatchString(fmt.Sprint(v)) {
+					continue
+				}
+			} else {
+				buf, err := fsm.conn.Peek(-1, false)
+				if err != nil {
+					return err
+				} else if !re.Match(buf) {
+					continue
+				}
 			}
 		}
 
 		fn := FindPlugin(action.Module, action.Method)
 		if fn == nil {
 			return fmt.Errorf("plugin not found: %s", action.Name())
+		} else if !mar.PartyAllowed(action.Module, action.Method, fsm.party) {
+			return fmt.Errorf("plugin cannot be invoked by %s: %s", fsm.party, action.Name())
+		} else if timeout := action.Timeout(); timeout > 0 {
+			return fsm.evalActionWithTimeout(fn, action, timeout)
 		} else if err := fn(fsm.ctx, fsm, action.ArgValues()...); err != nil {
 			return err
 		}
@@ -351,6 +508,34 @@ func (fsm *fsm) evalActions(actions []*mar.Action) error {
 	return ErrNoTransitions
 }
 
+// evalActionWithTimeout executes an action which must complete before its
+// timeout. The deadline is kept across retries of the same state so actions
+// which repeatedly retry still time out.
+func (fsm *fsm) evalActionWithTimeout(fn PluginFunc, action *mar.Action, timeout time.Duration) error {
+	if fsm.deadline.IsZero() {
+		fsm.deadline = time.Now().Add(timeout)
+	}
+	if !time.Now().Before(fsm.deadline) {
+		return ErrActionTimeout
+	}
+
+	ctx, cancel := context.WithDeadline(fsm.ctx, fsm.deadline)
+	defer cancel()
+
+	// Ensure blocking reads return once the deadline passes.
+	fsm.conn.SetPeekDeadline(fsm.deadline)
+	defer fsm.conn.SetPeekDeadline(time.Time{})
+
+	if err := fn(ctx, fsm, action.ArgValues()...); err == ErrRetryTransition {
+		return err
+	} else if err != nil && !time.Now().Before(fsm.deadline) {
+		return ErrActionTimeout
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (fsm *fsm) Var(key string) interface{} {
 	switch key {
 	case "model_instance_id":
@@ -360,12 +545,27 @@ func (fsm *fsm) Var(key string) interface{} {
 	case "party":
 		return fsm.party
 	default:
+		fsm.infoMu.Lock()
+		defer fsm.infoMu.Unlock()
 		return fsm.vars[key]
 	}
 }
 
 func (fsm *fsm) SetVar(key string, value interface{}) {
+	fsm.infoMu.Lock()
 	fsm.vars[key] = value
+	fsm.infoMu.Unlock()
+}
+
+func (fsm *fsm) Vars() map[string]interface{} {
+	fsm.infoMu.Lock()
+	defer fsm.infoMu.Unlock()
+
+	other := make(map[string]interface{}, len(fsm.vars))
+	for k, v := range fsm.vars {
+		other[k] = v
+	}
+	return other
 }
 
 // Cipher returns a cipher with the given settings.
@@ -380,10 +580,52 @@ func (fsm *fsm) DFA(regex string, n int) (DFA, error) {
 	return fsm.fteCache.DFA(regex, n)
 }
 
-func (fsm *fsm) Listen() (port int, err error) {
-	addr := fsm.host
-	if s := os.Getenv("MARIONETTE_CHANNEL_BIND_PORT"); s != "" {
-		addr = net.JoinHostPort(addr, s)
+func (fsm *fsm) Listen(network string, minPort, maxPort int) (port int, err error) {
+	if network == "" {
+		network = "tcp"
+	} else if network != "tcp" && network != "udp" {
+		return 0, fmt.Errorf("invalid listen network: %q", network)
+	}
+
+	// Use a random port, or the override port, if no range is specified.
+	if minPort == 0 {
+		addr := net.JoinHostPort(fsm.host, "0")
+		if s := os.Getenv("MARIONETTE_CHANNEL_BIND_PORT"); s != "" {
+			addr = net.JoinHostPort(fsm.host, s)
+		}
+		return fsm.listen(network, addr)
+	}
+
+	if maxPort < minPort {
+		return 0, fmt.Errorf("invalid listen port range: %d-%d", minPort, maxPort)
+	}
+
+	// Start at a random offset in the range and try each port until one binds.
+	n := maxPort - minPort + 1
+	offset := rand.Intn(n)
+	for i := 0; i < n; i++ {
+		addr := net.JoinHostPort(fsm.host, strconv.Itoa(minPort+(offset+i)%n))
+		if port, err = fsm.listen(network, addr); err == nil {
+			return port, nil
+		}
+	}
+	return 0, err
+}
+
+func (fsm *fsm) listen(network, addr string) (port int, err error) {
+	fsm.portsMu.Lock()
+	defer fsm.portsMu.Unlock()
+
+	if network == "udp" {
+		conn, err := net.ListenPacket("udp", addr)
+		if err != nil {
+			return 0, err
+		}
+		port = conn.LocalAddr().(*net.UDPAddr).Port
+		l := newPacketListener(conn)
+		fsm.packetConns[port] = l
+		fsm.closeFuncs = append(fsm.closeFuncs, l.Close)
+		return port, nil
 	}
 
 	ln, err := net.Listen("tcp", addr)
@@ -408,47 +650,124 @@ func (fsm *fsm) ensureConn(ctx context.Context) error {
 }
 
 func (fsm *fsm) ensureClientConn(ctx context.Context) error {
-	conn, err := net.Dial(fsm.doc.Transport, net.JoinHostPort(fsm.host, strconv.Itoa(fsm.Port())))
+	dial := fsm.dial
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+	conn, err := dial(ctx, fsm.doc.Transport, net.JoinHostPort(fsm.host, strconv.Itoa(fsm.Port())))
 	if err != nil {
 		return err
 	}
 
-	fsm.conn = NewBufferedConn(conn, MaxCellLength)
+	fsm.conn = fsm.newBufferedConn(conn)
 	fsm.closeFuncs = append(fsm.closeFuncs, conn.Close)
 
 	return nil
 }
 
 func (fsm *fsm) ensureServerConn(ctx context.Context) (err error) {
+	if fsm.doc.Transport == "udp" {
+		return fsm.ensureServerPacketConn(ctx)
+	}
+
+	fsm.portsMu.Lock()
 	ln := fsm.listeners[fsm.Port()]
 	if ln == nil {
 		if ln, err = net.Listen("tcp", net.JoinHostPort(fsm.host, strconv.Itoa(fsm.Port()))); err != nil {
+			fsm.portsMu.Unlock()
 			return err
 		}
 		fsm.listeners[fsm.Port()] = ln
 	}
+	fsm.portsMu.Unlock()
 
 	conn, err := ln.Accept()
 	if err != nil {
 		return err
 	}
+	conn = CaptureConn(conn, fsm.capture, PartyServer)
 
-	fsm.conn = NewBufferedConn(conn, MaxCellLength)
+	fsm.conn = fsm.newBufferedConn(conn)
 	fsm.closeFuncs = append(fsm.closeFuncs, conn.Close)
 
 	return nil
 }
 
+func (fsm *fsm) ensureServerPacketConn(ctx context.Context) (err error) {
+	fsm.portsMu.Lock()
+	l := fsm.packetConns[fsm.Port()]
+	if l == nil {
+		pc, err := net.ListenPacket("udp", net.JoinHostPort(fsm.host, strconv.Itoa(fsm.Port())))
+		if err != nil {
+			fsm.portsMu.Unlock()
+			return err
+		}
+		l = newPacketListener(pc)
+		fsm.packetConns[fsm.Port()] = l
+		fsm.closeFuncs = append(fsm.closeFuncs, l.Close)
+	}
+	fsm.portsMu.Unlock()
+
+	// Wait for the first datagram from a new peer to determine its address.
+	conn, err := l.Accept()
+	if err != nil {
+		return err
+	}
+
+	fsm.conn = fsm.newBufferedConn(CaptureConn(conn, fsm.capture, PartyServer))
+	fsm.closeFuncs = append(fsm.closeFuncs, conn.Close)
+
+	return nil
+}
+
+// newBufferedConn wraps conn with the FSM's write segmentation & handshake
+// profile.
+func (fsm *fsm) newBufferedConn(conn net.Conn) *BufferedConn {
+	size := MaxCellLength
+	if fsm.opts.bufferSize > 0 {
+		size = fsm.opts.bufferSize
+	}
+	c := NewBufferedConn(conn, size)
+	c.Segmentation = fsm.segmentation
+	c.Handshake = fsm.handshakeProfile
+	return c
+}
+
+// setSegmentation sets the write segmentation of the current & future connections.
+func (fsm *fsm) setSegmentation(seg *WriteSegmentation) {
+	fsm.segmentation = seg
+	if fsm.conn != nil {
+		fsm.conn.Segmentation = seg
+	}
+}
+
+// setHandshakeProfile sets the handshake profile of the current & future connections.
+func (fsm *fsm) setHandshakeProfile(p *HandshakeProfile) {
+	fsm.handshakeProfile = p
+	if fsm.conn != nil {
+		fsm.conn.Handshake = p
+	}
+}
+
 func (f *fsm) Clone(doc *mar.Document) FSM {
 	other := &fsm{
-		state:     "start",
-		vars:      make(map[string]interface{}),
-		doc:       doc,
-		host:      f.host,
-		party:     f.party,
-		fteCache:  f.fteCache,
-		streamSet: f.streamSet,
-		listeners: f.listeners,
+		state:       "start",
+		vars:        make(map[string]interface{}),
+		doc:         doc,
+		host:        f.host,
+		party:       f.party,
+		fteCache:    f.fteCache,
+		streamSet:   f.streamSet,
+		portsMu:     f.portsMu,
+		listeners:   f.listeners,
+		packetConns: f.packetConns,
+
+		dial:             f.dial,
+		segmentation:     f.segmentation,
+		handshakeProfile: f.handshakeProfile,
+		capture:          f.capture,
+		opts:             f.opts,
+		traceCtx:         f.traceCtx,
 	}
 
 	other.buildTransitions()
@@ -462,9 +781,56 @@ func (f *fsm) Clone(doc *mar.Document) FSM {
 	return other
 }
 
+// startTracing creates the FSM's spans as children of the span in ctx and
+// begins the handshake span.
+func (fsm *fsm) startTracing(ctx context.Context) {
+	fsm.traceCtx = ctx
+	_, fsm.handshakeSpan = tracer.Start(ctx, "marionette.handshake")
+}
+
+// endTracing ends the spans of the handshake & current state, if open, with
+// the error which stopped the FSM.
+func (fsm *fsm) endTracing(err error) {
+	if fsm.handshakeSpan != nil {
+		if err == nil {
+			err = errHandshakeIncomplete
+		}
+		endSpan(fsm.handshakeSpan, err)
+		fsm.handshakeSpan = nil
+	}
+	fsm.endStateSpan(err)
+}
+
+// endStateSpan ends the span of the current state, if open.
+func (fsm *fsm) endStateSpan(err error) {
+	if fsm.stateSpan != nil {
+		endSpan(fsm.stateSpan, err)
+		fsm.stateSpan = nil
+	}
+}
+
+// traceContext returns the parent context of the FSM's spans.
+func (fsm *fsm) traceContext() context.Context {
+	if fsm.traceCtx == nil {
+		return context.Background()
+	}
+	return fsm.traceCtx
+}
+
+// Step returns the number of transitions taken.
+func (fsm *fsm) Step() int {
+	fsm.infoMu.Lock()
+	defer fsm.infoMu.Unlock()
+	return fsm.stepN
+}
+
 func (fsm *fsm) Logger() *zap.Logger {
 	if fsm.Closed() {
 		return zap.NewNop()
 	}
-	return Logger.With(zap.String("party", fsm.party))
+	logger := Logger
+	if fsm.opts.logger != nil {
+		logger = fsm.opts.logger
+	}
+	return logger.Named(LoggerFSM).With(zap.String("party", fsm.party))
 }
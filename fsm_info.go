@@ -0,0 +1,111 @@
+package marionette
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MaxFSMHistory is the number of recent transitions kept by each FSM for inspection.
+const MaxFSMHistory = 16
+
+// maxFSMVarLen is the length at which variable values are truncated in FSMInfo.
+const maxFSMVarLen = 256
+
+// FSMInfo describes the state machine executing a cover connection for
+// inspection. Its ID is the connection's ID.
+type FSMInfo struct {
+	ID          int               `json:"id"`
+	Party       string            `json:"party"`
+	Format      string            `json:"format"`
+	InstanceID  int               `json:"instance_id"`
+	State       string            `json:"state"`
+	Steps       int               `json:"steps"`
+	Vars        map[string]string `json:"vars"`
+	Streams     []int             `json:"streams"`
+	Transitions []FSMTransition   `json:"transitions"`
+	OpenedAt    time.Time         `json:"opened_at"`
+}
+
+// FSMTransition is a move between two states of an FSM.
+type FSMTransition struct {
+	From string    `json:"from"`
+	To   string    `json:"to"`
+	Time time.Time `json:"time"`
+}
+
+// fsms holds the FSMs executing connections of every listener & dialer in the
+// process, by connection ID.
+var fsms = struct {
+	mu sync.RWMutex
+	m  map[int]*registeredFSM
+}{m: make(map[int]*registeredFSM)}
+
+type registeredFSM struct {
+	fsm      *fsm
+	openedAt time.Time
+}
+
+// registerFSM adds an FSM executing the connection with id to FSMs(). It
+// must be called before the FSM is executed.
+func registerFSM(id int, fsm *fsm, openedAt time.Time) {
+	fsm.connID = id
+
+	fsms.mu.Lock()
+	fsms.m[id] = &registeredFSM{fsm: fsm, openedAt: openedAt}
+	fsms.mu.Unlock()
+}
+
+// unregisterFSM removes the FSM of the connection with id from FSMs().
+func unregisterFSM(id int) {
+	fsms.mu.Lock()
+	delete(fsms.m, id)
+	fsms.mu.Unlock()
+}
+
+// FSMs returns a description of the FSM of each connection open in the
+// process, ordered by ID.
+func FSMs() []FSMInfo {
+	fsms.mu.RLock()
+	defer fsms.mu.RUnlock()
+
+	infos := make([]FSMInfo, 0, len(fsms.m))
+	for id, r := range fsms.m {
+		infos = append(infos, r.fsm.info(id, r.openedAt))
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// info returns a description of the FSM. Variables are formatted as strings.
+func (fsm *fsm) info(id int, openedAt time.Time) FSMInfo {
+	info := FSMInfo{
+		ID:       id,
+		Party:    fsm.party,
+		Format:   fsm.doc.Format,
+		Vars:     make(map[string]string),
+		Streams:  []int{},
+		OpenedAt: openedAt,
+	}
+
+	fsm.infoMu.Lock()
+	info.InstanceID, info.State, info.Steps = fsm.instanceID, fsm.state, fsm.stepN
+	info.Transitions = append([]FSMTransition{}, fsm.history...)
+	for k, v := range fsm.vars {
+		s := fmt.Sprint(v)
+		if len(s) > maxFSMVarLen {
+			s = s[:maxFSMVarLen] + "..."
+		}
+		info.Vars[k] = s
+	}
+	fsm.infoMu.Unlock()
+
+	if fsm.streamSet != nil {
+		for _, stream := range fsm.streamSet.target().Streams() {
+			info.Streams = append(info.Streams, stream.ID())
+		}
+		sort.Ints(info.Streams)
+	}
+	return info
+}
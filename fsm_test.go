@@ -0,0 +1,275 @@
+package marionette_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+func TestFSM_Listen(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyServer, []byte("connection(tcp, 8080):\n  start end NULL 1.0\n"))
+
+	t.Run("TCP", func(t *testing.T) {
+		conn, other := net.Pipe()
+		defer other.Close()
+		fsm := marionette.NewFSM(doc, "127.0.0.1", marionette.PartyServer, conn, marionette.NewStreamSet())
+		defer fsm.Close()
+		defer fsm.Reset()
+
+		if port, err := fsm.Listen("tcp", 0, 0); err != nil {
+			t.Fatal(err)
+		} else if port == 0 {
+			t.Fatal("expected port")
+		}
+	})
+
+	// Ensure bracketed & unbracketed IPv6 hosts can be listened on.
+	t.Run("IPv6", func(t *testing.T) {
+		if ln, err := net.Listen("tcp", "[::1]:0"); err != nil {
+			t.Skip("IPv6 not available")
+		} else {
+			ln.Close()
+		}
+
+		for _, host := range []string{"::1", "[::1]"} {
+			conn, other := net.Pipe()
+			defer other.Close()
+			fsm := marionette.NewFSM(doc, host, marionette.PartyServer, conn, marionette.NewStreamSet())
+			defer fsm.Close()
+			defer fsm.Reset()
+
+			if fsm.Host() != "::1" {
+				t.Fatalf("unexpected host: %q", fsm.Host())
+			}
+			for _, network := range []string{"tcp", "udp"} {
+				if port, err := fsm.Listen(network, 0, 0); err != nil {
+					t.Fatal(err)
+				} else if port == 0 {
+					t.Fatal("expected port")
+				}
+			}
+		}
+	})
+
+	// Ensure a port is chosen from within the requested range.
+	t.Run("PortRange", func(t *testing.T) {
+		conn, other := net.Pipe()
+		defer other.Close()
+		fsm := marionette.NewFSM(doc, "127.0.0.1", marionette.PartyServer, conn, marionette.NewStreamSet())
+		defer fsm.Close()
+		defer fsm.Reset()
+
+		for _, network := range []string{"tcp", "udp"} {
+			port := freePort(t, network)
+			if got, err := fsm.Listen(network, port, port); err != nil {
+				t.Fatal(err)
+			} else if got != port {
+				t.Fatalf("unexpected port: %d", got)
+			}
+		}
+	})
+
+	// Ensure an error is returned if every port in the range is in use.
+	t.Run("ErrPortRangeInUse", func(t *testing.T) {
+		conn, other := net.Pipe()
+		defer other.Close()
+		fsm := marionette.NewFSM(doc, "127.0.0.1", marionette.PartyServer, conn, marionette.NewStreamSet())
+		defer fsm.Close()
+		defer fsm.Reset()
+
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		port := ln.Addr().(*net.TCPAddr).Port
+		if _, err := fsm.Listen("tcp", port, port); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	// Ensure clones sharing listeners, such as spawned children, may listen
+	// concurrently. Run with -race.
+	t.Run("Clones", func(t *testing.T) {
+		conn, other := net.Pipe()
+		defer other.Close()
+		fsm := marionette.NewFSM(doc, "127.0.0.1", marionette.PartyServer, conn, marionette.NewStreamSet())
+		defer fsm.Close()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			child := fsm.Clone(doc)
+			defer child.Reset()
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := child.Listen("tcp", 0, 0); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("ErrInvalidNetwork", func(t *testing.T) {
+		conn, other := net.Pipe()
+		defer other.Close()
+		fsm := marionette.NewFSM(doc, "127.0.0.1", marionette.PartyServer, conn, marionette.NewStreamSet())
+		defer fsm.Close()
+
+		if _, err := fsm.Listen("sctp", 0, 0); err == nil || err.Error() != `invalid listen network: "sctp"` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure spawned FSMs sharing a UDP port each receive from their own peer and
+// that resetting one does not affect the others.
+func TestFSM_Execute_UDP(t *testing.T) {
+	conn, other := net.Pipe()
+	defer other.Close()
+	parentDoc := mar.MustParse(marionette.PartyServer, []byte("connection(tcp, 8080):\n  start end NULL 1.0\n"))
+	fsm := marionette.NewFSM(parentDoc, "127.0.0.1", marionette.PartyServer, conn, marionette.NewStreamSet())
+	defer fsm.Close()
+	defer fsm.Reset()
+
+	port, err := fsm.Listen("udp", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := mar.MustParse(marionette.PartyServer, []byte(fmt.Sprintf(`connection(udp, %d):
+  start end recv 1.0
+
+action recv:
+  server io.gets("hello")
+`, port)))
+
+	for i := 0; i < 2; i++ {
+		peer, err := net.Dial("udp", fmt.Sprintf("127.0.0.1:%d", port))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer peer.Close()
+		if _, err := peer.Write([]byte("hello")); err != nil {
+			t.Fatal(err)
+		}
+
+		child := fsm.Clone(doc)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err = child.Execute(ctx)
+		cancel()
+		child.Reset()
+		if err != nil {
+			t.Fatalf("peer %d: %s", i, err)
+		}
+	}
+}
+
+// freePort returns a port on the loopback interface not in use by network.
+func freePort(tb testing.TB, network string) int {
+	if network == "udp" {
+		pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+		if err != nil {
+			tb.Fatal(err)
+		}
+		defer pc.Close()
+		return pc.LocalAddr().(*net.UDPAddr).Port
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer ln.Close()
+	return ln.Addr().(*net.TCPAddr).Port
+}
+
+func TestFSM_Next_Timeout(t *testing.T) {
+	t.Run("ErrorTransition", func(t *testing.T) {
+		doc := mar.MustParse(marionette.PartyClient, []byte(`connection(tcp, 8080):
+  start    end      recv 1.0
+  start    timedout NULL error
+
+action recv:
+  client io.gets("foo", timeout=10ms)
+`))
+
+		conn, other := net.Pipe()
+		defer other.Close()
+		fsm := marionette.NewFSM(doc, "127.0.0.1", marionette.PartyClient, conn, marionette.NewStreamSet())
+		defer fsm.Close()
+
+		if err := fsm.Next(context.Background()); err != nil {
+			t.Fatal(err)
+		} else if got, want := fsm.State(), "timedout"; got != want {
+			t.Fatalf("State()=%q, want %q", got, want)
+		}
+	})
+
+	t.Run("ErrActionTimeout", func(t *testing.T) {
+		doc := mar.MustParse(marionette.PartyClient, []byte(`connection(tcp, 8080):
+  start    end      recv 1.0
+
+action recv:
+  client io.gets("foo", timeout=10ms)
+`))
+
+		conn, other := net.Pipe()
+		defer other.Close()
+		fsm := marionette.NewFSM(doc, "127.0.0.1", marionette.PartyClient, conn, marionette.NewStreamSet())
+		defer fsm.Close()
+
+		if err := fsm.Next(context.Background()); err != marionette.ErrActionTimeout {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure an action conditional on a variable only runs if the variable matches.
+func TestFSM_Next_VarMatch(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(`connection(tcp, 8080):
+  start    end      send 1.0
+
+action send:
+  client io.puts("active") if var_match("mode", "^active$")
+  client io.puts("passive")
+`))
+
+	for _, tt := range []struct {
+		mode interface{}
+		exp  string
+	}{
+		{mode: "active", exp: "active"},
+		{mode: "activex", exp: "passive"},
+		{mode: nil, exp: "passive"},
+	} {
+		conn, other := net.Pipe()
+		fsm := marionette.NewFSM(doc, "127.0.0.1", marionette.PartyClient, conn, marionette.NewStreamSet())
+		if tt.mode != nil {
+			fsm.SetVar("mode", tt.mode)
+		}
+
+		buf := make(chan string, 1)
+		go func() {
+			b := make([]byte, 16)
+			n, _ := other.Read(b)
+			buf <- string(b[:n])
+		}()
+
+		if err := fsm.Next(context.Background()); err != nil {
+			t.Fatal(err)
+		} else if got := <-buf; got != tt.exp {
+			t.Fatalf("mode=%v: got %q, want %q", tt.mode, got, tt.exp)
+		}
+		fsm.Close()
+		other.Close()
+	}
+}
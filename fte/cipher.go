@@ -107,9 +107,19 @@ func (c *Cipher) Encrypt(plaintext []byte) (ciphertext []byte, err error) {
 }
 
 // Decrypt decrypts ciphertext into plaintext.
-// Returns ErrShortCiphertext if the ciphertext is too short to be decrypted.
+// Returns ErrShortCiphertext if the ciphertext is too short to be decrypted
+// but may still match once more data arrives. Returns ErrNoMatch if the
+// covertext can never match the regex.
 func (c *Cipher) Decrypt(ciphertext []byte) (plaintext, remainder []byte, err error) {
-	if len(ciphertext) < c.dfa.N() {
+	covertext := ciphertext
+	if len(covertext) > c.dfa.N() {
+		covertext = covertext[:c.dfa.N()]
+	}
+	if ok, err := c.dfa.MatchPrefix(covertext); err != nil {
+		return nil, nil, err
+	} else if !ok {
+		return nil, nil, ErrNoMatch
+	} else if len(ciphertext) < c.dfa.N() {
 		return nil, nil, ErrShortCiphertext
 	}
 
@@ -127,6 +137,9 @@ func (c *Cipher) Decrypt(ciphertext []byte) (plaintext, remainder []byte, err er
 	msg_len_header := make([]byte, 16)
 	c.dec.block.Decrypt(msg_len_header, X[:16])
 	msg_len := binary.BigEndian.Uint64(msg_len_header[8:16])
+	if msg_len > uint64(len(X)-16) {
+		return nil, nil, ErrInvalidMessageLength
+	}
 
 	retval := X[16 : 16+msg_len]
 	retval = append(retval, ciphertext[c.dfa.N():]...)
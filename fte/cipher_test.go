@@ -4,8 +4,14 @@ import (
 	"testing"
 
 	"github.com/redjack/marionette/fte"
+	"github.com/redjack/marionette/regex2dfa"
 )
 
+func init() {
+	// Add the table of the test regex so the tests also run without cgo.
+	regex2dfa.Builtin()[`^(a|b|c)+$`] = "0\t1\t97\t97\n0\t1\t98\t98\n0\t1\t99\t99\n1\t1\t97\t97\n1\t1\t98\t98\n1\t1\t99\t99\n1\n"
+}
+
 func TestCipher(t *testing.T) {
 	cipher, err := fte.NewCipher(`^(a|b|c)+$`, 512)
 	if err != nil {
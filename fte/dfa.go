@@ -1,22 +1,9 @@
 package fte
 
-// #cgo CXXFLAGS: -std=c++11
-// #cgo LDFLAGS: -ldl /usr/local/lib/libgmp.a
-// #include <stdlib.h>
-// #include <stdint.h>
-// void* _dfa_new(char *tbl, const uint32_t max_len);
-// void _dfa_delete(void *ptr);
-// int _dfa_rank(void *ptr, const char *s, const size_t ssz, char **out, size_t *sz);
-// int _dfa_unrank(void *ptr, const char *in, const size_t insz, char **out, size_t *sz);
-// char* _dfa_getNumWordsInLanguage(void *ptr, const uint32_t min_word_length, const uint32_t max_word_length, char **out, size_t *sz);
-import "C"
-
 import (
 	"errors"
-	"fmt"
 	"math/big"
 	"sync"
-	"unsafe"
 
 	"github.com/redjack/marionette/regex2dfa"
 )
@@ -25,13 +12,50 @@ var (
 	ErrLanguageIsEmptySet = errors.New("fte: language is empty set")
 )
 
+// Ranker ranks & unranks the words of exactly n symbols in a DFA's language.
+type Ranker interface {
+	Rank(s string) (*big.Int, error)
+	Unrank(rank *big.Int) (string, error)
+	NumWordsInLanguage(min, max int) (*big.Int, error)
+	Close() error
+}
+
+// NewRanker returns the Ranker used by new DFAs for a table produced by
+// regex2dfa. It defaults to the pure-Go Table, or to GMP in cgo builds
+// without the "purego" tag. Both produce the same ranks.
+var NewRanker func(tbl string, n int) (Ranker, error) = defaultNewRanker
+
+// NewTableRanker returns a Ranker using the pure-Go Table.
+func NewTableRanker(tbl string, n int) (Ranker, error) {
+	t, err := NewTable(tbl, n)
+	if err != nil {
+		return nil, err
+	}
+	return &tableRanker{t}, nil
+}
+
+// tableRanker adapts Table to the Ranker interface.
+type tableRanker struct {
+	t *Table
+}
+
+func (r *tableRanker) Rank(s string) (*big.Int, error)      { return r.t.Rank(s) }
+func (r *tableRanker) Unrank(rank *big.Int) (string, error) { return r.t.Unrank(rank) }
+func (r *tableRanker) Close() error                         { return nil }
+func (r *tableRanker) NumWordsInLanguage(min, max int) (*big.Int, error) {
+	return r.t.NumWordsInLanguage(min, max), nil
+}
+
 type DFA struct {
 	mu       sync.RWMutex
-	ptr      unsafe.Pointer
+	ranker   Ranker
 	capacity int
 
 	regex string
 	n     int
+
+	tbl     string
+	matcher *PrefixMatcher
 }
 
 func NewDFA(regex string, n int) (*DFA, error) {
@@ -40,11 +64,11 @@ func NewDFA(regex string, n int) (*DFA, error) {
 		return nil, err
 	}
 
-	ctbl := C.CString(tbl)
-	defer C.free(unsafe.Pointer(ctbl))
-
-	ptr := C._dfa_new(ctbl, C.uint32_t(n))
-	dfa := &DFA{ptr: ptr, regex: regex, n: n}
+	ranker, err := NewRanker(tbl, n)
+	if err != nil {
+		return nil, err
+	}
+	dfa := &DFA{ranker: ranker, regex: regex, n: n, tbl: tbl}
 
 	// Calculate capacity.
 	if err := dfa.calculateCapacity(); err != nil {
@@ -56,13 +80,32 @@ func NewDFA(regex string, n int) (*DFA, error) {
 }
 
 func (dfa *DFA) Close() error {
-	if dfa.ptr != nil {
-		C._dfa_delete(dfa.ptr)
-		dfa.ptr = nil
+	if dfa.ranker != nil {
+		err := dfa.ranker.Close()
+		dfa.ranker = nil
+		return err
 	}
 	return nil
 }
 
+// Rank maps s into an integer ranking.
+func (dfa *DFA) Rank(s string) (*big.Int, error) {
+	dfa.mu.Lock()
+	defer dfa.mu.Unlock()
+	return dfa.ranker.Rank(s)
+}
+
+// Unrank reverses the map from an integer to a string.
+func (dfa *DFA) Unrank(rank *big.Int) (string, error) {
+	dfa.mu.Lock()
+	defer dfa.mu.Unlock()
+	return dfa.ranker.Unrank(rank)
+}
+
+func (dfa *DFA) NumWordsInLanguage(min, max int) (*big.Int, error) {
+	return dfa.ranker.NumWordsInLanguage(min, max)
+}
+
 // Regex returns the regex passed into the DFA.
 func (dfa *DFA) Regex() string { return dfa.regex }
 
@@ -86,70 +129,26 @@ func (dfa *DFA) calculateCapacity() error {
 	return nil
 }
 
-// Rank maps s into an integer ranking.
-func (dfa *DFA) Rank(s string) (*big.Int, error) {
-	dfa.mu.Lock()
-	defer dfa.mu.Unlock()
-
-	cs := C.CString(s)
-	defer C.free(unsafe.Pointer(cs))
-
-	var cout *C.char
-	var sz C.size_t
-	errno := C._dfa_rank(dfa.ptr, cs, C.size_t(len(s)), &cout, &sz)
-	out := C.GoStringN(cout, C.int(sz))
-	C.free(unsafe.Pointer(cout))
-
-	if errno != 0 {
-		return nil, fmt.Errorf("fte.DFA.Rank: %s", out)
-	}
-
-	var rank big.Int
-	if _, ok := rank.SetString(out, 10); !ok {
-		return nil, fmt.Errorf("fte.Rank: cannot parse returned big.Int: %q", out)
-	}
-	return &rank, nil
-}
-
-// Unrank reverses the map from an integer to a string.
-func (dfa *DFA) Unrank(rank *big.Int) (string, error) {
+// MatchPrefix returns true if s can be completed into a word of length N().
+func (dfa *DFA) MatchPrefix(s []byte) (bool, error) {
 	dfa.mu.Lock()
 	defer dfa.mu.Unlock()
 
-	rankStr := rank.String()
-	cin := C.CString(rankStr)
-	defer C.free(unsafe.Pointer(cin))
-
-	var cout *C.char
-	var sz C.size_t
-	if ret := C._dfa_unrank(dfa.ptr, cin, C.size_t(len(rankStr)), &cout, &sz); ret != 0 {
-		return "", fmt.Errorf("fte.Unrank: error")
+	// Build the matcher on first use as most DFAs are only used for encoding.
+	if dfa.matcher == nil {
+		m, err := NewPrefixMatcher(dfa.tbl, dfa.n)
+		if err != nil {
+			return false, err
+		}
+		dfa.matcher = m
 	}
-
-	out := C.GoStringN(cout, C.int(sz))
-	C.free(unsafe.Pointer(cout))
-	return out, nil
+	return dfa.matcher.MatchPrefix(s), nil
 }
 
 func (dfa *DFA) NumWordsInSlice(n int) (*big.Int, error) {
 	return dfa.NumWordsInLanguage(n, n)
 }
 
-func (dfa *DFA) NumWordsInLanguage(min, max int) (*big.Int, error) {
-	var cout *C.char
-	var sz C.size_t
-	C._dfa_getNumWordsInLanguage(dfa.ptr, C.uint32_t(min), C.uint32_t(max), &cout, &sz)
-
-	out := C.GoStringN(cout, C.int(sz))
-	C.free(unsafe.Pointer(cout))
-
-	var rank big.Int
-	if _, ok := rank.SetString(out, 10); !ok {
-		return nil, fmt.Errorf("fte.NumWordsInLanguage: cannot parse returned big.Int: %q", out)
-	}
-	return &rank, nil
-}
-
 // Log2 returns floor(log2(v)).
 func Log2(v *big.Int) int {
 	for i := 1; ; i++ {
@@ -0,0 +1,101 @@
+//go:build cgo && !purego
+// +build cgo,!purego
+
+package fte
+
+// #cgo CXXFLAGS: -std=c++11
+// #cgo LDFLAGS: -ldl /usr/local/lib/libgmp.a
+// #include <stdlib.h>
+// #include <stdint.h>
+// void* _dfa_new(char *tbl, const uint32_t max_len);
+// void _dfa_delete(void *ptr);
+// int _dfa_rank(void *ptr, const char *s, const size_t ssz, char **out, size_t *sz);
+// int _dfa_unrank(void *ptr, const char *in, const size_t insz, char **out, size_t *sz);
+// char* _dfa_getNumWordsInLanguage(void *ptr, const uint32_t min_word_length, const uint32_t max_word_length, char **out, size_t *sz);
+import "C"
+
+import (
+	"fmt"
+	"math/big"
+	"unsafe"
+)
+
+// defaultNewRanker uses GMP when cgo is available.
+func defaultNewRanker(tbl string, n int) (Ranker, error) {
+	return NewGMPRanker(tbl, n)
+}
+
+// GMPRanker ranks & unranks words using the C++ ranking and GMP.
+type GMPRanker struct {
+	ptr unsafe.Pointer
+}
+
+// NewGMPRanker returns a GMPRanker for a table produced by regex2dfa.
+func NewGMPRanker(tbl string, n int) (Ranker, error) {
+	ctbl := C.CString(tbl)
+	defer C.free(unsafe.Pointer(ctbl))
+
+	return &GMPRanker{ptr: C._dfa_new(ctbl, C.uint32_t(n))}, nil
+}
+
+func (r *GMPRanker) Close() error {
+	if r.ptr != nil {
+		C._dfa_delete(r.ptr)
+		r.ptr = nil
+	}
+	return nil
+}
+
+// Rank maps s into an integer ranking.
+func (r *GMPRanker) Rank(s string) (*big.Int, error) {
+	cs := C.CString(s)
+	defer C.free(unsafe.Pointer(cs))
+
+	var cout *C.char
+	var sz C.size_t
+	errno := C._dfa_rank(r.ptr, cs, C.size_t(len(s)), &cout, &sz)
+	out := C.GoStringN(cout, C.int(sz))
+	C.free(unsafe.Pointer(cout))
+
+	if errno != 0 {
+		return nil, fmt.Errorf("fte.DFA.Rank: %s", out)
+	}
+
+	var rank big.Int
+	if _, ok := rank.SetString(out, 10); !ok {
+		return nil, fmt.Errorf("fte.Rank: cannot parse returned big.Int: %q", out)
+	}
+	return &rank, nil
+}
+
+// Unrank reverses the map from an integer to a string.
+func (r *GMPRanker) Unrank(rank *big.Int) (string, error) {
+	rankStr := rank.String()
+	cin := C.CString(rankStr)
+	defer C.free(unsafe.Pointer(cin))
+
+	var cout *C.char
+	var sz C.size_t
+	if ret := C._dfa_unrank(r.ptr, cin, C.size_t(len(rankStr)), &cout, &sz); ret != 0 {
+		return "", fmt.Errorf("fte.Unrank: error")
+	}
+
+	out := C.GoStringN(cout, C.int(sz))
+	C.free(unsafe.Pointer(cout))
+	return out, nil
+}
+
+func (r *GMPRanker) NumWordsInLanguage(min, max int) (*big.Int, error) {
+	var cout *C.char
+	var sz C.size_t
+	C._dfa_getNumWordsInLanguage(r.ptr, C.uint32_t(min), C.uint32_t(max), &cout, &sz)
+
+	out := C.GoStringN(cout, C.int(sz))
+	C.free(unsafe.Pointer(cout))
+
+	var rank big.Int
+	if _, ok := rank.SetString(out, 10); !ok {
+		return nil, fmt.Errorf("fte.NumWordsInLanguage: cannot parse returned big.Int: %q", out)
+	}
+	return &rank, nil
+}
@@ -0,0 +1,10 @@
+//go:build !cgo || purego
+// +build !cgo purego
+
+package fte
+
+// defaultNewRanker uses the pure-Go Table when cgo is unavailable, such as
+// in static or js/wasm builds.
+func defaultNewRanker(tbl string, n int) (Ranker, error) {
+	return NewTableRanker(tbl, n)
+}
@@ -0,0 +1,109 @@
+package fte
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrNoMatch = errors.New("fte: ciphertext does not match regex")
+)
+
+// PrefixMatcher determines if a partial covertext can still be completed
+// into a word of exactly n characters in a DFA's language.
+type PrefixMatcher struct {
+	start int
+	delta map[int]map[byte]int
+
+	// live[i] is the set of states that can reach a final state in exactly
+	// i transitions. Used to reject prefixes that can never complete.
+	live []map[int]bool
+}
+
+// NewPrefixMatcher returns a matcher for a DFA table produced by regex2dfa.
+func NewPrefixMatcher(tbl string, n int) (*PrefixMatcher, error) {
+	m := &PrefixMatcher{start: -1, delta: make(map[int]map[byte]int)}
+
+	finals := make(map[int]bool)
+	for _, line := range strings.Split(tbl, "\n") {
+		if line == "" {
+			break
+		}
+
+		a := strings.Split(line, "\t")
+		switch len(a) {
+		case 4:
+			src, err := strconv.Atoi(a[0])
+			if err != nil {
+				return nil, fmt.Errorf("fte: invalid dfa state: %q", a[0])
+			}
+			dst, err := strconv.Atoi(a[1])
+			if err != nil {
+				return nil, fmt.Errorf("fte: invalid dfa state: %q", a[1])
+			}
+			sym, err := strconv.Atoi(a[2])
+			if err != nil || sym < 0 || sym > 255 {
+				return nil, fmt.Errorf("fte: invalid dfa symbol: %q", a[2])
+			}
+
+			if m.start == -1 {
+				m.start = src
+			}
+			if m.delta[src] == nil {
+				m.delta[src] = make(map[byte]int)
+			}
+			m.delta[src][byte(sym)] = dst
+
+		case 1:
+			state, err := strconv.Atoi(a[0])
+			if err != nil {
+				return nil, fmt.Errorf("fte: invalid dfa final state: %q", a[0])
+			}
+			finals[state] = true
+
+		default:
+			return nil, fmt.Errorf("fte: invalid dfa line: %q", line)
+		}
+	}
+
+	// Work backward from the final states to determine which states can
+	// complete a word in the remaining number of characters.
+	m.live = make([]map[int]bool, n+1)
+	m.live[0] = finals
+	for i := 1; i <= n; i++ {
+		m.live[i] = make(map[int]bool)
+		for src, transitions := range m.delta {
+			for _, dst := range transitions {
+				if m.live[i-1][dst] {
+					m.live[i][src] = true
+					break
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// N returns the length of words matched by m.
+func (m *PrefixMatcher) N() int { return len(m.live) - 1 }
+
+// MatchPrefix returns true if s is a prefix of at least one word in the
+// language of length N(). Returns false if s is longer than N().
+func (m *PrefixMatcher) MatchPrefix(s []byte) bool {
+	if len(s) > m.N() {
+		return false
+	}
+
+	state := m.start
+	for _, ch := range s {
+		next, ok := m.delta[state][ch]
+		if !ok {
+			return false
+		}
+		state = next
+	}
+	return m.live[m.N()-len(s)][state]
+}
@@ -0,0 +1,49 @@
+package fte_test
+
+import (
+	"testing"
+
+	"github.com/redjack/marionette/fte"
+)
+
+func TestPrefixMatcher_MatchPrefix(t *testing.T) {
+	// DFA table for `^(abc)|(abc123)$`.
+	const tbl = "0\t1\t97\t97\n1\t2\t98\t98\n2\t3\t99\t99\n3\t4\t49\t49\n3\n4\t5\t50\t50\n5\t6\t51\t51\n6\n"
+
+	m, err := fte.NewPrefixMatcher(tbl, 6)
+	if err != nil {
+		t.Fatal(err)
+	} else if m.N() != 6 {
+		t.Fatalf("unexpected n: %d", m.N())
+	}
+
+	for _, tt := range []struct {
+		s  string
+		ok bool
+	}{
+		{"", true},
+		{"a", true},
+		{"abc1", true},
+		{"abc123", true},
+		{"b", false},
+		{"abc12x", false},
+		{"abc1234", false},
+	} {
+		if ok := m.MatchPrefix([]byte(tt.s)); ok != tt.ok {
+			t.Errorf("MatchPrefix(%q)=%v, want %v", tt.s, ok, tt.ok)
+		}
+	}
+
+	// Words of length 3 exist but not length 4 so "abc" can't end early.
+	if m, err := fte.NewPrefixMatcher(tbl, 4); err != nil {
+		t.Fatal(err)
+	} else if m.MatchPrefix([]byte("a")) {
+		t.Fatal("expected no match")
+	}
+}
+
+func TestNewPrefixMatcher_ErrInvalidLine(t *testing.T) {
+	if _, err := fte.NewPrefixMatcher("0\t1\t97\n", 1); err == nil || err.Error() != `fte: invalid dfa line: "0\t1\t97"` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
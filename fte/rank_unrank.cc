@@ -1,3 +1,6 @@
+//go:build cgo && !purego
+// +build cgo,!purego
+
 #include <rank_unrank.h>
 
 #include <iostream>
@@ -0,0 +1,229 @@
+package fte
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrRankOutOfRange = errors.New("fte: rank out of range")
+	ErrNotFinalState  = errors.New("fte: word does not end in a final state")
+)
+
+// Table ranks & unranks the words of exactly n symbols in a DFA's language
+// without cgo. It is a port of the C++ ranking used by DFA and produces the
+// same ranks so both can communicate.
+type Table struct {
+	n      int
+	start  int
+	finals map[int]bool
+
+	sigma        []byte       // symbol by index, in order of first appearance
+	sigmaReverse map[byte]int // index by symbol
+
+	delta      [][]int // next state by state & symbol index
+	deltaDense []bool  // true if every symbol leads to the same state
+
+	// t[q][i] is the number of words of length i from state q to a final state.
+	t [][]*big.Int
+}
+
+// NewTable parses a DFA table produced by regex2dfa for words of length n.
+func NewTable(tbl string, n int) (*Table, error) {
+	t := &Table{n: n, finals: make(map[int]bool), sigmaReverse: make(map[byte]int)}
+
+	type transition struct{ src, dst, sym int }
+	var transitions []transition
+	states := make(map[int]bool)
+	for _, line := range strings.Split(tbl, "\n") {
+		if line == "" {
+			break
+		}
+
+		a := strings.Split(line, "\t")
+		switch len(a) {
+		case 4:
+			src, err := strconv.Atoi(a[0])
+			if err != nil {
+				return nil, fmt.Errorf("fte: invalid dfa state: %q", a[0])
+			}
+			dst, err := strconv.Atoi(a[1])
+			if err != nil {
+				return nil, fmt.Errorf("fte: invalid dfa state: %q", a[1])
+			}
+			sym, err := strconv.Atoi(a[2])
+			if err != nil || sym < 0 || sym > 255 {
+				return nil, fmt.Errorf("fte: invalid dfa symbol: %q", a[2])
+			}
+
+			if len(transitions) == 0 {
+				t.start = src
+			}
+			states[src] = true
+			if _, ok := t.sigmaReverse[byte(sym)]; !ok {
+				t.sigmaReverse[byte(sym)] = len(t.sigma)
+				t.sigma = append(t.sigma, byte(sym))
+			}
+			transitions = append(transitions, transition{src, dst, sym})
+
+		case 1:
+			state, err := strconv.Atoi(a[0])
+			if err != nil {
+				return nil, fmt.Errorf("fte: invalid dfa final state: %q", a[0])
+			}
+			t.finals[state] = true
+			states[state] = true
+
+		default:
+			return nil, fmt.Errorf("fte: invalid dfa line: %q", line)
+		}
+	}
+	if len(states) == 0 || len(t.sigma) == 0 {
+		return nil, errors.New("fte: invalid dfa: no states or symbols")
+	}
+
+	// States must be numbered 0 to N-1. State N is the dead state which
+	// every missing transition leads to.
+	dead := len(states)
+	for state := range states {
+		if state >= dead {
+			return nil, fmt.Errorf("fte: invalid dfa state: %d", state)
+		}
+	}
+	t.delta = make([][]int, dead+1)
+	for q := range t.delta {
+		t.delta[q] = make([]int, len(t.sigma))
+		for a := range t.delta[q] {
+			t.delta[q][a] = dead
+		}
+	}
+	for _, tr := range transitions {
+		if tr.dst > dead {
+			return nil, fmt.Errorf("fte: invalid dfa state: %d", tr.dst)
+		}
+		t.delta[tr.src][t.sigmaReverse[byte(tr.sym)]] = tr.dst
+	}
+
+	t.deltaDense = make([]bool, len(t.delta))
+	for q := range t.delta {
+		t.deltaDense[q] = true
+		for a := 1; a < len(t.sigma); a++ {
+			if t.delta[q][a-1] != t.delta[q][a] {
+				t.deltaDense[q] = false
+				break
+			}
+		}
+	}
+
+	t.buildTable()
+	return t, nil
+}
+
+// buildTable counts the words of each length from each state.
+func (t *Table) buildTable() {
+	t.t = make([][]*big.Int, len(t.delta))
+	for q := range t.t {
+		t.t[q] = make([]*big.Int, t.n+1)
+		for i := range t.t[q] {
+			t.t[q][i] = new(big.Int)
+		}
+	}
+	for q := range t.finals {
+		t.t[q][0].SetInt64(1)
+	}
+
+	for i := 1; i <= t.n; i++ {
+		for q := range t.delta {
+			for _, state := range t.delta[q] {
+				t.t[q][i].Add(t.t[q][i], t.t[state][i-1])
+			}
+		}
+	}
+}
+
+// NumWordsInLanguage returns the number of words with lengths between min &
+// max, inclusive. The max must not exceed the table's length.
+func (t *Table) NumWordsInLanguage(min, max int) *big.Int {
+	n := new(big.Int)
+	for i := min; i <= max && i <= t.n; i++ {
+		n.Add(n, t.t[t.start][i])
+	}
+	return n
+}
+
+// Rank maps s, which must be exactly n symbols, to its integer rank.
+func (t *Table) Rank(s string) (*big.Int, error) {
+	if len(s) != t.n {
+		return nil, fmt.Errorf("fte: invalid rank input length: %d != %d", len(s), t.n)
+	}
+
+	rank, tmp := new(big.Int), new(big.Int)
+	q := t.start
+	for i := 1; i <= t.n; i++ {
+		sym, ok := t.sigmaReverse[s[i-1]]
+		if !ok {
+			return nil, fmt.Errorf("fte: symbol not in dfa: %q", s[i-1])
+		}
+
+		if t.deltaDense[q] {
+			state := t.delta[q][0]
+			rank.Add(rank, tmp.Mul(t.t[state][t.n-i], big.NewInt(int64(sym))))
+		} else {
+			for j := 1; j <= sym; j++ {
+				rank.Add(rank, t.t[t.delta[q][j-1]][t.n-i])
+			}
+		}
+		q = t.delta[q][sym]
+	}
+
+	if !t.finals[q] {
+		return nil, ErrNotFinalState
+	}
+	return rank, nil
+}
+
+// Unrank returns the word of n symbols with the given rank.
+func (t *Table) Unrank(rank *big.Int) (string, error) {
+	if rank.Sign() < 0 || rank.Cmp(t.NumWordsInLanguage(t.n, t.n)) > 0 {
+		return "", ErrRankOutOfRange
+	}
+
+	buf := make([]byte, 0, t.n)
+	c, index, rem := new(big.Int).Set(rank), new(big.Int), new(big.Int)
+	q := t.start
+	for i := 1; i <= t.n; i++ {
+		var cursor, state int
+		if t.deltaDense[q] {
+			state = t.delta[q][0]
+			count := t.t[state][t.n-i]
+			if count.Sign() == 0 {
+				return "", ErrRankOutOfRange
+			}
+			index.DivMod(c, count, rem)
+			c.Set(rem)
+			if !index.IsInt64() || index.Int64() >= int64(len(t.sigma)) {
+				return "", ErrRankOutOfRange
+			}
+			cursor = int(index.Int64())
+		} else {
+			state = t.delta[q][cursor]
+			for c.Cmp(t.t[state][t.n-i]) >= 0 {
+				c.Sub(c, t.t[state][t.n-i])
+				if cursor++; cursor >= len(t.sigma) {
+					return "", ErrRankOutOfRange
+				}
+				state = t.delta[q][cursor]
+			}
+		}
+		buf = append(buf, t.sigma[cursor])
+		q = state
+	}
+
+	if !t.finals[q] {
+		return "", ErrNotFinalState
+	}
+	return string(buf), nil
+}
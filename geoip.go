@@ -0,0 +1,62 @@
+package marionette
+
+import (
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// MaxMindDB looks up IP addresses in MaxMind GeoIP2 or GeoLite2 databases,
+// such as a Country database together with an ASN database.
+type MaxMindDB struct {
+	readers []*maxminddb.Reader
+}
+
+// OpenMaxMindDB opens the database files at paths. The first database with a
+// country or ASN for an address is used for each.
+func OpenMaxMindDB(paths ...string) (*MaxMindDB, error) {
+	db := &MaxMindDB{}
+	for _, path := range paths {
+		r, err := maxminddb.Open(path)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		db.readers = append(db.readers, r)
+	}
+	return db, nil
+}
+
+// Close closes the database files.
+func (db *MaxMindDB) Close() (err error) {
+	for _, r := range db.readers {
+		if e := r.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// LookupGeo returns the country & ASN of ip. Fields are empty if no database
+// has them.
+func (db *MaxMindDB) LookupGeo(ip net.IP) (GeoInfo, error) {
+	var info GeoInfo
+	for _, r := range db.readers {
+		var record struct {
+			Country struct {
+				ISOCode string `maxminddb:"iso_code"`
+			} `maxminddb:"country"`
+			ASN uint `maxminddb:"autonomous_system_number"`
+		}
+		if err := r.Lookup(ip, &record); err != nil {
+			return info, err
+		}
+		if info.Country == "" {
+			info.Country = record.Country.ISOCode
+		}
+		if info.ASN == 0 {
+			info.ASN = record.ASN
+		}
+	}
+	return info, nil
+}
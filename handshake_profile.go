@@ -0,0 +1,77 @@
+package marionette
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand"
+	"time"
+)
+
+// MaxAuthPadding is the maximum padding, in bytes, added to an AUTH cell.
+const MaxAuthPadding = 128
+
+// handshakeProfileLabel separates handshake profile seeds from other uses of
+// the secret.
+const handshakeProfileLabel = "marionette handshake profile v1"
+
+// HandshakeProfile randomizes the first messages of each connection so that
+// deployments of the same format don't share an identical first-packet
+// signature. Each deployment derives its own ranges from its secret, and a
+// value is chosen uniformly from each range for every connection.
+type HandshakeProfile struct {
+	// Number of writes at the start of each connection which are shaped.
+	Writes int
+
+	// Range of the padding added to the client's AUTH cell.
+	MinPadding int
+	MaxPadding int
+
+	// Range of the size of each write a handshake message is split into.
+	MinSegment int
+	MaxSegment int
+
+	// Range of the delay before each handshake message is written.
+	MinDelay time.Duration
+	MaxDelay time.Duration
+}
+
+// NewHandshakeProfile returns the profile of the deployment using secret,
+// which is a bridge secret for servers & clients or a user's credential.
+// The same secret always returns the same profile.
+func NewHandshakeProfile(secret []byte) *HandshakeProfile {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(handshakeProfileLabel))
+	rng := rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(h.Sum(nil)))))
+
+	var p HandshakeProfile
+	p.Writes = 1 + rng.Intn(3)
+	p.MinPadding = rng.Intn(MaxAuthPadding / 2)
+	p.MaxPadding = p.MinPadding + rng.Intn(MaxAuthPadding-p.MinPadding+1)
+	p.MinSegment = 64 + rng.Intn(448)
+	p.MaxSegment = p.MinSegment + rng.Intn(1024)
+	p.MinDelay = time.Duration(rng.Intn(50)) * time.Millisecond
+	p.MaxDelay = p.MinDelay + time.Duration(rng.Intn(150))*time.Millisecond
+	return &p
+}
+
+// padding returns the padding of a connection's AUTH cell.
+func (p *HandshakeProfile) padding() int {
+	if p.MaxPadding <= p.MinPadding {
+		return p.MinPadding
+	}
+	return p.MinPadding + rand.Intn(p.MaxPadding-p.MinPadding+1)
+}
+
+// delay returns the time to wait before writing a handshake message.
+func (p *HandshakeProfile) delay() time.Duration {
+	if p.MaxDelay <= p.MinDelay {
+		return p.MinDelay
+	}
+	return p.MinDelay + time.Duration(rand.Int63n(int64(p.MaxDelay-p.MinDelay)+1))
+}
+
+// segmentation returns the segmentation of a handshake message.
+func (p *HandshakeProfile) segmentation() *WriteSegmentation {
+	return &WriteSegmentation{MinSize: p.MinSegment, MaxSize: p.MaxSegment}
+}
@@ -0,0 +1,71 @@
+package marionette_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// Ensure each secret has its own profile, which is the same every time.
+func TestNewHandshakeProfile(t *testing.T) {
+	secret := mustAuthSecret(t)
+	p := marionette.NewHandshakeProfile(secret)
+	if other := marionette.NewHandshakeProfile(secret); !reflect.DeepEqual(p, other) {
+		t.Fatalf("profile mismatch: %+v != %+v", p, other)
+	} else if other := marionette.NewHandshakeProfile(mustAuthSecret(t)); reflect.DeepEqual(p, other) {
+		t.Fatal("expected profiles of different secrets to differ")
+	}
+
+	if p.Writes < 1 {
+		t.Fatalf("unexpected writes: %d", p.Writes)
+	} else if p.MinPadding < 0 || p.MinPadding > p.MaxPadding || p.MaxPadding > marionette.MaxAuthPadding {
+		t.Fatalf("unexpected padding: %d-%d", p.MinPadding, p.MaxPadding)
+	} else if p.MinSegment < 1 || p.MinSegment > p.MaxSegment {
+		t.Fatalf("unexpected segment size: %d-%d", p.MinSegment, p.MaxSegment)
+	} else if p.MinDelay < 0 || p.MinDelay > p.MaxDelay {
+		t.Fatalf("unexpected delay: %s-%s", p.MinDelay, p.MaxDelay)
+	}
+}
+
+// Ensure a client with a padded AUTH cell & shaped handshake is served.
+func TestHandshakeProfile(t *testing.T) {
+	secret := mustAuthSecret(t)
+	dialer, ln := marionette.Pipe(
+		mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc)),
+		mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)),
+		marionette.WithAuthSecret(secret),
+		marionette.WithHandshakeProfile(marionette.NewHandshakeProfile(secret)),
+	)
+	defer ln.Close()
+	dialer.HandshakeProfile = &marionette.HandshakeProfile{
+		Writes:     2,
+		MinPadding: marionette.MaxAuthPadding,
+		MaxPadding: marionette.MaxAuthPadding,
+		MinSegment: 1,
+		MaxSegment: 16,
+	}
+
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientStream.Close()
+	mustWrite(t, clientStream, []byte("foo"))
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverStream.Close()
+	mustRead(t, serverStream, []byte("foo"))
+
+	mustWrite(t, serverStream, []byte("bar"))
+	mustRead(t, clientStream, []byte("bar"))
+}
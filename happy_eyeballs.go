@@ -0,0 +1,150 @@
+package marionette
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+// DefaultConnectionAttemptDelay is the default time to wait for a connection
+// attempt before starting the next one, as recommended by RFC 8305.
+const DefaultConnectionAttemptDelay = 250 * time.Millisecond
+
+// HappyEyeballsDialer connects to hosts with both IPv6 & IPv4 addresses by
+// racing connection attempts as described in RFC 8305. Addresses are tried
+// alternating between families, starting with IPv6, and each attempt starts
+// once the previous one fails or AttemptDelay elapses. The first connection
+// established is returned and the remaining attempts are canceled.
+type HappyEyeballsDialer struct {
+	// Time to wait before starting the next attempt.
+	// Defaults to DefaultConnectionAttemptDelay.
+	AttemptDelay time.Duration
+
+	// Looks up the addresses of a host. Uses net.DefaultResolver if nil.
+	Lookup func(ctx context.Context, host string) ([]net.IPAddr, error)
+
+	// Dialer used for each attempt. Uses a net.Dialer if nil.
+	Dialer NetDialer
+}
+
+// Dial connects to address on network.
+func (d *HappyEyeballsDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+// DialContext connects to address on network. Only host names on the "tcp" &
+// "udp" networks are raced; other addresses are dialed directly.
+func (d *HappyEyeballsDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	} else if (network != "tcp" && network != "udp") || net.ParseIP(host) != nil {
+		return d.dialer().DialContext(ctx, network, address)
+	}
+
+	lookup := d.Lookup
+	if lookup == nil {
+		lookup = net.DefaultResolver.LookupIPAddr
+	}
+	addrs, err := lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	} else if len(addrs) == 0 {
+		return nil, fmt.Errorf("no addresses found for host: %s", host)
+	}
+	return d.race(ctx, network, port, interleaveAddrs(addrs))
+}
+
+// race starts staggered connection attempts to each address in order and
+// returns the first connection established.
+func (d *HappyEyeballsDialer) race(ctx context.Context, network, port string, addrs []net.IPAddr) (net.Conn, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	delay := d.AttemptDelay
+	if delay <= 0 {
+		delay = DefaultConnectionAttemptDelay
+	}
+
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	results := make(chan result, len(addrs))
+
+	var next, pending int
+	var timeout <-chan time.Time
+	start := func() {
+		address := net.JoinHostPort(addrs[next].String(), port)
+		next, pending = next+1, pending+1
+		go func() {
+			conn, err := d.dialer().DialContext(ctx, network, address)
+			results <- result{conn: conn, err: err}
+		}()
+		timeout = time.After(delay)
+	}
+
+	var firstErr error
+	for start(); pending > 0; {
+		select {
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				// Close connections from attempts which complete after the winner.
+				go func(n int) {
+					for i := 0; i < n; i++ {
+						if r := <-results; r.conn != nil {
+							r.conn.Close()
+						}
+					}
+				}(pending)
+				return r.conn, nil
+			}
+
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			if next < len(addrs) {
+				start()
+			}
+
+		case <-timeout:
+			if next < len(addrs) {
+				start()
+			}
+		}
+	}
+	return nil, firstErr
+}
+
+func (d *HappyEyeballsDialer) dialer() NetDialer {
+	if d.Dialer == nil {
+		return &net.Dialer{}
+	}
+	return d.Dialer
+}
+
+// interleaveAddrs orders addresses alternating between IPv6 & IPv4, starting
+// with IPv6, while preserving the resolver's order within each family.
+func interleaveAddrs(addrs []net.IPAddr) []net.IPAddr {
+	var v6, v4 []net.IPAddr
+	for _, addr := range addrs {
+		if addr.IP.To4() != nil {
+			v4 = append(v4, addr)
+		} else {
+			v6 = append(v6, addr)
+		}
+	}
+
+	ordered := make([]net.IPAddr, 0, len(addrs))
+	for i := 0; i < len(v6) || i < len(v4); i++ {
+		if i < len(v6) {
+			ordered = append(ordered, v6[i])
+		}
+		if i < len(v4) {
+			ordered = append(ordered, v4[i])
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,127 @@
+package marionette_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestHappyEyeballsDialer_DialContext(t *testing.T) {
+	lookup := func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		if host != "example.com" {
+			t.Fatalf("unexpected host: %s", host)
+		}
+		return []net.IPAddr{{IP: net.ParseIP("192.0.2.1")}, {IP: net.ParseIP("192.0.2.2")}, {IP: net.ParseIP("2001:db8::1")}}, nil
+	}
+
+	// Ensure IPv6 is attempted first and IPv4 is raced after the attempt delay.
+	t.Run("Race", func(t *testing.T) {
+		var fd fakeDialer
+		fd.blocking = map[string]bool{"[2001:db8::1]:80": true}
+		d := &marionette.HappyEyeballsDialer{AttemptDelay: 10 * time.Millisecond, Lookup: lookup, Dialer: &fd}
+
+		conn, err := d.DialContext(context.Background(), "tcp", "example.com:80")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		if addr := conn.(*fakeConn).addr; addr != "192.0.2.1:80" {
+			t.Fatalf("unexpected address: %s", addr)
+		} else if addrs := fd.Addrs(); len(addrs) != 2 || addrs[0] != "[2001:db8::1]:80" {
+			t.Fatalf("unexpected attempts: %v", addrs)
+		}
+	})
+
+	// Ensure the next attempt starts immediately when an attempt fails.
+	t.Run("Fail", func(t *testing.T) {
+		var fd fakeDialer
+		fd.failing = map[string]bool{"[2001:db8::1]:80": true, "192.0.2.1:80": true}
+		d := &marionette.HappyEyeballsDialer{AttemptDelay: time.Hour, Lookup: lookup, Dialer: &fd}
+
+		conn, err := d.DialContext(context.Background(), "tcp", "example.com:80")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		if addr := conn.(*fakeConn).addr; addr != "192.0.2.2:80" {
+			t.Fatalf("unexpected address: %s", addr)
+		}
+	})
+
+	t.Run("ErrUnreachable", func(t *testing.T) {
+		var fd fakeDialer
+		fd.failing = map[string]bool{"[2001:db8::1]:80": true, "192.0.2.1:80": true, "192.0.2.2:80": true}
+		d := &marionette.HappyEyeballsDialer{AttemptDelay: time.Hour, Lookup: lookup, Dialer: &fd}
+
+		if _, err := d.DialContext(context.Background(), "tcp", "example.com:80"); err == nil || err.Error() != "connection refused" {
+			t.Fatalf("unexpected error: %v", err)
+		} else if n := len(fd.Addrs()); n != 3 {
+			t.Fatalf("unexpected attempt count: %d", n)
+		}
+	})
+
+	// Ensure IP addresses are dialed without a lookup.
+	t.Run("IP", func(t *testing.T) {
+		var fd fakeDialer
+		d := &marionette.HappyEyeballsDialer{Lookup: lookup, Dialer: &fd}
+
+		conn, err := d.DialContext(context.Background(), "tcp", "[2001:db8::2]:80")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		if addr := conn.(*fakeConn).addr; addr != "[2001:db8::2]:80" {
+			t.Fatalf("unexpected address: %s", addr)
+		}
+	})
+}
+
+// fakeDialer returns connections which record their address. Failing addresses
+// are refused and blocking addresses wait until their context is done.
+type fakeDialer struct {
+	mu       sync.Mutex
+	addrs    []string
+	failing  map[string]bool
+	blocking map[string]bool
+}
+
+func (d *fakeDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+func (d *fakeDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	d.mu.Lock()
+	d.addrs = append(d.addrs, address)
+	d.mu.Unlock()
+
+	if d.failing[address] {
+		return nil, errors.New("connection refused")
+	} else if d.blocking[address] {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	conn, other := net.Pipe()
+	other.Close()
+	return &fakeConn{Conn: conn, addr: address}, nil
+}
+
+// Addrs returns the addresses of all attempts.
+func (d *fakeDialer) Addrs() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.addrs...)
+}
+
+type fakeConn struct {
+	net.Conn
+	addr string
+}
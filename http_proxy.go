@@ -0,0 +1,128 @@
+package marionette
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// hopHeaders are removed from requests before forwarding to the destination.
+var hopHeaders = []string{
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Proxy-Authenticate",
+}
+
+// serveHTTPProxy handles HTTP proxy requests from conn. CONNECT requests are
+// tunneled to their destination and absolute-URI requests are forwarded with
+// their response written back. Connections to destinations are made by dial.
+func serveHTTPProxy(conn net.Conn, dial func(ctx context.Context, network, addr string) (net.Conn, error)) error {
+	br := bufio.NewReader(conn)
+	for {
+		req, err := http.ReadRequest(br)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if req.Method == http.MethodConnect {
+			return serveHTTPConnect(conn, br, req, dial)
+		} else if req.URL.Host == "" || req.URL.Scheme != "http" {
+			writeHTTPProxyError(conn, http.StatusBadRequest)
+			return nil
+		}
+
+		closing, err := serveHTTPForward(conn, req, dial)
+		if err != nil {
+			return err
+		} else if closing {
+			return nil
+		}
+	}
+}
+
+// serveHTTPConnect connects to the requested host and copies data in both
+// directions until both sides close.
+func serveHTTPConnect(conn net.Conn, br *bufio.Reader, req *http.Request, dial func(ctx context.Context, network, addr string) (net.Conn, error)) error {
+	addr := req.URL.Host
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, "443")
+	}
+
+	target, err := dial(req.Context(), "tcp", addr)
+	if err != nil {
+		writeHTTPProxyError(conn, http.StatusBadGateway)
+		return err
+	}
+	defer target.Close()
+
+	if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n"); err != nil {
+		return err
+	}
+
+	// Copy between connection, including any buffered data, and target.
+	relay(conn, br, target)
+	return nil
+}
+
+// serveHTTPForward sends an absolute-URI request to its host and writes the
+// response back to conn. Returns true if the connection should be closed.
+func serveHTTPForward(conn net.Conn, req *http.Request, dial func(ctx context.Context, network, addr string) (net.Conn, error)) (bool, error) {
+	addr := req.URL.Host
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, "80")
+	}
+
+	target, err := dial(req.Context(), "tcp", addr)
+	if err != nil {
+		writeHTTPProxyError(conn, http.StatusBadGateway)
+		return true, err
+	}
+	defer target.Close()
+
+	// Only a single request is sent over each connection to the destination.
+	closing := req.Close
+	for _, key := range hopHeaders {
+		req.Header.Del(key)
+	}
+	req.Close = true
+	if err := req.Write(target); err != nil {
+		writeHTTPProxyError(conn, http.StatusBadGateway)
+		return true, err
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(target), req)
+	if err != nil {
+		writeHTTPProxyError(conn, http.StatusBadGateway)
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	// Bodies delimited by the end of the connection also end the client's.
+	if resp.ContentLength == -1 && len(resp.TransferEncoding) == 0 {
+		closing = true
+	}
+	resp.Close = closing
+	if err := resp.Write(conn); err != nil {
+		return true, err
+	}
+	return closing, nil
+}
+
+// writeHTTPProxyError writes an empty response with the given status code.
+func writeHTTPProxyError(w io.Writer, code int) {
+	resp := &http.Response{
+		StatusCode: code,
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Close:      true,
+	}
+	if err := resp.Write(w); err != nil {
+		proxyLogger().Debug("client proxy: cannot write http error", zap.Error(err))
+	}
+}
@@ -0,0 +1,165 @@
+package marionette_test
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// Ensure CONNECT requests are tunneled to their destination.
+func TestClientProxy_HTTPProxy_Connect(t *testing.T) {
+	// Start a destination which echos data back.
+	dst, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dst.Close()
+	go func() {
+		conn, err := dst.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		io.Copy(conn, conn)
+	}()
+
+	conn, err := net.Dial("tcp", openHTTPProxy(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	mustWrite(t, conn, []byte("CONNECT "+dst.Addr().String()+" HTTP/1.1\r\nHost: "+dst.Addr().String()+"\r\n\r\n"))
+	mustRead(t, conn, []byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+	mustWrite(t, conn, []byte("hello"))
+	mustRead(t, conn, []byte("hello"))
+}
+
+// Ensure absolute-URI requests are forwarded & hop-by-hop headers removed.
+func TestClientProxy_HTTPProxy_Forward(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("Proxy-Authorization"); v != "" {
+			t.Errorf("unexpected proxy authorization: %q", v)
+		}
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	proxyURL := &url.URL{Scheme: "http", User: url.UserPassword("user", "pass"), Host: openHTTPProxy(t)}
+	transport := &http.Transport{Proxy: http.ProxyURL(proxyURL)}
+	defer transport.CloseIdleConnections()
+
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if body, err := io.ReadAll(resp.Body); err != nil {
+		t.Fatal(err)
+	} else if string(body) != "ok" {
+		t.Fatalf("unexpected body: %q", body)
+	}
+}
+
+// Ensure requests which are not proxy requests are rejected.
+func TestClientProxy_HTTPProxy_ErrMalformed(t *testing.T) {
+	addr := openHTTPProxy(t)
+
+	t.Run("RelativeURI", func(t *testing.T) {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		mustWrite(t, conn, []byte("GET /foo HTTP/1.1\r\nHost: example.com\r\n\r\n"))
+		resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		if err != nil {
+			t.Fatal(err)
+		} else if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("unexpected status: %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("Scheme", func(t *testing.T) {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		mustWrite(t, conn, []byte("GET ftp://example.com/foo HTTP/1.1\r\nHost: example.com\r\n\r\n"))
+		resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		if err != nil {
+			t.Fatal(err)
+		} else if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("unexpected status: %d", resp.StatusCode)
+		}
+	})
+
+	// Data which cannot be parsed as a request closes the connection.
+	t.Run("Garbage", func(t *testing.T) {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		mustWrite(t, conn, []byte("not a request\r\n\r\n"))
+		if buf, err := io.ReadAll(conn); err != nil {
+			t.Fatal(err)
+		} else if len(buf) != 0 {
+			t.Fatalf("unexpected response: %q", buf)
+		}
+	})
+}
+
+// openHTTPProxy starts a server proxy which allows destinations and a client
+// proxy which accepts HTTP proxy requests. Returns the client proxy address.
+func openHTTPProxy(tb testing.TB) string {
+	ln, err := marionette.ListenFormat("http_simple_blocking:20150701", &marionette.FormatOptions{Bind: "127.0.0.1:0"})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { ln.Close() })
+
+	serverProxy := marionette.NewServerProxy(ln.(*marionette.Listener))
+	serverProxy.AllowDestinations = true
+	if err := serverProxy.Open(); err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { serverProxy.Close() })
+
+	data, err := mar.ReadFormat("http_simple_blocking:20150701")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	doc := mar.MustParse(marionette.PartyClient, data)
+	_, doc.Port, _ = net.SplitHostPort(ln.Addr().String())
+
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+	if err := dialer.Open(); err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { dialer.Close() })
+
+	proxyLn, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { proxyLn.Close() })
+
+	clientProxy := marionette.NewClientProxy(proxyLn, dialer)
+	clientProxy.HTTPProxy = true
+	if err := clientProxy.Open(); err != nil {
+		tb.Fatal(err)
+	}
+	return proxyLn.Addr().String()
+}
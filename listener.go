@@ -2,11 +2,14 @@ package marionette
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"io"
 	"net"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/redjack/marionette/mar"
 	"go.uber.org/zap"
@@ -15,33 +18,211 @@ import (
 var (
 	// ErrListenerClosed is returned when trying to operate on a closed listener.
 	ErrListenerClosed = errors.New("marionette: listener closed")
+
+	// ErrNoClients is returned when opening a stream while no clients are connected.
+	ErrNoClients = errors.New("marionette: no connected clients")
 )
 
+// DefaultSessionTimeout is the default time a disconnected client's streams
+// are kept open so they can be resumed on a new connection.
+const DefaultSessionTimeout = 1 * time.Minute
+
+// drainPollInterval is the time between checks for open streams while a
+// listener or dialer is shutting down.
+const drainPollInterval = 100 * time.Millisecond
+
 // Listener listens on a port and communicates over the marionette protocol.
 type Listener struct {
 	mu         sync.RWMutex
 	iface      string
 	ln         net.Listener
 	conns      map[net.Conn]struct{}
-	fsms       map[FSM]struct{}
+	fsms       map[FSM]*listenerConn
+	limiter    *RateLimiter
+	clients    map[string]*clientLimiter
+	slots      chan struct{}           // holds a token per served connection if MaxConns is set
+	pending    int                     // connections waiting for a slot
+	hostConns  map[string]int          // served & pending connections per client IP
+	stats      map[string]*clientStats // per client IP
+	pruned     time.Time               // last removal of expired stats
+	probes     probeCounter            // active probes detected, for alerts
 	doc        *mar.Document
+	opts       *options
 	newStreams chan *Stream
 	err        error
 
 	ctx    context.Context
 	cancel func()
 
-	once    sync.Once
-	wg      sync.WaitGroup
-	closing chan struct{}
-	closed  bool
+	once     sync.Once
+	wg       sync.WaitGroup
+	closing  chan struct{}
+	closed   bool
+	draining bool // no longer accepting connections
 
 	// Specifies directory for dumping stream traces. Passed to StreamSet.TracePath.
 	TracePath string
+
+	// Time to keep a disconnected session's streams open for resumption.
+	SessionTimeout time.Duration
+
+	// Client sessions which connections may resume or join. Listeners of
+	// different formats can share a table so that a multipath client bonds
+	// connections across them; such listeners should be closed together.
+	Sessions *SessionTable
+
+	// Bandwidth limits, in bytes per second, combined for both directions.
+	// Zero disables a limit.
+	RateLimit       int // shared by all client connections
+	ClientRateLimit int // shared by connections from the same client IP
+	StreamRateLimit int // applied to each stream
+
+	// Limits on each stream's idle time & total lifetime. Passed to the
+	// StreamSet. Zero disables a limit.
+	StreamIdleTimeout time.Duration
+	StreamMaxLifetime time.Duration
+
+	// Options applied to accepted TCP connections, if set.
+	SocketOptions *SocketOptions
+
+	// Controls how messages are split into segments on client connections,
+	// if set.
+	Segmentation *WriteSegmentation
+
+	// Records the traffic of client connections, if set.
+	Capture *PcapWriter
+
+	// Connection limits which protect the server from accept storms. Once
+	// MaxConns connections are being served, new connections wait for one to
+	// finish. Connections beyond MaxPendingConns waiting, or beyond
+	// MaxClientConns from the same client IP, are closed immediately.
+	// Zero disables MaxConns & MaxClientConns. Set by WithConnLimits().
+	MaxConns        int
+	MaxPendingConns int
+	MaxClientConns  int
+
+	// Temporarily bans a client IP once BanThreshold of its connections fail
+	// to complete a handshake within BanWindow. Connections from a banned IP
+	// are closed immediately for BanDuration. Zero disables bans.
+	BanThreshold int
+	BanWindow    time.Duration
+	BanDuration  time.Duration
+
+	// Bridge secret which clients must prove knowledge of, if set. A
+	// connection whose first cell is not an AUTH cell made with the secret
+	// fails with ErrUnauthenticated. With formats where the client sends
+	// first, the server sends nothing to a prober without the secret. Set by
+	// WithAuthSecret(); connections accepted before it is changed otherwise
+	// are served without authentication.
+	AuthSecret []byte
+
+	// Users of a private bridge, if set. Each client must then authenticate
+	// with its own credential, issued by Credentials.Issue() with AuthSecret,
+	// instead of AuthSecret itself. Requires AuthSecret. Set by
+	// WithCredentials(). Each user's bytes are added to Credentials.Usage, if
+	// set, and connections of users over quota are closed.
+	Credentials *Credentials
+
+	// AUTH cells received by the listener, which are rejected with
+	// ErrReplay if received again. Listeners may share a cache. Replays are
+	// not detected if nil.
+	Replays *ReplayCache
+
+	// Address of a decoy service, such as a web or FTP server, if set.
+	// Connections which fail before authenticating, such as with
+	// ErrUnauthenticated or ErrReplay, or which have not authenticated within
+	// DecoyTimeout, are relayed to the decoy with the bytes already received
+	// so that probes see a genuine service. Requires AuthSecret. Zero
+	// disables DecoyTimeout. Set by WithDecoy().
+	Decoy        string
+	DecoyTimeout time.Duration
+
+	// Randomizes the size & timing of the first messages on each connection,
+	// if set. Usually NewHandshakeProfile() of AuthSecret so that each
+	// deployment has its own first-packet signature. Set by
+	// WithHandshakeProfile().
+	HandshakeProfile *HandshakeProfile
+
+	// If true, connections which fail their handshake are checked for signs
+	// of an active probe, such as sending nothing, replaying a handshake or
+	// beginning like a known scanner's request, and an EventProbe is
+	// published for each. An EventProbeAlert is also published each time
+	// ProbeAlertThreshold probes are detected within ProbeAlertWindow. Zero
+	// ProbeAlertThreshold disables alerts. Set by WithProbeDetection().
+	DetectProbes        bool
+	ProbeAlertThreshold int
+	ProbeAlertWindow    time.Duration
+
+	// Chooses how each connection is treated by the country & network it
+	// comes from, if set. Connections may be closed, relayed to Decoy without
+	// being served, rate limited or served with another format. Set by
+	// WithPolicy(); change its rules with Policy.SetRules().
+	Policy *Policy
+}
+
+// admission is the result of checking a connection against the limits.
+type admission int
+
+const (
+	admitShed admission = iota
+	admitServe
+	admitQueue
+)
+
+// clientLimiter is a rate limiter shared by a client's connections.
+type clientLimiter struct {
+	limiter *RateLimiter
+	refs    int
+}
+
+// listenerConn tracks a served connection for inspection.
+type listenerConn struct {
+	id       int
+	host     string
+	openedAt time.Time
+	usage    int64 // bytes added to the usage of the user authenticated as
+}
+
+// SessionTable holds the client sessions of one or more listeners.
+type SessionTable struct {
+	mu       sync.Mutex
+	sessions map[string]*listenerSession
+}
+
+// NewSessionTable returns a new instance of SessionTable.
+func NewSessionTable() *SessionTable {
+	return &SessionTable{sessions: make(map[string]*listenerSession)}
+}
+
+// listenerSession tracks the streams of a client session across connections.
+type listenerSession struct {
+	streamSet *StreamSet // set holding the session's streams
+	owner     *StreamSet // set of the current connection, if any
+	conn      net.Conn   // current connection, if any
+	timer     *time.Timer
+
+	// Additional connections joined by a multipath client.
+	joined map[*StreamSet]net.Conn
+}
+
+// Listen returns a new instance of Listener. The iface is an IPv4 or IPv6
+// address, which may be bracketed. A blank iface, or "::", listens on all
+// interfaces for both IPv4 & IPv6 connections. Options are applied to the
+// listener and to the FSM of each connection.
+func Listen(doc *mar.Document, iface string, opts ...Option) (*Listener, error) {
+	l, err := listen(doc, iface, newOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	l.start()
+	return l, nil
 }
 
-// Listen returns a new instance of Listener.
-func Listen(doc *mar.Document, iface string) (*Listener, error) {
+// listen returns a Listener on iface which has not started accepting
+// connections, so that its fields may be set first.
+func listen(doc *mar.Document, iface string, o *options) (*Listener, error) {
+	iface = trimHostBrackets(iface)
+
 	// Parse port from MAR specification.
 	port, err := strconv.Atoi(doc.Port)
 	if err != nil {
@@ -49,28 +230,100 @@ func Listen(doc *mar.Document, iface string) (*Listener, error) {
 	}
 	addr := net.JoinHostPort(iface, strconv.Itoa(port))
 
-	Logger.Debug("listen", zap.String("transport", doc.Transport), zap.String("bind", addr))
+	logger := Logger
+	if o.logger != nil {
+		logger = o.logger
+	}
+	logger.Debug("listen", zap.String("transport", doc.Transport), zap.String("bind", addr))
 
 	ln, err := net.Listen(doc.Transport, addr)
 	if err != nil {
 		return nil, err
 	}
+	return newListener(ln, doc, iface, o), nil
+}
+
+// NewListener returns a Listener which executes doc on connections accepted
+// from ln, such as a socket passed by a service manager. The listener closes
+// ln when it is closed.
+func NewListener(ln net.Listener, doc *mar.Document, opts ...Option) *Listener {
+	host, _, _ := net.SplitHostPort(ln.Addr().String())
+	l := newListener(ln, doc, host, newOptions(opts))
+	l.start()
+	return l
+}
+
+// Serve executes doc on connections accepted from ln and calls handler in a
+// new goroutine with each stream opened by a client, so that a program can
+// handle streams itself instead of proxying them. Each stream is closed once
+// its handler returns. Serve closes ln & returns once it fails or is closed.
+func Serve(ln net.Listener, doc *mar.Document, handler func(stream net.Conn), opts ...Option) error {
+	l := NewListener(ln, doc, opts...)
+	defer l.Close()
+
+	for {
+		stream, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer stream.Close()
+			handler(stream)
+		}()
+	}
+}
+
+// newListener returns a Listener which executes doc on connections from ln
+// once started.
+func newListener(ln net.Listener, doc *mar.Document, iface string, opts *options) *Listener {
 	l := &Listener{
 		ln:         ln,
 		iface:      iface,
 		doc:        doc,
+		opts:       opts,
 		conns:      make(map[net.Conn]struct{}),
-		fsms:       make(map[FSM]struct{}),
+		fsms:       make(map[FSM]*listenerConn),
+		clients:    make(map[string]*clientLimiter),
+		hostConns:  make(map[string]int),
+		stats:      make(map[string]*clientStats),
 		newStreams: make(chan *Stream),
 		closing:    make(chan struct{}),
+
+		SessionTimeout: DefaultSessionTimeout,
+		Sessions:       NewSessionTable(),
+		Replays:        NewReplayCache(),
+		BanWindow:      DefaultBanWindow,
+		BanDuration:    DefaultBanDuration,
+		AuthSecret:     opts.authSecret,
+		Credentials:    opts.credentials,
+		Decoy:          opts.decoy,
+		DecoyTimeout:   opts.decoyTimeout,
+
+		MaxConns:        opts.maxConns,
+		MaxPendingConns: opts.maxPendingConns,
+		MaxClientConns:  opts.maxClientConns,
+		Policy:          opts.policy,
+
+		HandshakeProfile: opts.handshakeProfile,
+
+		DetectProbes:        opts.detectProbes,
+		ProbeAlertThreshold: opts.probeThreshold,
+		ProbeAlertWindow:    opts.probeWindow,
 	}
 	l.ctx, l.cancel = context.WithCancel(context.Background())
+	return l
+}
 
+// start begins accepting connections.
+func (l *Listener) start() {
 	// Hand off connection handling to separate goroutine.
 	l.wg.Add(1)
 	go func() { defer l.wg.Done(); l.accept() }()
 
-	return l, nil
+	if l.Credentials != nil {
+		l.wg.Add(1)
+		go func() { defer l.wg.Done(); l.meterUsage() }()
+	}
 }
 
 // Err returns the last error that occurred on the listener.
@@ -87,21 +340,41 @@ func (l *Listener) Addr() net.Addr { return l.ln.Addr() }
 func (l *Listener) Close() error {
 	err := l.ln.Close()
 
+	// Close connections & FSMs without holding the lock as closing may wait
+	// for queued writes.
 	l.mu.Lock()
 	l.closed = true
-	for conn := range l.conns {
+	for f, c := range l.fsms {
+		if f, ok := f.(*fsm); ok {
+			l.recordUsage(f, c)
+		}
+	}
+	conns, fsms := l.conns, l.fsms
+	l.conns, l.fsms = make(map[net.Conn]struct{}), make(map[FSM]*listenerConn)
+	l.mu.Unlock()
+
+	for conn := range conns {
 		if e := conn.Close(); e != nil && err == nil {
 			err = e
 		}
-		delete(l.conns, conn)
 	}
-	for fsm := range l.fsms {
+	for fsm := range fsms {
 		if e := fsm.Close(); e != nil && err == nil {
 			err = e
 		}
-		delete(l.fsms, fsm)
 	}
-	l.mu.Unlock()
+
+	l.Sessions.mu.Lock()
+	sessions := l.Sessions.sessions
+	l.Sessions.sessions = make(map[string]*listenerSession)
+	l.Sessions.mu.Unlock()
+
+	for _, sess := range sessions {
+		if sess.timer != nil {
+			sess.timer.Stop()
+		}
+		sess.streamSet.Close()
+	}
 
 	l.once.Do(func() {
 		l.cancel()
@@ -112,6 +385,58 @@ func (l *Listener) Close() error {
 	return err
 }
 
+// SetRateLimits changes the global & per-client limits of all connections,
+// including those already being served. The stream limit applies to streams
+// of connections served afterwards. A limit of zero is unlimited.
+func (l *Listener) SetRateLimits(rate, clientRate, streamRate int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.RateLimit, l.ClientRateLimit, l.StreamRateLimit = rate, clientRate, streamRate
+	if l.limiter != nil {
+		l.limiter.SetRate(rate)
+	}
+	for _, c := range l.clients {
+		c.limiter.SetRate(clientRate)
+	}
+}
+
+// Shutdown stops accepting connections and waits for the streams of served
+// connections to close before closing the listener. Streams opened by served
+// connections are still accepted meanwhile. If ctx is done first then the
+// listener is closed along with the remaining streams and ctx's error is
+// returned.
+func (l *Listener) Shutdown(ctx context.Context) error {
+	l.mu.Lock()
+	l.draining = true
+	l.mu.Unlock()
+	l.ln.Close()
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for l.streamN() > 0 {
+		select {
+		case <-ctx.Done():
+			l.Close()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return l.Close()
+}
+
+// streamN returns the number of streams open on served connections.
+func (l *Listener) streamN() int {
+	l.mu.RLock()
+	fsms := make([]FSM, 0, len(l.fsms))
+	for fsm := range l.fsms {
+		fsms = append(fsms, fsm)
+	}
+	l.mu.RUnlock()
+
+	return countStreams(fsms)
+}
+
 // Closed returns true if the listener has been closed.
 func (l *Listener) Closed() bool {
 	l.mu.RLock()
@@ -130,72 +455,585 @@ func (l *Listener) Accept() (net.Conn, error) {
 	}
 }
 
+// Dial opens a new stream to a connected client, such as to reach a service
+// behind the client's NAT. The stream is opened on the client connection, or
+// session, carrying the fewest streams.
+func (l *Listener) Dial() (net.Conn, error) {
+	l.mu.RLock()
+	if l.closed {
+		l.mu.RUnlock()
+		return nil, ErrListenerClosed
+	}
+	fsms := make([]FSM, 0, len(l.fsms))
+	for fsm := range l.fsms {
+		fsms = append(fsms, fsm)
+	}
+	l.mu.RUnlock()
+
+	var streamSet *StreamSet
+	var n int
+	for _, fsm := range fsms {
+		ss := fsm.StreamSet().target()
+		if m := ss.Len(); streamSet == nil || m < n {
+			streamSet, n = ss, m
+		}
+	}
+	if streamSet == nil {
+		return nil, ErrNoClients
+	}
+	return streamSet.open(), nil
+}
+
+// Conns returns a description of each connection being served, ordered by ID.
+func (l *Listener) Conns() []ConnInfo {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	infos := make([]ConnInfo, 0, len(l.fsms))
+	for fsm, c := range l.fsms {
+		infos = append(infos, newConnInfo(c.id, fsm, c.openedAt))
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// CloseConn closes the served connection with the given ID. The client's
+// streams are kept for resumption if it has a session.
+func (l *Listener) CloseConn(id int) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for fsm, c := range l.fsms {
+		if c.id == id {
+			return closeFSMConn(fsm)
+		}
+	}
+	return ErrConnNotFound
+}
+
+// SetDocument replaces the document executed on new connections, such as
+// after a format is edited. Connections already being served continue with
+// the previous document. The document must use the same transport & port.
+func (l *Listener) SetDocument(doc *mar.Document) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if doc.Transport != l.doc.Transport || doc.Port != l.doc.Port {
+		return errors.New("document transport & port cannot change")
+	}
+	l.doc = doc
+	return nil
+}
+
 // accept continually accepts networks connections and multiplexes to streams.
 func (l *Listener) accept() {
-	defer close(l.newStreams)
-
 	for {
 		// Wait for next connection.
 		conn, err := l.ln.Accept()
 		if err != nil {
 			l.mu.Lock()
+			draining := l.draining && !l.closed
 			if l.closed {
 				l.err = ErrListenerClosed
-			} else {
+			} else if !draining {
 				l.err = err
 			}
 			l.mu.Unlock()
+
+			// Continue to pass on streams of served connections while draining.
+			if !draining {
+				close(l.newStreams)
+			}
 			return
 		}
 
-		streamSet := NewStreamSet()
-		streamSet.OnNewStream = l.onNewStream
-		streamSet.TracePath = l.TracePath
+		applySocketOptions(l.SocketOptions, conn)
 
-		fsm := NewFSM(l.doc, l.iface, PartyServer, conn, streamSet)
+		// Serve the connection if within the limits. Otherwise wait for a
+		// served connection to finish or, if too many are waiting, close it.
+		host, _, _ := net.SplitHostPort(conn.RemoteAddr().String())
+		if !l.recordAttempt(host) {
+			l.logger().Debug("client banned, closing connection", zap.String("addr", conn.RemoteAddr().String()))
+			conn.Close()
+			continue
+		}
 
-		// Run execution in a separate goroutine.
-		l.wg.Add(1)
-		go func() { defer l.wg.Done(); l.execute(fsm, conn) }()
+		// Close or relay the connection to the decoy if the policy says so.
+		rule := l.policyRule(host)
+		switch rule.Action {
+		case PolicyDeny:
+			l.logger().Debug("client denied by policy, closing connection", zap.String("addr", conn.RemoteAddr().String()))
+			l.recordRejected(host)
+			conn.Close()
+			continue
+		case PolicyDecoy:
+			l.logger().Debug("client relayed to decoy by policy", zap.String("addr", conn.RemoteAddr().String()))
+			l.relayDecoy(conn)
+			continue
+		}
+
+		switch l.admit(host) {
+		case admitServe:
+			l.serve(conn, host, rule)
+		case admitQueue:
+			l.wg.Add(1)
+			go func() {
+				defer l.wg.Done()
+				if !l.waitSlot() {
+					l.release(host, false)
+					l.recordRejected(host)
+					conn.Close()
+					return
+				}
+				l.serve(conn, host, rule)
+			}()
+		default:
+			l.logger().Info("connection limit reached, closing connection", zap.String("addr", conn.RemoteAddr().String()))
+			l.recordRejected(host)
+			conn.Close()
+		}
+	}
+}
+
+// serve begins executing the protocol on conn in a separate goroutine, rate
+// limited or with another format if the policy rule says so.
+func (l *Listener) serve(conn net.Conn, host string, rule PolicyRule) {
+	conn = CaptureConn(conn, l.Capture, PartyServer)
+	conn, release := l.limitConn(conn)
+	if rule.Action == PolicyThrottle {
+		conn = RateLimitConn(conn, NewRateLimiter(rule.RateLimit))
+	}
+	var probe *probeConn
+	if l.DetectProbes {
+		probe = newProbeConn(conn)
+		conn = probe
+	}
+	var decoy *decoyConn
+	if l.Decoy != "" && l.AuthSecret != nil {
+		decoy = newDecoyConn(conn)
+		conn = decoy
+	}
+	if l.opts.tlsConfig != nil {
+		conn = tls.Server(conn, l.opts.tlsConfig)
+	}
+
+	streamSet := NewStreamSet()
+	streamSet.localAddr, streamSet.remoteAddr = conn.LocalAddr(), conn.RemoteAddr()
+	streamSet.OnNewStream = l.onNewStream
+	streamSet.TracePath = l.TracePath
+	l.mu.RLock()
+	streamSet.StreamRateLimit = l.StreamRateLimit
+	l.mu.RUnlock()
+	streamSet.StreamIdleTimeout = l.StreamIdleTimeout
+	streamSet.StreamMaxLifetime = l.StreamMaxLifetime
+	streamSet.OnSession = func(ss *StreamSet, ticket []byte) *StreamSet {
+		return l.onSession(ss, conn, ticket)
+	}
+	streamSet.OnJoin = func(ss *StreamSet, ticket []byte) *StreamSet {
+		return l.onJoin(ss, conn, ticket)
+	}
+
+	l.mu.RLock()
+	doc := l.doc
+	l.mu.RUnlock()
+	if rule.Action == PolicyFormat && rule.Doc != nil {
+		doc = rule.Doc
+	}
+	if l.AuthSecret != nil {
+		streamSet.requireAuth(l.AuthSecret, doc.UUID, l.Credentials, l.Replays)
+	}
+	if decoy != nil {
+		streamSet.onAuth = decoy.authenticate
+	}
+
+	f := newFSM(doc, l.iface, PartyServer, conn, streamSet, l.opts)
+	f.setSegmentation(l.Segmentation)
+	f.setHandshakeProfile(l.HandshakeProfile)
+	f.capture = l.Capture
+
+	// Run execution in a separate goroutine.
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		defer l.release(host, true)
+		defer release()
+		l.execute(f, conn, decoy, probe, host)
+	}()
+}
+
+// policyRule returns the policy rule for connections from host.
+func (l *Listener) policyRule(host string) PolicyRule {
+	if l.Policy == nil {
+		return PolicyRule{Action: PolicyAllow}
+	}
+	return l.Policy.Rule(net.ParseIP(host))
+}
+
+// relayDecoy relays conn to the decoy in a separate goroutine without
+// executing the protocol. The connection is closed if there is no decoy.
+func (l *Listener) relayDecoy(conn net.Conn) {
+	if l.Decoy == "" {
+		conn.Close()
+		return
 	}
+	decoy := newDecoyConn(conn)
+	decoy.hijack()
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		if err := decoy.serveDecoy(l.ctx, l.Decoy); err != nil {
+			l.logger().Debug("decoy relay error", zap.Error(err))
+		}
+	}()
+}
+
+// admit checks a connection from host against the connection limits. Admitted
+// and queued connections must call release() once finished.
+func (l *Listener) admit(host string) admission {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.MaxClientConns > 0 && l.hostConns[host] >= l.MaxClientConns {
+		return admitShed
+	}
+
+	result := admitServe
+	if l.MaxConns > 0 {
+		if l.slots == nil {
+			l.slots = make(chan struct{}, l.MaxConns)
+		}
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			if l.pending >= l.MaxPendingConns {
+				return admitShed
+			}
+			l.pending++
+			result = admitQueue
+		}
+	}
+	l.hostConns[host]++
+	return result
 }
 
-func (l *Listener) execute(fsm FSM, conn net.Conn) {
-	defer fsm.StreamSet().Close()
+// waitSlot waits for a served connection to finish. Returns false if the
+// listener is closed first.
+func (l *Listener) waitSlot() bool {
+	defer func() {
+		l.mu.Lock()
+		l.pending--
+		l.mu.Unlock()
+	}()
 
-	l.addConn(conn, fsm)
-	defer l.removeConn(conn, fsm)
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-l.closing:
+		return false
+	}
+}
+
+// release removes a finished connection from the limits. If slot is true then
+// the connection's slot is freed for a waiting connection.
+func (l *Listener) release(host string, slot bool) {
+	l.mu.Lock()
+	if l.hostConns[host]--; l.hostConns[host] <= 0 {
+		delete(l.hostConns, host)
+	}
+	slots := l.slots
+	l.mu.Unlock()
+
+	if slot && slots != nil {
+		select {
+		case <-slots:
+		default:
+		}
+	}
+}
+
+// limitConn applies the global & per-client rate limits to conn. Returns a
+// function to release the client's limiter once the connection is finished.
+func (l *Listener) limitConn(conn net.Conn) (net.Conn, func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Limiters are created even if unlimited so that SetRateLimits() can
+	// apply new limits to connections already being served.
+	if l.limiter == nil {
+		l.limiter = NewRateLimiter(l.RateLimit)
+	}
+
+	host, _, _ := net.SplitHostPort(conn.RemoteAddr().String())
+	c := l.clients[host]
+	if c == nil {
+		c = &clientLimiter{limiter: NewRateLimiter(l.ClientRateLimit)}
+		l.clients[host] = c
+	}
+	c.refs++
+
+	release := func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if c.refs--; c.refs == 0 {
+			delete(l.clients, host)
+		}
+	}
+	return RateLimitConn(conn, l.limiter, c.limiter), release
+}
+
+func (l *Listener) execute(fsm *fsm, conn net.Conn, decoy *decoyConn, probe *probeConn, host string) {
+	defer l.releaseStreamSet(fsm.StreamSet())
+
+	id := l.addConn(conn, fsm, host)
+	defer l.removeConn(conn, fsm, host)
+	registerFSM(id, fsm, time.Now())
+	defer unregisterFSM(id)
+
+	ctx, span := startConnSpan(l.ctx, PartyServer, fsm.UUID(), id)
+	setPeerAddr(span, conn)
+	fsm.StreamSet().setTraceContext(ctx)
+	fsm.startTracing(ctx)
+
+	publishConnOpened(id, fsm)
+
+	var err error
+	defer func() {
+		// A transition may complete before the first message is decoded, so
+		// a connection which never authenticated is checked too.
+		if probe != nil && (!fsm.handshook || fsm.streamSet.unauthenticated()) && !l.Closed() {
+			l.detectProbe(id, fsm, probe, err)
+		}
+		publishConnClosed(id, fsm, err)
+		fsm.endTracing(err)
+		endSpan(span, err)
+	}()
+
+	// Take the connection from the FSM if it doesn't authenticate in time.
+	if decoy != nil && l.DecoyTimeout > 0 {
+		timer := time.AfterFunc(l.DecoyTimeout, func() {
+			if decoy.hijack() {
+				fsm.Close()
+			}
+		})
+		defer timer.Stop()
+	}
 
 	for !l.Closed() {
-		if err := fsm.Execute(l.ctx); err == ErrStreamClosed {
-			Logger.Debug("stream closed", zap.String("addr", conn.RemoteAddr().String()))
+		if err = fsm.Execute(l.ctx); err != nil && decoy != nil && !l.Closed() && decoy.hijack() {
+			l.logger().Debug("client not authenticated, relaying to decoy", zap.String("addr", conn.RemoteAddr().String()))
+			if probe != nil {
+				probe.stop()
+			}
+			if e := decoy.serveDecoy(l.ctx, l.Decoy); e != nil {
+				l.logger().Debug("decoy relay error", zap.Error(e))
+			}
+			if err != ErrReplay {
+				err = ErrUnauthenticated
+			}
+			return
+		} else if err == ErrStreamClosed {
+			l.logger().Debug("stream closed", zap.String("addr", conn.RemoteAddr().String()))
+			err = nil
 			return
 		} else if err == io.EOF {
-			Logger.Debug("client disconnected", zap.String("addr", conn.RemoteAddr().String()))
+			l.logger().Debug("client disconnected", zap.String("addr", conn.RemoteAddr().String()))
+			err = nil
+			return
+		} else if err == ErrUnauthenticated || err == ErrReplay {
+			l.logger().Debug("client not authenticated, closing connection", zap.String("addr", conn.RemoteAddr().String()))
+			conn.Close()
 			return
 		} else if err != nil {
-			Logger.Debug("server fsm execution error", zap.Error(err))
+			l.logger().Debug("server fsm execution error", zap.Error(err))
 			return
 		}
 		fsm.Reset()
 	}
 }
 
+// detectProbe publishes an EventProbe if a connection which failed its
+// handshake with err looks like an active probe, and an EventProbeAlert if
+// enough probes have been detected within the alert window.
+func (l *Listener) detectProbe(id int, fsm *fsm, probe *probeConn, err error) {
+	if err == nil {
+		err = errHandshakeIncomplete
+	}
+	n, prefix := probe.stats()
+	kind, pattern := classifyProbe(err, n, prefix)
+	if kind == "" {
+		return
+	}
+
+	addr := probe.RemoteAddr().String()
+	l.logger().Info("active probe detected", zap.String("addr", addr), zap.String("probe", kind), zap.String("pattern", pattern))
+	publishEvent(Event{Type: EventProbe, Party: PartyServer, Format: fsm.doc.Format, ConnID: id, RemoteAddr: addr, BytesRead: n, Probe: kind, Pattern: pattern})
+
+	window := l.ProbeAlertWindow
+	if window <= 0 {
+		window = DefaultProbeAlertWindow
+	}
+	if count, alert := l.probes.add(time.Now(), l.ProbeAlertThreshold, window); alert {
+		l.logger().Warn("bridge may be being scanned", zap.Int("probes", count), zap.Duration("window", window))
+		publishEvent(Event{Type: EventProbeAlert, Party: PartyServer, Format: fsm.doc.Format, Probes: count})
+	}
+}
+
+// logger returns the logger passed to the listener's constructor, if any, or Logger.
+func (l *Listener) logger() *zap.Logger {
+	if l.opts.logger != nil {
+		return l.opts.logger
+	}
+	return Logger
+}
+
 // onNewStream is called everytime the FSM's stream set creates a new stream.
 func (l *Listener) onNewStream(stream *Stream) {
 	l.newStreams <- stream
 }
 
-func (l *Listener) addConn(conn net.Conn, fsm FSM) {
+// onSession attaches a connection to a client session. If the session exists
+// then its previous connection is closed and its stream set is returned so
+// the streams can be resumed. Otherwise ss begins a new session.
+func (l *Listener) onSession(ss *StreamSet, conn net.Conn, ticket []byte) *StreamSet {
+	t := l.Sessions
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sess := t.sessions[string(ticket)]
+	if sess == nil {
+		t.sessions[string(ticket)] = &listenerSession{streamSet: ss, owner: ss, conn: conn}
+		return ss
+	}
+
+	l.logger().Debug("session resumed", zap.String("addr", conn.RemoteAddr().String()))
+	if sess.timer != nil {
+		sess.timer.Stop()
+		sess.timer = nil
+	}
+	if sess.conn != nil {
+		sess.conn.Close()
+	}
+	sess.owner, sess.conn = ss, conn
+	return sess.streamSet
+}
+
+// onJoin attaches an additional connection to a client session, or begins a
+// new session with ss if none exists. Unlike onSession, the session's other
+// connections remain open so that cells are striped across all of them.
+func (l *Listener) onJoin(ss *StreamSet, conn net.Conn, ticket []byte) *StreamSet {
+	t := l.Sessions
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sess := t.sessions[string(ticket)]
+	if sess == nil {
+		t.sessions[string(ticket)] = &listenerSession{streamSet: ss, owner: ss, conn: conn}
+		return ss
+	}
+
+	l.logger().Debug("session joined", zap.String("addr", conn.RemoteAddr().String()))
+	if sess.timer != nil {
+		sess.timer.Stop()
+		sess.timer = nil
+	}
+	if sess.owner == nil {
+		sess.owner, sess.conn = ss, conn
+	} else {
+		if sess.joined == nil {
+			sess.joined = make(map[*StreamSet]net.Conn)
+		}
+		sess.joined[ss] = conn
+	}
+	return sess.streamSet
+}
+
+// releaseStreamSet closes the streams of a finished connection. If the
+// connection belongs to a session then its streams are kept open for
+// SessionTimeout so that the client can resume them. Streams of a session
+// with other joined connections continue on those connections.
+func (l *Listener) releaseStreamSet(ss *StreamSet) {
+	ticket := ss.Ticket()
+	if ticket == nil {
+		ss.Close()
+		return
+	}
+	ss.target().detach(ss)
+
+	// Close the connection's own set if it only forwarded to the session's set.
+	closed := l.Closed()
+	t := l.Sessions
+	t.mu.Lock()
+	sess := t.sessions[string(ticket)]
+	if sess == nil || sess.streamSet != ss {
+		defer ss.Close()
+	}
+	if sess != nil && sess.owner != ss {
+		delete(sess.joined, ss)
+	}
+	if sess == nil || sess.owner != ss {
+		t.mu.Unlock()
+		return // session expired or resumed by another connection
+	}
+
+	// Hand the session to another joined connection, if any.
+	for other, conn := range sess.joined {
+		delete(sess.joined, other)
+		sess.owner, sess.conn = other, conn
+		t.mu.Unlock()
+		return
+	}
+
+	if closed || l.SessionTimeout <= 0 {
+		delete(t.sessions, string(ticket))
+		t.mu.Unlock()
+		sess.streamSet.Close()
+		return
+	}
+
+	sess.owner, sess.conn = nil, nil
+	sess.timer = time.AfterFunc(l.SessionTimeout, func() { l.expireSession(ticket, sess) })
+	t.mu.Unlock()
+}
+
+// expireSession closes a session's streams if it has not been resumed.
+func (l *Listener) expireSession(ticket []byte, sess *listenerSession) {
+	t := l.Sessions
+	t.mu.Lock()
+	if t.sessions[string(ticket)] != sess || sess.owner != nil {
+		t.mu.Unlock()
+		return
+	}
+	delete(t.sessions, string(ticket))
+	t.mu.Unlock()
+
+	l.logger().Debug("session expired")
+	sess.streamSet.Close()
+}
+
+// addConn tracks a served connection and returns its id. The connection is
+// closed instead if the listener has closed.
+func (l *Listener) addConn(conn net.Conn, fsm FSM, host string) int {
+	id := newConnID()
 	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		conn.Close()
+		return id
+	}
 	l.conns[conn] = struct{}{}
-	l.fsms[fsm] = struct{}{}
+	l.fsms[fsm] = &listenerConn{id: id, host: host, openedAt: time.Now()}
 	l.mu.Unlock()
+	return id
 }
 
-func (l *Listener) removeConn(conn net.Conn, fsm FSM) {
+// removeConn stops tracking a finished connection and adds it to the
+// statistics of its client.
+func (l *Listener) removeConn(conn net.Conn, fsm *fsm, host string) {
 	l.mu.Lock()
+	l.recordUsage(fsm, l.fsms[fsm])
 	delete(l.conns, conn)
 	delete(l.fsms, fsm)
+	l.recordConnEnd(host, fsm)
 	l.mu.Unlock()
 }
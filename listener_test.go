@@ -0,0 +1,432 @@
+package marionette_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// waitingServerDoc is a server document which waits for data that never arrives.
+const waitingServerDoc = `connection(tcp, 0):
+  start end recv 1.0
+
+action recv:
+  server io.gets("foo")
+`
+
+func TestListener_MaxConns(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc))
+
+	// Ensure connections beyond the limit are closed and a slot is freed once
+	// a served connection finishes.
+	t.Run("Shed", func(t *testing.T) {
+		ln := mustListen(t, doc, marionette.WithConnLimits(1, 0, 0))
+		defer ln.Close()
+
+		conn0 := mustDial(t, ln)
+		defer conn0.Close()
+		assertConnOpen(t, conn0)
+
+		conn1 := mustDial(t, ln)
+		defer conn1.Close()
+		assertConnShed(t, conn1)
+
+		conn0.Close()
+		for i := 0; ; i++ {
+			if i > 100 {
+				t.Fatal("expected connection to be served")
+			}
+			conn := mustDial(t, ln)
+			defer conn.Close()
+			if isConnOpen(conn) {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+
+	// Ensure connections wait for a slot until too many are waiting.
+	t.Run("Pending", func(t *testing.T) {
+		ln := mustListen(t, doc, marionette.WithConnLimits(1, 1, 0))
+		defer ln.Close()
+
+		conn0 := mustDial(t, ln)
+		defer conn0.Close()
+		assertConnOpen(t, conn0)
+
+		conn1 := mustDial(t, ln)
+		defer conn1.Close()
+		assertConnOpen(t, conn1)
+
+		conn2 := mustDial(t, ln)
+		defer conn2.Close()
+		assertConnShed(t, conn2)
+	})
+
+	t.Run("Client", func(t *testing.T) {
+		ln := mustListen(t, doc, marionette.WithConnLimits(0, 0, 2))
+		defer ln.Close()
+
+		for i := 0; i < 2; i++ {
+			conn := mustDial(t, ln)
+			defer conn.Close()
+			assertConnOpen(t, conn)
+		}
+
+		conn := mustDial(t, ln)
+		defer conn.Close()
+		assertConnShed(t, conn)
+	})
+}
+
+// Ensure a listener serves connections from an existing socket.
+func TestNewListener(t *testing.T) {
+	sock, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln := marionette.NewListener(sock, mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc)))
+	defer ln.Close()
+
+	if ln.Addr().String() != sock.Addr().String() {
+		t.Fatalf("unexpected addr: %s", ln.Addr())
+	}
+
+	conn := mustDial(t, ln)
+	defer conn.Close()
+	mustWrite(t, conn, []byte("fo"))
+	assertConnOpen(t, conn)
+	if n := len(ln.Conns()); n != 1 {
+		t.Fatalf("unexpected conn count: %d", n)
+	}
+}
+
+func TestServe(t *testing.T) {
+	sock, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Echo each stream back to the client.
+	errs := make(chan error, 1)
+	go func() {
+		errs <- marionette.Serve(sock, mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)), func(stream net.Conn) {
+			io.Copy(stream, stream)
+		})
+	}()
+
+	doc := mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc))
+	_, doc.Port, _ = net.SplitHostPort(sock.Addr().String())
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet())
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	stream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+	mustWrite(t, stream, []byte("foo"))
+	mustRead(t, stream, []byte("foo"))
+
+	// Serve returns once its listener is closed.
+	sock.Close()
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+	}
+}
+
+func TestListener_Dial(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc))
+
+	t.Run("OK", func(t *testing.T) {
+		ln := mustListen(t, doc)
+		defer ln.Close()
+
+		conn := mustDial(t, ln)
+		defer conn.Close()
+		assertConnOpen(t, conn)
+
+		stream, err := ln.Dial()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer stream.Close()
+	})
+
+	t.Run("ErrNoClients", func(t *testing.T) {
+		ln := mustListen(t, doc)
+		defer ln.Close()
+
+		if _, err := ln.Dial(); err != marionette.ErrNoClients {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestListener_Conns(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc))
+	ln := mustListen(t, doc)
+	defer ln.Close()
+
+	conn := mustDial(t, ln)
+	defer conn.Close()
+	mustWrite(t, conn, []byte("fo"))
+	assertConnOpen(t, conn)
+
+	infos := ln.Conns()
+	if len(infos) != 1 {
+		t.Fatalf("unexpected conn count: %d", len(infos))
+	} else if info := infos[0]; info.Party != marionette.PartyServer {
+		t.Fatalf("unexpected party: %s", info.Party)
+	} else if info.RemoteAddr != conn.LocalAddr().String() {
+		t.Fatalf("unexpected remote addr: %s", info.RemoteAddr)
+	} else if info.BytesRead != 2 {
+		t.Fatalf("unexpected bytes read: %d", info.BytesRead)
+	}
+
+	if err := ln.CloseConn(infos[0].ID + 1); err != marionette.ErrConnNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	} else if err := ln.CloseConn(infos[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	assertConnShed(t, conn)
+}
+
+func TestListener_FSMs(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc))
+	ln := mustListen(t, doc)
+	defer ln.Close()
+
+	conn := mustDial(t, ln)
+	defer conn.Close()
+	mustWrite(t, conn, []byte("fo"))
+	assertConnOpen(t, conn)
+
+	id := ln.Conns()[0].ID
+	for _, info := range marionette.FSMs() {
+		if info.ID != id {
+			continue
+		} else if info.Party != marionette.PartyServer {
+			t.Fatalf("unexpected party: %s", info.Party)
+		} else if info.Format != doc.Format {
+			t.Fatalf("unexpected format: %s", info.Format)
+		} else if info.State == "" {
+			t.Fatal("expected state")
+		}
+		return
+	}
+	t.Fatalf("fsm not found: %d", id)
+}
+
+func TestListener_ClientStats(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc))
+	ln := mustListen(t, doc)
+	defer ln.Close()
+
+	conn := mustDial(t, ln)
+	defer conn.Close()
+	mustWrite(t, conn, []byte("fo"))
+	assertConnOpen(t, conn)
+
+	if a := ln.ClientStats(); len(a) != 1 {
+		t.Fatalf("unexpected client count: %d", len(a))
+	} else if cs := a[0]; cs.Addr != "127.0.0.1" || cs.Conns != 1 || cs.ActiveConns != 1 || cs.BytesRead != 2 {
+		t.Fatalf("unexpected stats: %+v", cs)
+	}
+
+	// The connection closes without completing a handshake.
+	conn.Close()
+	cs := waitForHandshakeFailures(t, ln, 1)
+	if cs.ActiveConns != 0 || cs.BytesRead != 2 || cs.BannedUntil != nil {
+		t.Fatalf("unexpected stats: %+v", cs)
+	}
+
+	if err := ln.Unban("10.0.0.1"); err != marionette.ErrClientNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure a client IP is banned after repeated handshake failures and may
+// connect again once unbanned.
+func TestListener_Ban(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc))
+	ln := mustListen(t, doc)
+	defer ln.Close()
+	ln.BanThreshold = 2
+
+	for i := 0; i < 2; i++ {
+		conn := mustDial(t, ln)
+		assertConnOpen(t, conn)
+		conn.Close()
+		waitForHandshakeFailures(t, ln, int64(i+1))
+	}
+
+	conn := mustDial(t, ln)
+	defer conn.Close()
+	assertConnShed(t, conn)
+	if cs := ln.ClientStats()[0]; cs.BannedUntil == nil || cs.Rejected != 1 {
+		t.Fatalf("unexpected stats: %+v", cs)
+	}
+
+	if err := ln.Unban("127.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	conn = mustDial(t, ln)
+	defer conn.Close()
+	assertConnOpen(t, conn)
+}
+
+// waitForHandshakeFailures waits until the listener's only client has n
+// handshake failures and returns its stats.
+func waitForHandshakeFailures(t *testing.T, ln *marionette.Listener, n int64) marionette.ClientStats {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		if a := ln.ClientStats(); len(a) == 1 && a[0].HandshakeFailures >= n {
+			return a[0]
+		} else if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %d handshake failures", n)
+		}
+	}
+}
+
+// Ensure a listener's document can be replaced only if its port is unchanged.
+func TestListener_SetDocument(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc))
+	ln := mustListen(t, doc)
+	defer ln.Close()
+
+	if err := ln.SetDocument(mar.MustParse(marionette.PartyServer, []byte(waitingServerDoc))); err != nil {
+		t.Fatal(err)
+	} else if err := ln.SetDocument(mar.MustParse(marionette.PartyServer, []byte(blockingDoc))); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// Ensure a listener shutting down waits for open streams to close.
+func TestListener_Shutdown(t *testing.T) {
+	t.Run("Drained", func(t *testing.T) {
+		dialer, ln, clientStream, serverStream := mustPipeStream(t)
+		defer dialer.Close()
+
+		done := make(chan error, 1)
+		go func() { done <- ln.Shutdown(context.Background()) }()
+
+		select {
+		case err := <-done:
+			t.Fatalf("unexpected shutdown while streams open: %v", err)
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		clientStream.Close()
+		serverStream.Close()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected shutdown once streams closed")
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		dialer, ln, clientStream, serverStream := mustPipeStream(t)
+		defer dialer.Close()
+		defer clientStream.Close()
+		defer serverStream.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		if err := ln.Shutdown(ctx); err != context.DeadlineExceeded {
+			t.Fatalf("unexpected error: %v", err)
+		} else if !ln.Closed() {
+			t.Fatal("expected listener to be closed")
+		}
+	})
+}
+
+// mustPipeStream returns a dialer & listener connected by Pipe() and the
+// client & server ends of a stream opened between them.
+func mustPipeStream(t *testing.T) (*marionette.Dialer, *marionette.Listener, net.Conn, net.Conn) {
+	t.Helper()
+	dialer, ln := marionette.Pipe(
+		mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc)),
+		mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)),
+	)
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustWrite(t, clientStream, []byte("foo"))
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustRead(t, serverStream, []byte("foo"))
+	return dialer, ln, clientStream, serverStream
+}
+
+func mustListen(t *testing.T, doc *mar.Document, opts ...marionette.Option) *marionette.Listener {
+	t.Helper()
+	ln, err := marionette.Listen(doc, "127.0.0.1", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ln
+}
+
+func mustDial(t *testing.T, ln *marionette.Listener) net.Conn {
+	t.Helper()
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+// isConnOpen returns true if the server has not closed conn after a short wait.
+func isConnOpen(conn net.Conn) bool {
+	conn.SetReadDeadline(time.Now().Add(50 * time.Millisecond))
+	defer conn.SetReadDeadline(time.Time{})
+
+	var buf [1]byte
+	_, err := conn.Read(buf[:])
+	if err, ok := err.(net.Error); ok && err.Timeout() {
+		return true
+	}
+	return false
+}
+
+func assertConnOpen(t *testing.T, conn net.Conn) {
+	t.Helper()
+	if !isConnOpen(conn) {
+		t.Fatal("expected connection to remain open")
+	}
+}
+
+func assertConnShed(t *testing.T, conn net.Conn) {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var buf [1]byte
+	if _, err := conn.Read(buf[:]); err != io.EOF {
+		t.Fatalf("expected connection to be closed, got: %v", err)
+	}
+}
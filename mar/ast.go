@@ -2,6 +2,7 @@ package mar
 
 import (
 	"math/rand"
+	"time"
 )
 
 // Node represents a node within the AST.
@@ -205,6 +206,15 @@ type Action struct {
 	Regex                    string
 	RegexPos                 Pos
 	RegexMatchIncomingRparen Pos
+
+	// Set instead of RegexMatchIncoming if Regex is matched against a
+	// variable, such as one stored by io.capture.
+	VarMatch       Pos
+	VarMatchLparen Pos
+	Var            string
+	VarPos         Pos
+	VarMatchComma  Pos
+	VarMatchRparen Pos
 }
 
 // Name returns the concatenation of the module & method.
@@ -212,14 +222,38 @@ func (a *Action) Name() string {
 	return a.Module + "." + a.Method
 }
 
+// ArgValues returns the values of the positional arguments.
 func (a *Action) ArgValues() []interface{} {
-	other := make([]interface{}, len(a.Args))
-	for i, arg := range a.Args {
-		other[i] = arg.Value
+	other := make([]interface{}, 0, len(a.Args))
+	for _, arg := range a.Args {
+		if arg.Name == "" {
+			other = append(other, arg.Value)
+		}
 	}
 	return other
 }
 
+// KeywordArg returns the keyword argument with the given name, if it exists.
+func (a *Action) KeywordArg(name string) *Arg {
+	for _, arg := range a.Args {
+		if arg.Name == name {
+			return arg
+		}
+	}
+	return nil
+}
+
+// Timeout returns the value of the "timeout" keyword argument.
+// Returns zero if no timeout is specified.
+func (a *Action) Timeout() time.Duration {
+	arg := a.KeywordArg("timeout")
+	if arg == nil {
+		return 0
+	}
+	d, _ := toDuration(arg.Value)
+	return d
+}
+
 // Transform converts the action to its complement depending on the party.
 func (a *Action) Transform(party string) {
 	switch party {
@@ -233,10 +267,10 @@ func (a *Action) Transform(party string) {
 func (a *Action) transform(from, to string) {
 	if a.Party == from {
 		switch a.Module {
-		case "fte", "tg":
-			if a.Method == "send" {
+		case "fte", "tg", "cover", "random":
+			if a.Method == "send" || a.Method == "send_queued" {
 				a.Method = "recv"
-			} else if a.Method == "send_async" {
+			} else if a.Method == "send_async" || a.Method == "send_async_queued" {
 				a.Method = "recv_async"
 			}
 			a.Party = to
@@ -263,9 +297,28 @@ func FilterActionsByParty(actions []*Action, party string) []*Action {
 }
 
 type Arg struct {
-	Value  interface{}
-	Pos    Pos
-	EndPos Pos
+	Name    string // keyword name, if specified
+	NamePos Pos
+	Value   interface{}
+	Pos     Pos
+	EndPos  Pos
+}
+
+// toDuration converts a duration, a string, or a number of seconds to a duration.
+func toDuration(v interface{}) (time.Duration, bool) {
+	switch v := v.(type) {
+	case time.Duration:
+		return v, true
+	case int:
+		return time.Duration(v) * time.Second, true
+	case float64:
+		return time.Duration(v * float64(time.Second)), true
+	case string:
+		d, err := time.ParseDuration(v)
+		return d, err == nil
+	default:
+		return 0, false
+	}
 }
 
 // Pos specifies the line and character position of a token.
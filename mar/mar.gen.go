@@ -16,6 +16,7 @@
 // formats/20150701/http_squid_blocking.mar
 // formats/20150701/https_simple_blocking.mar
 // formats/20150701/nmap/kpdyer.com.mar
+// formats/20150701/randomized.mar
 // formats/20150701/smb_simple_nonblocking.mar
 // formats/20150701/ssh_simple_nonblocking.mar
 // formats/20150701/ta/amzn_conn.mar
@@ -413,6 +414,26 @@ func formats20150701NmapKpdyerComMar() (*asset, error) {
 	return a, nil
 }
 
+var _formats20150701RandomizedMar = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7d\x90\x41\x0e\x82\x30\x10\x45\xf7\x9c\x62\x96\x90\x10\x02\xb1\x21\xc6\x33\x10\x77\xae\x49\xd3\x4e\x02\x11\xa6\x4d\x5b\x34\xde\x5e\x69\x11\xab\xa8\xb3\x9a\xf6\xff\x37\xfd\x53\xa1\x88\x50\xb8\x5e\x51\xea\x84\xce\x61\xcf\xd8\x2e\x3b\x24\x00\xd6\x71\xe3\xc0\x97\x45\x94\xa1\x83\xe3\xa9\x69\xe0\x59\x55\x51\x26\x6f\x6a\xc7\x49\xda\x8e\x9f\x11\xc0\x3c\x5a\x35\xb6\x41\x0c\xc6\x48\x9d\xb4\x75\x06\xf9\x08\xab\xb1\xc3\x61\x50\x8b\x31\x52\xa5\xba\xd2\x72\x58\x8c\x93\x7e\x3d\x1d\xa9\xdb\x89\xb3\x18\x8c\x09\xf7\x0b\xc6\x99\xe6\x0d\xc5\xd0\x23\xb9\xe5\xb6\x58\xd3\xa5\xd9\x27\xe0\xb3\x6d\x09\x8b\x24\xd3\x9a\xe5\x50\xb1\xba\xdc\x40\x93\xfe\x4e\xb4\xdc\xde\x48\xfc\xe6\xe6\xd8\xfe\xff\xd1\x5c\xd0\xfc\x25\xef\x61\x78\xfa\xeb\xbc\x01\x00\x00")
+
+func formats20150701RandomizedMarBytes() ([]byte, error) {
+	return bindataRead(
+		_formats20150701RandomizedMar,
+		"formats/20150701/randomized.mar",
+	)
+}
+
+func formats20150701RandomizedMar() (*asset, error) {
+	bytes, err := formats20150701RandomizedMarBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "formats/20150701/randomized.mar", size: 444, mode: os.FileMode(420), modTime: time.Unix(1792258828, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _formats20150701Smb_simple_nonblockingMar = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x8f\xc1\x4a\xc3\x40\x10\x86\xef\x79\x8a\xa1\x78\x68\xa1\x94\x64\x6d\x68\xf0\x2a\xf4\x54\xbc\x79\x72\xb4\xac\x93\xd1\x86\xd6\xd9\xb2\xbb\xea\x88\xf8\xee\xb2\xc1\x86\xae\x7a\xcd\xc0\x1c\x96\xef\x67\xbe\x7f\xc9\x89\x30\xc5\xce\xc9\x34\xd2\x71\x0e\x4d\xd9\x98\xd9\x55\x01\x10\xa2\xf5\x11\xfa\xd9\x59\x69\xc3\xce\xee\x19\x00\x6e\x6e\x37\x1b\x18\xa6\x5a\x94\x45\xc6\x5f\x8f\x21\x7a\xb6\x2f\x09\x06\x96\x76\xfb\x78\x70\xb4\xef\xe4\xf9\x27\x7a\xc6\x5b\xf7\x2e\xa7\x07\xa5\xec\xaf\xab\x67\x3c\xbb\xfa\x27\x5a\xd8\xbe\x7f\xee\x4b\x5f\xa0\x43\xc7\x12\xe1\x29\xf2\x22\xb1\xe9\xe4\x01\xb5\x2c\x87\x45\x5d\x11\xea\x7a\x8d\x5a\x5f\xa2\x2e\x5b\xd4\xa5\xb9\x43\x35\x35\xea\xca\xdc\x67\xd1\xb4\xd7\x9f\x55\x55\x7f\x5d\x4c\xe6\x50\x99\x66\x36\x58\xfb\xea\xff\xd8\xb6\x36\x7c\x08\x8d\xe3\x0c\x27\x67\x60\xff\xc6\x7e\x54\xe7\x77\x00\x00\x00\xff\xff\xbf\x30\x5a\x94\x21\x02\x00\x00")
 
 func formats20150701Smb_simple_nonblockingMarBytes() ([]byte, error) {
@@ -681,6 +702,7 @@ var _bindata = map[string]func() (*asset, error){
 	"formats/20150701/http_squid_blocking.mar": formats20150701Http_squid_blockingMar,
 	"formats/20150701/https_simple_blocking.mar": formats20150701Https_simple_blockingMar,
 	"formats/20150701/nmap/kpdyer.com.mar": formats20150701NmapKpdyerComMar,
+	"formats/20150701/randomized.mar": formats20150701RandomizedMar,
 	"formats/20150701/smb_simple_nonblocking.mar": formats20150701Smb_simple_nonblockingMar,
 	"formats/20150701/ssh_simple_nonblocking.mar": formats20150701Ssh_simple_nonblockingMar,
 	"formats/20150701/ta/amzn_conn.mar": formats20150701TaAmzn_connMar,
@@ -755,6 +777,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"nmap": &bintree{nil, map[string]*bintree{
 				"kpdyer.com.mar": &bintree{formats20150701NmapKpdyerComMar, map[string]*bintree{}},
 			}},
+			"randomized.mar": &bintree{formats20150701RandomizedMar, map[string]*bintree{}},
 			"smb_simple_nonblocking.mar": &bintree{formats20150701Smb_simple_nonblockingMar, map[string]*bintree{}},
 			"ssh_simple_nonblocking.mar": &bintree{formats20150701Ssh_simple_nonblockingMar, map[string]*bintree{}},
 			"ta": &bintree{nil, map[string]*bintree{
@@ -2,7 +2,10 @@ package mar
 
 import (
 	"io/ioutil"
+	"os"
 	"path"
+	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -10,6 +13,10 @@ import (
 
 var FormatVersions = []string{"20150701", "20150702"}
 
+// FormatDirs are directories searched for formats which are not built in.
+// The format "NAME" is read from the file "DIR/NAME.mar".
+var FormatDirs []string
+
 // Format returns the contents of the named embedded MAR file.
 // If the verison is not specified then latest version is returned.
 // Returns nil if the format does not exist.
@@ -30,7 +37,8 @@ func Format(name, version string) []byte {
 	return nil
 }
 
-// ReadFormat returns a built-in format, if it exists, or reads from a file.
+// ReadFormat returns a built-in format, if it exists, or a format from
+// FormatDirs. Otherwise name is read as a file path.
 func ReadFormat(name string) ([]byte, error) {
 	// Search built-in first.
 	formatName, formatVersion := SplitFormat(name)
@@ -38,10 +46,56 @@ func ReadFormat(name string) ([]byte, error) {
 		return data, nil
 	}
 
+	// Then search the format directories.
+	if path := FormatPath(name); path != "" {
+		return ioutil.ReadFile(path)
+	}
+
 	// Otherwise read from file.
 	return ioutil.ReadFile(name)
 }
 
+// FormatPath returns the path of the named format in FormatDirs. Returns a
+// blank string if the format is not found.
+func FormatPath(name string) string {
+	for _, dir := range FormatDirs {
+		path := filepath.Join(dir, filepath.FromSlash(name)+".mar")
+		if fi, err := os.Stat(path); err == nil && fi.Mode().IsRegular() {
+			return path
+		}
+	}
+	return ""
+}
+
+// UserFormats returns a sorted list of the formats in FormatDirs.
+func UserFormats() ([]string, error) {
+	seen := make(map[string]bool)
+	var names []string
+	for _, dir := range FormatDirs {
+		if err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			} else if fi.IsDir() || filepath.Ext(path) != ".mar" {
+				return nil
+			}
+
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			if name := filepath.ToSlash(strings.TrimSuffix(rel, ".mar")); !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
 // Formats returns a list of available built-in formats.
 // Excludes formats that are only to be spawned by other formats.
 func Formats() []string {
@@ -2,6 +2,10 @@ package mar_test
 
 import (
 	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/redjack/marionette/mar"
@@ -20,3 +24,41 @@ func TestFormat(t *testing.T) {
 		}
 	})
 }
+
+func TestReadFormat_FormatDirs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "marionette-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.MkdirAll(filepath.Join(dir, "custom"), 0700); err != nil {
+		t.Fatal(err)
+	} else if err := ioutil.WriteFile(filepath.Join(dir, "custom", "foo.mar"), []byte("connection(tcp, 80):\n"), 0600); err != nil {
+		t.Fatal(err)
+	} else if err := ioutil.WriteFile(filepath.Join(dir, "README"), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	mar.FormatDirs = []string{dir}
+	defer func() { mar.FormatDirs = nil }()
+
+	if buf, err := mar.ReadFormat("custom/foo"); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "connection(tcp, 80):\n" {
+		t.Fatalf("unexpected format: %q", buf)
+	}
+
+	// Built-in formats take precedence.
+	if buf, err := mar.ReadFormat("http_simple_blocking"); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Contains(buf, []byte(`HTTP/1\.0`)) {
+		t.Fatal("incorrect file")
+	}
+
+	if names, err := mar.UserFormats(); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(names, []string{"custom/foo"}) {
+		t.Fatalf("unexpected formats: %v", names)
+	}
+}
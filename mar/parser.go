@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strconv"
+	"time"
 )
 
 // Parse parses data in to a MAR document.
@@ -288,24 +289,46 @@ func (p *Parser) parseAction(scanner *Scanner) (*Action, error) {
 	}
 	action.Rparen = pos
 
-	// Parse incoming regex match.
+	// Parse incoming regex or variable match.
 	if tok, _, _ := scanner.PeekIgnoreWhitespace(); tok == IF {
 		// Read "if" statement.
 		_, _, action.If = scanner.ScanIgnoreWhitespace()
 
-		// Read 'regex_match_incoming' keyword.
+		// Read 'regex_match_incoming' or 'var_match' keyword.
 		tok, lit, pos = scanner.ScanIgnoreWhitespace()
-		if tok != REGEX_MATCH_INCOMING {
-			return nil, newSyntaxError("expected 'regex_match_incoming'", tok, lit, pos)
+		varMatch := tok == VAR_MATCH
+		switch tok {
+		case REGEX_MATCH_INCOMING:
+			action.RegexMatchIncoming = pos
+		case VAR_MATCH:
+			action.VarMatch = pos
+		default:
+			return nil, newSyntaxError("expected 'regex_match_incoming' or 'var_match'", tok, lit, pos)
 		}
-		action.RegexMatchIncoming = pos
 
-		// Read parens and regex string.
+		// Read parens, variable name and regex string.
 		tok, lit, pos = scanner.Scan()
 		if tok != LPAREN {
 			return nil, newSyntaxError("expected '('", tok, lit, pos)
 		}
-		action.RegexMatchIncomingLparen = pos
+		if varMatch {
+			action.VarMatchLparen = pos
+
+			tok, lit, pos = scanner.ScanIgnoreWhitespace()
+			if tok != STRING {
+				return nil, newSyntaxError("expected variable name string", tok, lit, pos)
+			}
+			action.Var = lit
+			action.VarPos = pos
+
+			tok, lit, pos = scanner.ScanIgnoreWhitespace()
+			if tok != COMMA {
+				return nil, newSyntaxError("expected ','", tok, lit, pos)
+			}
+			action.VarMatchComma = pos
+		} else {
+			action.RegexMatchIncomingLparen = pos
+		}
 
 		tok, lit, pos = scanner.ScanIgnoreWhitespace()
 		if tok != STRING {
@@ -318,12 +341,24 @@ func (p *Parser) parseAction(scanner *Scanner) (*Action, error) {
 		if tok != RPAREN {
 			return nil, newSyntaxError("expected ')'", tok, lit, pos)
 		}
-		action.RegexMatchIncomingRparen = pos
+		if varMatch {
+			action.VarMatchRparen = pos
+		} else {
+			action.RegexMatchIncomingRparen = pos
+		}
 	}
 
 	// Perform transformation depending on party.
 	action.Transform(p.party)
 
+	// Ensure the action is assigned to a party that is allowed to invoke it.
+	if !PartyAllowed(action.Module, action.Method, action.Party) {
+		return nil, &SyntaxError{
+			Message: fmt.Sprintf("%s cannot be invoked by %s at line %d", action.Name(), action.Party, action.PartyPos.Line),
+			Pos:     action.PartyPos,
+		}
+	}
+
 	return &action, nil
 }
 
@@ -335,30 +370,60 @@ func (p *Parser) parseArgs(scanner *Scanner) ([]*Arg, error) {
 	var args []*Arg
 	for {
 		tok, lit, pos := scanner.ScanIgnoreWhitespace()
-		arg := &Arg{Pos: pos, EndPos: Pos{Line: pos.Line, Char: pos.Char + len(lit)}}
+		arg := &Arg{}
+
+		// Keyword arguments are specified as "name=value" and must come after
+		// all positional arguments.
+		if next, _, _ := scanner.Peek(); tok == IDENT && next == EQ {
+			if _, ok := keywordArgs[lit]; !ok {
+				return nil, newSyntaxError("unknown keyword argument", tok, lit, pos)
+			}
+			arg.Name, arg.NamePos = lit, pos
+			scanner.Scan()
+			tok, lit, pos = scanner.ScanIgnoreWhitespace()
+		} else if len(args) > 0 && args[len(args)-1].Name != "" {
+			return nil, newSyntaxError("positional argument follows keyword argument", tok, lit, pos)
+		}
+		arg.Pos, arg.EndPos = pos, Pos{Line: pos.Line, Char: pos.Char + len(lit)}
 
 		switch tok {
 		case STRING:
 			arg.Value = lit
 
-		case INTEGER:
-			i, err := strconv.Atoi(lit)
-			if err != nil {
-				return nil, err
+		case INTEGER, FLOAT:
+			// Numbers immediately followed by a unit are durations (e.g. 5s).
+			if next, unit, _ := scanner.Peek(); next == IDENT {
+				scanner.Scan()
+				d, err := time.ParseDuration(lit + unit)
+				if err != nil {
+					return nil, newSyntaxError("invalid duration", tok, lit+unit, pos)
+				}
+				arg.Value, arg.EndPos.Char = d, arg.EndPos.Char+len(unit)
+			} else if tok == INTEGER {
+				i, err := strconv.Atoi(lit)
+				if err != nil {
+					return nil, err
+				}
+				arg.Value = i
+			} else {
+				f, err := strconv.ParseFloat(lit, 64)
+				if err != nil {
+					return nil, err
+				}
+				arg.Value = f
 			}
-			arg.Value = i
-
-		case FLOAT:
-			f, err := strconv.ParseFloat(lit, 64)
-			if err != nil {
-				return nil, err
-			}
-			arg.Value = f
 
 		default:
 			return nil, newSyntaxError("expected string, integer, or float argument", tok, lit, pos)
 		}
 
+		// Validate keyword argument values.
+		if arg.Name != "" {
+			if validate := keywordArgs[arg.Name]; validate != nil && !validate(arg.Value) {
+				return nil, newSyntaxError("invalid "+arg.Name+" argument", tok, lit, pos)
+			}
+		}
+
 		args = append(args, arg)
 
 		if tok, _, _ := scanner.PeekIgnoreWhitespace(); tok == COMMA {
@@ -372,6 +437,39 @@ func (p *Parser) parseArgs(scanner *Scanner) ([]*Arg, error) {
 	return args, nil
 }
 
+// keywordArgs are the keyword arguments accepted by all actions, along with
+// an optional function to validate their values.
+var keywordArgs = map[string]func(v interface{}) bool{
+	"timeout": func(v interface{}) bool {
+		d, ok := toDuration(v)
+		return ok && d > 0
+	},
+}
+
+// partyRestrictions holds the parties allowed to invoke an action, keyed by
+// "module.method". Actions without an entry may be invoked by any party.
+var partyRestrictions = make(map[string][]string)
+
+// RestrictParties limits the parties which may invoke module.method.
+// Documents which assign the action to any other party fail to parse.
+func RestrictParties(module, method string, parties ...string) {
+	partyRestrictions[module+"."+method] = parties
+}
+
+// PartyAllowed returns true if party may invoke module.method.
+func PartyAllowed(module, method, party string) bool {
+	parties, ok := partyRestrictions[module+"."+method]
+	if !ok {
+		return true
+	}
+	for _, other := range parties {
+		if other == party {
+			return true
+		}
+	}
+	return false
+}
+
 func expect(expectedTok Token, expectedLit string, tok Token, lit string, pos Pos) error {
 	switch expectedTok {
 	case IDENT:
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/redjack/marionette/mar"
@@ -870,6 +871,82 @@ action downstream_async:
 		}
 	})
 
+	t.Run("keyword_args", func(t *testing.T) {
+		doc, err := Parse("", `connection(tcp, 80):
+        start end recv 1.0
+        start end NULL error
+        action recv:
+        client io.gets("foo", timeout=1.5s)
+        client io.gets("bar", timeout=2)
+        `)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actions := doc.ActionBlocks[0].Actions
+		if got := actions[0].ArgValues(); !reflect.DeepEqual(got, []interface{}{"foo"}) {
+			t.Fatalf("unexpected args: %#v", got)
+		} else if got := actions[0].Timeout(); got != 1500*time.Millisecond {
+			t.Fatalf("unexpected timeout: %s", got)
+		} else if got := actions[1].Timeout(); got != 2*time.Second {
+			t.Fatalf("unexpected timeout: %s", got)
+		}
+	})
+
+	t.Run("var_match", func(t *testing.T) {
+		doc, err := Parse("", `connection(tcp, 80):
+        start end recv 1.0
+        action recv:
+        client io.puts("active") if var_match("mode", "^active$")
+        client io.puts("passive")
+        `)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actions := doc.ActionBlocks[0].Actions
+		if actions[0].Var != "mode" || actions[0].Regex != "^active$" {
+			t.Fatalf("unexpected match: %q %q", actions[0].Var, actions[0].Regex)
+		} else if actions[1].Var != "" || actions[1].Regex != "" {
+			t.Fatalf("unexpected match: %q %q", actions[1].Var, actions[1].Regex)
+		}
+	})
+
+	t.Run("ErrVarMatchRegex", func(t *testing.T) {
+		if _, err := Parse("", "connection(tcp, 80):\n  start end recv 1.0\naction recv:\n  client io.puts(\"foo\") if var_match(\"mode\")\n"); err == nil || err.Error() != `expected ',' at line 3, found )` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrUnknownKeywordArg", func(t *testing.T) {
+		if _, err := Parse("", "connection(tcp, 80):\n  start end recv 1.0\naction recv:\n  client io.gets(\"foo\", tmeout=1s)\n"); err == nil || err.Error() != `unknown keyword argument at line 3, found IDENT` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrPositionalAfterKeywordArg", func(t *testing.T) {
+		if _, err := Parse("", "connection(tcp, 80):\n  start end recv 1.0\naction recv:\n  client io.gets(timeout=1s, \"foo\")\n"); err == nil || err.Error() != `positional argument follows keyword argument at line 3, found STRING` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrInvalidTimeout", func(t *testing.T) {
+		if _, err := Parse("", "connection(tcp, 80):\n  start end recv 1.0\naction recv:\n  client io.gets(\"foo\", timeout=\"soon\")\n"); err == nil || err.Error() != `invalid timeout argument at line 3, found STRING` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	// Ensure actions restricted to a party cannot be assigned to another.
+	t.Run("ErrPartyRestricted", func(t *testing.T) {
+		mar.RestrictParties("partytest", "serve", "server")
+
+		if _, err := Parse("", "connection(tcp, 80):\n  start end recv 1.0\naction recv:\n  server partytest.serve()\n"); err != nil {
+			t.Fatal(err)
+		} else if _, err := Parse("", "connection(tcp, 80):\n  start end recv 1.0\naction recv:\n  client partytest.serve()\n"); err == nil || err.Error() != `partytest.serve cannot be invoked by client at line 3` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
 	// Sanity check all built-in formats.
 	for _, format := range mar.Formats() {
 		t.Run(format, func(t *testing.T) {
@@ -922,8 +999,14 @@ func Strip(node mar.Node) {
 			node.RegexMatchIncomingLparen = mar.Pos{}
 			node.RegexPos = mar.Pos{}
 			node.RegexMatchIncomingRparen = mar.Pos{}
+			node.VarMatch = mar.Pos{}
+			node.VarMatchLparen = mar.Pos{}
+			node.VarPos = mar.Pos{}
+			node.VarMatchComma = mar.Pos{}
+			node.VarMatchRparen = mar.Pos{}
 
 		case *mar.Arg:
+			node.NamePos = mar.Pos{}
 			node.Pos = mar.Pos{}
 			node.EndPos = mar.Pos{}
 		}
@@ -55,6 +55,8 @@ func (s *Scanner) Scan() (tok Token, lit string, pos Pos) {
 			return DOT, string(ch), pos
 		case '#':
 			return HASH, string(ch), pos
+		case '=':
+			return EQ, string(ch), pos
 		default:
 			return ILLEGAL, string(ch), pos
 		}
@@ -245,6 +247,8 @@ func (s *Scanner) scanIdent() (tok Token, lit string, pos Pos) {
 		return SERVER, lit, pos
 	case "start":
 		return START, lit, pos
+	case "var_match":
+		return VAR_MATCH, lit, pos
 	default:
 		return IDENT, buf.String(), pos
 	}
@@ -23,6 +23,7 @@ const (
 	COMMA  // ,
 	COLON  // :
 	HASH   // #
+	EQ     // =
 
 	// keywords
 	ACTION
@@ -32,6 +33,7 @@ const (
 	REGEX_MATCH_INCOMING
 	SERVER
 	START
+	VAR_MATCH
 )
 
 var tokens = [...]string{
@@ -52,6 +54,7 @@ var tokens = [...]string{
 	COMMA:  ",",
 	COLON:  ":",
 	HASH:   "#",
+	EQ:     "=",
 
 	ACTION:               "action",
 	CLIENT:               "client",
@@ -60,6 +63,7 @@ var tokens = [...]string{
 	REGEX_MATCH_INCOMING: "regex_match_incoming",
 	SERVER:               "server",
 	START:                "start",
+	VAR_MATCH:            "var_match",
 }
 
 func (tok Token) String() string {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"math/big"
 	"math/rand"
+	"sort"
 	"time"
 
+	"github.com/redjack/marionette/mar"
 	"go.uber.org/zap"
 )
 
@@ -24,6 +26,17 @@ func init() {
 // Logger is the global marionette logger.
 var Logger = zap.NewNop()
 
+// Names of the subsystem loggers derived from Logger. Each is a separate
+// named logger so that its level may be set independently.
+const (
+	LoggerFSM   = "fsm"
+	LoggerFTE   = "fte"
+	LoggerProxy = "proxy"
+)
+
+// proxyLogger returns the logger used by the proxies & relays.
+func proxyLogger() *zap.Logger { return Logger.Named(LoggerProxy) }
+
 // Rand returns a new PRNG seeded from the current time.
 // This function can be overridden by the tests to provide a repeatable PRNG.
 var Rand = func() *rand.Rand { return rand.New(rand.NewSource(time.Now().UnixNano())) }
@@ -31,18 +44,91 @@ var Rand = func() *rand.Rand { return rand.New(rand.NewSource(time.Now().UnixNan
 // PluginFunc represents a plugin in the MAR language.
 type PluginFunc func(ctx context.Context, fsm FSM, args ...interface{}) error
 
+// ModuleFunc returns a plugin for any method name within a module.
+// Returns nil if the method is not supported.
+type ModuleFunc func(method string) PluginFunc
+
 // FindPlugin returns a plugin function by module & name.
+// Falls back to the module's ModuleFunc, if registered.
 func FindPlugin(module, method string) PluginFunc {
-	return plugins[pluginKey{module, method}]
+	if fn := plugins[pluginKey{module, method}]; fn != nil {
+		return fn
+	} else if fn := modules[module]; fn != nil {
+		return fn(method)
+	}
+	return nil
 }
 
 // RegisterPlugin adds a plugin to the plugin registry.
+// Optional metadata can be provided to describe the plugin.
 // Panic on duplicate registration.
-func RegisterPlugin(module, method string, fn PluginFunc) {
+func RegisterPlugin(module, method string, fn PluginFunc, info ...PluginInfo) {
 	if v := FindPlugin(module, method); v != nil {
 		panic("plugin already registered")
 	}
 	plugins[pluginKey{module, method}] = fn
+
+	other := PluginInfo{}
+	if len(info) > 0 {
+		other = info[0]
+	}
+	other.Module, other.Method = module, method
+	pluginInfos[pluginKey{module, method}] = &other
+
+	// Reject documents which assign the plugin to the wrong party.
+	if len(other.Parties) > 0 {
+		mar.RestrictParties(module, method, other.Parties...)
+	}
+}
+
+// PluginInfo describes a registered plugin.
+type PluginInfo struct {
+	Module      string
+	Method      string
+	Description string
+
+	// Arguments accepted by the plugin, in order.
+	Args []PluginArg
+
+	// Parties that may invoke the plugin. Empty if any party is allowed.
+	Parties []string
+}
+
+// Name returns the concatenation of the module & method.
+func (info *PluginInfo) Name() string {
+	return info.Module + "." + info.Method
+}
+
+// PluginArg describes a single plugin argument.
+type PluginArg struct {
+	Name     string
+	Type     string // "string", "int", or "float"
+	Optional bool
+}
+
+// FindPluginInfo returns metadata for a registered plugin.
+// Returns nil if the plugin is not registered by name.
+func FindPluginInfo(module, method string) *PluginInfo {
+	return pluginInfos[pluginKey{module, method}]
+}
+
+// Plugins returns metadata for all plugins registered by name, sorted by name.
+func Plugins() []*PluginInfo {
+	a := make([]*PluginInfo, 0, len(pluginInfos))
+	for _, info := range pluginInfos {
+		a = append(a, info)
+	}
+	sort.Slice(a, func(i, j int) bool { return a[i].Name() < a[j].Name() })
+	return a
+}
+
+// RegisterModule adds a handler for all methods of a module to the registry.
+// Plugins registered by name take precedence. Panic on duplicate registration.
+func RegisterModule(module string, fn ModuleFunc) {
+	if _, ok := modules[module]; ok {
+		panic("module already registered")
+	}
+	modules[module] = fn
 }
 
 type pluginKey struct {
@@ -52,6 +138,10 @@ type pluginKey struct {
 
 var plugins = make(map[pluginKey]PluginFunc)
 
+var pluginInfos = make(map[pluginKey]*PluginInfo)
+
+var modules = make(map[string]ModuleFunc)
+
 // Cipher represents the interface to the FTE Cipher.
 type Cipher interface {
 	Capacity() int
@@ -67,6 +157,15 @@ type DFA interface {
 	NumWordsInSlice(n int) (numWords *big.Int, err error)
 }
 
+// trimHostBrackets removes the brackets surrounding an IPv6 literal host so
+// that "[::1]" and "::1" can be used interchangeably with net.JoinHostPort().
+func trimHostBrackets(host string) string {
+	if len(host) > 1 && host[0] == '[' && host[len(host)-1] == ']' {
+		return host[1 : len(host)-1]
+	}
+	return host
+}
+
 func assert(condition bool) {
 	if !condition {
 		panic("assertion failed")
@@ -1,9 +1,12 @@
 package marionette_test
 
 import (
+	"context"
 	"math/rand"
+	"testing"
 
 	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
 	_ "github.com/redjack/marionette/plugins"
 )
 
@@ -16,3 +19,48 @@ func init() {
 func NewRand() *rand.Rand {
 	return rand.New(rand.NewSource(0))
 }
+
+func TestFindPluginInfo(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		info := marionette.FindPluginInfo("io", "puts")
+		if info == nil {
+			t.Fatal("expected info")
+		} else if got, want := info.Name(), "io.puts"; got != want {
+			t.Fatalf("Name()=%q, want %q", got, want)
+		} else if len(info.Args) == 0 {
+			t.Fatal("expected args")
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		if info := marionette.FindPluginInfo("no", "such"); info != nil {
+			t.Fatalf("unexpected info: %#v", info)
+		}
+	})
+}
+
+// Ensure documents assigning a party-restricted plugin to the wrong party
+// are rejected when parsed.
+func TestRegisterPlugin_Parties(t *testing.T) {
+	marionette.RegisterPlugin("test", "server_only", func(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+		return nil
+	}, marionette.PluginInfo{Parties: []string{marionette.PartyServer}})
+
+	if _, err := mar.Parse(marionette.PartyServer, []byte("connection(tcp, 80):\n  start end blk 1.0\naction blk:\n  server test.server_only()\n")); err != nil {
+		t.Fatal(err)
+	} else if _, err := mar.Parse(marionette.PartyServer, []byte("connection(tcp, 80):\n  start end blk 1.0\naction blk:\n  client test.server_only()\n")); err == nil || err.Error() != `test.server_only cannot be invoked by client at line 3` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPlugins(t *testing.T) {
+	infos := marionette.Plugins()
+	if len(infos) == 0 {
+		t.Fatal("expected plugins")
+	}
+	for i := 1; i < len(infos); i++ {
+		if infos[i-1].Name() >= infos[i].Name() {
+			t.Fatalf("plugins not sorted: %q >= %q", infos[i-1].Name(), infos[i].Name())
+		}
+	}
+}
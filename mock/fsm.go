@@ -24,15 +24,17 @@ type FSM struct {
 	NextFn          func(ctx context.Context) error
 	ExecuteFn       func(ctx context.Context) error
 	ResetFn         func()
-	ListenFn        func() (int, error)
+	ListenFn        func(network string, minPort, maxPort int) (int, error)
 	ConnFn          func() *marionette.BufferedConn
 	StreamSetFn     func() *marionette.StreamSet
 	CipherFn        func(regex string, n int) (marionette.Cipher, error)
 	DFAFn           func(regex string, n int) (marionette.DFA, error)
 	SetVarFn        func(key string, value interface{})
 	VarFn           func(key string) interface{}
+	VarsFn          func() map[string]interface{}
 	CloneFn         func(doc *mar.Document) marionette.FSM
 	LoggerFn        func() *zap.Logger
+	StepFn          func() int
 
 	BufferedConn *marionette.BufferedConn
 }
@@ -45,7 +47,9 @@ func NewFSM(conn net.Conn, streamSet *marionette.StreamSet) FSM {
 	fsm.StateFn = func() string { return "default" }
 	fsm.ConnFn = func() *marionette.BufferedConn { return fsm.BufferedConn }
 	fsm.StreamSetFn = func() *marionette.StreamSet { return streamSet }
+	fsm.VarFn = func(key string) interface{} { return nil }
 	fsm.LoggerFn = func() *zap.Logger { return marionette.Logger }
+	fsm.StepFn = func() int { return 0 }
 	return fsm
 }
 
@@ -64,12 +68,16 @@ func (m *FSM) Next(ctx context.Context) error    { return m.NextFn(ctx) }
 func (m *FSM) Execute(ctx context.Context) error { return m.ExecuteFn(ctx) }
 func (m *FSM) Reset()                            { m.ResetFn() }
 
-func (m *FSM) Listen() (int, error)             { return m.ListenFn() }
+func (m *FSM) Listen(network string, minPort, maxPort int) (int, error) {
+	return m.ListenFn(network, minPort, maxPort)
+}
+
 func (m *FSM) Conn() *marionette.BufferedConn   { return m.ConnFn() }
 func (m *FSM) StreamSet() *marionette.StreamSet { return m.StreamSetFn() }
 
 func (m *FSM) SetVar(key string, value interface{}) { m.SetVarFn(key, value) }
 func (m *FSM) Var(key string) interface{}           { return m.VarFn(key) }
+func (m *FSM) Vars() map[string]interface{}         { return m.VarsFn() }
 
 func (m *FSM) Cipher(regex string, n int) (marionette.Cipher, error) {
 	return m.CipherFn(regex, n)
@@ -81,4 +89,6 @@ func (m *FSM) DFA(regex string, msgLen int) (marionette.DFA, error) {
 
 func (m *FSM) Clone(doc *mar.Document) marionette.FSM { return m.CloneFn(doc) }
 
+func (m *FSM) Step() int { return m.StepFn() }
+
 func (m *FSM) Logger() *zap.Logger { return m.LoggerFn() }
@@ -0,0 +1,158 @@
+package marionette
+
+import (
+	"context"
+	"crypto/tls"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/redjack/marionette/fte"
+	"go.uber.org/zap"
+)
+
+// Option configures a Dialer, Listener or FSM when passed to its constructor.
+// A dialer or listener passes its options on to the FSMs it creates. Options
+// which do not apply to what is being created are ignored.
+type Option func(*options)
+
+// options holds the settings applied by Options. Zero values keep the defaults.
+type options struct {
+	logger           *zap.Logger
+	rand             *lockedRand
+	fteCache         *fte.Cache
+	bufferSize       int
+	dial             func(ctx context.Context, network, address string) (net.Conn, error)
+	tlsConfig        *tls.Config
+	dialTimeout      time.Duration
+	handshakeTimeout time.Duration
+	authSecret       []byte
+	credentials      *Credentials
+	decoy            string
+	decoyTimeout     time.Duration
+	maxConns         int
+	maxPendingConns  int
+	maxClientConns   int
+	policy           *Policy
+	handshakeProfile *HandshakeProfile
+	detectProbes     bool
+	probeThreshold   int
+	probeWindow      time.Duration
+}
+
+// newOptions returns the settings of opts.
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithLogger logs to logger instead of the package Logger.
+func WithLogger(logger *zap.Logger) Option {
+	return func(o *options) { o.logger = logger }
+}
+
+// WithRand generates the instance IDs which seed each FSM's PRNG from src
+// instead of the global source, such as to reproduce a test. The source is
+// guarded so it may be shared by an FSM's dialer or listener.
+func WithRand(src rand.Source) Option {
+	return func(o *options) { o.rand = &lockedRand{r: rand.New(src)} }
+}
+
+// WithCipherCache builds the FTE ciphers of each FSM in cache instead of a
+// cache per FSM, so connections of the same format share their ciphers.
+func WithCipherCache(cache *fte.Cache) Option {
+	return func(o *options) { o.fteCache = cache }
+}
+
+// WithBufferSize sets the size, in bytes, of each connection's read buffer.
+// Defaults to MaxCellLength.
+func WithBufferSize(n int) Option {
+	return func(o *options) { o.bufferSize = n }
+}
+
+// WithDialFunc opens connections with fn, such as the connections to the
+// server or to additional ports opened by a format.
+func WithDialFunc(fn func(ctx context.Context, network, address string) (net.Conn, error)) Option {
+	return func(o *options) { o.dial = fn }
+}
+
+// WithTLSConfig wraps each connection in TLS using config, as a client for
+// dialers & as a server for listeners.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) { o.tlsConfig = config }
+}
+
+// WithDialTimeout limits the time spent connecting to the server, including
+// any TLS handshake.
+func WithDialTimeout(d time.Duration) Option {
+	return func(o *options) { o.dialTimeout = d }
+}
+
+// WithHandshakeTimeout closes a connection if no message is received from the
+// peer within d of the connection's FSM being created.
+func WithHandshakeTimeout(d time.Duration) Option {
+	return func(o *options) { o.handshakeTimeout = d }
+}
+
+// WithAuthSecret sets the bridge secret of a dialer or listener, which a
+// listener's clients must prove knowledge of. Unlike setting AuthSecret, a
+// listener is never serving connections before the secret is required.
+func WithAuthSecret(secret []byte) Option {
+	return func(o *options) { o.authSecret = secret }
+}
+
+// WithCredentials sets the users of a listener's private bridge. Requires
+// WithAuthSecret().
+func WithCredentials(creds *Credentials) Option {
+	return func(o *options) { o.credentials = creds }
+}
+
+// WithDecoy relays a listener's connections which fail before authenticating
+// to the decoy service at addr, or which have not authenticated within
+// timeout unless it is zero. Requires WithAuthSecret().
+func WithDecoy(addr string, timeout time.Duration) Option {
+	return func(o *options) { o.decoy, o.decoyTimeout = addr, timeout }
+}
+
+// WithConnLimits sets a listener's MaxConns, MaxPendingConns &
+// MaxClientConns so that they apply from the first connection accepted.
+func WithConnLimits(maxConns, maxPendingConns, maxClientConns int) Option {
+	return func(o *options) {
+		o.maxConns, o.maxPendingConns, o.maxClientConns = maxConns, maxPendingConns, maxClientConns
+	}
+}
+
+// WithPolicy chooses how a listener treats each connection by the country &
+// network it comes from, starting with the first connection accepted.
+func WithPolicy(p *Policy) Option {
+	return func(o *options) { o.policy = p }
+}
+
+// WithHandshakeProfile randomizes the size & timing of the first messages on
+// each connection of a dialer or listener with p.
+func WithHandshakeProfile(p *HandshakeProfile) Option {
+	return func(o *options) { o.handshakeProfile = p }
+}
+
+// WithProbeDetection publishes an event for each of a listener's connections
+// which looks like an active probe, and an alert each time threshold probes
+// are detected within window. Zero threshold disables alerts.
+func WithProbeDetection(threshold int, window time.Duration) Option {
+	return func(o *options) { o.detectProbes, o.probeThreshold, o.probeWindow = true, threshold, window }
+}
+
+// lockedRand is a PRNG which is safe for concurrent use.
+type lockedRand struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func (r *lockedRand) Int31() int32 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.r.Int31()
+}
@@ -0,0 +1,107 @@
+package marionette_test
+
+import (
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+func TestWithRand(t *testing.T) {
+	data, err := mar.ReadFormat("http_simple_blocking:20150701")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := mar.MustParse(marionette.PartyClient, data)
+
+	newFSM := func() marionette.FSM {
+		conn, other := net.Pipe()
+		t.Cleanup(func() { conn.Close(); other.Close() })
+		return marionette.NewFSM(doc, "127.0.0.1", marionette.PartyClient, conn, marionette.NewStreamSet(), marionette.WithRand(rand.NewSource(1)))
+	}
+	if a, b := newFSM(), newFSM(); a.InstanceID() == 0 || a.InstanceID() != b.InstanceID() {
+		t.Fatalf("unexpected instance ids: %d, %d", a.InstanceID(), b.InstanceID())
+	}
+}
+
+// Ensure a connection is replaced if the server does not reply in time.
+func TestWithHandshakeTimeout(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	var pd pipeDialer
+	defer pd.Close()
+
+	dialer := marionette.NewDialer(doc, "127.0.0.1", marionette.NewStreamSet(), marionette.WithHandshakeTimeout(50*time.Millisecond))
+	dialer.Dialer = &pd
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	for i := 0; pd.N() < 2; i++ {
+		if i > 500 {
+			t.Fatal("expected replacement connection")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Ensure a listener requires the secret from its first connection. Run with
+// -race to check the listener's settings are not changed while it accepts.
+func TestWithAuthSecret(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		secret []byte
+		served bool
+	}{
+		{"NoSecret", nil, false},
+		{"WrongSecret", mustAuthSecret(t), false},
+		{"Secret", nil, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := mustAuthSecret(t)
+			dialer, ln := marionette.Pipe(
+				mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc)),
+				mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)),
+				marionette.WithAuthSecret(secret),
+			)
+			defer ln.Close()
+			if !tt.served {
+				dialer.AuthSecret = tt.secret
+			}
+
+			failed := make(chan error, 8)
+			dialer.OnError = func(err error) { failed <- err }
+			if err := dialer.Open(); err != nil {
+				t.Fatal(err)
+			}
+			defer dialer.Close()
+
+			stream, err := dialer.Dial()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer stream.Close()
+			mustWrite(t, stream, []byte("foo"))
+
+			if !tt.served {
+				select {
+				case <-failed:
+				case <-time.After(5 * time.Second):
+					t.Fatal("expected connection to be closed")
+				}
+				return
+			}
+
+			serverStream, err := ln.Accept()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer serverStream.Close()
+			mustRead(t, serverStream, []byte("foo"))
+		})
+	}
+}
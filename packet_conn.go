@@ -0,0 +1,152 @@
+package marionette
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// packetConnBacklog is the number of datagrams queued for each peer, and the
+// number of new peers queued for accept, before further datagrams are dropped.
+const packetConnBacklog = 64
+
+// Ensure type implements interface.
+var _ net.Conn = &packetConn{}
+
+// packetListener demultiplexes datagrams received on a shared packet conn by
+// their sender. Each new sender is returned by Accept() as a packetConn.
+type packetListener struct {
+	pc      net.PacketConn
+	accepts chan *packetConn
+	closing chan struct{}
+	err     error // set before closing is closed
+
+	mu    sync.Mutex
+	conns map[string]*packetConn
+}
+
+// newPacketListener returns a listener which reads datagrams from pc.
+func newPacketListener(pc net.PacketConn) *packetListener {
+	l := &packetListener{
+		pc:      pc,
+		accepts: make(chan *packetConn, packetConnBacklog),
+		closing: make(chan struct{}),
+		conns:   make(map[string]*packetConn),
+	}
+	go l.serve()
+	return l
+}
+
+// Accept waits for a datagram from a new peer and returns a connection to
+// it. The first datagram is returned by the connection's first read.
+func (l *packetListener) Accept() (*packetConn, error) {
+	select {
+	case conn := <-l.accepts:
+		return conn, nil
+	case <-l.closing:
+		return nil, l.err
+	}
+}
+
+// Close closes the underlying packet conn and all peer connections.
+func (l *packetListener) Close() error {
+	return l.pc.Close()
+}
+
+// serve reads datagrams & routes them to their peer until pc is closed.
+// Datagrams are dropped if their peer's queue is full, as on the network.
+func (l *packetListener) serve() {
+	buf := make([]byte, 65536)
+	for {
+		n, addr, err := l.pc.ReadFrom(buf)
+		if err != nil {
+			l.err = err
+			close(l.closing)
+			return
+		}
+		data := make([]byte, n)
+		copy(data, buf[:n])
+
+		l.mu.Lock()
+		conn := l.conns[addr.String()]
+		if conn == nil {
+			conn = &packetConn{l: l, raddr: addr, incoming: make(chan []byte, packetConnBacklog), closing: make(chan struct{})}
+			select {
+			case l.accepts <- conn:
+				l.conns[addr.String()] = conn
+			default:
+				conn = nil
+			}
+		}
+		l.mu.Unlock()
+
+		if conn != nil {
+			select {
+			case conn.incoming <- data:
+			default:
+			}
+		}
+	}
+}
+
+// remove stops routing datagrams to conn.
+func (l *packetListener) remove(conn *packetConn) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conns[conn.raddr.String()] == conn {
+		delete(l.conns, conn.raddr.String())
+	}
+}
+
+// packetConn is a net.Conn to a single peer of a packetListener.
+type packetConn struct {
+	l        *packetListener
+	raddr    net.Addr
+	incoming chan []byte
+
+	once    sync.Once
+	closing chan struct{}
+}
+
+// Read reads the next datagram from the peer.
+func (c *packetConn) Read(b []byte) (n int, err error) {
+	select {
+	case data := <-c.incoming:
+		return copy(b, data), nil
+	case <-c.closing:
+		return 0, net.ErrClosed
+	case <-c.l.closing:
+		return 0, c.l.err
+	}
+}
+
+// Write sends b as a single datagram to the peer.
+func (c *packetConn) Write(b []byte) (n int, err error) {
+	select {
+	case <-c.closing:
+		return 0, net.ErrClosed
+	default:
+	}
+	return c.l.pc.WriteTo(b, c.raddr)
+}
+
+// Close unblocks any pending reads and stops receiving from the peer. The
+// listener is left open as it is shared by the FSM that created it.
+func (c *packetConn) Close() error {
+	c.once.Do(func() {
+		close(c.closing)
+		c.l.remove(c)
+	})
+	return nil
+}
+
+// LocalAddr returns the address of the listener.
+func (c *packetConn) LocalAddr() net.Addr { return c.l.pc.LocalAddr() }
+
+// RemoteAddr returns the address of the peer.
+func (c *packetConn) RemoteAddr() net.Addr { return c.raddr }
+
+// Deadlines are not supported as they would apply to every peer.
+func (c *packetConn) SetDeadline(t time.Time) error      { return nil }
+func (c *packetConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *packetConn) SetWriteDeadline(t time.Time) error { return nil }
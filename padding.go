@@ -0,0 +1,134 @@
+package marionette
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// PaddingMachineVar is the FSM variable that holds the PaddingMachine used to
+// inject dummy cells & delays on the connection.
+const PaddingMachineVar = "model_padding_machine"
+
+// PaddingDistribution maps durations, in seconds, to their probability. Any
+// probability not covered, such as from weights summing to less than one, is
+// the chance of an infinite duration.
+type PaddingDistribution map[float64]float64
+
+// Sample returns a random duration from the distribution, or false if the
+// infinite duration is chosen.
+func (d PaddingDistribution) Sample(rng *rand.Rand) (time.Duration, bool) {
+	keys := make([]float64, 0, len(d))
+	for k := range d {
+		keys = append(keys, k)
+	}
+	sort.Float64s(keys)
+
+	sum, coin := float64(0), rng.Float64()
+	for _, k := range keys {
+		if sum += d[k]; sum >= coin {
+			return time.Duration(k * float64(time.Second)), true
+		}
+	}
+	return 0, false
+}
+
+// PaddingMachine obscures the bursts of tunneled traffic on a connection in
+// the style of WTF-PAD's adaptive padding. Sending a data cell starts a burst
+// during which a dummy cell is sent whenever no cell has been sent for a time
+// sampled from Burst. Once Burst samples infinity the machine waits a time
+// sampled from Gap before starting a fake burst, and goes idle once Gap
+// samples infinity. Data cells are also delayed by a time sampled from Delay
+// so that their timing doesn't reveal the application's.
+//
+// Dummy cells are only sent when the format offers a chance to send, such as
+// from fte.send_async when there is no data, so formats should poll often.
+type PaddingMachine struct {
+	mu    sync.Mutex
+	rng   *rand.Rand
+	state paddingState
+	next  time.Time // time of the next dummy cell, if bursting or in a gap
+
+	// Counts of cells sent, used to cap the overhead.
+	data, dummies int
+
+	Burst PaddingDistribution
+	Gap   PaddingDistribution
+	Delay PaddingDistribution
+
+	// Maximum ratio of dummy cells to data cells. Zero is unlimited.
+	MaxOverhead float64
+}
+
+// paddingState is the state of a PaddingMachine.
+type paddingState int
+
+const (
+	paddingIdle paddingState = iota
+	paddingBurst
+	paddingGap
+)
+
+// NewPaddingMachine returns a new instance of PaddingMachine using seed for
+// its samples.
+func NewPaddingMachine(burst, gap, delay PaddingDistribution, seed int64) *PaddingMachine {
+	return &PaddingMachine{
+		rng:   rand.New(rand.NewSource(seed)),
+		Burst: burst,
+		Gap:   gap,
+		Delay: delay,
+	}
+}
+
+// Pad returns true if a dummy cell should be sent at time t.
+func (m *PaddingMachine) Pad(t time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.state == paddingIdle || t.Before(m.next) {
+		return false
+	} else if m.MaxOverhead > 0 && float64(m.dummies+1) > m.MaxOverhead*float64(m.data) {
+		m.state = paddingIdle
+		return false
+	}
+	return true
+}
+
+// DataDelay returns the time to wait before sending a data cell.
+func (m *PaddingMachine) DataDelay() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	d, _ := m.Delay.Sample(m.rng)
+	return d
+}
+
+// Sent updates the machine once a cell is sent at time t. Data cells start a
+// new burst and dummy cells continue the current one.
+func (m *PaddingMachine) Sent(t time.Time, dummy bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if dummy {
+		m.dummies++
+	} else {
+		m.data++
+		m.state = paddingBurst
+	}
+
+	// A dummy cell sent in a gap starts a fake burst.
+	if m.state == paddingGap {
+		m.state = paddingBurst
+	}
+	if m.state != paddingBurst {
+		return
+	}
+
+	if d, ok := m.Burst.Sample(m.rng); ok {
+		m.next = t.Add(d)
+	} else if d, ok := m.Gap.Sample(m.rng); ok {
+		m.state, m.next = paddingGap, t.Add(d)
+	} else {
+		m.state = paddingIdle
+	}
+}
@@ -0,0 +1,72 @@
+package marionette_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestPaddingMachine(t *testing.T) {
+	// Ensure dummy cells are sent once a burst goes quiet.
+	t.Run("Burst", func(t *testing.T) {
+		m := marionette.NewPaddingMachine(marionette.PaddingDistribution{0.1: 1}, nil, nil, 0)
+		t0 := time.Now()
+		if m.Pad(t0) {
+			t.Fatal("expected no padding while idle")
+		}
+
+		m.Sent(t0, false)
+		if m.Pad(t0.Add(50 * time.Millisecond)) {
+			t.Fatal("expected no padding within burst interval")
+		} else if !m.Pad(t0.Add(100 * time.Millisecond)) {
+			t.Fatal("expected padding after burst interval")
+		}
+	})
+
+	// Ensure the machine waits for a gap and goes idle once both
+	// distributions are infinite.
+	t.Run("Gap", func(t *testing.T) {
+		m := marionette.NewPaddingMachine(nil, marionette.PaddingDistribution{1: 0}, nil, 0)
+		t0 := time.Now()
+		m.Sent(t0, false)
+		if m.Pad(t0.Add(time.Hour)) {
+			t.Fatal("expected machine to be idle")
+		}
+
+		m = marionette.NewPaddingMachine(nil, marionette.PaddingDistribution{1: 1}, nil, 0)
+		m.Sent(t0, false)
+		if m.Pad(t0.Add(500 * time.Millisecond)) {
+			t.Fatal("expected no padding within gap")
+		} else if !m.Pad(t0.Add(time.Second)) {
+			t.Fatal("expected padding after gap")
+		}
+	})
+
+	// Ensure dummy cells are capped relative to data cells.
+	t.Run("MaxOverhead", func(t *testing.T) {
+		m := marionette.NewPaddingMachine(marionette.PaddingDistribution{0.001: 1}, nil, nil, 0)
+		m.MaxOverhead = 2
+		t0 := time.Now()
+		m.Sent(t0, false)
+
+		var n int
+		for i := 1; i < 10; i++ {
+			tt := t0.Add(time.Duration(i) * time.Second)
+			if m.Pad(tt) {
+				m.Sent(tt, true)
+				n++
+			}
+		}
+		if n != 2 {
+			t.Fatalf("unexpected dummy cells: %d", n)
+		}
+	})
+
+	t.Run("DataDelay", func(t *testing.T) {
+		m := marionette.NewPaddingMachine(nil, nil, marionette.PaddingDistribution{0.25: 1}, 0)
+		if d := m.DataDelay(); d != 250*time.Millisecond {
+			t.Fatalf("unexpected delay: %s", d)
+		}
+	})
+}
@@ -0,0 +1,329 @@
+package marionette
+
+import (
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Pcap file constants. Packets are written as raw IPv4 or IPv6 packets.
+const (
+	pcapMagic       = 0xa1b2c3d4 // microsecond timestamps
+	pcapSnapLen     = 262144
+	pcapLinkTypeRaw = 101
+)
+
+// maxCapturePayload is the maximum payload of a synthesized packet. Larger
+// reads & writes are split into several packets.
+const maxCapturePayload = 65535 - 60
+
+// TCP flags set on synthesized segments.
+const (
+	tcpFIN = 0x01
+	tcpSYN = 0x02
+	tcpPSH = 0x08
+	tcpACK = 0x10
+)
+
+// PcapWriter writes the traffic of captured connections to a pcap file with
+// synthesized TCP/IP or UDP/IP headers so that it can be inspected with tools
+// such as Wireshark. It is safe for concurrent use.
+type PcapWriter struct {
+	mu  sync.Mutex
+	w   io.Writer
+	id  uint16 // IPv4 identification of the next packet
+	err error
+}
+
+// NewPcapWriter writes the pcap file header to w and returns a PcapWriter
+// which writes packets to it.
+func NewPcapWriter(w io.Writer) (*PcapWriter, error) {
+	hdr := make([]byte, 24)
+	binary.LittleEndian.PutUint32(hdr[0:], pcapMagic)
+	binary.LittleEndian.PutUint16(hdr[4:], 2) // version 2.4
+	binary.LittleEndian.PutUint16(hdr[6:], 4)
+	binary.LittleEndian.PutUint32(hdr[16:], pcapSnapLen)
+	binary.LittleEndian.PutUint32(hdr[20:], pcapLinkTypeRaw)
+	if _, err := w.Write(hdr); err != nil {
+		return nil, err
+	}
+	return &PcapWriter{w: w}, nil
+}
+
+// Err returns the error which stopped the capture, if any.
+func (w *PcapWriter) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// writePacket writes an IP packet carrying a transport header & payload.
+// Writes are dropped once an error occurs.
+func (w *PcapWriter) writePacket(t time.Time, src, dst net.IP, proto byte, transport, payload []byte) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.err != nil {
+		return
+	}
+
+	pkt := make([]byte, 16, 16+60+len(transport)+len(payload))
+	if src4, dst4 := src.To4(), dst.To4(); src4 != nil && dst4 != nil {
+		pkt = append(pkt, ipv4Header(src4, dst4, proto, w.id, len(transport)+len(payload))...)
+		w.id++
+	} else {
+		pkt = append(pkt, ipv6Header(src.To16(), dst.To16(), proto, len(transport)+len(payload))...)
+	}
+	pkt = append(pkt, transport...)
+	pkt = append(pkt, payload...)
+
+	// Fill in the record header.
+	binary.LittleEndian.PutUint32(pkt[0:], uint32(t.Unix()))
+	binary.LittleEndian.PutUint32(pkt[4:], uint32(t.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(pkt[8:], uint32(len(pkt)-16))
+	binary.LittleEndian.PutUint32(pkt[12:], uint32(len(pkt)-16))
+
+	if _, err := w.w.Write(pkt); err != nil {
+		Logger.Error("cannot write packet capture, capture stopped", zap.Error(err))
+		w.err = err
+	}
+}
+
+// CaptureConn returns conn with the bytes it reads & writes recorded to w as
+// packets between its local & remote addresses. A TCP handshake is recorded
+// with party as the side which opened the connection. Returns conn if w is nil.
+func CaptureConn(conn net.Conn, w *PcapWriter, party string) net.Conn {
+	if w == nil {
+		return conn
+	}
+
+	c := &captureConn{Conn: conn, w: w}
+	c.local, c.localPort = captureAddr(conn.LocalAddr(), 1)
+	c.remote, c.remotePort = captureAddr(conn.RemoteAddr(), 2)
+	if _, ok := conn.LocalAddr().(*net.UDPAddr); ok {
+		c.proto = 17
+		return c
+	}
+
+	// Record the handshake from the client's side.
+	c.proto = 6
+	c.localSeq, c.remoteSeq = rand.Uint32(), rand.Uint32()
+	now := time.Now()
+	if party == PartyClient {
+		c.send(now, tcpSYN, nil)
+		c.recv(now, tcpSYN|tcpACK, nil)
+		c.send(now, tcpACK, nil)
+	} else {
+		c.recv(now, tcpSYN, nil)
+		c.send(now, tcpSYN|tcpACK, nil)
+		c.recv(now, tcpACK, nil)
+	}
+	return c
+}
+
+// captureConn records the traffic of a connection to a PcapWriter.
+type captureConn struct {
+	net.Conn
+	w     *PcapWriter
+	proto byte // 6 for TCP, 17 for UDP
+
+	mu                    sync.Mutex
+	local, remote         net.IP
+	localPort, remotePort uint16
+	localSeq, remoteSeq   uint32 // next TCP sequence numbers
+	localFin, remoteFin   bool   // true once a FIN has been recorded
+}
+
+func (c *captureConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for b := p[:n]; len(b) > 0; {
+		m := len(b)
+		if m > maxCapturePayload {
+			m = maxCapturePayload
+		}
+		c.recv(now, tcpPSH|tcpACK, b[:m])
+		b = b[m:]
+	}
+	if err == io.EOF && c.proto == 6 && !c.remoteFin {
+		c.recv(now, tcpFIN|tcpACK, nil)
+		c.remoteFin = true
+	}
+	return n, err
+}
+
+func (c *captureConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for b := p[:n]; len(b) > 0; {
+		m := len(b)
+		if m > maxCapturePayload {
+			m = maxCapturePayload
+		}
+		c.send(now, tcpPSH|tcpACK, b[:m])
+		b = b[m:]
+	}
+	return n, err
+}
+
+// CloseWrite half-closes the underlying connection, if supported.
+func (c *captureConn) CloseWrite() error {
+	err := closeWrite(c.Conn)
+	c.recordFin()
+	return err
+}
+
+func (c *captureConn) Close() error {
+	err := c.Conn.Close()
+	c.recordFin()
+	return err
+}
+
+// recordFin records a FIN from the local side, if not already recorded.
+func (c *captureConn) recordFin() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.proto == 6 && !c.localFin {
+		c.send(time.Now(), tcpFIN|tcpACK, nil)
+		c.localFin = true
+	}
+}
+
+// send records a packet from the local to the remote side. Must hold c.mu
+// once the connection is shared.
+func (c *captureConn) send(t time.Time, flags byte, payload []byte) {
+	if c.proto == 17 {
+		c.w.writePacket(t, c.local, c.remote, c.proto, udpHeader(c.local, c.remote, c.localPort, c.remotePort, payload), payload)
+		return
+	}
+	c.w.writePacket(t, c.local, c.remote, c.proto, tcpHeader(c.local, c.remote, c.localPort, c.remotePort, c.localSeq, c.remoteSeq, flags, payload), payload)
+	c.localSeq += tcpSeqLen(flags, payload)
+}
+
+// recv records a packet from the remote to the local side. Must hold c.mu
+// once the connection is shared.
+func (c *captureConn) recv(t time.Time, flags byte, payload []byte) {
+	if c.proto == 17 {
+		c.w.writePacket(t, c.remote, c.local, c.proto, udpHeader(c.remote, c.local, c.remotePort, c.localPort, payload), payload)
+		return
+	}
+	c.w.writePacket(t, c.remote, c.local, c.proto, tcpHeader(c.remote, c.local, c.remotePort, c.localPort, c.remoteSeq, c.localSeq, flags, payload), payload)
+	c.remoteSeq += tcpSeqLen(flags, payload)
+}
+
+// captureAddr returns the IP & port of addr. Addresses without an IP, such
+// as those of pipes & unix sockets, are recorded as 127.0.0.<n>.
+func captureAddr(addr net.Addr, n byte) (net.IP, uint16) {
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		return addr.IP, uint16(addr.Port)
+	case *net.UDPAddr:
+		return addr.IP, uint16(addr.Port)
+	}
+	return net.IPv4(127, 0, 0, n), 0
+}
+
+// tcpSeqLen returns the sequence space consumed by a TCP segment.
+func tcpSeqLen(flags byte, payload []byte) uint32 {
+	n := uint32(len(payload))
+	if flags&(tcpSYN|tcpFIN) != 0 {
+		n++
+	}
+	return n
+}
+
+func ipv4Header(src, dst net.IP, proto byte, id uint16, n int) []byte {
+	hdr := make([]byte, 20)
+	hdr[0] = 0x45 // version 4, 20 byte header
+	binary.BigEndian.PutUint16(hdr[2:], uint16(20+n))
+	binary.BigEndian.PutUint16(hdr[4:], id)
+	binary.BigEndian.PutUint16(hdr[6:], 0x4000) // don't fragment
+	hdr[8] = 64                                 // ttl
+	hdr[9] = proto
+	copy(hdr[12:], src)
+	copy(hdr[16:], dst)
+	binary.BigEndian.PutUint16(hdr[10:], ^checksum(0, hdr))
+	return hdr
+}
+
+func ipv6Header(src, dst net.IP, proto byte, n int) []byte {
+	hdr := make([]byte, 40)
+	hdr[0] = 0x60 // version 6
+	binary.BigEndian.PutUint16(hdr[4:], uint16(n))
+	hdr[6] = proto
+	hdr[7] = 64 // hop limit
+	copy(hdr[8:], src)
+	copy(hdr[24:], dst)
+	return hdr
+}
+
+func tcpHeader(src, dst net.IP, srcPort, dstPort uint16, seq, ack uint32, flags byte, payload []byte) []byte {
+	hdr := make([]byte, 20)
+	binary.BigEndian.PutUint16(hdr[0:], srcPort)
+	binary.BigEndian.PutUint16(hdr[2:], dstPort)
+	binary.BigEndian.PutUint32(hdr[4:], seq)
+	if flags&tcpACK != 0 {
+		binary.BigEndian.PutUint32(hdr[8:], ack)
+	}
+	hdr[12] = 5 << 4 // 20 byte header
+	hdr[13] = flags
+	binary.BigEndian.PutUint16(hdr[14:], 65535) // window
+	binary.BigEndian.PutUint16(hdr[16:], transportChecksum(src, dst, 6, hdr, payload))
+	return hdr
+}
+
+func udpHeader(src, dst net.IP, srcPort, dstPort uint16, payload []byte) []byte {
+	hdr := make([]byte, 8)
+	binary.BigEndian.PutUint16(hdr[0:], srcPort)
+	binary.BigEndian.PutUint16(hdr[2:], dstPort)
+	binary.BigEndian.PutUint16(hdr[4:], uint16(8+len(payload)))
+	sum := transportChecksum(src, dst, 17, hdr, payload)
+	if sum == 0 {
+		sum = 0xffff
+	}
+	binary.BigEndian.PutUint16(hdr[6:], sum)
+	return hdr
+}
+
+// transportChecksum returns the TCP or UDP checksum of a segment, including
+// the IPv4 or IPv6 pseudo-header.
+func transportChecksum(src, dst net.IP, proto byte, hdr, payload []byte) uint16 {
+	n := len(hdr) + len(payload)
+	var pseudo []byte
+	if src4, dst4 := src.To4(), dst.To4(); src4 != nil && dst4 != nil {
+		pseudo = append(append(pseudo, src4...), dst4...)
+		pseudo = append(pseudo, 0, proto, byte(n>>8), byte(n))
+	} else {
+		pseudo = append(append(pseudo, src.To16()...), dst.To16()...)
+		pseudo = append(pseudo, 0, 0, byte(n>>8), byte(n), 0, 0, 0, proto)
+	}
+
+	// Headers have an even length so the payload continues the same words.
+	sum := checksum(checksum(0, pseudo), hdr)
+	return ^checksum(sum, payload)
+}
+
+// checksum adds b to the ones' complement sum of 16-bit words, folded to 16 bits.
+func checksum(sum uint16, b []byte) uint16 {
+	s := uint32(sum)
+	for i := 0; i+1 < len(b); i += 2 {
+		s += uint32(b[i])<<8 | uint32(b[i+1])
+	}
+	if len(b)%2 != 0 {
+		s += uint32(b[len(b)-1]) << 8
+	}
+	for s > 0xffff {
+		s = s>>16 + s&0xffff
+	}
+	return uint16(s)
+}
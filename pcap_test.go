@@ -0,0 +1,124 @@
+package marionette_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+// Ensure a captured connection's handshake, data & close are written as TCP
+// segments between its addresses.
+func TestCaptureConn(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := marionette.NewPcapWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverConn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverConn.Close()
+
+	conn = marionette.CaptureConn(conn, w, marionette.PartyClient)
+	mustWrite(t, conn, []byte("foo"))
+	mustRead(t, serverConn, []byte("foo"))
+	mustWrite(t, serverConn, []byte("barbaz"))
+	mustRead(t, conn, []byte("barbaz"))
+	conn.Close()
+
+	if err := w.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	hdr := buf.Next(24)
+	if magic := binary.LittleEndian.Uint32(hdr); magic != 0xa1b2c3d4 {
+		t.Fatalf("unexpected magic: %x", magic)
+	} else if linkType := binary.LittleEndian.Uint32(hdr[20:]); linkType != 101 {
+		t.Fatalf("unexpected link type: %d", linkType)
+	}
+
+	type segment struct {
+		srcPort, dstPort uint16
+		seq              uint32
+		flags            byte
+		payload          string
+	}
+	var segments []segment
+	for buf.Len() > 0 {
+		n := binary.LittleEndian.Uint32(buf.Next(16)[8:])
+		pkt := buf.Next(int(n))
+		if pkt[0] != 0x45 || pkt[9] != 6 {
+			t.Fatalf("unexpected ip header: %x", pkt[:20])
+		} else if sum := onesComplementSum(pkt[:20]); sum != 0xffff {
+			t.Fatalf("invalid ip checksum: %x", sum)
+		}
+		tcp := pkt[20:]
+		segments = append(segments, segment{
+			srcPort: binary.BigEndian.Uint16(tcp[0:]),
+			dstPort: binary.BigEndian.Uint16(tcp[2:]),
+			seq:     binary.BigEndian.Uint32(tcp[4:]),
+			flags:   tcp[13],
+			payload: string(tcp[20:]),
+		})
+	}
+
+	clientPort := uint16(conn.LocalAddr().(*net.TCPAddr).Port)
+	serverPort := uint16(ln.Addr().(*net.TCPAddr).Port)
+	exp := []struct {
+		fromClient bool
+		flags      byte
+		payload    string
+	}{
+		{true, 0x02, ""},        // SYN
+		{false, 0x12, ""},       // SYN, ACK
+		{true, 0x10, ""},        // ACK
+		{true, 0x18, "foo"},     // PSH, ACK
+		{false, 0x18, "barbaz"}, // PSH, ACK
+		{true, 0x11, ""},        // FIN, ACK
+	}
+	if len(segments) != len(exp) {
+		t.Fatalf("unexpected segment count: %d", len(segments))
+	}
+	for i, e := range exp {
+		seg := segments[i]
+		if e.fromClient != (seg.srcPort == clientPort && seg.dstPort == serverPort) {
+			t.Fatalf("%d. unexpected ports: %d -> %d", i, seg.srcPort, seg.dstPort)
+		} else if seg.flags != e.flags {
+			t.Fatalf("%d. unexpected flags: %x", i, seg.flags)
+		} else if seg.payload != e.payload {
+			t.Fatalf("%d. unexpected payload: %q", i, seg.payload)
+		}
+	}
+
+	// The client's sequence numbers advance by the SYN & the data.
+	if seq := segments[0].seq; segments[3].seq != seq+1 || segments[5].seq != seq+4 {
+		t.Fatalf("unexpected sequence numbers: %d, %d, %d", seq, segments[3].seq, segments[5].seq)
+	}
+}
+
+// onesComplementSum returns the folded ones' complement sum of b's 16-bit words.
+func onesComplementSum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(b[i:]))
+	}
+	for sum > 0xffff {
+		sum = sum>>16 + sum&0xffff
+	}
+	return uint16(sum)
+}
@@ -0,0 +1,92 @@
+package marionette
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/redjack/marionette/mar"
+)
+
+// Pipe returns a dialer & listener connected by in-memory connections
+// instead of sockets. This allows applications to embed both parties and
+// tests to run the full protocol stack within a single process. Each
+// connection the dialer makes is accepted by the listener as one end of a
+// net.Pipe(). Formats must not depend on the network, such as by opening
+// additional ports with model.spawn or by using UDP.
+//
+// Options are applied to both the dialer & the listener. The dialer has not
+// been opened and may be configured before Open() is called. The listener is
+// serving and must be closed by the caller.
+func Pipe(clientDoc, serverDoc *mar.Document, opts ...Option) (*Dialer, *Listener) {
+	ln := newPipeListener()
+	l := newListener(ln, serverDoc, "", newOptions(opts))
+	l.start()
+
+	d := NewDialer(clientDoc, pipeNetwork, NewStreamSet(), opts...)
+	d.DialFunc = ln.dial
+	return d, l
+}
+
+// pipeNetwork is the network name reported by in-memory addresses.
+const pipeNetwork = "pipe"
+
+// pipeListener implements net.Listener for in-memory connections.
+type pipeListener struct {
+	conns   chan net.Conn
+	closing chan struct{}
+	once    sync.Once
+}
+
+func newPipeListener() *pipeListener {
+	return &pipeListener{
+		conns:   make(chan net.Conn),
+		closing: make(chan struct{}),
+	}
+}
+
+// Accept waits for the server end of the next dialed connection.
+func (ln *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case <-ln.closing:
+		return nil, ErrListenerClosed
+	case conn := <-ln.conns:
+		return conn, nil
+	}
+}
+
+// Close stops accepting connections. Connections already made are unaffected.
+func (ln *pipeListener) Close() error {
+	ln.once.Do(func() { close(ln.closing) })
+	return nil
+}
+
+// Addr returns an in-memory address.
+func (ln *pipeListener) Addr() net.Addr { return pipeAddr{} }
+
+// dial returns the client end of a new pipe once the listener accepts the
+// server end. The network & address are ignored.
+func (ln *pipeListener) dial(ctx context.Context, network, address string) (net.Conn, error) {
+	client, server := net.Pipe()
+	select {
+	case <-ctx.Done():
+	case <-ln.closing:
+	case ln.conns <- server:
+		return client, nil
+	}
+	client.Close()
+	server.Close()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		return nil, ErrListenerClosed
+	}
+}
+
+// pipeAddr is the address of an in-memory connection.
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string { return pipeNetwork }
+func (pipeAddr) String() string  { return pipeNetwork }
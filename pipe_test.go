@@ -0,0 +1,113 @@
+package marionette_test
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+func init() {
+	marionette.RegisterPlugin("pipetest", "send", pipeTestSend)
+	marionette.RegisterPlugin("pipetest", "recv", pipeTestRecv)
+}
+
+// Ensure a dialer & listener connected by Pipe() carry streams in both
+// directions without sockets.
+func TestPipe(t *testing.T) {
+	dialer, ln := marionette.Pipe(
+		mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc)),
+		mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)),
+	)
+	defer ln.Close()
+
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientStream.Close()
+	mustWrite(t, clientStream, []byte("foo"))
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverStream.Close()
+	mustRead(t, serverStream, []byte("foo"))
+
+	mustWrite(t, serverStream, []byte("bar"))
+	mustRead(t, clientStream, []byte("bar"))
+}
+
+// pipeTestDoc alternates sending unencoded cells between the parties.
+const pipeTestDoc = `
+connection(tcp, 8080):
+  start      upstream   NULL 1.0
+  upstream   downstream up   1.0
+  downstream upstream   down 1.0
+
+action up:
+  client pipetest.send()
+  server pipetest.recv()
+
+action down:
+  server pipetest.send()
+  client pipetest.recv()
+`
+
+// pipeTestSend writes the next cell, or an empty cell, without encoding.
+func pipeTestSend(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	cell := fsm.StreamSet().Dequeue(1024)
+	if cell == nil {
+		cell = marionette.NewCell(0, 0, 0, marionette.NORMAL)
+	}
+	cell.UUID, cell.InstanceID = fsm.UUID(), fsm.InstanceID()
+
+	buf, err := cell.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = fsm.Conn().Write(buf)
+	return err
+}
+
+// pipeTestRecv reads a cell written by pipeTestSend.
+func pipeTestRecv(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	conn := fsm.Conn()
+	hdr, err := conn.Peek(4, true)
+	if err != nil {
+		return err
+	}
+	n := int(binary.BigEndian.Uint32(hdr))
+	buf, err := conn.Peek(n, true)
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	} else if err != nil {
+		return err
+	}
+
+	var cell marionette.Cell
+	if err := cell.UnmarshalBinary(buf); err != nil {
+		return err
+	}
+
+	// Adopt the instance ID of the first cell and restart the transition.
+	if fsm.InstanceID() == 0 {
+		fsm.SetInstanceID(cell.InstanceID)
+		return marionette.ErrRetryTransition
+	}
+
+	if err := fsm.StreamSet().Enqueue(&cell); err != nil {
+		return err
+	}
+	_, err = conn.Seek(int64(n), io.SeekCurrent)
+	return err
+}
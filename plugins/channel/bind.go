@@ -10,10 +10,22 @@ import (
 )
 
 func init() {
-	marionette.RegisterPlugin("channel", "bind", Bind)
+	marionette.RegisterPlugin("channel", "bind", Bind, marionette.PluginInfo{
+		Description: "Binds a port and stores it in a variable.",
+		Args: []marionette.PluginArg{
+			{Name: "name", Type: "string"},
+			{Name: "network", Type: "string", Optional: true},
+			{Name: "min_port", Type: "int", Optional: true},
+			{Name: "max_port", Type: "int", Optional: true},
+		},
+	})
 }
 
 // Bind binds the variable specified in the first argument to a port.
+//
+// An optional second argument specifies the network ("tcp" or "udp") and
+// optional third & fourth arguments restrict the port to a range. The bound
+// port can be shared with the peer by referencing the variable in a template.
 func Bind(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 	t0 := time.Now()
 
@@ -32,6 +44,24 @@ func Bind(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 		return errors.New("invalid argument type")
 	}
 
+	network := "tcp"
+	if len(args) > 1 {
+		if network, ok = args[1].(string); !ok {
+			return errors.New("invalid network argument type")
+		}
+	}
+
+	var minPort, maxPort int
+	if len(args) > 2 {
+		if len(args) < 4 {
+			return errors.New("port range requires min and max ports")
+		} else if minPort, ok = args[2].(int); !ok {
+			return errors.New("invalid min port argument type")
+		} else if maxPort, ok = args[3].(int); !ok {
+			return errors.New("invalid max port argument type")
+		}
+	}
+
 	// Ignore if variable is already bound.
 	if value := fsm.Var(name); value != nil {
 		if i, _ := value.(int); i > 0 {
@@ -40,8 +70,8 @@ func Bind(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 		}
 	}
 
-	// Create a new connection on a random port.
-	port, err := fsm.Listen()
+	// Create a new connection on a random port or within the port range.
+	port, err := fsm.Listen(network, minPort, maxPort)
 	if err != nil {
 		logger.Error("cannot open listener", zap.Error(err))
 		return err
@@ -50,7 +80,7 @@ func Bind(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 	// Save port number to variables.
 	fsm.SetVar(name, port)
 
-	logger.Debug("channel bound", zap.Int("port", port), zap.Duration("t", time.Since(t0)))
+	logger.Debug("channel bound", zap.String("network", network), zap.Int("port", port), zap.Duration("t", time.Since(t0)))
 
 	return nil
 }
@@ -3,6 +3,8 @@ package channel_test
 import (
 	"context"
 	"errors"
+	"flag"
+	"os"
 	"testing"
 
 	"github.com/redjack/marionette"
@@ -11,10 +13,12 @@ import (
 	"go.uber.org/zap"
 )
 
-func init() {
+func TestMain(m *testing.M) {
+	flag.Parse()
 	if !testing.Verbose() {
 		marionette.Logger = zap.NewNop()
 	}
+	os.Exit(m.Run())
 }
 
 func TestBind(t *testing.T) {
@@ -23,7 +27,12 @@ func TestBind(t *testing.T) {
 		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
 		fsm.PartyFn = func() string { return marionette.PartyClient }
 		fsm.VarFn = func(name string) interface{} { return nil }
-		fsm.ListenFn = func() (int, error) { return 54321, nil }
+		fsm.ListenFn = func(network string, minPort, maxPort int) (int, error) {
+			if network != "tcp" || minPort != 0 || maxPort != 0 {
+				t.Fatalf("unexpected listen: %s %d-%d", network, minPort, maxPort)
+			}
+			return 54321, nil
+		}
 
 		var setVarInvoked bool
 		fsm.SetVarFn = func(name string, value interface{}) {
@@ -37,6 +46,40 @@ func TestBind(t *testing.T) {
 
 		if err := channel.Bind(context.Background(), &fsm, "ftp_pasv_port"); err != nil {
 			t.Fatal(err)
+		} else if !setVarInvoked {
+			t.Fatal("expected SetVar()")
+		}
+	})
+
+	// Ensure network and port range arguments are passed to the listener.
+	t.Run("PortRange", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+		fsm.VarFn = func(name string) interface{} { return nil }
+		fsm.ListenFn = func(network string, minPort, maxPort int) (int, error) {
+			if network != "udp" || minPort != 50000 || maxPort != 50100 {
+				t.Fatalf("unexpected listen: %s %d-%d", network, minPort, maxPort)
+			}
+			return 50010, nil
+		}
+
+		var value interface{}
+		fsm.SetVarFn = func(name string, v interface{}) { value = v }
+
+		if err := channel.Bind(context.Background(), &fsm, "data_port", "udp", 50000, 50100); err != nil {
+			t.Fatal(err)
+		} else if value != 50010 {
+			t.Fatalf("unexpected value: %v", value)
+		}
+	})
+
+	t.Run("ErrPortRange", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+		if err := channel.Bind(context.Background(), &fsm, "data_port", "tcp", 50000); err == nil || err.Error() != `port range requires min and max ports` {
+			t.Fatalf("unexpected error: %q", err)
 		}
 	})
 
@@ -77,7 +120,7 @@ func TestBind(t *testing.T) {
 		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
 		fsm.PartyFn = func() string { return marionette.PartyClient }
 		fsm.VarFn = func(name string) interface{} { return nil }
-		fsm.ListenFn = func() (int, error) { return 0, errMarker }
+		fsm.ListenFn = func(network string, minPort, maxPort int) (int, error) { return 0, errMarker }
 
 		if err := channel.Bind(context.Background(), &fsm, "ftp_pasv_port"); err != errMarker {
 			t.Fatal(err)
@@ -0,0 +1,184 @@
+package cover
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+func init() {
+	marionette.RegisterPlugin("cover", "send", Send, marionette.PluginInfo{
+		Description: "Sends protocol chatter that carries no data.",
+		Args: []marionette.PluginArg{
+			{Name: "chatter", Type: "string"},
+		},
+	})
+	marionette.RegisterPlugin("cover", "recv", Recv, marionette.PluginInfo{
+		Description: "Receives protocol chatter that carries no data.",
+		Args: []marionette.PluginArg{
+			{Name: "chatter", Type: "string"},
+		},
+	})
+}
+
+// Chatter represents a named set of protocol messages that carry no data.
+// One message is chosen at random each time the chatter is sent.
+type Chatter struct {
+	Name     string
+	Messages []string
+}
+
+var chatters = make(map[string]*Chatter)
+
+// RegisterChatter adds chatter to the registry.
+func RegisterChatter(chatter *Chatter) {
+	chatters[chatter.Name] = chatter
+}
+
+func init() {
+	RegisterChatter(&Chatter{
+		Name: "http_favicon_request",
+		Messages: []string{
+			"GET /favicon.ico HTTP/1.1\r\nUser-Agent: marionette 0.1\r\nConnection: keep-alive\r\n\r\n",
+		},
+	})
+
+	RegisterChatter(&Chatter{
+		Name: "http_favicon_response",
+		Messages: []string{
+			"HTTP/1.1 304 Not Modified\r\nConnection: keep-alive\r\n\r\n",
+			"HTTP/1.1 404 Not Found\r\nContent-Length: 0\r\nConnection: keep-alive\r\n\r\n",
+		},
+	})
+
+	RegisterChatter(&Chatter{
+		Name:     "ftp_noop",
+		Messages: []string{"NOOP\r\n"},
+	})
+
+	RegisterChatter(&Chatter{
+		Name:     "ftp_noop_ok",
+		Messages: []string{"200 NOOP ok.\r\n", "200 Zzz...\r\n"},
+	})
+
+	RegisterChatter(&Chatter{
+		Name:     "pop3_noop",
+		Messages: []string{"NOOP\r\n"},
+	})
+
+	RegisterChatter(&Chatter{
+		Name:     "pop3_noop_ok",
+		Messages: []string{"+OK\r\n"},
+	})
+
+	RegisterChatter(&Chatter{
+		Name:     "smtp_noop",
+		Messages: []string{"NOOP\r\n"},
+	})
+
+	RegisterChatter(&Chatter{
+		Name:     "smtp_noop_ok",
+		Messages: []string{"250 2.0.0 Ok\r\n"},
+	})
+}
+
+// Send writes a randomly chosen message from the named chatter to the connection.
+// No stream data is carried so idle channels continue to produce traffic.
+func Send(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "cover.send"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 1 {
+		return errors.New("not enough arguments")
+	}
+
+	name, ok := args[0].(string)
+	if !ok {
+		return errors.New("invalid chatter name argument type")
+	}
+
+	chatter := chatters[name]
+	if chatter == nil {
+		logger.Error("chatter not found", zap.String("name", name))
+		return fmt.Errorf("chatter not found: %q", name)
+	}
+
+	msg := chatter.Messages[rand.Intn(len(chatter.Messages))]
+	if _, err := fsm.Conn().Write([]byte(msg)); err != nil {
+		logger.Error("cannot write to connection", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("chatter sent", zap.String("name", name), zap.Int("n", len(msg)), zap.Duration("t", time.Since(t0)))
+	return nil
+}
+
+// Recv reads any message from the named chatter off the connection.
+// Returns ErrRetryTransition if only a partial message is available.
+func Recv(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "cover.recv"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 1 {
+		return errors.New("not enough arguments")
+	}
+
+	name, ok := args[0].(string)
+	if !ok {
+		return errors.New("invalid chatter name argument type")
+	}
+
+	chatter := chatters[name]
+	if chatter == nil {
+		logger.Error("chatter not found", zap.String("name", name))
+		return fmt.Errorf("chatter not found: %q", name)
+	}
+
+	buf, err := fsm.Conn().Peek(-1, true)
+	if err == io.EOF {
+		return err
+	} else if err != nil {
+		logger.Error("cannot read from connection", zap.Error(err))
+		return err
+	}
+
+	// Find the message at the beginning of the buffer.
+	var partial bool
+	for _, msg := range chatter.Messages {
+		if bytes.HasPrefix(buf, []byte(msg)) {
+			if _, err := fsm.Conn().Seek(int64(len(msg)), io.SeekCurrent); err != nil {
+				logger.Error("cannot move buffer forward", zap.Error(err))
+				return err
+			}
+			logger.Debug("chatter received", zap.String("name", name), zap.Int("n", len(msg)), zap.Duration("t", time.Since(t0)))
+			return nil
+		} else if bytes.HasPrefix([]byte(msg), buf) {
+			partial = true
+		}
+	}
+
+	// Wait for more data if the buffer could still become a message.
+	if partial {
+		return marionette.ErrRetryTransition
+	}
+
+	logger.Error("unexpected read", zap.String("data", string(buf)))
+	return fmt.Errorf("unexpected data: %q", buf)
+}
@@ -0,0 +1,131 @@
+package cover_test
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/cover"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		marionette.Logger = zap.NewNop()
+	}
+	os.Exit(m.Run())
+}
+
+func TestSend(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		conn.WriteFn = func(p []byte) (int, error) {
+			if string(p) != "NOOP\r\n" {
+				t.Fatalf("unexpected write: %q", p)
+			}
+			return len(p), nil
+		}
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+
+		if err := cover.Send(context.Background(), &fsm, "ftp_noop"); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		if err := cover.Send(context.Background(), &fsm); err == nil || err.Error() != `not enough arguments` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+
+	t.Run("ErrChatterNotFound", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		if err := cover.Send(context.Background(), &fsm, "no_such_chatter"); err == nil || err.Error() != `chatter not found: "no_such_chatter"` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+
+	// Ensure write errors are passed through.
+	t.Run("ErrWrite", func(t *testing.T) {
+		errMarker := errors.New("marker")
+		conn := mock.DefaultConn()
+		conn.WriteFn = func(p []byte) (int, error) { return 0, errMarker }
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		if err := cover.Send(context.Background(), &fsm, "ftp_noop"); err != errMarker {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+}
+
+func TestRecv(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		var done bool
+		conn := mock.DefaultConn()
+		conn.SetReadDeadlineFn = func(_ time.Time) error { return nil }
+		conn.ReadFn = func(p []byte) (int, error) {
+			if done {
+				<-make(chan struct{})
+			}
+			done = true
+			return copy(p, "+OK\r\nfoo"), nil
+		}
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+
+		if err := cover.Recv(context.Background(), &fsm, "pop3_noop_ok"); err != nil {
+			t.Fatal(err)
+		} else if buf, _ := fsm.Conn().Peek(-1, false); string(buf) != "foo" {
+			t.Fatalf("unexpected remaining buffer: %q", buf)
+		}
+	})
+
+	// Ensure a partial message causes the transition to be retried.
+	t.Run("Partial", func(t *testing.T) {
+		var done bool
+		conn := mock.DefaultConn()
+		conn.ReadFn = func(p []byte) (int, error) {
+			if done {
+				<-make(chan struct{})
+			}
+			done = true
+			return copy(p, "NO"), nil
+		}
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+
+		if err := cover.Recv(context.Background(), &fsm, "ftp_noop"); err != marionette.ErrRetryTransition {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrUnexpectedData", func(t *testing.T) {
+		var done bool
+		conn := mock.DefaultConn()
+		conn.ReadFn = func(p []byte) (int, error) {
+			if done {
+				<-make(chan struct{})
+			}
+			done = true
+			return copy(p, "QUIT\r\n"), nil
+		}
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+
+		if err := cover.Recv(context.Background(), &fsm, "ftp_noop"); err == nil || err.Error() != `unexpected data: "QUIT\r\n"` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
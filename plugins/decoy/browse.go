@@ -0,0 +1,153 @@
+package decoy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/plugins/model"
+	"go.uber.org/zap"
+)
+
+// MaxBrowseBodySize is the maximum number of bytes read from each decoy page.
+var MaxBrowseBodySize int64 = 1 << 20
+
+// UserAgent is sent with decoy browsing requests.
+var UserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:61.0) Gecko/20100101 Firefox/61.0"
+
+func init() {
+	marionette.RegisterPlugin("decoy", "browse", Browse, marionette.PluginInfo{
+		Description: "Fetches random URLs from an allowlist in the background to generate genuine cover traffic.",
+		Args: []marionette.PluginArg{
+			{Name: "urls", Type: "string"},
+			{Name: "n", Type: "int"},
+			{Name: "delay", Type: "distribution", Optional: true},
+		},
+		Parties: []string{marionette.PartyClient},
+	})
+}
+
+// Browse fetches n URLs chosen randomly from a comma-separated allowlist of
+// http or https URLs. Each request uses its own connection, separate from the
+// marionette connection, so the host produces genuine mixed traffic.
+//
+// Fetches occur in the background, waiting between each for a number of
+// seconds chosen from the delay distribution, and stop once ctx is done.
+// Fetch errors are logged but otherwise ignored.
+func Browse(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	logger := marionette.Logger.With(
+		zap.String("plugin", "decoy.browse"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 2 {
+		return errors.New("not enough arguments")
+	}
+
+	s, ok := args[0].(string)
+	if !ok {
+		return errors.New("invalid urls argument type")
+	}
+	urls, err := parseURLs(s)
+	if err != nil {
+		return err
+	}
+
+	n, ok := args[1].(int)
+	if !ok {
+		return errors.New("invalid n argument type")
+	} else if n < 0 {
+		return errors.New("n must not be negative")
+	}
+
+	var delay map[float64]float64
+	if len(args) > 2 {
+		s, ok := args[2].(string)
+		if !ok {
+			return errors.New("invalid delay argument type")
+		}
+		if delay, err = model.ParseSleepDistribution(s); err != nil {
+			return err
+		}
+	}
+
+	go func() {
+		client := &http.Client{
+			Timeout:   Timeout,
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, DisableKeepAlives: true},
+		}
+
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				if d := time.Duration(model.ChooseValue(delay) * float64(time.Second) * model.SleepFactor); d > 0 {
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(d):
+					}
+				}
+			}
+
+			u := urls[rand.Intn(len(urls))]
+			if err := fetch(ctx, client, u); err != nil {
+				logger.Debug("decoy fetch failed", zap.String("url", u), zap.Error(err))
+				continue
+			}
+			logger.Debug("decoy fetched", zap.String("url", u))
+		}
+	}()
+
+	return nil
+}
+
+// fetch requests u and reads its body so the transfer completes as a browser's would.
+func fetch(ctx context.Context, client *http.Client, u string) error {
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, MaxBrowseBodySize))
+	return err
+}
+
+// parseURLs splits a comma-separated list of URLs and validates each one.
+func parseURLs(s string) ([]string, error) {
+	var urls []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		u, err := url.Parse(item)
+		if err != nil {
+			return nil, err
+		} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid decoy url: %q", item)
+		}
+		urls = append(urls, item)
+	}
+
+	if len(urls) == 0 {
+		return nil, errors.New("no decoy urls specified")
+	}
+	return urls, nil
+}
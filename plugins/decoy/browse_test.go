@@ -0,0 +1,80 @@
+package decoy_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette/plugins/decoy"
+)
+
+func TestBrowse(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		hits := make(chan string, 2)
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits <- r.Header.Get("User-Agent")
+			w.Write([]byte("hello"))
+		}))
+		defer s.Close()
+
+		fsm, _ := newFSM("")
+		if err := decoy.Browse(context.Background(), fsm, s.URL+"/a, "+s.URL+"/b", 2, "{'0.01': 1.0}"); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 2; i++ {
+			select {
+			case ua := <-hits:
+				if ua != decoy.UserAgent {
+					t.Fatalf("unexpected user agent: %q", ua)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout")
+			}
+		}
+	})
+
+	// Ensure fetching stops once the context is canceled.
+	t.Run("Cancel", func(t *testing.T) {
+		hits := make(chan struct{}, 10)
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { hits <- struct{}{} }))
+		defer s.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		fsm, _ := newFSM("")
+		if err := decoy.Browse(ctx, fsm, s.URL, 10, "{'10': 1.0}"); err != nil {
+			t.Fatal(err)
+		}
+		<-hits
+		cancel()
+
+		select {
+		case <-hits:
+			t.Fatal("unexpected fetch")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("ErrInvalidURL", func(t *testing.T) {
+		fsm, _ := newFSM("")
+		if err := decoy.Browse(context.Background(), fsm, "ftp://example.com", 1); err == nil || err.Error() != `invalid decoy url: "ftp://example.com"` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrNoURLs", func(t *testing.T) {
+		fsm, _ := newFSM("")
+		if err := decoy.Browse(context.Background(), fsm, " , ", 1); err == nil || err.Error() != `no decoy urls specified` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
+		fsm, _ := newFSM("")
+		if err := decoy.Browse(context.Background(), fsm, "http://example.com"); err == nil || err.Error() != `not enough arguments` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package decoy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+// Timeout is the maximum time allowed to relay a request to the decoy server.
+var Timeout = 10 * time.Second
+
+func init() {
+	marionette.RegisterPlugin("decoy", "http", HTTP, marionette.PluginInfo{
+		Description: "Receives a tg message or relays unrecognized HTTP requests to a decoy web server.",
+		Args: []marionette.PluginArg{
+			{Name: "grammar", Type: "string"},
+			{Name: "addr", Type: "string"},
+		},
+		Parties: []string{marionette.PartyServer},
+	})
+}
+
+// HTTP attempts to receive a message using tg.recv with the given grammar. If
+// the incoming data is a complete HTTP request that cannot be decoded then it
+// is forwarded verbatim to the web server at addr and its response is
+// written back to the connection. This allows the port to serve a
+// believable website to probes while still accepting marionette traffic.
+func HTTP(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	logger := marionette.Logger.With(
+		zap.String("plugin", "decoy.http"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 2 {
+		return errors.New("not enough arguments")
+	}
+
+	grammar, ok := args[0].(string)
+	if !ok {
+		return errors.New("invalid grammar argument type")
+	}
+	addr, ok := args[1].(string)
+	if !ok {
+		return errors.New("invalid addr argument type")
+	}
+
+	recv := marionette.FindPlugin("tg", "recv")
+	if recv == nil {
+		return errors.New("tg.recv plugin not found")
+	}
+
+	// Attempt to receive normally. Only fall back to the decoy if there is a
+	// complete request that could not be decoded.
+	recvErr := recv(ctx, fsm, grammar)
+	if recvErr == nil || recvErr == io.EOF {
+		return recvErr
+	}
+
+	buf, err := fsm.Conn().Peek(-1, false)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	n, req := readRequest(buf)
+	if req == nil {
+		return recvErr
+	}
+	logger.Debug("relaying request to decoy", zap.String("addr", addr), zap.Int("n", n), zap.NamedError("recv_error", recvErr))
+
+	resp, closing, err := relay(addr, buf[:n])
+	if err != nil {
+		logger.Error("cannot relay request", zap.String("addr", addr), zap.Error(err))
+		return err
+	}
+
+	// Move buffer past the request & write the decoy response back.
+	if _, err := fsm.Conn().Seek(int64(n), io.SeekCurrent); err != nil {
+		return err
+	} else if _, err := fsm.Conn().Write(resp); err != nil {
+		return err
+	}
+
+	// Finish if either side requested the connection be closed. Otherwise
+	// wait for the next request in the same state.
+	if closing || req.Close {
+		return io.EOF
+	}
+	return marionette.ErrRetryTransition
+}
+
+// readRequest parses the first HTTP request in buf and returns its length,
+// including any body. Returns a nil request if buf is not a complete request.
+func readRequest(buf []byte) (int, *http.Request) {
+	r := bytes.NewReader(buf)
+	br := bufio.NewReader(r)
+	req, err := http.ReadRequest(br)
+	if err != nil {
+		return 0, nil
+	} else if _, err := io.Copy(ioutil.Discard, req.Body); err != nil {
+		return 0, nil
+	}
+	return len(buf) - r.Len() - br.Buffered(), req
+}
+
+// relay sends req to the server at addr and returns the raw response bytes.
+// Also returns true if the connection should be closed after the response.
+func relay(addr string, req []byte) ([]byte, bool, error) {
+	conn, err := net.DialTimeout("tcp", addr, Timeout)
+	if err != nil {
+		return nil, false, err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(Timeout)); err != nil {
+		return nil, false, err
+	} else if _, err := conn.Write(req); err != nil {
+		return nil, false, err
+	}
+
+	// Parse the response to find its end but return the bytes as received.
+	var raw bytes.Buffer
+	resp, err := http.ReadResponse(bufio.NewReader(io.TeeReader(conn, &raw)), nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(ioutil.Discard, resp.Body); err != nil {
+		return nil, false, err
+	}
+	return raw.Bytes(), resp.Close, nil
+}
@@ -0,0 +1,105 @@
+package decoy_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/decoy"
+	_ "github.com/redjack/marionette/plugins/tg"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		marionette.Logger = zap.NewNop()
+	}
+	os.Exit(m.Run())
+}
+
+func TestHTTP(t *testing.T) {
+	t.Run("Relay", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/index.html" {
+				t.Fatalf("unexpected path: %s", r.URL.Path)
+			}
+			w.Write([]byte("hello"))
+		}))
+		defer s.Close()
+
+		fsm, written := newFSM("GET /index.html HTTP/1.1\r\nHost: example.com\r\n\r\n")
+		if err := decoy.HTTP(context.Background(), fsm, "http_request_keep_alive", strings.TrimPrefix(s.URL, "http://")); err != marionette.ErrRetryTransition {
+			t.Fatalf("unexpected error: %#v", err)
+		} else if resp := written(); !strings.HasPrefix(resp, "HTTP/1.1 200 OK\r\n") || !strings.HasSuffix(resp, "\r\n\r\nhello") {
+			t.Fatalf("unexpected response: %q", resp)
+		} else if buf, _ := fsm.Conn().Peek(-1, false); len(buf) != 0 {
+			t.Fatalf("expected buffer to be consumed: %q", buf)
+		}
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer s.Close()
+
+		fsm, _ := newFSM("GET / HTTP/1.1\r\nHost: example.com\r\nConnection: close\r\n\r\n")
+		if err := decoy.HTTP(context.Background(), fsm, "http_request_keep_alive", strings.TrimPrefix(s.URL, "http://")); err != io.EOF {
+			t.Fatalf("unexpected error: %#v", err)
+		}
+	})
+
+	// Ensure that the tg.recv error is returned if the request is not complete.
+	t.Run("Incomplete", func(t *testing.T) {
+		fsm, _ := newFSM("GET / HTTP/1.1\r\nHost: example.com\r\nContent-Length: 10\r\n\r\nfoo")
+		if err := decoy.HTTP(context.Background(), fsm, "http_request_keep_alive", "127.0.0.1:0"); err != marionette.ErrRetryTransition {
+			t.Fatalf("unexpected error: %#v", err)
+		}
+	})
+
+	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
+		fsm, _ := newFSM("")
+		if err := decoy.HTTP(context.Background(), fsm, "http_request_keep_alive"); err == nil || err.Error() != `not enough arguments` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrInvalidArgument", func(t *testing.T) {
+		fsm, _ := newFSM("")
+		if err := decoy.HTTP(context.Background(), fsm, "http_request_keep_alive", 80); err == nil || err.Error() != `invalid addr argument type` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// newFSM returns a mock FSM which reads data once and returns a function
+// to retrieve all data written to the connection.
+func newFSM(data string) (*mock.FSM, func() string) {
+	var written bytes.Buffer
+	conn := mock.DefaultConn()
+	conn.ReadFn = func(p []byte) (int, error) {
+		if data == "" {
+			<-make(chan struct{})
+		}
+		n := copy(p, data)
+		data = data[n:]
+		return n, nil
+	}
+	conn.WriteFn = func(p []byte) (int, error) { return written.Write(p) }
+
+	fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+	fsm.PartyFn = func() string { return marionette.PartyServer }
+	fsm.HostFn = func() string { return "127.0.0.1" }
+	fsm.UUIDFn = func() int { return 100 }
+	fsm.InstanceIDFn = func() int { return 200 }
+	fsm.DFAFn = func(regex string, n int) (marionette.DFA, error) { return nil, errors.New("cannot rank") }
+	return &fsm, written.String
+}
@@ -0,0 +1,243 @@
+// Package extern forwards actions in the "extern" module to a sidecar process.
+//
+// The sidecar is called over JSON-RPC (net/rpc/jsonrpc) rather than gRPC so
+// that no code generation or new dependencies are required. Any language with
+// a JSON-RPC 1.0 server can implement the "Plugin.Call" method.
+package extern
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+func init() {
+	marionette.RegisterModule("extern", func(method string) marionette.PluginFunc {
+		return func(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+			return Call(ctx, fsm, method, args...)
+		}
+	})
+}
+
+// Addr is the address of the sidecar process that implements extern plugins.
+// Unix sockets are specified with a "unix:" prefix. Otherwise TCP is used.
+var Addr = ""
+
+// DialTimeout is the time allowed to connect to the sidecar.
+var DialTimeout = 5 * time.Second
+
+// CallTimeout is the time allowed for the sidecar to respond to each call.
+var CallTimeout = 10 * time.Second
+
+// ErrNoSidecar is returned when an extern plugin is called without an address.
+var ErrNoSidecar = errors.New("extern sidecar address not set")
+
+// ErrCallTimeout is returned when the sidecar does not respond within CallTimeout.
+var ErrCallTimeout = errors.New("extern sidecar call timed out")
+
+// ServiceMethod is the JSON-RPC method invoked on the sidecar.
+const ServiceMethod = "Plugin.Call"
+
+// Request is sent to the sidecar for each extern action.
+type Request struct {
+	Method  string                 `json:"method"`
+	Party   string                 `json:"party"`
+	State   string                 `json:"state"`
+	Args    []interface{}          `json:"args"`
+	Vars    map[string]interface{} `json:"vars"`
+	Payload []byte                 `json:"payload"`
+}
+
+// Response is returned from the sidecar.
+type Response struct {
+	// Variables to set on the FSM.
+	Vars map[string]interface{} `json:"vars"`
+
+	// Data to write to the connection.
+	Write []byte `json:"write"`
+
+	// Number of bytes of the payload to remove from the read buffer.
+	Consume int `json:"consume"`
+
+	// If true, the transition is retried once more data is available.
+	Retry bool `json:"retry"`
+
+	// If set, the action fails with the error message.
+	Error string `json:"error"`
+}
+
+var client struct {
+	mu   sync.Mutex
+	addr string
+	rpc  *rpc.Client
+}
+
+// Call invokes method on the sidecar and applies the response to the FSM.
+func Call(ctx context.Context, fsm marionette.FSM, method string, args ...interface{}) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "extern."+method),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	// Send any pending incoming data so the sidecar can act as a receiver.
+	payload, err := fsm.Conn().Peek(-1, false)
+	if err != nil && err != io.EOF {
+		logger.Error("cannot read from connection", zap.Error(err))
+		return err
+	}
+
+	req := &Request{
+		Method:  method,
+		Party:   fsm.Party(),
+		State:   fsm.State(),
+		Args:    args,
+		Vars:    marshalVars(fsm.Vars()),
+		Payload: payload,
+	}
+
+	resp, err := call(ctx, req)
+	if err != nil {
+		logger.Error("sidecar call failed", zap.Error(err))
+		return err
+	} else if resp.Error != "" {
+		return fmt.Errorf("extern.%s: %s", method, resp.Error)
+	} else if resp.Retry {
+		return marionette.ErrRetryTransition
+	}
+
+	for k, v := range resp.Vars {
+		fsm.SetVar(k, unmarshalVar(v))
+	}
+
+	if resp.Consume > 0 {
+		if resp.Consume > len(payload) {
+			return fmt.Errorf("extern.%s: consumed %d bytes, only %d available", method, resp.Consume, len(payload))
+		} else if _, err := fsm.Conn().Seek(int64(resp.Consume), io.SeekCurrent); err != nil {
+			logger.Error("cannot move buffer forward", zap.Error(err))
+			return err
+		}
+	}
+
+	if len(resp.Write) > 0 {
+		if _, err := fsm.Conn().Write(resp.Write); err != nil {
+			logger.Error("cannot write to connection", zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Debug("extern complete",
+		zap.Int("consumed", resp.Consume),
+		zap.Int("written", len(resp.Write)),
+		zap.Duration("t", time.Since(t0)),
+	)
+	return nil
+}
+
+// call sends req to the sidecar, reconnecting once if the connection is stale.
+// Returns early if ctx is done or the sidecar does not respond in time.
+func call(ctx context.Context, req *Request) (*Response, error) {
+	timer := time.NewTimer(CallTimeout)
+	defer timer.Stop()
+
+	for i := 0; ; i++ {
+		c, err := dial(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Replies are matched by sequence number so an abandoned call cannot
+		// receive the response to a later one.
+		var resp Response
+		rc := c.Go(ServiceMethod, req, &resp, make(chan *rpc.Call, 1))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, ErrCallTimeout
+		case <-rc.Done:
+		}
+
+		if err := rc.Error; err == rpc.ErrShutdown || err == io.ErrUnexpectedEOF {
+			reset(c)
+			if i == 0 {
+				continue
+			}
+			return nil, err
+		} else if err != nil {
+			return nil, err
+		}
+		return &resp, nil
+	}
+}
+
+// dial returns the cached sidecar client or connects a new one.
+func dial(ctx context.Context) (*rpc.Client, error) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	if Addr == "" {
+		return nil, ErrNoSidecar
+	} else if client.rpc != nil && client.addr == Addr {
+		return client.rpc, nil
+	} else if client.rpc != nil {
+		client.rpc.Close()
+		client.rpc = nil
+	}
+
+	network, addr := "tcp", Addr
+	if strings.HasPrefix(addr, "unix:") {
+		network, addr = "unix", strings.TrimPrefix(addr, "unix:")
+	}
+
+	dialer := net.Dialer{Timeout: DialTimeout}
+	conn, err := dialer.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+	client.addr, client.rpc = Addr, jsonrpc.NewClient(conn)
+	return client.rpc, nil
+}
+
+// reset closes c and removes it from the cache if it is still current.
+func reset(c *rpc.Client) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.rpc == c {
+		client.rpc = nil
+	}
+	c.Close()
+}
+
+// marshalVars returns the subset of vars that can be encoded as JSON scalars.
+func marshalVars(vars map[string]interface{}) map[string]interface{} {
+	other := make(map[string]interface{}, len(vars))
+	for k, v := range vars {
+		switch v.(type) {
+		case string, int, float64, bool:
+			other[k] = v
+		}
+	}
+	return other
+}
+
+// unmarshalVar converts whole JSON numbers back to ints.
+func unmarshalVar(v interface{}) interface{} {
+	if f, ok := v.(float64); ok && f == math.Trunc(f) && math.Abs(f) < math.MaxInt32 {
+		return int(f)
+	}
+	return v
+}
@@ -0,0 +1,194 @@
+package extern_test
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/extern"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		marionette.Logger = zap.NewNop()
+	}
+	os.Exit(m.Run())
+}
+
+func TestCall(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		ln := OpenSidecar(t, func(req *extern.Request, resp *extern.Response) error {
+			if req.Method != "my_handler" {
+				t.Fatalf("unexpected method: %s", req.Method)
+			} else if len(req.Args) != 1 || req.Args[0] != "foo" {
+				t.Fatalf("unexpected args: %#v", req.Args)
+			} else if req.Vars["session_id"] != "abc" {
+				t.Fatalf("unexpected vars: %#v", req.Vars)
+			}
+			resp.Vars = map[string]interface{}{"port": 8080}
+			resp.Write = []byte("bar")
+			return nil
+		})
+		defer ln.Close()
+		defer SetAddr(ln.Addr().String())()
+
+		conn := mock.DefaultConn()
+		conn.WriteFn = func(p []byte) (int, error) {
+			if string(p) != "bar" {
+				t.Fatalf("unexpected write: %q", p)
+			}
+			return len(p), nil
+		}
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.VarsFn = func() map[string]interface{} { return map[string]interface{}{"session_id": "abc"} }
+
+		var port interface{}
+		fsm.SetVarFn = func(key string, value interface{}) { port = value }
+
+		if err := marionette.FindPlugin("extern", "my_handler")(context.Background(), &fsm, "foo"); err != nil {
+			t.Fatal(err)
+		} else if port != 8080 {
+			t.Fatalf("unexpected port: %#v", port)
+		}
+	})
+
+	// Ensure errors returned by the sidecar fail the action.
+	t.Run("ErrSidecar", func(t *testing.T) {
+		ln := OpenSidecar(t, func(req *extern.Request, resp *extern.Response) error {
+			resp.Error = "bad input"
+			return nil
+		})
+		defer ln.Close()
+		defer SetAddr(ln.Addr().String())()
+
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.VarsFn = func() map[string]interface{} { return nil }
+
+		if err := extern.Call(context.Background(), &fsm, "my_handler"); err == nil || err.Error() != `extern.my_handler: bad input` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	// Ensure a sidecar which never responds does not block the FSM forever.
+	t.Run("ErrCallTimeout", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		ln := OpenSidecar(t, func(req *extern.Request, resp *extern.Response) error {
+			<-release
+			return nil
+		})
+		defer ln.Close()
+		defer SetAddr(ln.Addr().String())()
+		defer SetCallTimeout(50 * time.Millisecond)()
+
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.VarsFn = func() map[string]interface{} { return nil }
+
+		if err := extern.Call(context.Background(), &fsm, "my_handler"); err != extern.ErrCallTimeout {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	// Ensure a call returns as soon as its context is canceled.
+	t.Run("ErrCanceled", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		ln := OpenSidecar(t, func(req *extern.Request, resp *extern.Response) error {
+			<-release
+			return nil
+		})
+		defer ln.Close()
+		defer SetAddr(ln.Addr().String())()
+
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.VarsFn = func() map[string]interface{} { return nil }
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if err := extern.Call(ctx, &fsm, "my_handler"); err != context.DeadlineExceeded {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrNoSidecar", func(t *testing.T) {
+		defer SetAddr("")()
+
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.VarsFn = func() map[string]interface{} { return nil }
+
+		if err := extern.Call(context.Background(), &fsm, "my_handler"); err != extern.ErrNoSidecar {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Plugin is the sidecar service used for testing.
+type Plugin struct {
+	fn func(req *extern.Request, resp *extern.Response) error
+}
+
+func (p *Plugin) Call(req *extern.Request, resp *extern.Response) error {
+	if p.fn == nil {
+		return errors.New("no handler")
+	}
+	return p.fn(req, resp)
+}
+
+// OpenSidecar starts a JSON-RPC server on a random port and returns its listener.
+func OpenSidecar(tb testing.TB, fn func(req *extern.Request, resp *extern.Response) error) net.Listener {
+	srv := rpc.NewServer()
+	if err := srv.Register(&Plugin{fn: fn}); err != nil {
+		tb.Fatal(err)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go srv.ServeCodec(jsonrpc.NewServerCodec(conn))
+		}
+	}()
+	return ln
+}
+
+// SetAddr sets the sidecar address and returns a function to restore it.
+func SetAddr(addr string) func() {
+	prev := extern.Addr
+	extern.Addr = addr
+	return func() { extern.Addr = prev }
+}
+
+// SetCallTimeout sets the sidecar call timeout and returns a function to restore it.
+func SetCallTimeout(d time.Duration) func() {
+	prev := extern.CallTimeout
+	extern.CallTimeout = d
+	return func() { extern.CallTimeout = prev }
+}
@@ -12,9 +12,29 @@ import (
 	"go.uber.org/zap"
 )
 
+// MaxBufferSize is the maximum number of bytes buffered while waiting for a
+// partial message to complete.
+var MaxBufferSize = marionette.MaxCellLength * 2
+
+// ErrBufferFull is returned when a message cannot be decoded before the
+// buffer reaches MaxBufferSize.
+var ErrBufferFull = errors.New("fte: buffer full before complete message")
+
 func init() {
-	marionette.RegisterPlugin("fte", "recv", Recv)
-	marionette.RegisterPlugin("fte", "recv_async", RecvAsync)
+	marionette.RegisterPlugin("fte", "recv", Recv, marionette.PluginInfo{
+		Description: "Receives an FTE encrypted cell.",
+		Args: []marionette.PluginArg{
+			{Name: "regex", Type: "string"},
+			{Name: "msg_len", Type: "int"},
+		},
+	})
+	marionette.RegisterPlugin("fte", "recv_async", RecvAsync, marionette.PluginInfo{
+		Description: "Receives an FTE encrypted cell, if available.",
+		Args: []marionette.PluginArg{
+			{Name: "regex", Type: "string"},
+			{Name: "msg_len", Type: "int"},
+		},
+	})
 }
 
 // Recv receives data from a connection.
@@ -31,7 +51,7 @@ func recv(ctx context.Context, fsm marionette.FSM, args []interface{}, blocking
 	t0 := time.Now()
 
 	logger := func() *zap.Logger {
-		return fsm.Logger().With(
+		return fsm.Logger().Named(marionette.LoggerFTE).With(
 			zap.String("plugin", "fte.recv"),
 			zap.String("state", fsm.State()),
 		)
@@ -73,7 +93,7 @@ func recv(ctx context.Context, fsm marionette.FSM, args []interface{}, blocking
 		zap.Error(err),
 	)
 	if err == fte.ErrShortCiphertext {
-		return nil
+		return waitForMore(conn, len(ciphertext), blocking)
 	} else if err != nil {
 		logger().Error("cannot decrypt ciphertext", zap.Error(err))
 		return err
@@ -122,3 +142,21 @@ func recv(ctx context.Context, fsm marionette.FSM, args []interface{}, blocking
 
 	return nil
 }
+
+// waitForMore blocks until more than n bytes are available on the connection
+// and then signals a retry. The buffer is bounded by MaxBufferSize so a
+// message that is too large to ever complete returns an error.
+func waitForMore(conn *marionette.BufferedConn, n int, blocking bool) error {
+	if n >= MaxBufferSize {
+		return ErrBufferFull
+	} else if !blocking {
+		return nil
+	}
+
+	if _, err := conn.Peek(n+1, true); err == io.EOF {
+		return io.ErrUnexpectedEOF
+	} else if err != nil {
+		return err
+	}
+	return marionette.ErrRetryTransition
+}
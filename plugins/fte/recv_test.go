@@ -3,20 +3,27 @@ package fte_test
 import (
 	"context"
 	"errors"
+	"flag"
+	"io"
+	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/redjack/marionette"
+	corefte "github.com/redjack/marionette/fte"
 	"github.com/redjack/marionette/mock"
 	"github.com/redjack/marionette/plugins/fte"
 	"go.uber.org/zap"
 )
 
-func init() {
+func TestMain(m *testing.M) {
+	flag.Parse()
 	if !testing.Verbose() {
 		marionette.Logger = zap.NewNop()
 	}
+	os.Exit(m.Run())
 }
 
 func TestRecv(t *testing.T) {
@@ -146,6 +153,70 @@ func TestRecv(t *testing.T) {
 	})
 
 	// Ensure plugin passes through connection errors.
+	t.Run("ShortCiphertext", func(t *testing.T) {
+		// Reads return each chunk in order. Reads after the first wait for
+		// the first decryption attempt. Once chunks are exhausted, reads
+		// either return EOF or block.
+		newFSM := func(chunks []string, eof bool) *mock.FSM {
+			decrypted := make(chan struct{})
+			var readN int
+			conn := mock.DefaultConn()
+			conn.ReadFn = func(p []byte) (int, error) {
+				if readN++; readN > 1 {
+					<-decrypted
+				}
+				if len(chunks) == 0 {
+					if eof {
+						return 0, io.EOF
+					}
+					<-make(chan struct{})
+				}
+				n := copy(p, chunks[0])
+				chunks = chunks[1:]
+				return n, nil
+			}
+
+			fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+			fsm.PartyFn = func() string { return marionette.PartyClient }
+
+			var once sync.Once
+			var cipher mock.Cipher
+			cipher.DecryptFn = func(ciphertext []byte) (plaintext, remainder []byte, err error) {
+				once.Do(func() { close(decrypted) })
+				return nil, nil, corefte.ErrShortCiphertext
+			}
+			fsm.CipherFn = func(regex string, n int) (marionette.Cipher, error) { return &cipher, nil }
+			return &fsm
+		}
+
+		t.Run("Async", func(t *testing.T) {
+			if err := fte.RecvAsync(context.Background(), newFSM([]string{"foo"}, false), `([a-z0-9]+)`, 128); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		t.Run("Retry", func(t *testing.T) {
+			if err := fte.Recv(context.Background(), newFSM([]string{"foo", "bar"}, false), `([a-z0-9]+)`, 128); err != marionette.ErrRetryTransition {
+				t.Fatalf("unexpected error: %#v", err)
+			}
+		})
+
+		t.Run("ErrUnexpectedEOF", func(t *testing.T) {
+			if err := fte.Recv(context.Background(), newFSM([]string{"foo"}, true), `([a-z0-9]+)`, 128); err != io.ErrUnexpectedEOF {
+				t.Fatalf("unexpected error: %#v", err)
+			}
+		})
+
+		t.Run("ErrBufferFull", func(t *testing.T) {
+			defer func(v int) { fte.MaxBufferSize = v }(fte.MaxBufferSize)
+			fte.MaxBufferSize = 3
+
+			if err := fte.Recv(context.Background(), newFSM([]string{"foo"}, false), `([a-z0-9]+)`, 128); err != fte.ErrBufferFull {
+				t.Fatalf("unexpected error: %#v", err)
+			}
+		})
+	})
+
 	t.Run("ErrConnPeek", func(t *testing.T) {
 		errMarker := errors.New("marker")
 		conn := mock.DefaultConn()
@@ -170,8 +241,7 @@ func TestRecv(t *testing.T) {
 		conn := mock.DefaultConn()
 		conn.SetReadDeadlineFn = func(_ time.Time) error { return nil }
 		conn.ReadFn = func(p []byte) (int, error) {
-			copy(p, []byte("foo"))
-			return 3, nil
+			return copy(p, []byte("foo")), nil
 		}
 
 		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
@@ -11,26 +11,64 @@ import (
 )
 
 func init() {
-	marionette.RegisterPlugin("fte", "send", Send)
-	marionette.RegisterPlugin("fte", "send_async", SendAsync)
+	marionette.RegisterPlugin("fte", "send", Send, marionette.PluginInfo{
+		Description: "Sends an FTE encrypted cell.",
+		Args: []marionette.PluginArg{
+			{Name: "regex", Type: "string"},
+			{Name: "msg_len", Type: "int"},
+		},
+	})
+	marionette.RegisterPlugin("fte", "send_async", SendAsync, marionette.PluginInfo{
+		Description: "Sends an FTE encrypted cell, if data is available.",
+		Args: []marionette.PluginArg{
+			{Name: "regex", Type: "string"},
+			{Name: "msg_len", Type: "int"},
+		},
+	})
+	marionette.RegisterPlugin("fte", "send_queued", SendQueued, marionette.PluginInfo{
+		Description: "Queues an FTE encrypted cell to be sent in the background.",
+		Args: []marionette.PluginArg{
+			{Name: "regex", Type: "string"},
+			{Name: "msg_len", Type: "int"},
+		},
+	})
+	marionette.RegisterPlugin("fte", "send_async_queued", SendAsyncQueued, marionette.PluginInfo{
+		Description: "Queues an FTE encrypted cell to be sent in the background, if data is available.",
+		Args: []marionette.PluginArg{
+			{Name: "regex", Type: "string"},
+			{Name: "msg_len", Type: "int"},
+		},
+	})
 }
 
 // Send sends data to a connection.
 func Send(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
-	return send(ctx, fsm, args, true)
+	return send(ctx, fsm, args, true, false)
 }
 
 // SendAsync send data to a connection without blocking.
 func SendAsync(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
-	return send(ctx, fsm, args, false)
+	return send(ctx, fsm, args, false, false)
 }
 
-func send(ctx context.Context, fsm marionette.FSM, args []interface{}, blocking bool) error {
+// SendQueued encrypts a cell and queues it to be written to the connection by
+// a background goroutine so a slow write does not delay subsequent states.
+func SendQueued(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	return send(ctx, fsm, args, true, true)
+}
+
+// SendAsyncQueued queues data to be written to the connection if available.
+func SendAsyncQueued(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	return send(ctx, fsm, args, false, true)
+}
+
+func send(ctx context.Context, fsm marionette.FSM, args []interface{}, blocking, queued bool) error {
 	t0 := time.Now()
 
-	logger := marionette.Logger.With(
+	logger := marionette.Logger.Named(marionette.LoggerFTE).With(
 		zap.String("plugin", "fte.send"),
 		zap.Bool("blocking", blocking),
+		zap.Bool("queued", queued),
 		zap.String("party", fsm.Party()),
 		zap.String("state", fsm.State()),
 	)
@@ -54,16 +92,35 @@ func send(ctx context.Context, fsm marionette.FSM, args []interface{}, blocking
 	}
 	capacity := cipher.Capacity() - fte.COVERTEXT_HEADER_LEN_CIPHERTTEXT - fte.CTXT_EXPANSION
 
+	// If a cell length distribution is set then split & pad cells to a
+	// randomly chosen length instead of always filling the capacity.
+	n, padding := capacity, 0
+	if dist, ok := fsm.Var(marionette.CellLengthsVar).(marionette.CellLengthDistribution); ok && len(dist) > 0 {
+		n = dist.Choose(capacity)
+		padding = n
+	}
+
 	// Pull the next cell for the stream set. If no cell exists and we are
 	// blocking then send an empty cell. If no cell exists and we are not
 	// blocking then return. The FSM will move on to the next step. This
 	// allows non-blocking send/recv to continually check both sides of a conn.
-	cell := fsm.StreamSet().Dequeue(capacity)
+	//
+	// If a padding machine is set then data cells may be delayed, and an
+	// empty cell is sent when the machine calls for a dummy cell.
+	machine, _ := fsm.Var(marionette.PaddingMachineVar).(*marionette.PaddingMachine)
+	cell, dummy := fsm.StreamSet().Dequeue(n), false
 	if cell != nil {
-		// nop
+		if machine != nil {
+			if err := sleepContext(ctx, machine.DataDelay()); err != nil {
+				return err
+			}
+		}
 	} else if cell == nil && blocking {
 		logger.Debug("no cell, sending empty cell")
-		cell = marionette.NewCell(0, 0, 0, marionette.NORMAL)
+		cell, dummy = marionette.NewCell(0, 0, padding, marionette.NORMAL), true
+	} else if machine != nil && machine.Pad(time.Now()) {
+		logger.Debug("no cell, sending dummy cell")
+		cell, dummy = marionette.NewCell(0, 0, padding, marionette.NORMAL), true
 	} else {
 		return nil
 	}
@@ -83,10 +140,17 @@ func send(ctx context.Context, fsm marionette.FSM, args []interface{}, blocking
 		return err
 	}
 
-	// Write to outgoing connection.
-	if _, err := fsm.Conn().Write(ciphertext); err != nil {
+	// Write to outgoing connection, or hand off to the connection's writer.
+	if queued {
+		if err := fsm.Conn().WriteQueued(ciphertext); err != nil {
+			return err
+		}
+	} else if _, err := fsm.Conn().Write(ciphertext); err != nil {
 		return err
 	}
+	if machine != nil {
+		machine.Sent(time.Now(), dummy)
+	}
 
 	logger.Debug("msg sent",
 		zap.Int("plaintext", len(cell.Payload)),
@@ -95,3 +159,18 @@ func send(ctx context.Context, fsm marionette.FSM, args []interface{}, blocking
 	)
 	return nil
 }
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
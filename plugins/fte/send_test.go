@@ -63,6 +63,50 @@ func TestSend(t *testing.T) {
 		}
 	})
 
+	// Ensure cells are sized by the cell length distribution, if set.
+	t.Run("CellLengths", func(t *testing.T) {
+		streamSet := marionette.NewStreamSet()
+
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, streamSet)
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.UUIDFn = func() int { return 100 }
+		fsm.InstanceIDFn = func() int { return 200 }
+		fsm.VarFn = func(key string) interface{} {
+			if key == marionette.CellLengthsVar {
+				return marionette.CellLengthDistribution{30: 1}
+			}
+			return nil
+		}
+
+		var cipher mock.Cipher
+		cipher.CapacityFn = func() int { return 1024 }
+		cipher.EncryptFn = func(plaintext []byte) ([]byte, error) {
+			var cell marionette.Cell
+			if err := cell.UnmarshalBinary(plaintext); err != nil {
+				t.Fatal(err)
+			} else if len(plaintext) != 30 {
+				t.Fatalf("unexpected cell length: %d", len(plaintext))
+			} else if string(cell.Payload) != `fooba` {
+				t.Fatalf("unexpected payload: %s", cell.Payload)
+			}
+			return []byte(`bar`), nil
+		}
+		fsm.CipherFn = func(regex string, n int) (marionette.Cipher, error) { return &cipher, nil }
+		conn.WriteFn = func(p []byte) (int, error) { return len(p), nil }
+
+		stream := streamSet.Create()
+		if _, err := stream.Write([]byte(`foobar`)); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := fte.Send(context.Background(), &fsm, `([a-z0-9]+)`, 1024); err != nil {
+			t.Fatal(err)
+		} else if n := stream.WriteBufferLen(); n != 1 {
+			t.Fatalf("unexpected remaining write buffer: %d", n)
+		}
+	})
+
 	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
 		conn := mock.DefaultConn()
 		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
@@ -190,6 +234,34 @@ func TestSend(t *testing.T) {
 		}
 	})
 
+	t.Run("Queued", func(t *testing.T) {
+		streamSet := marionette.NewStreamSet()
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, streamSet)
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.UUIDFn = func() int { return 100 }
+		fsm.InstanceIDFn = func() int { return 200 }
+
+		var cipher mock.Cipher
+		cipher.CapacityFn = func() int { return 128 }
+		cipher.EncryptFn = func(plaintext []byte) ([]byte, error) { return []byte(`bar`), nil }
+		fsm.CipherFn = func(regex string, n int) (marionette.Cipher, error) { return &cipher, nil }
+
+		written := make(chan []byte, 1)
+		conn.WriteFn = func(p []byte) (int, error) {
+			written <- p
+			return len(p), nil
+		}
+
+		if err := fte.SendQueued(context.Background(), &fsm, `([a-z0-9]+)`, 128); err != nil {
+			t.Fatal(err)
+		} else if err := fsm.Conn().Flush(); err != nil {
+			t.Fatal(err)
+		} else if p := <-written; string(p) != `bar` {
+			t.Fatalf("unexpected write: %q", p)
+		}
+	})
+
 	// Ensure connection write errors are passed through.
 	t.Run("ErrConnWrite", func(t *testing.T) {
 		errMarker := errors.New("marker")
@@ -0,0 +1,97 @@
+package io
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+func init() {
+	marionette.RegisterPlugin("io", "capture", Capture, marionette.PluginInfo{
+		Description: "Stores the first capture group of a regex matched against received data in a variable.",
+		Args: []marionette.PluginArg{
+			{Name: "regex", Type: "string"},
+			{Name: "var", Type: "string"},
+		},
+	})
+}
+
+// Capture matches regex against the data received but not yet consumed and
+// stores its first capture group in the named variable. The data is left in
+// the buffer so it can still be read by later actions. This allows values
+// such as a negotiated port or token to be used by later states.
+//
+// Retries the transition once more data arrives if the regex does not match
+// the buffered data. Actions may branch on the stored value with
+// "if var_match(name, regex)".
+func Capture(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	logger := marionette.Logger.With(
+		zap.String("plugin", "io.capture"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 2 {
+		return errors.New("not enough arguments")
+	}
+	expr, ok := args[0].(string)
+	if !ok {
+		return errors.New("invalid regex argument type")
+	}
+	name, ok := args[1].(string)
+	if !ok {
+		return errors.New("invalid var argument type")
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return err
+	} else if re.NumSubexp() < 1 {
+		return errors.New("regex must contain a capture group")
+	}
+
+	// Wait until some data is available.
+	if _, err := fsm.Conn().Peek(1, true); err == io.EOF {
+		return err
+	} else if err != nil {
+		logger.Error("cannot read from connection", zap.Error(err))
+		return err
+	}
+
+	buf, err := fsm.Conn().Peek(-1, false)
+	if err != nil && err != io.EOF {
+		logger.Error("cannot read from connection", zap.Error(err))
+		return err
+	}
+
+	m := re.FindSubmatch(buf)
+	if m == nil {
+		logger.Debug("regex not matched, retrying", zap.Int("n", len(buf)))
+		return waitForMore(fsm.Conn(), len(buf))
+	}
+	fsm.SetVar(name, string(m[1]))
+
+	logger.Debug("captured", zap.String("var", name), zap.Int("n", len(m[1])))
+	return nil
+}
+
+// waitForMore blocks until more than n bytes are buffered and then signals a
+// retry so that an unmatched pattern does not spin. Returns an error once n
+// reaches MaxDelimitedLength since the pattern may never match.
+func waitForMore(conn *marionette.BufferedConn, n int) error {
+	if n >= MaxDelimitedLength {
+		return fmt.Errorf("pattern not matched within %d bytes", MaxDelimitedLength)
+	}
+
+	if _, err := conn.Peek(n+1, true); err == io.EOF {
+		return io.ErrUnexpectedEOF
+	} else if err != nil {
+		return err
+	}
+	return marionette.ErrRetryTransition
+}
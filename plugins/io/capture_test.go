@@ -0,0 +1,84 @@
+package io_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/plugins/io"
+)
+
+func TestCapture(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		const data = "227 Entering Passive Mode (127,0,0,1,78,52)\r\n"
+		fsm, vars := newReadFSM(data)
+		if err := io.Capture(context.Background(), fsm, `\(([\d,]+)\)`, "pasv"); err != nil {
+			t.Fatal(err)
+		} else if vars["pasv"] != "127,0,0,1,78,52" {
+			t.Fatalf("unexpected value: %q", vars["pasv"])
+		}
+
+		// Data should not be consumed.
+		if buf, _ := fsm.Conn().Peek(-1, false); string(buf) != data {
+			t.Fatalf("unexpected buffer: %q", buf)
+		}
+	})
+
+	// Ensure an unmatched regex waits for more data before retrying.
+	t.Run("ErrRetryTransition", func(t *testing.T) {
+		fsm, vars, release := newGatedReadFSM("foo ", "token=bar\r\n")
+
+		errs := make(chan error, 1)
+		go func() { errs <- io.Capture(context.Background(), fsm, `token=(\w+)\r\n`, "token") }()
+		select {
+		case err := <-errs:
+			t.Fatalf("unexpected return before more data: %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(release)
+		if err := <-errs; err != marionette.ErrRetryTransition {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _, ok := vars["token"]; ok {
+			t.Fatal("expected var to be unset")
+		}
+
+		if err := io.Capture(context.Background(), fsm, `token=(\w+)\r\n`, "token"); err != nil {
+			t.Fatal(err)
+		} else if vars["token"] != "bar" {
+			t.Fatalf("unexpected value: %q", vars["token"])
+		}
+	})
+
+	t.Run("ErrNotMatched", func(t *testing.T) {
+		defer func(v int) { io.MaxDelimitedLength = v }(io.MaxDelimitedLength)
+		io.MaxDelimitedLength = 4
+
+		fsm, _ := newReadFSM("foobar")
+		if err := io.Capture(context.Background(), fsm, `token=(\w+)`, "token"); err == nil || err.Error() != `pattern not matched within 4 bytes` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrNoCaptureGroup", func(t *testing.T) {
+		fsm, _ := newReadFSM("")
+		if err := io.Capture(context.Background(), fsm, `foo`, "v"); err == nil || err.Error() != `regex must contain a capture group` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrInvalidRegex", func(t *testing.T) {
+		fsm, _ := newReadFSM("")
+		if err := io.Capture(context.Background(), fsm, `(`, "v"); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
+		fsm, _ := newReadFSM("")
+		if err := io.Capture(context.Background(), fsm, `(foo)`); err == nil || err.Error() != `not enough arguments` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
@@ -13,7 +13,12 @@ import (
 )
 
 func init() {
-	marionette.RegisterPlugin("io", "gets", Gets)
+	marionette.RegisterPlugin("io", "gets", Gets, marionette.PluginInfo{
+		Description: "Reads an expected string from the connection.",
+		Args: []marionette.PluginArg{
+			{Name: "data", Type: "string"},
+		},
+	})
 }
 
 func Gets(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
@@ -33,6 +38,11 @@ func Gets(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 		return errors.New("invalid argument type")
 	}
 
+	// Match against the template if the peer interpolates the data.
+	if HasPlaceholders(exp) {
+		return getsTemplate(fsm, exp, logger, t0)
+	}
+
 	// Read buffer to see if our expected data comes through.
 	buf, err := fsm.Conn().Peek(len(exp), true)
 	if err == io.EOF {
@@ -54,3 +64,45 @@ func Gets(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 	logger.Debug("msg received", zap.Int("n", len(buf)), zap.Duration("t", time.Since(t0)))
 	return nil
 }
+
+// getsTemplate reads data matching an interpolated io.puts template.
+// Variables that are not set locally are assigned from the received data.
+func getsTemplate(fsm marionette.FSM, exp string, logger *zap.Logger, t0 time.Time) error {
+	re, names, minLen, err := templateMatcher(fsm, exp)
+	if err != nil {
+		return err
+	}
+
+	// Wait until at least the smallest possible match is available.
+	if _, err := fsm.Conn().Peek(minLen, true); err == io.EOF {
+		return err
+	} else if err != nil {
+		logger.Error("cannot read from connection", zap.Error(err))
+		return err
+	}
+
+	buf, err := fsm.Conn().Peek(-1, false)
+	if err != nil && err != io.EOF {
+		logger.Error("cannot read from connection", zap.Error(err))
+		return err
+	}
+
+	m := re.FindSubmatch(buf)
+	if m == nil {
+		logger.Debug("template not matched, retrying", zap.Int("n", len(buf)))
+		return waitForMore(fsm.Conn(), len(buf))
+	}
+
+	for i, name := range names {
+		fsm.SetVar(name, string(m[i+1]))
+	}
+
+	// Move buffer forward.
+	if _, err := fsm.Conn().Seek(int64(len(m[0])), io.SeekCurrent); err != nil {
+		logger.Error("cannot move buffer forward", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("msg received", zap.Int("n", len(m[0])), zap.Duration("t", time.Since(t0)))
+	return nil
+}
@@ -3,6 +3,8 @@ package io_test
 import (
 	"context"
 	"errors"
+	"flag"
+	"os"
 	"testing"
 	"time"
 
@@ -12,10 +14,12 @@ import (
 	"go.uber.org/zap"
 )
 
-func init() {
+func TestMain(m *testing.M) {
+	flag.Parse()
 	if !testing.Verbose() {
 		marionette.Logger = zap.NewNop()
 	}
+	os.Exit(m.Run())
 }
 
 func TestGets(t *testing.T) {
@@ -23,8 +27,7 @@ func TestGets(t *testing.T) {
 		conn := mock.DefaultConn()
 		conn.SetReadDeadlineFn = func(_ time.Time) error { return nil }
 		conn.ReadFn = func(p []byte) (int, error) {
-			copy(p, []byte("foo"))
-			return 3, nil
+			return copy(p, []byte("foo")), nil
 		}
 		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
 		fsm.PartyFn = func() string { return marionette.PartyClient }
@@ -34,6 +37,73 @@ func TestGets(t *testing.T) {
 		}
 	})
 
+	// Ensure templated data is matched and unset variables are assigned.
+	t.Run("Template", func(t *testing.T) {
+		var done bool
+		conn := mock.DefaultConn()
+		conn.SetReadDeadlineFn = func(_ time.Time) error { return nil }
+		conn.ReadFn = func(p []byte) (int, error) {
+			if done {
+				<-make(chan struct{})
+			}
+			done = true
+			return copy(p, "220 0a1b2c3d xyz\r\nfoo"), nil
+		}
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+		fsm.VarFn = func(key string) interface{} { return nil }
+
+		var value interface{}
+		fsm.SetVarFn = func(key string, v interface{}) {
+			if key != "session_id" {
+				t.Fatalf("unexpected key: %s", key)
+			}
+			value = v
+		}
+
+		if err := io.Gets(context.Background(), &fsm, "220 {rand_hex:8} {var:session_id}\r\n"); err != nil {
+			t.Fatal(err)
+		} else if value != "xyz" {
+			t.Fatalf("unexpected value: %v", value)
+		} else if buf, _ := fsm.Conn().Peek(-1, false); string(buf) != "foo" {
+			t.Fatalf("unexpected remaining buffer: %q", buf)
+		}
+	})
+
+	// Ensure a variable at the end of the template captures the whole line.
+	t.Run("TemplateTrailingVar", func(t *testing.T) {
+		fsm, vars := newReadFSM("token abcdef\r\nfoo")
+		if err := io.Gets(context.Background(), fsm, "token {var:token}"); err != nil {
+			t.Fatal(err)
+		} else if vars["token"] != "abcdef" {
+			t.Fatalf("unexpected value: %q", vars["token"])
+		} else if buf, _ := fsm.Conn().Peek(-1, false); string(buf) != "\r\nfoo" {
+			t.Fatalf("unexpected remaining buffer: %q", buf)
+		}
+	})
+
+	// Ensure an unmatched template waits for more data before retrying.
+	t.Run("TemplateRetry", func(t *testing.T) {
+		fsm, vars, release := newGatedReadFSM("abc ok", "\r\n")
+
+		errs := make(chan error, 1)
+		go func() { errs <- io.Gets(context.Background(), fsm, "{var:x} ok\r\n") }()
+		select {
+		case err := <-errs:
+			t.Fatalf("unexpected return before more data: %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(release)
+		if err := <-errs; err != marionette.ErrRetryTransition {
+			t.Fatalf("unexpected error: %v", err)
+		} else if err := io.Gets(context.Background(), fsm, "{var:x} ok\r\n"); err != nil {
+			t.Fatal(err)
+		} else if vars["x"] != "abc" {
+			t.Fatalf("unexpected value: %q", vars["x"])
+		}
+	})
+
 	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
 		conn := mock.DefaultConn()
 		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
@@ -71,8 +141,7 @@ func TestGets(t *testing.T) {
 		conn := mock.DefaultConn()
 		conn.SetReadDeadlineFn = func(_ time.Time) error { return nil }
 		conn.ReadFn = func(p []byte) (int, error) {
-			copy(p, []byte("bar"))
-			return 3, nil
+			return copy(p, []byte("bar")), nil
 		}
 		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
 		fsm.PartyFn = func() string { return marionette.PartyClient }
@@ -10,7 +10,12 @@ import (
 )
 
 func init() {
-	marionette.RegisterPlugin("io", "puts", Puts)
+	marionette.RegisterPlugin("io", "puts", Puts, marionette.PluginInfo{
+		Description: "Writes a string, with optional placeholders, to the connection.",
+		Args: []marionette.PluginArg{
+			{Name: "data", Type: "string"},
+		},
+	})
 }
 
 func Puts(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
@@ -30,6 +35,15 @@ func Puts(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 	if !ok {
 		return errors.New("invalid argument type")
 	}
+
+	// Replace any variable or random placeholders.
+	if HasPlaceholders(data) {
+		var err error
+		if data, err = Interpolate(fsm, data); err != nil {
+			logger.Error("cannot interpolate data", zap.Error(err))
+			return err
+		}
+	}
 	n := len(data)
 
 	// Keep attempting to send even if there are timeouts.
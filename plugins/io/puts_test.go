@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/redjack/marionette"
@@ -18,8 +19,7 @@ func TestPuts(t *testing.T) {
 			if string(p) != "foo" {
 				t.Fatalf("unexpected write: %q", p)
 			}
-			copy(p, []byte("foo"))
-			return 3, nil
+			return copy(p, []byte("foo")), nil
 		}
 		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
 		fsm.PartyFn = func() string { return marionette.PartyClient }
@@ -29,6 +29,39 @@ func TestPuts(t *testing.T) {
 		}
 	})
 
+	// Ensure variables and random generators are interpolated.
+	t.Run("Interpolate", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		conn.WriteFn = func(p []byte) (int, error) {
+			if !regexp.MustCompile(`^220 [0-9a-f]{8} abc\r\n$`).Match(p) {
+				t.Fatalf("unexpected write: %q", p)
+			}
+			return len(p), nil
+		}
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.VarFn = func(key string) interface{} {
+			if key != "session_id" {
+				t.Fatalf("unexpected key: %s", key)
+			}
+			return "abc"
+		}
+
+		if err := io.Puts(context.Background(), &fsm, "220 {rand_hex:8} {var:session_id}\r\n"); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("ErrVariableNotSet", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.VarFn = func(key string) interface{} { return nil }
+		if err := io.Puts(context.Background(), &fsm, "{var:session_id}"); err == nil || err.Error() != `variable not set: "session_id"` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+
 	// Ensure writes are continually attempted if there is a timeout error.
 	t.Run("Timeout", func(t *testing.T) {
 		var i int
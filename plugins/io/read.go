@@ -0,0 +1,190 @@
+package io
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+// MaxDelimitedLength is the maximum number of bytes io.gets_until will read
+// while searching for its delimiter.
+var MaxDelimitedLength = marionette.MaxCellLength
+
+func init() {
+	marionette.RegisterPlugin("io", "gets_bytes", GetsBytes, marionette.PluginInfo{
+		Description: "Reads an exact number of bytes from the connection and optionally stores them in a variable.",
+		Args: []marionette.PluginArg{
+			{Name: "n", Type: "int"},
+			{Name: "var", Type: "string", Optional: true},
+		},
+	})
+	marionette.RegisterPlugin("io", "gets_until", GetsUntil, marionette.PluginInfo{
+		Description: "Reads from the connection through a delimiter and optionally stores the preceding data in a variable.",
+		Args: []marionette.PluginArg{
+			{Name: "delim", Type: "string"},
+			{Name: "var", Type: "string", Optional: true},
+		},
+	})
+	marionette.RegisterPlugin("io", "discard", Discard, marionette.PluginInfo{
+		Description: "Discards an exact number of bytes, such as padding, from the connection.",
+		Args: []marionette.PluginArg{
+			{Name: "n", Type: "int"},
+		},
+	})
+}
+
+// GetsBytes reads exactly n bytes from the connection. If a variable name is
+// provided then the data is stored as a string in that variable.
+func GetsBytes(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "io.gets_bytes"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 1 {
+		return errors.New("not enough arguments")
+	}
+	n, ok := args[0].(int)
+	if !ok {
+		return errors.New("invalid n argument type")
+	} else if n < 0 {
+		return errors.New("n must not be negative")
+	}
+	name, err := optionalVarArg(args, 1)
+	if err != nil {
+		return err
+	}
+
+	buf, err := readBytes(fsm, n, logger)
+	if err != nil {
+		return err
+	} else if name != "" {
+		fsm.SetVar(name, string(buf))
+	}
+
+	logger.Debug("msg received", zap.Int("n", n), zap.Duration("t", time.Since(t0)))
+	return nil
+}
+
+// GetsUntil reads from the connection until delim is found. The delimiter is
+// consumed but is not included in the data stored in the optional variable.
+func GetsUntil(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "io.gets_until"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 1 {
+		return errors.New("not enough arguments")
+	}
+	delim, ok := args[0].(string)
+	if !ok {
+		return errors.New("invalid delim argument type")
+	} else if delim == "" {
+		return errors.New("delim must not be empty")
+	}
+	name, err := optionalVarArg(args, 1)
+	if err != nil {
+		return err
+	}
+
+	// Grow the peek one byte at a time until the delimiter is found so that
+	// each attempt waits for new data instead of spinning.
+	buf, err := fsm.Conn().Peek(len(delim), true)
+	for {
+		if err == io.EOF {
+			return err
+		} else if err != nil {
+			logger.Error("cannot read from connection", zap.Error(err))
+			return err
+		} else if i := bytes.Index(buf, []byte(delim)); i != -1 {
+			buf = buf[:i]
+			break
+		} else if len(buf) >= MaxDelimitedLength {
+			logger.Error("delimiter not found", zap.Int("n", len(buf)))
+			return fmt.Errorf("delimiter not found within %d bytes", MaxDelimitedLength)
+		}
+		buf, err = fsm.Conn().Peek(len(buf)+1, true)
+	}
+
+	if name != "" {
+		fsm.SetVar(name, string(buf))
+	}
+
+	// Move buffer forward past the delimiter.
+	n := len(buf) + len(delim)
+	if _, err := fsm.Conn().Seek(int64(n), io.SeekCurrent); err != nil {
+		logger.Error("cannot move buffer forward", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("msg received", zap.Int("n", n), zap.Duration("t", time.Since(t0)))
+	return nil
+}
+
+// Discard reads and ignores exactly n bytes from the connection.
+func Discard(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	logger := marionette.Logger.With(
+		zap.String("plugin", "io.discard"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 1 {
+		return errors.New("not enough arguments")
+	}
+	n, ok := args[0].(int)
+	if !ok {
+		return errors.New("invalid n argument type")
+	} else if n < 0 {
+		return errors.New("n must not be negative")
+	}
+
+	if _, err := readBytes(fsm, n, logger); err != nil {
+		return err
+	}
+	logger.Debug("discarded", zap.Int("n", n))
+	return nil
+}
+
+// readBytes waits for n bytes, moves the buffer past them, and returns a copy.
+func readBytes(fsm marionette.FSM, n int, logger *zap.Logger) ([]byte, error) {
+	buf, err := fsm.Conn().Peek(n, true)
+	if err == io.EOF {
+		return nil, err
+	} else if err != nil {
+		logger.Error("cannot read from connection", zap.Error(err))
+		return nil, err
+	}
+	buf = append([]byte(nil), buf...)
+
+	if _, err := fsm.Conn().Seek(int64(n), io.SeekCurrent); err != nil {
+		logger.Error("cannot move buffer forward", zap.Error(err))
+		return nil, err
+	}
+	return buf, nil
+}
+
+// optionalVarArg returns the variable name argument at index i, if provided.
+func optionalVarArg(args []interface{}, i int) (string, error) {
+	if len(args) <= i {
+		return "", nil
+	}
+	name, ok := args[i].(string)
+	if !ok {
+		return "", errors.New("invalid var argument type")
+	}
+	return name, nil
+}
@@ -0,0 +1,151 @@
+package io_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/io"
+)
+
+func TestGetsBytes(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		fsm, vars := newReadFSM("\x00\x01\x02rest")
+		if err := io.GetsBytes(context.Background(), fsm, 3, "hdr"); err != nil {
+			t.Fatal(err)
+		} else if vars["hdr"] != "\x00\x01\x02" {
+			t.Fatalf("unexpected value: %q", vars["hdr"])
+		} else if buf, _ := fsm.Conn().Peek(-1, false); string(buf) != "rest" {
+			t.Fatalf("unexpected remaining buffer: %q", buf)
+		}
+	})
+
+	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
+		fsm, _ := newReadFSM("")
+		if err := io.GetsBytes(context.Background(), fsm); err == nil || err.Error() != `not enough arguments` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+
+	t.Run("ErrInvalidN", func(t *testing.T) {
+		fsm, _ := newReadFSM("")
+		if err := io.GetsBytes(context.Background(), fsm, "3"); err == nil || err.Error() != `invalid n argument type` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+
+	t.Run("ErrInvalidVar", func(t *testing.T) {
+		fsm, _ := newReadFSM("")
+		if err := io.GetsBytes(context.Background(), fsm, 3, 4); err == nil || err.Error() != `invalid var argument type` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+}
+
+func TestGetsUntil(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		fsm, vars := newReadFSM("SSH-2.0-OpenSSH_7.4\r\nrest")
+		if err := io.GetsUntil(context.Background(), fsm, "\r\n", "banner"); err != nil {
+			t.Fatal(err)
+		} else if vars["banner"] != "SSH-2.0-OpenSSH_7.4" {
+			t.Fatalf("unexpected value: %q", vars["banner"])
+		} else if buf, _ := fsm.Conn().Peek(-1, false); string(buf) != "rest" {
+			t.Fatalf("unexpected remaining buffer: %q", buf)
+		}
+	})
+
+	// Ensure the delimiter is found if it is split across reads.
+	t.Run("Partial", func(t *testing.T) {
+		fsm, vars := newReadFSM("foo\r", "\nbar")
+		if err := io.GetsUntil(context.Background(), fsm, "\r\n", "v"); err != nil {
+			t.Fatal(err)
+		} else if vars["v"] != "foo" {
+			t.Fatalf("unexpected value: %q", vars["v"])
+		}
+	})
+
+	t.Run("ErrDelimiterNotFound", func(t *testing.T) {
+		defer func(v int) { io.MaxDelimitedLength = v }(io.MaxDelimitedLength)
+		io.MaxDelimitedLength = 4
+
+		fsm, _ := newReadFSM("foobar")
+		if err := io.GetsUntil(context.Background(), fsm, "\n"); err == nil || err.Error() != `delimiter not found within 4 bytes` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+
+	t.Run("ErrEmptyDelim", func(t *testing.T) {
+		fsm, _ := newReadFSM("")
+		if err := io.GetsUntil(context.Background(), fsm, ""); err == nil || err.Error() != `delim must not be empty` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+}
+
+func TestDiscard(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		fsm, _ := newReadFSM("\x00\x00\x00\x00rest")
+		if err := io.Discard(context.Background(), fsm, 4); err != nil {
+			t.Fatal(err)
+		} else if buf, _ := fsm.Conn().Peek(-1, false); string(buf) != "rest" {
+			t.Fatalf("unexpected remaining buffer: %q", buf)
+		}
+	})
+
+	t.Run("ErrNegative", func(t *testing.T) {
+		fsm, _ := newReadFSM("")
+		if err := io.Discard(context.Background(), fsm, -1); err == nil || err.Error() != `n must not be negative` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+}
+
+// newReadFSM returns a mock FSM whose connection returns each chunk in turn
+// and then blocks. Variables are stored in the returned map.
+func newReadFSM(chunks ...string) (*mock.FSM, map[string]interface{}) {
+	conn := mock.DefaultConn()
+	conn.SetReadDeadlineFn = func(_ time.Time) error { return nil }
+	conn.ReadFn = func(p []byte) (int, error) {
+		if len(chunks) == 0 {
+			<-make(chan struct{})
+		}
+		n := copy(p, chunks[0])
+		chunks = chunks[1:]
+		return n, nil
+	}
+
+	vars := make(map[string]interface{})
+	fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+	fsm.PartyFn = func() string { return marionette.PartyServer }
+	fsm.VarFn = func(key string) interface{} { return vars[key] }
+	fsm.SetVarFn = func(key string, v interface{}) { vars[key] = v }
+	return &fsm, vars
+}
+
+// newGatedReadFSM returns an FSM which receives first and then second once
+// the returned channel is closed.
+func newGatedReadFSM(first, second string) (*mock.FSM, map[string]interface{}, chan struct{}) {
+	release := make(chan struct{})
+	chunks := []string{first, second}
+	conn := mock.DefaultConn()
+	conn.SetReadDeadlineFn = func(_ time.Time) error { return nil }
+	conn.ReadFn = func(p []byte) (int, error) {
+		if len(chunks) == 1 {
+			<-release
+		} else if len(chunks) == 0 {
+			<-make(chan struct{})
+		}
+		n := copy(p, chunks[0])
+		chunks = chunks[1:]
+		return n, nil
+	}
+
+	vars := make(map[string]interface{})
+	fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+	fsm.PartyFn = func() string { return marionette.PartyServer }
+	fsm.VarFn = func(key string) interface{} { return vars[key] }
+	fsm.SetVarFn = func(key string, v interface{}) { vars[key] = v }
+	return &fsm, vars, release
+}
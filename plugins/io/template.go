@@ -0,0 +1,148 @@
+package io
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/redjack/marionette"
+)
+
+// placeholderRegex matches interpolation placeholders within io.puts data.
+// For example, "{rand_hex:16}" or "{var:session_id}". Random placeholders are
+// matched by their character set when received so they need not agree
+// between peers. They are drawn from crypto/rand so they are unpredictable,
+// such as when used as session ids.
+var placeholderRegex = regexp.MustCompile(`\{(rand_hex|rand_alnum|rand_digits|var):([A-Za-z0-9_]+)\}`)
+
+const (
+	hexChars   = "0123456789abcdef"
+	alnumChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	digitChars = "0123456789"
+)
+
+// HasPlaceholders returns true if s contains any interpolation placeholders.
+func HasPlaceholders(s string) bool {
+	return placeholderRegex.MatchString(s)
+}
+
+// Interpolate replaces all placeholders in s with FSM variables or random values.
+func Interpolate(fsm marionette.FSM, s string) (string, error) {
+	var err error
+	ret := placeholderRegex.ReplaceAllStringFunc(s, func(m string) string {
+		if err != nil {
+			return ""
+		}
+
+		a := placeholderRegex.FindStringSubmatch(m)
+		switch a[1] {
+		case "var":
+			v := fsm.Var(a[2])
+			if v == nil {
+				err = fmt.Errorf("variable not set: %q", a[2])
+				return ""
+			}
+			return fmt.Sprint(v)
+		default:
+			n, e := strconv.Atoi(a[2])
+			if e != nil {
+				err = fmt.Errorf("invalid %s length: %q", a[1], a[2])
+				return ""
+			}
+			v, e := randString(generatorChars(a[1]), n)
+			if e != nil {
+				err = e
+				return ""
+			}
+			return v
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	return ret, nil
+}
+
+// templateMatcher returns a regex that matches any interpolation of s.
+// Variables that are not set locally are captured by name so they can be
+// assigned from the received data. A variable at the end of s captures the
+// rest of the line since nothing follows it to end the match. Also returns
+// the minimum match length.
+func templateMatcher(fsm marionette.FSM, s string) (re *regexp.Regexp, names []string, minLen int, err error) {
+	var buf strings.Builder
+	buf.WriteString(`^`)
+
+	var pos int
+	for _, loc := range placeholderRegex.FindAllStringSubmatchIndex(s, -1) {
+		literal := s[pos:loc[0]]
+		buf.WriteString(regexp.QuoteMeta(literal))
+		minLen += len(literal)
+		pos = loc[1]
+
+		kind, arg := s[loc[2]:loc[3]], s[loc[4]:loc[5]]
+		switch kind {
+		case "var":
+			if v := fsm.Var(arg); v != nil {
+				value := fmt.Sprint(v)
+				buf.WriteString(regexp.QuoteMeta(value))
+				minLen += len(value)
+			} else {
+				if loc[1] == len(s) {
+					buf.WriteString(`([^\r\n]+)`)
+				} else {
+					buf.WriteString(`(.+?)`)
+				}
+				names = append(names, arg)
+				minLen++
+			}
+		default:
+			n, e := strconv.Atoi(arg)
+			if e != nil {
+				return nil, nil, 0, fmt.Errorf("invalid %s length: %q", kind, arg)
+			}
+			fmt.Fprintf(&buf, `[%s]{%d}`, regexp.QuoteMeta(generatorChars(kind)), n)
+			minLen += n
+		}
+	}
+	buf.WriteString(regexp.QuoteMeta(s[pos:]))
+	minLen += len(s) - pos
+
+	if re, err = regexp.Compile(buf.String()); err != nil {
+		return nil, nil, 0, errors.New("invalid template")
+	}
+	return re, names, minLen, nil
+}
+
+// generatorChars returns the character set used by a random generator.
+func generatorChars(kind string) string {
+	switch kind {
+	case "rand_hex":
+		return hexChars
+	case "rand_digits":
+		return digitChars
+	default:
+		return alnumChars
+	}
+}
+
+// randString returns a string of length n from chars using crypto/rand.
+// Bytes which would bias the choice of character are discarded.
+func randString(chars string, n int) (string, error) {
+	max := 256 - 256%len(chars)
+	b := make([]byte, 0, n)
+	buf := make([]byte, n+n/2)
+	for len(b) < n {
+		if _, err := rand.Read(buf); err != nil {
+			return "", err
+		}
+		for _, c := range buf {
+			if int(c) < max && len(b) < n {
+				b = append(b, chars[int(c)%len(chars)])
+			}
+		}
+	}
+	return string(b), nil
+}
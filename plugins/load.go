@@ -0,0 +1,38 @@
+//go:build (linux && cgo) || (darwin && cgo) || (freebsd && cgo)
+// +build linux,cgo darwin,cgo freebsd,cgo
+
+package plugins
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"plugin"
+	"sort"
+)
+
+// Load opens every Go plugin shared object (*.so) in dir in lexical order.
+// Each plugin is expected to call marionette.RegisterPlugin() from its init.
+// Returns the paths of the loaded plugins.
+func Load(dir string) ([]string, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, fi := range fis {
+		if fi.IsDir() || filepath.Ext(fi.Name()) != ".so" {
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, fi.Name()))
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if _, err := plugin.Open(path); err != nil {
+			return nil, fmt.Errorf("cannot load plugin %s: %s", path, err)
+		}
+	}
+	return paths, nil
+}
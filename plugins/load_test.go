@@ -0,0 +1,48 @@
+//go:build (linux && cgo) || (darwin && cgo) || (freebsd && cgo)
+// +build linux,cgo darwin,cgo freebsd,cgo
+
+package plugins_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/redjack/marionette/plugins"
+)
+
+// Ensure files other than shared objects are ignored.
+func TestLoad_Empty(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "README"), []byte("foo"), 0666); err != nil {
+		t.Fatal(err)
+	} else if err := os.Mkdir(filepath.Join(dir, "sub.so"), 0777); err != nil {
+		t.Fatal(err)
+	}
+
+	if paths, err := plugins.Load(dir); err != nil {
+		t.Fatal(err)
+	} else if len(paths) != 0 {
+		t.Fatalf("unexpected paths: %v", paths)
+	}
+}
+
+func TestLoad_ErrInvalidPlugin(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bad.so")
+	if err := ioutil.WriteFile(path, []byte("not a shared object"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := plugins.Load(dir); err == nil || !strings.HasPrefix(err.Error(), "cannot load plugin "+path+":") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestLoad_ErrNotExist(t *testing.T) {
+	if _, err := plugins.Load(filepath.Join(t.TempDir(), "missing")); !os.IsNotExist(err) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
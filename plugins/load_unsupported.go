@@ -0,0 +1,13 @@
+//go:build (!linux && !darwin && !freebsd) || !cgo
+// +build !linux,!darwin,!freebsd !cgo
+
+package plugins
+
+import (
+	"errors"
+)
+
+// Load is not supported on this platform.
+func Load(dir string) ([]string, error) {
+	return nil, errors.New("go plugins are not supported on this platform")
+}
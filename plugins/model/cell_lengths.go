@@ -0,0 +1,55 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+func init() {
+	marionette.RegisterPlugin("model", "cell_lengths", CellLengths, marionette.PluginInfo{
+		Description: "Sets the distribution of outgoing cell lengths.",
+		Args: []marionette.PluginArg{
+			{Name: "distribution", Type: "string"},
+		},
+	})
+}
+
+// CellLengths sets the distribution of outgoing cell lengths for the FSM.
+// The distribution uses the same format as model.sleep() but the keys are
+// marshaled cell lengths, in bytes. Cells are split and padded to match.
+func CellLengths(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "model.cell_lengths"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 1 {
+		return errors.New("not enough arguments")
+	}
+	distStr, ok := args[0].(string)
+	if !ok {
+		return errors.New("invalid argument type")
+	}
+
+	m, err := ParseSleepDistribution(distStr)
+	if err != nil {
+		return err
+	}
+
+	dist := make(marionette.CellLengthDistribution, len(m))
+	for k, v := range m {
+		dist[int(k)] += v
+	}
+	fsm.SetVar(marionette.CellLengthsVar, dist)
+
+	logger.Debug("cell lengths set", zap.Int("n", len(dist)), zap.Duration("t", time.Since(t0)))
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/model"
+)
+
+func TestCellLengths(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+
+		var value interface{}
+		fsm.SetVarFn = func(key string, v interface{}) {
+			if key != marionette.CellLengthsVar {
+				t.Fatalf("unexpected key: %s", key)
+			}
+			value = v
+		}
+
+		if err := model.CellLengths(context.Background(), &fsm, "{'128': 0.75, '1024': 0.25}"); err != nil {
+			t.Fatal(err)
+		} else if diff := cmp.Diff(value, marionette.CellLengthDistribution{128: 0.75, 1024: 0.25}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		if err := model.CellLengths(context.Background(), &fsm); err == nil || err.Error() != `not enough arguments` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+
+	t.Run("ErrInvalidArgument", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		if err := model.CellLengths(context.Background(), &fsm, 123); err == nil || err.Error() != `invalid argument type` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/fte"
+	"go.uber.org/zap"
+)
+
+func init() {
+	marionette.RegisterPlugin("model", "heartbeat_send", HeartbeatSend, marionette.PluginInfo{
+		Description: "Sends an authenticated keepalive cell.",
+		Args: []marionette.PluginArg{
+			{Name: "regex", Type: "string"},
+			{Name: "msg_len", Type: "int"},
+		},
+	})
+	marionette.RegisterPlugin("model", "heartbeat_expect", HeartbeatExpect, marionette.PluginInfo{
+		Description: "Tears down the FSM when too many keepalives are missed.",
+		Args: []marionette.PluginArg{
+			{Name: "regex", Type: "string"},
+			{Name: "msg_len", Type: "int"},
+			{Name: "interval", Type: "float"},
+			{Name: "max_missed", Type: "int"},
+		},
+	})
+}
+
+// ErrHeartbeatTimeout is returned when too many heartbeats have been missed.
+var ErrHeartbeatTimeout = errors.New("heartbeat timeout")
+
+const (
+	// heartbeatTimeVar stores the last time the peer was seen alive.
+	heartbeatTimeVar = "model_heartbeat_time"
+
+	// heartbeatMissedVar stores the number of consecutive missed heartbeats.
+	heartbeatMissedVar = "model_heartbeat_missed"
+)
+
+// HeartbeatSend encrypts an empty heartbeat cell with the FTE cipher and
+// writes it to the connection. The cipher authenticates the heartbeat.
+func HeartbeatSend(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "model.heartbeat_send"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 2 {
+		return errors.New("not enough arguments")
+	}
+	regex, ok := args[0].(string)
+	if !ok {
+		return errors.New("invalid regex argument type")
+	}
+	msgLen, ok := args[1].(int)
+	if !ok {
+		return errors.New("invalid msg_len argument type")
+	}
+
+	cipher, err := fsm.Cipher(regex, msgLen)
+	if err != nil {
+		return err
+	}
+
+	cell := marionette.NewCell(0, 0, 0, marionette.HEARTBEAT)
+	cell.UUID, cell.InstanceID = fsm.UUID(), fsm.InstanceID()
+	plaintext, err := cell.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	ciphertext, err := cipher.Encrypt(plaintext)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fsm.Conn().Write(ciphertext); err != nil {
+		logger.Error("cannot write to connection", zap.Error(err))
+		return err
+	}
+
+	logger.Debug("heartbeat sent", zap.Int("ciphertext", len(ciphertext)), zap.Duration("t", time.Since(t0)))
+	return nil
+}
+
+// HeartbeatExpect checks for a heartbeat from the peer without blocking.
+//
+// A heartbeat cell is consumed from the connection. Any other authenticated
+// cell is left for the next receive but also counts as a sign of life. If no
+// sign of life is seen within the interval then a heartbeat is counted as
+// missed. ErrHeartbeatTimeout is returned after maxMissed heartbeats so the
+// FSM is torn down.
+func HeartbeatExpect(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	logger := marionette.Logger.With(
+		zap.String("plugin", "model.heartbeat_expect"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 4 {
+		return errors.New("not enough arguments")
+	}
+	regex, ok := args[0].(string)
+	if !ok {
+		return errors.New("invalid regex argument type")
+	}
+	msgLen, ok := args[1].(int)
+	if !ok {
+		return errors.New("invalid msg_len argument type")
+	}
+	interval, ok := toFloat64(args[2])
+	if !ok {
+		return errors.New("invalid interval argument type")
+	}
+	maxMissed, ok := args[3].(int)
+	if !ok {
+		return errors.New("invalid max missed argument type")
+	}
+
+	now := time.Now()
+	lastSeen, ok := fsm.Var(heartbeatTimeVar).(time.Time)
+	if !ok {
+		lastSeen = now
+		fsm.SetVar(heartbeatTimeVar, lastSeen)
+	}
+	missed, _ := fsm.Var(heartbeatMissedVar).(int)
+
+	alive, err := peekHeartbeat(fsm, regex, msgLen)
+	if err != nil {
+		logger.Error("cannot read heartbeat", zap.Error(err))
+		return err
+	} else if alive {
+		fsm.SetVar(heartbeatTimeVar, now)
+		fsm.SetVar(heartbeatMissedVar, 0)
+		return nil
+	}
+
+	// Count a missed heartbeat every interval that passes without data.
+	if now.Sub(lastSeen) < time.Duration(interval*float64(time.Second)) {
+		return nil
+	}
+	missed++
+	fsm.SetVar(heartbeatTimeVar, now)
+	fsm.SetVar(heartbeatMissedVar, missed)
+	logger.Debug("heartbeat missed", zap.Int("missed", missed))
+
+	if missed >= maxMissed {
+		logger.Info("heartbeat timeout", zap.Int("missed", missed))
+		return ErrHeartbeatTimeout
+	}
+	return nil
+}
+
+// peekHeartbeat returns true if an authenticated cell is at the front of the
+// connection buffer. Heartbeat cells are removed from the buffer.
+func peekHeartbeat(fsm marionette.FSM, regex string, msgLen int) (bool, error) {
+	conn := fsm.Conn()
+	ciphertext, err := conn.Peek(-1, false)
+	if err != nil && err != io.EOF {
+		return false, err
+	} else if len(ciphertext) == 0 {
+		return false, nil
+	}
+
+	cipher, err := fsm.Cipher(regex, msgLen)
+	if err != nil {
+		return false, err
+	}
+
+	plaintext, remainder, err := cipher.Decrypt(ciphertext)
+	if err == fte.ErrShortCiphertext {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	var cell marionette.Cell
+	if err := cell.UnmarshalBinary(plaintext); err != nil {
+		return false, err
+	} else if cell.UUID != fsm.UUID() {
+		return false, marionette.ErrUUIDMismatch
+	} else if cell.Type != marionette.HEARTBEAT {
+		return true, nil
+	}
+
+	if _, err := conn.Seek(int64(len(ciphertext)-len(remainder)), io.SeekCurrent); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// toFloat64 converts an integer or float argument to a float64.
+func toFloat64(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,111 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/model"
+)
+
+func TestHeartbeatSend(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.UUIDFn = func() int { return 100 }
+		fsm.InstanceIDFn = func() int { return 200 }
+
+		var cipher mock.Cipher
+		cipher.EncryptFn = func(plaintext []byte) ([]byte, error) {
+			var cell marionette.Cell
+			if err := cell.UnmarshalBinary(plaintext); err != nil {
+				t.Fatal(err)
+			} else if cell.Type != marionette.HEARTBEAT {
+				t.Fatalf("unexpected cell type: %d", cell.Type)
+			} else if cell.UUID != 100 || cell.InstanceID != 200 {
+				t.Fatalf("unexpected ids: %d/%d", cell.UUID, cell.InstanceID)
+			}
+			return []byte("bar"), nil
+		}
+		fsm.CipherFn = func(regex string, n int) (marionette.Cipher, error) { return &cipher, nil }
+
+		var writeInvoked bool
+		conn.WriteFn = func(p []byte) (int, error) {
+			writeInvoked = true
+			if string(p) != "bar" {
+				t.Fatalf("unexpected write: %q", p)
+			}
+			return len(p), nil
+		}
+
+		if err := model.HeartbeatSend(context.Background(), &fsm, `([a-z]+)`, 128); err != nil {
+			t.Fatal(err)
+		} else if !writeInvoked {
+			t.Fatal("expected conn.Write()")
+		}
+	})
+
+	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		if err := model.HeartbeatSend(context.Background(), &fsm, `([a-z]+)`); err == nil || err.Error() != `not enough arguments` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+}
+
+func TestHeartbeatExpect(t *testing.T) {
+	// Ensure the FSM is torn down once the max missed heartbeats is reached.
+	t.Run("ErrHeartbeatTimeout", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		conn.ReadFn = func(p []byte) (int, error) { <-make(chan struct{}); return 0, nil }
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+
+		vars := map[string]interface{}{"model_heartbeat_time": time.Now().Add(-time.Minute)}
+		fsm.VarFn = func(key string) interface{} { return vars[key] }
+		fsm.SetVarFn = func(key string, value interface{}) { vars[key] = value }
+
+		if err := model.HeartbeatExpect(context.Background(), &fsm, `([a-z]+)`, 128, 1, 2); err != nil {
+			t.Fatal(err)
+		} else if vars["model_heartbeat_missed"] != 1 {
+			t.Fatalf("unexpected missed count: %v", vars["model_heartbeat_missed"])
+		}
+
+		vars["model_heartbeat_time"] = time.Now().Add(-time.Minute)
+		if err := model.HeartbeatExpect(context.Background(), &fsm, `([a-z]+)`, 128, 1, 2); err != model.ErrHeartbeatTimeout {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	// Ensure no heartbeat is counted as missed before the interval passes.
+	t.Run("WithinInterval", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		conn.ReadFn = func(p []byte) (int, error) { <-make(chan struct{}); return 0, nil }
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+
+		vars := make(map[string]interface{})
+		fsm.VarFn = func(key string) interface{} { return vars[key] }
+		fsm.SetVarFn = func(key string, value interface{}) { vars[key] = value }
+
+		if err := model.HeartbeatExpect(context.Background(), &fsm, `([a-z]+)`, 128, 5.0, 1); err != nil {
+			t.Fatal(err)
+		} else if vars["model_heartbeat_missed"] != nil {
+			t.Fatalf("unexpected missed count: %v", vars["model_heartbeat_missed"])
+		}
+	})
+
+	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+		if err := model.HeartbeatExpect(context.Background(), &fsm, `([a-z]+)`, 128, 5); err == nil || err.Error() != `not enough arguments` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+}
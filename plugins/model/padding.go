@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+func init() {
+	marionette.RegisterPlugin("model", "padding", Padding, marionette.PluginInfo{
+		Description: "Sets a padding machine which injects dummy cells & delays to obscure bursts.",
+		Args: []marionette.PluginArg{
+			{Name: "burst", Type: "string"},
+			{Name: "gap", Type: "string"},
+			{Name: "delay", Type: "string"},
+			{Name: "max_overhead", Type: "float"},
+		},
+	})
+}
+
+// Padding sets the padding machine used by fte.send for the FSM. The burst,
+// gap & delay distributions use the same format as model.sleep() and any
+// probability they don't cover is the chance of an infinite duration. An
+// empty distribution, "{}", is always infinite. The machine is kept if it is
+// already set so that a state may be revisited without losing its state.
+func Padding(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "model.padding"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 4 {
+		return errors.New("not enough arguments")
+	} else if fsm.Var(marionette.PaddingMachineVar) != nil {
+		return nil
+	}
+
+	var dists [3]marionette.PaddingDistribution
+	for i := range dists {
+		s, ok := args[i].(string)
+		if !ok {
+			return errors.New("invalid distribution argument type")
+		}
+		dist, err := parsePaddingDistribution(s)
+		if err != nil {
+			return err
+		}
+		dists[i] = dist
+	}
+	maxOverhead, ok := toFloat64(args[3])
+	if !ok {
+		return errors.New("invalid max_overhead argument type")
+	}
+
+	m := marionette.NewPaddingMachine(dists[0], dists[1], dists[2], rand.Int63())
+	m.MaxOverhead = maxOverhead
+	fsm.SetVar(marionette.PaddingMachineVar, m)
+
+	logger.Debug("padding machine set", zap.Duration("t", time.Since(t0)))
+
+	return nil
+}
+
+// parsePaddingDistribution parses a distribution in the format of
+// model.sleep(), which may be empty.
+func parsePaddingDistribution(s string) (marionette.PaddingDistribution, error) {
+	if strings.Trim(s, "{} \t\r\n") == "" {
+		return marionette.PaddingDistribution{}, nil
+	}
+	m, err := ParseSleepDistribution(s)
+	if err != nil {
+		return nil, err
+	}
+	return marionette.PaddingDistribution(m), nil
+}
@@ -0,0 +1,59 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/model"
+)
+
+func TestPadding(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+
+		var m *marionette.PaddingMachine
+		fsm.SetVarFn = func(key string, v interface{}) {
+			if key != marionette.PaddingMachineVar {
+				t.Fatalf("unexpected key: %s", key)
+			}
+			m = v.(*marionette.PaddingMachine)
+		}
+
+		if err := model.Padding(context.Background(), &fsm, "{'0.01': 0.9}", "{}", "{'0.005': 0.5}", 2); err != nil {
+			t.Fatal(err)
+		} else if m == nil {
+			t.Fatal("expected padding machine")
+		} else if diff := cmp.Diff(m.Burst, marionette.PaddingDistribution{0.01: 0.9}); diff != "" {
+			t.Fatal(diff)
+		} else if len(m.Gap) != 0 {
+			t.Fatalf("unexpected gap distribution: %v", m.Gap)
+		} else if diff := cmp.Diff(m.Delay, marionette.PaddingDistribution{0.005: 0.5}); diff != "" {
+			t.Fatal(diff)
+		} else if m.MaxOverhead != 2 {
+			t.Fatalf("unexpected max overhead: %v", m.MaxOverhead)
+		}
+	})
+
+	t.Run("ErrNotEnoughArguments", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		if err := model.Padding(context.Background(), &fsm, "{}"); err == nil || err.Error() != `not enough arguments` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+
+	t.Run("ErrInvalidArgument", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		if err := model.Padding(context.Background(), &fsm, "{}", 123, "{}", 0); err == nil || err.Error() != `invalid distribution argument type` {
+			t.Fatalf("unexpected error: %q", err)
+		}
+	})
+}
@@ -14,7 +14,12 @@ import (
 )
 
 func init() {
-	marionette.RegisterPlugin("model", "sleep", Sleep)
+	marionette.RegisterPlugin("model", "sleep", Sleep, marionette.PluginInfo{
+		Description: "Sleeps for a duration chosen from a distribution.",
+		Args: []marionette.PluginArg{
+			{Name: "distribution", Type: "string"},
+		},
+	})
 }
 
 // SleepFactor is the multiplier the sleep value is multipled by.
@@ -43,27 +48,36 @@ func Sleep(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 		return err
 	}
 
+	duration := time.Duration(ChooseValue(dist) * float64(time.Second) * SleepFactor)
+	time.Sleep(duration)
+
+	logger.Debug("sleep complete", zap.Duration("duration", duration), zap.Duration("t", time.Since(t0)))
+
+	return nil
+}
+
+// ChooseValue randomly chooses a value from a distribution of values to
+// probabilities. Returns zero if the distribution is empty.
+func ChooseValue(dist map[float64]float64) float64 {
+	return chooseValue(dist, rand.Float64())
+}
+
+// chooseValue chooses the value from dist at coin, between 0 & 1.
+func chooseValue(dist map[float64]float64, coin float64) float64 {
 	keys := make([]float64, 0, len(dist))
 	for k := range dist {
 		keys = append(keys, k)
 	}
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	sort.Float64s(keys)
 
-	sum, coin := float64(0), rand.Float64()
-	var k float64
+	var sum, k float64
 	for _, k = range keys {
 		sum += dist[k]
 		if sum >= coin {
 			break
 		}
 	}
-
-	duration := time.Duration(k * float64(time.Second) * SleepFactor)
-	time.Sleep(duration)
-
-	logger.Debug("sleep complete", zap.Duration("duration", duration), zap.Duration("t", time.Since(t0)))
-
-	return nil
+	return k
 }
 
 func ParseSleepDistribution(s string) (map[float64]float64, error) {
@@ -4,6 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sync"
+	"time"
 
 	"github.com/redjack/marionette"
 	"github.com/redjack/marionette/mar"
@@ -11,9 +15,27 @@ import (
 )
 
 func init() {
-	marionette.RegisterPlugin("model", "spawn", Spawn)
+	marionette.RegisterPlugin("model", "spawn", Spawn, marionette.PluginInfo{
+		Description: "Executes a child format a given number of times.",
+		Args: []marionette.PluginArg{
+			{Name: "format", Type: "string"},
+			{Name: "n", Type: "int|distribution"},
+			{Name: "delay", Type: "distribution", Optional: true},
+			{Name: "concurrency", Type: "int", Optional: true},
+		},
+	})
 }
 
+// MaxSpawnConcurrency is the hard limit on the number of child formats
+// executed at the same time by a single spawn.
+var MaxSpawnConcurrency = 8
+
+// Spawn executes a child format multiple times.
+//
+// The count may be an integer or a distribution to choose from. If a delay
+// distribution is specified then each launch waits for a randomly chosen
+// number of seconds so children don't start in identical bursts. Children run
+// sequentially unless a concurrency is specified.
 func Spawn(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 	logger := marionette.Logger.With(
 		zap.String("plugin", "model.spawn"),
@@ -30,11 +52,51 @@ func Spawn(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 		return errors.New("invalid format name argument type")
 	}
 
-	n, ok := args[1].(int)
-	if !ok {
+	var n int
+	switch arg := args[1].(type) {
+	case int:
+		n = arg
+	case string:
+		dist, err := ParseSleepDistribution(arg)
+		if err != nil {
+			return fmt.Errorf("invalid count distribution: %s", err)
+		}
+		// Both parties must spawn the same number of children so the count is
+		// chosen from the instance ID, which the server learns from the
+		// client's first message.
+		if fsm.InstanceID() == 0 {
+			return errors.New("count distribution requires instance id, spawn after the first message")
+		}
+		n = int(chooseValue(dist, spawnRand(fsm).Float64()))
+	default:
 		return errors.New("invalid count argument type")
 	}
 
+	var delay map[float64]float64
+	if len(args) > 2 {
+		s, ok := args[2].(string)
+		if !ok {
+			return errors.New("invalid delay argument type")
+		}
+		dist, err := ParseSleepDistribution(s)
+		if err != nil {
+			return fmt.Errorf("invalid delay distribution: %s", err)
+		}
+		delay = dist
+	}
+
+	concurrency := 1
+	if len(args) > 3 {
+		if concurrency, ok = args[3].(int); !ok {
+			return errors.New("invalid concurrency argument type")
+		} else if concurrency < 1 {
+			return errors.New("concurrency must be greater than zero")
+		}
+	}
+	if concurrency > MaxSpawnConcurrency {
+		concurrency = MaxSpawnConcurrency
+	}
+
 	// Find & parse format.
 	data := mar.Format(formatName, "")
 	if len(data) == 0 {
@@ -48,18 +110,75 @@ func Spawn(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 	}
 	doc.Format = formatName
 
-	// Execute a sub-FSM multiple times.
+	// Execute a sub-FSM multiple times, limited by the concurrency.
+	var wg sync.WaitGroup
+	var once sync.Once
+	var childErr error
+	done := make(chan struct{})
+	sem := make(chan struct{}, concurrency)
+
+	logger.Debug("spawning", zap.Int("n", n), zap.Int("concurrency", concurrency))
+
+loop:
 	for i := 0; i < n; i++ {
-		logger.Debug("spawn begin", zap.Int("i", i))
-		child := fsm.Clone(doc)
-		if err := child.Execute(context.TODO()); err != nil {
-			logger.Error("child execution failed", zap.Error(err))
-			child.Reset()
-			return err
+		// Wait for a randomly chosen time before launching.
+		if d := time.Duration(ChooseValue(delay) * float64(time.Second) * SleepFactor); d > 0 {
+			select {
+			case <-time.After(d):
+			case <-done:
+				break loop
+			case <-ctx.Done():
+				break loop
+			}
+		}
+
+		// Wait for a free slot.
+		select {
+		case sem <- struct{}{}:
+		case <-done:
+			break loop
+		case <-ctx.Done():
+			break loop
+		}
+
+		// Stop if a child failed while waiting for the slot.
+		select {
+		case <-done:
+			<-sem
+			break loop
+		default:
 		}
-		child.Reset()
-		logger.Debug("spawn end", zap.Int("i", i))
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			logger.Debug("spawn begin", zap.Int("i", i))
+			child := fsm.Clone(doc)
+			defer child.Reset()
+
+			if err := child.Execute(context.TODO()); err != nil {
+				logger.Error("child execution failed", zap.Error(err))
+				once.Do(func() { childErr = err; close(done) })
+				return
+			}
+			logger.Debug("spawn end", zap.Int("i", i))
+		}(i)
 	}
+	wg.Wait()
+
+	if childErr != nil {
+		return childErr
+	}
+	return ctx.Err()
+}
 
-	return nil
+// spawnRand returns a PRNG which both parties seed from the instance ID and
+// the FSM's state & step. It is separate from the FSM's PRNG, which chooses
+// transitions, so that spawning does not change the transitions chosen.
+func spawnRand(fsm marionette.FSM) *rand.Rand {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d:%s:%d", fsm.InstanceID(), fsm.State(), fsm.Step())
+	return rand.New(rand.NewSource(int64(h.Sum64())))
 }
@@ -2,7 +2,16 @@ package model_test
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/redjack/marionette"
 	"github.com/redjack/marionette/mar"
@@ -11,10 +20,12 @@ import (
 	"go.uber.org/zap"
 )
 
-func init() {
+func TestMain(m *testing.M) {
+	flag.Parse()
 	if !testing.Verbose() {
 		marionette.Logger = zap.NewNop()
 	}
+	os.Exit(m.Run())
 }
 
 func TestSpawn(t *testing.T) {
@@ -69,9 +80,223 @@ func TestSpawn(t *testing.T) {
 			conn := mock.DefaultConn()
 			fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
 			fsm.PartyFn = func() string { return marionette.PartyClient }
-			if err := model.Spawn(context.Background(), &fsm, "fmt", "xyz"); err == nil || err.Error() != `invalid count argument type` {
+			if err := model.Spawn(context.Background(), &fsm, "fmt", 1.5); err == nil || err.Error() != `invalid count argument type` {
+				t.Fatalf("unexpected error: %q", err)
+			}
+		})
+
+		t.Run("delay", func(t *testing.T) {
+			conn := mock.DefaultConn()
+			fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+			fsm.PartyFn = func() string { return marionette.PartyClient }
+			if err := model.Spawn(context.Background(), &fsm, "fmt", 1, 2); err == nil || err.Error() != `invalid delay argument type` {
+				t.Fatalf("unexpected error: %q", err)
+			}
+		})
+
+		t.Run("concurrency", func(t *testing.T) {
+			conn := mock.DefaultConn()
+			fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+			fsm.PartyFn = func() string { return marionette.PartyClient }
+			if err := model.Spawn(context.Background(), &fsm, "fmt", 1, "{'0.1': 1.0}", 0); err == nil || err.Error() != `concurrency must be greater than zero` {
 				t.Fatalf("unexpected error: %q", err)
 			}
 		})
 	})
+
+	// Ensure the count can be chosen from a distribution.
+	t.Run("CountDistribution", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+		fsm.InstanceIDFn = func() int { return 1 }
+
+		var executeN int
+		fsm.CloneFn = func(doc *mar.Document) marionette.FSM {
+			return &mock.FSM{
+				ExecuteFn: func(ctx context.Context) error { executeN++; return nil },
+				ResetFn:   func() {},
+			}
+		}
+
+		if err := model.Spawn(context.Background(), &fsm, "ftp_pasv_transfer", "{'3': 1.0}"); err != nil {
+			t.Fatal(err)
+		} else if executeN != 3 {
+			t.Fatalf("unexpected execution count: %d", executeN)
+		}
+	})
+
+	// Ensure the server cannot choose a count before it knows the instance ID.
+	t.Run("ErrInstanceIDRequired", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyServer }
+		fsm.InstanceIDFn = func() int { return 0 }
+		if err := model.Spawn(context.Background(), &fsm, "ftp_pasv_transfer", "{'3': 1.0}"); err == nil || err.Error() != `count distribution requires instance id, spawn after the first message` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	// Ensure a client & server executing the same format spawn the same
+	// number of children.
+	t.Run("CountDistributionPeers", func(t *testing.T) {
+		clientDoc := mar.MustParse(marionette.PartyClient, []byte(spawnTestDoc))
+		serverDoc := mar.MustParse(marionette.PartyServer, []byte(spawnTestDoc))
+		counts := make(map[int]bool)
+		for i := 0; i < 20; i++ {
+			clientConn, serverConn := net.Pipe()
+			client := marionette.NewFSM(clientDoc, "127.0.0.1", marionette.PartyClient, clientConn, marionette.NewStreamSet())
+			server := marionette.NewFSM(serverDoc, "127.0.0.1", marionette.PartyServer, serverConn, marionette.NewStreamSet())
+
+			errs := make(chan error, 2)
+			go func() { errs <- client.Execute(context.Background()) }()
+			go func() { errs <- server.Execute(context.Background()) }()
+			for j := 0; j < 2; j++ {
+				if err := <-errs; err != nil {
+					t.Fatal(err)
+				}
+			}
+			client.Close()
+			server.Close()
+
+			clientN, serverN := client.Var("spawn_n"), server.Var("spawn_n")
+			if clientN != serverN {
+				t.Fatalf("instance %d: client spawned %v, server spawned %v", client.InstanceID(), clientN, serverN)
+			}
+			counts[clientN.(int)] = true
+		}
+
+		// Ensure the count is chosen from the distribution, not fixed.
+		if len(counts) < 2 {
+			t.Fatalf("expected different counts: %v", counts)
+		}
+	})
+
+	// Ensure children run concurrently but never exceed the limit.
+	t.Run("Concurrency", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+
+		var mu sync.Mutex
+		var executeN, running, maxRunning int
+		fsm.CloneFn = func(doc *mar.Document) marionette.FSM {
+			return &mock.FSM{
+				ExecuteFn: func(ctx context.Context) error {
+					mu.Lock()
+					executeN, running = executeN+1, running+1
+					if running > maxRunning {
+						maxRunning = running
+					}
+					mu.Unlock()
+
+					time.Sleep(5 * time.Millisecond)
+
+					mu.Lock()
+					running--
+					mu.Unlock()
+					return nil
+				},
+				ResetFn: func() {},
+			}
+		}
+
+		if err := model.Spawn(context.Background(), &fsm, "ftp_pasv_transfer", 6, "{'0.001': 1.0}", 2); err != nil {
+			t.Fatal(err)
+		} else if executeN != 6 {
+			t.Fatalf("unexpected execution count: %d", executeN)
+		} else if maxRunning != 2 {
+			t.Fatalf("unexpected max concurrency: %d", maxRunning)
+		}
+	})
+
+	t.Run("ErrExecute", func(t *testing.T) {
+		conn := mock.DefaultConn()
+		fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+		fsm.PartyFn = func() string { return marionette.PartyClient }
+
+		errMarker := errors.New("marker")
+		var executeN int
+		fsm.CloneFn = func(doc *mar.Document) marionette.FSM {
+			return &mock.FSM{
+				ExecuteFn: func(ctx context.Context) error { executeN++; return errMarker },
+				ResetFn:   func() {},
+			}
+		}
+
+		if err := model.Spawn(context.Background(), &fsm, "ftp_pasv_transfer", 5); err != errMarker {
+			t.Fatalf("unexpected error: %v", err)
+		} else if executeN != 1 {
+			t.Fatalf("unexpected execution count: %d", executeN)
+		}
+	})
+}
+
+func init() {
+	marionette.RegisterPlugin("spawntest", "send", spawnTestSend)
+	marionette.RegisterPlugin("spawntest", "recv", spawnTestRecv)
+	marionette.RegisterPlugin("spawntest", "spawn", spawnTestSpawn)
+}
+
+// spawnTestDoc spawns a number of children, chosen from a distribution, once
+// the server has received the client's instance ID.
+const spawnTestDoc = `
+connection(tcp, 8080):
+  start   greeted NULL  1.0
+  greeted spawned hello 1.0
+  spawned dead    spawn 1.0
+
+action hello:
+  client spawntest.send()
+  server spawntest.recv()
+
+action spawn:
+  client spawntest.spawn("ftp_pasv_transfer", "{'1': 0.25, '2': 0.25, '3': 0.25, '4': 0.25}")
+  server spawntest.spawn("ftp_pasv_transfer", "{'1': 0.25, '2': 0.25, '3': 0.25, '4': 0.25}")
+`
+
+// spawnTestSend writes the instance ID to the peer.
+func spawnTestSend(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(fsm.InstanceID()))
+	_, err := fsm.Conn().Write(buf[:])
+	return err
+}
+
+// spawnTestRecv adopts the instance ID written by spawnTestSend.
+func spawnTestRecv(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	buf, err := fsm.Conn().Peek(4, true)
+	if err != nil {
+		return err
+	}
+	if fsm.InstanceID() == 0 {
+		fsm.SetInstanceID(int(binary.BigEndian.Uint32(buf)))
+		return marionette.ErrRetryTransition
+	}
+	_, err = fsm.Conn().Seek(4, io.SeekCurrent)
+	return err
+}
+
+// spawnTestSpawn spawns children which return immediately and sets the
+// "spawn_n" variable to the number executed.
+func spawnTestSpawn(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	counter := &spawnCountFSM{FSM: fsm}
+	if err := model.Spawn(ctx, counter, args...); err != nil {
+		return err
+	}
+	fsm.SetVar("spawn_n", int(atomic.LoadInt32(&counter.n)))
+	return nil
+}
+
+// spawnCountFSM counts the executions of its children.
+type spawnCountFSM struct {
+	marionette.FSM
+	n int32
+}
+
+func (f *spawnCountFSM) Clone(doc *mar.Document) marionette.FSM {
+	return &mock.FSM{
+		ExecuteFn: func(ctx context.Context) error { atomic.AddInt32(&f.n, 1); return nil },
+		ResetFn:   func() {},
+	}
 }
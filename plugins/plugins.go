@@ -2,8 +2,12 @@ package plugins
 
 import (
 	_ "github.com/redjack/marionette/plugins/channel"
+	_ "github.com/redjack/marionette/plugins/cover"
+	_ "github.com/redjack/marionette/plugins/decoy"
+	_ "github.com/redjack/marionette/plugins/extern"
 	_ "github.com/redjack/marionette/plugins/fte"
 	_ "github.com/redjack/marionette/plugins/io"
 	_ "github.com/redjack/marionette/plugins/model"
+	_ "github.com/redjack/marionette/plugins/random"
 	_ "github.com/redjack/marionette/plugins/tg"
 )
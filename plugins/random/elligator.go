@@ -0,0 +1,133 @@
+package random
+
+import (
+	"crypto/ecdh"
+	crand "crypto/rand"
+	"encoding/hex"
+
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
+)
+
+// curveA is the A coefficient of Curve25519, v² = u³ + Au² + u.
+var curveA = new(field.Element).Mult32(new(field.Element).One(), 486662)
+
+// lowOrderPoint generates the 8-torsion subgroup of edwards25519.
+var lowOrderPoint = mustPoint("26e8958fc2b227b045c3f489f2ef98f0d5dfac05d3c63339b13802886d53fc05")
+
+// generateKey returns an X25519 private key, its public key & the Elligator2
+// representative which is sent in place of the public key.
+//
+// Only about half of all public keys have a representative so keys are
+// generated until one does. A random low order point is added to the public
+// key, which the peer's clamped scalar removes again, as otherwise every
+// representative would decode to a point in the prime order subgroup.
+func generateKey() (*ecdh.PrivateKey, *ecdh.PublicKey, []byte, error) {
+	var seed, b [32]byte
+	for {
+		if _, err := crand.Read(seed[:]); err != nil {
+			return nil, nil, nil, err
+		} else if _, err := crand.Read(b[:1]); err != nil {
+			return nil, nil, nil, err
+		}
+
+		s, err := edwards25519.NewScalar().SetBytesWithClamping(seed[:])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		p := new(edwards25519.Point).ScalarBaseMult(s)
+		for i := byte(0); i < b[0]&7; i++ {
+			p.Add(p, lowOrderPoint)
+		}
+
+		u, err := new(field.Element).SetBytes(p.BytesMontgomery())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		repr, ok := representative(u, b[0]&0x08 != 0, b[0]&0x10 != 0)
+		if !ok {
+			continue
+		}
+
+		priv, err := ecdh.X25519().NewPrivateKey(seed[:])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		pub, err := ecdh.X25519().NewPublicKey(u.Bytes())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		// The top bit is never set by a field element so set it at random.
+		buf := repr.Bytes()
+		buf[PublicKeySize-1] |= b[0] & 0x80
+		return priv, pub, buf, nil
+	}
+}
+
+// representative returns a field element r which the Elligator2 map sends to
+// the u-coordinate u, if there is one. Each u has four representatives, ±r for
+// each of the two branches of the map, & alt and neg choose between them.
+func representative(u *field.Element, alt, neg bool) (*field.Element, bool) {
+	var zero field.Element
+	uA := new(field.Element).Add(u, curveA)
+	if u.Equal(&zero) == 1 || uA.Equal(&zero) == 1 {
+		return nil, false
+	}
+
+	// r² = -u / 2(u+A) or r² = -(u+A) / 2u
+	num, den := u, uA
+	if alt {
+		num, den = uA, u
+	}
+	num = new(field.Element).Negate(num)
+	den = new(field.Element).Add(den, den)
+
+	r, wasSquare := new(field.Element).SqrtRatio(num, den)
+	if wasSquare == 0 {
+		return nil, false
+	} else if neg {
+		r.Negate(r)
+	}
+	return r, true
+}
+
+// publicKeyFromRepresentative returns the public key which buf represents.
+func publicKeyFromRepresentative(buf []byte) (*ecdh.PublicKey, error) {
+	b := make([]byte, PublicKeySize)
+	copy(b, buf)
+	b[PublicKeySize-1] &= 0x7f
+	r, err := new(field.Element).SetBytes(b)
+	if err != nil {
+		return nil, err
+	}
+
+	// w = -A / (1 + 2r²)
+	one := new(field.Element).One()
+	d := new(field.Element).Square(r)
+	d.Add(d, d).Add(d, one)
+	w := new(field.Element).Invert(d)
+	w.Multiply(w, curveA).Negate(w)
+
+	// u = w if w³ + Aw² + w is square, otherwise u = -w - A.
+	e := new(field.Element).Add(w, curveA)
+	e.Multiply(e, w).Add(e, one).Multiply(e, w)
+	_, wasSquare := new(field.Element).SqrtRatio(e, one)
+	u := new(field.Element).Add(w, curveA)
+	u.Negate(u)
+	u.Select(w, u, wasSquare)
+
+	return ecdh.X25519().NewPublicKey(u.Bytes())
+}
+
+func mustPoint(s string) *edwards25519.Point {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	p, err := new(edwards25519.Point).SetBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
@@ -0,0 +1,62 @@
+package random
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Ensure each representative decodes to its public key & the peer's shared
+// secret is unaffected by the low order point added to it.
+func TestGenerateKey(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		priv, pub, repr, err := generateKey()
+		if err != nil {
+			t.Fatal(err)
+		} else if len(repr) != PublicKeySize {
+			t.Fatalf("unexpected representative size: %d", len(repr))
+		}
+
+		if key, err := publicKeyFromRepresentative(repr); err != nil {
+			t.Fatal(err)
+		} else if !key.Equal(pub) {
+			t.Fatalf("representative %x decoded to %x, expected %x", repr, key.Bytes(), pub.Bytes())
+		}
+
+		peer, _, _, err := generateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exp, err := peer.ECDH(priv.PublicKey()); err != nil {
+			t.Fatal(err)
+		} else if got, err := peer.ECDH(pub); err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(got, exp) {
+			t.Fatal("shared secret mismatch")
+		}
+	}
+}
+
+// Ensure the bits of a representative which a public key leaves fixed vary.
+func TestGenerateKey_Bits(t *testing.T) {
+	var top, low, torsion [2]bool
+	for i := 0; i < 100; i++ {
+		priv, pub, repr, err := generateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		top[repr[PublicKeySize-1]>>7] = true
+		low[repr[0]&1] = true
+		if pub.Equal(priv.PublicKey()) {
+			torsion[0] = true
+		} else {
+			torsion[1] = true
+		}
+	}
+	if top != [2]bool{true, true} {
+		t.Fatal("expected top bit to vary")
+	} else if low != [2]bool{true, true} {
+		t.Fatal("expected sign to vary")
+	} else if torsion != [2]bool{true, true} {
+		t.Fatal("expected low order point to vary")
+	}
+}
@@ -0,0 +1,228 @@
+package random
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+func init() {
+	marionette.RegisterPlugin("random", "handshake", Handshake, marionette.PluginInfo{
+		Description: "Exchanges the ephemeral keys which key the randomized format's frames.",
+	})
+}
+
+// PublicKeySize is the size, in bytes, of the representative of the
+// ephemeral public key sent by each party.
+const PublicKeySize = 32
+
+// frameHeaderSize is the size of a frame's masked length.
+const frameHeaderSize = 2
+
+// keyLabel separates the keys of randomized frames from other uses of a secret.
+const keyLabel = "marionette randomized v1"
+
+// stateVar is the FSM variable holding the keys of the current seed.
+const stateVar = "random_state"
+
+// ErrNoHandshake is returned when sending or receiving before random.handshake.
+var ErrNoHandshake = errors.New("random: handshake not complete")
+
+// state holds the keys for frames sent & received by one party.
+type state struct {
+	send, recv *direction
+}
+
+// direction encrypts or decrypts the frames sent by one party. Each frame is
+// its length, masked so that it is indistinguishable from random bytes,
+// followed by the sealed cell.
+type direction struct {
+	aead cipher.AEAD
+	mask cipher.Block
+	n    uint64 // frames sent or received so far
+}
+
+// newDirection returns the keys for frames sent by party after a handshake
+// which agreed on secret.
+func newDirection(secret []byte, party string) (*direction, error) {
+	aead, err := newAEAD(deriveKey(secret, party+" data"))
+	if err != nil {
+		return nil, err
+	}
+	mask, err := aes.NewCipher(deriveKey(secret, party+" length"))
+	if err != nil {
+		return nil, err
+	}
+	return &direction{aead: aead, mask: mask}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// deriveKey returns the key for label from secret.
+func deriveKey(secret []byte, label string) []byte {
+	h := hmac.New(sha256.New, []byte(keyLabel))
+	h.Write([]byte(label))
+	h.Write(secret)
+	return h.Sum(nil)
+}
+
+// nonce returns the nonce of the next frame.
+func (d *direction) nonce() []byte {
+	nonce := make([]byte, d.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], d.n)
+	return nonce
+}
+
+// lengthMask returns the mask of the next frame's length.
+func (d *direction) lengthMask() []byte {
+	var block [aes.BlockSize]byte
+	binary.BigEndian.PutUint64(block[:], d.n)
+	d.mask.Encrypt(block[:], block[:])
+	return block[:frameHeaderSize]
+}
+
+// seal returns plaintext as the next frame.
+func (d *direction) seal(plaintext []byte) []byte {
+	sealed := d.aead.Seal(nil, d.nonce(), plaintext, nil)
+
+	frame := make([]byte, frameHeaderSize, frameHeaderSize+len(sealed))
+	binary.BigEndian.PutUint16(frame, uint16(len(sealed)))
+	for i, b := range d.lengthMask() {
+		frame[i] ^= b
+	}
+	d.n++
+	return append(frame, sealed...)
+}
+
+// frameSize returns the size of the next frame from its header.
+func (d *direction) frameSize(hdr []byte) int {
+	var buf [frameHeaderSize]byte
+	copy(buf[:], hdr)
+	for i, b := range d.lengthMask() {
+		buf[i] ^= b
+	}
+	return frameHeaderSize + int(binary.BigEndian.Uint16(buf[:]))
+}
+
+// open returns the plaintext of the next frame. The frame is not consumed
+// until next() is called so that it may be opened again on retry.
+func (d *direction) open(frame []byte) ([]byte, error) {
+	return d.aead.Open(nil, d.nonce(), frame[frameHeaderSize:], nil)
+}
+
+// next moves on to the next frame once one has been consumed.
+func (d *direction) next() { d.n++ }
+
+// Handshake begins a new cycle of the randomized format. Each party sends an
+// ephemeral X25519 public key, Elligator2 encoded so that it looks like random
+// bytes, and the keys of both parties' frames are derived from the key
+// exchange, so they cannot be derived from the wire. If both parties know the
+// bridge secret then it is mixed in as well, which keeps out an active attacker
+// who doesn't know it.
+func Handshake(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "random.handshake"),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	priv, pub, repr, err := generateKey()
+	if err != nil {
+		return err
+	}
+
+	// The client sends its key first and the server replies with its own.
+	var peer *ecdh.PublicKey
+	if fsm.Party() == marionette.PartyClient {
+		if _, err := fsm.Conn().Write(repr); err != nil {
+			return err
+		} else if peer, err = readPublicKey(fsm.Conn()); err != nil {
+			return err
+		}
+	} else {
+		if peer, err = readPublicKey(fsm.Conn()); err != nil {
+			return err
+		} else if _, err := fsm.Conn().Write(repr); err != nil {
+			return err
+		}
+	}
+
+	// Low order public keys are rejected here, so a peer cannot force a
+	// known shared secret.
+	shared, err := priv.ECDH(peer)
+	if err != nil {
+		return err
+	}
+
+	// Bind the shared secret to both public keys & the bridge secret, if any.
+	clientKey, serverKey := pub, peer
+	if fsm.Party() == marionette.PartyServer {
+		clientKey, serverKey = peer, pub
+	}
+	secret := append(shared, clientKey.Bytes()...)
+	secret = append(secret, serverKey.Bytes()...)
+	secret = append(secret, fsm.StreamSet().SharedSecret()...)
+
+	client, err := newDirection(secret, marionette.PartyClient)
+	if err != nil {
+		return err
+	}
+	server, err := newDirection(secret, marionette.PartyServer)
+	if err != nil {
+		return err
+	}
+	if fsm.Party() == marionette.PartyClient {
+		fsm.SetVar(stateVar, &state{send: client, recv: server})
+	} else {
+		fsm.SetVar(stateVar, &state{send: server, recv: client})
+	}
+
+	logger.Debug("handshake complete", zap.Duration("t", time.Since(t0)))
+	return nil
+}
+
+// readPublicKey reads the representative of the other party's public key
+// from conn.
+func readPublicKey(conn *marionette.BufferedConn) (*ecdh.PublicKey, error) {
+	buf, err := conn.Peek(PublicKeySize, true)
+	if err == io.EOF && len(buf) > 0 {
+		return nil, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return nil, err
+	}
+
+	key, err := publicKeyFromRepresentative(buf)
+	if err != nil {
+		return nil, err
+	} else if _, err := conn.Seek(PublicKeySize, io.SeekCurrent); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// fsmState returns the keys set by Handshake.
+func fsmState(fsm marionette.FSM) (*state, error) {
+	st, ok := fsm.Var(stateVar).(*state)
+	if !ok {
+		return nil, ErrNoHandshake
+	}
+	return st, nil
+}
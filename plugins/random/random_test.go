@@ -0,0 +1,167 @@
+package random_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/random"
+)
+
+// Ensure streams are carried by the randomized format.
+func TestRandomized(t *testing.T) {
+	data := mar.Format("randomized", "")
+	if data == nil {
+		t.Fatal("randomized format not found")
+	}
+	dialer, ln := marionette.Pipe(
+		mar.MustParse(marionette.PartyClient, data),
+		mar.MustParse(marionette.PartyServer, data),
+	)
+	defer ln.Close()
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientStream.Close()
+	if _, err := clientStream.Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverStream.Close()
+	mustRead(t, serverStream, "foo")
+
+	if _, err := serverStream.Write([]byte("bar")); err != nil {
+		t.Fatal(err)
+	}
+	mustRead(t, clientStream, "bar")
+}
+
+// Ensure streams are carried when the keys are mixed with the bridge secret.
+func TestRandomized_AuthSecret(t *testing.T) {
+	secret, err := marionette.NewAuthSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := mar.Format("randomized", "")
+	dialer, ln := marionette.Pipe(
+		mar.MustParse(marionette.PartyClient, data),
+		mar.MustParse(marionette.PartyServer, data),
+		marionette.WithAuthSecret(secret),
+	)
+	defer ln.Close()
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientStream.Close()
+	if _, err := clientStream.Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverStream.Close()
+	mustRead(t, serverStream, "foo")
+}
+
+// Ensure the client's bytes on the wire cannot be opened by anyone running
+// the handshake again with them, as a censor who records a connection would.
+func TestHandshake_Wire(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	// Record everything the client writes.
+	var wire bytes.Buffer
+	conn := mock.DefaultConn()
+	conn.ReadFn = clientConn.Read
+	conn.WriteFn = func(p []byte) (int, error) {
+		wire.Write(p)
+		return clientConn.Write(p)
+	}
+	client := newFSM(&conn, marionette.PartyClient)
+	server := newFSM(serverConn, marionette.PartyServer)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := random.Handshake(context.Background(), &server); err != nil {
+			t.Error(err)
+		} else if err := random.Recv(context.Background(), &server); err != nil {
+			t.Error(err)
+		}
+	}()
+	if err := random.Handshake(context.Background(), &client); err != nil {
+		t.Fatal(err)
+	} else if err := random.Send(context.Background(), &client, 64, 128); err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+
+	// Replay the recorded bytes to a new server.
+	replay := mock.DefaultConn()
+	replay.ReadFn = bytes.NewReader(wire.Bytes()).Read
+	replay.WriteFn = func(p []byte) (int, error) { return len(p), nil }
+	observer := newFSM(&replay, marionette.PartyServer)
+	if err := random.Handshake(context.Background(), &observer); err != nil {
+		t.Fatal(err)
+	} else if err := random.Recv(context.Background(), &observer); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// Ensure sending before the handshake returns an error.
+func TestSend_ErrNoHandshake(t *testing.T) {
+	conn := mock.DefaultConn()
+	fsm := mock.NewFSM(&conn, marionette.NewStreamSet())
+	fsm.PartyFn = func() string { return marionette.PartyClient }
+	if err := random.Send(context.Background(), &fsm, 64, 128); err != random.ErrNoHandshake {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func mustRead(tb testing.TB, r io.Reader, exp string) {
+	tb.Helper()
+	buf := make([]byte, len(exp))
+	if _, err := io.ReadFull(r, buf); err != nil {
+		tb.Fatal(err)
+	} else if string(buf) != exp {
+		tb.Fatalf("unexpected data: %q", buf)
+	}
+}
+
+// newFSM returns a mock FSM for party which stores its variables.
+func newFSM(conn net.Conn, party string) mock.FSM {
+	vars := make(map[string]interface{})
+	fsm := mock.NewFSM(conn, marionette.NewStreamSet())
+	fsm.PartyFn = func() string { return party }
+	fsm.UUIDFn = func() int { return 100 }
+	fsm.InstanceIDFn = func() int { return 200 }
+	fsm.VarFn = func(key string) interface{} { return vars[key] }
+	fsm.SetVarFn = func(key string, value interface{}) { vars[key] = value }
+	return fsm
+}
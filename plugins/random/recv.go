@@ -0,0 +1,112 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+func init() {
+	marionette.RegisterPlugin("random", "recv", Recv, marionette.PluginInfo{
+		Description: "Receives a cell sent by random.send.",
+	})
+	marionette.RegisterPlugin("random", "recv_async", RecvAsync, marionette.PluginInfo{
+		Description: "Receives a cell sent by random.send, if available.",
+	})
+}
+
+// Recv receives the next frame.
+func Recv(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	return recv(ctx, fsm, true)
+}
+
+// RecvAsync receives the next frame without blocking.
+func RecvAsync(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	return recv(ctx, fsm, false)
+}
+
+func recv(ctx context.Context, fsm marionette.FSM, blocking bool) error {
+	t0 := time.Now()
+
+	logger := fsm.Logger().With(
+		zap.String("plugin", "random.recv"),
+		zap.String("state", fsm.State()),
+	)
+
+	st, err := fsmState(fsm)
+	if err != nil {
+		return err
+	}
+
+	// Read the masked length and then the whole frame.
+	conn := fsm.Conn()
+	hdr, err := conn.Peek(frameHeaderSize, blocking)
+	if err == io.EOF && len(hdr) > 0 {
+		return io.ErrUnexpectedEOF
+	} else if err != nil && (blocking || err != io.EOF) {
+		return err
+	} else if len(hdr) < frameHeaderSize {
+		return nil
+	}
+
+	size := st.recv.frameSize(hdr)
+	if size > frameHeaderSize+marionette.MaxCellLength+st.recv.aead.Overhead() {
+		return fmt.Errorf("random: frame too large: %d bytes", size)
+	}
+	frame, err := conn.Peek(size, blocking)
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	} else if err != nil {
+		return err
+	} else if len(frame) < size {
+		return nil
+	}
+
+	plaintext, err := st.recv.open(frame)
+	if err != nil {
+		logger.Error("cannot open frame", zap.Error(err))
+		return err
+	}
+
+	var cell marionette.Cell
+	if err := cell.UnmarshalBinary(plaintext); err != nil {
+		logger.Error("cannot unmarshal cell", zap.Error(err))
+		return err
+	}
+
+	// Validate that the FSM & cell document UUIDs match.
+	if fsm.UUID() != cell.UUID {
+		logger.Error("uuid mismatch", zap.Int("local", fsm.UUID()), zap.Int("remote", cell.UUID))
+		return marionette.ErrUUIDMismatch
+	}
+
+	// Set instance ID if it hasn't been set yet. The frame is opened again
+	// when the transition is retried.
+	if fsm.InstanceID() == 0 {
+		fsm.SetInstanceID(cell.InstanceID)
+		return marionette.ErrRetryTransition
+	} else if cell.InstanceID != 0 && fsm.InstanceID() != cell.InstanceID {
+		logger.Error("instance id mismatch", zap.Int("local", fsm.InstanceID()), zap.Int("remote", cell.InstanceID))
+		return fmt.Errorf("instance id mismatch: fsm=%d, cell=%d", fsm.InstanceID(), cell.InstanceID)
+	}
+
+	if err := fsm.StreamSet().Enqueue(&cell); err != nil {
+		logger.Error("cannot enqueue cell", zap.Error(err))
+		return err
+	}
+	if _, err := conn.Seek(int64(size), io.SeekCurrent); err != nil {
+		return err
+	}
+	st.recv.next()
+
+	logger.Debug("msg received",
+		zap.Int("plaintext", len(cell.Payload)),
+		zap.Int("frame", size),
+		zap.Duration("t", time.Since(t0)),
+	)
+	return nil
+}
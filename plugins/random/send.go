@@ -0,0 +1,102 @@
+package random
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+func init() {
+	marionette.RegisterPlugin("random", "send", Send, marionette.PluginInfo{
+		Description: "Sends a cell as a uniformly random frame of random length.",
+		Args: []marionette.PluginArg{
+			{Name: "min_len", Type: "int"},
+			{Name: "max_len", Type: "int"},
+		},
+	})
+	marionette.RegisterPlugin("random", "send_async", SendAsync, marionette.PluginInfo{
+		Description: "Sends a cell as a uniformly random frame of random length, if data is available.",
+		Args: []marionette.PluginArg{
+			{Name: "min_len", Type: "int"},
+			{Name: "max_len", Type: "int"},
+		},
+	})
+}
+
+// Send sends the next cell, or an empty cell, as a frame.
+func Send(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	return send(ctx, fsm, args, true)
+}
+
+// SendAsync sends the next cell as a frame if one is available.
+func SendAsync(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
+	return send(ctx, fsm, args, false)
+}
+
+func send(ctx context.Context, fsm marionette.FSM, args []interface{}, blocking bool) error {
+	t0 := time.Now()
+
+	logger := marionette.Logger.With(
+		zap.String("plugin", "random.send"),
+		zap.Bool("blocking", blocking),
+		zap.String("party", fsm.Party()),
+		zap.String("state", fsm.State()),
+	)
+
+	if len(args) < 2 {
+		return errors.New("not enough arguments")
+	}
+	minLen, ok := args[0].(int)
+	if !ok {
+		return errors.New("invalid min_len argument type")
+	}
+	maxLen, ok := args[1].(int)
+	if !ok {
+		return errors.New("invalid max_len argument type")
+	}
+	if minLen < marionette.CellHeaderSize {
+		minLen = marionette.CellHeaderSize
+	}
+	if maxLen > marionette.MaxCellLength {
+		maxLen = marionette.MaxCellLength
+	}
+	if maxLen < minLen {
+		return errors.New("max_len must not be less than min_len")
+	}
+
+	st, err := fsmState(fsm)
+	if err != nil {
+		return err
+	}
+
+	// Choose the cell's marshaled length uniformly so frame lengths reveal
+	// nothing about the data, then fill it from the stream set.
+	n := minLen + rand.Intn(maxLen-minLen+1)
+	cell := fsm.StreamSet().Dequeue(n)
+	if cell == nil && blocking {
+		cell = marionette.NewCell(0, 0, n, marionette.NORMAL)
+	} else if cell == nil {
+		return nil
+	}
+	cell.UUID, cell.InstanceID = fsm.UUID(), fsm.InstanceID()
+
+	plaintext, err := cell.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	frame := st.send.seal(plaintext)
+	if _, err := fsm.Conn().Write(frame); err != nil {
+		return err
+	}
+
+	logger.Debug("msg sent",
+		zap.Int("plaintext", len(cell.Payload)),
+		zap.Int("frame", len(frame)),
+		zap.Duration("t", time.Since(t0)),
+	)
+	return nil
+}
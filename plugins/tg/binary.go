@@ -0,0 +1,41 @@
+package tg
+
+import (
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/fte"
+)
+
+// BinaryCipher carries an encrypted cell as raw bytes. It is used as is by
+// binary protocols or wrapped by NewEncodedCipher() to embed cells in text.
+type BinaryCipher struct {
+	key      string
+	capacity int
+}
+
+func NewBinaryCipher(key string, capacity int) *BinaryCipher {
+	return &BinaryCipher{key: key, capacity: capacity}
+}
+
+func (c *BinaryCipher) Key() string {
+	return c.key
+}
+
+func (c *BinaryCipher) Capacity(fsm marionette.FSM) (int, error) {
+	return c.capacity, nil
+}
+
+func (c *BinaryCipher) Encrypt(fsm marionette.FSM, template string, plaintext []byte) (ciphertext []byte, err error) {
+	enc, err := fte.NewEncrypter()
+	if err != nil {
+		return nil, err
+	}
+	return enc.Encrypt(plaintext)
+}
+
+func (c *BinaryCipher) Decrypt(fsm marionette.FSM, ciphertext []byte) (plaintext []byte, err error) {
+	dec, err := fte.NewDecrypter()
+	if err != nil {
+		return nil, err
+	}
+	return dec.Decrypt(ciphertext)
+}
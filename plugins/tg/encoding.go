@@ -0,0 +1,141 @@
+package tg
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"mime/quotedprintable"
+	"net/url"
+
+	"github.com/redjack/marionette"
+)
+
+// Encoding represents a reversible transform applied to cipher output.
+type Encoding interface {
+	Encode(src []byte) []byte
+	Decode(src []byte) ([]byte, error)
+}
+
+// Built-in encodings.
+var (
+	Base64Encoding          Encoding = &base64Encoding{base64.StdEncoding}
+	Base64URLEncoding       Encoding = &base64Encoding{base64.RawURLEncoding}
+	Base32Encoding          Encoding = &base32Encoding{base32.StdEncoding}
+	HexEncoding             Encoding = hexEncoding{}
+	URLEncoding             Encoding = urlEncoding{}
+	QuotedPrintableEncoding Encoding = quotedPrintableEncoding{}
+)
+
+// EncodedCipher applies one or more encodings to the output of a cipher so
+// that it can be embedded in URLs, cookies, mail bodies, etc.
+type EncodedCipher struct {
+	cipher    TemplateCipher
+	encodings []Encoding
+}
+
+// NewEncodedCipher returns a cipher that applies encodings, in order, to the
+// output of cipher. Encodings are reversed in the opposite order on decrypt.
+func NewEncodedCipher(cipher TemplateCipher, encodings ...Encoding) *EncodedCipher {
+	return &EncodedCipher{cipher: cipher, encodings: encodings}
+}
+
+func (c *EncodedCipher) Key() string {
+	return c.cipher.Key()
+}
+
+func (c *EncodedCipher) Capacity(fsm marionette.FSM) (int, error) {
+	return c.cipher.Capacity(fsm)
+}
+
+func (c *EncodedCipher) Encrypt(fsm marionette.FSM, template string, plaintext []byte) (ciphertext []byte, err error) {
+	if ciphertext, err = c.cipher.Encrypt(fsm, template, plaintext); err != nil {
+		return nil, err
+	}
+	for _, enc := range c.encodings {
+		ciphertext = enc.Encode(ciphertext)
+	}
+	return ciphertext, nil
+}
+
+func (c *EncodedCipher) Decrypt(fsm marionette.FSM, ciphertext []byte) (plaintext []byte, err error) {
+	for i := len(c.encodings) - 1; i >= 0; i-- {
+		if ciphertext, err = c.encodings[i].Decode(ciphertext); err != nil {
+			return nil, err
+		}
+	}
+	return c.cipher.Decrypt(fsm, ciphertext)
+}
+
+type base64Encoding struct {
+	enc *base64.Encoding
+}
+
+func (e *base64Encoding) Encode(src []byte) []byte {
+	dst := make([]byte, e.enc.EncodedLen(len(src)))
+	e.enc.Encode(dst, src)
+	return dst
+}
+
+func (e *base64Encoding) Decode(src []byte) ([]byte, error) {
+	dst := make([]byte, e.enc.DecodedLen(len(src)))
+	n, err := e.enc.Decode(dst, src)
+	return dst[:n], err
+}
+
+type base32Encoding struct {
+	enc *base32.Encoding
+}
+
+func (e *base32Encoding) Encode(src []byte) []byte {
+	dst := make([]byte, e.enc.EncodedLen(len(src)))
+	e.enc.Encode(dst, src)
+	return dst
+}
+
+func (e *base32Encoding) Decode(src []byte) ([]byte, error) {
+	dst := make([]byte, e.enc.DecodedLen(len(src)))
+	n, err := e.enc.Decode(dst, src)
+	return dst[:n], err
+}
+
+type hexEncoding struct{}
+
+func (hexEncoding) Encode(src []byte) []byte {
+	dst := make([]byte, hex.EncodedLen(len(src)))
+	hex.Encode(dst, src)
+	return dst
+}
+
+func (hexEncoding) Decode(src []byte) ([]byte, error) {
+	dst := make([]byte, hex.DecodedLen(len(src)))
+	n, err := hex.Decode(dst, src)
+	return dst[:n], err
+}
+
+type urlEncoding struct{}
+
+func (urlEncoding) Encode(src []byte) []byte {
+	return []byte(url.QueryEscape(string(src)))
+}
+
+func (urlEncoding) Decode(src []byte) ([]byte, error) {
+	s, err := url.QueryUnescape(string(src))
+	return []byte(s), err
+}
+
+type quotedPrintableEncoding struct{}
+
+func (quotedPrintableEncoding) Encode(src []byte) []byte {
+	var buf bytes.Buffer
+	w := quotedprintable.NewWriter(&buf)
+	w.Binary = true
+	w.Write(src)
+	w.Close()
+	return buf.Bytes()
+}
+
+func (quotedPrintableEncoding) Decode(src []byte) ([]byte, error) {
+	return ioutil.ReadAll(quotedprintable.NewReader(bytes.NewReader(src)))
+}
@@ -0,0 +1,110 @@
+package tg_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"regexp"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/tg"
+)
+
+func TestEncoding(t *testing.T) {
+	data := []byte("\x00\xff foo=bar&baz\r\n\x80")
+	for _, tt := range []struct {
+		name string
+		enc  tg.Encoding
+	}{
+		{"Base64", tg.Base64Encoding},
+		{"Base64URL", tg.Base64URLEncoding},
+		{"Base32", tg.Base32Encoding},
+		{"Hex", tg.HexEncoding},
+		{"URL", tg.URLEncoding},
+		{"QuotedPrintable", tg.QuotedPrintableEncoding},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if buf, err := tt.enc.Decode(tt.enc.Encode(data)); err != nil {
+				t.Fatal(err)
+			} else if !bytes.Equal(buf, data) {
+				t.Fatalf("unexpected data: %q", buf)
+			}
+		})
+	}
+}
+
+func TestEncodedCipher(t *testing.T) {
+	conn := mock.DefaultConn()
+	fsm := newSharedFSM(&conn, marionette.NewStreamSet())
+	cipher := tg.NewEncodedCipher(&hexCipher{key: "COOKIE", n: 4}, tg.Base64Encoding, tg.URLEncoding)
+
+	if cipher.Key() != "COOKIE" {
+		t.Fatalf("unexpected key: %s", cipher.Key())
+	} else if n, err := cipher.Capacity(fsm); err != nil {
+		t.Fatal(err)
+	} else if n != 4 {
+		t.Fatalf("unexpected capacity: %d", n)
+	}
+
+	// Hex is encoded with base64 and then URL escaped.
+	ciphertext, err := cipher.Encrypt(fsm, "", []byte("\xfb\xff\x00\x01"))
+	if err != nil {
+		t.Fatal(err)
+	} else if string(ciphertext) != "ZmJmZjAwMDE%3D" {
+		t.Fatalf("unexpected ciphertext: %q", ciphertext)
+	}
+
+	if plaintext, err := cipher.Decrypt(fsm, ciphertext); err != nil {
+		t.Fatal(err)
+	} else if string(plaintext) != "\xfb\xff\x00\x01" {
+		t.Fatalf("unexpected plaintext: %q", plaintext)
+	}
+}
+
+// Ensure a cell can be sent & received in an encoded cookie.
+func TestSend_HTTPRequestCookie(t *testing.T) {
+	var msg []byte
+	sendConn := mock.DefaultConn()
+	sendConn.ReadFn = func(p []byte) (int, error) { <-make(chan struct{}); return 0, nil }
+	sendConn.WriteFn = func(p []byte) (int, error) {
+		msg = append([]byte(nil), p...)
+		return len(p), nil
+	}
+	sendStreamSet := marionette.NewStreamSet()
+	if _, err := sendStreamSet.Create().Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tg.Send(context.Background(), newSharedFSM(&sendConn, sendStreamSet), "http_request_cookie"); err != nil {
+		t.Fatal(err)
+	} else if !regexp.MustCompile(`\r\nCookie: [A-Za-z0-9_-]+\r\n`).Match(msg) {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+
+	var read bool
+	recvConn := mock.DefaultConn()
+	recvConn.ReadFn = func(p []byte) (int, error) {
+		if read {
+			<-make(chan struct{})
+		}
+		read = true
+		return copy(p, msg), nil
+	}
+
+	var stream *marionette.Stream
+	recvStreamSet := marionette.NewStreamSet()
+	recvStreamSet.OnNewStream = func(s *marionette.Stream) { stream = s }
+	if err := tg.Recv(context.Background(), newSharedFSM(&recvConn, recvStreamSet), "http_request_cookie"); err != nil {
+		t.Fatal(err)
+	} else if stream == nil {
+		t.Fatal("expected stream")
+	}
+
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(stream, buf); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "foo" {
+		t.Fatalf("unexpected read: %q", buf)
+	}
+}
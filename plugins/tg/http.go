@@ -56,10 +56,23 @@ func parseHTTPRequest(data string) map[string]string {
 	lines := lineBreakRegex.Split(data, -1)
 	segments := strings.Split(lines[0][:len(lines[0])-9], "/")
 
+	m := map[string]string{"URL": strings.Join(segments[1:], "/")}
 	if strings.HasPrefix(data, "GET http") {
-		return map[string]string{"URL": strings.Join(segments[3:], "/")}
+		m["URL"] = strings.Join(segments[3:], "/")
 	}
-	return map[string]string{"URL": strings.Join(segments[1:], "/")}
+
+	if v := httpHeaderValue(lines[1:], "Cookie"); v != "" {
+		m["COOKIE"] = v
+	}
+
+	// Include cache validators for conditional requests.
+	if v := httpHeaderValue(lines[1:], "If-None-Match"); v != "" {
+		m["ETAG"] = v
+	}
+	if v := httpHeaderValue(lines[1:], "If-Modified-Since"); v != "" {
+		m["LAST-MODIFIED"] = v
+	}
+	return m
 }
 
 func parseHTTPResponse(data string) map[string]string {
@@ -79,7 +92,18 @@ func parseHTTPResponse(data string) map[string]string {
 		m["HTTP-RESPONSE-BODY"] = ""
 	}
 
-	if m["CONTENT-LENGTH"] != strconv.Itoa(len(m["HTTP-RESPONSE-BODY"])) {
+	// Include cache validators, if available.
+	if v := httpHeaderValue(hdrs, "ETag"); v != "" {
+		m["ETAG"] = v
+	}
+	if v := httpHeaderValue(hdrs, "Last-Modified"); v != "" {
+		m["LAST-MODIFIED"] = v
+	}
+
+	// Responses without a body, such as 304s, may omit the content length.
+	if m["CONTENT-LENGTH"] == "" && m["HTTP-RESPONSE-BODY"] == "" {
+		return m
+	} else if m["CONTENT-LENGTH"] != strconv.Itoa(len(m["HTTP-RESPONSE-BODY"])) {
 		return nil
 	}
 	return m
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/redjack/marionette/mock"
 	"github.com/redjack/marionette/plugins/tg"
 )
 
@@ -28,6 +29,18 @@ func TestParse_HTTPRequest(t *testing.T) {
 		})
 	})
 
+	// Ensure cache validators are parsed from conditional requests.
+	t.Run("Conditional", func(t *testing.T) {
+		m := tg.Parse("http_request_conditional", "GET http://127.0.0.1:8080/foo HTTP/1.1\r\nUser-Agent: marionette 0.1\r\nIf-None-Match: \"abc\"\r\nIf-Modified-Since: Mon, 02 Jan 2006 15:04:05 GMT\r\nConnection: keep-alive\r\n\r\n")
+		if diff := cmp.Diff(m, map[string]string{
+			"URL":           "foo",
+			"ETAG":          `"abc"`,
+			"LAST-MODIFIED": "Mon, 02 Jan 2006 15:04:05 GMT",
+		}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
 	t.Run("ErrInvalidMethod", func(t *testing.T) {
 		if m := tg.Parse("http_request", "POST http://127.0.0.1:8080/foo"); m != nil {
 			t.Fatalf("unexpected values: %#v", m)
@@ -66,6 +79,19 @@ func TestParse_HTTPResponse(t *testing.T) {
 		})
 	})
 
+	// Ensure 304 responses without a content length are parsed.
+	t.Run("NotModified", func(t *testing.T) {
+		m := tg.Parse("http_response_not_modified", "HTTP/1.1 304 Not Modified\r\nETag: \"abc\"\r\nConnection: keep-alive\r\n\r\n")
+		if diff := cmp.Diff(m, map[string]string{
+			"COOKIE":             "",
+			"CONTENT-LENGTH":     "",
+			"HTTP-RESPONSE-BODY": "",
+			"ETAG":               `"abc"`,
+		}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
 	t.Run("ErrMissingVersion", func(t *testing.T) {
 		if m := tg.Parse("http_response", "XYZ"); m != nil {
 			t.Fatalf("unexpected values: %#v", m)
@@ -78,3 +104,31 @@ func TestParse_HTTPResponse(t *testing.T) {
 		}
 	})
 }
+
+func TestHTTPETagCipher(t *testing.T) {
+	vars := make(map[string]interface{})
+
+	var fsm mock.FSM
+	fsm.VarFn = func(key string) interface{} { return vars[key] }
+	fsm.SetVarFn = func(key string, value interface{}) { vars[key] = value }
+
+	// Ensure a tag is generated on first use and reused afterward.
+	c := tg.NewHTTPETagCipher()
+	etag, err := c.Encrypt(&fsm, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(etag) != 18 || etag[0] != '"' || etag[17] != '"' {
+		t.Fatalf("unexpected etag: %s", etag)
+	} else if other, err := c.Encrypt(&fsm, "", nil); err != nil {
+		t.Fatal(err)
+	} else if string(other) != string(etag) {
+		t.Fatalf("etag mismatch: %s != %s", other, etag)
+	}
+
+	// Ensure a received tag replaces the stored tag.
+	if _, err := c.Decrypt(&fsm, []byte(`"xyz"`)); err != nil {
+		t.Fatal(err)
+	} else if vars["http_etag"] != `"xyz"` {
+		t.Fatalf("unexpected etag: %v", vars["http_etag"])
+	}
+}
@@ -11,7 +11,12 @@ import (
 )
 
 func init() {
-	marionette.RegisterPlugin("tg", "recv", Recv)
+	marionette.RegisterPlugin("tg", "recv", Recv, marionette.PluginInfo{
+		Description: "Receives data encoded with a template grammar.",
+		Args: []marionette.PluginArg{
+			{Name: "grammar", Type: "string"},
+		},
+	})
 }
 
 func Recv(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
@@ -66,29 +71,15 @@ func Recv(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 		}
 	}
 
-	// If any handlers matched and returned data then decode data as a cell.
+	// Shared grammars may spread a cell across several messages. Otherwise,
+	// if any handlers matched and returned data then decode data as a cell.
 	var plaintextN int
-	if len(data) > 0 {
-		var cell marionette.Cell
-		if err := cell.UnmarshalBinary(data); err != nil {
-			logger.Error("cannot unmarshal cell", zap.Error(err))
+	if grammar.Shared {
+		if plaintextN, err = decryptShared(fsm, data, logger); err != nil {
 			return err
-		} else if cell.UUID != fsm.UUID() {
-			logger.Error("uuid mismatch", zap.Int("local", fsm.UUID()), zap.Int("remote", cell.UUID))
-			return marionette.ErrUUIDMismatch
 		}
-		plaintextN = len(cell.Payload)
-
-		if fsm.InstanceID() == 0 {
-			if cell.InstanceID == 0 {
-				logger.Error("instance id required")
-				return errors.New("msg instance id required")
-			}
-			fsm.SetInstanceID(cell.InstanceID)
-		}
-
-		if err := fsm.StreamSet().Enqueue(&cell); err != nil {
-			logger.Error("cannot enqueue cell", zap.Error(err))
+	} else if len(data) > 0 {
+		if plaintextN, err = decodeCell(fsm, data, logger); err != nil {
 			return err
 		}
 	}
@@ -108,3 +99,30 @@ func Recv(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 
 	return nil
 }
+
+// decodeCell unmarshals data as a cell and adds it to the FSM's stream set.
+// Returns the length of the cell's payload.
+func decodeCell(fsm marionette.FSM, data []byte, logger *zap.Logger) (int, error) {
+	var cell marionette.Cell
+	if err := cell.UnmarshalBinary(data); err != nil {
+		logger.Error("cannot unmarshal cell", zap.Error(err))
+		return 0, err
+	} else if cell.UUID != fsm.UUID() {
+		logger.Error("uuid mismatch", zap.Int("local", fsm.UUID()), zap.Int("remote", cell.UUID))
+		return 0, marionette.ErrUUIDMismatch
+	}
+
+	if fsm.InstanceID() == 0 {
+		if cell.InstanceID == 0 {
+			logger.Error("instance id required")
+			return 0, errors.New("msg instance id required")
+		}
+		fsm.SetInstanceID(cell.InstanceID)
+	}
+
+	if err := fsm.StreamSet().Enqueue(&cell); err != nil {
+		logger.Error("cannot enqueue cell", zap.Error(err))
+		return 0, err
+	}
+	return len(cell.Payload), nil
+}
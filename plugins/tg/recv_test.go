@@ -2,8 +2,10 @@ package tg_test
 
 import (
 	"context"
+	"flag"
 	"io"
 	"math/big"
+	"os"
 	"testing"
 
 	"github.com/redjack/marionette"
@@ -12,10 +14,12 @@ import (
 	"go.uber.org/zap"
 )
 
-func init() {
+func TestMain(m *testing.M) {
+	flag.Parse()
 	if !testing.Verbose() {
 		marionette.Logger = zap.NewNop()
 	}
+	os.Exit(m.Run())
 }
 
 func TestRecv(t *testing.T) {
@@ -13,7 +13,12 @@ import (
 )
 
 func init() {
-	marionette.RegisterPlugin("tg", "send", Send)
+	marionette.RegisterPlugin("tg", "send", Send, marionette.PluginInfo{
+		Description: "Sends data encoded with a template grammar.",
+		Args: []marionette.PluginArg{
+			{Name: "grammar", Type: "string"},
+		},
+	})
 }
 
 func Send(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
@@ -43,13 +48,21 @@ func Send(ctx context.Context, fsm marionette.FSM, args ...interface{}) error {
 
 	// Randomly choose template and replace embedded placeholders.
 	ciphertext := grammar.Templates[rand.Intn(len(grammar.Templates))]
-	ciphertext = strings.Replace(ciphertext, "%%SERVER_LISTEN_IP%%", fsm.Host(), -1)
-	for _, cipher := range grammar.Ciphers {
+	ciphertext = strings.Replace(ciphertext, "%%SERVER_LISTEN_IP%%", urlHost(fsm.Host()), -1)
+	if grammar.Shared {
 		var err error
-		if ciphertext, err = encryptTo(fsm, cipher, ciphertext, logger); err != nil {
-			logger.Error("cannot encrypt", zap.String("key", cipher.Key()), zap.Error(err))
+		if ciphertext, err = encryptShared(fsm, grammar, ciphertext); err != nil {
+			logger.Error("cannot encrypt", zap.Error(err))
 			return fmt.Errorf("cannot encrypt: %q", err)
 		}
+	} else {
+		for _, cipher := range grammar.Ciphers {
+			var err error
+			if ciphertext, err = encryptTo(fsm, cipher, ciphertext, logger); err != nil {
+				logger.Error("cannot encrypt", zap.String("key", cipher.Key()), zap.Error(err))
+				return fmt.Errorf("cannot encrypt: %q", err)
+			}
+		}
 	}
 
 	// Write to outgoing connection.
@@ -86,3 +99,12 @@ func encryptTo(fsm marionette.FSM, cipher TemplateCipher, template string, logge
 	}
 	return strings.Replace(template, "%%"+cipher.Key()+"%%", string(value), -1), nil
 }
+
+// urlHost returns host bracketed if it is an IPv6 literal so it can be
+// followed by a port in a URL.
+func urlHost(host string) string {
+	if strings.Contains(host, ":") {
+		return "[" + host + "]"
+	}
+	return host
+}
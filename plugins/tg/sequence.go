@@ -0,0 +1,209 @@
+package tg
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/redjack/marionette"
+)
+
+// SequenceCipher emits a per-connection sequence number which increases by
+// one for each message sent. The initial number is random, as with TCP.
+//
+// The receiver records the sequence from the first message and rejects any
+// later message which does not follow the previous one.
+type SequenceCipher struct {
+	key  string
+	name string
+	size int
+}
+
+// NewSequenceCipher returns a sequence number handler. The name identifies
+// the sequence so an AckCipher can acknowledge it. The size is the encoded
+// length in bytes: 1, 2, 4, or 8.
+func NewSequenceCipher(key, name string, size int) *SequenceCipher {
+	assertSequenceSize(size)
+	return &SequenceCipher{key: key, name: name, size: size}
+}
+
+func (c *SequenceCipher) Key() string {
+	return c.key
+}
+
+func (c *SequenceCipher) Capacity(fsm marionette.FSM) (int, error) {
+	return 0, nil
+}
+
+func (c *SequenceCipher) Encrypt(fsm marionette.FSM, template string, plaintext []byte) (ciphertext []byte, err error) {
+	seq, ok := fsm.Var(sequenceSendVar(c.name)).(uint64)
+	if !ok {
+		seq = uint64(rand.Int63())
+	}
+	seq = truncateSequence(seq, c.size)
+
+	fsm.SetVar(sequenceSendVar(c.name), truncateSequence(seq+1, c.size))
+	return encodeSequence(seq, c.size), nil
+}
+
+func (c *SequenceCipher) Decrypt(fsm marionette.FSM, ciphertext []byte) (plaintext []byte, err error) {
+	seq, err := decodeSequence(ciphertext, c.size)
+	if err != nil {
+		return nil, err
+	}
+
+	if expected, ok := fsm.Var(sequenceRecvVar(c.name)).(uint64); ok && seq != expected {
+		return nil, fmt.Errorf("%s sequence mismatch: got=%d, expected=%d", c.name, seq, expected)
+	}
+	fsm.SetVar(sequenceRecvVar(c.name), truncateSequence(seq+1, c.size))
+	return nil, nil
+}
+
+// AckCipher emits the next sequence number expected from the other party for
+// a named sequence. The receiver validates that the acknowledgement matches
+// the next sequence number it will send.
+type AckCipher struct {
+	key  string
+	name string
+	size int
+}
+
+// NewAckCipher returns an acknowledgement handler for the sequence with the
+// given name. The size is the encoded length in bytes: 1, 2, 4, or 8.
+func NewAckCipher(key, name string, size int) *AckCipher {
+	assertSequenceSize(size)
+	return &AckCipher{key: key, name: name, size: size}
+}
+
+func (c *AckCipher) Key() string {
+	return c.key
+}
+
+func (c *AckCipher) Capacity(fsm marionette.FSM) (int, error) {
+	return 0, nil
+}
+
+func (c *AckCipher) Encrypt(fsm marionette.FSM, template string, plaintext []byte) (ciphertext []byte, err error) {
+	ack, _ := fsm.Var(sequenceRecvVar(c.name)).(uint64)
+	return encodeSequence(ack, c.size), nil
+}
+
+func (c *AckCipher) Decrypt(fsm marionette.FSM, ciphertext []byte) (plaintext []byte, err error) {
+	ack, err := decodeSequence(ciphertext, c.size)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only validate once this party has sent on the sequence.
+	if expected, ok := fsm.Var(sequenceSendVar(c.name)).(uint64); ok && ack != expected {
+		return nil, fmt.Errorf("%s ack mismatch: got=%d, expected=%d", c.name, ack, expected)
+	}
+	return nil, nil
+}
+
+// LengthCipher emits the number of bytes that follow its placeholder in the
+// template. It must be listed after all ciphers whose placeholders follow it
+// so the length is computed from the final values.
+type LengthCipher struct {
+	key  string
+	size int
+}
+
+// NewLengthCipher returns a length handler with an encoded size, in bytes,
+// of 1, 2, 4, or 8.
+func NewLengthCipher(key string, size int) *LengthCipher {
+	assertSequenceSize(size)
+	return &LengthCipher{key: key, size: size}
+}
+
+func (c *LengthCipher) Key() string {
+	return c.key
+}
+
+func (c *LengthCipher) Capacity(fsm marionette.FSM) (int, error) {
+	return 0, nil
+}
+
+func (c *LengthCipher) Encrypt(fsm marionette.FSM, template string, plaintext []byte) (ciphertext []byte, err error) {
+	placeholder := "%%" + c.key + "%%"
+	i := strings.Index(template, placeholder)
+	if i == -1 {
+		return nil, fmt.Errorf("placeholder not found: %s", placeholder)
+	}
+
+	n := uint64(len(template) - i - len(placeholder))
+	if truncateSequence(n, c.size) != n {
+		return nil, fmt.Errorf("length too large for %d byte field: %d", c.size, n)
+	}
+	return encodeSequence(n, c.size), nil
+}
+
+func (c *LengthCipher) Decrypt(fsm marionette.FSM, ciphertext []byte) (plaintext []byte, err error) {
+	_, err = decodeSequence(ciphertext, c.size)
+	return nil, err
+}
+
+// Sizes of the fields of a binary_packet message: a length of the rest of the
+// packet, a sequence number & the acknowledgement of the other party's.
+const (
+	binaryPacketLengthSize = 2
+	binaryPacketSeqSize    = 4
+	binaryPacketHeaderSize = binaryPacketLengthSize + 2*binaryPacketSeqSize
+)
+
+// parseBinaryPacket returns the fields of a binary_packet message if data is
+// exactly one complete packet.
+func parseBinaryPacket(data string) map[string]string {
+	if len(data) < binaryPacketHeaderSize {
+		return nil
+	} else if n := int(binary.BigEndian.Uint16([]byte(data[:binaryPacketLengthSize]))); len(data) != binaryPacketLengthSize+n {
+		return nil
+	}
+
+	ack := binaryPacketLengthSize + binaryPacketSeqSize
+	return map[string]string{
+		"LENGTH":  data[:binaryPacketLengthSize],
+		"SEQ":     data[binaryPacketLengthSize:ack],
+		"ACK":     data[ack:binaryPacketHeaderSize],
+		"PAYLOAD": data[binaryPacketHeaderSize:],
+	}
+}
+
+func sequenceSendVar(name string) string { return "tg_seq_send_" + name }
+func sequenceRecvVar(name string) string { return "tg_seq_recv_" + name }
+
+func assertSequenceSize(size int) {
+	switch size {
+	case 1, 2, 4, 8:
+	default:
+		panic(fmt.Sprintf("tg: invalid sequence size: %d", size))
+	}
+}
+
+// truncateSequence wraps v to fit within size bytes.
+func truncateSequence(v uint64, size int) uint64 {
+	if size == 8 {
+		return v
+	}
+	return v & (1<<(uint(size)*8) - 1)
+}
+
+// encodeSequence returns the big endian encoding of v using size bytes.
+func encodeSequence(v uint64, size int) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, v)
+	return buf[8-size:]
+}
+
+// decodeSequence returns the big endian value stored in buf.
+func decodeSequence(buf []byte, size int) (uint64, error) {
+	if len(buf) != size {
+		return 0, fmt.Errorf("invalid sequence field length: %d", len(buf))
+	}
+	var v uint64
+	for _, b := range buf {
+		v = v<<8 | uint64(b)
+	}
+	return v, nil
+}
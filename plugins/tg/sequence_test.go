@@ -0,0 +1,185 @@
+package tg_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/tg"
+)
+
+// Ensure sequence numbers increase per message and acks echo the next
+// expected sequence back to the sender.
+func TestSequenceCipher(t *testing.T) {
+	conn := mock.DefaultConn()
+	client := newSharedFSM(&conn, marionette.NewStreamSet())
+	server := newSharedFSM(&conn, marionette.NewStreamSet())
+
+	seq := tg.NewSequenceCipher("SEQ", "client", 2)
+	ack := tg.NewAckCipher("ACK", "client", 2)
+
+	var prev []byte
+	for i := 0; i < 3; i++ {
+		buf, err := seq.Encrypt(client, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		} else if len(buf) != 2 {
+			t.Fatalf("unexpected length: %d", len(buf))
+		} else if prev != nil && (int(prev[0])<<8|int(prev[1]))+1&0xFFFF != int(buf[0])<<8|int(buf[1]) {
+			t.Fatalf("sequence did not increase: %x -> %x", prev, buf)
+		}
+		prev = buf
+
+		if _, err := seq.Decrypt(server, buf); err != nil {
+			t.Fatal(err)
+		}
+
+		// Server acknowledges & client validates.
+		if a, err := ack.Encrypt(server, "", nil); err != nil {
+			t.Fatal(err)
+		} else if _, err := ack.Decrypt(client, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Replayed sequence numbers are rejected.
+	if _, err := seq.Decrypt(server, prev); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// Stale acks are rejected.
+	if _, err := ack.Decrypt(client, prev); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestLengthCipher(t *testing.T) {
+	conn := mock.DefaultConn()
+	fsm := newSharedFSM(&conn, marionette.NewStreamSet())
+
+	c := tg.NewLengthCipher("LEN", 4)
+	if buf, err := c.Encrypt(fsm, "\x01%%LEN%%foobar", nil); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "\x00\x00\x00\x06" {
+		t.Fatalf("unexpected length: %x", buf)
+	}
+
+	if _, err := c.Encrypt(fsm, "foobar", nil); err == nil || err.Error() != `placeholder not found: %%LEN%%` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := tg.NewLengthCipher("LEN", 1).Encrypt(fsm, "%%LEN%%"+string(make([]byte, 256)), nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParse_BinaryPacket(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		m := tg.Parse("binary_packet", "\x00\x0b\x00\x00\x00\x01\x00\x00\x00\x02foo")
+		if m["LENGTH"] != "\x00\x0b" || m["SEQ"] != "\x00\x00\x00\x01" || m["ACK"] != "\x00\x00\x00\x02" || m["PAYLOAD"] != "foo" {
+			t.Fatalf("unexpected match: %#v", m)
+		}
+	})
+
+	t.Run("Incomplete", func(t *testing.T) {
+		if m := tg.Parse("binary_packet", "\x00\x0b\x00\x00\x00\x01\x00\x00\x00\x02fo"); m != nil {
+			t.Fatalf("unexpected match: %#v", m)
+		}
+	})
+}
+
+// binaryPacketDoc exchanges binary_packet messages in both directions.
+const binaryPacketDoc = `connection(tcp, 8080):
+  start      upstream   NULL 1.0
+  upstream   downstream up   1.0
+  downstream upstream   down 1.0
+
+action up:
+  client tg.send("binary_packet")
+
+action down:
+  server tg.send("binary_packet")
+`
+
+// Ensure streams are carried by the binary_packet grammar.
+func TestBinaryPacket(t *testing.T) {
+	dialer, ln := marionette.Pipe(
+		mar.MustParse(marionette.PartyClient, []byte(binaryPacketDoc)),
+		mar.MustParse(marionette.PartyServer, []byte(binaryPacketDoc)),
+	)
+	defer ln.Close()
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientStream.Close()
+	if _, err := clientStream.Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverStream.Close()
+
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(serverStream, buf); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "foo" {
+		t.Fatalf("unexpected read: %q", buf)
+	}
+
+	if _, err := serverStream.Write([]byte("bar")); err != nil {
+		t.Fatal(err)
+	} else if _, err := io.ReadFull(clientStream, buf); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "bar" {
+		t.Fatalf("unexpected read: %q", buf)
+	}
+}
+
+// Ensure a replayed binary_packet message is rejected by tg.recv.
+func TestBinaryPacket_Replay(t *testing.T) {
+	var msg []byte
+	sendConn := mock.DefaultConn()
+	sendConn.ReadFn = func(p []byte) (int, error) { <-make(chan struct{}); return 0, nil }
+	sendConn.WriteFn = func(p []byte) (int, error) {
+		msg = append([]byte(nil), p...)
+		return len(p), nil
+	}
+	if err := tg.Send(context.Background(), newSharedFSM(&sendConn, marionette.NewStreamSet()), "binary_packet"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Deliver the same message again once the first is received.
+	replay := make(chan struct{})
+	var reads int
+	recvConn := mock.DefaultConn()
+	recvConn.ReadFn = func(p []byte) (int, error) {
+		if reads == 1 {
+			<-replay
+		} else if reads == 2 {
+			<-make(chan struct{})
+		}
+		reads++
+		return copy(p, msg), nil
+	}
+	recv := newSharedFSM(&recvConn, marionette.NewStreamSet())
+	if err := tg.Recv(context.Background(), recv, "binary_packet"); err != nil {
+		t.Fatal(err)
+	}
+	close(replay)
+	if err := tg.Recv(context.Background(), recv, "binary_packet"); err == nil || !strings.Contains(err.Error(), "packet sequence mismatch") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package tg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/redjack/marionette"
+	"go.uber.org/zap"
+)
+
+const (
+	// sharedSendVar stores the marshaled cell bytes not yet sent by a shared grammar.
+	sharedSendVar = "tg_shared_send"
+
+	// sharedRecvVar stores the partial cell bytes received by a shared grammar.
+	sharedRecvVar = "tg_shared_recv"
+)
+
+// MaxSharedCellLength is the maximum size of a cell spread across messages
+// of a shared grammar.
+var MaxSharedCellLength = 1024
+
+var (
+	// ErrInsufficientSharedCapacity is returned when a shared grammar cannot
+	// carry at least the cell size header in a single message.
+	ErrInsufficientSharedCapacity = errors.New("tg: insufficient shared capacity")
+)
+
+// encryptShared fills the template using a single payload split across all
+// ciphers. Cells larger than the combined capacity are continued in the
+// next message sent using the same FSM.
+//
+// A message never carries the start of a new cell after the end of a
+// previous cell so the receiver can discard trailing padding. Idle messages
+// are all zeros which can never begin a cell since the size is non-zero.
+func encryptShared(fsm marionette.FSM, grammar *Grammar, template string) (string, error) {
+	capacities := make([]int, len(grammar.Ciphers))
+	var total int
+	for i, cipher := range grammar.Ciphers {
+		capacity, err := cipher.Capacity(fsm)
+		if err != nil {
+			return "", err
+		}
+		capacities[i], total = capacity, total+capacity
+	}
+	if total < 4 {
+		return "", ErrInsufficientSharedCapacity
+	}
+
+	// Start a new cell if the previous one has been fully sent. An empty cell
+	// is sent initially so the instance id is passed to the other party.
+	pending, ok := fsm.Var(sharedSendVar).([]byte)
+	if len(pending) == 0 {
+		cell := fsm.StreamSet().Dequeue(MaxSharedCellLength)
+		if cell == nil && !ok {
+			cell = marionette.NewCell(0, 0, 0, marionette.NORMAL)
+		}
+
+		if cell != nil {
+			// Padding is not needed as messages are already a fixed size.
+			cell.Length = 0
+			cell.UUID, cell.InstanceID = fsm.UUID(), fsm.InstanceID()
+			buf, err := cell.MarshalBinary()
+			if err != nil {
+				return "", err
+			}
+			pending = buf
+		}
+	}
+
+	// Take the next chunk of the cell & pad to fill all ciphers.
+	chunk := make([]byte, total)
+	n := copy(chunk, pending)
+	fsm.SetVar(sharedSendVar, pending[n:])
+
+	for i, cipher := range grammar.Ciphers {
+		var data []byte
+		if capacities[i] > 0 {
+			data, chunk = chunk[:capacities[i]], chunk[capacities[i]:]
+		}
+
+		value, err := cipher.Encrypt(fsm, template, data)
+		if err != nil {
+			return "", fmt.Errorf("%s: %s", cipher.Key(), err)
+		}
+		template = strings.Replace(template, "%%"+cipher.Key()+"%%", string(value), -1)
+	}
+	return template, nil
+}
+
+// decryptShared appends data to the partially received cell and enqueues the
+// cell once all of its bytes have arrived. Returns the plaintext length of
+// the enqueued cell, if any.
+func decryptShared(fsm marionette.FSM, data []byte, logger *zap.Logger) (int, error) {
+	buf, _ := fsm.Var(sharedRecvVar).([]byte)
+
+	// Ignore idle messages between cells.
+	if len(buf) == 0 && isZero(data) {
+		return 0, nil
+	}
+	buf = append(buf, data...)
+
+	// Wait until the full cell has been received.
+	if len(buf) < 4 {
+		fsm.SetVar(sharedRecvVar, buf)
+		return 0, nil
+	}
+	sz := int(binary.BigEndian.Uint32(buf))
+	if sz < marionette.CellHeaderSize || sz > marionette.MaxCellLength {
+		fsm.SetVar(sharedRecvVar, []byte(nil))
+		return 0, fmt.Errorf("tg: invalid shared cell size: %d", sz)
+	} else if len(buf) < sz {
+		fsm.SetVar(sharedRecvVar, buf)
+		return 0, nil
+	}
+
+	// Remaining bytes in the message are padding.
+	fsm.SetVar(sharedRecvVar, []byte(nil))
+	return decodeCell(fsm, buf[:sz], logger)
+}
+
+// isZero returns true if all bytes in b are zero.
+func isZero(b []byte) bool {
+	for _, ch := range b {
+		if ch != 0 {
+			return false
+		}
+	}
+	return true
+}
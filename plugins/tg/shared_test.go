@@ -0,0 +1,127 @@
+package tg_test
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/tg"
+)
+
+func init() {
+	tg.RegisterGrammar(&tg.Grammar{
+		Name:      "http_request_shared_test",
+		Templates: []string{"GET /%%URL%% HTTP/1.1\r\nCookie: %%COOKIE%%\r\n\r\n"},
+		Ciphers:   []tg.TemplateCipher{&hexCipher{key: "URL", n: 4}, &hexCipher{key: "COOKIE", n: 4}},
+		Shared:    true,
+	})
+}
+
+// Ensure a cell larger than a single message is spread across several
+// messages and reassembled by the receiver.
+func TestSend_Shared(t *testing.T) {
+	// Write a cell from the sender into a series of messages.
+	var msgs [][]byte
+	sendConn := mock.DefaultConn()
+	sendConn.ReadFn = func(p []byte) (int, error) { <-make(chan struct{}); return 0, nil }
+	sendConn.WriteFn = func(p []byte) (int, error) {
+		msgs = append(msgs, append([]byte(nil), p...))
+		return len(p), nil
+	}
+
+	sendStreamSet := marionette.NewStreamSet()
+	sender := newSharedFSM(&sendConn, sendStreamSet)
+	if _, err := sendStreamSet.Create().Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+
+	// A 28 byte cell requires 4 messages with 8 bytes of capacity.
+	for i := 0; i < 5; i++ {
+		if err := tg.Send(context.Background(), sender, "http_request_shared_test"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := string(msgs[0]), "GET /0000001c HTTP/1.1\r\nCookie: 00000003\r\n\r\n"; got != want {
+		t.Fatalf("unexpected message: %q, want %q", got, want)
+	} else if got, want := string(msgs[4]), "GET /00000000 HTTP/1.1\r\nCookie: 00000000\r\n\r\n"; got != want {
+		t.Fatalf("unexpected idle message: %q, want %q", got, want)
+	}
+
+	// Deliver one message at a time to the receiver.
+	ch := make(chan []byte, 1)
+	recvConn := mock.DefaultConn()
+	recvConn.ReadFn = func(p []byte) (int, error) { return copy(p, <-ch), nil }
+
+	var stream *marionette.Stream
+	recvStreamSet := marionette.NewStreamSet()
+	recvStreamSet.OnNewStream = func(s *marionette.Stream) { stream = s }
+	receiver := newSharedFSM(&recvConn, recvStreamSet)
+
+	for i, msg := range msgs {
+		ch <- msg
+		if err := tg.Recv(context.Background(), receiver, "http_request_shared_test"); err != nil {
+			t.Fatal(err)
+		} else if i < 3 && stream != nil {
+			t.Fatalf("unexpected stream after message %d", i)
+		}
+	}
+
+	if stream == nil {
+		t.Fatal("expected stream")
+	}
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(stream, buf); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != `foo` {
+		t.Fatalf("unexpected read: %q", buf)
+	}
+}
+
+func TestSend_ErrInsufficientSharedCapacity(t *testing.T) {
+	tg.RegisterGrammar(&tg.Grammar{
+		Name:      "http_request_shared_small_test",
+		Templates: []string{"GET /%%URL%% HTTP/1.1\r\n\r\n"},
+		Ciphers:   []tg.TemplateCipher{&hexCipher{key: "URL", n: 2}},
+		Shared:    true,
+	})
+
+	conn := mock.DefaultConn()
+	fsm := newSharedFSM(&conn, marionette.NewStreamSet())
+	if err := tg.Send(context.Background(), fsm, "http_request_shared_small_test"); err == nil || err.Error() != `cannot encrypt: "tg: insufficient shared capacity"` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// newSharedFSM returns a mock FSM with variable storage.
+func newSharedFSM(conn *mock.Conn, streamSet *marionette.StreamSet) *mock.FSM {
+	vars := make(map[string]interface{})
+	fsm := mock.NewFSM(conn, streamSet)
+	fsm.PartyFn = func() string { return marionette.PartyClient }
+	fsm.HostFn = func() string { return "127.0.0.1" }
+	fsm.UUIDFn = func() int { return 100 }
+	fsm.InstanceIDFn = func() int { return 200 }
+	fsm.VarFn = func(key string) interface{} { return vars[key] }
+	fsm.SetVarFn = func(key string, value interface{}) { vars[key] = value }
+	return &fsm
+}
+
+// hexCipher encodes a fixed number of bytes as hex.
+type hexCipher struct {
+	key string
+	n   int
+}
+
+func (c *hexCipher) Key() string { return c.key }
+
+func (c *hexCipher) Capacity(fsm marionette.FSM) (int, error) { return c.n, nil }
+
+func (c *hexCipher) Encrypt(fsm marionette.FSM, template string, plaintext []byte) ([]byte, error) {
+	return []byte(hex.EncodeToString(plaintext)), nil
+}
+
+func (c *hexCipher) Decrypt(fsm marionette.FSM, ciphertext []byte) ([]byte, error) {
+	return hex.DecodeString(string(ciphertext))
+}
@@ -1,6 +1,7 @@
 package tg
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/redjack/marionette"
@@ -10,6 +11,11 @@ type Grammar struct {
 	Name      string
 	Templates []string
 	Ciphers   []TemplateCipher
+
+	// If true, all ciphers share a single payload which may be spread
+	// across multiple consecutive messages. Used when individual fields
+	// are too small to carry a cell.
+	Shared bool
 }
 
 type TemplateCipher interface {
@@ -69,6 +75,53 @@ func init() {
 		},
 	})
 
+	RegisterGrammar(&Grammar{
+		Name: "http_response_cacheable",
+		Templates: []string{
+			"HTTP/1.1 200 OK\r\nContent-Length: %%CONTENT-LENGTH%%\r\nETag: %%ETAG%%\r\nLast-Modified: %%LAST-MODIFIED%%\r\nCache-Control: max-age=0, must-revalidate\r\nConnection: keep-alive\r\n\r\n%%HTTP-RESPONSE-BODY%%",
+		},
+		Ciphers: []TemplateCipher{
+			NewFTECipher("HTTP-RESPONSE-BODY", ".+", 128, false),
+			NewHTTPContentLengthCipher(),
+			NewHTTPETagCipher(),
+			NewHTTPLastModifiedCipher(),
+		},
+	})
+
+	RegisterGrammar(&Grammar{
+		Name: "http_request_conditional",
+		Templates: []string{
+			"GET http://%%SERVER_LISTEN_IP%%:8080/%%URL%% HTTP/1.1\r\nUser-Agent: marionette 0.1\r\nIf-None-Match: %%ETAG%%\r\nIf-Modified-Since: %%LAST-MODIFIED%%\r\nConnection: keep-alive\r\n\r\n",
+		},
+		Ciphers: []TemplateCipher{
+			NewRankerCipher("URL", `[a-zA-Z0-9\?\-\.\&]+`, 2048),
+			NewHTTPETagCipher(),
+			NewHTTPLastModifiedCipher(),
+		},
+	})
+
+	RegisterGrammar(&Grammar{
+		Name: "http_response_not_modified",
+		Templates: []string{
+			"HTTP/1.1 304 Not Modified\r\nETag: %%ETAG%%\r\nCache-Control: max-age=0, must-revalidate\r\nConnection: keep-alive\r\n\r\n",
+		},
+		Ciphers: []TemplateCipher{
+			NewHTTPETagCipher(),
+		},
+	})
+
+	RegisterGrammar(&Grammar{
+		Name: "http_request_shared",
+		Templates: []string{
+			"GET http://%%SERVER_LISTEN_IP%%:8080/%%URL%% HTTP/1.1\r\nUser-Agent: marionette 0.1\r\nCookie: %%COOKIE%%\r\nConnection: keep-alive\r\n\r\n",
+		},
+		Ciphers: []TemplateCipher{
+			NewRankerCipher("URL", `[a-z]+`, 12),
+			NewRankerCipher("COOKIE", `[0-9a-f]+`, 16),
+		},
+		Shared: true,
+	})
+
 	RegisterGrammar(&Grammar{
 		Name: "pop3_message_response",
 		Templates: []string{
@@ -112,6 +165,16 @@ func init() {
 		},
 	})
 
+	RegisterGrammar(&Grammar{
+		Name: "http_request_cookie",
+		Templates: []string{
+			"GET http://%%SERVER_LISTEN_IP%%:8080/ HTTP/1.1\r\nUser-Agent: marionette 0.1\r\nCookie: %%COOKIE%%\r\nConnection: keep-alive\r\n\r\n",
+		},
+		Ciphers: []TemplateCipher{
+			NewEncodedCipher(NewBinaryCipher("COOKIE", 1024), Base64URLEncoding),
+		},
+	})
+
 	RegisterGrammar(&Grammar{
 		Name: "http_amazon_request",
 		Templates: []string{
@@ -145,6 +208,25 @@ func init() {
 		},
 	})
 
+	RegisterGrammar(&Grammar{
+		Name:      "tls_application_data",
+		Templates: []string{"%%TLS_RECORD%%"},
+		Ciphers: []TemplateCipher{
+			NewTLSRecordCipher("TLS_RECORD", TLSApplicationData, 1024),
+		},
+	})
+
+	RegisterGrammar(&Grammar{
+		Name:      "binary_packet",
+		Templates: []string{"%%LENGTH%%%%SEQ%%%%ACK%%%%PAYLOAD%%"},
+		Ciphers: []TemplateCipher{
+			NewBinaryCipher("PAYLOAD", 1024),
+			NewSequenceCipher("SEQ", "packet", 4),
+			NewAckCipher("ACK", "packet", 4),
+			NewLengthCipher("LENGTH", 2),
+		},
+	})
+
 	RegisterGrammar(&Grammar{
 		Name: "dns_request",
 		Templates: []string{
@@ -169,6 +251,29 @@ func init() {
 	})
 }
 
+// Regexes returns the distinct FTE regexes used by the registered grammars,
+// such as to precompute their DFA tables.
+func Regexes() []string {
+	m := make(map[string]bool)
+	for _, grammar := range grammars {
+		for _, c := range grammar.Ciphers {
+			switch c := c.(type) {
+			case *RankerCipher:
+				m[c.regex] = true
+			case *FTECipher:
+				m[c.regex] = true
+			}
+		}
+	}
+
+	a := make([]string, 0, len(m))
+	for regex := range m {
+		a = append(a, regex)
+	}
+	sort.Strings(a)
+	return a
+}
+
 func Parse(name, data string) map[string]string {
 	if strings.HasPrefix(name, "http_response") || name == "http_amazon_response" {
 		return parseHTTPResponse(data)
@@ -184,6 +289,10 @@ func Parse(name, data string) map[string]string {
 		return parseDNSRequest(data)
 	} else if strings.HasPrefix(name, "dns_response") {
 		return parseDNSResponse(data)
+	} else if strings.HasPrefix(name, "tls_") {
+		return parseTLSRecord(data)
+	} else if strings.HasPrefix(name, "binary_packet") {
+		return parseBinaryPacket(data)
 	}
 	return nil
 }
@@ -0,0 +1,141 @@
+package tg
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/fte"
+)
+
+// TLS record content types.
+const (
+	TLSChangeCipherSpec = 0x14
+	TLSAlert            = 0x15
+	TLSHandshake        = 0x16
+	TLSApplicationData  = 0x17
+)
+
+const (
+	// tlsVersion is the record layer version for TLS 1.2.
+	tlsVersion = 0x0303
+
+	// tlsRecordHeaderSize is the size of the type, version, & length header.
+	tlsRecordHeaderSize = 5
+
+	// tlsExplicitNonceSize is the size of the explicit nonce which carries
+	// the record sequence number, as with AES-GCM cipher suites.
+	tlsExplicitNonceSize = 8
+
+	// tlsMaxRecordSize is the maximum length of a record's body.
+	tlsMaxRecordSize = 1<<14 + 2048
+)
+
+const (
+	// tlsSendSeqVar stores the sequence number of the next record sent.
+	tlsSendSeqVar = "tls_send_seq"
+
+	// tlsRecvSeqVar stores the sequence number of the next record expected.
+	tlsRecvSeqVar = "tls_recv_seq"
+)
+
+// TLSRecordCipher wraps an encrypted cell in a TLS record.
+//
+// Each record begins with an explicit nonce containing a per-connection
+// sequence number, as used by TLS 1.2 AEAD cipher suites. The receiver
+// rejects records which are out of sequence.
+type TLSRecordCipher struct {
+	key         string
+	contentType byte
+	capacity    int
+}
+
+func NewTLSRecordCipher(key string, contentType byte, capacity int) *TLSRecordCipher {
+	return &TLSRecordCipher{
+		key:         key,
+		contentType: contentType,
+		capacity:    capacity,
+	}
+}
+
+func (c *TLSRecordCipher) Key() string {
+	return c.key
+}
+
+func (c *TLSRecordCipher) Capacity(fsm marionette.FSM) (int, error) {
+	return c.capacity, nil
+}
+
+func (c *TLSRecordCipher) Encrypt(fsm marionette.FSM, template string, plaintext []byte) (ciphertext []byte, err error) {
+	enc, err := fte.NewEncrypter()
+	if err != nil {
+		return nil, err
+	}
+	body, err := enc.Encrypt(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	seq, _ := fsm.Var(tlsSendSeqVar).(int)
+	n := tlsExplicitNonceSize + len(body)
+	if n > tlsMaxRecordSize {
+		return nil, fmt.Errorf("tls record too large: %d", n)
+	}
+
+	buf := make([]byte, tlsRecordHeaderSize+tlsExplicitNonceSize, tlsRecordHeaderSize+n)
+	buf[0] = c.contentType
+	binary.BigEndian.PutUint16(buf[1:3], tlsVersion)
+	binary.BigEndian.PutUint16(buf[3:5], uint16(n))
+	binary.BigEndian.PutUint64(buf[5:13], uint64(seq))
+	buf = append(buf, body...)
+
+	fsm.SetVar(tlsSendSeqVar, seq+1)
+	return buf, nil
+}
+
+func (c *TLSRecordCipher) Decrypt(fsm marionette.FSM, ciphertext []byte) (plaintext []byte, err error) {
+	if len(ciphertext) < tlsRecordHeaderSize+tlsExplicitNonceSize {
+		return nil, fmt.Errorf("tls record too short: %d", len(ciphertext))
+	} else if ciphertext[0] != c.contentType {
+		return nil, fmt.Errorf("unexpected tls content type: %d", ciphertext[0])
+	} else if v := binary.BigEndian.Uint16(ciphertext[1:3]); v != tlsVersion {
+		return nil, fmt.Errorf("unexpected tls version: %#04x", v)
+	} else if n := int(binary.BigEndian.Uint16(ciphertext[3:5])); n != len(ciphertext)-tlsRecordHeaderSize {
+		return nil, fmt.Errorf("tls record length mismatch: %d != %d", n, len(ciphertext)-tlsRecordHeaderSize)
+	}
+
+	// Ensure records arrive in order.
+	expected, _ := fsm.Var(tlsRecvSeqVar).(int)
+	if seq := binary.BigEndian.Uint64(ciphertext[5:13]); seq != uint64(expected) {
+		return nil, fmt.Errorf("tls sequence mismatch: got=%d, expected=%d", seq, expected)
+	}
+
+	dec, err := fte.NewDecrypter()
+	if err != nil {
+		return nil, err
+	}
+	if plaintext, err = dec.Decrypt(ciphertext[tlsRecordHeaderSize+tlsExplicitNonceSize:]); err != nil {
+		return nil, err
+	}
+
+	fsm.SetVar(tlsRecvSeqVar, expected+1)
+	return plaintext, nil
+}
+
+// parseTLSRecord returns the record as TLS_RECORD if data is exactly one
+// complete TLS record.
+func parseTLSRecord(data string) map[string]string {
+	if len(data) < tlsRecordHeaderSize {
+		return nil
+	}
+	switch data[0] {
+	case TLSChangeCipherSpec, TLSAlert, TLSHandshake, TLSApplicationData:
+	default:
+		return nil
+	}
+
+	if n := int(binary.BigEndian.Uint16([]byte(data[3:5]))); len(data) != tlsRecordHeaderSize+n {
+		return nil
+	}
+	return map[string]string{"TLS_RECORD": data}
+}
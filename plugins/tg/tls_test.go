@@ -0,0 +1,111 @@
+package tg_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mock"
+	"github.com/redjack/marionette/plugins/tg"
+)
+
+func TestParse_TLSRecord(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		if m := tg.Parse("tls_application_data", "\x17\x03\x03\x00\x03foo"); m["TLS_RECORD"] != "\x17\x03\x03\x00\x03foo" {
+			t.Fatalf("unexpected match: %#v", m)
+		}
+	})
+
+	t.Run("Incomplete", func(t *testing.T) {
+		if m := tg.Parse("tls_application_data", "\x17\x03\x03\x00\x03fo"); m != nil {
+			t.Fatalf("unexpected match: %#v", m)
+		}
+	})
+
+	t.Run("InvalidContentType", func(t *testing.T) {
+		if m := tg.Parse("tls_application_data", "\x01\x03\x03\x00\x03foo"); m != nil {
+			t.Fatalf("unexpected match: %#v", m)
+		}
+	})
+}
+
+// Ensure records are sent with increasing sequence numbers and that the
+// receiver decodes them in order.
+func TestTLSRecordCipher(t *testing.T) {
+	cipher := tg.NewTLSRecordCipher("TLS_RECORD", tg.TLSApplicationData, 64)
+
+	conn := mock.DefaultConn()
+	sender := newSharedFSM(&conn, marionette.NewStreamSet())
+	receiver := newSharedFSM(&conn, marionette.NewStreamSet())
+
+	var records [][]byte
+	for _, s := range []string{"foo", "bar"} {
+		record, err := cipher.Encrypt(sender, "", []byte(s))
+		if err != nil {
+			t.Fatal(err)
+		} else if record[0] != tg.TLSApplicationData || record[1] != 0x03 || record[2] != 0x03 {
+			t.Fatalf("unexpected header: %x", record[:5])
+		} else if n := int(record[3])<<8 | int(record[4]); n != len(record)-5 {
+			t.Fatalf("unexpected length: %d", n)
+		}
+		records = append(records, record)
+	}
+
+	// Out of order records are rejected.
+	if _, err := cipher.Decrypt(receiver, records[1]); err == nil || err.Error() != `tls sequence mismatch: got=1, expected=0` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, s := range []string{"foo", "bar"} {
+		if plaintext, err := cipher.Decrypt(receiver, records[i]); err != nil {
+			t.Fatal(err)
+		} else if string(plaintext) != s {
+			t.Fatalf("unexpected plaintext: %q", plaintext)
+		}
+	}
+}
+
+// Ensure a cell can be sent & received using the TLS grammar.
+func TestSend_TLSApplicationData(t *testing.T) {
+	var msg []byte
+	sendConn := mock.DefaultConn()
+	sendConn.ReadFn = func(p []byte) (int, error) { <-make(chan struct{}); return 0, nil }
+	sendConn.WriteFn = func(p []byte) (int, error) {
+		msg = append([]byte(nil), p...)
+		return len(p), nil
+	}
+	sendStreamSet := marionette.NewStreamSet()
+	if _, err := sendStreamSet.Create().Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tg.Send(context.Background(), newSharedFSM(&sendConn, sendStreamSet), "tls_application_data"); err != nil {
+		t.Fatal(err)
+	}
+
+	var read bool
+	recvConn := mock.DefaultConn()
+	recvConn.ReadFn = func(p []byte) (int, error) {
+		if read {
+			<-make(chan struct{})
+		}
+		read = true
+		return copy(p, msg), nil
+	}
+
+	var stream *marionette.Stream
+	recvStreamSet := marionette.NewStreamSet()
+	recvStreamSet.OnNewStream = func(s *marionette.Stream) { stream = s }
+	if err := tg.Recv(context.Background(), newSharedFSM(&recvConn, recvStreamSet), "tls_application_data"); err != nil {
+		t.Fatal(err)
+	} else if stream == nil {
+		t.Fatal("expected stream")
+	}
+
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(stream, buf); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "foo" {
+		t.Fatalf("unexpected read: %q", buf)
+	}
+}
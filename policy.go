@@ -0,0 +1,203 @@
+package marionette
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/redjack/marionette/mar"
+)
+
+// Actions a policy rule applies to a connection.
+const (
+	PolicyAllow    = "allow"    // served normally
+	PolicyDeny     = "deny"     // closed immediately
+	PolicyDecoy    = "decoy"    // relayed to the listener's decoy without being served
+	PolicyThrottle = "throttle" // served with a rate limit
+	PolicyFormat   = "format"   // served with another format
+)
+
+// GeoInfo is the location & network of an IP address.
+type GeoInfo struct {
+	Country string // ISO 3166-1 alpha-2 code, such as "US"
+	ASN     uint   // autonomous system number
+}
+
+// GeoLookup looks up the location & network of IP addresses, such as from a
+// MaxMind database.
+type GeoLookup interface {
+	LookupGeo(ip net.IP) (GeoInfo, error)
+}
+
+// Policy is an ordered list of rules which choose how a listener treats a
+// connection by the country & network it comes from. The first rule matching
+// a connection applies. Connections which match no rule are allowed.
+type Policy struct {
+	mu sync.RWMutex
+
+	// Rules must only be changed with SetRules() once the policy is in use.
+	Rules []PolicyRule
+
+	// Looks up connections' countries & networks. Connections which cannot
+	// be looked up only match rules for all connections.
+	Geo GeoLookup
+}
+
+// PolicyRule applies an action to connections from a set of countries &
+// networks.
+type PolicyRule struct {
+	Action string
+
+	// Matches connections from any of the countries or ASNs. Matches all
+	// connections if both are empty.
+	Countries []string
+	ASNs      []uint
+
+	// Limit, in bytes per second, of a throttled connection.
+	RateLimit int
+
+	// Name & server document of the format used by the format action. The
+	// format must use the listener's transport and is served on its port.
+	Format string
+	Doc    *mar.Document
+}
+
+// Rule returns the first rule matching connections from ip, or an allow rule
+// if none match.
+func (p *Policy) Rule(ip net.IP) PolicyRule {
+	var info GeoInfo
+	if p.Geo != nil && ip != nil {
+		if other, err := p.Geo.LookupGeo(ip); err == nil {
+			info = other
+		}
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for i := range p.Rules {
+		if p.Rules[i].match(info) {
+			return p.Rules[i]
+		}
+	}
+	return PolicyRule{Action: PolicyAllow}
+}
+
+// SetRules replaces the rules. Connections already accepted keep their rule.
+func (p *Policy) SetRules(rules []PolicyRule) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.Rules = rules
+}
+
+func (r *PolicyRule) match(info GeoInfo) bool {
+	if len(r.Countries) == 0 && len(r.ASNs) == 0 {
+		return true
+	}
+	for _, country := range r.Countries {
+		if info.Country != "" && strings.EqualFold(country, info.Country) {
+			return true
+		}
+	}
+	for _, asn := range r.ASNs {
+		if info.ASN != 0 && asn == info.ASN {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadPolicyFile parses a policy from a file. See ParsePolicy() for the format.
+func ReadPolicyFile(path string) (*Policy, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParsePolicy(f)
+}
+
+// ParsePolicy parses policy rules, one per line, in the form:
+//
+//	allow|deny|decoy SOURCES
+//	throttle SOURCES BYTES_PER_SEC
+//	format SOURCES FORMAT
+//
+// SOURCES is "*" or a comma-separated list of two letter country codes and
+// ASNs prefixed with "AS", such as "CN,IR,AS4134". FORMAT is a format name &
+// optional version, such as "http_simple_blocking:20150701". Blank lines and
+// lines starting with "#" are ignored.
+func ParsePolicy(r io.Reader) (*Policy, error) {
+	p := &Policy{}
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule, err := parsePolicyRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("policy: %s at line %d", err, lineNo)
+		}
+		p.Rules = append(p.Rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func parsePolicyRule(line string) (rule PolicyRule, err error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return rule, errors.New("expected action and sources")
+	}
+
+	rule.Action = fields[0]
+	switch rule.Action {
+	case PolicyAllow, PolicyDeny, PolicyDecoy:
+		if len(fields) != 2 {
+			return rule, fmt.Errorf("unexpected argument to %s", rule.Action)
+		}
+	case PolicyThrottle:
+		if len(fields) != 3 {
+			return rule, errors.New("expected throttle rate")
+		} else if rule.RateLimit, err = strconv.Atoi(fields[2]); err != nil || rule.RateLimit <= 0 {
+			return rule, fmt.Errorf("invalid throttle rate: %q", fields[2])
+		}
+	case PolicyFormat:
+		if len(fields) != 3 {
+			return rule, errors.New("expected format")
+		}
+		rule.Format = fields[2]
+		if rule.Doc, err = readFormatDocument(PartyServer, rule.Format); err != nil {
+			return rule, err
+		}
+	default:
+		return rule, fmt.Errorf("invalid action: %q", fields[0])
+	}
+
+	if fields[1] == "*" {
+		return rule, nil
+	}
+	for _, source := range strings.Split(fields[1], ",") {
+		if s := strings.ToUpper(source); strings.HasPrefix(s, "AS") {
+			asn, err := strconv.ParseUint(s[2:], 10, 32)
+			if err != nil || asn == 0 {
+				return rule, fmt.Errorf("invalid asn: %q", source)
+			}
+			rule.ASNs = append(rule.ASNs, uint(asn))
+		} else if len(s) == 2 && s[0] >= 'A' && s[0] <= 'Z' && s[1] >= 'A' && s[1] <= 'Z' {
+			rule.Countries = append(rule.Countries, s)
+		} else {
+			return rule, fmt.Errorf("invalid source: %q", source)
+		}
+	}
+	return rule, nil
+}
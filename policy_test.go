@@ -0,0 +1,122 @@
+package marionette_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := marionette.ParsePolicy(strings.NewReader(`
+# Hide the bridge from scanners' networks.
+decoy    AS4134,as4837
+deny     KP
+throttle IR 65536
+format   cn,RU http_simple_blocking:20150701
+allow    *
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy.Geo = geoLookup{
+		"192.0.2.1": {Country: "CN", ASN: 4134},
+		"192.0.2.2": {Country: "CN", ASN: 4837},
+		"192.0.2.3": {Country: "KP"},
+		"192.0.2.4": {Country: "IR", ASN: 197207},
+		"192.0.2.5": {Country: "RU"},
+		"192.0.2.6": {Country: "CN"},
+		"192.0.2.7": {Country: "US", ASN: 15169},
+	}
+
+	for _, tt := range []struct {
+		ip     string
+		action string
+	}{
+		{"192.0.2.1", marionette.PolicyDecoy},
+		{"192.0.2.2", marionette.PolicyDecoy},
+		{"192.0.2.3", marionette.PolicyDeny},
+		{"192.0.2.4", marionette.PolicyThrottle},
+		{"192.0.2.5", marionette.PolicyFormat},
+		{"192.0.2.6", marionette.PolicyFormat},
+		{"192.0.2.7", marionette.PolicyAllow},
+		{"198.51.100.1", marionette.PolicyAllow},
+	} {
+		if rule := policy.Rule(net.ParseIP(tt.ip)); rule.Action != tt.action {
+			t.Errorf("Rule(%s)=%s, expected %s", tt.ip, rule.Action, tt.action)
+		}
+	}
+
+	if rule := policy.Rule(net.ParseIP("192.0.2.4")); rule.RateLimit != 65536 {
+		t.Fatalf("unexpected rate limit: %d", rule.RateLimit)
+	} else if rule := policy.Rule(net.ParseIP("192.0.2.5")); rule.Doc == nil || rule.Format != "http_simple_blocking:20150701" {
+		t.Fatalf("unexpected format: %+v", rule)
+	}
+
+	t.Run("ErrInvalidAction", func(t *testing.T) {
+		if _, err := marionette.ParsePolicy(strings.NewReader("\nblock *\n")); err == nil || err.Error() != `policy: invalid action: "block" at line 2` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrInvalidSource", func(t *testing.T) {
+		if _, err := marionette.ParsePolicy(strings.NewReader("deny CHN\n")); err == nil || err.Error() != `policy: invalid source: "CHN" at line 1` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ErrInvalidRate", func(t *testing.T) {
+		if _, err := marionette.ParsePolicy(strings.NewReader("throttle * 0\n")); err == nil || err.Error() != `policy: invalid throttle rate: "0" at line 1` {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// Ensure connections are relayed to the decoy without being served when the
+// policy says so.
+func TestListener_Policy(t *testing.T) {
+	decoy := mustEchoServer(t)
+	defer decoy.Close()
+
+	policy, err := marionette.ParsePolicy(strings.NewReader("decoy *\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln := mustListen(t, mar.MustParse(marionette.PartyServer, []byte(decoyTestDoc)),
+		marionette.WithDecoy(decoy.Addr().String(), 0),
+		marionette.WithPolicy(policy),
+	)
+	defer ln.Close()
+
+	conn := mustDial(t, ln)
+	defer conn.Close()
+	data := []byte("GET / HTTP/1.0\r\n\r\n")
+	if _, err := conn.Write(data); err != nil {
+		t.Fatal(err)
+	}
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, len(data))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(buf, data) {
+		t.Fatalf("unexpected data from decoy: %q", buf)
+	}
+}
+
+// geoLookup is a GeoLookup of IP addresses in a map.
+type geoLookup map[string]marionette.GeoInfo
+
+func (m geoLookup) LookupGeo(ip net.IP) (marionette.GeoInfo, error) {
+	info, ok := m[ip.String()]
+	if !ok {
+		return info, errors.New("address not found")
+	}
+	return info, nil
+}
@@ -0,0 +1,150 @@
+package marionette
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"time"
+)
+
+// DefaultProbeAlertWindow is the default time in which a listener's probes
+// count towards an alert.
+const DefaultProbeAlertWindow = 10 * time.Minute
+
+// Kinds of active probes detected by a listener.
+const (
+	ProbeSilent          = "silent"          // sent nothing before closing or timing out
+	ProbeReplay          = "replay"          // replayed another connection's AUTH cell
+	ProbeKnown           = "known"           // began like a known scanner's request
+	ProbeUnauthenticated = "unauthenticated" // did not prove knowledge of the bridge secret
+	ProbeMalformed       = "malformed"       // sent data which the format could not decode
+)
+
+// maxProbePrefix is the number of bytes recorded from the start of a
+// connection to match against ProbePatterns.
+const maxProbePrefix = 64
+
+// ProbePattern is the beginning of a request sent by known scanners.
+type ProbePattern struct {
+	Name   string
+	Prefix []byte
+}
+
+// ProbePatterns are the patterns which a connection failing its handshake is
+// matched against. Formats which mimic one of these protocols still match,
+// so only connections which fail the handshake are checked.
+var ProbePatterns = []ProbePattern{
+	{Name: "http", Prefix: []byte("GET ")},
+	{Name: "http", Prefix: []byte("HEAD ")},
+	{Name: "http", Prefix: []byte("POST ")},
+	{Name: "http", Prefix: []byte("OPTIONS ")},
+	{Name: "http-proxy", Prefix: []byte("CONNECT ")},
+	{Name: "tls", Prefix: []byte{0x16, 0x03}},
+	{Name: "ssh", Prefix: []byte("SSH-")},
+	{Name: "socks4", Prefix: []byte{0x04, 0x01}},
+	{Name: "socks5", Prefix: []byte{0x05, 0x01, 0x00}},
+	{Name: "crlf", Prefix: []byte("\r\n\r\n")},
+}
+
+// matchProbePattern returns the name of the first pattern which prefix begins
+// with, if any.
+func matchProbePattern(prefix []byte) string {
+	for _, p := range ProbePatterns {
+		if bytes.HasPrefix(prefix, p.Prefix) {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// classifyProbe returns the kind of probe, and the pattern matched if any, of
+// a connection which failed its handshake with err after the raw bytes read
+// beginning with prefix. Returns an empty kind if it doesn't look like a probe.
+func classifyProbe(err error, n int64, prefix []byte) (kind, pattern string) {
+	if n == 0 {
+		return ProbeSilent, ""
+	} else if err == ErrReplay {
+		return ProbeReplay, ""
+	} else if pattern = matchProbePattern(prefix); pattern != "" {
+		return ProbeKnown, pattern
+	} else if err == ErrUnauthenticated {
+		return ProbeUnauthenticated, ""
+	} else if err != nil {
+		return ProbeMalformed, ""
+	}
+	return "", ""
+}
+
+// probeConn records the number of bytes read from a connection and the first
+// bytes read so that a failed handshake can be classified.
+type probeConn struct {
+	net.Conn
+
+	mu      sync.Mutex
+	n       int64
+	prefix  []byte
+	stopped bool // true once the connection is relayed elsewhere
+}
+
+// newProbeConn returns a connection which records reads from conn.
+func newProbeConn(conn net.Conn) *probeConn {
+	return &probeConn{Conn: conn}
+}
+
+func (c *probeConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stopped {
+		return n, err
+	}
+	c.n += int64(n)
+	if i := maxProbePrefix - len(c.prefix); i > 0 {
+		if i > n {
+			i = n
+		}
+		c.prefix = append(c.prefix, p[:i]...)
+	}
+	return n, err
+}
+
+// stop stops recording reads, such as before relaying to a decoy.
+func (c *probeConn) stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+}
+
+// stats returns the number of bytes read & the first bytes read.
+func (c *probeConn) stats() (int64, []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.n, c.prefix
+}
+
+// probeCounter counts the probes detected within a window to decide when to
+// raise an alert.
+type probeCounter struct {
+	mu    sync.Mutex
+	start time.Time
+	n     int
+}
+
+// add counts a probe at time t. Returns the number of probes in the current
+// window & true once threshold probes are counted within window, after which
+// a new window begins.
+func (c *probeCounter) add(t time.Time, threshold int, window time.Duration) (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.n == 0 || t.Sub(c.start) > window {
+		c.start, c.n = t, 0
+	}
+	c.n++
+	if n := c.n; threshold > 0 && n >= threshold {
+		c.n = 0
+		return n, true
+	}
+	return c.n, false
+}
@@ -0,0 +1,73 @@
+package marionette_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// Ensure listeners publish probe events for failed handshakes which look like
+// active probes, and an alert once enough are detected.
+func TestListener_DetectProbes(t *testing.T) {
+	sink := &eventRecorder{}
+	marionette.AddEventSink(sink)
+	defer marionette.RemoveEventSink(sink)
+
+	ln := mustListen(t, mar.MustParse(marionette.PartyServer, []byte(decoyTestDoc)),
+		marionette.WithAuthSecret(mustAuthSecret(t)),
+		marionette.WithProbeDetection(2, time.Minute),
+	)
+	defer ln.Close()
+
+	t.Run("Known", func(t *testing.T) {
+		conn := mustDial(t, ln)
+		mustWrite(t, conn, []byte("GET / HTTP/1.1\r\n\r\n"))
+		time.Sleep(100 * time.Millisecond)
+		conn.Close()
+
+		e := waitEvent(t, sink, marionette.EventProbe)
+		if e.Probe != marionette.ProbeKnown || e.Pattern != "http" || e.BytesRead == 0 {
+			t.Fatalf("unexpected event: %+v", e)
+		}
+	})
+
+	t.Run("Silent", func(t *testing.T) {
+		conn := mustDial(t, ln)
+		time.Sleep(100 * time.Millisecond)
+		conn.Close()
+
+		e := waitEvent(t, sink, marionette.EventProbeAlert)
+		if e.Probes != 2 {
+			t.Fatalf("unexpected event: %+v", e)
+		} else if e := sink.last(marionette.EventProbe); e.Probe != marionette.ProbeSilent {
+			t.Fatalf("unexpected event: %+v", e)
+		}
+	})
+}
+
+// waitEvent waits for r to receive an event of the given type.
+func waitEvent(tb testing.TB, r *eventRecorder, typ string) *marionette.Event {
+	tb.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if e := r.last(typ); e != nil {
+			return e
+		}
+	}
+	tb.Fatalf("expected %s event", typ)
+	return nil
+}
+
+// last returns the last event of the given type, if any.
+func (r *eventRecorder) last(typ string) *marionette.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.events) - 1; i >= 0; i-- {
+		if r.events[i].Type == typ {
+			e := r.events[i]
+			return &e
+		}
+	}
+	return nil
+}
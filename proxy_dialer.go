@@ -0,0 +1,268 @@
+package marionette
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ProxyDialer connects to destinations through an upstream SOCKS or HTTP
+// proxy. The socks5, socks4a, and http schemes allowed by the pluggable
+// transport TOR_PT_PROXY setting are supported, as well as https for HTTP
+// proxies connected to over TLS. Credentials are taken from the URL's user info.
+type ProxyDialer struct {
+	URL *url.URL
+
+	// Dialer used to connect to the proxy. Uses a net.Dialer if nil.
+	Dialer NetDialer
+
+	// TLS configuration used to connect to https proxies. The server name
+	// defaults to the proxy's host name.
+	TLSConfig *tls.Config
+}
+
+// NewProxyDialer returns a new ProxyDialer for the proxy at u.
+func NewProxyDialer(u *url.URL, forward NetDialer) (*ProxyDialer, error) {
+	switch u.Scheme {
+	case "socks5", "socks4a", "http", "https":
+	default:
+		return nil, fmt.Errorf("proxy: unsupported scheme: %q", u.Scheme)
+	}
+
+	if _, port, err := net.SplitHostPort(u.Host); err != nil || port == "" {
+		return nil, fmt.Errorf("proxy: host and port required: %q", u.Host)
+	}
+
+	if u.User != nil {
+		password, _ := u.User.Password()
+		if u.Scheme == "socks5" && (len(u.User.Username()) > 255 || len(password) > 255) {
+			return nil, errors.New("proxy: socks5 username and password must be at most 255 bytes")
+		} else if u.Scheme == "socks4a" && password != "" {
+			return nil, errors.New("proxy: socks4a does not support passwords")
+		}
+	}
+
+	return &ProxyDialer{URL: u, Dialer: forward}, nil
+}
+
+// Dial connects to address through the proxy.
+func (d *ProxyDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+// DialContext connects to address through the proxy. Only the "tcp" network
+// is supported.
+func (d *ProxyDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if network != "tcp" {
+		return nil, fmt.Errorf("proxy: unsupported network: %s", network)
+	}
+
+	forward := d.Dialer
+	if forward == nil {
+		forward = &net.Dialer{}
+	}
+	conn, err := forward.DialContext(ctx, "tcp", d.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	// Abort the handshake if the context is done.
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			conn.SetDeadline(time.Unix(1, 0))
+		case <-done:
+		}
+	}()
+
+	switch d.URL.Scheme {
+	case "socks5":
+		err = d.connectSocks5(conn, address)
+	case "socks4a":
+		err = d.connectSocks4a(conn, address)
+	case "http":
+		conn, err = d.connectHTTP(conn, address)
+	case "https":
+		if conn, err = d.handshakeTLS(ctx, conn); err == nil {
+			conn, err = d.connectHTTP(conn, address)
+		}
+	default:
+		err = fmt.Errorf("proxy: unsupported scheme: %q", d.URL.Scheme)
+	}
+	close(done)
+	<-stopped
+
+	if err == nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// connectSocks5 negotiates authentication and requests a connection to address.
+func (d *ProxyDialer) connectSocks5(conn net.Conn, address string) error {
+	method := byte(socks5AuthNone)
+	if d.URL.User != nil {
+		method = socks5AuthPassword
+	}
+	if _, err := conn.Write([]byte{socks5Version, 1, method}); err != nil {
+		return err
+	}
+
+	var buf [2]byte
+	if _, err := io.ReadFull(conn, buf[:]); err != nil {
+		return err
+	} else if buf[0] != socks5Version {
+		return fmt.Errorf("proxy: unsupported socks version: %d", buf[0])
+	} else if buf[1] != method {
+		return errors.New("proxy: no acceptable socks authentication method")
+	}
+
+	if method == socks5AuthPassword {
+		username := d.URL.User.Username()
+		password, _ := d.URL.User.Password()
+		req := append([]byte{0x01, byte(len(username))}, username...)
+		req = append(append(req, byte(len(password))), password...)
+		if _, err := conn.Write(req); err != nil {
+			return err
+		} else if _, err := io.ReadFull(conn, buf[:]); err != nil {
+			return err
+		} else if buf[1] != 0x00 {
+			return errors.New("proxy: invalid socks username or password")
+		}
+	}
+
+	req, err := appendSocks5Addr([]byte{socks5Version, socks5Connect, 0}, address)
+	if err != nil {
+		return err
+	} else if _, err := conn.Write(req); err != nil {
+		return err
+	}
+
+	var hdr [3]byte
+	if _, err := io.ReadFull(conn, hdr[:]); err != nil {
+		return err
+	} else if hdr[1] != socks5ReplySucceeded {
+		return fmt.Errorf("proxy: socks connect failed: %d", hdr[1])
+	}
+	_, err = readSocks5Addr(conn)
+	return err
+}
+
+// connectSocks4a requests a connection to address. Host names are resolved by
+// the proxy. IPv6 destinations are not supported by the protocol.
+func (d *ProxyDialer) connectSocks4a(conn net.Conn, address string) error {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 0 || port > 65535 {
+		return fmt.Errorf("proxy: invalid port: %q", portStr)
+	}
+
+	// An address of 0.0.0.x indicates the host name follows the user id.
+	ip := net.IPv4(0, 0, 0, 1).To4()
+	if v := net.ParseIP(host); v != nil && v.To4() != nil {
+		ip, host = v.To4(), ""
+	} else if v != nil {
+		return errors.New("proxy: socks4a does not support ipv6 addresses")
+	}
+
+	req := append([]byte{0x04, 0x01, byte(port >> 8), byte(port)}, ip...)
+	if d.URL.User != nil {
+		req = append(req, d.URL.User.Username()...)
+	}
+	req = append(req, 0)
+	if host != "" {
+		req = append(append(req, host...), 0)
+	}
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+
+	var reply [8]byte
+	if _, err := io.ReadFull(conn, reply[:]); err != nil {
+		return err
+	} else if reply[1] != 0x5A {
+		return fmt.Errorf("proxy: socks connect failed: %d", reply[1])
+	}
+	return nil
+}
+
+// handshakeTLS returns conn wrapped in a TLS client connection to the proxy.
+func (d *ProxyDialer) handshakeTLS(ctx context.Context, conn net.Conn) (net.Conn, error) {
+	config := &tls.Config{}
+	if d.TLSConfig != nil {
+		config = d.TLSConfig.Clone()
+	}
+	if config.ServerName == "" {
+		config.ServerName = d.URL.Hostname()
+	}
+
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return conn, err
+	}
+	return tlsConn, nil
+}
+
+// connectHTTP sends a CONNECT request for address. Returns a connection which
+// includes any data read past the proxy's response.
+func (d *ProxyDialer) connectHTTP(conn net.Conn, address string) (net.Conn, error) {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if d.URL.User != nil {
+		password, _ := d.URL.User.Password()
+		auth := d.URL.User.Username() + ":" + password
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(auth)))
+	}
+	if err := req.Write(conn); err != nil {
+		return conn, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return conn, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return conn, fmt.Errorf("proxy: http connect failed: %s", resp.Status)
+	}
+
+	if br.Buffered() == 0 {
+		return conn, nil
+	}
+	return &bufferedReadConn{Conn: conn, r: br}, nil
+}
+
+// bufferedReadConn reads from a buffered reader wrapping its connection.
+type bufferedReadConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *bufferedReadConn) Read(p []byte) (int, error) { return c.r.Read(p) }
@@ -0,0 +1,95 @@
+package marionette
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// PROXY protocol versions which can be sent to backends.
+const (
+	ProxyProtocolV1 = 1
+	ProxyProtocolV2 = 2
+)
+
+// proxyProtocolV2Sig is the signature which begins a version 2 header.
+var proxyProtocolV2Sig = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+// WriteProxyHeader writes a PROXY protocol header to w describing a TCP
+// connection from src to dst, so that a backend such as nginx or haproxy sees
+// the original client address. Addresses which are not TCP are sent as
+// unknown and the backend uses the connection's own addresses.
+func WriteProxyHeader(w io.Writer, version int, src, dst net.Addr) error {
+	var buf []byte
+	switch version {
+	case ProxyProtocolV1:
+		buf = proxyHeaderV1(src, dst)
+	case ProxyProtocolV2:
+		buf = proxyHeaderV2(src, dst)
+	default:
+		return fmt.Errorf("unsupported proxy protocol version: %d", version)
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// proxyHeaderV1 returns a human-readable version 1 header.
+func proxyHeaderV1(src, dst net.Addr) []byte {
+	s, d, v6, ok := proxyAddrs(src, dst)
+	if !ok {
+		return []byte("PROXY UNKNOWN\r\n")
+	} else if !v6 {
+		return []byte("PROXY TCP4 " + s.IP.String() + " " + d.IP.String() + " " + strconv.Itoa(s.Port) + " " + strconv.Itoa(d.Port) + "\r\n")
+	}
+	return []byte("PROXY TCP6 " + proxyIPv6String(s.IP) + " " + proxyIPv6String(d.IP) + " " + strconv.Itoa(s.Port) + " " + strconv.Itoa(d.Port) + "\r\n")
+}
+
+// proxyIPv6String formats ip as IPv6, mapping IPv4 addresses.
+func proxyIPv6String(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return "::ffff:" + ip4.String()
+	}
+	return ip.String()
+}
+
+// proxyHeaderV2 returns a binary version 2 header.
+func proxyHeaderV2(src, dst net.Addr) []byte {
+	var buf bytes.Buffer
+	buf.Write(proxyProtocolV2Sig)
+	buf.WriteByte(0x21) // version 2, PROXY command
+
+	s, d, v6, ok := proxyAddrs(src, dst)
+	switch {
+	case !ok:
+		buf.Write([]byte{0x00, 0, 0}) // unspecified family, no addresses
+		return buf.Bytes()
+	case !v6:
+		buf.WriteByte(0x11) // TCP over IPv4
+		binary.Write(&buf, binary.BigEndian, uint16(12))
+		buf.Write(s.IP.To4())
+		buf.Write(d.IP.To4())
+	default:
+		buf.WriteByte(0x21) // TCP over IPv6
+		binary.Write(&buf, binary.BigEndian, uint16(36))
+		buf.Write(s.IP.To16())
+		buf.Write(d.IP.To16())
+	}
+	binary.Write(&buf, binary.BigEndian, uint16(s.Port))
+	binary.Write(&buf, binary.BigEndian, uint16(d.Port))
+	return buf.Bytes()
+}
+
+// proxyAddrs returns src and dst as TCP addresses. If either is an IPv6
+// address then v6 is true and both are sent as IPv6. Returns false if either
+// address is not TCP.
+func proxyAddrs(src, dst net.Addr) (s, d *net.TCPAddr, v6, ok bool) {
+	s, ok1 := src.(*net.TCPAddr)
+	d, ok2 := dst.(*net.TCPAddr)
+	if !ok1 || !ok2 || s == nil || d == nil || s.IP == nil || d.IP == nil {
+		return nil, nil, false, false
+	}
+	return s, d, s.IP.To4() == nil || d.IP.To4() == nil, true
+}
@@ -0,0 +1,55 @@
+package marionette_test
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestWriteProxyHeader(t *testing.T) {
+	src4 := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 56324}
+	dst4 := &net.TCPAddr{IP: net.ParseIP("198.51.100.1"), Port: 443}
+	src6 := &net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 56324}
+
+	for _, tt := range []struct {
+		name     string
+		version  int
+		src, dst net.Addr
+		exp      string
+	}{
+		{"V1/TCP4", 1, src4, dst4, "PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n"},
+		{"V1/TCP6", 1, src6, dst4, "PROXY TCP6 2001:db8::1 ::ffff:198.51.100.1 56324 443\r\n"},
+		{"V1/Unknown", 1, nil, dst4, "PROXY UNKNOWN\r\n"},
+		{"V2/TCP4", 2, src4, dst4, "\r\n\r\n\x00\r\nQUIT\n\x21\x11\x00\x0c" +
+			"\xc0\x00\x02\x01" + "\xc6\x33\x64\x01" + "\xdc\x04" + "\x01\xbb"},
+		{"V2/Unknown", 2, &net.UnixAddr{Name: "/tmp/sock", Net: "unix"}, dst4, "\r\n\r\n\x00\r\nQUIT\n\x21\x00\x00\x00"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := marionette.WriteProxyHeader(&buf, tt.version, tt.src, tt.dst); err != nil {
+				t.Fatal(err)
+			} else if buf.String() != tt.exp {
+				t.Fatalf("unexpected header: %q", buf.String())
+			}
+		})
+	}
+
+	t.Run("V2/TCP6", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := marionette.WriteProxyHeader(&buf, 2, src6, dst4); err != nil {
+			t.Fatal(err)
+		} else if buf.Len() != 16+36 {
+			t.Fatalf("unexpected header length: %d", buf.Len())
+		} else if b := buf.Bytes(); b[13] != 0x21 || !bytes.Equal(b[16:32], net.ParseIP("2001:db8::1")) || !bytes.Equal(b[32:48], net.ParseIP("198.51.100.1").To16()) {
+			t.Fatalf("unexpected header: %x", b)
+		}
+	})
+
+	t.Run("ErrUnsupportedVersion", func(t *testing.T) {
+		if err := marionette.WriteProxyHeader(&bytes.Buffer{}, 3, src4, dst4); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
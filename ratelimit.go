@@ -0,0 +1,139 @@
+package marionette
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket which limits throughput to a number of bytes
+// per second. Up to one second of unused throughput may accumulate to allow
+// short bursts. A single limiter can be shared by multiple connections.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // bytes per second
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter allowing bytesPerSec bytes per second. A
+// limit of zero or less allows unlimited throughput.
+func NewRateLimiter(bytesPerSec int) *RateLimiter {
+	return &RateLimiter{
+		rate:   float64(bytesPerSec),
+		tokens: float64(bytesPerSec),
+		last:   time.Now(),
+	}
+}
+
+// Rate returns the limit in bytes per second.
+func (l *RateLimiter) Rate() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.rate)
+}
+
+// SetRate changes the limit to bytesPerSec bytes per second. The change
+// applies immediately to everything sharing the limiter.
+func (l *RateLimiter) SetRate(bytesPerSec int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate, l.tokens, l.last = float64(bytesPerSec), float64(bytesPerSec), time.Now()
+}
+
+// WaitN blocks until n bytes are allowed or ctx is done. Requests larger than
+// the burst size are allowed but delay later requests until they are repaid.
+func (l *RateLimiter) WaitN(ctx context.Context, n int) error {
+	if err := l.wait(n, ctx.Done()); err != nil {
+		return ctx.Err()
+	}
+	return nil
+}
+
+func (l *RateLimiter) wait(n int, done <-chan struct{}) error {
+	if n <= 0 {
+		return nil
+	}
+
+	d := l.reserve(n)
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return context.Canceled
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes n tokens and returns how long to wait until they are available.
+func (l *RateLimiter) reserve(n int) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+
+	l.tokens -= float64(n)
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// RateLimitConn returns conn with reads & writes limited by each limiter.
+// Nil limiters are ignored. Returns conn if there are no limiters.
+func RateLimitConn(conn net.Conn, limiters ...*RateLimiter) net.Conn {
+	var a []*RateLimiter
+	for _, l := range limiters {
+		if l != nil {
+			a = append(a, l)
+		}
+	}
+	if len(a) == 0 {
+		return conn
+	}
+	return &rateLimitedConn{Conn: conn, limiters: a}
+}
+
+// rateLimitedConn limits throughput of a connection.
+type rateLimitedConn struct {
+	net.Conn
+	limiters []*RateLimiter
+}
+
+func (c *rateLimitedConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.wait(n)
+	return n, err
+}
+
+func (c *rateLimitedConn) Write(p []byte) (int, error) {
+	c.wait(len(p))
+	return c.Conn.Write(p)
+}
+
+// CloseWrite half-closes the underlying connection, if supported.
+func (c *rateLimitedConn) CloseWrite() error {
+	return closeWrite(c.Conn)
+}
+
+func (c *rateLimitedConn) wait(n int) {
+	for _, l := range c.limiters {
+		l.wait(n, nil)
+	}
+}
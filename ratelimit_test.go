@@ -0,0 +1,101 @@
+package marionette_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestRateLimiter_WaitN(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		l := marionette.NewRateLimiter(1000)
+
+		// Initial burst is allowed immediately.
+		t0 := time.Now()
+		if err := l.WaitN(context.Background(), 1000); err != nil {
+			t.Fatal(err)
+		} else if d := time.Since(t0); d > 100*time.Millisecond {
+			t.Fatalf("unexpected wait: %s", d)
+		}
+
+		// Subsequent bytes wait for the bucket to refill.
+		t0 = time.Now()
+		if err := l.WaitN(context.Background(), 200); err != nil {
+			t.Fatal(err)
+		} else if d := time.Since(t0); d < 150*time.Millisecond {
+			t.Fatalf("unexpected wait: %s", d)
+		}
+	})
+
+	t.Run("ErrDeadlineExceeded", func(t *testing.T) {
+		l := marionette.NewRateLimiter(10)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if err := l.WaitN(ctx, 1000); err != context.DeadlineExceeded {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestRateLimiter_SetRate(t *testing.T) {
+	t.Run("Unlimited", func(t *testing.T) {
+		l := marionette.NewRateLimiter(10)
+		l.SetRate(0)
+
+		t0 := time.Now()
+		if err := l.WaitN(context.Background(), 1000000); err != nil {
+			t.Fatal(err)
+		} else if d := time.Since(t0); d > 100*time.Millisecond {
+			t.Fatalf("unexpected wait: %s", d)
+		}
+	})
+
+	t.Run("Limited", func(t *testing.T) {
+		l := marionette.NewRateLimiter(0)
+		l.SetRate(1000)
+		if n := l.Rate(); n != 1000 {
+			t.Fatalf("unexpected rate: %d", n)
+		}
+
+		// Burst of the new rate is allowed, then bytes wait for a refill.
+		if err := l.WaitN(context.Background(), 1000); err != nil {
+			t.Fatal(err)
+		}
+		t0 := time.Now()
+		if err := l.WaitN(context.Background(), 200); err != nil {
+			t.Fatal(err)
+		} else if d := time.Since(t0); d < 150*time.Millisecond {
+			t.Fatalf("unexpected wait: %s", d)
+		}
+	})
+}
+
+// Ensure connections sharing a limiter are limited together.
+func TestRateLimitConn(t *testing.T) {
+	l := marionette.NewRateLimiter(1000)
+
+	a, b := net.Pipe()
+	defer a.Close()
+	defer b.Close()
+	go io.Copy(ioutil.Discard, b)
+
+	conn := marionette.RateLimitConn(a, l, nil)
+	t0 := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := conn.Write(make([]byte, 500)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := time.Since(t0); d < 400*time.Millisecond {
+		t.Fatalf("unexpected duration: %s", d)
+	}
+
+	if other := marionette.RateLimitConn(a, nil); other != a {
+		t.Fatal("expected unwrapped connection")
+	}
+}
@@ -0,0 +1,177 @@
+package marionette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Default settings of a reachability reporter.
+const (
+	DefaultReachabilityInterval = 24 * time.Hour
+	DefaultReachabilityEpsilon  = 1.0
+)
+
+// ReachabilityReporter is an event sink which counts the client's successful
+// & failed handshakes for each format and posts them to a research collector
+// once per interval, to measure which formats are blocked where. Reports hold
+// no addresses, destinations or connection times: only noised counters, the
+// interval they cover and an optional, user-supplied autonomous system number.
+// Reporting is opt-in and the collector should not retain submitters' IPs.
+type ReachabilityReporter struct {
+	URL    string
+	Client *http.Client
+
+	// Autonomous system number of the client's network, if the user chooses
+	// to report it. Zero omits it.
+	ASN int
+
+	// Length of each reporting interval. Interval boundaries are aligned to
+	// UTC so that report times do not reveal when the client started.
+	Interval time.Duration
+
+	// Privacy budget of each counter. Smaller values add more noise.
+	Epsilon float64
+
+	mu        sync.Mutex
+	start     time.Time
+	successes map[string]int64
+	failures  map[string]int64
+	closed    chan struct{}
+	wg        sync.WaitGroup
+}
+
+// ReachabilityReport is the body posted to a collector for one interval.
+// Counters are noised, rounded & never negative.
+type ReachabilityReport struct {
+	Start   time.Time                      `json:"start"`
+	End     time.Time                      `json:"end"`
+	ASN     int                            `json:"asn,omitempty"`
+	Formats map[string]ReachabilityCounter `json:"formats"`
+}
+
+// ReachabilityCounter is the number of handshakes with a format which
+// succeeded & failed.
+type ReachabilityCounter struct {
+	Successes int64 `json:"successes"`
+	Failures  int64 `json:"failures"`
+}
+
+// NewReachabilityReporter returns a reporter posting JSON reports to url.
+func NewReachabilityReporter(url string) *ReachabilityReporter {
+	return &ReachabilityReporter{
+		URL:      url,
+		Client:   &http.Client{Timeout: WebhookTimeout},
+		Interval: DefaultReachabilityInterval,
+		Epsilon:  DefaultReachabilityEpsilon,
+
+		successes: make(map[string]int64),
+		failures:  make(map[string]int64),
+		closed:    make(chan struct{}),
+	}
+}
+
+// Open starts the first interval.
+func (r *ReachabilityReporter) Open() error {
+	r.start = time.Now().UTC().Truncate(r.Interval)
+	r.wg.Add(1)
+	go func() { defer r.wg.Done(); r.run() }()
+	return nil
+}
+
+// Close stops the reporter. Counts of the current, partial interval are
+// discarded as posting them would reveal when the client stopped.
+func (r *ReachabilityReporter) Close() error {
+	close(r.closed)
+	r.wg.Wait()
+	return nil
+}
+
+func (r *ReachabilityReporter) run() {
+	for {
+		timer := time.NewTimer(time.Until(r.start.Add(r.Interval)))
+		select {
+		case <-r.closed:
+			timer.Stop()
+			return
+		case <-timer.C:
+			if err := r.post(r.flush()); err != nil {
+				Logger.Debug("cannot post reachability report", zap.Error(err))
+			}
+		}
+	}
+}
+
+// WriteEvent counts the client's handshakes by format. A handshake event with
+// an error is a failure.
+func (r *ReachabilityReporter) WriteEvent(e *Event) error {
+	if e.Type != EventHandshake || e.Party != PartyClient {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e.Error == "" {
+		r.successes[e.Format]++
+		if _, ok := r.failures[e.Format]; !ok {
+			r.failures[e.Format] = 0
+		}
+	} else {
+		r.failures[e.Format]++
+		if _, ok := r.successes[e.Format]; !ok {
+			r.successes[e.Format] = 0
+		}
+	}
+	return nil
+}
+
+// flush returns a noised report of the current interval and starts the next.
+// Formats seen in earlier intervals are reported even if idle so that their
+// absence does not reveal a zero count.
+func (r *ReachabilityReporter) flush() *ReachabilityReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	end := r.start.Add(r.Interval)
+	report := &ReachabilityReport{
+		Start:   r.start,
+		End:     end,
+		ASN:     r.ASN,
+		Formats: make(map[string]ReachabilityCounter, len(r.successes)),
+	}
+	for format := range r.successes {
+		report.Formats[format] = ReachabilityCounter{
+			Successes: noisyCount(r.successes[format], 1/r.Epsilon),
+			Failures:  noisyCount(r.failures[format], 1/r.Epsilon),
+		}
+		r.successes[format], r.failures[format] = 0, 0
+	}
+	r.start = end
+	return report
+}
+
+// post sends a report to the collector.
+func (r *ReachabilityReporter) post(report *ReachabilityReport) error {
+	buf, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.Client.Post(r.URL, "application/json", bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
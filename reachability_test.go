@@ -0,0 +1,65 @@
+package marionette_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestReachabilityReporter(t *testing.T) {
+	reports := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		reports <- string(buf)
+	}))
+	defer srv.Close()
+
+	r := marionette.NewReachabilityReporter(srv.URL)
+	r.ASN = 64496
+	r.Interval = 200 * time.Millisecond
+	r.Epsilon = 1e9 // negligible noise
+	if err := r.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	r.WriteEvent(&marionette.Event{Type: marionette.EventHandshake, Party: marionette.PartyClient, Format: "http_simple_blocking", RemoteAddr: "203.0.113.1:8080"})
+	r.WriteEvent(&marionette.Event{Type: marionette.EventHandshake, Party: marionette.PartyClient, Format: "http_simple_blocking"})
+	r.WriteEvent(&marionette.Event{Type: marionette.EventHandshake, Party: marionette.PartyClient, Format: "ftp_simple_blocking", Error: "EOF"})
+	r.WriteEvent(&marionette.Event{Type: marionette.EventHandshake, Party: marionette.PartyServer, Format: "http_simple_blocking", Error: "EOF"})
+	r.WriteEvent(&marionette.Event{Type: marionette.EventConnOpened, Party: marionette.PartyClient, Format: "http_simple_blocking"})
+
+	var s string
+	select {
+	case s = <-reports:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for report")
+	}
+
+	// Addresses are never reported.
+	if strings.Contains(s, "203.0.113.1") {
+		t.Fatalf("unexpected address in report: %s", s)
+	}
+
+	var report marionette.ReachabilityReport
+	if err := json.Unmarshal([]byte(s), &report); err != nil {
+		t.Fatal(err)
+	} else if report.ASN != 64496 {
+		t.Fatalf("unexpected asn: %d", report.ASN)
+	} else if !report.Start.Equal(report.Start.Truncate(r.Interval)) || report.End.Sub(report.Start) != r.Interval {
+		t.Fatalf("unexpected interval: %s-%s", report.Start, report.End)
+	} else if c := report.Formats["http_simple_blocking"]; c != (marionette.ReachabilityCounter{Successes: 2}) {
+		t.Fatalf("unexpected http counter: %+v", c)
+	} else if c := report.Formats["ftp_simple_blocking"]; c != (marionette.ReachabilityCounter{Failures: 1}) {
+		t.Fatalf("unexpected ftp counter: %+v", c)
+	}
+}
@@ -0,0 +1,84 @@
+//go:build ignore
+// +build ignore
+
+// This program generates tables.go, the DFA tables of the regexes used by the
+// built-in formats, so that they can be used without cgo. It must be run on a
+// host with cgo & OpenFST.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	"github.com/redjack/marionette/plugins/tg"
+	"github.com/redjack/marionette/regex2dfa"
+)
+
+func main() {
+	regexes := make(map[string]bool)
+	for _, regex := range tg.Regexes() {
+		regexes[regex] = true
+	}
+
+	// Actions such as fte.send & model.heartbeat_send take a regex & length.
+	for _, name := range mar.Formats() {
+		data, err := mar.ReadFormat(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, party := range []string{marionette.PartyClient, marionette.PartyServer} {
+			doc, err := mar.Parse(party, data)
+			if err != nil {
+				log.Fatalf("%s: %s", name, err)
+			}
+			for _, block := range doc.ActionBlocks {
+				for _, action := range block.Actions {
+					if args := action.ArgValues(); len(args) >= 2 {
+						regex, ok0 := args[0].(string)
+						_, ok1 := args[1].(int)
+						if ok0 && ok1 {
+							regexes[regex] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(regexes))
+	for regex := range regexes {
+		keys = append(keys, regex)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_tables.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package regex2dfa")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// tables holds the DFA table of each regex used by the built-in formats.")
+	fmt.Fprintln(&buf, "var tables = Tables{")
+	for _, regex := range keys {
+		tbl, err := regex2dfa.OpenFST{}.Regex2DFA(regex)
+		if err != nil {
+			log.Printf("skipping %q: %s", regex, err)
+			continue
+		}
+		fmt.Fprintf(&buf, "\t%q: %q,\n", regex, tbl)
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("tables.go", src, 0666); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,3 +1,6 @@
+//go:build cgo && !purego
+// +build cgo,!purego
+
 #include <fst/fstlib.h>
 #include <fst/script/fstscript.h>
 
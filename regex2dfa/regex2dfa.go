@@ -1,36 +1,45 @@
 package regex2dfa
 
-// #cgo CXXFLAGS: -std=c++11 -DMARIONETTE -I${SRCDIR}/../third_party/re2
-// #cgo LDFLAGS: -ldl /usr/local/lib/libfst.a /usr/local/lib/libfstscript.a /usr/local/lib/libre2.a
-// #include <stdlib.h>
-// #include <stdint.h>
-// int _regex2dfa(const char* input_regex, uint32_t input_regex_len, char **out, size_t *sz);
-import "C"
-
-import (
-	"errors"
-	"unsafe"
+import "errors"
+
+//go:generate go run gen_tables.go
+
+var (
+	// ErrInternal is returned any error occurs.
+	ErrInternal = errors.New("regex2dfa: internal error")
+
+	// ErrNoTable is returned by Tables when a regex has no precomputed table.
+	ErrNoTable = errors.New("regex2dfa: no precomputed table for regex")
 )
 
-// ErrInternal is returned any error occurs.
-var ErrInternal = errors.New("regex2dfa: internal error")
+// Compiler converts a regex into a DFA table.
+type Compiler interface {
+	Regex2DFA(regex string) (string, error)
+}
+
+// DefaultCompiler is used by Regex2DFA(). It defaults to OpenFST in cgo
+// builds without the "purego" tag and to the built-in Tables otherwise.
+var DefaultCompiler Compiler = defaultCompiler
 
-// Regex2DFA converts regex into a DFA table.
-func Regex2DFA(regex string) (string, error) {
-	regex = "^" + regex + "$"
+// Tables is a pure-Go Compiler which returns the precomputed table of each
+// regex it holds.
+type Tables map[string]string
 
-	cregex := C.CString(regex)
-	defer C.free(unsafe.Pointer(cregex))
+// Builtin returns the precomputed tables of the regexes used by the built-in
+// formats. Additional tables may be added to the returned map.
+func Builtin() Tables { return tables }
 
-	var cout *C.char
-	var sz C.size_t
-	if errno := C._regex2dfa(cregex, C.uint32_t(len(regex)), &cout, &sz); errno != 0 {
-		return "", ErrInternal
+// Regex2DFA returns the precomputed table of regex.
+func (t Tables) Regex2DFA(regex string) (string, error) {
+	if tbl, ok := t[regex]; ok {
+		return tbl, nil
 	}
-	out := C.GoStringN(cout, C.int(sz))
-	C.free(unsafe.Pointer(cout))
+	return "", ErrNoTable
+}
 
-	return out, nil
+// Regex2DFA converts regex into a DFA table using DefaultCompiler.
+func Regex2DFA(regex string) (string, error) {
+	return DefaultCompiler.Regex2DFA(regex)
 }
 
 // MustRegex2DFA converts regex into a DFA table. Panic on error.
@@ -0,0 +1,9 @@
+//go:build !cgo || purego
+// +build !cgo purego
+
+package regex2dfa
+
+// defaultCompiler returns precomputed tables since OpenFST is only available
+// with cgo. Tables exist for the regexes of the built-in formats and are
+// generated with "go generate" on a host with cgo.
+var defaultCompiler Compiler = tables
@@ -7,10 +7,14 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	"github.com/redjack/marionette/plugins/tg"
 	"github.com/redjack/marionette/regex2dfa"
 )
 
 func TestRegex2DFA(t *testing.T) {
+	skipTables(t)
 	for i := 1; i <= 8; i++ {
 		name := fmt.Sprintf("test%d", i)
 
@@ -34,7 +38,79 @@ func TestRegex2DFA(t *testing.T) {
 }
 
 func TestRegex2DFA_HTTP(t *testing.T) {
+	skipTables(t)
 	if _, err := regex2dfa.Regex2DFA("^HTTP/1\\.1\\ 200 OK\r\nContent-Type:\\ ([a-zA-Z0-9]+)\r\n\r\n\\C{64}$"); err != nil {
 		t.Fatal(err)
 	}
 }
+
+func TestTables_Regex2DFA(t *testing.T) {
+	tables := regex2dfa.Tables{"(a|b)+": "0\t1\t97\t97\n0\t1\t98\t98\n1\t1\t97\t97\n1\t1\t98\t98\n1\n"}
+	if tbl, err := tables.Regex2DFA("(a|b)+"); err != nil {
+		t.Fatal(err)
+	} else if tbl != tables["(a|b)+"] {
+		t.Fatalf("unexpected table: %q", tbl)
+	}
+
+	if _, err := tables.Regex2DFA("c+"); err != regex2dfa.ErrNoTable {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure every regex used by the built-in formats has a precomputed table so
+// that they work without cgo.
+func TestBuiltin(t *testing.T) {
+	tables := regex2dfa.Builtin()
+	for _, regex := range tg.Regexes() {
+		if _, err := tables.Regex2DFA(regex); err != nil {
+			t.Errorf("tg: %q: %s", regex, err)
+		}
+	}
+
+	for _, name := range mar.Formats() {
+		data, err := mar.ReadFormat(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, party := range []string{marionette.PartyClient, marionette.PartyServer} {
+			doc, err := mar.Parse(party, data)
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+			for _, block := range doc.ActionBlocks {
+				for _, action := range block.Actions {
+					args := action.ArgValues()
+					if len(args) < 2 {
+						continue
+					}
+					regex, ok0 := args[0].(string)
+					_, ok1 := args[1].(int)
+					if !ok0 || !ok1 {
+						continue
+					}
+					if _, err := tables.Regex2DFA(regex); err != nil {
+						t.Errorf("%s: %s: %q: %s", name, action.Name(), regex, err)
+					}
+				}
+			}
+		}
+	}
+
+	// Ensure the tables are up to date when OpenFST is available.
+	if _, ok := regex2dfa.DefaultCompiler.(regex2dfa.Tables); !ok {
+		for regex, tbl := range tables {
+			if other, err := regex2dfa.Regex2DFA(regex); err != nil {
+				t.Errorf("%q: %s", regex, err)
+			} else if other != tbl {
+				t.Errorf("%q: table out of date, run go generate", regex)
+			}
+		}
+	}
+}
+
+// skipTables skips tests of OpenFST when tables are used instead.
+func skipTables(t *testing.T) {
+	if _, ok := regex2dfa.DefaultCompiler.(regex2dfa.Tables); ok {
+		t.Skip("requires cgo & OpenFST")
+	}
+}
@@ -0,0 +1,32 @@
+// Code generated by gen_tables.go; DO NOT EDIT.
+
+package regex2dfa
+
+// tables holds the DFA table of each regex used by the built-in formats.
+var tables = Tables{
+	".+":                       "0\t1\t0\t0\n0\t1\t1\t1\n0\t1\t2\t2\n0\t1\t3\t3\n0\t1\t4\t4\n0\t1\t5\t5\n0\t1\t6\t6\n0\t1\t7\t7\n0\t1\t8\t8\n0\t1\t9\t9\n0\t1\t10\t10\n0\t1\t11\t11\n0\t1\t12\t12\n0\t1\t13\t13\n0\t1\t14\t14\n0\t1\t15\t15\n0\t1\t16\t16\n0\t1\t17\t17\n0\t1\t18\t18\n0\t1\t19\t19\n0\t1\t20\t20\n0\t1\t21\t21\n0\t1\t22\t22\n0\t1\t23\t23\n0\t1\t24\t24\n0\t1\t25\t25\n0\t1\t26\t26\n0\t1\t27\t27\n0\t1\t28\t28\n0\t1\t29\t29\n0\t1\t30\t30\n0\t1\t31\t31\n0\t1\t32\t32\n0\t1\t33\t33\n0\t1\t34\t34\n0\t1\t35\t35\n0\t1\t36\t36\n0\t1\t37\t37\n0\t1\t38\t38\n0\t1\t39\t39\n0\t1\t40\t40\n0\t1\t41\t41\n0\t1\t42\t42\n0\t1\t43\t43\n0\t1\t44\t44\n0\t1\t45\t45\n0\t1\t46\t46\n0\t1\t47\t47\n0\t1\t48\t48\n0\t1\t49\t49\n0\t1\t50\t50\n0\t1\t51\t51\n0\t1\t52\t52\n0\t1\t53\t53\n0\t1\t54\t54\n0\t1\t55\t55\n0\t1\t56\t56\n0\t1\t57\t57\n0\t1\t58\t58\n0\t1\t59\t59\n0\t1\t60\t60\n0\t1\t61\t61\n0\t1\t62\t62\n0\t1\t63\t63\n0\t1\t64\t64\n0\t1\t65\t65\n0\t1\t66\t66\n0\t1\t67\t67\n0\t1\t68\t68\n0\t1\t69\t69\n0\t1\t70\t70\n0\t1\t71\t71\n0\t1\t72\t72\n0\t1\t73\t73\n0\t1\t74\t74\n0\t1\t75\t75\n0\t1\t76\t76\n0\t1\t77\t77\n0\t1\t78\t78\n0\t1\t79\t79\n0\t1\t80\t80\n0\t1\t81\t81\n0\t1\t82\t82\n0\t1\t83\t83\n0\t1\t84\t84\n0\t1\t85\t85\n0\t1\t86\t86\n0\t1\t87\t87\n0\t1\t88\t88\n0\t1\t89\t89\n0\t1\t90\t90\n0\t1\t91\t91\n0\t1\t92\t92\n0\t1\t93\t93\n0\t1\t94\t94\n0\t1\t95\t95\n0\t1\t96\t96\n0\t1\t97\t97\n0\t1\t98\t98\n0\t1\t99\t99\n0\t1\t100\t100\n0\t1\t101\t101\n0\t1\t102\t102\n0\t1\t103\t103\n0\t1\t104\t104\n0\t1\t105\t105\n0\t1\t106\t106\n0\t1\t107\t107\n0\t1\t108\t108\n0\t1\t109\t109\n0\t1\t110\t110\n0\t1\t111\t111\n0\t1\t112\t112\n0\t1\t113\t113\n0\t1\t114\t114\n0\t1\t115\t115\n0\t1\t116\t116\n0\t1\t117\t117\n0\t1\t118\t118\n0\t1\t119\t119\n0\t1\t120\t120\n0\t1\t121\t121\n0\t1\t122\t122\n0\t1\t123\t123\n0\t1\t124\t124\n0\t1\t125\t125\n0\t1\t126\t126\n0\t1\t127\t127\n0\t1\t128\t128\n0\t1\t129\t129\n0\t1\t130\t130\n0\t1\t131\t131\n0\t1\t132\t132\n0\t1\t133\t133\n0\t1\t134\t134\n0\t1\t135\t135\n0\t1\t136\t136\n0\t1\t137\t137\n0\t1\t138\t138\n0\t1\t139\t139\n0\t1\t140\t140\n0\t1\t141\t141\n0\t1\t142\t142\n0\t1\t143\t143\n0\t1\t144\t144\n0\t1\t145\t145\n0\t1\t146\t146\n0\t1\t147\t147\n0\t1\t148\t148\n0\t1\t149\t149\n0\t1\t150\t150\n0\t1\t151\t151\n0\t1\t152\t152\n0\t1\t153\t153\n0\t1\t154\t154\n0\t1\t155\t155\n0\t1\t156\t156\n0\t1\t157\t157\n0\t1\t158\t158\n0\t1\t159\t159\n0\t1\t160\t160\n0\t1\t161\t161\n0\t1\t162\t162\n0\t1\t163\t163\n0\t1\t164\t164\n0\t1\t165\t165\n0\t1\t166\t166\n0\t1\t167\t167\n0\t1\t168\t168\n0\t1\t169\t169\n0\t1\t170\t170\n0\t1\t171\t171\n0\t1\t172\t172\n0\t1\t173\t173\n0\t1\t174\t174\n0\t1\t175\t175\n0\t1\t176\t176\n0\t1\t177\t177\n0\t1\t178\t178\n0\t1\t179\t179\n0\t1\t180\t180\n0\t1\t181\t181\n0\t1\t182\t182\n0\t1\t183\t183\n0\t1\t184\t184\n0\t1\t185\t185\n0\t1\t186\t186\n0\t1\t187\t187\n0\t1\t188\t188\n0\t1\t189\t189\n0\t1\t190\t190\n0\t1\t191\t191\n0\t1\t192\t192\n0\t1\t193\t193\n0\t1\t194\t194\n0\t1\t195\t195\n0\t1\t196\t196\n0\t1\t197\t197\n0\t1\t198\t198\n0\t1\t199\t199\n0\t1\t200\t200\n0\t1\t201\t201\n0\t1\t202\t202\n0\t1\t203\t203\n0\t1\t204\t204\n0\t1\t205\t205\n0\t1\t206\t206\n0\t1\t207\t207\n0\t1\t208\t208\n0\t1\t209\t209\n0\t1\t210\t210\n0\t1\t211\t211\n0\t1\t212\t212\n0\t1\t213\t213\n0\t1\t214\t214\n0\t1\t215\t215\n0\t1\t216\t216\n0\t1\t217\t217\n0\t1\t218\t218\n0\t1\t219\t219\n0\t1\t220\t220\n0\t1\t221\t221\n0\t1\t222\t222\n0\t1\t223\t223\n0\t1\t224\t224\n0\t1\t225\t225\n0\t1\t226\t226\n0\t1\t227\t227\n0\t1\t228\t228\n0\t1\t229\t229\n0\t1\t230\t230\n0\t1\t231\t231\n0\t1\t232\t232\n0\t1\t233\t233\n0\t1\t234\t234\n0\t1\t235\t235\n0\t1\t236\t236\n0\t1\t237\t237\n0\t1\t238\t238\n0\t1\t239\t239\n0\t1\t240\t240\n0\t1\t241\t241\n0\t1\t242\t242\n0\t1\t243\t243\n0\t1\t244\t244\n0\t1\t245\t245\n0\t1\t246\t246\n0\t1\t247\t247\n0\t1\t248\t248\n0\t1\t249\t249\n0\t1\t250\t250\n0\t1\t251\t251\n0\t1\t252\t252\n0\t1\t253\t253\n0\t1\t254\t254\n0\t1\t255\t255\n1\t1\t0\t0\n1\t1\t1\t1\n1\t1\t2\t2\n1\t1\t3\t3\n1\t1\t4\t4\n1\t1\t5\t5\n1\t1\t6\t6\n1\t1\t7\t7\n1\t1\t8\t8\n1\t1\t9\t9\n1\t1\t10\t10\n1\t1\t11\t11\n1\t1\t12\t12\n1\t1\t13\t13\n1\t1\t14\t14\n1\t1\t15\t15\n1\t1\t16\t16\n1\t1\t17\t17\n1\t1\t18\t18\n1\t1\t19\t19\n1\t1\t20\t20\n1\t1\t21\t21\n1\t1\t22\t22\n1\t1\t23\t23\n1\t1\t24\t24\n1\t1\t25\t25\n1\t1\t26\t26\n1\t1\t27\t27\n1\t1\t28\t28\n1\t1\t29\t29\n1\t1\t30\t30\n1\t1\t31\t31\n1\t1\t32\t32\n1\t1\t33\t33\n1\t1\t34\t34\n1\t1\t35\t35\n1\t1\t36\t36\n1\t1\t37\t37\n1\t1\t38\t38\n1\t1\t39\t39\n1\t1\t40\t40\n1\t1\t41\t41\n1\t1\t42\t42\n1\t1\t43\t43\n1\t1\t44\t44\n1\t1\t45\t45\n1\t1\t46\t46\n1\t1\t47\t47\n1\t1\t48\t48\n1\t1\t49\t49\n1\t1\t50\t50\n1\t1\t51\t51\n1\t1\t52\t52\n1\t1\t53\t53\n1\t1\t54\t54\n1\t1\t55\t55\n1\t1\t56\t56\n1\t1\t57\t57\n1\t1\t58\t58\n1\t1\t59\t59\n1\t1\t60\t60\n1\t1\t61\t61\n1\t1\t62\t62\n1\t1\t63\t63\n1\t1\t64\t64\n1\t1\t65\t65\n1\t1\t66\t66\n1\t1\t67\t67\n1\t1\t68\t68\n1\t1\t69\t69\n1\t1\t70\t70\n1\t1\t71\t71\n1\t1\t72\t72\n1\t1\t73\t73\n1\t1\t74\t74\n1\t1\t75\t75\n1\t1\t76\t76\n1\t1\t77\t77\n1\t1\t78\t78\n1\t1\t79\t79\n1\t1\t80\t80\n1\t1\t81\t81\n1\t1\t82\t82\n1\t1\t83\t83\n1\t1\t84\t84\n1\t1\t85\t85\n1\t1\t86\t86\n1\t1\t87\t87\n1\t1\t88\t88\n1\t1\t89\t89\n1\t1\t90\t90\n1\t1\t91\t91\n1\t1\t92\t92\n1\t1\t93\t93\n1\t1\t94\t94\n1\t1\t95\t95\n1\t1\t96\t96\n1\t1\t97\t97\n1\t1\t98\t98\n1\t1\t99\t99\n1\t1\t100\t100\n1\t1\t101\t101\n1\t1\t102\t102\n1\t1\t103\t103\n1\t1\t104\t104\n1\t1\t105\t105\n1\t1\t106\t106\n1\t1\t107\t107\n1\t1\t108\t108\n1\t1\t109\t109\n1\t1\t110\t110\n1\t1\t111\t111\n1\t1\t112\t112\n1\t1\t113\t113\n1\t1\t114\t114\n1\t1\t115\t115\n1\t1\t116\t116\n1\t1\t117\t117\n1\t1\t118\t118\n1\t1\t119\t119\n1\t1\t120\t120\n1\t1\t121\t121\n1\t1\t122\t122\n1\t1\t123\t123\n1\t1\t124\t124\n1\t1\t125\t125\n1\t1\t126\t126\n1\t1\t127\t127\n1\t1\t128\t128\n1\t1\t129\t129\n1\t1\t130\t130\n1\t1\t131\t131\n1\t1\t132\t132\n1\t1\t133\t133\n1\t1\t134\t134\n1\t1\t135\t135\n1\t1\t136\t136\n1\t1\t137\t137\n1\t1\t138\t138\n1\t1\t139\t139\n1\t1\t140\t140\n1\t1\t141\t141\n1\t1\t142\t142\n1\t1\t143\t143\n1\t1\t144\t144\n1\t1\t145\t145\n1\t1\t146\t146\n1\t1\t147\t147\n1\t1\t148\t148\n1\t1\t149\t149\n1\t1\t150\t150\n1\t1\t151\t151\n1\t1\t152\t152\n1\t1\t153\t153\n1\t1\t154\t154\n1\t1\t155\t155\n1\t1\t156\t156\n1\t1\t157\t157\n1\t1\t158\t158\n1\t1\t159\t159\n1\t1\t160\t160\n1\t1\t161\t161\n1\t1\t162\t162\n1\t1\t163\t163\n1\t1\t164\t164\n1\t1\t165\t165\n1\t1\t166\t166\n1\t1\t167\t167\n1\t1\t168\t168\n1\t1\t169\t169\n1\t1\t170\t170\n1\t1\t171\t171\n1\t1\t172\t172\n1\t1\t173\t173\n1\t1\t174\t174\n1\t1\t175\t175\n1\t1\t176\t176\n1\t1\t177\t177\n1\t1\t178\t178\n1\t1\t179\t179\n1\t1\t180\t180\n1\t1\t181\t181\n1\t1\t182\t182\n1\t1\t183\t183\n1\t1\t184\t184\n1\t1\t185\t185\n1\t1\t186\t186\n1\t1\t187\t187\n1\t1\t188\t188\n1\t1\t189\t189\n1\t1\t190\t190\n1\t1\t191\t191\n1\t1\t192\t192\n1\t1\t193\t193\n1\t1\t194\t194\n1\t1\t195\t195\n1\t1\t196\t196\n1\t1\t197\t197\n1\t1\t198\t198\n1\t1\t199\t199\n1\t1\t200\t200\n1\t1\t201\t201\n1\t1\t202\t202\n1\t1\t203\t203\n1\t1\t204\t204\n1\t1\t205\t205\n1\t1\t206\t206\n1\t1\t207\t207\n1\t1\t208\t208\n1\t1\t209\t209\n1\t1\t210\t210\n1\t1\t211\t211\n1\t1\t212\t212\n1\t1\t213\t213\n1\t1\t214\t214\n1\t1\t215\t215\n1\t1\t216\t216\n1\t1\t217\t217\n1\t1\t218\t218\n1\t1\t219\t219\n1\t1\t220\t220\n1\t1\t221\t221\n1\t1\t222\t222\n1\t1\t223\t223\n1\t1\t224\t224\n1\t1\t225\t225\n1\t1\t226\t226\n1\t1\t227\t227\n1\t1\t228\t228\n1\t1\t229\t229\n1\t1\t230\t230\n1\t1\t231\t231\n1\t1\t232\t232\n1\t1\t233\t233\n1\t1\t234\t234\n1\t1\t235\t235\n1\t1\t236\t236\n1\t1\t237\t237\n1\t1\t238\t238\n1\t1\t239\t239\n1\t1\t240\t240\n1\t1\t241\t241\n1\t1\t242\t242\n1\t1\t243\t243\n1\t1\t244\t244\n1\t1\t245\t245\n1\t1\t246\t246\n1\t1\t247\t247\n1\t1\t248\t248\n1\t1\t249\t249\n1\t1\t250\t250\n1\t1\t251\t251\n1\t1\t252\t252\n1\t1\t253\t253\n1\t1\t254\t254\n1\t1\t255\t255\n1\n",
+	"GET\\s/.*":                "0\t1\t71\t71\n1\t2\t69\t69\n2\t3\t84\t84\n3\t4\t9\t9\n3\t4\t10\t10\n3\t4\t12\t12\n3\t4\t13\t13\n3\t4\t32\t32\n4\t5\t47\t47\n5\t5\t0\t0\n5\t5\t1\t1\n5\t5\t2\t2\n5\t5\t3\t3\n5\t5\t4\t4\n5\t5\t5\t5\n5\t5\t6\t6\n5\t5\t7\t7\n5\t5\t8\t8\n5\t5\t9\t9\n5\t5\t10\t10\n5\t5\t11\t11\n5\t5\t12\t12\n5\t5\t13\t13\n5\t5\t14\t14\n5\t5\t15\t15\n5\t5\t16\t16\n5\t5\t17\t17\n5\t5\t18\t18\n5\t5\t19\t19\n5\t5\t20\t20\n5\t5\t21\t21\n5\t5\t22\t22\n5\t5\t23\t23\n5\t5\t24\t24\n5\t5\t25\t25\n5\t5\t26\t26\n5\t5\t27\t27\n5\t5\t28\t28\n5\t5\t29\t29\n5\t5\t30\t30\n5\t5\t31\t31\n5\t5\t32\t32\n5\t5\t33\t33\n5\t5\t34\t34\n5\t5\t35\t35\n5\t5\t36\t36\n5\t5\t37\t37\n5\t5\t38\t38\n5\t5\t39\t39\n5\t5\t40\t40\n5\t5\t41\t41\n5\t5\t42\t42\n5\t5\t43\t43\n5\t5\t44\t44\n5\t5\t45\t45\n5\t5\t46\t46\n5\t5\t47\t47\n5\t5\t48\t48\n5\t5\t49\t49\n5\t5\t50\t50\n5\t5\t51\t51\n5\t5\t52\t52\n5\t5\t53\t53\n5\t5\t54\t54\n5\t5\t55\t55\n5\t5\t56\t56\n5\t5\t57\t57\n5\t5\t58\t58\n5\t5\t59\t59\n5\t5\t60\t60\n5\t5\t61\t61\n5\t5\t62\t62\n5\t5\t63\t63\n5\t5\t64\t64\n5\t5\t65\t65\n5\t5\t66\t66\n5\t5\t67\t67\n5\t5\t68\t68\n5\t5\t69\t69\n5\t5\t70\t70\n5\t5\t71\t71\n5\t5\t72\t72\n5\t5\t73\t73\n5\t5\t74\t74\n5\t5\t75\t75\n5\t5\t76\t76\n5\t5\t77\t77\n5\t5\t78\t78\n5\t5\t79\t79\n5\t5\t80\t80\n5\t5\t81\t81\n5\t5\t82\t82\n5\t5\t83\t83\n5\t5\t84\t84\n5\t5\t85\t85\n5\t5\t86\t86\n5\t5\t87\t87\n5\t5\t88\t88\n5\t5\t89\t89\n5\t5\t90\t90\n5\t5\t91\t91\n5\t5\t92\t92\n5\t5\t93\t93\n5\t5\t94\t94\n5\t5\t95\t95\n5\t5\t96\t96\n5\t5\t97\t97\n5\t5\t98\t98\n5\t5\t99\t99\n5\t5\t100\t100\n5\t5\t101\t101\n5\t5\t102\t102\n5\t5\t103\t103\n5\t5\t104\t104\n5\t5\t105\t105\n5\t5\t106\t106\n5\t5\t107\t107\n5\t5\t108\t108\n5\t5\t109\t109\n5\t5\t110\t110\n5\t5\t111\t111\n5\t5\t112\t112\n5\t5\t113\t113\n5\t5\t114\t114\n5\t5\t115\t115\n5\t5\t116\t116\n5\t5\t117\t117\n5\t5\t118\t118\n5\t5\t119\t119\n5\t5\t120\t120\n5\t5\t121\t121\n5\t5\t122\t122\n5\t5\t123\t123\n5\t5\t124\t124\n5\t5\t125\t125\n5\t5\t126\t126\n5\t5\t127\t127\n5\t5\t128\t128\n5\t5\t129\t129\n5\t5\t130\t130\n5\t5\t131\t131\n5\t5\t132\t132\n5\t5\t133\t133\n5\t5\t134\t134\n5\t5\t135\t135\n5\t5\t136\t136\n5\t5\t137\t137\n5\t5\t138\t138\n5\t5\t139\t139\n5\t5\t140\t140\n5\t5\t141\t141\n5\t5\t142\t142\n5\t5\t143\t143\n5\t5\t144\t144\n5\t5\t145\t145\n5\t5\t146\t146\n5\t5\t147\t147\n5\t5\t148\t148\n5\t5\t149\t149\n5\t5\t150\t150\n5\t5\t151\t151\n5\t5\t152\t152\n5\t5\t153\t153\n5\t5\t154\t154\n5\t5\t155\t155\n5\t5\t156\t156\n5\t5\t157\t157\n5\t5\t158\t158\n5\t5\t159\t159\n5\t5\t160\t160\n5\t5\t161\t161\n5\t5\t162\t162\n5\t5\t163\t163\n5\t5\t164\t164\n5\t5\t165\t165\n5\t5\t166\t166\n5\t5\t167\t167\n5\t5\t168\t168\n5\t5\t169\t169\n5\t5\t170\t170\n5\t5\t171\t171\n5\t5\t172\t172\n5\t5\t173\t173\n5\t5\t174\t174\n5\t5\t175\t175\n5\t5\t176\t176\n5\t5\t177\t177\n5\t5\t178\t178\n5\t5\t179\t179\n5\t5\t180\t180\n5\t5\t181\t181\n5\t5\t182\t182\n5\t5\t183\t183\n5\t5\t184\t184\n5\t5\t185\t185\n5\t5\t186\t186\n5\t5\t187\t187\n5\t5\t188\t188\n5\t5\t189\t189\n5\t5\t190\t190\n5\t5\t191\t191\n5\t5\t192\t192\n5\t5\t193\t193\n5\t5\t194\t194\n5\t5\t195\t195\n5\t5\t196\t196\n5\t5\t197\t197\n5\t5\t198\t198\n5\t5\t199\t199\n5\t5\t200\t200\n5\t5\t201\t201\n5\t5\t202\t202\n5\t5\t203\t203\n5\t5\t204\t204\n5\t5\t205\t205\n5\t5\t206\t206\n5\t5\t207\t207\n5\t5\t208\t208\n5\t5\t209\t209\n5\t5\t210\t210\n5\t5\t211\t211\n5\t5\t212\t212\n5\t5\t213\t213\n5\t5\t214\t214\n5\t5\t215\t215\n5\t5\t216\t216\n5\t5\t217\t217\n5\t5\t218\t218\n5\t5\t219\t219\n5\t5\t220\t220\n5\t5\t221\t221\n5\t5\t222\t222\n5\t5\t223\t223\n5\t5\t224\t224\n5\t5\t225\t225\n5\t5\t226\t226\n5\t5\t227\t227\n5\t5\t228\t228\n5\t5\t229\t229\n5\t5\t230\t230\n5\t5\t231\t231\n5\t5\t232\t232\n5\t5\t233\t233\n5\t5\t234\t234\n5\t5\t235\t235\n5\t5\t236\t236\n5\t5\t237\t237\n5\t5\t238\t238\n5\t5\t239\t239\n5\t5\t240\t240\n5\t5\t241\t241\n5\t5\t242\t242\n5\t5\t243\t243\n5\t5\t244\t244\n5\t5\t245\t245\n5\t5\t246\t246\n5\t5\t247\t247\n5\t5\t248\t248\n5\t5\t249\t249\n5\t5\t250\t250\n5\t5\t251\t251\n5\t5\t252\t252\n5\t5\t253\t253\n5\t5\t254\t254\n5\t5\t255\t255\n5\n",
+	"HTTP/1\\.0.*":             "0\t1\t72\t72\n1\t2\t84\t84\n2\t3\t84\t84\n3\t4\t80\t80\n4\t5\t47\t47\n5\t6\t49\t49\n6\t7\t46\t46\n7\t8\t48\t48\n8\t8\t0\t0\n8\t8\t1\t1\n8\t8\t2\t2\n8\t8\t3\t3\n8\t8\t4\t4\n8\t8\t5\t5\n8\t8\t6\t6\n8\t8\t7\t7\n8\t8\t8\t8\n8\t8\t9\t9\n8\t8\t10\t10\n8\t8\t11\t11\n8\t8\t12\t12\n8\t8\t13\t13\n8\t8\t14\t14\n8\t8\t15\t15\n8\t8\t16\t16\n8\t8\t17\t17\n8\t8\t18\t18\n8\t8\t19\t19\n8\t8\t20\t20\n8\t8\t21\t21\n8\t8\t22\t22\n8\t8\t23\t23\n8\t8\t24\t24\n8\t8\t25\t25\n8\t8\t26\t26\n8\t8\t27\t27\n8\t8\t28\t28\n8\t8\t29\t29\n8\t8\t30\t30\n8\t8\t31\t31\n8\t8\t32\t32\n8\t8\t33\t33\n8\t8\t34\t34\n8\t8\t35\t35\n8\t8\t36\t36\n8\t8\t37\t37\n8\t8\t38\t38\n8\t8\t39\t39\n8\t8\t40\t40\n8\t8\t41\t41\n8\t8\t42\t42\n8\t8\t43\t43\n8\t8\t44\t44\n8\t8\t45\t45\n8\t8\t46\t46\n8\t8\t47\t47\n8\t8\t48\t48\n8\t8\t49\t49\n8\t8\t50\t50\n8\t8\t51\t51\n8\t8\t52\t52\n8\t8\t53\t53\n8\t8\t54\t54\n8\t8\t55\t55\n8\t8\t56\t56\n8\t8\t57\t57\n8\t8\t58\t58\n8\t8\t59\t59\n8\t8\t60\t60\n8\t8\t61\t61\n8\t8\t62\t62\n8\t8\t63\t63\n8\t8\t64\t64\n8\t8\t65\t65\n8\t8\t66\t66\n8\t8\t67\t67\n8\t8\t68\t68\n8\t8\t69\t69\n8\t8\t70\t70\n8\t8\t71\t71\n8\t8\t72\t72\n8\t8\t73\t73\n8\t8\t74\t74\n8\t8\t75\t75\n8\t8\t76\t76\n8\t8\t77\t77\n8\t8\t78\t78\n8\t8\t79\t79\n8\t8\t80\t80\n8\t8\t81\t81\n8\t8\t82\t82\n8\t8\t83\t83\n8\t8\t84\t84\n8\t8\t85\t85\n8\t8\t86\t86\n8\t8\t87\t87\n8\t8\t88\t88\n8\t8\t89\t89\n8\t8\t90\t90\n8\t8\t91\t91\n8\t8\t92\t92\n8\t8\t93\t93\n8\t8\t94\t94\n8\t8\t95\t95\n8\t8\t96\t96\n8\t8\t97\t97\n8\t8\t98\t98\n8\t8\t99\t99\n8\t8\t100\t100\n8\t8\t101\t101\n8\t8\t102\t102\n8\t8\t103\t103\n8\t8\t104\t104\n8\t8\t105\t105\n8\t8\t106\t106\n8\t8\t107\t107\n8\t8\t108\t108\n8\t8\t109\t109\n8\t8\t110\t110\n8\t8\t111\t111\n8\t8\t112\t112\n8\t8\t113\t113\n8\t8\t114\t114\n8\t8\t115\t115\n8\t8\t116\t116\n8\t8\t117\t117\n8\t8\t118\t118\n8\t8\t119\t119\n8\t8\t120\t120\n8\t8\t121\t121\n8\t8\t122\t122\n8\t8\t123\t123\n8\t8\t124\t124\n8\t8\t125\t125\n8\t8\t126\t126\n8\t8\t127\t127\n8\t8\t128\t128\n8\t8\t129\t129\n8\t8\t130\t130\n8\t8\t131\t131\n8\t8\t132\t132\n8\t8\t133\t133\n8\t8\t134\t134\n8\t8\t135\t135\n8\t8\t136\t136\n8\t8\t137\t137\n8\t8\t138\t138\n8\t8\t139\t139\n8\t8\t140\t140\n8\t8\t141\t141\n8\t8\t142\t142\n8\t8\t143\t143\n8\t8\t144\t144\n8\t8\t145\t145\n8\t8\t146\t146\n8\t8\t147\t147\n8\t8\t148\t148\n8\t8\t149\t149\n8\t8\t150\t150\n8\t8\t151\t151\n8\t8\t152\t152\n8\t8\t153\t153\n8\t8\t154\t154\n8\t8\t155\t155\n8\t8\t156\t156\n8\t8\t157\t157\n8\t8\t158\t158\n8\t8\t159\t159\n8\t8\t160\t160\n8\t8\t161\t161\n8\t8\t162\t162\n8\t8\t163\t163\n8\t8\t164\t164\n8\t8\t165\t165\n8\t8\t166\t166\n8\t8\t167\t167\n8\t8\t168\t168\n8\t8\t169\t169\n8\t8\t170\t170\n8\t8\t171\t171\n8\t8\t172\t172\n8\t8\t173\t173\n8\t8\t174\t174\n8\t8\t175\t175\n8\t8\t176\t176\n8\t8\t177\t177\n8\t8\t178\t178\n8\t8\t179\t179\n8\t8\t180\t180\n8\t8\t181\t181\n8\t8\t182\t182\n8\t8\t183\t183\n8\t8\t184\t184\n8\t8\t185\t185\n8\t8\t186\t186\n8\t8\t187\t187\n8\t8\t188\t188\n8\t8\t189\t189\n8\t8\t190\t190\n8\t8\t191\t191\n8\t8\t192\t192\n8\t8\t193\t193\n8\t8\t194\t194\n8\t8\t195\t195\n8\t8\t196\t196\n8\t8\t197\t197\n8\t8\t198\t198\n8\t8\t199\t199\n8\t8\t200\t200\n8\t8\t201\t201\n8\t8\t202\t202\n8\t8\t203\t203\n8\t8\t204\t204\n8\t8\t205\t205\n8\t8\t206\t206\n8\t8\t207\t207\n8\t8\t208\t208\n8\t8\t209\t209\n8\t8\t210\t210\n8\t8\t211\t211\n8\t8\t212\t212\n8\t8\t213\t213\n8\t8\t214\t214\n8\t8\t215\t215\n8\t8\t216\t216\n8\t8\t217\t217\n8\t8\t218\t218\n8\t8\t219\t219\n8\t8\t220\t220\n8\t8\t221\t221\n8\t8\t222\t222\n8\t8\t223\t223\n8\t8\t224\t224\n8\t8\t225\t225\n8\t8\t226\t226\n8\t8\t227\t227\n8\t8\t228\t228\n8\t8\t229\t229\n8\t8\t230\t230\n8\t8\t231\t231\n8\t8\t232\t232\n8\t8\t233\t233\n8\t8\t234\t234\n8\t8\t235\t235\n8\t8\t236\t236\n8\t8\t237\t237\n8\t8\t238\t238\n8\t8\t239\t239\n8\t8\t240\t240\n8\t8\t241\t241\n8\t8\t242\t242\n8\t8\t243\t243\n8\t8\t244\t244\n8\t8\t245\t245\n8\t8\t246\t246\n8\t8\t247\t247\n8\t8\t248\t248\n8\t8\t249\t249\n8\t8\t250\t250\n8\t8\t251\t251\n8\t8\t252\t252\n8\t8\t253\t253\n8\t8\t254\t254\n8\t8\t255\t255\n8\n",
+	"HTTP/1\\.1.*":             "0\t1\t72\t72\n1\t2\t84\t84\n2\t3\t84\t84\n3\t4\t80\t80\n4\t5\t47\t47\n5\t6\t49\t49\n6\t7\t46\t46\n7\t8\t49\t49\n8\t8\t0\t0\n8\t8\t1\t1\n8\t8\t2\t2\n8\t8\t3\t3\n8\t8\t4\t4\n8\t8\t5\t5\n8\t8\t6\t6\n8\t8\t7\t7\n8\t8\t8\t8\n8\t8\t9\t9\n8\t8\t10\t10\n8\t8\t11\t11\n8\t8\t12\t12\n8\t8\t13\t13\n8\t8\t14\t14\n8\t8\t15\t15\n8\t8\t16\t16\n8\t8\t17\t17\n8\t8\t18\t18\n8\t8\t19\t19\n8\t8\t20\t20\n8\t8\t21\t21\n8\t8\t22\t22\n8\t8\t23\t23\n8\t8\t24\t24\n8\t8\t25\t25\n8\t8\t26\t26\n8\t8\t27\t27\n8\t8\t28\t28\n8\t8\t29\t29\n8\t8\t30\t30\n8\t8\t31\t31\n8\t8\t32\t32\n8\t8\t33\t33\n8\t8\t34\t34\n8\t8\t35\t35\n8\t8\t36\t36\n8\t8\t37\t37\n8\t8\t38\t38\n8\t8\t39\t39\n8\t8\t40\t40\n8\t8\t41\t41\n8\t8\t42\t42\n8\t8\t43\t43\n8\t8\t44\t44\n8\t8\t45\t45\n8\t8\t46\t46\n8\t8\t47\t47\n8\t8\t48\t48\n8\t8\t49\t49\n8\t8\t50\t50\n8\t8\t51\t51\n8\t8\t52\t52\n8\t8\t53\t53\n8\t8\t54\t54\n8\t8\t55\t55\n8\t8\t56\t56\n8\t8\t57\t57\n8\t8\t58\t58\n8\t8\t59\t59\n8\t8\t60\t60\n8\t8\t61\t61\n8\t8\t62\t62\n8\t8\t63\t63\n8\t8\t64\t64\n8\t8\t65\t65\n8\t8\t66\t66\n8\t8\t67\t67\n8\t8\t68\t68\n8\t8\t69\t69\n8\t8\t70\t70\n8\t8\t71\t71\n8\t8\t72\t72\n8\t8\t73\t73\n8\t8\t74\t74\n8\t8\t75\t75\n8\t8\t76\t76\n8\t8\t77\t77\n8\t8\t78\t78\n8\t8\t79\t79\n8\t8\t80\t80\n8\t8\t81\t81\n8\t8\t82\t82\n8\t8\t83\t83\n8\t8\t84\t84\n8\t8\t85\t85\n8\t8\t86\t86\n8\t8\t87\t87\n8\t8\t88\t88\n8\t8\t89\t89\n8\t8\t90\t90\n8\t8\t91\t91\n8\t8\t92\t92\n8\t8\t93\t93\n8\t8\t94\t94\n8\t8\t95\t95\n8\t8\t96\t96\n8\t8\t97\t97\n8\t8\t98\t98\n8\t8\t99\t99\n8\t8\t100\t100\n8\t8\t101\t101\n8\t8\t102\t102\n8\t8\t103\t103\n8\t8\t104\t104\n8\t8\t105\t105\n8\t8\t106\t106\n8\t8\t107\t107\n8\t8\t108\t108\n8\t8\t109\t109\n8\t8\t110\t110\n8\t8\t111\t111\n8\t8\t112\t112\n8\t8\t113\t113\n8\t8\t114\t114\n8\t8\t115\t115\n8\t8\t116\t116\n8\t8\t117\t117\n8\t8\t118\t118\n8\t8\t119\t119\n8\t8\t120\t120\n8\t8\t121\t121\n8\t8\t122\t122\n8\t8\t123\t123\n8\t8\t124\t124\n8\t8\t125\t125\n8\t8\t126\t126\n8\t8\t127\t127\n8\t8\t128\t128\n8\t8\t129\t129\n8\t8\t130\t130\n8\t8\t131\t131\n8\t8\t132\t132\n8\t8\t133\t133\n8\t8\t134\t134\n8\t8\t135\t135\n8\t8\t136\t136\n8\t8\t137\t137\n8\t8\t138\t138\n8\t8\t139\t139\n8\t8\t140\t140\n8\t8\t141\t141\n8\t8\t142\t142\n8\t8\t143\t143\n8\t8\t144\t144\n8\t8\t145\t145\n8\t8\t146\t146\n8\t8\t147\t147\n8\t8\t148\t148\n8\t8\t149\t149\n8\t8\t150\t150\n8\t8\t151\t151\n8\t8\t152\t152\n8\t8\t153\t153\n8\t8\t154\t154\n8\t8\t155\t155\n8\t8\t156\t156\n8\t8\t157\t157\n8\t8\t158\t158\n8\t8\t159\t159\n8\t8\t160\t160\n8\t8\t161\t161\n8\t8\t162\t162\n8\t8\t163\t163\n8\t8\t164\t164\n8\t8\t165\t165\n8\t8\t166\t166\n8\t8\t167\t167\n8\t8\t168\t168\n8\t8\t169\t169\n8\t8\t170\t170\n8\t8\t171\t171\n8\t8\t172\t172\n8\t8\t173\t173\n8\t8\t174\t174\n8\t8\t175\t175\n8\t8\t176\t176\n8\t8\t177\t177\n8\t8\t178\t178\n8\t8\t179\t179\n8\t8\t180\t180\n8\t8\t181\t181\n8\t8\t182\t182\n8\t8\t183\t183\n8\t8\t184\t184\n8\t8\t185\t185\n8\t8\t186\t186\n8\t8\t187\t187\n8\t8\t188\t188\n8\t8\t189\t189\n8\t8\t190\t190\n8\t8\t191\t191\n8\t8\t192\t192\n8\t8\t193\t193\n8\t8\t194\t194\n8\t8\t195\t195\n8\t8\t196\t196\n8\t8\t197\t197\n8\t8\t198\t198\n8\t8\t199\t199\n8\t8\t200\t200\n8\t8\t201\t201\n8\t8\t202\t202\n8\t8\t203\t203\n8\t8\t204\t204\n8\t8\t205\t205\n8\t8\t206\t206\n8\t8\t207\t207\n8\t8\t208\t208\n8\t8\t209\t209\n8\t8\t210\t210\n8\t8\t211\t211\n8\t8\t212\t212\n8\t8\t213\t213\n8\t8\t214\t214\n8\t8\t215\t215\n8\t8\t216\t216\n8\t8\t217\t217\n8\t8\t218\t218\n8\t8\t219\t219\n8\t8\t220\t220\n8\t8\t221\t221\n8\t8\t222\t222\n8\t8\t223\t223\n8\t8\t224\t224\n8\t8\t225\t225\n8\t8\t226\t226\n8\t8\t227\t227\n8\t8\t228\t228\n8\t8\t229\t229\n8\t8\t230\t230\n8\t8\t231\t231\n8\t8\t232\t232\n8\t8\t233\t233\n8\t8\t234\t234\n8\t8\t235\t235\n8\t8\t236\t236\n8\t8\t237\t237\n8\t8\t238\t238\n8\t8\t239\t239\n8\t8\t240\t240\n8\t8\t241\t241\n8\t8\t242\t242\n8\t8\t243\t243\n8\t8\t244\t244\n8\t8\t245\t245\n8\t8\t246\t246\n8\t8\t247\t247\n8\t8\t248\t248\n8\t8\t249\t249\n8\t8\t250\t250\n8\t8\t251\t251\n8\t8\t252\t252\n8\t8\t253\t253\n8\t8\t254\t254\n8\t8\t255\t255\n8\n",
+	"POST\\s/.*":               "0\t1\t80\t80\n1\t2\t79\t79\n2\t3\t83\t83\n3\t4\t84\t84\n4\t5\t9\t9\n4\t5\t10\t10\n4\t5\t12\t12\n4\t5\t13\t13\n4\t5\t32\t32\n5\t6\t47\t47\n6\t6\t0\t0\n6\t6\t1\t1\n6\t6\t2\t2\n6\t6\t3\t3\n6\t6\t4\t4\n6\t6\t5\t5\n6\t6\t6\t6\n6\t6\t7\t7\n6\t6\t8\t8\n6\t6\t9\t9\n6\t6\t10\t10\n6\t6\t11\t11\n6\t6\t12\t12\n6\t6\t13\t13\n6\t6\t14\t14\n6\t6\t15\t15\n6\t6\t16\t16\n6\t6\t17\t17\n6\t6\t18\t18\n6\t6\t19\t19\n6\t6\t20\t20\n6\t6\t21\t21\n6\t6\t22\t22\n6\t6\t23\t23\n6\t6\t24\t24\n6\t6\t25\t25\n6\t6\t26\t26\n6\t6\t27\t27\n6\t6\t28\t28\n6\t6\t29\t29\n6\t6\t30\t30\n6\t6\t31\t31\n6\t6\t32\t32\n6\t6\t33\t33\n6\t6\t34\t34\n6\t6\t35\t35\n6\t6\t36\t36\n6\t6\t37\t37\n6\t6\t38\t38\n6\t6\t39\t39\n6\t6\t40\t40\n6\t6\t41\t41\n6\t6\t42\t42\n6\t6\t43\t43\n6\t6\t44\t44\n6\t6\t45\t45\n6\t6\t46\t46\n6\t6\t47\t47\n6\t6\t48\t48\n6\t6\t49\t49\n6\t6\t50\t50\n6\t6\t51\t51\n6\t6\t52\t52\n6\t6\t53\t53\n6\t6\t54\t54\n6\t6\t55\t55\n6\t6\t56\t56\n6\t6\t57\t57\n6\t6\t58\t58\n6\t6\t59\t59\n6\t6\t60\t60\n6\t6\t61\t61\n6\t6\t62\t62\n6\t6\t63\t63\n6\t6\t64\t64\n6\t6\t65\t65\n6\t6\t66\t66\n6\t6\t67\t67\n6\t6\t68\t68\n6\t6\t69\t69\n6\t6\t70\t70\n6\t6\t71\t71\n6\t6\t72\t72\n6\t6\t73\t73\n6\t6\t74\t74\n6\t6\t75\t75\n6\t6\t76\t76\n6\t6\t77\t77\n6\t6\t78\t78\n6\t6\t79\t79\n6\t6\t80\t80\n6\t6\t81\t81\n6\t6\t82\t82\n6\t6\t83\t83\n6\t6\t84\t84\n6\t6\t85\t85\n6\t6\t86\t86\n6\t6\t87\t87\n6\t6\t88\t88\n6\t6\t89\t89\n6\t6\t90\t90\n6\t6\t91\t91\n6\t6\t92\t92\n6\t6\t93\t93\n6\t6\t94\t94\n6\t6\t95\t95\n6\t6\t96\t96\n6\t6\t97\t97\n6\t6\t98\t98\n6\t6\t99\t99\n6\t6\t100\t100\n6\t6\t101\t101\n6\t6\t102\t102\n6\t6\t103\t103\n6\t6\t104\t104\n6\t6\t105\t105\n6\t6\t106\t106\n6\t6\t107\t107\n6\t6\t108\t108\n6\t6\t109\t109\n6\t6\t110\t110\n6\t6\t111\t111\n6\t6\t112\t112\n6\t6\t113\t113\n6\t6\t114\t114\n6\t6\t115\t115\n6\t6\t116\t116\n6\t6\t117\t117\n6\t6\t118\t118\n6\t6\t119\t119\n6\t6\t120\t120\n6\t6\t121\t121\n6\t6\t122\t122\n6\t6\t123\t123\n6\t6\t124\t124\n6\t6\t125\t125\n6\t6\t126\t126\n6\t6\t127\t127\n6\t6\t128\t128\n6\t6\t129\t129\n6\t6\t130\t130\n6\t6\t131\t131\n6\t6\t132\t132\n6\t6\t133\t133\n6\t6\t134\t134\n6\t6\t135\t135\n6\t6\t136\t136\n6\t6\t137\t137\n6\t6\t138\t138\n6\t6\t139\t139\n6\t6\t140\t140\n6\t6\t141\t141\n6\t6\t142\t142\n6\t6\t143\t143\n6\t6\t144\t144\n6\t6\t145\t145\n6\t6\t146\t146\n6\t6\t147\t147\n6\t6\t148\t148\n6\t6\t149\t149\n6\t6\t150\t150\n6\t6\t151\t151\n6\t6\t152\t152\n6\t6\t153\t153\n6\t6\t154\t154\n6\t6\t155\t155\n6\t6\t156\t156\n6\t6\t157\t157\n6\t6\t158\t158\n6\t6\t159\t159\n6\t6\t160\t160\n6\t6\t161\t161\n6\t6\t162\t162\n6\t6\t163\t163\n6\t6\t164\t164\n6\t6\t165\t165\n6\t6\t166\t166\n6\t6\t167\t167\n6\t6\t168\t168\n6\t6\t169\t169\n6\t6\t170\t170\n6\t6\t171\t171\n6\t6\t172\t172\n6\t6\t173\t173\n6\t6\t174\t174\n6\t6\t175\t175\n6\t6\t176\t176\n6\t6\t177\t177\n6\t6\t178\t178\n6\t6\t179\t179\n6\t6\t180\t180\n6\t6\t181\t181\n6\t6\t182\t182\n6\t6\t183\t183\n6\t6\t184\t184\n6\t6\t185\t185\n6\t6\t186\t186\n6\t6\t187\t187\n6\t6\t188\t188\n6\t6\t189\t189\n6\t6\t190\t190\n6\t6\t191\t191\n6\t6\t192\t192\n6\t6\t193\t193\n6\t6\t194\t194\n6\t6\t195\t195\n6\t6\t196\t196\n6\t6\t197\t197\n6\t6\t198\t198\n6\t6\t199\t199\n6\t6\t200\t200\n6\t6\t201\t201\n6\t6\t202\t202\n6\t6\t203\t203\n6\t6\t204\t204\n6\t6\t205\t205\n6\t6\t206\t206\n6\t6\t207\t207\n6\t6\t208\t208\n6\t6\t209\t209\n6\t6\t210\t210\n6\t6\t211\t211\n6\t6\t212\t212\n6\t6\t213\t213\n6\t6\t214\t214\n6\t6\t215\t215\n6\t6\t216\t216\n6\t6\t217\t217\n6\t6\t218\t218\n6\t6\t219\t219\n6\t6\t220\t220\n6\t6\t221\t221\n6\t6\t222\t222\n6\t6\t223\t223\n6\t6\t224\t224\n6\t6\t225\t225\n6\t6\t226\t226\n6\t6\t227\t227\n6\t6\t228\t228\n6\t6\t229\t229\n6\t6\t230\t230\n6\t6\t231\t231\n6\t6\t232\t232\n6\t6\t233\t233\n6\t6\t234\t234\n6\t6\t235\t235\n6\t6\t236\t236\n6\t6\t237\t237\n6\t6\t238\t238\n6\t6\t239\t239\n6\t6\t240\t240\n6\t6\t241\t241\n6\t6\t242\t242\n6\t6\t243\t243\n6\t6\t244\t244\n6\t6\t245\t245\n6\t6\t246\t246\n6\t6\t247\t247\n6\t6\t248\t248\n6\t6\t249\t249\n6\t6\t250\t250\n6\t6\t251\t251\n6\t6\t252\t252\n6\t6\t253\t253\n6\t6\t254\t254\n6\t6\t255\t255\n6\n",
+	"[0-9a-f]+":                "0\t1\t48\t48\n0\t1\t49\t49\n0\t1\t50\t50\n0\t1\t51\t51\n0\t1\t52\t52\n0\t1\t53\t53\n0\t1\t54\t54\n0\t1\t55\t55\n0\t1\t56\t56\n0\t1\t57\t57\n0\t1\t97\t97\n0\t1\t98\t98\n0\t1\t99\t99\n0\t1\t100\t100\n0\t1\t101\t101\n0\t1\t102\t102\n1\t1\t48\t48\n1\t1\t49\t49\n1\t1\t50\t50\n1\t1\t51\t51\n1\t1\t52\t52\n1\t1\t53\t53\n1\t1\t54\t54\n1\t1\t55\t55\n1\t1\t56\t56\n1\t1\t57\t57\n1\t1\t97\t97\n1\t1\t98\t98\n1\t1\t99\t99\n1\t1\t100\t100\n1\t1\t101\t101\n1\t1\t102\t102\n1\n",
+	"[a-zA-Z0-9\\?\\-\\.\\&]+": "0\t1\t38\t38\n0\t1\t45\t45\n0\t1\t46\t46\n0\t1\t48\t48\n0\t1\t49\t49\n0\t1\t50\t50\n0\t1\t51\t51\n0\t1\t52\t52\n0\t1\t53\t53\n0\t1\t54\t54\n0\t1\t55\t55\n0\t1\t56\t56\n0\t1\t57\t57\n0\t1\t63\t63\n0\t1\t65\t65\n0\t1\t66\t66\n0\t1\t67\t67\n0\t1\t68\t68\n0\t1\t69\t69\n0\t1\t70\t70\n0\t1\t71\t71\n0\t1\t72\t72\n0\t1\t73\t73\n0\t1\t74\t74\n0\t1\t75\t75\n0\t1\t76\t76\n0\t1\t77\t77\n0\t1\t78\t78\n0\t1\t79\t79\n0\t1\t80\t80\n0\t1\t81\t81\n0\t1\t82\t82\n0\t1\t83\t83\n0\t1\t84\t84\n0\t1\t85\t85\n0\t1\t86\t86\n0\t1\t87\t87\n0\t1\t88\t88\n0\t1\t89\t89\n0\t1\t90\t90\n0\t1\t97\t97\n0\t1\t98\t98\n0\t1\t99\t99\n0\t1\t100\t100\n0\t1\t101\t101\n0\t1\t102\t102\n0\t1\t103\t103\n0\t1\t104\t104\n0\t1\t105\t105\n0\t1\t106\t106\n0\t1\t107\t107\n0\t1\t108\t108\n0\t1\t109\t109\n0\t1\t110\t110\n0\t1\t111\t111\n0\t1\t112\t112\n0\t1\t113\t113\n0\t1\t114\t114\n0\t1\t115\t115\n0\t1\t116\t116\n0\t1\t117\t117\n0\t1\t118\t118\n0\t1\t119\t119\n0\t1\t120\t120\n0\t1\t121\t121\n0\t1\t122\t122\n1\t1\t38\t38\n1\t1\t45\t45\n1\t1\t46\t46\n1\t1\t48\t48\n1\t1\t49\t49\n1\t1\t50\t50\n1\t1\t51\t51\n1\t1\t52\t52\n1\t1\t53\t53\n1\t1\t54\t54\n1\t1\t55\t55\n1\t1\t56\t56\n1\t1\t57\t57\n1\t1\t63\t63\n1\t1\t65\t65\n1\t1\t66\t66\n1\t1\t67\t67\n1\t1\t68\t68\n1\t1\t69\t69\n1\t1\t70\t70\n1\t1\t71\t71\n1\t1\t72\t72\n1\t1\t73\t73\n1\t1\t74\t74\n1\t1\t75\t75\n1\t1\t76\t76\n1\t1\t77\t77\n1\t1\t78\t78\n1\t1\t79\t79\n1\t1\t80\t80\n1\t1\t81\t81\n1\t1\t82\t82\n1\t1\t83\t83\n1\t1\t84\t84\n1\t1\t85\t85\n1\t1\t86\t86\n1\t1\t87\t87\n1\t1\t88\t88\n1\t1\t89\t89\n1\t1\t90\t90\n1\t1\t97\t97\n1\t1\t98\t98\n1\t1\t99\t99\n1\t1\t100\t100\n1\t1\t101\t101\n1\t1\t102\t102\n1\t1\t103\t103\n1\t1\t104\t104\n1\t1\t105\t105\n1\t1\t106\t106\n1\t1\t107\t107\n1\t1\t108\t108\n1\t1\t109\t109\n1\t1\t110\t110\n1\t1\t111\t111\n1\t1\t112\t112\n1\t1\t113\t113\n1\t1\t114\t114\n1\t1\t115\t115\n1\t1\t116\t116\n1\t1\t117\t117\n1\t1\t118\t118\n1\t1\t119\t119\n1\t1\t120\t120\n1\t1\t121\t121\n1\t1\t122\t122\n1\n",
+	"[a-zA-Z0-9]+":             "0\t1\t48\t48\n0\t1\t49\t49\n0\t1\t50\t50\n0\t1\t51\t51\n0\t1\t52\t52\n0\t1\t53\t53\n0\t1\t54\t54\n0\t1\t55\t55\n0\t1\t56\t56\n0\t1\t57\t57\n0\t1\t65\t65\n0\t1\t66\t66\n0\t1\t67\t67\n0\t1\t68\t68\n0\t1\t69\t69\n0\t1\t70\t70\n0\t1\t71\t71\n0\t1\t72\t72\n0\t1\t73\t73\n0\t1\t74\t74\n0\t1\t75\t75\n0\t1\t76\t76\n0\t1\t77\t77\n0\t1\t78\t78\n0\t1\t79\t79\n0\t1\t80\t80\n0\t1\t81\t81\n0\t1\t82\t82\n0\t1\t83\t83\n0\t1\t84\t84\n0\t1\t85\t85\n0\t1\t86\t86\n0\t1\t87\t87\n0\t1\t88\t88\n0\t1\t89\t89\n0\t1\t90\t90\n0\t1\t97\t97\n0\t1\t98\t98\n0\t1\t99\t99\n0\t1\t100\t100\n0\t1\t101\t101\n0\t1\t102\t102\n0\t1\t103\t103\n0\t1\t104\t104\n0\t1\t105\t105\n0\t1\t106\t106\n0\t1\t107\t107\n0\t1\t108\t108\n0\t1\t109\t109\n0\t1\t110\t110\n0\t1\t111\t111\n0\t1\t112\t112\n0\t1\t113\t113\n0\t1\t114\t114\n0\t1\t115\t115\n0\t1\t116\t116\n0\t1\t117\t117\n0\t1\t118\t118\n0\t1\t119\t119\n0\t1\t120\t120\n0\t1\t121\t121\n0\t1\t122\t122\n1\t1\t48\t48\n1\t1\t49\t49\n1\t1\t50\t50\n1\t1\t51\t51\n1\t1\t52\t52\n1\t1\t53\t53\n1\t1\t54\t54\n1\t1\t55\t55\n1\t1\t56\t56\n1\t1\t57\t57\n1\t1\t65\t65\n1\t1\t66\t66\n1\t1\t67\t67\n1\t1\t68\t68\n1\t1\t69\t69\n1\t1\t70\t70\n1\t1\t71\t71\n1\t1\t72\t72\n1\t1\t73\t73\n1\t1\t74\t74\n1\t1\t75\t75\n1\t1\t76\t76\n1\t1\t77\t77\n1\t1\t78\t78\n1\t1\t79\t79\n1\t1\t80\t80\n1\t1\t81\t81\n1\t1\t82\t82\n1\t1\t83\t83\n1\t1\t84\t84\n1\t1\t85\t85\n1\t1\t86\t86\n1\t1\t87\t87\n1\t1\t88\t88\n1\t1\t89\t89\n1\t1\t90\t90\n1\t1\t97\t97\n1\t1\t98\t98\n1\t1\t99\t99\n1\t1\t100\t100\n1\t1\t101\t101\n1\t1\t102\t102\n1\t1\t103\t103\n1\t1\t104\t104\n1\t1\t105\t105\n1\t1\t106\t106\n1\t1\t107\t107\n1\t1\t108\t108\n1\t1\t109\t109\n1\t1\t110\t110\n1\t1\t111\t111\n1\t1\t112\t112\n1\t1\t113\t113\n1\t1\t114\t114\n1\t1\t115\t115\n1\t1\t116\t116\n1\t1\t117\t117\n1\t1\t118\t118\n1\t1\t119\t119\n1\t1\t120\t120\n1\t1\t121\t121\n1\t1\t122\t122\n1\n",
+	"[a-z]+":                   "0\t1\t97\t97\n0\t1\t98\t98\n0\t1\t99\t99\n0\t1\t100\t100\n0\t1\t101\t101\n0\t1\t102\t102\n0\t1\t103\t103\n0\t1\t104\t104\n0\t1\t105\t105\n0\t1\t106\t106\n0\t1\t107\t107\n0\t1\t108\t108\n0\t1\t109\t109\n0\t1\t110\t110\n0\t1\t111\t111\n0\t1\t112\t112\n0\t1\t113\t113\n0\t1\t114\t114\n0\t1\t115\t115\n0\t1\t116\t116\n0\t1\t117\t117\n0\t1\t118\t118\n0\t1\t119\t119\n0\t1\t120\t120\n0\t1\t121\t121\n0\t1\t122\t122\n1\t1\t97\t97\n1\t1\t98\t98\n1\t1\t99\t99\n1\t1\t100\t100\n1\t1\t101\t101\n1\t1\t102\t102\n1\t1\t103\t103\n1\t1\t104\t104\n1\t1\t105\t105\n1\t1\t106\t106\n1\t1\t107\t107\n1\t1\t108\t108\n1\t1\t109\t109\n1\t1\t110\t110\n1\t1\t111\t111\n1\t1\t112\t112\n1\t1\t113\t113\n1\t1\t114\t114\n1\t1\t115\t115\n1\t1\t116\t116\n1\t1\t117\t117\n1\t1\t118\t118\n1\t1\t119\t119\n1\t1\t120\t120\n1\t1\t121\t121\n1\t1\t122\t122\n1\n",
+	"^\x00\x00\x00\\x7cÿSMB[%r]\x00\x00\x00\x00\\C{115}$": "0\t1\t0\t0\n1\t2\t0\t0\n2\t3\t0\t0\n3\t4\t124\t124\n4\t5\t195\t195\n5\t6\t191\t191\n6\t7\t83\t83\n7\t8\t77\t77\n8\t9\t66\t66\n9\t10\t37\t37\n9\t10\t114\t114\n10\t11\t0\t0\n11\t12\t0\t0\n12\t13\t0\t0\n13\t14\t0\t0\n14\t15\t0\t0\n14\t15\t1\t1\n14\t15\t2\t2\n14\t15\t3\t3\n14\t15\t4\t4\n14\t15\t5\t5\n14\t15\t6\t6\n14\t15\t7\t7\n14\t15\t8\t8\n14\t15\t9\t9\n14\t15\t10\t10\n14\t15\t11\t11\n14\t15\t12\t12\n14\t15\t13\t13\n14\t15\t14\t14\n14\t15\t15\t15\n14\t15\t16\t16\n14\t15\t17\t17\n14\t15\t18\t18\n14\t15\t19\t19\n14\t15\t20\t20\n14\t15\t21\t21\n14\t15\t22\t22\n14\t15\t23\t23\n14\t15\t24\t24\n14\t15\t25\t25\n14\t15\t26\t26\n14\t15\t27\t27\n14\t15\t28\t28\n14\t15\t29\t29\n14\t15\t30\t30\n14\t15\t31\t31\n14\t15\t32\t32\n14\t15\t33\t33\n14\t15\t34\t34\n14\t15\t35\t35\n14\t15\t36\t36\n14\t15\t37\t37\n14\t15\t38\t38\n14\t15\t39\t39\n14\t15\t40\t40\n14\t15\t41\t41\n14\t15\t42\t42\n14\t15\t43\t43\n14\t15\t44\t44\n14\t15\t45\t45\n14\t15\t46\t46\n14\t15\t47\t47\n14\t15\t48\t48\n14\t15\t49\t49\n14\t15\t50\t50\n14\t15\t51\t51\n14\t15\t52\t52\n14\t15\t53\t53\n14\t15\t54\t54\n14\t15\t55\t55\n14\t15\t56\t56\n14\t15\t57\t57\n14\t15\t58\t58\n14\t15\t59\t59\n14\t15\t60\t60\n14\t15\t61\t61\n14\t15\t62\t62\n14\t15\t63\t63\n14\t15\t64\t64\n14\t15\t65\t65\n14\t15\t66\t66\n14\t15\t67\t67\n14\t15\t68\t68\n14\t15\t69\t69\n14\t15\t70\t70\n14\t15\t71\t71\n14\t15\t72\t72\n14\t15\t73\t73\n14\t15\t74\t74\n14\t15\t75\t75\n14\t15\t76\t76\n14\t15\t77\t77\n14\t15\t78\t78\n14\t15\t79\t79\n14\t15\t80\t80\n14\t15\t81\t81\n14\t15\t82\t82\n14\t15\t83\t83\n14\t15\t84\t84\n14\t15\t85\t85\n14\t15\t86\t86\n14\t15\t87\t87\n14\t15\t88\t88\n14\t15\t89\t89\n14\t15\t90\t90\n14\t15\t91\t91\n14\t15\t92\t92\n14\t15\t93\t93\n14\t15\t94\t94\n14\t15\t95\t95\n14\t15\t96\t96\n14\t15\t97\t97\n14\t15\t98\t98\n14\t15\t99\t99\n14\t15\t100\t100\n14\t15\t101\t101\n14\t15\t102\t102\n14\t15\t103\t103\n14\t15\t104\t104\n14\t15\t105\t105\n14\t15\t106\t106\n14\t15\t107\t107\n14\t15\t108\t108\n14\t15\t109\t109\n14\t15\t110\t110\n14\t15\t111\t111\n14\t15\t112\t112\n14\t15\t113\t113\n14\t15\t114\t114\n14\t15\t115\t115\n14\t15\t116\t116\n14\t15\t117\t117\n14\t15\t118\t118\n14\t15\t119\t119\n14\t15\t120\t120\n14\t15\t121\t121\n14\t15\t122\t122\n14\t15\t123\t123\n14\t15\t124\t124\n14\t15\t125\t125\n14\t15\t126\t126\n14\t15\t127\t127\n14\t15\t128\t128\n14\t15\t129\t129\n14\t15\t130\t130\n14\t15\t131\t131\n14\t15\t132\t132\n14\t15\t133\t133\n14\t15\t134\t134\n14\t15\t135\t135\n14\t15\t136\t136\n14\t15\t137\t137\n14\t15\t138\t138\n14\t15\t139\t139\n14\t15\t140\t140\n14\t15\t141\t141\n14\t15\t142\t142\n14\t15\t143\t143\n14\t15\t144\t144\n14\t15\t145\t145\n14\t15\t146\t146\n14\t15\t147\t147\n14\t15\t148\t148\n14\t15\t149\t149\n14\t15\t150\t150\n14\t15\t151\t151\n14\t15\t152\t152\n14\t15\t153\t153\n14\t15\t154\t154\n14\t15\t155\t155\n14\t15\t156\t156\n14\t15\t157\t157\n14\t15\t158\t158\n14\t15\t159\t159\n14\t15\t160\t160\n14\t15\t161\t161\n14\t15\t162\t162\n14\t15\t163\t163\n14\t15\t164\t164\n14\t15\t165\t165\n14\t15\t166\t166\n14\t15\t167\t167\n14\t15\t168\t168\n14\t15\t169\t169\n14\t15\t170\t170\n14\t15\t171\t171\n14\t15\t172\t172\n14\t15\t173\t173\n14\t15\t174\t174\n14\t15\t175\t175\n14\t15\t176\t176\n14\t15\t177\t177\n14\t15\t178\t178\n14\t15\t179\t179\n14\t15\t180\t180\n14\t15\t181\t181\n14\t15\t182\t182\n14\t15\t183\t183\n14\t15\t184\t184\n14\t15\t185\t185\n14\t15\t186\t186\n14\t15\t187\t187\n14\t15\t188\t188\n14\t15\t189\t189\n14\t15\t190\t190\n14\t15\t191\t191\n14\t15\t192\t192\n14\t15\t193\t193\n14\t15\t194\t194\n14\t15\t195\t195\n14\t15\t196\t196\n14\t15\t197\t197\n14\t15\t198\t198\n14\t15\t199\t199\n14\t15\t200\t200\n14\t15\t201\t201\n14\t15\t202\t202\n14\t15\t203\t203\n14\t15\t204\t204\n14\t15\t205\t205\n14\t15\t206\t206\n14\t15\t207\t207\n14\t15\t208\t208\n14\t15\t209\t209\n14\t15\t210\t210\n14\t15\t211\t211\n14\t15\t212\t212\n14\t15\t213\t213\n14\t15\t214\t214\n14\t15\t215\t215\n14\t15\t216\t216\n14\t15\t217\t217\n14\t15\t218\t218\n14\t15\t219\t219\n14\t15\t220\t220\n14\t15\t221\t221\n14\t15\t222\t222\n14\t15\t223\t223\n14\t15\t224\t224\n14\t15\t225\t225\n14\t15\t226\t226\n14\t15\t227\t227\n14\t15\t228\t228\n14\t15\t229\t229\n14\t15\t230\t230\n14\t15\t231\t231\n14\t15\t232\t232\n14\t15\t233\t233\n14\t15\t234\t234\n14\t15\t235\t235\n14\t15\t236\t236\n14\t15\t237\t237\n14\t15\t238\t238\n14\t15\t239\t239\n14\t15\t240\t240\n14\t15\t241\t241\n14\t15\t242\t242\n14\t15\t243\t243\n14\t15\t244\t244\n14\t15\t245\t245\n14\t15\t246\t246\n14\t15\t247\t247\n14\t15\t248\t248\n14\t15\t249\t249\n14\t15\t250\t250\n14\t15\t251\t251\n14\t15\t252\t252\n14\t15\t253\t253\n14\t15\t254\t254\n14\t15\t255\t255\n15\t16\t0\t0\n15\t16\t1\t1\n15\t16\t2\t2\n15\t16\t3\t3\n15\t16\t4\t4\n15\t16\t5\t5\n15\t16\t6\t6\n15\t16\t7\t7\n15\t16\t8\t8\n15\t16\t9\t9\n15\t16\t10\t10\n15\t16\t11\t11\n15\t16\t12\t12\n15\t16\t13\t13\n15\t16\t14\t14\n15\t16\t15\t15\n15\t16\t16\t16\n15\t16\t17\t17\n15\t16\t18\t18\n15\t16\t19\t19\n15\t16\t20\t20\n15\t16\t21\t21\n15\t16\t22\t22\n15\t16\t23\t23\n15\t16\t24\t24\n15\t16\t25\t25\n15\t16\t26\t26\n15\t16\t27\t27\n15\t16\t28\t28\n15\t16\t29\t29\n15\t16\t30\t30\n15\t16\t31\t31\n15\t16\t32\t32\n15\t16\t33\t33\n15\t16\t34\t34\n15\t16\t35\t35\n15\t16\t36\t36\n15\t16\t37\t37\n15\t16\t38\t38\n15\t16\t39\t39\n15\t16\t40\t40\n15\t16\t41\t41\n15\t16\t42\t42\n15\t16\t43\t43\n15\t16\t44\t44\n15\t16\t45\t45\n15\t16\t46\t46\n15\t16\t47\t47\n15\t16\t48\t48\n15\t16\t49\t49\n15\t16\t50\t50\n15\t16\t51\t51\n15\t16\t52\t52\n15\t16\t53\t53\n15\t16\t54\t54\n15\t16\t55\t55\n15\t16\t56\t56\n15\t16\t57\t57\n15\t16\t58\t58\n15\t16\t59\t59\n15\t16\t60\t60\n15\t16\t61\t61\n15\t16\t62\t62\n15\t16\t63\t63\n15\t16\t64\t64\n15\t16\t65\t65\n15\t16\t66\t66\n15\t16\t67\t67\n15\t16\t68\t68\n15\t16\t69\t69\n15\t16\t70\t70\n15\t16\t71\t71\n15\t16\t72\t72\n15\t16\t73\t73\n15\t16\t74\t74\n15\t16\t75\t75\n15\t16\t76\t76\n15\t16\t77\t77\n15\t16\t78\t78\n15\t16\t79\t79\n15\t16\t80\t80\n15\t16\t81\t81\n15\t16\t82\t82\n15\t16\t83\t83\n15\t16\t84\t84\n15\t16\t85\t85\n15\t16\t86\t86\n15\t16\t87\t87\n15\t16\t88\t88\n15\t16\t89\t89\n15\t16\t90\t90\n15\t16\t91\t91\n15\t16\t92\t92\n15\t16\t93\t93\n15\t16\t94\t94\n15\t16\t95\t95\n15\t16\t96\t96\n15\t16\t97\t97\n15\t16\t98\t98\n15\t16\t99\t99\n15\t16\t100\t100\n15\t16\t101\t101\n15\t16\t102\t102\n15\t16\t103\t103\n15\t16\t104\t104\n15\t16\t105\t105\n15\t16\t106\t106\n15\t16\t107\t107\n15\t16\t108\t108\n15\t16\t109\t109\n15\t16\t110\t110\n15\t16\t111\t111\n15\t16\t112\t112\n15\t16\t113\t113\n15\t16\t114\t114\n15\t16\t115\t115\n15\t16\t116\t116\n15\t16\t117\t117\n15\t16\t118\t118\n15\t16\t119\t119\n15\t16\t120\t120\n15\t16\t121\t121\n15\t16\t122\t122\n15\t16\t123\t123\n15\t16\t124\t124\n15\t16\t125\t125\n15\t16\t126\t126\n15\t16\t127\t127\n15\t16\t128\t128\n15\t16\t129\t129\n15\t16\t130\t130\n15\t16\t131\t131\n15\t16\t132\t132\n15\t16\t133\t133\n15\t16\t134\t134\n15\t16\t135\t135\n15\t16\t136\t136\n15\t16\t137\t137\n15\t16\t138\t138\n15\t16\t139\t139\n15\t16\t140\t140\n15\t16\t141\t141\n15\t16\t142\t142\n15\t16\t143\t143\n15\t16\t144\t144\n15\t16\t145\t145\n15\t16\t146\t146\n15\t16\t147\t147\n15\t16\t148\t148\n15\t16\t149\t149\n15\t16\t150\t150\n15\t16\t151\t151\n15\t16\t152\t152\n15\t16\t153\t153\n15\t16\t154\t154\n15\t16\t155\t155\n15\t16\t156\t156\n15\t16\t157\t157\n15\t16\t158\t158\n15\t16\t159\t159\n15\t16\t160\t160\n15\t16\t161\t161\n15\t16\t162\t162\n15\t16\t163\t163\n15\t16\t164\t164\n15\t16\t165\t165\n15\t16\t166\t166\n15\t16\t167\t167\n15\t16\t168\t168\n15\t16\t169\t169\n15\t16\t170\t170\n15\t16\t171\t171\n15\t16\t172\t172\n15\t16\t173\t173\n15\t16\t174\t174\n15\t16\t175\t175\n15\t16\t176\t176\n15\t16\t177\t177\n15\t16\t178\t178\n15\t16\t179\t179\n15\t16\t180\t180\n15\t16\t181\t181\n15\t16\t182\t182\n15\t16\t183\t183\n15\t16\t184\t184\n15\t16\t185\t185\n15\t16\t186\t186\n15\t16\t187\t187\n15\t16\t188\t188\n15\t16\t189\t189\n15\t16\t190\t190\n15\t16\t191\t191\n15\t16\t192\t192\n15\t16\t193\t193\n15\t16\t194\t194\n15\t16\t195\t195\n15\t16\t196\t196\n15\t16\t197\t197\n15\t16\t198\t198\n15\t16\t199\t199\n15\t16\t200\t200\n15\t16\t201\t201\n15\t16\t202\t202\n15\t16\t203\t203\n15\t16\t204\t204\n15\t16\t205\t205\n15\t16\t206\t206\n15\t16\t207\t207\n15\t16\t208\t208\n15\t16\t209\t209\n15\t16\t210\t210\n15\t16\t211\t211\n15\t16\t212\t212\n15\t16\t213\t213\n15\t16\t214\t214\n15\t16\t215\t215\n15\t16\t216\t216\n15\t16\t217\t217\n15\t16\t218\t218\n15\t16\t219\t219\n15\t16\t220\t220\n15\t16\t221\t221\n15\t16\t222\t222\n15\t16\t223\t223\n15\t16\t224\t224\n15\t16\t225\t225\n15\t16\t226\t226\n15\t16\t227\t227\n15\t16\t228\t228\n15\t16\t229\t229\n15\t16\t230\t230\n15\t16\t231\t231\n15\t16\t232\t232\n15\t16\t233\t233\n15\t16\t234\t234\n15\t16\t235\t235\n15\t16\t236\t236\n15\t16\t237\t237\n15\t16\t238\t238\n15\t16\t239\t239\n15\t16\t240\t240\n15\t16\t241\t241\n15\t16\t242\t242\n15\t16\t243\t243\n15\t16\t244\t244\n15\t16\t245\t245\n15\t16\t246\t246\n15\t16\t247\t247\n15\t16\t248\t248\n15\t16\t249\t249\n15\t16\t250\t250\n15\t16\t251\t251\n15\t16\t252\t252\n15\t16\t253\t253\n15\t16\t254\t254\n15\t16\t255\t255\n16\t17\t0\t0\n16\t17\t1\t1\n16\t17\t2\t2\n16\t17\t3\t3\n16\t17\t4\t4\n16\t17\t5\t5\n16\t17\t6\t6\n16\t17\t7\t7\n16\t17\t8\t8\n16\t17\t9\t9\n16\t17\t10\t10\n16\t17\t11\t11\n16\t17\t12\t12\n16\t17\t13\t13\n16\t17\t14\t14\n16\t17\t15\t15\n16\t17\t16\t16\n16\t17\t17\t17\n16\t17\t18\t18\n16\t17\t19\t19\n16\t17\t20\t20\n16\t17\t21\t21\n16\t17\t22\t22\n16\t17\t23\t23\n16\t17\t24\t24\n16\t17\t25\t25\n16\t17\t26\t26\n16\t17\t27\t27\n16\t17\t28\t28\n16\t17\t29\t29\n16\t17\t30\t30\n16\t17\t31\t31\n16\t17\t32\t32\n16\t17\t33\t33\n16\t17\t34\t34\n16\t17\t35\t35\n16\t17\t36\t36\n16\t17\t37\t37\n16\t17\t38\t38\n16\t17\t39\t39\n16\t17\t40\t40\n16\t17\t41\t41\n16\t17\t42\t42\n16\t17\t43\t43\n16\t17\t44\t44\n16\t17\t45\t45\n16\t17\t46\t46\n16\t17\t47\t47\n16\t17\t48\t48\n16\t17\t49\t49\n16\t17\t50\t50\n16\t17\t51\t51\n16\t17\t52\t52\n16\t17\t53\t53\n16\t17\t54\t54\n16\t17\t55\t55\n16\t17\t56\t56\n16\t17\t57\t57\n16\t17\t58\t58\n16\t17\t59\t59\n16\t17\t60\t60\n16\t17\t61\t61\n16\t17\t62\t62\n16\t17\t63\t63\n16\t17\t64\t64\n16\t17\t65\t65\n16\t17\t66\t66\n16\t17\t67\t67\n16\t17\t68\t68\n16\t17\t69\t69\n16\t17\t70\t70\n16\t17\t71\t71\n16\t17\t72\t72\n16\t17\t73\t73\n16\t17\t74\t74\n16\t17\t75\t75\n16\t17\t76\t76\n16\t17\t77\t77\n16\t17\t78\t78\n16\t17\t79\t79\n16\t17\t80\t80\n16\t17\t81\t81\n16\t17\t82\t82\n16\t17\t83\t83\n16\t17\t84\t84\n16\t17\t85\t85\n16\t17\t86\t86\n16\t17\t87\t87\n16\t17\t88\t88\n16\t17\t89\t89\n16\t17\t90\t90\n16\t17\t91\t91\n16\t17\t92\t92\n16\t17\t93\t93\n16\t17\t94\t94\n16\t17\t95\t95\n16\t17\t96\t96\n16\t17\t97\t97\n16\t17\t98\t98\n16\t17\t99\t99\n16\t17\t100\t100\n16\t17\t101\t101\n16\t17\t102\t102\n16\t17\t103\t103\n16\t17\t104\t104\n16\t17\t105\t105\n16\t17\t106\t106\n16\t17\t107\t107\n16\t17\t108\t108\n16\t17\t109\t109\n16\t17\t110\t110\n16\t17\t111\t111\n16\t17\t112\t112\n16\t17\t113\t113\n16\t17\t114\t114\n16\t17\t115\t115\n16\t17\t116\t116\n16\t17\t117\t117\n16\t17\t118\t118\n16\t17\t119\t119\n16\t17\t120\t120\n16\t17\t121\t121\n16\t17\t122\t122\n16\t17\t123\t123\n16\t17\t124\t124\n16\t17\t125\t125\n16\t17\t126\t126\n16\t17\t127\t127\n16\t17\t128\t128\n16\t17\t129\t129\n16\t17\t130\t130\n16\t17\t131\t131\n16\t17\t132\t132\n16\t17\t133\t133\n16\t17\t134\t134\n16\t17\t135\t135\n16\t17\t136\t136\n16\t17\t137\t137\n16\t17\t138\t138\n16\t17\t139\t139\n16\t17\t140\t140\n16\t17\t141\t141\n16\t17\t142\t142\n16\t17\t143\t143\n16\t17\t144\t144\n16\t17\t145\t145\n16\t17\t146\t146\n16\t17\t147\t147\n16\t17\t148\t148\n16\t17\t149\t149\n16\t17\t150\t150\n16\t17\t151\t151\n16\t17\t152\t152\n16\t17\t153\t153\n16\t17\t154\t154\n16\t17\t155\t155\n16\t17\t156\t156\n16\t17\t157\t157\n16\t17\t158\t158\n16\t17\t159\t159\n16\t17\t160\t160\n16\t17\t161\t161\n16\t17\t162\t162\n16\t17\t163\t163\n16\t17\t164\t164\n16\t17\t165\t165\n16\t17\t166\t166\n16\t17\t167\t167\n16\t17\t168\t168\n16\t17\t169\t169\n16\t17\t170\t170\n16\t17\t171\t171\n16\t17\t172\t172\n16\t17\t173\t173\n16\t17\t174\t174\n16\t17\t175\t175\n16\t17\t176\t176\n16\t17\t177\t177\n16\t17\t178\t178\n16\t17\t179\t179\n16\t17\t180\t180\n16\t17\t181\t181\n16\t17\t182\t182\n16\t17\t183\t183\n16\t17\t184\t184\n16\t17\t185\t185\n16\t17\t186\t186\n16\t17\t187\t187\n16\t17\t188\t188\n16\t17\t189\t189\n16\t17\t190\t190\n16\t17\t191\t191\n16\t17\t192\t192\n16\t17\t193\t193\n16\t17\t194\t194\n16\t17\t195\t195\n16\t17\t196\t196\n16\t17\t197\t197\n16\t17\t198\t198\n16\t17\t199\t199\n16\t17\t200\t200\n16\t17\t201\t201\n16\t17\t202\t202\n16\t17\t203\t203\n16\t17\t204\t204\n16\t17\t205\t205\n16\t17\t206\t206\n16\t17\t207\t207\n16\t17\t208\t208\n16\t17\t209\t209\n16\t17\t210\t210\n16\t17\t211\t211\n16\t17\t212\t212\n16\t17\t213\t213\n16\t17\t214\t214\n16\t17\t215\t215\n16\t17\t216\t216\n16\t17\t217\t217\n16\t17\t218\t218\n16\t17\t219\t219\n16\t17\t220\t220\n16\t17\t221\t221\n16\t17\t222\t222\n16\t17\t223\t223\n16\t17\t224\t224\n16\t17\t225\t225\n16\t17\t226\t226\n16\t17\t227\t227\n16\t17\t228\t228\n16\t17\t229\t229\n16\t17\t230\t230\n16\t17\t231\t231\n16\t17\t232\t232\n16\t17\t233\t233\n16\t17\t234\t234\n16\t17\t235\t235\n16\t17\t236\t236\n16\t17\t237\t237\n16\t17\t238\t238\n16\t17\t239\t239\n16\t17\t240\t240\n16\t17\t241\t241\n16\t17\t242\t242\n16\t17\t243\t243\n16\t17\t244\t244\n16\t17\t245\t245\n16\t17\t246\t246\n16\t17\t247\t247\n16\t17\t248\t248\n16\t17\t249\t249\n16\t17\t250\t250\n16\t17\t251\t251\n16\t17\t252\t252\n16\t17\t253\t253\n16\t17\t254\t254\n16\t17\t255\t255\n17\t18\t0\t0\n17\t18\t1\t1\n17\t18\t2\t2\n17\t18\t3\t3\n17\t18\t4\t4\n17\t18\t5\t5\n17\t18\t6\t6\n17\t18\t7\t7\n17\t18\t8\t8\n17\t18\t9\t9\n17\t18\t10\t10\n17\t18\t11\t11\n17\t18\t12\t12\n17\t18\t13\t13\n17\t18\t14\t14\n17\t18\t15\t15\n17\t18\t16\t16\n17\t18\t17\t17\n17\t18\t18\t18\n17\t18\t19\t19\n17\t18\t20\t20\n17\t18\t21\t21\n17\t18\t22\t22\n17\t18\t23\t23\n17\t18\t24\t24\n17\t18\t25\t25\n17\t18\t26\t26\n17\t18\t27\t27\n17\t18\t28\t28\n17\t18\t29\t29\n17\t18\t30\t30\n17\t18\t31\t31\n17\t18\t32\t32\n17\t18\t33\t33\n17\t18\t34\t34\n17\t18\t35\t35\n17\t18\t36\t36\n17\t18\t37\t37\n17\t18\t38\t38\n17\t18\t39\t39\n17\t18\t40\t40\n17\t18\t41\t41\n17\t18\t42\t42\n17\t18\t43\t43\n17\t18\t44\t44\n17\t18\t45\t45\n17\t18\t46\t46\n17\t18\t47\t47\n17\t18\t48\t48\n17\t18\t49\t49\n17\t18\t50\t50\n17\t18\t51\t51\n17\t18\t52\t52\n17\t18\t53\t53\n17\t18\t54\t54\n17\t18\t55\t55\n17\t18\t56\t56\n17\t18\t57\t57\n17\t18\t58\t58\n17\t18\t59\t59\n17\t18\t60\t60\n17\t18\t61\t61\n17\t18\t62\t62\n17\t18\t63\t63\n17\t18\t64\t64\n17\t18\t65\t65\n17\t18\t66\t66\n17\t18\t67\t67\n17\t18\t68\t68\n17\t18\t69\t69\n17\t18\t70\t70\n17\t18\t71\t71\n17\t18\t72\t72\n17\t18\t73\t73\n17\t18\t74\t74\n17\t18\t75\t75\n17\t18\t76\t76\n17\t18\t77\t77\n17\t18\t78\t78\n17\t18\t79\t79\n17\t18\t80\t80\n17\t18\t81\t81\n17\t18\t82\t82\n17\t18\t83\t83\n17\t18\t84\t84\n17\t18\t85\t85\n17\t18\t86\t86\n17\t18\t87\t87\n17\t18\t88\t88\n17\t18\t89\t89\n17\t18\t90\t90\n17\t18\t91\t91\n17\t18\t92\t92\n17\t18\t93\t93\n17\t18\t94\t94\n17\t18\t95\t95\n17\t18\t96\t96\n17\t18\t97\t97\n17\t18\t98\t98\n17\t18\t99\t99\n17\t18\t100\t100\n17\t18\t101\t101\n17\t18\t102\t102\n17\t18\t103\t103\n17\t18\t104\t104\n17\t18\t105\t105\n17\t18\t106\t106\n17\t18\t107\t107\n17\t18\t108\t108\n17\t18\t109\t109\n17\t18\t110\t110\n17\t18\t111\t111\n17\t18\t112\t112\n17\t18\t113\t113\n17\t18\t114\t114\n17\t18\t115\t115\n17\t18\t116\t116\n17\t18\t117\t117\n17\t18\t118\t118\n17\t18\t119\t119\n17\t18\t120\t120\n17\t18\t121\t121\n17\t18\t122\t122\n17\t18\t123\t123\n17\t18\t124\t124\n17\t18\t125\t125\n17\t18\t126\t126\n17\t18\t127\t127\n17\t18\t128\t128\n17\t18\t129\t129\n17\t18\t130\t130\n17\t18\t131\t131\n17\t18\t132\t132\n17\t18\t133\t133\n17\t18\t134\t134\n17\t18\t135\t135\n17\t18\t136\t136\n17\t18\t137\t137\n17\t18\t138\t138\n17\t18\t139\t139\n17\t18\t140\t140\n17\t18\t141\t141\n17\t18\t142\t142\n17\t18\t143\t143\n17\t18\t144\t144\n17\t18\t145\t145\n17\t18\t146\t146\n17\t18\t147\t147\n17\t18\t148\t148\n17\t18\t149\t149\n17\t18\t150\t150\n17\t18\t151\t151\n17\t18\t152\t152\n17\t18\t153\t153\n17\t18\t154\t154\n17\t18\t155\t155\n17\t18\t156\t156\n17\t18\t157\t157\n17\t18\t158\t158\n17\t18\t159\t159\n17\t18\t160\t160\n17\t18\t161\t161\n17\t18\t162\t162\n17\t18\t163\t163\n17\t18\t164\t164\n17\t18\t165\t165\n17\t18\t166\t166\n17\t18\t167\t167\n17\t18\t168\t168\n17\t18\t169\t169\n17\t18\t170\t170\n17\t18\t171\t171\n17\t18\t172\t172\n17\t18\t173\t173\n17\t18\t174\t174\n17\t18\t175\t175\n17\t18\t176\t176\n17\t18\t177\t177\n17\t18\t178\t178\n17\t18\t179\t179\n17\t18\t180\t180\n17\t18\t181\t181\n17\t18\t182\t182\n17\t18\t183\t183\n17\t18\t184\t184\n17\t18\t185\t185\n17\t18\t186\t186\n17\t18\t187\t187\n17\t18\t188\t188\n17\t18\t189\t189\n17\t18\t190\t190\n17\t18\t191\t191\n17\t18\t192\t192\n17\t18\t193\t193\n17\t18\t194\t194\n17\t18\t195\t195\n17\t18\t196\t196\n17\t18\t197\t197\n17\t18\t198\t198\n17\t18\t199\t199\n17\t18\t200\t200\n17\t18\t201\t201\n17\t18\t202\t202\n17\t18\t203\t203\n17\t18\t204\t204\n17\t18\t205\t205\n17\t18\t206\t206\n17\t18\t207\t207\n17\t18\t208\t208\n17\t18\t209\t209\n17\t18\t210\t210\n17\t18\t211\t211\n17\t18\t212\t212\n17\t18\t213\t213\n17\t18\t214\t214\n17\t18\t215\t215\n17\t18\t216\t216\n17\t18\t217\t217\n17\t18\t218\t218\n17\t18\t219\t219\n17\t18\t220\t220\n17\t18\t221\t221\n17\t18\t222\t222\n17\t18\t223\t223\n17\t18\t224\t224\n17\t18\t225\t225\n17\t18\t226\t226\n17\t18\t227\t227\n17\t18\t228\t228\n17\t18\t229\t229\n17\t18\t230\t230\n17\t18\t231\t231\n17\t18\t232\t232\n17\t18\t233\t233\n17\t18\t234\t234\n17\t18\t235\t235\n17\t18\t236\t236\n17\t18\t237\t237\n17\t18\t238\t238\n17\t18\t239\t239\n17\t18\t240\t240\n17\t18\t241\t241\n17\t18\t242\t242\n17\t18\t243\t243\n17\t18\t244\t244\n17\t18\t245\t245\n17\t18\t246\t246\n17\t18\t247\t247\n17\t18\t248\t248\n17\t18\t249\t249\n17\t18\t250\t250\n17\t18\t251\t251\n17\t18\t252\t252\n17\t18\t253\t253\n17\t18\t254\t254\n17\t18\t255\t255\n18\t19\t0\t0\n18\t19\t1\t1\n18\t19\t2\t2\n18\t19\t3\t3\n18\t19\t4\t4\n18\t19\t5\t5\n18\t19\t6\t6\n18\t19\t7\t7\n18\t19\t8\t8\n18\t19\t9\t9\n18\t19\t10\t10\n18\t19\t11\t11\n18\t19\t12\t12\n18\t19\t13\t13\n18\t19\t14\t14\n18\t19\t15\t15\n18\t19\t16\t16\n18\t19\t17\t17\n18\t19\t18\t18\n18\t19\t19\t19\n18\t19\t20\t20\n18\t19\t21\t21\n18\t19\t22\t22\n18\t19\t23\t23\n18\t19\t24\t24\n18\t19\t25\t25\n18\t19\t26\t26\n18\t19\t27\t27\n18\t19\t28\t28\n18\t19\t29\t29\n18\t19\t30\t30\n18\t19\t31\t31\n18\t19\t32\t32\n18\t19\t33\t33\n18\t19\t34\t34\n18\t19\t35\t35\n18\t19\t36\t36\n18\t19\t37\t37\n18\t19\t38\t38\n18\t19\t39\t39\n18\t19\t40\t40\n18\t19\t41\t41\n18\t19\t42\t42\n18\t19\t43\t43\n18\t19\t44\t44\n18\t19\t45\t45\n18\t19\t46\t46\n18\t19\t47\t47\n18\t19\t48\t48\n18\t19\t49\t49\n18\t19\t50\t50\n18\t19\t51\t51\n18\t19\t52\t52\n18\t19\t53\t53\n18\t19\t54\t54\n18\t19\t55\t55\n18\t19\t56\t56\n18\t19\t57\t57\n18\t19\t58\t58\n18\t19\t59\t59\n18\t19\t60\t60\n18\t19\t61\t61\n18\t19\t62\t62\n18\t19\t63\t63\n18\t19\t64\t64\n18\t19\t65\t65\n18\t19\t66\t66\n18\t19\t67\t67\n18\t19\t68\t68\n18\t19\t69\t69\n18\t19\t70\t70\n18\t19\t71\t71\n18\t19\t72\t72\n18\t19\t73\t73\n18\t19\t74\t74\n18\t19\t75\t75\n18\t19\t76\t76\n18\t19\t77\t77\n18\t19\t78\t78\n18\t19\t79\t79\n18\t19\t80\t80\n18\t19\t81\t81\n18\t19\t82\t82\n18\t19\t83\t83\n18\t19\t84\t84\n18\t19\t85\t85\n18\t19\t86\t86\n18\t19\t87\t87\n18\t19\t88\t88\n18\t19\t89\t89\n18\t19\t90\t90\n18\t19\t91\t91\n18\t19\t92\t92\n18\t19\t93\t93\n18\t19\t94\t94\n18\t19\t95\t95\n18\t19\t96\t96\n18\t19\t97\t97\n18\t19\t98\t98\n18\t19\t99\t99\n18\t19\t100\t100\n18\t19\t101\t101\n18\t19\t102\t102\n18\t19\t103\t103\n18\t19\t104\t104\n18\t19\t105\t105\n18\t19\t106\t106\n18\t19\t107\t107\n18\t19\t108\t108\n18\t19\t109\t109\n18\t19\t110\t110\n18\t19\t111\t111\n18\t19\t112\t112\n18\t19\t113\t113\n18\t19\t114\t114\n18\t19\t115\t115\n18\t19\t116\t116\n18\t19\t117\t117\n18\t19\t118\t118\n18\t19\t119\t119\n18\t19\t120\t120\n18\t19\t121\t121\n18\t19\t122\t122\n18\t19\t123\t123\n18\t19\t124\t124\n18\t19\t125\t125\n18\t19\t126\t126\n18\t19\t127\t127\n18\t19\t128\t128\n18\t19\t129\t129\n18\t19\t130\t130\n18\t19\t131\t131\n18\t19\t132\t132\n18\t19\t133\t133\n18\t19\t134\t134\n18\t19\t135\t135\n18\t19\t136\t136\n18\t19\t137\t137\n18\t19\t138\t138\n18\t19\t139\t139\n18\t19\t140\t140\n18\t19\t141\t141\n18\t19\t142\t142\n18\t19\t143\t143\n18\t19\t144\t144\n18\t19\t145\t145\n18\t19\t146\t146\n18\t19\t147\t147\n18\t19\t148\t148\n18\t19\t149\t149\n18\t19\t150\t150\n18\t19\t151\t151\n18\t19\t152\t152\n18\t19\t153\t153\n18\t19\t154\t154\n18\t19\t155\t155\n18\t19\t156\t156\n18\t19\t157\t157\n18\t19\t158\t158\n18\t19\t159\t159\n18\t19\t160\t160\n18\t19\t161\t161\n18\t19\t162\t162\n18\t19\t163\t163\n18\t19\t164\t164\n18\t19\t165\t165\n18\t19\t166\t166\n18\t19\t167\t167\n18\t19\t168\t168\n18\t19\t169\t169\n18\t19\t170\t170\n18\t19\t171\t171\n18\t19\t172\t172\n18\t19\t173\t173\n18\t19\t174\t174\n18\t19\t175\t175\n18\t19\t176\t176\n18\t19\t177\t177\n18\t19\t178\t178\n18\t19\t179\t179\n18\t19\t180\t180\n18\t19\t181\t181\n18\t19\t182\t182\n18\t19\t183\t183\n18\t19\t184\t184\n18\t19\t185\t185\n18\t19\t186\t186\n18\t19\t187\t187\n18\t19\t188\t188\n18\t19\t189\t189\n18\t19\t190\t190\n18\t19\t191\t191\n18\t19\t192\t192\n18\t19\t193\t193\n18\t19\t194\t194\n18\t19\t195\t195\n18\t19\t196\t196\n18\t19\t197\t197\n18\t19\t198\t198\n18\t19\t199\t199\n18\t19\t200\t200\n18\t19\t201\t201\n18\t19\t202\t202\n18\t19\t203\t203\n18\t19\t204\t204\n18\t19\t205\t205\n18\t19\t206\t206\n18\t19\t207\t207\n18\t19\t208\t208\n18\t19\t209\t209\n18\t19\t210\t210\n18\t19\t211\t211\n18\t19\t212\t212\n18\t19\t213\t213\n18\t19\t214\t214\n18\t19\t215\t215\n18\t19\t216\t216\n18\t19\t217\t217\n18\t19\t218\t218\n18\t19\t219\t219\n18\t19\t220\t220\n18\t19\t221\t221\n18\t19\t222\t222\n18\t19\t223\t223\n18\t19\t224\t224\n18\t19\t225\t225\n18\t19\t226\t226\n18\t19\t227\t227\n18\t19\t228\t228\n18\t19\t229\t229\n18\t19\t230\t230\n18\t19\t231\t231\n18\t19\t232\t232\n18\t19\t233\t233\n18\t19\t234\t234\n18\t19\t235\t235\n18\t19\t236\t236\n18\t19\t237\t237\n18\t19\t238\t238\n18\t19\t239\t239\n18\t19\t240\t240\n18\t19\t241\t241\n18\t19\t242\t242\n18\t19\t243\t243\n18\t19\t244\t244\n18\t19\t245\t245\n18\t19\t246\t246\n18\t19\t247\t247\n18\t19\t248\t248\n18\t19\t249\t249\n18\t19\t250\t250\n18\t19\t251\t251\n18\t19\t252\t252\n18\t19\t253\t253\n18\t19\t254\t254\n18\t19\t255\t255\n19\t20\t0\t0\n19\t20\t1\t1\n19\t20\t2\t2\n19\t20\t3\t3\n19\t20\t4\t4\n19\t20\t5\t5\n19\t20\t6\t6\n19\t20\t7\t7\n19\t20\t8\t8\n19\t20\t9\t9\n19\t20\t10\t10\n19\t20\t11\t11\n19\t20\t12\t12\n19\t20\t13\t13\n19\t20\t14\t14\n19\t20\t15\t15\n19\t20\t16\t16\n19\t20\t17\t17\n19\t20\t18\t18\n19\t20\t19\t19\n19\t20\t20\t20\n19\t20\t21\t21\n19\t20\t22\t22\n19\t20\t23\t23\n19\t20\t24\t24\n19\t20\t25\t25\n19\t20\t26\t26\n19\t20\t27\t27\n19\t20\t28\t28\n19\t20\t29\t29\n19\t20\t30\t30\n19\t20\t31\t31\n19\t20\t32\t32\n19\t20\t33\t33\n19\t20\t34\t34\n19\t20\t35\t35\n19\t20\t36\t36\n19\t20\t37\t37\n19\t20\t38\t38\n19\t20\t39\t39\n19\t20\t40\t40\n19\t20\t41\t41\n19\t20\t42\t42\n19\t20\t43\t43\n19\t20\t44\t44\n19\t20\t45\t45\n19\t20\t46\t46\n19\t20\t47\t47\n19\t20\t48\t48\n19\t20\t49\t49\n19\t20\t50\t50\n19\t20\t51\t51\n19\t20\t52\t52\n19\t20\t53\t53\n19\t20\t54\t54\n19\t20\t55\t55\n19\t20\t56\t56\n19\t20\t57\t57\n19\t20\t58\t58\n19\t20\t59\t59\n19\t20\t60\t60\n19\t20\t61\t61\n19\t20\t62\t62\n19\t20\t63\t63\n19\t20\t64\t64\n19\t20\t65\t65\n19\t20\t66\t66\n19\t20\t67\t67\n19\t20\t68\t68\n19\t20\t69\t69\n19\t20\t70\t70\n19\t20\t71\t71\n19\t20\t72\t72\n19\t20\t73\t73\n19\t20\t74\t74\n19\t20\t75\t75\n19\t20\t76\t76\n19\t20\t77\t77\n19\t20\t78\t78\n19\t20\t79\t79\n19\t20\t80\t80\n19\t20\t81\t81\n19\t20\t82\t82\n19\t20\t83\t83\n19\t20\t84\t84\n19\t20\t85\t85\n19\t20\t86\t86\n19\t20\t87\t87\n19\t20\t88\t88\n19\t20\t89\t89\n19\t20\t90\t90\n19\t20\t91\t91\n19\t20\t92\t92\n19\t20\t93\t93\n19\t20\t94\t94\n19\t20\t95\t95\n19\t20\t96\t96\n19\t20\t97\t97\n19\t20\t98\t98\n19\t20\t99\t99\n19\t20\t100\t100\n19\t20\t101\t101\n19\t20\t102\t102\n19\t20\t103\t103\n19\t20\t104\t104\n19\t20\t105\t105\n19\t20\t106\t106\n19\t20\t107\t107\n19\t20\t108\t108\n19\t20\t109\t109\n19\t20\t110\t110\n19\t20\t111\t111\n19\t20\t112\t112\n19\t20\t113\t113\n19\t20\t114\t114\n19\t20\t115\t115\n19\t20\t116\t116\n19\t20\t117\t117\n19\t20\t118\t118\n19\t20\t119\t119\n19\t20\t120\t120\n19\t20\t121\t121\n19\t20\t122\t122\n19\t20\t123\t123\n19\t20\t124\t124\n19\t20\t125\t125\n19\t20\t126\t126\n19\t20\t127\t127\n19\t20\t128\t128\n19\t20\t129\t129\n19\t20\t130\t130\n19\t20\t131\t131\n19\t20\t132\t132\n19\t20\t133\t133\n19\t20\t134\t134\n19\t20\t135\t135\n19\t20\t136\t136\n19\t20\t137\t137\n19\t20\t138\t138\n19\t20\t139\t139\n19\t20\t140\t140\n19\t20\t141\t141\n19\t20\t142\t142\n19\t20\t143\t143\n19\t20\t144\t144\n19\t20\t145\t145\n19\t20\t146\t146\n19\t20\t147\t147\n19\t20\t148\t148\n19\t20\t149\t149\n19\t20\t150\t150\n19\t20\t151\t151\n19\t20\t152\t152\n19\t20\t153\t153\n19\t20\t154\t154\n19\t20\t155\t155\n19\t20\t156\t156\n19\t20\t157\t157\n19\t20\t158\t158\n19\t20\t159\t159\n19\t20\t160\t160\n19\t20\t161\t161\n19\t20\t162\t162\n19\t20\t163\t163\n19\t20\t164\t164\n19\t20\t165\t165\n19\t20\t166\t166\n19\t20\t167\t167\n19\t20\t168\t168\n19\t20\t169\t169\n19\t20\t170\t170\n19\t20\t171\t171\n19\t20\t172\t172\n19\t20\t173\t173\n19\t20\t174\t174\n19\t20\t175\t175\n19\t20\t176\t176\n19\t20\t177\t177\n19\t20\t178\t178\n19\t20\t179\t179\n19\t20\t180\t180\n19\t20\t181\t181\n19\t20\t182\t182\n19\t20\t183\t183\n19\t20\t184\t184\n19\t20\t185\t185\n19\t20\t186\t186\n19\t20\t187\t187\n19\t20\t188\t188\n19\t20\t189\t189\n19\t20\t190\t190\n19\t20\t191\t191\n19\t20\t192\t192\n19\t20\t193\t193\n19\t20\t194\t194\n19\t20\t195\t195\n19\t20\t196\t196\n19\t20\t197\t197\n19\t20\t198\t198\n19\t20\t199\t199\n19\t20\t200\t200\n19\t20\t201\t201\n19\t20\t202\t202\n19\t20\t203\t203\n19\t20\t204\t204\n19\t20\t205\t205\n19\t20\t206\t206\n19\t20\t207\t207\n19\t20\t208\t208\n19\t20\t209\t209\n19\t20\t210\t210\n19\t20\t211\t211\n19\t20\t212\t212\n19\t20\t213\t213\n19\t20\t214\t214\n19\t20\t215\t215\n19\t20\t216\t216\n19\t20\t217\t217\n19\t20\t218\t218\n19\t20\t219\t219\n19\t20\t220\t220\n19\t20\t221\t221\n19\t20\t222\t222\n19\t20\t223\t223\n19\t20\t224\t224\n19\t20\t225\t225\n19\t20\t226\t226\n19\t20\t227\t227\n19\t20\t228\t228\n19\t20\t229\t229\n19\t20\t230\t230\n19\t20\t231\t231\n19\t20\t232\t232\n19\t20\t233\t233\n19\t20\t234\t234\n19\t20\t235\t235\n19\t20\t236\t236\n19\t20\t237\t237\n19\t20\t238\t238\n19\t20\t239\t239\n19\t20\t240\t240\n19\t20\t241\t241\n19\t20\t242\t242\n19\t20\t243\t243\n19\t20\t244\t244\n19\t20\t245\t245\n19\t20\t246\t246\n19\t20\t247\t247\n19\t20\t248\t248\n19\t20\t249\t249\n19\t20\t250\t250\n19\t20\t251\t251\n19\t20\t252\t252\n19\t20\t253\t253\n19\t20\t254\t254\n19\t20\t255\t255\n20\t21\t0\t0\n20\t21\t1\t1\n20\t21\t2\t2\n20\t21\t3\t3\n20\t21\t4\t4\n20\t21\t5\t5\n20\t21\t6\t6\n20\t21\t7\t7\n20\t21\t8\t8\n20\t21\t9\t9\n20\t21\t10\t10\n20\t21\t11\t11\n20\t21\t12\t12\n20\t21\t13\t13\n20\t21\t14\t14\n20\t21\t15\t15\n20\t21\t16\t16\n20\t21\t17\t17\n20\t21\t18\t18\n20\t21\t19\t19\n20\t21\t20\t20\n20\t21\t21\t21\n20\t21\t22\t22\n20\t21\t23\t23\n20\t21\t24\t24\n20\t21\t25\t25\n20\t21\t26\t26\n20\t21\t27\t27\n20\t21\t28\t28\n20\t21\t29\t29\n20\t21\t30\t30\n20\t21\t31\t31\n20\t21\t32\t32\n20\t21\t33\t33\n20\t21\t34\t34\n20\t21\t35\t35\n20\t21\t36\t36\n20\t21\t37\t37\n20\t21\t38\t38\n20\t21\t39\t39\n20\t21\t40\t40\n20\t21\t41\t41\n20\t21\t42\t42\n20\t21\t43\t43\n20\t21\t44\t44\n20\t21\t45\t45\n20\t21\t46\t46\n20\t21\t47\t47\n20\t21\t48\t48\n20\t21\t49\t49\n20\t21\t50\t50\n20\t21\t51\t51\n20\t21\t52\t52\n20\t21\t53\t53\n20\t21\t54\t54\n20\t21\t55\t55\n20\t21\t56\t56\n20\t21\t57\t57\n20\t21\t58\t58\n20\t21\t59\t59\n20\t21\t60\t60\n20\t21\t61\t61\n20\t21\t62\t62\n20\t21\t63\t63\n20\t21\t64\t64\n20\t21\t65\t65\n20\t21\t66\t66\n20\t21\t67\t67\n20\t21\t68\t68\n20\t21\t69\t69\n20\t21\t70\t70\n20\t21\t71\t71\n20\t21\t72\t72\n20\t21\t73\t73\n20\t21\t74\t74\n20\t21\t75\t75\n20\t21\t76\t76\n20\t21\t77\t77\n20\t21\t78\t78\n20\t21\t79\t79\n20\t21\t80\t80\n20\t21\t81\t81\n20\t21\t82\t82\n20\t21\t83\t83\n20\t21\t84\t84\n20\t21\t85\t85\n20\t21\t86\t86\n20\t21\t87\t87\n20\t21\t88\t88\n20\t21\t89\t89\n20\t21\t90\t90\n20\t21\t91\t91\n20\t21\t92\t92\n20\t21\t93\t93\n20\t21\t94\t94\n20\t21\t95\t95\n20\t21\t96\t96\n20\t21\t97\t97\n20\t21\t98\t98\n20\t21\t99\t99\n20\t21\t100\t100\n20\t21\t101\t101\n20\t21\t102\t102\n20\t21\t103\t103\n20\t21\t104\t104\n20\t21\t105\t105\n20\t21\t106\t106\n20\t21\t107\t107\n20\t21\t108\t108\n20\t21\t109\t109\n20\t21\t110\t110\n20\t21\t111\t111\n20\t21\t112\t112\n20\t21\t113\t113\n20\t21\t114\t114\n20\t21\t115\t115\n20\t21\t116\t116\n20\t21\t117\t117\n20\t21\t118\t118\n20\t21\t119\t119\n20\t21\t120\t120\n20\t21\t121\t121\n20\t21\t122\t122\n20\t21\t123\t123\n20\t21\t124\t124\n20\t21\t125\t125\n20\t21\t126\t126\n20\t21\t127\t127\n20\t21\t128\t128\n20\t21\t129\t129\n20\t21\t130\t130\n20\t21\t131\t131\n20\t21\t132\t132\n20\t21\t133\t133\n20\t21\t134\t134\n20\t21\t135\t135\n20\t21\t136\t136\n20\t21\t137\t137\n20\t21\t138\t138\n20\t21\t139\t139\n20\t21\t140\t140\n20\t21\t141\t141\n20\t21\t142\t142\n20\t21\t143\t143\n20\t21\t144\t144\n20\t21\t145\t145\n20\t21\t146\t146\n20\t21\t147\t147\n20\t21\t148\t148\n20\t21\t149\t149\n20\t21\t150\t150\n20\t21\t151\t151\n20\t21\t152\t152\n20\t21\t153\t153\n20\t21\t154\t154\n20\t21\t155\t155\n20\t21\t156\t156\n20\t21\t157\t157\n20\t21\t158\t158\n20\t21\t159\t159\n20\t21\t160\t160\n20\t21\t161\t161\n20\t21\t162\t162\n20\t21\t163\t163\n20\t21\t164\t164\n20\t21\t165\t165\n20\t21\t166\t166\n20\t21\t167\t167\n20\t21\t168\t168\n20\t21\t169\t169\n20\t21\t170\t170\n20\t21\t171\t171\n20\t21\t172\t172\n20\t21\t173\t173\n20\t21\t174\t174\n20\t21\t175\t175\n20\t21\t176\t176\n20\t21\t177\t177\n20\t21\t178\t178\n20\t21\t179\t179\n20\t21\t180\t180\n20\t21\t181\t181\n20\t21\t182\t182\n20\t21\t183\t183\n20\t21\t184\t184\n20\t21\t185\t185\n20\t21\t186\t186\n20\t21\t187\t187\n20\t21\t188\t188\n20\t21\t189\t189\n20\t21\t190\t190\n20\t21\t191\t191\n20\t21\t192\t192\n20\t21\t193\t193\n20\t21\t194\t194\n20\t21\t195\t195\n20\t21\t196\t196\n20\t21\t197\t197\n20\t21\t198\t198\n20\t21\t199\t199\n20\t21\t200\t200\n20\t21\t201\t201\n20\t21\t202\t202\n20\t21\t203\t203\n20\t21\t204\t204\n20\t21\t205\t205\n20\t21\t206\t206\n20\t21\t207\t207\n20\t21\t208\t208\n20\t21\t209\t209\n20\t21\t210\t210\n20\t21\t211\t211\n20\t21\t212\t212\n20\t21\t213\t213\n20\t21\t214\t214\n20\t21\t215\t215\n20\t21\t216\t216\n20\t21\t217\t217\n20\t21\t218\t218\n20\t21\t219\t219\n20\t21\t220\t220\n20\t21\t221\t221\n20\t21\t222\t222\n20\t21\t223\t223\n20\t21\t224\t224\n20\t21\t225\t225\n20\t21\t226\t226\n20\t21\t227\t227\n20\t21\t228\t228\n20\t21\t229\t229\n20\t21\t230\t230\n20\t21\t231\t231\n20\t21\t232\t232\n20\t21\t233\t233\n20\t21\t234\t234\n20\t21\t235\t235\n20\t21\t236\t236\n20\t21\t237\t237\n20\t21\t238\t238\n20\t21\t239\t239\n20\t21\t240\t240\n20\t21\t241\t241\n20\t21\t242\t242\n20\t21\t243\t243\n20\t21\t244\t244\n20\t21\t245\t245\n20\t21\t246\t246\n20\t21\t247\t247\n20\t21\t248\t248\n20\t21\t249\t249\n20\t21\t250\t250\n20\t21\t251\t251\n20\t21\t252\t252\n20\t21\t253\t253\n20\t21\t254\t254\n20\t21\t255\t255\n21\t22\t0\t0\n21\t22\t1\t1\n21\t22\t2\t2\n21\t22\t3\t3\n21\t22\t4\t4\n21\t22\t5\t5\n21\t22\t6\t6\n21\t22\t7\t7\n21\t22\t8\t8\n21\t22\t9\t9\n21\t22\t10\t10\n21\t22\t11\t11\n21\t22\t12\t12\n21\t22\t13\t13\n21\t22\t14\t14\n21\t22\t15\t15\n21\t22\t16\t16\n21\t22\t17\t17\n21\t22\t18\t18\n21\t22\t19\t19\n21\t22\t20\t20\n21\t22\t21\t21\n21\t22\t22\t22\n21\t22\t23\t23\n21\t22\t24\t24\n21\t22\t25\t25\n21\t22\t26\t26\n21\t22\t27\t27\n21\t22\t28\t28\n21\t22\t29\t29\n21\t22\t30\t30\n21\t22\t31\t31\n21\t22\t32\t32\n21\t22\t33\t33\n21\t22\t34\t34\n21\t22\t35\t35\n21\t22\t36\t36\n21\t22\t37\t37\n21\t22\t38\t38\n21\t22\t39\t39\n21\t22\t40\t40\n21\t22\t41\t41\n21\t22\t42\t42\n21\t22\t43\t43\n21\t22\t44\t44\n21\t22\t45\t45\n21\t22\t46\t46\n21\t22\t47\t47\n21\t22\t48\t48\n21\t22\t49\t49\n21\t22\t50\t50\n21\t22\t51\t51\n21\t22\t52\t52\n21\t22\t53\t53\n21\t22\t54\t54\n21\t22\t55\t55\n21\t22\t56\t56\n21\t22\t57\t57\n21\t22\t58\t58\n21\t22\t59\t59\n21\t22\t60\t60\n21\t22\t61\t61\n21\t22\t62\t62\n21\t22\t63\t63\n21\t22\t64\t64\n21\t22\t65\t65\n21\t22\t66\t66\n21\t22\t67\t67\n21\t22\t68\t68\n21\t22\t69\t69\n21\t22\t70\t70\n21\t22\t71\t71\n21\t22\t72\t72\n21\t22\t73\t73\n21\t22\t74\t74\n21\t22\t75\t75\n21\t22\t76\t76\n21\t22\t77\t77\n21\t22\t78\t78\n21\t22\t79\t79\n21\t22\t80\t80\n21\t22\t81\t81\n21\t22\t82\t82\n21\t22\t83\t83\n21\t22\t84\t84\n21\t22\t85\t85\n21\t22\t86\t86\n21\t22\t87\t87\n21\t22\t88\t88\n21\t22\t89\t89\n21\t22\t90\t90\n21\t22\t91\t91\n21\t22\t92\t92\n21\t22\t93\t93\n21\t22\t94\t94\n21\t22\t95\t95\n21\t22\t96\t96\n21\t22\t97\t97\n21\t22\t98\t98\n21\t22\t99\t99\n21\t22\t100\t100\n21\t22\t101\t101\n21\t22\t102\t102\n21\t22\t103\t103\n21\t22\t104\t104\n21\t22\t105\t105\n21\t22\t106\t106\n21\t22\t107\t107\n21\t22\t108\t108\n21\t22\t109\t109\n21\t22\t110\t110\n21\t22\t111\t111\n21\t22\t112\t112\n21\t22\t113\t113\n21\t22\t114\t114\n21\t22\t115\t115\n21\t22\t116\t116\n21\t22\t117\t117\n21\t22\t118\t118\n21\t22\t119\t119\n21\t22\t120\t120\n21\t22\t121\t121\n21\t22\t122\t122\n21\t22\t123\t123\n21\t22\t124\t124\n21\t22\t125\t125\n21\t22\t126\t126\n21\t22\t127\t127\n21\t22\t128\t128\n21\t22\t129\t129\n21\t22\t130\t130\n21\t22\t131\t131\n21\t22\t132\t132\n21\t22\t133\t133\n21\t22\t134\t134\n21\t22\t135\t135\n21\t22\t136\t136\n21\t22\t137\t137\n21\t22\t138\t138\n21\t22\t139\t139\n21\t22\t140\t140\n21\t22\t141\t141\n21\t22\t142\t142\n21\t22\t143\t143\n21\t22\t144\t144\n21\t22\t145\t145\n21\t22\t146\t146\n21\t22\t147\t147\n21\t22\t148\t148\n21\t22\t149\t149\n21\t22\t150\t150\n21\t22\t151\t151\n21\t22\t152\t152\n21\t22\t153\t153\n21\t22\t154\t154\n21\t22\t155\t155\n21\t22\t156\t156\n21\t22\t157\t157\n21\t22\t158\t158\n21\t22\t159\t159\n21\t22\t160\t160\n21\t22\t161\t161\n21\t22\t162\t162\n21\t22\t163\t163\n21\t22\t164\t164\n21\t22\t165\t165\n21\t22\t166\t166\n21\t22\t167\t167\n21\t22\t168\t168\n21\t22\t169\t169\n21\t22\t170\t170\n21\t22\t171\t171\n21\t22\t172\t172\n21\t22\t173\t173\n21\t22\t174\t174\n21\t22\t175\t175\n21\t22\t176\t176\n21\t22\t177\t177\n21\t22\t178\t178\n21\t22\t179\t179\n21\t22\t180\t180\n21\t22\t181\t181\n21\t22\t182\t182\n21\t22\t183\t183\n21\t22\t184\t184\n21\t22\t185\t185\n21\t22\t186\t186\n21\t22\t187\t187\n21\t22\t188\t188\n21\t22\t189\t189\n21\t22\t190\t190\n21\t22\t191\t191\n21\t22\t192\t192\n21\t22\t193\t193\n21\t22\t194\t194\n21\t22\t195\t195\n21\t22\t196\t196\n21\t22\t197\t197\n21\t22\t198\t198\n21\t22\t199\t199\n21\t22\t200\t200\n21\t22\t201\t201\n21\t22\t202\t202\n21\t22\t203\t203\n21\t22\t204\t204\n21\t22\t205\t205\n21\t22\t206\t206\n21\t22\t207\t207\n21\t22\t208\t208\n21\t22\t209\t209\n21\t22\t210\t210\n21\t22\t211\t211\n21\t22\t212\t212\n21\t22\t213\t213\n21\t22\t214\t214\n21\t22\t215\t215\n21\t22\t216\t216\n21\t22\t217\t217\n21\t22\t218\t218\n21\t22\t219\t219\n21\t22\t220\t220\n21\t22\t221\t221\n21\t22\t222\t222\n21\t22\t223\t223\n21\t22\t224\t224\n21\t22\t225\t225\n21\t22\t226\t226\n21\t22\t227\t227\n21\t22\t228\t228\n21\t22\t229\t229\n21\t22\t230\t230\n21\t22\t231\t231\n21\t22\t232\t232\n21\t22\t233\t233\n21\t22\t234\t234\n21\t22\t235\t235\n21\t22\t236\t236\n21\t22\t237\t237\n21\t22\t238\t238\n21\t22\t239\t239\n21\t22\t240\t240\n21\t22\t241\t241\n21\t22\t242\t242\n21\t22\t243\t243\n21\t22\t244\t244\n21\t22\t245\t245\n21\t22\t246\t246\n21\t22\t247\t247\n21\t22\t248\t248\n21\t22\t249\t249\n21\t22\t250\t250\n21\t22\t251\t251\n21\t22\t252\t252\n21\t22\t253\t253\n21\t22\t254\t254\n21\t22\t255\t255\n22\t23\t0\t0\n22\t23\t1\t1\n22\t23\t2\t2\n22\t23\t3\t3\n22\t23\t4\t4\n22\t23\t5\t5\n22\t23\t6\t6\n22\t23\t7\t7\n22\t23\t8\t8\n22\t23\t9\t9\n22\t23\t10\t10\n22\t23\t11\t11\n22\t23\t12\t12\n22\t23\t13\t13\n22\t23\t14\t14\n22\t23\t15\t15\n22\t23\t16\t16\n22\t23\t17\t17\n22\t23\t18\t18\n22\t23\t19\t19\n22\t23\t20\t20\n22\t23\t21\t21\n22\t23\t22\t22\n22\t23\t23\t23\n22\t23\t24\t24\n22\t23\t25\t25\n22\t23\t26\t26\n22\t23\t27\t27\n22\t23\t28\t28\n22\t23\t29\t29\n22\t23\t30\t30\n22\t23\t31\t31\n22\t23\t32\t32\n22\t23\t33\t33\n22\t23\t34\t34\n22\t23\t35\t35\n22\t23\t36\t36\n22\t23\t37\t37\n22\t23\t38\t38\n22\t23\t39\t39\n22\t23\t40\t40\n22\t23\t41\t41\n22\t23\t42\t42\n22\t23\t43\t43\n22\t23\t44\t44\n22\t23\t45\t45\n22\t23\t46\t46\n22\t23\t47\t47\n22\t23\t48\t48\n22\t23\t49\t49\n22\t23\t50\t50\n22\t23\t51\t51\n22\t23\t52\t52\n22\t23\t53\t53\n22\t23\t54\t54\n22\t23\t55\t55\n22\t23\t56\t56\n22\t23\t57\t57\n22\t23\t58\t58\n22\t23\t59\t59\n22\t23\t60\t60\n22\t23\t61\t61\n22\t23\t62\t62\n22\t23\t63\t63\n22\t23\t64\t64\n22\t23\t65\t65\n22\t23\t66\t66\n22\t23\t67\t67\n22\t23\t68\t68\n22\t23\t69\t69\n22\t23\t70\t70\n22\t23\t71\t71\n22\t23\t72\t72\n22\t23\t73\t73\n22\t23\t74\t74\n22\t23\t75\t75\n22\t23\t76\t76\n22\t23\t77\t77\n22\t23\t78\t78\n22\t23\t79\t79\n22\t23\t80\t80\n22\t23\t81\t81\n22\t23\t82\t82\n22\t23\t83\t83\n22\t23\t84\t84\n22\t23\t85\t85\n22\t23\t86\t86\n22\t23\t87\t87\n22\t23\t88\t88\n22\t23\t89\t89\n22\t23\t90\t90\n22\t23\t91\t91\n22\t23\t92\t92\n22\t23\t93\t93\n22\t23\t94\t94\n22\t23\t95\t95\n22\t23\t96\t96\n22\t23\t97\t97\n22\t23\t98\t98\n22\t23\t99\t99\n22\t23\t100\t100\n22\t23\t101\t101\n22\t23\t102\t102\n22\t23\t103\t103\n22\t23\t104\t104\n22\t23\t105\t105\n22\t23\t106\t106\n22\t23\t107\t107\n22\t23\t108\t108\n22\t23\t109\t109\n22\t23\t110\t110\n22\t23\t111\t111\n22\t23\t112\t112\n22\t23\t113\t113\n22\t23\t114\t114\n22\t23\t115\t115\n22\t23\t116\t116\n22\t23\t117\t117\n22\t23\t118\t118\n22\t23\t119\t119\n22\t23\t120\t120\n22\t23\t121\t121\n22\t23\t122\t122\n22\t23\t123\t123\n22\t23\t124\t124\n22\t23\t125\t125\n22\t23\t126\t126\n22\t23\t127\t127\n22\t23\t128\t128\n22\t23\t129\t129\n22\t23\t130\t130\n22\t23\t131\t131\n22\t23\t132\t132\n22\t23\t133\t133\n22\t23\t134\t134\n22\t23\t135\t135\n22\t23\t136\t136\n22\t23\t137\t137\n22\t23\t138\t138\n22\t23\t139\t139\n22\t23\t140\t140\n22\t23\t141\t141\n22\t23\t142\t142\n22\t23\t143\t143\n22\t23\t144\t144\n22\t23\t145\t145\n22\t23\t146\t146\n22\t23\t147\t147\n22\t23\t148\t148\n22\t23\t149\t149\n22\t23\t150\t150\n22\t23\t151\t151\n22\t23\t152\t152\n22\t23\t153\t153\n22\t23\t154\t154\n22\t23\t155\t155\n22\t23\t156\t156\n22\t23\t157\t157\n22\t23\t158\t158\n22\t23\t159\t159\n22\t23\t160\t160\n22\t23\t161\t161\n22\t23\t162\t162\n22\t23\t163\t163\n22\t23\t164\t164\n22\t23\t165\t165\n22\t23\t166\t166\n22\t23\t167\t167\n22\t23\t168\t168\n22\t23\t169\t169\n22\t23\t170\t170\n22\t23\t171\t171\n22\t23\t172\t172\n22\t23\t173\t173\n22\t23\t174\t174\n22\t23\t175\t175\n22\t23\t176\t176\n22\t23\t177\t177\n22\t23\t178\t178\n22\t23\t179\t179\n22\t23\t180\t180\n22\t23\t181\t181\n22\t23\t182\t182\n22\t23\t183\t183\n22\t23\t184\t184\n22\t23\t185\t185\n22\t23\t186\t186\n22\t23\t187\t187\n22\t23\t188\t188\n22\t23\t189\t189\n22\t23\t190\t190\n22\t23\t191\t191\n22\t23\t192\t192\n22\t23\t193\t193\n22\t23\t194\t194\n22\t23\t195\t195\n22\t23\t196\t196\n22\t23\t197\t197\n22\t23\t198\t198\n22\t23\t199\t199\n22\t23\t200\t200\n22\t23\t201\t201\n22\t23\t202\t202\n22\t23\t203\t203\n22\t23\t204\t204\n22\t23\t205\t205\n22\t23\t206\t206\n22\t23\t207\t207\n22\t23\t208\t208\n22\t23\t209\t209\n22\t23\t210\t210\n22\t23\t211\t211\n22\t23\t212\t212\n22\t23\t213\t213\n22\t23\t214\t214\n22\t23\t215\t215\n22\t23\t216\t216\n22\t23\t217\t217\n22\t23\t218\t218\n22\t23\t219\t219\n22\t23\t220\t220\n22\t23\t221\t221\n22\t23\t222\t222\n22\t23\t223\t223\n22\t23\t224\t224\n22\t23\t225\t225\n22\t23\t226\t226\n22\t23\t227\t227\n22\t23\t228\t228\n22\t23\t229\t229\n22\t23\t230\t230\n22\t23\t231\t231\n22\t23\t232\t232\n22\t23\t233\t233\n22\t23\t234\t234\n22\t23\t235\t235\n22\t23\t236\t236\n22\t23\t237\t237\n22\t23\t238\t238\n22\t23\t239\t239\n22\t23\t240\t240\n22\t23\t241\t241\n22\t23\t242\t242\n22\t23\t243\t243\n22\t23\t244\t244\n22\t23\t245\t245\n22\t23\t246\t246\n22\t23\t247\t247\n22\t23\t248\t248\n22\t23\t249\t249\n22\t23\t250\t250\n22\t23\t251\t251\n22\t23\t252\t252\n22\t23\t253\t253\n22\t23\t254\t254\n22\t23\t255\t255\n23\t24\t0\t0\n23\t24\t1\t1\n23\t24\t2\t2\n23\t24\t3\t3\n23\t24\t4\t4\n23\t24\t5\t5\n23\t24\t6\t6\n23\t24\t7\t7\n23\t24\t8\t8\n23\t24\t9\t9\n23\t24\t10\t10\n23\t24\t11\t11\n23\t24\t12\t12\n23\t24\t13\t13\n23\t24\t14\t14\n23\t24\t15\t15\n23\t24\t16\t16\n23\t24\t17\t17\n23\t24\t18\t18\n23\t24\t19\t19\n23\t24\t20\t20\n23\t24\t21\t21\n23\t24\t22\t22\n23\t24\t23\t23\n23\t24\t24\t24\n23\t24\t25\t25\n23\t24\t26\t26\n23\t24\t27\t27\n23\t24\t28\t28\n23\t24\t29\t29\n23\t24\t30\t30\n23\t24\t31\t31\n23\t24\t32\t32\n23\t24\t33\t33\n23\t24\t34\t34\n23\t24\t35\t35\n23\t24\t36\t36\n23\t24\t37\t37\n23\t24\t38\t38\n23\t24\t39\t39\n23\t24\t40\t40\n23\t24\t41\t41\n23\t24\t42\t42\n23\t24\t43\t43\n23\t24\t44\t44\n23\t24\t45\t45\n23\t24\t46\t46\n23\t24\t47\t47\n23\t24\t48\t48\n23\t24\t49\t49\n23\t24\t50\t50\n23\t24\t51\t51\n23\t24\t52\t52\n23\t24\t53\t53\n23\t24\t54\t54\n23\t24\t55\t55\n23\t24\t56\t56\n23\t24\t57\t57\n23\t24\t58\t58\n23\t24\t59\t59\n23\t24\t60\t60\n23\t24\t61\t61\n23\t24\t62\t62\n23\t24\t63\t63\n23\t24\t64\t64\n23\t24\t65\t65\n23\t24\t66\t66\n23\t24\t67\t67\n23\t24\t68\t68\n23\t24\t69\t69\n23\t24\t70\t70\n23\t24\t71\t71\n23\t24\t72\t72\n23\t24\t73\t73\n23\t24\t74\t74\n23\t24\t75\t75\n23\t24\t76\t76\n23\t24\t77\t77\n23\t24\t78\t78\n23\t24\t79\t79\n23\t24\t80\t80\n23\t24\t81\t81\n23\t24\t82\t82\n23\t24\t83\t83\n23\t24\t84\t84\n23\t24\t85\t85\n23\t24\t86\t86\n23\t24\t87\t87\n23\t24\t88\t88\n23\t24\t89\t89\n23\t24\t90\t90\n23\t24\t91\t91\n23\t24\t92\t92\n23\t24\t93\t93\n23\t24\t94\t94\n23\t24\t95\t95\n23\t24\t96\t96\n23\t24\t97\t97\n23\t24\t98\t98\n23\t24\t99\t99\n23\t24\t100\t100\n23\t24\t101\t101\n23\t24\t102\t102\n23\t24\t103\t103\n23\t24\t104\t104\n23\t24\t105\t105\n23\t24\t106\t106\n23\t24\t107\t107\n23\t24\t108\t108\n23\t24\t109\t109\n23\t24\t110\t110\n23\t24\t111\t111\n23\t24\t112\t112\n23\t24\t113\t113\n23\t24\t114\t114\n23\t24\t115\t115\n23\t24\t116\t116\n23\t24\t117\t117\n23\t24\t118\t118\n23\t24\t119\t119\n23\t24\t120\t120\n23\t24\t121\t121\n23\t24\t122\t122\n23\t24\t123\t123\n23\t24\t124\t124\n23\t24\t125\t125\n23\t24\t126\t126\n23\t24\t127\t127\n23\t24\t128\t128\n23\t24\t129\t129\n23\t24\t130\t130\n23\t24\t131\t131\n23\t24\t132\t132\n23\t24\t133\t133\n23\t24\t134\t134\n23\t24\t135\t135\n23\t24\t136\t136\n23\t24\t137\t137\n23\t24\t138\t138\n23\t24\t139\t139\n23\t24\t140\t140\n23\t24\t141\t141\n23\t24\t142\t142\n23\t24\t143\t143\n23\t24\t144\t144\n23\t24\t145\t145\n23\t24\t146\t146\n23\t24\t147\t147\n23\t24\t148\t148\n23\t24\t149\t149\n23\t24\t150\t150\n23\t24\t151\t151\n23\t24\t152\t152\n23\t24\t153\t153\n23\t24\t154\t154\n23\t24\t155\t155\n23\t24\t156\t156\n23\t24\t157\t157\n23\t24\t158\t158\n23\t24\t159\t159\n23\t24\t160\t160\n23\t24\t161\t161\n23\t24\t162\t162\n23\t24\t163\t163\n23\t24\t164\t164\n23\t24\t165\t165\n23\t24\t166\t166\n23\t24\t167\t167\n23\t24\t168\t168\n23\t24\t169\t169\n23\t24\t170\t170\n23\t24\t171\t171\n23\t24\t172\t172\n23\t24\t173\t173\n23\t24\t174\t174\n23\t24\t175\t175\n23\t24\t176\t176\n23\t24\t177\t177\n23\t24\t178\t178\n23\t24\t179\t179\n23\t24\t180\t180\n23\t24\t181\t181\n23\t24\t182\t182\n23\t24\t183\t183\n23\t24\t184\t184\n23\t24\t185\t185\n23\t24\t186\t186\n23\t24\t187\t187\n23\t24\t188\t188\n23\t24\t189\t189\n23\t24\t190\t190\n23\t24\t191\t191\n23\t24\t192\t192\n23\t24\t193\t193\n23\t24\t194\t194\n23\t24\t195\t195\n23\t24\t196\t196\n23\t24\t197\t197\n23\t24\t198\t198\n23\t24\t199\t199\n23\t24\t200\t200\n23\t24\t201\t201\n23\t24\t202\t202\n23\t24\t203\t203\n23\t24\t204\t204\n23\t24\t205\t205\n23\t24\t206\t206\n23\t24\t207\t207\n23\t24\t208\t208\n23\t24\t209\t209\n23\t24\t210\t210\n23\t24\t211\t211\n23\t24\t212\t212\n23\t24\t213\t213\n23\t24\t214\t214\n23\t24\t215\t215\n23\t24\t216\t216\n23\t24\t217\t217\n23\t24\t218\t218\n23\t24\t219\t219\n23\t24\t220\t220\n23\t24\t221\t221\n23\t24\t222\t222\n23\t24\t223\t223\n23\t24\t224\t224\n23\t24\t225\t225\n23\t24\t226\t226\n23\t24\t227\t227\n23\t24\t228\t228\n23\t24\t229\t229\n23\t24\t230\t230\n23\t24\t231\t231\n23\t24\t232\t232\n23\t24\t233\t233\n23\t24\t234\t234\n23\t24\t235\t235\n23\t24\t236\t236\n23\t24\t237\t237\n23\t24\t238\t238\n23\t24\t239\t239\n23\t24\t240\t240\n23\t24\t241\t241\n23\t24\t242\t242\n23\t24\t243\t243\n23\t24\t244\t244\n23\t24\t245\t245\n23\t24\t246\t246\n23\t24\t247\t247\n23\t24\t248\t248\n23\t24\t249\t249\n23\t24\t250\t250\n23\t24\t251\t251\n23\t24\t252\t252\n23\t24\t253\t253\n23\t24\t254\t254\n23\t24\t255\t255\n24\t25\t0\t0\n24\t25\t1\t1\n24\t25\t2\t2\n24\t25\t3\t3\n24\t25\t4\t4\n24\t25\t5\t5\n24\t25\t6\t6\n24\t25\t7\t7\n24\t25\t8\t8\n24\t25\t9\t9\n24\t25\t10\t10\n24\t25\t11\t11\n24\t25\t12\t12\n24\t25\t13\t13\n24\t25\t14\t14\n24\t25\t15\t15\n24\t25\t16\t16\n24\t25\t17\t17\n24\t25\t18\t18\n24\t25\t19\t19\n24\t25\t20\t20\n24\t25\t21\t21\n24\t25\t22\t22\n24\t25\t23\t23\n24\t25\t24\t24\n24\t25\t25\t25\n24\t25\t26\t26\n24\t25\t27\t27\n24\t25\t28\t28\n24\t25\t29\t29\n24\t25\t30\t30\n24\t25\t31\t31\n24\t25\t32\t32\n24\t25\t33\t33\n24\t25\t34\t34\n24\t25\t35\t35\n24\t25\t36\t36\n24\t25\t37\t37\n24\t25\t38\t38\n24\t25\t39\t39\n24\t25\t40\t40\n24\t25\t41\t41\n24\t25\t42\t42\n24\t25\t43\t43\n24\t25\t44\t44\n24\t25\t45\t45\n24\t25\t46\t46\n24\t25\t47\t47\n24\t25\t48\t48\n24\t25\t49\t49\n24\t25\t50\t50\n24\t25\t51\t51\n24\t25\t52\t52\n24\t25\t53\t53\n24\t25\t54\t54\n24\t25\t55\t55\n24\t25\t56\t56\n24\t25\t57\t57\n24\t25\t58\t58\n24\t25\t59\t59\n24\t25\t60\t60\n24\t25\t61\t61\n24\t25\t62\t62\n24\t25\t63\t63\n24\t25\t64\t64\n24\t25\t65\t65\n24\t25\t66\t66\n24\t25\t67\t67\n24\t25\t68\t68\n24\t25\t69\t69\n24\t25\t70\t70\n24\t25\t71\t71\n24\t25\t72\t72\n24\t25\t73\t73\n24\t25\t74\t74\n24\t25\t75\t75\n24\t25\t76\t76\n24\t25\t77\t77\n24\t25\t78\t78\n24\t25\t79\t79\n24\t25\t80\t80\n24\t25\t81\t81\n24\t25\t82\t82\n24\t25\t83\t83\n24\t25\t84\t84\n24\t25\t85\t85\n24\t25\t86\t86\n24\t25\t87\t87\n24\t25\t88\t88\n24\t25\t89\t89\n24\t25\t90\t90\n24\t25\t91\t91\n24\t25\t92\t92\n24\t25\t93\t93\n24\t25\t94\t94\n24\t25\t95\t95\n24\t25\t96\t96\n24\t25\t97\t97\n24\t25\t98\t98\n24\t25\t99\t99\n24\t25\t100\t100\n24\t25\t101\t101\n24\t25\t102\t102\n24\t25\t103\t103\n24\t25\t104\t104\n24\t25\t105\t105\n24\t25\t106\t106\n24\t25\t107\t107\n24\t25\t108\t108\n24\t25\t109\t109\n24\t25\t110\t110\n24\t25\t111\t111\n24\t25\t112\t112\n24\t25\t113\t113\n24\t25\t114\t114\n24\t25\t115\t115\n24\t25\t116\t116\n24\t25\t117\t117\n24\t25\t118\t118\n24\t25\t119\t119\n24\t25\t120\t120\n24\t25\t121\t121\n24\t25\t122\t122\n24\t25\t123\t123\n24\t25\t124\t124\n24\t25\t125\t125\n24\t25\t126\t126\n24\t25\t127\t127\n24\t25\t128\t128\n24\t25\t129\t129\n24\t25\t130\t130\n24\t25\t131\t131\n24\t25\t132\t132\n24\t25\t133\t133\n24\t25\t134\t134\n24\t25\t135\t135\n24\t25\t136\t136\n24\t25\t137\t137\n24\t25\t138\t138\n24\t25\t139\t139\n24\t25\t140\t140\n24\t25\t141\t141\n24\t25\t142\t142\n24\t25\t143\t143\n24\t25\t144\t144\n24\t25\t145\t145\n24\t25\t146\t146\n24\t25\t147\t147\n24\t25\t148\t148\n24\t25\t149\t149\n24\t25\t150\t150\n24\t25\t151\t151\n24\t25\t152\t152\n24\t25\t153\t153\n24\t25\t154\t154\n24\t25\t155\t155\n24\t25\t156\t156\n24\t25\t157\t157\n24\t25\t158\t158\n24\t25\t159\t159\n24\t25\t160\t160\n24\t25\t161\t161\n24\t25\t162\t162\n24\t25\t163\t163\n24\t25\t164\t164\n24\t25\t165\t165\n24\t25\t166\t166\n24\t25\t167\t167\n24\t25\t168\t168\n24\t25\t169\t169\n24\t25\t170\t170\n24\t25\t171\t171\n24\t25\t172\t172\n24\t25\t173\t173\n24\t25\t174\t174\n24\t25\t175\t175\n24\t25\t176\t176\n24\t25\t177\t177\n24\t25\t178\t178\n24\t25\t179\t179\n24\t25\t180\t180\n24\t25\t181\t181\n24\t25\t182\t182\n24\t25\t183\t183\n24\t25\t184\t184\n24\t25\t185\t185\n24\t25\t186\t186\n24\t25\t187\t187\n24\t25\t188\t188\n24\t25\t189\t189\n24\t25\t190\t190\n24\t25\t191\t191\n24\t25\t192\t192\n24\t25\t193\t193\n24\t25\t194\t194\n24\t25\t195\t195\n24\t25\t196\t196\n24\t25\t197\t197\n24\t25\t198\t198\n24\t25\t199\t199\n24\t25\t200\t200\n24\t25\t201\t201\n24\t25\t202\t202\n24\t25\t203\t203\n24\t25\t204\t204\n24\t25\t205\t205\n24\t25\t206\t206\n24\t25\t207\t207\n24\t25\t208\t208\n24\t25\t209\t209\n24\t25\t210\t210\n24\t25\t211\t211\n24\t25\t212\t212\n24\t25\t213\t213\n24\t25\t214\t214\n24\t25\t215\t215\n24\t25\t216\t216\n24\t25\t217\t217\n24\t25\t218\t218\n24\t25\t219\t219\n24\t25\t220\t220\n24\t25\t221\t221\n24\t25\t222\t222\n24\t25\t223\t223\n24\t25\t224\t224\n24\t25\t225\t225\n24\t25\t226\t226\n24\t25\t227\t227\n24\t25\t228\t228\n24\t25\t229\t229\n24\t25\t230\t230\n24\t25\t231\t231\n24\t25\t232\t232\n24\t25\t233\t233\n24\t25\t234\t234\n24\t25\t235\t235\n24\t25\t236\t236\n24\t25\t237\t237\n24\t25\t238\t238\n24\t25\t239\t239\n24\t25\t240\t240\n24\t25\t241\t241\n24\t25\t242\t242\n24\t25\t243\t243\n24\t25\t244\t244\n24\t25\t245\t245\n24\t25\t246\t246\n24\t25\t247\t247\n24\t25\t248\t248\n24\t25\t249\t249\n24\t25\t250\t250\n24\t25\t251\t251\n24\t25\t252\t252\n24\t25\t253\t253\n24\t25\t254\t254\n24\t25\t255\t255\n25\t26\t0\t0\n25\t26\t1\t1\n25\t26\t2\t2\n25\t26\t3\t3\n25\t26\t4\t4\n25\t26\t5\t5\n25\t26\t6\t6\n25\t26\t7\t7\n25\t26\t8\t8\n25\t26\t9\t9\n25\t26\t10\t10\n25\t26\t11\t11\n25\t26\t12\t12\n25\t26\t13\t13\n25\t26\t14\t14\n25\t26\t15\t15\n25\t26\t16\t16\n25\t26\t17\t17\n25\t26\t18\t18\n25\t26\t19\t19\n25\t26\t20\t20\n25\t26\t21\t21\n25\t26\t22\t22\n25\t26\t23\t23\n25\t26\t24\t24\n25\t26\t25\t25\n25\t26\t26\t26\n25\t26\t27\t27\n25\t26\t28\t28\n25\t26\t29\t29\n25\t26\t30\t30\n25\t26\t31\t31\n25\t26\t32\t32\n25\t26\t33\t33\n25\t26\t34\t34\n25\t26\t35\t35\n25\t26\t36\t36\n25\t26\t37\t37\n25\t26\t38\t38\n25\t26\t39\t39\n25\t26\t40\t40\n25\t26\t41\t41\n25\t26\t42\t42\n25\t26\t43\t43\n25\t26\t44\t44\n25\t26\t45\t45\n25\t26\t46\t46\n25\t26\t47\t47\n25\t26\t48\t48\n25\t26\t49\t49\n25\t26\t50\t50\n25\t26\t51\t51\n25\t26\t52\t52\n25\t26\t53\t53\n25\t26\t54\t54\n25\t26\t55\t55\n25\t26\t56\t56\n25\t26\t57\t57\n25\t26\t58\t58\n25\t26\t59\t59\n25\t26\t60\t60\n25\t26\t61\t61\n25\t26\t62\t62\n25\t26\t63\t63\n25\t26\t64\t64\n25\t26\t65\t65\n25\t26\t66\t66\n25\t26\t67\t67\n25\t26\t68\t68\n25\t26\t69\t69\n25\t26\t70\t70\n25\t26\t71\t71\n25\t26\t72\t72\n25\t26\t73\t73\n25\t26\t74\t74\n25\t26\t75\t75\n25\t26\t76\t76\n25\t26\t77\t77\n25\t26\t78\t78\n25\t26\t79\t79\n25\t26\t80\t80\n25\t26\t81\t81\n25\t26\t82\t82\n25\t26\t83\t83\n25\t26\t84\t84\n25\t26\t85\t85\n25\t26\t86\t86\n25\t26\t87\t87\n25\t26\t88\t88\n25\t26\t89\t89\n25\t26\t90\t90\n25\t26\t91\t91\n25\t26\t92\t92\n25\t26\t93\t93\n25\t26\t94\t94\n25\t26\t95\t95\n25\t26\t96\t96\n25\t26\t97\t97\n25\t26\t98\t98\n25\t26\t99\t99\n25\t26\t100\t100\n25\t26\t101\t101\n25\t26\t102\t102\n25\t26\t103\t103\n25\t26\t104\t104\n25\t26\t105\t105\n25\t26\t106\t106\n25\t26\t107\t107\n25\t26\t108\t108\n25\t26\t109\t109\n25\t26\t110\t110\n25\t26\t111\t111\n25\t26\t112\t112\n25\t26\t113\t113\n25\t26\t114\t114\n25\t26\t115\t115\n25\t26\t116\t116\n25\t26\t117\t117\n25\t26\t118\t118\n25\t26\t119\t119\n25\t26\t120\t120\n25\t26\t121\t121\n25\t26\t122\t122\n25\t26\t123\t123\n25\t26\t124\t124\n25\t26\t125\t125\n25\t26\t126\t126\n25\t26\t127\t127\n25\t26\t128\t128\n25\t26\t129\t129\n25\t26\t130\t130\n25\t26\t131\t131\n25\t26\t132\t132\n25\t26\t133\t133\n25\t26\t134\t134\n25\t26\t135\t135\n25\t26\t136\t136\n25\t26\t137\t137\n25\t26\t138\t138\n25\t26\t139\t139\n25\t26\t140\t140\n25\t26\t141\t141\n25\t26\t142\t142\n25\t26\t143\t143\n25\t26\t144\t144\n25\t26\t145\t145\n25\t26\t146\t146\n25\t26\t147\t147\n25\t26\t148\t148\n25\t26\t149\t149\n25\t26\t150\t150\n25\t26\t151\t151\n25\t26\t152\t152\n25\t26\t153\t153\n25\t26\t154\t154\n25\t26\t155\t155\n25\t26\t156\t156\n25\t26\t157\t157\n25\t26\t158\t158\n25\t26\t159\t159\n25\t26\t160\t160\n25\t26\t161\t161\n25\t26\t162\t162\n25\t26\t163\t163\n25\t26\t164\t164\n25\t26\t165\t165\n25\t26\t166\t166\n25\t26\t167\t167\n25\t26\t168\t168\n25\t26\t169\t169\n25\t26\t170\t170\n25\t26\t171\t171\n25\t26\t172\t172\n25\t26\t173\t173\n25\t26\t174\t174\n25\t26\t175\t175\n25\t26\t176\t176\n25\t26\t177\t177\n25\t26\t178\t178\n25\t26\t179\t179\n25\t26\t180\t180\n25\t26\t181\t181\n25\t26\t182\t182\n25\t26\t183\t183\n25\t26\t184\t184\n25\t26\t185\t185\n25\t26\t186\t186\n25\t26\t187\t187\n25\t26\t188\t188\n25\t26\t189\t189\n25\t26\t190\t190\n25\t26\t191\t191\n25\t26\t192\t192\n25\t26\t193\t193\n25\t26\t194\t194\n25\t26\t195\t195\n25\t26\t196\t196\n25\t26\t197\t197\n25\t26\t198\t198\n25\t26\t199\t199\n25\t26\t200\t200\n25\t26\t201\t201\n25\t26\t202\t202\n25\t26\t203\t203\n25\t26\t204\t204\n25\t26\t205\t205\n25\t26\t206\t206\n25\t26\t207\t207\n25\t26\t208\t208\n25\t26\t209\t209\n25\t26\t210\t210\n25\t26\t211\t211\n25\t26\t212\t212\n25\t26\t213\t213\n25\t26\t214\t214\n25\t26\t215\t215\n25\t26\t216\t216\n25\t26\t217\t217\n25\t26\t218\t218\n25\t26\t219\t219\n25\t26\t220\t220\n25\t26\t221\t221\n25\t26\t222\t222\n25\t26\t223\t223\n25\t26\t224\t224\n25\t26\t225\t225\n25\t26\t226\t226\n25\t26\t227\t227\n25\t26\t228\t228\n25\t26\t229\t229\n25\t26\t230\t230\n25\t26\t231\t231\n25\t26\t232\t232\n25\t26\t233\t233\n25\t26\t234\t234\n25\t26\t235\t235\n25\t26\t236\t236\n25\t26\t237\t237\n25\t26\t238\t238\n25\t26\t239\t239\n25\t26\t240\t240\n25\t26\t241\t241\n25\t26\t242\t242\n25\t26\t243\t243\n25\t26\t244\t244\n25\t26\t245\t245\n25\t26\t246\t246\n25\t26\t247\t247\n25\t26\t248\t248\n25\t26\t249\t249\n25\t26\t250\t250\n25\t26\t251\t251\n25\t26\t252\t252\n25\t26\t253\t253\n25\t26\t254\t254\n25\t26\t255\t255\n26\t27\t0\t0\n26\t27\t1\t1\n26\t27\t2\t2\n26\t27\t3\t3\n26\t27\t4\t4\n26\t27\t5\t5\n26\t27\t6\t6\n26\t27\t7\t7\n26\t27\t8\t8\n26\t27\t9\t9\n26\t27\t10\t10\n26\t27\t11\t11\n26\t27\t12\t12\n26\t27\t13\t13\n26\t27\t14\t14\n26\t27\t15\t15\n26\t27\t16\t16\n26\t27\t17\t17\n26\t27\t18\t18\n26\t27\t19\t19\n26\t27\t20\t20\n26\t27\t21\t21\n26\t27\t22\t22\n26\t27\t23\t23\n26\t27\t24\t24\n26\t27\t25\t25\n26\t27\t26\t26\n26\t27\t27\t27\n26\t27\t28\t28\n26\t27\t29\t29\n26\t27\t30\t30\n26\t27\t31\t31\n26\t27\t32\t32\n26\t27\t33\t33\n26\t27\t34\t34\n26\t27\t35\t35\n26\t27\t36\t36\n26\t27\t37\t37\n26\t27\t38\t38\n26\t27\t39\t39\n26\t27\t40\t40\n26\t27\t41\t41\n26\t27\t42\t42\n26\t27\t43\t43\n26\t27\t44\t44\n26\t27\t45\t45\n26\t27\t46\t46\n26\t27\t47\t47\n26\t27\t48\t48\n26\t27\t49\t49\n26\t27\t50\t50\n26\t27\t51\t51\n26\t27\t52\t52\n26\t27\t53\t53\n26\t27\t54\t54\n26\t27\t55\t55\n26\t27\t56\t56\n26\t27\t57\t57\n26\t27\t58\t58\n26\t27\t59\t59\n26\t27\t60\t60\n26\t27\t61\t61\n26\t27\t62\t62\n26\t27\t63\t63\n26\t27\t64\t64\n26\t27\t65\t65\n26\t27\t66\t66\n26\t27\t67\t67\n26\t27\t68\t68\n26\t27\t69\t69\n26\t27\t70\t70\n26\t27\t71\t71\n26\t27\t72\t72\n26\t27\t73\t73\n26\t27\t74\t74\n26\t27\t75\t75\n26\t27\t76\t76\n26\t27\t77\t77\n26\t27\t78\t78\n26\t27\t79\t79\n26\t27\t80\t80\n26\t27\t81\t81\n26\t27\t82\t82\n26\t27\t83\t83\n26\t27\t84\t84\n26\t27\t85\t85\n26\t27\t86\t86\n26\t27\t87\t87\n26\t27\t88\t88\n26\t27\t89\t89\n26\t27\t90\t90\n26\t27\t91\t91\n26\t27\t92\t92\n26\t27\t93\t93\n26\t27\t94\t94\n26\t27\t95\t95\n26\t27\t96\t96\n26\t27\t97\t97\n26\t27\t98\t98\n26\t27\t99\t99\n26\t27\t100\t100\n26\t27\t101\t101\n26\t27\t102\t102\n26\t27\t103\t103\n26\t27\t104\t104\n26\t27\t105\t105\n26\t27\t106\t106\n26\t27\t107\t107\n26\t27\t108\t108\n26\t27\t109\t109\n26\t27\t110\t110\n26\t27\t111\t111\n26\t27\t112\t112\n26\t27\t113\t113\n26\t27\t114\t114\n26\t27\t115\t115\n26\t27\t116\t116\n26\t27\t117\t117\n26\t27\t118\t118\n26\t27\t119\t119\n26\t27\t120\t120\n26\t27\t121\t121\n26\t27\t122\t122\n26\t27\t123\t123\n26\t27\t124\t124\n26\t27\t125\t125\n26\t27\t126\t126\n26\t27\t127\t127\n26\t27\t128\t128\n26\t27\t129\t129\n26\t27\t130\t130\n26\t27\t131\t131\n26\t27\t132\t132\n26\t27\t133\t133\n26\t27\t134\t134\n26\t27\t135\t135\n26\t27\t136\t136\n26\t27\t137\t137\n26\t27\t138\t138\n26\t27\t139\t139\n26\t27\t140\t140\n26\t27\t141\t141\n26\t27\t142\t142\n26\t27\t143\t143\n26\t27\t144\t144\n26\t27\t145\t145\n26\t27\t146\t146\n26\t27\t147\t147\n26\t27\t148\t148\n26\t27\t149\t149\n26\t27\t150\t150\n26\t27\t151\t151\n26\t27\t152\t152\n26\t27\t153\t153\n26\t27\t154\t154\n26\t27\t155\t155\n26\t27\t156\t156\n26\t27\t157\t157\n26\t27\t158\t158\n26\t27\t159\t159\n26\t27\t160\t160\n26\t27\t161\t161\n26\t27\t162\t162\n26\t27\t163\t163\n26\t27\t164\t164\n26\t27\t165\t165\n26\t27\t166\t166\n26\t27\t167\t167\n26\t27\t168\t168\n26\t27\t169\t169\n26\t27\t170\t170\n26\t27\t171\t171\n26\t27\t172\t172\n26\t27\t173\t173\n26\t27\t174\t174\n26\t27\t175\t175\n26\t27\t176\t176\n26\t27\t177\t177\n26\t27\t178\t178\n26\t27\t179\t179\n26\t27\t180\t180\n26\t27\t181\t181\n26\t27\t182\t182\n26\t27\t183\t183\n26\t27\t184\t184\n26\t27\t185\t185\n26\t27\t186\t186\n26\t27\t187\t187\n26\t27\t188\t188\n26\t27\t189\t189\n26\t27\t190\t190\n26\t27\t191\t191\n26\t27\t192\t192\n26\t27\t193\t193\n26\t27\t194\t194\n26\t27\t195\t195\n26\t27\t196\t196\n26\t27\t197\t197\n26\t27\t198\t198\n26\t27\t199\t199\n26\t27\t200\t200\n26\t27\t201\t201\n26\t27\t202\t202\n26\t27\t203\t203\n26\t27\t204\t204\n26\t27\t205\t205\n26\t27\t206\t206\n26\t27\t207\t207\n26\t27\t208\t208\n26\t27\t209\t209\n26\t27\t210\t210\n26\t27\t211\t211\n26\t27\t212\t212\n26\t27\t213\t213\n26\t27\t214\t214\n26\t27\t215\t215\n26\t27\t216\t216\n26\t27\t217\t217\n26\t27\t218\t218\n26\t27\t219\t219\n26\t27\t220\t220\n26\t27\t221\t221\n26\t27\t222\t222\n26\t27\t223\t223\n26\t27\t224\t224\n26\t27\t225\t225\n26\t27\t226\t226\n26\t27\t227\t227\n26\t27\t228\t228\n26\t27\t229\t229\n26\t27\t230\t230\n26\t27\t231\t231\n26\t27\t232\t232\n26\t27\t233\t233\n26\t27\t234\t234\n26\t27\t235\t235\n26\t27\t236\t236\n26\t27\t237\t237\n26\t27\t238\t238\n26\t27\t239\t239\n26\t27\t240\t240\n26\t27\t241\t241\n26\t27\t242\t242\n26\t27\t243\t243\n26\t27\t244\t244\n26\t27\t245\t245\n26\t27\t246\t246\n26\t27\t247\t247\n26\t27\t248\t248\n26\t27\t249\t249\n26\t27\t250\t250\n26\t27\t251\t251\n26\t27\t252\t252\n26\t27\t253\t253\n26\t27\t254\t254\n26\t27\t255\t255\n27\t28\t0\t0\n27\t28\t1\t1\n27\t28\t2\t2\n27\t28\t3\t3\n27\t28\t4\t4\n27\t28\t5\t5\n27\t28\t6\t6\n27\t28\t7\t7\n27\t28\t8\t8\n27\t28\t9\t9\n27\t28\t10\t10\n27\t28\t11\t11\n27\t28\t12\t12\n27\t28\t13\t13\n27\t28\t14\t14\n27\t28\t15\t15\n27\t28\t16\t16\n27\t28\t17\t17\n27\t28\t18\t18\n27\t28\t19\t19\n27\t28\t20\t20\n27\t28\t21\t21\n27\t28\t22\t22\n27\t28\t23\t23\n27\t28\t24\t24\n27\t28\t25\t25\n27\t28\t26\t26\n27\t28\t27\t27\n27\t28\t28\t28\n27\t28\t29\t29\n27\t28\t30\t30\n27\t28\t31\t31\n27\t28\t32\t32\n27\t28\t33\t33\n27\t28\t34\t34\n27\t28\t35\t35\n27\t28\t36\t36\n27\t28\t37\t37\n27\t28\t38\t38\n27\t28\t39\t39\n27\t28\t40\t40\n27\t28\t41\t41\n27\t28\t42\t42\n27\t28\t43\t43\n27\t28\t44\t44\n27\t28\t45\t45\n27\t28\t46\t46\n27\t28\t47\t47\n27\t28\t48\t48\n27\t28\t49\t49\n27\t28\t50\t50\n27\t28\t51\t51\n27\t28\t52\t52\n27\t28\t53\t53\n27\t28\t54\t54\n27\t28\t55\t55\n27\t28\t56\t56\n27\t28\t57\t57\n27\t28\t58\t58\n27\t28\t59\t59\n27\t28\t60\t60\n27\t28\t61\t61\n27\t28\t62\t62\n27\t28\t63\t63\n27\t28\t64\t64\n27\t28\t65\t65\n27\t28\t66\t66\n27\t28\t67\t67\n27\t28\t68\t68\n27\t28\t69\t69\n27\t28\t70\t70\n27\t28\t71\t71\n27\t28\t72\t72\n27\t28\t73\t73\n27\t28\t74\t74\n27\t28\t75\t75\n27\t28\t76\t76\n27\t28\t77\t77\n27\t28\t78\t78\n27\t28\t79\t79\n27\t28\t80\t80\n27\t28\t81\t81\n27\t28\t82\t82\n27\t28\t83\t83\n27\t28\t84\t84\n27\t28\t85\t85\n27\t28\t86\t86\n27\t28\t87\t87\n27\t28\t88\t88\n27\t28\t89\t89\n27\t28\t90\t90\n27\t28\t91\t91\n27\t28\t92\t92\n27\t28\t93\t93\n27\t28\t94\t94\n27\t28\t95\t95\n27\t28\t96\t96\n27\t28\t97\t97\n27\t28\t98\t98\n27\t28\t99\t99\n27\t28\t100\t100\n27\t28\t101\t101\n27\t28\t102\t102\n27\t28\t103\t103\n27\t28\t104\t104\n27\t28\t105\t105\n27\t28\t106\t106\n27\t28\t107\t107\n27\t28\t108\t108\n27\t28\t109\t109\n27\t28\t110\t110\n27\t28\t111\t111\n27\t28\t112\t112\n27\t28\t113\t113\n27\t28\t114\t114\n27\t28\t115\t115\n27\t28\t116\t116\n27\t28\t117\t117\n27\t28\t118\t118\n27\t28\t119\t119\n27\t28\t120\t120\n27\t28\t121\t121\n27\t28\t122\t122\n27\t28\t123\t123\n27\t28\t124\t124\n27\t28\t125\t125\n27\t28\t126\t126\n27\t28\t127\t127\n27\t28\t128\t128\n27\t28\t129\t129\n27\t28\t130\t130\n27\t28\t131\t131\n27\t28\t132\t132\n27\t28\t133\t133\n27\t28\t134\t134\n27\t28\t135\t135\n27\t28\t136\t136\n27\t28\t137\t137\n27\t28\t138\t138\n27\t28\t139\t139\n27\t28\t140\t140\n27\t28\t141\t141\n27\t28\t142\t142\n27\t28\t143\t143\n27\t28\t144\t144\n27\t28\t145\t145\n27\t28\t146\t146\n27\t28\t147\t147\n27\t28\t148\t148\n27\t28\t149\t149\n27\t28\t150\t150\n27\t28\t151\t151\n27\t28\t152\t152\n27\t28\t153\t153\n27\t28\t154\t154\n27\t28\t155\t155\n27\t28\t156\t156\n27\t28\t157\t157\n27\t28\t158\t158\n27\t28\t159\t159\n27\t28\t160\t160\n27\t28\t161\t161\n27\t28\t162\t162\n27\t28\t163\t163\n27\t28\t164\t164\n27\t28\t165\t165\n27\t28\t166\t166\n27\t28\t167\t167\n27\t28\t168\t168\n27\t28\t169\t169\n27\t28\t170\t170\n27\t28\t171\t171\n27\t28\t172\t172\n27\t28\t173\t173\n27\t28\t174\t174\n27\t28\t175\t175\n27\t28\t176\t176\n27\t28\t177\t177\n27\t28\t178\t178\n27\t28\t179\t179\n27\t28\t180\t180\n27\t28\t181\t181\n27\t28\t182\t182\n27\t28\t183\t183\n27\t28\t184\t184\n27\t28\t185\t185\n27\t28\t186\t186\n27\t28\t187\t187\n27\t28\t188\t188\n27\t28\t189\t189\n27\t28\t190\t190\n27\t28\t191\t191\n27\t28\t192\t192\n27\t28\t193\t193\n27\t28\t194\t194\n27\t28\t195\t195\n27\t28\t196\t196\n27\t28\t197\t197\n27\t28\t198\t198\n27\t28\t199\t199\n27\t28\t200\t200\n27\t28\t201\t201\n27\t28\t202\t202\n27\t28\t203\t203\n27\t28\t204\t204\n27\t28\t205\t205\n27\t28\t206\t206\n27\t28\t207\t207\n27\t28\t208\t208\n27\t28\t209\t209\n27\t28\t210\t210\n27\t28\t211\t211\n27\t28\t212\t212\n27\t28\t213\t213\n27\t28\t214\t214\n27\t28\t215\t215\n27\t28\t216\t216\n27\t28\t217\t217\n27\t28\t218\t218\n27\t28\t219\t219\n27\t28\t220\t220\n27\t28\t221\t221\n27\t28\t222\t222\n27\t28\t223\t223\n27\t28\t224\t224\n27\t28\t225\t225\n27\t28\t226\t226\n27\t28\t227\t227\n27\t28\t228\t228\n27\t28\t229\t229\n27\t28\t230\t230\n27\t28\t231\t231\n27\t28\t232\t232\n27\t28\t233\t233\n27\t28\t234\t234\n27\t28\t235\t235\n27\t28\t236\t236\n27\t28\t237\t237\n27\t28\t238\t238\n27\t28\t239\t239\n27\t28\t240\t240\n27\t28\t241\t241\n27\t28\t242\t242\n27\t28\t243\t243\n27\t28\t244\t244\n27\t28\t245\t245\n27\t28\t246\t246\n27\t28\t247\t247\n27\t28\t248\t248\n27\t28\t249\t249\n27\t28\t250\t250\n27\t28\t251\t251\n27\t28\t252\t252\n27\t28\t253\t253\n27\t28\t254\t254\n27\t28\t255\t255\n28\t29\t0\t0\n28\t29\t1\t1\n28\t29\t2\t2\n28\t29\t3\t3\n28\t29\t4\t4\n28\t29\t5\t5\n28\t29\t6\t6\n28\t29\t7\t7\n28\t29\t8\t8\n28\t29\t9\t9\n28\t29\t10\t10\n28\t29\t11\t11\n28\t29\t12\t12\n28\t29\t13\t13\n28\t29\t14\t14\n28\t29\t15\t15\n28\t29\t16\t16\n28\t29\t17\t17\n28\t29\t18\t18\n28\t29\t19\t19\n28\t29\t20\t20\n28\t29\t21\t21\n28\t29\t22\t22\n28\t29\t23\t23\n28\t29\t24\t24\n28\t29\t25\t25\n28\t29\t26\t26\n28\t29\t27\t27\n28\t29\t28\t28\n28\t29\t29\t29\n28\t29\t30\t30\n28\t29\t31\t31\n28\t29\t32\t32\n28\t29\t33\t33\n28\t29\t34\t34\n28\t29\t35\t35\n28\t29\t36\t36\n28\t29\t37\t37\n28\t29\t38\t38\n28\t29\t39\t39\n28\t29\t40\t40\n28\t29\t41\t41\n28\t29\t42\t42\n28\t29\t43\t43\n28\t29\t44\t44\n28\t29\t45\t45\n28\t29\t46\t46\n28\t29\t47\t47\n28\t29\t48\t48\n28\t29\t49\t49\n28\t29\t50\t50\n28\t29\t51\t51\n28\t29\t52\t52\n28\t29\t53\t53\n28\t29\t54\t54\n28\t29\t55\t55\n28\t29\t56\t56\n28\t29\t57\t57\n28\t29\t58\t58\n28\t29\t59\t59\n28\t29\t60\t60\n28\t29\t61\t61\n28\t29\t62\t62\n28\t29\t63\t63\n28\t29\t64\t64\n28\t29\t65\t65\n28\t29\t66\t66\n28\t29\t67\t67\n28\t29\t68\t68\n28\t29\t69\t69\n28\t29\t70\t70\n28\t29\t71\t71\n28\t29\t72\t72\n28\t29\t73\t73\n28\t29\t74\t74\n28\t29\t75\t75\n28\t29\t76\t76\n28\t29\t77\t77\n28\t29\t78\t78\n28\t29\t79\t79\n28\t29\t80\t80\n28\t29\t81\t81\n28\t29\t82\t82\n28\t29\t83\t83\n28\t29\t84\t84\n28\t29\t85\t85\n28\t29\t86\t86\n28\t29\t87\t87\n28\t29\t88\t88\n28\t29\t89\t89\n28\t29\t90\t90\n28\t29\t91\t91\n28\t29\t92\t92\n28\t29\t93\t93\n28\t29\t94\t94\n28\t29\t95\t95\n28\t29\t96\t96\n28\t29\t97\t97\n28\t29\t98\t98\n28\t29\t99\t99\n28\t29\t100\t100\n28\t29\t101\t101\n28\t29\t102\t102\n28\t29\t103\t103\n28\t29\t104\t104\n28\t29\t105\t105\n28\t29\t106\t106\n28\t29\t107\t107\n28\t29\t108\t108\n28\t29\t109\t109\n28\t29\t110\t110\n28\t29\t111\t111\n28\t29\t112\t112\n28\t29\t113\t113\n28\t29\t114\t114\n28\t29\t115\t115\n28\t29\t116\t116\n28\t29\t117\t117\n28\t29\t118\t118\n28\t29\t119\t119\n28\t29\t120\t120\n28\t29\t121\t121\n28\t29\t122\t122\n28\t29\t123\t123\n28\t29\t124\t124\n28\t29\t125\t125\n28\t29\t126\t126\n28\t29\t127\t127\n28\t29\t128\t128\n28\t29\t129\t129\n28\t29\t130\t130\n28\t29\t131\t131\n28\t29\t132\t132\n28\t29\t133\t133\n28\t29\t134\t134\n28\t29\t135\t135\n28\t29\t136\t136\n28\t29\t137\t137\n28\t29\t138\t138\n28\t29\t139\t139\n28\t29\t140\t140\n28\t29\t141\t141\n28\t29\t142\t142\n28\t29\t143\t143\n28\t29\t144\t144\n28\t29\t145\t145\n28\t29\t146\t146\n28\t29\t147\t147\n28\t29\t148\t148\n28\t29\t149\t149\n28\t29\t150\t150\n28\t29\t151\t151\n28\t29\t152\t152\n28\t29\t153\t153\n28\t29\t154\t154\n28\t29\t155\t155\n28\t29\t156\t156\n28\t29\t157\t157\n28\t29\t158\t158\n28\t29\t159\t159\n28\t29\t160\t160\n28\t29\t161\t161\n28\t29\t162\t162\n28\t29\t163\t163\n28\t29\t164\t164\n28\t29\t165\t165\n28\t29\t166\t166\n28\t29\t167\t167\n28\t29\t168\t168\n28\t29\t169\t169\n28\t29\t170\t170\n28\t29\t171\t171\n28\t29\t172\t172\n28\t29\t173\t173\n28\t29\t174\t174\n28\t29\t175\t175\n28\t29\t176\t176\n28\t29\t177\t177\n28\t29\t178\t178\n28\t29\t179\t179\n28\t29\t180\t180\n28\t29\t181\t181\n28\t29\t182\t182\n28\t29\t183\t183\n28\t29\t184\t184\n28\t29\t185\t185\n28\t29\t186\t186\n28\t29\t187\t187\n28\t29\t188\t188\n28\t29\t189\t189\n28\t29\t190\t190\n28\t29\t191\t191\n28\t29\t192\t192\n28\t29\t193\t193\n28\t29\t194\t194\n28\t29\t195\t195\n28\t29\t196\t196\n28\t29\t197\t197\n28\t29\t198\t198\n28\t29\t199\t199\n28\t29\t200\t200\n28\t29\t201\t201\n28\t29\t202\t202\n28\t29\t203\t203\n28\t29\t204\t204\n28\t29\t205\t205\n28\t29\t206\t206\n28\t29\t207\t207\n28\t29\t208\t208\n28\t29\t209\t209\n28\t29\t210\t210\n28\t29\t211\t211\n28\t29\t212\t212\n28\t29\t213\t213\n28\t29\t214\t214\n28\t29\t215\t215\n28\t29\t216\t216\n28\t29\t217\t217\n28\t29\t218\t218\n28\t29\t219\t219\n28\t29\t220\t220\n28\t29\t221\t221\n28\t29\t222\t222\n28\t29\t223\t223\n28\t29\t224\t224\n28\t29\t225\t225\n28\t29\t226\t226\n28\t29\t227\t227\n28\t29\t228\t228\n28\t29\t229\t229\n28\t29\t230\t230\n28\t29\t231\t231\n28\t29\t232\t232\n28\t29\t233\t233\n28\t29\t234\t234\n28\t29\t235\t235\n28\t29\t236\t236\n28\t29\t237\t237\n28\t29\t238\t238\n28\t29\t239\t239\n28\t29\t240\t240\n28\t29\t241\t241\n28\t29\t242\t242\n28\t29\t243\t243\n28\t29\t244\t244\n28\t29\t245\t245\n28\t29\t246\t246\n28\t29\t247\t247\n28\t29\t248\t248\n28\t29\t249\t249\n28\t29\t250\t250\n28\t29\t251\t251\n28\t29\t252\t252\n28\t29\t253\t253\n28\t29\t254\t254\n28\t29\t255\t255\n29\t30\t0\t0\n29\t30\t1\t1\n29\t30\t2\t2\n29\t30\t3\t3\n29\t30\t4\t4\n29\t30\t5\t5\n29\t30\t6\t6\n29\t30\t7\t7\n29\t30\t8\t8\n29\t30\t9\t9\n29\t30\t10\t10\n29\t30\t11\t11\n29\t30\t12\t12\n29\t30\t13\t13\n29\t30\t14\t14\n29\t30\t15\t15\n29\t30\t16\t16\n29\t30\t17\t17\n29\t30\t18\t18\n29\t30\t19\t19\n29\t30\t20\t20\n29\t30\t21\t21\n29\t30\t22\t22\n29\t30\t23\t23\n29\t30\t24\t24\n29\t30\t25\t25\n29\t30\t26\t26\n29\t30\t27\t27\n29\t30\t28\t28\n29\t30\t29\t29\n29\t30\t30\t30\n29\t30\t31\t31\n29\t30\t32\t32\n29\t30\t33\t33\n29\t30\t34\t34\n29\t30\t35\t35\n29\t30\t36\t36\n29\t30\t37\t37\n29\t30\t38\t38\n29\t30\t39\t39\n29\t30\t40\t40\n29\t30\t41\t41\n29\t30\t42\t42\n29\t30\t43\t43\n29\t30\t44\t44\n29\t30\t45\t45\n29\t30\t46\t46\n29\t30\t47\t47\n29\t30\t48\t48\n29\t30\t49\t49\n29\t30\t50\t50\n29\t30\t51\t51\n29\t30\t52\t52\n29\t30\t53\t53\n29\t30\t54\t54\n29\t30\t55\t55\n29\t30\t56\t56\n29\t30\t57\t57\n29\t30\t58\t58\n29\t30\t59\t59\n29\t30\t60\t60\n29\t30\t61\t61\n29\t30\t62\t62\n29\t30\t63\t63\n29\t30\t64\t64\n29\t30\t65\t65\n29\t30\t66\t66\n29\t30\t67\t67\n29\t30\t68\t68\n29\t30\t69\t69\n29\t30\t70\t70\n29\t30\t71\t71\n29\t30\t72\t72\n29\t30\t73\t73\n29\t30\t74\t74\n29\t30\t75\t75\n29\t30\t76\t76\n29\t30\t77\t77\n29\t30\t78\t78\n29\t30\t79\t79\n29\t30\t80\t80\n29\t30\t81\t81\n29\t30\t82\t82\n29\t30\t83\t83\n29\t30\t84\t84\n29\t30\t85\t85\n29\t30\t86\t86\n29\t30\t87\t87\n29\t30\t88\t88\n29\t30\t89\t89\n29\t30\t90\t90\n29\t30\t91\t91\n29\t30\t92\t92\n29\t30\t93\t93\n29\t30\t94\t94\n29\t30\t95\t95\n29\t30\t96\t96\n29\t30\t97\t97\n29\t30\t98\t98\n29\t30\t99\t99\n29\t30\t100\t100\n29\t30\t101\t101\n29\t30\t102\t102\n29\t30\t103\t103\n29\t30\t104\t104\n29\t30\t105\t105\n29\t30\t106\t106\n29\t30\t107\t107\n29\t30\t108\t108\n29\t30\t109\t109\n29\t30\t110\t110\n29\t30\t111\t111\n29\t30\t112\t112\n29\t30\t113\t113\n29\t30\t114\t114\n29\t30\t115\t115\n29\t30\t116\t116\n29\t30\t117\t117\n29\t30\t118\t118\n29\t30\t119\t119\n29\t30\t120\t120\n29\t30\t121\t121\n29\t30\t122\t122\n29\t30\t123\t123\n29\t30\t124\t124\n29\t30\t125\t125\n29\t30\t126\t126\n29\t30\t127\t127\n29\t30\t128\t128\n29\t30\t129\t129\n29\t30\t130\t130\n29\t30\t131\t131\n29\t30\t132\t132\n29\t30\t133\t133\n29\t30\t134\t134\n29\t30\t135\t135\n29\t30\t136\t136\n29\t30\t137\t137\n29\t30\t138\t138\n29\t30\t139\t139\n29\t30\t140\t140\n29\t30\t141\t141\n29\t30\t142\t142\n29\t30\t143\t143\n29\t30\t144\t144\n29\t30\t145\t145\n29\t30\t146\t146\n29\t30\t147\t147\n29\t30\t148\t148\n29\t30\t149\t149\n29\t30\t150\t150\n29\t30\t151\t151\n29\t30\t152\t152\n29\t30\t153\t153\n29\t30\t154\t154\n29\t30\t155\t155\n29\t30\t156\t156\n29\t30\t157\t157\n29\t30\t158\t158\n29\t30\t159\t159\n29\t30\t160\t160\n29\t30\t161\t161\n29\t30\t162\t162\n29\t30\t163\t163\n29\t30\t164\t164\n29\t30\t165\t165\n29\t30\t166\t166\n29\t30\t167\t167\n29\t30\t168\t168\n29\t30\t169\t169\n29\t30\t170\t170\n29\t30\t171\t171\n29\t30\t172\t172\n29\t30\t173\t173\n29\t30\t174\t174\n29\t30\t175\t175\n29\t30\t176\t176\n29\t30\t177\t177\n29\t30\t178\t178\n29\t30\t179\t179\n29\t30\t180\t180\n29\t30\t181\t181\n29\t30\t182\t182\n29\t30\t183\t183\n29\t30\t184\t184\n29\t30\t185\t185\n29\t30\t186\t186\n29\t30\t187\t187\n29\t30\t188\t188\n29\t30\t189\t189\n29\t30\t190\t190\n29\t30\t191\t191\n29\t30\t192\t192\n29\t30\t193\t193\n29\t30\t194\t194\n29\t30\t195\t195\n29\t30\t196\t196\n29\t30\t197\t197\n29\t30\t198\t198\n29\t30\t199\t199\n29\t30\t200\t200\n29\t30\t201\t201\n29\t30\t202\t202\n29\t30\t203\t203\n29\t30\t204\t204\n29\t30\t205\t205\n29\t30\t206\t206\n29\t30\t207\t207\n29\t30\t208\t208\n29\t30\t209\t209\n29\t30\t210\t210\n29\t30\t211\t211\n29\t30\t212\t212\n29\t30\t213\t213\n29\t30\t214\t214\n29\t30\t215\t215\n29\t30\t216\t216\n29\t30\t217\t217\n29\t30\t218\t218\n29\t30\t219\t219\n29\t30\t220\t220\n29\t30\t221\t221\n29\t30\t222\t222\n29\t30\t223\t223\n29\t30\t224\t224\n29\t30\t225\t225\n29\t30\t226\t226\n29\t30\t227\t227\n29\t30\t228\t228\n29\t30\t229\t229\n29\t30\t230\t230\n29\t30\t231\t231\n29\t30\t232\t232\n29\t30\t233\t233\n29\t30\t234\t234\n29\t30\t235\t235\n29\t30\t236\t236\n29\t30\t237\t237\n29\t30\t238\t238\n29\t30\t239\t239\n29\t30\t240\t240\n29\t30\t241\t241\n29\t30\t242\t242\n29\t30\t243\t243\n29\t30\t244\t244\n29\t30\t245\t245\n29\t30\t246\t246\n29\t30\t247\t247\n29\t30\t248\t248\n29\t30\t249\t249\n29\t30\t250\t250\n29\t30\t251\t251\n29\t30\t252\t252\n29\t30\t253\t253\n29\t30\t254\t254\n29\t30\t255\t255\n30\t31\t0\t0\n30\t31\t1\t1\n30\t31\t2\t2\n30\t31\t3\t3\n30\t31\t4\t4\n30\t31\t5\t5\n30\t31\t6\t6\n30\t31\t7\t7\n30\t31\t8\t8\n30\t31\t9\t9\n30\t31\t10\t10\n30\t31\t11\t11\n30\t31\t12\t12\n30\t31\t13\t13\n30\t31\t14\t14\n30\t31\t15\t15\n30\t31\t16\t16\n30\t31\t17\t17\n30\t31\t18\t18\n30\t31\t19\t19\n30\t31\t20\t20\n30\t31\t21\t21\n30\t31\t22\t22\n30\t31\t23\t23\n30\t31\t24\t24\n30\t31\t25\t25\n30\t31\t26\t26\n30\t31\t27\t27\n30\t31\t28\t28\n30\t31\t29\t29\n30\t31\t30\t30\n30\t31\t31\t31\n30\t31\t32\t32\n30\t31\t33\t33\n30\t31\t34\t34\n30\t31\t35\t35\n30\t31\t36\t36\n30\t31\t37\t37\n30\t31\t38\t38\n30\t31\t39\t39\n30\t31\t40\t40\n30\t31\t41\t41\n30\t31\t42\t42\n30\t31\t43\t43\n30\t31\t44\t44\n30\t31\t45\t45\n30\t31\t46\t46\n30\t31\t47\t47\n30\t31\t48\t48\n30\t31\t49\t49\n30\t31\t50\t50\n30\t31\t51\t51\n30\t31\t52\t52\n30\t31\t53\t53\n30\t31\t54\t54\n30\t31\t55\t55\n30\t31\t56\t56\n30\t31\t57\t57\n30\t31\t58\t58\n30\t31\t59\t59\n30\t31\t60\t60\n30\t31\t61\t61\n30\t31\t62\t62\n30\t31\t63\t63\n30\t31\t64\t64\n30\t31\t65\t65\n30\t31\t66\t66\n30\t31\t67\t67\n30\t31\t68\t68\n30\t31\t69\t69\n30\t31\t70\t70\n30\t31\t71\t71\n30\t31\t72\t72\n30\t31\t73\t73\n30\t31\t74\t74\n30\t31\t75\t75\n30\t31\t76\t76\n30\t31\t77\t77\n30\t31\t78\t78\n30\t31\t79\t79\n30\t31\t80\t80\n30\t31\t81\t81\n30\t31\t82\t82\n30\t31\t83\t83\n30\t31\t84\t84\n30\t31\t85\t85\n30\t31\t86\t86\n30\t31\t87\t87\n30\t31\t88\t88\n30\t31\t89\t89\n30\t31\t90\t90\n30\t31\t91\t91\n30\t31\t92\t92\n30\t31\t93\t93\n30\t31\t94\t94\n30\t31\t95\t95\n30\t31\t96\t96\n30\t31\t97\t97\n30\t31\t98\t98\n30\t31\t99\t99\n30\t31\t100\t100\n30\t31\t101\t101\n30\t31\t102\t102\n30\t31\t103\t103\n30\t31\t104\t104\n30\t31\t105\t105\n30\t31\t106\t106\n30\t31\t107\t107\n30\t31\t108\t108\n30\t31\t109\t109\n30\t31\t110\t110\n30\t31\t111\t111\n30\t31\t112\t112\n30\t31\t113\t113\n30\t31\t114\t114\n30\t31\t115\t115\n30\t31\t116\t116\n30\t31\t117\t117\n30\t31\t118\t118\n30\t31\t119\t119\n30\t31\t120\t120\n30\t31\t121\t121\n30\t31\t122\t122\n30\t31\t123\t123\n30\t31\t124\t124\n30\t31\t125\t125\n30\t31\t126\t126\n30\t31\t127\t127\n30\t31\t128\t128\n30\t31\t129\t129\n30\t31\t130\t130\n30\t31\t131\t131\n30\t31\t132\t132\n30\t31\t133\t133\n30\t31\t134\t134\n30\t31\t135\t135\n30\t31\t136\t136\n30\t31\t137\t137\n30\t31\t138\t138\n30\t31\t139\t139\n30\t31\t140\t140\n30\t31\t141\t141\n30\t31\t142\t142\n30\t31\t143\t143\n30\t31\t144\t144\n30\t31\t145\t145\n30\t31\t146\t146\n30\t31\t147\t147\n30\t31\t148\t148\n30\t31\t149\t149\n30\t31\t150\t150\n30\t31\t151\t151\n30\t31\t152\t152\n30\t31\t153\t153\n30\t31\t154\t154\n30\t31\t155\t155\n30\t31\t156\t156\n30\t31\t157\t157\n30\t31\t158\t158\n30\t31\t159\t159\n30\t31\t160\t160\n30\t31\t161\t161\n30\t31\t162\t162\n30\t31\t163\t163\n30\t31\t164\t164\n30\t31\t165\t165\n30\t31\t166\t166\n30\t31\t167\t167\n30\t31\t168\t168\n30\t31\t169\t169\n30\t31\t170\t170\n30\t31\t171\t171\n30\t31\t172\t172\n30\t31\t173\t173\n30\t31\t174\t174\n30\t31\t175\t175\n30\t31\t176\t176\n30\t31\t177\t177\n30\t31\t178\t178\n30\t31\t179\t179\n30\t31\t180\t180\n30\t31\t181\t181\n30\t31\t182\t182\n30\t31\t183\t183\n30\t31\t184\t184\n30\t31\t185\t185\n30\t31\t186\t186\n30\t31\t187\t187\n30\t31\t188\t188\n30\t31\t189\t189\n30\t31\t190\t190\n30\t31\t191\t191\n30\t31\t192\t192\n30\t31\t193\t193\n30\t31\t194\t194\n30\t31\t195\t195\n30\t31\t196\t196\n30\t31\t197\t197\n30\t31\t198\t198\n30\t31\t199\t199\n30\t31\t200\t200\n30\t31\t201\t201\n30\t31\t202\t202\n30\t31\t203\t203\n30\t31\t204\t204\n30\t31\t205\t205\n30\t31\t206\t206\n30\t31\t207\t207\n30\t31\t208\t208\n30\t31\t209\t209\n30\t31\t210\t210\n30\t31\t211\t211\n30\t31\t212\t212\n30\t31\t213\t213\n30\t31\t214\t214\n30\t31\t215\t215\n30\t31\t216\t216\n30\t31\t217\t217\n30\t31\t218\t218\n30\t31\t219\t219\n30\t31\t220\t220\n30\t31\t221\t221\n30\t31\t222\t222\n30\t31\t223\t223\n30\t31\t224\t224\n30\t31\t225\t225\n30\t31\t226\t226\n30\t31\t227\t227\n30\t31\t228\t228\n30\t31\t229\t229\n30\t31\t230\t230\n30\t31\t231\t231\n30\t31\t232\t232\n30\t31\t233\t233\n30\t31\t234\t234\n30\t31\t235\t235\n30\t31\t236\t236\n30\t31\t237\t237\n30\t31\t238\t238\n30\t31\t239\t239\n30\t31\t240\t240\n30\t31\t241\t241\n30\t31\t242\t242\n30\t31\t243\t243\n30\t31\t244\t244\n30\t31\t245\t245\n30\t31\t246\t246\n30\t31\t247\t247\n30\t31\t248\t248\n30\t31\t249\t249\n30\t31\t250\t250\n30\t31\t251\t251\n30\t31\t252\t252\n30\t31\t253\t253\n30\t31\t254\t254\n30\t31\t255\t255\n31\t32\t0\t0\n31\t32\t1\t1\n31\t32\t2\t2\n31\t32\t3\t3\n31\t32\t4\t4\n31\t32\t5\t5\n31\t32\t6\t6\n31\t32\t7\t7\n31\t32\t8\t8\n31\t32\t9\t9\n31\t32\t10\t10\n31\t32\t11\t11\n31\t32\t12\t12\n31\t32\t13\t13\n31\t32\t14\t14\n31\t32\t15\t15\n31\t32\t16\t16\n31\t32\t17\t17\n31\t32\t18\t18\n31\t32\t19\t19\n31\t32\t20\t20\n31\t32\t21\t21\n31\t32\t22\t22\n31\t32\t23\t23\n31\t32\t24\t24\n31\t32\t25\t25\n31\t32\t26\t26\n31\t32\t27\t27\n31\t32\t28\t28\n31\t32\t29\t29\n31\t32\t30\t30\n31\t32\t31\t31\n31\t32\t32\t32\n31\t32\t33\t33\n31\t32\t34\t34\n31\t32\t35\t35\n31\t32\t36\t36\n31\t32\t37\t37\n31\t32\t38\t38\n31\t32\t39\t39\n31\t32\t40\t40\n31\t32\t41\t41\n31\t32\t42\t42\n31\t32\t43\t43\n31\t32\t44\t44\n31\t32\t45\t45\n31\t32\t46\t46\n31\t32\t47\t47\n31\t32\t48\t48\n31\t32\t49\t49\n31\t32\t50\t50\n31\t32\t51\t51\n31\t32\t52\t52\n31\t32\t53\t53\n31\t32\t54\t54\n31\t32\t55\t55\n31\t32\t56\t56\n31\t32\t57\t57\n31\t32\t58\t58\n31\t32\t59\t59\n31\t32\t60\t60\n31\t32\t61\t61\n31\t32\t62\t62\n31\t32\t63\t63\n31\t32\t64\t64\n31\t32\t65\t65\n31\t32\t66\t66\n31\t32\t67\t67\n31\t32\t68\t68\n31\t32\t69\t69\n31\t32\t70\t70\n31\t32\t71\t71\n31\t32\t72\t72\n31\t32\t73\t73\n31\t32\t74\t74\n31\t32\t75\t75\n31\t32\t76\t76\n31\t32\t77\t77\n31\t32\t78\t78\n31\t32\t79\t79\n31\t32\t80\t80\n31\t32\t81\t81\n31\t32\t82\t82\n31\t32\t83\t83\n31\t32\t84\t84\n31\t32\t85\t85\n31\t32\t86\t86\n31\t32\t87\t87\n31\t32\t88\t88\n31\t32\t89\t89\n31\t32\t90\t90\n31\t32\t91\t91\n31\t32\t92\t92\n31\t32\t93\t93\n31\t32\t94\t94\n31\t32\t95\t95\n31\t32\t96\t96\n31\t32\t97\t97\n31\t32\t98\t98\n31\t32\t99\t99\n31\t32\t100\t100\n31\t32\t101\t101\n31\t32\t102\t102\n31\t32\t103\t103\n31\t32\t104\t104\n31\t32\t105\t105\n31\t32\t106\t106\n31\t32\t107\t107\n31\t32\t108\t108\n31\t32\t109\t109\n31\t32\t110\t110\n31\t32\t111\t111\n31\t32\t112\t112\n31\t32\t113\t113\n31\t32\t114\t114\n31\t32\t115\t115\n31\t32\t116\t116\n31\t32\t117\t117\n31\t32\t118\t118\n31\t32\t119\t119\n31\t32\t120\t120\n31\t32\t121\t121\n31\t32\t122\t122\n31\t32\t123\t123\n31\t32\t124\t124\n31\t32\t125\t125\n31\t32\t126\t126\n31\t32\t127\t127\n31\t32\t128\t128\n31\t32\t129\t129\n31\t32\t130\t130\n31\t32\t131\t131\n31\t32\t132\t132\n31\t32\t133\t133\n31\t32\t134\t134\n31\t32\t135\t135\n31\t32\t136\t136\n31\t32\t137\t137\n31\t32\t138\t138\n31\t32\t139\t139\n31\t32\t140\t140\n31\t32\t141\t141\n31\t32\t142\t142\n31\t32\t143\t143\n31\t32\t144\t144\n31\t32\t145\t145\n31\t32\t146\t146\n31\t32\t147\t147\n31\t32\t148\t148\n31\t32\t149\t149\n31\t32\t150\t150\n31\t32\t151\t151\n31\t32\t152\t152\n31\t32\t153\t153\n31\t32\t154\t154\n31\t32\t155\t155\n31\t32\t156\t156\n31\t32\t157\t157\n31\t32\t158\t158\n31\t32\t159\t159\n31\t32\t160\t160\n31\t32\t161\t161\n31\t32\t162\t162\n31\t32\t163\t163\n31\t32\t164\t164\n31\t32\t165\t165\n31\t32\t166\t166\n31\t32\t167\t167\n31\t32\t168\t168\n31\t32\t169\t169\n31\t32\t170\t170\n31\t32\t171\t171\n31\t32\t172\t172\n31\t32\t173\t173\n31\t32\t174\t174\n31\t32\t175\t175\n31\t32\t176\t176\n31\t32\t177\t177\n31\t32\t178\t178\n31\t32\t179\t179\n31\t32\t180\t180\n31\t32\t181\t181\n31\t32\t182\t182\n31\t32\t183\t183\n31\t32\t184\t184\n31\t32\t185\t185\n31\t32\t186\t186\n31\t32\t187\t187\n31\t32\t188\t188\n31\t32\t189\t189\n31\t32\t190\t190\n31\t32\t191\t191\n31\t32\t192\t192\n31\t32\t193\t193\n31\t32\t194\t194\n31\t32\t195\t195\n31\t32\t196\t196\n31\t32\t197\t197\n31\t32\t198\t198\n31\t32\t199\t199\n31\t32\t200\t200\n31\t32\t201\t201\n31\t32\t202\t202\n31\t32\t203\t203\n31\t32\t204\t204\n31\t32\t205\t205\n31\t32\t206\t206\n31\t32\t207\t207\n31\t32\t208\t208\n31\t32\t209\t209\n31\t32\t210\t210\n31\t32\t211\t211\n31\t32\t212\t212\n31\t32\t213\t213\n31\t32\t214\t214\n31\t32\t215\t215\n31\t32\t216\t216\n31\t32\t217\t217\n31\t32\t218\t218\n31\t32\t219\t219\n31\t32\t220\t220\n31\t32\t221\t221\n31\t32\t222\t222\n31\t32\t223\t223\n31\t32\t224\t224\n31\t32\t225\t225\n31\t32\t226\t226\n31\t32\t227\t227\n31\t32\t228\t228\n31\t32\t229\t229\n31\t32\t230\t230\n31\t32\t231\t231\n31\t32\t232\t232\n31\t32\t233\t233\n31\t32\t234\t234\n31\t32\t235\t235\n31\t32\t236\t236\n31\t32\t237\t237\n31\t32\t238\t238\n31\t32\t239\t239\n31\t32\t240\t240\n31\t32\t241\t241\n31\t32\t242\t242\n31\t32\t243\t243\n31\t32\t244\t244\n31\t32\t245\t245\n31\t32\t246\t246\n31\t32\t247\t247\n31\t32\t248\t248\n31\t32\t249\t249\n31\t32\t250\t250\n31\t32\t251\t251\n31\t32\t252\t252\n31\t32\t253\t253\n31\t32\t254\t254\n31\t32\t255\t255\n32\t33\t0\t0\n32\t33\t1\t1\n32\t33\t2\t2\n32\t33\t3\t3\n32\t33\t4\t4\n32\t33\t5\t5\n32\t33\t6\t6\n32\t33\t7\t7\n32\t33\t8\t8\n32\t33\t9\t9\n32\t33\t10\t10\n32\t33\t11\t11\n32\t33\t12\t12\n32\t33\t13\t13\n32\t33\t14\t14\n32\t33\t15\t15\n32\t33\t16\t16\n32\t33\t17\t17\n32\t33\t18\t18\n32\t33\t19\t19\n32\t33\t20\t20\n32\t33\t21\t21\n32\t33\t22\t22\n32\t33\t23\t23\n32\t33\t24\t24\n32\t33\t25\t25\n32\t33\t26\t26\n32\t33\t27\t27\n32\t33\t28\t28\n32\t33\t29\t29\n32\t33\t30\t30\n32\t33\t31\t31\n32\t33\t32\t32\n32\t33\t33\t33\n32\t33\t34\t34\n32\t33\t35\t35\n32\t33\t36\t36\n32\t33\t37\t37\n32\t33\t38\t38\n32\t33\t39\t39\n32\t33\t40\t40\n32\t33\t41\t41\n32\t33\t42\t42\n32\t33\t43\t43\n32\t33\t44\t44\n32\t33\t45\t45\n32\t33\t46\t46\n32\t33\t47\t47\n32\t33\t48\t48\n32\t33\t49\t49\n32\t33\t50\t50\n32\t33\t51\t51\n32\t33\t52\t52\n32\t33\t53\t53\n32\t33\t54\t54\n32\t33\t55\t55\n32\t33\t56\t56\n32\t33\t57\t57\n32\t33\t58\t58\n32\t33\t59\t59\n32\t33\t60\t60\n32\t33\t61\t61\n32\t33\t62\t62\n32\t33\t63\t63\n32\t33\t64\t64\n32\t33\t65\t65\n32\t33\t66\t66\n32\t33\t67\t67\n32\t33\t68\t68\n32\t33\t69\t69\n32\t33\t70\t70\n32\t33\t71\t71\n32\t33\t72\t72\n32\t33\t73\t73\n32\t33\t74\t74\n32\t33\t75\t75\n32\t33\t76\t76\n32\t33\t77\t77\n32\t33\t78\t78\n32\t33\t79\t79\n32\t33\t80\t80\n32\t33\t81\t81\n32\t33\t82\t82\n32\t33\t83\t83\n32\t33\t84\t84\n32\t33\t85\t85\n32\t33\t86\t86\n32\t33\t87\t87\n32\t33\t88\t88\n32\t33\t89\t89\n32\t33\t90\t90\n32\t33\t91\t91\n32\t33\t92\t92\n32\t33\t93\t93\n32\t33\t94\t94\n32\t33\t95\t95\n32\t33\t96\t96\n32\t33\t97\t97\n32\t33\t98\t98\n32\t33\t99\t99\n32\t33\t100\t100\n32\t33\t101\t101\n32\t33\t102\t102\n32\t33\t103\t103\n32\t33\t104\t104\n32\t33\t105\t105\n32\t33\t106\t106\n32\t33\t107\t107\n32\t33\t108\t108\n32\t33\t109\t109\n32\t33\t110\t110\n32\t33\t111\t111\n32\t33\t112\t112\n32\t33\t113\t113\n32\t33\t114\t114\n32\t33\t115\t115\n32\t33\t116\t116\n32\t33\t117\t117\n32\t33\t118\t118\n32\t33\t119\t119\n32\t33\t120\t120\n32\t33\t121\t121\n32\t33\t122\t122\n32\t33\t123\t123\n32\t33\t124\t124\n32\t33\t125\t125\n32\t33\t126\t126\n32\t33\t127\t127\n32\t33\t128\t128\n32\t33\t129\t129\n32\t33\t130\t130\n32\t33\t131\t131\n32\t33\t132\t132\n32\t33\t133\t133\n32\t33\t134\t134\n32\t33\t135\t135\n32\t33\t136\t136\n32\t33\t137\t137\n32\t33\t138\t138\n32\t33\t139\t139\n32\t33\t140\t140\n32\t33\t141\t141\n32\t33\t142\t142\n32\t33\t143\t143\n32\t33\t144\t144\n32\t33\t145\t145\n32\t33\t146\t146\n32\t33\t147\t147\n32\t33\t148\t148\n32\t33\t149\t149\n32\t33\t150\t150\n32\t33\t151\t151\n32\t33\t152\t152\n32\t33\t153\t153\n32\t33\t154\t154\n32\t33\t155\t155\n32\t33\t156\t156\n32\t33\t157\t157\n32\t33\t158\t158\n32\t33\t159\t159\n32\t33\t160\t160\n32\t33\t161\t161\n32\t33\t162\t162\n32\t33\t163\t163\n32\t33\t164\t164\n32\t33\t165\t165\n32\t33\t166\t166\n32\t33\t167\t167\n32\t33\t168\t168\n32\t33\t169\t169\n32\t33\t170\t170\n32\t33\t171\t171\n32\t33\t172\t172\n32\t33\t173\t173\n32\t33\t174\t174\n32\t33\t175\t175\n32\t33\t176\t176\n32\t33\t177\t177\n32\t33\t178\t178\n32\t33\t179\t179\n32\t33\t180\t180\n32\t33\t181\t181\n32\t33\t182\t182\n32\t33\t183\t183\n32\t33\t184\t184\n32\t33\t185\t185\n32\t33\t186\t186\n32\t33\t187\t187\n32\t33\t188\t188\n32\t33\t189\t189\n32\t33\t190\t190\n32\t33\t191\t191\n32\t33\t192\t192\n32\t33\t193\t193\n32\t33\t194\t194\n32\t33\t195\t195\n32\t33\t196\t196\n32\t33\t197\t197\n32\t33\t198\t198\n32\t33\t199\t199\n32\t33\t200\t200\n32\t33\t201\t201\n32\t33\t202\t202\n32\t33\t203\t203\n32\t33\t204\t204\n32\t33\t205\t205\n32\t33\t206\t206\n32\t33\t207\t207\n32\t33\t208\t208\n32\t33\t209\t209\n32\t33\t210\t210\n32\t33\t211\t211\n32\t33\t212\t212\n32\t33\t213\t213\n32\t33\t214\t214\n32\t33\t215\t215\n32\t33\t216\t216\n32\t33\t217\t217\n32\t33\t218\t218\n32\t33\t219\t219\n32\t33\t220\t220\n32\t33\t221\t221\n32\t33\t222\t222\n32\t33\t223\t223\n32\t33\t224\t224\n32\t33\t225\t225\n32\t33\t226\t226\n32\t33\t227\t227\n32\t33\t228\t228\n32\t33\t229\t229\n32\t33\t230\t230\n32\t33\t231\t231\n32\t33\t232\t232\n32\t33\t233\t233\n32\t33\t234\t234\n32\t33\t235\t235\n32\t33\t236\t236\n32\t33\t237\t237\n32\t33\t238\t238\n32\t33\t239\t239\n32\t33\t240\t240\n32\t33\t241\t241\n32\t33\t242\t242\n32\t33\t243\t243\n32\t33\t244\t244\n32\t33\t245\t245\n32\t33\t246\t246\n32\t33\t247\t247\n32\t33\t248\t248\n32\t33\t249\t249\n32\t33\t250\t250\n32\t33\t251\t251\n32\t33\t252\t252\n32\t33\t253\t253\n32\t33\t254\t254\n32\t33\t255\t255\n33\t34\t0\t0\n33\t34\t1\t1\n33\t34\t2\t2\n33\t34\t3\t3\n33\t34\t4\t4\n33\t34\t5\t5\n33\t34\t6\t6\n33\t34\t7\t7\n33\t34\t8\t8\n33\t34\t9\t9\n33\t34\t10\t10\n33\t34\t11\t11\n33\t34\t12\t12\n33\t34\t13\t13\n33\t34\t14\t14\n33\t34\t15\t15\n33\t34\t16\t16\n33\t34\t17\t17\n33\t34\t18\t18\n33\t34\t19\t19\n33\t34\t20\t20\n33\t34\t21\t21\n33\t34\t22\t22\n33\t34\t23\t23\n33\t34\t24\t24\n33\t34\t25\t25\n33\t34\t26\t26\n33\t34\t27\t27\n33\t34\t28\t28\n33\t34\t29\t29\n33\t34\t30\t30\n33\t34\t31\t31\n33\t34\t32\t32\n33\t34\t33\t33\n33\t34\t34\t34\n33\t34\t35\t35\n33\t34\t36\t36\n33\t34\t37\t37\n33\t34\t38\t38\n33\t34\t39\t39\n33\t34\t40\t40\n33\t34\t41\t41\n33\t34\t42\t42\n33\t34\t43\t43\n33\t34\t44\t44\n33\t34\t45\t45\n33\t34\t46\t46\n33\t34\t47\t47\n33\t34\t48\t48\n33\t34\t49\t49\n33\t34\t50\t50\n33\t34\t51\t51\n33\t34\t52\t52\n33\t34\t53\t53\n33\t34\t54\t54\n33\t34\t55\t55\n33\t34\t56\t56\n33\t34\t57\t57\n33\t34\t58\t58\n33\t34\t59\t59\n33\t34\t60\t60\n33\t34\t61\t61\n33\t34\t62\t62\n33\t34\t63\t63\n33\t34\t64\t64\n33\t34\t65\t65\n33\t34\t66\t66\n33\t34\t67\t67\n33\t34\t68\t68\n33\t34\t69\t69\n33\t34\t70\t70\n33\t34\t71\t71\n33\t34\t72\t72\n33\t34\t73\t73\n33\t34\t74\t74\n33\t34\t75\t75\n33\t34\t76\t76\n33\t34\t77\t77\n33\t34\t78\t78\n33\t34\t79\t79\n33\t34\t80\t80\n33\t34\t81\t81\n33\t34\t82\t82\n33\t34\t83\t83\n33\t34\t84\t84\n33\t34\t85\t85\n33\t34\t86\t86\n33\t34\t87\t87\n33\t34\t88\t88\n33\t34\t89\t89\n33\t34\t90\t90\n33\t34\t91\t91\n33\t34\t92\t92\n33\t34\t93\t93\n33\t34\t94\t94\n33\t34\t95\t95\n33\t34\t96\t96\n33\t34\t97\t97\n33\t34\t98\t98\n33\t34\t99\t99\n33\t34\t100\t100\n33\t34\t101\t101\n33\t34\t102\t102\n33\t34\t103\t103\n33\t34\t104\t104\n33\t34\t105\t105\n33\t34\t106\t106\n33\t34\t107\t107\n33\t34\t108\t108\n33\t34\t109\t109\n33\t34\t110\t110\n33\t34\t111\t111\n33\t34\t112\t112\n33\t34\t113\t113\n33\t34\t114\t114\n33\t34\t115\t115\n33\t34\t116\t116\n33\t34\t117\t117\n33\t34\t118\t118\n33\t34\t119\t119\n33\t34\t120\t120\n33\t34\t121\t121\n33\t34\t122\t122\n33\t34\t123\t123\n33\t34\t124\t124\n33\t34\t125\t125\n33\t34\t126\t126\n33\t34\t127\t127\n33\t34\t128\t128\n33\t34\t129\t129\n33\t34\t130\t130\n33\t34\t131\t131\n33\t34\t132\t132\n33\t34\t133\t133\n33\t34\t134\t134\n33\t34\t135\t135\n33\t34\t136\t136\n33\t34\t137\t137\n33\t34\t138\t138\n33\t34\t139\t139\n33\t34\t140\t140\n33\t34\t141\t141\n33\t34\t142\t142\n33\t34\t143\t143\n33\t34\t144\t144\n33\t34\t145\t145\n33\t34\t146\t146\n33\t34\t147\t147\n33\t34\t148\t148\n33\t34\t149\t149\n33\t34\t150\t150\n33\t34\t151\t151\n33\t34\t152\t152\n33\t34\t153\t153\n33\t34\t154\t154\n33\t34\t155\t155\n33\t34\t156\t156\n33\t34\t157\t157\n33\t34\t158\t158\n33\t34\t159\t159\n33\t34\t160\t160\n33\t34\t161\t161\n33\t34\t162\t162\n33\t34\t163\t163\n33\t34\t164\t164\n33\t34\t165\t165\n33\t34\t166\t166\n33\t34\t167\t167\n33\t34\t168\t168\n33\t34\t169\t169\n33\t34\t170\t170\n33\t34\t171\t171\n33\t34\t172\t172\n33\t34\t173\t173\n33\t34\t174\t174\n33\t34\t175\t175\n33\t34\t176\t176\n33\t34\t177\t177\n33\t34\t178\t178\n33\t34\t179\t179\n33\t34\t180\t180\n33\t34\t181\t181\n33\t34\t182\t182\n33\t34\t183\t183\n33\t34\t184\t184\n33\t34\t185\t185\n33\t34\t186\t186\n33\t34\t187\t187\n33\t34\t188\t188\n33\t34\t189\t189\n33\t34\t190\t190\n33\t34\t191\t191\n33\t34\t192\t192\n33\t34\t193\t193\n33\t34\t194\t194\n33\t34\t195\t195\n33\t34\t196\t196\n33\t34\t197\t197\n33\t34\t198\t198\n33\t34\t199\t199\n33\t34\t200\t200\n33\t34\t201\t201\n33\t34\t202\t202\n33\t34\t203\t203\n33\t34\t204\t204\n33\t34\t205\t205\n33\t34\t206\t206\n33\t34\t207\t207\n33\t34\t208\t208\n33\t34\t209\t209\n33\t34\t210\t210\n33\t34\t211\t211\n33\t34\t212\t212\n33\t34\t213\t213\n33\t34\t214\t214\n33\t34\t215\t215\n33\t34\t216\t216\n33\t34\t217\t217\n33\t34\t218\t218\n33\t34\t219\t219\n33\t34\t220\t220\n33\t34\t221\t221\n33\t34\t222\t222\n33\t34\t223\t223\n33\t34\t224\t224\n33\t34\t225\t225\n33\t34\t226\t226\n33\t34\t227\t227\n33\t34\t228\t228\n33\t34\t229\t229\n33\t34\t230\t230\n33\t34\t231\t231\n33\t34\t232\t232\n33\t34\t233\t233\n33\t34\t234\t234\n33\t34\t235\t235\n33\t34\t236\t236\n33\t34\t237\t237\n33\t34\t238\t238\n33\t34\t239\t239\n33\t34\t240\t240\n33\t34\t241\t241\n33\t34\t242\t242\n33\t34\t243\t243\n33\t34\t244\t244\n33\t34\t245\t245\n33\t34\t246\t246\n33\t34\t247\t247\n33\t34\t248\t248\n33\t34\t249\t249\n33\t34\t250\t250\n33\t34\t251\t251\n33\t34\t252\t252\n33\t34\t253\t253\n33\t34\t254\t254\n33\t34\t255\t255\n34\t35\t0\t0\n34\t35\t1\t1\n34\t35\t2\t2\n34\t35\t3\t3\n34\t35\t4\t4\n34\t35\t5\t5\n34\t35\t6\t6\n34\t35\t7\t7\n34\t35\t8\t8\n34\t35\t9\t9\n34\t35\t10\t10\n34\t35\t11\t11\n34\t35\t12\t12\n34\t35\t13\t13\n34\t35\t14\t14\n34\t35\t15\t15\n34\t35\t16\t16\n34\t35\t17\t17\n34\t35\t18\t18\n34\t35\t19\t19\n34\t35\t20\t20\n34\t35\t21\t21\n34\t35\t22\t22\n34\t35\t23\t23\n34\t35\t24\t24\n34\t35\t25\t25\n34\t35\t26\t26\n34\t35\t27\t27\n34\t35\t28\t28\n34\t35\t29\t29\n34\t35\t30\t30\n34\t35\t31\t31\n34\t35\t32\t32\n34\t35\t33\t33\n34\t35\t34\t34\n34\t35\t35\t35\n34\t35\t36\t36\n34\t35\t37\t37\n34\t35\t38\t38\n34\t35\t39\t39\n34\t35\t40\t40\n34\t35\t41\t41\n34\t35\t42\t42\n34\t35\t43\t43\n34\t35\t44\t44\n34\t35\t45\t45\n34\t35\t46\t46\n34\t35\t47\t47\n34\t35\t48\t48\n34\t35\t49\t49\n34\t35\t50\t50\n34\t35\t51\t51\n34\t35\t52\t52\n34\t35\t53\t53\n34\t35\t54\t54\n34\t35\t55\t55\n34\t35\t56\t56\n34\t35\t57\t57\n34\t35\t58\t58\n34\t35\t59\t59\n34\t35\t60\t60\n34\t35\t61\t61\n34\t35\t62\t62\n34\t35\t63\t63\n34\t35\t64\t64\n34\t35\t65\t65\n34\t35\t66\t66\n34\t35\t67\t67\n34\t35\t68\t68\n34\t35\t69\t69\n34\t35\t70\t70\n34\t35\t71\t71\n34\t35\t72\t72\n34\t35\t73\t73\n34\t35\t74\t74\n34\t35\t75\t75\n34\t35\t76\t76\n34\t35\t77\t77\n34\t35\t78\t78\n34\t35\t79\t79\n34\t35\t80\t80\n34\t35\t81\t81\n34\t35\t82\t82\n34\t35\t83\t83\n34\t35\t84\t84\n34\t35\t85\t85\n34\t35\t86\t86\n34\t35\t87\t87\n34\t35\t88\t88\n34\t35\t89\t89\n34\t35\t90\t90\n34\t35\t91\t91\n34\t35\t92\t92\n34\t35\t93\t93\n34\t35\t94\t94\n34\t35\t95\t95\n34\t35\t96\t96\n34\t35\t97\t97\n34\t35\t98\t98\n34\t35\t99\t99\n34\t35\t100\t100\n34\t35\t101\t101\n34\t35\t102\t102\n34\t35\t103\t103\n34\t35\t104\t104\n34\t35\t105\t105\n34\t35\t106\t106\n34\t35\t107\t107\n34\t35\t108\t108\n34\t35\t109\t109\n34\t35\t110\t110\n34\t35\t111\t111\n34\t35\t112\t112\n34\t35\t113\t113\n34\t35\t114\t114\n34\t35\t115\t115\n34\t35\t116\t116\n34\t35\t117\t117\n34\t35\t118\t118\n34\t35\t119\t119\n34\t35\t120\t120\n34\t35\t121\t121\n34\t35\t122\t122\n34\t35\t123\t123\n34\t35\t124\t124\n34\t35\t125\t125\n34\t35\t126\t126\n34\t35\t127\t127\n34\t35\t128\t128\n34\t35\t129\t129\n34\t35\t130\t130\n34\t35\t131\t131\n34\t35\t132\t132\n34\t35\t133\t133\n34\t35\t134\t134\n34\t35\t135\t135\n34\t35\t136\t136\n34\t35\t137\t137\n34\t35\t138\t138\n34\t35\t139\t139\n34\t35\t140\t140\n34\t35\t141\t141\n34\t35\t142\t142\n34\t35\t143\t143\n34\t35\t144\t144\n34\t35\t145\t145\n34\t35\t146\t146\n34\t35\t147\t147\n34\t35\t148\t148\n34\t35\t149\t149\n34\t35\t150\t150\n34\t35\t151\t151\n34\t35\t152\t152\n34\t35\t153\t153\n34\t35\t154\t154\n34\t35\t155\t155\n34\t35\t156\t156\n34\t35\t157\t157\n34\t35\t158\t158\n34\t35\t159\t159\n34\t35\t160\t160\n34\t35\t161\t161\n34\t35\t162\t162\n34\t35\t163\t163\n34\t35\t164\t164\n34\t35\t165\t165\n34\t35\t166\t166\n34\t35\t167\t167\n34\t35\t168\t168\n34\t35\t169\t169\n34\t35\t170\t170\n34\t35\t171\t171\n34\t35\t172\t172\n34\t35\t173\t173\n34\t35\t174\t174\n34\t35\t175\t175\n34\t35\t176\t176\n34\t35\t177\t177\n34\t35\t178\t178\n34\t35\t179\t179\n34\t35\t180\t180\n34\t35\t181\t181\n34\t35\t182\t182\n34\t35\t183\t183\n34\t35\t184\t184\n34\t35\t185\t185\n34\t35\t186\t186\n34\t35\t187\t187\n34\t35\t188\t188\n34\t35\t189\t189\n34\t35\t190\t190\n34\t35\t191\t191\n34\t35\t192\t192\n34\t35\t193\t193\n34\t35\t194\t194\n34\t35\t195\t195\n34\t35\t196\t196\n34\t35\t197\t197\n34\t35\t198\t198\n34\t35\t199\t199\n34\t35\t200\t200\n34\t35\t201\t201\n34\t35\t202\t202\n34\t35\t203\t203\n34\t35\t204\t204\n34\t35\t205\t205\n34\t35\t206\t206\n34\t35\t207\t207\n34\t35\t208\t208\n34\t35\t209\t209\n34\t35\t210\t210\n34\t35\t211\t211\n34\t35\t212\t212\n34\t35\t213\t213\n34\t35\t214\t214\n34\t35\t215\t215\n34\t35\t216\t216\n34\t35\t217\t217\n34\t35\t218\t218\n34\t35\t219\t219\n34\t35\t220\t220\n34\t35\t221\t221\n34\t35\t222\t222\n34\t35\t223\t223\n34\t35\t224\t224\n34\t35\t225\t225\n34\t35\t226\t226\n34\t35\t227\t227\n34\t35\t228\t228\n34\t35\t229\t229\n34\t35\t230\t230\n34\t35\t231\t231\n34\t35\t232\t232\n34\t35\t233\t233\n34\t35\t234\t234\n34\t35\t235\t235\n34\t35\t236\t236\n34\t35\t237\t237\n34\t35\t238\t238\n34\t35\t239\t239\n34\t35\t240\t240\n34\t35\t241\t241\n34\t35\t242\t242\n34\t35\t243\t243\n34\t35\t244\t244\n34\t35\t245\t245\n34\t35\t246\t246\n34\t35\t247\t247\n34\t35\t248\t248\n34\t35\t249\t249\n34\t35\t250\t250\n34\t35\t251\t251\n34\t35\t252\t252\n34\t35\t253\t253\n34\t35\t254\t254\n34\t35\t255\t255\n35\t36\t0\t0\n35\t36\t1\t1\n35\t36\t2\t2\n35\t36\t3\t3\n35\t36\t4\t4\n35\t36\t5\t5\n35\t36\t6\t6\n35\t36\t7\t7\n35\t36\t8\t8\n35\t36\t9\t9\n35\t36\t10\t10\n35\t36\t11\t11\n35\t36\t12\t12\n35\t36\t13\t13\n35\t36\t14\t14\n35\t36\t15\t15\n35\t36\t16\t16\n35\t36\t17\t17\n35\t36\t18\t18\n35\t36\t19\t19\n35\t36\t20\t20\n35\t36\t21\t21\n35\t36\t22\t22\n35\t36\t23\t23\n35\t36\t24\t24\n35\t36\t25\t25\n35\t36\t26\t26\n35\t36\t27\t27\n35\t36\t28\t28\n35\t36\t29\t29\n35\t36\t30\t30\n35\t36\t31\t31\n35\t36\t32\t32\n35\t36\t33\t33\n35\t36\t34\t34\n35\t36\t35\t35\n35\t36\t36\t36\n35\t36\t37\t37\n35\t36\t38\t38\n35\t36\t39\t39\n35\t36\t40\t40\n35\t36\t41\t41\n35\t36\t42\t42\n35\t36\t43\t43\n35\t36\t44\t44\n35\t36\t45\t45\n35\t36\t46\t46\n35\t36\t47\t47\n35\t36\t48\t48\n35\t36\t49\t49\n35\t36\t50\t50\n35\t36\t51\t51\n35\t36\t52\t52\n35\t36\t53\t53\n35\t36\t54\t54\n35\t36\t55\t55\n35\t36\t56\t56\n35\t36\t57\t57\n35\t36\t58\t58\n35\t36\t59\t59\n35\t36\t60\t60\n35\t36\t61\t61\n35\t36\t62\t62\n35\t36\t63\t63\n35\t36\t64\t64\n35\t36\t65\t65\n35\t36\t66\t66\n35\t36\t67\t67\n35\t36\t68\t68\n35\t36\t69\t69\n35\t36\t70\t70\n35\t36\t71\t71\n35\t36\t72\t72\n35\t36\t73\t73\n35\t36\t74\t74\n35\t36\t75\t75\n35\t36\t76\t76\n35\t36\t77\t77\n35\t36\t78\t78\n35\t36\t79\t79\n35\t36\t80\t80\n35\t36\t81\t81\n35\t36\t82\t82\n35\t36\t83\t83\n35\t36\t84\t84\n35\t36\t85\t85\n35\t36\t86\t86\n35\t36\t87\t87\n35\t36\t88\t88\n35\t36\t89\t89\n35\t36\t90\t90\n35\t36\t91\t91\n35\t36\t92\t92\n35\t36\t93\t93\n35\t36\t94\t94\n35\t36\t95\t95\n35\t36\t96\t96\n35\t36\t97\t97\n35\t36\t98\t98\n35\t36\t99\t99\n35\t36\t100\t100\n35\t36\t101\t101\n35\t36\t102\t102\n35\t36\t103\t103\n35\t36\t104\t104\n35\t36\t105\t105\n35\t36\t106\t106\n35\t36\t107\t107\n35\t36\t108\t108\n35\t36\t109\t109\n35\t36\t110\t110\n35\t36\t111\t111\n35\t36\t112\t112\n35\t36\t113\t113\n35\t36\t114\t114\n35\t36\t115\t115\n35\t36\t116\t116\n35\t36\t117\t117\n35\t36\t118\t118\n35\t36\t119\t119\n35\t36\t120\t120\n35\t36\t121\t121\n35\t36\t122\t122\n35\t36\t123\t123\n35\t36\t124\t124\n35\t36\t125\t125\n35\t36\t126\t126\n35\t36\t127\t127\n35\t36\t128\t128\n35\t36\t129\t129\n35\t36\t130\t130\n35\t36\t131\t131\n35\t36\t132\t132\n35\t36\t133\t133\n35\t36\t134\t134\n35\t36\t135\t135\n35\t36\t136\t136\n35\t36\t137\t137\n35\t36\t138\t138\n35\t36\t139\t139\n35\t36\t140\t140\n35\t36\t141\t141\n35\t36\t142\t142\n35\t36\t143\t143\n35\t36\t144\t144\n35\t36\t145\t145\n35\t36\t146\t146\n35\t36\t147\t147\n35\t36\t148\t148\n35\t36\t149\t149\n35\t36\t150\t150\n35\t36\t151\t151\n35\t36\t152\t152\n35\t36\t153\t153\n35\t36\t154\t154\n35\t36\t155\t155\n35\t36\t156\t156\n35\t36\t157\t157\n35\t36\t158\t158\n35\t36\t159\t159\n35\t36\t160\t160\n35\t36\t161\t161\n35\t36\t162\t162\n35\t36\t163\t163\n35\t36\t164\t164\n35\t36\t165\t165\n35\t36\t166\t166\n35\t36\t167\t167\n35\t36\t168\t168\n35\t36\t169\t169\n35\t36\t170\t170\n35\t36\t171\t171\n35\t36\t172\t172\n35\t36\t173\t173\n35\t36\t174\t174\n35\t36\t175\t175\n35\t36\t176\t176\n35\t36\t177\t177\n35\t36\t178\t178\n35\t36\t179\t179\n35\t36\t180\t180\n35\t36\t181\t181\n35\t36\t182\t182\n35\t36\t183\t183\n35\t36\t184\t184\n35\t36\t185\t185\n35\t36\t186\t186\n35\t36\t187\t187\n35\t36\t188\t188\n35\t36\t189\t189\n35\t36\t190\t190\n35\t36\t191\t191\n35\t36\t192\t192\n35\t36\t193\t193\n35\t36\t194\t194\n35\t36\t195\t195\n35\t36\t196\t196\n35\t36\t197\t197\n35\t36\t198\t198\n35\t36\t199\t199\n35\t36\t200\t200\n35\t36\t201\t201\n35\t36\t202\t202\n35\t36\t203\t203\n35\t36\t204\t204\n35\t36\t205\t205\n35\t36\t206\t206\n35\t36\t207\t207\n35\t36\t208\t208\n35\t36\t209\t209\n35\t36\t210\t210\n35\t36\t211\t211\n35\t36\t212\t212\n35\t36\t213\t213\n35\t36\t214\t214\n35\t36\t215\t215\n35\t36\t216\t216\n35\t36\t217\t217\n35\t36\t218\t218\n35\t36\t219\t219\n35\t36\t220\t220\n35\t36\t221\t221\n35\t36\t222\t222\n35\t36\t223\t223\n35\t36\t224\t224\n35\t36\t225\t225\n35\t36\t226\t226\n35\t36\t227\t227\n35\t36\t228\t228\n35\t36\t229\t229\n35\t36\t230\t230\n35\t36\t231\t231\n35\t36\t232\t232\n35\t36\t233\t233\n35\t36\t234\t234\n35\t36\t235\t235\n35\t36\t236\t236\n35\t36\t237\t237\n35\t36\t238\t238\n35\t36\t239\t239\n35\t36\t240\t240\n35\t36\t241\t241\n35\t36\t242\t242\n35\t36\t243\t243\n35\t36\t244\t244\n35\t36\t245\t245\n35\t36\t246\t246\n35\t36\t247\t247\n35\t36\t248\t248\n35\t36\t249\t249\n35\t36\t250\t250\n35\t36\t251\t251\n35\t36\t252\t252\n35\t36\t253\t253\n35\t36\t254\t254\n35\t36\t255\t255\n36\t37\t0\t0\n36\t37\t1\t1\n36\t37\t2\t2\n36\t37\t3\t3\n36\t37\t4\t4\n36\t37\t5\t5\n36\t37\t6\t6\n36\t37\t7\t7\n36\t37\t8\t8\n36\t37\t9\t9\n36\t37\t10\t10\n36\t37\t11\t11\n36\t37\t12\t12\n36\t37\t13\t13\n36\t37\t14\t14\n36\t37\t15\t15\n36\t37\t16\t16\n36\t37\t17\t17\n36\t37\t18\t18\n36\t37\t19\t19\n36\t37\t20\t20\n36\t37\t21\t21\n36\t37\t22\t22\n36\t37\t23\t23\n36\t37\t24\t24\n36\t37\t25\t25\n36\t37\t26\t26\n36\t37\t27\t27\n36\t37\t28\t28\n36\t37\t29\t29\n36\t37\t30\t30\n36\t37\t31\t31\n36\t37\t32\t32\n36\t37\t33\t33\n36\t37\t34\t34\n36\t37\t35\t35\n36\t37\t36\t36\n36\t37\t37\t37\n36\t37\t38\t38\n36\t37\t39\t39\n36\t37\t40\t40\n36\t37\t41\t41\n36\t37\t42\t42\n36\t37\t43\t43\n36\t37\t44\t44\n36\t37\t45\t45\n36\t37\t46\t46\n36\t37\t47\t47\n36\t37\t48\t48\n36\t37\t49\t49\n36\t37\t50\t50\n36\t37\t51\t51\n36\t37\t52\t52\n36\t37\t53\t53\n36\t37\t54\t54\n36\t37\t55\t55\n36\t37\t56\t56\n36\t37\t57\t57\n36\t37\t58\t58\n36\t37\t59\t59\n36\t37\t60\t60\n36\t37\t61\t61\n36\t37\t62\t62\n36\t37\t63\t63\n36\t37\t64\t64\n36\t37\t65\t65\n36\t37\t66\t66\n36\t37\t67\t67\n36\t37\t68\t68\n36\t37\t69\t69\n36\t37\t70\t70\n36\t37\t71\t71\n36\t37\t72\t72\n36\t37\t73\t73\n36\t37\t74\t74\n36\t37\t75\t75\n36\t37\t76\t76\n36\t37\t77\t77\n36\t37\t78\t78\n36\t37\t79\t79\n36\t37\t80\t80\n36\t37\t81\t81\n36\t37\t82\t82\n36\t37\t83\t83\n36\t37\t84\t84\n36\t37\t85\t85\n36\t37\t86\t86\n36\t37\t87\t87\n36\t37\t88\t88\n36\t37\t89\t89\n36\t37\t90\t90\n36\t37\t91\t91\n36\t37\t92\t92\n36\t37\t93\t93\n36\t37\t94\t94\n36\t37\t95\t95\n36\t37\t96\t96\n36\t37\t97\t97\n36\t37\t98\t98\n36\t37\t99\t99\n36\t37\t100\t100\n36\t37\t101\t101\n36\t37\t102\t102\n36\t37\t103\t103\n36\t37\t104\t104\n36\t37\t105\t105\n36\t37\t106\t106\n36\t37\t107\t107\n36\t37\t108\t108\n36\t37\t109\t109\n36\t37\t110\t110\n36\t37\t111\t111\n36\t37\t112\t112\n36\t37\t113\t113\n36\t37\t114\t114\n36\t37\t115\t115\n36\t37\t116\t116\n36\t37\t117\t117\n36\t37\t118\t118\n36\t37\t119\t119\n36\t37\t120\t120\n36\t37\t121\t121\n36\t37\t122\t122\n36\t37\t123\t123\n36\t37\t124\t124\n36\t37\t125\t125\n36\t37\t126\t126\n36\t37\t127\t127\n36\t37\t128\t128\n36\t37\t129\t129\n36\t37\t130\t130\n36\t37\t131\t131\n36\t37\t132\t132\n36\t37\t133\t133\n36\t37\t134\t134\n36\t37\t135\t135\n36\t37\t136\t136\n36\t37\t137\t137\n36\t37\t138\t138\n36\t37\t139\t139\n36\t37\t140\t140\n36\t37\t141\t141\n36\t37\t142\t142\n36\t37\t143\t143\n36\t37\t144\t144\n36\t37\t145\t145\n36\t37\t146\t146\n36\t37\t147\t147\n36\t37\t148\t148\n36\t37\t149\t149\n36\t37\t150\t150\n36\t37\t151\t151\n36\t37\t152\t152\n36\t37\t153\t153\n36\t37\t154\t154\n36\t37\t155\t155\n36\t37\t156\t156\n36\t37\t157\t157\n36\t37\t158\t158\n36\t37\t159\t159\n36\t37\t160\t160\n36\t37\t161\t161\n36\t37\t162\t162\n36\t37\t163\t163\n36\t37\t164\t164\n36\t37\t165\t165\n36\t37\t166\t166\n36\t37\t167\t167\n36\t37\t168\t168\n36\t37\t169\t169\n36\t37\t170\t170\n36\t37\t171\t171\n36\t37\t172\t172\n36\t37\t173\t173\n36\t37\t174\t174\n36\t37\t175\t175\n36\t37\t176\t176\n36\t37\t177\t177\n36\t37\t178\t178\n36\t37\t179\t179\n36\t37\t180\t180\n36\t37\t181\t181\n36\t37\t182\t182\n36\t37\t183\t183\n36\t37\t184\t184\n36\t37\t185\t185\n36\t37\t186\t186\n36\t37\t187\t187\n36\t37\t188\t188\n36\t37\t189\t189\n36\t37\t190\t190\n36\t37\t191\t191\n36\t37\t192\t192\n36\t37\t193\t193\n36\t37\t194\t194\n36\t37\t195\t195\n36\t37\t196\t196\n36\t37\t197\t197\n36\t37\t198\t198\n36\t37\t199\t199\n36\t37\t200\t200\n36\t37\t201\t201\n36\t37\t202\t202\n36\t37\t203\t203\n36\t37\t204\t204\n36\t37\t205\t205\n36\t37\t206\t206\n36\t37\t207\t207\n36\t37\t208\t208\n36\t37\t209\t209\n36\t37\t210\t210\n36\t37\t211\t211\n36\t37\t212\t212\n36\t37\t213\t213\n36\t37\t214\t214\n36\t37\t215\t215\n36\t37\t216\t216\n36\t37\t217\t217\n36\t37\t218\t218\n36\t37\t219\t219\n36\t37\t220\t220\n36\t37\t221\t221\n36\t37\t222\t222\n36\t37\t223\t223\n36\t37\t224\t224\n36\t37\t225\t225\n36\t37\t226\t226\n36\t37\t227\t227\n36\t37\t228\t228\n36\t37\t229\t229\n36\t37\t230\t230\n36\t37\t231\t231\n36\t37\t232\t232\n36\t37\t233\t233\n36\t37\t234\t234\n36\t37\t235\t235\n36\t37\t236\t236\n36\t37\t237\t237\n36\t37\t238\t238\n36\t37\t239\t239\n36\t37\t240\t240\n36\t37\t241\t241\n36\t37\t242\t242\n36\t37\t243\t243\n36\t37\t244\t244\n36\t37\t245\t245\n36\t37\t246\t246\n36\t37\t247\t247\n36\t37\t248\t248\n36\t37\t249\t249\n36\t37\t250\t250\n36\t37\t251\t251\n36\t37\t252\t252\n36\t37\t253\t253\n36\t37\t254\t254\n36\t37\t255\t255\n37\t38\t0\t0\n37\t38\t1\t1\n37\t38\t2\t2\n37\t38\t3\t3\n37\t38\t4\t4\n37\t38\t5\t5\n37\t38\t6\t6\n37\t38\t7\t7\n37\t38\t8\t8\n37\t38\t9\t9\n37\t38\t10\t10\n37\t38\t11\t11\n37\t38\t12\t12\n37\t38\t13\t13\n37\t38\t14\t14\n37\t38\t15\t15\n37\t38\t16\t16\n37\t38\t17\t17\n37\t38\t18\t18\n37\t38\t19\t19\n37\t38\t20\t20\n37\t38\t21\t21\n37\t38\t22\t22\n37\t38\t23\t23\n37\t38\t24\t24\n37\t38\t25\t25\n37\t38\t26\t26\n37\t38\t27\t27\n37\t38\t28\t28\n37\t38\t29\t29\n37\t38\t30\t30\n37\t38\t31\t31\n37\t38\t32\t32\n37\t38\t33\t33\n37\t38\t34\t34\n37\t38\t35\t35\n37\t38\t36\t36\n37\t38\t37\t37\n37\t38\t38\t38\n37\t38\t39\t39\n37\t38\t40\t40\n37\t38\t41\t41\n37\t38\t42\t42\n37\t38\t43\t43\n37\t38\t44\t44\n37\t38\t45\t45\n37\t38\t46\t46\n37\t38\t47\t47\n37\t38\t48\t48\n37\t38\t49\t49\n37\t38\t50\t50\n37\t38\t51\t51\n37\t38\t52\t52\n37\t38\t53\t53\n37\t38\t54\t54\n37\t38\t55\t55\n37\t38\t56\t56\n37\t38\t57\t57\n37\t38\t58\t58\n37\t38\t59\t59\n37\t38\t60\t60\n37\t38\t61\t61\n37\t38\t62\t62\n37\t38\t63\t63\n37\t38\t64\t64\n37\t38\t65\t65\n37\t38\t66\t66\n37\t38\t67\t67\n37\t38\t68\t68\n37\t38\t69\t69\n37\t38\t70\t70\n37\t38\t71\t71\n37\t38\t72\t72\n37\t38\t73\t73\n37\t38\t74\t74\n37\t38\t75\t75\n37\t38\t76\t76\n37\t38\t77\t77\n37\t38\t78\t78\n37\t38\t79\t79\n37\t38\t80\t80\n37\t38\t81\t81\n37\t38\t82\t82\n37\t38\t83\t83\n37\t38\t84\t84\n37\t38\t85\t85\n37\t38\t86\t86\n37\t38\t87\t87\n37\t38\t88\t88\n37\t38\t89\t89\n37\t38\t90\t90\n37\t38\t91\t91\n37\t38\t92\t92\n37\t38\t93\t93\n37\t38\t94\t94\n37\t38\t95\t95\n37\t38\t96\t96\n37\t38\t97\t97\n37\t38\t98\t98\n37\t38\t99\t99\n37\t38\t100\t100\n37\t38\t101\t101\n37\t38\t102\t102\n37\t38\t103\t103\n37\t38\t104\t104\n37\t38\t105\t105\n37\t38\t106\t106\n37\t38\t107\t107\n37\t38\t108\t108\n37\t38\t109\t109\n37\t38\t110\t110\n37\t38\t111\t111\n37\t38\t112\t112\n37\t38\t113\t113\n37\t38\t114\t114\n37\t38\t115\t115\n37\t38\t116\t116\n37\t38\t117\t117\n37\t38\t118\t118\n37\t38\t119\t119\n37\t38\t120\t120\n37\t38\t121\t121\n37\t38\t122\t122\n37\t38\t123\t123\n37\t38\t124\t124\n37\t38\t125\t125\n37\t38\t126\t126\n37\t38\t127\t127\n37\t38\t128\t128\n37\t38\t129\t129\n37\t38\t130\t130\n37\t38\t131\t131\n37\t38\t132\t132\n37\t38\t133\t133\n37\t38\t134\t134\n37\t38\t135\t135\n37\t38\t136\t136\n37\t38\t137\t137\n37\t38\t138\t138\n37\t38\t139\t139\n37\t38\t140\t140\n37\t38\t141\t141\n37\t38\t142\t142\n37\t38\t143\t143\n37\t38\t144\t144\n37\t38\t145\t145\n37\t38\t146\t146\n37\t38\t147\t147\n37\t38\t148\t148\n37\t38\t149\t149\n37\t38\t150\t150\n37\t38\t151\t151\n37\t38\t152\t152\n37\t38\t153\t153\n37\t38\t154\t154\n37\t38\t155\t155\n37\t38\t156\t156\n37\t38\t157\t157\n37\t38\t158\t158\n37\t38\t159\t159\n37\t38\t160\t160\n37\t38\t161\t161\n37\t38\t162\t162\n37\t38\t163\t163\n37\t38\t164\t164\n37\t38\t165\t165\n37\t38\t166\t166\n37\t38\t167\t167\n37\t38\t168\t168\n37\t38\t169\t169\n37\t38\t170\t170\n37\t38\t171\t171\n37\t38\t172\t172\n37\t38\t173\t173\n37\t38\t174\t174\n37\t38\t175\t175\n37\t38\t176\t176\n37\t38\t177\t177\n37\t38\t178\t178\n37\t38\t179\t179\n37\t38\t180\t180\n37\t38\t181\t181\n37\t38\t182\t182\n37\t38\t183\t183\n37\t38\t184\t184\n37\t38\t185\t185\n37\t38\t186\t186\n37\t38\t187\t187\n37\t38\t188\t188\n37\t38\t189\t189\n37\t38\t190\t190\n37\t38\t191\t191\n37\t38\t192\t192\n37\t38\t193\t193\n37\t38\t194\t194\n37\t38\t195\t195\n37\t38\t196\t196\n37\t38\t197\t197\n37\t38\t198\t198\n37\t38\t199\t199\n37\t38\t200\t200\n37\t38\t201\t201\n37\t38\t202\t202\n37\t38\t203\t203\n37\t38\t204\t204\n37\t38\t205\t205\n37\t38\t206\t206\n37\t38\t207\t207\n37\t38\t208\t208\n37\t38\t209\t209\n37\t38\t210\t210\n37\t38\t211\t211\n37\t38\t212\t212\n37\t38\t213\t213\n37\t38\t214\t214\n37\t38\t215\t215\n37\t38\t216\t216\n37\t38\t217\t217\n37\t38\t218\t218\n37\t38\t219\t219\n37\t38\t220\t220\n37\t38\t221\t221\n37\t38\t222\t222\n37\t38\t223\t223\n37\t38\t224\t224\n37\t38\t225\t225\n37\t38\t226\t226\n37\t38\t227\t227\n37\t38\t228\t228\n37\t38\t229\t229\n37\t38\t230\t230\n37\t38\t231\t231\n37\t38\t232\t232\n37\t38\t233\t233\n37\t38\t234\t234\n37\t38\t235\t235\n37\t38\t236\t236\n37\t38\t237\t237\n37\t38\t238\t238\n37\t38\t239\t239\n37\t38\t240\t240\n37\t38\t241\t241\n37\t38\t242\t242\n37\t38\t243\t243\n37\t38\t244\t244\n37\t38\t245\t245\n37\t38\t246\t246\n37\t38\t247\t247\n37\t38\t248\t248\n37\t38\t249\t249\n37\t38\t250\t250\n37\t38\t251\t251\n37\t38\t252\t252\n37\t38\t253\t253\n37\t38\t254\t254\n37\t38\t255\t255\n38\t39\t0\t0\n38\t39\t1\t1\n38\t39\t2\t2\n38\t39\t3\t3\n38\t39\t4\t4\n38\t39\t5\t5\n38\t39\t6\t6\n38\t39\t7\t7\n38\t39\t8\t8\n38\t39\t9\t9\n38\t39\t10\t10\n38\t39\t11\t11\n38\t39\t12\t12\n38\t39\t13\t13\n38\t39\t14\t14\n38\t39\t15\t15\n38\t39\t16\t16\n38\t39\t17\t17\n38\t39\t18\t18\n38\t39\t19\t19\n38\t39\t20\t20\n38\t39\t21\t21\n38\t39\t22\t22\n38\t39\t23\t23\n38\t39\t24\t24\n38\t39\t25\t25\n38\t39\t26\t26\n38\t39\t27\t27\n38\t39\t28\t28\n38\t39\t29\t29\n38\t39\t30\t30\n38\t39\t31\t31\n38\t39\t32\t32\n38\t39\t33\t33\n38\t39\t34\t34\n38\t39\t35\t35\n38\t39\t36\t36\n38\t39\t37\t37\n38\t39\t38\t38\n38\t39\t39\t39\n38\t39\t40\t40\n38\t39\t41\t41\n38\t39\t42\t42\n38\t39\t43\t43\n38\t39\t44\t44\n38\t39\t45\t45\n38\t39\t46\t46\n38\t39\t47\t47\n38\t39\t48\t48\n38\t39\t49\t49\n38\t39\t50\t50\n38\t39\t51\t51\n38\t39\t52\t52\n38\t39\t53\t53\n38\t39\t54\t54\n38\t39\t55\t55\n38\t39\t56\t56\n38\t39\t57\t57\n38\t39\t58\t58\n38\t39\t59\t59\n38\t39\t60\t60\n38\t39\t61\t61\n38\t39\t62\t62\n38\t39\t63\t63\n38\t39\t64\t64\n38\t39\t65\t65\n38\t39\t66\t66\n38\t39\t67\t67\n38\t39\t68\t68\n38\t39\t69\t69\n38\t39\t70\t70\n38\t39\t71\t71\n38\t39\t72\t72\n38\t39\t73\t73\n38\t39\t74\t74\n38\t39\t75\t75\n38\t39\t76\t76\n38\t39\t77\t77\n38\t39\t78\t78\n38\t39\t79\t79\n38\t39\t80\t80\n38\t39\t81\t81\n38\t39\t82\t82\n38\t39\t83\t83\n38\t39\t84\t84\n38\t39\t85\t85\n38\t39\t86\t86\n38\t39\t87\t87\n38\t39\t88\t88\n38\t39\t89\t89\n38\t39\t90\t90\n38\t39\t91\t91\n38\t39\t92\t92\n38\t39\t93\t93\n38\t39\t94\t94\n38\t39\t95\t95\n38\t39\t96\t96\n38\t39\t97\t97\n38\t39\t98\t98\n38\t39\t99\t99\n38\t39\t100\t100\n38\t39\t101\t101\n38\t39\t102\t102\n38\t39\t103\t103\n38\t39\t104\t104\n38\t39\t105\t105\n38\t39\t106\t106\n38\t39\t107\t107\n38\t39\t108\t108\n38\t39\t109\t109\n38\t39\t110\t110\n38\t39\t111\t111\n38\t39\t112\t112\n38\t39\t113\t113\n38\t39\t114\t114\n38\t39\t115\t115\n38\t39\t116\t116\n38\t39\t117\t117\n38\t39\t118\t118\n38\t39\t119\t119\n38\t39\t120\t120\n38\t39\t121\t121\n38\t39\t122\t122\n38\t39\t123\t123\n38\t39\t124\t124\n38\t39\t125\t125\n38\t39\t126\t126\n38\t39\t127\t127\n38\t39\t128\t128\n38\t39\t129\t129\n38\t39\t130\t130\n38\t39\t131\t131\n38\t39\t132\t132\n38\t39\t133\t133\n38\t39\t134\t134\n38\t39\t135\t135\n38\t39\t136\t136\n38\t39\t137\t137\n38\t39\t138\t138\n38\t39\t139\t139\n38\t39\t140\t140\n38\t39\t141\t141\n38\t39\t142\t142\n38\t39\t143\t143\n38\t39\t144\t144\n38\t39\t145\t145\n38\t39\t146\t146\n38\t39\t147\t147\n38\t39\t148\t148\n38\t39\t149\t149\n38\t39\t150\t150\n38\t39\t151\t151\n38\t39\t152\t152\n38\t39\t153\t153\n38\t39\t154\t154\n38\t39\t155\t155\n38\t39\t156\t156\n38\t39\t157\t157\n38\t39\t158\t158\n38\t39\t159\t159\n38\t39\t160\t160\n38\t39\t161\t161\n38\t39\t162\t162\n38\t39\t163\t163\n38\t39\t164\t164\n38\t39\t165\t165\n38\t39\t166\t166\n38\t39\t167\t167\n38\t39\t168\t168\n38\t39\t169\t169\n38\t39\t170\t170\n38\t39\t171\t171\n38\t39\t172\t172\n38\t39\t173\t173\n38\t39\t174\t174\n38\t39\t175\t175\n38\t39\t176\t176\n38\t39\t177\t177\n38\t39\t178\t178\n38\t39\t179\t179\n38\t39\t180\t180\n38\t39\t181\t181\n38\t39\t182\t182\n38\t39\t183\t183\n38\t39\t184\t184\n38\t39\t185\t185\n38\t39\t186\t186\n38\t39\t187\t187\n38\t39\t188\t188\n38\t39\t189\t189\n38\t39\t190\t190\n38\t39\t191\t191\n38\t39\t192\t192\n38\t39\t193\t193\n38\t39\t194\t194\n38\t39\t195\t195\n38\t39\t196\t196\n38\t39\t197\t197\n38\t39\t198\t198\n38\t39\t199\t199\n38\t39\t200\t200\n38\t39\t201\t201\n38\t39\t202\t202\n38\t39\t203\t203\n38\t39\t204\t204\n38\t39\t205\t205\n38\t39\t206\t206\n38\t39\t207\t207\n38\t39\t208\t208\n38\t39\t209\t209\n38\t39\t210\t210\n38\t39\t211\t211\n38\t39\t212\t212\n38\t39\t213\t213\n38\t39\t214\t214\n38\t39\t215\t215\n38\t39\t216\t216\n38\t39\t217\t217\n38\t39\t218\t218\n38\t39\t219\t219\n38\t39\t220\t220\n38\t39\t221\t221\n38\t39\t222\t222\n38\t39\t223\t223\n38\t39\t224\t224\n38\t39\t225\t225\n38\t39\t226\t226\n38\t39\t227\t227\n38\t39\t228\t228\n38\t39\t229\t229\n38\t39\t230\t230\n38\t39\t231\t231\n38\t39\t232\t232\n38\t39\t233\t233\n38\t39\t234\t234\n38\t39\t235\t235\n38\t39\t236\t236\n38\t39\t237\t237\n38\t39\t238\t238\n38\t39\t239\t239\n38\t39\t240\t240\n38\t39\t241\t241\n38\t39\t242\t242\n38\t39\t243\t243\n38\t39\t244\t244\n38\t39\t245\t245\n38\t39\t246\t246\n38\t39\t247\t247\n38\t39\t248\t248\n38\t39\t249\t249\n38\t39\t250\t250\n38\t39\t251\t251\n38\t39\t252\t252\n38\t39\t253\t253\n38\t39\t254\t254\n38\t39\t255\t255\n39\t40\t0\t0\n39\t40\t1\t1\n39\t40\t2\t2\n39\t40\t3\t3\n39\t40\t4\t4\n39\t40\t5\t5\n39\t40\t6\t6\n39\t40\t7\t7\n39\t40\t8\t8\n39\t40\t9\t9\n39\t40\t10\t10\n39\t40\t11\t11\n39\t40\t12\t12\n39\t40\t13\t13\n39\t40\t14\t14\n39\t40\t15\t15\n39\t40\t16\t16\n39\t40\t17\t17\n39\t40\t18\t18\n39\t40\t19\t19\n39\t40\t20\t20\n39\t40\t21\t21\n39\t40\t22\t22\n39\t40\t23\t23\n39\t40\t24\t24\n39\t40\t25\t25\n39\t40\t26\t26\n39\t40\t27\t27\n39\t40\t28\t28\n39\t40\t29\t29\n39\t40\t30\t30\n39\t40\t31\t31\n39\t40\t32\t32\n39\t40\t33\t33\n39\t40\t34\t34\n39\t40\t35\t35\n39\t40\t36\t36\n39\t40\t37\t37\n39\t40\t38\t38\n39\t40\t39\t39\n39\t40\t40\t40\n39\t40\t41\t41\n39\t40\t42\t42\n39\t40\t43\t43\n39\t40\t44\t44\n39\t40\t45\t45\n39\t40\t46\t46\n39\t40\t47\t47\n39\t40\t48\t48\n39\t40\t49\t49\n39\t40\t50\t50\n39\t40\t51\t51\n39\t40\t52\t52\n39\t40\t53\t53\n39\t40\t54\t54\n39\t40\t55\t55\n39\t40\t56\t56\n39\t40\t57\t57\n39\t40\t58\t58\n39\t40\t59\t59\n39\t40\t60\t60\n39\t40\t61\t61\n39\t40\t62\t62\n39\t40\t63\t63\n39\t40\t64\t64\n39\t40\t65\t65\n39\t40\t66\t66\n39\t40\t67\t67\n39\t40\t68\t68\n39\t40\t69\t69\n39\t40\t70\t70\n39\t40\t71\t71\n39\t40\t72\t72\n39\t40\t73\t73\n39\t40\t74\t74\n39\t40\t75\t75\n39\t40\t76\t76\n39\t40\t77\t77\n39\t40\t78\t78\n39\t40\t79\t79\n39\t40\t80\t80\n39\t40\t81\t81\n39\t40\t82\t82\n39\t40\t83\t83\n39\t40\t84\t84\n39\t40\t85\t85\n39\t40\t86\t86\n39\t40\t87\t87\n39\t40\t88\t88\n39\t40\t89\t89\n39\t40\t90\t90\n39\t40\t91\t91\n39\t40\t92\t92\n39\t40\t93\t93\n39\t40\t94\t94\n39\t40\t95\t95\n39\t40\t96\t96\n39\t40\t97\t97\n39\t40\t98\t98\n39\t40\t99\t99\n39\t40\t100\t100\n39\t40\t101\t101\n39\t40\t102\t102\n39\t40\t103\t103\n39\t40\t104\t104\n39\t40\t105\t105\n39\t40\t106\t106\n39\t40\t107\t107\n39\t40\t108\t108\n39\t40\t109\t109\n39\t40\t110\t110\n39\t40\t111\t111\n39\t40\t112\t112\n39\t40\t113\t113\n39\t40\t114\t114\n39\t40\t115\t115\n39\t40\t116\t116\n39\t40\t117\t117\n39\t40\t118\t118\n39\t40\t119\t119\n39\t40\t120\t120\n39\t40\t121\t121\n39\t40\t122\t122\n39\t40\t123\t123\n39\t40\t124\t124\n39\t40\t125\t125\n39\t40\t126\t126\n39\t40\t127\t127\n39\t40\t128\t128\n39\t40\t129\t129\n39\t40\t130\t130\n39\t40\t131\t131\n39\t40\t132\t132\n39\t40\t133\t133\n39\t40\t134\t134\n39\t40\t135\t135\n39\t40\t136\t136\n39\t40\t137\t137\n39\t40\t138\t138\n39\t40\t139\t139\n39\t40\t140\t140\n39\t40\t141\t141\n39\t40\t142\t142\n39\t40\t143\t143\n39\t40\t144\t144\n39\t40\t145\t145\n39\t40\t146\t146\n39\t40\t147\t147\n39\t40\t148\t148\n39\t40\t149\t149\n39\t40\t150\t150\n39\t40\t151\t151\n39\t40\t152\t152\n39\t40\t153\t153\n39\t40\t154\t154\n39\t40\t155\t155\n39\t40\t156\t156\n39\t40\t157\t157\n39\t40\t158\t158\n39\t40\t159\t159\n39\t40\t160\t160\n39\t40\t161\t161\n39\t40\t162\t162\n39\t40\t163\t163\n39\t40\t164\t164\n39\t40\t165\t165\n39\t40\t166\t166\n39\t40\t167\t167\n39\t40\t168\t168\n39\t40\t169\t169\n39\t40\t170\t170\n39\t40\t171\t171\n39\t40\t172\t172\n39\t40\t173\t173\n39\t40\t174\t174\n39\t40\t175\t175\n39\t40\t176\t176\n39\t40\t177\t177\n39\t40\t178\t178\n39\t40\t179\t179\n39\t40\t180\t180\n39\t40\t181\t181\n39\t40\t182\t182\n39\t40\t183\t183\n39\t40\t184\t184\n39\t40\t185\t185\n39\t40\t186\t186\n39\t40\t187\t187\n39\t40\t188\t188\n39\t40\t189\t189\n39\t40\t190\t190\n39\t40\t191\t191\n39\t40\t192\t192\n39\t40\t193\t193\n39\t40\t194\t194\n39\t40\t195\t195\n39\t40\t196\t196\n39\t40\t197\t197\n39\t40\t198\t198\n39\t40\t199\t199\n39\t40\t200\t200\n39\t40\t201\t201\n39\t40\t202\t202\n39\t40\t203\t203\n39\t40\t204\t204\n39\t40\t205\t205\n39\t40\t206\t206\n39\t40\t207\t207\n39\t40\t208\t208\n39\t40\t209\t209\n39\t40\t210\t210\n39\t40\t211\t211\n39\t40\t212\t212\n39\t40\t213\t213\n39\t40\t214\t214\n39\t40\t215\t215\n39\t40\t216\t216\n39\t40\t217\t217\n39\t40\t218\t218\n39\t40\t219\t219\n39\t40\t220\t220\n39\t40\t221\t221\n39\t40\t222\t222\n39\t40\t223\t223\n39\t40\t224\t224\n39\t40\t225\t225\n39\t40\t226\t226\n39\t40\t227\t227\n39\t40\t228\t228\n39\t40\t229\t229\n39\t40\t230\t230\n39\t40\t231\t231\n39\t40\t232\t232\n39\t40\t233\t233\n39\t40\t234\t234\n39\t40\t235\t235\n39\t40\t236\t236\n39\t40\t237\t237\n39\t40\t238\t238\n39\t40\t239\t239\n39\t40\t240\t240\n39\t40\t241\t241\n39\t40\t242\t242\n39\t40\t243\t243\n39\t40\t244\t244\n39\t40\t245\t245\n39\t40\t246\t246\n39\t40\t247\t247\n39\t40\t248\t248\n39\t40\t249\t249\n39\t40\t250\t250\n39\t40\t251\t251\n39\t40\t252\t252\n39\t40\t253\t253\n39\t40\t254\t254\n39\t40\t255\t255\n40\t41\t0\t0\n40\t41\t1\t1\n40\t41\t2\t2\n40\t41\t3\t3\n40\t41\t4\t4\n40\t41\t5\t5\n40\t41\t6\t6\n40\t41\t7\t7\n40\t41\t8\t8\n40\t41\t9\t9\n40\t41\t10\t10\n40\t41\t11\t11\n40\t41\t12\t12\n40\t41\t13\t13\n40\t41\t14\t14\n40\t41\t15\t15\n40\t41\t16\t16\n40\t41\t17\t17\n40\t41\t18\t18\n40\t41\t19\t19\n40\t41\t20\t20\n40\t41\t21\t21\n40\t41\t22\t22\n40\t41\t23\t23\n40\t41\t24\t24\n40\t41\t25\t25\n40\t41\t26\t26\n40\t41\t27\t27\n40\t41\t28\t28\n40\t41\t29\t29\n40\t41\t30\t30\n40\t41\t31\t31\n40\t41\t32\t32\n40\t41\t33\t33\n40\t41\t34\t34\n40\t41\t35\t35\n40\t41\t36\t36\n40\t41\t37\t37\n40\t41\t38\t38\n40\t41\t39\t39\n40\t41\t40\t40\n40\t41\t41\t41\n40\t41\t42\t42\n40\t41\t43\t43\n40\t41\t44\t44\n40\t41\t45\t45\n40\t41\t46\t46\n40\t41\t47\t47\n40\t41\t48\t48\n40\t41\t49\t49\n40\t41\t50\t50\n40\t41\t51\t51\n40\t41\t52\t52\n40\t41\t53\t53\n40\t41\t54\t54\n40\t41\t55\t55\n40\t41\t56\t56\n40\t41\t57\t57\n40\t41\t58\t58\n40\t41\t59\t59\n40\t41\t60\t60\n40\t41\t61\t61\n40\t41\t62\t62\n40\t41\t63\t63\n40\t41\t64\t64\n40\t41\t65\t65\n40\t41\t66\t66\n40\t41\t67\t67\n40\t41\t68\t68\n40\t41\t69\t69\n40\t41\t70\t70\n40\t41\t71\t71\n40\t41\t72\t72\n40\t41\t73\t73\n40\t41\t74\t74\n40\t41\t75\t75\n40\t41\t76\t76\n40\t41\t77\t77\n40\t41\t78\t78\n40\t41\t79\t79\n40\t41\t80\t80\n40\t41\t81\t81\n40\t41\t82\t82\n40\t41\t83\t83\n40\t41\t84\t84\n40\t41\t85\t85\n40\t41\t86\t86\n40\t41\t87\t87\n40\t41\t88\t88\n40\t41\t89\t89\n40\t41\t90\t90\n40\t41\t91\t91\n40\t41\t92\t92\n40\t41\t93\t93\n40\t41\t94\t94\n40\t41\t95\t95\n40\t41\t96\t96\n40\t41\t97\t97\n40\t41\t98\t98\n40\t41\t99\t99\n40\t41\t100\t100\n40\t41\t101\t101\n40\t41\t102\t102\n40\t41\t103\t103\n40\t41\t104\t104\n40\t41\t105\t105\n40\t41\t106\t106\n40\t41\t107\t107\n40\t41\t108\t108\n40\t41\t109\t109\n40\t41\t110\t110\n40\t41\t111\t111\n40\t41\t112\t112\n40\t41\t113\t113\n40\t41\t114\t114\n40\t41\t115\t115\n40\t41\t116\t116\n40\t41\t117\t117\n40\t41\t118\t118\n40\t41\t119\t119\n40\t41\t120\t120\n40\t41\t121\t121\n40\t41\t122\t122\n40\t41\t123\t123\n40\t41\t124\t124\n40\t41\t125\t125\n40\t41\t126\t126\n40\t41\t127\t127\n40\t41\t128\t128\n40\t41\t129\t129\n40\t41\t130\t130\n40\t41\t131\t131\n40\t41\t132\t132\n40\t41\t133\t133\n40\t41\t134\t134\n40\t41\t135\t135\n40\t41\t136\t136\n40\t41\t137\t137\n40\t41\t138\t138\n40\t41\t139\t139\n40\t41\t140\t140\n40\t41\t141\t141\n40\t41\t142\t142\n40\t41\t143\t143\n40\t41\t144\t144\n40\t41\t145\t145\n40\t41\t146\t146\n40\t41\t147\t147\n40\t41\t148\t148\n40\t41\t149\t149\n40\t41\t150\t150\n40\t41\t151\t151\n40\t41\t152\t152\n40\t41\t153\t153\n40\t41\t154\t154\n40\t41\t155\t155\n40\t41\t156\t156\n40\t41\t157\t157\n40\t41\t158\t158\n40\t41\t159\t159\n40\t41\t160\t160\n40\t41\t161\t161\n40\t41\t162\t162\n40\t41\t163\t163\n40\t41\t164\t164\n40\t41\t165\t165\n40\t41\t166\t166\n40\t41\t167\t167\n40\t41\t168\t168\n40\t41\t169\t169\n40\t41\t170\t170\n40\t41\t171\t171\n40\t41\t172\t172\n40\t41\t173\t173\n40\t41\t174\t174\n40\t41\t175\t175\n40\t41\t176\t176\n40\t41\t177\t177\n40\t41\t178\t178\n40\t41\t179\t179\n40\t41\t180\t180\n40\t41\t181\t181\n40\t41\t182\t182\n40\t41\t183\t183\n40\t41\t184\t184\n40\t41\t185\t185\n40\t41\t186\t186\n40\t41\t187\t187\n40\t41\t188\t188\n40\t41\t189\t189\n40\t41\t190\t190\n40\t41\t191\t191\n40\t41\t192\t192\n40\t41\t193\t193\n40\t41\t194\t194\n40\t41\t195\t195\n40\t41\t196\t196\n40\t41\t197\t197\n40\t41\t198\t198\n40\t41\t199\t199\n40\t41\t200\t200\n40\t41\t201\t201\n40\t41\t202\t202\n40\t41\t203\t203\n40\t41\t204\t204\n40\t41\t205\t205\n40\t41\t206\t206\n40\t41\t207\t207\n40\t41\t208\t208\n40\t41\t209\t209\n40\t41\t210\t210\n40\t41\t211\t211\n40\t41\t212\t212\n40\t41\t213\t213\n40\t41\t214\t214\n40\t41\t215\t215\n40\t41\t216\t216\n40\t41\t217\t217\n40\t41\t218\t218\n40\t41\t219\t219\n40\t41\t220\t220\n40\t41\t221\t221\n40\t41\t222\t222\n40\t41\t223\t223\n40\t41\t224\t224\n40\t41\t225\t225\n40\t41\t226\t226\n40\t41\t227\t227\n40\t41\t228\t228\n40\t41\t229\t229\n40\t41\t230\t230\n40\t41\t231\t231\n40\t41\t232\t232\n40\t41\t233\t233\n40\t41\t234\t234\n40\t41\t235\t235\n40\t41\t236\t236\n40\t41\t237\t237\n40\t41\t238\t238\n40\t41\t239\t239\n40\t41\t240\t240\n40\t41\t241\t241\n40\t41\t242\t242\n40\t41\t243\t243\n40\t41\t244\t244\n40\t41\t245\t245\n40\t41\t246\t246\n40\t41\t247\t247\n40\t41\t248\t248\n40\t41\t249\t249\n40\t41\t250\t250\n40\t41\t251\t251\n40\t41\t252\t252\n40\t41\t253\t253\n40\t41\t254\t254\n40\t41\t255\t255\n41\t42\t0\t0\n41\t42\t1\t1\n41\t42\t2\t2\n41\t42\t3\t3\n41\t42\t4\t4\n41\t42\t5\t5\n41\t42\t6\t6\n41\t42\t7\t7\n41\t42\t8\t8\n41\t42\t9\t9\n41\t42\t10\t10\n41\t42\t11\t11\n41\t42\t12\t12\n41\t42\t13\t13\n41\t42\t14\t14\n41\t42\t15\t15\n41\t42\t16\t16\n41\t42\t17\t17\n41\t42\t18\t18\n41\t42\t19\t19\n41\t42\t20\t20\n41\t42\t21\t21\n41\t42\t22\t22\n41\t42\t23\t23\n41\t42\t24\t24\n41\t42\t25\t25\n41\t42\t26\t26\n41\t42\t27\t27\n41\t42\t28\t28\n41\t42\t29\t29\n41\t42\t30\t30\n41\t42\t31\t31\n41\t42\t32\t32\n41\t42\t33\t33\n41\t42\t34\t34\n41\t42\t35\t35\n41\t42\t36\t36\n41\t42\t37\t37\n41\t42\t38\t38\n41\t42\t39\t39\n41\t42\t40\t40\n41\t42\t41\t41\n41\t42\t42\t42\n41\t42\t43\t43\n41\t42\t44\t44\n41\t42\t45\t45\n41\t42\t46\t46\n41\t42\t47\t47\n41\t42\t48\t48\n41\t42\t49\t49\n41\t42\t50\t50\n41\t42\t51\t51\n41\t42\t52\t52\n41\t42\t53\t53\n41\t42\t54\t54\n41\t42\t55\t55\n41\t42\t56\t56\n41\t42\t57\t57\n41\t42\t58\t58\n41\t42\t59\t59\n41\t42\t60\t60\n41\t42\t61\t61\n41\t42\t62\t62\n41\t42\t63\t63\n41\t42\t64\t64\n41\t42\t65\t65\n41\t42\t66\t66\n41\t42\t67\t67\n41\t42\t68\t68\n41\t42\t69\t69\n41\t42\t70\t70\n41\t42\t71\t71\n41\t42\t72\t72\n41\t42\t73\t73\n41\t42\t74\t74\n41\t42\t75\t75\n41\t42\t76\t76\n41\t42\t77\t77\n41\t42\t78\t78\n41\t42\t79\t79\n41\t42\t80\t80\n41\t42\t81\t81\n41\t42\t82\t82\n41\t42\t83\t83\n41\t42\t84\t84\n41\t42\t85\t85\n41\t42\t86\t86\n41\t42\t87\t87\n41\t42\t88\t88\n41\t42\t89\t89\n41\t42\t90\t90\n41\t42\t91\t91\n41\t42\t92\t92\n41\t42\t93\t93\n41\t42\t94\t94\n41\t42\t95\t95\n41\t42\t96\t96\n41\t42\t97\t97\n41\t42\t98\t98\n41\t42\t99\t99\n41\t42\t100\t100\n41\t42\t101\t101\n41\t42\t102\t102\n41\t42\t103\t103\n41\t42\t104\t104\n41\t42\t105\t105\n41\t42\t106\t106\n41\t42\t107\t107\n41\t42\t108\t108\n41\t42\t109\t109\n41\t42\t110\t110\n41\t42\t111\t111\n41\t42\t112\t112\n41\t42\t113\t113\n41\t42\t114\t114\n41\t42\t115\t115\n41\t42\t116\t116\n41\t42\t117\t117\n41\t42\t118\t118\n41\t42\t119\t119\n41\t42\t120\t120\n41\t42\t121\t121\n41\t42\t122\t122\n41\t42\t123\t123\n41\t42\t124\t124\n41\t42\t125\t125\n41\t42\t126\t126\n41\t42\t127\t127\n41\t42\t128\t128\n41\t42\t129\t129\n41\t42\t130\t130\n41\t42\t131\t131\n41\t42\t132\t132\n41\t42\t133\t133\n41\t42\t134\t134\n41\t42\t135\t135\n41\t42\t136\t136\n41\t42\t137\t137\n41\t42\t138\t138\n41\t42\t139\t139\n41\t42\t140\t140\n41\t42\t141\t141\n41\t42\t142\t142\n41\t42\t143\t143\n41\t42\t144\t144\n41\t42\t145\t145\n41\t42\t146\t146\n41\t42\t147\t147\n41\t42\t148\t148\n41\t42\t149\t149\n41\t42\t150\t150\n41\t42\t151\t151\n41\t42\t152\t152\n41\t42\t153\t153\n41\t42\t154\t154\n41\t42\t155\t155\n41\t42\t156\t156\n41\t42\t157\t157\n41\t42\t158\t158\n41\t42\t159\t159\n41\t42\t160\t160\n41\t42\t161\t161\n41\t42\t162\t162\n41\t42\t163\t163\n41\t42\t164\t164\n41\t42\t165\t165\n41\t42\t166\t166\n41\t42\t167\t167\n41\t42\t168\t168\n41\t42\t169\t169\n41\t42\t170\t170\n41\t42\t171\t171\n41\t42\t172\t172\n41\t42\t173\t173\n41\t42\t174\t174\n41\t42\t175\t175\n41\t42\t176\t176\n41\t42\t177\t177\n41\t42\t178\t178\n41\t42\t179\t179\n41\t42\t180\t180\n41\t42\t181\t181\n41\t42\t182\t182\n41\t42\t183\t183\n41\t42\t184\t184\n41\t42\t185\t185\n41\t42\t186\t186\n41\t42\t187\t187\n41\t42\t188\t188\n41\t42\t189\t189\n41\t42\t190\t190\n41\t42\t191\t191\n41\t42\t192\t192\n41\t42\t193\t193\n41\t42\t194\t194\n41\t42\t195\t195\n41\t42\t196\t196\n41\t42\t197\t197\n41\t42\t198\t198\n41\t42\t199\t199\n41\t42\t200\t200\n41\t42\t201\t201\n41\t42\t202\t202\n41\t42\t203\t203\n41\t42\t204\t204\n41\t42\t205\t205\n41\t42\t206\t206\n41\t42\t207\t207\n41\t42\t208\t208\n41\t42\t209\t209\n41\t42\t210\t210\n41\t42\t211\t211\n41\t42\t212\t212\n41\t42\t213\t213\n41\t42\t214\t214\n41\t42\t215\t215\n41\t42\t216\t216\n41\t42\t217\t217\n41\t42\t218\t218\n41\t42\t219\t219\n41\t42\t220\t220\n41\t42\t221\t221\n41\t42\t222\t222\n41\t42\t223\t223\n41\t42\t224\t224\n41\t42\t225\t225\n41\t42\t226\t226\n41\t42\t227\t227\n41\t42\t228\t228\n41\t42\t229\t229\n41\t42\t230\t230\n41\t42\t231\t231\n41\t42\t232\t232\n41\t42\t233\t233\n41\t42\t234\t234\n41\t42\t235\t235\n41\t42\t236\t236\n41\t42\t237\t237\n41\t42\t238\t238\n41\t42\t239\t239\n41\t42\t240\t240\n41\t42\t241\t241\n41\t42\t242\t242\n41\t42\t243\t243\n41\t42\t244\t244\n41\t42\t245\t245\n41\t42\t246\t246\n41\t42\t247\t247\n41\t42\t248\t248\n41\t42\t249\t249\n41\t42\t250\t250\n41\t42\t251\t251\n41\t42\t252\t252\n41\t42\t253\t253\n41\t42\t254\t254\n41\t42\t255\t255\n42\t43\t0\t0\n42\t43\t1\t1\n42\t43\t2\t2\n42\t43\t3\t3\n42\t43\t4\t4\n42\t43\t5\t5\n42\t43\t6\t6\n42\t43\t7\t7\n42\t43\t8\t8\n42\t43\t9\t9\n42\t43\t10\t10\n42\t43\t11\t11\n42\t43\t12\t12\n42\t43\t13\t13\n42\t43\t14\t14\n42\t43\t15\t15\n42\t43\t16\t16\n42\t43\t17\t17\n42\t43\t18\t18\n42\t43\t19\t19\n42\t43\t20\t20\n42\t43\t21\t21\n42\t43\t22\t22\n42\t43\t23\t23\n42\t43\t24\t24\n42\t43\t25\t25\n42\t43\t26\t26\n42\t43\t27\t27\n42\t43\t28\t28\n42\t43\t29\t29\n42\t43\t30\t30\n42\t43\t31\t31\n42\t43\t32\t32\n42\t43\t33\t33\n42\t43\t34\t34\n42\t43\t35\t35\n42\t43\t36\t36\n42\t43\t37\t37\n42\t43\t38\t38\n42\t43\t39\t39\n42\t43\t40\t40\n42\t43\t41\t41\n42\t43\t42\t42\n42\t43\t43\t43\n42\t43\t44\t44\n42\t43\t45\t45\n42\t43\t46\t46\n42\t43\t47\t47\n42\t43\t48\t48\n42\t43\t49\t49\n42\t43\t50\t50\n42\t43\t51\t51\n42\t43\t52\t52\n42\t43\t53\t53\n42\t43\t54\t54\n42\t43\t55\t55\n42\t43\t56\t56\n42\t43\t57\t57\n42\t43\t58\t58\n42\t43\t59\t59\n42\t43\t60\t60\n42\t43\t61\t61\n42\t43\t62\t62\n42\t43\t63\t63\n42\t43\t64\t64\n42\t43\t65\t65\n42\t43\t66\t66\n42\t43\t67\t67\n42\t43\t68\t68\n42\t43\t69\t69\n42\t43\t70\t70\n42\t43\t71\t71\n42\t43\t72\t72\n42\t43\t73\t73\n42\t43\t74\t74\n42\t43\t75\t75\n42\t43\t76\t76\n42\t43\t77\t77\n42\t43\t78\t78\n42\t43\t79\t79\n42\t43\t80\t80\n42\t43\t81\t81\n42\t43\t82\t82\n42\t43\t83\t83\n42\t43\t84\t84\n42\t43\t85\t85\n42\t43\t86\t86\n42\t43\t87\t87\n42\t43\t88\t88\n42\t43\t89\t89\n42\t43\t90\t90\n42\t43\t91\t91\n42\t43\t92\t92\n42\t43\t93\t93\n42\t43\t94\t94\n42\t43\t95\t95\n42\t43\t96\t96\n42\t43\t97\t97\n42\t43\t98\t98\n42\t43\t99\t99\n42\t43\t100\t100\n42\t43\t101\t101\n42\t43\t102\t102\n42\t43\t103\t103\n42\t43\t104\t104\n42\t43\t105\t105\n42\t43\t106\t106\n42\t43\t107\t107\n42\t43\t108\t108\n42\t43\t109\t109\n42\t43\t110\t110\n42\t43\t111\t111\n42\t43\t112\t112\n42\t43\t113\t113\n42\t43\t114\t114\n42\t43\t115\t115\n42\t43\t116\t116\n42\t43\t117\t117\n42\t43\t118\t118\n42\t43\t119\t119\n42\t43\t120\t120\n42\t43\t121\t121\n42\t43\t122\t122\n42\t43\t123\t123\n42\t43\t124\t124\n42\t43\t125\t125\n42\t43\t126\t126\n42\t43\t127\t127\n42\t43\t128\t128\n42\t43\t129\t129\n42\t43\t130\t130\n42\t43\t131\t131\n42\t43\t132\t132\n42\t43\t133\t133\n42\t43\t134\t134\n42\t43\t135\t135\n42\t43\t136\t136\n42\t43\t137\t137\n42\t43\t138\t138\n42\t43\t139\t139\n42\t43\t140\t140\n42\t43\t141\t141\n42\t43\t142\t142\n42\t43\t143\t143\n42\t43\t144\t144\n42\t43\t145\t145\n42\t43\t146\t146\n42\t43\t147\t147\n42\t43\t148\t148\n42\t43\t149\t149\n42\t43\t150\t150\n42\t43\t151\t151\n42\t43\t152\t152\n42\t43\t153\t153\n42\t43\t154\t154\n42\t43\t155\t155\n42\t43\t156\t156\n42\t43\t157\t157\n42\t43\t158\t158\n42\t43\t159\t159\n42\t43\t160\t160\n42\t43\t161\t161\n42\t43\t162\t162\n42\t43\t163\t163\n42\t43\t164\t164\n42\t43\t165\t165\n42\t43\t166\t166\n42\t43\t167\t167\n42\t43\t168\t168\n42\t43\t169\t169\n42\t43\t170\t170\n42\t43\t171\t171\n42\t43\t172\t172\n42\t43\t173\t173\n42\t43\t174\t174\n42\t43\t175\t175\n42\t43\t176\t176\n42\t43\t177\t177\n42\t43\t178\t178\n42\t43\t179\t179\n42\t43\t180\t180\n42\t43\t181\t181\n42\t43\t182\t182\n42\t43\t183\t183\n42\t43\t184\t184\n42\t43\t185\t185\n42\t43\t186\t186\n42\t43\t187\t187\n42\t43\t188\t188\n42\t43\t189\t189\n42\t43\t190\t190\n42\t43\t191\t191\n42\t43\t192\t192\n42\t43\t193\t193\n42\t43\t194\t194\n42\t43\t195\t195\n42\t43\t196\t196\n42\t43\t197\t197\n42\t43\t198\t198\n42\t43\t199\t199\n42\t43\t200\t200\n42\t43\t201\t201\n42\t43\t202\t202\n42\t43\t203\t203\n42\t43\t204\t204\n42\t43\t205\t205\n42\t43\t206\t206\n42\t43\t207\t207\n42\t43\t208\t208\n42\t43\t209\t209\n42\t43\t210\t210\n42\t43\t211\t211\n42\t43\t212\t212\n42\t43\t213\t213\n42\t43\t214\t214\n42\t43\t215\t215\n42\t43\t216\t216\n42\t43\t217\t217\n42\t43\t218\t218\n42\t43\t219\t219\n42\t43\t220\t220\n42\t43\t221\t221\n42\t43\t222\t222\n42\t43\t223\t223\n42\t43\t224\t224\n42\t43\t225\t225\n42\t43\t226\t226\n42\t43\t227\t227\n42\t43\t228\t228\n42\t43\t229\t229\n42\t43\t230\t230\n42\t43\t231\t231\n42\t43\t232\t232\n42\t43\t233\t233\n42\t43\t234\t234\n42\t43\t235\t235\n42\t43\t236\t236\n42\t43\t237\t237\n42\t43\t238\t238\n42\t43\t239\t239\n42\t43\t240\t240\n42\t43\t241\t241\n42\t43\t242\t242\n42\t43\t243\t243\n42\t43\t244\t244\n42\t43\t245\t245\n42\t43\t246\t246\n42\t43\t247\t247\n42\t43\t248\t248\n42\t43\t249\t249\n42\t43\t250\t250\n42\t43\t251\t251\n42\t43\t252\t252\n42\t43\t253\t253\n42\t43\t254\t254\n42\t43\t255\t255\n43\t44\t0\t0\n43\t44\t1\t1\n43\t44\t2\t2\n43\t44\t3\t3\n43\t44\t4\t4\n43\t44\t5\t5\n43\t44\t6\t6\n43\t44\t7\t7\n43\t44\t8\t8\n43\t44\t9\t9\n43\t44\t10\t10\n43\t44\t11\t11\n43\t44\t12\t12\n43\t44\t13\t13\n43\t44\t14\t14\n43\t44\t15\t15\n43\t44\t16\t16\n43\t44\t17\t17\n43\t44\t18\t18\n43\t44\t19\t19\n43\t44\t20\t20\n43\t44\t21\t21\n43\t44\t22\t22\n43\t44\t23\t23\n43\t44\t24\t24\n43\t44\t25\t25\n43\t44\t26\t26\n43\t44\t27\t27\n43\t44\t28\t28\n43\t44\t29\t29\n43\t44\t30\t30\n43\t44\t31\t31\n43\t44\t32\t32\n43\t44\t33\t33\n43\t44\t34\t34\n43\t44\t35\t35\n43\t44\t36\t36\n43\t44\t37\t37\n43\t44\t38\t38\n43\t44\t39\t39\n43\t44\t40\t40\n43\t44\t41\t41\n43\t44\t42\t42\n43\t44\t43\t43\n43\t44\t44\t44\n43\t44\t45\t45\n43\t44\t46\t46\n43\t44\t47\t47\n43\t44\t48\t48\n43\t44\t49\t49\n43\t44\t50\t50\n43\t44\t51\t51\n43\t44\t52\t52\n43\t44\t53\t53\n43\t44\t54\t54\n43\t44\t55\t55\n43\t44\t56\t56\n43\t44\t57\t57\n43\t44\t58\t58\n43\t44\t59\t59\n43\t44\t60\t60\n43\t44\t61\t61\n43\t44\t62\t62\n43\t44\t63\t63\n43\t44\t64\t64\n43\t44\t65\t65\n43\t44\t66\t66\n43\t44\t67\t67\n43\t44\t68\t68\n43\t44\t69\t69\n43\t44\t70\t70\n43\t44\t71\t71\n43\t44\t72\t72\n43\t44\t73\t73\n43\t44\t74\t74\n43\t44\t75\t75\n43\t44\t76\t76\n43\t44\t77\t77\n43\t44\t78\t78\n43\t44\t79\t79\n43\t44\t80\t80\n43\t44\t81\t81\n43\t44\t82\t82\n43\t44\t83\t83\n43\t44\t84\t84\n43\t44\t85\t85\n43\t44\t86\t86\n43\t44\t87\t87\n43\t44\t88\t88\n43\t44\t89\t89\n43\t44\t90\t90\n43\t44\t91\t91\n43\t44\t92\t92\n43\t44\t93\t93\n43\t44\t94\t94\n43\t44\t95\t95\n43\t44\t96\t96\n43\t44\t97\t97\n43\t44\t98\t98\n43\t44\t99\t99\n43\t44\t100\t100\n43\t44\t101\t101\n43\t44\t102\t102\n43\t44\t103\t103\n43\t44\t104\t104\n43\t44\t105\t105\n43\t44\t106\t106\n43\t44\t107\t107\n43\t44\t108\t108\n43\t44\t109\t109\n43\t44\t110\t110\n43\t44\t111\t111\n43\t44\t112\t112\n43\t44\t113\t113\n43\t44\t114\t114\n43\t44\t115\t115\n43\t44\t116\t116\n43\t44\t117\t117\n43\t44\t118\t118\n43\t44\t119\t119\n43\t44\t120\t120\n43\t44\t121\t121\n43\t44\t122\t122\n43\t44\t123\t123\n43\t44\t124\t124\n43\t44\t125\t125\n43\t44\t126\t126\n43\t44\t127\t127\n43\t44\t128\t128\n43\t44\t129\t129\n43\t44\t130\t130\n43\t44\t131\t131\n43\t44\t132\t132\n43\t44\t133\t133\n43\t44\t134\t134\n43\t44\t135\t135\n43\t44\t136\t136\n43\t44\t137\t137\n43\t44\t138\t138\n43\t44\t139\t139\n43\t44\t140\t140\n43\t44\t141\t141\n43\t44\t142\t142\n43\t44\t143\t143\n43\t44\t144\t144\n43\t44\t145\t145\n43\t44\t146\t146\n43\t44\t147\t147\n43\t44\t148\t148\n43\t44\t149\t149\n43\t44\t150\t150\n43\t44\t151\t151\n43\t44\t152\t152\n43\t44\t153\t153\n43\t44\t154\t154\n43\t44\t155\t155\n43\t44\t156\t156\n43\t44\t157\t157\n43\t44\t158\t158\n43\t44\t159\t159\n43\t44\t160\t160\n43\t44\t161\t161\n43\t44\t162\t162\n43\t44\t163\t163\n43\t44\t164\t164\n43\t44\t165\t165\n43\t44\t166\t166\n43\t44\t167\t167\n43\t44\t168\t168\n43\t44\t169\t169\n43\t44\t170\t170\n43\t44\t171\t171\n43\t44\t172\t172\n43\t44\t173\t173\n43\t44\t174\t174\n43\t44\t175\t175\n43\t44\t176\t176\n43\t44\t177\t177\n43\t44\t178\t178\n43\t44\t179\t179\n43\t44\t180\t180\n43\t44\t181\t181\n43\t44\t182\t182\n43\t44\t183\t183\n43\t44\t184\t184\n43\t44\t185\t185\n43\t44\t186\t186\n43\t44\t187\t187\n43\t44\t188\t188\n43\t44\t189\t189\n43\t44\t190\t190\n43\t44\t191\t191\n43\t44\t192\t192\n43\t44\t193\t193\n43\t44\t194\t194\n43\t44\t195\t195\n43\t44\t196\t196\n43\t44\t197\t197\n43\t44\t198\t198\n43\t44\t199\t199\n43\t44\t200\t200\n43\t44\t201\t201\n43\t44\t202\t202\n43\t44\t203\t203\n43\t44\t204\t204\n43\t44\t205\t205\n43\t44\t206\t206\n43\t44\t207\t207\n43\t44\t208\t208\n43\t44\t209\t209\n43\t44\t210\t210\n43\t44\t211\t211\n43\t44\t212\t212\n43\t44\t213\t213\n43\t44\t214\t214\n43\t44\t215\t215\n43\t44\t216\t216\n43\t44\t217\t217\n43\t44\t218\t218\n43\t44\t219\t219\n43\t44\t220\t220\n43\t44\t221\t221\n43\t44\t222\t222\n43\t44\t223\t223\n43\t44\t224\t224\n43\t44\t225\t225\n43\t44\t226\t226\n43\t44\t227\t227\n43\t44\t228\t228\n43\t44\t229\t229\n43\t44\t230\t230\n43\t44\t231\t231\n43\t44\t232\t232\n43\t44\t233\t233\n43\t44\t234\t234\n43\t44\t235\t235\n43\t44\t236\t236\n43\t44\t237\t237\n43\t44\t238\t238\n43\t44\t239\t239\n43\t44\t240\t240\n43\t44\t241\t241\n43\t44\t242\t242\n43\t44\t243\t243\n43\t44\t244\t244\n43\t44\t245\t245\n43\t44\t246\t246\n43\t44\t247\t247\n43\t44\t248\t248\n43\t44\t249\t249\n43\t44\t250\t250\n43\t44\t251\t251\n43\t44\t252\t252\n43\t44\t253\t253\n43\t44\t254\t254\n43\t44\t255\t255\n44\t45\t0\t0\n44\t45\t1\t1\n44\t45\t2\t2\n44\t45\t3\t3\n44\t45\t4\t4\n44\t45\t5\t5\n44\t45\t6\t6\n44\t45\t7\t7\n44\t45\t8\t8\n44\t45\t9\t9\n44\t45\t10\t10\n44\t45\t11\t11\n44\t45\t12\t12\n44\t45\t13\t13\n44\t45\t14\t14\n44\t45\t15\t15\n44\t45\t16\t16\n44\t45\t17\t17\n44\t45\t18\t18\n44\t45\t19\t19\n44\t45\t20\t20\n44\t45\t21\t21\n44\t45\t22\t22\n44\t45\t23\t23\n44\t45\t24\t24\n44\t45\t25\t25\n44\t45\t26\t26\n44\t45\t27\t27\n44\t45\t28\t28\n44\t45\t29\t29\n44\t45\t30\t30\n44\t45\t31\t31\n44\t45\t32\t32\n44\t45\t33\t33\n44\t45\t34\t34\n44\t45\t35\t35\n44\t45\t36\t36\n44\t45\t37\t37\n44\t45\t38\t38\n44\t45\t39\t39\n44\t45\t40\t40\n44\t45\t41\t41\n44\t45\t42\t42\n44\t45\t43\t43\n44\t45\t44\t44\n44\t45\t45\t45\n44\t45\t46\t46\n44\t45\t47\t47\n44\t45\t48\t48\n44\t45\t49\t49\n44\t45\t50\t50\n44\t45\t51\t51\n44\t45\t52\t52\n44\t45\t53\t53\n44\t45\t54\t54\n44\t45\t55\t55\n44\t45\t56\t56\n44\t45\t57\t57\n44\t45\t58\t58\n44\t45\t59\t59\n44\t45\t60\t60\n44\t45\t61\t61\n44\t45\t62\t62\n44\t45\t63\t63\n44\t45\t64\t64\n44\t45\t65\t65\n44\t45\t66\t66\n44\t45\t67\t67\n44\t45\t68\t68\n44\t45\t69\t69\n44\t45\t70\t70\n44\t45\t71\t71\n44\t45\t72\t72\n44\t45\t73\t73\n44\t45\t74\t74\n44\t45\t75\t75\n44\t45\t76\t76\n44\t45\t77\t77\n44\t45\t78\t78\n44\t45\t79\t79\n44\t45\t80\t80\n44\t45\t81\t81\n44\t45\t82\t82\n44\t45\t83\t83\n44\t45\t84\t84\n44\t45\t85\t85\n44\t45\t86\t86\n44\t45\t87\t87\n44\t45\t88\t88\n44\t45\t89\t89\n44\t45\t90\t90\n44\t45\t91\t91\n44\t45\t92\t92\n44\t45\t93\t93\n44\t45\t94\t94\n44\t45\t95\t95\n44\t45\t96\t96\n44\t45\t97\t97\n44\t45\t98\t98\n44\t45\t99\t99\n44\t45\t100\t100\n44\t45\t101\t101\n44\t45\t102\t102\n44\t45\t103\t103\n44\t45\t104\t104\n44\t45\t105\t105\n44\t45\t106\t106\n44\t45\t107\t107\n44\t45\t108\t108\n44\t45\t109\t109\n44\t45\t110\t110\n44\t45\t111\t111\n44\t45\t112\t112\n44\t45\t113\t113\n44\t45\t114\t114\n44\t45\t115\t115\n44\t45\t116\t116\n44\t45\t117\t117\n44\t45\t118\t118\n44\t45\t119\t119\n44\t45\t120\t120\n44\t45\t121\t121\n44\t45\t122\t122\n44\t45\t123\t123\n44\t45\t124\t124\n44\t45\t125\t125\n44\t45\t126\t126\n44\t45\t127\t127\n44\t45\t128\t128\n44\t45\t129\t129\n44\t45\t130\t130\n44\t45\t131\t131\n44\t45\t132\t132\n44\t45\t133\t133\n44\t45\t134\t134\n44\t45\t135\t135\n44\t45\t136\t136\n44\t45\t137\t137\n44\t45\t138\t138\n44\t45\t139\t139\n44\t45\t140\t140\n44\t45\t141\t141\n44\t45\t142\t142\n44\t45\t143\t143\n44\t45\t144\t144\n44\t45\t145\t145\n44\t45\t146\t146\n44\t45\t147\t147\n44\t45\t148\t148\n44\t45\t149\t149\n44\t45\t150\t150\n44\t45\t151\t151\n44\t45\t152\t152\n44\t45\t153\t153\n44\t45\t154\t154\n44\t45\t155\t155\n44\t45\t156\t156\n44\t45\t157\t157\n44\t45\t158\t158\n44\t45\t159\t159\n44\t45\t160\t160\n44\t45\t161\t161\n44\t45\t162\t162\n44\t45\t163\t163\n44\t45\t164\t164\n44\t45\t165\t165\n44\t45\t166\t166\n44\t45\t167\t167\n44\t45\t168\t168\n44\t45\t169\t169\n44\t45\t170\t170\n44\t45\t171\t171\n44\t45\t172\t172\n44\t45\t173\t173\n44\t45\t174\t174\n44\t45\t175\t175\n44\t45\t176\t176\n44\t45\t177\t177\n44\t45\t178\t178\n44\t45\t179\t179\n44\t45\t180\t180\n44\t45\t181\t181\n44\t45\t182\t182\n44\t45\t183\t183\n44\t45\t184\t184\n44\t45\t185\t185\n44\t45\t186\t186\n44\t45\t187\t187\n44\t45\t188\t188\n44\t45\t189\t189\n44\t45\t190\t190\n44\t45\t191\t191\n44\t45\t192\t192\n44\t45\t193\t193\n44\t45\t194\t194\n44\t45\t195\t195\n44\t45\t196\t196\n44\t45\t197\t197\n44\t45\t198\t198\n44\t45\t199\t199\n44\t45\t200\t200\n44\t45\t201\t201\n44\t45\t202\t202\n44\t45\t203\t203\n44\t45\t204\t204\n44\t45\t205\t205\n44\t45\t206\t206\n44\t45\t207\t207\n44\t45\t208\t208\n44\t45\t209\t209\n44\t45\t210\t210\n44\t45\t211\t211\n44\t45\t212\t212\n44\t45\t213\t213\n44\t45\t214\t214\n44\t45\t215\t215\n44\t45\t216\t216\n44\t45\t217\t217\n44\t45\t218\t218\n44\t45\t219\t219\n44\t45\t220\t220\n44\t45\t221\t221\n44\t45\t222\t222\n44\t45\t223\t223\n44\t45\t224\t224\n44\t45\t225\t225\n44\t45\t226\t226\n44\t45\t227\t227\n44\t45\t228\t228\n44\t45\t229\t229\n44\t45\t230\t230\n44\t45\t231\t231\n44\t45\t232\t232\n44\t45\t233\t233\n44\t45\t234\t234\n44\t45\t235\t235\n44\t45\t236\t236\n44\t45\t237\t237\n44\t45\t238\t238\n44\t45\t239\t239\n44\t45\t240\t240\n44\t45\t241\t241\n44\t45\t242\t242\n44\t45\t243\t243\n44\t45\t244\t244\n44\t45\t245\t245\n44\t45\t246\t246\n44\t45\t247\t247\n44\t45\t248\t248\n44\t45\t249\t249\n44\t45\t250\t250\n44\t45\t251\t251\n44\t45\t252\t252\n44\t45\t253\t253\n44\t45\t254\t254\n44\t45\t255\t255\n45\t46\t0\t0\n45\t46\t1\t1\n45\t46\t2\t2\n45\t46\t3\t3\n45\t46\t4\t4\n45\t46\t5\t5\n45\t46\t6\t6\n45\t46\t7\t7\n45\t46\t8\t8\n45\t46\t9\t9\n45\t46\t10\t10\n45\t46\t11\t11\n45\t46\t12\t12\n45\t46\t13\t13\n45\t46\t14\t14\n45\t46\t15\t15\n45\t46\t16\t16\n45\t46\t17\t17\n45\t46\t18\t18\n45\t46\t19\t19\n45\t46\t20\t20\n45\t46\t21\t21\n45\t46\t22\t22\n45\t46\t23\t23\n45\t46\t24\t24\n45\t46\t25\t25\n45\t46\t26\t26\n45\t46\t27\t27\n45\t46\t28\t28\n45\t46\t29\t29\n45\t46\t30\t30\n45\t46\t31\t31\n45\t46\t32\t32\n45\t46\t33\t33\n45\t46\t34\t34\n45\t46\t35\t35\n45\t46\t36\t36\n45\t46\t37\t37\n45\t46\t38\t38\n45\t46\t39\t39\n45\t46\t40\t40\n45\t46\t41\t41\n45\t46\t42\t42\n45\t46\t43\t43\n45\t46\t44\t44\n45\t46\t45\t45\n45\t46\t46\t46\n45\t46\t47\t47\n45\t46\t48\t48\n45\t46\t49\t49\n45\t46\t50\t50\n45\t46\t51\t51\n45\t46\t52\t52\n45\t46\t53\t53\n45\t46\t54\t54\n45\t46\t55\t55\n45\t46\t56\t56\n45\t46\t57\t57\n45\t46\t58\t58\n45\t46\t59\t59\n45\t46\t60\t60\n45\t46\t61\t61\n45\t46\t62\t62\n45\t46\t63\t63\n45\t46\t64\t64\n45\t46\t65\t65\n45\t46\t66\t66\n45\t46\t67\t67\n45\t46\t68\t68\n45\t46\t69\t69\n45\t46\t70\t70\n45\t46\t71\t71\n45\t46\t72\t72\n45\t46\t73\t73\n45\t46\t74\t74\n45\t46\t75\t75\n45\t46\t76\t76\n45\t46\t77\t77\n45\t46\t78\t78\n45\t46\t79\t79\n45\t46\t80\t80\n45\t46\t81\t81\n45\t46\t82\t82\n45\t46\t83\t83\n45\t46\t84\t84\n45\t46\t85\t85\n45\t46\t86\t86\n45\t46\t87\t87\n45\t46\t88\t88\n45\t46\t89\t89\n45\t46\t90\t90\n45\t46\t91\t91\n45\t46\t92\t92\n45\t46\t93\t93\n45\t46\t94\t94\n45\t46\t95\t95\n45\t46\t96\t96\n45\t46\t97\t97\n45\t46\t98\t98\n45\t46\t99\t99\n45\t46\t100\t100\n45\t46\t101\t101\n45\t46\t102\t102\n45\t46\t103\t103\n45\t46\t104\t104\n45\t46\t105\t105\n45\t46\t106\t106\n45\t46\t107\t107\n45\t46\t108\t108\n45\t46\t109\t109\n45\t46\t110\t110\n45\t46\t111\t111\n45\t46\t112\t112\n45\t46\t113\t113\n45\t46\t114\t114\n45\t46\t115\t115\n45\t46\t116\t116\n45\t46\t117\t117\n45\t46\t118\t118\n45\t46\t119\t119\n45\t46\t120\t120\n45\t46\t121\t121\n45\t46\t122\t122\n45\t46\t123\t123\n45\t46\t124\t124\n45\t46\t125\t125\n45\t46\t126\t126\n45\t46\t127\t127\n45\t46\t128\t128\n45\t46\t129\t129\n45\t46\t130\t130\n45\t46\t131\t131\n45\t46\t132\t132\n45\t46\t133\t133\n45\t46\t134\t134\n45\t46\t135\t135\n45\t46\t136\t136\n45\t46\t137\t137\n45\t46\t138\t138\n45\t46\t139\t139\n45\t46\t140\t140\n45\t46\t141\t141\n45\t46\t142\t142\n45\t46\t143\t143\n45\t46\t144\t144\n45\t46\t145\t145\n45\t46\t146\t146\n45\t46\t147\t147\n45\t46\t148\t148\n45\t46\t149\t149\n45\t46\t150\t150\n45\t46\t151\t151\n45\t46\t152\t152\n45\t46\t153\t153\n45\t46\t154\t154\n45\t46\t155\t155\n45\t46\t156\t156\n45\t46\t157\t157\n45\t46\t158\t158\n45\t46\t159\t159\n45\t46\t160\t160\n45\t46\t161\t161\n45\t46\t162\t162\n45\t46\t163\t163\n45\t46\t164\t164\n45\t46\t165\t165\n45\t46\t166\t166\n45\t46\t167\t167\n45\t46\t168\t168\n45\t46\t169\t169\n45\t46\t170\t170\n45\t46\t171\t171\n45\t46\t172\t172\n45\t46\t173\t173\n45\t46\t174\t174\n45\t46\t175\t175\n45\t46\t176\t176\n45\t46\t177\t177\n45\t46\t178\t178\n45\t46\t179\t179\n45\t46\t180\t180\n45\t46\t181\t181\n45\t46\t182\t182\n45\t46\t183\t183\n45\t46\t184\t184\n45\t46\t185\t185\n45\t46\t186\t186\n45\t46\t187\t187\n45\t46\t188\t188\n45\t46\t189\t189\n45\t46\t190\t190\n45\t46\t191\t191\n45\t46\t192\t192\n45\t46\t193\t193\n45\t46\t194\t194\n45\t46\t195\t195\n45\t46\t196\t196\n45\t46\t197\t197\n45\t46\t198\t198\n45\t46\t199\t199\n45\t46\t200\t200\n45\t46\t201\t201\n45\t46\t202\t202\n45\t46\t203\t203\n45\t46\t204\t204\n45\t46\t205\t205\n45\t46\t206\t206\n45\t46\t207\t207\n45\t46\t208\t208\n45\t46\t209\t209\n45\t46\t210\t210\n45\t46\t211\t211\n45\t46\t212\t212\n45\t46\t213\t213\n45\t46\t214\t214\n45\t46\t215\t215\n45\t46\t216\t216\n45\t46\t217\t217\n45\t46\t218\t218\n45\t46\t219\t219\n45\t46\t220\t220\n45\t46\t221\t221\n45\t46\t222\t222\n45\t46\t223\t223\n45\t46\t224\t224\n45\t46\t225\t225\n45\t46\t226\t226\n45\t46\t227\t227\n45\t46\t228\t228\n45\t46\t229\t229\n45\t46\t230\t230\n45\t46\t231\t231\n45\t46\t232\t232\n45\t46\t233\t233\n45\t46\t234\t234\n45\t46\t235\t235\n45\t46\t236\t236\n45\t46\t237\t237\n45\t46\t238\t238\n45\t46\t239\t239\n45\t46\t240\t240\n45\t46\t241\t241\n45\t46\t242\t242\n45\t46\t243\t243\n45\t46\t244\t244\n45\t46\t245\t245\n45\t46\t246\t246\n45\t46\t247\t247\n45\t46\t248\t248\n45\t46\t249\t249\n45\t46\t250\t250\n45\t46\t251\t251\n45\t46\t252\t252\n45\t46\t253\t253\n45\t46\t254\t254\n45\t46\t255\t255\n46\t47\t0\t0\n46\t47\t1\t1\n46\t47\t2\t2\n46\t47\t3\t3\n46\t47\t4\t4\n46\t47\t5\t5\n46\t47\t6\t6\n46\t47\t7\t7\n46\t47\t8\t8\n46\t47\t9\t9\n46\t47\t10\t10\n46\t47\t11\t11\n46\t47\t12\t12\n46\t47\t13\t13\n46\t47\t14\t14\n46\t47\t15\t15\n46\t47\t16\t16\n46\t47\t17\t17\n46\t47\t18\t18\n46\t47\t19\t19\n46\t47\t20\t20\n46\t47\t21\t21\n46\t47\t22\t22\n46\t47\t23\t23\n46\t47\t24\t24\n46\t47\t25\t25\n46\t47\t26\t26\n46\t47\t27\t27\n46\t47\t28\t28\n46\t47\t29\t29\n46\t47\t30\t30\n46\t47\t31\t31\n46\t47\t32\t32\n46\t47\t33\t33\n46\t47\t34\t34\n46\t47\t35\t35\n46\t47\t36\t36\n46\t47\t37\t37\n46\t47\t38\t38\n46\t47\t39\t39\n46\t47\t40\t40\n46\t47\t41\t41\n46\t47\t42\t42\n46\t47\t43\t43\n46\t47\t44\t44\n46\t47\t45\t45\n46\t47\t46\t46\n46\t47\t47\t47\n46\t47\t48\t48\n46\t47\t49\t49\n46\t47\t50\t50\n46\t47\t51\t51\n46\t47\t52\t52\n46\t47\t53\t53\n46\t47\t54\t54\n46\t47\t55\t55\n46\t47\t56\t56\n46\t47\t57\t57\n46\t47\t58\t58\n46\t47\t59\t59\n46\t47\t60\t60\n46\t47\t61\t61\n46\t47\t62\t62\n46\t47\t63\t63\n46\t47\t64\t64\n46\t47\t65\t65\n46\t47\t66\t66\n46\t47\t67\t67\n46\t47\t68\t68\n46\t47\t69\t69\n46\t47\t70\t70\n46\t47\t71\t71\n46\t47\t72\t72\n46\t47\t73\t73\n46\t47\t74\t74\n46\t47\t75\t75\n46\t47\t76\t76\n46\t47\t77\t77\n46\t47\t78\t78\n46\t47\t79\t79\n46\t47\t80\t80\n46\t47\t81\t81\n46\t47\t82\t82\n46\t47\t83\t83\n46\t47\t84\t84\n46\t47\t85\t85\n46\t47\t86\t86\n46\t47\t87\t87\n46\t47\t88\t88\n46\t47\t89\t89\n46\t47\t90\t90\n46\t47\t91\t91\n46\t47\t92\t92\n46\t47\t93\t93\n46\t47\t94\t94\n46\t47\t95\t95\n46\t47\t96\t96\n46\t47\t97\t97\n46\t47\t98\t98\n46\t47\t99\t99\n46\t47\t100\t100\n46\t47\t101\t101\n46\t47\t102\t102\n46\t47\t103\t103\n46\t47\t104\t104\n46\t47\t105\t105\n46\t47\t106\t106\n46\t47\t107\t107\n46\t47\t108\t108\n46\t47\t109\t109\n46\t47\t110\t110\n46\t47\t111\t111\n46\t47\t112\t112\n46\t47\t113\t113\n46\t47\t114\t114\n46\t47\t115\t115\n46\t47\t116\t116\n46\t47\t117\t117\n46\t47\t118\t118\n46\t47\t119\t119\n46\t47\t120\t120\n46\t47\t121\t121\n46\t47\t122\t122\n46\t47\t123\t123\n46\t47\t124\t124\n46\t47\t125\t125\n46\t47\t126\t126\n46\t47\t127\t127\n46\t47\t128\t128\n46\t47\t129\t129\n46\t47\t130\t130\n46\t47\t131\t131\n46\t47\t132\t132\n46\t47\t133\t133\n46\t47\t134\t134\n46\t47\t135\t135\n46\t47\t136\t136\n46\t47\t137\t137\n46\t47\t138\t138\n46\t47\t139\t139\n46\t47\t140\t140\n46\t47\t141\t141\n46\t47\t142\t142\n46\t47\t143\t143\n46\t47\t144\t144\n46\t47\t145\t145\n46\t47\t146\t146\n46\t47\t147\t147\n46\t47\t148\t148\n46\t47\t149\t149\n46\t47\t150\t150\n46\t47\t151\t151\n46\t47\t152\t152\n46\t47\t153\t153\n46\t47\t154\t154\n46\t47\t155\t155\n46\t47\t156\t156\n46\t47\t157\t157\n46\t47\t158\t158\n46\t47\t159\t159\n46\t47\t160\t160\n46\t47\t161\t161\n46\t47\t162\t162\n46\t47\t163\t163\n46\t47\t164\t164\n46\t47\t165\t165\n46\t47\t166\t166\n46\t47\t167\t167\n46\t47\t168\t168\n46\t47\t169\t169\n46\t47\t170\t170\n46\t47\t171\t171\n46\t47\t172\t172\n46\t47\t173\t173\n46\t47\t174\t174\n46\t47\t175\t175\n46\t47\t176\t176\n46\t47\t177\t177\n46\t47\t178\t178\n46\t47\t179\t179\n46\t47\t180\t180\n46\t47\t181\t181\n46\t47\t182\t182\n46\t47\t183\t183\n46\t47\t184\t184\n46\t47\t185\t185\n46\t47\t186\t186\n46\t47\t187\t187\n46\t47\t188\t188\n46\t47\t189\t189\n46\t47\t190\t190\n46\t47\t191\t191\n46\t47\t192\t192\n46\t47\t193\t193\n46\t47\t194\t194\n46\t47\t195\t195\n46\t47\t196\t196\n46\t47\t197\t197\n46\t47\t198\t198\n46\t47\t199\t199\n46\t47\t200\t200\n46\t47\t201\t201\n46\t47\t202\t202\n46\t47\t203\t203\n46\t47\t204\t204\n46\t47\t205\t205\n46\t47\t206\t206\n46\t47\t207\t207\n46\t47\t208\t208\n46\t47\t209\t209\n46\t47\t210\t210\n46\t47\t211\t211\n46\t47\t212\t212\n46\t47\t213\t213\n46\t47\t214\t214\n46\t47\t215\t215\n46\t47\t216\t216\n46\t47\t217\t217\n46\t47\t218\t218\n46\t47\t219\t219\n46\t47\t220\t220\n46\t47\t221\t221\n46\t47\t222\t222\n46\t47\t223\t223\n46\t47\t224\t224\n46\t47\t225\t225\n46\t47\t226\t226\n46\t47\t227\t227\n46\t47\t228\t228\n46\t47\t229\t229\n46\t47\t230\t230\n46\t47\t231\t231\n46\t47\t232\t232\n46\t47\t233\t233\n46\t47\t234\t234\n46\t47\t235\t235\n46\t47\t236\t236\n46\t47\t237\t237\n46\t47\t238\t238\n46\t47\t239\t239\n46\t47\t240\t240\n46\t47\t241\t241\n46\t47\t242\t242\n46\t47\t243\t243\n46\t47\t244\t244\n46\t47\t245\t245\n46\t47\t246\t246\n46\t47\t247\t247\n46\t47\t248\t248\n46\t47\t249\t249\n46\t47\t250\t250\n46\t47\t251\t251\n46\t47\t252\t252\n46\t47\t253\t253\n46\t47\t254\t254\n46\t47\t255\t255\n47\t48\t0\t0\n47\t48\t1\t1\n47\t48\t2\t2\n47\t48\t3\t3\n47\t48\t4\t4\n47\t48\t5\t5\n47\t48\t6\t6\n47\t48\t7\t7\n47\t48\t8\t8\n47\t48\t9\t9\n47\t48\t10\t10\n47\t48\t11\t11\n47\t48\t12\t12\n47\t48\t13\t13\n47\t48\t14\t14\n47\t48\t15\t15\n47\t48\t16\t16\n47\t48\t17\t17\n47\t48\t18\t18\n47\t48\t19\t19\n47\t48\t20\t20\n47\t48\t21\t21\n47\t48\t22\t22\n47\t48\t23\t23\n47\t48\t24\t24\n47\t48\t25\t25\n47\t48\t26\t26\n47\t48\t27\t27\n47\t48\t28\t28\n47\t48\t29\t29\n47\t48\t30\t30\n47\t48\t31\t31\n47\t48\t32\t32\n47\t48\t33\t33\n47\t48\t34\t34\n47\t48\t35\t35\n47\t48\t36\t36\n47\t48\t37\t37\n47\t48\t38\t38\n47\t48\t39\t39\n47\t48\t40\t40\n47\t48\t41\t41\n47\t48\t42\t42\n47\t48\t43\t43\n47\t48\t44\t44\n47\t48\t45\t45\n47\t48\t46\t46\n47\t48\t47\t47\n47\t48\t48\t48\n47\t48\t49\t49\n47\t48\t50\t50\n47\t48\t51\t51\n47\t48\t52\t52\n47\t48\t53\t53\n47\t48\t54\t54\n47\t48\t55\t55\n47\t48\t56\t56\n47\t48\t57\t57\n47\t48\t58\t58\n47\t48\t59\t59\n47\t48\t60\t60\n47\t48\t61\t61\n47\t48\t62\t62\n47\t48\t63\t63\n47\t48\t64\t64\n47\t48\t65\t65\n47\t48\t66\t66\n47\t48\t67\t67\n47\t48\t68\t68\n47\t48\t69\t69\n47\t48\t70\t70\n47\t48\t71\t71\n47\t48\t72\t72\n47\t48\t73\t73\n47\t48\t74\t74\n47\t48\t75\t75\n47\t48\t76\t76\n47\t48\t77\t77\n47\t48\t78\t78\n47\t48\t79\t79\n47\t48\t80\t80\n47\t48\t81\t81\n47\t48\t82\t82\n47\t48\t83\t83\n47\t48\t84\t84\n47\t48\t85\t85\n47\t48\t86\t86\n47\t48\t87\t87\n47\t48\t88\t88\n47\t48\t89\t89\n47\t48\t90\t90\n47\t48\t91\t91\n47\t48\t92\t92\n47\t48\t93\t93\n47\t48\t94\t94\n47\t48\t95\t95\n47\t48\t96\t96\n47\t48\t97\t97\n47\t48\t98\t98\n47\t48\t99\t99\n47\t48\t100\t100\n47\t48\t101\t101\n47\t48\t102\t102\n47\t48\t103\t103\n47\t48\t104\t104\n47\t48\t105\t105\n47\t48\t106\t106\n47\t48\t107\t107\n47\t48\t108\t108\n47\t48\t109\t109\n47\t48\t110\t110\n47\t48\t111\t111\n47\t48\t112\t112\n47\t48\t113\t113\n47\t48\t114\t114\n47\t48\t115\t115\n47\t48\t116\t116\n47\t48\t117\t117\n47\t48\t118\t118\n47\t48\t119\t119\n47\t48\t120\t120\n47\t48\t121\t121\n47\t48\t122\t122\n47\t48\t123\t123\n47\t48\t124\t124\n47\t48\t125\t125\n47\t48\t126\t126\n47\t48\t127\t127\n47\t48\t128\t128\n47\t48\t129\t129\n47\t48\t130\t130\n47\t48\t131\t131\n47\t48\t132\t132\n47\t48\t133\t133\n47\t48\t134\t134\n47\t48\t135\t135\n47\t48\t136\t136\n47\t48\t137\t137\n47\t48\t138\t138\n47\t48\t139\t139\n47\t48\t140\t140\n47\t48\t141\t141\n47\t48\t142\t142\n47\t48\t143\t143\n47\t48\t144\t144\n47\t48\t145\t145\n47\t48\t146\t146\n47\t48\t147\t147\n47\t48\t148\t148\n47\t48\t149\t149\n47\t48\t150\t150\n47\t48\t151\t151\n47\t48\t152\t152\n47\t48\t153\t153\n47\t48\t154\t154\n47\t48\t155\t155\n47\t48\t156\t156\n47\t48\t157\t157\n47\t48\t158\t158\n47\t48\t159\t159\n47\t48\t160\t160\n47\t48\t161\t161\n47\t48\t162\t162\n47\t48\t163\t163\n47\t48\t164\t164\n47\t48\t165\t165\n47\t48\t166\t166\n47\t48\t167\t167\n47\t48\t168\t168\n47\t48\t169\t169\n47\t48\t170\t170\n47\t48\t171\t171\n47\t48\t172\t172\n47\t48\t173\t173\n47\t48\t174\t174\n47\t48\t175\t175\n47\t48\t176\t176\n47\t48\t177\t177\n47\t48\t178\t178\n47\t48\t179\t179\n47\t48\t180\t180\n47\t48\t181\t181\n47\t48\t182\t182\n47\t48\t183\t183\n47\t48\t184\t184\n47\t48\t185\t185\n47\t48\t186\t186\n47\t48\t187\t187\n47\t48\t188\t188\n47\t48\t189\t189\n47\t48\t190\t190\n47\t48\t191\t191\n47\t48\t192\t192\n47\t48\t193\t193\n47\t48\t194\t194\n47\t48\t195\t195\n47\t48\t196\t196\n47\t48\t197\t197\n47\t48\t198\t198\n47\t48\t199\t199\n47\t48\t200\t200\n47\t48\t201\t201\n47\t48\t202\t202\n47\t48\t203\t203\n47\t48\t204\t204\n47\t48\t205\t205\n47\t48\t206\t206\n47\t48\t207\t207\n47\t48\t208\t208\n47\t48\t209\t209\n47\t48\t210\t210\n47\t48\t211\t211\n47\t48\t212\t212\n47\t48\t213\t213\n47\t48\t214\t214\n47\t48\t215\t215\n47\t48\t216\t216\n47\t48\t217\t217\n47\t48\t218\t218\n47\t48\t219\t219\n47\t48\t220\t220\n47\t48\t221\t221\n47\t48\t222\t222\n47\t48\t223\t223\n47\t48\t224\t224\n47\t48\t225\t225\n47\t48\t226\t226\n47\t48\t227\t227\n47\t48\t228\t228\n47\t48\t229\t229\n47\t48\t230\t230\n47\t48\t231\t231\n47\t48\t232\t232\n47\t48\t233\t233\n47\t48\t234\t234\n47\t48\t235\t235\n47\t48\t236\t236\n47\t48\t237\t237\n47\t48\t238\t238\n47\t48\t239\t239\n47\t48\t240\t240\n47\t48\t241\t241\n47\t48\t242\t242\n47\t48\t243\t243\n47\t48\t244\t244\n47\t48\t245\t245\n47\t48\t246\t246\n47\t48\t247\t247\n47\t48\t248\t248\n47\t48\t249\t249\n47\t48\t250\t250\n47\t48\t251\t251\n47\t48\t252\t252\n47\t48\t253\t253\n47\t48\t254\t254\n47\t48\t255\t255\n48\t49\t0\t0\n48\t49\t1\t1\n48\t49\t2\t2\n48\t49\t3\t3\n48\t49\t4\t4\n48\t49\t5\t5\n48\t49\t6\t6\n48\t49\t7\t7\n48\t49\t8\t8\n48\t49\t9\t9\n48\t49\t10\t10\n48\t49\t11\t11\n48\t49\t12\t12\n48\t49\t13\t13\n48\t49\t14\t14\n48\t49\t15\t15\n48\t49\t16\t16\n48\t49\t17\t17\n48\t49\t18\t18\n48\t49\t19\t19\n48\t49\t20\t20\n48\t49\t21\t21\n48\t49\t22\t22\n48\t49\t23\t23\n48\t49\t24\t24\n48\t49\t25\t25\n48\t49\t26\t26\n48\t49\t27\t27\n48\t49\t28\t28\n48\t49\t29\t29\n48\t49\t30\t30\n48\t49\t31\t31\n48\t49\t32\t32\n48\t49\t33\t33\n48\t49\t34\t34\n48\t49\t35\t35\n48\t49\t36\t36\n48\t49\t37\t37\n48\t49\t38\t38\n48\t49\t39\t39\n48\t49\t40\t40\n48\t49\t41\t41\n48\t49\t42\t42\n48\t49\t43\t43\n48\t49\t44\t44\n48\t49\t45\t45\n48\t49\t46\t46\n48\t49\t47\t47\n48\t49\t48\t48\n48\t49\t49\t49\n48\t49\t50\t50\n48\t49\t51\t51\n48\t49\t52\t52\n48\t49\t53\t53\n48\t49\t54\t54\n48\t49\t55\t55\n48\t49\t56\t56\n48\t49\t57\t57\n48\t49\t58\t58\n48\t49\t59\t59\n48\t49\t60\t60\n48\t49\t61\t61\n48\t49\t62\t62\n48\t49\t63\t63\n48\t49\t64\t64\n48\t49\t65\t65\n48\t49\t66\t66\n48\t49\t67\t67\n48\t49\t68\t68\n48\t49\t69\t69\n48\t49\t70\t70\n48\t49\t71\t71\n48\t49\t72\t72\n48\t49\t73\t73\n48\t49\t74\t74\n48\t49\t75\t75\n48\t49\t76\t76\n48\t49\t77\t77\n48\t49\t78\t78\n48\t49\t79\t79\n48\t49\t80\t80\n48\t49\t81\t81\n48\t49\t82\t82\n48\t49\t83\t83\n48\t49\t84\t84\n48\t49\t85\t85\n48\t49\t86\t86\n48\t49\t87\t87\n48\t49\t88\t88\n48\t49\t89\t89\n48\t49\t90\t90\n48\t49\t91\t91\n48\t49\t92\t92\n48\t49\t93\t93\n48\t49\t94\t94\n48\t49\t95\t95\n48\t49\t96\t96\n48\t49\t97\t97\n48\t49\t98\t98\n48\t49\t99\t99\n48\t49\t100\t100\n48\t49\t101\t101\n48\t49\t102\t102\n48\t49\t103\t103\n48\t49\t104\t104\n48\t49\t105\t105\n48\t49\t106\t106\n48\t49\t107\t107\n48\t49\t108\t108\n48\t49\t109\t109\n48\t49\t110\t110\n48\t49\t111\t111\n48\t49\t112\t112\n48\t49\t113\t113\n48\t49\t114\t114\n48\t49\t115\t115\n48\t49\t116\t116\n48\t49\t117\t117\n48\t49\t118\t118\n48\t49\t119\t119\n48\t49\t120\t120\n48\t49\t121\t121\n48\t49\t122\t122\n48\t49\t123\t123\n48\t49\t124\t124\n48\t49\t125\t125\n48\t49\t126\t126\n48\t49\t127\t127\n48\t49\t128\t128\n48\t49\t129\t129\n48\t49\t130\t130\n48\t49\t131\t131\n48\t49\t132\t132\n48\t49\t133\t133\n48\t49\t134\t134\n48\t49\t135\t135\n48\t49\t136\t136\n48\t49\t137\t137\n48\t49\t138\t138\n48\t49\t139\t139\n48\t49\t140\t140\n48\t49\t141\t141\n48\t49\t142\t142\n48\t49\t143\t143\n48\t49\t144\t144\n48\t49\t145\t145\n48\t49\t146\t146\n48\t49\t147\t147\n48\t49\t148\t148\n48\t49\t149\t149\n48\t49\t150\t150\n48\t49\t151\t151\n48\t49\t152\t152\n48\t49\t153\t153\n48\t49\t154\t154\n48\t49\t155\t155\n48\t49\t156\t156\n48\t49\t157\t157\n48\t49\t158\t158\n48\t49\t159\t159\n48\t49\t160\t160\n48\t49\t161\t161\n48\t49\t162\t162\n48\t49\t163\t163\n48\t49\t164\t164\n48\t49\t165\t165\n48\t49\t166\t166\n48\t49\t167\t167\n48\t49\t168\t168\n48\t49\t169\t169\n48\t49\t170\t170\n48\t49\t171\t171\n48\t49\t172\t172\n48\t49\t173\t173\n48\t49\t174\t174\n48\t49\t175\t175\n48\t49\t176\t176\n48\t49\t177\t177\n48\t49\t178\t178\n48\t49\t179\t179\n48\t49\t180\t180\n48\t49\t181\t181\n48\t49\t182\t182\n48\t49\t183\t183\n48\t49\t184\t184\n48\t49\t185\t185\n48\t49\t186\t186\n48\t49\t187\t187\n48\t49\t188\t188\n48\t49\t189\t189\n48\t49\t190\t190\n48\t49\t191\t191\n48\t49\t192\t192\n48\t49\t193\t193\n48\t49\t194\t194\n48\t49\t195\t195\n48\t49\t196\t196\n48\t49\t197\t197\n48\t49\t198\t198\n48\t49\t199\t199\n48\t49\t200\t200\n48\t49\t201\t201\n48\t49\t202\t202\n48\t49\t203\t203\n48\t49\t204\t204\n48\t49\t205\t205\n48\t49\t206\t206\n48\t49\t207\t207\n48\t49\t208\t208\n48\t49\t209\t209\n48\t49\t210\t210\n48\t49\t211\t211\n48\t49\t212\t212\n48\t49\t213\t213\n48\t49\t214\t214\n48\t49\t215\t215\n48\t49\t216\t216\n48\t49\t217\t217\n48\t49\t218\t218\n48\t49\t219\t219\n48\t49\t220\t220\n48\t49\t221\t221\n48\t49\t222\t222\n48\t49\t223\t223\n48\t49\t224\t224\n48\t49\t225\t225\n48\t49\t226\t226\n48\t49\t227\t227\n48\t49\t228\t228\n48\t49\t229\t229\n48\t49\t230\t230\n48\t49\t231\t231\n48\t49\t232\t232\n48\t49\t233\t233\n48\t49\t234\t234\n48\t49\t235\t235\n48\t49\t236\t236\n48\t49\t237\t237\n48\t49\t238\t238\n48\t49\t239\t239\n48\t49\t240\t240\n48\t49\t241\t241\n48\t49\t242\t242\n48\t49\t243\t243\n48\t49\t244\t244\n48\t49\t245\t245\n48\t49\t246\t246\n48\t49\t247\t247\n48\t49\t248\t248\n48\t49\t249\t249\n48\t49\t250\t250\n48\t49\t251\t251\n48\t49\t252\t252\n48\t49\t253\t253\n48\t49\t254\t254\n48\t49\t255\t255\n49\t50\t0\t0\n49\t50\t1\t1\n49\t50\t2\t2\n49\t50\t3\t3\n49\t50\t4\t4\n49\t50\t5\t5\n49\t50\t6\t6\n49\t50\t7\t7\n49\t50\t8\t8\n49\t50\t9\t9\n49\t50\t10\t10\n49\t50\t11\t11\n49\t50\t12\t12\n49\t50\t13\t13\n49\t50\t14\t14\n49\t50\t15\t15\n49\t50\t16\t16\n49\t50\t17\t17\n49\t50\t18\t18\n49\t50\t19\t19\n49\t50\t20\t20\n49\t50\t21\t21\n49\t50\t22\t22\n49\t50\t23\t23\n49\t50\t24\t24\n49\t50\t25\t25\n49\t50\t26\t26\n49\t50\t27\t27\n49\t50\t28\t28\n49\t50\t29\t29\n49\t50\t30\t30\n49\t50\t31\t31\n49\t50\t32\t32\n49\t50\t33\t33\n49\t50\t34\t34\n49\t50\t35\t35\n49\t50\t36\t36\n49\t50\t37\t37\n49\t50\t38\t38\n49\t50\t39\t39\n49\t50\t40\t40\n49\t50\t41\t41\n49\t50\t42\t42\n49\t50\t43\t43\n49\t50\t44\t44\n49\t50\t45\t45\n49\t50\t46\t46\n49\t50\t47\t47\n49\t50\t48\t48\n49\t50\t49\t49\n49\t50\t50\t50\n49\t50\t51\t51\n49\t50\t52\t52\n49\t50\t53\t53\n49\t50\t54\t54\n49\t50\t55\t55\n49\t50\t56\t56\n49\t50\t57\t57\n49\t50\t58\t58\n49\t50\t59\t59\n49\t50\t60\t60\n49\t50\t61\t61\n49\t50\t62\t62\n49\t50\t63\t63\n49\t50\t64\t64\n49\t50\t65\t65\n49\t50\t66\t66\n49\t50\t67\t67\n49\t50\t68\t68\n49\t50\t69\t69\n49\t50\t70\t70\n49\t50\t71\t71\n49\t50\t72\t72\n49\t50\t73\t73\n49\t50\t74\t74\n49\t50\t75\t75\n49\t50\t76\t76\n49\t50\t77\t77\n49\t50\t78\t78\n49\t50\t79\t79\n49\t50\t80\t80\n49\t50\t81\t81\n49\t50\t82\t82\n49\t50\t83\t83\n49\t50\t84\t84\n49\t50\t85\t85\n49\t50\t86\t86\n49\t50\t87\t87\n49\t50\t88\t88\n49\t50\t89\t89\n49\t50\t90\t90\n49\t50\t91\t91\n49\t50\t92\t92\n49\t50\t93\t93\n49\t50\t94\t94\n49\t50\t95\t95\n49\t50\t96\t96\n49\t50\t97\t97\n49\t50\t98\t98\n49\t50\t99\t99\n49\t50\t100\t100\n49\t50\t101\t101\n49\t50\t102\t102\n49\t50\t103\t103\n49\t50\t104\t104\n49\t50\t105\t105\n49\t50\t106\t106\n49\t50\t107\t107\n49\t50\t108\t108\n49\t50\t109\t109\n49\t50\t110\t110\n49\t50\t111\t111\n49\t50\t112\t112\n49\t50\t113\t113\n49\t50\t114\t114\n49\t50\t115\t115\n49\t50\t116\t116\n49\t50\t117\t117\n49\t50\t118\t118\n49\t50\t119\t119\n49\t50\t120\t120\n49\t50\t121\t121\n49\t50\t122\t122\n49\t50\t123\t123\n49\t50\t124\t124\n49\t50\t125\t125\n49\t50\t126\t126\n49\t50\t127\t127\n49\t50\t128\t128\n49\t50\t129\t129\n49\t50\t130\t130\n49\t50\t131\t131\n49\t50\t132\t132\n49\t50\t133\t133\n49\t50\t134\t134\n49\t50\t135\t135\n49\t50\t136\t136\n49\t50\t137\t137\n49\t50\t138\t138\n49\t50\t139\t139\n49\t50\t140\t140\n49\t50\t141\t141\n49\t50\t142\t142\n49\t50\t143\t143\n49\t50\t144\t144\n49\t50\t145\t145\n49\t50\t146\t146\n49\t50\t147\t147\n49\t50\t148\t148\n49\t50\t149\t149\n49\t50\t150\t150\n49\t50\t151\t151\n49\t50\t152\t152\n49\t50\t153\t153\n49\t50\t154\t154\n49\t50\t155\t155\n49\t50\t156\t156\n49\t50\t157\t157\n49\t50\t158\t158\n49\t50\t159\t159\n49\t50\t160\t160\n49\t50\t161\t161\n49\t50\t162\t162\n49\t50\t163\t163\n49\t50\t164\t164\n49\t50\t165\t165\n49\t50\t166\t166\n49\t50\t167\t167\n49\t50\t168\t168\n49\t50\t169\t169\n49\t50\t170\t170\n49\t50\t171\t171\n49\t50\t172\t172\n49\t50\t173\t173\n49\t50\t174\t174\n49\t50\t175\t175\n49\t50\t176\t176\n49\t50\t177\t177\n49\t50\t178\t178\n49\t50\t179\t179\n49\t50\t180\t180\n49\t50\t181\t181\n49\t50\t182\t182\n49\t50\t183\t183\n49\t50\t184\t184\n49\t50\t185\t185\n49\t50\t186\t186\n49\t50\t187\t187\n49\t50\t188\t188\n49\t50\t189\t189\n49\t50\t190\t190\n49\t50\t191\t191\n49\t50\t192\t192\n49\t50\t193\t193\n49\t50\t194\t194\n49\t50\t195\t195\n49\t50\t196\t196\n49\t50\t197\t197\n49\t50\t198\t198\n49\t50\t199\t199\n49\t50\t200\t200\n49\t50\t201\t201\n49\t50\t202\t202\n49\t50\t203\t203\n49\t50\t204\t204\n49\t50\t205\t205\n49\t50\t206\t206\n49\t50\t207\t207\n49\t50\t208\t208\n49\t50\t209\t209\n49\t50\t210\t210\n49\t50\t211\t211\n49\t50\t212\t212\n49\t50\t213\t213\n49\t50\t214\t214\n49\t50\t215\t215\n49\t50\t216\t216\n49\t50\t217\t217\n49\t50\t218\t218\n49\t50\t219\t219\n49\t50\t220\t220\n49\t50\t221\t221\n49\t50\t222\t222\n49\t50\t223\t223\n49\t50\t224\t224\n49\t50\t225\t225\n49\t50\t226\t226\n49\t50\t227\t227\n49\t50\t228\t228\n49\t50\t229\t229\n49\t50\t230\t230\n49\t50\t231\t231\n49\t50\t232\t232\n49\t50\t233\t233\n49\t50\t234\t234\n49\t50\t235\t235\n49\t50\t236\t236\n49\t50\t237\t237\n49\t50\t238\t238\n49\t50\t239\t239\n49\t50\t240\t240\n49\t50\t241\t241\n49\t50\t242\t242\n49\t50\t243\t243\n49\t50\t244\t244\n49\t50\t245\t245\n49\t50\t246\t246\n49\t50\t247\t247\n49\t50\t248\t248\n49\t50\t249\t249\n49\t50\t250\t250\n49\t50\t251\t251\n49\t50\t252\t252\n49\t50\t253\t253\n49\t50\t254\t254\n49\t50\t255\t255\n50\t51\t0\t0\n50\t51\t1\t1\n50\t51\t2\t2\n50\t51\t3\t3\n50\t51\t4\t4\n50\t51\t5\t5\n50\t51\t6\t6\n50\t51\t7\t7\n50\t51\t8\t8\n50\t51\t9\t9\n50\t51\t10\t10\n50\t51\t11\t11\n50\t51\t12\t12\n50\t51\t13\t13\n50\t51\t14\t14\n50\t51\t15\t15\n50\t51\t16\t16\n50\t51\t17\t17\n50\t51\t18\t18\n50\t51\t19\t19\n50\t51\t20\t20\n50\t51\t21\t21\n50\t51\t22\t22\n50\t51\t23\t23\n50\t51\t24\t24\n50\t51\t25\t25\n50\t51\t26\t26\n50\t51\t27\t27\n50\t51\t28\t28\n50\t51\t29\t29\n50\t51\t30\t30\n50\t51\t31\t31\n50\t51\t32\t32\n50\t51\t33\t33\n50\t51\t34\t34\n50\t51\t35\t35\n50\t51\t36\t36\n50\t51\t37\t37\n50\t51\t38\t38\n50\t51\t39\t39\n50\t51\t40\t40\n50\t51\t41\t41\n50\t51\t42\t42\n50\t51\t43\t43\n50\t51\t44\t44\n50\t51\t45\t45\n50\t51\t46\t46\n50\t51\t47\t47\n50\t51\t48\t48\n50\t51\t49\t49\n50\t51\t50\t50\n50\t51\t51\t51\n50\t51\t52\t52\n50\t51\t53\t53\n50\t51\t54\t54\n50\t51\t55\t55\n50\t51\t56\t56\n50\t51\t57\t57\n50\t51\t58\t58\n50\t51\t59\t59\n50\t51\t60\t60\n50\t51\t61\t61\n50\t51\t62\t62\n50\t51\t63\t63\n50\t51\t64\t64\n50\t51\t65\t65\n50\t51\t66\t66\n50\t51\t67\t67\n50\t51\t68\t68\n50\t51\t69\t69\n50\t51\t70\t70\n50\t51\t71\t71\n50\t51\t72\t72\n50\t51\t73\t73\n50\t51\t74\t74\n50\t51\t75\t75\n50\t51\t76\t76\n50\t51\t77\t77\n50\t51\t78\t78\n50\t51\t79\t79\n50\t51\t80\t80\n50\t51\t81\t81\n50\t51\t82\t82\n50\t51\t83\t83\n50\t51\t84\t84\n50\t51\t85\t85\n50\t51\t86\t86\n50\t51\t87\t87\n50\t51\t88\t88\n50\t51\t89\t89\n50\t51\t90\t90\n50\t51\t91\t91\n50\t51\t92\t92\n50\t51\t93\t93\n50\t51\t94\t94\n50\t51\t95\t95\n50\t51\t96\t96\n50\t51\t97\t97\n50\t51\t98\t98\n50\t51\t99\t99\n50\t51\t100\t100\n50\t51\t101\t101\n50\t51\t102\t102\n50\t51\t103\t103\n50\t51\t104\t104\n50\t51\t105\t105\n50\t51\t106\t106\n50\t51\t107\t107\n50\t51\t108\t108\n50\t51\t109\t109\n50\t51\t110\t110\n50\t51\t111\t111\n50\t51\t112\t112\n50\t51\t113\t113\n50\t51\t114\t114\n50\t51\t115\t115\n50\t51\t116\t116\n50\t51\t117\t117\n50\t51\t118\t118\n50\t51\t119\t119\n50\t51\t120\t120\n50\t51\t121\t121\n50\t51\t122\t122\n50\t51\t123\t123\n50\t51\t124\t124\n50\t51\t125\t125\n50\t51\t126\t126\n50\t51\t127\t127\n50\t51\t128\t128\n50\t51\t129\t129\n50\t51\t130\t130\n50\t51\t131\t131\n50\t51\t132\t132\n50\t51\t133\t133\n50\t51\t134\t134\n50\t51\t135\t135\n50\t51\t136\t136\n50\t51\t137\t137\n50\t51\t138\t138\n50\t51\t139\t139\n50\t51\t140\t140\n50\t51\t141\t141\n50\t51\t142\t142\n50\t51\t143\t143\n50\t51\t144\t144\n50\t51\t145\t145\n50\t51\t146\t146\n50\t51\t147\t147\n50\t51\t148\t148\n50\t51\t149\t149\n50\t51\t150\t150\n50\t51\t151\t151\n50\t51\t152\t152\n50\t51\t153\t153\n50\t51\t154\t154\n50\t51\t155\t155\n50\t51\t156\t156\n50\t51\t157\t157\n50\t51\t158\t158\n50\t51\t159\t159\n50\t51\t160\t160\n50\t51\t161\t161\n50\t51\t162\t162\n50\t51\t163\t163\n50\t51\t164\t164\n50\t51\t165\t165\n50\t51\t166\t166\n50\t51\t167\t167\n50\t51\t168\t168\n50\t51\t169\t169\n50\t51\t170\t170\n50\t51\t171\t171\n50\t51\t172\t172\n50\t51\t173\t173\n50\t51\t174\t174\n50\t51\t175\t175\n50\t51\t176\t176\n50\t51\t177\t177\n50\t51\t178\t178\n50\t51\t179\t179\n50\t51\t180\t180\n50\t51\t181\t181\n50\t51\t182\t182\n50\t51\t183\t183\n50\t51\t184\t184\n50\t51\t185\t185\n50\t51\t186\t186\n50\t51\t187\t187\n50\t51\t188\t188\n50\t51\t189\t189\n50\t51\t190\t190\n50\t51\t191\t191\n50\t51\t192\t192\n50\t51\t193\t193\n50\t51\t194\t194\n50\t51\t195\t195\n50\t51\t196\t196\n50\t51\t197\t197\n50\t51\t198\t198\n50\t51\t199\t199\n50\t51\t200\t200\n50\t51\t201\t201\n50\t51\t202\t202\n50\t51\t203\t203\n50\t51\t204\t204\n50\t51\t205\t205\n50\t51\t206\t206\n50\t51\t207\t207\n50\t51\t208\t208\n50\t51\t209\t209\n50\t51\t210\t210\n50\t51\t211\t211\n50\t51\t212\t212\n50\t51\t213\t213\n50\t51\t214\t214\n50\t51\t215\t215\n50\t51\t216\t216\n50\t51\t217\t217\n50\t51\t218\t218\n50\t51\t219\t219\n50\t51\t220\t220\n50\t51\t221\t221\n50\t51\t222\t222\n50\t51\t223\t223\n50\t51\t224\t224\n50\t51\t225\t225\n50\t51\t226\t226\n50\t51\t227\t227\n50\t51\t228\t228\n50\t51\t229\t229\n50\t51\t230\t230\n50\t51\t231\t231\n50\t51\t232\t232\n50\t51\t233\t233\n50\t51\t234\t234\n50\t51\t235\t235\n50\t51\t236\t236\n50\t51\t237\t237\n50\t51\t238\t238\n50\t51\t239\t239\n50\t51\t240\t240\n50\t51\t241\t241\n50\t51\t242\t242\n50\t51\t243\t243\n50\t51\t244\t244\n50\t51\t245\t245\n50\t51\t246\t246\n50\t51\t247\t247\n50\t51\t248\t248\n50\t51\t249\t249\n50\t51\t250\t250\n50\t51\t251\t251\n50\t51\t252\t252\n50\t51\t253\t253\n50\t51\t254\t254\n50\t51\t255\t255\n51\t52\t0\t0\n51\t52\t1\t1\n51\t52\t2\t2\n51\t52\t3\t3\n51\t52\t4\t4\n51\t52\t5\t5\n51\t52\t6\t6\n51\t52\t7\t7\n51\t52\t8\t8\n51\t52\t9\t9\n51\t52\t10\t10\n51\t52\t11\t11\n51\t52\t12\t12\n51\t52\t13\t13\n51\t52\t14\t14\n51\t52\t15\t15\n51\t52\t16\t16\n51\t52\t17\t17\n51\t52\t18\t18\n51\t52\t19\t19\n51\t52\t20\t20\n51\t52\t21\t21\n51\t52\t22\t22\n51\t52\t23\t23\n51\t52\t24\t24\n51\t52\t25\t25\n51\t52\t26\t26\n51\t52\t27\t27\n51\t52\t28\t28\n51\t52\t29\t29\n51\t52\t30\t30\n51\t52\t31\t31\n51\t52\t32\t32\n51\t52\t33\t33\n51\t52\t34\t34\n51\t52\t35\t35\n51\t52\t36\t36\n51\t52\t37\t37\n51\t52\t38\t38\n51\t52\t39\t39\n51\t52\t40\t40\n51\t52\t41\t41\n51\t52\t42\t42\n51\t52\t43\t43\n51\t52\t44\t44\n51\t52\t45\t45\n51\t52\t46\t46\n51\t52\t47\t47\n51\t52\t48\t48\n51\t52\t49\t49\n51\t52\t50\t50\n51\t52\t51\t51\n51\t52\t52\t52\n51\t52\t53\t53\n51\t52\t54\t54\n51\t52\t55\t55\n51\t52\t56\t56\n51\t52\t57\t57\n51\t52\t58\t58\n51\t52\t59\t59\n51\t52\t60\t60\n51\t52\t61\t61\n51\t52\t62\t62\n51\t52\t63\t63\n51\t52\t64\t64\n51\t52\t65\t65\n51\t52\t66\t66\n51\t52\t67\t67\n51\t52\t68\t68\n51\t52\t69\t69\n51\t52\t70\t70\n51\t52\t71\t71\n51\t52\t72\t72\n51\t52\t73\t73\n51\t52\t74\t74\n51\t52\t75\t75\n51\t52\t76\t76\n51\t52\t77\t77\n51\t52\t78\t78\n51\t52\t79\t79\n51\t52\t80\t80\n51\t52\t81\t81\n51\t52\t82\t82\n51\t52\t83\t83\n51\t52\t84\t84\n51\t52\t85\t85\n51\t52\t86\t86\n51\t52\t87\t87\n51\t52\t88\t88\n51\t52\t89\t89\n51\t52\t90\t90\n51\t52\t91\t91\n51\t52\t92\t92\n51\t52\t93\t93\n51\t52\t94\t94\n51\t52\t95\t95\n51\t52\t96\t96\n51\t52\t97\t97\n51\t52\t98\t98\n51\t52\t99\t99\n51\t52\t100\t100\n51\t52\t101\t101\n51\t52\t102\t102\n51\t52\t103\t103\n51\t52\t104\t104\n51\t52\t105\t105\n51\t52\t106\t106\n51\t52\t107\t107\n51\t52\t108\t108\n51\t52\t109\t109\n51\t52\t110\t110\n51\t52\t111\t111\n51\t52\t112\t112\n51\t52\t113\t113\n51\t52\t114\t114\n51\t52\t115\t115\n51\t52\t116\t116\n51\t52\t117\t117\n51\t52\t118\t118\n51\t52\t119\t119\n51\t52\t120\t120\n51\t52\t121\t121\n51\t52\t122\t122\n51\t52\t123\t123\n51\t52\t124\t124\n51\t52\t125\t125\n51\t52\t126\t126\n51\t52\t127\t127\n51\t52\t128\t128\n51\t52\t129\t129\n51\t52\t130\t130\n51\t52\t131\t131\n51\t52\t132\t132\n51\t52\t133\t133\n51\t52\t134\t134\n51\t52\t135\t135\n51\t52\t136\t136\n51\t52\t137\t137\n51\t52\t138\t138\n51\t52\t139\t139\n51\t52\t140\t140\n51\t52\t141\t141\n51\t52\t142\t142\n51\t52\t143\t143\n51\t52\t144\t144\n51\t52\t145\t145\n51\t52\t146\t146\n51\t52\t147\t147\n51\t52\t148\t148\n51\t52\t149\t149\n51\t52\t150\t150\n51\t52\t151\t151\n51\t52\t152\t152\n51\t52\t153\t153\n51\t52\t154\t154\n51\t52\t155\t155\n51\t52\t156\t156\n51\t52\t157\t157\n51\t52\t158\t158\n51\t52\t159\t159\n51\t52\t160\t160\n51\t52\t161\t161\n51\t52\t162\t162\n51\t52\t163\t163\n51\t52\t164\t164\n51\t52\t165\t165\n51\t52\t166\t166\n51\t52\t167\t167\n51\t52\t168\t168\n51\t52\t169\t169\n51\t52\t170\t170\n51\t52\t171\t171\n51\t52\t172\t172\n51\t52\t173\t173\n51\t52\t174\t174\n51\t52\t175\t175\n51\t52\t176\t176\n51\t52\t177\t177\n51\t52\t178\t178\n51\t52\t179\t179\n51\t52\t180\t180\n51\t52\t181\t181\n51\t52\t182\t182\n51\t52\t183\t183\n51\t52\t184\t184\n51\t52\t185\t185\n51\t52\t186\t186\n51\t52\t187\t187\n51\t52\t188\t188\n51\t52\t189\t189\n51\t52\t190\t190\n51\t52\t191\t191\n51\t52\t192\t192\n51\t52\t193\t193\n51\t52\t194\t194\n51\t52\t195\t195\n51\t52\t196\t196\n51\t52\t197\t197\n51\t52\t198\t198\n51\t52\t199\t199\n51\t52\t200\t200\n51\t52\t201\t201\n51\t52\t202\t202\n51\t52\t203\t203\n51\t52\t204\t204\n51\t52\t205\t205\n51\t52\t206\t206\n51\t52\t207\t207\n51\t52\t208\t208\n51\t52\t209\t209\n51\t52\t210\t210\n51\t52\t211\t211\n51\t52\t212\t212\n51\t52\t213\t213\n51\t52\t214\t214\n51\t52\t215\t215\n51\t52\t216\t216\n51\t52\t217\t217\n51\t52\t218\t218\n51\t52\t219\t219\n51\t52\t220\t220\n51\t52\t221\t221\n51\t52\t222\t222\n51\t52\t223\t223\n51\t52\t224\t224\n51\t52\t225\t225\n51\t52\t226\t226\n51\t52\t227\t227\n51\t52\t228\t228\n51\t52\t229\t229\n51\t52\t230\t230\n51\t52\t231\t231\n51\t52\t232\t232\n51\t52\t233\t233\n51\t52\t234\t234\n51\t52\t235\t235\n51\t52\t236\t236\n51\t52\t237\t237\n51\t52\t238\t238\n51\t52\t239\t239\n51\t52\t240\t240\n51\t52\t241\t241\n51\t52\t242\t242\n51\t52\t243\t243\n51\t52\t244\t244\n51\t52\t245\t245\n51\t52\t246\t246\n51\t52\t247\t247\n51\t52\t248\t248\n51\t52\t249\t249\n51\t52\t250\t250\n51\t52\t251\t251\n51\t52\t252\t252\n51\t52\t253\t253\n51\t52\t254\t254\n51\t52\t255\t255\n52\t53\t0\t0\n52\t53\t1\t1\n52\t53\t2\t2\n52\t53\t3\t3\n52\t53\t4\t4\n52\t53\t5\t5\n52\t53\t6\t6\n52\t53\t7\t7\n52\t53\t8\t8\n52\t53\t9\t9\n52\t53\t10\t10\n52\t53\t11\t11\n52\t53\t12\t12\n52\t53\t13\t13\n52\t53\t14\t14\n52\t53\t15\t15\n52\t53\t16\t16\n52\t53\t17\t17\n52\t53\t18\t18\n52\t53\t19\t19\n52\t53\t20\t20\n52\t53\t21\t21\n52\t53\t22\t22\n52\t53\t23\t23\n52\t53\t24\t24\n52\t53\t25\t25\n52\t53\t26\t26\n52\t53\t27\t27\n52\t53\t28\t28\n52\t53\t29\t29\n52\t53\t30\t30\n52\t53\t31\t31\n52\t53\t32\t32\n52\t53\t33\t33\n52\t53\t34\t34\n52\t53\t35\t35\n52\t53\t36\t36\n52\t53\t37\t37\n52\t53\t38\t38\n52\t53\t39\t39\n52\t53\t40\t40\n52\t53\t41\t41\n52\t53\t42\t42\n52\t53\t43\t43\n52\t53\t44\t44\n52\t53\t45\t45\n52\t53\t46\t46\n52\t53\t47\t47\n52\t53\t48\t48\n52\t53\t49\t49\n52\t53\t50\t50\n52\t53\t51\t51\n52\t53\t52\t52\n52\t53\t53\t53\n52\t53\t54\t54\n52\t53\t55\t55\n52\t53\t56\t56\n52\t53\t57\t57\n52\t53\t58\t58\n52\t53\t59\t59\n52\t53\t60\t60\n52\t53\t61\t61\n52\t53\t62\t62\n52\t53\t63\t63\n52\t53\t64\t64\n52\t53\t65\t65\n52\t53\t66\t66\n52\t53\t67\t67\n52\t53\t68\t68\n52\t53\t69\t69\n52\t53\t70\t70\n52\t53\t71\t71\n52\t53\t72\t72\n52\t53\t73\t73\n52\t53\t74\t74\n52\t53\t75\t75\n52\t53\t76\t76\n52\t53\t77\t77\n52\t53\t78\t78\n52\t53\t79\t79\n52\t53\t80\t80\n52\t53\t81\t81\n52\t53\t82\t82\n52\t53\t83\t83\n52\t53\t84\t84\n52\t53\t85\t85\n52\t53\t86\t86\n52\t53\t87\t87\n52\t53\t88\t88\n52\t53\t89\t89\n52\t53\t90\t90\n52\t53\t91\t91\n52\t53\t92\t92\n52\t53\t93\t93\n52\t53\t94\t94\n52\t53\t95\t95\n52\t53\t96\t96\n52\t53\t97\t97\n52\t53\t98\t98\n52\t53\t99\t99\n52\t53\t100\t100\n52\t53\t101\t101\n52\t53\t102\t102\n52\t53\t103\t103\n52\t53\t104\t104\n52\t53\t105\t105\n52\t53\t106\t106\n52\t53\t107\t107\n52\t53\t108\t108\n52\t53\t109\t109\n52\t53\t110\t110\n52\t53\t111\t111\n52\t53\t112\t112\n52\t53\t113\t113\n52\t53\t114\t114\n52\t53\t115\t115\n52\t53\t116\t116\n52\t53\t117\t117\n52\t53\t118\t118\n52\t53\t119\t119\n52\t53\t120\t120\n52\t53\t121\t121\n52\t53\t122\t122\n52\t53\t123\t123\n52\t53\t124\t124\n52\t53\t125\t125\n52\t53\t126\t126\n52\t53\t127\t127\n52\t53\t128\t128\n52\t53\t129\t129\n52\t53\t130\t130\n52\t53\t131\t131\n52\t53\t132\t132\n52\t53\t133\t133\n52\t53\t134\t134\n52\t53\t135\t135\n52\t53\t136\t136\n52\t53\t137\t137\n52\t53\t138\t138\n52\t53\t139\t139\n52\t53\t140\t140\n52\t53\t141\t141\n52\t53\t142\t142\n52\t53\t143\t143\n52\t53\t144\t144\n52\t53\t145\t145\n52\t53\t146\t146\n52\t53\t147\t147\n52\t53\t148\t148\n52\t53\t149\t149\n52\t53\t150\t150\n52\t53\t151\t151\n52\t53\t152\t152\n52\t53\t153\t153\n52\t53\t154\t154\n52\t53\t155\t155\n52\t53\t156\t156\n52\t53\t157\t157\n52\t53\t158\t158\n52\t53\t159\t159\n52\t53\t160\t160\n52\t53\t161\t161\n52\t53\t162\t162\n52\t53\t163\t163\n52\t53\t164\t164\n52\t53\t165\t165\n52\t53\t166\t166\n52\t53\t167\t167\n52\t53\t168\t168\n52\t53\t169\t169\n52\t53\t170\t170\n52\t53\t171\t171\n52\t53\t172\t172\n52\t53\t173\t173\n52\t53\t174\t174\n52\t53\t175\t175\n52\t53\t176\t176\n52\t53\t177\t177\n52\t53\t178\t178\n52\t53\t179\t179\n52\t53\t180\t180\n52\t53\t181\t181\n52\t53\t182\t182\n52\t53\t183\t183\n52\t53\t184\t184\n52\t53\t185\t185\n52\t53\t186\t186\n52\t53\t187\t187\n52\t53\t188\t188\n52\t53\t189\t189\n52\t53\t190\t190\n52\t53\t191\t191\n52\t53\t192\t192\n52\t53\t193\t193\n52\t53\t194\t194\n52\t53\t195\t195\n52\t53\t196\t196\n52\t53\t197\t197\n52\t53\t198\t198\n52\t53\t199\t199\n52\t53\t200\t200\n52\t53\t201\t201\n52\t53\t202\t202\n52\t53\t203\t203\n52\t53\t204\t204\n52\t53\t205\t205\n52\t53\t206\t206\n52\t53\t207\t207\n52\t53\t208\t208\n52\t53\t209\t209\n52\t53\t210\t210\n52\t53\t211\t211\n52\t53\t212\t212\n52\t53\t213\t213\n52\t53\t214\t214\n52\t53\t215\t215\n52\t53\t216\t216\n52\t53\t217\t217\n52\t53\t218\t218\n52\t53\t219\t219\n52\t53\t220\t220\n52\t53\t221\t221\n52\t53\t222\t222\n52\t53\t223\t223\n52\t53\t224\t224\n52\t53\t225\t225\n52\t53\t226\t226\n52\t53\t227\t227\n52\t53\t228\t228\n52\t53\t229\t229\n52\t53\t230\t230\n52\t53\t231\t231\n52\t53\t232\t232\n52\t53\t233\t233\n52\t53\t234\t234\n52\t53\t235\t235\n52\t53\t236\t236\n52\t53\t237\t237\n52\t53\t238\t238\n52\t53\t239\t239\n52\t53\t240\t240\n52\t53\t241\t241\n52\t53\t242\t242\n52\t53\t243\t243\n52\t53\t244\t244\n52\t53\t245\t245\n52\t53\t246\t246\n52\t53\t247\t247\n52\t53\t248\t248\n52\t53\t249\t249\n52\t53\t250\t250\n52\t53\t251\t251\n52\t53\t252\t252\n52\t53\t253\t253\n52\t53\t254\t254\n52\t53\t255\t255\n53\t54\t0\t0\n53\t54\t1\t1\n53\t54\t2\t2\n53\t54\t3\t3\n53\t54\t4\t4\n53\t54\t5\t5\n53\t54\t6\t6\n53\t54\t7\t7\n53\t54\t8\t8\n53\t54\t9\t9\n53\t54\t10\t10\n53\t54\t11\t11\n53\t54\t12\t12\n53\t54\t13\t13\n53\t54\t14\t14\n53\t54\t15\t15\n53\t54\t16\t16\n53\t54\t17\t17\n53\t54\t18\t18\n53\t54\t19\t19\n53\t54\t20\t20\n53\t54\t21\t21\n53\t54\t22\t22\n53\t54\t23\t23\n53\t54\t24\t24\n53\t54\t25\t25\n53\t54\t26\t26\n53\t54\t27\t27\n53\t54\t28\t28\n53\t54\t29\t29\n53\t54\t30\t30\n53\t54\t31\t31\n53\t54\t32\t32\n53\t54\t33\t33\n53\t54\t34\t34\n53\t54\t35\t35\n53\t54\t36\t36\n53\t54\t37\t37\n53\t54\t38\t38\n53\t54\t39\t39\n53\t54\t40\t40\n53\t54\t41\t41\n53\t54\t42\t42\n53\t54\t43\t43\n53\t54\t44\t44\n53\t54\t45\t45\n53\t54\t46\t46\n53\t54\t47\t47\n53\t54\t48\t48\n53\t54\t49\t49\n53\t54\t50\t50\n53\t54\t51\t51\n53\t54\t52\t52\n53\t54\t53\t53\n53\t54\t54\t54\n53\t54\t55\t55\n53\t54\t56\t56\n53\t54\t57\t57\n53\t54\t58\t58\n53\t54\t59\t59\n53\t54\t60\t60\n53\t54\t61\t61\n53\t54\t62\t62\n53\t54\t63\t63\n53\t54\t64\t64\n53\t54\t65\t65\n53\t54\t66\t66\n53\t54\t67\t67\n53\t54\t68\t68\n53\t54\t69\t69\n53\t54\t70\t70\n53\t54\t71\t71\n53\t54\t72\t72\n53\t54\t73\t73\n53\t54\t74\t74\n53\t54\t75\t75\n53\t54\t76\t76\n53\t54\t77\t77\n53\t54\t78\t78\n53\t54\t79\t79\n53\t54\t80\t80\n53\t54\t81\t81\n53\t54\t82\t82\n53\t54\t83\t83\n53\t54\t84\t84\n53\t54\t85\t85\n53\t54\t86\t86\n53\t54\t87\t87\n53\t54\t88\t88\n53\t54\t89\t89\n53\t54\t90\t90\n53\t54\t91\t91\n53\t54\t92\t92\n53\t54\t93\t93\n53\t54\t94\t94\n53\t54\t95\t95\n53\t54\t96\t96\n53\t54\t97\t97\n53\t54\t98\t98\n53\t54\t99\t99\n53\t54\t100\t100\n53\t54\t101\t101\n53\t54\t102\t102\n53\t54\t103\t103\n53\t54\t104\t104\n53\t54\t105\t105\n53\t54\t106\t106\n53\t54\t107\t107\n53\t54\t108\t108\n53\t54\t109\t109\n53\t54\t110\t110\n53\t54\t111\t111\n53\t54\t112\t112\n53\t54\t113\t113\n53\t54\t114\t114\n53\t54\t115\t115\n53\t54\t116\t116\n53\t54\t117\t117\n53\t54\t118\t118\n53\t54\t119\t119\n53\t54\t120\t120\n53\t54\t121\t121\n53\t54\t122\t122\n53\t54\t123\t123\n53\t54\t124\t124\n53\t54\t125\t125\n53\t54\t126\t126\n53\t54\t127\t127\n53\t54\t128\t128\n53\t54\t129\t129\n53\t54\t130\t130\n53\t54\t131\t131\n53\t54\t132\t132\n53\t54\t133\t133\n53\t54\t134\t134\n53\t54\t135\t135\n53\t54\t136\t136\n53\t54\t137\t137\n53\t54\t138\t138\n53\t54\t139\t139\n53\t54\t140\t140\n53\t54\t141\t141\n53\t54\t142\t142\n53\t54\t143\t143\n53\t54\t144\t144\n53\t54\t145\t145\n53\t54\t146\t146\n53\t54\t147\t147\n53\t54\t148\t148\n53\t54\t149\t149\n53\t54\t150\t150\n53\t54\t151\t151\n53\t54\t152\t152\n53\t54\t153\t153\n53\t54\t154\t154\n53\t54\t155\t155\n53\t54\t156\t156\n53\t54\t157\t157\n53\t54\t158\t158\n53\t54\t159\t159\n53\t54\t160\t160\n53\t54\t161\t161\n53\t54\t162\t162\n53\t54\t163\t163\n53\t54\t164\t164\n53\t54\t165\t165\n53\t54\t166\t166\n53\t54\t167\t167\n53\t54\t168\t168\n53\t54\t169\t169\n53\t54\t170\t170\n53\t54\t171\t171\n53\t54\t172\t172\n53\t54\t173\t173\n53\t54\t174\t174\n53\t54\t175\t175\n53\t54\t176\t176\n53\t54\t177\t177\n53\t54\t178\t178\n53\t54\t179\t179\n53\t54\t180\t180\n53\t54\t181\t181\n53\t54\t182\t182\n53\t54\t183\t183\n53\t54\t184\t184\n53\t54\t185\t185\n53\t54\t186\t186\n53\t54\t187\t187\n53\t54\t188\t188\n53\t54\t189\t189\n53\t54\t190\t190\n53\t54\t191\t191\n53\t54\t192\t192\n53\t54\t193\t193\n53\t54\t194\t194\n53\t54\t195\t195\n53\t54\t196\t196\n53\t54\t197\t197\n53\t54\t198\t198\n53\t54\t199\t199\n53\t54\t200\t200\n53\t54\t201\t201\n53\t54\t202\t202\n53\t54\t203\t203\n53\t54\t204\t204\n53\t54\t205\t205\n53\t54\t206\t206\n53\t54\t207\t207\n53\t54\t208\t208\n53\t54\t209\t209\n53\t54\t210\t210\n53\t54\t211\t211\n53\t54\t212\t212\n53\t54\t213\t213\n53\t54\t214\t214\n53\t54\t215\t215\n53\t54\t216\t216\n53\t54\t217\t217\n53\t54\t218\t218\n53\t54\t219\t219\n53\t54\t220\t220\n53\t54\t221\t221\n53\t54\t222\t222\n53\t54\t223\t223\n53\t54\t224\t224\n53\t54\t225\t225\n53\t54\t226\t226\n53\t54\t227\t227\n53\t54\t228\t228\n53\t54\t229\t229\n53\t54\t230\t230\n53\t54\t231\t231\n53\t54\t232\t232\n53\t54\t233\t233\n53\t54\t234\t234\n53\t54\t235\t235\n53\t54\t236\t236\n53\t54\t237\t237\n53\t54\t238\t238\n53\t54\t239\t239\n53\t54\t240\t240\n53\t54\t241\t241\n53\t54\t242\t242\n53\t54\t243\t243\n53\t54\t244\t244\n53\t54\t245\t245\n53\t54\t246\t246\n53\t54\t247\t247\n53\t54\t248\t248\n53\t54\t249\t249\n53\t54\t250\t250\n53\t54\t251\t251\n53\t54\t252\t252\n53\t54\t253\t253\n53\t54\t254\t254\n53\t54\t255\t255\n54\t55\t0\t0\n54\t55\t1\t1\n54\t55\t2\t2\n54\t55\t3\t3\n54\t55\t4\t4\n54\t55\t5\t5\n54\t55\t6\t6\n54\t55\t7\t7\n54\t55\t8\t8\n54\t55\t9\t9\n54\t55\t10\t10\n54\t55\t11\t11\n54\t55\t12\t12\n54\t55\t13\t13\n54\t55\t14\t14\n54\t55\t15\t15\n54\t55\t16\t16\n54\t55\t17\t17\n54\t55\t18\t18\n54\t55\t19\t19\n54\t55\t20\t20\n54\t55\t21\t21\n54\t55\t22\t22\n54\t55\t23\t23\n54\t55\t24\t24\n54\t55\t25\t25\n54\t55\t26\t26\n54\t55\t27\t27\n54\t55\t28\t28\n54\t55\t29\t29\n54\t55\t30\t30\n54\t55\t31\t31\n54\t55\t32\t32\n54\t55\t33\t33\n54\t55\t34\t34\n54\t55\t35\t35\n54\t55\t36\t36\n54\t55\t37\t37\n54\t55\t38\t38\n54\t55\t39\t39\n54\t55\t40\t40\n54\t55\t41\t41\n54\t55\t42\t42\n54\t55\t43\t43\n54\t55\t44\t44\n54\t55\t45\t45\n54\t55\t46\t46\n54\t55\t47\t47\n54\t55\t48\t48\n54\t55\t49\t49\n54\t55\t50\t50\n54\t55\t51\t51\n54\t55\t52\t52\n54\t55\t53\t53\n54\t55\t54\t54\n54\t55\t55\t55\n54\t55\t56\t56\n54\t55\t57\t57\n54\t55\t58\t58\n54\t55\t59\t59\n54\t55\t60\t60\n54\t55\t61\t61\n54\t55\t62\t62\n54\t55\t63\t63\n54\t55\t64\t64\n54\t55\t65\t65\n54\t55\t66\t66\n54\t55\t67\t67\n54\t55\t68\t68\n54\t55\t69\t69\n54\t55\t70\t70\n54\t55\t71\t71\n54\t55\t72\t72\n54\t55\t73\t73\n54\t55\t74\t74\n54\t55\t75\t75\n54\t55\t76\t76\n54\t55\t77\t77\n54\t55\t78\t78\n54\t55\t79\t79\n54\t55\t80\t80\n54\t55\t81\t81\n54\t55\t82\t82\n54\t55\t83\t83\n54\t55\t84\t84\n54\t55\t85\t85\n54\t55\t86\t86\n54\t55\t87\t87\n54\t55\t88\t88\n54\t55\t89\t89\n54\t55\t90\t90\n54\t55\t91\t91\n54\t55\t92\t92\n54\t55\t93\t93\n54\t55\t94\t94\n54\t55\t95\t95\n54\t55\t96\t96\n54\t55\t97\t97\n54\t55\t98\t98\n54\t55\t99\t99\n54\t55\t100\t100\n54\t55\t101\t101\n54\t55\t102\t102\n54\t55\t103\t103\n54\t55\t104\t104\n54\t55\t105\t105\n54\t55\t106\t106\n54\t55\t107\t107\n54\t55\t108\t108\n54\t55\t109\t109\n54\t55\t110\t110\n54\t55\t111\t111\n54\t55\t112\t112\n54\t55\t113\t113\n54\t55\t114\t114\n54\t55\t115\t115\n54\t55\t116\t116\n54\t55\t117\t117\n54\t55\t118\t118\n54\t55\t119\t119\n54\t55\t120\t120\n54\t55\t121\t121\n54\t55\t122\t122\n54\t55\t123\t123\n54\t55\t124\t124\n54\t55\t125\t125\n54\t55\t126\t126\n54\t55\t127\t127\n54\t55\t128\t128\n54\t55\t129\t129\n54\t55\t130\t130\n54\t55\t131\t131\n54\t55\t132\t132\n54\t55\t133\t133\n54\t55\t134\t134\n54\t55\t135\t135\n54\t55\t136\t136\n54\t55\t137\t137\n54\t55\t138\t138\n54\t55\t139\t139\n54\t55\t140\t140\n54\t55\t141\t141\n54\t55\t142\t142\n54\t55\t143\t143\n54\t55\t144\t144\n54\t55\t145\t145\n54\t55\t146\t146\n54\t55\t147\t147\n54\t55\t148\t148\n54\t55\t149\t149\n54\t55\t150\t150\n54\t55\t151\t151\n54\t55\t152\t152\n54\t55\t153\t153\n54\t55\t154\t154\n54\t55\t155\t155\n54\t55\t156\t156\n54\t55\t157\t157\n54\t55\t158\t158\n54\t55\t159\t159\n54\t55\t160\t160\n54\t55\t161\t161\n54\t55\t162\t162\n54\t55\t163\t163\n54\t55\t164\t164\n54\t55\t165\t165\n54\t55\t166\t166\n54\t55\t167\t167\n54\t55\t168\t168\n54\t55\t169\t169\n54\t55\t170\t170\n54\t55\t171\t171\n54\t55\t172\t172\n54\t55\t173\t173\n54\t55\t174\t174\n54\t55\t175\t175\n54\t55\t176\t176\n54\t55\t177\t177\n54\t55\t178\t178\n54\t55\t179\t179\n54\t55\t180\t180\n54\t55\t181\t181\n54\t55\t182\t182\n54\t55\t183\t183\n54\t55\t184\t184\n54\t55\t185\t185\n54\t55\t186\t186\n54\t55\t187\t187\n54\t55\t188\t188\n54\t55\t189\t189\n54\t55\t190\t190\n54\t55\t191\t191\n54\t55\t192\t192\n54\t55\t193\t193\n54\t55\t194\t194\n54\t55\t195\t195\n54\t55\t196\t196\n54\t55\t197\t197\n54\t55\t198\t198\n54\t55\t199\t199\n54\t55\t200\t200\n54\t55\t201\t201\n54\t55\t202\t202\n54\t55\t203\t203\n54\t55\t204\t204\n54\t55\t205\t205\n54\t55\t206\t206\n54\t55\t207\t207\n54\t55\t208\t208\n54\t55\t209\t209\n54\t55\t210\t210\n54\t55\t211\t211\n54\t55\t212\t212\n54\t55\t213\t213\n54\t55\t214\t214\n54\t55\t215\t215\n54\t55\t216\t216\n54\t55\t217\t217\n54\t55\t218\t218\n54\t55\t219\t219\n54\t55\t220\t220\n54\t55\t221\t221\n54\t55\t222\t222\n54\t55\t223\t223\n54\t55\t224\t224\n54\t55\t225\t225\n54\t55\t226\t226\n54\t55\t227\t227\n54\t55\t228\t228\n54\t55\t229\t229\n54\t55\t230\t230\n54\t55\t231\t231\n54\t55\t232\t232\n54\t55\t233\t233\n54\t55\t234\t234\n54\t55\t235\t235\n54\t55\t236\t236\n54\t55\t237\t237\n54\t55\t238\t238\n54\t55\t239\t239\n54\t55\t240\t240\n54\t55\t241\t241\n54\t55\t242\t242\n54\t55\t243\t243\n54\t55\t244\t244\n54\t55\t245\t245\n54\t55\t246\t246\n54\t55\t247\t247\n54\t55\t248\t248\n54\t55\t249\t249\n54\t55\t250\t250\n54\t55\t251\t251\n54\t55\t252\t252\n54\t55\t253\t253\n54\t55\t254\t254\n54\t55\t255\t255\n55\t56\t0\t0\n55\t56\t1\t1\n55\t56\t2\t2\n55\t56\t3\t3\n55\t56\t4\t4\n55\t56\t5\t5\n55\t56\t6\t6\n55\t56\t7\t7\n55\t56\t8\t8\n55\t56\t9\t9\n55\t56\t10\t10\n55\t56\t11\t11\n55\t56\t12\t12\n55\t56\t13\t13\n55\t56\t14\t14\n55\t56\t15\t15\n55\t56\t16\t16\n55\t56\t17\t17\n55\t56\t18\t18\n55\t56\t19\t19\n55\t56\t20\t20\n55\t56\t21\t21\n55\t56\t22\t22\n55\t56\t23\t23\n55\t56\t24\t24\n55\t56\t25\t25\n55\t56\t26\t26\n55\t56\t27\t27\n55\t56\t28\t28\n55\t56\t29\t29\n55\t56\t30\t30\n55\t56\t31\t31\n55\t56\t32\t32\n55\t56\t33\t33\n55\t56\t34\t34\n55\t56\t35\t35\n55\t56\t36\t36\n55\t56\t37\t37\n55\t56\t38\t38\n55\t56\t39\t39\n55\t56\t40\t40\n55\t56\t41\t41\n55\t56\t42\t42\n55\t56\t43\t43\n55\t56\t44\t44\n55\t56\t45\t45\n55\t56\t46\t46\n55\t56\t47\t47\n55\t56\t48\t48\n55\t56\t49\t49\n55\t56\t50\t50\n55\t56\t51\t51\n55\t56\t52\t52\n55\t56\t53\t53\n55\t56\t54\t54\n55\t56\t55\t55\n55\t56\t56\t56\n55\t56\t57\t57\n55\t56\t58\t58\n55\t56\t59\t59\n55\t56\t60\t60\n55\t56\t61\t61\n55\t56\t62\t62\n55\t56\t63\t63\n55\t56\t64\t64\n55\t56\t65\t65\n55\t56\t66\t66\n55\t56\t67\t67\n55\t56\t68\t68\n55\t56\t69\t69\n55\t56\t70\t70\n55\t56\t71\t71\n55\t56\t72\t72\n55\t56\t73\t73\n55\t56\t74\t74\n55\t56\t75\t75\n55\t56\t76\t76\n55\t56\t77\t77\n55\t56\t78\t78\n55\t56\t79\t79\n55\t56\t80\t80\n55\t56\t81\t81\n55\t56\t82\t82\n55\t56\t83\t83\n55\t56\t84\t84\n55\t56\t85\t85\n55\t56\t86\t86\n55\t56\t87\t87\n55\t56\t88\t88\n55\t56\t89\t89\n55\t56\t90\t90\n55\t56\t91\t91\n55\t56\t92\t92\n55\t56\t93\t93\n55\t56\t94\t94\n55\t56\t95\t95\n55\t56\t96\t96\n55\t56\t97\t97\n55\t56\t98\t98\n55\t56\t99\t99\n55\t56\t100\t100\n55\t56\t101\t101\n55\t56\t102\t102\n55\t56\t103\t103\n55\t56\t104\t104\n55\t56\t105\t105\n55\t56\t106\t106\n55\t56\t107\t107\n55\t56\t108\t108\n55\t56\t109\t109\n55\t56\t110\t110\n55\t56\t111\t111\n55\t56\t112\t112\n55\t56\t113\t113\n55\t56\t114\t114\n55\t56\t115\t115\n55\t56\t116\t116\n55\t56\t117\t117\n55\t56\t118\t118\n55\t56\t119\t119\n55\t56\t120\t120\n55\t56\t121\t121\n55\t56\t122\t122\n55\t56\t123\t123\n55\t56\t124\t124\n55\t56\t125\t125\n55\t56\t126\t126\n55\t56\t127\t127\n55\t56\t128\t128\n55\t56\t129\t129\n55\t56\t130\t130\n55\t56\t131\t131\n55\t56\t132\t132\n55\t56\t133\t133\n55\t56\t134\t134\n55\t56\t135\t135\n55\t56\t136\t136\n55\t56\t137\t137\n55\t56\t138\t138\n55\t56\t139\t139\n55\t56\t140\t140\n55\t56\t141\t141\n55\t56\t142\t142\n55\t56\t143\t143\n55\t56\t144\t144\n55\t56\t145\t145\n55\t56\t146\t146\n55\t56\t147\t147\n55\t56\t148\t148\n55\t56\t149\t149\n55\t56\t150\t150\n55\t56\t151\t151\n55\t56\t152\t152\n55\t56\t153\t153\n55\t56\t154\t154\n55\t56\t155\t155\n55\t56\t156\t156\n55\t56\t157\t157\n55\t56\t158\t158\n55\t56\t159\t159\n55\t56\t160\t160\n55\t56\t161\t161\n55\t56\t162\t162\n55\t56\t163\t163\n55\t56\t164\t164\n55\t56\t165\t165\n55\t56\t166\t166\n55\t56\t167\t167\n55\t56\t168\t168\n55\t56\t169\t169\n55\t56\t170\t170\n55\t56\t171\t171\n55\t56\t172\t172\n55\t56\t173\t173\n55\t56\t174\t174\n55\t56\t175\t175\n55\t56\t176\t176\n55\t56\t177\t177\n55\t56\t178\t178\n55\t56\t179\t179\n55\t56\t180\t180\n55\t56\t181\t181\n55\t56\t182\t182\n55\t56\t183\t183\n55\t56\t184\t184\n55\t56\t185\t185\n55\t56\t186\t186\n55\t56\t187\t187\n55\t56\t188\t188\n55\t56\t189\t189\n55\t56\t190\t190\n55\t56\t191\t191\n55\t56\t192\t192\n55\t56\t193\t193\n55\t56\t194\t194\n55\t56\t195\t195\n55\t56\t196\t196\n55\t56\t197\t197\n55\t56\t198\t198\n55\t56\t199\t199\n55\t56\t200\t200\n55\t56\t201\t201\n55\t56\t202\t202\n55\t56\t203\t203\n55\t56\t204\t204\n55\t56\t205\t205\n55\t56\t206\t206\n55\t56\t207\t207\n55\t56\t208\t208\n55\t56\t209\t209\n55\t56\t210\t210\n55\t56\t211\t211\n55\t56\t212\t212\n55\t56\t213\t213\n55\t56\t214\t214\n55\t56\t215\t215\n55\t56\t216\t216\n55\t56\t217\t217\n55\t56\t218\t218\n55\t56\t219\t219\n55\t56\t220\t220\n55\t56\t221\t221\n55\t56\t222\t222\n55\t56\t223\t223\n55\t56\t224\t224\n55\t56\t225\t225\n55\t56\t226\t226\n55\t56\t227\t227\n55\t56\t228\t228\n55\t56\t229\t229\n55\t56\t230\t230\n55\t56\t231\t231\n55\t56\t232\t232\n55\t56\t233\t233\n55\t56\t234\t234\n55\t56\t235\t235\n55\t56\t236\t236\n55\t56\t237\t237\n55\t56\t238\t238\n55\t56\t239\t239\n55\t56\t240\t240\n55\t56\t241\t241\n55\t56\t242\t242\n55\t56\t243\t243\n55\t56\t244\t244\n55\t56\t245\t245\n55\t56\t246\t246\n55\t56\t247\t247\n55\t56\t248\t248\n55\t56\t249\t249\n55\t56\t250\t250\n55\t56\t251\t251\n55\t56\t252\t252\n55\t56\t253\t253\n55\t56\t254\t254\n55\t56\t255\t255\n56\t57\t0\t0\n56\t57\t1\t1\n56\t57\t2\t2\n56\t57\t3\t3\n56\t57\t4\t4\n56\t57\t5\t5\n56\t57\t6\t6\n56\t57\t7\t7\n56\t57\t8\t8\n56\t57\t9\t9\n56\t57\t10\t10\n56\t57\t11\t11\n56\t57\t12\t12\n56\t57\t13\t13\n56\t57\t14\t14\n56\t57\t15\t15\n56\t57\t16\t16\n56\t57\t17\t17\n56\t57\t18\t18\n56\t57\t19\t19\n56\t57\t20\t20\n56\t57\t21\t21\n56\t57\t22\t22\n56\t57\t23\t23\n56\t57\t24\t24\n56\t57\t25\t25\n56\t57\t26\t26\n56\t57\t27\t27\n56\t57\t28\t28\n56\t57\t29\t29\n56\t57\t30\t30\n56\t57\t31\t31\n56\t57\t32\t32\n56\t57\t33\t33\n56\t57\t34\t34\n56\t57\t35\t35\n56\t57\t36\t36\n56\t57\t37\t37\n56\t57\t38\t38\n56\t57\t39\t39\n56\t57\t40\t40\n56\t57\t41\t41\n56\t57\t42\t42\n56\t57\t43\t43\n56\t57\t44\t44\n56\t57\t45\t45\n56\t57\t46\t46\n56\t57\t47\t47\n56\t57\t48\t48\n56\t57\t49\t49\n56\t57\t50\t50\n56\t57\t51\t51\n56\t57\t52\t52\n56\t57\t53\t53\n56\t57\t54\t54\n56\t57\t55\t55\n56\t57\t56\t56\n56\t57\t57\t57\n56\t57\t58\t58\n56\t57\t59\t59\n56\t57\t60\t60\n56\t57\t61\t61\n56\t57\t62\t62\n56\t57\t63\t63\n56\t57\t64\t64\n56\t57\t65\t65\n56\t57\t66\t66\n56\t57\t67\t67\n56\t57\t68\t68\n56\t57\t69\t69\n56\t57\t70\t70\n56\t57\t71\t71\n56\t57\t72\t72\n56\t57\t73\t73\n56\t57\t74\t74\n56\t57\t75\t75\n56\t57\t76\t76\n56\t57\t77\t77\n56\t57\t78\t78\n56\t57\t79\t79\n56\t57\t80\t80\n56\t57\t81\t81\n56\t57\t82\t82\n56\t57\t83\t83\n56\t57\t84\t84\n56\t57\t85\t85\n56\t57\t86\t86\n56\t57\t87\t87\n56\t57\t88\t88\n56\t57\t89\t89\n56\t57\t90\t90\n56\t57\t91\t91\n56\t57\t92\t92\n56\t57\t93\t93\n56\t57\t94\t94\n56\t57\t95\t95\n56\t57\t96\t96\n56\t57\t97\t97\n56\t57\t98\t98\n56\t57\t99\t99\n56\t57\t100\t100\n56\t57\t101\t101\n56\t57\t102\t102\n56\t57\t103\t103\n56\t57\t104\t104\n56\t57\t105\t105\n56\t57\t106\t106\n56\t57\t107\t107\n56\t57\t108\t108\n56\t57\t109\t109\n56\t57\t110\t110\n56\t57\t111\t111\n56\t57\t112\t112\n56\t57\t113\t113\n56\t57\t114\t114\n56\t57\t115\t115\n56\t57\t116\t116\n56\t57\t117\t117\n56\t57\t118\t118\n56\t57\t119\t119\n56\t57\t120\t120\n56\t57\t121\t121\n56\t57\t122\t122\n56\t57\t123\t123\n56\t57\t124\t124\n56\t57\t125\t125\n56\t57\t126\t126\n56\t57\t127\t127\n56\t57\t128\t128\n56\t57\t129\t129\n56\t57\t130\t130\n56\t57\t131\t131\n56\t57\t132\t132\n56\t57\t133\t133\n56\t57\t134\t134\n56\t57\t135\t135\n56\t57\t136\t136\n56\t57\t137\t137\n56\t57\t138\t138\n56\t57\t139\t139\n56\t57\t140\t140\n56\t57\t141\t141\n56\t57\t142\t142\n56\t57\t143\t143\n56\t57\t144\t144\n56\t57\t145\t145\n56\t57\t146\t146\n56\t57\t147\t147\n56\t57\t148\t148\n56\t57\t149\t149\n56\t57\t150\t150\n56\t57\t151\t151\n56\t57\t152\t152\n56\t57\t153\t153\n56\t57\t154\t154\n56\t57\t155\t155\n56\t57\t156\t156\n56\t57\t157\t157\n56\t57\t158\t158\n56\t57\t159\t159\n56\t57\t160\t160\n56\t57\t161\t161\n56\t57\t162\t162\n56\t57\t163\t163\n56\t57\t164\t164\n56\t57\t165\t165\n56\t57\t166\t166\n56\t57\t167\t167\n56\t57\t168\t168\n56\t57\t169\t169\n56\t57\t170\t170\n56\t57\t171\t171\n56\t57\t172\t172\n56\t57\t173\t173\n56\t57\t174\t174\n56\t57\t175\t175\n56\t57\t176\t176\n56\t57\t177\t177\n56\t57\t178\t178\n56\t57\t179\t179\n56\t57\t180\t180\n56\t57\t181\t181\n56\t57\t182\t182\n56\t57\t183\t183\n56\t57\t184\t184\n56\t57\t185\t185\n56\t57\t186\t186\n56\t57\t187\t187\n56\t57\t188\t188\n56\t57\t189\t189\n56\t57\t190\t190\n56\t57\t191\t191\n56\t57\t192\t192\n56\t57\t193\t193\n56\t57\t194\t194\n56\t57\t195\t195\n56\t57\t196\t196\n56\t57\t197\t197\n56\t57\t198\t198\n56\t57\t199\t199\n56\t57\t200\t200\n56\t57\t201\t201\n56\t57\t202\t202\n56\t57\t203\t203\n56\t57\t204\t204\n56\t57\t205\t205\n56\t57\t206\t206\n56\t57\t207\t207\n56\t57\t208\t208\n56\t57\t209\t209\n56\t57\t210\t210\n56\t57\t211\t211\n56\t57\t212\t212\n56\t57\t213\t213\n56\t57\t214\t214\n56\t57\t215\t215\n56\t57\t216\t216\n56\t57\t217\t217\n56\t57\t218\t218\n56\t57\t219\t219\n56\t57\t220\t220\n56\t57\t221\t221\n56\t57\t222\t222\n56\t57\t223\t223\n56\t57\t224\t224\n56\t57\t225\t225\n56\t57\t226\t226\n56\t57\t227\t227\n56\t57\t228\t228\n56\t57\t229\t229\n56\t57\t230\t230\n56\t57\t231\t231\n56\t57\t232\t232\n56\t57\t233\t233\n56\t57\t234\t234\n56\t57\t235\t235\n56\t57\t236\t236\n56\t57\t237\t237\n56\t57\t238\t238\n56\t57\t239\t239\n56\t57\t240\t240\n56\t57\t241\t241\n56\t57\t242\t242\n56\t57\t243\t243\n56\t57\t244\t244\n56\t57\t245\t245\n56\t57\t246\t246\n56\t57\t247\t247\n56\t57\t248\t248\n56\t57\t249\t249\n56\t57\t250\t250\n56\t57\t251\t251\n56\t57\t252\t252\n56\t57\t253\t253\n56\t57\t254\t254\n56\t57\t255\t255\n57\t58\t0\t0\n57\t58\t1\t1\n57\t58\t2\t2\n57\t58\t3\t3\n57\t58\t4\t4\n57\t58\t5\t5\n57\t58\t6\t6\n57\t58\t7\t7\n57\t58\t8\t8\n57\t58\t9\t9\n57\t58\t10\t10\n57\t58\t11\t11\n57\t58\t12\t12\n57\t58\t13\t13\n57\t58\t14\t14\n57\t58\t15\t15\n57\t58\t16\t16\n57\t58\t17\t17\n57\t58\t18\t18\n57\t58\t19\t19\n57\t58\t20\t20\n57\t58\t21\t21\n57\t58\t22\t22\n57\t58\t23\t23\n57\t58\t24\t24\n57\t58\t25\t25\n57\t58\t26\t26\n57\t58\t27\t27\n57\t58\t28\t28\n57\t58\t29\t29\n57\t58\t30\t30\n57\t58\t31\t31\n57\t58\t32\t32\n57\t58\t33\t33\n57\t58\t34\t34\n57\t58\t35\t35\n57\t58\t36\t36\n57\t58\t37\t37\n57\t58\t38\t38\n57\t58\t39\t39\n57\t58\t40\t40\n57\t58\t41\t41\n57\t58\t42\t42\n57\t58\t43\t43\n57\t58\t44\t44\n57\t58\t45\t45\n57\t58\t46\t46\n57\t58\t47\t47\n57\t58\t48\t48\n57\t58\t49\t49\n57\t58\t50\t50\n57\t58\t51\t51\n57\t58\t52\t52\n57\t58\t53\t53\n57\t58\t54\t54\n57\t58\t55\t55\n57\t58\t56\t56\n57\t58\t57\t57\n57\t58\t58\t58\n57\t58\t59\t59\n57\t58\t60\t60\n57\t58\t61\t61\n57\t58\t62\t62\n57\t58\t63\t63\n57\t58\t64\t64\n57\t58\t65\t65\n57\t58\t66\t66\n57\t58\t67\t67\n57\t58\t68\t68\n57\t58\t69\t69\n57\t58\t70\t70\n57\t58\t71\t71\n57\t58\t72\t72\n57\t58\t73\t73\n57\t58\t74\t74\n57\t58\t75\t75\n57\t58\t76\t76\n57\t58\t77\t77\n57\t58\t78\t78\n57\t58\t79\t79\n57\t58\t80\t80\n57\t58\t81\t81\n57\t58\t82\t82\n57\t58\t83\t83\n57\t58\t84\t84\n57\t58\t85\t85\n57\t58\t86\t86\n57\t58\t87\t87\n57\t58\t88\t88\n57\t58\t89\t89\n57\t58\t90\t90\n57\t58\t91\t91\n57\t58\t92\t92\n57\t58\t93\t93\n57\t58\t94\t94\n57\t58\t95\t95\n57\t58\t96\t96\n57\t58\t97\t97\n57\t58\t98\t98\n57\t58\t99\t99\n57\t58\t100\t100\n57\t58\t101\t101\n57\t58\t102\t102\n57\t58\t103\t103\n57\t58\t104\t104\n57\t58\t105\t105\n57\t58\t106\t106\n57\t58\t107\t107\n57\t58\t108\t108\n57\t58\t109\t109\n57\t58\t110\t110\n57\t58\t111\t111\n57\t58\t112\t112\n57\t58\t113\t113\n57\t58\t114\t114\n57\t58\t115\t115\n57\t58\t116\t116\n57\t58\t117\t117\n57\t58\t118\t118\n57\t58\t119\t119\n57\t58\t120\t120\n57\t58\t121\t121\n57\t58\t122\t122\n57\t58\t123\t123\n57\t58\t124\t124\n57\t58\t125\t125\n57\t58\t126\t126\n57\t58\t127\t127\n57\t58\t128\t128\n57\t58\t129\t129\n57\t58\t130\t130\n57\t58\t131\t131\n57\t58\t132\t132\n57\t58\t133\t133\n57\t58\t134\t134\n57\t58\t135\t135\n57\t58\t136\t136\n57\t58\t137\t137\n57\t58\t138\t138\n57\t58\t139\t139\n57\t58\t140\t140\n57\t58\t141\t141\n57\t58\t142\t142\n57\t58\t143\t143\n57\t58\t144\t144\n57\t58\t145\t145\n57\t58\t146\t146\n57\t58\t147\t147\n57\t58\t148\t148\n57\t58\t149\t149\n57\t58\t150\t150\n57\t58\t151\t151\n57\t58\t152\t152\n57\t58\t153\t153\n57\t58\t154\t154\n57\t58\t155\t155\n57\t58\t156\t156\n57\t58\t157\t157\n57\t58\t158\t158\n57\t58\t159\t159\n57\t58\t160\t160\n57\t58\t161\t161\n57\t58\t162\t162\n57\t58\t163\t163\n57\t58\t164\t164\n57\t58\t165\t165\n57\t58\t166\t166\n57\t58\t167\t167\n57\t58\t168\t168\n57\t58\t169\t169\n57\t58\t170\t170\n57\t58\t171\t171\n57\t58\t172\t172\n57\t58\t173\t173\n57\t58\t174\t174\n57\t58\t175\t175\n57\t58\t176\t176\n57\t58\t177\t177\n57\t58\t178\t178\n57\t58\t179\t179\n57\t58\t180\t180\n57\t58\t181\t181\n57\t58\t182\t182\n57\t58\t183\t183\n57\t58\t184\t184\n57\t58\t185\t185\n57\t58\t186\t186\n57\t58\t187\t187\n57\t58\t188\t188\n57\t58\t189\t189\n57\t58\t190\t190\n57\t58\t191\t191\n57\t58\t192\t192\n57\t58\t193\t193\n57\t58\t194\t194\n57\t58\t195\t195\n57\t58\t196\t196\n57\t58\t197\t197\n57\t58\t198\t198\n57\t58\t199\t199\n57\t58\t200\t200\n57\t58\t201\t201\n57\t58\t202\t202\n57\t58\t203\t203\n57\t58\t204\t204\n57\t58\t205\t205\n57\t58\t206\t206\n57\t58\t207\t207\n57\t58\t208\t208\n57\t58\t209\t209\n57\t58\t210\t210\n57\t58\t211\t211\n57\t58\t212\t212\n57\t58\t213\t213\n57\t58\t214\t214\n57\t58\t215\t215\n57\t58\t216\t216\n57\t58\t217\t217\n57\t58\t218\t218\n57\t58\t219\t219\n57\t58\t220\t220\n57\t58\t221\t221\n57\t58\t222\t222\n57\t58\t223\t223\n57\t58\t224\t224\n57\t58\t225\t225\n57\t58\t226\t226\n57\t58\t227\t227\n57\t58\t228\t228\n57\t58\t229\t229\n57\t58\t230\t230\n57\t58\t231\t231\n57\t58\t232\t232\n57\t58\t233\t233\n57\t58\t234\t234\n57\t58\t235\t235\n57\t58\t236\t236\n57\t58\t237\t237\n57\t58\t238\t238\n57\t58\t239\t239\n57\t58\t240\t240\n57\t58\t241\t241\n57\t58\t242\t242\n57\t58\t243\t243\n57\t58\t244\t244\n57\t58\t245\t245\n57\t58\t246\t246\n57\t58\t247\t247\n57\t58\t248\t248\n57\t58\t249\t249\n57\t58\t250\t250\n57\t58\t251\t251\n57\t58\t252\t252\n57\t58\t253\t253\n57\t58\t254\t254\n57\t58\t255\t255\n58\t59\t0\t0\n58\t59\t1\t1\n58\t59\t2\t2\n58\t59\t3\t3\n58\t59\t4\t4\n58\t59\t5\t5\n58\t59\t6\t6\n58\t59\t7\t7\n58\t59\t8\t8\n58\t59\t9\t9\n58\t59\t10\t10\n58\t59\t11\t11\n58\t59\t12\t12\n58\t59\t13\t13\n58\t59\t14\t14\n58\t59\t15\t15\n58\t59\t16\t16\n58\t59\t17\t17\n58\t59\t18\t18\n58\t59\t19\t19\n58\t59\t20\t20\n58\t59\t21\t21\n58\t59\t22\t22\n58\t59\t23\t23\n58\t59\t24\t24\n58\t59\t25\t25\n58\t59\t26\t26\n58\t59\t27\t27\n58\t59\t28\t28\n58\t59\t29\t29\n58\t59\t30\t30\n58\t59\t31\t31\n58\t59\t32\t32\n58\t59\t33\t33\n58\t59\t34\t34\n58\t59\t35\t35\n58\t59\t36\t36\n58\t59\t37\t37\n58\t59\t38\t38\n58\t59\t39\t39\n58\t59\t40\t40\n58\t59\t41\t41\n58\t59\t42\t42\n58\t59\t43\t43\n58\t59\t44\t44\n58\t59\t45\t45\n58\t59\t46\t46\n58\t59\t47\t47\n58\t59\t48\t48\n58\t59\t49\t49\n58\t59\t50\t50\n58\t59\t51\t51\n58\t59\t52\t52\n58\t59\t53\t53\n58\t59\t54\t54\n58\t59\t55\t55\n58\t59\t56\t56\n58\t59\t57\t57\n58\t59\t58\t58\n58\t59\t59\t59\n58\t59\t60\t60\n58\t59\t61\t61\n58\t59\t62\t62\n58\t59\t63\t63\n58\t59\t64\t64\n58\t59\t65\t65\n58\t59\t66\t66\n58\t59\t67\t67\n58\t59\t68\t68\n58\t59\t69\t69\n58\t59\t70\t70\n58\t59\t71\t71\n58\t59\t72\t72\n58\t59\t73\t73\n58\t59\t74\t74\n58\t59\t75\t75\n58\t59\t76\t76\n58\t59\t77\t77\n58\t59\t78\t78\n58\t59\t79\t79\n58\t59\t80\t80\n58\t59\t81\t81\n58\t59\t82\t82\n58\t59\t83\t83\n58\t59\t84\t84\n58\t59\t85\t85\n58\t59\t86\t86\n58\t59\t87\t87\n58\t59\t88\t88\n58\t59\t89\t89\n58\t59\t90\t90\n58\t59\t91\t91\n58\t59\t92\t92\n58\t59\t93\t93\n58\t59\t94\t94\n58\t59\t95\t95\n58\t59\t96\t96\n58\t59\t97\t97\n58\t59\t98\t98\n58\t59\t99\t99\n58\t59\t100\t100\n58\t59\t101\t101\n58\t59\t102\t102\n58\t59\t103\t103\n58\t59\t104\t104\n58\t59\t105\t105\n58\t59\t106\t106\n58\t59\t107\t107\n58\t59\t108\t108\n58\t59\t109\t109\n58\t59\t110\t110\n58\t59\t111\t111\n58\t59\t112\t112\n58\t59\t113\t113\n58\t59\t114\t114\n58\t59\t115\t115\n58\t59\t116\t116\n58\t59\t117\t117\n58\t59\t118\t118\n58\t59\t119\t119\n58\t59\t120\t120\n58\t59\t121\t121\n58\t59\t122\t122\n58\t59\t123\t123\n58\t59\t124\t124\n58\t59\t125\t125\n58\t59\t126\t126\n58\t59\t127\t127\n58\t59\t128\t128\n58\t59\t129\t129\n58\t59\t130\t130\n58\t59\t131\t131\n58\t59\t132\t132\n58\t59\t133\t133\n58\t59\t134\t134\n58\t59\t135\t135\n58\t59\t136\t136\n58\t59\t137\t137\n58\t59\t138\t138\n58\t59\t139\t139\n58\t59\t140\t140\n58\t59\t141\t141\n58\t59\t142\t142\n58\t59\t143\t143\n58\t59\t144\t144\n58\t59\t145\t145\n58\t59\t146\t146\n58\t59\t147\t147\n58\t59\t148\t148\n58\t59\t149\t149\n58\t59\t150\t150\n58\t59\t151\t151\n58\t59\t152\t152\n58\t59\t153\t153\n58\t59\t154\t154\n58\t59\t155\t155\n58\t59\t156\t156\n58\t59\t157\t157\n58\t59\t158\t158\n58\t59\t159\t159\n58\t59\t160\t160\n58\t59\t161\t161\n58\t59\t162\t162\n58\t59\t163\t163\n58\t59\t164\t164\n58\t59\t165\t165\n58\t59\t166\t166\n58\t59\t167\t167\n58\t59\t168\t168\n58\t59\t169\t169\n58\t59\t170\t170\n58\t59\t171\t171\n58\t59\t172\t172\n58\t59\t173\t173\n58\t59\t174\t174\n58\t59\t175\t175\n58\t59\t176\t176\n58\t59\t177\t177\n58\t59\t178\t178\n58\t59\t179\t179\n58\t59\t180\t180\n58\t59\t181\t181\n58\t59\t182\t182\n58\t59\t183\t183\n58\t59\t184\t184\n58\t59\t185\t185\n58\t59\t186\t186\n58\t59\t187\t187\n58\t59\t188\t188\n58\t59\t189\t189\n58\t59\t190\t190\n58\t59\t191\t191\n58\t59\t192\t192\n58\t59\t193\t193\n58\t59\t194\t194\n58\t59\t195\t195\n58\t59\t196\t196\n58\t59\t197\t197\n58\t59\t198\t198\n58\t59\t199\t199\n58\t59\t200\t200\n58\t59\t201\t201\n58\t59\t202\t202\n58\t59\t203\t203\n58\t59\t204\t204\n58\t59\t205\t205\n58\t59\t206\t206\n58\t59\t207\t207\n58\t59\t208\t208\n58\t59\t209\t209\n58\t59\t210\t210\n58\t59\t211\t211\n58\t59\t212\t212\n58\t59\t213\t213\n58\t59\t214\t214\n58\t59\t215\t215\n58\t59\t216\t216\n58\t59\t217\t217\n58\t59\t218\t218\n58\t59\t219\t219\n58\t59\t220\t220\n58\t59\t221\t221\n58\t59\t222\t222\n58\t59\t223\t223\n58\t59\t224\t224\n58\t59\t225\t225\n58\t59\t226\t226\n58\t59\t227\t227\n58\t59\t228\t228\n58\t59\t229\t229\n58\t59\t230\t230\n58\t59\t231\t231\n58\t59\t232\t232\n58\t59\t233\t233\n58\t59\t234\t234\n58\t59\t235\t235\n58\t59\t236\t236\n58\t59\t237\t237\n58\t59\t238\t238\n58\t59\t239\t239\n58\t59\t240\t240\n58\t59\t241\t241\n58\t59\t242\t242\n58\t59\t243\t243\n58\t59\t244\t244\n58\t59\t245\t245\n58\t59\t246\t246\n58\t59\t247\t247\n58\t59\t248\t248\n58\t59\t249\t249\n58\t59\t250\t250\n58\t59\t251\t251\n58\t59\t252\t252\n58\t59\t253\t253\n58\t59\t254\t254\n58\t59\t255\t255\n59\t60\t0\t0\n59\t60\t1\t1\n59\t60\t2\t2\n59\t60\t3\t3\n59\t60\t4\t4\n59\t60\t5\t5\n59\t60\t6\t6\n59\t60\t7\t7\n59\t60\t8\t8\n59\t60\t9\t9\n59\t60\t10\t10\n59\t60\t11\t11\n59\t60\t12\t12\n59\t60\t13\t13\n59\t60\t14\t14\n59\t60\t15\t15\n59\t60\t16\t16\n59\t60\t17\t17\n59\t60\t18\t18\n59\t60\t19\t19\n59\t60\t20\t20\n59\t60\t21\t21\n59\t60\t22\t22\n59\t60\t23\t23\n59\t60\t24\t24\n59\t60\t25\t25\n59\t60\t26\t26\n59\t60\t27\t27\n59\t60\t28\t28\n59\t60\t29\t29\n59\t60\t30\t30\n59\t60\t31\t31\n59\t60\t32\t32\n59\t60\t33\t33\n59\t60\t34\t34\n59\t60\t35\t35\n59\t60\t36\t36\n59\t60\t37\t37\n59\t60\t38\t38\n59\t60\t39\t39\n59\t60\t40\t40\n59\t60\t41\t41\n59\t60\t42\t42\n59\t60\t43\t43\n59\t60\t44\t44\n59\t60\t45\t45\n59\t60\t46\t46\n59\t60\t47\t47\n59\t60\t48\t48\n59\t60\t49\t49\n59\t60\t50\t50\n59\t60\t51\t51\n59\t60\t52\t52\n59\t60\t53\t53\n59\t60\t54\t54\n59\t60\t55\t55\n59\t60\t56\t56\n59\t60\t57\t57\n59\t60\t58\t58\n59\t60\t59\t59\n59\t60\t60\t60\n59\t60\t61\t61\n59\t60\t62\t62\n59\t60\t63\t63\n59\t60\t64\t64\n59\t60\t65\t65\n59\t60\t66\t66\n59\t60\t67\t67\n59\t60\t68\t68\n59\t60\t69\t69\n59\t60\t70\t70\n59\t60\t71\t71\n59\t60\t72\t72\n59\t60\t73\t73\n59\t60\t74\t74\n59\t60\t75\t75\n59\t60\t76\t76\n59\t60\t77\t77\n59\t60\t78\t78\n59\t60\t79\t79\n59\t60\t80\t80\n59\t60\t81\t81\n59\t60\t82\t82\n59\t60\t83\t83\n59\t60\t84\t84\n59\t60\t85\t85\n59\t60\t86\t86\n59\t60\t87\t87\n59\t60\t88\t88\n59\t60\t89\t89\n59\t60\t90\t90\n59\t60\t91\t91\n59\t60\t92\t92\n59\t60\t93\t93\n59\t60\t94\t94\n59\t60\t95\t95\n59\t60\t96\t96\n59\t60\t97\t97\n59\t60\t98\t98\n59\t60\t99\t99\n59\t60\t100\t100\n59\t60\t101\t101\n59\t60\t102\t102\n59\t60\t103\t103\n59\t60\t104\t104\n59\t60\t105\t105\n59\t60\t106\t106\n59\t60\t107\t107\n59\t60\t108\t108\n59\t60\t109\t109\n59\t60\t110\t110\n59\t60\t111\t111\n59\t60\t112\t112\n59\t60\t113\t113\n59\t60\t114\t114\n59\t60\t115\t115\n59\t60\t116\t116\n59\t60\t117\t117\n59\t60\t118\t118\n59\t60\t119\t119\n59\t60\t120\t120\n59\t60\t121\t121\n59\t60\t122\t122\n59\t60\t123\t123\n59\t60\t124\t124\n59\t60\t125\t125\n59\t60\t126\t126\n59\t60\t127\t127\n59\t60\t128\t128\n59\t60\t129\t129\n59\t60\t130\t130\n59\t60\t131\t131\n59\t60\t132\t132\n59\t60\t133\t133\n59\t60\t134\t134\n59\t60\t135\t135\n59\t60\t136\t136\n59\t60\t137\t137\n59\t60\t138\t138\n59\t60\t139\t139\n59\t60\t140\t140\n59\t60\t141\t141\n59\t60\t142\t142\n59\t60\t143\t143\n59\t60\t144\t144\n59\t60\t145\t145\n59\t60\t146\t146\n59\t60\t147\t147\n59\t60\t148\t148\n59\t60\t149\t149\n59\t60\t150\t150\n59\t60\t151\t151\n59\t60\t152\t152\n59\t60\t153\t153\n59\t60\t154\t154\n59\t60\t155\t155\n59\t60\t156\t156\n59\t60\t157\t157\n59\t60\t158\t158\n59\t60\t159\t159\n59\t60\t160\t160\n59\t60\t161\t161\n59\t60\t162\t162\n59\t60\t163\t163\n59\t60\t164\t164\n59\t60\t165\t165\n59\t60\t166\t166\n59\t60\t167\t167\n59\t60\t168\t168\n59\t60\t169\t169\n59\t60\t170\t170\n59\t60\t171\t171\n59\t60\t172\t172\n59\t60\t173\t173\n59\t60\t174\t174\n59\t60\t175\t175\n59\t60\t176\t176\n59\t60\t177\t177\n59\t60\t178\t178\n59\t60\t179\t179\n59\t60\t180\t180\n59\t60\t181\t181\n59\t60\t182\t182\n59\t60\t183\t183\n59\t60\t184\t184\n59\t60\t185\t185\n59\t60\t186\t186\n59\t60\t187\t187\n59\t60\t188\t188\n59\t60\t189\t189\n59\t60\t190\t190\n59\t60\t191\t191\n59\t60\t192\t192\n59\t60\t193\t193\n59\t60\t194\t194\n59\t60\t195\t195\n59\t60\t196\t196\n59\t60\t197\t197\n59\t60\t198\t198\n59\t60\t199\t199\n59\t60\t200\t200\n59\t60\t201\t201\n59\t60\t202\t202\n59\t60\t203\t203\n59\t60\t204\t204\n59\t60\t205\t205\n59\t60\t206\t206\n59\t60\t207\t207\n59\t60\t208\t208\n59\t60\t209\t209\n59\t60\t210\t210\n59\t60\t211\t211\n59\t60\t212\t212\n59\t60\t213\t213\n59\t60\t214\t214\n59\t60\t215\t215\n59\t60\t216\t216\n59\t60\t217\t217\n59\t60\t218\t218\n59\t60\t219\t219\n59\t60\t220\t220\n59\t60\t221\t221\n59\t60\t222\t222\n59\t60\t223\t223\n59\t60\t224\t224\n59\t60\t225\t225\n59\t60\t226\t226\n59\t60\t227\t227\n59\t60\t228\t228\n59\t60\t229\t229\n59\t60\t230\t230\n59\t60\t231\t231\n59\t60\t232\t232\n59\t60\t233\t233\n59\t60\t234\t234\n59\t60\t235\t235\n59\t60\t236\t236\n59\t60\t237\t237\n59\t60\t238\t238\n59\t60\t239\t239\n59\t60\t240\t240\n59\t60\t241\t241\n59\t60\t242\t242\n59\t60\t243\t243\n59\t60\t244\t244\n59\t60\t245\t245\n59\t60\t246\t246\n59\t60\t247\t247\n59\t60\t248\t248\n59\t60\t249\t249\n59\t60\t250\t250\n59\t60\t251\t251\n59\t60\t252\t252\n59\t60\t253\t253\n59\t60\t254\t254\n59\t60\t255\t255\n60\t61\t0\t0\n60\t61\t1\t1\n60\t61\t2\t2\n60\t61\t3\t3\n60\t61\t4\t4\n60\t61\t5\t5\n60\t61\t6\t6\n60\t61\t7\t7\n60\t61\t8\t8\n60\t61\t9\t9\n60\t61\t10\t10\n60\t61\t11\t11\n60\t61\t12\t12\n60\t61\t13\t13\n60\t61\t14\t14\n60\t61\t15\t15\n60\t61\t16\t16\n60\t61\t17\t17\n60\t61\t18\t18\n60\t61\t19\t19\n60\t61\t20\t20\n60\t61\t21\t21\n60\t61\t22\t22\n60\t61\t23\t23\n60\t61\t24\t24\n60\t61\t25\t25\n60\t61\t26\t26\n60\t61\t27\t27\n60\t61\t28\t28\n60\t61\t29\t29\n60\t61\t30\t30\n60\t61\t31\t31\n60\t61\t32\t32\n60\t61\t33\t33\n60\t61\t34\t34\n60\t61\t35\t35\n60\t61\t36\t36\n60\t61\t37\t37\n60\t61\t38\t38\n60\t61\t39\t39\n60\t61\t40\t40\n60\t61\t41\t41\n60\t61\t42\t42\n60\t61\t43\t43\n60\t61\t44\t44\n60\t61\t45\t45\n60\t61\t46\t46\n60\t61\t47\t47\n60\t61\t48\t48\n60\t61\t49\t49\n60\t61\t50\t50\n60\t61\t51\t51\n60\t61\t52\t52\n60\t61\t53\t53\n60\t61\t54\t54\n60\t61\t55\t55\n60\t61\t56\t56\n60\t61\t57\t57\n60\t61\t58\t58\n60\t61\t59\t59\n60\t61\t60\t60\n60\t61\t61\t61\n60\t61\t62\t62\n60\t61\t63\t63\n60\t61\t64\t64\n60\t61\t65\t65\n60\t61\t66\t66\n60\t61\t67\t67\n60\t61\t68\t68\n60\t61\t69\t69\n60\t61\t70\t70\n60\t61\t71\t71\n60\t61\t72\t72\n60\t61\t73\t73\n60\t61\t74\t74\n60\t61\t75\t75\n60\t61\t76\t76\n60\t61\t77\t77\n60\t61\t78\t78\n60\t61\t79\t79\n60\t61\t80\t80\n60\t61\t81\t81\n60\t61\t82\t82\n60\t61\t83\t83\n60\t61\t84\t84\n60\t61\t85\t85\n60\t61\t86\t86\n60\t61\t87\t87\n60\t61\t88\t88\n60\t61\t89\t89\n60\t61\t90\t90\n60\t61\t91\t91\n60\t61\t92\t92\n60\t61\t93\t93\n60\t61\t94\t94\n60\t61\t95\t95\n60\t61\t96\t96\n60\t61\t97\t97\n60\t61\t98\t98\n60\t61\t99\t99\n60\t61\t100\t100\n60\t61\t101\t101\n60\t61\t102\t102\n60\t61\t103\t103\n60\t61\t104\t104\n60\t61\t105\t105\n60\t61\t106\t106\n60\t61\t107\t107\n60\t61\t108\t108\n60\t61\t109\t109\n60\t61\t110\t110\n60\t61\t111\t111\n60\t61\t112\t112\n60\t61\t113\t113\n60\t61\t114\t114\n60\t61\t115\t115\n60\t61\t116\t116\n60\t61\t117\t117\n60\t61\t118\t118\n60\t61\t119\t119\n60\t61\t120\t120\n60\t61\t121\t121\n60\t61\t122\t122\n60\t61\t123\t123\n60\t61\t124\t124\n60\t61\t125\t125\n60\t61\t126\t126\n60\t61\t127\t127\n60\t61\t128\t128\n60\t61\t129\t129\n60\t61\t130\t130\n60\t61\t131\t131\n60\t61\t132\t132\n60\t61\t133\t133\n60\t61\t134\t134\n60\t61\t135\t135\n60\t61\t136\t136\n60\t61\t137\t137\n60\t61\t138\t138\n60\t61\t139\t139\n60\t61\t140\t140\n60\t61\t141\t141\n60\t61\t142\t142\n60\t61\t143\t143\n60\t61\t144\t144\n60\t61\t145\t145\n60\t61\t146\t146\n60\t61\t147\t147\n60\t61\t148\t148\n60\t61\t149\t149\n60\t61\t150\t150\n60\t61\t151\t151\n60\t61\t152\t152\n60\t61\t153\t153\n60\t61\t154\t154\n60\t61\t155\t155\n60\t61\t156\t156\n60\t61\t157\t157\n60\t61\t158\t158\n60\t61\t159\t159\n60\t61\t160\t160\n60\t61\t161\t161\n60\t61\t162\t162\n60\t61\t163\t163\n60\t61\t164\t164\n60\t61\t165\t165\n60\t61\t166\t166\n60\t61\t167\t167\n60\t61\t168\t168\n60\t61\t169\t169\n60\t61\t170\t170\n60\t61\t171\t171\n60\t61\t172\t172\n60\t61\t173\t173\n60\t61\t174\t174\n60\t61\t175\t175\n60\t61\t176\t176\n60\t61\t177\t177\n60\t61\t178\t178\n60\t61\t179\t179\n60\t61\t180\t180\n60\t61\t181\t181\n60\t61\t182\t182\n60\t61\t183\t183\n60\t61\t184\t184\n60\t61\t185\t185\n60\t61\t186\t186\n60\t61\t187\t187\n60\t61\t188\t188\n60\t61\t189\t189\n60\t61\t190\t190\n60\t61\t191\t191\n60\t61\t192\t192\n60\t61\t193\t193\n60\t61\t194\t194\n60\t61\t195\t195\n60\t61\t196\t196\n60\t61\t197\t197\n60\t61\t198\t198\n60\t61\t199\t199\n60\t61\t200\t200\n60\t61\t201\t201\n60\t61\t202\t202\n60\t61\t203\t203\n60\t61\t204\t204\n60\t61\t205\t205\n60\t61\t206\t206\n60\t61\t207\t207\n60\t61\t208\t208\n60\t61\t209\t209\n60\t61\t210\t210\n60\t61\t211\t211\n60\t61\t212\t212\n60\t61\t213\t213\n60\t61\t214\t214\n60\t61\t215\t215\n60\t61\t216\t216\n60\t61\t217\t217\n60\t61\t218\t218\n60\t61\t219\t219\n60\t61\t220\t220\n60\t61\t221\t221\n60\t61\t222\t222\n60\t61\t223\t223\n60\t61\t224\t224\n60\t61\t225\t225\n60\t61\t226\t226\n60\t61\t227\t227\n60\t61\t228\t228\n60\t61\t229\t229\n60\t61\t230\t230\n60\t61\t231\t231\n60\t61\t232\t232\n60\t61\t233\t233\n60\t61\t234\t234\n60\t61\t235\t235\n60\t61\t236\t236\n60\t61\t237\t237\n60\t61\t238\t238\n60\t61\t239\t239\n60\t61\t240\t240\n60\t61\t241\t241\n60\t61\t242\t242\n60\t61\t243\t243\n60\t61\t244\t244\n60\t61\t245\t245\n60\t61\t246\t246\n60\t61\t247\t247\n60\t61\t248\t248\n60\t61\t249\t249\n60\t61\t250\t250\n60\t61\t251\t251\n60\t61\t252\t252\n60\t61\t253\t253\n60\t61\t254\t254\n60\t61\t255\t255\n61\t62\t0\t0\n61\t62\t1\t1\n61\t62\t2\t2\n61\t62\t3\t3\n61\t62\t4\t4\n61\t62\t5\t5\n61\t62\t6\t6\n61\t62\t7\t7\n61\t62\t8\t8\n61\t62\t9\t9\n61\t62\t10\t10\n61\t62\t11\t11\n61\t62\t12\t12\n61\t62\t13\t13\n61\t62\t14\t14\n61\t62\t15\t15\n61\t62\t16\t16\n61\t62\t17\t17\n61\t62\t18\t18\n61\t62\t19\t19\n61\t62\t20\t20\n61\t62\t21\t21\n61\t62\t22\t22\n61\t62\t23\t23\n61\t62\t24\t24\n61\t62\t25\t25\n61\t62\t26\t26\n61\t62\t27\t27\n61\t62\t28\t28\n61\t62\t29\t29\n61\t62\t30\t30\n61\t62\t31\t31\n61\t62\t32\t32\n61\t62\t33\t33\n61\t62\t34\t34\n61\t62\t35\t35\n61\t62\t36\t36\n61\t62\t37\t37\n61\t62\t38\t38\n61\t62\t39\t39\n61\t62\t40\t40\n61\t62\t41\t41\n61\t62\t42\t42\n61\t62\t43\t43\n61\t62\t44\t44\n61\t62\t45\t45\n61\t62\t46\t46\n61\t62\t47\t47\n61\t62\t48\t48\n61\t62\t49\t49\n61\t62\t50\t50\n61\t62\t51\t51\n61\t62\t52\t52\n61\t62\t53\t53\n61\t62\t54\t54\n61\t62\t55\t55\n61\t62\t56\t56\n61\t62\t57\t57\n61\t62\t58\t58\n61\t62\t59\t59\n61\t62\t60\t60\n61\t62\t61\t61\n61\t62\t62\t62\n61\t62\t63\t63\n61\t62\t64\t64\n61\t62\t65\t65\n61\t62\t66\t66\n61\t62\t67\t67\n61\t62\t68\t68\n61\t62\t69\t69\n61\t62\t70\t70\n61\t62\t71\t71\n61\t62\t72\t72\n61\t62\t73\t73\n61\t62\t74\t74\n61\t62\t75\t75\n61\t62\t76\t76\n61\t62\t77\t77\n61\t62\t78\t78\n61\t62\t79\t79\n61\t62\t80\t80\n61\t62\t81\t81\n61\t62\t82\t82\n61\t62\t83\t83\n61\t62\t84\t84\n61\t62\t85\t85\n61\t62\t86\t86\n61\t62\t87\t87\n61\t62\t88\t88\n61\t62\t89\t89\n61\t62\t90\t90\n61\t62\t91\t91\n61\t62\t92\t92\n61\t62\t93\t93\n61\t62\t94\t94\n61\t62\t95\t95\n61\t62\t96\t96\n61\t62\t97\t97\n61\t62\t98\t98\n61\t62\t99\t99\n61\t62\t100\t100\n61\t62\t101\t101\n61\t62\t102\t102\n61\t62\t103\t103\n61\t62\t104\t104\n61\t62\t105\t105\n61\t62\t106\t106\n61\t62\t107\t107\n61\t62\t108\t108\n61\t62\t109\t109\n61\t62\t110\t110\n61\t62\t111\t111\n61\t62\t112\t112\n61\t62\t113\t113\n61\t62\t114\t114\n61\t62\t115\t115\n61\t62\t116\t116\n61\t62\t117\t117\n61\t62\t118\t118\n61\t62\t119\t119\n61\t62\t120\t120\n61\t62\t121\t121\n61\t62\t122\t122\n61\t62\t123\t123\n61\t62\t124\t124\n61\t62\t125\t125\n61\t62\t126\t126\n61\t62\t127\t127\n61\t62\t128\t128\n61\t62\t129\t129\n61\t62\t130\t130\n61\t62\t131\t131\n61\t62\t132\t132\n61\t62\t133\t133\n61\t62\t134\t134\n61\t62\t135\t135\n61\t62\t136\t136\n61\t62\t137\t137\n61\t62\t138\t138\n61\t62\t139\t139\n61\t62\t140\t140\n61\t62\t141\t141\n61\t62\t142\t142\n61\t62\t143\t143\n61\t62\t144\t144\n61\t62\t145\t145\n61\t62\t146\t146\n61\t62\t147\t147\n61\t62\t148\t148\n61\t62\t149\t149\n61\t62\t150\t150\n61\t62\t151\t151\n61\t62\t152\t152\n61\t62\t153\t153\n61\t62\t154\t154\n61\t62\t155\t155\n61\t62\t156\t156\n61\t62\t157\t157\n61\t62\t158\t158\n61\t62\t159\t159\n61\t62\t160\t160\n61\t62\t161\t161\n61\t62\t162\t162\n61\t62\t163\t163\n61\t62\t164\t164\n61\t62\t165\t165\n61\t62\t166\t166\n61\t62\t167\t167\n61\t62\t168\t168\n61\t62\t169\t169\n61\t62\t170\t170\n61\t62\t171\t171\n61\t62\t172\t172\n61\t62\t173\t173\n61\t62\t174\t174\n61\t62\t175\t175\n61\t62\t176\t176\n61\t62\t177\t177\n61\t62\t178\t178\n61\t62\t179\t179\n61\t62\t180\t180\n61\t62\t181\t181\n61\t62\t182\t182\n61\t62\t183\t183\n61\t62\t184\t184\n61\t62\t185\t185\n61\t62\t186\t186\n61\t62\t187\t187\n61\t62\t188\t188\n61\t62\t189\t189\n61\t62\t190\t190\n61\t62\t191\t191\n61\t62\t192\t192\n61\t62\t193\t193\n61\t62\t194\t194\n61\t62\t195\t195\n61\t62\t196\t196\n61\t62\t197\t197\n61\t62\t198\t198\n61\t62\t199\t199\n61\t62\t200\t200\n61\t62\t201\t201\n61\t62\t202\t202\n61\t62\t203\t203\n61\t62\t204\t204\n61\t62\t205\t205\n61\t62\t206\t206\n61\t62\t207\t207\n61\t62\t208\t208\n61\t62\t209\t209\n61\t62\t210\t210\n61\t62\t211\t211\n61\t62\t212\t212\n61\t62\t213\t213\n61\t62\t214\t214\n61\t62\t215\t215\n61\t62\t216\t216\n61\t62\t217\t217\n61\t62\t218\t218\n61\t62\t219\t219\n61\t62\t220\t220\n61\t62\t221\t221\n61\t62\t222\t222\n61\t62\t223\t223\n61\t62\t224\t224\n61\t62\t225\t225\n61\t62\t226\t226\n61\t62\t227\t227\n61\t62\t228\t228\n61\t62\t229\t229\n61\t62\t230\t230\n61\t62\t231\t231\n61\t62\t232\t232\n61\t62\t233\t233\n61\t62\t234\t234\n61\t62\t235\t235\n61\t62\t236\t236\n61\t62\t237\t237\n61\t62\t238\t238\n61\t62\t239\t239\n61\t62\t240\t240\n61\t62\t241\t241\n61\t62\t242\t242\n61\t62\t243\t243\n61\t62\t244\t244\n61\t62\t245\t245\n61\t62\t246\t246\n61\t62\t247\t247\n61\t62\t248\t248\n61\t62\t249\t249\n61\t62\t250\t250\n61\t62\t251\t251\n61\t62\t252\t252\n61\t62\t253\t253\n61\t62\t254\t254\n61\t62\t255\t255\n62\t63\t0\t0\n62\t63\t1\t1\n62\t63\t2\t2\n62\t63\t3\t3\n62\t63\t4\t4\n62\t63\t5\t5\n62\t63\t6\t6\n62\t63\t7\t7\n62\t63\t8\t8\n62\t63\t9\t9\n62\t63\t10\t10\n62\t63\t11\t11\n62\t63\t12\t12\n62\t63\t13\t13\n62\t63\t14\t14\n62\t63\t15\t15\n62\t63\t16\t16\n62\t63\t17\t17\n62\t63\t18\t18\n62\t63\t19\t19\n62\t63\t20\t20\n62\t63\t21\t21\n62\t63\t22\t22\n62\t63\t23\t23\n62\t63\t24\t24\n62\t63\t25\t25\n62\t63\t26\t26\n62\t63\t27\t27\n62\t63\t28\t28\n62\t63\t29\t29\n62\t63\t30\t30\n62\t63\t31\t31\n62\t63\t32\t32\n62\t63\t33\t33\n62\t63\t34\t34\n62\t63\t35\t35\n62\t63\t36\t36\n62\t63\t37\t37\n62\t63\t38\t38\n62\t63\t39\t39\n62\t63\t40\t40\n62\t63\t41\t41\n62\t63\t42\t42\n62\t63\t43\t43\n62\t63\t44\t44\n62\t63\t45\t45\n62\t63\t46\t46\n62\t63\t47\t47\n62\t63\t48\t48\n62\t63\t49\t49\n62\t63\t50\t50\n62\t63\t51\t51\n62\t63\t52\t52\n62\t63\t53\t53\n62\t63\t54\t54\n62\t63\t55\t55\n62\t63\t56\t56\n62\t63\t57\t57\n62\t63\t58\t58\n62\t63\t59\t59\n62\t63\t60\t60\n62\t63\t61\t61\n62\t63\t62\t62\n62\t63\t63\t63\n62\t63\t64\t64\n62\t63\t65\t65\n62\t63\t66\t66\n62\t63\t67\t67\n62\t63\t68\t68\n62\t63\t69\t69\n62\t63\t70\t70\n62\t63\t71\t71\n62\t63\t72\t72\n62\t63\t73\t73\n62\t63\t74\t74\n62\t63\t75\t75\n62\t63\t76\t76\n62\t63\t77\t77\n62\t63\t78\t78\n62\t63\t79\t79\n62\t63\t80\t80\n62\t63\t81\t81\n62\t63\t82\t82\n62\t63\t83\t83\n62\t63\t84\t84\n62\t63\t85\t85\n62\t63\t86\t86\n62\t63\t87\t87\n62\t63\t88\t88\n62\t63\t89\t89\n62\t63\t90\t90\n62\t63\t91\t91\n62\t63\t92\t92\n62\t63\t93\t93\n62\t63\t94\t94\n62\t63\t95\t95\n62\t63\t96\t96\n62\t63\t97\t97\n62\t63\t98\t98\n62\t63\t99\t99\n62\t63\t100\t100\n62\t63\t101\t101\n62\t63\t102\t102\n62\t63\t103\t103\n62\t63\t104\t104\n62\t63\t105\t105\n62\t63\t106\t106\n62\t63\t107\t107\n62\t63\t108\t108\n62\t63\t109\t109\n62\t63\t110\t110\n62\t63\t111\t111\n62\t63\t112\t112\n62\t63\t113\t113\n62\t63\t114\t114\n62\t63\t115\t115\n62\t63\t116\t116\n62\t63\t117\t117\n62\t63\t118\t118\n62\t63\t119\t119\n62\t63\t120\t120\n62\t63\t121\t121\n62\t63\t122\t122\n62\t63\t123\t123\n62\t63\t124\t124\n62\t63\t125\t125\n62\t63\t126\t126\n62\t63\t127\t127\n62\t63\t128\t128\n62\t63\t129\t129\n62\t63\t130\t130\n62\t63\t131\t131\n62\t63\t132\t132\n62\t63\t133\t133\n62\t63\t134\t134\n62\t63\t135\t135\n62\t63\t136\t136\n62\t63\t137\t137\n62\t63\t138\t138\n62\t63\t139\t139\n62\t63\t140\t140\n62\t63\t141\t141\n62\t63\t142\t142\n62\t63\t143\t143\n62\t63\t144\t144\n62\t63\t145\t145\n62\t63\t146\t146\n62\t63\t147\t147\n62\t63\t148\t148\n62\t63\t149\t149\n62\t63\t150\t150\n62\t63\t151\t151\n62\t63\t152\t152\n62\t63\t153\t153\n62\t63\t154\t154\n62\t63\t155\t155\n62\t63\t156\t156\n62\t63\t157\t157\n62\t63\t158\t158\n62\t63\t159\t159\n62\t63\t160\t160\n62\t63\t161\t161\n62\t63\t162\t162\n62\t63\t163\t163\n62\t63\t164\t164\n62\t63\t165\t165\n62\t63\t166\t166\n62\t63\t167\t167\n62\t63\t168\t168\n62\t63\t169\t169\n62\t63\t170\t170\n62\t63\t171\t171\n62\t63\t172\t172\n62\t63\t173\t173\n62\t63\t174\t174\n62\t63\t175\t175\n62\t63\t176\t176\n62\t63\t177\t177\n62\t63\t178\t178\n62\t63\t179\t179\n62\t63\t180\t180\n62\t63\t181\t181\n62\t63\t182\t182\n62\t63\t183\t183\n62\t63\t184\t184\n62\t63\t185\t185\n62\t63\t186\t186\n62\t63\t187\t187\n62\t63\t188\t188\n62\t63\t189\t189\n62\t63\t190\t190\n62\t63\t191\t191\n62\t63\t192\t192\n62\t63\t193\t193\n62\t63\t194\t194\n62\t63\t195\t195\n62\t63\t196\t196\n62\t63\t197\t197\n62\t63\t198\t198\n62\t63\t199\t199\n62\t63\t200\t200\n62\t63\t201\t201\n62\t63\t202\t202\n62\t63\t203\t203\n62\t63\t204\t204\n62\t63\t205\t205\n62\t63\t206\t206\n62\t63\t207\t207\n62\t63\t208\t208\n62\t63\t209\t209\n62\t63\t210\t210\n62\t63\t211\t211\n62\t63\t212\t212\n62\t63\t213\t213\n62\t63\t214\t214\n62\t63\t215\t215\n62\t63\t216\t216\n62\t63\t217\t217\n62\t63\t218\t218\n62\t63\t219\t219\n62\t63\t220\t220\n62\t63\t221\t221\n62\t63\t222\t222\n62\t63\t223\t223\n62\t63\t224\t224\n62\t63\t225\t225\n62\t63\t226\t226\n62\t63\t227\t227\n62\t63\t228\t228\n62\t63\t229\t229\n62\t63\t230\t230\n62\t63\t231\t231\n62\t63\t232\t232\n62\t63\t233\t233\n62\t63\t234\t234\n62\t63\t235\t235\n62\t63\t236\t236\n62\t63\t237\t237\n62\t63\t238\t238\n62\t63\t239\t239\n62\t63\t240\t240\n62\t63\t241\t241\n62\t63\t242\t242\n62\t63\t243\t243\n62\t63\t244\t244\n62\t63\t245\t245\n62\t63\t246\t246\n62\t63\t247\t247\n62\t63\t248\t248\n62\t63\t249\t249\n62\t63\t250\t250\n62\t63\t251\t251\n62\t63\t252\t252\n62\t63\t253\t253\n62\t63\t254\t254\n62\t63\t255\t255\n63\t64\t0\t0\n63\t64\t1\t1\n63\t64\t2\t2\n63\t64\t3\t3\n63\t64\t4\t4\n63\t64\t5\t5\n63\t64\t6\t6\n63\t64\t7\t7\n63\t64\t8\t8\n63\t64\t9\t9\n63\t64\t10\t10\n63\t64\t11\t11\n63\t64\t12\t12\n63\t64\t13\t13\n63\t64\t14\t14\n63\t64\t15\t15\n63\t64\t16\t16\n63\t64\t17\t17\n63\t64\t18\t18\n63\t64\t19\t19\n63\t64\t20\t20\n63\t64\t21\t21\n63\t64\t22\t22\n63\t64\t23\t23\n63\t64\t24\t24\n63\t64\t25\t25\n63\t64\t26\t26\n63\t64\t27\t27\n63\t64\t28\t28\n63\t64\t29\t29\n63\t64\t30\t30\n63\t64\t31\t31\n63\t64\t32\t32\n63\t64\t33\t33\n63\t64\t34\t34\n63\t64\t35\t35\n63\t64\t36\t36\n63\t64\t37\t37\n63\t64\t38\t38\n63\t64\t39\t39\n63\t64\t40\t40\n63\t64\t41\t41\n63\t64\t42\t42\n63\t64\t43\t43\n63\t64\t44\t44\n63\t64\t45\t45\n63\t64\t46\t46\n63\t64\t47\t47\n63\t64\t48\t48\n63\t64\t49\t49\n63\t64\t50\t50\n63\t64\t51\t51\n63\t64\t52\t52\n63\t64\t53\t53\n63\t64\t54\t54\n63\t64\t55\t55\n63\t64\t56\t56\n63\t64\t57\t57\n63\t64\t58\t58\n63\t64\t59\t59\n63\t64\t60\t60\n63\t64\t61\t61\n63\t64\t62\t62\n63\t64\t63\t63\n63\t64\t64\t64\n63\t64\t65\t65\n63\t64\t66\t66\n63\t64\t67\t67\n63\t64\t68\t68\n63\t64\t69\t69\n63\t64\t70\t70\n63\t64\t71\t71\n63\t64\t72\t72\n63\t64\t73\t73\n63\t64\t74\t74\n63\t64\t75\t75\n63\t64\t76\t76\n63\t64\t77\t77\n63\t64\t78\t78\n63\t64\t79\t79\n63\t64\t80\t80\n63\t64\t81\t81\n63\t64\t82\t82\n63\t64\t83\t83\n63\t64\t84\t84\n63\t64\t85\t85\n63\t64\t86\t86\n63\t64\t87\t87\n63\t64\t88\t88\n63\t64\t89\t89\n63\t64\t90\t90\n63\t64\t91\t91\n63\t64\t92\t92\n63\t64\t93\t93\n63\t64\t94\t94\n63\t64\t95\t95\n63\t64\t96\t96\n63\t64\t97\t97\n63\t64\t98\t98\n63\t64\t99\t99\n63\t64\t100\t100\n63\t64\t101\t101\n63\t64\t102\t102\n63\t64\t103\t103\n63\t64\t104\t104\n63\t64\t105\t105\n63\t64\t106\t106\n63\t64\t107\t107\n63\t64\t108\t108\n63\t64\t109\t109\n63\t64\t110\t110\n63\t64\t111\t111\n63\t64\t112\t112\n63\t64\t113\t113\n63\t64\t114\t114\n63\t64\t115\t115\n63\t64\t116\t116\n63\t64\t117\t117\n63\t64\t118\t118\n63\t64\t119\t119\n63\t64\t120\t120\n63\t64\t121\t121\n63\t64\t122\t122\n63\t64\t123\t123\n63\t64\t124\t124\n63\t64\t125\t125\n63\t64\t126\t126\n63\t64\t127\t127\n63\t64\t128\t128\n63\t64\t129\t129\n63\t64\t130\t130\n63\t64\t131\t131\n63\t64\t132\t132\n63\t64\t133\t133\n63\t64\t134\t134\n63\t64\t135\t135\n63\t64\t136\t136\n63\t64\t137\t137\n63\t64\t138\t138\n63\t64\t139\t139\n63\t64\t140\t140\n63\t64\t141\t141\n63\t64\t142\t142\n63\t64\t143\t143\n63\t64\t144\t144\n63\t64\t145\t145\n63\t64\t146\t146\n63\t64\t147\t147\n63\t64\t148\t148\n63\t64\t149\t149\n63\t64\t150\t150\n63\t64\t151\t151\n63\t64\t152\t152\n63\t64\t153\t153\n63\t64\t154\t154\n63\t64\t155\t155\n63\t64\t156\t156\n63\t64\t157\t157\n63\t64\t158\t158\n63\t64\t159\t159\n63\t64\t160\t160\n63\t64\t161\t161\n63\t64\t162\t162\n63\t64\t163\t163\n63\t64\t164\t164\n63\t64\t165\t165\n63\t64\t166\t166\n63\t64\t167\t167\n63\t64\t168\t168\n63\t64\t169\t169\n63\t64\t170\t170\n63\t64\t171\t171\n63\t64\t172\t172\n63\t64\t173\t173\n63\t64\t174\t174\n63\t64\t175\t175\n63\t64\t176\t176\n63\t64\t177\t177\n63\t64\t178\t178\n63\t64\t179\t179\n63\t64\t180\t180\n63\t64\t181\t181\n63\t64\t182\t182\n63\t64\t183\t183\n63\t64\t184\t184\n63\t64\t185\t185\n63\t64\t186\t186\n63\t64\t187\t187\n63\t64\t188\t188\n63\t64\t189\t189\n63\t64\t190\t190\n63\t64\t191\t191\n63\t64\t192\t192\n63\t64\t193\t193\n63\t64\t194\t194\n63\t64\t195\t195\n63\t64\t196\t196\n63\t64\t197\t197\n63\t64\t198\t198\n63\t64\t199\t199\n63\t64\t200\t200\n63\t64\t201\t201\n63\t64\t202\t202\n63\t64\t203\t203\n63\t64\t204\t204\n63\t64\t205\t205\n63\t64\t206\t206\n63\t64\t207\t207\n63\t64\t208\t208\n63\t64\t209\t209\n63\t64\t210\t210\n63\t64\t211\t211\n63\t64\t212\t212\n63\t64\t213\t213\n63\t64\t214\t214\n63\t64\t215\t215\n63\t64\t216\t216\n63\t64\t217\t217\n63\t64\t218\t218\n63\t64\t219\t219\n63\t64\t220\t220\n63\t64\t221\t221\n63\t64\t222\t222\n63\t64\t223\t223\n63\t64\t224\t224\n63\t64\t225\t225\n63\t64\t226\t226\n63\t64\t227\t227\n63\t64\t228\t228\n63\t64\t229\t229\n63\t64\t230\t230\n63\t64\t231\t231\n63\t64\t232\t232\n63\t64\t233\t233\n63\t64\t234\t234\n63\t64\t235\t235\n63\t64\t236\t236\n63\t64\t237\t237\n63\t64\t238\t238\n63\t64\t239\t239\n63\t64\t240\t240\n63\t64\t241\t241\n63\t64\t242\t242\n63\t64\t243\t243\n63\t64\t244\t244\n63\t64\t245\t245\n63\t64\t246\t246\n63\t64\t247\t247\n63\t64\t248\t248\n63\t64\t249\t249\n63\t64\t250\t250\n63\t64\t251\t251\n63\t64\t252\t252\n63\t64\t253\t253\n63\t64\t254\t254\n63\t64\t255\t255\n64\t65\t0\t0\n64\t65\t1\t1\n64\t65\t2\t2\n64\t65\t3\t3\n64\t65\t4\t4\n64\t65\t5\t5\n64\t65\t6\t6\n64\t65\t7\t7\n64\t65\t8\t8\n64\t65\t9\t9\n64\t65\t10\t10\n64\t65\t11\t11\n64\t65\t12\t12\n64\t65\t13\t13\n64\t65\t14\t14\n64\t65\t15\t15\n64\t65\t16\t16\n64\t65\t17\t17\n64\t65\t18\t18\n64\t65\t19\t19\n64\t65\t20\t20\n64\t65\t21\t21\n64\t65\t22\t22\n64\t65\t23\t23\n64\t65\t24\t24\n64\t65\t25\t25\n64\t65\t26\t26\n64\t65\t27\t27\n64\t65\t28\t28\n64\t65\t29\t29\n64\t65\t30\t30\n64\t65\t31\t31\n64\t65\t32\t32\n64\t65\t33\t33\n64\t65\t34\t34\n64\t65\t35\t35\n64\t65\t36\t36\n64\t65\t37\t37\n64\t65\t38\t38\n64\t65\t39\t39\n64\t65\t40\t40\n64\t65\t41\t41\n64\t65\t42\t42\n64\t65\t43\t43\n64\t65\t44\t44\n64\t65\t45\t45\n64\t65\t46\t46\n64\t65\t47\t47\n64\t65\t48\t48\n64\t65\t49\t49\n64\t65\t50\t50\n64\t65\t51\t51\n64\t65\t52\t52\n64\t65\t53\t53\n64\t65\t54\t54\n64\t65\t55\t55\n64\t65\t56\t56\n64\t65\t57\t57\n64\t65\t58\t58\n64\t65\t59\t59\n64\t65\t60\t60\n64\t65\t61\t61\n64\t65\t62\t62\n64\t65\t63\t63\n64\t65\t64\t64\n64\t65\t65\t65\n64\t65\t66\t66\n64\t65\t67\t67\n64\t65\t68\t68\n64\t65\t69\t69\n64\t65\t70\t70\n64\t65\t71\t71\n64\t65\t72\t72\n64\t65\t73\t73\n64\t65\t74\t74\n64\t65\t75\t75\n64\t65\t76\t76\n64\t65\t77\t77\n64\t65\t78\t78\n64\t65\t79\t79\n64\t65\t80\t80\n64\t65\t81\t81\n64\t65\t82\t82\n64\t65\t83\t83\n64\t65\t84\t84\n64\t65\t85\t85\n64\t65\t86\t86\n64\t65\t87\t87\n64\t65\t88\t88\n64\t65\t89\t89\n64\t65\t90\t90\n64\t65\t91\t91\n64\t65\t92\t92\n64\t65\t93\t93\n64\t65\t94\t94\n64\t65\t95\t95\n64\t65\t96\t96\n64\t65\t97\t97\n64\t65\t98\t98\n64\t65\t99\t99\n64\t65\t100\t100\n64\t65\t101\t101\n64\t65\t102\t102\n64\t65\t103\t103\n64\t65\t104\t104\n64\t65\t105\t105\n64\t65\t106\t106\n64\t65\t107\t107\n64\t65\t108\t108\n64\t65\t109\t109\n64\t65\t110\t110\n64\t65\t111\t111\n64\t65\t112\t112\n64\t65\t113\t113\n64\t65\t114\t114\n64\t65\t115\t115\n64\t65\t116\t116\n64\t65\t117\t117\n64\t65\t118\t118\n64\t65\t119\t119\n64\t65\t120\t120\n64\t65\t121\t121\n64\t65\t122\t122\n64\t65\t123\t123\n64\t65\t124\t124\n64\t65\t125\t125\n64\t65\t126\t126\n64\t65\t127\t127\n64\t65\t128\t128\n64\t65\t129\t129\n64\t65\t130\t130\n64\t65\t131\t131\n64\t65\t132\t132\n64\t65\t133\t133\n64\t65\t134\t134\n64\t65\t135\t135\n64\t65\t136\t136\n64\t65\t137\t137\n64\t65\t138\t138\n64\t65\t139\t139\n64\t65\t140\t140\n64\t65\t141\t141\n64\t65\t142\t142\n64\t65\t143\t143\n64\t65\t144\t144\n64\t65\t145\t145\n64\t65\t146\t146\n64\t65\t147\t147\n64\t65\t148\t148\n64\t65\t149\t149\n64\t65\t150\t150\n64\t65\t151\t151\n64\t65\t152\t152\n64\t65\t153\t153\n64\t65\t154\t154\n64\t65\t155\t155\n64\t65\t156\t156\n64\t65\t157\t157\n64\t65\t158\t158\n64\t65\t159\t159\n64\t65\t160\t160\n64\t65\t161\t161\n64\t65\t162\t162\n64\t65\t163\t163\n64\t65\t164\t164\n64\t65\t165\t165\n64\t65\t166\t166\n64\t65\t167\t167\n64\t65\t168\t168\n64\t65\t169\t169\n64\t65\t170\t170\n64\t65\t171\t171\n64\t65\t172\t172\n64\t65\t173\t173\n64\t65\t174\t174\n64\t65\t175\t175\n64\t65\t176\t176\n64\t65\t177\t177\n64\t65\t178\t178\n64\t65\t179\t179\n64\t65\t180\t180\n64\t65\t181\t181\n64\t65\t182\t182\n64\t65\t183\t183\n64\t65\t184\t184\n64\t65\t185\t185\n64\t65\t186\t186\n64\t65\t187\t187\n64\t65\t188\t188\n64\t65\t189\t189\n64\t65\t190\t190\n64\t65\t191\t191\n64\t65\t192\t192\n64\t65\t193\t193\n64\t65\t194\t194\n64\t65\t195\t195\n64\t65\t196\t196\n64\t65\t197\t197\n64\t65\t198\t198\n64\t65\t199\t199\n64\t65\t200\t200\n64\t65\t201\t201\n64\t65\t202\t202\n64\t65\t203\t203\n64\t65\t204\t204\n64\t65\t205\t205\n64\t65\t206\t206\n64\t65\t207\t207\n64\t65\t208\t208\n64\t65\t209\t209\n64\t65\t210\t210\n64\t65\t211\t211\n64\t65\t212\t212\n64\t65\t213\t213\n64\t65\t214\t214\n64\t65\t215\t215\n64\t65\t216\t216\n64\t65\t217\t217\n64\t65\t218\t218\n64\t65\t219\t219\n64\t65\t220\t220\n64\t65\t221\t221\n64\t65\t222\t222\n64\t65\t223\t223\n64\t65\t224\t224\n64\t65\t225\t225\n64\t65\t226\t226\n64\t65\t227\t227\n64\t65\t228\t228\n64\t65\t229\t229\n64\t65\t230\t230\n64\t65\t231\t231\n64\t65\t232\t232\n64\t65\t233\t233\n64\t65\t234\t234\n64\t65\t235\t235\n64\t65\t236\t236\n64\t65\t237\t237\n64\t65\t238\t238\n64\t65\t239\t239\n64\t65\t240\t240\n64\t65\t241\t241\n64\t65\t242\t242\n64\t65\t243\t243\n64\t65\t244\t244\n64\t65\t245\t245\n64\t65\t246\t246\n64\t65\t247\t247\n64\t65\t248\t248\n64\t65\t249\t249\n64\t65\t250\t250\n64\t65\t251\t251\n64\t65\t252\t252\n64\t65\t253\t253\n64\t65\t254\t254\n64\t65\t255\t255\n65\t66\t0\t0\n65\t66\t1\t1\n65\t66\t2\t2\n65\t66\t3\t3\n65\t66\t4\t4\n65\t66\t5\t5\n65\t66\t6\t6\n65\t66\t7\t7\n65\t66\t8\t8\n65\t66\t9\t9\n65\t66\t10\t10\n65\t66\t11\t11\n65\t66\t12\t12\n65\t66\t13\t13\n65\t66\t14\t14\n65\t66\t15\t15\n65\t66\t16\t16\n65\t66\t17\t17\n65\t66\t18\t18\n65\t66\t19\t19\n65\t66\t20\t20\n65\t66\t21\t21\n65\t66\t22\t22\n65\t66\t23\t23\n65\t66\t24\t24\n65\t66\t25\t25\n65\t66\t26\t26\n65\t66\t27\t27\n65\t66\t28\t28\n65\t66\t29\t29\n65\t66\t30\t30\n65\t66\t31\t31\n65\t66\t32\t32\n65\t66\t33\t33\n65\t66\t34\t34\n65\t66\t35\t35\n65\t66\t36\t36\n65\t66\t37\t37\n65\t66\t38\t38\n65\t66\t39\t39\n65\t66\t40\t40\n65\t66\t41\t41\n65\t66\t42\t42\n65\t66\t43\t43\n65\t66\t44\t44\n65\t66\t45\t45\n65\t66\t46\t46\n65\t66\t47\t47\n65\t66\t48\t48\n65\t66\t49\t49\n65\t66\t50\t50\n65\t66\t51\t51\n65\t66\t52\t52\n65\t66\t53\t53\n65\t66\t54\t54\n65\t66\t55\t55\n65\t66\t56\t56\n65\t66\t57\t57\n65\t66\t58\t58\n65\t66\t59\t59\n65\t66\t60\t60\n65\t66\t61\t61\n65\t66\t62\t62\n65\t66\t63\t63\n65\t66\t64\t64\n65\t66\t65\t65\n65\t66\t66\t66\n65\t66\t67\t67\n65\t66\t68\t68\n65\t66\t69\t69\n65\t66\t70\t70\n65\t66\t71\t71\n65\t66\t72\t72\n65\t66\t73\t73\n65\t66\t74\t74\n65\t66\t75\t75\n65\t66\t76\t76\n65\t66\t77\t77\n65\t66\t78\t78\n65\t66\t79\t79\n65\t66\t80\t80\n65\t66\t81\t81\n65\t66\t82\t82\n65\t66\t83\t83\n65\t66\t84\t84\n65\t66\t85\t85\n65\t66\t86\t86\n65\t66\t87\t87\n65\t66\t88\t88\n65\t66\t89\t89\n65\t66\t90\t90\n65\t66\t91\t91\n65\t66\t92\t92\n65\t66\t93\t93\n65\t66\t94\t94\n65\t66\t95\t95\n65\t66\t96\t96\n65\t66\t97\t97\n65\t66\t98\t98\n65\t66\t99\t99\n65\t66\t100\t100\n65\t66\t101\t101\n65\t66\t102\t102\n65\t66\t103\t103\n65\t66\t104\t104\n65\t66\t105\t105\n65\t66\t106\t106\n65\t66\t107\t107\n65\t66\t108\t108\n65\t66\t109\t109\n65\t66\t110\t110\n65\t66\t111\t111\n65\t66\t112\t112\n65\t66\t113\t113\n65\t66\t114\t114\n65\t66\t115\t115\n65\t66\t116\t116\n65\t66\t117\t117\n65\t66\t118\t118\n65\t66\t119\t119\n65\t66\t120\t120\n65\t66\t121\t121\n65\t66\t122\t122\n65\t66\t123\t123\n65\t66\t124\t124\n65\t66\t125\t125\n65\t66\t126\t126\n65\t66\t127\t127\n65\t66\t128\t128\n65\t66\t129\t129\n65\t66\t130\t130\n65\t66\t131\t131\n65\t66\t132\t132\n65\t66\t133\t133\n65\t66\t134\t134\n65\t66\t135\t135\n65\t66\t136\t136\n65\t66\t137\t137\n65\t66\t138\t138\n65\t66\t139\t139\n65\t66\t140\t140\n65\t66\t141\t141\n65\t66\t142\t142\n65\t66\t143\t143\n65\t66\t144\t144\n65\t66\t145\t145\n65\t66\t146\t146\n65\t66\t147\t147\n65\t66\t148\t148\n65\t66\t149\t149\n65\t66\t150\t150\n65\t66\t151\t151\n65\t66\t152\t152\n65\t66\t153\t153\n65\t66\t154\t154\n65\t66\t155\t155\n65\t66\t156\t156\n65\t66\t157\t157\n65\t66\t158\t158\n65\t66\t159\t159\n65\t66\t160\t160\n65\t66\t161\t161\n65\t66\t162\t162\n65\t66\t163\t163\n65\t66\t164\t164\n65\t66\t165\t165\n65\t66\t166\t166\n65\t66\t167\t167\n65\t66\t168\t168\n65\t66\t169\t169\n65\t66\t170\t170\n65\t66\t171\t171\n65\t66\t172\t172\n65\t66\t173\t173\n65\t66\t174\t174\n65\t66\t175\t175\n65\t66\t176\t176\n65\t66\t177\t177\n65\t66\t178\t178\n65\t66\t179\t179\n65\t66\t180\t180\n65\t66\t181\t181\n65\t66\t182\t182\n65\t66\t183\t183\n65\t66\t184\t184\n65\t66\t185\t185\n65\t66\t186\t186\n65\t66\t187\t187\n65\t66\t188\t188\n65\t66\t189\t189\n65\t66\t190\t190\n65\t66\t191\t191\n65\t66\t192\t192\n65\t66\t193\t193\n65\t66\t194\t194\n65\t66\t195\t195\n65\t66\t196\t196\n65\t66\t197\t197\n65\t66\t198\t198\n65\t66\t199\t199\n65\t66\t200\t200\n65\t66\t201\t201\n65\t66\t202\t202\n65\t66\t203\t203\n65\t66\t204\t204\n65\t66\t205\t205\n65\t66\t206\t206\n65\t66\t207\t207\n65\t66\t208\t208\n65\t66\t209\t209\n65\t66\t210\t210\n65\t66\t211\t211\n65\t66\t212\t212\n65\t66\t213\t213\n65\t66\t214\t214\n65\t66\t215\t215\n65\t66\t216\t216\n65\t66\t217\t217\n65\t66\t218\t218\n65\t66\t219\t219\n65\t66\t220\t220\n65\t66\t221\t221\n65\t66\t222\t222\n65\t66\t223\t223\n65\t66\t224\t224\n65\t66\t225\t225\n65\t66\t226\t226\n65\t66\t227\t227\n65\t66\t228\t228\n65\t66\t229\t229\n65\t66\t230\t230\n65\t66\t231\t231\n65\t66\t232\t232\n65\t66\t233\t233\n65\t66\t234\t234\n65\t66\t235\t235\n65\t66\t236\t236\n65\t66\t237\t237\n65\t66\t238\t238\n65\t66\t239\t239\n65\t66\t240\t240\n65\t66\t241\t241\n65\t66\t242\t242\n65\t66\t243\t243\n65\t66\t244\t244\n65\t66\t245\t245\n65\t66\t246\t246\n65\t66\t247\t247\n65\t66\t248\t248\n65\t66\t249\t249\n65\t66\t250\t250\n65\t66\t251\t251\n65\t66\t252\t252\n65\t66\t253\t253\n65\t66\t254\t254\n65\t66\t255\t255\n66\t67\t0\t0\n66\t67\t1\t1\n66\t67\t2\t2\n66\t67\t3\t3\n66\t67\t4\t4\n66\t67\t5\t5\n66\t67\t6\t6\n66\t67\t7\t7\n66\t67\t8\t8\n66\t67\t9\t9\n66\t67\t10\t10\n66\t67\t11\t11\n66\t67\t12\t12\n66\t67\t13\t13\n66\t67\t14\t14\n66\t67\t15\t15\n66\t67\t16\t16\n66\t67\t17\t17\n66\t67\t18\t18\n66\t67\t19\t19\n66\t67\t20\t20\n66\t67\t21\t21\n66\t67\t22\t22\n66\t67\t23\t23\n66\t67\t24\t24\n66\t67\t25\t25\n66\t67\t26\t26\n66\t67\t27\t27\n66\t67\t28\t28\n66\t67\t29\t29\n66\t67\t30\t30\n66\t67\t31\t31\n66\t67\t32\t32\n66\t67\t33\t33\n66\t67\t34\t34\n66\t67\t35\t35\n66\t67\t36\t36\n66\t67\t37\t37\n66\t67\t38\t38\n66\t67\t39\t39\n66\t67\t40\t40\n66\t67\t41\t41\n66\t67\t42\t42\n66\t67\t43\t43\n66\t67\t44\t44\n66\t67\t45\t45\n66\t67\t46\t46\n66\t67\t47\t47\n66\t67\t48\t48\n66\t67\t49\t49\n66\t67\t50\t50\n66\t67\t51\t51\n66\t67\t52\t52\n66\t67\t53\t53\n66\t67\t54\t54\n66\t67\t55\t55\n66\t67\t56\t56\n66\t67\t57\t57\n66\t67\t58\t58\n66\t67\t59\t59\n66\t67\t60\t60\n66\t67\t61\t61\n66\t67\t62\t62\n66\t67\t63\t63\n66\t67\t64\t64\n66\t67\t65\t65\n66\t67\t66\t66\n66\t67\t67\t67\n66\t67\t68\t68\n66\t67\t69\t69\n66\t67\t70\t70\n66\t67\t71\t71\n66\t67\t72\t72\n66\t67\t73\t73\n66\t67\t74\t74\n66\t67\t75\t75\n66\t67\t76\t76\n66\t67\t77\t77\n66\t67\t78\t78\n66\t67\t79\t79\n66\t67\t80\t80\n66\t67\t81\t81\n66\t67\t82\t82\n66\t67\t83\t83\n66\t67\t84\t84\n66\t67\t85\t85\n66\t67\t86\t86\n66\t67\t87\t87\n66\t67\t88\t88\n66\t67\t89\t89\n66\t67\t90\t90\n66\t67\t91\t91\n66\t67\t92\t92\n66\t67\t93\t93\n66\t67\t94\t94\n66\t67\t95\t95\n66\t67\t96\t96\n66\t67\t97\t97\n66\t67\t98\t98\n66\t67\t99\t99\n66\t67\t100\t100\n66\t67\t101\t101\n66\t67\t102\t102\n66\t67\t103\t103\n66\t67\t104\t104\n66\t67\t105\t105\n66\t67\t106\t106\n66\t67\t107\t107\n66\t67\t108\t108\n66\t67\t109\t109\n66\t67\t110\t110\n66\t67\t111\t111\n66\t67\t112\t112\n66\t67\t113\t113\n66\t67\t114\t114\n66\t67\t115\t115\n66\t67\t116\t116\n66\t67\t117\t117\n66\t67\t118\t118\n66\t67\t119\t119\n66\t67\t120\t120\n66\t67\t121\t121\n66\t67\t122\t122\n66\t67\t123\t123\n66\t67\t124\t124\n66\t67\t125\t125\n66\t67\t126\t126\n66\t67\t127\t127\n66\t67\t128\t128\n66\t67\t129\t129\n66\t67\t130\t130\n66\t67\t131\t131\n66\t67\t132\t132\n66\t67\t133\t133\n66\t67\t134\t134\n66\t67\t135\t135\n66\t67\t136\t136\n66\t67\t137\t137\n66\t67\t138\t138\n66\t67\t139\t139\n66\t67\t140\t140\n66\t67\t141\t141\n66\t67\t142\t142\n66\t67\t143\t143\n66\t67\t144\t144\n66\t67\t145\t145\n66\t67\t146\t146\n66\t67\t147\t147\n66\t67\t148\t148\n66\t67\t149\t149\n66\t67\t150\t150\n66\t67\t151\t151\n66\t67\t152\t152\n66\t67\t153\t153\n66\t67\t154\t154\n66\t67\t155\t155\n66\t67\t156\t156\n66\t67\t157\t157\n66\t67\t158\t158\n66\t67\t159\t159\n66\t67\t160\t160\n66\t67\t161\t161\n66\t67\t162\t162\n66\t67\t163\t163\n66\t67\t164\t164\n66\t67\t165\t165\n66\t67\t166\t166\n66\t67\t167\t167\n66\t67\t168\t168\n66\t67\t169\t169\n66\t67\t170\t170\n66\t67\t171\t171\n66\t67\t172\t172\n66\t67\t173\t173\n66\t67\t174\t174\n66\t67\t175\t175\n66\t67\t176\t176\n66\t67\t177\t177\n66\t67\t178\t178\n66\t67\t179\t179\n66\t67\t180\t180\n66\t67\t181\t181\n66\t67\t182\t182\n66\t67\t183\t183\n66\t67\t184\t184\n66\t67\t185\t185\n66\t67\t186\t186\n66\t67\t187\t187\n66\t67\t188\t188\n66\t67\t189\t189\n66\t67\t190\t190\n66\t67\t191\t191\n66\t67\t192\t192\n66\t67\t193\t193\n66\t67\t194\t194\n66\t67\t195\t195\n66\t67\t196\t196\n66\t67\t197\t197\n66\t67\t198\t198\n66\t67\t199\t199\n66\t67\t200\t200\n66\t67\t201\t201\n66\t67\t202\t202\n66\t67\t203\t203\n66\t67\t204\t204\n66\t67\t205\t205\n66\t67\t206\t206\n66\t67\t207\t207\n66\t67\t208\t208\n66\t67\t209\t209\n66\t67\t210\t210\n66\t67\t211\t211\n66\t67\t212\t212\n66\t67\t213\t213\n66\t67\t214\t214\n66\t67\t215\t215\n66\t67\t216\t216\n66\t67\t217\t217\n66\t67\t218\t218\n66\t67\t219\t219\n66\t67\t220\t220\n66\t67\t221\t221\n66\t67\t222\t222\n66\t67\t223\t223\n66\t67\t224\t224\n66\t67\t225\t225\n66\t67\t226\t226\n66\t67\t227\t227\n66\t67\t228\t228\n66\t67\t229\t229\n66\t67\t230\t230\n66\t67\t231\t231\n66\t67\t232\t232\n66\t67\t233\t233\n66\t67\t234\t234\n66\t67\t235\t235\n66\t67\t236\t236\n66\t67\t237\t237\n66\t67\t238\t238\n66\t67\t239\t239\n66\t67\t240\t240\n66\t67\t241\t241\n66\t67\t242\t242\n66\t67\t243\t243\n66\t67\t244\t244\n66\t67\t245\t245\n66\t67\t246\t246\n66\t67\t247\t247\n66\t67\t248\t248\n66\t67\t249\t249\n66\t67\t250\t250\n66\t67\t251\t251\n66\t67\t252\t252\n66\t67\t253\t253\n66\t67\t254\t254\n66\t67\t255\t255\n67\t68\t0\t0\n67\t68\t1\t1\n67\t68\t2\t2\n67\t68\t3\t3\n67\t68\t4\t4\n67\t68\t5\t5\n67\t68\t6\t6\n67\t68\t7\t7\n67\t68\t8\t8\n67\t68\t9\t9\n67\t68\t10\t10\n67\t68\t11\t11\n67\t68\t12\t12\n67\t68\t13\t13\n67\t68\t14\t14\n67\t68\t15\t15\n67\t68\t16\t16\n67\t68\t17\t17\n67\t68\t18\t18\n67\t68\t19\t19\n67\t68\t20\t20\n67\t68\t21\t21\n67\t68\t22\t22\n67\t68\t23\t23\n67\t68\t24\t24\n67\t68\t25\t25\n67\t68\t26\t26\n67\t68\t27\t27\n67\t68\t28\t28\n67\t68\t29\t29\n67\t68\t30\t30\n67\t68\t31\t31\n67\t68\t32\t32\n67\t68\t33\t33\n67\t68\t34\t34\n67\t68\t35\t35\n67\t68\t36\t36\n67\t68\t37\t37\n67\t68\t38\t38\n67\t68\t39\t39\n67\t68\t40\t40\n67\t68\t41\t41\n67\t68\t42\t42\n67\t68\t43\t43\n67\t68\t44\t44\n67\t68\t45\t45\n67\t68\t46\t46\n67\t68\t47\t47\n67\t68\t48\t48\n67\t68\t49\t49\n67\t68\t50\t50\n67\t68\t51\t51\n67\t68\t52\t52\n67\t68\t53\t53\n67\t68\t54\t54\n67\t68\t55\t55\n67\t68\t56\t56\n67\t68\t57\t57\n67\t68\t58\t58\n67\t68\t59\t59\n67\t68\t60\t60\n67\t68\t61\t61\n67\t68\t62\t62\n67\t68\t63\t63\n67\t68\t64\t64\n67\t68\t65\t65\n67\t68\t66\t66\n67\t68\t67\t67\n67\t68\t68\t68\n67\t68\t69\t69\n67\t68\t70\t70\n67\t68\t71\t71\n67\t68\t72\t72\n67\t68\t73\t73\n67\t68\t74\t74\n67\t68\t75\t75\n67\t68\t76\t76\n67\t68\t77\t77\n67\t68\t78\t78\n67\t68\t79\t79\n67\t68\t80\t80\n67\t68\t81\t81\n67\t68\t82\t82\n67\t68\t83\t83\n67\t68\t84\t84\n67\t68\t85\t85\n67\t68\t86\t86\n67\t68\t87\t87\n67\t68\t88\t88\n67\t68\t89\t89\n67\t68\t90\t90\n67\t68\t91\t91\n67\t68\t92\t92\n67\t68\t93\t93\n67\t68\t94\t94\n67\t68\t95\t95\n67\t68\t96\t96\n67\t68\t97\t97\n67\t68\t98\t98\n67\t68\t99\t99\n67\t68\t100\t100\n67\t68\t101\t101\n67\t68\t102\t102\n67\t68\t103\t103\n67\t68\t104\t104\n67\t68\t105\t105\n67\t68\t106\t106\n67\t68\t107\t107\n67\t68\t108\t108\n67\t68\t109\t109\n67\t68\t110\t110\n67\t68\t111\t111\n67\t68\t112\t112\n67\t68\t113\t113\n67\t68\t114\t114\n67\t68\t115\t115\n67\t68\t116\t116\n67\t68\t117\t117\n67\t68\t118\t118\n67\t68\t119\t119\n67\t68\t120\t120\n67\t68\t121\t121\n67\t68\t122\t122\n67\t68\t123\t123\n67\t68\t124\t124\n67\t68\t125\t125\n67\t68\t126\t126\n67\t68\t127\t127\n67\t68\t128\t128\n67\t68\t129\t129\n67\t68\t130\t130\n67\t68\t131\t131\n67\t68\t132\t132\n67\t68\t133\t133\n67\t68\t134\t134\n67\t68\t135\t135\n67\t68\t136\t136\n67\t68\t137\t137\n67\t68\t138\t138\n67\t68\t139\t139\n67\t68\t140\t140\n67\t68\t141\t141\n67\t68\t142\t142\n67\t68\t143\t143\n67\t68\t144\t144\n67\t68\t145\t145\n67\t68\t146\t146\n67\t68\t147\t147\n67\t68\t148\t148\n67\t68\t149\t149\n67\t68\t150\t150\n67\t68\t151\t151\n67\t68\t152\t152\n67\t68\t153\t153\n67\t68\t154\t154\n67\t68\t155\t155\n67\t68\t156\t156\n67\t68\t157\t157\n67\t68\t158\t158\n67\t68\t159\t159\n67\t68\t160\t160\n67\t68\t161\t161\n67\t68\t162\t162\n67\t68\t163\t163\n67\t68\t164\t164\n67\t68\t165\t165\n67\t68\t166\t166\n67\t68\t167\t167\n67\t68\t168\t168\n67\t68\t169\t169\n67\t68\t170\t170\n67\t68\t171\t171\n67\t68\t172\t172\n67\t68\t173\t173\n67\t68\t174\t174\n67\t68\t175\t175\n67\t68\t176\t176\n67\t68\t177\t177\n67\t68\t178\t178\n67\t68\t179\t179\n67\t68\t180\t180\n67\t68\t181\t181\n67\t68\t182\t182\n67\t68\t183\t183\n67\t68\t184\t184\n67\t68\t185\t185\n67\t68\t186\t186\n67\t68\t187\t187\n67\t68\t188\t188\n67\t68\t189\t189\n67\t68\t190\t190\n67\t68\t191\t191\n67\t68\t192\t192\n67\t68\t193\t193\n67\t68\t194\t194\n67\t68\t195\t195\n67\t68\t196\t196\n67\t68\t197\t197\n67\t68\t198\t198\n67\t68\t199\t199\n67\t68\t200\t200\n67\t68\t201\t201\n67\t68\t202\t202\n67\t68\t203\t203\n67\t68\t204\t204\n67\t68\t205\t205\n67\t68\t206\t206\n67\t68\t207\t207\n67\t68\t208\t208\n67\t68\t209\t209\n67\t68\t210\t210\n67\t68\t211\t211\n67\t68\t212\t212\n67\t68\t213\t213\n67\t68\t214\t214\n67\t68\t215\t215\n67\t68\t216\t216\n67\t68\t217\t217\n67\t68\t218\t218\n67\t68\t219\t219\n67\t68\t220\t220\n67\t68\t221\t221\n67\t68\t222\t222\n67\t68\t223\t223\n67\t68\t224\t224\n67\t68\t225\t225\n67\t68\t226\t226\n67\t68\t227\t227\n67\t68\t228\t228\n67\t68\t229\t229\n67\t68\t230\t230\n67\t68\t231\t231\n67\t68\t232\t232\n67\t68\t233\t233\n67\t68\t234\t234\n67\t68\t235\t235\n67\t68\t236\t236\n67\t68\t237\t237\n67\t68\t238\t238\n67\t68\t239\t239\n67\t68\t240\t240\n67\t68\t241\t241\n67\t68\t242\t242\n67\t68\t243\t243\n67\t68\t244\t244\n67\t68\t245\t245\n67\t68\t246\t246\n67\t68\t247\t247\n67\t68\t248\t248\n67\t68\t249\t249\n67\t68\t250\t250\n67\t68\t251\t251\n67\t68\t252\t252\n67\t68\t253\t253\n67\t68\t254\t254\n67\t68\t255\t255\n68\t69\t0\t0\n68\t69\t1\t1\n68\t69\t2\t2\n68\t69\t3\t3\n68\t69\t4\t4\n68\t69\t5\t5\n68\t69\t6\t6\n68\t69\t7\t7\n68\t69\t8\t8\n68\t69\t9\t9\n68\t69\t10\t10\n68\t69\t11\t11\n68\t69\t12\t12\n68\t69\t13\t13\n68\t69\t14\t14\n68\t69\t15\t15\n68\t69\t16\t16\n68\t69\t17\t17\n68\t69\t18\t18\n68\t69\t19\t19\n68\t69\t20\t20\n68\t69\t21\t21\n68\t69\t22\t22\n68\t69\t23\t23\n68\t69\t24\t24\n68\t69\t25\t25\n68\t69\t26\t26\n68\t69\t27\t27\n68\t69\t28\t28\n68\t69\t29\t29\n68\t69\t30\t30\n68\t69\t31\t31\n68\t69\t32\t32\n68\t69\t33\t33\n68\t69\t34\t34\n68\t69\t35\t35\n68\t69\t36\t36\n68\t69\t37\t37\n68\t69\t38\t38\n68\t69\t39\t39\n68\t69\t40\t40\n68\t69\t41\t41\n68\t69\t42\t42\n68\t69\t43\t43\n68\t69\t44\t44\n68\t69\t45\t45\n68\t69\t46\t46\n68\t69\t47\t47\n68\t69\t48\t48\n68\t69\t49\t49\n68\t69\t50\t50\n68\t69\t51\t51\n68\t69\t52\t52\n68\t69\t53\t53\n68\t69\t54\t54\n68\t69\t55\t55\n68\t69\t56\t56\n68\t69\t57\t57\n68\t69\t58\t58\n68\t69\t59\t59\n68\t69\t60\t60\n68\t69\t61\t61\n68\t69\t62\t62\n68\t69\t63\t63\n68\t69\t64\t64\n68\t69\t65\t65\n68\t69\t66\t66\n68\t69\t67\t67\n68\t69\t68\t68\n68\t69\t69\t69\n68\t69\t70\t70\n68\t69\t71\t71\n68\t69\t72\t72\n68\t69\t73\t73\n68\t69\t74\t74\n68\t69\t75\t75\n68\t69\t76\t76\n68\t69\t77\t77\n68\t69\t78\t78\n68\t69\t79\t79\n68\t69\t80\t80\n68\t69\t81\t81\n68\t69\t82\t82\n68\t69\t83\t83\n68\t69\t84\t84\n68\t69\t85\t85\n68\t69\t86\t86\n68\t69\t87\t87\n68\t69\t88\t88\n68\t69\t89\t89\n68\t69\t90\t90\n68\t69\t91\t91\n68\t69\t92\t92\n68\t69\t93\t93\n68\t69\t94\t94\n68\t69\t95\t95\n68\t69\t96\t96\n68\t69\t97\t97\n68\t69\t98\t98\n68\t69\t99\t99\n68\t69\t100\t100\n68\t69\t101\t101\n68\t69\t102\t102\n68\t69\t103\t103\n68\t69\t104\t104\n68\t69\t105\t105\n68\t69\t106\t106\n68\t69\t107\t107\n68\t69\t108\t108\n68\t69\t109\t109\n68\t69\t110\t110\n68\t69\t111\t111\n68\t69\t112\t112\n68\t69\t113\t113\n68\t69\t114\t114\n68\t69\t115\t115\n68\t69\t116\t116\n68\t69\t117\t117\n68\t69\t118\t118\n68\t69\t119\t119\n68\t69\t120\t120\n68\t69\t121\t121\n68\t69\t122\t122\n68\t69\t123\t123\n68\t69\t124\t124\n68\t69\t125\t125\n68\t69\t126\t126\n68\t69\t127\t127\n68\t69\t128\t128\n68\t69\t129\t129\n68\t69\t130\t130\n68\t69\t131\t131\n68\t69\t132\t132\n68\t69\t133\t133\n68\t69\t134\t134\n68\t69\t135\t135\n68\t69\t136\t136\n68\t69\t137\t137\n68\t69\t138\t138\n68\t69\t139\t139\n68\t69\t140\t140\n68\t69\t141\t141\n68\t69\t142\t142\n68\t69\t143\t143\n68\t69\t144\t144\n68\t69\t145\t145\n68\t69\t146\t146\n68\t69\t147\t147\n68\t69\t148\t148\n68\t69\t149\t149\n68\t69\t150\t150\n68\t69\t151\t151\n68\t69\t152\t152\n68\t69\t153\t153\n68\t69\t154\t154\n68\t69\t155\t155\n68\t69\t156\t156\n68\t69\t157\t157\n68\t69\t158\t158\n68\t69\t159\t159\n68\t69\t160\t160\n68\t69\t161\t161\n68\t69\t162\t162\n68\t69\t163\t163\n68\t69\t164\t164\n68\t69\t165\t165\n68\t69\t166\t166\n68\t69\t167\t167\n68\t69\t168\t168\n68\t69\t169\t169\n68\t69\t170\t170\n68\t69\t171\t171\n68\t69\t172\t172\n68\t69\t173\t173\n68\t69\t174\t174\n68\t69\t175\t175\n68\t69\t176\t176\n68\t69\t177\t177\n68\t69\t178\t178\n68\t69\t179\t179\n68\t69\t180\t180\n68\t69\t181\t181\n68\t69\t182\t182\n68\t69\t183\t183\n68\t69\t184\t184\n68\t69\t185\t185\n68\t69\t186\t186\n68\t69\t187\t187\n68\t69\t188\t188\n68\t69\t189\t189\n68\t69\t190\t190\n68\t69\t191\t191\n68\t69\t192\t192\n68\t69\t193\t193\n68\t69\t194\t194\n68\t69\t195\t195\n68\t69\t196\t196\n68\t69\t197\t197\n68\t69\t198\t198\n68\t69\t199\t199\n68\t69\t200\t200\n68\t69\t201\t201\n68\t69\t202\t202\n68\t69\t203\t203\n68\t69\t204\t204\n68\t69\t205\t205\n68\t69\t206\t206\n68\t69\t207\t207\n68\t69\t208\t208\n68\t69\t209\t209\n68\t69\t210\t210\n68\t69\t211\t211\n68\t69\t212\t212\n68\t69\t213\t213\n68\t69\t214\t214\n68\t69\t215\t215\n68\t69\t216\t216\n68\t69\t217\t217\n68\t69\t218\t218\n68\t69\t219\t219\n68\t69\t220\t220\n68\t69\t221\t221\n68\t69\t222\t222\n68\t69\t223\t223\n68\t69\t224\t224\n68\t69\t225\t225\n68\t69\t226\t226\n68\t69\t227\t227\n68\t69\t228\t228\n68\t69\t229\t229\n68\t69\t230\t230\n68\t69\t231\t231\n68\t69\t232\t232\n68\t69\t233\t233\n68\t69\t234\t234\n68\t69\t235\t235\n68\t69\t236\t236\n68\t69\t237\t237\n68\t69\t238\t238\n68\t69\t239\t239\n68\t69\t240\t240\n68\t69\t241\t241\n68\t69\t242\t242\n68\t69\t243\t243\n68\t69\t244\t244\n68\t69\t245\t245\n68\t69\t246\t246\n68\t69\t247\t247\n68\t69\t248\t248\n68\t69\t249\t249\n68\t69\t250\t250\n68\t69\t251\t251\n68\t69\t252\t252\n68\t69\t253\t253\n68\t69\t254\t254\n68\t69\t255\t255\n69\t70\t0\t0\n69\t70\t1\t1\n69\t70\t2\t2\n69\t70\t3\t3\n69\t70\t4\t4\n69\t70\t5\t5\n69\t70\t6\t6\n69\t70\t7\t7\n69\t70\t8\t8\n69\t70\t9\t9\n69\t70\t10\t10\n69\t70\t11\t11\n69\t70\t12\t12\n69\t70\t13\t13\n69\t70\t14\t14\n69\t70\t15\t15\n69\t70\t16\t16\n69\t70\t17\t17\n69\t70\t18\t18\n69\t70\t19\t19\n69\t70\t20\t20\n69\t70\t21\t21\n69\t70\t22\t22\n69\t70\t23\t23\n69\t70\t24\t24\n69\t70\t25\t25\n69\t70\t26\t26\n69\t70\t27\t27\n69\t70\t28\t28\n69\t70\t29\t29\n69\t70\t30\t30\n69\t70\t31\t31\n69\t70\t32\t32\n69\t70\t33\t33\n69\t70\t34\t34\n69\t70\t35\t35\n69\t70\t36\t36\n69\t70\t37\t37\n69\t70\t38\t38\n69\t70\t39\t39\n69\t70\t40\t40\n69\t70\t41\t41\n69\t70\t42\t42\n69\t70\t43\t43\n69\t70\t44\t44\n69\t70\t45\t45\n69\t70\t46\t46\n69\t70\t47\t47\n69\t70\t48\t48\n69\t70\t49\t49\n69\t70\t50\t50\n69\t70\t51\t51\n69\t70\t52\t52\n69\t70\t53\t53\n69\t70\t54\t54\n69\t70\t55\t55\n69\t70\t56\t56\n69\t70\t57\t57\n69\t70\t58\t58\n69\t70\t59\t59\n69\t70\t60\t60\n69\t70\t61\t61\n69\t70\t62\t62\n69\t70\t63\t63\n69\t70\t64\t64\n69\t70\t65\t65\n69\t70\t66\t66\n69\t70\t67\t67\n69\t70\t68\t68\n69\t70\t69\t69\n69\t70\t70\t70\n69\t70\t71\t71\n69\t70\t72\t72\n69\t70\t73\t73\n69\t70\t74\t74\n69\t70\t75\t75\n69\t70\t76\t76\n69\t70\t77\t77\n69\t70\t78\t78\n69\t70\t79\t79\n69\t70\t80\t80\n69\t70\t81\t81\n69\t70\t82\t82\n69\t70\t83\t83\n69\t70\t84\t84\n69\t70\t85\t85\n69\t70\t86\t86\n69\t70\t87\t87\n69\t70\t88\t88\n69\t70\t89\t89\n69\t70\t90\t90\n69\t70\t91\t91\n69\t70\t92\t92\n69\t70\t93\t93\n69\t70\t94\t94\n69\t70\t95\t95\n69\t70\t96\t96\n69\t70\t97\t97\n69\t70\t98\t98\n69\t70\t99\t99\n69\t70\t100\t100\n69\t70\t101\t101\n69\t70\t102\t102\n69\t70\t103\t103\n69\t70\t104\t104\n69\t70\t105\t105\n69\t70\t106\t106\n69\t70\t107\t107\n69\t70\t108\t108\n69\t70\t109\t109\n69\t70\t110\t110\n69\t70\t111\t111\n69\t70\t112\t112\n69\t70\t113\t113\n69\t70\t114\t114\n69\t70\t115\t115\n69\t70\t116\t116\n69\t70\t117\t117\n69\t70\t118\t118\n69\t70\t119\t119\n69\t70\t120\t120\n69\t70\t121\t121\n69\t70\t122\t122\n69\t70\t123\t123\n69\t70\t124\t124\n69\t70\t125\t125\n69\t70\t126\t126\n69\t70\t127\t127\n69\t70\t128\t128\n69\t70\t129\t129\n69\t70\t130\t130\n69\t70\t131\t131\n69\t70\t132\t132\n69\t70\t133\t133\n69\t70\t134\t134\n69\t70\t135\t135\n69\t70\t136\t136\n69\t70\t137\t137\n69\t70\t138\t138\n69\t70\t139\t139\n69\t70\t140\t140\n69\t70\t141\t141\n69\t70\t142\t142\n69\t70\t143\t143\n69\t70\t144\t144\n69\t70\t145\t145\n69\t70\t146\t146\n69\t70\t147\t147\n69\t70\t148\t148\n69\t70\t149\t149\n69\t70\t150\t150\n69\t70\t151\t151\n69\t70\t152\t152\n69\t70\t153\t153\n69\t70\t154\t154\n69\t70\t155\t155\n69\t70\t156\t156\n69\t70\t157\t157\n69\t70\t158\t158\n69\t70\t159\t159\n69\t70\t160\t160\n69\t70\t161\t161\n69\t70\t162\t162\n69\t70\t163\t163\n69\t70\t164\t164\n69\t70\t165\t165\n69\t70\t166\t166\n69\t70\t167\t167\n69\t70\t168\t168\n69\t70\t169\t169\n69\t70\t170\t170\n69\t70\t171\t171\n69\t70\t172\t172\n69\t70\t173\t173\n69\t70\t174\t174\n69\t70\t175\t175\n69\t70\t176\t176\n69\t70\t177\t177\n69\t70\t178\t178\n69\t70\t179\t179\n69\t70\t180\t180\n69\t70\t181\t181\n69\t70\t182\t182\n69\t70\t183\t183\n69\t70\t184\t184\n69\t70\t185\t185\n69\t70\t186\t186\n69\t70\t187\t187\n69\t70\t188\t188\n69\t70\t189\t189\n69\t70\t190\t190\n69\t70\t191\t191\n69\t70\t192\t192\n69\t70\t193\t193\n69\t70\t194\t194\n69\t70\t195\t195\n69\t70\t196\t196\n69\t70\t197\t197\n69\t70\t198\t198\n69\t70\t199\t199\n69\t70\t200\t200\n69\t70\t201\t201\n69\t70\t202\t202\n69\t70\t203\t203\n69\t70\t204\t204\n69\t70\t205\t205\n69\t70\t206\t206\n69\t70\t207\t207\n69\t70\t208\t208\n69\t70\t209\t209\n69\t70\t210\t210\n69\t70\t211\t211\n69\t70\t212\t212\n69\t70\t213\t213\n69\t70\t214\t214\n69\t70\t215\t215\n69\t70\t216\t216\n69\t70\t217\t217\n69\t70\t218\t218\n69\t70\t219\t219\n69\t70\t220\t220\n69\t70\t221\t221\n69\t70\t222\t222\n69\t70\t223\t223\n69\t70\t224\t224\n69\t70\t225\t225\n69\t70\t226\t226\n69\t70\t227\t227\n69\t70\t228\t228\n69\t70\t229\t229\n69\t70\t230\t230\n69\t70\t231\t231\n69\t70\t232\t232\n69\t70\t233\t233\n69\t70\t234\t234\n69\t70\t235\t235\n69\t70\t236\t236\n69\t70\t237\t237\n69\t70\t238\t238\n69\t70\t239\t239\n69\t70\t240\t240\n69\t70\t241\t241\n69\t70\t242\t242\n69\t70\t243\t243\n69\t70\t244\t244\n69\t70\t245\t245\n69\t70\t246\t246\n69\t70\t247\t247\n69\t70\t248\t248\n69\t70\t249\t249\n69\t70\t250\t250\n69\t70\t251\t251\n69\t70\t252\t252\n69\t70\t253\t253\n69\t70\t254\t254\n69\t70\t255\t255\n70\t71\t0\t0\n70\t71\t1\t1\n70\t71\t2\t2\n70\t71\t3\t3\n70\t71\t4\t4\n70\t71\t5\t5\n70\t71\t6\t6\n70\t71\t7\t7\n70\t71\t8\t8\n70\t71\t9\t9\n70\t71\t10\t10\n70\t71\t11\t11\n70\t71\t12\t12\n70\t71\t13\t13\n70\t71\t14\t14\n70\t71\t15\t15\n70\t71\t16\t16\n70\t71\t17\t17\n70\t71\t18\t18\n70\t71\t19\t19\n70\t71\t20\t20\n70\t71\t21\t21\n70\t71\t22\t22\n70\t71\t23\t23\n70\t71\t24\t24\n70\t71\t25\t25\n70\t71\t26\t26\n70\t71\t27\t27\n70\t71\t28\t28\n70\t71\t29\t29\n70\t71\t30\t30\n70\t71\t31\t31\n70\t71\t32\t32\n70\t71\t33\t33\n70\t71\t34\t34\n70\t71\t35\t35\n70\t71\t36\t36\n70\t71\t37\t37\n70\t71\t38\t38\n70\t71\t39\t39\n70\t71\t40\t40\n70\t71\t41\t41\n70\t71\t42\t42\n70\t71\t43\t43\n70\t71\t44\t44\n70\t71\t45\t45\n70\t71\t46\t46\n70\t71\t47\t47\n70\t71\t48\t48\n70\t71\t49\t49\n70\t71\t50\t50\n70\t71\t51\t51\n70\t71\t52\t52\n70\t71\t53\t53\n70\t71\t54\t54\n70\t71\t55\t55\n70\t71\t56\t56\n70\t71\t57\t57\n70\t71\t58\t58\n70\t71\t59\t59\n70\t71\t60\t60\n70\t71\t61\t61\n70\t71\t62\t62\n70\t71\t63\t63\n70\t71\t64\t64\n70\t71\t65\t65\n70\t71\t66\t66\n70\t71\t67\t67\n70\t71\t68\t68\n70\t71\t69\t69\n70\t71\t70\t70\n70\t71\t71\t71\n70\t71\t72\t72\n70\t71\t73\t73\n70\t71\t74\t74\n70\t71\t75\t75\n70\t71\t76\t76\n70\t71\t77\t77\n70\t71\t78\t78\n70\t71\t79\t79\n70\t71\t80\t80\n70\t71\t81\t81\n70\t71\t82\t82\n70\t71\t83\t83\n70\t71\t84\t84\n70\t71\t85\t85\n70\t71\t86\t86\n70\t71\t87\t87\n70\t71\t88\t88\n70\t71\t89\t89\n70\t71\t90\t90\n70\t71\t91\t91\n70\t71\t92\t92\n70\t71\t93\t93\n70\t71\t94\t94\n70\t71\t95\t95\n70\t71\t96\t96\n70\t71\t97\t97\n70\t71\t98\t98\n70\t71\t99\t99\n70\t71\t100\t100\n70\t71\t101\t101\n70\t71\t102\t102\n70\t71\t103\t103\n70\t71\t104\t104\n70\t71\t105\t105\n70\t71\t106\t106\n70\t71\t107\t107\n70\t71\t108\t108\n70\t71\t109\t109\n70\t71\t110\t110\n70\t71\t111\t111\n70\t71\t112\t112\n70\t71\t113\t113\n70\t71\t114\t114\n70\t71\t115\t115\n70\t71\t116\t116\n70\t71\t117\t117\n70\t71\t118\t118\n70\t71\t119\t119\n70\t71\t120\t120\n70\t71\t121\t121\n70\t71\t122\t122\n70\t71\t123\t123\n70\t71\t124\t124\n70\t71\t125\t125\n70\t71\t126\t126\n70\t71\t127\t127\n70\t71\t128\t128\n70\t71\t129\t129\n70\t71\t130\t130\n70\t71\t131\t131\n70\t71\t132\t132\n70\t71\t133\t133\n70\t71\t134\t134\n70\t71\t135\t135\n70\t71\t136\t136\n70\t71\t137\t137\n70\t71\t138\t138\n70\t71\t139\t139\n70\t71\t140\t140\n70\t71\t141\t141\n70\t71\t142\t142\n70\t71\t143\t143\n70\t71\t144\t144\n70\t71\t145\t145\n70\t71\t146\t146\n70\t71\t147\t147\n70\t71\t148\t148\n70\t71\t149\t149\n70\t71\t150\t150\n70\t71\t151\t151\n70\t71\t152\t152\n70\t71\t153\t153\n70\t71\t154\t154\n70\t71\t155\t155\n70\t71\t156\t156\n70\t71\t157\t157\n70\t71\t158\t158\n70\t71\t159\t159\n70\t71\t160\t160\n70\t71\t161\t161\n70\t71\t162\t162\n70\t71\t163\t163\n70\t71\t164\t164\n70\t71\t165\t165\n70\t71\t166\t166\n70\t71\t167\t167\n70\t71\t168\t168\n70\t71\t169\t169\n70\t71\t170\t170\n70\t71\t171\t171\n70\t71\t172\t172\n70\t71\t173\t173\n70\t71\t174\t174\n70\t71\t175\t175\n70\t71\t176\t176\n70\t71\t177\t177\n70\t71\t178\t178\n70\t71\t179\t179\n70\t71\t180\t180\n70\t71\t181\t181\n70\t71\t182\t182\n70\t71\t183\t183\n70\t71\t184\t184\n70\t71\t185\t185\n70\t71\t186\t186\n70\t71\t187\t187\n70\t71\t188\t188\n70\t71\t189\t189\n70\t71\t190\t190\n70\t71\t191\t191\n70\t71\t192\t192\n70\t71\t193\t193\n70\t71\t194\t194\n70\t71\t195\t195\n70\t71\t196\t196\n70\t71\t197\t197\n70\t71\t198\t198\n70\t71\t199\t199\n70\t71\t200\t200\n70\t71\t201\t201\n70\t71\t202\t202\n70\t71\t203\t203\n70\t71\t204\t204\n70\t71\t205\t205\n70\t71\t206\t206\n70\t71\t207\t207\n70\t71\t208\t208\n70\t71\t209\t209\n70\t71\t210\t210\n70\t71\t211\t211\n70\t71\t212\t212\n70\t71\t213\t213\n70\t71\t214\t214\n70\t71\t215\t215\n70\t71\t216\t216\n70\t71\t217\t217\n70\t71\t218\t218\n70\t71\t219\t219\n70\t71\t220\t220\n70\t71\t221\t221\n70\t71\t222\t222\n70\t71\t223\t223\n70\t71\t224\t224\n70\t71\t225\t225\n70\t71\t226\t226\n70\t71\t227\t227\n70\t71\t228\t228\n70\t71\t229\t229\n70\t71\t230\t230\n70\t71\t231\t231\n70\t71\t232\t232\n70\t71\t233\t233\n70\t71\t234\t234\n70\t71\t235\t235\n70\t71\t236\t236\n70\t71\t237\t237\n70\t71\t238\t238\n70\t71\t239\t239\n70\t71\t240\t240\n70\t71\t241\t241\n70\t71\t242\t242\n70\t71\t243\t243\n70\t71\t244\t244\n70\t71\t245\t245\n70\t71\t246\t246\n70\t71\t247\t247\n70\t71\t248\t248\n70\t71\t249\t249\n70\t71\t250\t250\n70\t71\t251\t251\n70\t71\t252\t252\n70\t71\t253\t253\n70\t71\t254\t254\n70\t71\t255\t255\n71\t72\t0\t0\n71\t72\t1\t1\n71\t72\t2\t2\n71\t72\t3\t3\n71\t72\t4\t4\n71\t72\t5\t5\n71\t72\t6\t6\n71\t72\t7\t7\n71\t72\t8\t8\n71\t72\t9\t9\n71\t72\t10\t10\n71\t72\t11\t11\n71\t72\t12\t12\n71\t72\t13\t13\n71\t72\t14\t14\n71\t72\t15\t15\n71\t72\t16\t16\n71\t72\t17\t17\n71\t72\t18\t18\n71\t72\t19\t19\n71\t72\t20\t20\n71\t72\t21\t21\n71\t72\t22\t22\n71\t72\t23\t23\n71\t72\t24\t24\n71\t72\t25\t25\n71\t72\t26\t26\n71\t72\t27\t27\n71\t72\t28\t28\n71\t72\t29\t29\n71\t72\t30\t30\n71\t72\t31\t31\n71\t72\t32\t32\n71\t72\t33\t33\n71\t72\t34\t34\n71\t72\t35\t35\n71\t72\t36\t36\n71\t72\t37\t37\n71\t72\t38\t38\n71\t72\t39\t39\n71\t72\t40\t40\n71\t72\t41\t41\n71\t72\t42\t42\n71\t72\t43\t43\n71\t72\t44\t44\n71\t72\t45\t45\n71\t72\t46\t46\n71\t72\t47\t47\n71\t72\t48\t48\n71\t72\t49\t49\n71\t72\t50\t50\n71\t72\t51\t51\n71\t72\t52\t52\n71\t72\t53\t53\n71\t72\t54\t54\n71\t72\t55\t55\n71\t72\t56\t56\n71\t72\t57\t57\n71\t72\t58\t58\n71\t72\t59\t59\n71\t72\t60\t60\n71\t72\t61\t61\n71\t72\t62\t62\n71\t72\t63\t63\n71\t72\t64\t64\n71\t72\t65\t65\n71\t72\t66\t66\n71\t72\t67\t67\n71\t72\t68\t68\n71\t72\t69\t69\n71\t72\t70\t70\n71\t72\t71\t71\n71\t72\t72\t72\n71\t72\t73\t73\n71\t72\t74\t74\n71\t72\t75\t75\n71\t72\t76\t76\n71\t72\t77\t77\n71\t72\t78\t78\n71\t72\t79\t79\n71\t72\t80\t80\n71\t72\t81\t81\n71\t72\t82\t82\n71\t72\t83\t83\n71\t72\t84\t84\n71\t72\t85\t85\n71\t72\t86\t86\n71\t72\t87\t87\n71\t72\t88\t88\n71\t72\t89\t89\n71\t72\t90\t90\n71\t72\t91\t91\n71\t72\t92\t92\n71\t72\t93\t93\n71\t72\t94\t94\n71\t72\t95\t95\n71\t72\t96\t96\n71\t72\t97\t97\n71\t72\t98\t98\n71\t72\t99\t99\n71\t72\t100\t100\n71\t72\t101\t101\n71\t72\t102\t102\n71\t72\t103\t103\n71\t72\t104\t104\n71\t72\t105\t105\n71\t72\t106\t106\n71\t72\t107\t107\n71\t72\t108\t108\n71\t72\t109\t109\n71\t72\t110\t110\n71\t72\t111\t111\n71\t72\t112\t112\n71\t72\t113\t113\n71\t72\t114\t114\n71\t72\t115\t115\n71\t72\t116\t116\n71\t72\t117\t117\n71\t72\t118\t118\n71\t72\t119\t119\n71\t72\t120\t120\n71\t72\t121\t121\n71\t72\t122\t122\n71\t72\t123\t123\n71\t72\t124\t124\n71\t72\t125\t125\n71\t72\t126\t126\n71\t72\t127\t127\n71\t72\t128\t128\n71\t72\t129\t129\n71\t72\t130\t130\n71\t72\t131\t131\n71\t72\t132\t132\n71\t72\t133\t133\n71\t72\t134\t134\n71\t72\t135\t135\n71\t72\t136\t136\n71\t72\t137\t137\n71\t72\t138\t138\n71\t72\t139\t139\n71\t72\t140\t140\n71\t72\t141\t141\n71\t72\t142\t142\n71\t72\t143\t143\n71\t72\t144\t144\n71\t72\t145\t145\n71\t72\t146\t146\n71\t72\t147\t147\n71\t72\t148\t148\n71\t72\t149\t149\n71\t72\t150\t150\n71\t72\t151\t151\n71\t72\t152\t152\n71\t72\t153\t153\n71\t72\t154\t154\n71\t72\t155\t155\n71\t72\t156\t156\n71\t72\t157\t157\n71\t72\t158\t158\n71\t72\t159\t159\n71\t72\t160\t160\n71\t72\t161\t161\n71\t72\t162\t162\n71\t72\t163\t163\n71\t72\t164\t164\n71\t72\t165\t165\n71\t72\t166\t166\n71\t72\t167\t167\n71\t72\t168\t168\n71\t72\t169\t169\n71\t72\t170\t170\n71\t72\t171\t171\n71\t72\t172\t172\n71\t72\t173\t173\n71\t72\t174\t174\n71\t72\t175\t175\n71\t72\t176\t176\n71\t72\t177\t177\n71\t72\t178\t178\n71\t72\t179\t179\n71\t72\t180\t180\n71\t72\t181\t181\n71\t72\t182\t182\n71\t72\t183\t183\n71\t72\t184\t184\n71\t72\t185\t185\n71\t72\t186\t186\n71\t72\t187\t187\n71\t72\t188\t188\n71\t72\t189\t189\n71\t72\t190\t190\n71\t72\t191\t191\n71\t72\t192\t192\n71\t72\t193\t193\n71\t72\t194\t194\n71\t72\t195\t195\n71\t72\t196\t196\n71\t72\t197\t197\n71\t72\t198\t198\n71\t72\t199\t199\n71\t72\t200\t200\n71\t72\t201\t201\n71\t72\t202\t202\n71\t72\t203\t203\n71\t72\t204\t204\n71\t72\t205\t205\n71\t72\t206\t206\n71\t72\t207\t207\n71\t72\t208\t208\n71\t72\t209\t209\n71\t72\t210\t210\n71\t72\t211\t211\n71\t72\t212\t212\n71\t72\t213\t213\n71\t72\t214\t214\n71\t72\t215\t215\n71\t72\t216\t216\n71\t72\t217\t217\n71\t72\t218\t218\n71\t72\t219\t219\n71\t72\t220\t220\n71\t72\t221\t221\n71\t72\t222\t222\n71\t72\t223\t223\n71\t72\t224\t224\n71\t72\t225\t225\n71\t72\t226\t226\n71\t72\t227\t227\n71\t72\t228\t228\n71\t72\t229\t229\n71\t72\t230\t230\n71\t72\t231\t231\n71\t72\t232\t232\n71\t72\t233\t233\n71\t72\t234\t234\n71\t72\t235\t235\n71\t72\t236\t236\n71\t72\t237\t237\n71\t72\t238\t238\n71\t72\t239\t239\n71\t72\t240\t240\n71\t72\t241\t241\n71\t72\t242\t242\n71\t72\t243\t243\n71\t72\t244\t244\n71\t72\t245\t245\n71\t72\t246\t246\n71\t72\t247\t247\n71\t72\t248\t248\n71\t72\t249\t249\n71\t72\t250\t250\n71\t72\t251\t251\n71\t72\t252\t252\n71\t72\t253\t253\n71\t72\t254\t254\n71\t72\t255\t255\n72\t73\t0\t0\n72\t73\t1\t1\n72\t73\t2\t2\n72\t73\t3\t3\n72\t73\t4\t4\n72\t73\t5\t5\n72\t73\t6\t6\n72\t73\t7\t7\n72\t73\t8\t8\n72\t73\t9\t9\n72\t73\t10\t10\n72\t73\t11\t11\n72\t73\t12\t12\n72\t73\t13\t13\n72\t73\t14\t14\n72\t73\t15\t15\n72\t73\t16\t16\n72\t73\t17\t17\n72\t73\t18\t18\n72\t73\t19\t19\n72\t73\t20\t20\n72\t73\t21\t21\n72\t73\t22\t22\n72\t73\t23\t23\n72\t73\t24\t24\n72\t73\t25\t25\n72\t73\t26\t26\n72\t73\t27\t27\n72\t73\t28\t28\n72\t73\t29\t29\n72\t73\t30\t30\n72\t73\t31\t31\n72\t73\t32\t32\n72\t73\t33\t33\n72\t73\t34\t34\n72\t73\t35\t35\n72\t73\t36\t36\n72\t73\t37\t37\n72\t73\t38\t38\n72\t73\t39\t39\n72\t73\t40\t40\n72\t73\t41\t41\n72\t73\t42\t42\n72\t73\t43\t43\n72\t73\t44\t44\n72\t73\t45\t45\n72\t73\t46\t46\n72\t73\t47\t47\n72\t73\t48\t48\n72\t73\t49\t49\n72\t73\t50\t50\n72\t73\t51\t51\n72\t73\t52\t52\n72\t73\t53\t53\n72\t73\t54\t54\n72\t73\t55\t55\n72\t73\t56\t56\n72\t73\t57\t57\n72\t73\t58\t58\n72\t73\t59\t59\n72\t73\t60\t60\n72\t73\t61\t61\n72\t73\t62\t62\n72\t73\t63\t63\n72\t73\t64\t64\n72\t73\t65\t65\n72\t73\t66\t66\n72\t73\t67\t67\n72\t73\t68\t68\n72\t73\t69\t69\n72\t73\t70\t70\n72\t73\t71\t71\n72\t73\t72\t72\n72\t73\t73\t73\n72\t73\t74\t74\n72\t73\t75\t75\n72\t73\t76\t76\n72\t73\t77\t77\n72\t73\t78\t78\n72\t73\t79\t79\n72\t73\t80\t80\n72\t73\t81\t81\n72\t73\t82\t82\n72\t73\t83\t83\n72\t73\t84\t84\n72\t73\t85\t85\n72\t73\t86\t86\n72\t73\t87\t87\n72\t73\t88\t88\n72\t73\t89\t89\n72\t73\t90\t90\n72\t73\t91\t91\n72\t73\t92\t92\n72\t73\t93\t93\n72\t73\t94\t94\n72\t73\t95\t95\n72\t73\t96\t96\n72\t73\t97\t97\n72\t73\t98\t98\n72\t73\t99\t99\n72\t73\t100\t100\n72\t73\t101\t101\n72\t73\t102\t102\n72\t73\t103\t103\n72\t73\t104\t104\n72\t73\t105\t105\n72\t73\t106\t106\n72\t73\t107\t107\n72\t73\t108\t108\n72\t73\t109\t109\n72\t73\t110\t110\n72\t73\t111\t111\n72\t73\t112\t112\n72\t73\t113\t113\n72\t73\t114\t114\n72\t73\t115\t115\n72\t73\t116\t116\n72\t73\t117\t117\n72\t73\t118\t118\n72\t73\t119\t119\n72\t73\t120\t120\n72\t73\t121\t121\n72\t73\t122\t122\n72\t73\t123\t123\n72\t73\t124\t124\n72\t73\t125\t125\n72\t73\t126\t126\n72\t73\t127\t127\n72\t73\t128\t128\n72\t73\t129\t129\n72\t73\t130\t130\n72\t73\t131\t131\n72\t73\t132\t132\n72\t73\t133\t133\n72\t73\t134\t134\n72\t73\t135\t135\n72\t73\t136\t136\n72\t73\t137\t137\n72\t73\t138\t138\n72\t73\t139\t139\n72\t73\t140\t140\n72\t73\t141\t141\n72\t73\t142\t142\n72\t73\t143\t143\n72\t73\t144\t144\n72\t73\t145\t145\n72\t73\t146\t146\n72\t73\t147\t147\n72\t73\t148\t148\n72\t73\t149\t149\n72\t73\t150\t150\n72\t73\t151\t151\n72\t73\t152\t152\n72\t73\t153\t153\n72\t73\t154\t154\n72\t73\t155\t155\n72\t73\t156\t156\n72\t73\t157\t157\n72\t73\t158\t158\n72\t73\t159\t159\n72\t73\t160\t160\n72\t73\t161\t161\n72\t73\t162\t162\n72\t73\t163\t163\n72\t73\t164\t164\n72\t73\t165\t165\n72\t73\t166\t166\n72\t73\t167\t167\n72\t73\t168\t168\n72\t73\t169\t169\n72\t73\t170\t170\n72\t73\t171\t171\n72\t73\t172\t172\n72\t73\t173\t173\n72\t73\t174\t174\n72\t73\t175\t175\n72\t73\t176\t176\n72\t73\t177\t177\n72\t73\t178\t178\n72\t73\t179\t179\n72\t73\t180\t180\n72\t73\t181\t181\n72\t73\t182\t182\n72\t73\t183\t183\n72\t73\t184\t184\n72\t73\t185\t185\n72\t73\t186\t186\n72\t73\t187\t187\n72\t73\t188\t188\n72\t73\t189\t189\n72\t73\t190\t190\n72\t73\t191\t191\n72\t73\t192\t192\n72\t73\t193\t193\n72\t73\t194\t194\n72\t73\t195\t195\n72\t73\t196\t196\n72\t73\t197\t197\n72\t73\t198\t198\n72\t73\t199\t199\n72\t73\t200\t200\n72\t73\t201\t201\n72\t73\t202\t202\n72\t73\t203\t203\n72\t73\t204\t204\n72\t73\t205\t205\n72\t73\t206\t206\n72\t73\t207\t207\n72\t73\t208\t208\n72\t73\t209\t209\n72\t73\t210\t210\n72\t73\t211\t211\n72\t73\t212\t212\n72\t73\t213\t213\n72\t73\t214\t214\n72\t73\t215\t215\n72\t73\t216\t216\n72\t73\t217\t217\n72\t73\t218\t218\n72\t73\t219\t219\n72\t73\t220\t220\n72\t73\t221\t221\n72\t73\t222\t222\n72\t73\t223\t223\n72\t73\t224\t224\n72\t73\t225\t225\n72\t73\t226\t226\n72\t73\t227\t227\n72\t73\t228\t228\n72\t73\t229\t229\n72\t73\t230\t230\n72\t73\t231\t231\n72\t73\t232\t232\n72\t73\t233\t233\n72\t73\t234\t234\n72\t73\t235\t235\n72\t73\t236\t236\n72\t73\t237\t237\n72\t73\t238\t238\n72\t73\t239\t239\n72\t73\t240\t240\n72\t73\t241\t241\n72\t73\t242\t242\n72\t73\t243\t243\n72\t73\t244\t244\n72\t73\t245\t245\n72\t73\t246\t246\n72\t73\t247\t247\n72\t73\t248\t248\n72\t73\t249\t249\n72\t73\t250\t250\n72\t73\t251\t251\n72\t73\t252\t252\n72\t73\t253\t253\n72\t73\t254\t254\n72\t73\t255\t255\n73\t74\t0\t0\n73\t74\t1\t1\n73\t74\t2\t2\n73\t74\t3\t3\n73\t74\t4\t4\n73\t74\t5\t5\n73\t74\t6\t6\n73\t74\t7\t7\n73\t74\t8\t8\n73\t74\t9\t9\n73\t74\t10\t10\n73\t74\t11\t11\n73\t74\t12\t12\n73\t74\t13\t13\n73\t74\t14\t14\n73\t74\t15\t15\n73\t74\t16\t16\n73\t74\t17\t17\n73\t74\t18\t18\n73\t74\t19\t19\n73\t74\t20\t20\n73\t74\t21\t21\n73\t74\t22\t22\n73\t74\t23\t23\n73\t74\t24\t24\n73\t74\t25\t25\n73\t74\t26\t26\n73\t74\t27\t27\n73\t74\t28\t28\n73\t74\t29\t29\n73\t74\t30\t30\n73\t74\t31\t31\n73\t74\t32\t32\n73\t74\t33\t33\n73\t74\t34\t34\n73\t74\t35\t35\n73\t74\t36\t36\n73\t74\t37\t37\n73\t74\t38\t38\n73\t74\t39\t39\n73\t74\t40\t40\n73\t74\t41\t41\n73\t74\t42\t42\n73\t74\t43\t43\n73\t74\t44\t44\n73\t74\t45\t45\n73\t74\t46\t46\n73\t74\t47\t47\n73\t74\t48\t48\n73\t74\t49\t49\n73\t74\t50\t50\n73\t74\t51\t51\n73\t74\t52\t52\n73\t74\t53\t53\n73\t74\t54\t54\n73\t74\t55\t55\n73\t74\t56\t56\n73\t74\t57\t57\n73\t74\t58\t58\n73\t74\t59\t59\n73\t74\t60\t60\n73\t74\t61\t61\n73\t74\t62\t62\n73\t74\t63\t63\n73\t74\t64\t64\n73\t74\t65\t65\n73\t74\t66\t66\n73\t74\t67\t67\n73\t74\t68\t68\n73\t74\t69\t69\n73\t74\t70\t70\n73\t74\t71\t71\n73\t74\t72\t72\n73\t74\t73\t73\n73\t74\t74\t74\n73\t74\t75\t75\n73\t74\t76\t76\n73\t74\t77\t77\n73\t74\t78\t78\n73\t74\t79\t79\n73\t74\t80\t80\n73\t74\t81\t81\n73\t74\t82\t82\n73\t74\t83\t83\n73\t74\t84\t84\n73\t74\t85\t85\n73\t74\t86\t86\n73\t74\t87\t87\n73\t74\t88\t88\n73\t74\t89\t89\n73\t74\t90\t90\n73\t74\t91\t91\n73\t74\t92\t92\n73\t74\t93\t93\n73\t74\t94\t94\n73\t74\t95\t95\n73\t74\t96\t96\n73\t74\t97\t97\n73\t74\t98\t98\n73\t74\t99\t99\n73\t74\t100\t100\n73\t74\t101\t101\n73\t74\t102\t102\n73\t74\t103\t103\n73\t74\t104\t104\n73\t74\t105\t105\n73\t74\t106\t106\n73\t74\t107\t107\n73\t74\t108\t108\n73\t74\t109\t109\n73\t74\t110\t110\n73\t74\t111\t111\n73\t74\t112\t112\n73\t74\t113\t113\n73\t74\t114\t114\n73\t74\t115\t115\n73\t74\t116\t116\n73\t74\t117\t117\n73\t74\t118\t118\n73\t74\t119\t119\n73\t74\t120\t120\n73\t74\t121\t121\n73\t74\t122\t122\n73\t74\t123\t123\n73\t74\t124\t124\n73\t74\t125\t125\n73\t74\t126\t126\n73\t74\t127\t127\n73\t74\t128\t128\n73\t74\t129\t129\n73\t74\t130\t130\n73\t74\t131\t131\n73\t74\t132\t132\n73\t74\t133\t133\n73\t74\t134\t134\n73\t74\t135\t135\n73\t74\t136\t136\n73\t74\t137\t137\n73\t74\t138\t138\n73\t74\t139\t139\n73\t74\t140\t140\n73\t74\t141\t141\n73\t74\t142\t142\n73\t74\t143\t143\n73\t74\t144\t144\n73\t74\t145\t145\n73\t74\t146\t146\n73\t74\t147\t147\n73\t74\t148\t148\n73\t74\t149\t149\n73\t74\t150\t150\n73\t74\t151\t151\n73\t74\t152\t152\n73\t74\t153\t153\n73\t74\t154\t154\n73\t74\t155\t155\n73\t74\t156\t156\n73\t74\t157\t157\n73\t74\t158\t158\n73\t74\t159\t159\n73\t74\t160\t160\n73\t74\t161\t161\n73\t74\t162\t162\n73\t74\t163\t163\n73\t74\t164\t164\n73\t74\t165\t165\n73\t74\t166\t166\n73\t74\t167\t167\n73\t74\t168\t168\n73\t74\t169\t169\n73\t74\t170\t170\n73\t74\t171\t171\n73\t74\t172\t172\n73\t74\t173\t173\n73\t74\t174\t174\n73\t74\t175\t175\n73\t74\t176\t176\n73\t74\t177\t177\n73\t74\t178\t178\n73\t74\t179\t179\n73\t74\t180\t180\n73\t74\t181\t181\n73\t74\t182\t182\n73\t74\t183\t183\n73\t74\t184\t184\n73\t74\t185\t185\n73\t74\t186\t186\n73\t74\t187\t187\n73\t74\t188\t188\n73\t74\t189\t189\n73\t74\t190\t190\n73\t74\t191\t191\n73\t74\t192\t192\n73\t74\t193\t193\n73\t74\t194\t194\n73\t74\t195\t195\n73\t74\t196\t196\n73\t74\t197\t197\n73\t74\t198\t198\n73\t74\t199\t199\n73\t74\t200\t200\n73\t74\t201\t201\n73\t74\t202\t202\n73\t74\t203\t203\n73\t74\t204\t204\n73\t74\t205\t205\n73\t74\t206\t206\n73\t74\t207\t207\n73\t74\t208\t208\n73\t74\t209\t209\n73\t74\t210\t210\n73\t74\t211\t211\n73\t74\t212\t212\n73\t74\t213\t213\n73\t74\t214\t214\n73\t74\t215\t215\n73\t74\t216\t216\n73\t74\t217\t217\n73\t74\t218\t218\n73\t74\t219\t219\n73\t74\t220\t220\n73\t74\t221\t221\n73\t74\t222\t222\n73\t74\t223\t223\n73\t74\t224\t224\n73\t74\t225\t225\n73\t74\t226\t226\n73\t74\t227\t227\n73\t74\t228\t228\n73\t74\t229\t229\n73\t74\t230\t230\n73\t74\t231\t231\n73\t74\t232\t232\n73\t74\t233\t233\n73\t74\t234\t234\n73\t74\t235\t235\n73\t74\t236\t236\n73\t74\t237\t237\n73\t74\t238\t238\n73\t74\t239\t239\n73\t74\t240\t240\n73\t74\t241\t241\n73\t74\t242\t242\n73\t74\t243\t243\n73\t74\t244\t244\n73\t74\t245\t245\n73\t74\t246\t246\n73\t74\t247\t247\n73\t74\t248\t248\n73\t74\t249\t249\n73\t74\t250\t250\n73\t74\t251\t251\n73\t74\t252\t252\n73\t74\t253\t253\n73\t74\t254\t254\n73\t74\t255\t255\n74\t75\t0\t0\n74\t75\t1\t1\n74\t75\t2\t2\n74\t75\t3\t3\n74\t75\t4\t4\n74\t75\t5\t5\n74\t75\t6\t6\n74\t75\t7\t7\n74\t75\t8\t8\n74\t75\t9\t9\n74\t75\t10\t10\n74\t75\t11\t11\n74\t75\t12\t12\n74\t75\t13\t13\n74\t75\t14\t14\n74\t75\t15\t15\n74\t75\t16\t16\n74\t75\t17\t17\n74\t75\t18\t18\n74\t75\t19\t19\n74\t75\t20\t20\n74\t75\t21\t21\n74\t75\t22\t22\n74\t75\t23\t23\n74\t75\t24\t24\n74\t75\t25\t25\n74\t75\t26\t26\n74\t75\t27\t27\n74\t75\t28\t28\n74\t75\t29\t29\n74\t75\t30\t30\n74\t75\t31\t31\n74\t75\t32\t32\n74\t75\t33\t33\n74\t75\t34\t34\n74\t75\t35\t35\n74\t75\t36\t36\n74\t75\t37\t37\n74\t75\t38\t38\n74\t75\t39\t39\n74\t75\t40\t40\n74\t75\t41\t41\n74\t75\t42\t42\n74\t75\t43\t43\n74\t75\t44\t44\n74\t75\t45\t45\n74\t75\t46\t46\n74\t75\t47\t47\n74\t75\t48\t48\n74\t75\t49\t49\n74\t75\t50\t50\n74\t75\t51\t51\n74\t75\t52\t52\n74\t75\t53\t53\n74\t75\t54\t54\n74\t75\t55\t55\n74\t75\t56\t56\n74\t75\t57\t57\n74\t75\t58\t58\n74\t75\t59\t59\n74\t75\t60\t60\n74\t75\t61\t61\n74\t75\t62\t62\n74\t75\t63\t63\n74\t75\t64\t64\n74\t75\t65\t65\n74\t75\t66\t66\n74\t75\t67\t67\n74\t75\t68\t68\n74\t75\t69\t69\n74\t75\t70\t70\n74\t75\t71\t71\n74\t75\t72\t72\n74\t75\t73\t73\n74\t75\t74\t74\n74\t75\t75\t75\n74\t75\t76\t76\n74\t75\t77\t77\n74\t75\t78\t78\n74\t75\t79\t79\n74\t75\t80\t80\n74\t75\t81\t81\n74\t75\t82\t82\n74\t75\t83\t83\n74\t75\t84\t84\n74\t75\t85\t85\n74\t75\t86\t86\n74\t75\t87\t87\n74\t75\t88\t88\n74\t75\t89\t89\n74\t75\t90\t90\n74\t75\t91\t91\n74\t75\t92\t92\n74\t75\t93\t93\n74\t75\t94\t94\n74\t75\t95\t95\n74\t75\t96\t96\n74\t75\t97\t97\n74\t75\t98\t98\n74\t75\t99\t99\n74\t75\t100\t100\n74\t75\t101\t101\n74\t75\t102\t102\n74\t75\t103\t103\n74\t75\t104\t104\n74\t75\t105\t105\n74\t75\t106\t106\n74\t75\t107\t107\n74\t75\t108\t108\n74\t75\t109\t109\n74\t75\t110\t110\n74\t75\t111\t111\n74\t75\t112\t112\n74\t75\t113\t113\n74\t75\t114\t114\n74\t75\t115\t115\n74\t75\t116\t116\n74\t75\t117\t117\n74\t75\t118\t118\n74\t75\t119\t119\n74\t75\t120\t120\n74\t75\t121\t121\n74\t75\t122\t122\n74\t75\t123\t123\n74\t75\t124\t124\n74\t75\t125\t125\n74\t75\t126\t126\n74\t75\t127\t127\n74\t75\t128\t128\n74\t75\t129\t129\n74\t75\t130\t130\n74\t75\t131\t131\n74\t75\t132\t132\n74\t75\t133\t133\n74\t75\t134\t134\n74\t75\t135\t135\n74\t75\t136\t136\n74\t75\t137\t137\n74\t75\t138\t138\n74\t75\t139\t139\n74\t75\t140\t140\n74\t75\t141\t141\n74\t75\t142\t142\n74\t75\t143\t143\n74\t75\t144\t144\n74\t75\t145\t145\n74\t75\t146\t146\n74\t75\t147\t147\n74\t75\t148\t148\n74\t75\t149\t149\n74\t75\t150\t150\n74\t75\t151\t151\n74\t75\t152\t152\n74\t75\t153\t153\n74\t75\t154\t154\n74\t75\t155\t155\n74\t75\t156\t156\n74\t75\t157\t157\n74\t75\t158\t158\n74\t75\t159\t159\n74\t75\t160\t160\n74\t75\t161\t161\n74\t75\t162\t162\n74\t75\t163\t163\n74\t75\t164\t164\n74\t75\t165\t165\n74\t75\t166\t166\n74\t75\t167\t167\n74\t75\t168\t168\n74\t75\t169\t169\n74\t75\t170\t170\n74\t75\t171\t171\n74\t75\t172\t172\n74\t75\t173\t173\n74\t75\t174\t174\n74\t75\t175\t175\n74\t75\t176\t176\n74\t75\t177\t177\n74\t75\t178\t178\n74\t75\t179\t179\n74\t75\t180\t180\n74\t75\t181\t181\n74\t75\t182\t182\n74\t75\t183\t183\n74\t75\t184\t184\n74\t75\t185\t185\n74\t75\t186\t186\n74\t75\t187\t187\n74\t75\t188\t188\n74\t75\t189\t189\n74\t75\t190\t190\n74\t75\t191\t191\n74\t75\t192\t192\n74\t75\t193\t193\n74\t75\t194\t194\n74\t75\t195\t195\n74\t75\t196\t196\n74\t75\t197\t197\n74\t75\t198\t198\n74\t75\t199\t199\n74\t75\t200\t200\n74\t75\t201\t201\n74\t75\t202\t202\n74\t75\t203\t203\n74\t75\t204\t204\n74\t75\t205\t205\n74\t75\t206\t206\n74\t75\t207\t207\n74\t75\t208\t208\n74\t75\t209\t209\n74\t75\t210\t210\n74\t75\t211\t211\n74\t75\t212\t212\n74\t75\t213\t213\n74\t75\t214\t214\n74\t75\t215\t215\n74\t75\t216\t216\n74\t75\t217\t217\n74\t75\t218\t218\n74\t75\t219\t219\n74\t75\t220\t220\n74\t75\t221\t221\n74\t75\t222\t222\n74\t75\t223\t223\n74\t75\t224\t224\n74\t75\t225\t225\n74\t75\t226\t226\n74\t75\t227\t227\n74\t75\t228\t228\n74\t75\t229\t229\n74\t75\t230\t230\n74\t75\t231\t231\n74\t75\t232\t232\n74\t75\t233\t233\n74\t75\t234\t234\n74\t75\t235\t235\n74\t75\t236\t236\n74\t75\t237\t237\n74\t75\t238\t238\n74\t75\t239\t239\n74\t75\t240\t240\n74\t75\t241\t241\n74\t75\t242\t242\n74\t75\t243\t243\n74\t75\t244\t244\n74\t75\t245\t245\n74\t75\t246\t246\n74\t75\t247\t247\n74\t75\t248\t248\n74\t75\t249\t249\n74\t75\t250\t250\n74\t75\t251\t251\n74\t75\t252\t252\n74\t75\t253\t253\n74\t75\t254\t254\n74\t75\t255\t255\n75\t76\t0\t0\n75\t76\t1\t1\n75\t76\t2\t2\n75\t76\t3\t3\n75\t76\t4\t4\n75\t76\t5\t5\n75\t76\t6\t6\n75\t76\t7\t7\n75\t76\t8\t8\n75\t76\t9\t9\n75\t76\t10\t10\n75\t76\t11\t11\n75\t76\t12\t12\n75\t76\t13\t13\n75\t76\t14\t14\n75\t76\t15\t15\n75\t76\t16\t16\n75\t76\t17\t17\n75\t76\t18\t18\n75\t76\t19\t19\n75\t76\t20\t20\n75\t76\t21\t21\n75\t76\t22\t22\n75\t76\t23\t23\n75\t76\t24\t24\n75\t76\t25\t25\n75\t76\t26\t26\n75\t76\t27\t27\n75\t76\t28\t28\n75\t76\t29\t29\n75\t76\t30\t30\n75\t76\t31\t31\n75\t76\t32\t32\n75\t76\t33\t33\n75\t76\t34\t34\n75\t76\t35\t35\n75\t76\t36\t36\n75\t76\t37\t37\n75\t76\t38\t38\n75\t76\t39\t39\n75\t76\t40\t40\n75\t76\t41\t41\n75\t76\t42\t42\n75\t76\t43\t43\n75\t76\t44\t44\n75\t76\t45\t45\n75\t76\t46\t46\n75\t76\t47\t47\n75\t76\t48\t48\n75\t76\t49\t49\n75\t76\t50\t50\n75\t76\t51\t51\n75\t76\t52\t52\n75\t76\t53\t53\n75\t76\t54\t54\n75\t76\t55\t55\n75\t76\t56\t56\n75\t76\t57\t57\n75\t76\t58\t58\n75\t76\t59\t59\n75\t76\t60\t60\n75\t76\t61\t61\n75\t76\t62\t62\n75\t76\t63\t63\n75\t76\t64\t64\n75\t76\t65\t65\n75\t76\t66\t66\n75\t76\t67\t67\n75\t76\t68\t68\n75\t76\t69\t69\n75\t76\t70\t70\n75\t76\t71\t71\n75\t76\t72\t72\n75\t76\t73\t73\n75\t76\t74\t74\n75\t76\t75\t75\n75\t76\t76\t76\n75\t76\t77\t77\n75\t76\t78\t78\n75\t76\t79\t79\n75\t76\t80\t80\n75\t76\t81\t81\n75\t76\t82\t82\n75\t76\t83\t83\n75\t76\t84\t84\n75\t76\t85\t85\n75\t76\t86\t86\n75\t76\t87\t87\n75\t76\t88\t88\n75\t76\t89\t89\n75\t76\t90\t90\n75\t76\t91\t91\n75\t76\t92\t92\n75\t76\t93\t93\n75\t76\t94\t94\n75\t76\t95\t95\n75\t76\t96\t96\n75\t76\t97\t97\n75\t76\t98\t98\n75\t76\t99\t99\n75\t76\t100\t100\n75\t76\t101\t101\n75\t76\t102\t102\n75\t76\t103\t103\n75\t76\t104\t104\n75\t76\t105\t105\n75\t76\t106\t106\n75\t76\t107\t107\n75\t76\t108\t108\n75\t76\t109\t109\n75\t76\t110\t110\n75\t76\t111\t111\n75\t76\t112\t112\n75\t76\t113\t113\n75\t76\t114\t114\n75\t76\t115\t115\n75\t76\t116\t116\n75\t76\t117\t117\n75\t76\t118\t118\n75\t76\t119\t119\n75\t76\t120\t120\n75\t76\t121\t121\n75\t76\t122\t122\n75\t76\t123\t123\n75\t76\t124\t124\n75\t76\t125\t125\n75\t76\t126\t126\n75\t76\t127\t127\n75\t76\t128\t128\n75\t76\t129\t129\n75\t76\t130\t130\n75\t76\t131\t131\n75\t76\t132\t132\n75\t76\t133\t133\n75\t76\t134\t134\n75\t76\t135\t135\n75\t76\t136\t136\n75\t76\t137\t137\n75\t76\t138\t138\n75\t76\t139\t139\n75\t76\t140\t140\n75\t76\t141\t141\n75\t76\t142\t142\n75\t76\t143\t143\n75\t76\t144\t144\n75\t76\t145\t145\n75\t76\t146\t146\n75\t76\t147\t147\n75\t76\t148\t148\n75\t76\t149\t149\n75\t76\t150\t150\n75\t76\t151\t151\n75\t76\t152\t152\n75\t76\t153\t153\n75\t76\t154\t154\n75\t76\t155\t155\n75\t76\t156\t156\n75\t76\t157\t157\n75\t76\t158\t158\n75\t76\t159\t159\n75\t76\t160\t160\n75\t76\t161\t161\n75\t76\t162\t162\n75\t76\t163\t163\n75\t76\t164\t164\n75\t76\t165\t165\n75\t76\t166\t166\n75\t76\t167\t167\n75\t76\t168\t168\n75\t76\t169\t169\n75\t76\t170\t170\n75\t76\t171\t171\n75\t76\t172\t172\n75\t76\t173\t173\n75\t76\t174\t174\n75\t76\t175\t175\n75\t76\t176\t176\n75\t76\t177\t177\n75\t76\t178\t178\n75\t76\t179\t179\n75\t76\t180\t180\n75\t76\t181\t181\n75\t76\t182\t182\n75\t76\t183\t183\n75\t76\t184\t184\n75\t76\t185\t185\n75\t76\t186\t186\n75\t76\t187\t187\n75\t76\t188\t188\n75\t76\t189\t189\n75\t76\t190\t190\n75\t76\t191\t191\n75\t76\t192\t192\n75\t76\t193\t193\n75\t76\t194\t194\n75\t76\t195\t195\n75\t76\t196\t196\n75\t76\t197\t197\n75\t76\t198\t198\n75\t76\t199\t199\n75\t76\t200\t200\n75\t76\t201\t201\n75\t76\t202\t202\n75\t76\t203\t203\n75\t76\t204\t204\n75\t76\t205\t205\n75\t76\t206\t206\n75\t76\t207\t207\n75\t76\t208\t208\n75\t76\t209\t209\n75\t76\t210\t210\n75\t76\t211\t211\n75\t76\t212\t212\n75\t76\t213\t213\n75\t76\t214\t214\n75\t76\t215\t215\n75\t76\t216\t216\n75\t76\t217\t217\n75\t76\t218\t218\n75\t76\t219\t219\n75\t76\t220\t220\n75\t76\t221\t221\n75\t76\t222\t222\n75\t76\t223\t223\n75\t76\t224\t224\n75\t76\t225\t225\n75\t76\t226\t226\n75\t76\t227\t227\n75\t76\t228\t228\n75\t76\t229\t229\n75\t76\t230\t230\n75\t76\t231\t231\n75\t76\t232\t232\n75\t76\t233\t233\n75\t76\t234\t234\n75\t76\t235\t235\n75\t76\t236\t236\n75\t76\t237\t237\n75\t76\t238\t238\n75\t76\t239\t239\n75\t76\t240\t240\n75\t76\t241\t241\n75\t76\t242\t242\n75\t76\t243\t243\n75\t76\t244\t244\n75\t76\t245\t245\n75\t76\t246\t246\n75\t76\t247\t247\n75\t76\t248\t248\n75\t76\t249\t249\n75\t76\t250\t250\n75\t76\t251\t251\n75\t76\t252\t252\n75\t76\t253\t253\n75\t76\t254\t254\n75\t76\t255\t255\n76\t77\t0\t0\n76\t77\t1\t1\n76\t77\t2\t2\n76\t77\t3\t3\n76\t77\t4\t4\n76\t77\t5\t5\n76\t77\t6\t6\n76\t77\t7\t7\n76\t77\t8\t8\n76\t77\t9\t9\n76\t77\t10\t10\n76\t77\t11\t11\n76\t77\t12\t12\n76\t77\t13\t13\n76\t77\t14\t14\n76\t77\t15\t15\n76\t77\t16\t16\n76\t77\t17\t17\n76\t77\t18\t18\n76\t77\t19\t19\n76\t77\t20\t20\n76\t77\t21\t21\n76\t77\t22\t22\n76\t77\t23\t23\n76\t77\t24\t24\n76\t77\t25\t25\n76\t77\t26\t26\n76\t77\t27\t27\n76\t77\t28\t28\n76\t77\t29\t29\n76\t77\t30\t30\n76\t77\t31\t31\n76\t77\t32\t32\n76\t77\t33\t33\n76\t77\t34\t34\n76\t77\t35\t35\n76\t77\t36\t36\n76\t77\t37\t37\n76\t77\t38\t38\n76\t77\t39\t39\n76\t77\t40\t40\n76\t77\t41\t41\n76\t77\t42\t42\n76\t77\t43\t43\n76\t77\t44\t44\n76\t77\t45\t45\n76\t77\t46\t46\n76\t77\t47\t47\n76\t77\t48\t48\n76\t77\t49\t49\n76\t77\t50\t50\n76\t77\t51\t51\n76\t77\t52\t52\n76\t77\t53\t53\n76\t77\t54\t54\n76\t77\t55\t55\n76\t77\t56\t56\n76\t77\t57\t57\n76\t77\t58\t58\n76\t77\t59\t59\n76\t77\t60\t60\n76\t77\t61\t61\n76\t77\t62\t62\n76\t77\t63\t63\n76\t77\t64\t64\n76\t77\t65\t65\n76\t77\t66\t66\n76\t77\t67\t67\n76\t77\t68\t68\n76\t77\t69\t69\n76\t77\t70\t70\n76\t77\t71\t71\n76\t77\t72\t72\n76\t77\t73\t73\n76\t77\t74\t74\n76\t77\t75\t75\n76\t77\t76\t76\n76\t77\t77\t77\n76\t77\t78\t78\n76\t77\t79\t79\n76\t77\t80\t80\n76\t77\t81\t81\n76\t77\t82\t82\n76\t77\t83\t83\n76\t77\t84\t84\n76\t77\t85\t85\n76\t77\t86\t86\n76\t77\t87\t87\n76\t77\t88\t88\n76\t77\t89\t89\n76\t77\t90\t90\n76\t77\t91\t91\n76\t77\t92\t92\n76\t77\t93\t93\n76\t77\t94\t94\n76\t77\t95\t95\n76\t77\t96\t96\n76\t77\t97\t97\n76\t77\t98\t98\n76\t77\t99\t99\n76\t77\t100\t100\n76\t77\t101\t101\n76\t77\t102\t102\n76\t77\t103\t103\n76\t77\t104\t104\n76\t77\t105\t105\n76\t77\t106\t106\n76\t77\t107\t107\n76\t77\t108\t108\n76\t77\t109\t109\n76\t77\t110\t110\n76\t77\t111\t111\n76\t77\t112\t112\n76\t77\t113\t113\n76\t77\t114\t114\n76\t77\t115\t115\n76\t77\t116\t116\n76\t77\t117\t117\n76\t77\t118\t118\n76\t77\t119\t119\n76\t77\t120\t120\n76\t77\t121\t121\n76\t77\t122\t122\n76\t77\t123\t123\n76\t77\t124\t124\n76\t77\t125\t125\n76\t77\t126\t126\n76\t77\t127\t127\n76\t77\t128\t128\n76\t77\t129\t129\n76\t77\t130\t130\n76\t77\t131\t131\n76\t77\t132\t132\n76\t77\t133\t133\n76\t77\t134\t134\n76\t77\t135\t135\n76\t77\t136\t136\n76\t77\t137\t137\n76\t77\t138\t138\n76\t77\t139\t139\n76\t77\t140\t140\n76\t77\t141\t141\n76\t77\t142\t142\n76\t77\t143\t143\n76\t77\t144\t144\n76\t77\t145\t145\n76\t77\t146\t146\n76\t77\t147\t147\n76\t77\t148\t148\n76\t77\t149\t149\n76\t77\t150\t150\n76\t77\t151\t151\n76\t77\t152\t152\n76\t77\t153\t153\n76\t77\t154\t154\n76\t77\t155\t155\n76\t77\t156\t156\n76\t77\t157\t157\n76\t77\t158\t158\n76\t77\t159\t159\n76\t77\t160\t160\n76\t77\t161\t161\n76\t77\t162\t162\n76\t77\t163\t163\n76\t77\t164\t164\n76\t77\t165\t165\n76\t77\t166\t166\n76\t77\t167\t167\n76\t77\t168\t168\n76\t77\t169\t169\n76\t77\t170\t170\n76\t77\t171\t171\n76\t77\t172\t172\n76\t77\t173\t173\n76\t77\t174\t174\n76\t77\t175\t175\n76\t77\t176\t176\n76\t77\t177\t177\n76\t77\t178\t178\n76\t77\t179\t179\n76\t77\t180\t180\n76\t77\t181\t181\n76\t77\t182\t182\n76\t77\t183\t183\n76\t77\t184\t184\n76\t77\t185\t185\n76\t77\t186\t186\n76\t77\t187\t187\n76\t77\t188\t188\n76\t77\t189\t189\n76\t77\t190\t190\n76\t77\t191\t191\n76\t77\t192\t192\n76\t77\t193\t193\n76\t77\t194\t194\n76\t77\t195\t195\n76\t77\t196\t196\n76\t77\t197\t197\n76\t77\t198\t198\n76\t77\t199\t199\n76\t77\t200\t200\n76\t77\t201\t201\n76\t77\t202\t202\n76\t77\t203\t203\n76\t77\t204\t204\n76\t77\t205\t205\n76\t77\t206\t206\n76\t77\t207\t207\n76\t77\t208\t208\n76\t77\t209\t209\n76\t77\t210\t210\n76\t77\t211\t211\n76\t77\t212\t212\n76\t77\t213\t213\n76\t77\t214\t214\n76\t77\t215\t215\n76\t77\t216\t216\n76\t77\t217\t217\n76\t77\t218\t218\n76\t77\t219\t219\n76\t77\t220\t220\n76\t77\t221\t221\n76\t77\t222\t222\n76\t77\t223\t223\n76\t77\t224\t224\n76\t77\t225\t225\n76\t77\t226\t226\n76\t77\t227\t227\n76\t77\t228\t228\n76\t77\t229\t229\n76\t77\t230\t230\n76\t77\t231\t231\n76\t77\t232\t232\n76\t77\t233\t233\n76\t77\t234\t234\n76\t77\t235\t235\n76\t77\t236\t236\n76\t77\t237\t237\n76\t77\t238\t238\n76\t77\t239\t239\n76\t77\t240\t240\n76\t77\t241\t241\n76\t77\t242\t242\n76\t77\t243\t243\n76\t77\t244\t244\n76\t77\t245\t245\n76\t77\t246\t246\n76\t77\t247\t247\n76\t77\t248\t248\n76\t77\t249\t249\n76\t77\t250\t250\n76\t77\t251\t251\n76\t77\t252\t252\n76\t77\t253\t253\n76\t77\t254\t254\n76\t77\t255\t255\n77\t78\t0\t0\n77\t78\t1\t1\n77\t78\t2\t2\n77\t78\t3\t3\n77\t78\t4\t4\n77\t78\t5\t5\n77\t78\t6\t6\n77\t78\t7\t7\n77\t78\t8\t8\n77\t78\t9\t9\n77\t78\t10\t10\n77\t78\t11\t11\n77\t78\t12\t12\n77\t78\t13\t13\n77\t78\t14\t14\n77\t78\t15\t15\n77\t78\t16\t16\n77\t78\t17\t17\n77\t78\t18\t18\n77\t78\t19\t19\n77\t78\t20\t20\n77\t78\t21\t21\n77\t78\t22\t22\n77\t78\t23\t23\n77\t78\t24\t24\n77\t78\t25\t25\n77\t78\t26\t26\n77\t78\t27\t27\n77\t78\t28\t28\n77\t78\t29\t29\n77\t78\t30\t30\n77\t78\t31\t31\n77\t78\t32\t32\n77\t78\t33\t33\n77\t78\t34\t34\n77\t78\t35\t35\n77\t78\t36\t36\n77\t78\t37\t37\n77\t78\t38\t38\n77\t78\t39\t39\n77\t78\t40\t40\n77\t78\t41\t41\n77\t78\t42\t42\n77\t78\t43\t43\n77\t78\t44\t44\n77\t78\t45\t45\n77\t78\t46\t46\n77\t78\t47\t47\n77\t78\t48\t48\n77\t78\t49\t49\n77\t78\t50\t50\n77\t78\t51\t51\n77\t78\t52\t52\n77\t78\t53\t53\n77\t78\t54\t54\n77\t78\t55\t55\n77\t78\t56\t56\n77\t78\t57\t57\n77\t78\t58\t58\n77\t78\t59\t59\n77\t78\t60\t60\n77\t78\t61\t61\n77\t78\t62\t62\n77\t78\t63\t63\n77\t78\t64\t64\n77\t78\t65\t65\n77\t78\t66\t66\n77\t78\t67\t67\n77\t78\t68\t68\n77\t78\t69\t69\n77\t78\t70\t70\n77\t78\t71\t71\n77\t78\t72\t72\n77\t78\t73\t73\n77\t78\t74\t74\n77\t78\t75\t75\n77\t78\t76\t76\n77\t78\t77\t77\n77\t78\t78\t78\n77\t78\t79\t79\n77\t78\t80\t80\n77\t78\t81\t81\n77\t78\t82\t82\n77\t78\t83\t83\n77\t78\t84\t84\n77\t78\t85\t85\n77\t78\t86\t86\n77\t78\t87\t87\n77\t78\t88\t88\n77\t78\t89\t89\n77\t78\t90\t90\n77\t78\t91\t91\n77\t78\t92\t92\n77\t78\t93\t93\n77\t78\t94\t94\n77\t78\t95\t95\n77\t78\t96\t96\n77\t78\t97\t97\n77\t78\t98\t98\n77\t78\t99\t99\n77\t78\t100\t100\n77\t78\t101\t101\n77\t78\t102\t102\n77\t78\t103\t103\n77\t78\t104\t104\n77\t78\t105\t105\n77\t78\t106\t106\n77\t78\t107\t107\n77\t78\t108\t108\n77\t78\t109\t109\n77\t78\t110\t110\n77\t78\t111\t111\n77\t78\t112\t112\n77\t78\t113\t113\n77\t78\t114\t114\n77\t78\t115\t115\n77\t78\t116\t116\n77\t78\t117\t117\n77\t78\t118\t118\n77\t78\t119\t119\n77\t78\t120\t120\n77\t78\t121\t121\n77\t78\t122\t122\n77\t78\t123\t123\n77\t78\t124\t124\n77\t78\t125\t125\n77\t78\t126\t126\n77\t78\t127\t127\n77\t78\t128\t128\n77\t78\t129\t129\n77\t78\t130\t130\n77\t78\t131\t131\n77\t78\t132\t132\n77\t78\t133\t133\n77\t78\t134\t134\n77\t78\t135\t135\n77\t78\t136\t136\n77\t78\t137\t137\n77\t78\t138\t138\n77\t78\t139\t139\n77\t78\t140\t140\n77\t78\t141\t141\n77\t78\t142\t142\n77\t78\t143\t143\n77\t78\t144\t144\n77\t78\t145\t145\n77\t78\t146\t146\n77\t78\t147\t147\n77\t78\t148\t148\n77\t78\t149\t149\n77\t78\t150\t150\n77\t78\t151\t151\n77\t78\t152\t152\n77\t78\t153\t153\n77\t78\t154\t154\n77\t78\t155\t155\n77\t78\t156\t156\n77\t78\t157\t157\n77\t78\t158\t158\n77\t78\t159\t159\n77\t78\t160\t160\n77\t78\t161\t161\n77\t78\t162\t162\n77\t78\t163\t163\n77\t78\t164\t164\n77\t78\t165\t165\n77\t78\t166\t166\n77\t78\t167\t167\n77\t78\t168\t168\n77\t78\t169\t169\n77\t78\t170\t170\n77\t78\t171\t171\n77\t78\t172\t172\n77\t78\t173\t173\n77\t78\t174\t174\n77\t78\t175\t175\n77\t78\t176\t176\n77\t78\t177\t177\n77\t78\t178\t178\n77\t78\t179\t179\n77\t78\t180\t180\n77\t78\t181\t181\n77\t78\t182\t182\n77\t78\t183\t183\n77\t78\t184\t184\n77\t78\t185\t185\n77\t78\t186\t186\n77\t78\t187\t187\n77\t78\t188\t188\n77\t78\t189\t189\n77\t78\t190\t190\n77\t78\t191\t191\n77\t78\t192\t192\n77\t78\t193\t193\n77\t78\t194\t194\n77\t78\t195\t195\n77\t78\t196\t196\n77\t78\t197\t197\n77\t78\t198\t198\n77\t78\t199\t199\n77\t78\t200\t200\n77\t78\t201\t201\n77\t78\t202\t202\n77\t78\t203\t203\n77\t78\t204\t204\n77\t78\t205\t205\n77\t78\t206\t206\n77\t78\t207\t207\n77\t78\t208\t208\n77\t78\t209\t209\n77\t78\t210\t210\n77\t78\t211\t211\n77\t78\t212\t212\n77\t78\t213\t213\n77\t78\t214\t214\n77\t78\t215\t215\n77\t78\t216\t216\n77\t78\t217\t217\n77\t78\t218\t218\n77\t78\t219\t219\n77\t78\t220\t220\n77\t78\t221\t221\n77\t78\t222\t222\n77\t78\t223\t223\n77\t78\t224\t224\n77\t78\t225\t225\n77\t78\t226\t226\n77\t78\t227\t227\n77\t78\t228\t228\n77\t78\t229\t229\n77\t78\t230\t230\n77\t78\t231\t231\n77\t78\t232\t232\n77\t78\t233\t233\n77\t78\t234\t234\n77\t78\t235\t235\n77\t78\t236\t236\n77\t78\t237\t237\n77\t78\t238\t238\n77\t78\t239\t239\n77\t78\t240\t240\n77\t78\t241\t241\n77\t78\t242\t242\n77\t78\t243\t243\n77\t78\t244\t244\n77\t78\t245\t245\n77\t78\t246\t246\n77\t78\t247\t247\n77\t78\t248\t248\n77\t78\t249\t249\n77\t78\t250\t250\n77\t78\t251\t251\n77\t78\t252\t252\n77\t78\t253\t253\n77\t78\t254\t254\n77\t78\t255\t255\n78\t79\t0\t0\n78\t79\t1\t1\n78\t79\t2\t2\n78\t79\t3\t3\n78\t79\t4\t4\n78\t79\t5\t5\n78\t79\t6\t6\n78\t79\t7\t7\n78\t79\t8\t8\n78\t79\t9\t9\n78\t79\t10\t10\n78\t79\t11\t11\n78\t79\t12\t12\n78\t79\t13\t13\n78\t79\t14\t14\n78\t79\t15\t15\n78\t79\t16\t16\n78\t79\t17\t17\n78\t79\t18\t18\n78\t79\t19\t19\n78\t79\t20\t20\n78\t79\t21\t21\n78\t79\t22\t22\n78\t79\t23\t23\n78\t79\t24\t24\n78\t79\t25\t25\n78\t79\t26\t26\n78\t79\t27\t27\n78\t79\t28\t28\n78\t79\t29\t29\n78\t79\t30\t30\n78\t79\t31\t31\n78\t79\t32\t32\n78\t79\t33\t33\n78\t79\t34\t34\n78\t79\t35\t35\n78\t79\t36\t36\n78\t79\t37\t37\n78\t79\t38\t38\n78\t79\t39\t39\n78\t79\t40\t40\n78\t79\t41\t41\n78\t79\t42\t42\n78\t79\t43\t43\n78\t79\t44\t44\n78\t79\t45\t45\n78\t79\t46\t46\n78\t79\t47\t47\n78\t79\t48\t48\n78\t79\t49\t49\n78\t79\t50\t50\n78\t79\t51\t51\n78\t79\t52\t52\n78\t79\t53\t53\n78\t79\t54\t54\n78\t79\t55\t55\n78\t79\t56\t56\n78\t79\t57\t57\n78\t79\t58\t58\n78\t79\t59\t59\n78\t79\t60\t60\n78\t79\t61\t61\n78\t79\t62\t62\n78\t79\t63\t63\n78\t79\t64\t64\n78\t79\t65\t65\n78\t79\t66\t66\n78\t79\t67\t67\n78\t79\t68\t68\n78\t79\t69\t69\n78\t79\t70\t70\n78\t79\t71\t71\n78\t79\t72\t72\n78\t79\t73\t73\n78\t79\t74\t74\n78\t79\t75\t75\n78\t79\t76\t76\n78\t79\t77\t77\n78\t79\t78\t78\n78\t79\t79\t79\n78\t79\t80\t80\n78\t79\t81\t81\n78\t79\t82\t82\n78\t79\t83\t83\n78\t79\t84\t84\n78\t79\t85\t85\n78\t79\t86\t86\n78\t79\t87\t87\n78\t79\t88\t88\n78\t79\t89\t89\n78\t79\t90\t90\n78\t79\t91\t91\n78\t79\t92\t92\n78\t79\t93\t93\n78\t79\t94\t94\n78\t79\t95\t95\n78\t79\t96\t96\n78\t79\t97\t97\n78\t79\t98\t98\n78\t79\t99\t99\n78\t79\t100\t100\n78\t79\t101\t101\n78\t79\t102\t102\n78\t79\t103\t103\n78\t79\t104\t104\n78\t79\t105\t105\n78\t79\t106\t106\n78\t79\t107\t107\n78\t79\t108\t108\n78\t79\t109\t109\n78\t79\t110\t110\n78\t79\t111\t111\n78\t79\t112\t112\n78\t79\t113\t113\n78\t79\t114\t114\n78\t79\t115\t115\n78\t79\t116\t116\n78\t79\t117\t117\n78\t79\t118\t118\n78\t79\t119\t119\n78\t79\t120\t120\n78\t79\t121\t121\n78\t79\t122\t122\n78\t79\t123\t123\n78\t79\t124\t124\n78\t79\t125\t125\n78\t79\t126\t126\n78\t79\t127\t127\n78\t79\t128\t128\n78\t79\t129\t129\n78\t79\t130\t130\n78\t79\t131\t131\n78\t79\t132\t132\n78\t79\t133\t133\n78\t79\t134\t134\n78\t79\t135\t135\n78\t79\t136\t136\n78\t79\t137\t137\n78\t79\t138\t138\n78\t79\t139\t139\n78\t79\t140\t140\n78\t79\t141\t141\n78\t79\t142\t142\n78\t79\t143\t143\n78\t79\t144\t144\n78\t79\t145\t145\n78\t79\t146\t146\n78\t79\t147\t147\n78\t79\t148\t148\n78\t79\t149\t149\n78\t79\t150\t150\n78\t79\t151\t151\n78\t79\t152\t152\n78\t79\t153\t153\n78\t79\t154\t154\n78\t79\t155\t155\n78\t79\t156\t156\n78\t79\t157\t157\n78\t79\t158\t158\n78\t79\t159\t159\n78\t79\t160\t160\n78\t79\t161\t161\n78\t79\t162\t162\n78\t79\t163\t163\n78\t79\t164\t164\n78\t79\t165\t165\n78\t79\t166\t166\n78\t79\t167\t167\n78\t79\t168\t168\n78\t79\t169\t169\n78\t79\t170\t170\n78\t79\t171\t171\n78\t79\t172\t172\n78\t79\t173\t173\n78\t79\t174\t174\n78\t79\t175\t175\n78\t79\t176\t176\n78\t79\t177\t177\n78\t79\t178\t178\n78\t79\t179\t179\n78\t79\t180\t180\n78\t79\t181\t181\n78\t79\t182\t182\n78\t79\t183\t183\n78\t79\t184\t184\n78\t79\t185\t185\n78\t79\t186\t186\n78\t79\t187\t187\n78\t79\t188\t188\n78\t79\t189\t189\n78\t79\t190\t190\n78\t79\t191\t191\n78\t79\t192\t192\n78\t79\t193\t193\n78\t79\t194\t194\n78\t79\t195\t195\n78\t79\t196\t196\n78\t79\t197\t197\n78\t79\t198\t198\n78\t79\t199\t199\n78\t79\t200\t200\n78\t79\t201\t201\n78\t79\t202\t202\n78\t79\t203\t203\n78\t79\t204\t204\n78\t79\t205\t205\n78\t79\t206\t206\n78\t79\t207\t207\n78\t79\t208\t208\n78\t79\t209\t209\n78\t79\t210\t210\n78\t79\t211\t211\n78\t79\t212\t212\n78\t79\t213\t213\n78\t79\t214\t214\n78\t79\t215\t215\n78\t79\t216\t216\n78\t79\t217\t217\n78\t79\t218\t218\n78\t79\t219\t219\n78\t79\t220\t220\n78\t79\t221\t221\n78\t79\t222\t222\n78\t79\t223\t223\n78\t79\t224\t224\n78\t79\t225\t225\n78\t79\t226\t226\n78\t79\t227\t227\n78\t79\t228\t228\n78\t79\t229\t229\n78\t79\t230\t230\n78\t79\t231\t231\n78\t79\t232\t232\n78\t79\t233\t233\n78\t79\t234\t234\n78\t79\t235\t235\n78\t79\t236\t236\n78\t79\t237\t237\n78\t79\t238\t238\n78\t79\t239\t239\n78\t79\t240\t240\n78\t79\t241\t241\n78\t79\t242\t242\n78\t79\t243\t243\n78\t79\t244\t244\n78\t79\t245\t245\n78\t79\t246\t246\n78\t79\t247\t247\n78\t79\t248\t248\n78\t79\t249\t249\n78\t79\t250\t250\n78\t79\t251\t251\n78\t79\t252\t252\n78\t79\t253\t253\n78\t79\t254\t254\n78\t79\t255\t255\n79\t80\t0\t0\n79\t80\t1\t1\n79\t80\t2\t2\n79\t80\t3\t3\n79\t80\t4\t4\n79\t80\t5\t5\n79\t80\t6\t6\n79\t80\t7\t7\n79\t80\t8\t8\n79\t80\t9\t9\n79\t80\t10\t10\n79\t80\t11\t11\n79\t80\t12\t12\n79\t80\t13\t13\n79\t80\t14\t14\n79\t80\t15\t15\n79\t80\t16\t16\n79\t80\t17\t17\n79\t80\t18\t18\n79\t80\t19\t19\n79\t80\t20\t20\n79\t80\t21\t21\n79\t80\t22\t22\n79\t80\t23\t23\n79\t80\t24\t24\n79\t80\t25\t25\n79\t80\t26\t26\n79\t80\t27\t27\n79\t80\t28\t28\n79\t80\t29\t29\n79\t80\t30\t30\n79\t80\t31\t31\n79\t80\t32\t32\n79\t80\t33\t33\n79\t80\t34\t34\n79\t80\t35\t35\n79\t80\t36\t36\n79\t80\t37\t37\n79\t80\t38\t38\n79\t80\t39\t39\n79\t80\t40\t40\n79\t80\t41\t41\n79\t80\t42\t42\n79\t80\t43\t43\n79\t80\t44\t44\n79\t80\t45\t45\n79\t80\t46\t46\n79\t80\t47\t47\n79\t80\t48\t48\n79\t80\t49\t49\n79\t80\t50\t50\n79\t80\t51\t51\n79\t80\t52\t52\n79\t80\t53\t53\n79\t80\t54\t54\n79\t80\t55\t55\n79\t80\t56\t56\n79\t80\t57\t57\n79\t80\t58\t58\n79\t80\t59\t59\n79\t80\t60\t60\n79\t80\t61\t61\n79\t80\t62\t62\n79\t80\t63\t63\n79\t80\t64\t64\n79\t80\t65\t65\n79\t80\t66\t66\n79\t80\t67\t67\n79\t80\t68\t68\n79\t80\t69\t69\n79\t80\t70\t70\n79\t80\t71\t71\n79\t80\t72\t72\n79\t80\t73\t73\n79\t80\t74\t74\n79\t80\t75\t75\n79\t80\t76\t76\n79\t80\t77\t77\n79\t80\t78\t78\n79\t80\t79\t79\n79\t80\t80\t80\n79\t80\t81\t81\n79\t80\t82\t82\n79\t80\t83\t83\n79\t80\t84\t84\n79\t80\t85\t85\n79\t80\t86\t86\n79\t80\t87\t87\n79\t80\t88\t88\n79\t80\t89\t89\n79\t80\t90\t90\n79\t80\t91\t91\n79\t80\t92\t92\n79\t80\t93\t93\n79\t80\t94\t94\n79\t80\t95\t95\n79\t80\t96\t96\n79\t80\t97\t97\n79\t80\t98\t98\n79\t80\t99\t99\n79\t80\t100\t100\n79\t80\t101\t101\n79\t80\t102\t102\n79\t80\t103\t103\n79\t80\t104\t104\n79\t80\t105\t105\n79\t80\t106\t106\n79\t80\t107\t107\n79\t80\t108\t108\n79\t80\t109\t109\n79\t80\t110\t110\n79\t80\t111\t111\n79\t80\t112\t112\n79\t80\t113\t113\n79\t80\t114\t114\n79\t80\t115\t115\n79\t80\t116\t116\n79\t80\t117\t117\n79\t80\t118\t118\n79\t80\t119\t119\n79\t80\t120\t120\n79\t80\t121\t121\n79\t80\t122\t122\n79\t80\t123\t123\n79\t80\t124\t124\n79\t80\t125\t125\n79\t80\t126\t126\n79\t80\t127\t127\n79\t80\t128\t128\n79\t80\t129\t129\n79\t80\t130\t130\n79\t80\t131\t131\n79\t80\t132\t132\n79\t80\t133\t133\n79\t80\t134\t134\n79\t80\t135\t135\n79\t80\t136\t136\n79\t80\t137\t137\n79\t80\t138\t138\n79\t80\t139\t139\n79\t80\t140\t140\n79\t80\t141\t141\n79\t80\t142\t142\n79\t80\t143\t143\n79\t80\t144\t144\n79\t80\t145\t145\n79\t80\t146\t146\n79\t80\t147\t147\n79\t80\t148\t148\n79\t80\t149\t149\n79\t80\t150\t150\n79\t80\t151\t151\n79\t80\t152\t152\n79\t80\t153\t153\n79\t80\t154\t154\n79\t80\t155\t155\n79\t80\t156\t156\n79\t80\t157\t157\n79\t80\t158\t158\n79\t80\t159\t159\n79\t80\t160\t160\n79\t80\t161\t161\n79\t80\t162\t162\n79\t80\t163\t163\n79\t80\t164\t164\n79\t80\t165\t165\n79\t80\t166\t166\n79\t80\t167\t167\n79\t80\t168\t168\n79\t80\t169\t169\n79\t80\t170\t170\n79\t80\t171\t171\n79\t80\t172\t172\n79\t80\t173\t173\n79\t80\t174\t174\n79\t80\t175\t175\n79\t80\t176\t176\n79\t80\t177\t177\n79\t80\t178\t178\n79\t80\t179\t179\n79\t80\t180\t180\n79\t80\t181\t181\n79\t80\t182\t182\n79\t80\t183\t183\n79\t80\t184\t184\n79\t80\t185\t185\n79\t80\t186\t186\n79\t80\t187\t187\n79\t80\t188\t188\n79\t80\t189\t189\n79\t80\t190\t190\n79\t80\t191\t191\n79\t80\t192\t192\n79\t80\t193\t193\n79\t80\t194\t194\n79\t80\t195\t195\n79\t80\t196\t196\n79\t80\t197\t197\n79\t80\t198\t198\n79\t80\t199\t199\n79\t80\t200\t200\n79\t80\t201\t201\n79\t80\t202\t202\n79\t80\t203\t203\n79\t80\t204\t204\n79\t80\t205\t205\n79\t80\t206\t206\n79\t80\t207\t207\n79\t80\t208\t208\n79\t80\t209\t209\n79\t80\t210\t210\n79\t80\t211\t211\n79\t80\t212\t212\n79\t80\t213\t213\n79\t80\t214\t214\n79\t80\t215\t215\n79\t80\t216\t216\n79\t80\t217\t217\n79\t80\t218\t218\n79\t80\t219\t219\n79\t80\t220\t220\n79\t80\t221\t221\n79\t80\t222\t222\n79\t80\t223\t223\n79\t80\t224\t224\n79\t80\t225\t225\n79\t80\t226\t226\n79\t80\t227\t227\n79\t80\t228\t228\n79\t80\t229\t229\n79\t80\t230\t230\n79\t80\t231\t231\n79\t80\t232\t232\n79\t80\t233\t233\n79\t80\t234\t234\n79\t80\t235\t235\n79\t80\t236\t236\n79\t80\t237\t237\n79\t80\t238\t238\n79\t80\t239\t239\n79\t80\t240\t240\n79\t80\t241\t241\n79\t80\t242\t242\n79\t80\t243\t243\n79\t80\t244\t244\n79\t80\t245\t245\n79\t80\t246\t246\n79\t80\t247\t247\n79\t80\t248\t248\n79\t80\t249\t249\n79\t80\t250\t250\n79\t80\t251\t251\n79\t80\t252\t252\n79\t80\t253\t253\n79\t80\t254\t254\n79\t80\t255\t255\n80\t81\t0\t0\n80\t81\t1\t1\n80\t81\t2\t2\n80\t81\t3\t3\n80\t81\t4\t4\n80\t81\t5\t5\n80\t81\t6\t6\n80\t81\t7\t7\n80\t81\t8\t8\n80\t81\t9\t9\n80\t81\t10\t10\n80\t81\t11\t11\n80\t81\t12\t12\n80\t81\t13\t13\n80\t81\t14\t14\n80\t81\t15\t15\n80\t81\t16\t16\n80\t81\t17\t17\n80\t81\t18\t18\n80\t81\t19\t19\n80\t81\t20\t20\n80\t81\t21\t21\n80\t81\t22\t22\n80\t81\t23\t23\n80\t81\t24\t24\n80\t81\t25\t25\n80\t81\t26\t26\n80\t81\t27\t27\n80\t81\t28\t28\n80\t81\t29\t29\n80\t81\t30\t30\n80\t81\t31\t31\n80\t81\t32\t32\n80\t81\t33\t33\n80\t81\t34\t34\n80\t81\t35\t35\n80\t81\t36\t36\n80\t81\t37\t37\n80\t81\t38\t38\n80\t81\t39\t39\n80\t81\t40\t40\n80\t81\t41\t41\n80\t81\t42\t42\n80\t81\t43\t43\n80\t81\t44\t44\n80\t81\t45\t45\n80\t81\t46\t46\n80\t81\t47\t47\n80\t81\t48\t48\n80\t81\t49\t49\n80\t81\t50\t50\n80\t81\t51\t51\n80\t81\t52\t52\n80\t81\t53\t53\n80\t81\t54\t54\n80\t81\t55\t55\n80\t81\t56\t56\n80\t81\t57\t57\n80\t81\t58\t58\n80\t81\t59\t59\n80\t81\t60\t60\n80\t81\t61\t61\n80\t81\t62\t62\n80\t81\t63\t63\n80\t81\t64\t64\n80\t81\t65\t65\n80\t81\t66\t66\n80\t81\t67\t67\n80\t81\t68\t68\n80\t81\t69\t69\n80\t81\t70\t70\n80\t81\t71\t71\n80\t81\t72\t72\n80\t81\t73\t73\n80\t81\t74\t74\n80\t81\t75\t75\n80\t81\t76\t76\n80\t81\t77\t77\n80\t81\t78\t78\n80\t81\t79\t79\n80\t81\t80\t80\n80\t81\t81\t81\n80\t81\t82\t82\n80\t81\t83\t83\n80\t81\t84\t84\n80\t81\t85\t85\n80\t81\t86\t86\n80\t81\t87\t87\n80\t81\t88\t88\n80\t81\t89\t89\n80\t81\t90\t90\n80\t81\t91\t91\n80\t81\t92\t92\n80\t81\t93\t93\n80\t81\t94\t94\n80\t81\t95\t95\n80\t81\t96\t96\n80\t81\t97\t97\n80\t81\t98\t98\n80\t81\t99\t99\n80\t81\t100\t100\n80\t81\t101\t101\n80\t81\t102\t102\n80\t81\t103\t103\n80\t81\t104\t104\n80\t81\t105\t105\n80\t81\t106\t106\n80\t81\t107\t107\n80\t81\t108\t108\n80\t81\t109\t109\n80\t81\t110\t110\n80\t81\t111\t111\n80\t81\t112\t112\n80\t81\t113\t113\n80\t81\t114\t114\n80\t81\t115\t115\n80\t81\t116\t116\n80\t81\t117\t117\n80\t81\t118\t118\n80\t81\t119\t119\n80\t81\t120\t120\n80\t81\t121\t121\n80\t81\t122\t122\n80\t81\t123\t123\n80\t81\t124\t124\n80\t81\t125\t125\n80\t81\t126\t126\n80\t81\t127\t127\n80\t81\t128\t128\n80\t81\t129\t129\n80\t81\t130\t130\n80\t81\t131\t131\n80\t81\t132\t132\n80\t81\t133\t133\n80\t81\t134\t134\n80\t81\t135\t135\n80\t81\t136\t136\n80\t81\t137\t137\n80\t81\t138\t138\n80\t81\t139\t139\n80\t81\t140\t140\n80\t81\t141\t141\n80\t81\t142\t142\n80\t81\t143\t143\n80\t81\t144\t144\n80\t81\t145\t145\n80\t81\t146\t146\n80\t81\t147\t147\n80\t81\t148\t148\n80\t81\t149\t149\n80\t81\t150\t150\n80\t81\t151\t151\n80\t81\t152\t152\n80\t81\t153\t153\n80\t81\t154\t154\n80\t81\t155\t155\n80\t81\t156\t156\n80\t81\t157\t157\n80\t81\t158\t158\n80\t81\t159\t159\n80\t81\t160\t160\n80\t81\t161\t161\n80\t81\t162\t162\n80\t81\t163\t163\n80\t81\t164\t164\n80\t81\t165\t165\n80\t81\t166\t166\n80\t81\t167\t167\n80\t81\t168\t168\n80\t81\t169\t169\n80\t81\t170\t170\n80\t81\t171\t171\n80\t81\t172\t172\n80\t81\t173\t173\n80\t81\t174\t174\n80\t81\t175\t175\n80\t81\t176\t176\n80\t81\t177\t177\n80\t81\t178\t178\n80\t81\t179\t179\n80\t81\t180\t180\n80\t81\t181\t181\n80\t81\t182\t182\n80\t81\t183\t183\n80\t81\t184\t184\n80\t81\t185\t185\n80\t81\t186\t186\n80\t81\t187\t187\n80\t81\t188\t188\n80\t81\t189\t189\n80\t81\t190\t190\n80\t81\t191\t191\n80\t81\t192\t192\n80\t81\t193\t193\n80\t81\t194\t194\n80\t81\t195\t195\n80\t81\t196\t196\n80\t81\t197\t197\n80\t81\t198\t198\n80\t81\t199\t199\n80\t81\t200\t200\n80\t81\t201\t201\n80\t81\t202\t202\n80\t81\t203\t203\n80\t81\t204\t204\n80\t81\t205\t205\n80\t81\t206\t206\n80\t81\t207\t207\n80\t81\t208\t208\n80\t81\t209\t209\n80\t81\t210\t210\n80\t81\t211\t211\n80\t81\t212\t212\n80\t81\t213\t213\n80\t81\t214\t214\n80\t81\t215\t215\n80\t81\t216\t216\n80\t81\t217\t217\n80\t81\t218\t218\n80\t81\t219\t219\n80\t81\t220\t220\n80\t81\t221\t221\n80\t81\t222\t222\n80\t81\t223\t223\n80\t81\t224\t224\n80\t81\t225\t225\n80\t81\t226\t226\n80\t81\t227\t227\n80\t81\t228\t228\n80\t81\t229\t229\n80\t81\t230\t230\n80\t81\t231\t231\n80\t81\t232\t232\n80\t81\t233\t233\n80\t81\t234\t234\n80\t81\t235\t235\n80\t81\t236\t236\n80\t81\t237\t237\n80\t81\t238\t238\n80\t81\t239\t239\n80\t81\t240\t240\n80\t81\t241\t241\n80\t81\t242\t242\n80\t81\t243\t243\n80\t81\t244\t244\n80\t81\t245\t245\n80\t81\t246\t246\n80\t81\t247\t247\n80\t81\t248\t248\n80\t81\t249\t249\n80\t81\t250\t250\n80\t81\t251\t251\n80\t81\t252\t252\n80\t81\t253\t253\n80\t81\t254\t254\n80\t81\t255\t255\n81\t82\t0\t0\n81\t82\t1\t1\n81\t82\t2\t2\n81\t82\t3\t3\n81\t82\t4\t4\n81\t82\t5\t5\n81\t82\t6\t6\n81\t82\t7\t7\n81\t82\t8\t8\n81\t82\t9\t9\n81\t82\t10\t10\n81\t82\t11\t11\n81\t82\t12\t12\n81\t82\t13\t13\n81\t82\t14\t14\n81\t82\t15\t15\n81\t82\t16\t16\n81\t82\t17\t17\n81\t82\t18\t18\n81\t82\t19\t19\n81\t82\t20\t20\n81\t82\t21\t21\n81\t82\t22\t22\n81\t82\t23\t23\n81\t82\t24\t24\n81\t82\t25\t25\n81\t82\t26\t26\n81\t82\t27\t27\n81\t82\t28\t28\n81\t82\t29\t29\n81\t82\t30\t30\n81\t82\t31\t31\n81\t82\t32\t32\n81\t82\t33\t33\n81\t82\t34\t34\n81\t82\t35\t35\n81\t82\t36\t36\n81\t82\t37\t37\n81\t82\t38\t38\n81\t82\t39\t39\n81\t82\t40\t40\n81\t82\t41\t41\n81\t82\t42\t42\n81\t82\t43\t43\n81\t82\t44\t44\n81\t82\t45\t45\n81\t82\t46\t46\n81\t82\t47\t47\n81\t82\t48\t48\n81\t82\t49\t49\n81\t82\t50\t50\n81\t82\t51\t51\n81\t82\t52\t52\n81\t82\t53\t53\n81\t82\t54\t54\n81\t82\t55\t55\n81\t82\t56\t56\n81\t82\t57\t57\n81\t82\t58\t58\n81\t82\t59\t59\n81\t82\t60\t60\n81\t82\t61\t61\n81\t82\t62\t62\n81\t82\t63\t63\n81\t82\t64\t64\n81\t82\t65\t65\n81\t82\t66\t66\n81\t82\t67\t67\n81\t82\t68\t68\n81\t82\t69\t69\n81\t82\t70\t70\n81\t82\t71\t71\n81\t82\t72\t72\n81\t82\t73\t73\n81\t82\t74\t74\n81\t82\t75\t75\n81\t82\t76\t76\n81\t82\t77\t77\n81\t82\t78\t78\n81\t82\t79\t79\n81\t82\t80\t80\n81\t82\t81\t81\n81\t82\t82\t82\n81\t82\t83\t83\n81\t82\t84\t84\n81\t82\t85\t85\n81\t82\t86\t86\n81\t82\t87\t87\n81\t82\t88\t88\n81\t82\t89\t89\n81\t82\t90\t90\n81\t82\t91\t91\n81\t82\t92\t92\n81\t82\t93\t93\n81\t82\t94\t94\n81\t82\t95\t95\n81\t82\t96\t96\n81\t82\t97\t97\n81\t82\t98\t98\n81\t82\t99\t99\n81\t82\t100\t100\n81\t82\t101\t101\n81\t82\t102\t102\n81\t82\t103\t103\n81\t82\t104\t104\n81\t82\t105\t105\n81\t82\t106\t106\n81\t82\t107\t107\n81\t82\t108\t108\n81\t82\t109\t109\n81\t82\t110\t110\n81\t82\t111\t111\n81\t82\t112\t112\n81\t82\t113\t113\n81\t82\t114\t114\n81\t82\t115\t115\n81\t82\t116\t116\n81\t82\t117\t117\n81\t82\t118\t118\n81\t82\t119\t119\n81\t82\t120\t120\n81\t82\t121\t121\n81\t82\t122\t122\n81\t82\t123\t123\n81\t82\t124\t124\n81\t82\t125\t125\n81\t82\t126\t126\n81\t82\t127\t127\n81\t82\t128\t128\n81\t82\t129\t129\n81\t82\t130\t130\n81\t82\t131\t131\n81\t82\t132\t132\n81\t82\t133\t133\n81\t82\t134\t134\n81\t82\t135\t135\n81\t82\t136\t136\n81\t82\t137\t137\n81\t82\t138\t138\n81\t82\t139\t139\n81\t82\t140\t140\n81\t82\t141\t141\n81\t82\t142\t142\n81\t82\t143\t143\n81\t82\t144\t144\n81\t82\t145\t145\n81\t82\t146\t146\n81\t82\t147\t147\n81\t82\t148\t148\n81\t82\t149\t149\n81\t82\t150\t150\n81\t82\t151\t151\n81\t82\t152\t152\n81\t82\t153\t153\n81\t82\t154\t154\n81\t82\t155\t155\n81\t82\t156\t156\n81\t82\t157\t157\n81\t82\t158\t158\n81\t82\t159\t159\n81\t82\t160\t160\n81\t82\t161\t161\n81\t82\t162\t162\n81\t82\t163\t163\n81\t82\t164\t164\n81\t82\t165\t165\n81\t82\t166\t166\n81\t82\t167\t167\n81\t82\t168\t168\n81\t82\t169\t169\n81\t82\t170\t170\n81\t82\t171\t171\n81\t82\t172\t172\n81\t82\t173\t173\n81\t82\t174\t174\n81\t82\t175\t175\n81\t82\t176\t176\n81\t82\t177\t177\n81\t82\t178\t178\n81\t82\t179\t179\n81\t82\t180\t180\n81\t82\t181\t181\n81\t82\t182\t182\n81\t82\t183\t183\n81\t82\t184\t184\n81\t82\t185\t185\n81\t82\t186\t186\n81\t82\t187\t187\n81\t82\t188\t188\n81\t82\t189\t189\n81\t82\t190\t190\n81\t82\t191\t191\n81\t82\t192\t192\n81\t82\t193\t193\n81\t82\t194\t194\n81\t82\t195\t195\n81\t82\t196\t196\n81\t82\t197\t197\n81\t82\t198\t198\n81\t82\t199\t199\n81\t82\t200\t200\n81\t82\t201\t201\n81\t82\t202\t202\n81\t82\t203\t203\n81\t82\t204\t204\n81\t82\t205\t205\n81\t82\t206\t206\n81\t82\t207\t207\n81\t82\t208\t208\n81\t82\t209\t209\n81\t82\t210\t210\n81\t82\t211\t211\n81\t82\t212\t212\n81\t82\t213\t213\n81\t82\t214\t214\n81\t82\t215\t215\n81\t82\t216\t216\n81\t82\t217\t217\n81\t82\t218\t218\n81\t82\t219\t219\n81\t82\t220\t220\n81\t82\t221\t221\n81\t82\t222\t222\n81\t82\t223\t223\n81\t82\t224\t224\n81\t82\t225\t225\n81\t82\t226\t226\n81\t82\t227\t227\n81\t82\t228\t228\n81\t82\t229\t229\n81\t82\t230\t230\n81\t82\t231\t231\n81\t82\t232\t232\n81\t82\t233\t233\n81\t82\t234\t234\n81\t82\t235\t235\n81\t82\t236\t236\n81\t82\t237\t237\n81\t82\t238\t238\n81\t82\t239\t239\n81\t82\t240\t240\n81\t82\t241\t241\n81\t82\t242\t242\n81\t82\t243\t243\n81\t82\t244\t244\n81\t82\t245\t245\n81\t82\t246\t246\n81\t82\t247\t247\n81\t82\t248\t248\n81\t82\t249\t249\n81\t82\t250\t250\n81\t82\t251\t251\n81\t82\t252\t252\n81\t82\t253\t253\n81\t82\t254\t254\n81\t82\t255\t255\n82\t83\t0\t0\n82\t83\t1\t1\n82\t83\t2\t2\n82\t83\t3\t3\n82\t83\t4\t4\n82\t83\t5\t5\n82\t83\t6\t6\n82\t83\t7\t7\n82\t83\t8\t8\n82\t83\t9\t9\n82\t83\t10\t10\n82\t83\t11\t11\n82\t83\t12\t12\n82\t83\t13\t13\n82\t83\t14\t14\n82\t83\t15\t15\n82\t83\t16\t16\n82\t83\t17\t17\n82\t83\t18\t18\n82\t83\t19\t19\n82\t83\t20\t20\n82\t83\t21\t21\n82\t83\t22\t22\n82\t83\t23\t23\n82\t83\t24\t24\n82\t83\t25\t25\n82\t83\t26\t26\n82\t83\t27\t27\n82\t83\t28\t28\n82\t83\t29\t29\n82\t83\t30\t30\n82\t83\t31\t31\n82\t83\t32\t32\n82\t83\t33\t33\n82\t83\t34\t34\n82\t83\t35\t35\n82\t83\t36\t36\n82\t83\t37\t37\n82\t83\t38\t38\n82\t83\t39\t39\n82\t83\t40\t40\n82\t83\t41\t41\n82\t83\t42\t42\n82\t83\t43\t43\n82\t83\t44\t44\n82\t83\t45\t45\n82\t83\t46\t46\n82\t83\t47\t47\n82\t83\t48\t48\n82\t83\t49\t49\n82\t83\t50\t50\n82\t83\t51\t51\n82\t83\t52\t52\n82\t83\t53\t53\n82\t83\t54\t54\n82\t83\t55\t55\n82\t83\t56\t56\n82\t83\t57\t57\n82\t83\t58\t58\n82\t83\t59\t59\n82\t83\t60\t60\n82\t83\t61\t61\n82\t83\t62\t62\n82\t83\t63\t63\n82\t83\t64\t64\n82\t83\t65\t65\n82\t83\t66\t66\n82\t83\t67\t67\n82\t83\t68\t68\n82\t83\t69\t69\n82\t83\t70\t70\n82\t83\t71\t71\n82\t83\t72\t72\n82\t83\t73\t73\n82\t83\t74\t74\n82\t83\t75\t75\n82\t83\t76\t76\n82\t83\t77\t77\n82\t83\t78\t78\n82\t83\t79\t79\n82\t83\t80\t80\n82\t83\t81\t81\n82\t83\t82\t82\n82\t83\t83\t83\n82\t83\t84\t84\n82\t83\t85\t85\n82\t83\t86\t86\n82\t83\t87\t87\n82\t83\t88\t88\n82\t83\t89\t89\n82\t83\t90\t90\n82\t83\t91\t91\n82\t83\t92\t92\n82\t83\t93\t93\n82\t83\t94\t94\n82\t83\t95\t95\n82\t83\t96\t96\n82\t83\t97\t97\n82\t83\t98\t98\n82\t83\t99\t99\n82\t83\t100\t100\n82\t83\t101\t101\n82\t83\t102\t102\n82\t83\t103\t103\n82\t83\t104\t104\n82\t83\t105\t105\n82\t83\t106\t106\n82\t83\t107\t107\n82\t83\t108\t108\n82\t83\t109\t109\n82\t83\t110\t110\n82\t83\t111\t111\n82\t83\t112\t112\n82\t83\t113\t113\n82\t83\t114\t114\n82\t83\t115\t115\n82\t83\t116\t116\n82\t83\t117\t117\n82\t83\t118\t118\n82\t83\t119\t119\n82\t83\t120\t120\n82\t83\t121\t121\n82\t83\t122\t122\n82\t83\t123\t123\n82\t83\t124\t124\n82\t83\t125\t125\n82\t83\t126\t126\n82\t83\t127\t127\n82\t83\t128\t128\n82\t83\t129\t129\n82\t83\t130\t130\n82\t83\t131\t131\n82\t83\t132\t132\n82\t83\t133\t133\n82\t83\t134\t134\n82\t83\t135\t135\n82\t83\t136\t136\n82\t83\t137\t137\n82\t83\t138\t138\n82\t83\t139\t139\n82\t83\t140\t140\n82\t83\t141\t141\n82\t83\t142\t142\n82\t83\t143\t143\n82\t83\t144\t144\n82\t83\t145\t145\n82\t83\t146\t146\n82\t83\t147\t147\n82\t83\t148\t148\n82\t83\t149\t149\n82\t83\t150\t150\n82\t83\t151\t151\n82\t83\t152\t152\n82\t83\t153\t153\n82\t83\t154\t154\n82\t83\t155\t155\n82\t83\t156\t156\n82\t83\t157\t157\n82\t83\t158\t158\n82\t83\t159\t159\n82\t83\t160\t160\n82\t83\t161\t161\n82\t83\t162\t162\n82\t83\t163\t163\n82\t83\t164\t164\n82\t83\t165\t165\n82\t83\t166\t166\n82\t83\t167\t167\n82\t83\t168\t168\n82\t83\t169\t169\n82\t83\t170\t170\n82\t83\t171\t171\n82\t83\t172\t172\n82\t83\t173\t173\n82\t83\t174\t174\n82\t83\t175\t175\n82\t83\t176\t176\n82\t83\t177\t177\n82\t83\t178\t178\n82\t83\t179\t179\n82\t83\t180\t180\n82\t83\t181\t181\n82\t83\t182\t182\n82\t83\t183\t183\n82\t83\t184\t184\n82\t83\t185\t185\n82\t83\t186\t186\n82\t83\t187\t187\n82\t83\t188\t188\n82\t83\t189\t189\n82\t83\t190\t190\n82\t83\t191\t191\n82\t83\t192\t192\n82\t83\t193\t193\n82\t83\t194\t194\n82\t83\t195\t195\n82\t83\t196\t196\n82\t83\t197\t197\n82\t83\t198\t198\n82\t83\t199\t199\n82\t83\t200\t200\n82\t83\t201\t201\n82\t83\t202\t202\n82\t83\t203\t203\n82\t83\t204\t204\n82\t83\t205\t205\n82\t83\t206\t206\n82\t83\t207\t207\n82\t83\t208\t208\n82\t83\t209\t209\n82\t83\t210\t210\n82\t83\t211\t211\n82\t83\t212\t212\n82\t83\t213\t213\n82\t83\t214\t214\n82\t83\t215\t215\n82\t83\t216\t216\n82\t83\t217\t217\n82\t83\t218\t218\n82\t83\t219\t219\n82\t83\t220\t220\n82\t83\t221\t221\n82\t83\t222\t222\n82\t83\t223\t223\n82\t83\t224\t224\n82\t83\t225\t225\n82\t83\t226\t226\n82\t83\t227\t227\n82\t83\t228\t228\n82\t83\t229\t229\n82\t83\t230\t230\n82\t83\t231\t231\n82\t83\t232\t232\n82\t83\t233\t233\n82\t83\t234\t234\n82\t83\t235\t235\n82\t83\t236\t236\n82\t83\t237\t237\n82\t83\t238\t238\n82\t83\t239\t239\n82\t83\t240\t240\n82\t83\t241\t241\n82\t83\t242\t242\n82\t83\t243\t243\n82\t83\t244\t244\n82\t83\t245\t245\n82\t83\t246\t246\n82\t83\t247\t247\n82\t83\t248\t248\n82\t83\t249\t249\n82\t83\t250\t250\n82\t83\t251\t251\n82\t83\t252\t252\n82\t83\t253\t253\n82\t83\t254\t254\n82\t83\t255\t255\n83\t84\t0\t0\n83\t84\t1\t1\n83\t84\t2\t2\n83\t84\t3\t3\n83\t84\t4\t4\n83\t84\t5\t5\n83\t84\t6\t6\n83\t84\t7\t7\n83\t84\t8\t8\n83\t84\t9\t9\n83\t84\t10\t10\n83\t84\t11\t11\n83\t84\t12\t12\n83\t84\t13\t13\n83\t84\t14\t14\n83\t84\t15\t15\n83\t84\t16\t16\n83\t84\t17\t17\n83\t84\t18\t18\n83\t84\t19\t19\n83\t84\t20\t20\n83\t84\t21\t21\n83\t84\t22\t22\n83\t84\t23\t23\n83\t84\t24\t24\n83\t84\t25\t25\n83\t84\t26\t26\n83\t84\t27\t27\n83\t84\t28\t28\n83\t84\t29\t29\n83\t84\t30\t30\n83\t84\t31\t31\n83\t84\t32\t32\n83\t84\t33\t33\n83\t84\t34\t34\n83\t84\t35\t35\n83\t84\t36\t36\n83\t84\t37\t37\n83\t84\t38\t38\n83\t84\t39\t39\n83\t84\t40\t40\n83\t84\t41\t41\n83\t84\t42\t42\n83\t84\t43\t43\n83\t84\t44\t44\n83\t84\t45\t45\n83\t84\t46\t46\n83\t84\t47\t47\n83\t84\t48\t48\n83\t84\t49\t49\n83\t84\t50\t50\n83\t84\t51\t51\n83\t84\t52\t52\n83\t84\t53\t53\n83\t84\t54\t54\n83\t84\t55\t55\n83\t84\t56\t56\n83\t84\t57\t57\n83\t84\t58\t58\n83\t84\t59\t59\n83\t84\t60\t60\n83\t84\t61\t61\n83\t84\t62\t62\n83\t84\t63\t63\n83\t84\t64\t64\n83\t84\t65\t65\n83\t84\t66\t66\n83\t84\t67\t67\n83\t84\t68\t68\n83\t84\t69\t69\n83\t84\t70\t70\n83\t84\t71\t71\n83\t84\t72\t72\n83\t84\t73\t73\n83\t84\t74\t74\n83\t84\t75\t75\n83\t84\t76\t76\n83\t84\t77\t77\n83\t84\t78\t78\n83\t84\t79\t79\n83\t84\t80\t80\n83\t84\t81\t81\n83\t84\t82\t82\n83\t84\t83\t83\n83\t84\t84\t84\n83\t84\t85\t85\n83\t84\t86\t86\n83\t84\t87\t87\n83\t84\t88\t88\n83\t84\t89\t89\n83\t84\t90\t90\n83\t84\t91\t91\n83\t84\t92\t92\n83\t84\t93\t93\n83\t84\t94\t94\n83\t84\t95\t95\n83\t84\t96\t96\n83\t84\t97\t97\n83\t84\t98\t98\n83\t84\t99\t99\n83\t84\t100\t100\n83\t84\t101\t101\n83\t84\t102\t102\n83\t84\t103\t103\n83\t84\t104\t104\n83\t84\t105\t105\n83\t84\t106\t106\n83\t84\t107\t107\n83\t84\t108\t108\n83\t84\t109\t109\n83\t84\t110\t110\n83\t84\t111\t111\n83\t84\t112\t112\n83\t84\t113\t113\n83\t84\t114\t114\n83\t84\t115\t115\n83\t84\t116\t116\n83\t84\t117\t117\n83\t84\t118\t118\n83\t84\t119\t119\n83\t84\t120\t120\n83\t84\t121\t121\n83\t84\t122\t122\n83\t84\t123\t123\n83\t84\t124\t124\n83\t84\t125\t125\n83\t84\t126\t126\n83\t84\t127\t127\n83\t84\t128\t128\n83\t84\t129\t129\n83\t84\t130\t130\n83\t84\t131\t131\n83\t84\t132\t132\n83\t84\t133\t133\n83\t84\t134\t134\n83\t84\t135\t135\n83\t84\t136\t136\n83\t84\t137\t137\n83\t84\t138\t138\n83\t84\t139\t139\n83\t84\t140\t140\n83\t84\t141\t141\n83\t84\t142\t142\n83\t84\t143\t143\n83\t84\t144\t144\n83\t84\t145\t145\n83\t84\t146\t146\n83\t84\t147\t147\n83\t84\t148\t148\n83\t84\t149\t149\n83\t84\t150\t150\n83\t84\t151\t151\n83\t84\t152\t152\n83\t84\t153\t153\n83\t84\t154\t154\n83\t84\t155\t155\n83\t84\t156\t156\n83\t84\t157\t157\n83\t84\t158\t158\n83\t84\t159\t159\n83\t84\t160\t160\n83\t84\t161\t161\n83\t84\t162\t162\n83\t84\t163\t163\n83\t84\t164\t164\n83\t84\t165\t165\n83\t84\t166\t166\n83\t84\t167\t167\n83\t84\t168\t168\n83\t84\t169\t169\n83\t84\t170\t170\n83\t84\t171\t171\n83\t84\t172\t172\n83\t84\t173\t173\n83\t84\t174\t174\n83\t84\t175\t175\n83\t84\t176\t176\n83\t84\t177\t177\n83\t84\t178\t178\n83\t84\t179\t179\n83\t84\t180\t180\n83\t84\t181\t181\n83\t84\t182\t182\n83\t84\t183\t183\n83\t84\t184\t184\n83\t84\t185\t185\n83\t84\t186\t186\n83\t84\t187\t187\n83\t84\t188\t188\n83\t84\t189\t189\n83\t84\t190\t190\n83\t84\t191\t191\n83\t84\t192\t192\n83\t84\t193\t193\n83\t84\t194\t194\n83\t84\t195\t195\n83\t84\t196\t196\n83\t84\t197\t197\n83\t84\t198\t198\n83\t84\t199\t199\n83\t84\t200\t200\n83\t84\t201\t201\n83\t84\t202\t202\n83\t84\t203\t203\n83\t84\t204\t204\n83\t84\t205\t205\n83\t84\t206\t206\n83\t84\t207\t207\n83\t84\t208\t208\n83\t84\t209\t209\n83\t84\t210\t210\n83\t84\t211\t211\n83\t84\t212\t212\n83\t84\t213\t213\n83\t84\t214\t214\n83\t84\t215\t215\n83\t84\t216\t216\n83\t84\t217\t217\n83\t84\t218\t218\n83\t84\t219\t219\n83\t84\t220\t220\n83\t84\t221\t221\n83\t84\t222\t222\n83\t84\t223\t223\n83\t84\t224\t224\n83\t84\t225\t225\n83\t84\t226\t226\n83\t84\t227\t227\n83\t84\t228\t228\n83\t84\t229\t229\n83\t84\t230\t230\n83\t84\t231\t231\n83\t84\t232\t232\n83\t84\t233\t233\n83\t84\t234\t234\n83\t84\t235\t235\n83\t84\t236\t236\n83\t84\t237\t237\n83\t84\t238\t238\n83\t84\t239\t239\n83\t84\t240\t240\n83\t84\t241\t241\n83\t84\t242\t242\n83\t84\t243\t243\n83\t84\t244\t244\n83\t84\t245\t245\n83\t84\t246\t246\n83\t84\t247\t247\n83\t84\t248\t248\n83\t84\t249\t249\n83\t84\t250\t250\n83\t84\t251\t251\n83\t84\t252\t252\n83\t84\t253\t253\n83\t84\t254\t254\n83\t84\t255\t255\n84\t85\t0\t0\n84\t85\t1\t1\n84\t85\t2\t2\n84\t85\t3\t3\n84\t85\t4\t4\n84\t85\t5\t5\n84\t85\t6\t6\n84\t85\t7\t7\n84\t85\t8\t8\n84\t85\t9\t9\n84\t85\t10\t10\n84\t85\t11\t11\n84\t85\t12\t12\n84\t85\t13\t13\n84\t85\t14\t14\n84\t85\t15\t15\n84\t85\t16\t16\n84\t85\t17\t17\n84\t85\t18\t18\n84\t85\t19\t19\n84\t85\t20\t20\n84\t85\t21\t21\n84\t85\t22\t22\n84\t85\t23\t23\n84\t85\t24\t24\n84\t85\t25\t25\n84\t85\t26\t26\n84\t85\t27\t27\n84\t85\t28\t28\n84\t85\t29\t29\n84\t85\t30\t30\n84\t85\t31\t31\n84\t85\t32\t32\n84\t85\t33\t33\n84\t85\t34\t34\n84\t85\t35\t35\n84\t85\t36\t36\n84\t85\t37\t37\n84\t85\t38\t38\n84\t85\t39\t39\n84\t85\t40\t40\n84\t85\t41\t41\n84\t85\t42\t42\n84\t85\t43\t43\n84\t85\t44\t44\n84\t85\t45\t45\n84\t85\t46\t46\n84\t85\t47\t47\n84\t85\t48\t48\n84\t85\t49\t49\n84\t85\t50\t50\n84\t85\t51\t51\n84\t85\t52\t52\n84\t85\t53\t53\n84\t85\t54\t54\n84\t85\t55\t55\n84\t85\t56\t56\n84\t85\t57\t57\n84\t85\t58\t58\n84\t85\t59\t59\n84\t85\t60\t60\n84\t85\t61\t61\n84\t85\t62\t62\n84\t85\t63\t63\n84\t85\t64\t64\n84\t85\t65\t65\n84\t85\t66\t66\n84\t85\t67\t67\n84\t85\t68\t68\n84\t85\t69\t69\n84\t85\t70\t70\n84\t85\t71\t71\n84\t85\t72\t72\n84\t85\t73\t73\n84\t85\t74\t74\n84\t85\t75\t75\n84\t85\t76\t76\n84\t85\t77\t77\n84\t85\t78\t78\n84\t85\t79\t79\n84\t85\t80\t80\n84\t85\t81\t81\n84\t85\t82\t82\n84\t85\t83\t83\n84\t85\t84\t84\n84\t85\t85\t85\n84\t85\t86\t86\n84\t85\t87\t87\n84\t85\t88\t88\n84\t85\t89\t89\n84\t85\t90\t90\n84\t85\t91\t91\n84\t85\t92\t92\n84\t85\t93\t93\n84\t85\t94\t94\n84\t85\t95\t95\n84\t85\t96\t96\n84\t85\t97\t97\n84\t85\t98\t98\n84\t85\t99\t99\n84\t85\t100\t100\n84\t85\t101\t101\n84\t85\t102\t102\n84\t85\t103\t103\n84\t85\t104\t104\n84\t85\t105\t105\n84\t85\t106\t106\n84\t85\t107\t107\n84\t85\t108\t108\n84\t85\t109\t109\n84\t85\t110\t110\n84\t85\t111\t111\n84\t85\t112\t112\n84\t85\t113\t113\n84\t85\t114\t114\n84\t85\t115\t115\n84\t85\t116\t116\n84\t85\t117\t117\n84\t85\t118\t118\n84\t85\t119\t119\n84\t85\t120\t120\n84\t85\t121\t121\n84\t85\t122\t122\n84\t85\t123\t123\n84\t85\t124\t124\n84\t85\t125\t125\n84\t85\t126\t126\n84\t85\t127\t127\n84\t85\t128\t128\n84\t85\t129\t129\n84\t85\t130\t130\n84\t85\t131\t131\n84\t85\t132\t132\n84\t85\t133\t133\n84\t85\t134\t134\n84\t85\t135\t135\n84\t85\t136\t136\n84\t85\t137\t137\n84\t85\t138\t138\n84\t85\t139\t139\n84\t85\t140\t140\n84\t85\t141\t141\n84\t85\t142\t142\n84\t85\t143\t143\n84\t85\t144\t144\n84\t85\t145\t145\n84\t85\t146\t146\n84\t85\t147\t147\n84\t85\t148\t148\n84\t85\t149\t149\n84\t85\t150\t150\n84\t85\t151\t151\n84\t85\t152\t152\n84\t85\t153\t153\n84\t85\t154\t154\n84\t85\t155\t155\n84\t85\t156\t156\n84\t85\t157\t157\n84\t85\t158\t158\n84\t85\t159\t159\n84\t85\t160\t160\n84\t85\t161\t161\n84\t85\t162\t162\n84\t85\t163\t163\n84\t85\t164\t164\n84\t85\t165\t165\n84\t85\t166\t166\n84\t85\t167\t167\n84\t85\t168\t168\n84\t85\t169\t169\n84\t85\t170\t170\n84\t85\t171\t171\n84\t85\t172\t172\n84\t85\t173\t173\n84\t85\t174\t174\n84\t85\t175\t175\n84\t85\t176\t176\n84\t85\t177\t177\n84\t85\t178\t178\n84\t85\t179\t179\n84\t85\t180\t180\n84\t85\t181\t181\n84\t85\t182\t182\n84\t85\t183\t183\n84\t85\t184\t184\n84\t85\t185\t185\n84\t85\t186\t186\n84\t85\t187\t187\n84\t85\t188\t188\n84\t85\t189\t189\n84\t85\t190\t190\n84\t85\t191\t191\n84\t85\t192\t192\n84\t85\t193\t193\n84\t85\t194\t194\n84\t85\t195\t195\n84\t85\t196\t196\n84\t85\t197\t197\n84\t85\t198\t198\n84\t85\t199\t199\n84\t85\t200\t200\n84\t85\t201\t201\n84\t85\t202\t202\n84\t85\t203\t203\n84\t85\t204\t204\n84\t85\t205\t205\n84\t85\t206\t206\n84\t85\t207\t207\n84\t85\t208\t208\n84\t85\t209\t209\n84\t85\t210\t210\n84\t85\t211\t211\n84\t85\t212\t212\n84\t85\t213\t213\n84\t85\t214\t214\n84\t85\t215\t215\n84\t85\t216\t216\n84\t85\t217\t217\n84\t85\t218\t218\n84\t85\t219\t219\n84\t85\t220\t220\n84\t85\t221\t221\n84\t85\t222\t222\n84\t85\t223\t223\n84\t85\t224\t224\n84\t85\t225\t225\n84\t85\t226\t226\n84\t85\t227\t227\n84\t85\t228\t228\n84\t85\t229\t229\n84\t85\t230\t230\n84\t85\t231\t231\n84\t85\t232\t232\n84\t85\t233\t233\n84\t85\t234\t234\n84\t85\t235\t235\n84\t85\t236\t236\n84\t85\t237\t237\n84\t85\t238\t238\n84\t85\t239\t239\n84\t85\t240\t240\n84\t85\t241\t241\n84\t85\t242\t242\n84\t85\t243\t243\n84\t85\t244\t244\n84\t85\t245\t245\n84\t85\t246\t246\n84\t85\t247\t247\n84\t85\t248\t248\n84\t85\t249\t249\n84\t85\t250\t250\n84\t85\t251\t251\n84\t85\t252\t252\n84\t85\t253\t253\n84\t85\t254\t254\n84\t85\t255\t255\n85\t86\t0\t0\n85\t86\t1\t1\n85\t86\t2\t2\n85\t86\t3\t3\n85\t86\t4\t4\n85\t86\t5\t5\n85\t86\t6\t6\n85\t86\t7\t7\n85\t86\t8\t8\n85\t86\t9\t9\n85\t86\t10\t10\n85\t86\t11\t11\n85\t86\t12\t12\n85\t86\t13\t13\n85\t86\t14\t14\n85\t86\t15\t15\n85\t86\t16\t16\n85\t86\t17\t17\n85\t86\t18\t18\n85\t86\t19\t19\n85\t86\t20\t20\n85\t86\t21\t21\n85\t86\t22\t22\n85\t86\t23\t23\n85\t86\t24\t24\n85\t86\t25\t25\n85\t86\t26\t26\n85\t86\t27\t27\n85\t86\t28\t28\n85\t86\t29\t29\n85\t86\t30\t30\n85\t86\t31\t31\n85\t86\t32\t32\n85\t86\t33\t33\n85\t86\t34\t34\n85\t86\t35\t35\n85\t86\t36\t36\n85\t86\t37\t37\n85\t86\t38\t38\n85\t86\t39\t39\n85\t86\t40\t40\n85\t86\t41\t41\n85\t86\t42\t42\n85\t86\t43\t43\n85\t86\t44\t44\n85\t86\t45\t45\n85\t86\t46\t46\n85\t86\t47\t47\n85\t86\t48\t48\n85\t86\t49\t49\n85\t86\t50\t50\n85\t86\t51\t51\n85\t86\t52\t52\n85\t86\t53\t53\n85\t86\t54\t54\n85\t86\t55\t55\n85\t86\t56\t56\n85\t86\t57\t57\n85\t86\t58\t58\n85\t86\t59\t59\n85\t86\t60\t60\n85\t86\t61\t61\n85\t86\t62\t62\n85\t86\t63\t63\n85\t86\t64\t64\n85\t86\t65\t65\n85\t86\t66\t66\n85\t86\t67\t67\n85\t86\t68\t68\n85\t86\t69\t69\n85\t86\t70\t70\n85\t86\t71\t71\n85\t86\t72\t72\n85\t86\t73\t73\n85\t86\t74\t74\n85\t86\t75\t75\n85\t86\t76\t76\n85\t86\t77\t77\n85\t86\t78\t78\n85\t86\t79\t79\n85\t86\t80\t80\n85\t86\t81\t81\n85\t86\t82\t82\n85\t86\t83\t83\n85\t86\t84\t84\n85\t86\t85\t85\n85\t86\t86\t86\n85\t86\t87\t87\n85\t86\t88\t88\n85\t86\t89\t89\n85\t86\t90\t90\n85\t86\t91\t91\n85\t86\t92\t92\n85\t86\t93\t93\n85\t86\t94\t94\n85\t86\t95\t95\n85\t86\t96\t96\n85\t86\t97\t97\n85\t86\t98\t98\n85\t86\t99\t99\n85\t86\t100\t100\n85\t86\t101\t101\n85\t86\t102\t102\n85\t86\t103\t103\n85\t86\t104\t104\n85\t86\t105\t105\n85\t86\t106\t106\n85\t86\t107\t107\n85\t86\t108\t108\n85\t86\t109\t109\n85\t86\t110\t110\n85\t86\t111\t111\n85\t86\t112\t112\n85\t86\t113\t113\n85\t86\t114\t114\n85\t86\t115\t115\n85\t86\t116\t116\n85\t86\t117\t117\n85\t86\t118\t118\n85\t86\t119\t119\n85\t86\t120\t120\n85\t86\t121\t121\n85\t86\t122\t122\n85\t86\t123\t123\n85\t86\t124\t124\n85\t86\t125\t125\n85\t86\t126\t126\n85\t86\t127\t127\n85\t86\t128\t128\n85\t86\t129\t129\n85\t86\t130\t130\n85\t86\t131\t131\n85\t86\t132\t132\n85\t86\t133\t133\n85\t86\t134\t134\n85\t86\t135\t135\n85\t86\t136\t136\n85\t86\t137\t137\n85\t86\t138\t138\n85\t86\t139\t139\n85\t86\t140\t140\n85\t86\t141\t141\n85\t86\t142\t142\n85\t86\t143\t143\n85\t86\t144\t144\n85\t86\t145\t145\n85\t86\t146\t146\n85\t86\t147\t147\n85\t86\t148\t148\n85\t86\t149\t149\n85\t86\t150\t150\n85\t86\t151\t151\n85\t86\t152\t152\n85\t86\t153\t153\n85\t86\t154\t154\n85\t86\t155\t155\n85\t86\t156\t156\n85\t86\t157\t157\n85\t86\t158\t158\n85\t86\t159\t159\n85\t86\t160\t160\n85\t86\t161\t161\n85\t86\t162\t162\n85\t86\t163\t163\n85\t86\t164\t164\n85\t86\t165\t165\n85\t86\t166\t166\n85\t86\t167\t167\n85\t86\t168\t168\n85\t86\t169\t169\n85\t86\t170\t170\n85\t86\t171\t171\n85\t86\t172\t172\n85\t86\t173\t173\n85\t86\t174\t174\n85\t86\t175\t175\n85\t86\t176\t176\n85\t86\t177\t177\n85\t86\t178\t178\n85\t86\t179\t179\n85\t86\t180\t180\n85\t86\t181\t181\n85\t86\t182\t182\n85\t86\t183\t183\n85\t86\t184\t184\n85\t86\t185\t185\n85\t86\t186\t186\n85\t86\t187\t187\n85\t86\t188\t188\n85\t86\t189\t189\n85\t86\t190\t190\n85\t86\t191\t191\n85\t86\t192\t192\n85\t86\t193\t193\n85\t86\t194\t194\n85\t86\t195\t195\n85\t86\t196\t196\n85\t86\t197\t197\n85\t86\t198\t198\n85\t86\t199\t199\n85\t86\t200\t200\n85\t86\t201\t201\n85\t86\t202\t202\n85\t86\t203\t203\n85\t86\t204\t204\n85\t86\t205\t205\n85\t86\t206\t206\n85\t86\t207\t207\n85\t86\t208\t208\n85\t86\t209\t209\n85\t86\t210\t210\n85\t86\t211\t211\n85\t86\t212\t212\n85\t86\t213\t213\n85\t86\t214\t214\n85\t86\t215\t215\n85\t86\t216\t216\n85\t86\t217\t217\n85\t86\t218\t218\n85\t86\t219\t219\n85\t86\t220\t220\n85\t86\t221\t221\n85\t86\t222\t222\n85\t86\t223\t223\n85\t86\t224\t224\n85\t86\t225\t225\n85\t86\t226\t226\n85\t86\t227\t227\n85\t86\t228\t228\n85\t86\t229\t229\n85\t86\t230\t230\n85\t86\t231\t231\n85\t86\t232\t232\n85\t86\t233\t233\n85\t86\t234\t234\n85\t86\t235\t235\n85\t86\t236\t236\n85\t86\t237\t237\n85\t86\t238\t238\n85\t86\t239\t239\n85\t86\t240\t240\n85\t86\t241\t241\n85\t86\t242\t242\n85\t86\t243\t243\n85\t86\t244\t244\n85\t86\t245\t245\n85\t86\t246\t246\n85\t86\t247\t247\n85\t86\t248\t248\n85\t86\t249\t249\n85\t86\t250\t250\n85\t86\t251\t251\n85\t86\t252\t252\n85\t86\t253\t253\n85\t86\t254\t254\n85\t86\t255\t255\n86\t87\t0\t0\n86\t87\t1\t1\n86\t87\t2\t2\n86\t87\t3\t3\n86\t87\t4\t4\n86\t87\t5\t5\n86\t87\t6\t6\n86\t87\t7\t7\n86\t87\t8\t8\n86\t87\t9\t9\n86\t87\t10\t10\n86\t87\t11\t11\n86\t87\t12\t12\n86\t87\t13\t13\n86\t87\t14\t14\n86\t87\t15\t15\n86\t87\t16\t16\n86\t87\t17\t17\n86\t87\t18\t18\n86\t87\t19\t19\n86\t87\t20\t20\n86\t87\t21\t21\n86\t87\t22\t22\n86\t87\t23\t23\n86\t87\t24\t24\n86\t87\t25\t25\n86\t87\t26\t26\n86\t87\t27\t27\n86\t87\t28\t28\n86\t87\t29\t29\n86\t87\t30\t30\n86\t87\t31\t31\n86\t87\t32\t32\n86\t87\t33\t33\n86\t87\t34\t34\n86\t87\t35\t35\n86\t87\t36\t36\n86\t87\t37\t37\n86\t87\t38\t38\n86\t87\t39\t39\n86\t87\t40\t40\n86\t87\t41\t41\n86\t87\t42\t42\n86\t87\t43\t43\n86\t87\t44\t44\n86\t87\t45\t45\n86\t87\t46\t46\n86\t87\t47\t47\n86\t87\t48\t48\n86\t87\t49\t49\n86\t87\t50\t50\n86\t87\t51\t51\n86\t87\t52\t52\n86\t87\t53\t53\n86\t87\t54\t54\n86\t87\t55\t55\n86\t87\t56\t56\n86\t87\t57\t57\n86\t87\t58\t58\n86\t87\t59\t59\n86\t87\t60\t60\n86\t87\t61\t61\n86\t87\t62\t62\n86\t87\t63\t63\n86\t87\t64\t64\n86\t87\t65\t65\n86\t87\t66\t66\n86\t87\t67\t67\n86\t87\t68\t68\n86\t87\t69\t69\n86\t87\t70\t70\n86\t87\t71\t71\n86\t87\t72\t72\n86\t87\t73\t73\n86\t87\t74\t74\n86\t87\t75\t75\n86\t87\t76\t76\n86\t87\t77\t77\n86\t87\t78\t78\n86\t87\t79\t79\n86\t87\t80\t80\n86\t87\t81\t81\n86\t87\t82\t82\n86\t87\t83\t83\n86\t87\t84\t84\n86\t87\t85\t85\n86\t87\t86\t86\n86\t87\t87\t87\n86\t87\t88\t88\n86\t87\t89\t89\n86\t87\t90\t90\n86\t87\t91\t91\n86\t87\t92\t92\n86\t87\t93\t93\n86\t87\t94\t94\n86\t87\t95\t95\n86\t87\t96\t96\n86\t87\t97\t97\n86\t87\t98\t98\n86\t87\t99\t99\n86\t87\t100\t100\n86\t87\t101\t101\n86\t87\t102\t102\n86\t87\t103\t103\n86\t87\t104\t104\n86\t87\t105\t105\n86\t87\t106\t106\n86\t87\t107\t107\n86\t87\t108\t108\n86\t87\t109\t109\n86\t87\t110\t110\n86\t87\t111\t111\n86\t87\t112\t112\n86\t87\t113\t113\n86\t87\t114\t114\n86\t87\t115\t115\n86\t87\t116\t116\n86\t87\t117\t117\n86\t87\t118\t118\n86\t87\t119\t119\n86\t87\t120\t120\n86\t87\t121\t121\n86\t87\t122\t122\n86\t87\t123\t123\n86\t87\t124\t124\n86\t87\t125\t125\n86\t87\t126\t126\n86\t87\t127\t127\n86\t87\t128\t128\n86\t87\t129\t129\n86\t87\t130\t130\n86\t87\t131\t131\n86\t87\t132\t132\n86\t87\t133\t133\n86\t87\t134\t134\n86\t87\t135\t135\n86\t87\t136\t136\n86\t87\t137\t137\n86\t87\t138\t138\n86\t87\t139\t139\n86\t87\t140\t140\n86\t87\t141\t141\n86\t87\t142\t142\n86\t87\t143\t143\n86\t87\t144\t144\n86\t87\t145\t145\n86\t87\t146\t146\n86\t87\t147\t147\n86\t87\t148\t148\n86\t87\t149\t149\n86\t87\t150\t150\n86\t87\t151\t151\n86\t87\t152\t152\n86\t87\t153\t153\n86\t87\t154\t154\n86\t87\t155\t155\n86\t87\t156\t156\n86\t87\t157\t157\n86\t87\t158\t158\n86\t87\t159\t159\n86\t87\t160\t160\n86\t87\t161\t161\n86\t87\t162\t162\n86\t87\t163\t163\n86\t87\t164\t164\n86\t87\t165\t165\n86\t87\t166\t166\n86\t87\t167\t167\n86\t87\t168\t168\n86\t87\t169\t169\n86\t87\t170\t170\n86\t87\t171\t171\n86\t87\t172\t172\n86\t87\t173\t173\n86\t87\t174\t174\n86\t87\t175\t175\n86\t87\t176\t176\n86\t87\t177\t177\n86\t87\t178\t178\n86\t87\t179\t179\n86\t87\t180\t180\n86\t87\t181\t181\n86\t87\t182\t182\n86\t87\t183\t183\n86\t87\t184\t184\n86\t87\t185\t185\n86\t87\t186\t186\n86\t87\t187\t187\n86\t87\t188\t188\n86\t87\t189\t189\n86\t87\t190\t190\n86\t87\t191\t191\n86\t87\t192\t192\n86\t87\t193\t193\n86\t87\t194\t194\n86\t87\t195\t195\n86\t87\t196\t196\n86\t87\t197\t197\n86\t87\t198\t198\n86\t87\t199\t199\n86\t87\t200\t200\n86\t87\t201\t201\n86\t87\t202\t202\n86\t87\t203\t203\n86\t87\t204\t204\n86\t87\t205\t205\n86\t87\t206\t206\n86\t87\t207\t207\n86\t87\t208\t208\n86\t87\t209\t209\n86\t87\t210\t210\n86\t87\t211\t211\n86\t87\t212\t212\n86\t87\t213\t213\n86\t87\t214\t214\n86\t87\t215\t215\n86\t87\t216\t216\n86\t87\t217\t217\n86\t87\t218\t218\n86\t87\t219\t219\n86\t87\t220\t220\n86\t87\t221\t221\n86\t87\t222\t222\n86\t87\t223\t223\n86\t87\t224\t224\n86\t87\t225\t225\n86\t87\t226\t226\n86\t87\t227\t227\n86\t87\t228\t228\n86\t87\t229\t229\n86\t87\t230\t230\n86\t87\t231\t231\n86\t87\t232\t232\n86\t87\t233\t233\n86\t87\t234\t234\n86\t87\t235\t235\n86\t87\t236\t236\n86\t87\t237\t237\n86\t87\t238\t238\n86\t87\t239\t239\n86\t87\t240\t240\n86\t87\t241\t241\n86\t87\t242\t242\n86\t87\t243\t243\n86\t87\t244\t244\n86\t87\t245\t245\n86\t87\t246\t246\n86\t87\t247\t247\n86\t87\t248\t248\n86\t87\t249\t249\n86\t87\t250\t250\n86\t87\t251\t251\n86\t87\t252\t252\n86\t87\t253\t253\n86\t87\t254\t254\n86\t87\t255\t255\n87\t88\t0\t0\n87\t88\t1\t1\n87\t88\t2\t2\n87\t88\t3\t3\n87\t88\t4\t4\n87\t88\t5\t5\n87\t88\t6\t6\n87\t88\t7\t7\n87\t88\t8\t8\n87\t88\t9\t9\n87\t88\t10\t10\n87\t88\t11\t11\n87\t88\t12\t12\n87\t88\t13\t13\n87\t88\t14\t14\n87\t88\t15\t15\n87\t88\t16\t16\n87\t88\t17\t17\n87\t88\t18\t18\n87\t88\t19\t19\n87\t88\t20\t20\n87\t88\t21\t21\n87\t88\t22\t22\n87\t88\t23\t23\n87\t88\t24\t24\n87\t88\t25\t25\n87\t88\t26\t26\n87\t88\t27\t27\n87\t88\t28\t28\n87\t88\t29\t29\n87\t88\t30\t30\n87\t88\t31\t31\n87\t88\t32\t32\n87\t88\t33\t33\n87\t88\t34\t34\n87\t88\t35\t35\n87\t88\t36\t36\n87\t88\t37\t37\n87\t88\t38\t38\n87\t88\t39\t39\n87\t88\t40\t40\n87\t88\t41\t41\n87\t88\t42\t42\n87\t88\t43\t43\n87\t88\t44\t44\n87\t88\t45\t45\n87\t88\t46\t46\n87\t88\t47\t47\n87\t88\t48\t48\n87\t88\t49\t49\n87\t88\t50\t50\n87\t88\t51\t51\n87\t88\t52\t52\n87\t88\t53\t53\n87\t88\t54\t54\n87\t88\t55\t55\n87\t88\t56\t56\n87\t88\t57\t57\n87\t88\t58\t58\n87\t88\t59\t59\n87\t88\t60\t60\n87\t88\t61\t61\n87\t88\t62\t62\n87\t88\t63\t63\n87\t88\t64\t64\n87\t88\t65\t65\n87\t88\t66\t66\n87\t88\t67\t67\n87\t88\t68\t68\n87\t88\t69\t69\n87\t88\t70\t70\n87\t88\t71\t71\n87\t88\t72\t72\n87\t88\t73\t73\n87\t88\t74\t74\n87\t88\t75\t75\n87\t88\t76\t76\n87\t88\t77\t77\n87\t88\t78\t78\n87\t88\t79\t79\n87\t88\t80\t80\n87\t88\t81\t81\n87\t88\t82\t82\n87\t88\t83\t83\n87\t88\t84\t84\n87\t88\t85\t85\n87\t88\t86\t86\n87\t88\t87\t87\n87\t88\t88\t88\n87\t88\t89\t89\n87\t88\t90\t90\n87\t88\t91\t91\n87\t88\t92\t92\n87\t88\t93\t93\n87\t88\t94\t94\n87\t88\t95\t95\n87\t88\t96\t96\n87\t88\t97\t97\n87\t88\t98\t98\n87\t88\t99\t99\n87\t88\t100\t100\n87\t88\t101\t101\n87\t88\t102\t102\n87\t88\t103\t103\n87\t88\t104\t104\n87\t88\t105\t105\n87\t88\t106\t106\n87\t88\t107\t107\n87\t88\t108\t108\n87\t88\t109\t109\n87\t88\t110\t110\n87\t88\t111\t111\n87\t88\t112\t112\n87\t88\t113\t113\n87\t88\t114\t114\n87\t88\t115\t115\n87\t88\t116\t116\n87\t88\t117\t117\n87\t88\t118\t118\n87\t88\t119\t119\n87\t88\t120\t120\n87\t88\t121\t121\n87\t88\t122\t122\n87\t88\t123\t123\n87\t88\t124\t124\n87\t88\t125\t125\n87\t88\t126\t126\n87\t88\t127\t127\n87\t88\t128\t128\n87\t88\t129\t129\n87\t88\t130\t130\n87\t88\t131\t131\n87\t88\t132\t132\n87\t88\t133\t133\n87\t88\t134\t134\n87\t88\t135\t135\n87\t88\t136\t136\n87\t88\t137\t137\n87\t88\t138\t138\n87\t88\t139\t139\n87\t88\t140\t140\n87\t88\t141\t141\n87\t88\t142\t142\n87\t88\t143\t143\n87\t88\t144\t144\n87\t88\t145\t145\n87\t88\t146\t146\n87\t88\t147\t147\n87\t88\t148\t148\n87\t88\t149\t149\n87\t88\t150\t150\n87\t88\t151\t151\n87\t88\t152\t152\n87\t88\t153\t153\n87\t88\t154\t154\n87\t88\t155\t155\n87\t88\t156\t156\n87\t88\t157\t157\n87\t88\t158\t158\n87\t88\t159\t159\n87\t88\t160\t160\n87\t88\t161\t161\n87\t88\t162\t162\n87\t88\t163\t163\n87\t88\t164\t164\n87\t88\t165\t165\n87\t88\t166\t166\n87\t88\t167\t167\n87\t88\t168\t168\n87\t88\t169\t169\n87\t88\t170\t170\n87\t88\t171\t171\n87\t88\t172\t172\n87\t88\t173\t173\n87\t88\t174\t174\n87\t88\t175\t175\n87\t88\t176\t176\n87\t88\t177\t177\n87\t88\t178\t178\n87\t88\t179\t179\n87\t88\t180\t180\n87\t88\t181\t181\n87\t88\t182\t182\n87\t88\t183\t183\n87\t88\t184\t184\n87\t88\t185\t185\n87\t88\t186\t186\n87\t88\t187\t187\n87\t88\t188\t188\n87\t88\t189\t189\n87\t88\t190\t190\n87\t88\t191\t191\n87\t88\t192\t192\n87\t88\t193\t193\n87\t88\t194\t194\n87\t88\t195\t195\n87\t88\t196\t196\n87\t88\t197\t197\n87\t88\t198\t198\n87\t88\t199\t199\n87\t88\t200\t200\n87\t88\t201\t201\n87\t88\t202\t202\n87\t88\t203\t203\n87\t88\t204\t204\n87\t88\t205\t205\n87\t88\t206\t206\n87\t88\t207\t207\n87\t88\t208\t208\n87\t88\t209\t209\n87\t88\t210\t210\n87\t88\t211\t211\n87\t88\t212\t212\n87\t88\t213\t213\n87\t88\t214\t214\n87\t88\t215\t215\n87\t88\t216\t216\n87\t88\t217\t217\n87\t88\t218\t218\n87\t88\t219\t219\n87\t88\t220\t220\n87\t88\t221\t221\n87\t88\t222\t222\n87\t88\t223\t223\n87\t88\t224\t224\n87\t88\t225\t225\n87\t88\t226\t226\n87\t88\t227\t227\n87\t88\t228\t228\n87\t88\t229\t229\n87\t88\t230\t230\n87\t88\t231\t231\n87\t88\t232\t232\n87\t88\t233\t233\n87\t88\t234\t234\n87\t88\t235\t235\n87\t88\t236\t236\n87\t88\t237\t237\n87\t88\t238\t238\n87\t88\t239\t239\n87\t88\t240\t240\n87\t88\t241\t241\n87\t88\t242\t242\n87\t88\t243\t243\n87\t88\t244\t244\n87\t88\t245\t245\n87\t88\t246\t246\n87\t88\t247\t247\n87\t88\t248\t248\n87\t88\t249\t249\n87\t88\t250\t250\n87\t88\t251\t251\n87\t88\t252\t252\n87\t88\t253\t253\n87\t88\t254\t254\n87\t88\t255\t255\n88\t89\t0\t0\n88\t89\t1\t1\n88\t89\t2\t2\n88\t89\t3\t3\n88\t89\t4\t4\n88\t89\t5\t5\n88\t89\t6\t6\n88\t89\t7\t7\n88\t89\t8\t8\n88\t89\t9\t9\n88\t89\t10\t10\n88\t89\t11\t11\n88\t89\t12\t12\n88\t89\t13\t13\n88\t89\t14\t14\n88\t89\t15\t15\n88\t89\t16\t16\n88\t89\t17\t17\n88\t89\t18\t18\n88\t89\t19\t19\n88\t89\t20\t20\n88\t89\t21\t21\n88\t89\t22\t22\n88\t89\t23\t23\n88\t89\t24\t24\n88\t89\t25\t25\n88\t89\t26\t26\n88\t89\t27\t27\n88\t89\t28\t28\n88\t89\t29\t29\n88\t89\t30\t30\n88\t89\t31\t31\n88\t89\t32\t32\n88\t89\t33\t33\n88\t89\t34\t34\n88\t89\t35\t35\n88\t89\t36\t36\n88\t89\t37\t37\n88\t89\t38\t38\n88\t89\t39\t39\n88\t89\t40\t40\n88\t89\t41\t41\n88\t89\t42\t42\n88\t89\t43\t43\n88\t89\t44\t44\n88\t89\t45\t45\n88\t89\t46\t46\n88\t89\t47\t47\n88\t89\t48\t48\n88\t89\t49\t49\n88\t89\t50\t50\n88\t89\t51\t51\n88\t89\t52\t52\n88\t89\t53\t53\n88\t89\t54\t54\n88\t89\t55\t55\n88\t89\t56\t56\n88\t89\t57\t57\n88\t89\t58\t58\n88\t89\t59\t59\n88\t89\t60\t60\n88\t89\t61\t61\n88\t89\t62\t62\n88\t89\t63\t63\n88\t89\t64\t64\n88\t89\t65\t65\n88\t89\t66\t66\n88\t89\t67\t67\n88\t89\t68\t68\n88\t89\t69\t69\n88\t89\t70\t70\n88\t89\t71\t71\n88\t89\t72\t72\n88\t89\t73\t73\n88\t89\t74\t74\n88\t89\t75\t75\n88\t89\t76\t76\n88\t89\t77\t77\n88\t89\t78\t78\n88\t89\t79\t79\n88\t89\t80\t80\n88\t89\t81\t81\n88\t89\t82\t82\n88\t89\t83\t83\n88\t89\t84\t84\n88\t89\t85\t85\n88\t89\t86\t86\n88\t89\t87\t87\n88\t89\t88\t88\n88\t89\t89\t89\n88\t89\t90\t90\n88\t89\t91\t91\n88\t89\t92\t92\n88\t89\t93\t93\n88\t89\t94\t94\n88\t89\t95\t95\n88\t89\t96\t96\n88\t89\t97\t97\n88\t89\t98\t98\n88\t89\t99\t99\n88\t89\t100\t100\n88\t89\t101\t101\n88\t89\t102\t102\n88\t89\t103\t103\n88\t89\t104\t104\n88\t89\t105\t105\n88\t89\t106\t106\n88\t89\t107\t107\n88\t89\t108\t108\n88\t89\t109\t109\n88\t89\t110\t110\n88\t89\t111\t111\n88\t89\t112\t112\n88\t89\t113\t113\n88\t89\t114\t114\n88\t89\t115\t115\n88\t89\t116\t116\n88\t89\t117\t117\n88\t89\t118\t118\n88\t89\t119\t119\n88\t89\t120\t120\n88\t89\t121\t121\n88\t89\t122\t122\n88\t89\t123\t123\n88\t89\t124\t124\n88\t89\t125\t125\n88\t89\t126\t126\n88\t89\t127\t127\n88\t89\t128\t128\n88\t89\t129\t129\n88\t89\t130\t130\n88\t89\t131\t131\n88\t89\t132\t132\n88\t89\t133\t133\n88\t89\t134\t134\n88\t89\t135\t135\n88\t89\t136\t136\n88\t89\t137\t137\n88\t89\t138\t138\n88\t89\t139\t139\n88\t89\t140\t140\n88\t89\t141\t141\n88\t89\t142\t142\n88\t89\t143\t143\n88\t89\t144\t144\n88\t89\t145\t145\n88\t89\t146\t146\n88\t89\t147\t147\n88\t89\t148\t148\n88\t89\t149\t149\n88\t89\t150\t150\n88\t89\t151\t151\n88\t89\t152\t152\n88\t89\t153\t153\n88\t89\t154\t154\n88\t89\t155\t155\n88\t89\t156\t156\n88\t89\t157\t157\n88\t89\t158\t158\n88\t89\t159\t159\n88\t89\t160\t160\n88\t89\t161\t161\n88\t89\t162\t162\n88\t89\t163\t163\n88\t89\t164\t164\n88\t89\t165\t165\n88\t89\t166\t166\n88\t89\t167\t167\n88\t89\t168\t168\n88\t89\t169\t169\n88\t89\t170\t170\n88\t89\t171\t171\n88\t89\t172\t172\n88\t89\t173\t173\n88\t89\t174\t174\n88\t89\t175\t175\n88\t89\t176\t176\n88\t89\t177\t177\n88\t89\t178\t178\n88\t89\t179\t179\n88\t89\t180\t180\n88\t89\t181\t181\n88\t89\t182\t182\n88\t89\t183\t183\n88\t89\t184\t184\n88\t89\t185\t185\n88\t89\t186\t186\n88\t89\t187\t187\n88\t89\t188\t188\n88\t89\t189\t189\n88\t89\t190\t190\n88\t89\t191\t191\n88\t89\t192\t192\n88\t89\t193\t193\n88\t89\t194\t194\n88\t89\t195\t195\n88\t89\t196\t196\n88\t89\t197\t197\n88\t89\t198\t198\n88\t89\t199\t199\n88\t89\t200\t200\n88\t89\t201\t201\n88\t89\t202\t202\n88\t89\t203\t203\n88\t89\t204\t204\n88\t89\t205\t205\n88\t89\t206\t206\n88\t89\t207\t207\n88\t89\t208\t208\n88\t89\t209\t209\n88\t89\t210\t210\n88\t89\t211\t211\n88\t89\t212\t212\n88\t89\t213\t213\n88\t89\t214\t214\n88\t89\t215\t215\n88\t89\t216\t216\n88\t89\t217\t217\n88\t89\t218\t218\n88\t89\t219\t219\n88\t89\t220\t220\n88\t89\t221\t221\n88\t89\t222\t222\n88\t89\t223\t223\n88\t89\t224\t224\n88\t89\t225\t225\n88\t89\t226\t226\n88\t89\t227\t227\n88\t89\t228\t228\n88\t89\t229\t229\n88\t89\t230\t230\n88\t89\t231\t231\n88\t89\t232\t232\n88\t89\t233\t233\n88\t89\t234\t234\n88\t89\t235\t235\n88\t89\t236\t236\n88\t89\t237\t237\n88\t89\t238\t238\n88\t89\t239\t239\n88\t89\t240\t240\n88\t89\t241\t241\n88\t89\t242\t242\n88\t89\t243\t243\n88\t89\t244\t244\n88\t89\t245\t245\n88\t89\t246\t246\n88\t89\t247\t247\n88\t89\t248\t248\n88\t89\t249\t249\n88\t89\t250\t250\n88\t89\t251\t251\n88\t89\t252\t252\n88\t89\t253\t253\n88\t89\t254\t254\n88\t89\t255\t255\n89\t90\t0\t0\n89\t90\t1\t1\n89\t90\t2\t2\n89\t90\t3\t3\n89\t90\t4\t4\n89\t90\t5\t5\n89\t90\t6\t6\n89\t90\t7\t7\n89\t90\t8\t8\n89\t90\t9\t9\n89\t90\t10\t10\n89\t90\t11\t11\n89\t90\t12\t12\n89\t90\t13\t13\n89\t90\t14\t14\n89\t90\t15\t15\n89\t90\t16\t16\n89\t90\t17\t17\n89\t90\t18\t18\n89\t90\t19\t19\n89\t90\t20\t20\n89\t90\t21\t21\n89\t90\t22\t22\n89\t90\t23\t23\n89\t90\t24\t24\n89\t90\t25\t25\n89\t90\t26\t26\n89\t90\t27\t27\n89\t90\t28\t28\n89\t90\t29\t29\n89\t90\t30\t30\n89\t90\t31\t31\n89\t90\t32\t32\n89\t90\t33\t33\n89\t90\t34\t34\n89\t90\t35\t35\n89\t90\t36\t36\n89\t90\t37\t37\n89\t90\t38\t38\n89\t90\t39\t39\n89\t90\t40\t40\n89\t90\t41\t41\n89\t90\t42\t42\n89\t90\t43\t43\n89\t90\t44\t44\n89\t90\t45\t45\n89\t90\t46\t46\n89\t90\t47\t47\n89\t90\t48\t48\n89\t90\t49\t49\n89\t90\t50\t50\n89\t90\t51\t51\n89\t90\t52\t52\n89\t90\t53\t53\n89\t90\t54\t54\n89\t90\t55\t55\n89\t90\t56\t56\n89\t90\t57\t57\n89\t90\t58\t58\n89\t90\t59\t59\n89\t90\t60\t60\n89\t90\t61\t61\n89\t90\t62\t62\n89\t90\t63\t63\n89\t90\t64\t64\n89\t90\t65\t65\n89\t90\t66\t66\n89\t90\t67\t67\n89\t90\t68\t68\n89\t90\t69\t69\n89\t90\t70\t70\n89\t90\t71\t71\n89\t90\t72\t72\n89\t90\t73\t73\n89\t90\t74\t74\n89\t90\t75\t75\n89\t90\t76\t76\n89\t90\t77\t77\n89\t90\t78\t78\n89\t90\t79\t79\n89\t90\t80\t80\n89\t90\t81\t81\n89\t90\t82\t82\n89\t90\t83\t83\n89\t90\t84\t84\n89\t90\t85\t85\n89\t90\t86\t86\n89\t90\t87\t87\n89\t90\t88\t88\n89\t90\t89\t89\n89\t90\t90\t90\n89\t90\t91\t91\n89\t90\t92\t92\n89\t90\t93\t93\n89\t90\t94\t94\n89\t90\t95\t95\n89\t90\t96\t96\n89\t90\t97\t97\n89\t90\t98\t98\n89\t90\t99\t99\n89\t90\t100\t100\n89\t90\t101\t101\n89\t90\t102\t102\n89\t90\t103\t103\n89\t90\t104\t104\n89\t90\t105\t105\n89\t90\t106\t106\n89\t90\t107\t107\n89\t90\t108\t108\n89\t90\t109\t109\n89\t90\t110\t110\n89\t90\t111\t111\n89\t90\t112\t112\n89\t90\t113\t113\n89\t90\t114\t114\n89\t90\t115\t115\n89\t90\t116\t116\n89\t90\t117\t117\n89\t90\t118\t118\n89\t90\t119\t119\n89\t90\t120\t120\n89\t90\t121\t121\n89\t90\t122\t122\n89\t90\t123\t123\n89\t90\t124\t124\n89\t90\t125\t125\n89\t90\t126\t126\n89\t90\t127\t127\n89\t90\t128\t128\n89\t90\t129\t129\n89\t90\t130\t130\n89\t90\t131\t131\n89\t90\t132\t132\n89\t90\t133\t133\n89\t90\t134\t134\n89\t90\t135\t135\n89\t90\t136\t136\n89\t90\t137\t137\n89\t90\t138\t138\n89\t90\t139\t139\n89\t90\t140\t140\n89\t90\t141\t141\n89\t90\t142\t142\n89\t90\t143\t143\n89\t90\t144\t144\n89\t90\t145\t145\n89\t90\t146\t146\n89\t90\t147\t147\n89\t90\t148\t148\n89\t90\t149\t149\n89\t90\t150\t150\n89\t90\t151\t151\n89\t90\t152\t152\n89\t90\t153\t153\n89\t90\t154\t154\n89\t90\t155\t155\n89\t90\t156\t156\n89\t90\t157\t157\n89\t90\t158\t158\n89\t90\t159\t159\n89\t90\t160\t160\n89\t90\t161\t161\n89\t90\t162\t162\n89\t90\t163\t163\n89\t90\t164\t164\n89\t90\t165\t165\n89\t90\t166\t166\n89\t90\t167\t167\n89\t90\t168\t168\n89\t90\t169\t169\n89\t90\t170\t170\n89\t90\t171\t171\n89\t90\t172\t172\n89\t90\t173\t173\n89\t90\t174\t174\n89\t90\t175\t175\n89\t90\t176\t176\n89\t90\t177\t177\n89\t90\t178\t178\n89\t90\t179\t179\n89\t90\t180\t180\n89\t90\t181\t181\n89\t90\t182\t182\n89\t90\t183\t183\n89\t90\t184\t184\n89\t90\t185\t185\n89\t90\t186\t186\n89\t90\t187\t187\n89\t90\t188\t188\n89\t90\t189\t189\n89\t90\t190\t190\n89\t90\t191\t191\n89\t90\t192\t192\n89\t90\t193\t193\n89\t90\t194\t194\n89\t90\t195\t195\n89\t90\t196\t196\n89\t90\t197\t197\n89\t90\t198\t198\n89\t90\t199\t199\n89\t90\t200\t200\n89\t90\t201\t201\n89\t90\t202\t202\n89\t90\t203\t203\n89\t90\t204\t204\n89\t90\t205\t205\n89\t90\t206\t206\n89\t90\t207\t207\n89\t90\t208\t208\n89\t90\t209\t209\n89\t90\t210\t210\n89\t90\t211\t211\n89\t90\t212\t212\n89\t90\t213\t213\n89\t90\t214\t214\n89\t90\t215\t215\n89\t90\t216\t216\n89\t90\t217\t217\n89\t90\t218\t218\n89\t90\t219\t219\n89\t90\t220\t220\n89\t90\t221\t221\n89\t90\t222\t222\n89\t90\t223\t223\n89\t90\t224\t224\n89\t90\t225\t225\n89\t90\t226\t226\n89\t90\t227\t227\n89\t90\t228\t228\n89\t90\t229\t229\n89\t90\t230\t230\n89\t90\t231\t231\n89\t90\t232\t232\n89\t90\t233\t233\n89\t90\t234\t234\n89\t90\t235\t235\n89\t90\t236\t236\n89\t90\t237\t237\n89\t90\t238\t238\n89\t90\t239\t239\n89\t90\t240\t240\n89\t90\t241\t241\n89\t90\t242\t242\n89\t90\t243\t243\n89\t90\t244\t244\n89\t90\t245\t245\n89\t90\t246\t246\n89\t90\t247\t247\n89\t90\t248\t248\n89\t90\t249\t249\n89\t90\t250\t250\n89\t90\t251\t251\n89\t90\t252\t252\n89\t90\t253\t253\n89\t90\t254\t254\n89\t90\t255\t255\n90\t91\t0\t0\n90\t91\t1\t1\n90\t91\t2\t2\n90\t91\t3\t3\n90\t91\t4\t4\n90\t91\t5\t5\n90\t91\t6\t6\n90\t91\t7\t7\n90\t91\t8\t8\n90\t91\t9\t9\n90\t91\t10\t10\n90\t91\t11\t11\n90\t91\t12\t12\n90\t91\t13\t13\n90\t91\t14\t14\n90\t91\t15\t15\n90\t91\t16\t16\n90\t91\t17\t17\n90\t91\t18\t18\n90\t91\t19\t19\n90\t91\t20\t20\n90\t91\t21\t21\n90\t91\t22\t22\n90\t91\t23\t23\n90\t91\t24\t24\n90\t91\t25\t25\n90\t91\t26\t26\n90\t91\t27\t27\n90\t91\t28\t28\n90\t91\t29\t29\n90\t91\t30\t30\n90\t91\t31\t31\n90\t91\t32\t32\n90\t91\t33\t33\n90\t91\t34\t34\n90\t91\t35\t35\n90\t91\t36\t36\n90\t91\t37\t37\n90\t91\t38\t38\n90\t91\t39\t39\n90\t91\t40\t40\n90\t91\t41\t41\n90\t91\t42\t42\n90\t91\t43\t43\n90\t91\t44\t44\n90\t91\t45\t45\n90\t91\t46\t46\n90\t91\t47\t47\n90\t91\t48\t48\n90\t91\t49\t49\n90\t91\t50\t50\n90\t91\t51\t51\n90\t91\t52\t52\n90\t91\t53\t53\n90\t91\t54\t54\n90\t91\t55\t55\n90\t91\t56\t56\n90\t91\t57\t57\n90\t91\t58\t58\n90\t91\t59\t59\n90\t91\t60\t60\n90\t91\t61\t61\n90\t91\t62\t62\n90\t91\t63\t63\n90\t91\t64\t64\n90\t91\t65\t65\n90\t91\t66\t66\n90\t91\t67\t67\n90\t91\t68\t68\n90\t91\t69\t69\n90\t91\t70\t70\n90\t91\t71\t71\n90\t91\t72\t72\n90\t91\t73\t73\n90\t91\t74\t74\n90\t91\t75\t75\n90\t91\t76\t76\n90\t91\t77\t77\n90\t91\t78\t78\n90\t91\t79\t79\n90\t91\t80\t80\n90\t91\t81\t81\n90\t91\t82\t82\n90\t91\t83\t83\n90\t91\t84\t84\n90\t91\t85\t85\n90\t91\t86\t86\n90\t91\t87\t87\n90\t91\t88\t88\n90\t91\t89\t89\n90\t91\t90\t90\n90\t91\t91\t91\n90\t91\t92\t92\n90\t91\t93\t93\n90\t91\t94\t94\n90\t91\t95\t95\n90\t91\t96\t96\n90\t91\t97\t97\n90\t91\t98\t98\n90\t91\t99\t99\n90\t91\t100\t100\n90\t91\t101\t101\n90\t91\t102\t102\n90\t91\t103\t103\n90\t91\t104\t104\n90\t91\t105\t105\n90\t91\t106\t106\n90\t91\t107\t107\n90\t91\t108\t108\n90\t91\t109\t109\n90\t91\t110\t110\n90\t91\t111\t111\n90\t91\t112\t112\n90\t91\t113\t113\n90\t91\t114\t114\n90\t91\t115\t115\n90\t91\t116\t116\n90\t91\t117\t117\n90\t91\t118\t118\n90\t91\t119\t119\n90\t91\t120\t120\n90\t91\t121\t121\n90\t91\t122\t122\n90\t91\t123\t123\n90\t91\t124\t124\n90\t91\t125\t125\n90\t91\t126\t126\n90\t91\t127\t127\n90\t91\t128\t128\n90\t91\t129\t129\n90\t91\t130\t130\n90\t91\t131\t131\n90\t91\t132\t132\n90\t91\t133\t133\n90\t91\t134\t134\n90\t91\t135\t135\n90\t91\t136\t136\n90\t91\t137\t137\n90\t91\t138\t138\n90\t91\t139\t139\n90\t91\t140\t140\n90\t91\t141\t141\n90\t91\t142\t142\n90\t91\t143\t143\n90\t91\t144\t144\n90\t91\t145\t145\n90\t91\t146\t146\n90\t91\t147\t147\n90\t91\t148\t148\n90\t91\t149\t149\n90\t91\t150\t150\n90\t91\t151\t151\n90\t91\t152\t152\n90\t91\t153\t153\n90\t91\t154\t154\n90\t91\t155\t155\n90\t91\t156\t156\n90\t91\t157\t157\n90\t91\t158\t158\n90\t91\t159\t159\n90\t91\t160\t160\n90\t91\t161\t161\n90\t91\t162\t162\n90\t91\t163\t163\n90\t91\t164\t164\n90\t91\t165\t165\n90\t91\t166\t166\n90\t91\t167\t167\n90\t91\t168\t168\n90\t91\t169\t169\n90\t91\t170\t170\n90\t91\t171\t171\n90\t91\t172\t172\n90\t91\t173\t173\n90\t91\t174\t174\n90\t91\t175\t175\n90\t91\t176\t176\n90\t91\t177\t177\n90\t91\t178\t178\n90\t91\t179\t179\n90\t91\t180\t180\n90\t91\t181\t181\n90\t91\t182\t182\n90\t91\t183\t183\n90\t91\t184\t184\n90\t91\t185\t185\n90\t91\t186\t186\n90\t91\t187\t187\n90\t91\t188\t188\n90\t91\t189\t189\n90\t91\t190\t190\n90\t91\t191\t191\n90\t91\t192\t192\n90\t91\t193\t193\n90\t91\t194\t194\n90\t91\t195\t195\n90\t91\t196\t196\n90\t91\t197\t197\n90\t91\t198\t198\n90\t91\t199\t199\n90\t91\t200\t200\n90\t91\t201\t201\n90\t91\t202\t202\n90\t91\t203\t203\n90\t91\t204\t204\n90\t91\t205\t205\n90\t91\t206\t206\n90\t91\t207\t207\n90\t91\t208\t208\n90\t91\t209\t209\n90\t91\t210\t210\n90\t91\t211\t211\n90\t91\t212\t212\n90\t91\t213\t213\n90\t91\t214\t214\n90\t91\t215\t215\n90\t91\t216\t216\n90\t91\t217\t217\n90\t91\t218\t218\n90\t91\t219\t219\n90\t91\t220\t220\n90\t91\t221\t221\n90\t91\t222\t222\n90\t91\t223\t223\n90\t91\t224\t224\n90\t91\t225\t225\n90\t91\t226\t226\n90\t91\t227\t227\n90\t91\t228\t228\n90\t91\t229\t229\n90\t91\t230\t230\n90\t91\t231\t231\n90\t91\t232\t232\n90\t91\t233\t233\n90\t91\t234\t234\n90\t91\t235\t235\n90\t91\t236\t236\n90\t91\t237\t237\n90\t91\t238\t238\n90\t91\t239\t239\n90\t91\t240\t240\n90\t91\t241\t241\n90\t91\t242\t242\n90\t91\t243\t243\n90\t91\t244\t244\n90\t91\t245\t245\n90\t91\t246\t246\n90\t91\t247\t247\n90\t91\t248\t248\n90\t91\t249\t249\n90\t91\t250\t250\n90\t91\t251\t251\n90\t91\t252\t252\n90\t91\t253\t253\n90\t91\t254\t254\n90\t91\t255\t255\n91\t92\t0\t0\n91\t92\t1\t1\n91\t92\t2\t2\n91\t92\t3\t3\n91\t92\t4\t4\n91\t92\t5\t5\n91\t92\t6\t6\n91\t92\t7\t7\n91\t92\t8\t8\n91\t92\t9\t9\n91\t92\t10\t10\n91\t92\t11\t11\n91\t92\t12\t12\n91\t92\t13\t13\n91\t92\t14\t14\n91\t92\t15\t15\n91\t92\t16\t16\n91\t92\t17\t17\n91\t92\t18\t18\n91\t92\t19\t19\n91\t92\t20\t20\n91\t92\t21\t21\n91\t92\t22\t22\n91\t92\t23\t23\n91\t92\t24\t24\n91\t92\t25\t25\n91\t92\t26\t26\n91\t92\t27\t27\n91\t92\t28\t28\n91\t92\t29\t29\n91\t92\t30\t30\n91\t92\t31\t31\n91\t92\t32\t32\n91\t92\t33\t33\n91\t92\t34\t34\n91\t92\t35\t35\n91\t92\t36\t36\n91\t92\t37\t37\n91\t92\t38\t38\n91\t92\t39\t39\n91\t92\t40\t40\n91\t92\t41\t41\n91\t92\t42\t42\n91\t92\t43\t43\n91\t92\t44\t44\n91\t92\t45\t45\n91\t92\t46\t46\n91\t92\t47\t47\n91\t92\t48\t48\n91\t92\t49\t49\n91\t92\t50\t50\n91\t92\t51\t51\n91\t92\t52\t52\n91\t92\t53\t53\n91\t92\t54\t54\n91\t92\t55\t55\n91\t92\t56\t56\n91\t92\t57\t57\n91\t92\t58\t58\n91\t92\t59\t59\n91\t92\t60\t60\n91\t92\t61\t61\n91\t92\t62\t62\n91\t92\t63\t63\n91\t92\t64\t64\n91\t92\t65\t65\n91\t92\t66\t66\n91\t92\t67\t67\n91\t92\t68\t68\n91\t92\t69\t69\n91\t92\t70\t70\n91\t92\t71\t71\n91\t92\t72\t72\n91\t92\t73\t73\n91\t92\t74\t74\n91\t92\t75\t75\n91\t92\t76\t76\n91\t92\t77\t77\n91\t92\t78\t78\n91\t92\t79\t79\n91\t92\t80\t80\n91\t92\t81\t81\n91\t92\t82\t82\n91\t92\t83\t83\n91\t92\t84\t84\n91\t92\t85\t85\n91\t92\t86\t86\n91\t92\t87\t87\n91\t92\t88\t88\n91\t92\t89\t89\n91\t92\t90\t90\n91\t92\t91\t91\n91\t92\t92\t92\n91\t92\t93\t93\n91\t92\t94\t94\n91\t92\t95\t95\n91\t92\t96\t96\n91\t92\t97\t97\n91\t92\t98\t98\n91\t92\t99\t99\n91\t92\t100\t100\n91\t92\t101\t101\n91\t92\t102\t102\n91\t92\t103\t103\n91\t92\t104\t104\n91\t92\t105\t105\n91\t92\t106\t106\n91\t92\t107\t107\n91\t92\t108\t108\n91\t92\t109\t109\n91\t92\t110\t110\n91\t92\t111\t111\n91\t92\t112\t112\n91\t92\t113\t113\n91\t92\t114\t114\n91\t92\t115\t115\n91\t92\t116\t116\n91\t92\t117\t117\n91\t92\t118\t118\n91\t92\t119\t119\n91\t92\t120\t120\n91\t92\t121\t121\n91\t92\t122\t122\n91\t92\t123\t123\n91\t92\t124\t124\n91\t92\t125\t125\n91\t92\t126\t126\n91\t92\t127\t127\n91\t92\t128\t128\n91\t92\t129\t129\n91\t92\t130\t130\n91\t92\t131\t131\n91\t92\t132\t132\n91\t92\t133\t133\n91\t92\t134\t134\n91\t92\t135\t135\n91\t92\t136\t136\n91\t92\t137\t137\n91\t92\t138\t138\n91\t92\t139\t139\n91\t92\t140\t140\n91\t92\t141\t141\n91\t92\t142\t142\n91\t92\t143\t143\n91\t92\t144\t144\n91\t92\t145\t145\n91\t92\t146\t146\n91\t92\t147\t147\n91\t92\t148\t148\n91\t92\t149\t149\n91\t92\t150\t150\n91\t92\t151\t151\n91\t92\t152\t152\n91\t92\t153\t153\n91\t92\t154\t154\n91\t92\t155\t155\n91\t92\t156\t156\n91\t92\t157\t157\n91\t92\t158\t158\n91\t92\t159\t159\n91\t92\t160\t160\n91\t92\t161\t161\n91\t92\t162\t162\n91\t92\t163\t163\n91\t92\t164\t164\n91\t92\t165\t165\n91\t92\t166\t166\n91\t92\t167\t167\n91\t92\t168\t168\n91\t92\t169\t169\n91\t92\t170\t170\n91\t92\t171\t171\n91\t92\t172\t172\n91\t92\t173\t173\n91\t92\t174\t174\n91\t92\t175\t175\n91\t92\t176\t176\n91\t92\t177\t177\n91\t92\t178\t178\n91\t92\t179\t179\n91\t92\t180\t180\n91\t92\t181\t181\n91\t92\t182\t182\n91\t92\t183\t183\n91\t92\t184\t184\n91\t92\t185\t185\n91\t92\t186\t186\n91\t92\t187\t187\n91\t92\t188\t188\n91\t92\t189\t189\n91\t92\t190\t190\n91\t92\t191\t191\n91\t92\t192\t192\n91\t92\t193\t193\n91\t92\t194\t194\n91\t92\t195\t195\n91\t92\t196\t196\n91\t92\t197\t197\n91\t92\t198\t198\n91\t92\t199\t199\n91\t92\t200\t200\n91\t92\t201\t201\n91\t92\t202\t202\n91\t92\t203\t203\n91\t92\t204\t204\n91\t92\t205\t205\n91\t92\t206\t206\n91\t92\t207\t207\n91\t92\t208\t208\n91\t92\t209\t209\n91\t92\t210\t210\n91\t92\t211\t211\n91\t92\t212\t212\n91\t92\t213\t213\n91\t92\t214\t214\n91\t92\t215\t215\n91\t92\t216\t216\n91\t92\t217\t217\n91\t92\t218\t218\n91\t92\t219\t219\n91\t92\t220\t220\n91\t92\t221\t221\n91\t92\t222\t222\n91\t92\t223\t223\n91\t92\t224\t224\n91\t92\t225\t225\n91\t92\t226\t226\n91\t92\t227\t227\n91\t92\t228\t228\n91\t92\t229\t229\n91\t92\t230\t230\n91\t92\t231\t231\n91\t92\t232\t232\n91\t92\t233\t233\n91\t92\t234\t234\n91\t92\t235\t235\n91\t92\t236\t236\n91\t92\t237\t237\n91\t92\t238\t238\n91\t92\t239\t239\n91\t92\t240\t240\n91\t92\t241\t241\n91\t92\t242\t242\n91\t92\t243\t243\n91\t92\t244\t244\n91\t92\t245\t245\n91\t92\t246\t246\n91\t92\t247\t247\n91\t92\t248\t248\n91\t92\t249\t249\n91\t92\t250\t250\n91\t92\t251\t251\n91\t92\t252\t252\n91\t92\t253\t253\n91\t92\t254\t254\n91\t92\t255\t255\n92\t93\t0\t0\n92\t93\t1\t1\n92\t93\t2\t2\n92\t93\t3\t3\n92\t93\t4\t4\n92\t93\t5\t5\n92\t93\t6\t6\n92\t93\t7\t7\n92\t93\t8\t8\n92\t93\t9\t9\n92\t93\t10\t10\n92\t93\t11\t11\n92\t93\t12\t12\n92\t93\t13\t13\n92\t93\t14\t14\n92\t93\t15\t15\n92\t93\t16\t16\n92\t93\t17\t17\n92\t93\t18\t18\n92\t93\t19\t19\n92\t93\t20\t20\n92\t93\t21\t21\n92\t93\t22\t22\n92\t93\t23\t23\n92\t93\t24\t24\n92\t93\t25\t25\n92\t93\t26\t26\n92\t93\t27\t27\n92\t93\t28\t28\n92\t93\t29\t29\n92\t93\t30\t30\n92\t93\t31\t31\n92\t93\t32\t32\n92\t93\t33\t33\n92\t93\t34\t34\n92\t93\t35\t35\n92\t93\t36\t36\n92\t93\t37\t37\n92\t93\t38\t38\n92\t93\t39\t39\n92\t93\t40\t40\n92\t93\t41\t41\n92\t93\t42\t42\n92\t93\t43\t43\n92\t93\t44\t44\n92\t93\t45\t45\n92\t93\t46\t46\n92\t93\t47\t47\n92\t93\t48\t48\n92\t93\t49\t49\n92\t93\t50\t50\n92\t93\t51\t51\n92\t93\t52\t52\n92\t93\t53\t53\n92\t93\t54\t54\n92\t93\t55\t55\n92\t93\t56\t56\n92\t93\t57\t57\n92\t93\t58\t58\n92\t93\t59\t59\n92\t93\t60\t60\n92\t93\t61\t61\n92\t93\t62\t62\n92\t93\t63\t63\n92\t93\t64\t64\n92\t93\t65\t65\n92\t93\t66\t66\n92\t93\t67\t67\n92\t93\t68\t68\n92\t93\t69\t69\n92\t93\t70\t70\n92\t93\t71\t71\n92\t93\t72\t72\n92\t93\t73\t73\n92\t93\t74\t74\n92\t93\t75\t75\n92\t93\t76\t76\n92\t93\t77\t77\n92\t93\t78\t78\n92\t93\t79\t79\n92\t93\t80\t80\n92\t93\t81\t81\n92\t93\t82\t82\n92\t93\t83\t83\n92\t93\t84\t84\n92\t93\t85\t85\n92\t93\t86\t86\n92\t93\t87\t87\n92\t93\t88\t88\n92\t93\t89\t89\n92\t93\t90\t90\n92\t93\t91\t91\n92\t93\t92\t92\n92\t93\t93\t93\n92\t93\t94\t94\n92\t93\t95\t95\n92\t93\t96\t96\n92\t93\t97\t97\n92\t93\t98\t98\n92\t93\t99\t99\n92\t93\t100\t100\n92\t93\t101\t101\n92\t93\t102\t102\n92\t93\t103\t103\n92\t93\t104\t104\n92\t93\t105\t105\n92\t93\t106\t106\n92\t93\t107\t107\n92\t93\t108\t108\n92\t93\t109\t109\n92\t93\t110\t110\n92\t93\t111\t111\n92\t93\t112\t112\n92\t93\t113\t113\n92\t93\t114\t114\n92\t93\t115\t115\n92\t93\t116\t116\n92\t93\t117\t117\n92\t93\t118\t118\n92\t93\t119\t119\n92\t93\t120\t120\n92\t93\t121\t121\n92\t93\t122\t122\n92\t93\t123\t123\n92\t93\t124\t124\n92\t93\t125\t125\n92\t93\t126\t126\n92\t93\t127\t127\n92\t93\t128\t128\n92\t93\t129\t129\n92\t93\t130\t130\n92\t93\t131\t131\n92\t93\t132\t132\n92\t93\t133\t133\n92\t93\t134\t134\n92\t93\t135\t135\n92\t93\t136\t136\n92\t93\t137\t137\n92\t93\t138\t138\n92\t93\t139\t139\n92\t93\t140\t140\n92\t93\t141\t141\n92\t93\t142\t142\n92\t93\t143\t143\n92\t93\t144\t144\n92\t93\t145\t145\n92\t93\t146\t146\n92\t93\t147\t147\n92\t93\t148\t148\n92\t93\t149\t149\n92\t93\t150\t150\n92\t93\t151\t151\n92\t93\t152\t152\n92\t93\t153\t153\n92\t93\t154\t154\n92\t93\t155\t155\n92\t93\t156\t156\n92\t93\t157\t157\n92\t93\t158\t158\n92\t93\t159\t159\n92\t93\t160\t160\n92\t93\t161\t161\n92\t93\t162\t162\n92\t93\t163\t163\n92\t93\t164\t164\n92\t93\t165\t165\n92\t93\t166\t166\n92\t93\t167\t167\n92\t93\t168\t168\n92\t93\t169\t169\n92\t93\t170\t170\n92\t93\t171\t171\n92\t93\t172\t172\n92\t93\t173\t173\n92\t93\t174\t174\n92\t93\t175\t175\n92\t93\t176\t176\n92\t93\t177\t177\n92\t93\t178\t178\n92\t93\t179\t179\n92\t93\t180\t180\n92\t93\t181\t181\n92\t93\t182\t182\n92\t93\t183\t183\n92\t93\t184\t184\n92\t93\t185\t185\n92\t93\t186\t186\n92\t93\t187\t187\n92\t93\t188\t188\n92\t93\t189\t189\n92\t93\t190\t190\n92\t93\t191\t191\n92\t93\t192\t192\n92\t93\t193\t193\n92\t93\t194\t194\n92\t93\t195\t195\n92\t93\t196\t196\n92\t93\t197\t197\n92\t93\t198\t198\n92\t93\t199\t199\n92\t93\t200\t200\n92\t93\t201\t201\n92\t93\t202\t202\n92\t93\t203\t203\n92\t93\t204\t204\n92\t93\t205\t205\n92\t93\t206\t206\n92\t93\t207\t207\n92\t93\t208\t208\n92\t93\t209\t209\n92\t93\t210\t210\n92\t93\t211\t211\n92\t93\t212\t212\n92\t93\t213\t213\n92\t93\t214\t214\n92\t93\t215\t215\n92\t93\t216\t216\n92\t93\t217\t217\n92\t93\t218\t218\n92\t93\t219\t219\n92\t93\t220\t220\n92\t93\t221\t221\n92\t93\t222\t222\n92\t93\t223\t223\n92\t93\t224\t224\n92\t93\t225\t225\n92\t93\t226\t226\n92\t93\t227\t227\n92\t93\t228\t228\n92\t93\t229\t229\n92\t93\t230\t230\n92\t93\t231\t231\n92\t93\t232\t232\n92\t93\t233\t233\n92\t93\t234\t234\n92\t93\t235\t235\n92\t93\t236\t236\n92\t93\t237\t237\n92\t93\t238\t238\n92\t93\t239\t239\n92\t93\t240\t240\n92\t93\t241\t241\n92\t93\t242\t242\n92\t93\t243\t243\n92\t93\t244\t244\n92\t93\t245\t245\n92\t93\t246\t246\n92\t93\t247\t247\n92\t93\t248\t248\n92\t93\t249\t249\n92\t93\t250\t250\n92\t93\t251\t251\n92\t93\t252\t252\n92\t93\t253\t253\n92\t93\t254\t254\n92\t93\t255\t255\n93\t94\t0\t0\n93\t94\t1\t1\n93\t94\t2\t2\n93\t94\t3\t3\n93\t94\t4\t4\n93\t94\t5\t5\n93\t94\t6\t6\n93\t94\t7\t7\n93\t94\t8\t8\n93\t94\t9\t9\n93\t94\t10\t10\n93\t94\t11\t11\n93\t94\t12\t12\n93\t94\t13\t13\n93\t94\t14\t14\n93\t94\t15\t15\n93\t94\t16\t16\n93\t94\t17\t17\n93\t94\t18\t18\n93\t94\t19\t19\n93\t94\t20\t20\n93\t94\t21\t21\n93\t94\t22\t22\n93\t94\t23\t23\n93\t94\t24\t24\n93\t94\t25\t25\n93\t94\t26\t26\n93\t94\t27\t27\n93\t94\t28\t28\n93\t94\t29\t29\n93\t94\t30\t30\n93\t94\t31\t31\n93\t94\t32\t32\n93\t94\t33\t33\n93\t94\t34\t34\n93\t94\t35\t35\n93\t94\t36\t36\n93\t94\t37\t37\n93\t94\t38\t38\n93\t94\t39\t39\n93\t94\t40\t40\n93\t94\t41\t41\n93\t94\t42\t42\n93\t94\t43\t43\n93\t94\t44\t44\n93\t94\t45\t45\n93\t94\t46\t46\n93\t94\t47\t47\n93\t94\t48\t48\n93\t94\t49\t49\n93\t94\t50\t50\n93\t94\t51\t51\n93\t94\t52\t52\n93\t94\t53\t53\n93\t94\t54\t54\n93\t94\t55\t55\n93\t94\t56\t56\n93\t94\t57\t57\n93\t94\t58\t58\n93\t94\t59\t59\n93\t94\t60\t60\n93\t94\t61\t61\n93\t94\t62\t62\n93\t94\t63\t63\n93\t94\t64\t64\n93\t94\t65\t65\n93\t94\t66\t66\n93\t94\t67\t67\n93\t94\t68\t68\n93\t94\t69\t69\n93\t94\t70\t70\n93\t94\t71\t71\n93\t94\t72\t72\n93\t94\t73\t73\n93\t94\t74\t74\n93\t94\t75\t75\n93\t94\t76\t76\n93\t94\t77\t77\n93\t94\t78\t78\n93\t94\t79\t79\n93\t94\t80\t80\n93\t94\t81\t81\n93\t94\t82\t82\n93\t94\t83\t83\n93\t94\t84\t84\n93\t94\t85\t85\n93\t94\t86\t86\n93\t94\t87\t87\n93\t94\t88\t88\n93\t94\t89\t89\n93\t94\t90\t90\n93\t94\t91\t91\n93\t94\t92\t92\n93\t94\t93\t93\n93\t94\t94\t94\n93\t94\t95\t95\n93\t94\t96\t96\n93\t94\t97\t97\n93\t94\t98\t98\n93\t94\t99\t99\n93\t94\t100\t100\n93\t94\t101\t101\n93\t94\t102\t102\n93\t94\t103\t103\n93\t94\t104\t104\n93\t94\t105\t105\n93\t94\t106\t106\n93\t94\t107\t107\n93\t94\t108\t108\n93\t94\t109\t109\n93\t94\t110\t110\n93\t94\t111\t111\n93\t94\t112\t112\n93\t94\t113\t113\n93\t94\t114\t114\n93\t94\t115\t115\n93\t94\t116\t116\n93\t94\t117\t117\n93\t94\t118\t118\n93\t94\t119\t119\n93\t94\t120\t120\n93\t94\t121\t121\n93\t94\t122\t122\n93\t94\t123\t123\n93\t94\t124\t124\n93\t94\t125\t125\n93\t94\t126\t126\n93\t94\t127\t127\n93\t94\t128\t128\n93\t94\t129\t129\n93\t94\t130\t130\n93\t94\t131\t131\n93\t94\t132\t132\n93\t94\t133\t133\n93\t94\t134\t134\n93\t94\t135\t135\n93\t94\t136\t136\n93\t94\t137\t137\n93\t94\t138\t138\n93\t94\t139\t139\n93\t94\t140\t140\n93\t94\t141\t141\n93\t94\t142\t142\n93\t94\t143\t143\n93\t94\t144\t144\n93\t94\t145\t145\n93\t94\t146\t146\n93\t94\t147\t147\n93\t94\t148\t148\n93\t94\t149\t149\n93\t94\t150\t150\n93\t94\t151\t151\n93\t94\t152\t152\n93\t94\t153\t153\n93\t94\t154\t154\n93\t94\t155\t155\n93\t94\t156\t156\n93\t94\t157\t157\n93\t94\t158\t158\n93\t94\t159\t159\n93\t94\t160\t160\n93\t94\t161\t161\n93\t94\t162\t162\n93\t94\t163\t163\n93\t94\t164\t164\n93\t94\t165\t165\n93\t94\t166\t166\n93\t94\t167\t167\n93\t94\t168\t168\n93\t94\t169\t169\n93\t94\t170\t170\n93\t94\t171\t171\n93\t94\t172\t172\n93\t94\t173\t173\n93\t94\t174\t174\n93\t94\t175\t175\n93\t94\t176\t176\n93\t94\t177\t177\n93\t94\t178\t178\n93\t94\t179\t179\n93\t94\t180\t180\n93\t94\t181\t181\n93\t94\t182\t182\n93\t94\t183\t183\n93\t94\t184\t184\n93\t94\t185\t185\n93\t94\t186\t186\n93\t94\t187\t187\n93\t94\t188\t188\n93\t94\t189\t189\n93\t94\t190\t190\n93\t94\t191\t191\n93\t94\t192\t192\n93\t94\t193\t193\n93\t94\t194\t194\n93\t94\t195\t195\n93\t94\t196\t196\n93\t94\t197\t197\n93\t94\t198\t198\n93\t94\t199\t199\n93\t94\t200\t200\n93\t94\t201\t201\n93\t94\t202\t202\n93\t94\t203\t203\n93\t94\t204\t204\n93\t94\t205\t205\n93\t94\t206\t206\n93\t94\t207\t207\n93\t94\t208\t208\n93\t94\t209\t209\n93\t94\t210\t210\n93\t94\t211\t211\n93\t94\t212\t212\n93\t94\t213\t213\n93\t94\t214\t214\n93\t94\t215\t215\n93\t94\t216\t216\n93\t94\t217\t217\n93\t94\t218\t218\n93\t94\t219\t219\n93\t94\t220\t220\n93\t94\t221\t221\n93\t94\t222\t222\n93\t94\t223\t223\n93\t94\t224\t224\n93\t94\t225\t225\n93\t94\t226\t226\n93\t94\t227\t227\n93\t94\t228\t228\n93\t94\t229\t229\n93\t94\t230\t230\n93\t94\t231\t231\n93\t94\t232\t232\n93\t94\t233\t233\n93\t94\t234\t234\n93\t94\t235\t235\n93\t94\t236\t236\n93\t94\t237\t237\n93\t94\t238\t238\n93\t94\t239\t239\n93\t94\t240\t240\n93\t94\t241\t241\n93\t94\t242\t242\n93\t94\t243\t243\n93\t94\t244\t244\n93\t94\t245\t245\n93\t94\t246\t246\n93\t94\t247\t247\n93\t94\t248\t248\n93\t94\t249\t249\n93\t94\t250\t250\n93\t94\t251\t251\n93\t94\t252\t252\n93\t94\t253\t253\n93\t94\t254\t254\n93\t94\t255\t255\n94\t95\t0\t0\n94\t95\t1\t1\n94\t95\t2\t2\n94\t95\t3\t3\n94\t95\t4\t4\n94\t95\t5\t5\n94\t95\t6\t6\n94\t95\t7\t7\n94\t95\t8\t8\n94\t95\t9\t9\n94\t95\t10\t10\n94\t95\t11\t11\n94\t95\t12\t12\n94\t95\t13\t13\n94\t95\t14\t14\n94\t95\t15\t15\n94\t95\t16\t16\n94\t95\t17\t17\n94\t95\t18\t18\n94\t95\t19\t19\n94\t95\t20\t20\n94\t95\t21\t21\n94\t95\t22\t22\n94\t95\t23\t23\n94\t95\t24\t24\n94\t95\t25\t25\n94\t95\t26\t26\n94\t95\t27\t27\n94\t95\t28\t28\n94\t95\t29\t29\n94\t95\t30\t30\n94\t95\t31\t31\n94\t95\t32\t32\n94\t95\t33\t33\n94\t95\t34\t34\n94\t95\t35\t35\n94\t95\t36\t36\n94\t95\t37\t37\n94\t95\t38\t38\n94\t95\t39\t39\n94\t95\t40\t40\n94\t95\t41\t41\n94\t95\t42\t42\n94\t95\t43\t43\n94\t95\t44\t44\n94\t95\t45\t45\n94\t95\t46\t46\n94\t95\t47\t47\n94\t95\t48\t48\n94\t95\t49\t49\n94\t95\t50\t50\n94\t95\t51\t51\n94\t95\t52\t52\n94\t95\t53\t53\n94\t95\t54\t54\n94\t95\t55\t55\n94\t95\t56\t56\n94\t95\t57\t57\n94\t95\t58\t58\n94\t95\t59\t59\n94\t95\t60\t60\n94\t95\t61\t61\n94\t95\t62\t62\n94\t95\t63\t63\n94\t95\t64\t64\n94\t95\t65\t65\n94\t95\t66\t66\n94\t95\t67\t67\n94\t95\t68\t68\n94\t95\t69\t69\n94\t95\t70\t70\n94\t95\t71\t71\n94\t95\t72\t72\n94\t95\t73\t73\n94\t95\t74\t74\n94\t95\t75\t75\n94\t95\t76\t76\n94\t95\t77\t77\n94\t95\t78\t78\n94\t95\t79\t79\n94\t95\t80\t80\n94\t95\t81\t81\n94\t95\t82\t82\n94\t95\t83\t83\n94\t95\t84\t84\n94\t95\t85\t85\n94\t95\t86\t86\n94\t95\t87\t87\n94\t95\t88\t88\n94\t95\t89\t89\n94\t95\t90\t90\n94\t95\t91\t91\n94\t95\t92\t92\n94\t95\t93\t93\n94\t95\t94\t94\n94\t95\t95\t95\n94\t95\t96\t96\n94\t95\t97\t97\n94\t95\t98\t98\n94\t95\t99\t99\n94\t95\t100\t100\n94\t95\t101\t101\n94\t95\t102\t102\n94\t95\t103\t103\n94\t95\t104\t104\n94\t95\t105\t105\n94\t95\t106\t106\n94\t95\t107\t107\n94\t95\t108\t108\n94\t95\t109\t109\n94\t95\t110\t110\n94\t95\t111\t111\n94\t95\t112\t112\n94\t95\t113\t113\n94\t95\t114\t114\n94\t95\t115\t115\n94\t95\t116\t116\n94\t95\t117\t117\n94\t95\t118\t118\n94\t95\t119\t119\n94\t95\t120\t120\n94\t95\t121\t121\n94\t95\t122\t122\n94\t95\t123\t123\n94\t95\t124\t124\n94\t95\t125\t125\n94\t95\t126\t126\n94\t95\t127\t127\n94\t95\t128\t128\n94\t95\t129\t129\n94\t95\t130\t130\n94\t95\t131\t131\n94\t95\t132\t132\n94\t95\t133\t133\n94\t95\t134\t134\n94\t95\t135\t135\n94\t95\t136\t136\n94\t95\t137\t137\n94\t95\t138\t138\n94\t95\t139\t139\n94\t95\t140\t140\n94\t95\t141\t141\n94\t95\t142\t142\n94\t95\t143\t143\n94\t95\t144\t144\n94\t95\t145\t145\n94\t95\t146\t146\n94\t95\t147\t147\n94\t95\t148\t148\n94\t95\t149\t149\n94\t95\t150\t150\n94\t95\t151\t151\n94\t95\t152\t152\n94\t95\t153\t153\n94\t95\t154\t154\n94\t95\t155\t155\n94\t95\t156\t156\n94\t95\t157\t157\n94\t95\t158\t158\n94\t95\t159\t159\n94\t95\t160\t160\n94\t95\t161\t161\n94\t95\t162\t162\n94\t95\t163\t163\n94\t95\t164\t164\n94\t95\t165\t165\n94\t95\t166\t166\n94\t95\t167\t167\n94\t95\t168\t168\n94\t95\t169\t169\n94\t95\t170\t170\n94\t95\t171\t171\n94\t95\t172\t172\n94\t95\t173\t173\n94\t95\t174\t174\n94\t95\t175\t175\n94\t95\t176\t176\n94\t95\t177\t177\n94\t95\t178\t178\n94\t95\t179\t179\n94\t95\t180\t180\n94\t95\t181\t181\n94\t95\t182\t182\n94\t95\t183\t183\n94\t95\t184\t184\n94\t95\t185\t185\n94\t95\t186\t186\n94\t95\t187\t187\n94\t95\t188\t188\n94\t95\t189\t189\n94\t95\t190\t190\n94\t95\t191\t191\n94\t95\t192\t192\n94\t95\t193\t193\n94\t95\t194\t194\n94\t95\t195\t195\n94\t95\t196\t196\n94\t95\t197\t197\n94\t95\t198\t198\n94\t95\t199\t199\n94\t95\t200\t200\n94\t95\t201\t201\n94\t95\t202\t202\n94\t95\t203\t203\n94\t95\t204\t204\n94\t95\t205\t205\n94\t95\t206\t206\n94\t95\t207\t207\n94\t95\t208\t208\n94\t95\t209\t209\n94\t95\t210\t210\n94\t95\t211\t211\n94\t95\t212\t212\n94\t95\t213\t213\n94\t95\t214\t214\n94\t95\t215\t215\n94\t95\t216\t216\n94\t95\t217\t217\n94\t95\t218\t218\n94\t95\t219\t219\n94\t95\t220\t220\n94\t95\t221\t221\n94\t95\t222\t222\n94\t95\t223\t223\n94\t95\t224\t224\n94\t95\t225\t225\n94\t95\t226\t226\n94\t95\t227\t227\n94\t95\t228\t228\n94\t95\t229\t229\n94\t95\t230\t230\n94\t95\t231\t231\n94\t95\t232\t232\n94\t95\t233\t233\n94\t95\t234\t234\n94\t95\t235\t235\n94\t95\t236\t236\n94\t95\t237\t237\n94\t95\t238\t238\n94\t95\t239\t239\n94\t95\t240\t240\n94\t95\t241\t241\n94\t95\t242\t242\n94\t95\t243\t243\n94\t95\t244\t244\n94\t95\t245\t245\n94\t95\t246\t246\n94\t95\t247\t247\n94\t95\t248\t248\n94\t95\t249\t249\n94\t95\t250\t250\n94\t95\t251\t251\n94\t95\t252\t252\n94\t95\t253\t253\n94\t95\t254\t254\n94\t95\t255\t255\n95\t96\t0\t0\n95\t96\t1\t1\n95\t96\t2\t2\n95\t96\t3\t3\n95\t96\t4\t4\n95\t96\t5\t5\n95\t96\t6\t6\n95\t96\t7\t7\n95\t96\t8\t8\n95\t96\t9\t9\n95\t96\t10\t10\n95\t96\t11\t11\n95\t96\t12\t12\n95\t96\t13\t13\n95\t96\t14\t14\n95\t96\t15\t15\n95\t96\t16\t16\n95\t96\t17\t17\n95\t96\t18\t18\n95\t96\t19\t19\n95\t96\t20\t20\n95\t96\t21\t21\n95\t96\t22\t22\n95\t96\t23\t23\n95\t96\t24\t24\n95\t96\t25\t25\n95\t96\t26\t26\n95\t96\t27\t27\n95\t96\t28\t28\n95\t96\t29\t29\n95\t96\t30\t30\n95\t96\t31\t31\n95\t96\t32\t32\n95\t96\t33\t33\n95\t96\t34\t34\n95\t96\t35\t35\n95\t96\t36\t36\n95\t96\t37\t37\n95\t96\t38\t38\n95\t96\t39\t39\n95\t96\t40\t40\n95\t96\t41\t41\n95\t96\t42\t42\n95\t96\t43\t43\n95\t96\t44\t44\n95\t96\t45\t45\n95\t96\t46\t46\n95\t96\t47\t47\n95\t96\t48\t48\n95\t96\t49\t49\n95\t96\t50\t50\n95\t96\t51\t51\n95\t96\t52\t52\n95\t96\t53\t53\n95\t96\t54\t54\n95\t96\t55\t55\n95\t96\t56\t56\n95\t96\t57\t57\n95\t96\t58\t58\n95\t96\t59\t59\n95\t96\t60\t60\n95\t96\t61\t61\n95\t96\t62\t62\n95\t96\t63\t63\n95\t96\t64\t64\n95\t96\t65\t65\n95\t96\t66\t66\n95\t96\t67\t67\n95\t96\t68\t68\n95\t96\t69\t69\n95\t96\t70\t70\n95\t96\t71\t71\n95\t96\t72\t72\n95\t96\t73\t73\n95\t96\t74\t74\n95\t96\t75\t75\n95\t96\t76\t76\n95\t96\t77\t77\n95\t96\t78\t78\n95\t96\t79\t79\n95\t96\t80\t80\n95\t96\t81\t81\n95\t96\t82\t82\n95\t96\t83\t83\n95\t96\t84\t84\n95\t96\t85\t85\n95\t96\t86\t86\n95\t96\t87\t87\n95\t96\t88\t88\n95\t96\t89\t89\n95\t96\t90\t90\n95\t96\t91\t91\n95\t96\t92\t92\n95\t96\t93\t93\n95\t96\t94\t94\n95\t96\t95\t95\n95\t96\t96\t96\n95\t96\t97\t97\n95\t96\t98\t98\n95\t96\t99\t99\n95\t96\t100\t100\n95\t96\t101\t101\n95\t96\t102\t102\n95\t96\t103\t103\n95\t96\t104\t104\n95\t96\t105\t105\n95\t96\t106\t106\n95\t96\t107\t107\n95\t96\t108\t108\n95\t96\t109\t109\n95\t96\t110\t110\n95\t96\t111\t111\n95\t96\t112\t112\n95\t96\t113\t113\n95\t96\t114\t114\n95\t96\t115\t115\n95\t96\t116\t116\n95\t96\t117\t117\n95\t96\t118\t118\n95\t96\t119\t119\n95\t96\t120\t120\n95\t96\t121\t121\n95\t96\t122\t122\n95\t96\t123\t123\n95\t96\t124\t124\n95\t96\t125\t125\n95\t96\t126\t126\n95\t96\t127\t127\n95\t96\t128\t128\n95\t96\t129\t129\n95\t96\t130\t130\n95\t96\t131\t131\n95\t96\t132\t132\n95\t96\t133\t133\n95\t96\t134\t134\n95\t96\t135\t135\n95\t96\t136\t136\n95\t96\t137\t137\n95\t96\t138\t138\n95\t96\t139\t139\n95\t96\t140\t140\n95\t96\t141\t141\n95\t96\t142\t142\n95\t96\t143\t143\n95\t96\t144\t144\n95\t96\t145\t145\n95\t96\t146\t146\n95\t96\t147\t147\n95\t96\t148\t148\n95\t96\t149\t149\n95\t96\t150\t150\n95\t96\t151\t151\n95\t96\t152\t152\n95\t96\t153\t153\n95\t96\t154\t154\n95\t96\t155\t155\n95\t96\t156\t156\n95\t96\t157\t157\n95\t96\t158\t158\n95\t96\t159\t159\n95\t96\t160\t160\n95\t96\t161\t161\n95\t96\t162\t162\n95\t96\t163\t163\n95\t96\t164\t164\n95\t96\t165\t165\n95\t96\t166\t166\n95\t96\t167\t167\n95\t96\t168\t168\n95\t96\t169\t169\n95\t96\t170\t170\n95\t96\t171\t171\n95\t96\t172\t172\n95\t96\t173\t173\n95\t96\t174\t174\n95\t96\t175\t175\n95\t96\t176\t176\n95\t96\t177\t177\n95\t96\t178\t178\n95\t96\t179\t179\n95\t96\t180\t180\n95\t96\t181\t181\n95\t96\t182\t182\n95\t96\t183\t183\n95\t96\t184\t184\n95\t96\t185\t185\n95\t96\t186\t186\n95\t96\t187\t187\n95\t96\t188\t188\n95\t96\t189\t189\n95\t96\t190\t190\n95\t96\t191\t191\n95\t96\t192\t192\n95\t96\t193\t193\n95\t96\t194\t194\n95\t96\t195\t195\n95\t96\t196\t196\n95\t96\t197\t197\n95\t96\t198\t198\n95\t96\t199\t199\n95\t96\t200\t200\n95\t96\t201\t201\n95\t96\t202\t202\n95\t96\t203\t203\n95\t96\t204\t204\n95\t96\t205\t205\n95\t96\t206\t206\n95\t96\t207\t207\n95\t96\t208\t208\n95\t96\t209\t209\n95\t96\t210\t210\n95\t96\t211\t211\n95\t96\t212\t212\n95\t96\t213\t213\n95\t96\t214\t214\n95\t96\t215\t215\n95\t96\t216\t216\n95\t96\t217\t217\n95\t96\t218\t218\n95\t96\t219\t219\n95\t96\t220\t220\n95\t96\t221\t221\n95\t96\t222\t222\n95\t96\t223\t223\n95\t96\t224\t224\n95\t96\t225\t225\n95\t96\t226\t226\n95\t96\t227\t227\n95\t96\t228\t228\n95\t96\t229\t229\n95\t96\t230\t230\n95\t96\t231\t231\n95\t96\t232\t232\n95\t96\t233\t233\n95\t96\t234\t234\n95\t96\t235\t235\n95\t96\t236\t236\n95\t96\t237\t237\n95\t96\t238\t238\n95\t96\t239\t239\n95\t96\t240\t240\n95\t96\t241\t241\n95\t96\t242\t242\n95\t96\t243\t243\n95\t96\t244\t244\n95\t96\t245\t245\n95\t96\t246\t246\n95\t96\t247\t247\n95\t96\t248\t248\n95\t96\t249\t249\n95\t96\t250\t250\n95\t96\t251\t251\n95\t96\t252\t252\n95\t96\t253\t253\n95\t96\t254\t254\n95\t96\t255\t255\n96\t97\t0\t0\n96\t97\t1\t1\n96\t97\t2\t2\n96\t97\t3\t3\n96\t97\t4\t4\n96\t97\t5\t5\n96\t97\t6\t6\n96\t97\t7\t7\n96\t97\t8\t8\n96\t97\t9\t9\n96\t97\t10\t10\n96\t97\t11\t11\n96\t97\t12\t12\n96\t97\t13\t13\n96\t97\t14\t14\n96\t97\t15\t15\n96\t97\t16\t16\n96\t97\t17\t17\n96\t97\t18\t18\n96\t97\t19\t19\n96\t97\t20\t20\n96\t97\t21\t21\n96\t97\t22\t22\n96\t97\t23\t23\n96\t97\t24\t24\n96\t97\t25\t25\n96\t97\t26\t26\n96\t97\t27\t27\n96\t97\t28\t28\n96\t97\t29\t29\n96\t97\t30\t30\n96\t97\t31\t31\n96\t97\t32\t32\n96\t97\t33\t33\n96\t97\t34\t34\n96\t97\t35\t35\n96\t97\t36\t36\n96\t97\t37\t37\n96\t97\t38\t38\n96\t97\t39\t39\n96\t97\t40\t40\n96\t97\t41\t41\n96\t97\t42\t42\n96\t97\t43\t43\n96\t97\t44\t44\n96\t97\t45\t45\n96\t97\t46\t46\n96\t97\t47\t47\n96\t97\t48\t48\n96\t97\t49\t49\n96\t97\t50\t50\n96\t97\t51\t51\n96\t97\t52\t52\n96\t97\t53\t53\n96\t97\t54\t54\n96\t97\t55\t55\n96\t97\t56\t56\n96\t97\t57\t57\n96\t97\t58\t58\n96\t97\t59\t59\n96\t97\t60\t60\n96\t97\t61\t61\n96\t97\t62\t62\n96\t97\t63\t63\n96\t97\t64\t64\n96\t97\t65\t65\n96\t97\t66\t66\n96\t97\t67\t67\n96\t97\t68\t68\n96\t97\t69\t69\n96\t97\t70\t70\n96\t97\t71\t71\n96\t97\t72\t72\n96\t97\t73\t73\n96\t97\t74\t74\n96\t97\t75\t75\n96\t97\t76\t76\n96\t97\t77\t77\n96\t97\t78\t78\n96\t97\t79\t79\n96\t97\t80\t80\n96\t97\t81\t81\n96\t97\t82\t82\n96\t97\t83\t83\n96\t97\t84\t84\n96\t97\t85\t85\n96\t97\t86\t86\n96\t97\t87\t87\n96\t97\t88\t88\n96\t97\t89\t89\n96\t97\t90\t90\n96\t97\t91\t91\n96\t97\t92\t92\n96\t97\t93\t93\n96\t97\t94\t94\n96\t97\t95\t95\n96\t97\t96\t96\n96\t97\t97\t97\n96\t97\t98\t98\n96\t97\t99\t99\n96\t97\t100\t100\n96\t97\t101\t101\n96\t97\t102\t102\n96\t97\t103\t103\n96\t97\t104\t104\n96\t97\t105\t105\n96\t97\t106\t106\n96\t97\t107\t107\n96\t97\t108\t108\n96\t97\t109\t109\n96\t97\t110\t110\n96\t97\t111\t111\n96\t97\t112\t112\n96\t97\t113\t113\n96\t97\t114\t114\n96\t97\t115\t115\n96\t97\t116\t116\n96\t97\t117\t117\n96\t97\t118\t118\n96\t97\t119\t119\n96\t97\t120\t120\n96\t97\t121\t121\n96\t97\t122\t122\n96\t97\t123\t123\n96\t97\t124\t124\n96\t97\t125\t125\n96\t97\t126\t126\n96\t97\t127\t127\n96\t97\t128\t128\n96\t97\t129\t129\n96\t97\t130\t130\n96\t97\t131\t131\n96\t97\t132\t132\n96\t97\t133\t133\n96\t97\t134\t134\n96\t97\t135\t135\n96\t97\t136\t136\n96\t97\t137\t137\n96\t97\t138\t138\n96\t97\t139\t139\n96\t97\t140\t140\n96\t97\t141\t141\n96\t97\t142\t142\n96\t97\t143\t143\n96\t97\t144\t144\n96\t97\t145\t145\n96\t97\t146\t146\n96\t97\t147\t147\n96\t97\t148\t148\n96\t97\t149\t149\n96\t97\t150\t150\n96\t97\t151\t151\n96\t97\t152\t152\n96\t97\t153\t153\n96\t97\t154\t154\n96\t97\t155\t155\n96\t97\t156\t156\n96\t97\t157\t157\n96\t97\t158\t158\n96\t97\t159\t159\n96\t97\t160\t160\n96\t97\t161\t161\n96\t97\t162\t162\n96\t97\t163\t163\n96\t97\t164\t164\n96\t97\t165\t165\n96\t97\t166\t166\n96\t97\t167\t167\n96\t97\t168\t168\n96\t97\t169\t169\n96\t97\t170\t170\n96\t97\t171\t171\n96\t97\t172\t172\n96\t97\t173\t173\n96\t97\t174\t174\n96\t97\t175\t175\n96\t97\t176\t176\n96\t97\t177\t177\n96\t97\t178\t178\n96\t97\t179\t179\n96\t97\t180\t180\n96\t97\t181\t181\n96\t97\t182\t182\n96\t97\t183\t183\n96\t97\t184\t184\n96\t97\t185\t185\n96\t97\t186\t186\n96\t97\t187\t187\n96\t97\t188\t188\n96\t97\t189\t189\n96\t97\t190\t190\n96\t97\t191\t191\n96\t97\t192\t192\n96\t97\t193\t193\n96\t97\t194\t194\n96\t97\t195\t195\n96\t97\t196\t196\n96\t97\t197\t197\n96\t97\t198\t198\n96\t97\t199\t199\n96\t97\t200\t200\n96\t97\t201\t201\n96\t97\t202\t202\n96\t97\t203\t203\n96\t97\t204\t204\n96\t97\t205\t205\n96\t97\t206\t206\n96\t97\t207\t207\n96\t97\t208\t208\n96\t97\t209\t209\n96\t97\t210\t210\n96\t97\t211\t211\n96\t97\t212\t212\n96\t97\t213\t213\n96\t97\t214\t214\n96\t97\t215\t215\n96\t97\t216\t216\n96\t97\t217\t217\n96\t97\t218\t218\n96\t97\t219\t219\n96\t97\t220\t220\n96\t97\t221\t221\n96\t97\t222\t222\n96\t97\t223\t223\n96\t97\t224\t224\n96\t97\t225\t225\n96\t97\t226\t226\n96\t97\t227\t227\n96\t97\t228\t228\n96\t97\t229\t229\n96\t97\t230\t230\n96\t97\t231\t231\n96\t97\t232\t232\n96\t97\t233\t233\n96\t97\t234\t234\n96\t97\t235\t235\n96\t97\t236\t236\n96\t97\t237\t237\n96\t97\t238\t238\n96\t97\t239\t239\n96\t97\t240\t240\n96\t97\t241\t241\n96\t97\t242\t242\n96\t97\t243\t243\n96\t97\t244\t244\n96\t97\t245\t245\n96\t97\t246\t246\n96\t97\t247\t247\n96\t97\t248\t248\n96\t97\t249\t249\n96\t97\t250\t250\n96\t97\t251\t251\n96\t97\t252\t252\n96\t97\t253\t253\n96\t97\t254\t254\n96\t97\t255\t255\n97\t98\t0\t0\n97\t98\t1\t1\n97\t98\t2\t2\n97\t98\t3\t3\n97\t98\t4\t4\n97\t98\t5\t5\n97\t98\t6\t6\n97\t98\t7\t7\n97\t98\t8\t8\n97\t98\t9\t9\n97\t98\t10\t10\n97\t98\t11\t11\n97\t98\t12\t12\n97\t98\t13\t13\n97\t98\t14\t14\n97\t98\t15\t15\n97\t98\t16\t16\n97\t98\t17\t17\n97\t98\t18\t18\n97\t98\t19\t19\n97\t98\t20\t20\n97\t98\t21\t21\n97\t98\t22\t22\n97\t98\t23\t23\n97\t98\t24\t24\n97\t98\t25\t25\n97\t98\t26\t26\n97\t98\t27\t27\n97\t98\t28\t28\n97\t98\t29\t29\n97\t98\t30\t30\n97\t98\t31\t31\n97\t98\t32\t32\n97\t98\t33\t33\n97\t98\t34\t34\n97\t98\t35\t35\n97\t98\t36\t36\n97\t98\t37\t37\n97\t98\t38\t38\n97\t98\t39\t39\n97\t98\t40\t40\n97\t98\t41\t41\n97\t98\t42\t42\n97\t98\t43\t43\n97\t98\t44\t44\n97\t98\t45\t45\n97\t98\t46\t46\n97\t98\t47\t47\n97\t98\t48\t48\n97\t98\t49\t49\n97\t98\t50\t50\n97\t98\t51\t51\n97\t98\t52\t52\n97\t98\t53\t53\n97\t98\t54\t54\n97\t98\t55\t55\n97\t98\t56\t56\n97\t98\t57\t57\n97\t98\t58\t58\n97\t98\t59\t59\n97\t98\t60\t60\n97\t98\t61\t61\n97\t98\t62\t62\n97\t98\t63\t63\n97\t98\t64\t64\n97\t98\t65\t65\n97\t98\t66\t66\n97\t98\t67\t67\n97\t98\t68\t68\n97\t98\t69\t69\n97\t98\t70\t70\n97\t98\t71\t71\n97\t98\t72\t72\n97\t98\t73\t73\n97\t98\t74\t74\n97\t98\t75\t75\n97\t98\t76\t76\n97\t98\t77\t77\n97\t98\t78\t78\n97\t98\t79\t79\n97\t98\t80\t80\n97\t98\t81\t81\n97\t98\t82\t82\n97\t98\t83\t83\n97\t98\t84\t84\n97\t98\t85\t85\n97\t98\t86\t86\n97\t98\t87\t87\n97\t98\t88\t88\n97\t98\t89\t89\n97\t98\t90\t90\n97\t98\t91\t91\n97\t98\t92\t92\n97\t98\t93\t93\n97\t98\t94\t94\n97\t98\t95\t95\n97\t98\t96\t96\n97\t98\t97\t97\n97\t98\t98\t98\n97\t98\t99\t99\n97\t98\t100\t100\n97\t98\t101\t101\n97\t98\t102\t102\n97\t98\t103\t103\n97\t98\t104\t104\n97\t98\t105\t105\n97\t98\t106\t106\n97\t98\t107\t107\n97\t98\t108\t108\n97\t98\t109\t109\n97\t98\t110\t110\n97\t98\t111\t111\n97\t98\t112\t112\n97\t98\t113\t113\n97\t98\t114\t114\n97\t98\t115\t115\n97\t98\t116\t116\n97\t98\t117\t117\n97\t98\t118\t118\n97\t98\t119\t119\n97\t98\t120\t120\n97\t98\t121\t121\n97\t98\t122\t122\n97\t98\t123\t123\n97\t98\t124\t124\n97\t98\t125\t125\n97\t98\t126\t126\n97\t98\t127\t127\n97\t98\t128\t128\n97\t98\t129\t129\n97\t98\t130\t130\n97\t98\t131\t131\n97\t98\t132\t132\n97\t98\t133\t133\n97\t98\t134\t134\n97\t98\t135\t135\n97\t98\t136\t136\n97\t98\t137\t137\n97\t98\t138\t138\n97\t98\t139\t139\n97\t98\t140\t140\n97\t98\t141\t141\n97\t98\t142\t142\n97\t98\t143\t143\n97\t98\t144\t144\n97\t98\t145\t145\n97\t98\t146\t146\n97\t98\t147\t147\n97\t98\t148\t148\n97\t98\t149\t149\n97\t98\t150\t150\n97\t98\t151\t151\n97\t98\t152\t152\n97\t98\t153\t153\n97\t98\t154\t154\n97\t98\t155\t155\n97\t98\t156\t156\n97\t98\t157\t157\n97\t98\t158\t158\n97\t98\t159\t159\n97\t98\t160\t160\n97\t98\t161\t161\n97\t98\t162\t162\n97\t98\t163\t163\n97\t98\t164\t164\n97\t98\t165\t165\n97\t98\t166\t166\n97\t98\t167\t167\n97\t98\t168\t168\n97\t98\t169\t169\n97\t98\t170\t170\n97\t98\t171\t171\n97\t98\t172\t172\n97\t98\t173\t173\n97\t98\t174\t174\n97\t98\t175\t175\n97\t98\t176\t176\n97\t98\t177\t177\n97\t98\t178\t178\n97\t98\t179\t179\n97\t98\t180\t180\n97\t98\t181\t181\n97\t98\t182\t182\n97\t98\t183\t183\n97\t98\t184\t184\n97\t98\t185\t185\n97\t98\t186\t186\n97\t98\t187\t187\n97\t98\t188\t188\n97\t98\t189\t189\n97\t98\t190\t190\n97\t98\t191\t191\n97\t98\t192\t192\n97\t98\t193\t193\n97\t98\t194\t194\n97\t98\t195\t195\n97\t98\t196\t196\n97\t98\t197\t197\n97\t98\t198\t198\n97\t98\t199\t199\n97\t98\t200\t200\n97\t98\t201\t201\n97\t98\t202\t202\n97\t98\t203\t203\n97\t98\t204\t204\n97\t98\t205\t205\n97\t98\t206\t206\n97\t98\t207\t207\n97\t98\t208\t208\n97\t98\t209\t209\n97\t98\t210\t210\n97\t98\t211\t211\n97\t98\t212\t212\n97\t98\t213\t213\n97\t98\t214\t214\n97\t98\t215\t215\n97\t98\t216\t216\n97\t98\t217\t217\n97\t98\t218\t218\n97\t98\t219\t219\n97\t98\t220\t220\n97\t98\t221\t221\n97\t98\t222\t222\n97\t98\t223\t223\n97\t98\t224\t224\n97\t98\t225\t225\n97\t98\t226\t226\n97\t98\t227\t227\n97\t98\t228\t228\n97\t98\t229\t229\n97\t98\t230\t230\n97\t98\t231\t231\n97\t98\t232\t232\n97\t98\t233\t233\n97\t98\t234\t234\n97\t98\t235\t235\n97\t98\t236\t236\n97\t98\t237\t237\n97\t98\t238\t238\n97\t98\t239\t239\n97\t98\t240\t240\n97\t98\t241\t241\n97\t98\t242\t242\n97\t98\t243\t243\n97\t98\t244\t244\n97\t98\t245\t245\n97\t98\t246\t246\n97\t98\t247\t247\n97\t98\t248\t248\n97\t98\t249\t249\n97\t98\t250\t250\n97\t98\t251\t251\n97\t98\t252\t252\n97\t98\t253\t253\n97\t98\t254\t254\n97\t98\t255\t255\n98\t99\t0\t0\n98\t99\t1\t1\n98\t99\t2\t2\n98\t99\t3\t3\n98\t99\t4\t4\n98\t99\t5\t5\n98\t99\t6\t6\n98\t99\t7\t7\n98\t99\t8\t8\n98\t99\t9\t9\n98\t99\t10\t10\n98\t99\t11\t11\n98\t99\t12\t12\n98\t99\t13\t13\n98\t99\t14\t14\n98\t99\t15\t15\n98\t99\t16\t16\n98\t99\t17\t17\n98\t99\t18\t18\n98\t99\t19\t19\n98\t99\t20\t20\n98\t99\t21\t21\n98\t99\t22\t22\n98\t99\t23\t23\n98\t99\t24\t24\n98\t99\t25\t25\n98\t99\t26\t26\n98\t99\t27\t27\n98\t99\t28\t28\n98\t99\t29\t29\n98\t99\t30\t30\n98\t99\t31\t31\n98\t99\t32\t32\n98\t99\t33\t33\n98\t99\t34\t34\n98\t99\t35\t35\n98\t99\t36\t36\n98\t99\t37\t37\n98\t99\t38\t38\n98\t99\t39\t39\n98\t99\t40\t40\n98\t99\t41\t41\n98\t99\t42\t42\n98\t99\t43\t43\n98\t99\t44\t44\n98\t99\t45\t45\n98\t99\t46\t46\n98\t99\t47\t47\n98\t99\t48\t48\n98\t99\t49\t49\n98\t99\t50\t50\n98\t99\t51\t51\n98\t99\t52\t52\n98\t99\t53\t53\n98\t99\t54\t54\n98\t99\t55\t55\n98\t99\t56\t56\n98\t99\t57\t57\n98\t99\t58\t58\n98\t99\t59\t59\n98\t99\t60\t60\n98\t99\t61\t61\n98\t99\t62\t62\n98\t99\t63\t63\n98\t99\t64\t64\n98\t99\t65\t65\n98\t99\t66\t66\n98\t99\t67\t67\n98\t99\t68\t68\n98\t99\t69\t69\n98\t99\t70\t70\n98\t99\t71\t71\n98\t99\t72\t72\n98\t99\t73\t73\n98\t99\t74\t74\n98\t99\t75\t75\n98\t99\t76\t76\n98\t99\t77\t77\n98\t99\t78\t78\n98\t99\t79\t79\n98\t99\t80\t80\n98\t99\t81\t81\n98\t99\t82\t82\n98\t99\t83\t83\n98\t99\t84\t84\n98\t99\t85\t85\n98\t99\t86\t86\n98\t99\t87\t87\n98\t99\t88\t88\n98\t99\t89\t89\n98\t99\t90\t90\n98\t99\t91\t91\n98\t99\t92\t92\n98\t99\t93\t93\n98\t99\t94\t94\n98\t99\t95\t95\n98\t99\t96\t96\n98\t99\t97\t97\n98\t99\t98\t98\n98\t99\t99\t99\n98\t99\t100\t100\n98\t99\t101\t101\n98\t99\t102\t102\n98\t99\t103\t103\n98\t99\t104\t104\n98\t99\t105\t105\n98\t99\t106\t106\n98\t99\t107\t107\n98\t99\t108\t108\n98\t99\t109\t109\n98\t99\t110\t110\n98\t99\t111\t111\n98\t99\t112\t112\n98\t99\t113\t113\n98\t99\t114\t114\n98\t99\t115\t115\n98\t99\t116\t116\n98\t99\t117\t117\n98\t99\t118\t118\n98\t99\t119\t119\n98\t99\t120\t120\n98\t99\t121\t121\n98\t99\t122\t122\n98\t99\t123\t123\n98\t99\t124\t124\n98\t99\t125\t125\n98\t99\t126\t126\n98\t99\t127\t127\n98\t99\t128\t128\n98\t99\t129\t129\n98\t99\t130\t130\n98\t99\t131\t131\n98\t99\t132\t132\n98\t99\t133\t133\n98\t99\t134\t134\n98\t99\t135\t135\n98\t99\t136\t136\n98\t99\t137\t137\n98\t99\t138\t138\n98\t99\t139\t139\n98\t99\t140\t140\n98\t99\t141\t141\n98\t99\t142\t142\n98\t99\t143\t143\n98\t99\t144\t144\n98\t99\t145\t145\n98\t99\t146\t146\n98\t99\t147\t147\n98\t99\t148\t148\n98\t99\t149\t149\n98\t99\t150\t150\n98\t99\t151\t151\n98\t99\t152\t152\n98\t99\t153\t153\n98\t99\t154\t154\n98\t99\t155\t155\n98\t99\t156\t156\n98\t99\t157\t157\n98\t99\t158\t158\n98\t99\t159\t159\n98\t99\t160\t160\n98\t99\t161\t161\n98\t99\t162\t162\n98\t99\t163\t163\n98\t99\t164\t164\n98\t99\t165\t165\n98\t99\t166\t166\n98\t99\t167\t167\n98\t99\t168\t168\n98\t99\t169\t169\n98\t99\t170\t170\n98\t99\t171\t171\n98\t99\t172\t172\n98\t99\t173\t173\n98\t99\t174\t174\n98\t99\t175\t175\n98\t99\t176\t176\n98\t99\t177\t177\n98\t99\t178\t178\n98\t99\t179\t179\n98\t99\t180\t180\n98\t99\t181\t181\n98\t99\t182\t182\n98\t99\t183\t183\n98\t99\t184\t184\n98\t99\t185\t185\n98\t99\t186\t186\n98\t99\t187\t187\n98\t99\t188\t188\n98\t99\t189\t189\n98\t99\t190\t190\n98\t99\t191\t191\n98\t99\t192\t192\n98\t99\t193\t193\n98\t99\t194\t194\n98\t99\t195\t195\n98\t99\t196\t196\n98\t99\t197\t197\n98\t99\t198\t198\n98\t99\t199\t199\n98\t99\t200\t200\n98\t99\t201\t201\n98\t99\t202\t202\n98\t99\t203\t203\n98\t99\t204\t204\n98\t99\t205\t205\n98\t99\t206\t206\n98\t99\t207\t207\n98\t99\t208\t208\n98\t99\t209\t209\n98\t99\t210\t210\n98\t99\t211\t211\n98\t99\t212\t212\n98\t99\t213\t213\n98\t99\t214\t214\n98\t99\t215\t215\n98\t99\t216\t216\n98\t99\t217\t217\n98\t99\t218\t218\n98\t99\t219\t219\n98\t99\t220\t220\n98\t99\t221\t221\n98\t99\t222\t222\n98\t99\t223\t223\n98\t99\t224\t224\n98\t99\t225\t225\n98\t99\t226\t226\n98\t99\t227\t227\n98\t99\t228\t228\n98\t99\t229\t229\n98\t99\t230\t230\n98\t99\t231\t231\n98\t99\t232\t232\n98\t99\t233\t233\n98\t99\t234\t234\n98\t99\t235\t235\n98\t99\t236\t236\n98\t99\t237\t237\n98\t99\t238\t238\n98\t99\t239\t239\n98\t99\t240\t240\n98\t99\t241\t241\n98\t99\t242\t242\n98\t99\t243\t243\n98\t99\t244\t244\n98\t99\t245\t245\n98\t99\t246\t246\n98\t99\t247\t247\n98\t99\t248\t248\n98\t99\t249\t249\n98\t99\t250\t250\n98\t99\t251\t251\n98\t99\t252\t252\n98\t99\t253\t253\n98\t99\t254\t254\n98\t99\t255\t255\n99\t100\t0\t0\n99\t100\t1\t1\n99\t100\t2\t2\n99\t100\t3\t3\n99\t100\t4\t4\n99\t100\t5\t5\n99\t100\t6\t6\n99\t100\t7\t7\n99\t100\t8\t8\n99\t100\t9\t9\n99\t100\t10\t10\n99\t100\t11\t11\n99\t100\t12\t12\n99\t100\t13\t13\n99\t100\t14\t14\n99\t100\t15\t15\n99\t100\t16\t16\n99\t100\t17\t17\n99\t100\t18\t18\n99\t100\t19\t19\n99\t100\t20\t20\n99\t100\t21\t21\n99\t100\t22\t22\n99\t100\t23\t23\n99\t100\t24\t24\n99\t100\t25\t25\n99\t100\t26\t26\n99\t100\t27\t27\n99\t100\t28\t28\n99\t100\t29\t29\n99\t100\t30\t30\n99\t100\t31\t31\n99\t100\t32\t32\n99\t100\t33\t33\n99\t100\t34\t34\n99\t100\t35\t35\n99\t100\t36\t36\n99\t100\t37\t37\n99\t100\t38\t38\n99\t100\t39\t39\n99\t100\t40\t40\n99\t100\t41\t41\n99\t100\t42\t42\n99\t100\t43\t43\n99\t100\t44\t44\n99\t100\t45\t45\n99\t100\t46\t46\n99\t100\t47\t47\n99\t100\t48\t48\n99\t100\t49\t49\n99\t100\t50\t50\n99\t100\t51\t51\n99\t100\t52\t52\n99\t100\t53\t53\n99\t100\t54\t54\n99\t100\t55\t55\n99\t100\t56\t56\n99\t100\t57\t57\n99\t100\t58\t58\n99\t100\t59\t59\n99\t100\t60\t60\n99\t100\t61\t61\n99\t100\t62\t62\n99\t100\t63\t63\n99\t100\t64\t64\n99\t100\t65\t65\n99\t100\t66\t66\n99\t100\t67\t67\n99\t100\t68\t68\n99\t100\t69\t69\n99\t100\t70\t70\n99\t100\t71\t71\n99\t100\t72\t72\n99\t100\t73\t73\n99\t100\t74\t74\n99\t100\t75\t75\n99\t100\t76\t76\n99\t100\t77\t77\n99\t100\t78\t78\n99\t100\t79\t79\n99\t100\t80\t80\n99\t100\t81\t81\n99\t100\t82\t82\n99\t100\t83\t83\n99\t100\t84\t84\n99\t100\t85\t85\n99\t100\t86\t86\n99\t100\t87\t87\n99\t100\t88\t88\n99\t100\t89\t89\n99\t100\t90\t90\n99\t100\t91\t91\n99\t100\t92\t92\n99\t100\t93\t93\n99\t100\t94\t94\n99\t100\t95\t95\n99\t100\t96\t96\n99\t100\t97\t97\n99\t100\t98\t98\n99\t100\t99\t99\n99\t100\t100\t100\n99\t100\t101\t101\n99\t100\t102\t102\n99\t100\t103\t103\n99\t100\t104\t104\n99\t100\t105\t105\n99\t100\t106\t106\n99\t100\t107\t107\n99\t100\t108\t108\n99\t100\t109\t109\n99\t100\t110\t110\n99\t100\t111\t111\n99\t100\t112\t112\n99\t100\t113\t113\n99\t100\t114\t114\n99\t100\t115\t115\n99\t100\t116\t116\n99\t100\t117\t117\n99\t100\t118\t118\n99\t100\t119\t119\n99\t100\t120\t120\n99\t100\t121\t121\n99\t100\t122\t122\n99\t100\t123\t123\n99\t100\t124\t124\n99\t100\t125\t125\n99\t100\t126\t126\n99\t100\t127\t127\n99\t100\t128\t128\n99\t100\t129\t129\n99\t100\t130\t130\n99\t100\t131\t131\n99\t100\t132\t132\n99\t100\t133\t133\n99\t100\t134\t134\n99\t100\t135\t135\n99\t100\t136\t136\n99\t100\t137\t137\n99\t100\t138\t138\n99\t100\t139\t139\n99\t100\t140\t140\n99\t100\t141\t141\n99\t100\t142\t142\n99\t100\t143\t143\n99\t100\t144\t144\n99\t100\t145\t145\n99\t100\t146\t146\n99\t100\t147\t147\n99\t100\t148\t148\n99\t100\t149\t149\n99\t100\t150\t150\n99\t100\t151\t151\n99\t100\t152\t152\n99\t100\t153\t153\n99\t100\t154\t154\n99\t100\t155\t155\n99\t100\t156\t156\n99\t100\t157\t157\n99\t100\t158\t158\n99\t100\t159\t159\n99\t100\t160\t160\n99\t100\t161\t161\n99\t100\t162\t162\n99\t100\t163\t163\n99\t100\t164\t164\n99\t100\t165\t165\n99\t100\t166\t166\n99\t100\t167\t167\n99\t100\t168\t168\n99\t100\t169\t169\n99\t100\t170\t170\n99\t100\t171\t171\n99\t100\t172\t172\n99\t100\t173\t173\n99\t100\t174\t174\n99\t100\t175\t175\n99\t100\t176\t176\n99\t100\t177\t177\n99\t100\t178\t178\n99\t100\t179\t179\n99\t100\t180\t180\n99\t100\t181\t181\n99\t100\t182\t182\n99\t100\t183\t183\n99\t100\t184\t184\n99\t100\t185\t185\n99\t100\t186\t186\n99\t100\t187\t187\n99\t100\t188\t188\n99\t100\t189\t189\n99\t100\t190\t190\n99\t100\t191\t191\n99\t100\t192\t192\n99\t100\t193\t193\n99\t100\t194\t194\n99\t100\t195\t195\n99\t100\t196\t196\n99\t100\t197\t197\n99\t100\t198\t198\n99\t100\t199\t199\n99\t100\t200\t200\n99\t100\t201\t201\n99\t100\t202\t202\n99\t100\t203\t203\n99\t100\t204\t204\n99\t100\t205\t205\n99\t100\t206\t206\n99\t100\t207\t207\n99\t100\t208\t208\n99\t100\t209\t209\n99\t100\t210\t210\n99\t100\t211\t211\n99\t100\t212\t212\n99\t100\t213\t213\n99\t100\t214\t214\n99\t100\t215\t215\n99\t100\t216\t216\n99\t100\t217\t217\n99\t100\t218\t218\n99\t100\t219\t219\n99\t100\t220\t220\n99\t100\t221\t221\n99\t100\t222\t222\n99\t100\t223\t223\n99\t100\t224\t224\n99\t100\t225\t225\n99\t100\t226\t226\n99\t100\t227\t227\n99\t100\t228\t228\n99\t100\t229\t229\n99\t100\t230\t230\n99\t100\t231\t231\n99\t100\t232\t232\n99\t100\t233\t233\n99\t100\t234\t234\n99\t100\t235\t235\n99\t100\t236\t236\n99\t100\t237\t237\n99\t100\t238\t238\n99\t100\t239\t239\n99\t100\t240\t240\n99\t100\t241\t241\n99\t100\t242\t242\n99\t100\t243\t243\n99\t100\t244\t244\n99\t100\t245\t245\n99\t100\t246\t246\n99\t100\t247\t247\n99\t100\t248\t248\n99\t100\t249\t249\n99\t100\t250\t250\n99\t100\t251\t251\n99\t100\t252\t252\n99\t100\t253\t253\n99\t100\t254\t254\n99\t100\t255\t255\n100\t101\t0\t0\n100\t101\t1\t1\n100\t101\t2\t2\n100\t101\t3\t3\n100\t101\t4\t4\n100\t101\t5\t5\n100\t101\t6\t6\n100\t101\t7\t7\n100\t101\t8\t8\n100\t101\t9\t9\n100\t101\t10\t10\n100\t101\t11\t11\n100\t101\t12\t12\n100\t101\t13\t13\n100\t101\t14\t14\n100\t101\t15\t15\n100\t101\t16\t16\n100\t101\t17\t17\n100\t101\t18\t18\n100\t101\t19\t19\n100\t101\t20\t20\n100\t101\t21\t21\n100\t101\t22\t22\n100\t101\t23\t23\n100\t101\t24\t24\n100\t101\t25\t25\n100\t101\t26\t26\n100\t101\t27\t27\n100\t101\t28\t28\n100\t101\t29\t29\n100\t101\t30\t30\n100\t101\t31\t31\n100\t101\t32\t32\n100\t101\t33\t33\n100\t101\t34\t34\n100\t101\t35\t35\n100\t101\t36\t36\n100\t101\t37\t37\n100\t101\t38\t38\n100\t101\t39\t39\n100\t101\t40\t40\n100\t101\t41\t41\n100\t101\t42\t42\n100\t101\t43\t43\n100\t101\t44\t44\n100\t101\t45\t45\n100\t101\t46\t46\n100\t101\t47\t47\n100\t101\t48\t48\n100\t101\t49\t49\n100\t101\t50\t50\n100\t101\t51\t51\n100\t101\t52\t52\n100\t101\t53\t53\n100\t101\t54\t54\n100\t101\t55\t55\n100\t101\t56\t56\n100\t101\t57\t57\n100\t101\t58\t58\n100\t101\t59\t59\n100\t101\t60\t60\n100\t101\t61\t61\n100\t101\t62\t62\n100\t101\t63\t63\n100\t101\t64\t64\n100\t101\t65\t65\n100\t101\t66\t66\n100\t101\t67\t67\n100\t101\t68\t68\n100\t101\t69\t69\n100\t101\t70\t70\n100\t101\t71\t71\n100\t101\t72\t72\n100\t101\t73\t73\n100\t101\t74\t74\n100\t101\t75\t75\n100\t101\t76\t76\n100\t101\t77\t77\n100\t101\t78\t78\n100\t101\t79\t79\n100\t101\t80\t80\n100\t101\t81\t81\n100\t101\t82\t82\n100\t101\t83\t83\n100\t101\t84\t84\n100\t101\t85\t85\n100\t101\t86\t86\n100\t101\t87\t87\n100\t101\t88\t88\n100\t101\t89\t89\n100\t101\t90\t90\n100\t101\t91\t91\n100\t101\t92\t92\n100\t101\t93\t93\n100\t101\t94\t94\n100\t101\t95\t95\n100\t101\t96\t96\n100\t101\t97\t97\n100\t101\t98\t98\n100\t101\t99\t99\n100\t101\t100\t100\n100\t101\t101\t101\n100\t101\t102\t102\n100\t101\t103\t103\n100\t101\t104\t104\n100\t101\t105\t105\n100\t101\t106\t106\n100\t101\t107\t107\n100\t101\t108\t108\n100\t101\t109\t109\n100\t101\t110\t110\n100\t101\t111\t111\n100\t101\t112\t112\n100\t101\t113\t113\n100\t101\t114\t114\n100\t101\t115\t115\n100\t101\t116\t116\n100\t101\t117\t117\n100\t101\t118\t118\n100\t101\t119\t119\n100\t101\t120\t120\n100\t101\t121\t121\n100\t101\t122\t122\n100\t101\t123\t123\n100\t101\t124\t124\n100\t101\t125\t125\n100\t101\t126\t126\n100\t101\t127\t127\n100\t101\t128\t128\n100\t101\t129\t129\n100\t101\t130\t130\n100\t101\t131\t131\n100\t101\t132\t132\n100\t101\t133\t133\n100\t101\t134\t134\n100\t101\t135\t135\n100\t101\t136\t136\n100\t101\t137\t137\n100\t101\t138\t138\n100\t101\t139\t139\n100\t101\t140\t140\n100\t101\t141\t141\n100\t101\t142\t142\n100\t101\t143\t143\n100\t101\t144\t144\n100\t101\t145\t145\n100\t101\t146\t146\n100\t101\t147\t147\n100\t101\t148\t148\n100\t101\t149\t149\n100\t101\t150\t150\n100\t101\t151\t151\n100\t101\t152\t152\n100\t101\t153\t153\n100\t101\t154\t154\n100\t101\t155\t155\n100\t101\t156\t156\n100\t101\t157\t157\n100\t101\t158\t158\n100\t101\t159\t159\n100\t101\t160\t160\n100\t101\t161\t161\n100\t101\t162\t162\n100\t101\t163\t163\n100\t101\t164\t164\n100\t101\t165\t165\n100\t101\t166\t166\n100\t101\t167\t167\n100\t101\t168\t168\n100\t101\t169\t169\n100\t101\t170\t170\n100\t101\t171\t171\n100\t101\t172\t172\n100\t101\t173\t173\n100\t101\t174\t174\n100\t101\t175\t175\n100\t101\t176\t176\n100\t101\t177\t177\n100\t101\t178\t178\n100\t101\t179\t179\n100\t101\t180\t180\n100\t101\t181\t181\n100\t101\t182\t182\n100\t101\t183\t183\n100\t101\t184\t184\n100\t101\t185\t185\n100\t101\t186\t186\n100\t101\t187\t187\n100\t101\t188\t188\n100\t101\t189\t189\n100\t101\t190\t190\n100\t101\t191\t191\n100\t101\t192\t192\n100\t101\t193\t193\n100\t101\t194\t194\n100\t101\t195\t195\n100\t101\t196\t196\n100\t101\t197\t197\n100\t101\t198\t198\n100\t101\t199\t199\n100\t101\t200\t200\n100\t101\t201\t201\n100\t101\t202\t202\n100\t101\t203\t203\n100\t101\t204\t204\n100\t101\t205\t205\n100\t101\t206\t206\n100\t101\t207\t207\n100\t101\t208\t208\n100\t101\t209\t209\n100\t101\t210\t210\n100\t101\t211\t211\n100\t101\t212\t212\n100\t101\t213\t213\n100\t101\t214\t214\n100\t101\t215\t215\n100\t101\t216\t216\n100\t101\t217\t217\n100\t101\t218\t218\n100\t101\t219\t219\n100\t101\t220\t220\n100\t101\t221\t221\n100\t101\t222\t222\n100\t101\t223\t223\n100\t101\t224\t224\n100\t101\t225\t225\n100\t101\t226\t226\n100\t101\t227\t227\n100\t101\t228\t228\n100\t101\t229\t229\n100\t101\t230\t230\n100\t101\t231\t231\n100\t101\t232\t232\n100\t101\t233\t233\n100\t101\t234\t234\n100\t101\t235\t235\n100\t101\t236\t236\n100\t101\t237\t237\n100\t101\t238\t238\n100\t101\t239\t239\n100\t101\t240\t240\n100\t101\t241\t241\n100\t101\t242\t242\n100\t101\t243\t243\n100\t101\t244\t244\n100\t101\t245\t245\n100\t101\t246\t246\n100\t101\t247\t247\n100\t101\t248\t248\n100\t101\t249\t249\n100\t101\t250\t250\n100\t101\t251\t251\n100\t101\t252\t252\n100\t101\t253\t253\n100\t101\t254\t254\n100\t101\t255\t255\n101\t102\t0\t0\n101\t102\t1\t1\n101\t102\t2\t2\n101\t102\t3\t3\n101\t102\t4\t4\n101\t102\t5\t5\n101\t102\t6\t6\n101\t102\t7\t7\n101\t102\t8\t8\n101\t102\t9\t9\n101\t102\t10\t10\n101\t102\t11\t11\n101\t102\t12\t12\n101\t102\t13\t13\n101\t102\t14\t14\n101\t102\t15\t15\n101\t102\t16\t16\n101\t102\t17\t17\n101\t102\t18\t18\n101\t102\t19\t19\n101\t102\t20\t20\n101\t102\t21\t21\n101\t102\t22\t22\n101\t102\t23\t23\n101\t102\t24\t24\n101\t102\t25\t25\n101\t102\t26\t26\n101\t102\t27\t27\n101\t102\t28\t28\n101\t102\t29\t29\n101\t102\t30\t30\n101\t102\t31\t31\n101\t102\t32\t32\n101\t102\t33\t33\n101\t102\t34\t34\n101\t102\t35\t35\n101\t102\t36\t36\n101\t102\t37\t37\n101\t102\t38\t38\n101\t102\t39\t39\n101\t102\t40\t40\n101\t102\t41\t41\n101\t102\t42\t42\n101\t102\t43\t43\n101\t102\t44\t44\n101\t102\t45\t45\n101\t102\t46\t46\n101\t102\t47\t47\n101\t102\t48\t48\n101\t102\t49\t49\n101\t102\t50\t50\n101\t102\t51\t51\n101\t102\t52\t52\n101\t102\t53\t53\n101\t102\t54\t54\n101\t102\t55\t55\n101\t102\t56\t56\n101\t102\t57\t57\n101\t102\t58\t58\n101\t102\t59\t59\n101\t102\t60\t60\n101\t102\t61\t61\n101\t102\t62\t62\n101\t102\t63\t63\n101\t102\t64\t64\n101\t102\t65\t65\n101\t102\t66\t66\n101\t102\t67\t67\n101\t102\t68\t68\n101\t102\t69\t69\n101\t102\t70\t70\n101\t102\t71\t71\n101\t102\t72\t72\n101\t102\t73\t73\n101\t102\t74\t74\n101\t102\t75\t75\n101\t102\t76\t76\n101\t102\t77\t77\n101\t102\t78\t78\n101\t102\t79\t79\n101\t102\t80\t80\n101\t102\t81\t81\n101\t102\t82\t82\n101\t102\t83\t83\n101\t102\t84\t84\n101\t102\t85\t85\n101\t102\t86\t86\n101\t102\t87\t87\n101\t102\t88\t88\n101\t102\t89\t89\n101\t102\t90\t90\n101\t102\t91\t91\n101\t102\t92\t92\n101\t102\t93\t93\n101\t102\t94\t94\n101\t102\t95\t95\n101\t102\t96\t96\n101\t102\t97\t97\n101\t102\t98\t98\n101\t102\t99\t99\n101\t102\t100\t100\n101\t102\t101\t101\n101\t102\t102\t102\n101\t102\t103\t103\n101\t102\t104\t104\n101\t102\t105\t105\n101\t102\t106\t106\n101\t102\t107\t107\n101\t102\t108\t108\n101\t102\t109\t109\n101\t102\t110\t110\n101\t102\t111\t111\n101\t102\t112\t112\n101\t102\t113\t113\n101\t102\t114\t114\n101\t102\t115\t115\n101\t102\t116\t116\n101\t102\t117\t117\n101\t102\t118\t118\n101\t102\t119\t119\n101\t102\t120\t120\n101\t102\t121\t121\n101\t102\t122\t122\n101\t102\t123\t123\n101\t102\t124\t124\n101\t102\t125\t125\n101\t102\t126\t126\n101\t102\t127\t127\n101\t102\t128\t128\n101\t102\t129\t129\n101\t102\t130\t130\n101\t102\t131\t131\n101\t102\t132\t132\n101\t102\t133\t133\n101\t102\t134\t134\n101\t102\t135\t135\n101\t102\t136\t136\n101\t102\t137\t137\n101\t102\t138\t138\n101\t102\t139\t139\n101\t102\t140\t140\n101\t102\t141\t141\n101\t102\t142\t142\n101\t102\t143\t143\n101\t102\t144\t144\n101\t102\t145\t145\n101\t102\t146\t146\n101\t102\t147\t147\n101\t102\t148\t148\n101\t102\t149\t149\n101\t102\t150\t150\n101\t102\t151\t151\n101\t102\t152\t152\n101\t102\t153\t153\n101\t102\t154\t154\n101\t102\t155\t155\n101\t102\t156\t156\n101\t102\t157\t157\n101\t102\t158\t158\n101\t102\t159\t159\n101\t102\t160\t160\n101\t102\t161\t161\n101\t102\t162\t162\n101\t102\t163\t163\n101\t102\t164\t164\n101\t102\t165\t165\n101\t102\t166\t166\n101\t102\t167\t167\n101\t102\t168\t168\n101\t102\t169\t169\n101\t102\t170\t170\n101\t102\t171\t171\n101\t102\t172\t172\n101\t102\t173\t173\n101\t102\t174\t174\n101\t102\t175\t175\n101\t102\t176\t176\n101\t102\t177\t177\n101\t102\t178\t178\n101\t102\t179\t179\n101\t102\t180\t180\n101\t102\t181\t181\n101\t102\t182\t182\n101\t102\t183\t183\n101\t102\t184\t184\n101\t102\t185\t185\n101\t102\t186\t186\n101\t102\t187\t187\n101\t102\t188\t188\n101\t102\t189\t189\n101\t102\t190\t190\n101\t102\t191\t191\n101\t102\t192\t192\n101\t102\t193\t193\n101\t102\t194\t194\n101\t102\t195\t195\n101\t102\t196\t196\n101\t102\t197\t197\n101\t102\t198\t198\n101\t102\t199\t199\n101\t102\t200\t200\n101\t102\t201\t201\n101\t102\t202\t202\n101\t102\t203\t203\n101\t102\t204\t204\n101\t102\t205\t205\n101\t102\t206\t206\n101\t102\t207\t207\n101\t102\t208\t208\n101\t102\t209\t209\n101\t102\t210\t210\n101\t102\t211\t211\n101\t102\t212\t212\n101\t102\t213\t213\n101\t102\t214\t214\n101\t102\t215\t215\n101\t102\t216\t216\n101\t102\t217\t217\n101\t102\t218\t218\n101\t102\t219\t219\n101\t102\t220\t220\n101\t102\t221\t221\n101\t102\t222\t222\n101\t102\t223\t223\n101\t102\t224\t224\n101\t102\t225\t225\n101\t102\t226\t226\n101\t102\t227\t227\n101\t102\t228\t228\n101\t102\t229\t229\n101\t102\t230\t230\n101\t102\t231\t231\n101\t102\t232\t232\n101\t102\t233\t233\n101\t102\t234\t234\n101\t102\t235\t235\n101\t102\t236\t236\n101\t102\t237\t237\n101\t102\t238\t238\n101\t102\t239\t239\n101\t102\t240\t240\n101\t102\t241\t241\n101\t102\t242\t242\n101\t102\t243\t243\n101\t102\t244\t244\n101\t102\t245\t245\n101\t102\t246\t246\n101\t102\t247\t247\n101\t102\t248\t248\n101\t102\t249\t249\n101\t102\t250\t250\n101\t102\t251\t251\n101\t102\t252\t252\n101\t102\t253\t253\n101\t102\t254\t254\n101\t102\t255\t255\n102\t103\t0\t0\n102\t103\t1\t1\n102\t103\t2\t2\n102\t103\t3\t3\n102\t103\t4\t4\n102\t103\t5\t5\n102\t103\t6\t6\n102\t103\t7\t7\n102\t103\t8\t8\n102\t103\t9\t9\n102\t103\t10\t10\n102\t103\t11\t11\n102\t103\t12\t12\n102\t103\t13\t13\n102\t103\t14\t14\n102\t103\t15\t15\n102\t103\t16\t16\n102\t103\t17\t17\n102\t103\t18\t18\n102\t103\t19\t19\n102\t103\t20\t20\n102\t103\t21\t21\n102\t103\t22\t22\n102\t103\t23\t23\n102\t103\t24\t24\n102\t103\t25\t25\n102\t103\t26\t26\n102\t103\t27\t27\n102\t103\t28\t28\n102\t103\t29\t29\n102\t103\t30\t30\n102\t103\t31\t31\n102\t103\t32\t32\n102\t103\t33\t33\n102\t103\t34\t34\n102\t103\t35\t35\n102\t103\t36\t36\n102\t103\t37\t37\n102\t103\t38\t38\n102\t103\t39\t39\n102\t103\t40\t40\n102\t103\t41\t41\n102\t103\t42\t42\n102\t103\t43\t43\n102\t103\t44\t44\n102\t103\t45\t45\n102\t103\t46\t46\n102\t103\t47\t47\n102\t103\t48\t48\n102\t103\t49\t49\n102\t103\t50\t50\n102\t103\t51\t51\n102\t103\t52\t52\n102\t103\t53\t53\n102\t103\t54\t54\n102\t103\t55\t55\n102\t103\t56\t56\n102\t103\t57\t57\n102\t103\t58\t58\n102\t103\t59\t59\n102\t103\t60\t60\n102\t103\t61\t61\n102\t103\t62\t62\n102\t103\t63\t63\n102\t103\t64\t64\n102\t103\t65\t65\n102\t103\t66\t66\n102\t103\t67\t67\n102\t103\t68\t68\n102\t103\t69\t69\n102\t103\t70\t70\n102\t103\t71\t71\n102\t103\t72\t72\n102\t103\t73\t73\n102\t103\t74\t74\n102\t103\t75\t75\n102\t103\t76\t76\n102\t103\t77\t77\n102\t103\t78\t78\n102\t103\t79\t79\n102\t103\t80\t80\n102\t103\t81\t81\n102\t103\t82\t82\n102\t103\t83\t83\n102\t103\t84\t84\n102\t103\t85\t85\n102\t103\t86\t86\n102\t103\t87\t87\n102\t103\t88\t88\n102\t103\t89\t89\n102\t103\t90\t90\n102\t103\t91\t91\n102\t103\t92\t92\n102\t103\t93\t93\n102\t103\t94\t94\n102\t103\t95\t95\n102\t103\t96\t96\n102\t103\t97\t97\n102\t103\t98\t98\n102\t103\t99\t99\n102\t103\t100\t100\n102\t103\t101\t101\n102\t103\t102\t102\n102\t103\t103\t103\n102\t103\t104\t104\n102\t103\t105\t105\n102\t103\t106\t106\n102\t103\t107\t107\n102\t103\t108\t108\n102\t103\t109\t109\n102\t103\t110\t110\n102\t103\t111\t111\n102\t103\t112\t112\n102\t103\t113\t113\n102\t103\t114\t114\n102\t103\t115\t115\n102\t103\t116\t116\n102\t103\t117\t117\n102\t103\t118\t118\n102\t103\t119\t119\n102\t103\t120\t120\n102\t103\t121\t121\n102\t103\t122\t122\n102\t103\t123\t123\n102\t103\t124\t124\n102\t103\t125\t125\n102\t103\t126\t126\n102\t103\t127\t127\n102\t103\t128\t128\n102\t103\t129\t129\n102\t103\t130\t130\n102\t103\t131\t131\n102\t103\t132\t132\n102\t103\t133\t133\n102\t103\t134\t134\n102\t103\t135\t135\n102\t103\t136\t136\n102\t103\t137\t137\n102\t103\t138\t138\n102\t103\t139\t139\n102\t103\t140\t140\n102\t103\t141\t141\n102\t103\t142\t142\n102\t103\t143\t143\n102\t103\t144\t144\n102\t103\t145\t145\n102\t103\t146\t146\n102\t103\t147\t147\n102\t103\t148\t148\n102\t103\t149\t149\n102\t103\t150\t150\n102\t103\t151\t151\n102\t103\t152\t152\n102\t103\t153\t153\n102\t103\t154\t154\n102\t103\t155\t155\n102\t103\t156\t156\n102\t103\t157\t157\n102\t103\t158\t158\n102\t103\t159\t159\n102\t103\t160\t160\n102\t103\t161\t161\n102\t103\t162\t162\n102\t103\t163\t163\n102\t103\t164\t164\n102\t103\t165\t165\n102\t103\t166\t166\n102\t103\t167\t167\n102\t103\t168\t168\n102\t103\t169\t169\n102\t103\t170\t170\n102\t103\t171\t171\n102\t103\t172\t172\n102\t103\t173\t173\n102\t103\t174\t174\n102\t103\t175\t175\n102\t103\t176\t176\n102\t103\t177\t177\n102\t103\t178\t178\n102\t103\t179\t179\n102\t103\t180\t180\n102\t103\t181\t181\n102\t103\t182\t182\n102\t103\t183\t183\n102\t103\t184\t184\n102\t103\t185\t185\n102\t103\t186\t186\n102\t103\t187\t187\n102\t103\t188\t188\n102\t103\t189\t189\n102\t103\t190\t190\n102\t103\t191\t191\n102\t103\t192\t192\n102\t103\t193\t193\n102\t103\t194\t194\n102\t103\t195\t195\n102\t103\t196\t196\n102\t103\t197\t197\n102\t103\t198\t198\n102\t103\t199\t199\n102\t103\t200\t200\n102\t103\t201\t201\n102\t103\t202\t202\n102\t103\t203\t203\n102\t103\t204\t204\n102\t103\t205\t205\n102\t103\t206\t206\n102\t103\t207\t207\n102\t103\t208\t208\n102\t103\t209\t209\n102\t103\t210\t210\n102\t103\t211\t211\n102\t103\t212\t212\n102\t103\t213\t213\n102\t103\t214\t214\n102\t103\t215\t215\n102\t103\t216\t216\n102\t103\t217\t217\n102\t103\t218\t218\n102\t103\t219\t219\n102\t103\t220\t220\n102\t103\t221\t221\n102\t103\t222\t222\n102\t103\t223\t223\n102\t103\t224\t224\n102\t103\t225\t225\n102\t103\t226\t226\n102\t103\t227\t227\n102\t103\t228\t228\n102\t103\t229\t229\n102\t103\t230\t230\n102\t103\t231\t231\n102\t103\t232\t232\n102\t103\t233\t233\n102\t103\t234\t234\n102\t103\t235\t235\n102\t103\t236\t236\n102\t103\t237\t237\n102\t103\t238\t238\n102\t103\t239\t239\n102\t103\t240\t240\n102\t103\t241\t241\n102\t103\t242\t242\n102\t103\t243\t243\n102\t103\t244\t244\n102\t103\t245\t245\n102\t103\t246\t246\n102\t103\t247\t247\n102\t103\t248\t248\n102\t103\t249\t249\n102\t103\t250\t250\n102\t103\t251\t251\n102\t103\t252\t252\n102\t103\t253\t253\n102\t103\t254\t254\n102\t103\t255\t255\n103\t104\t0\t0\n103\t104\t1\t1\n103\t104\t2\t2\n103\t104\t3\t3\n103\t104\t4\t4\n103\t104\t5\t5\n103\t104\t6\t6\n103\t104\t7\t7\n103\t104\t8\t8\n103\t104\t9\t9\n103\t104\t10\t10\n103\t104\t11\t11\n103\t104\t12\t12\n103\t104\t13\t13\n103\t104\t14\t14\n103\t104\t15\t15\n103\t104\t16\t16\n103\t104\t17\t17\n103\t104\t18\t18\n103\t104\t19\t19\n103\t104\t20\t20\n103\t104\t21\t21\n103\t104\t22\t22\n103\t104\t23\t23\n103\t104\t24\t24\n103\t104\t25\t25\n103\t104\t26\t26\n103\t104\t27\t27\n103\t104\t28\t28\n103\t104\t29\t29\n103\t104\t30\t30\n103\t104\t31\t31\n103\t104\t32\t32\n103\t104\t33\t33\n103\t104\t34\t34\n103\t104\t35\t35\n103\t104\t36\t36\n103\t104\t37\t37\n103\t104\t38\t38\n103\t104\t39\t39\n103\t104\t40\t40\n103\t104\t41\t41\n103\t104\t42\t42\n103\t104\t43\t43\n103\t104\t44\t44\n103\t104\t45\t45\n103\t104\t46\t46\n103\t104\t47\t47\n103\t104\t48\t48\n103\t104\t49\t49\n103\t104\t50\t50\n103\t104\t51\t51\n103\t104\t52\t52\n103\t104\t53\t53\n103\t104\t54\t54\n103\t104\t55\t55\n103\t104\t56\t56\n103\t104\t57\t57\n103\t104\t58\t58\n103\t104\t59\t59\n103\t104\t60\t60\n103\t104\t61\t61\n103\t104\t62\t62\n103\t104\t63\t63\n103\t104\t64\t64\n103\t104\t65\t65\n103\t104\t66\t66\n103\t104\t67\t67\n103\t104\t68\t68\n103\t104\t69\t69\n103\t104\t70\t70\n103\t104\t71\t71\n103\t104\t72\t72\n103\t104\t73\t73\n103\t104\t74\t74\n103\t104\t75\t75\n103\t104\t76\t76\n103\t104\t77\t77\n103\t104\t78\t78\n103\t104\t79\t79\n103\t104\t80\t80\n103\t104\t81\t81\n103\t104\t82\t82\n103\t104\t83\t83\n103\t104\t84\t84\n103\t104\t85\t85\n103\t104\t86\t86\n103\t104\t87\t87\n103\t104\t88\t88\n103\t104\t89\t89\n103\t104\t90\t90\n103\t104\t91\t91\n103\t104\t92\t92\n103\t104\t93\t93\n103\t104\t94\t94\n103\t104\t95\t95\n103\t104\t96\t96\n103\t104\t97\t97\n103\t104\t98\t98\n103\t104\t99\t99\n103\t104\t100\t100\n103\t104\t101\t101\n103\t104\t102\t102\n103\t104\t103\t103\n103\t104\t104\t104\n103\t104\t105\t105\n103\t104\t106\t106\n103\t104\t107\t107\n103\t104\t108\t108\n103\t104\t109\t109\n103\t104\t110\t110\n103\t104\t111\t111\n103\t104\t112\t112\n103\t104\t113\t113\n103\t104\t114\t114\n103\t104\t115\t115\n103\t104\t116\t116\n103\t104\t117\t117\n103\t104\t118\t118\n103\t104\t119\t119\n103\t104\t120\t120\n103\t104\t121\t121\n103\t104\t122\t122\n103\t104\t123\t123\n103\t104\t124\t124\n103\t104\t125\t125\n103\t104\t126\t126\n103\t104\t127\t127\n103\t104\t128\t128\n103\t104\t129\t129\n103\t104\t130\t130\n103\t104\t131\t131\n103\t104\t132\t132\n103\t104\t133\t133\n103\t104\t134\t134\n103\t104\t135\t135\n103\t104\t136\t136\n103\t104\t137\t137\n103\t104\t138\t138\n103\t104\t139\t139\n103\t104\t140\t140\n103\t104\t141\t141\n103\t104\t142\t142\n103\t104\t143\t143\n103\t104\t144\t144\n103\t104\t145\t145\n103\t104\t146\t146\n103\t104\t147\t147\n103\t104\t148\t148\n103\t104\t149\t149\n103\t104\t150\t150\n103\t104\t151\t151\n103\t104\t152\t152\n103\t104\t153\t153\n103\t104\t154\t154\n103\t104\t155\t155\n103\t104\t156\t156\n103\t104\t157\t157\n103\t104\t158\t158\n103\t104\t159\t159\n103\t104\t160\t160\n103\t104\t161\t161\n103\t104\t162\t162\n103\t104\t163\t163\n103\t104\t164\t164\n103\t104\t165\t165\n103\t104\t166\t166\n103\t104\t167\t167\n103\t104\t168\t168\n103\t104\t169\t169\n103\t104\t170\t170\n103\t104\t171\t171\n103\t104\t172\t172\n103\t104\t173\t173\n103\t104\t174\t174\n103\t104\t175\t175\n103\t104\t176\t176\n103\t104\t177\t177\n103\t104\t178\t178\n103\t104\t179\t179\n103\t104\t180\t180\n103\t104\t181\t181\n103\t104\t182\t182\n103\t104\t183\t183\n103\t104\t184\t184\n103\t104\t185\t185\n103\t104\t186\t186\n103\t104\t187\t187\n103\t104\t188\t188\n103\t104\t189\t189\n103\t104\t190\t190\n103\t104\t191\t191\n103\t104\t192\t192\n103\t104\t193\t193\n103\t104\t194\t194\n103\t104\t195\t195\n103\t104\t196\t196\n103\t104\t197\t197\n103\t104\t198\t198\n103\t104\t199\t199\n103\t104\t200\t200\n103\t104\t201\t201\n103\t104\t202\t202\n103\t104\t203\t203\n103\t104\t204\t204\n103\t104\t205\t205\n103\t104\t206\t206\n103\t104\t207\t207\n103\t104\t208\t208\n103\t104\t209\t209\n103\t104\t210\t210\n103\t104\t211\t211\n103\t104\t212\t212\n103\t104\t213\t213\n103\t104\t214\t214\n103\t104\t215\t215\n103\t104\t216\t216\n103\t104\t217\t217\n103\t104\t218\t218\n103\t104\t219\t219\n103\t104\t220\t220\n103\t104\t221\t221\n103\t104\t222\t222\n103\t104\t223\t223\n103\t104\t224\t224\n103\t104\t225\t225\n103\t104\t226\t226\n103\t104\t227\t227\n103\t104\t228\t228\n103\t104\t229\t229\n103\t104\t230\t230\n103\t104\t231\t231\n103\t104\t232\t232\n103\t104\t233\t233\n103\t104\t234\t234\n103\t104\t235\t235\n103\t104\t236\t236\n103\t104\t237\t237\n103\t104\t238\t238\n103\t104\t239\t239\n103\t104\t240\t240\n103\t104\t241\t241\n103\t104\t242\t242\n103\t104\t243\t243\n103\t104\t244\t244\n103\t104\t245\t245\n103\t104\t246\t246\n103\t104\t247\t247\n103\t104\t248\t248\n103\t104\t249\t249\n103\t104\t250\t250\n103\t104\t251\t251\n103\t104\t252\t252\n103\t104\t253\t253\n103\t104\t254\t254\n103\t104\t255\t255\n104\t105\t0\t0\n104\t105\t1\t1\n104\t105\t2\t2\n104\t105\t3\t3\n104\t105\t4\t4\n104\t105\t5\t5\n104\t105\t6\t6\n104\t105\t7\t7\n104\t105\t8\t8\n104\t105\t9\t9\n104\t105\t10\t10\n104\t105\t11\t11\n104\t105\t12\t12\n104\t105\t13\t13\n104\t105\t14\t14\n104\t105\t15\t15\n104\t105\t16\t16\n104\t105\t17\t17\n104\t105\t18\t18\n104\t105\t19\t19\n104\t105\t20\t20\n104\t105\t21\t21\n104\t105\t22\t22\n104\t105\t23\t23\n104\t105\t24\t24\n104\t105\t25\t25\n104\t105\t26\t26\n104\t105\t27\t27\n104\t105\t28\t28\n104\t105\t29\t29\n104\t105\t30\t30\n104\t105\t31\t31\n104\t105\t32\t32\n104\t105\t33\t33\n104\t105\t34\t34\n104\t105\t35\t35\n104\t105\t36\t36\n104\t105\t37\t37\n104\t105\t38\t38\n104\t105\t39\t39\n104\t105\t40\t40\n104\t105\t41\t41\n104\t105\t42\t42\n104\t105\t43\t43\n104\t105\t44\t44\n104\t105\t45\t45\n104\t105\t46\t46\n104\t105\t47\t47\n104\t105\t48\t48\n104\t105\t49\t49\n104\t105\t50\t50\n104\t105\t51\t51\n104\t105\t52\t52\n104\t105\t53\t53\n104\t105\t54\t54\n104\t105\t55\t55\n104\t105\t56\t56\n104\t105\t57\t57\n104\t105\t58\t58\n104\t105\t59\t59\n104\t105\t60\t60\n104\t105\t61\t61\n104\t105\t62\t62\n104\t105\t63\t63\n104\t105\t64\t64\n104\t105\t65\t65\n104\t105\t66\t66\n104\t105\t67\t67\n104\t105\t68\t68\n104\t105\t69\t69\n104\t105\t70\t70\n104\t105\t71\t71\n104\t105\t72\t72\n104\t105\t73\t73\n104\t105\t74\t74\n104\t105\t75\t75\n104\t105\t76\t76\n104\t105\t77\t77\n104\t105\t78\t78\n104\t105\t79\t79\n104\t105\t80\t80\n104\t105\t81\t81\n104\t105\t82\t82\n104\t105\t83\t83\n104\t105\t84\t84\n104\t105\t85\t85\n104\t105\t86\t86\n104\t105\t87\t87\n104\t105\t88\t88\n104\t105\t89\t89\n104\t105\t90\t90\n104\t105\t91\t91\n104\t105\t92\t92\n104\t105\t93\t93\n104\t105\t94\t94\n104\t105\t95\t95\n104\t105\t96\t96\n104\t105\t97\t97\n104\t105\t98\t98\n104\t105\t99\t99\n104\t105\t100\t100\n104\t105\t101\t101\n104\t105\t102\t102\n104\t105\t103\t103\n104\t105\t104\t104\n104\t105\t105\t105\n104\t105\t106\t106\n104\t105\t107\t107\n104\t105\t108\t108\n104\t105\t109\t109\n104\t105\t110\t110\n104\t105\t111\t111\n104\t105\t112\t112\n104\t105\t113\t113\n104\t105\t114\t114\n104\t105\t115\t115\n104\t105\t116\t116\n104\t105\t117\t117\n104\t105\t118\t118\n104\t105\t119\t119\n104\t105\t120\t120\n104\t105\t121\t121\n104\t105\t122\t122\n104\t105\t123\t123\n104\t105\t124\t124\n104\t105\t125\t125\n104\t105\t126\t126\n104\t105\t127\t127\n104\t105\t128\t128\n104\t105\t129\t129\n104\t105\t130\t130\n104\t105\t131\t131\n104\t105\t132\t132\n104\t105\t133\t133\n104\t105\t134\t134\n104\t105\t135\t135\n104\t105\t136\t136\n104\t105\t137\t137\n104\t105\t138\t138\n104\t105\t139\t139\n104\t105\t140\t140\n104\t105\t141\t141\n104\t105\t142\t142\n104\t105\t143\t143\n104\t105\t144\t144\n104\t105\t145\t145\n104\t105\t146\t146\n104\t105\t147\t147\n104\t105\t148\t148\n104\t105\t149\t149\n104\t105\t150\t150\n104\t105\t151\t151\n104\t105\t152\t152\n104\t105\t153\t153\n104\t105\t154\t154\n104\t105\t155\t155\n104\t105\t156\t156\n104\t105\t157\t157\n104\t105\t158\t158\n104\t105\t159\t159\n104\t105\t160\t160\n104\t105\t161\t161\n104\t105\t162\t162\n104\t105\t163\t163\n104\t105\t164\t164\n104\t105\t165\t165\n104\t105\t166\t166\n104\t105\t167\t167\n104\t105\t168\t168\n104\t105\t169\t169\n104\t105\t170\t170\n104\t105\t171\t171\n104\t105\t172\t172\n104\t105\t173\t173\n104\t105\t174\t174\n104\t105\t175\t175\n104\t105\t176\t176\n104\t105\t177\t177\n104\t105\t178\t178\n104\t105\t179\t179\n104\t105\t180\t180\n104\t105\t181\t181\n104\t105\t182\t182\n104\t105\t183\t183\n104\t105\t184\t184\n104\t105\t185\t185\n104\t105\t186\t186\n104\t105\t187\t187\n104\t105\t188\t188\n104\t105\t189\t189\n104\t105\t190\t190\n104\t105\t191\t191\n104\t105\t192\t192\n104\t105\t193\t193\n104\t105\t194\t194\n104\t105\t195\t195\n104\t105\t196\t196\n104\t105\t197\t197\n104\t105\t198\t198\n104\t105\t199\t199\n104\t105\t200\t200\n104\t105\t201\t201\n104\t105\t202\t202\n104\t105\t203\t203\n104\t105\t204\t204\n104\t105\t205\t205\n104\t105\t206\t206\n104\t105\t207\t207\n104\t105\t208\t208\n104\t105\t209\t209\n104\t105\t210\t210\n104\t105\t211\t211\n104\t105\t212\t212\n104\t105\t213\t213\n104\t105\t214\t214\n104\t105\t215\t215\n104\t105\t216\t216\n104\t105\t217\t217\n104\t105\t218\t218\n104\t105\t219\t219\n104\t105\t220\t220\n104\t105\t221\t221\n104\t105\t222\t222\n104\t105\t223\t223\n104\t105\t224\t224\n104\t105\t225\t225\n104\t105\t226\t226\n104\t105\t227\t227\n104\t105\t228\t228\n104\t105\t229\t229\n104\t105\t230\t230\n104\t105\t231\t231\n104\t105\t232\t232\n104\t105\t233\t233\n104\t105\t234\t234\n104\t105\t235\t235\n104\t105\t236\t236\n104\t105\t237\t237\n104\t105\t238\t238\n104\t105\t239\t239\n104\t105\t240\t240\n104\t105\t241\t241\n104\t105\t242\t242\n104\t105\t243\t243\n104\t105\t244\t244\n104\t105\t245\t245\n104\t105\t246\t246\n104\t105\t247\t247\n104\t105\t248\t248\n104\t105\t249\t249\n104\t105\t250\t250\n104\t105\t251\t251\n104\t105\t252\t252\n104\t105\t253\t253\n104\t105\t254\t254\n104\t105\t255\t255\n105\t106\t0\t0\n105\t106\t1\t1\n105\t106\t2\t2\n105\t106\t3\t3\n105\t106\t4\t4\n105\t106\t5\t5\n105\t106\t6\t6\n105\t106\t7\t7\n105\t106\t8\t8\n105\t106\t9\t9\n105\t106\t10\t10\n105\t106\t11\t11\n105\t106\t12\t12\n105\t106\t13\t13\n105\t106\t14\t14\n105\t106\t15\t15\n105\t106\t16\t16\n105\t106\t17\t17\n105\t106\t18\t18\n105\t106\t19\t19\n105\t106\t20\t20\n105\t106\t21\t21\n105\t106\t22\t22\n105\t106\t23\t23\n105\t106\t24\t24\n105\t106\t25\t25\n105\t106\t26\t26\n105\t106\t27\t27\n105\t106\t28\t28\n105\t106\t29\t29\n105\t106\t30\t30\n105\t106\t31\t31\n105\t106\t32\t32\n105\t106\t33\t33\n105\t106\t34\t34\n105\t106\t35\t35\n105\t106\t36\t36\n105\t106\t37\t37\n105\t106\t38\t38\n105\t106\t39\t39\n105\t106\t40\t40\n105\t106\t41\t41\n105\t106\t42\t42\n105\t106\t43\t43\n105\t106\t44\t44\n105\t106\t45\t45\n105\t106\t46\t46\n105\t106\t47\t47\n105\t106\t48\t48\n105\t106\t49\t49\n105\t106\t50\t50\n105\t106\t51\t51\n105\t106\t52\t52\n105\t106\t53\t53\n105\t106\t54\t54\n105\t106\t55\t55\n105\t106\t56\t56\n105\t106\t57\t57\n105\t106\t58\t58\n105\t106\t59\t59\n105\t106\t60\t60\n105\t106\t61\t61\n105\t106\t62\t62\n105\t106\t63\t63\n105\t106\t64\t64\n105\t106\t65\t65\n105\t106\t66\t66\n105\t106\t67\t67\n105\t106\t68\t68\n105\t106\t69\t69\n105\t106\t70\t70\n105\t106\t71\t71\n105\t106\t72\t72\n105\t106\t73\t73\n105\t106\t74\t74\n105\t106\t75\t75\n105\t106\t76\t76\n105\t106\t77\t77\n105\t106\t78\t78\n105\t106\t79\t79\n105\t106\t80\t80\n105\t106\t81\t81\n105\t106\t82\t82\n105\t106\t83\t83\n105\t106\t84\t84\n105\t106\t85\t85\n105\t106\t86\t86\n105\t106\t87\t87\n105\t106\t88\t88\n105\t106\t89\t89\n105\t106\t90\t90\n105\t106\t91\t91\n105\t106\t92\t92\n105\t106\t93\t93\n105\t106\t94\t94\n105\t106\t95\t95\n105\t106\t96\t96\n105\t106\t97\t97\n105\t106\t98\t98\n105\t106\t99\t99\n105\t106\t100\t100\n105\t106\t101\t101\n105\t106\t102\t102\n105\t106\t103\t103\n105\t106\t104\t104\n105\t106\t105\t105\n105\t106\t106\t106\n105\t106\t107\t107\n105\t106\t108\t108\n105\t106\t109\t109\n105\t106\t110\t110\n105\t106\t111\t111\n105\t106\t112\t112\n105\t106\t113\t113\n105\t106\t114\t114\n105\t106\t115\t115\n105\t106\t116\t116\n105\t106\t117\t117\n105\t106\t118\t118\n105\t106\t119\t119\n105\t106\t120\t120\n105\t106\t121\t121\n105\t106\t122\t122\n105\t106\t123\t123\n105\t106\t124\t124\n105\t106\t125\t125\n105\t106\t126\t126\n105\t106\t127\t127\n105\t106\t128\t128\n105\t106\t129\t129\n105\t106\t130\t130\n105\t106\t131\t131\n105\t106\t132\t132\n105\t106\t133\t133\n105\t106\t134\t134\n105\t106\t135\t135\n105\t106\t136\t136\n105\t106\t137\t137\n105\t106\t138\t138\n105\t106\t139\t139\n105\t106\t140\t140\n105\t106\t141\t141\n105\t106\t142\t142\n105\t106\t143\t143\n105\t106\t144\t144\n105\t106\t145\t145\n105\t106\t146\t146\n105\t106\t147\t147\n105\t106\t148\t148\n105\t106\t149\t149\n105\t106\t150\t150\n105\t106\t151\t151\n105\t106\t152\t152\n105\t106\t153\t153\n105\t106\t154\t154\n105\t106\t155\t155\n105\t106\t156\t156\n105\t106\t157\t157\n105\t106\t158\t158\n105\t106\t159\t159\n105\t106\t160\t160\n105\t106\t161\t161\n105\t106\t162\t162\n105\t106\t163\t163\n105\t106\t164\t164\n105\t106\t165\t165\n105\t106\t166\t166\n105\t106\t167\t167\n105\t106\t168\t168\n105\t106\t169\t169\n105\t106\t170\t170\n105\t106\t171\t171\n105\t106\t172\t172\n105\t106\t173\t173\n105\t106\t174\t174\n105\t106\t175\t175\n105\t106\t176\t176\n105\t106\t177\t177\n105\t106\t178\t178\n105\t106\t179\t179\n105\t106\t180\t180\n105\t106\t181\t181\n105\t106\t182\t182\n105\t106\t183\t183\n105\t106\t184\t184\n105\t106\t185\t185\n105\t106\t186\t186\n105\t106\t187\t187\n105\t106\t188\t188\n105\t106\t189\t189\n105\t106\t190\t190\n105\t106\t191\t191\n105\t106\t192\t192\n105\t106\t193\t193\n105\t106\t194\t194\n105\t106\t195\t195\n105\t106\t196\t196\n105\t106\t197\t197\n105\t106\t198\t198\n105\t106\t199\t199\n105\t106\t200\t200\n105\t106\t201\t201\n105\t106\t202\t202\n105\t106\t203\t203\n105\t106\t204\t204\n105\t106\t205\t205\n105\t106\t206\t206\n105\t106\t207\t207\n105\t106\t208\t208\n105\t106\t209\t209\n105\t106\t210\t210\n105\t106\t211\t211\n105\t106\t212\t212\n105\t106\t213\t213\n105\t106\t214\t214\n105\t106\t215\t215\n105\t106\t216\t216\n105\t106\t217\t217\n105\t106\t218\t218\n105\t106\t219\t219\n105\t106\t220\t220\n105\t106\t221\t221\n105\t106\t222\t222\n105\t106\t223\t223\n105\t106\t224\t224\n105\t106\t225\t225\n105\t106\t226\t226\n105\t106\t227\t227\n105\t106\t228\t228\n105\t106\t229\t229\n105\t106\t230\t230\n105\t106\t231\t231\n105\t106\t232\t232\n105\t106\t233\t233\n105\t106\t234\t234\n105\t106\t235\t235\n105\t106\t236\t236\n105\t106\t237\t237\n105\t106\t238\t238\n105\t106\t239\t239\n105\t106\t240\t240\n105\t106\t241\t241\n105\t106\t242\t242\n105\t106\t243\t243\n105\t106\t244\t244\n105\t106\t245\t245\n105\t106\t246\t246\n105\t106\t247\t247\n105\t106\t248\t248\n105\t106\t249\t249\n105\t106\t250\t250\n105\t106\t251\t251\n105\t106\t252\t252\n105\t106\t253\t253\n105\t106\t254\t254\n105\t106\t255\t255\n106\t107\t0\t0\n106\t107\t1\t1\n106\t107\t2\t2\n106\t107\t3\t3\n106\t107\t4\t4\n106\t107\t5\t5\n106\t107\t6\t6\n106\t107\t7\t7\n106\t107\t8\t8\n106\t107\t9\t9\n106\t107\t10\t10\n106\t107\t11\t11\n106\t107\t12\t12\n106\t107\t13\t13\n106\t107\t14\t14\n106\t107\t15\t15\n106\t107\t16\t16\n106\t107\t17\t17\n106\t107\t18\t18\n106\t107\t19\t19\n106\t107\t20\t20\n106\t107\t21\t21\n106\t107\t22\t22\n106\t107\t23\t23\n106\t107\t24\t24\n106\t107\t25\t25\n106\t107\t26\t26\n106\t107\t27\t27\n106\t107\t28\t28\n106\t107\t29\t29\n106\t107\t30\t30\n106\t107\t31\t31\n106\t107\t32\t32\n106\t107\t33\t33\n106\t107\t34\t34\n106\t107\t35\t35\n106\t107\t36\t36\n106\t107\t37\t37\n106\t107\t38\t38\n106\t107\t39\t39\n106\t107\t40\t40\n106\t107\t41\t41\n106\t107\t42\t42\n106\t107\t43\t43\n106\t107\t44\t44\n106\t107\t45\t45\n106\t107\t46\t46\n106\t107\t47\t47\n106\t107\t48\t48\n106\t107\t49\t49\n106\t107\t50\t50\n106\t107\t51\t51\n106\t107\t52\t52\n106\t107\t53\t53\n106\t107\t54\t54\n106\t107\t55\t55\n106\t107\t56\t56\n106\t107\t57\t57\n106\t107\t58\t58\n106\t107\t59\t59\n106\t107\t60\t60\n106\t107\t61\t61\n106\t107\t62\t62\n106\t107\t63\t63\n106\t107\t64\t64\n106\t107\t65\t65\n106\t107\t66\t66\n106\t107\t67\t67\n106\t107\t68\t68\n106\t107\t69\t69\n106\t107\t70\t70\n106\t107\t71\t71\n106\t107\t72\t72\n106\t107\t73\t73\n106\t107\t74\t74\n106\t107\t75\t75\n106\t107\t76\t76\n106\t107\t77\t77\n106\t107\t78\t78\n106\t107\t79\t79\n106\t107\t80\t80\n106\t107\t81\t81\n106\t107\t82\t82\n106\t107\t83\t83\n106\t107\t84\t84\n106\t107\t85\t85\n106\t107\t86\t86\n106\t107\t87\t87\n106\t107\t88\t88\n106\t107\t89\t89\n106\t107\t90\t90\n106\t107\t91\t91\n106\t107\t92\t92\n106\t107\t93\t93\n106\t107\t94\t94\n106\t107\t95\t95\n106\t107\t96\t96\n106\t107\t97\t97\n106\t107\t98\t98\n106\t107\t99\t99\n106\t107\t100\t100\n106\t107\t101\t101\n106\t107\t102\t102\n106\t107\t103\t103\n106\t107\t104\t104\n106\t107\t105\t105\n106\t107\t106\t106\n106\t107\t107\t107\n106\t107\t108\t108\n106\t107\t109\t109\n106\t107\t110\t110\n106\t107\t111\t111\n106\t107\t112\t112\n106\t107\t113\t113\n106\t107\t114\t114\n106\t107\t115\t115\n106\t107\t116\t116\n106\t107\t117\t117\n106\t107\t118\t118\n106\t107\t119\t119\n106\t107\t120\t120\n106\t107\t121\t121\n106\t107\t122\t122\n106\t107\t123\t123\n106\t107\t124\t124\n106\t107\t125\t125\n106\t107\t126\t126\n106\t107\t127\t127\n106\t107\t128\t128\n106\t107\t129\t129\n106\t107\t130\t130\n106\t107\t131\t131\n106\t107\t132\t132\n106\t107\t133\t133\n106\t107\t134\t134\n106\t107\t135\t135\n106\t107\t136\t136\n106\t107\t137\t137\n106\t107\t138\t138\n106\t107\t139\t139\n106\t107\t140\t140\n106\t107\t141\t141\n106\t107\t142\t142\n106\t107\t143\t143\n106\t107\t144\t144\n106\t107\t145\t145\n106\t107\t146\t146\n106\t107\t147\t147\n106\t107\t148\t148\n106\t107\t149\t149\n106\t107\t150\t150\n106\t107\t151\t151\n106\t107\t152\t152\n106\t107\t153\t153\n106\t107\t154\t154\n106\t107\t155\t155\n106\t107\t156\t156\n106\t107\t157\t157\n106\t107\t158\t158\n106\t107\t159\t159\n106\t107\t160\t160\n106\t107\t161\t161\n106\t107\t162\t162\n106\t107\t163\t163\n106\t107\t164\t164\n106\t107\t165\t165\n106\t107\t166\t166\n106\t107\t167\t167\n106\t107\t168\t168\n106\t107\t169\t169\n106\t107\t170\t170\n106\t107\t171\t171\n106\t107\t172\t172\n106\t107\t173\t173\n106\t107\t174\t174\n106\t107\t175\t175\n106\t107\t176\t176\n106\t107\t177\t177\n106\t107\t178\t178\n106\t107\t179\t179\n106\t107\t180\t180\n106\t107\t181\t181\n106\t107\t182\t182\n106\t107\t183\t183\n106\t107\t184\t184\n106\t107\t185\t185\n106\t107\t186\t186\n106\t107\t187\t187\n106\t107\t188\t188\n106\t107\t189\t189\n106\t107\t190\t190\n106\t107\t191\t191\n106\t107\t192\t192\n106\t107\t193\t193\n106\t107\t194\t194\n106\t107\t195\t195\n106\t107\t196\t196\n106\t107\t197\t197\n106\t107\t198\t198\n106\t107\t199\t199\n106\t107\t200\t200\n106\t107\t201\t201\n106\t107\t202\t202\n106\t107\t203\t203\n106\t107\t204\t204\n106\t107\t205\t205\n106\t107\t206\t206\n106\t107\t207\t207\n106\t107\t208\t208\n106\t107\t209\t209\n106\t107\t210\t210\n106\t107\t211\t211\n106\t107\t212\t212\n106\t107\t213\t213\n106\t107\t214\t214\n106\t107\t215\t215\n106\t107\t216\t216\n106\t107\t217\t217\n106\t107\t218\t218\n106\t107\t219\t219\n106\t107\t220\t220\n106\t107\t221\t221\n106\t107\t222\t222\n106\t107\t223\t223\n106\t107\t224\t224\n106\t107\t225\t225\n106\t107\t226\t226\n106\t107\t227\t227\n106\t107\t228\t228\n106\t107\t229\t229\n106\t107\t230\t230\n106\t107\t231\t231\n106\t107\t232\t232\n106\t107\t233\t233\n106\t107\t234\t234\n106\t107\t235\t235\n106\t107\t236\t236\n106\t107\t237\t237\n106\t107\t238\t238\n106\t107\t239\t239\n106\t107\t240\t240\n106\t107\t241\t241\n106\t107\t242\t242\n106\t107\t243\t243\n106\t107\t244\t244\n106\t107\t245\t245\n106\t107\t246\t246\n106\t107\t247\t247\n106\t107\t248\t248\n106\t107\t249\t249\n106\t107\t250\t250\n106\t107\t251\t251\n106\t107\t252\t252\n106\t107\t253\t253\n106\t107\t254\t254\n106\t107\t255\t255\n107\t108\t0\t0\n107\t108\t1\t1\n107\t108\t2\t2\n107\t108\t3\t3\n107\t108\t4\t4\n107\t108\t5\t5\n107\t108\t6\t6\n107\t108\t7\t7\n107\t108\t8\t8\n107\t108\t9\t9\n107\t108\t10\t10\n107\t108\t11\t11\n107\t108\t12\t12\n107\t108\t13\t13\n107\t108\t14\t14\n107\t108\t15\t15\n107\t108\t16\t16\n107\t108\t17\t17\n107\t108\t18\t18\n107\t108\t19\t19\n107\t108\t20\t20\n107\t108\t21\t21\n107\t108\t22\t22\n107\t108\t23\t23\n107\t108\t24\t24\n107\t108\t25\t25\n107\t108\t26\t26\n107\t108\t27\t27\n107\t108\t28\t28\n107\t108\t29\t29\n107\t108\t30\t30\n107\t108\t31\t31\n107\t108\t32\t32\n107\t108\t33\t33\n107\t108\t34\t34\n107\t108\t35\t35\n107\t108\t36\t36\n107\t108\t37\t37\n107\t108\t38\t38\n107\t108\t39\t39\n107\t108\t40\t40\n107\t108\t41\t41\n107\t108\t42\t42\n107\t108\t43\t43\n107\t108\t44\t44\n107\t108\t45\t45\n107\t108\t46\t46\n107\t108\t47\t47\n107\t108\t48\t48\n107\t108\t49\t49\n107\t108\t50\t50\n107\t108\t51\t51\n107\t108\t52\t52\n107\t108\t53\t53\n107\t108\t54\t54\n107\t108\t55\t55\n107\t108\t56\t56\n107\t108\t57\t57\n107\t108\t58\t58\n107\t108\t59\t59\n107\t108\t60\t60\n107\t108\t61\t61\n107\t108\t62\t62\n107\t108\t63\t63\n107\t108\t64\t64\n107\t108\t65\t65\n107\t108\t66\t66\n107\t108\t67\t67\n107\t108\t68\t68\n107\t108\t69\t69\n107\t108\t70\t70\n107\t108\t71\t71\n107\t108\t72\t72\n107\t108\t73\t73\n107\t108\t74\t74\n107\t108\t75\t75\n107\t108\t76\t76\n107\t108\t77\t77\n107\t108\t78\t78\n107\t108\t79\t79\n107\t108\t80\t80\n107\t108\t81\t81\n107\t108\t82\t82\n107\t108\t83\t83\n107\t108\t84\t84\n107\t108\t85\t85\n107\t108\t86\t86\n107\t108\t87\t87\n107\t108\t88\t88\n107\t108\t89\t89\n107\t108\t90\t90\n107\t108\t91\t91\n107\t108\t92\t92\n107\t108\t93\t93\n107\t108\t94\t94\n107\t108\t95\t95\n107\t108\t96\t96\n107\t108\t97\t97\n107\t108\t98\t98\n107\t108\t99\t99\n107\t108\t100\t100\n107\t108\t101\t101\n107\t108\t102\t102\n107\t108\t103\t103\n107\t108\t104\t104\n107\t108\t105\t105\n107\t108\t106\t106\n107\t108\t107\t107\n107\t108\t108\t108\n107\t108\t109\t109\n107\t108\t110\t110\n107\t108\t111\t111\n107\t108\t112\t112\n107\t108\t113\t113\n107\t108\t114\t114\n107\t108\t115\t115\n107\t108\t116\t116\n107\t108\t117\t117\n107\t108\t118\t118\n107\t108\t119\t119\n107\t108\t120\t120\n107\t108\t121\t121\n107\t108\t122\t122\n107\t108\t123\t123\n107\t108\t124\t124\n107\t108\t125\t125\n107\t108\t126\t126\n107\t108\t127\t127\n107\t108\t128\t128\n107\t108\t129\t129\n107\t108\t130\t130\n107\t108\t131\t131\n107\t108\t132\t132\n107\t108\t133\t133\n107\t108\t134\t134\n107\t108\t135\t135\n107\t108\t136\t136\n107\t108\t137\t137\n107\t108\t138\t138\n107\t108\t139\t139\n107\t108\t140\t140\n107\t108\t141\t141\n107\t108\t142\t142\n107\t108\t143\t143\n107\t108\t144\t144\n107\t108\t145\t145\n107\t108\t146\t146\n107\t108\t147\t147\n107\t108\t148\t148\n107\t108\t149\t149\n107\t108\t150\t150\n107\t108\t151\t151\n107\t108\t152\t152\n107\t108\t153\t153\n107\t108\t154\t154\n107\t108\t155\t155\n107\t108\t156\t156\n107\t108\t157\t157\n107\t108\t158\t158\n107\t108\t159\t159\n107\t108\t160\t160\n107\t108\t161\t161\n107\t108\t162\t162\n107\t108\t163\t163\n107\t108\t164\t164\n107\t108\t165\t165\n107\t108\t166\t166\n107\t108\t167\t167\n107\t108\t168\t168\n107\t108\t169\t169\n107\t108\t170\t170\n107\t108\t171\t171\n107\t108\t172\t172\n107\t108\t173\t173\n107\t108\t174\t174\n107\t108\t175\t175\n107\t108\t176\t176\n107\t108\t177\t177\n107\t108\t178\t178\n107\t108\t179\t179\n107\t108\t180\t180\n107\t108\t181\t181\n107\t108\t182\t182\n107\t108\t183\t183\n107\t108\t184\t184\n107\t108\t185\t185\n107\t108\t186\t186\n107\t108\t187\t187\n107\t108\t188\t188\n107\t108\t189\t189\n107\t108\t190\t190\n107\t108\t191\t191\n107\t108\t192\t192\n107\t108\t193\t193\n107\t108\t194\t194\n107\t108\t195\t195\n107\t108\t196\t196\n107\t108\t197\t197\n107\t108\t198\t198\n107\t108\t199\t199\n107\t108\t200\t200\n107\t108\t201\t201\n107\t108\t202\t202\n107\t108\t203\t203\n107\t108\t204\t204\n107\t108\t205\t205\n107\t108\t206\t206\n107\t108\t207\t207\n107\t108\t208\t208\n107\t108\t209\t209\n107\t108\t210\t210\n107\t108\t211\t211\n107\t108\t212\t212\n107\t108\t213\t213\n107\t108\t214\t214\n107\t108\t215\t215\n107\t108\t216\t216\n107\t108\t217\t217\n107\t108\t218\t218\n107\t108\t219\t219\n107\t108\t220\t220\n107\t108\t221\t221\n107\t108\t222\t222\n107\t108\t223\t223\n107\t108\t224\t224\n107\t108\t225\t225\n107\t108\t226\t226\n107\t108\t227\t227\n107\t108\t228\t228\n107\t108\t229\t229\n107\t108\t230\t230\n107\t108\t231\t231\n107\t108\t232\t232\n107\t108\t233\t233\n107\t108\t234\t234\n107\t108\t235\t235\n107\t108\t236\t236\n107\t108\t237\t237\n107\t108\t238\t238\n107\t108\t239\t239\n107\t108\t240\t240\n107\t108\t241\t241\n107\t108\t242\t242\n107\t108\t243\t243\n107\t108\t244\t244\n107\t108\t245\t245\n107\t108\t246\t246\n107\t108\t247\t247\n107\t108\t248\t248\n107\t108\t249\t249\n107\t108\t250\t250\n107\t108\t251\t251\n107\t108\t252\t252\n107\t108\t253\t253\n107\t108\t254\t254\n107\t108\t255\t255\n108\t109\t0\t0\n108\t109\t1\t1\n108\t109\t2\t2\n108\t109\t3\t3\n108\t109\t4\t4\n108\t109\t5\t5\n108\t109\t6\t6\n108\t109\t7\t7\n108\t109\t8\t8\n108\t109\t9\t9\n108\t109\t10\t10\n108\t109\t11\t11\n108\t109\t12\t12\n108\t109\t13\t13\n108\t109\t14\t14\n108\t109\t15\t15\n108\t109\t16\t16\n108\t109\t17\t17\n108\t109\t18\t18\n108\t109\t19\t19\n108\t109\t20\t20\n108\t109\t21\t21\n108\t109\t22\t22\n108\t109\t23\t23\n108\t109\t24\t24\n108\t109\t25\t25\n108\t109\t26\t26\n108\t109\t27\t27\n108\t109\t28\t28\n108\t109\t29\t29\n108\t109\t30\t30\n108\t109\t31\t31\n108\t109\t32\t32\n108\t109\t33\t33\n108\t109\t34\t34\n108\t109\t35\t35\n108\t109\t36\t36\n108\t109\t37\t37\n108\t109\t38\t38\n108\t109\t39\t39\n108\t109\t40\t40\n108\t109\t41\t41\n108\t109\t42\t42\n108\t109\t43\t43\n108\t109\t44\t44\n108\t109\t45\t45\n108\t109\t46\t46\n108\t109\t47\t47\n108\t109\t48\t48\n108\t109\t49\t49\n108\t109\t50\t50\n108\t109\t51\t51\n108\t109\t52\t52\n108\t109\t53\t53\n108\t109\t54\t54\n108\t109\t55\t55\n108\t109\t56\t56\n108\t109\t57\t57\n108\t109\t58\t58\n108\t109\t59\t59\n108\t109\t60\t60\n108\t109\t61\t61\n108\t109\t62\t62\n108\t109\t63\t63\n108\t109\t64\t64\n108\t109\t65\t65\n108\t109\t66\t66\n108\t109\t67\t67\n108\t109\t68\t68\n108\t109\t69\t69\n108\t109\t70\t70\n108\t109\t71\t71\n108\t109\t72\t72\n108\t109\t73\t73\n108\t109\t74\t74\n108\t109\t75\t75\n108\t109\t76\t76\n108\t109\t77\t77\n108\t109\t78\t78\n108\t109\t79\t79\n108\t109\t80\t80\n108\t109\t81\t81\n108\t109\t82\t82\n108\t109\t83\t83\n108\t109\t84\t84\n108\t109\t85\t85\n108\t109\t86\t86\n108\t109\t87\t87\n108\t109\t88\t88\n108\t109\t89\t89\n108\t109\t90\t90\n108\t109\t91\t91\n108\t109\t92\t92\n108\t109\t93\t93\n108\t109\t94\t94\n108\t109\t95\t95\n108\t109\t96\t96\n108\t109\t97\t97\n108\t109\t98\t98\n108\t109\t99\t99\n108\t109\t100\t100\n108\t109\t101\t101\n108\t109\t102\t102\n108\t109\t103\t103\n108\t109\t104\t104\n108\t109\t105\t105\n108\t109\t106\t106\n108\t109\t107\t107\n108\t109\t108\t108\n108\t109\t109\t109\n108\t109\t110\t110\n108\t109\t111\t111\n108\t109\t112\t112\n108\t109\t113\t113\n108\t109\t114\t114\n108\t109\t115\t115\n108\t109\t116\t116\n108\t109\t117\t117\n108\t109\t118\t118\n108\t109\t119\t119\n108\t109\t120\t120\n108\t109\t121\t121\n108\t109\t122\t122\n108\t109\t123\t123\n108\t109\t124\t124\n108\t109\t125\t125\n108\t109\t126\t126\n108\t109\t127\t127\n108\t109\t128\t128\n108\t109\t129\t129\n108\t109\t130\t130\n108\t109\t131\t131\n108\t109\t132\t132\n108\t109\t133\t133\n108\t109\t134\t134\n108\t109\t135\t135\n108\t109\t136\t136\n108\t109\t137\t137\n108\t109\t138\t138\n108\t109\t139\t139\n108\t109\t140\t140\n108\t109\t141\t141\n108\t109\t142\t142\n108\t109\t143\t143\n108\t109\t144\t144\n108\t109\t145\t145\n108\t109\t146\t146\n108\t109\t147\t147\n108\t109\t148\t148\n108\t109\t149\t149\n108\t109\t150\t150\n108\t109\t151\t151\n108\t109\t152\t152\n108\t109\t153\t153\n108\t109\t154\t154\n108\t109\t155\t155\n108\t109\t156\t156\n108\t109\t157\t157\n108\t109\t158\t158\n108\t109\t159\t159\n108\t109\t160\t160\n108\t109\t161\t161\n108\t109\t162\t162\n108\t109\t163\t163\n108\t109\t164\t164\n108\t109\t165\t165\n108\t109\t166\t166\n108\t109\t167\t167\n108\t109\t168\t168\n108\t109\t169\t169\n108\t109\t170\t170\n108\t109\t171\t171\n108\t109\t172\t172\n108\t109\t173\t173\n108\t109\t174\t174\n108\t109\t175\t175\n108\t109\t176\t176\n108\t109\t177\t177\n108\t109\t178\t178\n108\t109\t179\t179\n108\t109\t180\t180\n108\t109\t181\t181\n108\t109\t182\t182\n108\t109\t183\t183\n108\t109\t184\t184\n108\t109\t185\t185\n108\t109\t186\t186\n108\t109\t187\t187\n108\t109\t188\t188\n108\t109\t189\t189\n108\t109\t190\t190\n108\t109\t191\t191\n108\t109\t192\t192\n108\t109\t193\t193\n108\t109\t194\t194\n108\t109\t195\t195\n108\t109\t196\t196\n108\t109\t197\t197\n108\t109\t198\t198\n108\t109\t199\t199\n108\t109\t200\t200\n108\t109\t201\t201\n108\t109\t202\t202\n108\t109\t203\t203\n108\t109\t204\t204\n108\t109\t205\t205\n108\t109\t206\t206\n108\t109\t207\t207\n108\t109\t208\t208\n108\t109\t209\t209\n108\t109\t210\t210\n108\t109\t211\t211\n108\t109\t212\t212\n108\t109\t213\t213\n108\t109\t214\t214\n108\t109\t215\t215\n108\t109\t216\t216\n108\t109\t217\t217\n108\t109\t218\t218\n108\t109\t219\t219\n108\t109\t220\t220\n108\t109\t221\t221\n108\t109\t222\t222\n108\t109\t223\t223\n108\t109\t224\t224\n108\t109\t225\t225\n108\t109\t226\t226\n108\t109\t227\t227\n108\t109\t228\t228\n108\t109\t229\t229\n108\t109\t230\t230\n108\t109\t231\t231\n108\t109\t232\t232\n108\t109\t233\t233\n108\t109\t234\t234\n108\t109\t235\t235\n108\t109\t236\t236\n108\t109\t237\t237\n108\t109\t238\t238\n108\t109\t239\t239\n108\t109\t240\t240\n108\t109\t241\t241\n108\t109\t242\t242\n108\t109\t243\t243\n108\t109\t244\t244\n108\t109\t245\t245\n108\t109\t246\t246\n108\t109\t247\t247\n108\t109\t248\t248\n108\t109\t249\t249\n108\t109\t250\t250\n108\t109\t251\t251\n108\t109\t252\t252\n108\t109\t253\t253\n108\t109\t254\t254\n108\t109\t255\t255\n109\t110\t0\t0\n109\t110\t1\t1\n109\t110\t2\t2\n109\t110\t3\t3\n109\t110\t4\t4\n109\t110\t5\t5\n109\t110\t6\t6\n109\t110\t7\t7\n109\t110\t8\t8\n109\t110\t9\t9\n109\t110\t10\t10\n109\t110\t11\t11\n109\t110\t12\t12\n109\t110\t13\t13\n109\t110\t14\t14\n109\t110\t15\t15\n109\t110\t16\t16\n109\t110\t17\t17\n109\t110\t18\t18\n109\t110\t19\t19\n109\t110\t20\t20\n109\t110\t21\t21\n109\t110\t22\t22\n109\t110\t23\t23\n109\t110\t24\t24\n109\t110\t25\t25\n109\t110\t26\t26\n109\t110\t27\t27\n109\t110\t28\t28\n109\t110\t29\t29\n109\t110\t30\t30\n109\t110\t31\t31\n109\t110\t32\t32\n109\t110\t33\t33\n109\t110\t34\t34\n109\t110\t35\t35\n109\t110\t36\t36\n109\t110\t37\t37\n109\t110\t38\t38\n109\t110\t39\t39\n109\t110\t40\t40\n109\t110\t41\t41\n109\t110\t42\t42\n109\t110\t43\t43\n109\t110\t44\t44\n109\t110\t45\t45\n109\t110\t46\t46\n109\t110\t47\t47\n109\t110\t48\t48\n109\t110\t49\t49\n109\t110\t50\t50\n109\t110\t51\t51\n109\t110\t52\t52\n109\t110\t53\t53\n109\t110\t54\t54\n109\t110\t55\t55\n109\t110\t56\t56\n109\t110\t57\t57\n109\t110\t58\t58\n109\t110\t59\t59\n109\t110\t60\t60\n109\t110\t61\t61\n109\t110\t62\t62\n109\t110\t63\t63\n109\t110\t64\t64\n109\t110\t65\t65\n109\t110\t66\t66\n109\t110\t67\t67\n109\t110\t68\t68\n109\t110\t69\t69\n109\t110\t70\t70\n109\t110\t71\t71\n109\t110\t72\t72\n109\t110\t73\t73\n109\t110\t74\t74\n109\t110\t75\t75\n109\t110\t76\t76\n109\t110\t77\t77\n109\t110\t78\t78\n109\t110\t79\t79\n109\t110\t80\t80\n109\t110\t81\t81\n109\t110\t82\t82\n109\t110\t83\t83\n109\t110\t84\t84\n109\t110\t85\t85\n109\t110\t86\t86\n109\t110\t87\t87\n109\t110\t88\t88\n109\t110\t89\t89\n109\t110\t90\t90\n109\t110\t91\t91\n109\t110\t92\t92\n109\t110\t93\t93\n109\t110\t94\t94\n109\t110\t95\t95\n109\t110\t96\t96\n109\t110\t97\t97\n109\t110\t98\t98\n109\t110\t99\t99\n109\t110\t100\t100\n109\t110\t101\t101\n109\t110\t102\t102\n109\t110\t103\t103\n109\t110\t104\t104\n109\t110\t105\t105\n109\t110\t106\t106\n109\t110\t107\t107\n109\t110\t108\t108\n109\t110\t109\t109\n109\t110\t110\t110\n109\t110\t111\t111\n109\t110\t112\t112\n109\t110\t113\t113\n109\t110\t114\t114\n109\t110\t115\t115\n109\t110\t116\t116\n109\t110\t117\t117\n109\t110\t118\t118\n109\t110\t119\t119\n109\t110\t120\t120\n109\t110\t121\t121\n109\t110\t122\t122\n109\t110\t123\t123\n109\t110\t124\t124\n109\t110\t125\t125\n109\t110\t126\t126\n109\t110\t127\t127\n109\t110\t128\t128\n109\t110\t129\t129\n109\t110\t130\t130\n109\t110\t131\t131\n109\t110\t132\t132\n109\t110\t133\t133\n109\t110\t134\t134\n109\t110\t135\t135\n109\t110\t136\t136\n109\t110\t137\t137\n109\t110\t138\t138\n109\t110\t139\t139\n109\t110\t140\t140\n109\t110\t141\t141\n109\t110\t142\t142\n109\t110\t143\t143\n109\t110\t144\t144\n109\t110\t145\t145\n109\t110\t146\t146\n109\t110\t147\t147\n109\t110\t148\t148\n109\t110\t149\t149\n109\t110\t150\t150\n109\t110\t151\t151\n109\t110\t152\t152\n109\t110\t153\t153\n109\t110\t154\t154\n109\t110\t155\t155\n109\t110\t156\t156\n109\t110\t157\t157\n109\t110\t158\t158\n109\t110\t159\t159\n109\t110\t160\t160\n109\t110\t161\t161\n109\t110\t162\t162\n109\t110\t163\t163\n109\t110\t164\t164\n109\t110\t165\t165\n109\t110\t166\t166\n109\t110\t167\t167\n109\t110\t168\t168\n109\t110\t169\t169\n109\t110\t170\t170\n109\t110\t171\t171\n109\t110\t172\t172\n109\t110\t173\t173\n109\t110\t174\t174\n109\t110\t175\t175\n109\t110\t176\t176\n109\t110\t177\t177\n109\t110\t178\t178\n109\t110\t179\t179\n109\t110\t180\t180\n109\t110\t181\t181\n109\t110\t182\t182\n109\t110\t183\t183\n109\t110\t184\t184\n109\t110\t185\t185\n109\t110\t186\t186\n109\t110\t187\t187\n109\t110\t188\t188\n109\t110\t189\t189\n109\t110\t190\t190\n109\t110\t191\t191\n109\t110\t192\t192\n109\t110\t193\t193\n109\t110\t194\t194\n109\t110\t195\t195\n109\t110\t196\t196\n109\t110\t197\t197\n109\t110\t198\t198\n109\t110\t199\t199\n109\t110\t200\t200\n109\t110\t201\t201\n109\t110\t202\t202\n109\t110\t203\t203\n109\t110\t204\t204\n109\t110\t205\t205\n109\t110\t206\t206\n109\t110\t207\t207\n109\t110\t208\t208\n109\t110\t209\t209\n109\t110\t210\t210\n109\t110\t211\t211\n109\t110\t212\t212\n109\t110\t213\t213\n109\t110\t214\t214\n109\t110\t215\t215\n109\t110\t216\t216\n109\t110\t217\t217\n109\t110\t218\t218\n109\t110\t219\t219\n109\t110\t220\t220\n109\t110\t221\t221\n109\t110\t222\t222\n109\t110\t223\t223\n109\t110\t224\t224\n109\t110\t225\t225\n109\t110\t226\t226\n109\t110\t227\t227\n109\t110\t228\t228\n109\t110\t229\t229\n109\t110\t230\t230\n109\t110\t231\t231\n109\t110\t232\t232\n109\t110\t233\t233\n109\t110\t234\t234\n109\t110\t235\t235\n109\t110\t236\t236\n109\t110\t237\t237\n109\t110\t238\t238\n109\t110\t239\t239\n109\t110\t240\t240\n109\t110\t241\t241\n109\t110\t242\t242\n109\t110\t243\t243\n109\t110\t244\t244\n109\t110\t245\t245\n109\t110\t246\t246\n109\t110\t247\t247\n109\t110\t248\t248\n109\t110\t249\t249\n109\t110\t250\t250\n109\t110\t251\t251\n109\t110\t252\t252\n109\t110\t253\t253\n109\t110\t254\t254\n109\t110\t255\t255\n110\t111\t0\t0\n110\t111\t1\t1\n110\t111\t2\t2\n110\t111\t3\t3\n110\t111\t4\t4\n110\t111\t5\t5\n110\t111\t6\t6\n110\t111\t7\t7\n110\t111\t8\t8\n110\t111\t9\t9\n110\t111\t10\t10\n110\t111\t11\t11\n110\t111\t12\t12\n110\t111\t13\t13\n110\t111\t14\t14\n110\t111\t15\t15\n110\t111\t16\t16\n110\t111\t17\t17\n110\t111\t18\t18\n110\t111\t19\t19\n110\t111\t20\t20\n110\t111\t21\t21\n110\t111\t22\t22\n110\t111\t23\t23\n110\t111\t24\t24\n110\t111\t25\t25\n110\t111\t26\t26\n110\t111\t27\t27\n110\t111\t28\t28\n110\t111\t29\t29\n110\t111\t30\t30\n110\t111\t31\t31\n110\t111\t32\t32\n110\t111\t33\t33\n110\t111\t34\t34\n110\t111\t35\t35\n110\t111\t36\t36\n110\t111\t37\t37\n110\t111\t38\t38\n110\t111\t39\t39\n110\t111\t40\t40\n110\t111\t41\t41\n110\t111\t42\t42\n110\t111\t43\t43\n110\t111\t44\t44\n110\t111\t45\t45\n110\t111\t46\t46\n110\t111\t47\t47\n110\t111\t48\t48\n110\t111\t49\t49\n110\t111\t50\t50\n110\t111\t51\t51\n110\t111\t52\t52\n110\t111\t53\t53\n110\t111\t54\t54\n110\t111\t55\t55\n110\t111\t56\t56\n110\t111\t57\t57\n110\t111\t58\t58\n110\t111\t59\t59\n110\t111\t60\t60\n110\t111\t61\t61\n110\t111\t62\t62\n110\t111\t63\t63\n110\t111\t64\t64\n110\t111\t65\t65\n110\t111\t66\t66\n110\t111\t67\t67\n110\t111\t68\t68\n110\t111\t69\t69\n110\t111\t70\t70\n110\t111\t71\t71\n110\t111\t72\t72\n110\t111\t73\t73\n110\t111\t74\t74\n110\t111\t75\t75\n110\t111\t76\t76\n110\t111\t77\t77\n110\t111\t78\t78\n110\t111\t79\t79\n110\t111\t80\t80\n110\t111\t81\t81\n110\t111\t82\t82\n110\t111\t83\t83\n110\t111\t84\t84\n110\t111\t85\t85\n110\t111\t86\t86\n110\t111\t87\t87\n110\t111\t88\t88\n110\t111\t89\t89\n110\t111\t90\t90\n110\t111\t91\t91\n110\t111\t92\t92\n110\t111\t93\t93\n110\t111\t94\t94\n110\t111\t95\t95\n110\t111\t96\t96\n110\t111\t97\t97\n110\t111\t98\t98\n110\t111\t99\t99\n110\t111\t100\t100\n110\t111\t101\t101\n110\t111\t102\t102\n110\t111\t103\t103\n110\t111\t104\t104\n110\t111\t105\t105\n110\t111\t106\t106\n110\t111\t107\t107\n110\t111\t108\t108\n110\t111\t109\t109\n110\t111\t110\t110\n110\t111\t111\t111\n110\t111\t112\t112\n110\t111\t113\t113\n110\t111\t114\t114\n110\t111\t115\t115\n110\t111\t116\t116\n110\t111\t117\t117\n110\t111\t118\t118\n110\t111\t119\t119\n110\t111\t120\t120\n110\t111\t121\t121\n110\t111\t122\t122\n110\t111\t123\t123\n110\t111\t124\t124\n110\t111\t125\t125\n110\t111\t126\t126\n110\t111\t127\t127\n110\t111\t128\t128\n110\t111\t129\t129\n110\t111\t130\t130\n110\t111\t131\t131\n110\t111\t132\t132\n110\t111\t133\t133\n110\t111\t134\t134\n110\t111\t135\t135\n110\t111\t136\t136\n110\t111\t137\t137\n110\t111\t138\t138\n110\t111\t139\t139\n110\t111\t140\t140\n110\t111\t141\t141\n110\t111\t142\t142\n110\t111\t143\t143\n110\t111\t144\t144\n110\t111\t145\t145\n110\t111\t146\t146\n110\t111\t147\t147\n110\t111\t148\t148\n110\t111\t149\t149\n110\t111\t150\t150\n110\t111\t151\t151\n110\t111\t152\t152\n110\t111\t153\t153\n110\t111\t154\t154\n110\t111\t155\t155\n110\t111\t156\t156\n110\t111\t157\t157\n110\t111\t158\t158\n110\t111\t159\t159\n110\t111\t160\t160\n110\t111\t161\t161\n110\t111\t162\t162\n110\t111\t163\t163\n110\t111\t164\t164\n110\t111\t165\t165\n110\t111\t166\t166\n110\t111\t167\t167\n110\t111\t168\t168\n110\t111\t169\t169\n110\t111\t170\t170\n110\t111\t171\t171\n110\t111\t172\t172\n110\t111\t173\t173\n110\t111\t174\t174\n110\t111\t175\t175\n110\t111\t176\t176\n110\t111\t177\t177\n110\t111\t178\t178\n110\t111\t179\t179\n110\t111\t180\t180\n110\t111\t181\t181\n110\t111\t182\t182\n110\t111\t183\t183\n110\t111\t184\t184\n110\t111\t185\t185\n110\t111\t186\t186\n110\t111\t187\t187\n110\t111\t188\t188\n110\t111\t189\t189\n110\t111\t190\t190\n110\t111\t191\t191\n110\t111\t192\t192\n110\t111\t193\t193\n110\t111\t194\t194\n110\t111\t195\t195\n110\t111\t196\t196\n110\t111\t197\t197\n110\t111\t198\t198\n110\t111\t199\t199\n110\t111\t200\t200\n110\t111\t201\t201\n110\t111\t202\t202\n110\t111\t203\t203\n110\t111\t204\t204\n110\t111\t205\t205\n110\t111\t206\t206\n110\t111\t207\t207\n110\t111\t208\t208\n110\t111\t209\t209\n110\t111\t210\t210\n110\t111\t211\t211\n110\t111\t212\t212\n110\t111\t213\t213\n110\t111\t214\t214\n110\t111\t215\t215\n110\t111\t216\t216\n110\t111\t217\t217\n110\t111\t218\t218\n110\t111\t219\t219\n110\t111\t220\t220\n110\t111\t221\t221\n110\t111\t222\t222\n110\t111\t223\t223\n110\t111\t224\t224\n110\t111\t225\t225\n110\t111\t226\t226\n110\t111\t227\t227\n110\t111\t228\t228\n110\t111\t229\t229\n110\t111\t230\t230\n110\t111\t231\t231\n110\t111\t232\t232\n110\t111\t233\t233\n110\t111\t234\t234\n110\t111\t235\t235\n110\t111\t236\t236\n110\t111\t237\t237\n110\t111\t238\t238\n110\t111\t239\t239\n110\t111\t240\t240\n110\t111\t241\t241\n110\t111\t242\t242\n110\t111\t243\t243\n110\t111\t244\t244\n110\t111\t245\t245\n110\t111\t246\t246\n110\t111\t247\t247\n110\t111\t248\t248\n110\t111\t249\t249\n110\t111\t250\t250\n110\t111\t251\t251\n110\t111\t252\t252\n110\t111\t253\t253\n110\t111\t254\t254\n110\t111\t255\t255\n111\t112\t0\t0\n111\t112\t1\t1\n111\t112\t2\t2\n111\t112\t3\t3\n111\t112\t4\t4\n111\t112\t5\t5\n111\t112\t6\t6\n111\t112\t7\t7\n111\t112\t8\t8\n111\t112\t9\t9\n111\t112\t10\t10\n111\t112\t11\t11\n111\t112\t12\t12\n111\t112\t13\t13\n111\t112\t14\t14\n111\t112\t15\t15\n111\t112\t16\t16\n111\t112\t17\t17\n111\t112\t18\t18\n111\t112\t19\t19\n111\t112\t20\t20\n111\t112\t21\t21\n111\t112\t22\t22\n111\t112\t23\t23\n111\t112\t24\t24\n111\t112\t25\t25\n111\t112\t26\t26\n111\t112\t27\t27\n111\t112\t28\t28\n111\t112\t29\t29\n111\t112\t30\t30\n111\t112\t31\t31\n111\t112\t32\t32\n111\t112\t33\t33\n111\t112\t34\t34\n111\t112\t35\t35\n111\t112\t36\t36\n111\t112\t37\t37\n111\t112\t38\t38\n111\t112\t39\t39\n111\t112\t40\t40\n111\t112\t41\t41\n111\t112\t42\t42\n111\t112\t43\t43\n111\t112\t44\t44\n111\t112\t45\t45\n111\t112\t46\t46\n111\t112\t47\t47\n111\t112\t48\t48\n111\t112\t49\t49\n111\t112\t50\t50\n111\t112\t51\t51\n111\t112\t52\t52\n111\t112\t53\t53\n111\t112\t54\t54\n111\t112\t55\t55\n111\t112\t56\t56\n111\t112\t57\t57\n111\t112\t58\t58\n111\t112\t59\t59\n111\t112\t60\t60\n111\t112\t61\t61\n111\t112\t62\t62\n111\t112\t63\t63\n111\t112\t64\t64\n111\t112\t65\t65\n111\t112\t66\t66\n111\t112\t67\t67\n111\t112\t68\t68\n111\t112\t69\t69\n111\t112\t70\t70\n111\t112\t71\t71\n111\t112\t72\t72\n111\t112\t73\t73\n111\t112\t74\t74\n111\t112\t75\t75\n111\t112\t76\t76\n111\t112\t77\t77\n111\t112\t78\t78\n111\t112\t79\t79\n111\t112\t80\t80\n111\t112\t81\t81\n111\t112\t82\t82\n111\t112\t83\t83\n111\t112\t84\t84\n111\t112\t85\t85\n111\t112\t86\t86\n111\t112\t87\t87\n111\t112\t88\t88\n111\t112\t89\t89\n111\t112\t90\t90\n111\t112\t91\t91\n111\t112\t92\t92\n111\t112\t93\t93\n111\t112\t94\t94\n111\t112\t95\t95\n111\t112\t96\t96\n111\t112\t97\t97\n111\t112\t98\t98\n111\t112\t99\t99\n111\t112\t100\t100\n111\t112\t101\t101\n111\t112\t102\t102\n111\t112\t103\t103\n111\t112\t104\t104\n111\t112\t105\t105\n111\t112\t106\t106\n111\t112\t107\t107\n111\t112\t108\t108\n111\t112\t109\t109\n111\t112\t110\t110\n111\t112\t111\t111\n111\t112\t112\t112\n111\t112\t113\t113\n111\t112\t114\t114\n111\t112\t115\t115\n111\t112\t116\t116\n111\t112\t117\t117\n111\t112\t118\t118\n111\t112\t119\t119\n111\t112\t120\t120\n111\t112\t121\t121\n111\t112\t122\t122\n111\t112\t123\t123\n111\t112\t124\t124\n111\t112\t125\t125\n111\t112\t126\t126\n111\t112\t127\t127\n111\t112\t128\t128\n111\t112\t129\t129\n111\t112\t130\t130\n111\t112\t131\t131\n111\t112\t132\t132\n111\t112\t133\t133\n111\t112\t134\t134\n111\t112\t135\t135\n111\t112\t136\t136\n111\t112\t137\t137\n111\t112\t138\t138\n111\t112\t139\t139\n111\t112\t140\t140\n111\t112\t141\t141\n111\t112\t142\t142\n111\t112\t143\t143\n111\t112\t144\t144\n111\t112\t145\t145\n111\t112\t146\t146\n111\t112\t147\t147\n111\t112\t148\t148\n111\t112\t149\t149\n111\t112\t150\t150\n111\t112\t151\t151\n111\t112\t152\t152\n111\t112\t153\t153\n111\t112\t154\t154\n111\t112\t155\t155\n111\t112\t156\t156\n111\t112\t157\t157\n111\t112\t158\t158\n111\t112\t159\t159\n111\t112\t160\t160\n111\t112\t161\t161\n111\t112\t162\t162\n111\t112\t163\t163\n111\t112\t164\t164\n111\t112\t165\t165\n111\t112\t166\t166\n111\t112\t167\t167\n111\t112\t168\t168\n111\t112\t169\t169\n111\t112\t170\t170\n111\t112\t171\t171\n111\t112\t172\t172\n111\t112\t173\t173\n111\t112\t174\t174\n111\t112\t175\t175\n111\t112\t176\t176\n111\t112\t177\t177\n111\t112\t178\t178\n111\t112\t179\t179\n111\t112\t180\t180\n111\t112\t181\t181\n111\t112\t182\t182\n111\t112\t183\t183\n111\t112\t184\t184\n111\t112\t185\t185\n111\t112\t186\t186\n111\t112\t187\t187\n111\t112\t188\t188\n111\t112\t189\t189\n111\t112\t190\t190\n111\t112\t191\t191\n111\t112\t192\t192\n111\t112\t193\t193\n111\t112\t194\t194\n111\t112\t195\t195\n111\t112\t196\t196\n111\t112\t197\t197\n111\t112\t198\t198\n111\t112\t199\t199\n111\t112\t200\t200\n111\t112\t201\t201\n111\t112\t202\t202\n111\t112\t203\t203\n111\t112\t204\t204\n111\t112\t205\t205\n111\t112\t206\t206\n111\t112\t207\t207\n111\t112\t208\t208\n111\t112\t209\t209\n111\t112\t210\t210\n111\t112\t211\t211\n111\t112\t212\t212\n111\t112\t213\t213\n111\t112\t214\t214\n111\t112\t215\t215\n111\t112\t216\t216\n111\t112\t217\t217\n111\t112\t218\t218\n111\t112\t219\t219\n111\t112\t220\t220\n111\t112\t221\t221\n111\t112\t222\t222\n111\t112\t223\t223\n111\t112\t224\t224\n111\t112\t225\t225\n111\t112\t226\t226\n111\t112\t227\t227\n111\t112\t228\t228\n111\t112\t229\t229\n111\t112\t230\t230\n111\t112\t231\t231\n111\t112\t232\t232\n111\t112\t233\t233\n111\t112\t234\t234\n111\t112\t235\t235\n111\t112\t236\t236\n111\t112\t237\t237\n111\t112\t238\t238\n111\t112\t239\t239\n111\t112\t240\t240\n111\t112\t241\t241\n111\t112\t242\t242\n111\t112\t243\t243\n111\t112\t244\t244\n111\t112\t245\t245\n111\t112\t246\t246\n111\t112\t247\t247\n111\t112\t248\t248\n111\t112\t249\t249\n111\t112\t250\t250\n111\t112\t251\t251\n111\t112\t252\t252\n111\t112\t253\t253\n111\t112\t254\t254\n111\t112\t255\t255\n112\t113\t0\t0\n112\t113\t1\t1\n112\t113\t2\t2\n112\t113\t3\t3\n112\t113\t4\t4\n112\t113\t5\t5\n112\t113\t6\t6\n112\t113\t7\t7\n112\t113\t8\t8\n112\t113\t9\t9\n112\t113\t10\t10\n112\t113\t11\t11\n112\t113\t12\t12\n112\t113\t13\t13\n112\t113\t14\t14\n112\t113\t15\t15\n112\t113\t16\t16\n112\t113\t17\t17\n112\t113\t18\t18\n112\t113\t19\t19\n112\t113\t20\t20\n112\t113\t21\t21\n112\t113\t22\t22\n112\t113\t23\t23\n112\t113\t24\t24\n112\t113\t25\t25\n112\t113\t26\t26\n112\t113\t27\t27\n112\t113\t28\t28\n112\t113\t29\t29\n112\t113\t30\t30\n112\t113\t31\t31\n112\t113\t32\t32\n112\t113\t33\t33\n112\t113\t34\t34\n112\t113\t35\t35\n112\t113\t36\t36\n112\t113\t37\t37\n112\t113\t38\t38\n112\t113\t39\t39\n112\t113\t40\t40\n112\t113\t41\t41\n112\t113\t42\t42\n112\t113\t43\t43\n112\t113\t44\t44\n112\t113\t45\t45\n112\t113\t46\t46\n112\t113\t47\t47\n112\t113\t48\t48\n112\t113\t49\t49\n112\t113\t50\t50\n112\t113\t51\t51\n112\t113\t52\t52\n112\t113\t53\t53\n112\t113\t54\t54\n112\t113\t55\t55\n112\t113\t56\t56\n112\t113\t57\t57\n112\t113\t58\t58\n112\t113\t59\t59\n112\t113\t60\t60\n112\t113\t61\t61\n112\t113\t62\t62\n112\t113\t63\t63\n112\t113\t64\t64\n112\t113\t65\t65\n112\t113\t66\t66\n112\t113\t67\t67\n112\t113\t68\t68\n112\t113\t69\t69\n112\t113\t70\t70\n112\t113\t71\t71\n112\t113\t72\t72\n112\t113\t73\t73\n112\t113\t74\t74\n112\t113\t75\t75\n112\t113\t76\t76\n112\t113\t77\t77\n112\t113\t78\t78\n112\t113\t79\t79\n112\t113\t80\t80\n112\t113\t81\t81\n112\t113\t82\t82\n112\t113\t83\t83\n112\t113\t84\t84\n112\t113\t85\t85\n112\t113\t86\t86\n112\t113\t87\t87\n112\t113\t88\t88\n112\t113\t89\t89\n112\t113\t90\t90\n112\t113\t91\t91\n112\t113\t92\t92\n112\t113\t93\t93\n112\t113\t94\t94\n112\t113\t95\t95\n112\t113\t96\t96\n112\t113\t97\t97\n112\t113\t98\t98\n112\t113\t99\t99\n112\t113\t100\t100\n112\t113\t101\t101\n112\t113\t102\t102\n112\t113\t103\t103\n112\t113\t104\t104\n112\t113\t105\t105\n112\t113\t106\t106\n112\t113\t107\t107\n112\t113\t108\t108\n112\t113\t109\t109\n112\t113\t110\t110\n112\t113\t111\t111\n112\t113\t112\t112\n112\t113\t113\t113\n112\t113\t114\t114\n112\t113\t115\t115\n112\t113\t116\t116\n112\t113\t117\t117\n112\t113\t118\t118\n112\t113\t119\t119\n112\t113\t120\t120\n112\t113\t121\t121\n112\t113\t122\t122\n112\t113\t123\t123\n112\t113\t124\t124\n112\t113\t125\t125\n112\t113\t126\t126\n112\t113\t127\t127\n112\t113\t128\t128\n112\t113\t129\t129\n112\t113\t130\t130\n112\t113\t131\t131\n112\t113\t132\t132\n112\t113\t133\t133\n112\t113\t134\t134\n112\t113\t135\t135\n112\t113\t136\t136\n112\t113\t137\t137\n112\t113\t138\t138\n112\t113\t139\t139\n112\t113\t140\t140\n112\t113\t141\t141\n112\t113\t142\t142\n112\t113\t143\t143\n112\t113\t144\t144\n112\t113\t145\t145\n112\t113\t146\t146\n112\t113\t147\t147\n112\t113\t148\t148\n112\t113\t149\t149\n112\t113\t150\t150\n112\t113\t151\t151\n112\t113\t152\t152\n112\t113\t153\t153\n112\t113\t154\t154\n112\t113\t155\t155\n112\t113\t156\t156\n112\t113\t157\t157\n112\t113\t158\t158\n112\t113\t159\t159\n112\t113\t160\t160\n112\t113\t161\t161\n112\t113\t162\t162\n112\t113\t163\t163\n112\t113\t164\t164\n112\t113\t165\t165\n112\t113\t166\t166\n112\t113\t167\t167\n112\t113\t168\t168\n112\t113\t169\t169\n112\t113\t170\t170\n112\t113\t171\t171\n112\t113\t172\t172\n112\t113\t173\t173\n112\t113\t174\t174\n112\t113\t175\t175\n112\t113\t176\t176\n112\t113\t177\t177\n112\t113\t178\t178\n112\t113\t179\t179\n112\t113\t180\t180\n112\t113\t181\t181\n112\t113\t182\t182\n112\t113\t183\t183\n112\t113\t184\t184\n112\t113\t185\t185\n112\t113\t186\t186\n112\t113\t187\t187\n112\t113\t188\t188\n112\t113\t189\t189\n112\t113\t190\t190\n112\t113\t191\t191\n112\t113\t192\t192\n112\t113\t193\t193\n112\t113\t194\t194\n112\t113\t195\t195\n112\t113\t196\t196\n112\t113\t197\t197\n112\t113\t198\t198\n112\t113\t199\t199\n112\t113\t200\t200\n112\t113\t201\t201\n112\t113\t202\t202\n112\t113\t203\t203\n112\t113\t204\t204\n112\t113\t205\t205\n112\t113\t206\t206\n112\t113\t207\t207\n112\t113\t208\t208\n112\t113\t209\t209\n112\t113\t210\t210\n112\t113\t211\t211\n112\t113\t212\t212\n112\t113\t213\t213\n112\t113\t214\t214\n112\t113\t215\t215\n112\t113\t216\t216\n112\t113\t217\t217\n112\t113\t218\t218\n112\t113\t219\t219\n112\t113\t220\t220\n112\t113\t221\t221\n112\t113\t222\t222\n112\t113\t223\t223\n112\t113\t224\t224\n112\t113\t225\t225\n112\t113\t226\t226\n112\t113\t227\t227\n112\t113\t228\t228\n112\t113\t229\t229\n112\t113\t230\t230\n112\t113\t231\t231\n112\t113\t232\t232\n112\t113\t233\t233\n112\t113\t234\t234\n112\t113\t235\t235\n112\t113\t236\t236\n112\t113\t237\t237\n112\t113\t238\t238\n112\t113\t239\t239\n112\t113\t240\t240\n112\t113\t241\t241\n112\t113\t242\t242\n112\t113\t243\t243\n112\t113\t244\t244\n112\t113\t245\t245\n112\t113\t246\t246\n112\t113\t247\t247\n112\t113\t248\t248\n112\t113\t249\t249\n112\t113\t250\t250\n112\t113\t251\t251\n112\t113\t252\t252\n112\t113\t253\t253\n112\t113\t254\t254\n112\t113\t255\t255\n113\t114\t0\t0\n113\t114\t1\t1\n113\t114\t2\t2\n113\t114\t3\t3\n113\t114\t4\t4\n113\t114\t5\t5\n113\t114\t6\t6\n113\t114\t7\t7\n113\t114\t8\t8\n113\t114\t9\t9\n113\t114\t10\t10\n113\t114\t11\t11\n113\t114\t12\t12\n113\t114\t13\t13\n113\t114\t14\t14\n113\t114\t15\t15\n113\t114\t16\t16\n113\t114\t17\t17\n113\t114\t18\t18\n113\t114\t19\t19\n113\t114\t20\t20\n113\t114\t21\t21\n113\t114\t22\t22\n113\t114\t23\t23\n113\t114\t24\t24\n113\t114\t25\t25\n113\t114\t26\t26\n113\t114\t27\t27\n113\t114\t28\t28\n113\t114\t29\t29\n113\t114\t30\t30\n113\t114\t31\t31\n113\t114\t32\t32\n113\t114\t33\t33\n113\t114\t34\t34\n113\t114\t35\t35\n113\t114\t36\t36\n113\t114\t37\t37\n113\t114\t38\t38\n113\t114\t39\t39\n113\t114\t40\t40\n113\t114\t41\t41\n113\t114\t42\t42\n113\t114\t43\t43\n113\t114\t44\t44\n113\t114\t45\t45\n113\t114\t46\t46\n113\t114\t47\t47\n113\t114\t48\t48\n113\t114\t49\t49\n113\t114\t50\t50\n113\t114\t51\t51\n113\t114\t52\t52\n113\t114\t53\t53\n113\t114\t54\t54\n113\t114\t55\t55\n113\t114\t56\t56\n113\t114\t57\t57\n113\t114\t58\t58\n113\t114\t59\t59\n113\t114\t60\t60\n113\t114\t61\t61\n113\t114\t62\t62\n113\t114\t63\t63\n113\t114\t64\t64\n113\t114\t65\t65\n113\t114\t66\t66\n113\t114\t67\t67\n113\t114\t68\t68\n113\t114\t69\t69\n113\t114\t70\t70\n113\t114\t71\t71\n113\t114\t72\t72\n113\t114\t73\t73\n113\t114\t74\t74\n113\t114\t75\t75\n113\t114\t76\t76\n113\t114\t77\t77\n113\t114\t78\t78\n113\t114\t79\t79\n113\t114\t80\t80\n113\t114\t81\t81\n113\t114\t82\t82\n113\t114\t83\t83\n113\t114\t84\t84\n113\t114\t85\t85\n113\t114\t86\t86\n113\t114\t87\t87\n113\t114\t88\t88\n113\t114\t89\t89\n113\t114\t90\t90\n113\t114\t91\t91\n113\t114\t92\t92\n113\t114\t93\t93\n113\t114\t94\t94\n113\t114\t95\t95\n113\t114\t96\t96\n113\t114\t97\t97\n113\t114\t98\t98\n113\t114\t99\t99\n113\t114\t100\t100\n113\t114\t101\t101\n113\t114\t102\t102\n113\t114\t103\t103\n113\t114\t104\t104\n113\t114\t105\t105\n113\t114\t106\t106\n113\t114\t107\t107\n113\t114\t108\t108\n113\t114\t109\t109\n113\t114\t110\t110\n113\t114\t111\t111\n113\t114\t112\t112\n113\t114\t113\t113\n113\t114\t114\t114\n113\t114\t115\t115\n113\t114\t116\t116\n113\t114\t117\t117\n113\t114\t118\t118\n113\t114\t119\t119\n113\t114\t120\t120\n113\t114\t121\t121\n113\t114\t122\t122\n113\t114\t123\t123\n113\t114\t124\t124\n113\t114\t125\t125\n113\t114\t126\t126\n113\t114\t127\t127\n113\t114\t128\t128\n113\t114\t129\t129\n113\t114\t130\t130\n113\t114\t131\t131\n113\t114\t132\t132\n113\t114\t133\t133\n113\t114\t134\t134\n113\t114\t135\t135\n113\t114\t136\t136\n113\t114\t137\t137\n113\t114\t138\t138\n113\t114\t139\t139\n113\t114\t140\t140\n113\t114\t141\t141\n113\t114\t142\t142\n113\t114\t143\t143\n113\t114\t144\t144\n113\t114\t145\t145\n113\t114\t146\t146\n113\t114\t147\t147\n113\t114\t148\t148\n113\t114\t149\t149\n113\t114\t150\t150\n113\t114\t151\t151\n113\t114\t152\t152\n113\t114\t153\t153\n113\t114\t154\t154\n113\t114\t155\t155\n113\t114\t156\t156\n113\t114\t157\t157\n113\t114\t158\t158\n113\t114\t159\t159\n113\t114\t160\t160\n113\t114\t161\t161\n113\t114\t162\t162\n113\t114\t163\t163\n113\t114\t164\t164\n113\t114\t165\t165\n113\t114\t166\t166\n113\t114\t167\t167\n113\t114\t168\t168\n113\t114\t169\t169\n113\t114\t170\t170\n113\t114\t171\t171\n113\t114\t172\t172\n113\t114\t173\t173\n113\t114\t174\t174\n113\t114\t175\t175\n113\t114\t176\t176\n113\t114\t177\t177\n113\t114\t178\t178\n113\t114\t179\t179\n113\t114\t180\t180\n113\t114\t181\t181\n113\t114\t182\t182\n113\t114\t183\t183\n113\t114\t184\t184\n113\t114\t185\t185\n113\t114\t186\t186\n113\t114\t187\t187\n113\t114\t188\t188\n113\t114\t189\t189\n113\t114\t190\t190\n113\t114\t191\t191\n113\t114\t192\t192\n113\t114\t193\t193\n113\t114\t194\t194\n113\t114\t195\t195\n113\t114\t196\t196\n113\t114\t197\t197\n113\t114\t198\t198\n113\t114\t199\t199\n113\t114\t200\t200\n113\t114\t201\t201\n113\t114\t202\t202\n113\t114\t203\t203\n113\t114\t204\t204\n113\t114\t205\t205\n113\t114\t206\t206\n113\t114\t207\t207\n113\t114\t208\t208\n113\t114\t209\t209\n113\t114\t210\t210\n113\t114\t211\t211\n113\t114\t212\t212\n113\t114\t213\t213\n113\t114\t214\t214\n113\t114\t215\t215\n113\t114\t216\t216\n113\t114\t217\t217\n113\t114\t218\t218\n113\t114\t219\t219\n113\t114\t220\t220\n113\t114\t221\t221\n113\t114\t222\t222\n113\t114\t223\t223\n113\t114\t224\t224\n113\t114\t225\t225\n113\t114\t226\t226\n113\t114\t227\t227\n113\t114\t228\t228\n113\t114\t229\t229\n113\t114\t230\t230\n113\t114\t231\t231\n113\t114\t232\t232\n113\t114\t233\t233\n113\t114\t234\t234\n113\t114\t235\t235\n113\t114\t236\t236\n113\t114\t237\t237\n113\t114\t238\t238\n113\t114\t239\t239\n113\t114\t240\t240\n113\t114\t241\t241\n113\t114\t242\t242\n113\t114\t243\t243\n113\t114\t244\t244\n113\t114\t245\t245\n113\t114\t246\t246\n113\t114\t247\t247\n113\t114\t248\t248\n113\t114\t249\t249\n113\t114\t250\t250\n113\t114\t251\t251\n113\t114\t252\t252\n113\t114\t253\t253\n113\t114\t254\t254\n113\t114\t255\t255\n114\t115\t0\t0\n114\t115\t1\t1\n114\t115\t2\t2\n114\t115\t3\t3\n114\t115\t4\t4\n114\t115\t5\t5\n114\t115\t6\t6\n114\t115\t7\t7\n114\t115\t8\t8\n114\t115\t9\t9\n114\t115\t10\t10\n114\t115\t11\t11\n114\t115\t12\t12\n114\t115\t13\t13\n114\t115\t14\t14\n114\t115\t15\t15\n114\t115\t16\t16\n114\t115\t17\t17\n114\t115\t18\t18\n114\t115\t19\t19\n114\t115\t20\t20\n114\t115\t21\t21\n114\t115\t22\t22\n114\t115\t23\t23\n114\t115\t24\t24\n114\t115\t25\t25\n114\t115\t26\t26\n114\t115\t27\t27\n114\t115\t28\t28\n114\t115\t29\t29\n114\t115\t30\t30\n114\t115\t31\t31\n114\t115\t32\t32\n114\t115\t33\t33\n114\t115\t34\t34\n114\t115\t35\t35\n114\t115\t36\t36\n114\t115\t37\t37\n114\t115\t38\t38\n114\t115\t39\t39\n114\t115\t40\t40\n114\t115\t41\t41\n114\t115\t42\t42\n114\t115\t43\t43\n114\t115\t44\t44\n114\t115\t45\t45\n114\t115\t46\t46\n114\t115\t47\t47\n114\t115\t48\t48\n114\t115\t49\t49\n114\t115\t50\t50\n114\t115\t51\t51\n114\t115\t52\t52\n114\t115\t53\t53\n114\t115\t54\t54\n114\t115\t55\t55\n114\t115\t56\t56\n114\t115\t57\t57\n114\t115\t58\t58\n114\t115\t59\t59\n114\t115\t60\t60\n114\t115\t61\t61\n114\t115\t62\t62\n114\t115\t63\t63\n114\t115\t64\t64\n114\t115\t65\t65\n114\t115\t66\t66\n114\t115\t67\t67\n114\t115\t68\t68\n114\t115\t69\t69\n114\t115\t70\t70\n114\t115\t71\t71\n114\t115\t72\t72\n114\t115\t73\t73\n114\t115\t74\t74\n114\t115\t75\t75\n114\t115\t76\t76\n114\t115\t77\t77\n114\t115\t78\t78\n114\t115\t79\t79\n114\t115\t80\t80\n114\t115\t81\t81\n114\t115\t82\t82\n114\t115\t83\t83\n114\t115\t84\t84\n114\t115\t85\t85\n114\t115\t86\t86\n114\t115\t87\t87\n114\t115\t88\t88\n114\t115\t89\t89\n114\t115\t90\t90\n114\t115\t91\t91\n114\t115\t92\t92\n114\t115\t93\t93\n114\t115\t94\t94\n114\t115\t95\t95\n114\t115\t96\t96\n114\t115\t97\t97\n114\t115\t98\t98\n114\t115\t99\t99\n114\t115\t100\t100\n114\t115\t101\t101\n114\t115\t102\t102\n114\t115\t103\t103\n114\t115\t104\t104\n114\t115\t105\t105\n114\t115\t106\t106\n114\t115\t107\t107\n114\t115\t108\t108\n114\t115\t109\t109\n114\t115\t110\t110\n114\t115\t111\t111\n114\t115\t112\t112\n114\t115\t113\t113\n114\t115\t114\t114\n114\t115\t115\t115\n114\t115\t116\t116\n114\t115\t117\t117\n114\t115\t118\t118\n114\t115\t119\t119\n114\t115\t120\t120\n114\t115\t121\t121\n114\t115\t122\t122\n114\t115\t123\t123\n114\t115\t124\t124\n114\t115\t125\t125\n114\t115\t126\t126\n114\t115\t127\t127\n114\t115\t128\t128\n114\t115\t129\t129\n114\t115\t130\t130\n114\t115\t131\t131\n114\t115\t132\t132\n114\t115\t133\t133\n114\t115\t134\t134\n114\t115\t135\t135\n114\t115\t136\t136\n114\t115\t137\t137\n114\t115\t138\t138\n114\t115\t139\t139\n114\t115\t140\t140\n114\t115\t141\t141\n114\t115\t142\t142\n114\t115\t143\t143\n114\t115\t144\t144\n114\t115\t145\t145\n114\t115\t146\t146\n114\t115\t147\t147\n114\t115\t148\t148\n114\t115\t149\t149\n114\t115\t150\t150\n114\t115\t151\t151\n114\t115\t152\t152\n114\t115\t153\t153\n114\t115\t154\t154\n114\t115\t155\t155\n114\t115\t156\t156\n114\t115\t157\t157\n114\t115\t158\t158\n114\t115\t159\t159\n114\t115\t160\t160\n114\t115\t161\t161\n114\t115\t162\t162\n114\t115\t163\t163\n114\t115\t164\t164\n114\t115\t165\t165\n114\t115\t166\t166\n114\t115\t167\t167\n114\t115\t168\t168\n114\t115\t169\t169\n114\t115\t170\t170\n114\t115\t171\t171\n114\t115\t172\t172\n114\t115\t173\t173\n114\t115\t174\t174\n114\t115\t175\t175\n114\t115\t176\t176\n114\t115\t177\t177\n114\t115\t178\t178\n114\t115\t179\t179\n114\t115\t180\t180\n114\t115\t181\t181\n114\t115\t182\t182\n114\t115\t183\t183\n114\t115\t184\t184\n114\t115\t185\t185\n114\t115\t186\t186\n114\t115\t187\t187\n114\t115\t188\t188\n114\t115\t189\t189\n114\t115\t190\t190\n114\t115\t191\t191\n114\t115\t192\t192\n114\t115\t193\t193\n114\t115\t194\t194\n114\t115\t195\t195\n114\t115\t196\t196\n114\t115\t197\t197\n114\t115\t198\t198\n114\t115\t199\t199\n114\t115\t200\t200\n114\t115\t201\t201\n114\t115\t202\t202\n114\t115\t203\t203\n114\t115\t204\t204\n114\t115\t205\t205\n114\t115\t206\t206\n114\t115\t207\t207\n114\t115\t208\t208\n114\t115\t209\t209\n114\t115\t210\t210\n114\t115\t211\t211\n114\t115\t212\t212\n114\t115\t213\t213\n114\t115\t214\t214\n114\t115\t215\t215\n114\t115\t216\t216\n114\t115\t217\t217\n114\t115\t218\t218\n114\t115\t219\t219\n114\t115\t220\t220\n114\t115\t221\t221\n114\t115\t222\t222\n114\t115\t223\t223\n114\t115\t224\t224\n114\t115\t225\t225\n114\t115\t226\t226\n114\t115\t227\t227\n114\t115\t228\t228\n114\t115\t229\t229\n114\t115\t230\t230\n114\t115\t231\t231\n114\t115\t232\t232\n114\t115\t233\t233\n114\t115\t234\t234\n114\t115\t235\t235\n114\t115\t236\t236\n114\t115\t237\t237\n114\t115\t238\t238\n114\t115\t239\t239\n114\t115\t240\t240\n114\t115\t241\t241\n114\t115\t242\t242\n114\t115\t243\t243\n114\t115\t244\t244\n114\t115\t245\t245\n114\t115\t246\t246\n114\t115\t247\t247\n114\t115\t248\t248\n114\t115\t249\t249\n114\t115\t250\t250\n114\t115\t251\t251\n114\t115\t252\t252\n114\t115\t253\t253\n114\t115\t254\t254\n114\t115\t255\t255\n115\t116\t0\t0\n115\t116\t1\t1\n115\t116\t2\t2\n115\t116\t3\t3\n115\t116\t4\t4\n115\t116\t5\t5\n115\t116\t6\t6\n115\t116\t7\t7\n115\t116\t8\t8\n115\t116\t9\t9\n115\t116\t10\t10\n115\t116\t11\t11\n115\t116\t12\t12\n115\t116\t13\t13\n115\t116\t14\t14\n115\t116\t15\t15\n115\t116\t16\t16\n115\t116\t17\t17\n115\t116\t18\t18\n115\t116\t19\t19\n115\t116\t20\t20\n115\t116\t21\t21\n115\t116\t22\t22\n115\t116\t23\t23\n115\t116\t24\t24\n115\t116\t25\t25\n115\t116\t26\t26\n115\t116\t27\t27\n115\t116\t28\t28\n115\t116\t29\t29\n115\t116\t30\t30\n115\t116\t31\t31\n115\t116\t32\t32\n115\t116\t33\t33\n115\t116\t34\t34\n115\t116\t35\t35\n115\t116\t36\t36\n115\t116\t37\t37\n115\t116\t38\t38\n115\t116\t39\t39\n115\t116\t40\t40\n115\t116\t41\t41\n115\t116\t42\t42\n115\t116\t43\t43\n115\t116\t44\t44\n115\t116\t45\t45\n115\t116\t46\t46\n115\t116\t47\t47\n115\t116\t48\t48\n115\t116\t49\t49\n115\t116\t50\t50\n115\t116\t51\t51\n115\t116\t52\t52\n115\t116\t53\t53\n115\t116\t54\t54\n115\t116\t55\t55\n115\t116\t56\t56\n115\t116\t57\t57\n115\t116\t58\t58\n115\t116\t59\t59\n115\t116\t60\t60\n115\t116\t61\t61\n115\t116\t62\t62\n115\t116\t63\t63\n115\t116\t64\t64\n115\t116\t65\t65\n115\t116\t66\t66\n115\t116\t67\t67\n115\t116\t68\t68\n115\t116\t69\t69\n115\t116\t70\t70\n115\t116\t71\t71\n115\t116\t72\t72\n115\t116\t73\t73\n115\t116\t74\t74\n115\t116\t75\t75\n115\t116\t76\t76\n115\t116\t77\t77\n115\t116\t78\t78\n115\t116\t79\t79\n115\t116\t80\t80\n115\t116\t81\t81\n115\t116\t82\t82\n115\t116\t83\t83\n115\t116\t84\t84\n115\t116\t85\t85\n115\t116\t86\t86\n115\t116\t87\t87\n115\t116\t88\t88\n115\t116\t89\t89\n115\t116\t90\t90\n115\t116\t91\t91\n115\t116\t92\t92\n115\t116\t93\t93\n115\t116\t94\t94\n115\t116\t95\t95\n115\t116\t96\t96\n115\t116\t97\t97\n115\t116\t98\t98\n115\t116\t99\t99\n115\t116\t100\t100\n115\t116\t101\t101\n115\t116\t102\t102\n115\t116\t103\t103\n115\t116\t104\t104\n115\t116\t105\t105\n115\t116\t106\t106\n115\t116\t107\t107\n115\t116\t108\t108\n115\t116\t109\t109\n115\t116\t110\t110\n115\t116\t111\t111\n115\t116\t112\t112\n115\t116\t113\t113\n115\t116\t114\t114\n115\t116\t115\t115\n115\t116\t116\t116\n115\t116\t117\t117\n115\t116\t118\t118\n115\t116\t119\t119\n115\t116\t120\t120\n115\t116\t121\t121\n115\t116\t122\t122\n115\t116\t123\t123\n115\t116\t124\t124\n115\t116\t125\t125\n115\t116\t126\t126\n115\t116\t127\t127\n115\t116\t128\t128\n115\t116\t129\t129\n115\t116\t130\t130\n115\t116\t131\t131\n115\t116\t132\t132\n115\t116\t133\t133\n115\t116\t134\t134\n115\t116\t135\t135\n115\t116\t136\t136\n115\t116\t137\t137\n115\t116\t138\t138\n115\t116\t139\t139\n115\t116\t140\t140\n115\t116\t141\t141\n115\t116\t142\t142\n115\t116\t143\t143\n115\t116\t144\t144\n115\t116\t145\t145\n115\t116\t146\t146\n115\t116\t147\t147\n115\t116\t148\t148\n115\t116\t149\t149\n115\t116\t150\t150\n115\t116\t151\t151\n115\t116\t152\t152\n115\t116\t153\t153\n115\t116\t154\t154\n115\t116\t155\t155\n115\t116\t156\t156\n115\t116\t157\t157\n115\t116\t158\t158\n115\t116\t159\t159\n115\t116\t160\t160\n115\t116\t161\t161\n115\t116\t162\t162\n115\t116\t163\t163\n115\t116\t164\t164\n115\t116\t165\t165\n115\t116\t166\t166\n115\t116\t167\t167\n115\t116\t168\t168\n115\t116\t169\t169\n115\t116\t170\t170\n115\t116\t171\t171\n115\t116\t172\t172\n115\t116\t173\t173\n115\t116\t174\t174\n115\t116\t175\t175\n115\t116\t176\t176\n115\t116\t177\t177\n115\t116\t178\t178\n115\t116\t179\t179\n115\t116\t180\t180\n115\t116\t181\t181\n115\t116\t182\t182\n115\t116\t183\t183\n115\t116\t184\t184\n115\t116\t185\t185\n115\t116\t186\t186\n115\t116\t187\t187\n115\t116\t188\t188\n115\t116\t189\t189\n115\t116\t190\t190\n115\t116\t191\t191\n115\t116\t192\t192\n115\t116\t193\t193\n115\t116\t194\t194\n115\t116\t195\t195\n115\t116\t196\t196\n115\t116\t197\t197\n115\t116\t198\t198\n115\t116\t199\t199\n115\t116\t200\t200\n115\t116\t201\t201\n115\t116\t202\t202\n115\t116\t203\t203\n115\t116\t204\t204\n115\t116\t205\t205\n115\t116\t206\t206\n115\t116\t207\t207\n115\t116\t208\t208\n115\t116\t209\t209\n115\t116\t210\t210\n115\t116\t211\t211\n115\t116\t212\t212\n115\t116\t213\t213\n115\t116\t214\t214\n115\t116\t215\t215\n115\t116\t216\t216\n115\t116\t217\t217\n115\t116\t218\t218\n115\t116\t219\t219\n115\t116\t220\t220\n115\t116\t221\t221\n115\t116\t222\t222\n115\t116\t223\t223\n115\t116\t224\t224\n115\t116\t225\t225\n115\t116\t226\t226\n115\t116\t227\t227\n115\t116\t228\t228\n115\t116\t229\t229\n115\t116\t230\t230\n115\t116\t231\t231\n115\t116\t232\t232\n115\t116\t233\t233\n115\t116\t234\t234\n115\t116\t235\t235\n115\t116\t236\t236\n115\t116\t237\t237\n115\t116\t238\t238\n115\t116\t239\t239\n115\t116\t240\t240\n115\t116\t241\t241\n115\t116\t242\t242\n115\t116\t243\t243\n115\t116\t244\t244\n115\t116\t245\t245\n115\t116\t246\t246\n115\t116\t247\t247\n115\t116\t248\t248\n115\t116\t249\t249\n115\t116\t250\t250\n115\t116\t251\t251\n115\t116\t252\t252\n115\t116\t253\t253\n115\t116\t254\t254\n115\t116\t255\t255\n116\t117\t0\t0\n116\t117\t1\t1\n116\t117\t2\t2\n116\t117\t3\t3\n116\t117\t4\t4\n116\t117\t5\t5\n116\t117\t6\t6\n116\t117\t7\t7\n116\t117\t8\t8\n116\t117\t9\t9\n116\t117\t10\t10\n116\t117\t11\t11\n116\t117\t12\t12\n116\t117\t13\t13\n116\t117\t14\t14\n116\t117\t15\t15\n116\t117\t16\t16\n116\t117\t17\t17\n116\t117\t18\t18\n116\t117\t19\t19\n116\t117\t20\t20\n116\t117\t21\t21\n116\t117\t22\t22\n116\t117\t23\t23\n116\t117\t24\t24\n116\t117\t25\t25\n116\t117\t26\t26\n116\t117\t27\t27\n116\t117\t28\t28\n116\t117\t29\t29\n116\t117\t30\t30\n116\t117\t31\t31\n116\t117\t32\t32\n116\t117\t33\t33\n116\t117\t34\t34\n116\t117\t35\t35\n116\t117\t36\t36\n116\t117\t37\t37\n116\t117\t38\t38\n116\t117\t39\t39\n116\t117\t40\t40\n116\t117\t41\t41\n116\t117\t42\t42\n116\t117\t43\t43\n116\t117\t44\t44\n116\t117\t45\t45\n116\t117\t46\t46\n116\t117\t47\t47\n116\t117\t48\t48\n116\t117\t49\t49\n116\t117\t50\t50\n116\t117\t51\t51\n116\t117\t52\t52\n116\t117\t53\t53\n116\t117\t54\t54\n116\t117\t55\t55\n116\t117\t56\t56\n116\t117\t57\t57\n116\t117\t58\t58\n116\t117\t59\t59\n116\t117\t60\t60\n116\t117\t61\t61\n116\t117\t62\t62\n116\t117\t63\t63\n116\t117\t64\t64\n116\t117\t65\t65\n116\t117\t66\t66\n116\t117\t67\t67\n116\t117\t68\t68\n116\t117\t69\t69\n116\t117\t70\t70\n116\t117\t71\t71\n116\t117\t72\t72\n116\t117\t73\t73\n116\t117\t74\t74\n116\t117\t75\t75\n116\t117\t76\t76\n116\t117\t77\t77\n116\t117\t78\t78\n116\t117\t79\t79\n116\t117\t80\t80\n116\t117\t81\t81\n116\t117\t82\t82\n116\t117\t83\t83\n116\t117\t84\t84\n116\t117\t85\t85\n116\t117\t86\t86\n116\t117\t87\t87\n116\t117\t88\t88\n116\t117\t89\t89\n116\t117\t90\t90\n116\t117\t91\t91\n116\t117\t92\t92\n116\t117\t93\t93\n116\t117\t94\t94\n116\t117\t95\t95\n116\t117\t96\t96\n116\t117\t97\t97\n116\t117\t98\t98\n116\t117\t99\t99\n116\t117\t100\t100\n116\t117\t101\t101\n116\t117\t102\t102\n116\t117\t103\t103\n116\t117\t104\t104\n116\t117\t105\t105\n116\t117\t106\t106\n116\t117\t107\t107\n116\t117\t108\t108\n116\t117\t109\t109\n116\t117\t110\t110\n116\t117\t111\t111\n116\t117\t112\t112\n116\t117\t113\t113\n116\t117\t114\t114\n116\t117\t115\t115\n116\t117\t116\t116\n116\t117\t117\t117\n116\t117\t118\t118\n116\t117\t119\t119\n116\t117\t120\t120\n116\t117\t121\t121\n116\t117\t122\t122\n116\t117\t123\t123\n116\t117\t124\t124\n116\t117\t125\t125\n116\t117\t126\t126\n116\t117\t127\t127\n116\t117\t128\t128\n116\t117\t129\t129\n116\t117\t130\t130\n116\t117\t131\t131\n116\t117\t132\t132\n116\t117\t133\t133\n116\t117\t134\t134\n116\t117\t135\t135\n116\t117\t136\t136\n116\t117\t137\t137\n116\t117\t138\t138\n116\t117\t139\t139\n116\t117\t140\t140\n116\t117\t141\t141\n116\t117\t142\t142\n116\t117\t143\t143\n116\t117\t144\t144\n116\t117\t145\t145\n116\t117\t146\t146\n116\t117\t147\t147\n116\t117\t148\t148\n116\t117\t149\t149\n116\t117\t150\t150\n116\t117\t151\t151\n116\t117\t152\t152\n116\t117\t153\t153\n116\t117\t154\t154\n116\t117\t155\t155\n116\t117\t156\t156\n116\t117\t157\t157\n116\t117\t158\t158\n116\t117\t159\t159\n116\t117\t160\t160\n116\t117\t161\t161\n116\t117\t162\t162\n116\t117\t163\t163\n116\t117\t164\t164\n116\t117\t165\t165\n116\t117\t166\t166\n116\t117\t167\t167\n116\t117\t168\t168\n116\t117\t169\t169\n116\t117\t170\t170\n116\t117\t171\t171\n116\t117\t172\t172\n116\t117\t173\t173\n116\t117\t174\t174\n116\t117\t175\t175\n116\t117\t176\t176\n116\t117\t177\t177\n116\t117\t178\t178\n116\t117\t179\t179\n116\t117\t180\t180\n116\t117\t181\t181\n116\t117\t182\t182\n116\t117\t183\t183\n116\t117\t184\t184\n116\t117\t185\t185\n116\t117\t186\t186\n116\t117\t187\t187\n116\t117\t188\t188\n116\t117\t189\t189\n116\t117\t190\t190\n116\t117\t191\t191\n116\t117\t192\t192\n116\t117\t193\t193\n116\t117\t194\t194\n116\t117\t195\t195\n116\t117\t196\t196\n116\t117\t197\t197\n116\t117\t198\t198\n116\t117\t199\t199\n116\t117\t200\t200\n116\t117\t201\t201\n116\t117\t202\t202\n116\t117\t203\t203\n116\t117\t204\t204\n116\t117\t205\t205\n116\t117\t206\t206\n116\t117\t207\t207\n116\t117\t208\t208\n116\t117\t209\t209\n116\t117\t210\t210\n116\t117\t211\t211\n116\t117\t212\t212\n116\t117\t213\t213\n116\t117\t214\t214\n116\t117\t215\t215\n116\t117\t216\t216\n116\t117\t217\t217\n116\t117\t218\t218\n116\t117\t219\t219\n116\t117\t220\t220\n116\t117\t221\t221\n116\t117\t222\t222\n116\t117\t223\t223\n116\t117\t224\t224\n116\t117\t225\t225\n116\t117\t226\t226\n116\t117\t227\t227\n116\t117\t228\t228\n116\t117\t229\t229\n116\t117\t230\t230\n116\t117\t231\t231\n116\t117\t232\t232\n116\t117\t233\t233\n116\t117\t234\t234\n116\t117\t235\t235\n116\t117\t236\t236\n116\t117\t237\t237\n116\t117\t238\t238\n116\t117\t239\t239\n116\t117\t240\t240\n116\t117\t241\t241\n116\t117\t242\t242\n116\t117\t243\t243\n116\t117\t244\t244\n116\t117\t245\t245\n116\t117\t246\t246\n116\t117\t247\t247\n116\t117\t248\t248\n116\t117\t249\t249\n116\t117\t250\t250\n116\t117\t251\t251\n116\t117\t252\t252\n116\t117\t253\t253\n116\t117\t254\t254\n116\t117\t255\t255\n117\t118\t0\t0\n117\t118\t1\t1\n117\t118\t2\t2\n117\t118\t3\t3\n117\t118\t4\t4\n117\t118\t5\t5\n117\t118\t6\t6\n117\t118\t7\t7\n117\t118\t8\t8\n117\t118\t9\t9\n117\t118\t10\t10\n117\t118\t11\t11\n117\t118\t12\t12\n117\t118\t13\t13\n117\t118\t14\t14\n117\t118\t15\t15\n117\t118\t16\t16\n117\t118\t17\t17\n117\t118\t18\t18\n117\t118\t19\t19\n117\t118\t20\t20\n117\t118\t21\t21\n117\t118\t22\t22\n117\t118\t23\t23\n117\t118\t24\t24\n117\t118\t25\t25\n117\t118\t26\t26\n117\t118\t27\t27\n117\t118\t28\t28\n117\t118\t29\t29\n117\t118\t30\t30\n117\t118\t31\t31\n117\t118\t32\t32\n117\t118\t33\t33\n117\t118\t34\t34\n117\t118\t35\t35\n117\t118\t36\t36\n117\t118\t37\t37\n117\t118\t38\t38\n117\t118\t39\t39\n117\t118\t40\t40\n117\t118\t41\t41\n117\t118\t42\t42\n117\t118\t43\t43\n117\t118\t44\t44\n117\t118\t45\t45\n117\t118\t46\t46\n117\t118\t47\t47\n117\t118\t48\t48\n117\t118\t49\t49\n117\t118\t50\t50\n117\t118\t51\t51\n117\t118\t52\t52\n117\t118\t53\t53\n117\t118\t54\t54\n117\t118\t55\t55\n117\t118\t56\t56\n117\t118\t57\t57\n117\t118\t58\t58\n117\t118\t59\t59\n117\t118\t60\t60\n117\t118\t61\t61\n117\t118\t62\t62\n117\t118\t63\t63\n117\t118\t64\t64\n117\t118\t65\t65\n117\t118\t66\t66\n117\t118\t67\t67\n117\t118\t68\t68\n117\t118\t69\t69\n117\t118\t70\t70\n117\t118\t71\t71\n117\t118\t72\t72\n117\t118\t73\t73\n117\t118\t74\t74\n117\t118\t75\t75\n117\t118\t76\t76\n117\t118\t77\t77\n117\t118\t78\t78\n117\t118\t79\t79\n117\t118\t80\t80\n117\t118\t81\t81\n117\t118\t82\t82\n117\t118\t83\t83\n117\t118\t84\t84\n117\t118\t85\t85\n117\t118\t86\t86\n117\t118\t87\t87\n117\t118\t88\t88\n117\t118\t89\t89\n117\t118\t90\t90\n117\t118\t91\t91\n117\t118\t92\t92\n117\t118\t93\t93\n117\t118\t94\t94\n117\t118\t95\t95\n117\t118\t96\t96\n117\t118\t97\t97\n117\t118\t98\t98\n117\t118\t99\t99\n117\t118\t100\t100\n117\t118\t101\t101\n117\t118\t102\t102\n117\t118\t103\t103\n117\t118\t104\t104\n117\t118\t105\t105\n117\t118\t106\t106\n117\t118\t107\t107\n117\t118\t108\t108\n117\t118\t109\t109\n117\t118\t110\t110\n117\t118\t111\t111\n117\t118\t112\t112\n117\t118\t113\t113\n117\t118\t114\t114\n117\t118\t115\t115\n117\t118\t116\t116\n117\t118\t117\t117\n117\t118\t118\t118\n117\t118\t119\t119\n117\t118\t120\t120\n117\t118\t121\t121\n117\t118\t122\t122\n117\t118\t123\t123\n117\t118\t124\t124\n117\t118\t125\t125\n117\t118\t126\t126\n117\t118\t127\t127\n117\t118\t128\t128\n117\t118\t129\t129\n117\t118\t130\t130\n117\t118\t131\t131\n117\t118\t132\t132\n117\t118\t133\t133\n117\t118\t134\t134\n117\t118\t135\t135\n117\t118\t136\t136\n117\t118\t137\t137\n117\t118\t138\t138\n117\t118\t139\t139\n117\t118\t140\t140\n117\t118\t141\t141\n117\t118\t142\t142\n117\t118\t143\t143\n117\t118\t144\t144\n117\t118\t145\t145\n117\t118\t146\t146\n117\t118\t147\t147\n117\t118\t148\t148\n117\t118\t149\t149\n117\t118\t150\t150\n117\t118\t151\t151\n117\t118\t152\t152\n117\t118\t153\t153\n117\t118\t154\t154\n117\t118\t155\t155\n117\t118\t156\t156\n117\t118\t157\t157\n117\t118\t158\t158\n117\t118\t159\t159\n117\t118\t160\t160\n117\t118\t161\t161\n117\t118\t162\t162\n117\t118\t163\t163\n117\t118\t164\t164\n117\t118\t165\t165\n117\t118\t166\t166\n117\t118\t167\t167\n117\t118\t168\t168\n117\t118\t169\t169\n117\t118\t170\t170\n117\t118\t171\t171\n117\t118\t172\t172\n117\t118\t173\t173\n117\t118\t174\t174\n117\t118\t175\t175\n117\t118\t176\t176\n117\t118\t177\t177\n117\t118\t178\t178\n117\t118\t179\t179\n117\t118\t180\t180\n117\t118\t181\t181\n117\t118\t182\t182\n117\t118\t183\t183\n117\t118\t184\t184\n117\t118\t185\t185\n117\t118\t186\t186\n117\t118\t187\t187\n117\t118\t188\t188\n117\t118\t189\t189\n117\t118\t190\t190\n117\t118\t191\t191\n117\t118\t192\t192\n117\t118\t193\t193\n117\t118\t194\t194\n117\t118\t195\t195\n117\t118\t196\t196\n117\t118\t197\t197\n117\t118\t198\t198\n117\t118\t199\t199\n117\t118\t200\t200\n117\t118\t201\t201\n117\t118\t202\t202\n117\t118\t203\t203\n117\t118\t204\t204\n117\t118\t205\t205\n117\t118\t206\t206\n117\t118\t207\t207\n117\t118\t208\t208\n117\t118\t209\t209\n117\t118\t210\t210\n117\t118\t211\t211\n117\t118\t212\t212\n117\t118\t213\t213\n117\t118\t214\t214\n117\t118\t215\t215\n117\t118\t216\t216\n117\t118\t217\t217\n117\t118\t218\t218\n117\t118\t219\t219\n117\t118\t220\t220\n117\t118\t221\t221\n117\t118\t222\t222\n117\t118\t223\t223\n117\t118\t224\t224\n117\t118\t225\t225\n117\t118\t226\t226\n117\t118\t227\t227\n117\t118\t228\t228\n117\t118\t229\t229\n117\t118\t230\t230\n117\t118\t231\t231\n117\t118\t232\t232\n117\t118\t233\t233\n117\t118\t234\t234\n117\t118\t235\t235\n117\t118\t236\t236\n117\t118\t237\t237\n117\t118\t238\t238\n117\t118\t239\t239\n117\t118\t240\t240\n117\t118\t241\t241\n117\t118\t242\t242\n117\t118\t243\t243\n117\t118\t244\t244\n117\t118\t245\t245\n117\t118\t246\t246\n117\t118\t247\t247\n117\t118\t248\t248\n117\t118\t249\t249\n117\t118\t250\t250\n117\t118\t251\t251\n117\t118\t252\t252\n117\t118\t253\t253\n117\t118\t254\t254\n117\t118\t255\t255\n118\t119\t0\t0\n118\t119\t1\t1\n118\t119\t2\t2\n118\t119\t3\t3\n118\t119\t4\t4\n118\t119\t5\t5\n118\t119\t6\t6\n118\t119\t7\t7\n118\t119\t8\t8\n118\t119\t9\t9\n118\t119\t10\t10\n118\t119\t11\t11\n118\t119\t12\t12\n118\t119\t13\t13\n118\t119\t14\t14\n118\t119\t15\t15\n118\t119\t16\t16\n118\t119\t17\t17\n118\t119\t18\t18\n118\t119\t19\t19\n118\t119\t20\t20\n118\t119\t21\t21\n118\t119\t22\t22\n118\t119\t23\t23\n118\t119\t24\t24\n118\t119\t25\t25\n118\t119\t26\t26\n118\t119\t27\t27\n118\t119\t28\t28\n118\t119\t29\t29\n118\t119\t30\t30\n118\t119\t31\t31\n118\t119\t32\t32\n118\t119\t33\t33\n118\t119\t34\t34\n118\t119\t35\t35\n118\t119\t36\t36\n118\t119\t37\t37\n118\t119\t38\t38\n118\t119\t39\t39\n118\t119\t40\t40\n118\t119\t41\t41\n118\t119\t42\t42\n118\t119\t43\t43\n118\t119\t44\t44\n118\t119\t45\t45\n118\t119\t46\t46\n118\t119\t47\t47\n118\t119\t48\t48\n118\t119\t49\t49\n118\t119\t50\t50\n118\t119\t51\t51\n118\t119\t52\t52\n118\t119\t53\t53\n118\t119\t54\t54\n118\t119\t55\t55\n118\t119\t56\t56\n118\t119\t57\t57\n118\t119\t58\t58\n118\t119\t59\t59\n118\t119\t60\t60\n118\t119\t61\t61\n118\t119\t62\t62\n118\t119\t63\t63\n118\t119\t64\t64\n118\t119\t65\t65\n118\t119\t66\t66\n118\t119\t67\t67\n118\t119\t68\t68\n118\t119\t69\t69\n118\t119\t70\t70\n118\t119\t71\t71\n118\t119\t72\t72\n118\t119\t73\t73\n118\t119\t74\t74\n118\t119\t75\t75\n118\t119\t76\t76\n118\t119\t77\t77\n118\t119\t78\t78\n118\t119\t79\t79\n118\t119\t80\t80\n118\t119\t81\t81\n118\t119\t82\t82\n118\t119\t83\t83\n118\t119\t84\t84\n118\t119\t85\t85\n118\t119\t86\t86\n118\t119\t87\t87\n118\t119\t88\t88\n118\t119\t89\t89\n118\t119\t90\t90\n118\t119\t91\t91\n118\t119\t92\t92\n118\t119\t93\t93\n118\t119\t94\t94\n118\t119\t95\t95\n118\t119\t96\t96\n118\t119\t97\t97\n118\t119\t98\t98\n118\t119\t99\t99\n118\t119\t100\t100\n118\t119\t101\t101\n118\t119\t102\t102\n118\t119\t103\t103\n118\t119\t104\t104\n118\t119\t105\t105\n118\t119\t106\t106\n118\t119\t107\t107\n118\t119\t108\t108\n118\t119\t109\t109\n118\t119\t110\t110\n118\t119\t111\t111\n118\t119\t112\t112\n118\t119\t113\t113\n118\t119\t114\t114\n118\t119\t115\t115\n118\t119\t116\t116\n118\t119\t117\t117\n118\t119\t118\t118\n118\t119\t119\t119\n118\t119\t120\t120\n118\t119\t121\t121\n118\t119\t122\t122\n118\t119\t123\t123\n118\t119\t124\t124\n118\t119\t125\t125\n118\t119\t126\t126\n118\t119\t127\t127\n118\t119\t128\t128\n118\t119\t129\t129\n118\t119\t130\t130\n118\t119\t131\t131\n118\t119\t132\t132\n118\t119\t133\t133\n118\t119\t134\t134\n118\t119\t135\t135\n118\t119\t136\t136\n118\t119\t137\t137\n118\t119\t138\t138\n118\t119\t139\t139\n118\t119\t140\t140\n118\t119\t141\t141\n118\t119\t142\t142\n118\t119\t143\t143\n118\t119\t144\t144\n118\t119\t145\t145\n118\t119\t146\t146\n118\t119\t147\t147\n118\t119\t148\t148\n118\t119\t149\t149\n118\t119\t150\t150\n118\t119\t151\t151\n118\t119\t152\t152\n118\t119\t153\t153\n118\t119\t154\t154\n118\t119\t155\t155\n118\t119\t156\t156\n118\t119\t157\t157\n118\t119\t158\t158\n118\t119\t159\t159\n118\t119\t160\t160\n118\t119\t161\t161\n118\t119\t162\t162\n118\t119\t163\t163\n118\t119\t164\t164\n118\t119\t165\t165\n118\t119\t166\t166\n118\t119\t167\t167\n118\t119\t168\t168\n118\t119\t169\t169\n118\t119\t170\t170\n118\t119\t171\t171\n118\t119\t172\t172\n118\t119\t173\t173\n118\t119\t174\t174\n118\t119\t175\t175\n118\t119\t176\t176\n118\t119\t177\t177\n118\t119\t178\t178\n118\t119\t179\t179\n118\t119\t180\t180\n118\t119\t181\t181\n118\t119\t182\t182\n118\t119\t183\t183\n118\t119\t184\t184\n118\t119\t185\t185\n118\t119\t186\t186\n118\t119\t187\t187\n118\t119\t188\t188\n118\t119\t189\t189\n118\t119\t190\t190\n118\t119\t191\t191\n118\t119\t192\t192\n118\t119\t193\t193\n118\t119\t194\t194\n118\t119\t195\t195\n118\t119\t196\t196\n118\t119\t197\t197\n118\t119\t198\t198\n118\t119\t199\t199\n118\t119\t200\t200\n118\t119\t201\t201\n118\t119\t202\t202\n118\t119\t203\t203\n118\t119\t204\t204\n118\t119\t205\t205\n118\t119\t206\t206\n118\t119\t207\t207\n118\t119\t208\t208\n118\t119\t209\t209\n118\t119\t210\t210\n118\t119\t211\t211\n118\t119\t212\t212\n118\t119\t213\t213\n118\t119\t214\t214\n118\t119\t215\t215\n118\t119\t216\t216\n118\t119\t217\t217\n118\t119\t218\t218\n118\t119\t219\t219\n118\t119\t220\t220\n118\t119\t221\t221\n118\t119\t222\t222\n118\t119\t223\t223\n118\t119\t224\t224\n118\t119\t225\t225\n118\t119\t226\t226\n118\t119\t227\t227\n118\t119\t228\t228\n118\t119\t229\t229\n118\t119\t230\t230\n118\t119\t231\t231\n118\t119\t232\t232\n118\t119\t233\t233\n118\t119\t234\t234\n118\t119\t235\t235\n118\t119\t236\t236\n118\t119\t237\t237\n118\t119\t238\t238\n118\t119\t239\t239\n118\t119\t240\t240\n118\t119\t241\t241\n118\t119\t242\t242\n118\t119\t243\t243\n118\t119\t244\t244\n118\t119\t245\t245\n118\t119\t246\t246\n118\t119\t247\t247\n118\t119\t248\t248\n118\t119\t249\t249\n118\t119\t250\t250\n118\t119\t251\t251\n118\t119\t252\t252\n118\t119\t253\t253\n118\t119\t254\t254\n118\t119\t255\t255\n119\t120\t0\t0\n119\t120\t1\t1\n119\t120\t2\t2\n119\t120\t3\t3\n119\t120\t4\t4\n119\t120\t5\t5\n119\t120\t6\t6\n119\t120\t7\t7\n119\t120\t8\t8\n119\t120\t9\t9\n119\t120\t10\t10\n119\t120\t11\t11\n119\t120\t12\t12\n119\t120\t13\t13\n119\t120\t14\t14\n119\t120\t15\t15\n119\t120\t16\t16\n119\t120\t17\t17\n119\t120\t18\t18\n119\t120\t19\t19\n119\t120\t20\t20\n119\t120\t21\t21\n119\t120\t22\t22\n119\t120\t23\t23\n119\t120\t24\t24\n119\t120\t25\t25\n119\t120\t26\t26\n119\t120\t27\t27\n119\t120\t28\t28\n119\t120\t29\t29\n119\t120\t30\t30\n119\t120\t31\t31\n119\t120\t32\t32\n119\t120\t33\t33\n119\t120\t34\t34\n119\t120\t35\t35\n119\t120\t36\t36\n119\t120\t37\t37\n119\t120\t38\t38\n119\t120\t39\t39\n119\t120\t40\t40\n119\t120\t41\t41\n119\t120\t42\t42\n119\t120\t43\t43\n119\t120\t44\t44\n119\t120\t45\t45\n119\t120\t46\t46\n119\t120\t47\t47\n119\t120\t48\t48\n119\t120\t49\t49\n119\t120\t50\t50\n119\t120\t51\t51\n119\t120\t52\t52\n119\t120\t53\t53\n119\t120\t54\t54\n119\t120\t55\t55\n119\t120\t56\t56\n119\t120\t57\t57\n119\t120\t58\t58\n119\t120\t59\t59\n119\t120\t60\t60\n119\t120\t61\t61\n119\t120\t62\t62\n119\t120\t63\t63\n119\t120\t64\t64\n119\t120\t65\t65\n119\t120\t66\t66\n119\t120\t67\t67\n119\t120\t68\t68\n119\t120\t69\t69\n119\t120\t70\t70\n119\t120\t71\t71\n119\t120\t72\t72\n119\t120\t73\t73\n119\t120\t74\t74\n119\t120\t75\t75\n119\t120\t76\t76\n119\t120\t77\t77\n119\t120\t78\t78\n119\t120\t79\t79\n119\t120\t80\t80\n119\t120\t81\t81\n119\t120\t82\t82\n119\t120\t83\t83\n119\t120\t84\t84\n119\t120\t85\t85\n119\t120\t86\t86\n119\t120\t87\t87\n119\t120\t88\t88\n119\t120\t89\t89\n119\t120\t90\t90\n119\t120\t91\t91\n119\t120\t92\t92\n119\t120\t93\t93\n119\t120\t94\t94\n119\t120\t95\t95\n119\t120\t96\t96\n119\t120\t97\t97\n119\t120\t98\t98\n119\t120\t99\t99\n119\t120\t100\t100\n119\t120\t101\t101\n119\t120\t102\t102\n119\t120\t103\t103\n119\t120\t104\t104\n119\t120\t105\t105\n119\t120\t106\t106\n119\t120\t107\t107\n119\t120\t108\t108\n119\t120\t109\t109\n119\t120\t110\t110\n119\t120\t111\t111\n119\t120\t112\t112\n119\t120\t113\t113\n119\t120\t114\t114\n119\t120\t115\t115\n119\t120\t116\t116\n119\t120\t117\t117\n119\t120\t118\t118\n119\t120\t119\t119\n119\t120\t120\t120\n119\t120\t121\t121\n119\t120\t122\t122\n119\t120\t123\t123\n119\t120\t124\t124\n119\t120\t125\t125\n119\t120\t126\t126\n119\t120\t127\t127\n119\t120\t128\t128\n119\t120\t129\t129\n119\t120\t130\t130\n119\t120\t131\t131\n119\t120\t132\t132\n119\t120\t133\t133\n119\t120\t134\t134\n119\t120\t135\t135\n119\t120\t136\t136\n119\t120\t137\t137\n119\t120\t138\t138\n119\t120\t139\t139\n119\t120\t140\t140\n119\t120\t141\t141\n119\t120\t142\t142\n119\t120\t143\t143\n119\t120\t144\t144\n119\t120\t145\t145\n119\t120\t146\t146\n119\t120\t147\t147\n119\t120\t148\t148\n119\t120\t149\t149\n119\t120\t150\t150\n119\t120\t151\t151\n119\t120\t152\t152\n119\t120\t153\t153\n119\t120\t154\t154\n119\t120\t155\t155\n119\t120\t156\t156\n119\t120\t157\t157\n119\t120\t158\t158\n119\t120\t159\t159\n119\t120\t160\t160\n119\t120\t161\t161\n119\t120\t162\t162\n119\t120\t163\t163\n119\t120\t164\t164\n119\t120\t165\t165\n119\t120\t166\t166\n119\t120\t167\t167\n119\t120\t168\t168\n119\t120\t169\t169\n119\t120\t170\t170\n119\t120\t171\t171\n119\t120\t172\t172\n119\t120\t173\t173\n119\t120\t174\t174\n119\t120\t175\t175\n119\t120\t176\t176\n119\t120\t177\t177\n119\t120\t178\t178\n119\t120\t179\t179\n119\t120\t180\t180\n119\t120\t181\t181\n119\t120\t182\t182\n119\t120\t183\t183\n119\t120\t184\t184\n119\t120\t185\t185\n119\t120\t186\t186\n119\t120\t187\t187\n119\t120\t188\t188\n119\t120\t189\t189\n119\t120\t190\t190\n119\t120\t191\t191\n119\t120\t192\t192\n119\t120\t193\t193\n119\t120\t194\t194\n119\t120\t195\t195\n119\t120\t196\t196\n119\t120\t197\t197\n119\t120\t198\t198\n119\t120\t199\t199\n119\t120\t200\t200\n119\t120\t201\t201\n119\t120\t202\t202\n119\t120\t203\t203\n119\t120\t204\t204\n119\t120\t205\t205\n119\t120\t206\t206\n119\t120\t207\t207\n119\t120\t208\t208\n119\t120\t209\t209\n119\t120\t210\t210\n119\t120\t211\t211\n119\t120\t212\t212\n119\t120\t213\t213\n119\t120\t214\t214\n119\t120\t215\t215\n119\t120\t216\t216\n119\t120\t217\t217\n119\t120\t218\t218\n119\t120\t219\t219\n119\t120\t220\t220\n119\t120\t221\t221\n119\t120\t222\t222\n119\t120\t223\t223\n119\t120\t224\t224\n119\t120\t225\t225\n119\t120\t226\t226\n119\t120\t227\t227\n119\t120\t228\t228\n119\t120\t229\t229\n119\t120\t230\t230\n119\t120\t231\t231\n119\t120\t232\t232\n119\t120\t233\t233\n119\t120\t234\t234\n119\t120\t235\t235\n119\t120\t236\t236\n119\t120\t237\t237\n119\t120\t238\t238\n119\t120\t239\t239\n119\t120\t240\t240\n119\t120\t241\t241\n119\t120\t242\t242\n119\t120\t243\t243\n119\t120\t244\t244\n119\t120\t245\t245\n119\t120\t246\t246\n119\t120\t247\t247\n119\t120\t248\t248\n119\t120\t249\t249\n119\t120\t250\t250\n119\t120\t251\t251\n119\t120\t252\t252\n119\t120\t253\t253\n119\t120\t254\t254\n119\t120\t255\t255\n120\t121\t0\t0\n120\t121\t1\t1\n120\t121\t2\t2\n120\t121\t3\t3\n120\t121\t4\t4\n120\t121\t5\t5\n120\t121\t6\t6\n120\t121\t7\t7\n120\t121\t8\t8\n120\t121\t9\t9\n120\t121\t10\t10\n120\t121\t11\t11\n120\t121\t12\t12\n120\t121\t13\t13\n120\t121\t14\t14\n120\t121\t15\t15\n120\t121\t16\t16\n120\t121\t17\t17\n120\t121\t18\t18\n120\t121\t19\t19\n120\t121\t20\t20\n120\t121\t21\t21\n120\t121\t22\t22\n120\t121\t23\t23\n120\t121\t24\t24\n120\t121\t25\t25\n120\t121\t26\t26\n120\t121\t27\t27\n120\t121\t28\t28\n120\t121\t29\t29\n120\t121\t30\t30\n120\t121\t31\t31\n120\t121\t32\t32\n120\t121\t33\t33\n120\t121\t34\t34\n120\t121\t35\t35\n120\t121\t36\t36\n120\t121\t37\t37\n120\t121\t38\t38\n120\t121\t39\t39\n120\t121\t40\t40\n120\t121\t41\t41\n120\t121\t42\t42\n120\t121\t43\t43\n120\t121\t44\t44\n120\t121\t45\t45\n120\t121\t46\t46\n120\t121\t47\t47\n120\t121\t48\t48\n120\t121\t49\t49\n120\t121\t50\t50\n120\t121\t51\t51\n120\t121\t52\t52\n120\t121\t53\t53\n120\t121\t54\t54\n120\t121\t55\t55\n120\t121\t56\t56\n120\t121\t57\t57\n120\t121\t58\t58\n120\t121\t59\t59\n120\t121\t60\t60\n120\t121\t61\t61\n120\t121\t62\t62\n120\t121\t63\t63\n120\t121\t64\t64\n120\t121\t65\t65\n120\t121\t66\t66\n120\t121\t67\t67\n120\t121\t68\t68\n120\t121\t69\t69\n120\t121\t70\t70\n120\t121\t71\t71\n120\t121\t72\t72\n120\t121\t73\t73\n120\t121\t74\t74\n120\t121\t75\t75\n120\t121\t76\t76\n120\t121\t77\t77\n120\t121\t78\t78\n120\t121\t79\t79\n120\t121\t80\t80\n120\t121\t81\t81\n120\t121\t82\t82\n120\t121\t83\t83\n120\t121\t84\t84\n120\t121\t85\t85\n120\t121\t86\t86\n120\t121\t87\t87\n120\t121\t88\t88\n120\t121\t89\t89\n120\t121\t90\t90\n120\t121\t91\t91\n120\t121\t92\t92\n120\t121\t93\t93\n120\t121\t94\t94\n120\t121\t95\t95\n120\t121\t96\t96\n120\t121\t97\t97\n120\t121\t98\t98\n120\t121\t99\t99\n120\t121\t100\t100\n120\t121\t101\t101\n120\t121\t102\t102\n120\t121\t103\t103\n120\t121\t104\t104\n120\t121\t105\t105\n120\t121\t106\t106\n120\t121\t107\t107\n120\t121\t108\t108\n120\t121\t109\t109\n120\t121\t110\t110\n120\t121\t111\t111\n120\t121\t112\t112\n120\t121\t113\t113\n120\t121\t114\t114\n120\t121\t115\t115\n120\t121\t116\t116\n120\t121\t117\t117\n120\t121\t118\t118\n120\t121\t119\t119\n120\t121\t120\t120\n120\t121\t121\t121\n120\t121\t122\t122\n120\t121\t123\t123\n120\t121\t124\t124\n120\t121\t125\t125\n120\t121\t126\t126\n120\t121\t127\t127\n120\t121\t128\t128\n120\t121\t129\t129\n120\t121\t130\t130\n120\t121\t131\t131\n120\t121\t132\t132\n120\t121\t133\t133\n120\t121\t134\t134\n120\t121\t135\t135\n120\t121\t136\t136\n120\t121\t137\t137\n120\t121\t138\t138\n120\t121\t139\t139\n120\t121\t140\t140\n120\t121\t141\t141\n120\t121\t142\t142\n120\t121\t143\t143\n120\t121\t144\t144\n120\t121\t145\t145\n120\t121\t146\t146\n120\t121\t147\t147\n120\t121\t148\t148\n120\t121\t149\t149\n120\t121\t150\t150\n120\t121\t151\t151\n120\t121\t152\t152\n120\t121\t153\t153\n120\t121\t154\t154\n120\t121\t155\t155\n120\t121\t156\t156\n120\t121\t157\t157\n120\t121\t158\t158\n120\t121\t159\t159\n120\t121\t160\t160\n120\t121\t161\t161\n120\t121\t162\t162\n120\t121\t163\t163\n120\t121\t164\t164\n120\t121\t165\t165\n120\t121\t166\t166\n120\t121\t167\t167\n120\t121\t168\t168\n120\t121\t169\t169\n120\t121\t170\t170\n120\t121\t171\t171\n120\t121\t172\t172\n120\t121\t173\t173\n120\t121\t174\t174\n120\t121\t175\t175\n120\t121\t176\t176\n120\t121\t177\t177\n120\t121\t178\t178\n120\t121\t179\t179\n120\t121\t180\t180\n120\t121\t181\t181\n120\t121\t182\t182\n120\t121\t183\t183\n120\t121\t184\t184\n120\t121\t185\t185\n120\t121\t186\t186\n120\t121\t187\t187\n120\t121\t188\t188\n120\t121\t189\t189\n120\t121\t190\t190\n120\t121\t191\t191\n120\t121\t192\t192\n120\t121\t193\t193\n120\t121\t194\t194\n120\t121\t195\t195\n120\t121\t196\t196\n120\t121\t197\t197\n120\t121\t198\t198\n120\t121\t199\t199\n120\t121\t200\t200\n120\t121\t201\t201\n120\t121\t202\t202\n120\t121\t203\t203\n120\t121\t204\t204\n120\t121\t205\t205\n120\t121\t206\t206\n120\t121\t207\t207\n120\t121\t208\t208\n120\t121\t209\t209\n120\t121\t210\t210\n120\t121\t211\t211\n120\t121\t212\t212\n120\t121\t213\t213\n120\t121\t214\t214\n120\t121\t215\t215\n120\t121\t216\t216\n120\t121\t217\t217\n120\t121\t218\t218\n120\t121\t219\t219\n120\t121\t220\t220\n120\t121\t221\t221\n120\t121\t222\t222\n120\t121\t223\t223\n120\t121\t224\t224\n120\t121\t225\t225\n120\t121\t226\t226\n120\t121\t227\t227\n120\t121\t228\t228\n120\t121\t229\t229\n120\t121\t230\t230\n120\t121\t231\t231\n120\t121\t232\t232\n120\t121\t233\t233\n120\t121\t234\t234\n120\t121\t235\t235\n120\t121\t236\t236\n120\t121\t237\t237\n120\t121\t238\t238\n120\t121\t239\t239\n120\t121\t240\t240\n120\t121\t241\t241\n120\t121\t242\t242\n120\t121\t243\t243\n120\t121\t244\t244\n120\t121\t245\t245\n120\t121\t246\t246\n120\t121\t247\t247\n120\t121\t248\t248\n120\t121\t249\t249\n120\t121\t250\t250\n120\t121\t251\t251\n120\t121\t252\t252\n120\t121\t253\t253\n120\t121\t254\t254\n120\t121\t255\t255\n121\t122\t0\t0\n121\t122\t1\t1\n121\t122\t2\t2\n121\t122\t3\t3\n121\t122\t4\t4\n121\t122\t5\t5\n121\t122\t6\t6\n121\t122\t7\t7\n121\t122\t8\t8\n121\t122\t9\t9\n121\t122\t10\t10\n121\t122\t11\t11\n121\t122\t12\t12\n121\t122\t13\t13\n121\t122\t14\t14\n121\t122\t15\t15\n121\t122\t16\t16\n121\t122\t17\t17\n121\t122\t18\t18\n121\t122\t19\t19\n121\t122\t20\t20\n121\t122\t21\t21\n121\t122\t22\t22\n121\t122\t23\t23\n121\t122\t24\t24\n121\t122\t25\t25\n121\t122\t26\t26\n121\t122\t27\t27\n121\t122\t28\t28\n121\t122\t29\t29\n121\t122\t30\t30\n121\t122\t31\t31\n121\t122\t32\t32\n121\t122\t33\t33\n121\t122\t34\t34\n121\t122\t35\t35\n121\t122\t36\t36\n121\t122\t37\t37\n121\t122\t38\t38\n121\t122\t39\t39\n121\t122\t40\t40\n121\t122\t41\t41\n121\t122\t42\t42\n121\t122\t43\t43\n121\t122\t44\t44\n121\t122\t45\t45\n121\t122\t46\t46\n121\t122\t47\t47\n121\t122\t48\t48\n121\t122\t49\t49\n121\t122\t50\t50\n121\t122\t51\t51\n121\t122\t52\t52\n121\t122\t53\t53\n121\t122\t54\t54\n121\t122\t55\t55\n121\t122\t56\t56\n121\t122\t57\t57\n121\t122\t58\t58\n121\t122\t59\t59\n121\t122\t60\t60\n121\t122\t61\t61\n121\t122\t62\t62\n121\t122\t63\t63\n121\t122\t64\t64\n121\t122\t65\t65\n121\t122\t66\t66\n121\t122\t67\t67\n121\t122\t68\t68\n121\t122\t69\t69\n121\t122\t70\t70\n121\t122\t71\t71\n121\t122\t72\t72\n121\t122\t73\t73\n121\t122\t74\t74\n121\t122\t75\t75\n121\t122\t76\t76\n121\t122\t77\t77\n121\t122\t78\t78\n121\t122\t79\t79\n121\t122\t80\t80\n121\t122\t81\t81\n121\t122\t82\t82\n121\t122\t83\t83\n121\t122\t84\t84\n121\t122\t85\t85\n121\t122\t86\t86\n121\t122\t87\t87\n121\t122\t88\t88\n121\t122\t89\t89\n121\t122\t90\t90\n121\t122\t91\t91\n121\t122\t92\t92\n121\t122\t93\t93\n121\t122\t94\t94\n121\t122\t95\t95\n121\t122\t96\t96\n121\t122\t97\t97\n121\t122\t98\t98\n121\t122\t99\t99\n121\t122\t100\t100\n121\t122\t101\t101\n121\t122\t102\t102\n121\t122\t103\t103\n121\t122\t104\t104\n121\t122\t105\t105\n121\t122\t106\t106\n121\t122\t107\t107\n121\t122\t108\t108\n121\t122\t109\t109\n121\t122\t110\t110\n121\t122\t111\t111\n121\t122\t112\t112\n121\t122\t113\t113\n121\t122\t114\t114\n121\t122\t115\t115\n121\t122\t116\t116\n121\t122\t117\t117\n121\t122\t118\t118\n121\t122\t119\t119\n121\t122\t120\t120\n121\t122\t121\t121\n121\t122\t122\t122\n121\t122\t123\t123\n121\t122\t124\t124\n121\t122\t125\t125\n121\t122\t126\t126\n121\t122\t127\t127\n121\t122\t128\t128\n121\t122\t129\t129\n121\t122\t130\t130\n121\t122\t131\t131\n121\t122\t132\t132\n121\t122\t133\t133\n121\t122\t134\t134\n121\t122\t135\t135\n121\t122\t136\t136\n121\t122\t137\t137\n121\t122\t138\t138\n121\t122\t139\t139\n121\t122\t140\t140\n121\t122\t141\t141\n121\t122\t142\t142\n121\t122\t143\t143\n121\t122\t144\t144\n121\t122\t145\t145\n121\t122\t146\t146\n121\t122\t147\t147\n121\t122\t148\t148\n121\t122\t149\t149\n121\t122\t150\t150\n121\t122\t151\t151\n121\t122\t152\t152\n121\t122\t153\t153\n121\t122\t154\t154\n121\t122\t155\t155\n121\t122\t156\t156\n121\t122\t157\t157\n121\t122\t158\t158\n121\t122\t159\t159\n121\t122\t160\t160\n121\t122\t161\t161\n121\t122\t162\t162\n121\t122\t163\t163\n121\t122\t164\t164\n121\t122\t165\t165\n121\t122\t166\t166\n121\t122\t167\t167\n121\t122\t168\t168\n121\t122\t169\t169\n121\t122\t170\t170\n121\t122\t171\t171\n121\t122\t172\t172\n121\t122\t173\t173\n121\t122\t174\t174\n121\t122\t175\t175\n121\t122\t176\t176\n121\t122\t177\t177\n121\t122\t178\t178\n121\t122\t179\t179\n121\t122\t180\t180\n121\t122\t181\t181\n121\t122\t182\t182\n121\t122\t183\t183\n121\t122\t184\t184\n121\t122\t185\t185\n121\t122\t186\t186\n121\t122\t187\t187\n121\t122\t188\t188\n121\t122\t189\t189\n121\t122\t190\t190\n121\t122\t191\t191\n121\t122\t192\t192\n121\t122\t193\t193\n121\t122\t194\t194\n121\t122\t195\t195\n121\t122\t196\t196\n121\t122\t197\t197\n121\t122\t198\t198\n121\t122\t199\t199\n121\t122\t200\t200\n121\t122\t201\t201\n121\t122\t202\t202\n121\t122\t203\t203\n121\t122\t204\t204\n121\t122\t205\t205\n121\t122\t206\t206\n121\t122\t207\t207\n121\t122\t208\t208\n121\t122\t209\t209\n121\t122\t210\t210\n121\t122\t211\t211\n121\t122\t212\t212\n121\t122\t213\t213\n121\t122\t214\t214\n121\t122\t215\t215\n121\t122\t216\t216\n121\t122\t217\t217\n121\t122\t218\t218\n121\t122\t219\t219\n121\t122\t220\t220\n121\t122\t221\t221\n121\t122\t222\t222\n121\t122\t223\t223\n121\t122\t224\t224\n121\t122\t225\t225\n121\t122\t226\t226\n121\t122\t227\t227\n121\t122\t228\t228\n121\t122\t229\t229\n121\t122\t230\t230\n121\t122\t231\t231\n121\t122\t232\t232\n121\t122\t233\t233\n121\t122\t234\t234\n121\t122\t235\t235\n121\t122\t236\t236\n121\t122\t237\t237\n121\t122\t238\t238\n121\t122\t239\t239\n121\t122\t240\t240\n121\t122\t241\t241\n121\t122\t242\t242\n121\t122\t243\t243\n121\t122\t244\t244\n121\t122\t245\t245\n121\t122\t246\t246\n121\t122\t247\t247\n121\t122\t248\t248\n121\t122\t249\t249\n121\t122\t250\t250\n121\t122\t251\t251\n121\t122\t252\t252\n121\t122\t253\t253\n121\t122\t254\t254\n121\t122\t255\t255\n122\t123\t0\t0\n122\t123\t1\t1\n122\t123\t2\t2\n122\t123\t3\t3\n122\t123\t4\t4\n122\t123\t5\t5\n122\t123\t6\t6\n122\t123\t7\t7\n122\t123\t8\t8\n122\t123\t9\t9\n122\t123\t10\t10\n122\t123\t11\t11\n122\t123\t12\t12\n122\t123\t13\t13\n122\t123\t14\t14\n122\t123\t15\t15\n122\t123\t16\t16\n122\t123\t17\t17\n122\t123\t18\t18\n122\t123\t19\t19\n122\t123\t20\t20\n122\t123\t21\t21\n122\t123\t22\t22\n122\t123\t23\t23\n122\t123\t24\t24\n122\t123\t25\t25\n122\t123\t26\t26\n122\t123\t27\t27\n122\t123\t28\t28\n122\t123\t29\t29\n122\t123\t30\t30\n122\t123\t31\t31\n122\t123\t32\t32\n122\t123\t33\t33\n122\t123\t34\t34\n122\t123\t35\t35\n122\t123\t36\t36\n122\t123\t37\t37\n122\t123\t38\t38\n122\t123\t39\t39\n122\t123\t40\t40\n122\t123\t41\t41\n122\t123\t42\t42\n122\t123\t43\t43\n122\t123\t44\t44\n122\t123\t45\t45\n122\t123\t46\t46\n122\t123\t47\t47\n122\t123\t48\t48\n122\t123\t49\t49\n122\t123\t50\t50\n122\t123\t51\t51\n122\t123\t52\t52\n122\t123\t53\t53\n122\t123\t54\t54\n122\t123\t55\t55\n122\t123\t56\t56\n122\t123\t57\t57\n122\t123\t58\t58\n122\t123\t59\t59\n122\t123\t60\t60\n122\t123\t61\t61\n122\t123\t62\t62\n122\t123\t63\t63\n122\t123\t64\t64\n122\t123\t65\t65\n122\t123\t66\t66\n122\t123\t67\t67\n122\t123\t68\t68\n122\t123\t69\t69\n122\t123\t70\t70\n122\t123\t71\t71\n122\t123\t72\t72\n122\t123\t73\t73\n122\t123\t74\t74\n122\t123\t75\t75\n122\t123\t76\t76\n122\t123\t77\t77\n122\t123\t78\t78\n122\t123\t79\t79\n122\t123\t80\t80\n122\t123\t81\t81\n122\t123\t82\t82\n122\t123\t83\t83\n122\t123\t84\t84\n122\t123\t85\t85\n122\t123\t86\t86\n122\t123\t87\t87\n122\t123\t88\t88\n122\t123\t89\t89\n122\t123\t90\t90\n122\t123\t91\t91\n122\t123\t92\t92\n122\t123\t93\t93\n122\t123\t94\t94\n122\t123\t95\t95\n122\t123\t96\t96\n122\t123\t97\t97\n122\t123\t98\t98\n122\t123\t99\t99\n122\t123\t100\t100\n122\t123\t101\t101\n122\t123\t102\t102\n122\t123\t103\t103\n122\t123\t104\t104\n122\t123\t105\t105\n122\t123\t106\t106\n122\t123\t107\t107\n122\t123\t108\t108\n122\t123\t109\t109\n122\t123\t110\t110\n122\t123\t111\t111\n122\t123\t112\t112\n122\t123\t113\t113\n122\t123\t114\t114\n122\t123\t115\t115\n122\t123\t116\t116\n122\t123\t117\t117\n122\t123\t118\t118\n122\t123\t119\t119\n122\t123\t120\t120\n122\t123\t121\t121\n122\t123\t122\t122\n122\t123\t123\t123\n122\t123\t124\t124\n122\t123\t125\t125\n122\t123\t126\t126\n122\t123\t127\t127\n122\t123\t128\t128\n122\t123\t129\t129\n122\t123\t130\t130\n122\t123\t131\t131\n122\t123\t132\t132\n122\t123\t133\t133\n122\t123\t134\t134\n122\t123\t135\t135\n122\t123\t136\t136\n122\t123\t137\t137\n122\t123\t138\t138\n122\t123\t139\t139\n122\t123\t140\t140\n122\t123\t141\t141\n122\t123\t142\t142\n122\t123\t143\t143\n122\t123\t144\t144\n122\t123\t145\t145\n122\t123\t146\t146\n122\t123\t147\t147\n122\t123\t148\t148\n122\t123\t149\t149\n122\t123\t150\t150\n122\t123\t151\t151\n122\t123\t152\t152\n122\t123\t153\t153\n122\t123\t154\t154\n122\t123\t155\t155\n122\t123\t156\t156\n122\t123\t157\t157\n122\t123\t158\t158\n122\t123\t159\t159\n122\t123\t160\t160\n122\t123\t161\t161\n122\t123\t162\t162\n122\t123\t163\t163\n122\t123\t164\t164\n122\t123\t165\t165\n122\t123\t166\t166\n122\t123\t167\t167\n122\t123\t168\t168\n122\t123\t169\t169\n122\t123\t170\t170\n122\t123\t171\t171\n122\t123\t172\t172\n122\t123\t173\t173\n122\t123\t174\t174\n122\t123\t175\t175\n122\t123\t176\t176\n122\t123\t177\t177\n122\t123\t178\t178\n122\t123\t179\t179\n122\t123\t180\t180\n122\t123\t181\t181\n122\t123\t182\t182\n122\t123\t183\t183\n122\t123\t184\t184\n122\t123\t185\t185\n122\t123\t186\t186\n122\t123\t187\t187\n122\t123\t188\t188\n122\t123\t189\t189\n122\t123\t190\t190\n122\t123\t191\t191\n122\t123\t192\t192\n122\t123\t193\t193\n122\t123\t194\t194\n122\t123\t195\t195\n122\t123\t196\t196\n122\t123\t197\t197\n122\t123\t198\t198\n122\t123\t199\t199\n122\t123\t200\t200\n122\t123\t201\t201\n122\t123\t202\t202\n122\t123\t203\t203\n122\t123\t204\t204\n122\t123\t205\t205\n122\t123\t206\t206\n122\t123\t207\t207\n122\t123\t208\t208\n122\t123\t209\t209\n122\t123\t210\t210\n122\t123\t211\t211\n122\t123\t212\t212\n122\t123\t213\t213\n122\t123\t214\t214\n122\t123\t215\t215\n122\t123\t216\t216\n122\t123\t217\t217\n122\t123\t218\t218\n122\t123\t219\t219\n122\t123\t220\t220\n122\t123\t221\t221\n122\t123\t222\t222\n122\t123\t223\t223\n122\t123\t224\t224\n122\t123\t225\t225\n122\t123\t226\t226\n122\t123\t227\t227\n122\t123\t228\t228\n122\t123\t229\t229\n122\t123\t230\t230\n122\t123\t231\t231\n122\t123\t232\t232\n122\t123\t233\t233\n122\t123\t234\t234\n122\t123\t235\t235\n122\t123\t236\t236\n122\t123\t237\t237\n122\t123\t238\t238\n122\t123\t239\t239\n122\t123\t240\t240\n122\t123\t241\t241\n122\t123\t242\t242\n122\t123\t243\t243\n122\t123\t244\t244\n122\t123\t245\t245\n122\t123\t246\t246\n122\t123\t247\t247\n122\t123\t248\t248\n122\t123\t249\t249\n122\t123\t250\t250\n122\t123\t251\t251\n122\t123\t252\t252\n122\t123\t253\t253\n122\t123\t254\t254\n122\t123\t255\t255\n123\t124\t0\t0\n123\t124\t1\t1\n123\t124\t2\t2\n123\t124\t3\t3\n123\t124\t4\t4\n123\t124\t5\t5\n123\t124\t6\t6\n123\t124\t7\t7\n123\t124\t8\t8\n123\t124\t9\t9\n123\t124\t10\t10\n123\t124\t11\t11\n123\t124\t12\t12\n123\t124\t13\t13\n123\t124\t14\t14\n123\t124\t15\t15\n123\t124\t16\t16\n123\t124\t17\t17\n123\t124\t18\t18\n123\t124\t19\t19\n123\t124\t20\t20\n123\t124\t21\t21\n123\t124\t22\t22\n123\t124\t23\t23\n123\t124\t24\t24\n123\t124\t25\t25\n123\t124\t26\t26\n123\t124\t27\t27\n123\t124\t28\t28\n123\t124\t29\t29\n123\t124\t30\t30\n123\t124\t31\t31\n123\t124\t32\t32\n123\t124\t33\t33\n123\t124\t34\t34\n123\t124\t35\t35\n123\t124\t36\t36\n123\t124\t37\t37\n123\t124\t38\t38\n123\t124\t39\t39\n123\t124\t40\t40\n123\t124\t41\t41\n123\t124\t42\t42\n123\t124\t43\t43\n123\t124\t44\t44\n123\t124\t45\t45\n123\t124\t46\t46\n123\t124\t47\t47\n123\t124\t48\t48\n123\t124\t49\t49\n123\t124\t50\t50\n123\t124\t51\t51\n123\t124\t52\t52\n123\t124\t53\t53\n123\t124\t54\t54\n123\t124\t55\t55\n123\t124\t56\t56\n123\t124\t57\t57\n123\t124\t58\t58\n123\t124\t59\t59\n123\t124\t60\t60\n123\t124\t61\t61\n123\t124\t62\t62\n123\t124\t63\t63\n123\t124\t64\t64\n123\t124\t65\t65\n123\t124\t66\t66\n123\t124\t67\t67\n123\t124\t68\t68\n123\t124\t69\t69\n123\t124\t70\t70\n123\t124\t71\t71\n123\t124\t72\t72\n123\t124\t73\t73\n123\t124\t74\t74\n123\t124\t75\t75\n123\t124\t76\t76\n123\t124\t77\t77\n123\t124\t78\t78\n123\t124\t79\t79\n123\t124\t80\t80\n123\t124\t81\t81\n123\t124\t82\t82\n123\t124\t83\t83\n123\t124\t84\t84\n123\t124\t85\t85\n123\t124\t86\t86\n123\t124\t87\t87\n123\t124\t88\t88\n123\t124\t89\t89\n123\t124\t90\t90\n123\t124\t91\t91\n123\t124\t92\t92\n123\t124\t93\t93\n123\t124\t94\t94\n123\t124\t95\t95\n123\t124\t96\t96\n123\t124\t97\t97\n123\t124\t98\t98\n123\t124\t99\t99\n123\t124\t100\t100\n123\t124\t101\t101\n123\t124\t102\t102\n123\t124\t103\t103\n123\t124\t104\t104\n123\t124\t105\t105\n123\t124\t106\t106\n123\t124\t107\t107\n123\t124\t108\t108\n123\t124\t109\t109\n123\t124\t110\t110\n123\t124\t111\t111\n123\t124\t112\t112\n123\t124\t113\t113\n123\t124\t114\t114\n123\t124\t115\t115\n123\t124\t116\t116\n123\t124\t117\t117\n123\t124\t118\t118\n123\t124\t119\t119\n123\t124\t120\t120\n123\t124\t121\t121\n123\t124\t122\t122\n123\t124\t123\t123\n123\t124\t124\t124\n123\t124\t125\t125\n123\t124\t126\t126\n123\t124\t127\t127\n123\t124\t128\t128\n123\t124\t129\t129\n123\t124\t130\t130\n123\t124\t131\t131\n123\t124\t132\t132\n123\t124\t133\t133\n123\t124\t134\t134\n123\t124\t135\t135\n123\t124\t136\t136\n123\t124\t137\t137\n123\t124\t138\t138\n123\t124\t139\t139\n123\t124\t140\t140\n123\t124\t141\t141\n123\t124\t142\t142\n123\t124\t143\t143\n123\t124\t144\t144\n123\t124\t145\t145\n123\t124\t146\t146\n123\t124\t147\t147\n123\t124\t148\t148\n123\t124\t149\t149\n123\t124\t150\t150\n123\t124\t151\t151\n123\t124\t152\t152\n123\t124\t153\t153\n123\t124\t154\t154\n123\t124\t155\t155\n123\t124\t156\t156\n123\t124\t157\t157\n123\t124\t158\t158\n123\t124\t159\t159\n123\t124\t160\t160\n123\t124\t161\t161\n123\t124\t162\t162\n123\t124\t163\t163\n123\t124\t164\t164\n123\t124\t165\t165\n123\t124\t166\t166\n123\t124\t167\t167\n123\t124\t168\t168\n123\t124\t169\t169\n123\t124\t170\t170\n123\t124\t171\t171\n123\t124\t172\t172\n123\t124\t173\t173\n123\t124\t174\t174\n123\t124\t175\t175\n123\t124\t176\t176\n123\t124\t177\t177\n123\t124\t178\t178\n123\t124\t179\t179\n123\t124\t180\t180\n123\t124\t181\t181\n123\t124\t182\t182\n123\t124\t183\t183\n123\t124\t184\t184\n123\t124\t185\t185\n123\t124\t186\t186\n123\t124\t187\t187\n123\t124\t188\t188\n123\t124\t189\t189\n123\t124\t190\t190\n123\t124\t191\t191\n123\t124\t192\t192\n123\t124\t193\t193\n123\t124\t194\t194\n123\t124\t195\t195\n123\t124\t196\t196\n123\t124\t197\t197\n123\t124\t198\t198\n123\t124\t199\t199\n123\t124\t200\t200\n123\t124\t201\t201\n123\t124\t202\t202\n123\t124\t203\t203\n123\t124\t204\t204\n123\t124\t205\t205\n123\t124\t206\t206\n123\t124\t207\t207\n123\t124\t208\t208\n123\t124\t209\t209\n123\t124\t210\t210\n123\t124\t211\t211\n123\t124\t212\t212\n123\t124\t213\t213\n123\t124\t214\t214\n123\t124\t215\t215\n123\t124\t216\t216\n123\t124\t217\t217\n123\t124\t218\t218\n123\t124\t219\t219\n123\t124\t220\t220\n123\t124\t221\t221\n123\t124\t222\t222\n123\t124\t223\t223\n123\t124\t224\t224\n123\t124\t225\t225\n123\t124\t226\t226\n123\t124\t227\t227\n123\t124\t228\t228\n123\t124\t229\t229\n123\t124\t230\t230\n123\t124\t231\t231\n123\t124\t232\t232\n123\t124\t233\t233\n123\t124\t234\t234\n123\t124\t235\t235\n123\t124\t236\t236\n123\t124\t237\t237\n123\t124\t238\t238\n123\t124\t239\t239\n123\t124\t240\t240\n123\t124\t241\t241\n123\t124\t242\t242\n123\t124\t243\t243\n123\t124\t244\t244\n123\t124\t245\t245\n123\t124\t246\t246\n123\t124\t247\t247\n123\t124\t248\t248\n123\t124\t249\t249\n123\t124\t250\t250\n123\t124\t251\t251\n123\t124\t252\t252\n123\t124\t253\t253\n123\t124\t254\t254\n123\t124\t255\t255\n124\t125\t0\t0\n124\t125\t1\t1\n124\t125\t2\t2\n124\t125\t3\t3\n124\t125\t4\t4\n124\t125\t5\t5\n124\t125\t6\t6\n124\t125\t7\t7\n124\t125\t8\t8\n124\t125\t9\t9\n124\t125\t10\t10\n124\t125\t11\t11\n124\t125\t12\t12\n124\t125\t13\t13\n124\t125\t14\t14\n124\t125\t15\t15\n124\t125\t16\t16\n124\t125\t17\t17\n124\t125\t18\t18\n124\t125\t19\t19\n124\t125\t20\t20\n124\t125\t21\t21\n124\t125\t22\t22\n124\t125\t23\t23\n124\t125\t24\t24\n124\t125\t25\t25\n124\t125\t26\t26\n124\t125\t27\t27\n124\t125\t28\t28\n124\t125\t29\t29\n124\t125\t30\t30\n124\t125\t31\t31\n124\t125\t32\t32\n124\t125\t33\t33\n124\t125\t34\t34\n124\t125\t35\t35\n124\t125\t36\t36\n124\t125\t37\t37\n124\t125\t38\t38\n124\t125\t39\t39\n124\t125\t40\t40\n124\t125\t41\t41\n124\t125\t42\t42\n124\t125\t43\t43\n124\t125\t44\t44\n124\t125\t45\t45\n124\t125\t46\t46\n124\t125\t47\t47\n124\t125\t48\t48\n124\t125\t49\t49\n124\t125\t50\t50\n124\t125\t51\t51\n124\t125\t52\t52\n124\t125\t53\t53\n124\t125\t54\t54\n124\t125\t55\t55\n124\t125\t56\t56\n124\t125\t57\t57\n124\t125\t58\t58\n124\t125\t59\t59\n124\t125\t60\t60\n124\t125\t61\t61\n124\t125\t62\t62\n124\t125\t63\t63\n124\t125\t64\t64\n124\t125\t65\t65\n124\t125\t66\t66\n124\t125\t67\t67\n124\t125\t68\t68\n124\t125\t69\t69\n124\t125\t70\t70\n124\t125\t71\t71\n124\t125\t72\t72\n124\t125\t73\t73\n124\t125\t74\t74\n124\t125\t75\t75\n124\t125\t76\t76\n124\t125\t77\t77\n124\t125\t78\t78\n124\t125\t79\t79\n124\t125\t80\t80\n124\t125\t81\t81\n124\t125\t82\t82\n124\t125\t83\t83\n124\t125\t84\t84\n124\t125\t85\t85\n124\t125\t86\t86\n124\t125\t87\t87\n124\t125\t88\t88\n124\t125\t89\t89\n124\t125\t90\t90\n124\t125\t91\t91\n124\t125\t92\t92\n124\t125\t93\t93\n124\t125\t94\t94\n124\t125\t95\t95\n124\t125\t96\t96\n124\t125\t97\t97\n124\t125\t98\t98\n124\t125\t99\t99\n124\t125\t100\t100\n124\t125\t101\t101\n124\t125\t102\t102\n124\t125\t103\t103\n124\t125\t104\t104\n124\t125\t105\t105\n124\t125\t106\t106\n124\t125\t107\t107\n124\t125\t108\t108\n124\t125\t109\t109\n124\t125\t110\t110\n124\t125\t111\t111\n124\t125\t112\t112\n124\t125\t113\t113\n124\t125\t114\t114\n124\t125\t115\t115\n124\t125\t116\t116\n124\t125\t117\t117\n124\t125\t118\t118\n124\t125\t119\t119\n124\t125\t120\t120\n124\t125\t121\t121\n124\t125\t122\t122\n124\t125\t123\t123\n124\t125\t124\t124\n124\t125\t125\t125\n124\t125\t126\t126\n124\t125\t127\t127\n124\t125\t128\t128\n124\t125\t129\t129\n124\t125\t130\t130\n124\t125\t131\t131\n124\t125\t132\t132\n124\t125\t133\t133\n124\t125\t134\t134\n124\t125\t135\t135\n124\t125\t136\t136\n124\t125\t137\t137\n124\t125\t138\t138\n124\t125\t139\t139\n124\t125\t140\t140\n124\t125\t141\t141\n124\t125\t142\t142\n124\t125\t143\t143\n124\t125\t144\t144\n124\t125\t145\t145\n124\t125\t146\t146\n124\t125\t147\t147\n124\t125\t148\t148\n124\t125\t149\t149\n124\t125\t150\t150\n124\t125\t151\t151\n124\t125\t152\t152\n124\t125\t153\t153\n124\t125\t154\t154\n124\t125\t155\t155\n124\t125\t156\t156\n124\t125\t157\t157\n124\t125\t158\t158\n124\t125\t159\t159\n124\t125\t160\t160\n124\t125\t161\t161\n124\t125\t162\t162\n124\t125\t163\t163\n124\t125\t164\t164\n124\t125\t165\t165\n124\t125\t166\t166\n124\t125\t167\t167\n124\t125\t168\t168\n124\t125\t169\t169\n124\t125\t170\t170\n124\t125\t171\t171\n124\t125\t172\t172\n124\t125\t173\t173\n124\t125\t174\t174\n124\t125\t175\t175\n124\t125\t176\t176\n124\t125\t177\t177\n124\t125\t178\t178\n124\t125\t179\t179\n124\t125\t180\t180\n124\t125\t181\t181\n124\t125\t182\t182\n124\t125\t183\t183\n124\t125\t184\t184\n124\t125\t185\t185\n124\t125\t186\t186\n124\t125\t187\t187\n124\t125\t188\t188\n124\t125\t189\t189\n124\t125\t190\t190\n124\t125\t191\t191\n124\t125\t192\t192\n124\t125\t193\t193\n124\t125\t194\t194\n124\t125\t195\t195\n124\t125\t196\t196\n124\t125\t197\t197\n124\t125\t198\t198\n124\t125\t199\t199\n124\t125\t200\t200\n124\t125\t201\t201\n124\t125\t202\t202\n124\t125\t203\t203\n124\t125\t204\t204\n124\t125\t205\t205\n124\t125\t206\t206\n124\t125\t207\t207\n124\t125\t208\t208\n124\t125\t209\t209\n124\t125\t210\t210\n124\t125\t211\t211\n124\t125\t212\t212\n124\t125\t213\t213\n124\t125\t214\t214\n124\t125\t215\t215\n124\t125\t216\t216\n124\t125\t217\t217\n124\t125\t218\t218\n124\t125\t219\t219\n124\t125\t220\t220\n124\t125\t221\t221\n124\t125\t222\t222\n124\t125\t223\t223\n124\t125\t224\t224\n124\t125\t225\t225\n124\t125\t226\t226\n124\t125\t227\t227\n124\t125\t228\t228\n124\t125\t229\t229\n124\t125\t230\t230\n124\t125\t231\t231\n124\t125\t232\t232\n124\t125\t233\t233\n124\t125\t234\t234\n124\t125\t235\t235\n124\t125\t236\t236\n124\t125\t237\t237\n124\t125\t238\t238\n124\t125\t239\t239\n124\t125\t240\t240\n124\t125\t241\t241\n124\t125\t242\t242\n124\t125\t243\t243\n124\t125\t244\t244\n124\t125\t245\t245\n124\t125\t246\t246\n124\t125\t247\t247\n124\t125\t248\t248\n124\t125\t249\t249\n124\t125\t250\t250\n124\t125\t251\t251\n124\t125\t252\t252\n124\t125\t253\t253\n124\t125\t254\t254\n124\t125\t255\t255\n125\t126\t0\t0\n125\t126\t1\t1\n125\t126\t2\t2\n125\t126\t3\t3\n125\t126\t4\t4\n125\t126\t5\t5\n125\t126\t6\t6\n125\t126\t7\t7\n125\t126\t8\t8\n125\t126\t9\t9\n125\t126\t10\t10\n125\t126\t11\t11\n125\t126\t12\t12\n125\t126\t13\t13\n125\t126\t14\t14\n125\t126\t15\t15\n125\t126\t16\t16\n125\t126\t17\t17\n125\t126\t18\t18\n125\t126\t19\t19\n125\t126\t20\t20\n125\t126\t21\t21\n125\t126\t22\t22\n125\t126\t23\t23\n125\t126\t24\t24\n125\t126\t25\t25\n125\t126\t26\t26\n125\t126\t27\t27\n125\t126\t28\t28\n125\t126\t29\t29\n125\t126\t30\t30\n125\t126\t31\t31\n125\t126\t32\t32\n125\t126\t33\t33\n125\t126\t34\t34\n125\t126\t35\t35\n125\t126\t36\t36\n125\t126\t37\t37\n125\t126\t38\t38\n125\t126\t39\t39\n125\t126\t40\t40\n125\t126\t41\t41\n125\t126\t42\t42\n125\t126\t43\t43\n125\t126\t44\t44\n125\t126\t45\t45\n125\t126\t46\t46\n125\t126\t47\t47\n125\t126\t48\t48\n125\t126\t49\t49\n125\t126\t50\t50\n125\t126\t51\t51\n125\t126\t52\t52\n125\t126\t53\t53\n125\t126\t54\t54\n125\t126\t55\t55\n125\t126\t56\t56\n125\t126\t57\t57\n125\t126\t58\t58\n125\t126\t59\t59\n125\t126\t60\t60\n125\t126\t61\t61\n125\t126\t62\t62\n125\t126\t63\t63\n125\t126\t64\t64\n125\t126\t65\t65\n125\t126\t66\t66\n125\t126\t67\t67\n125\t126\t68\t68\n125\t126\t69\t69\n125\t126\t70\t70\n125\t126\t71\t71\n125\t126\t72\t72\n125\t126\t73\t73\n125\t126\t74\t74\n125\t126\t75\t75\n125\t126\t76\t76\n125\t126\t77\t77\n125\t126\t78\t78\n125\t126\t79\t79\n125\t126\t80\t80\n125\t126\t81\t81\n125\t126\t82\t82\n125\t126\t83\t83\n125\t126\t84\t84\n125\t126\t85\t85\n125\t126\t86\t86\n125\t126\t87\t87\n125\t126\t88\t88\n125\t126\t89\t89\n125\t126\t90\t90\n125\t126\t91\t91\n125\t126\t92\t92\n125\t126\t93\t93\n125\t126\t94\t94\n125\t126\t95\t95\n125\t126\t96\t96\n125\t126\t97\t97\n125\t126\t98\t98\n125\t126\t99\t99\n125\t126\t100\t100\n125\t126\t101\t101\n125\t126\t102\t102\n125\t126\t103\t103\n125\t126\t104\t104\n125\t126\t105\t105\n125\t126\t106\t106\n125\t126\t107\t107\n125\t126\t108\t108\n125\t126\t109\t109\n125\t126\t110\t110\n125\t126\t111\t111\n125\t126\t112\t112\n125\t126\t113\t113\n125\t126\t114\t114\n125\t126\t115\t115\n125\t126\t116\t116\n125\t126\t117\t117\n125\t126\t118\t118\n125\t126\t119\t119\n125\t126\t120\t120\n125\t126\t121\t121\n125\t126\t122\t122\n125\t126\t123\t123\n125\t126\t124\t124\n125\t126\t125\t125\n125\t126\t126\t126\n125\t126\t127\t127\n125\t126\t128\t128\n125\t126\t129\t129\n125\t126\t130\t130\n125\t126\t131\t131\n125\t126\t132\t132\n125\t126\t133\t133\n125\t126\t134\t134\n125\t126\t135\t135\n125\t126\t136\t136\n125\t126\t137\t137\n125\t126\t138\t138\n125\t126\t139\t139\n125\t126\t140\t140\n125\t126\t141\t141\n125\t126\t142\t142\n125\t126\t143\t143\n125\t126\t144\t144\n125\t126\t145\t145\n125\t126\t146\t146\n125\t126\t147\t147\n125\t126\t148\t148\n125\t126\t149\t149\n125\t126\t150\t150\n125\t126\t151\t151\n125\t126\t152\t152\n125\t126\t153\t153\n125\t126\t154\t154\n125\t126\t155\t155\n125\t126\t156\t156\n125\t126\t157\t157\n125\t126\t158\t158\n125\t126\t159\t159\n125\t126\t160\t160\n125\t126\t161\t161\n125\t126\t162\t162\n125\t126\t163\t163\n125\t126\t164\t164\n125\t126\t165\t165\n125\t126\t166\t166\n125\t126\t167\t167\n125\t126\t168\t168\n125\t126\t169\t169\n125\t126\t170\t170\n125\t126\t171\t171\n125\t126\t172\t172\n125\t126\t173\t173\n125\t126\t174\t174\n125\t126\t175\t175\n125\t126\t176\t176\n125\t126\t177\t177\n125\t126\t178\t178\n125\t126\t179\t179\n125\t126\t180\t180\n125\t126\t181\t181\n125\t126\t182\t182\n125\t126\t183\t183\n125\t126\t184\t184\n125\t126\t185\t185\n125\t126\t186\t186\n125\t126\t187\t187\n125\t126\t188\t188\n125\t126\t189\t189\n125\t126\t190\t190\n125\t126\t191\t191\n125\t126\t192\t192\n125\t126\t193\t193\n125\t126\t194\t194\n125\t126\t195\t195\n125\t126\t196\t196\n125\t126\t197\t197\n125\t126\t198\t198\n125\t126\t199\t199\n125\t126\t200\t200\n125\t126\t201\t201\n125\t126\t202\t202\n125\t126\t203\t203\n125\t126\t204\t204\n125\t126\t205\t205\n125\t126\t206\t206\n125\t126\t207\t207\n125\t126\t208\t208\n125\t126\t209\t209\n125\t126\t210\t210\n125\t126\t211\t211\n125\t126\t212\t212\n125\t126\t213\t213\n125\t126\t214\t214\n125\t126\t215\t215\n125\t126\t216\t216\n125\t126\t217\t217\n125\t126\t218\t218\n125\t126\t219\t219\n125\t126\t220\t220\n125\t126\t221\t221\n125\t126\t222\t222\n125\t126\t223\t223\n125\t126\t224\t224\n125\t126\t225\t225\n125\t126\t226\t226\n125\t126\t227\t227\n125\t126\t228\t228\n125\t126\t229\t229\n125\t126\t230\t230\n125\t126\t231\t231\n125\t126\t232\t232\n125\t126\t233\t233\n125\t126\t234\t234\n125\t126\t235\t235\n125\t126\t236\t236\n125\t126\t237\t237\n125\t126\t238\t238\n125\t126\t239\t239\n125\t126\t240\t240\n125\t126\t241\t241\n125\t126\t242\t242\n125\t126\t243\t243\n125\t126\t244\t244\n125\t126\t245\t245\n125\t126\t246\t246\n125\t126\t247\t247\n125\t126\t248\t248\n125\t126\t249\t249\n125\t126\t250\t250\n125\t126\t251\t251\n125\t126\t252\t252\n125\t126\t253\t253\n125\t126\t254\t254\n125\t126\t255\t255\n126\t127\t0\t0\n126\t127\t1\t1\n126\t127\t2\t2\n126\t127\t3\t3\n126\t127\t4\t4\n126\t127\t5\t5\n126\t127\t6\t6\n126\t127\t7\t7\n126\t127\t8\t8\n126\t127\t9\t9\n126\t127\t10\t10\n126\t127\t11\t11\n126\t127\t12\t12\n126\t127\t13\t13\n126\t127\t14\t14\n126\t127\t15\t15\n126\t127\t16\t16\n126\t127\t17\t17\n126\t127\t18\t18\n126\t127\t19\t19\n126\t127\t20\t20\n126\t127\t21\t21\n126\t127\t22\t22\n126\t127\t23\t23\n126\t127\t24\t24\n126\t127\t25\t25\n126\t127\t26\t26\n126\t127\t27\t27\n126\t127\t28\t28\n126\t127\t29\t29\n126\t127\t30\t30\n126\t127\t31\t31\n126\t127\t32\t32\n126\t127\t33\t33\n126\t127\t34\t34\n126\t127\t35\t35\n126\t127\t36\t36\n126\t127\t37\t37\n126\t127\t38\t38\n126\t127\t39\t39\n126\t127\t40\t40\n126\t127\t41\t41\n126\t127\t42\t42\n126\t127\t43\t43\n126\t127\t44\t44\n126\t127\t45\t45\n126\t127\t46\t46\n126\t127\t47\t47\n126\t127\t48\t48\n126\t127\t49\t49\n126\t127\t50\t50\n126\t127\t51\t51\n126\t127\t52\t52\n126\t127\t53\t53\n126\t127\t54\t54\n126\t127\t55\t55\n126\t127\t56\t56\n126\t127\t57\t57\n126\t127\t58\t58\n126\t127\t59\t59\n126\t127\t60\t60\n126\t127\t61\t61\n126\t127\t62\t62\n126\t127\t63\t63\n126\t127\t64\t64\n126\t127\t65\t65\n126\t127\t66\t66\n126\t127\t67\t67\n126\t127\t68\t68\n126\t127\t69\t69\n126\t127\t70\t70\n126\t127\t71\t71\n126\t127\t72\t72\n126\t127\t73\t73\n126\t127\t74\t74\n126\t127\t75\t75\n126\t127\t76\t76\n126\t127\t77\t77\n126\t127\t78\t78\n126\t127\t79\t79\n126\t127\t80\t80\n126\t127\t81\t81\n126\t127\t82\t82\n126\t127\t83\t83\n126\t127\t84\t84\n126\t127\t85\t85\n126\t127\t86\t86\n126\t127\t87\t87\n126\t127\t88\t88\n126\t127\t89\t89\n126\t127\t90\t90\n126\t127\t91\t91\n126\t127\t92\t92\n126\t127\t93\t93\n126\t127\t94\t94\n126\t127\t95\t95\n126\t127\t96\t96\n126\t127\t97\t97\n126\t127\t98\t98\n126\t127\t99\t99\n126\t127\t100\t100\n126\t127\t101\t101\n126\t127\t102\t102\n126\t127\t103\t103\n126\t127\t104\t104\n126\t127\t105\t105\n126\t127\t106\t106\n126\t127\t107\t107\n126\t127\t108\t108\n126\t127\t109\t109\n126\t127\t110\t110\n126\t127\t111\t111\n126\t127\t112\t112\n126\t127\t113\t113\n126\t127\t114\t114\n126\t127\t115\t115\n126\t127\t116\t116\n126\t127\t117\t117\n126\t127\t118\t118\n126\t127\t119\t119\n126\t127\t120\t120\n126\t127\t121\t121\n126\t127\t122\t122\n126\t127\t123\t123\n126\t127\t124\t124\n126\t127\t125\t125\n126\t127\t126\t126\n126\t127\t127\t127\n126\t127\t128\t128\n126\t127\t129\t129\n126\t127\t130\t130\n126\t127\t131\t131\n126\t127\t132\t132\n126\t127\t133\t133\n126\t127\t134\t134\n126\t127\t135\t135\n126\t127\t136\t136\n126\t127\t137\t137\n126\t127\t138\t138\n126\t127\t139\t139\n126\t127\t140\t140\n126\t127\t141\t141\n126\t127\t142\t142\n126\t127\t143\t143\n126\t127\t144\t144\n126\t127\t145\t145\n126\t127\t146\t146\n126\t127\t147\t147\n126\t127\t148\t148\n126\t127\t149\t149\n126\t127\t150\t150\n126\t127\t151\t151\n126\t127\t152\t152\n126\t127\t153\t153\n126\t127\t154\t154\n126\t127\t155\t155\n126\t127\t156\t156\n126\t127\t157\t157\n126\t127\t158\t158\n126\t127\t159\t159\n126\t127\t160\t160\n126\t127\t161\t161\n126\t127\t162\t162\n126\t127\t163\t163\n126\t127\t164\t164\n126\t127\t165\t165\n126\t127\t166\t166\n126\t127\t167\t167\n126\t127\t168\t168\n126\t127\t169\t169\n126\t127\t170\t170\n126\t127\t171\t171\n126\t127\t172\t172\n126\t127\t173\t173\n126\t127\t174\t174\n126\t127\t175\t175\n126\t127\t176\t176\n126\t127\t177\t177\n126\t127\t178\t178\n126\t127\t179\t179\n126\t127\t180\t180\n126\t127\t181\t181\n126\t127\t182\t182\n126\t127\t183\t183\n126\t127\t184\t184\n126\t127\t185\t185\n126\t127\t186\t186\n126\t127\t187\t187\n126\t127\t188\t188\n126\t127\t189\t189\n126\t127\t190\t190\n126\t127\t191\t191\n126\t127\t192\t192\n126\t127\t193\t193\n126\t127\t194\t194\n126\t127\t195\t195\n126\t127\t196\t196\n126\t127\t197\t197\n126\t127\t198\t198\n126\t127\t199\t199\n126\t127\t200\t200\n126\t127\t201\t201\n126\t127\t202\t202\n126\t127\t203\t203\n126\t127\t204\t204\n126\t127\t205\t205\n126\t127\t206\t206\n126\t127\t207\t207\n126\t127\t208\t208\n126\t127\t209\t209\n126\t127\t210\t210\n126\t127\t211\t211\n126\t127\t212\t212\n126\t127\t213\t213\n126\t127\t214\t214\n126\t127\t215\t215\n126\t127\t216\t216\n126\t127\t217\t217\n126\t127\t218\t218\n126\t127\t219\t219\n126\t127\t220\t220\n126\t127\t221\t221\n126\t127\t222\t222\n126\t127\t223\t223\n126\t127\t224\t224\n126\t127\t225\t225\n126\t127\t226\t226\n126\t127\t227\t227\n126\t127\t228\t228\n126\t127\t229\t229\n126\t127\t230\t230\n126\t127\t231\t231\n126\t127\t232\t232\n126\t127\t233\t233\n126\t127\t234\t234\n126\t127\t235\t235\n126\t127\t236\t236\n126\t127\t237\t237\n126\t127\t238\t238\n126\t127\t239\t239\n126\t127\t240\t240\n126\t127\t241\t241\n126\t127\t242\t242\n126\t127\t243\t243\n126\t127\t244\t244\n126\t127\t245\t245\n126\t127\t246\t246\n126\t127\t247\t247\n126\t127\t248\t248\n126\t127\t249\t249\n126\t127\t250\t250\n126\t127\t251\t251\n126\t127\t252\t252\n126\t127\t253\t253\n126\t127\t254\t254\n126\t127\t255\t255\n127\t128\t0\t0\n127\t128\t1\t1\n127\t128\t2\t2\n127\t128\t3\t3\n127\t128\t4\t4\n127\t128\t5\t5\n127\t128\t6\t6\n127\t128\t7\t7\n127\t128\t8\t8\n127\t128\t9\t9\n127\t128\t10\t10\n127\t128\t11\t11\n127\t128\t12\t12\n127\t128\t13\t13\n127\t128\t14\t14\n127\t128\t15\t15\n127\t128\t16\t16\n127\t128\t17\t17\n127\t128\t18\t18\n127\t128\t19\t19\n127\t128\t20\t20\n127\t128\t21\t21\n127\t128\t22\t22\n127\t128\t23\t23\n127\t128\t24\t24\n127\t128\t25\t25\n127\t128\t26\t26\n127\t128\t27\t27\n127\t128\t28\t28\n127\t128\t29\t29\n127\t128\t30\t30\n127\t128\t31\t31\n127\t128\t32\t32\n127\t128\t33\t33\n127\t128\t34\t34\n127\t128\t35\t35\n127\t128\t36\t36\n127\t128\t37\t37\n127\t128\t38\t38\n127\t128\t39\t39\n127\t128\t40\t40\n127\t128\t41\t41\n127\t128\t42\t42\n127\t128\t43\t43\n127\t128\t44\t44\n127\t128\t45\t45\n127\t128\t46\t46\n127\t128\t47\t47\n127\t128\t48\t48\n127\t128\t49\t49\n127\t128\t50\t50\n127\t128\t51\t51\n127\t128\t52\t52\n127\t128\t53\t53\n127\t128\t54\t54\n127\t128\t55\t55\n127\t128\t56\t56\n127\t128\t57\t57\n127\t128\t58\t58\n127\t128\t59\t59\n127\t128\t60\t60\n127\t128\t61\t61\n127\t128\t62\t62\n127\t128\t63\t63\n127\t128\t64\t64\n127\t128\t65\t65\n127\t128\t66\t66\n127\t128\t67\t67\n127\t128\t68\t68\n127\t128\t69\t69\n127\t128\t70\t70\n127\t128\t71\t71\n127\t128\t72\t72\n127\t128\t73\t73\n127\t128\t74\t74\n127\t128\t75\t75\n127\t128\t76\t76\n127\t128\t77\t77\n127\t128\t78\t78\n127\t128\t79\t79\n127\t128\t80\t80\n127\t128\t81\t81\n127\t128\t82\t82\n127\t128\t83\t83\n127\t128\t84\t84\n127\t128\t85\t85\n127\t128\t86\t86\n127\t128\t87\t87\n127\t128\t88\t88\n127\t128\t89\t89\n127\t128\t90\t90\n127\t128\t91\t91\n127\t128\t92\t92\n127\t128\t93\t93\n127\t128\t94\t94\n127\t128\t95\t95\n127\t128\t96\t96\n127\t128\t97\t97\n127\t128\t98\t98\n127\t128\t99\t99\n127\t128\t100\t100\n127\t128\t101\t101\n127\t128\t102\t102\n127\t128\t103\t103\n127\t128\t104\t104\n127\t128\t105\t105\n127\t128\t106\t106\n127\t128\t107\t107\n127\t128\t108\t108\n127\t128\t109\t109\n127\t128\t110\t110\n127\t128\t111\t111\n127\t128\t112\t112\n127\t128\t113\t113\n127\t128\t114\t114\n127\t128\t115\t115\n127\t128\t116\t116\n127\t128\t117\t117\n127\t128\t118\t118\n127\t128\t119\t119\n127\t128\t120\t120\n127\t128\t121\t121\n127\t128\t122\t122\n127\t128\t123\t123\n127\t128\t124\t124\n127\t128\t125\t125\n127\t128\t126\t126\n127\t128\t127\t127\n127\t128\t128\t128\n127\t128\t129\t129\n127\t128\t130\t130\n127\t128\t131\t131\n127\t128\t132\t132\n127\t128\t133\t133\n127\t128\t134\t134\n127\t128\t135\t135\n127\t128\t136\t136\n127\t128\t137\t137\n127\t128\t138\t138\n127\t128\t139\t139\n127\t128\t140\t140\n127\t128\t141\t141\n127\t128\t142\t142\n127\t128\t143\t143\n127\t128\t144\t144\n127\t128\t145\t145\n127\t128\t146\t146\n127\t128\t147\t147\n127\t128\t148\t148\n127\t128\t149\t149\n127\t128\t150\t150\n127\t128\t151\t151\n127\t128\t152\t152\n127\t128\t153\t153\n127\t128\t154\t154\n127\t128\t155\t155\n127\t128\t156\t156\n127\t128\t157\t157\n127\t128\t158\t158\n127\t128\t159\t159\n127\t128\t160\t160\n127\t128\t161\t161\n127\t128\t162\t162\n127\t128\t163\t163\n127\t128\t164\t164\n127\t128\t165\t165\n127\t128\t166\t166\n127\t128\t167\t167\n127\t128\t168\t168\n127\t128\t169\t169\n127\t128\t170\t170\n127\t128\t171\t171\n127\t128\t172\t172\n127\t128\t173\t173\n127\t128\t174\t174\n127\t128\t175\t175\n127\t128\t176\t176\n127\t128\t177\t177\n127\t128\t178\t178\n127\t128\t179\t179\n127\t128\t180\t180\n127\t128\t181\t181\n127\t128\t182\t182\n127\t128\t183\t183\n127\t128\t184\t184\n127\t128\t185\t185\n127\t128\t186\t186\n127\t128\t187\t187\n127\t128\t188\t188\n127\t128\t189\t189\n127\t128\t190\t190\n127\t128\t191\t191\n127\t128\t192\t192\n127\t128\t193\t193\n127\t128\t194\t194\n127\t128\t195\t195\n127\t128\t196\t196\n127\t128\t197\t197\n127\t128\t198\t198\n127\t128\t199\t199\n127\t128\t200\t200\n127\t128\t201\t201\n127\t128\t202\t202\n127\t128\t203\t203\n127\t128\t204\t204\n127\t128\t205\t205\n127\t128\t206\t206\n127\t128\t207\t207\n127\t128\t208\t208\n127\t128\t209\t209\n127\t128\t210\t210\n127\t128\t211\t211\n127\t128\t212\t212\n127\t128\t213\t213\n127\t128\t214\t214\n127\t128\t215\t215\n127\t128\t216\t216\n127\t128\t217\t217\n127\t128\t218\t218\n127\t128\t219\t219\n127\t128\t220\t220\n127\t128\t221\t221\n127\t128\t222\t222\n127\t128\t223\t223\n127\t128\t224\t224\n127\t128\t225\t225\n127\t128\t226\t226\n127\t128\t227\t227\n127\t128\t228\t228\n127\t128\t229\t229\n127\t128\t230\t230\n127\t128\t231\t231\n127\t128\t232\t232\n127\t128\t233\t233\n127\t128\t234\t234\n127\t128\t235\t235\n127\t128\t236\t236\n127\t128\t237\t237\n127\t128\t238\t238\n127\t128\t239\t239\n127\t128\t240\t240\n127\t128\t241\t241\n127\t128\t242\t242\n127\t128\t243\t243\n127\t128\t244\t244\n127\t128\t245\t245\n127\t128\t246\t246\n127\t128\t247\t247\n127\t128\t248\t248\n127\t128\t249\t249\n127\t128\t250\t250\n127\t128\t251\t251\n127\t128\t252\t252\n127\t128\t253\t253\n127\t128\t254\t254\n127\t128\t255\t255\n128\t129\t0\t0\n128\t129\t1\t1\n128\t129\t2\t2\n128\t129\t3\t3\n128\t129\t4\t4\n128\t129\t5\t5\n128\t129\t6\t6\n128\t129\t7\t7\n128\t129\t8\t8\n128\t129\t9\t9\n128\t129\t10\t10\n128\t129\t11\t11\n128\t129\t12\t12\n128\t129\t13\t13\n128\t129\t14\t14\n128\t129\t15\t15\n128\t129\t16\t16\n128\t129\t17\t17\n128\t129\t18\t18\n128\t129\t19\t19\n128\t129\t20\t20\n128\t129\t21\t21\n128\t129\t22\t22\n128\t129\t23\t23\n128\t129\t24\t24\n128\t129\t25\t25\n128\t129\t26\t26\n128\t129\t27\t27\n128\t129\t28\t28\n128\t129\t29\t29\n128\t129\t30\t30\n128\t129\t31\t31\n128\t129\t32\t32\n128\t129\t33\t33\n128\t129\t34\t34\n128\t129\t35\t35\n128\t129\t36\t36\n128\t129\t37\t37\n128\t129\t38\t38\n128\t129\t39\t39\n128\t129\t40\t40\n128\t129\t41\t41\n128\t129\t42\t42\n128\t129\t43\t43\n128\t129\t44\t44\n128\t129\t45\t45\n128\t129\t46\t46\n128\t129\t47\t47\n128\t129\t48\t48\n128\t129\t49\t49\n128\t129\t50\t50\n128\t129\t51\t51\n128\t129\t52\t52\n128\t129\t53\t53\n128\t129\t54\t54\n128\t129\t55\t55\n128\t129\t56\t56\n128\t129\t57\t57\n128\t129\t58\t58\n128\t129\t59\t59\n128\t129\t60\t60\n128\t129\t61\t61\n128\t129\t62\t62\n128\t129\t63\t63\n128\t129\t64\t64\n128\t129\t65\t65\n128\t129\t66\t66\n128\t129\t67\t67\n128\t129\t68\t68\n128\t129\t69\t69\n128\t129\t70\t70\n128\t129\t71\t71\n128\t129\t72\t72\n128\t129\t73\t73\n128\t129\t74\t74\n128\t129\t75\t75\n128\t129\t76\t76\n128\t129\t77\t77\n128\t129\t78\t78\n128\t129\t79\t79\n128\t129\t80\t80\n128\t129\t81\t81\n128\t129\t82\t82\n128\t129\t83\t83\n128\t129\t84\t84\n128\t129\t85\t85\n128\t129\t86\t86\n128\t129\t87\t87\n128\t129\t88\t88\n128\t129\t89\t89\n128\t129\t90\t90\n128\t129\t91\t91\n128\t129\t92\t92\n128\t129\t93\t93\n128\t129\t94\t94\n128\t129\t95\t95\n128\t129\t96\t96\n128\t129\t97\t97\n128\t129\t98\t98\n128\t129\t99\t99\n128\t129\t100\t100\n128\t129\t101\t101\n128\t129\t102\t102\n128\t129\t103\t103\n128\t129\t104\t104\n128\t129\t105\t105\n128\t129\t106\t106\n128\t129\t107\t107\n128\t129\t108\t108\n128\t129\t109\t109\n128\t129\t110\t110\n128\t129\t111\t111\n128\t129\t112\t112\n128\t129\t113\t113\n128\t129\t114\t114\n128\t129\t115\t115\n128\t129\t116\t116\n128\t129\t117\t117\n128\t129\t118\t118\n128\t129\t119\t119\n128\t129\t120\t120\n128\t129\t121\t121\n128\t129\t122\t122\n128\t129\t123\t123\n128\t129\t124\t124\n128\t129\t125\t125\n128\t129\t126\t126\n128\t129\t127\t127\n128\t129\t128\t128\n128\t129\t129\t129\n128\t129\t130\t130\n128\t129\t131\t131\n128\t129\t132\t132\n128\t129\t133\t133\n128\t129\t134\t134\n128\t129\t135\t135\n128\t129\t136\t136\n128\t129\t137\t137\n128\t129\t138\t138\n128\t129\t139\t139\n128\t129\t140\t140\n128\t129\t141\t141\n128\t129\t142\t142\n128\t129\t143\t143\n128\t129\t144\t144\n128\t129\t145\t145\n128\t129\t146\t146\n128\t129\t147\t147\n128\t129\t148\t148\n128\t129\t149\t149\n128\t129\t150\t150\n128\t129\t151\t151\n128\t129\t152\t152\n128\t129\t153\t153\n128\t129\t154\t154\n128\t129\t155\t155\n128\t129\t156\t156\n128\t129\t157\t157\n128\t129\t158\t158\n128\t129\t159\t159\n128\t129\t160\t160\n128\t129\t161\t161\n128\t129\t162\t162\n128\t129\t163\t163\n128\t129\t164\t164\n128\t129\t165\t165\n128\t129\t166\t166\n128\t129\t167\t167\n128\t129\t168\t168\n128\t129\t169\t169\n128\t129\t170\t170\n128\t129\t171\t171\n128\t129\t172\t172\n128\t129\t173\t173\n128\t129\t174\t174\n128\t129\t175\t175\n128\t129\t176\t176\n128\t129\t177\t177\n128\t129\t178\t178\n128\t129\t179\t179\n128\t129\t180\t180\n128\t129\t181\t181\n128\t129\t182\t182\n128\t129\t183\t183\n128\t129\t184\t184\n128\t129\t185\t185\n128\t129\t186\t186\n128\t129\t187\t187\n128\t129\t188\t188\n128\t129\t189\t189\n128\t129\t190\t190\n128\t129\t191\t191\n128\t129\t192\t192\n128\t129\t193\t193\n128\t129\t194\t194\n128\t129\t195\t195\n128\t129\t196\t196\n128\t129\t197\t197\n128\t129\t198\t198\n128\t129\t199\t199\n128\t129\t200\t200\n128\t129\t201\t201\n128\t129\t202\t202\n128\t129\t203\t203\n128\t129\t204\t204\n128\t129\t205\t205\n128\t129\t206\t206\n128\t129\t207\t207\n128\t129\t208\t208\n128\t129\t209\t209\n128\t129\t210\t210\n128\t129\t211\t211\n128\t129\t212\t212\n128\t129\t213\t213\n128\t129\t214\t214\n128\t129\t215\t215\n128\t129\t216\t216\n128\t129\t217\t217\n128\t129\t218\t218\n128\t129\t219\t219\n128\t129\t220\t220\n128\t129\t221\t221\n128\t129\t222\t222\n128\t129\t223\t223\n128\t129\t224\t224\n128\t129\t225\t225\n128\t129\t226\t226\n128\t129\t227\t227\n128\t129\t228\t228\n128\t129\t229\t229\n128\t129\t230\t230\n128\t129\t231\t231\n128\t129\t232\t232\n128\t129\t233\t233\n128\t129\t234\t234\n128\t129\t235\t235\n128\t129\t236\t236\n128\t129\t237\t237\n128\t129\t238\t238\n128\t129\t239\t239\n128\t129\t240\t240\n128\t129\t241\t241\n128\t129\t242\t242\n128\t129\t243\t243\n128\t129\t244\t244\n128\t129\t245\t245\n128\t129\t246\t246\n128\t129\t247\t247\n128\t129\t248\t248\n128\t129\t249\t249\n128\t129\t250\t250\n128\t129\t251\t251\n128\t129\t252\t252\n128\t129\t253\t253\n128\t129\t254\t254\n128\t129\t255\t255\n129\n",
+	"^\x17\x03\x03\x01¾.*$":                               "0\t1\t23\t23\n1\t2\t3\t3\n2\t3\t3\t3\n3\t4\t1\t1\n4\t5\t194\t194\n5\t6\t190\t190\n6\t6\t0\t0\n6\t6\t1\t1\n6\t6\t2\t2\n6\t6\t3\t3\n6\t6\t4\t4\n6\t6\t5\t5\n6\t6\t6\t6\n6\t6\t7\t7\n6\t6\t8\t8\n6\t6\t9\t9\n6\t6\t10\t10\n6\t6\t11\t11\n6\t6\t12\t12\n6\t6\t13\t13\n6\t6\t14\t14\n6\t6\t15\t15\n6\t6\t16\t16\n6\t6\t17\t17\n6\t6\t18\t18\n6\t6\t19\t19\n6\t6\t20\t20\n6\t6\t21\t21\n6\t6\t22\t22\n6\t6\t23\t23\n6\t6\t24\t24\n6\t6\t25\t25\n6\t6\t26\t26\n6\t6\t27\t27\n6\t6\t28\t28\n6\t6\t29\t29\n6\t6\t30\t30\n6\t6\t31\t31\n6\t6\t32\t32\n6\t6\t33\t33\n6\t6\t34\t34\n6\t6\t35\t35\n6\t6\t36\t36\n6\t6\t37\t37\n6\t6\t38\t38\n6\t6\t39\t39\n6\t6\t40\t40\n6\t6\t41\t41\n6\t6\t42\t42\n6\t6\t43\t43\n6\t6\t44\t44\n6\t6\t45\t45\n6\t6\t46\t46\n6\t6\t47\t47\n6\t6\t48\t48\n6\t6\t49\t49\n6\t6\t50\t50\n6\t6\t51\t51\n6\t6\t52\t52\n6\t6\t53\t53\n6\t6\t54\t54\n6\t6\t55\t55\n6\t6\t56\t56\n6\t6\t57\t57\n6\t6\t58\t58\n6\t6\t59\t59\n6\t6\t60\t60\n6\t6\t61\t61\n6\t6\t62\t62\n6\t6\t63\t63\n6\t6\t64\t64\n6\t6\t65\t65\n6\t6\t66\t66\n6\t6\t67\t67\n6\t6\t68\t68\n6\t6\t69\t69\n6\t6\t70\t70\n6\t6\t71\t71\n6\t6\t72\t72\n6\t6\t73\t73\n6\t6\t74\t74\n6\t6\t75\t75\n6\t6\t76\t76\n6\t6\t77\t77\n6\t6\t78\t78\n6\t6\t79\t79\n6\t6\t80\t80\n6\t6\t81\t81\n6\t6\t82\t82\n6\t6\t83\t83\n6\t6\t84\t84\n6\t6\t85\t85\n6\t6\t86\t86\n6\t6\t87\t87\n6\t6\t88\t88\n6\t6\t89\t89\n6\t6\t90\t90\n6\t6\t91\t91\n6\t6\t92\t92\n6\t6\t93\t93\n6\t6\t94\t94\n6\t6\t95\t95\n6\t6\t96\t96\n6\t6\t97\t97\n6\t6\t98\t98\n6\t6\t99\t99\n6\t6\t100\t100\n6\t6\t101\t101\n6\t6\t102\t102\n6\t6\t103\t103\n6\t6\t104\t104\n6\t6\t105\t105\n6\t6\t106\t106\n6\t6\t107\t107\n6\t6\t108\t108\n6\t6\t109\t109\n6\t6\t110\t110\n6\t6\t111\t111\n6\t6\t112\t112\n6\t6\t113\t113\n6\t6\t114\t114\n6\t6\t115\t115\n6\t6\t116\t116\n6\t6\t117\t117\n6\t6\t118\t118\n6\t6\t119\t119\n6\t6\t120\t120\n6\t6\t121\t121\n6\t6\t122\t122\n6\t6\t123\t123\n6\t6\t124\t124\n6\t6\t125\t125\n6\t6\t126\t126\n6\t6\t127\t127\n6\t6\t128\t128\n6\t6\t129\t129\n6\t6\t130\t130\n6\t6\t131\t131\n6\t6\t132\t132\n6\t6\t133\t133\n6\t6\t134\t134\n6\t6\t135\t135\n6\t6\t136\t136\n6\t6\t137\t137\n6\t6\t138\t138\n6\t6\t139\t139\n6\t6\t140\t140\n6\t6\t141\t141\n6\t6\t142\t142\n6\t6\t143\t143\n6\t6\t144\t144\n6\t6\t145\t145\n6\t6\t146\t146\n6\t6\t147\t147\n6\t6\t148\t148\n6\t6\t149\t149\n6\t6\t150\t150\n6\t6\t151\t151\n6\t6\t152\t152\n6\t6\t153\t153\n6\t6\t154\t154\n6\t6\t155\t155\n6\t6\t156\t156\n6\t6\t157\t157\n6\t6\t158\t158\n6\t6\t159\t159\n6\t6\t160\t160\n6\t6\t161\t161\n6\t6\t162\t162\n6\t6\t163\t163\n6\t6\t164\t164\n6\t6\t165\t165\n6\t6\t166\t166\n6\t6\t167\t167\n6\t6\t168\t168\n6\t6\t169\t169\n6\t6\t170\t170\n6\t6\t171\t171\n6\t6\t172\t172\n6\t6\t173\t173\n6\t6\t174\t174\n6\t6\t175\t175\n6\t6\t176\t176\n6\t6\t177\t177\n6\t6\t178\t178\n6\t6\t179\t179\n6\t6\t180\t180\n6\t6\t181\t181\n6\t6\t182\t182\n6\t6\t183\t183\n6\t6\t184\t184\n6\t6\t185\t185\n6\t6\t186\t186\n6\t6\t187\t187\n6\t6\t188\t188\n6\t6\t189\t189\n6\t6\t190\t190\n6\t6\t191\t191\n6\t6\t192\t192\n6\t6\t193\t193\n6\t6\t194\t194\n6\t6\t195\t195\n6\t6\t196\t196\n6\t6\t197\t197\n6\t6\t198\t198\n6\t6\t199\t199\n6\t6\t200\t200\n6\t6\t201\t201\n6\t6\t202\t202\n6\t6\t203\t203\n6\t6\t204\t204\n6\t6\t205\t205\n6\t6\t206\t206\n6\t6\t207\t207\n6\t6\t208\t208\n6\t6\t209\t209\n6\t6\t210\t210\n6\t6\t211\t211\n6\t6\t212\t212\n6\t6\t213\t213\n6\t6\t214\t214\n6\t6\t215\t215\n6\t6\t216\t216\n6\t6\t217\t217\n6\t6\t218\t218\n6\t6\t219\t219\n6\t6\t220\t220\n6\t6\t221\t221\n6\t6\t222\t222\n6\t6\t223\t223\n6\t6\t224\t224\n6\t6\t225\t225\n6\t6\t226\t226\n6\t6\t227\t227\n6\t6\t228\t228\n6\t6\t229\t229\n6\t6\t230\t230\n6\t6\t231\t231\n6\t6\t232\t232\n6\t6\t233\t233\n6\t6\t234\t234\n6\t6\t235\t235\n6\t6\t236\t236\n6\t6\t237\t237\n6\t6\t238\t238\n6\t6\t239\t239\n6\t6\t240\t240\n6\t6\t241\t241\n6\t6\t242\t242\n6\t6\t243\t243\n6\t6\t244\t244\n6\t6\t245\t245\n6\t6\t246\t246\n6\t6\t247\t247\n6\t6\t248\t248\n6\t6\t249\t249\n6\t6\t250\t250\n6\t6\t251\t251\n6\t6\t252\t252\n6\t6\t253\t253\n6\t6\t254\t254\n6\t6\t255\t255\n6\n",
+	"^.*$":                                                "0\t0\t0\t0\n0\t0\t1\t1\n0\t0\t2\t2\n0\t0\t3\t3\n0\t0\t4\t4\n0\t0\t5\t5\n0\t0\t6\t6\n0\t0\t7\t7\n0\t0\t8\t8\n0\t0\t9\t9\n0\t0\t10\t10\n0\t0\t11\t11\n0\t0\t12\t12\n0\t0\t13\t13\n0\t0\t14\t14\n0\t0\t15\t15\n0\t0\t16\t16\n0\t0\t17\t17\n0\t0\t18\t18\n0\t0\t19\t19\n0\t0\t20\t20\n0\t0\t21\t21\n0\t0\t22\t22\n0\t0\t23\t23\n0\t0\t24\t24\n0\t0\t25\t25\n0\t0\t26\t26\n0\t0\t27\t27\n0\t0\t28\t28\n0\t0\t29\t29\n0\t0\t30\t30\n0\t0\t31\t31\n0\t0\t32\t32\n0\t0\t33\t33\n0\t0\t34\t34\n0\t0\t35\t35\n0\t0\t36\t36\n0\t0\t37\t37\n0\t0\t38\t38\n0\t0\t39\t39\n0\t0\t40\t40\n0\t0\t41\t41\n0\t0\t42\t42\n0\t0\t43\t43\n0\t0\t44\t44\n0\t0\t45\t45\n0\t0\t46\t46\n0\t0\t47\t47\n0\t0\t48\t48\n0\t0\t49\t49\n0\t0\t50\t50\n0\t0\t51\t51\n0\t0\t52\t52\n0\t0\t53\t53\n0\t0\t54\t54\n0\t0\t55\t55\n0\t0\t56\t56\n0\t0\t57\t57\n0\t0\t58\t58\n0\t0\t59\t59\n0\t0\t60\t60\n0\t0\t61\t61\n0\t0\t62\t62\n0\t0\t63\t63\n0\t0\t64\t64\n0\t0\t65\t65\n0\t0\t66\t66\n0\t0\t67\t67\n0\t0\t68\t68\n0\t0\t69\t69\n0\t0\t70\t70\n0\t0\t71\t71\n0\t0\t72\t72\n0\t0\t73\t73\n0\t0\t74\t74\n0\t0\t75\t75\n0\t0\t76\t76\n0\t0\t77\t77\n0\t0\t78\t78\n0\t0\t79\t79\n0\t0\t80\t80\n0\t0\t81\t81\n0\t0\t82\t82\n0\t0\t83\t83\n0\t0\t84\t84\n0\t0\t85\t85\n0\t0\t86\t86\n0\t0\t87\t87\n0\t0\t88\t88\n0\t0\t89\t89\n0\t0\t90\t90\n0\t0\t91\t91\n0\t0\t92\t92\n0\t0\t93\t93\n0\t0\t94\t94\n0\t0\t95\t95\n0\t0\t96\t96\n0\t0\t97\t97\n0\t0\t98\t98\n0\t0\t99\t99\n0\t0\t100\t100\n0\t0\t101\t101\n0\t0\t102\t102\n0\t0\t103\t103\n0\t0\t104\t104\n0\t0\t105\t105\n0\t0\t106\t106\n0\t0\t107\t107\n0\t0\t108\t108\n0\t0\t109\t109\n0\t0\t110\t110\n0\t0\t111\t111\n0\t0\t112\t112\n0\t0\t113\t113\n0\t0\t114\t114\n0\t0\t115\t115\n0\t0\t116\t116\n0\t0\t117\t117\n0\t0\t118\t118\n0\t0\t119\t119\n0\t0\t120\t120\n0\t0\t121\t121\n0\t0\t122\t122\n0\t0\t123\t123\n0\t0\t124\t124\n0\t0\t125\t125\n0\t0\t126\t126\n0\t0\t127\t127\n0\t0\t128\t128\n0\t0\t129\t129\n0\t0\t130\t130\n0\t0\t131\t131\n0\t0\t132\t132\n0\t0\t133\t133\n0\t0\t134\t134\n0\t0\t135\t135\n0\t0\t136\t136\n0\t0\t137\t137\n0\t0\t138\t138\n0\t0\t139\t139\n0\t0\t140\t140\n0\t0\t141\t141\n0\t0\t142\t142\n0\t0\t143\t143\n0\t0\t144\t144\n0\t0\t145\t145\n0\t0\t146\t146\n0\t0\t147\t147\n0\t0\t148\t148\n0\t0\t149\t149\n0\t0\t150\t150\n0\t0\t151\t151\n0\t0\t152\t152\n0\t0\t153\t153\n0\t0\t154\t154\n0\t0\t155\t155\n0\t0\t156\t156\n0\t0\t157\t157\n0\t0\t158\t158\n0\t0\t159\t159\n0\t0\t160\t160\n0\t0\t161\t161\n0\t0\t162\t162\n0\t0\t163\t163\n0\t0\t164\t164\n0\t0\t165\t165\n0\t0\t166\t166\n0\t0\t167\t167\n0\t0\t168\t168\n0\t0\t169\t169\n0\t0\t170\t170\n0\t0\t171\t171\n0\t0\t172\t172\n0\t0\t173\t173\n0\t0\t174\t174\n0\t0\t175\t175\n0\t0\t176\t176\n0\t0\t177\t177\n0\t0\t178\t178\n0\t0\t179\t179\n0\t0\t180\t180\n0\t0\t181\t181\n0\t0\t182\t182\n0\t0\t183\t183\n0\t0\t184\t184\n0\t0\t185\t185\n0\t0\t186\t186\n0\t0\t187\t187\n0\t0\t188\t188\n0\t0\t189\t189\n0\t0\t190\t190\n0\t0\t191\t191\n0\t0\t192\t192\n0\t0\t193\t193\n0\t0\t194\t194\n0\t0\t195\t195\n0\t0\t196\t196\n0\t0\t197\t197\n0\t0\t198\t198\n0\t0\t199\t199\n0\t0\t200\t200\n0\t0\t201\t201\n0\t0\t202\t202\n0\t0\t203\t203\n0\t0\t204\t204\n0\t0\t205\t205\n0\t0\t206\t206\n0\t0\t207\t207\n0\t0\t208\t208\n0\t0\t209\t209\n0\t0\t210\t210\n0\t0\t211\t211\n0\t0\t212\t212\n0\t0\t213\t213\n0\t0\t214\t214\n0\t0\t215\t215\n0\t0\t216\t216\n0\t0\t217\t217\n0\t0\t218\t218\n0\t0\t219\t219\n0\t0\t220\t220\n0\t0\t221\t221\n0\t0\t222\t222\n0\t0\t223\t223\n0\t0\t224\t224\n0\t0\t225\t225\n0\t0\t226\t226\n0\t0\t227\t227\n0\t0\t228\t228\n0\t0\t229\t229\n0\t0\t230\t230\n0\t0\t231\t231\n0\t0\t232\t232\n0\t0\t233\t233\n0\t0\t234\t234\n0\t0\t235\t235\n0\t0\t236\t236\n0\t0\t237\t237\n0\t0\t238\t238\n0\t0\t239\t239\n0\t0\t240\t240\n0\t0\t241\t241\n0\t0\t242\t242\n0\t0\t243\t243\n0\t0\t244\t244\n0\t0\t245\t245\n0\t0\t246\t246\n0\t0\t247\t247\n0\t0\t248\t248\n0\t0\t249\t249\n0\t0\t250\t250\n0\t0\t251\t251\n0\t0\t252\t252\n0\t0\t253\t253\n0\t0\t254\t254\n0\t0\t255\t255\n0\n",
+	"^GET\\ \\/([a-zA-Z0-9\\.\\/]*) HTTP/1\\.0\r\n\r\n$":                     "0\t1\t71\t71\n1\t2\t69\t69\n2\t3\t84\t84\n3\t4\t32\t32\n4\t5\t47\t47\n5\t6\t32\t32\n5\t5\t46\t46\n5\t5\t47\t47\n5\t5\t48\t48\n5\t5\t49\t49\n5\t5\t50\t50\n5\t5\t51\t51\n5\t5\t52\t52\n5\t5\t53\t53\n5\t5\t54\t54\n5\t5\t55\t55\n5\t5\t56\t56\n5\t5\t57\t57\n5\t5\t65\t65\n5\t5\t66\t66\n5\t5\t67\t67\n5\t5\t68\t68\n5\t5\t69\t69\n5\t5\t70\t70\n5\t5\t71\t71\n5\t5\t72\t72\n5\t5\t73\t73\n5\t5\t74\t74\n5\t5\t75\t75\n5\t5\t76\t76\n5\t5\t77\t77\n5\t5\t78\t78\n5\t5\t79\t79\n5\t5\t80\t80\n5\t5\t81\t81\n5\t5\t82\t82\n5\t5\t83\t83\n5\t5\t84\t84\n5\t5\t85\t85\n5\t5\t86\t86\n5\t5\t87\t87\n5\t5\t88\t88\n5\t5\t89\t89\n5\t5\t90\t90\n5\t5\t97\t97\n5\t5\t98\t98\n5\t5\t99\t99\n5\t5\t100\t100\n5\t5\t101\t101\n5\t5\t102\t102\n5\t5\t103\t103\n5\t5\t104\t104\n5\t5\t105\t105\n5\t5\t106\t106\n5\t5\t107\t107\n5\t5\t108\t108\n5\t5\t109\t109\n5\t5\t110\t110\n5\t5\t111\t111\n5\t5\t112\t112\n5\t5\t113\t113\n5\t5\t114\t114\n5\t5\t115\t115\n5\t5\t116\t116\n5\t5\t117\t117\n5\t5\t118\t118\n5\t5\t119\t119\n5\t5\t120\t120\n5\t5\t121\t121\n5\t5\t122\t122\n6\t7\t72\t72\n7\t8\t84\t84\n8\t9\t84\t84\n9\t10\t80\t80\n10\t11\t47\t47\n11\t12\t49\t49\n12\t13\t46\t46\n13\t14\t48\t48\n14\t15\t13\t13\n15\t16\t10\t10\n16\t17\t13\t13\n17\t18\t10\t10\n18\n",
+	"^GET\\ \\/([a-zA-Z0-9\\.\\/]*) HTTP/1\\.1\r\n\r\n$":                     "0\t1\t71\t71\n1\t2\t69\t69\n2\t3\t84\t84\n3\t4\t32\t32\n4\t5\t47\t47\n5\t6\t32\t32\n5\t5\t46\t46\n5\t5\t47\t47\n5\t5\t48\t48\n5\t5\t49\t49\n5\t5\t50\t50\n5\t5\t51\t51\n5\t5\t52\t52\n5\t5\t53\t53\n5\t5\t54\t54\n5\t5\t55\t55\n5\t5\t56\t56\n5\t5\t57\t57\n5\t5\t65\t65\n5\t5\t66\t66\n5\t5\t67\t67\n5\t5\t68\t68\n5\t5\t69\t69\n5\t5\t70\t70\n5\t5\t71\t71\n5\t5\t72\t72\n5\t5\t73\t73\n5\t5\t74\t74\n5\t5\t75\t75\n5\t5\t76\t76\n5\t5\t77\t77\n5\t5\t78\t78\n5\t5\t79\t79\n5\t5\t80\t80\n5\t5\t81\t81\n5\t5\t82\t82\n5\t5\t83\t83\n5\t5\t84\t84\n5\t5\t85\t85\n5\t5\t86\t86\n5\t5\t87\t87\n5\t5\t88\t88\n5\t5\t89\t89\n5\t5\t90\t90\n5\t5\t97\t97\n5\t5\t98\t98\n5\t5\t99\t99\n5\t5\t100\t100\n5\t5\t101\t101\n5\t5\t102\t102\n5\t5\t103\t103\n5\t5\t104\t104\n5\t5\t105\t105\n5\t5\t106\t106\n5\t5\t107\t107\n5\t5\t108\t108\n5\t5\t109\t109\n5\t5\t110\t110\n5\t5\t111\t111\n5\t5\t112\t112\n5\t5\t113\t113\n5\t5\t114\t114\n5\t5\t115\t115\n5\t5\t116\t116\n5\t5\t117\t117\n5\t5\t118\t118\n5\t5\t119\t119\n5\t5\t120\t120\n5\t5\t121\t121\n5\t5\t122\t122\n6\t7\t72\t72\n7\t8\t84\t84\n8\t9\t84\t84\n9\t10\t80\t80\n10\t11\t47\t47\n11\t12\t49\t49\n12\t13\t46\t46\n13\t14\t49\t49\n14\t15\t13\t13\n15\t16\t10\t10\n16\t17\t13\t13\n17\t18\t10\t10\n18\n",
+	"^HTTP/1\\.0\\ 200 OK\r\nContent-Type:\\ ([a-zA-Z0-9]+)\r\n\r\n\\C*$":    "0\t1\t72\t72\n1\t2\t84\t84\n2\t3\t84\t84\n3\t4\t80\t80\n4\t5\t47\t47\n5\t6\t49\t49\n6\t7\t46\t46\n7\t8\t48\t48\n8\t9\t32\t32\n9\t10\t50\t50\n10\t11\t48\t48\n11\t12\t48\t48\n12\t13\t32\t32\n13\t14\t79\t79\n14\t15\t75\t75\n15\t16\t13\t13\n16\t17\t10\t10\n17\t18\t67\t67\n18\t19\t111\t111\n19\t20\t110\t110\n20\t21\t116\t116\n21\t22\t101\t101\n22\t23\t110\t110\n23\t24\t116\t116\n24\t25\t45\t45\n25\t26\t84\t84\n26\t27\t121\t121\n27\t28\t112\t112\n28\t29\t101\t101\n29\t30\t58\t58\n30\t31\t32\t32\n31\t32\t48\t48\n31\t32\t49\t49\n31\t32\t50\t50\n31\t32\t51\t51\n31\t32\t52\t52\n31\t32\t53\t53\n31\t32\t54\t54\n31\t32\t55\t55\n31\t32\t56\t56\n31\t32\t57\t57\n31\t32\t65\t65\n31\t32\t66\t66\n31\t32\t67\t67\n31\t32\t68\t68\n31\t32\t69\t69\n31\t32\t70\t70\n31\t32\t71\t71\n31\t32\t72\t72\n31\t32\t73\t73\n31\t32\t74\t74\n31\t32\t75\t75\n31\t32\t76\t76\n31\t32\t77\t77\n31\t32\t78\t78\n31\t32\t79\t79\n31\t32\t80\t80\n31\t32\t81\t81\n31\t32\t82\t82\n31\t32\t83\t83\n31\t32\t84\t84\n31\t32\t85\t85\n31\t32\t86\t86\n31\t32\t87\t87\n31\t32\t88\t88\n31\t32\t89\t89\n31\t32\t90\t90\n31\t32\t97\t97\n31\t32\t98\t98\n31\t32\t99\t99\n31\t32\t100\t100\n31\t32\t101\t101\n31\t32\t102\t102\n31\t32\t103\t103\n31\t32\t104\t104\n31\t32\t105\t105\n31\t32\t106\t106\n31\t32\t107\t107\n31\t32\t108\t108\n31\t32\t109\t109\n31\t32\t110\t110\n31\t32\t111\t111\n31\t32\t112\t112\n31\t32\t113\t113\n31\t32\t114\t114\n31\t32\t115\t115\n31\t32\t116\t116\n31\t32\t117\t117\n31\t32\t118\t118\n31\t32\t119\t119\n31\t32\t120\t120\n31\t32\t121\t121\n31\t32\t122\t122\n32\t33\t13\t13\n32\t32\t48\t48\n32\t32\t49\t49\n32\t32\t50\t50\n32\t32\t51\t51\n32\t32\t52\t52\n32\t32\t53\t53\n32\t32\t54\t54\n32\t32\t55\t55\n32\t32\t56\t56\n32\t32\t57\t57\n32\t32\t65\t65\n32\t32\t66\t66\n32\t32\t67\t67\n32\t32\t68\t68\n32\t32\t69\t69\n32\t32\t70\t70\n32\t32\t71\t71\n32\t32\t72\t72\n32\t32\t73\t73\n32\t32\t74\t74\n32\t32\t75\t75\n32\t32\t76\t76\n32\t32\t77\t77\n32\t32\t78\t78\n32\t32\t79\t79\n32\t32\t80\t80\n32\t32\t81\t81\n32\t32\t82\t82\n32\t32\t83\t83\n32\t32\t84\t84\n32\t32\t85\t85\n32\t32\t86\t86\n32\t32\t87\t87\n32\t32\t88\t88\n32\t32\t89\t89\n32\t32\t90\t90\n32\t32\t97\t97\n32\t32\t98\t98\n32\t32\t99\t99\n32\t32\t100\t100\n32\t32\t101\t101\n32\t32\t102\t102\n32\t32\t103\t103\n32\t32\t104\t104\n32\t32\t105\t105\n32\t32\t106\t106\n32\t32\t107\t107\n32\t32\t108\t108\n32\t32\t109\t109\n32\t32\t110\t110\n32\t32\t111\t111\n32\t32\t112\t112\n32\t32\t113\t113\n32\t32\t114\t114\n32\t32\t115\t115\n32\t32\t116\t116\n32\t32\t117\t117\n32\t32\t118\t118\n32\t32\t119\t119\n32\t32\t120\t120\n32\t32\t121\t121\n32\t32\t122\t122\n33\t34\t10\t10\n34\t35\t13\t13\n35\t36\t10\t10\n36\t36\t0\t0\n36\t36\t1\t1\n36\t36\t2\t2\n36\t36\t3\t3\n36\t36\t4\t4\n36\t36\t5\t5\n36\t36\t6\t6\n36\t36\t7\t7\n36\t36\t8\t8\n36\t36\t9\t9\n36\t36\t10\t10\n36\t36\t11\t11\n36\t36\t12\t12\n36\t36\t13\t13\n36\t36\t14\t14\n36\t36\t15\t15\n36\t36\t16\t16\n36\t36\t17\t17\n36\t36\t18\t18\n36\t36\t19\t19\n36\t36\t20\t20\n36\t36\t21\t21\n36\t36\t22\t22\n36\t36\t23\t23\n36\t36\t24\t24\n36\t36\t25\t25\n36\t36\t26\t26\n36\t36\t27\t27\n36\t36\t28\t28\n36\t36\t29\t29\n36\t36\t30\t30\n36\t36\t31\t31\n36\t36\t32\t32\n36\t36\t33\t33\n36\t36\t34\t34\n36\t36\t35\t35\n36\t36\t36\t36\n36\t36\t37\t37\n36\t36\t38\t38\n36\t36\t39\t39\n36\t36\t40\t40\n36\t36\t41\t41\n36\t36\t42\t42\n36\t36\t43\t43\n36\t36\t44\t44\n36\t36\t45\t45\n36\t36\t46\t46\n36\t36\t47\t47\n36\t36\t48\t48\n36\t36\t49\t49\n36\t36\t50\t50\n36\t36\t51\t51\n36\t36\t52\t52\n36\t36\t53\t53\n36\t36\t54\t54\n36\t36\t55\t55\n36\t36\t56\t56\n36\t36\t57\t57\n36\t36\t58\t58\n36\t36\t59\t59\n36\t36\t60\t60\n36\t36\t61\t61\n36\t36\t62\t62\n36\t36\t63\t63\n36\t36\t64\t64\n36\t36\t65\t65\n36\t36\t66\t66\n36\t36\t67\t67\n36\t36\t68\t68\n36\t36\t69\t69\n36\t36\t70\t70\n36\t36\t71\t71\n36\t36\t72\t72\n36\t36\t73\t73\n36\t36\t74\t74\n36\t36\t75\t75\n36\t36\t76\t76\n36\t36\t77\t77\n36\t36\t78\t78\n36\t36\t79\t79\n36\t36\t80\t80\n36\t36\t81\t81\n36\t36\t82\t82\n36\t36\t83\t83\n36\t36\t84\t84\n36\t36\t85\t85\n36\t36\t86\t86\n36\t36\t87\t87\n36\t36\t88\t88\n36\t36\t89\t89\n36\t36\t90\t90\n36\t36\t91\t91\n36\t36\t92\t92\n36\t36\t93\t93\n36\t36\t94\t94\n36\t36\t95\t95\n36\t36\t96\t96\n36\t36\t97\t97\n36\t36\t98\t98\n36\t36\t99\t99\n36\t36\t100\t100\n36\t36\t101\t101\n36\t36\t102\t102\n36\t36\t103\t103\n36\t36\t104\t104\n36\t36\t105\t105\n36\t36\t106\t106\n36\t36\t107\t107\n36\t36\t108\t108\n36\t36\t109\t109\n36\t36\t110\t110\n36\t36\t111\t111\n36\t36\t112\t112\n36\t36\t113\t113\n36\t36\t114\t114\n36\t36\t115\t115\n36\t36\t116\t116\n36\t36\t117\t117\n36\t36\t118\t118\n36\t36\t119\t119\n36\t36\t120\t120\n36\t36\t121\t121\n36\t36\t122\t122\n36\t36\t123\t123\n36\t36\t124\t124\n36\t36\t125\t125\n36\t36\t126\t126\n36\t36\t127\t127\n36\t36\t128\t128\n36\t36\t129\t129\n36\t36\t130\t130\n36\t36\t131\t131\n36\t36\t132\t132\n36\t36\t133\t133\n36\t36\t134\t134\n36\t36\t135\t135\n36\t36\t136\t136\n36\t36\t137\t137\n36\t36\t138\t138\n36\t36\t139\t139\n36\t36\t140\t140\n36\t36\t141\t141\n36\t36\t142\t142\n36\t36\t143\t143\n36\t36\t144\t144\n36\t36\t145\t145\n36\t36\t146\t146\n36\t36\t147\t147\n36\t36\t148\t148\n36\t36\t149\t149\n36\t36\t150\t150\n36\t36\t151\t151\n36\t36\t152\t152\n36\t36\t153\t153\n36\t36\t154\t154\n36\t36\t155\t155\n36\t36\t156\t156\n36\t36\t157\t157\n36\t36\t158\t158\n36\t36\t159\t159\n36\t36\t160\t160\n36\t36\t161\t161\n36\t36\t162\t162\n36\t36\t163\t163\n36\t36\t164\t164\n36\t36\t165\t165\n36\t36\t166\t166\n36\t36\t167\t167\n36\t36\t168\t168\n36\t36\t169\t169\n36\t36\t170\t170\n36\t36\t171\t171\n36\t36\t172\t172\n36\t36\t173\t173\n36\t36\t174\t174\n36\t36\t175\t175\n36\t36\t176\t176\n36\t36\t177\t177\n36\t36\t178\t178\n36\t36\t179\t179\n36\t36\t180\t180\n36\t36\t181\t181\n36\t36\t182\t182\n36\t36\t183\t183\n36\t36\t184\t184\n36\t36\t185\t185\n36\t36\t186\t186\n36\t36\t187\t187\n36\t36\t188\t188\n36\t36\t189\t189\n36\t36\t190\t190\n36\t36\t191\t191\n36\t36\t192\t192\n36\t36\t193\t193\n36\t36\t194\t194\n36\t36\t195\t195\n36\t36\t196\t196\n36\t36\t197\t197\n36\t36\t198\t198\n36\t36\t199\t199\n36\t36\t200\t200\n36\t36\t201\t201\n36\t36\t202\t202\n36\t36\t203\t203\n36\t36\t204\t204\n36\t36\t205\t205\n36\t36\t206\t206\n36\t36\t207\t207\n36\t36\t208\t208\n36\t36\t209\t209\n36\t36\t210\t210\n36\t36\t211\t211\n36\t36\t212\t212\n36\t36\t213\t213\n36\t36\t214\t214\n36\t36\t215\t215\n36\t36\t216\t216\n36\t36\t217\t217\n36\t36\t218\t218\n36\t36\t219\t219\n36\t36\t220\t220\n36\t36\t221\t221\n36\t36\t222\t222\n36\t36\t223\t223\n36\t36\t224\t224\n36\t36\t225\t225\n36\t36\t226\t226\n36\t36\t227\t227\n36\t36\t228\t228\n36\t36\t229\t229\n36\t36\t230\t230\n36\t36\t231\t231\n36\t36\t232\t232\n36\t36\t233\t233\n36\t36\t234\t234\n36\t36\t235\t235\n36\t36\t236\t236\n36\t36\t237\t237\n36\t36\t238\t238\n36\t36\t239\t239\n36\t36\t240\t240\n36\t36\t241\t241\n36\t36\t242\t242\n36\t36\t243\t243\n36\t36\t244\t244\n36\t36\t245\t245\n36\t36\t246\t246\n36\t36\t247\t247\n36\t36\t248\t248\n36\t36\t249\t249\n36\t36\t250\t250\n36\t36\t251\t251\n36\t36\t252\t252\n36\t36\t253\t253\n36\t36\t254\t254\n36\t36\t255\t255\n36\n",
+	"^HTTP/1\\.1\\ 200 OK\r\nContent-Type:\\ ([a-zA-Z0-9]+)\r\n\r\n\\C*$":    "0\t1\t72\t72\n1\t2\t84\t84\n2\t3\t84\t84\n3\t4\t80\t80\n4\t5\t47\t47\n5\t6\t49\t49\n6\t7\t46\t46\n7\t8\t49\t49\n8\t9\t32\t32\n9\t10\t50\t50\n10\t11\t48\t48\n11\t12\t48\t48\n12\t13\t32\t32\n13\t14\t79\t79\n14\t15\t75\t75\n15\t16\t13\t13\n16\t17\t10\t10\n17\t18\t67\t67\n18\t19\t111\t111\n19\t20\t110\t110\n20\t21\t116\t116\n21\t22\t101\t101\n22\t23\t110\t110\n23\t24\t116\t116\n24\t25\t45\t45\n25\t26\t84\t84\n26\t27\t121\t121\n27\t28\t112\t112\n28\t29\t101\t101\n29\t30\t58\t58\n30\t31\t32\t32\n31\t32\t48\t48\n31\t32\t49\t49\n31\t32\t50\t50\n31\t32\t51\t51\n31\t32\t52\t52\n31\t32\t53\t53\n31\t32\t54\t54\n31\t32\t55\t55\n31\t32\t56\t56\n31\t32\t57\t57\n31\t32\t65\t65\n31\t32\t66\t66\n31\t32\t67\t67\n31\t32\t68\t68\n31\t32\t69\t69\n31\t32\t70\t70\n31\t32\t71\t71\n31\t32\t72\t72\n31\t32\t73\t73\n31\t32\t74\t74\n31\t32\t75\t75\n31\t32\t76\t76\n31\t32\t77\t77\n31\t32\t78\t78\n31\t32\t79\t79\n31\t32\t80\t80\n31\t32\t81\t81\n31\t32\t82\t82\n31\t32\t83\t83\n31\t32\t84\t84\n31\t32\t85\t85\n31\t32\t86\t86\n31\t32\t87\t87\n31\t32\t88\t88\n31\t32\t89\t89\n31\t32\t90\t90\n31\t32\t97\t97\n31\t32\t98\t98\n31\t32\t99\t99\n31\t32\t100\t100\n31\t32\t101\t101\n31\t32\t102\t102\n31\t32\t103\t103\n31\t32\t104\t104\n31\t32\t105\t105\n31\t32\t106\t106\n31\t32\t107\t107\n31\t32\t108\t108\n31\t32\t109\t109\n31\t32\t110\t110\n31\t32\t111\t111\n31\t32\t112\t112\n31\t32\t113\t113\n31\t32\t114\t114\n31\t32\t115\t115\n31\t32\t116\t116\n31\t32\t117\t117\n31\t32\t118\t118\n31\t32\t119\t119\n31\t32\t120\t120\n31\t32\t121\t121\n31\t32\t122\t122\n32\t33\t13\t13\n32\t32\t48\t48\n32\t32\t49\t49\n32\t32\t50\t50\n32\t32\t51\t51\n32\t32\t52\t52\n32\t32\t53\t53\n32\t32\t54\t54\n32\t32\t55\t55\n32\t32\t56\t56\n32\t32\t57\t57\n32\t32\t65\t65\n32\t32\t66\t66\n32\t32\t67\t67\n32\t32\t68\t68\n32\t32\t69\t69\n32\t32\t70\t70\n32\t32\t71\t71\n32\t32\t72\t72\n32\t32\t73\t73\n32\t32\t74\t74\n32\t32\t75\t75\n32\t32\t76\t76\n32\t32\t77\t77\n32\t32\t78\t78\n32\t32\t79\t79\n32\t32\t80\t80\n32\t32\t81\t81\n32\t32\t82\t82\n32\t32\t83\t83\n32\t32\t84\t84\n32\t32\t85\t85\n32\t32\t86\t86\n32\t32\t87\t87\n32\t32\t88\t88\n32\t32\t89\t89\n32\t32\t90\t90\n32\t32\t97\t97\n32\t32\t98\t98\n32\t32\t99\t99\n32\t32\t100\t100\n32\t32\t101\t101\n32\t32\t102\t102\n32\t32\t103\t103\n32\t32\t104\t104\n32\t32\t105\t105\n32\t32\t106\t106\n32\t32\t107\t107\n32\t32\t108\t108\n32\t32\t109\t109\n32\t32\t110\t110\n32\t32\t111\t111\n32\t32\t112\t112\n32\t32\t113\t113\n32\t32\t114\t114\n32\t32\t115\t115\n32\t32\t116\t116\n32\t32\t117\t117\n32\t32\t118\t118\n32\t32\t119\t119\n32\t32\t120\t120\n32\t32\t121\t121\n32\t32\t122\t122\n33\t34\t10\t10\n34\t35\t13\t13\n35\t36\t10\t10\n36\t36\t0\t0\n36\t36\t1\t1\n36\t36\t2\t2\n36\t36\t3\t3\n36\t36\t4\t4\n36\t36\t5\t5\n36\t36\t6\t6\n36\t36\t7\t7\n36\t36\t8\t8\n36\t36\t9\t9\n36\t36\t10\t10\n36\t36\t11\t11\n36\t36\t12\t12\n36\t36\t13\t13\n36\t36\t14\t14\n36\t36\t15\t15\n36\t36\t16\t16\n36\t36\t17\t17\n36\t36\t18\t18\n36\t36\t19\t19\n36\t36\t20\t20\n36\t36\t21\t21\n36\t36\t22\t22\n36\t36\t23\t23\n36\t36\t24\t24\n36\t36\t25\t25\n36\t36\t26\t26\n36\t36\t27\t27\n36\t36\t28\t28\n36\t36\t29\t29\n36\t36\t30\t30\n36\t36\t31\t31\n36\t36\t32\t32\n36\t36\t33\t33\n36\t36\t34\t34\n36\t36\t35\t35\n36\t36\t36\t36\n36\t36\t37\t37\n36\t36\t38\t38\n36\t36\t39\t39\n36\t36\t40\t40\n36\t36\t41\t41\n36\t36\t42\t42\n36\t36\t43\t43\n36\t36\t44\t44\n36\t36\t45\t45\n36\t36\t46\t46\n36\t36\t47\t47\n36\t36\t48\t48\n36\t36\t49\t49\n36\t36\t50\t50\n36\t36\t51\t51\n36\t36\t52\t52\n36\t36\t53\t53\n36\t36\t54\t54\n36\t36\t55\t55\n36\t36\t56\t56\n36\t36\t57\t57\n36\t36\t58\t58\n36\t36\t59\t59\n36\t36\t60\t60\n36\t36\t61\t61\n36\t36\t62\t62\n36\t36\t63\t63\n36\t36\t64\t64\n36\t36\t65\t65\n36\t36\t66\t66\n36\t36\t67\t67\n36\t36\t68\t68\n36\t36\t69\t69\n36\t36\t70\t70\n36\t36\t71\t71\n36\t36\t72\t72\n36\t36\t73\t73\n36\t36\t74\t74\n36\t36\t75\t75\n36\t36\t76\t76\n36\t36\t77\t77\n36\t36\t78\t78\n36\t36\t79\t79\n36\t36\t80\t80\n36\t36\t81\t81\n36\t36\t82\t82\n36\t36\t83\t83\n36\t36\t84\t84\n36\t36\t85\t85\n36\t36\t86\t86\n36\t36\t87\t87\n36\t36\t88\t88\n36\t36\t89\t89\n36\t36\t90\t90\n36\t36\t91\t91\n36\t36\t92\t92\n36\t36\t93\t93\n36\t36\t94\t94\n36\t36\t95\t95\n36\t36\t96\t96\n36\t36\t97\t97\n36\t36\t98\t98\n36\t36\t99\t99\n36\t36\t100\t100\n36\t36\t101\t101\n36\t36\t102\t102\n36\t36\t103\t103\n36\t36\t104\t104\n36\t36\t105\t105\n36\t36\t106\t106\n36\t36\t107\t107\n36\t36\t108\t108\n36\t36\t109\t109\n36\t36\t110\t110\n36\t36\t111\t111\n36\t36\t112\t112\n36\t36\t113\t113\n36\t36\t114\t114\n36\t36\t115\t115\n36\t36\t116\t116\n36\t36\t117\t117\n36\t36\t118\t118\n36\t36\t119\t119\n36\t36\t120\t120\n36\t36\t121\t121\n36\t36\t122\t122\n36\t36\t123\t123\n36\t36\t124\t124\n36\t36\t125\t125\n36\t36\t126\t126\n36\t36\t127\t127\n36\t36\t128\t128\n36\t36\t129\t129\n36\t36\t130\t130\n36\t36\t131\t131\n36\t36\t132\t132\n36\t36\t133\t133\n36\t36\t134\t134\n36\t36\t135\t135\n36\t36\t136\t136\n36\t36\t137\t137\n36\t36\t138\t138\n36\t36\t139\t139\n36\t36\t140\t140\n36\t36\t141\t141\n36\t36\t142\t142\n36\t36\t143\t143\n36\t36\t144\t144\n36\t36\t145\t145\n36\t36\t146\t146\n36\t36\t147\t147\n36\t36\t148\t148\n36\t36\t149\t149\n36\t36\t150\t150\n36\t36\t151\t151\n36\t36\t152\t152\n36\t36\t153\t153\n36\t36\t154\t154\n36\t36\t155\t155\n36\t36\t156\t156\n36\t36\t157\t157\n36\t36\t158\t158\n36\t36\t159\t159\n36\t36\t160\t160\n36\t36\t161\t161\n36\t36\t162\t162\n36\t36\t163\t163\n36\t36\t164\t164\n36\t36\t165\t165\n36\t36\t166\t166\n36\t36\t167\t167\n36\t36\t168\t168\n36\t36\t169\t169\n36\t36\t170\t170\n36\t36\t171\t171\n36\t36\t172\t172\n36\t36\t173\t173\n36\t36\t174\t174\n36\t36\t175\t175\n36\t36\t176\t176\n36\t36\t177\t177\n36\t36\t178\t178\n36\t36\t179\t179\n36\t36\t180\t180\n36\t36\t181\t181\n36\t36\t182\t182\n36\t36\t183\t183\n36\t36\t184\t184\n36\t36\t185\t185\n36\t36\t186\t186\n36\t36\t187\t187\n36\t36\t188\t188\n36\t36\t189\t189\n36\t36\t190\t190\n36\t36\t191\t191\n36\t36\t192\t192\n36\t36\t193\t193\n36\t36\t194\t194\n36\t36\t195\t195\n36\t36\t196\t196\n36\t36\t197\t197\n36\t36\t198\t198\n36\t36\t199\t199\n36\t36\t200\t200\n36\t36\t201\t201\n36\t36\t202\t202\n36\t36\t203\t203\n36\t36\t204\t204\n36\t36\t205\t205\n36\t36\t206\t206\n36\t36\t207\t207\n36\t36\t208\t208\n36\t36\t209\t209\n36\t36\t210\t210\n36\t36\t211\t211\n36\t36\t212\t212\n36\t36\t213\t213\n36\t36\t214\t214\n36\t36\t215\t215\n36\t36\t216\t216\n36\t36\t217\t217\n36\t36\t218\t218\n36\t36\t219\t219\n36\t36\t220\t220\n36\t36\t221\t221\n36\t36\t222\t222\n36\t36\t223\t223\n36\t36\t224\t224\n36\t36\t225\t225\n36\t36\t226\t226\n36\t36\t227\t227\n36\t36\t228\t228\n36\t36\t229\t229\n36\t36\t230\t230\n36\t36\t231\t231\n36\t36\t232\t232\n36\t36\t233\t233\n36\t36\t234\t234\n36\t36\t235\t235\n36\t36\t236\t236\n36\t36\t237\t237\n36\t36\t238\t238\n36\t36\t239\t239\n36\t36\t240\t240\n36\t36\t241\t241\n36\t36\t242\t242\n36\t36\t243\t243\n36\t36\t244\t244\n36\t36\t245\t245\n36\t36\t246\t246\n36\t36\t247\t247\n36\t36\t248\t248\n36\t36\t249\t249\n36\t36\t250\t250\n36\t36\t251\t251\n36\t36\t252\t252\n36\t36\t253\t253\n36\t36\t254\t254\n36\t36\t255\t255\n36\n",
+	"^HTTP/1\\.1\\ 200 OK\r\nContent-Type:\\ ([a-zA-Z0-9]+)\r\n\r\n\\C{64}$": "0\t1\t72\t72\n1\t2\t84\t84\n2\t3\t84\t84\n3\t4\t80\t80\n4\t5\t47\t47\n5\t6\t49\t49\n6\t7\t46\t46\n7\t8\t49\t49\n8\t9\t32\t32\n9\t10\t50\t50\n10\t11\t48\t48\n11\t12\t48\t48\n12\t13\t32\t32\n13\t14\t79\t79\n14\t15\t75\t75\n15\t16\t13\t13\n16\t17\t10\t10\n17\t18\t67\t67\n18\t19\t111\t111\n19\t20\t110\t110\n20\t21\t116\t116\n21\t22\t101\t101\n22\t23\t110\t110\n23\t24\t116\t116\n24\t25\t45\t45\n25\t26\t84\t84\n26\t27\t121\t121\n27\t28\t112\t112\n28\t29\t101\t101\n29\t30\t58\t58\n30\t31\t32\t32\n31\t32\t48\t48\n31\t32\t49\t49\n31\t32\t50\t50\n31\t32\t51\t51\n31\t32\t52\t52\n31\t32\t53\t53\n31\t32\t54\t54\n31\t32\t55\t55\n31\t32\t56\t56\n31\t32\t57\t57\n31\t32\t65\t65\n31\t32\t66\t66\n31\t32\t67\t67\n31\t32\t68\t68\n31\t32\t69\t69\n31\t32\t70\t70\n31\t32\t71\t71\n31\t32\t72\t72\n31\t32\t73\t73\n31\t32\t74\t74\n31\t32\t75\t75\n31\t32\t76\t76\n31\t32\t77\t77\n31\t32\t78\t78\n31\t32\t79\t79\n31\t32\t80\t80\n31\t32\t81\t81\n31\t32\t82\t82\n31\t32\t83\t83\n31\t32\t84\t84\n31\t32\t85\t85\n31\t32\t86\t86\n31\t32\t87\t87\n31\t32\t88\t88\n31\t32\t89\t89\n31\t32\t90\t90\n31\t32\t97\t97\n31\t32\t98\t98\n31\t32\t99\t99\n31\t32\t100\t100\n31\t32\t101\t101\n31\t32\t102\t102\n31\t32\t103\t103\n31\t32\t104\t104\n31\t32\t105\t105\n31\t32\t106\t106\n31\t32\t107\t107\n31\t32\t108\t108\n31\t32\t109\t109\n31\t32\t110\t110\n31\t32\t111\t111\n31\t32\t112\t112\n31\t32\t113\t113\n31\t32\t114\t114\n31\t32\t115\t115\n31\t32\t116\t116\n31\t32\t117\t117\n31\t32\t118\t118\n31\t32\t119\t119\n31\t32\t120\t120\n31\t32\t121\t121\n31\t32\t122\t122\n32\t33\t13\t13\n32\t32\t48\t48\n32\t32\t49\t49\n32\t32\t50\t50\n32\t32\t51\t51\n32\t32\t52\t52\n32\t32\t53\t53\n32\t32\t54\t54\n32\t32\t55\t55\n32\t32\t56\t56\n32\t32\t57\t57\n32\t32\t65\t65\n32\t32\t66\t66\n32\t32\t67\t67\n32\t32\t68\t68\n32\t32\t69\t69\n32\t32\t70\t70\n32\t32\t71\t71\n32\t32\t72\t72\n32\t32\t73\t73\n32\t32\t74\t74\n32\t32\t75\t75\n32\t32\t76\t76\n32\t32\t77\t77\n32\t32\t78\t78\n32\t32\t79\t79\n32\t32\t80\t80\n32\t32\t81\t81\n32\t32\t82\t82\n32\t32\t83\t83\n32\t32\t84\t84\n32\t32\t85\t85\n32\t32\t86\t86\n32\t32\t87\t87\n32\t32\t88\t88\n32\t32\t89\t89\n32\t32\t90\t90\n32\t32\t97\t97\n32\t32\t98\t98\n32\t32\t99\t99\n32\t32\t100\t100\n32\t32\t101\t101\n32\t32\t102\t102\n32\t32\t103\t103\n32\t32\t104\t104\n32\t32\t105\t105\n32\t32\t106\t106\n32\t32\t107\t107\n32\t32\t108\t108\n32\t32\t109\t109\n32\t32\t110\t110\n32\t32\t111\t111\n32\t32\t112\t112\n32\t32\t113\t113\n32\t32\t114\t114\n32\t32\t115\t115\n32\t32\t116\t116\n32\t32\t117\t117\n32\t32\t118\t118\n32\t32\t119\t119\n32\t32\t120\t120\n32\t32\t121\t121\n32\t32\t122\t122\n33\t34\t10\t10\n34\t35\t13\t13\n35\t36\t10\t10\n36\t37\t0\t0\n36\t37\t1\t1\n36\t37\t2\t2\n36\t37\t3\t3\n36\t37\t4\t4\n36\t37\t5\t5\n36\t37\t6\t6\n36\t37\t7\t7\n36\t37\t8\t8\n36\t37\t9\t9\n36\t37\t10\t10\n36\t37\t11\t11\n36\t37\t12\t12\n36\t37\t13\t13\n36\t37\t14\t14\n36\t37\t15\t15\n36\t37\t16\t16\n36\t37\t17\t17\n36\t37\t18\t18\n36\t37\t19\t19\n36\t37\t20\t20\n36\t37\t21\t21\n36\t37\t22\t22\n36\t37\t23\t23\n36\t37\t24\t24\n36\t37\t25\t25\n36\t37\t26\t26\n36\t37\t27\t27\n36\t37\t28\t28\n36\t37\t29\t29\n36\t37\t30\t30\n36\t37\t31\t31\n36\t37\t32\t32\n36\t37\t33\t33\n36\t37\t34\t34\n36\t37\t35\t35\n36\t37\t36\t36\n36\t37\t37\t37\n36\t37\t38\t38\n36\t37\t39\t39\n36\t37\t40\t40\n36\t37\t41\t41\n36\t37\t42\t42\n36\t37\t43\t43\n36\t37\t44\t44\n36\t37\t45\t45\n36\t37\t46\t46\n36\t37\t47\t47\n36\t37\t48\t48\n36\t37\t49\t49\n36\t37\t50\t50\n36\t37\t51\t51\n36\t37\t52\t52\n36\t37\t53\t53\n36\t37\t54\t54\n36\t37\t55\t55\n36\t37\t56\t56\n36\t37\t57\t57\n36\t37\t58\t58\n36\t37\t59\t59\n36\t37\t60\t60\n36\t37\t61\t61\n36\t37\t62\t62\n36\t37\t63\t63\n36\t37\t64\t64\n36\t37\t65\t65\n36\t37\t66\t66\n36\t37\t67\t67\n36\t37\t68\t68\n36\t37\t69\t69\n36\t37\t70\t70\n36\t37\t71\t71\n36\t37\t72\t72\n36\t37\t73\t73\n36\t37\t74\t74\n36\t37\t75\t75\n36\t37\t76\t76\n36\t37\t77\t77\n36\t37\t78\t78\n36\t37\t79\t79\n36\t37\t80\t80\n36\t37\t81\t81\n36\t37\t82\t82\n36\t37\t83\t83\n36\t37\t84\t84\n36\t37\t85\t85\n36\t37\t86\t86\n36\t37\t87\t87\n36\t37\t88\t88\n36\t37\t89\t89\n36\t37\t90\t90\n36\t37\t91\t91\n36\t37\t92\t92\n36\t37\t93\t93\n36\t37\t94\t94\n36\t37\t95\t95\n36\t37\t96\t96\n36\t37\t97\t97\n36\t37\t98\t98\n36\t37\t99\t99\n36\t37\t100\t100\n36\t37\t101\t101\n36\t37\t102\t102\n36\t37\t103\t103\n36\t37\t104\t104\n36\t37\t105\t105\n36\t37\t106\t106\n36\t37\t107\t107\n36\t37\t108\t108\n36\t37\t109\t109\n36\t37\t110\t110\n36\t37\t111\t111\n36\t37\t112\t112\n36\t37\t113\t113\n36\t37\t114\t114\n36\t37\t115\t115\n36\t37\t116\t116\n36\t37\t117\t117\n36\t37\t118\t118\n36\t37\t119\t119\n36\t37\t120\t120\n36\t37\t121\t121\n36\t37\t122\t122\n36\t37\t123\t123\n36\t37\t124\t124\n36\t37\t125\t125\n36\t37\t126\t126\n36\t37\t127\t127\n36\t37\t128\t128\n36\t37\t129\t129\n36\t37\t130\t130\n36\t37\t131\t131\n36\t37\t132\t132\n36\t37\t133\t133\n36\t37\t134\t134\n36\t37\t135\t135\n36\t37\t136\t136\n36\t37\t137\t137\n36\t37\t138\t138\n36\t37\t139\t139\n36\t37\t140\t140\n36\t37\t141\t141\n36\t37\t142\t142\n36\t37\t143\t143\n36\t37\t144\t144\n36\t37\t145\t145\n36\t37\t146\t146\n36\t37\t147\t147\n36\t37\t148\t148\n36\t37\t149\t149\n36\t37\t150\t150\n36\t37\t151\t151\n36\t37\t152\t152\n36\t37\t153\t153\n36\t37\t154\t154\n36\t37\t155\t155\n36\t37\t156\t156\n36\t37\t157\t157\n36\t37\t158\t158\n36\t37\t159\t159\n36\t37\t160\t160\n36\t37\t161\t161\n36\t37\t162\t162\n36\t37\t163\t163\n36\t37\t164\t164\n36\t37\t165\t165\n36\t37\t166\t166\n36\t37\t167\t167\n36\t37\t168\t168\n36\t37\t169\t169\n36\t37\t170\t170\n36\t37\t171\t171\n36\t37\t172\t172\n36\t37\t173\t173\n36\t37\t174\t174\n36\t37\t175\t175\n36\t37\t176\t176\n36\t37\t177\t177\n36\t37\t178\t178\n36\t37\t179\t179\n36\t37\t180\t180\n36\t37\t181\t181\n36\t37\t182\t182\n36\t37\t183\t183\n36\t37\t184\t184\n36\t37\t185\t185\n36\t37\t186\t186\n36\t37\t187\t187\n36\t37\t188\t188\n36\t37\t189\t189\n36\t37\t190\t190\n36\t37\t191\t191\n36\t37\t192\t192\n36\t37\t193\t193\n36\t37\t194\t194\n36\t37\t195\t195\n36\t37\t196\t196\n36\t37\t197\t197\n36\t37\t198\t198\n36\t37\t199\t199\n36\t37\t200\t200\n36\t37\t201\t201\n36\t37\t202\t202\n36\t37\t203\t203\n36\t37\t204\t204\n36\t37\t205\t205\n36\t37\t206\t206\n36\t37\t207\t207\n36\t37\t208\t208\n36\t37\t209\t209\n36\t37\t210\t210\n36\t37\t211\t211\n36\t37\t212\t212\n36\t37\t213\t213\n36\t37\t214\t214\n36\t37\t215\t215\n36\t37\t216\t216\n36\t37\t217\t217\n36\t37\t218\t218\n36\t37\t219\t219\n36\t37\t220\t220\n36\t37\t221\t221\n36\t37\t222\t222\n36\t37\t223\t223\n36\t37\t224\t224\n36\t37\t225\t225\n36\t37\t226\t226\n36\t37\t227\t227\n36\t37\t228\t228\n36\t37\t229\t229\n36\t37\t230\t230\n36\t37\t231\t231\n36\t37\t232\t232\n36\t37\t233\t233\n36\t37\t234\t234\n36\t37\t235\t235\n36\t37\t236\t236\n36\t37\t237\t237\n36\t37\t238\t238\n36\t37\t239\t239\n36\t37\t240\t240\n36\t37\t241\t241\n36\t37\t242\t242\n36\t37\t243\t243\n36\t37\t244\t244\n36\t37\t245\t245\n36\t37\t246\t246\n36\t37\t247\t247\n36\t37\t248\t248\n36\t37\t249\t249\n36\t37\t250\t250\n36\t37\t251\t251\n36\t37\t252\t252\n36\t37\t253\t253\n36\t37\t254\t254\n36\t37\t255\t255\n37\t38\t0\t0\n37\t38\t1\t1\n37\t38\t2\t2\n37\t38\t3\t3\n37\t38\t4\t4\n37\t38\t5\t5\n37\t38\t6\t6\n37\t38\t7\t7\n37\t38\t8\t8\n37\t38\t9\t9\n37\t38\t10\t10\n37\t38\t11\t11\n37\t38\t12\t12\n37\t38\t13\t13\n37\t38\t14\t14\n37\t38\t15\t15\n37\t38\t16\t16\n37\t38\t17\t17\n37\t38\t18\t18\n37\t38\t19\t19\n37\t38\t20\t20\n37\t38\t21\t21\n37\t38\t22\t22\n37\t38\t23\t23\n37\t38\t24\t24\n37\t38\t25\t25\n37\t38\t26\t26\n37\t38\t27\t27\n37\t38\t28\t28\n37\t38\t29\t29\n37\t38\t30\t30\n37\t38\t31\t31\n37\t38\t32\t32\n37\t38\t33\t33\n37\t38\t34\t34\n37\t38\t35\t35\n37\t38\t36\t36\n37\t38\t37\t37\n37\t38\t38\t38\n37\t38\t39\t39\n37\t38\t40\t40\n37\t38\t41\t41\n37\t38\t42\t42\n37\t38\t43\t43\n37\t38\t44\t44\n37\t38\t45\t45\n37\t38\t46\t46\n37\t38\t47\t47\n37\t38\t48\t48\n37\t38\t49\t49\n37\t38\t50\t50\n37\t38\t51\t51\n37\t38\t52\t52\n37\t38\t53\t53\n37\t38\t54\t54\n37\t38\t55\t55\n37\t38\t56\t56\n37\t38\t57\t57\n37\t38\t58\t58\n37\t38\t59\t59\n37\t38\t60\t60\n37\t38\t61\t61\n37\t38\t62\t62\n37\t38\t63\t63\n37\t38\t64\t64\n37\t38\t65\t65\n37\t38\t66\t66\n37\t38\t67\t67\n37\t38\t68\t68\n37\t38\t69\t69\n37\t38\t70\t70\n37\t38\t71\t71\n37\t38\t72\t72\n37\t38\t73\t73\n37\t38\t74\t74\n37\t38\t75\t75\n37\t38\t76\t76\n37\t38\t77\t77\n37\t38\t78\t78\n37\t38\t79\t79\n37\t38\t80\t80\n37\t38\t81\t81\n37\t38\t82\t82\n37\t38\t83\t83\n37\t38\t84\t84\n37\t38\t85\t85\n37\t38\t86\t86\n37\t38\t87\t87\n37\t38\t88\t88\n37\t38\t89\t89\n37\t38\t90\t90\n37\t38\t91\t91\n37\t38\t92\t92\n37\t38\t93\t93\n37\t38\t94\t94\n37\t38\t95\t95\n37\t38\t96\t96\n37\t38\t97\t97\n37\t38\t98\t98\n37\t38\t99\t99\n37\t38\t100\t100\n37\t38\t101\t101\n37\t38\t102\t102\n37\t38\t103\t103\n37\t38\t104\t104\n37\t38\t105\t105\n37\t38\t106\t106\n37\t38\t107\t107\n37\t38\t108\t108\n37\t38\t109\t109\n37\t38\t110\t110\n37\t38\t111\t111\n37\t38\t112\t112\n37\t38\t113\t113\n37\t38\t114\t114\n37\t38\t115\t115\n37\t38\t116\t116\n37\t38\t117\t117\n37\t38\t118\t118\n37\t38\t119\t119\n37\t38\t120\t120\n37\t38\t121\t121\n37\t38\t122\t122\n37\t38\t123\t123\n37\t38\t124\t124\n37\t38\t125\t125\n37\t38\t126\t126\n37\t38\t127\t127\n37\t38\t128\t128\n37\t38\t129\t129\n37\t38\t130\t130\n37\t38\t131\t131\n37\t38\t132\t132\n37\t38\t133\t133\n37\t38\t134\t134\n37\t38\t135\t135\n37\t38\t136\t136\n37\t38\t137\t137\n37\t38\t138\t138\n37\t38\t139\t139\n37\t38\t140\t140\n37\t38\t141\t141\n37\t38\t142\t142\n37\t38\t143\t143\n37\t38\t144\t144\n37\t38\t145\t145\n37\t38\t146\t146\n37\t38\t147\t147\n37\t38\t148\t148\n37\t38\t149\t149\n37\t38\t150\t150\n37\t38\t151\t151\n37\t38\t152\t152\n37\t38\t153\t153\n37\t38\t154\t154\n37\t38\t155\t155\n37\t38\t156\t156\n37\t38\t157\t157\n37\t38\t158\t158\n37\t38\t159\t159\n37\t38\t160\t160\n37\t38\t161\t161\n37\t38\t162\t162\n37\t38\t163\t163\n37\t38\t164\t164\n37\t38\t165\t165\n37\t38\t166\t166\n37\t38\t167\t167\n37\t38\t168\t168\n37\t38\t169\t169\n37\t38\t170\t170\n37\t38\t171\t171\n37\t38\t172\t172\n37\t38\t173\t173\n37\t38\t174\t174\n37\t38\t175\t175\n37\t38\t176\t176\n37\t38\t177\t177\n37\t38\t178\t178\n37\t38\t179\t179\n37\t38\t180\t180\n37\t38\t181\t181\n37\t38\t182\t182\n37\t38\t183\t183\n37\t38\t184\t184\n37\t38\t185\t185\n37\t38\t186\t186\n37\t38\t187\t187\n37\t38\t188\t188\n37\t38\t189\t189\n37\t38\t190\t190\n37\t38\t191\t191\n37\t38\t192\t192\n37\t38\t193\t193\n37\t38\t194\t194\n37\t38\t195\t195\n37\t38\t196\t196\n37\t38\t197\t197\n37\t38\t198\t198\n37\t38\t199\t199\n37\t38\t200\t200\n37\t38\t201\t201\n37\t38\t202\t202\n37\t38\t203\t203\n37\t38\t204\t204\n37\t38\t205\t205\n37\t38\t206\t206\n37\t38\t207\t207\n37\t38\t208\t208\n37\t38\t209\t209\n37\t38\t210\t210\n37\t38\t211\t211\n37\t38\t212\t212\n37\t38\t213\t213\n37\t38\t214\t214\n37\t38\t215\t215\n37\t38\t216\t216\n37\t38\t217\t217\n37\t38\t218\t218\n37\t38\t219\t219\n37\t38\t220\t220\n37\t38\t221\t221\n37\t38\t222\t222\n37\t38\t223\t223\n37\t38\t224\t224\n37\t38\t225\t225\n37\t38\t226\t226\n37\t38\t227\t227\n37\t38\t228\t228\n37\t38\t229\t229\n37\t38\t230\t230\n37\t38\t231\t231\n37\t38\t232\t232\n37\t38\t233\t233\n37\t38\t234\t234\n37\t38\t235\t235\n37\t38\t236\t236\n37\t38\t237\t237\n37\t38\t238\t238\n37\t38\t239\t239\n37\t38\t240\t240\n37\t38\t241\t241\n37\t38\t242\t242\n37\t38\t243\t243\n37\t38\t244\t244\n37\t38\t245\t245\n37\t38\t246\t246\n37\t38\t247\t247\n37\t38\t248\t248\n37\t38\t249\t249\n37\t38\t250\t250\n37\t38\t251\t251\n37\t38\t252\t252\n37\t38\t253\t253\n37\t38\t254\t254\n37\t38\t255\t255\n38\t39\t0\t0\n38\t39\t1\t1\n38\t39\t2\t2\n38\t39\t3\t3\n38\t39\t4\t4\n38\t39\t5\t5\n38\t39\t6\t6\n38\t39\t7\t7\n38\t39\t8\t8\n38\t39\t9\t9\n38\t39\t10\t10\n38\t39\t11\t11\n38\t39\t12\t12\n38\t39\t13\t13\n38\t39\t14\t14\n38\t39\t15\t15\n38\t39\t16\t16\n38\t39\t17\t17\n38\t39\t18\t18\n38\t39\t19\t19\n38\t39\t20\t20\n38\t39\t21\t21\n38\t39\t22\t22\n38\t39\t23\t23\n38\t39\t24\t24\n38\t39\t25\t25\n38\t39\t26\t26\n38\t39\t27\t27\n38\t39\t28\t28\n38\t39\t29\t29\n38\t39\t30\t30\n38\t39\t31\t31\n38\t39\t32\t32\n38\t39\t33\t33\n38\t39\t34\t34\n38\t39\t35\t35\n38\t39\t36\t36\n38\t39\t37\t37\n38\t39\t38\t38\n38\t39\t39\t39\n38\t39\t40\t40\n38\t39\t41\t41\n38\t39\t42\t42\n38\t39\t43\t43\n38\t39\t44\t44\n38\t39\t45\t45\n38\t39\t46\t46\n38\t39\t47\t47\n38\t39\t48\t48\n38\t39\t49\t49\n38\t39\t50\t50\n38\t39\t51\t51\n38\t39\t52\t52\n38\t39\t53\t53\n38\t39\t54\t54\n38\t39\t55\t55\n38\t39\t56\t56\n38\t39\t57\t57\n38\t39\t58\t58\n38\t39\t59\t59\n38\t39\t60\t60\n38\t39\t61\t61\n38\t39\t62\t62\n38\t39\t63\t63\n38\t39\t64\t64\n38\t39\t65\t65\n38\t39\t66\t66\n38\t39\t67\t67\n38\t39\t68\t68\n38\t39\t69\t69\n38\t39\t70\t70\n38\t39\t71\t71\n38\t39\t72\t72\n38\t39\t73\t73\n38\t39\t74\t74\n38\t39\t75\t75\n38\t39\t76\t76\n38\t39\t77\t77\n38\t39\t78\t78\n38\t39\t79\t79\n38\t39\t80\t80\n38\t39\t81\t81\n38\t39\t82\t82\n38\t39\t83\t83\n38\t39\t84\t84\n38\t39\t85\t85\n38\t39\t86\t86\n38\t39\t87\t87\n38\t39\t88\t88\n38\t39\t89\t89\n38\t39\t90\t90\n38\t39\t91\t91\n38\t39\t92\t92\n38\t39\t93\t93\n38\t39\t94\t94\n38\t39\t95\t95\n38\t39\t96\t96\n38\t39\t97\t97\n38\t39\t98\t98\n38\t39\t99\t99\n38\t39\t100\t100\n38\t39\t101\t101\n38\t39\t102\t102\n38\t39\t103\t103\n38\t39\t104\t104\n38\t39\t105\t105\n38\t39\t106\t106\n38\t39\t107\t107\n38\t39\t108\t108\n38\t39\t109\t109\n38\t39\t110\t110\n38\t39\t111\t111\n38\t39\t112\t112\n38\t39\t113\t113\n38\t39\t114\t114\n38\t39\t115\t115\n38\t39\t116\t116\n38\t39\t117\t117\n38\t39\t118\t118\n38\t39\t119\t119\n38\t39\t120\t120\n38\t39\t121\t121\n38\t39\t122\t122\n38\t39\t123\t123\n38\t39\t124\t124\n38\t39\t125\t125\n38\t39\t126\t126\n38\t39\t127\t127\n38\t39\t128\t128\n38\t39\t129\t129\n38\t39\t130\t130\n38\t39\t131\t131\n38\t39\t132\t132\n38\t39\t133\t133\n38\t39\t134\t134\n38\t39\t135\t135\n38\t39\t136\t136\n38\t39\t137\t137\n38\t39\t138\t138\n38\t39\t139\t139\n38\t39\t140\t140\n38\t39\t141\t141\n38\t39\t142\t142\n38\t39\t143\t143\n38\t39\t144\t144\n38\t39\t145\t145\n38\t39\t146\t146\n38\t39\t147\t147\n38\t39\t148\t148\n38\t39\t149\t149\n38\t39\t150\t150\n38\t39\t151\t151\n38\t39\t152\t152\n38\t39\t153\t153\n38\t39\t154\t154\n38\t39\t155\t155\n38\t39\t156\t156\n38\t39\t157\t157\n38\t39\t158\t158\n38\t39\t159\t159\n38\t39\t160\t160\n38\t39\t161\t161\n38\t39\t162\t162\n38\t39\t163\t163\n38\t39\t164\t164\n38\t39\t165\t165\n38\t39\t166\t166\n38\t39\t167\t167\n38\t39\t168\t168\n38\t39\t169\t169\n38\t39\t170\t170\n38\t39\t171\t171\n38\t39\t172\t172\n38\t39\t173\t173\n38\t39\t174\t174\n38\t39\t175\t175\n38\t39\t176\t176\n38\t39\t177\t177\n38\t39\t178\t178\n38\t39\t179\t179\n38\t39\t180\t180\n38\t39\t181\t181\n38\t39\t182\t182\n38\t39\t183\t183\n38\t39\t184\t184\n38\t39\t185\t185\n38\t39\t186\t186\n38\t39\t187\t187\n38\t39\t188\t188\n38\t39\t189\t189\n38\t39\t190\t190\n38\t39\t191\t191\n38\t39\t192\t192\n38\t39\t193\t193\n38\t39\t194\t194\n38\t39\t195\t195\n38\t39\t196\t196\n38\t39\t197\t197\n38\t39\t198\t198\n38\t39\t199\t199\n38\t39\t200\t200\n38\t39\t201\t201\n38\t39\t202\t202\n38\t39\t203\t203\n38\t39\t204\t204\n38\t39\t205\t205\n38\t39\t206\t206\n38\t39\t207\t207\n38\t39\t208\t208\n38\t39\t209\t209\n38\t39\t210\t210\n38\t39\t211\t211\n38\t39\t212\t212\n38\t39\t213\t213\n38\t39\t214\t214\n38\t39\t215\t215\n38\t39\t216\t216\n38\t39\t217\t217\n38\t39\t218\t218\n38\t39\t219\t219\n38\t39\t220\t220\n38\t39\t221\t221\n38\t39\t222\t222\n38\t39\t223\t223\n38\t39\t224\t224\n38\t39\t225\t225\n38\t39\t226\t226\n38\t39\t227\t227\n38\t39\t228\t228\n38\t39\t229\t229\n38\t39\t230\t230\n38\t39\t231\t231\n38\t39\t232\t232\n38\t39\t233\t233\n38\t39\t234\t234\n38\t39\t235\t235\n38\t39\t236\t236\n38\t39\t237\t237\n38\t39\t238\t238\n38\t39\t239\t239\n38\t39\t240\t240\n38\t39\t241\t241\n38\t39\t242\t242\n38\t39\t243\t243\n38\t39\t244\t244\n38\t39\t245\t245\n38\t39\t246\t246\n38\t39\t247\t247\n38\t39\t248\t248\n38\t39\t249\t249\n38\t39\t250\t250\n38\t39\t251\t251\n38\t39\t252\t252\n38\t39\t253\t253\n38\t39\t254\t254\n38\t39\t255\t255\n39\t40\t0\t0\n39\t40\t1\t1\n39\t40\t2\t2\n39\t40\t3\t3\n39\t40\t4\t4\n39\t40\t5\t5\n39\t40\t6\t6\n39\t40\t7\t7\n39\t40\t8\t8\n39\t40\t9\t9\n39\t40\t10\t10\n39\t40\t11\t11\n39\t40\t12\t12\n39\t40\t13\t13\n39\t40\t14\t14\n39\t40\t15\t15\n39\t40\t16\t16\n39\t40\t17\t17\n39\t40\t18\t18\n39\t40\t19\t19\n39\t40\t20\t20\n39\t40\t21\t21\n39\t40\t22\t22\n39\t40\t23\t23\n39\t40\t24\t24\n39\t40\t25\t25\n39\t40\t26\t26\n39\t40\t27\t27\n39\t40\t28\t28\n39\t40\t29\t29\n39\t40\t30\t30\n39\t40\t31\t31\n39\t40\t32\t32\n39\t40\t33\t33\n39\t40\t34\t34\n39\t40\t35\t35\n39\t40\t36\t36\n39\t40\t37\t37\n39\t40\t38\t38\n39\t40\t39\t39\n39\t40\t40\t40\n39\t40\t41\t41\n39\t40\t42\t42\n39\t40\t43\t43\n39\t40\t44\t44\n39\t40\t45\t45\n39\t40\t46\t46\n39\t40\t47\t47\n39\t40\t48\t48\n39\t40\t49\t49\n39\t40\t50\t50\n39\t40\t51\t51\n39\t40\t52\t52\n39\t40\t53\t53\n39\t40\t54\t54\n39\t40\t55\t55\n39\t40\t56\t56\n39\t40\t57\t57\n39\t40\t58\t58\n39\t40\t59\t59\n39\t40\t60\t60\n39\t40\t61\t61\n39\t40\t62\t62\n39\t40\t63\t63\n39\t40\t64\t64\n39\t40\t65\t65\n39\t40\t66\t66\n39\t40\t67\t67\n39\t40\t68\t68\n39\t40\t69\t69\n39\t40\t70\t70\n39\t40\t71\t71\n39\t40\t72\t72\n39\t40\t73\t73\n39\t40\t74\t74\n39\t40\t75\t75\n39\t40\t76\t76\n39\t40\t77\t77\n39\t40\t78\t78\n39\t40\t79\t79\n39\t40\t80\t80\n39\t40\t81\t81\n39\t40\t82\t82\n39\t40\t83\t83\n39\t40\t84\t84\n39\t40\t85\t85\n39\t40\t86\t86\n39\t40\t87\t87\n39\t40\t88\t88\n39\t40\t89\t89\n39\t40\t90\t90\n39\t40\t91\t91\n39\t40\t92\t92\n39\t40\t93\t93\n39\t40\t94\t94\n39\t40\t95\t95\n39\t40\t96\t96\n39\t40\t97\t97\n39\t40\t98\t98\n39\t40\t99\t99\n39\t40\t100\t100\n39\t40\t101\t101\n39\t40\t102\t102\n39\t40\t103\t103\n39\t40\t104\t104\n39\t40\t105\t105\n39\t40\t106\t106\n39\t40\t107\t107\n39\t40\t108\t108\n39\t40\t109\t109\n39\t40\t110\t110\n39\t40\t111\t111\n39\t40\t112\t112\n39\t40\t113\t113\n39\t40\t114\t114\n39\t40\t115\t115\n39\t40\t116\t116\n39\t40\t117\t117\n39\t40\t118\t118\n39\t40\t119\t119\n39\t40\t120\t120\n39\t40\t121\t121\n39\t40\t122\t122\n39\t40\t123\t123\n39\t40\t124\t124\n39\t40\t125\t125\n39\t40\t126\t126\n39\t40\t127\t127\n39\t40\t128\t128\n39\t40\t129\t129\n39\t40\t130\t130\n39\t40\t131\t131\n39\t40\t132\t132\n39\t40\t133\t133\n39\t40\t134\t134\n39\t40\t135\t135\n39\t40\t136\t136\n39\t40\t137\t137\n39\t40\t138\t138\n39\t40\t139\t139\n39\t40\t140\t140\n39\t40\t141\t141\n39\t40\t142\t142\n39\t40\t143\t143\n39\t40\t144\t144\n39\t40\t145\t145\n39\t40\t146\t146\n39\t40\t147\t147\n39\t40\t148\t148\n39\t40\t149\t149\n39\t40\t150\t150\n39\t40\t151\t151\n39\t40\t152\t152\n39\t40\t153\t153\n39\t40\t154\t154\n39\t40\t155\t155\n39\t40\t156\t156\n39\t40\t157\t157\n39\t40\t158\t158\n39\t40\t159\t159\n39\t40\t160\t160\n39\t40\t161\t161\n39\t40\t162\t162\n39\t40\t163\t163\n39\t40\t164\t164\n39\t40\t165\t165\n39\t40\t166\t166\n39\t40\t167\t167\n39\t40\t168\t168\n39\t40\t169\t169\n39\t40\t170\t170\n39\t40\t171\t171\n39\t40\t172\t172\n39\t40\t173\t173\n39\t40\t174\t174\n39\t40\t175\t175\n39\t40\t176\t176\n39\t40\t177\t177\n39\t40\t178\t178\n39\t40\t179\t179\n39\t40\t180\t180\n39\t40\t181\t181\n39\t40\t182\t182\n39\t40\t183\t183\n39\t40\t184\t184\n39\t40\t185\t185\n39\t40\t186\t186\n39\t40\t187\t187\n39\t40\t188\t188\n39\t40\t189\t189\n39\t40\t190\t190\n39\t40\t191\t191\n39\t40\t192\t192\n39\t40\t193\t193\n39\t40\t194\t194\n39\t40\t195\t195\n39\t40\t196\t196\n39\t40\t197\t197\n39\t40\t198\t198\n39\t40\t199\t199\n39\t40\t200\t200\n39\t40\t201\t201\n39\t40\t202\t202\n39\t40\t203\t203\n39\t40\t204\t204\n39\t40\t205\t205\n39\t40\t206\t206\n39\t40\t207\t207\n39\t40\t208\t208\n39\t40\t209\t209\n39\t40\t210\t210\n39\t40\t211\t211\n39\t40\t212\t212\n39\t40\t213\t213\n39\t40\t214\t214\n39\t40\t215\t215\n39\t40\t216\t216\n39\t40\t217\t217\n39\t40\t218\t218\n39\t40\t219\t219\n39\t40\t220\t220\n39\t40\t221\t221\n39\t40\t222\t222\n39\t40\t223\t223\n39\t40\t224\t224\n39\t40\t225\t225\n39\t40\t226\t226\n39\t40\t227\t227\n39\t40\t228\t228\n39\t40\t229\t229\n39\t40\t230\t230\n39\t40\t231\t231\n39\t40\t232\t232\n39\t40\t233\t233\n39\t40\t234\t234\n39\t40\t235\t235\n39\t40\t236\t236\n39\t40\t237\t237\n39\t40\t238\t238\n39\t40\t239\t239\n39\t40\t240\t240\n39\t40\t241\t241\n39\t40\t242\t242\n39\t40\t243\t243\n39\t40\t244\t244\n39\t40\t245\t245\n39\t40\t246\t246\n39\t40\t247\t247\n39\t40\t248\t248\n39\t40\t249\t249\n39\t40\t250\t250\n39\t40\t251\t251\n39\t40\t252\t252\n39\t40\t253\t253\n39\t40\t254\t254\n39\t40\t255\t255\n40\t41\t0\t0\n40\t41\t1\t1\n40\t41\t2\t2\n40\t41\t3\t3\n40\t41\t4\t4\n40\t41\t5\t5\n40\t41\t6\t6\n40\t41\t7\t7\n40\t41\t8\t8\n40\t41\t9\t9\n40\t41\t10\t10\n40\t41\t11\t11\n40\t41\t12\t12\n40\t41\t13\t13\n40\t41\t14\t14\n40\t41\t15\t15\n40\t41\t16\t16\n40\t41\t17\t17\n40\t41\t18\t18\n40\t41\t19\t19\n40\t41\t20\t20\n40\t41\t21\t21\n40\t41\t22\t22\n40\t41\t23\t23\n40\t41\t24\t24\n40\t41\t25\t25\n40\t41\t26\t26\n40\t41\t27\t27\n40\t41\t28\t28\n40\t41\t29\t29\n40\t41\t30\t30\n40\t41\t31\t31\n40\t41\t32\t32\n40\t41\t33\t33\n40\t41\t34\t34\n40\t41\t35\t35\n40\t41\t36\t36\n40\t41\t37\t37\n40\t41\t38\t38\n40\t41\t39\t39\n40\t41\t40\t40\n40\t41\t41\t41\n40\t41\t42\t42\n40\t41\t43\t43\n40\t41\t44\t44\n40\t41\t45\t45\n40\t41\t46\t46\n40\t41\t47\t47\n40\t41\t48\t48\n40\t41\t49\t49\n40\t41\t50\t50\n40\t41\t51\t51\n40\t41\t52\t52\n40\t41\t53\t53\n40\t41\t54\t54\n40\t41\t55\t55\n40\t41\t56\t56\n40\t41\t57\t57\n40\t41\t58\t58\n40\t41\t59\t59\n40\t41\t60\t60\n40\t41\t61\t61\n40\t41\t62\t62\n40\t41\t63\t63\n40\t41\t64\t64\n40\t41\t65\t65\n40\t41\t66\t66\n40\t41\t67\t67\n40\t41\t68\t68\n40\t41\t69\t69\n40\t41\t70\t70\n40\t41\t71\t71\n40\t41\t72\t72\n40\t41\t73\t73\n40\t41\t74\t74\n40\t41\t75\t75\n40\t41\t76\t76\n40\t41\t77\t77\n40\t41\t78\t78\n40\t41\t79\t79\n40\t41\t80\t80\n40\t41\t81\t81\n40\t41\t82\t82\n40\t41\t83\t83\n40\t41\t84\t84\n40\t41\t85\t85\n40\t41\t86\t86\n40\t41\t87\t87\n40\t41\t88\t88\n40\t41\t89\t89\n40\t41\t90\t90\n40\t41\t91\t91\n40\t41\t92\t92\n40\t41\t93\t93\n40\t41\t94\t94\n40\t41\t95\t95\n40\t41\t96\t96\n40\t41\t97\t97\n40\t41\t98\t98\n40\t41\t99\t99\n40\t41\t100\t100\n40\t41\t101\t101\n40\t41\t102\t102\n40\t41\t103\t103\n40\t41\t104\t104\n40\t41\t105\t105\n40\t41\t106\t106\n40\t41\t107\t107\n40\t41\t108\t108\n40\t41\t109\t109\n40\t41\t110\t110\n40\t41\t111\t111\n40\t41\t112\t112\n40\t41\t113\t113\n40\t41\t114\t114\n40\t41\t115\t115\n40\t41\t116\t116\n40\t41\t117\t117\n40\t41\t118\t118\n40\t41\t119\t119\n40\t41\t120\t120\n40\t41\t121\t121\n40\t41\t122\t122\n40\t41\t123\t123\n40\t41\t124\t124\n40\t41\t125\t125\n40\t41\t126\t126\n40\t41\t127\t127\n40\t41\t128\t128\n40\t41\t129\t129\n40\t41\t130\t130\n40\t41\t131\t131\n40\t41\t132\t132\n40\t41\t133\t133\n40\t41\t134\t134\n40\t41\t135\t135\n40\t41\t136\t136\n40\t41\t137\t137\n40\t41\t138\t138\n40\t41\t139\t139\n40\t41\t140\t140\n40\t41\t141\t141\n40\t41\t142\t142\n40\t41\t143\t143\n40\t41\t144\t144\n40\t41\t145\t145\n40\t41\t146\t146\n40\t41\t147\t147\n40\t41\t148\t148\n40\t41\t149\t149\n40\t41\t150\t150\n40\t41\t151\t151\n40\t41\t152\t152\n40\t41\t153\t153\n40\t41\t154\t154\n40\t41\t155\t155\n40\t41\t156\t156\n40\t41\t157\t157\n40\t41\t158\t158\n40\t41\t159\t159\n40\t41\t160\t160\n40\t41\t161\t161\n40\t41\t162\t162\n40\t41\t163\t163\n40\t41\t164\t164\n40\t41\t165\t165\n40\t41\t166\t166\n40\t41\t167\t167\n40\t41\t168\t168\n40\t41\t169\t169\n40\t41\t170\t170\n40\t41\t171\t171\n40\t41\t172\t172\n40\t41\t173\t173\n40\t41\t174\t174\n40\t41\t175\t175\n40\t41\t176\t176\n40\t41\t177\t177\n40\t41\t178\t178\n40\t41\t179\t179\n40\t41\t180\t180\n40\t41\t181\t181\n40\t41\t182\t182\n40\t41\t183\t183\n40\t41\t184\t184\n40\t41\t185\t185\n40\t41\t186\t186\n40\t41\t187\t187\n40\t41\t188\t188\n40\t41\t189\t189\n40\t41\t190\t190\n40\t41\t191\t191\n40\t41\t192\t192\n40\t41\t193\t193\n40\t41\t194\t194\n40\t41\t195\t195\n40\t41\t196\t196\n40\t41\t197\t197\n40\t41\t198\t198\n40\t41\t199\t199\n40\t41\t200\t200\n40\t41\t201\t201\n40\t41\t202\t202\n40\t41\t203\t203\n40\t41\t204\t204\n40\t41\t205\t205\n40\t41\t206\t206\n40\t41\t207\t207\n40\t41\t208\t208\n40\t41\t209\t209\n40\t41\t210\t210\n40\t41\t211\t211\n40\t41\t212\t212\n40\t41\t213\t213\n40\t41\t214\t214\n40\t41\t215\t215\n40\t41\t216\t216\n40\t41\t217\t217\n40\t41\t218\t218\n40\t41\t219\t219\n40\t41\t220\t220\n40\t41\t221\t221\n40\t41\t222\t222\n40\t41\t223\t223\n40\t41\t224\t224\n40\t41\t225\t225\n40\t41\t226\t226\n40\t41\t227\t227\n40\t41\t228\t228\n40\t41\t229\t229\n40\t41\t230\t230\n40\t41\t231\t231\n40\t41\t232\t232\n40\t41\t233\t233\n40\t41\t234\t234\n40\t41\t235\t235\n40\t41\t236\t236\n40\t41\t237\t237\n40\t41\t238\t238\n40\t41\t239\t239\n40\t41\t240\t240\n40\t41\t241\t241\n40\t41\t242\t242\n40\t41\t243\t243\n40\t41\t244\t244\n40\t41\t245\t245\n40\t41\t246\t246\n40\t41\t247\t247\n40\t41\t248\t248\n40\t41\t249\t249\n40\t41\t250\t250\n40\t41\t251\t251\n40\t41\t252\t252\n40\t41\t253\t253\n40\t41\t254\t254\n40\t41\t255\t255\n41\t42\t0\t0\n41\t42\t1\t1\n41\t42\t2\t2\n41\t42\t3\t3\n41\t42\t4\t4\n41\t42\t5\t5\n41\t42\t6\t6\n41\t42\t7\t7\n41\t42\t8\t8\n41\t42\t9\t9\n41\t42\t10\t10\n41\t42\t11\t11\n41\t42\t12\t12\n41\t42\t13\t13\n41\t42\t14\t14\n41\t42\t15\t15\n41\t42\t16\t16\n41\t42\t17\t17\n41\t42\t18\t18\n41\t42\t19\t19\n41\t42\t20\t20\n41\t42\t21\t21\n41\t42\t22\t22\n41\t42\t23\t23\n41\t42\t24\t24\n41\t42\t25\t25\n41\t42\t26\t26\n41\t42\t27\t27\n41\t42\t28\t28\n41\t42\t29\t29\n41\t42\t30\t30\n41\t42\t31\t31\n41\t42\t32\t32\n41\t42\t33\t33\n41\t42\t34\t34\n41\t42\t35\t35\n41\t42\t36\t36\n41\t42\t37\t37\n41\t42\t38\t38\n41\t42\t39\t39\n41\t42\t40\t40\n41\t42\t41\t41\n41\t42\t42\t42\n41\t42\t43\t43\n41\t42\t44\t44\n41\t42\t45\t45\n41\t42\t46\t46\n41\t42\t47\t47\n41\t42\t48\t48\n41\t42\t49\t49\n41\t42\t50\t50\n41\t42\t51\t51\n41\t42\t52\t52\n41\t42\t53\t53\n41\t42\t54\t54\n41\t42\t55\t55\n41\t42\t56\t56\n41\t42\t57\t57\n41\t42\t58\t58\n41\t42\t59\t59\n41\t42\t60\t60\n41\t42\t61\t61\n41\t42\t62\t62\n41\t42\t63\t63\n41\t42\t64\t64\n41\t42\t65\t65\n41\t42\t66\t66\n41\t42\t67\t67\n41\t42\t68\t68\n41\t42\t69\t69\n41\t42\t70\t70\n41\t42\t71\t71\n41\t42\t72\t72\n41\t42\t73\t73\n41\t42\t74\t74\n41\t42\t75\t75\n41\t42\t76\t76\n41\t42\t77\t77\n41\t42\t78\t78\n41\t42\t79\t79\n41\t42\t80\t80\n41\t42\t81\t81\n41\t42\t82\t82\n41\t42\t83\t83\n41\t42\t84\t84\n41\t42\t85\t85\n41\t42\t86\t86\n41\t42\t87\t87\n41\t42\t88\t88\n41\t42\t89\t89\n41\t42\t90\t90\n41\t42\t91\t91\n41\t42\t92\t92\n41\t42\t93\t93\n41\t42\t94\t94\n41\t42\t95\t95\n41\t42\t96\t96\n41\t42\t97\t97\n41\t42\t98\t98\n41\t42\t99\t99\n41\t42\t100\t100\n41\t42\t101\t101\n41\t42\t102\t102\n41\t42\t103\t103\n41\t42\t104\t104\n41\t42\t105\t105\n41\t42\t106\t106\n41\t42\t107\t107\n41\t42\t108\t108\n41\t42\t109\t109\n41\t42\t110\t110\n41\t42\t111\t111\n41\t42\t112\t112\n41\t42\t113\t113\n41\t42\t114\t114\n41\t42\t115\t115\n41\t42\t116\t116\n41\t42\t117\t117\n41\t42\t118\t118\n41\t42\t119\t119\n41\t42\t120\t120\n41\t42\t121\t121\n41\t42\t122\t122\n41\t42\t123\t123\n41\t42\t124\t124\n41\t42\t125\t125\n41\t42\t126\t126\n41\t42\t127\t127\n41\t42\t128\t128\n41\t42\t129\t129\n41\t42\t130\t130\n41\t42\t131\t131\n41\t42\t132\t132\n41\t42\t133\t133\n41\t42\t134\t134\n41\t42\t135\t135\n41\t42\t136\t136\n41\t42\t137\t137\n41\t42\t138\t138\n41\t42\t139\t139\n41\t42\t140\t140\n41\t42\t141\t141\n41\t42\t142\t142\n41\t42\t143\t143\n41\t42\t144\t144\n41\t42\t145\t145\n41\t42\t146\t146\n41\t42\t147\t147\n41\t42\t148\t148\n41\t42\t149\t149\n41\t42\t150\t150\n41\t42\t151\t151\n41\t42\t152\t152\n41\t42\t153\t153\n41\t42\t154\t154\n41\t42\t155\t155\n41\t42\t156\t156\n41\t42\t157\t157\n41\t42\t158\t158\n41\t42\t159\t159\n41\t42\t160\t160\n41\t42\t161\t161\n41\t42\t162\t162\n41\t42\t163\t163\n41\t42\t164\t164\n41\t42\t165\t165\n41\t42\t166\t166\n41\t42\t167\t167\n41\t42\t168\t168\n41\t42\t169\t169\n41\t42\t170\t170\n41\t42\t171\t171\n41\t42\t172\t172\n41\t42\t173\t173\n41\t42\t174\t174\n41\t42\t175\t175\n41\t42\t176\t176\n41\t42\t177\t177\n41\t42\t178\t178\n41\t42\t179\t179\n41\t42\t180\t180\n41\t42\t181\t181\n41\t42\t182\t182\n41\t42\t183\t183\n41\t42\t184\t184\n41\t42\t185\t185\n41\t42\t186\t186\n41\t42\t187\t187\n41\t42\t188\t188\n41\t42\t189\t189\n41\t42\t190\t190\n41\t42\t191\t191\n41\t42\t192\t192\n41\t42\t193\t193\n41\t42\t194\t194\n41\t42\t195\t195\n41\t42\t196\t196\n41\t42\t197\t197\n41\t42\t198\t198\n41\t42\t199\t199\n41\t42\t200\t200\n41\t42\t201\t201\n41\t42\t202\t202\n41\t42\t203\t203\n41\t42\t204\t204\n41\t42\t205\t205\n41\t42\t206\t206\n41\t42\t207\t207\n41\t42\t208\t208\n41\t42\t209\t209\n41\t42\t210\t210\n41\t42\t211\t211\n41\t42\t212\t212\n41\t42\t213\t213\n41\t42\t214\t214\n41\t42\t215\t215\n41\t42\t216\t216\n41\t42\t217\t217\n41\t42\t218\t218\n41\t42\t219\t219\n41\t42\t220\t220\n41\t42\t221\t221\n41\t42\t222\t222\n41\t42\t223\t223\n41\t42\t224\t224\n41\t42\t225\t225\n41\t42\t226\t226\n41\t42\t227\t227\n41\t42\t228\t228\n41\t42\t229\t229\n41\t42\t230\t230\n41\t42\t231\t231\n41\t42\t232\t232\n41\t42\t233\t233\n41\t42\t234\t234\n41\t42\t235\t235\n41\t42\t236\t236\n41\t42\t237\t237\n41\t42\t238\t238\n41\t42\t239\t239\n41\t42\t240\t240\n41\t42\t241\t241\n41\t42\t242\t242\n41\t42\t243\t243\n41\t42\t244\t244\n41\t42\t245\t245\n41\t42\t246\t246\n41\t42\t247\t247\n41\t42\t248\t248\n41\t42\t249\t249\n41\t42\t250\t250\n41\t42\t251\t251\n41\t42\t252\t252\n41\t42\t253\t253\n41\t42\t254\t254\n41\t42\t255\t255\n42\t43\t0\t0\n42\t43\t1\t1\n42\t43\t2\t2\n42\t43\t3\t3\n42\t43\t4\t4\n42\t43\t5\t5\n42\t43\t6\t6\n42\t43\t7\t7\n42\t43\t8\t8\n42\t43\t9\t9\n42\t43\t10\t10\n42\t43\t11\t11\n42\t43\t12\t12\n42\t43\t13\t13\n42\t43\t14\t14\n42\t43\t15\t15\n42\t43\t16\t16\n42\t43\t17\t17\n42\t43\t18\t18\n42\t43\t19\t19\n42\t43\t20\t20\n42\t43\t21\t21\n42\t43\t22\t22\n42\t43\t23\t23\n42\t43\t24\t24\n42\t43\t25\t25\n42\t43\t26\t26\n42\t43\t27\t27\n42\t43\t28\t28\n42\t43\t29\t29\n42\t43\t30\t30\n42\t43\t31\t31\n42\t43\t32\t32\n42\t43\t33\t33\n42\t43\t34\t34\n42\t43\t35\t35\n42\t43\t36\t36\n42\t43\t37\t37\n42\t43\t38\t38\n42\t43\t39\t39\n42\t43\t40\t40\n42\t43\t41\t41\n42\t43\t42\t42\n42\t43\t43\t43\n42\t43\t44\t44\n42\t43\t45\t45\n42\t43\t46\t46\n42\t43\t47\t47\n42\t43\t48\t48\n42\t43\t49\t49\n42\t43\t50\t50\n42\t43\t51\t51\n42\t43\t52\t52\n42\t43\t53\t53\n42\t43\t54\t54\n42\t43\t55\t55\n42\t43\t56\t56\n42\t43\t57\t57\n42\t43\t58\t58\n42\t43\t59\t59\n42\t43\t60\t60\n42\t43\t61\t61\n42\t43\t62\t62\n42\t43\t63\t63\n42\t43\t64\t64\n42\t43\t65\t65\n42\t43\t66\t66\n42\t43\t67\t67\n42\t43\t68\t68\n42\t43\t69\t69\n42\t43\t70\t70\n42\t43\t71\t71\n42\t43\t72\t72\n42\t43\t73\t73\n42\t43\t74\t74\n42\t43\t75\t75\n42\t43\t76\t76\n42\t43\t77\t77\n42\t43\t78\t78\n42\t43\t79\t79\n42\t43\t80\t80\n42\t43\t81\t81\n42\t43\t82\t82\n42\t43\t83\t83\n42\t43\t84\t84\n42\t43\t85\t85\n42\t43\t86\t86\n42\t43\t87\t87\n42\t43\t88\t88\n42\t43\t89\t89\n42\t43\t90\t90\n42\t43\t91\t91\n42\t43\t92\t92\n42\t43\t93\t93\n42\t43\t94\t94\n42\t43\t95\t95\n42\t43\t96\t96\n42\t43\t97\t97\n42\t43\t98\t98\n42\t43\t99\t99\n42\t43\t100\t100\n42\t43\t101\t101\n42\t43\t102\t102\n42\t43\t103\t103\n42\t43\t104\t104\n42\t43\t105\t105\n42\t43\t106\t106\n42\t43\t107\t107\n42\t43\t108\t108\n42\t43\t109\t109\n42\t43\t110\t110\n42\t43\t111\t111\n42\t43\t112\t112\n42\t43\t113\t113\n42\t43\t114\t114\n42\t43\t115\t115\n42\t43\t116\t116\n42\t43\t117\t117\n42\t43\t118\t118\n42\t43\t119\t119\n42\t43\t120\t120\n42\t43\t121\t121\n42\t43\t122\t122\n42\t43\t123\t123\n42\t43\t124\t124\n42\t43\t125\t125\n42\t43\t126\t126\n42\t43\t127\t127\n42\t43\t128\t128\n42\t43\t129\t129\n42\t43\t130\t130\n42\t43\t131\t131\n42\t43\t132\t132\n42\t43\t133\t133\n42\t43\t134\t134\n42\t43\t135\t135\n42\t43\t136\t136\n42\t43\t137\t137\n42\t43\t138\t138\n42\t43\t139\t139\n42\t43\t140\t140\n42\t43\t141\t141\n42\t43\t142\t142\n42\t43\t143\t143\n42\t43\t144\t144\n42\t43\t145\t145\n42\t43\t146\t146\n42\t43\t147\t147\n42\t43\t148\t148\n42\t43\t149\t149\n42\t43\t150\t150\n42\t43\t151\t151\n42\t43\t152\t152\n42\t43\t153\t153\n42\t43\t154\t154\n42\t43\t155\t155\n42\t43\t156\t156\n42\t43\t157\t157\n42\t43\t158\t158\n42\t43\t159\t159\n42\t43\t160\t160\n42\t43\t161\t161\n42\t43\t162\t162\n42\t43\t163\t163\n42\t43\t164\t164\n42\t43\t165\t165\n42\t43\t166\t166\n42\t43\t167\t167\n42\t43\t168\t168\n42\t43\t169\t169\n42\t43\t170\t170\n42\t43\t171\t171\n42\t43\t172\t172\n42\t43\t173\t173\n42\t43\t174\t174\n42\t43\t175\t175\n42\t43\t176\t176\n42\t43\t177\t177\n42\t43\t178\t178\n42\t43\t179\t179\n42\t43\t180\t180\n42\t43\t181\t181\n42\t43\t182\t182\n42\t43\t183\t183\n42\t43\t184\t184\n42\t43\t185\t185\n42\t43\t186\t186\n42\t43\t187\t187\n42\t43\t188\t188\n42\t43\t189\t189\n42\t43\t190\t190\n42\t43\t191\t191\n42\t43\t192\t192\n42\t43\t193\t193\n42\t43\t194\t194\n42\t43\t195\t195\n42\t43\t196\t196\n42\t43\t197\t197\n42\t43\t198\t198\n42\t43\t199\t199\n42\t43\t200\t200\n42\t43\t201\t201\n42\t43\t202\t202\n42\t43\t203\t203\n42\t43\t204\t204\n42\t43\t205\t205\n42\t43\t206\t206\n42\t43\t207\t207\n42\t43\t208\t208\n42\t43\t209\t209\n42\t43\t210\t210\n42\t43\t211\t211\n42\t43\t212\t212\n42\t43\t213\t213\n42\t43\t214\t214\n42\t43\t215\t215\n42\t43\t216\t216\n42\t43\t217\t217\n42\t43\t218\t218\n42\t43\t219\t219\n42\t43\t220\t220\n42\t43\t221\t221\n42\t43\t222\t222\n42\t43\t223\t223\n42\t43\t224\t224\n42\t43\t225\t225\n42\t43\t226\t226\n42\t43\t227\t227\n42\t43\t228\t228\n42\t43\t229\t229\n42\t43\t230\t230\n42\t43\t231\t231\n42\t43\t232\t232\n42\t43\t233\t233\n42\t43\t234\t234\n42\t43\t235\t235\n42\t43\t236\t236\n42\t43\t237\t237\n42\t43\t238\t238\n42\t43\t239\t239\n42\t43\t240\t240\n42\t43\t241\t241\n42\t43\t242\t242\n42\t43\t243\t243\n42\t43\t244\t244\n42\t43\t245\t245\n42\t43\t246\t246\n42\t43\t247\t247\n42\t43\t248\t248\n42\t43\t249\t249\n42\t43\t250\t250\n42\t43\t251\t251\n42\t43\t252\t252\n42\t43\t253\t253\n42\t43\t254\t254\n42\t43\t255\t255\n43\t44\t0\t0\n43\t44\t1\t1\n43\t44\t2\t2\n43\t44\t3\t3\n43\t44\t4\t4\n43\t44\t5\t5\n43\t44\t6\t6\n43\t44\t7\t7\n43\t44\t8\t8\n43\t44\t9\t9\n43\t44\t10\t10\n43\t44\t11\t11\n43\t44\t12\t12\n43\t44\t13\t13\n43\t44\t14\t14\n43\t44\t15\t15\n43\t44\t16\t16\n43\t44\t17\t17\n43\t44\t18\t18\n43\t44\t19\t19\n43\t44\t20\t20\n43\t44\t21\t21\n43\t44\t22\t22\n43\t44\t23\t23\n43\t44\t24\t24\n43\t44\t25\t25\n43\t44\t26\t26\n43\t44\t27\t27\n43\t44\t28\t28\n43\t44\t29\t29\n43\t44\t30\t30\n43\t44\t31\t31\n43\t44\t32\t32\n43\t44\t33\t33\n43\t44\t34\t34\n43\t44\t35\t35\n43\t44\t36\t36\n43\t44\t37\t37\n43\t44\t38\t38\n43\t44\t39\t39\n43\t44\t40\t40\n43\t44\t41\t41\n43\t44\t42\t42\n43\t44\t43\t43\n43\t44\t44\t44\n43\t44\t45\t45\n43\t44\t46\t46\n43\t44\t47\t47\n43\t44\t48\t48\n43\t44\t49\t49\n43\t44\t50\t50\n43\t44\t51\t51\n43\t44\t52\t52\n43\t44\t53\t53\n43\t44\t54\t54\n43\t44\t55\t55\n43\t44\t56\t56\n43\t44\t57\t57\n43\t44\t58\t58\n43\t44\t59\t59\n43\t44\t60\t60\n43\t44\t61\t61\n43\t44\t62\t62\n43\t44\t63\t63\n43\t44\t64\t64\n43\t44\t65\t65\n43\t44\t66\t66\n43\t44\t67\t67\n43\t44\t68\t68\n43\t44\t69\t69\n43\t44\t70\t70\n43\t44\t71\t71\n43\t44\t72\t72\n43\t44\t73\t73\n43\t44\t74\t74\n43\t44\t75\t75\n43\t44\t76\t76\n43\t44\t77\t77\n43\t44\t78\t78\n43\t44\t79\t79\n43\t44\t80\t80\n43\t44\t81\t81\n43\t44\t82\t82\n43\t44\t83\t83\n43\t44\t84\t84\n43\t44\t85\t85\n43\t44\t86\t86\n43\t44\t87\t87\n43\t44\t88\t88\n43\t44\t89\t89\n43\t44\t90\t90\n43\t44\t91\t91\n43\t44\t92\t92\n43\t44\t93\t93\n43\t44\t94\t94\n43\t44\t95\t95\n43\t44\t96\t96\n43\t44\t97\t97\n43\t44\t98\t98\n43\t44\t99\t99\n43\t44\t100\t100\n43\t44\t101\t101\n43\t44\t102\t102\n43\t44\t103\t103\n43\t44\t104\t104\n43\t44\t105\t105\n43\t44\t106\t106\n43\t44\t107\t107\n43\t44\t108\t108\n43\t44\t109\t109\n43\t44\t110\t110\n43\t44\t111\t111\n43\t44\t112\t112\n43\t44\t113\t113\n43\t44\t114\t114\n43\t44\t115\t115\n43\t44\t116\t116\n43\t44\t117\t117\n43\t44\t118\t118\n43\t44\t119\t119\n43\t44\t120\t120\n43\t44\t121\t121\n43\t44\t122\t122\n43\t44\t123\t123\n43\t44\t124\t124\n43\t44\t125\t125\n43\t44\t126\t126\n43\t44\t127\t127\n43\t44\t128\t128\n43\t44\t129\t129\n43\t44\t130\t130\n43\t44\t131\t131\n43\t44\t132\t132\n43\t44\t133\t133\n43\t44\t134\t134\n43\t44\t135\t135\n43\t44\t136\t136\n43\t44\t137\t137\n43\t44\t138\t138\n43\t44\t139\t139\n43\t44\t140\t140\n43\t44\t141\t141\n43\t44\t142\t142\n43\t44\t143\t143\n43\t44\t144\t144\n43\t44\t145\t145\n43\t44\t146\t146\n43\t44\t147\t147\n43\t44\t148\t148\n43\t44\t149\t149\n43\t44\t150\t150\n43\t44\t151\t151\n43\t44\t152\t152\n43\t44\t153\t153\n43\t44\t154\t154\n43\t44\t155\t155\n43\t44\t156\t156\n43\t44\t157\t157\n43\t44\t158\t158\n43\t44\t159\t159\n43\t44\t160\t160\n43\t44\t161\t161\n43\t44\t162\t162\n43\t44\t163\t163\n43\t44\t164\t164\n43\t44\t165\t165\n43\t44\t166\t166\n43\t44\t167\t167\n43\t44\t168\t168\n43\t44\t169\t169\n43\t44\t170\t170\n43\t44\t171\t171\n43\t44\t172\t172\n43\t44\t173\t173\n43\t44\t174\t174\n43\t44\t175\t175\n43\t44\t176\t176\n43\t44\t177\t177\n43\t44\t178\t178\n43\t44\t179\t179\n43\t44\t180\t180\n43\t44\t181\t181\n43\t44\t182\t182\n43\t44\t183\t183\n43\t44\t184\t184\n43\t44\t185\t185\n43\t44\t186\t186\n43\t44\t187\t187\n43\t44\t188\t188\n43\t44\t189\t189\n43\t44\t190\t190\n43\t44\t191\t191\n43\t44\t192\t192\n43\t44\t193\t193\n43\t44\t194\t194\n43\t44\t195\t195\n43\t44\t196\t196\n43\t44\t197\t197\n43\t44\t198\t198\n43\t44\t199\t199\n43\t44\t200\t200\n43\t44\t201\t201\n43\t44\t202\t202\n43\t44\t203\t203\n43\t44\t204\t204\n43\t44\t205\t205\n43\t44\t206\t206\n43\t44\t207\t207\n43\t44\t208\t208\n43\t44\t209\t209\n43\t44\t210\t210\n43\t44\t211\t211\n43\t44\t212\t212\n43\t44\t213\t213\n43\t44\t214\t214\n43\t44\t215\t215\n43\t44\t216\t216\n43\t44\t217\t217\n43\t44\t218\t218\n43\t44\t219\t219\n43\t44\t220\t220\n43\t44\t221\t221\n43\t44\t222\t222\n43\t44\t223\t223\n43\t44\t224\t224\n43\t44\t225\t225\n43\t44\t226\t226\n43\t44\t227\t227\n43\t44\t228\t228\n43\t44\t229\t229\n43\t44\t230\t230\n43\t44\t231\t231\n43\t44\t232\t232\n43\t44\t233\t233\n43\t44\t234\t234\n43\t44\t235\t235\n43\t44\t236\t236\n43\t44\t237\t237\n43\t44\t238\t238\n43\t44\t239\t239\n43\t44\t240\t240\n43\t44\t241\t241\n43\t44\t242\t242\n43\t44\t243\t243\n43\t44\t244\t244\n43\t44\t245\t245\n43\t44\t246\t246\n43\t44\t247\t247\n43\t44\t248\t248\n43\t44\t249\t249\n43\t44\t250\t250\n43\t44\t251\t251\n43\t44\t252\t252\n43\t44\t253\t253\n43\t44\t254\t254\n43\t44\t255\t255\n44\t45\t0\t0\n44\t45\t1\t1\n44\t45\t2\t2\n44\t45\t3\t3\n44\t45\t4\t4\n44\t45\t5\t5\n44\t45\t6\t6\n44\t45\t7\t7\n44\t45\t8\t8\n44\t45\t9\t9\n44\t45\t10\t10\n44\t45\t11\t11\n44\t45\t12\t12\n44\t45\t13\t13\n44\t45\t14\t14\n44\t45\t15\t15\n44\t45\t16\t16\n44\t45\t17\t17\n44\t45\t18\t18\n44\t45\t19\t19\n44\t45\t20\t20\n44\t45\t21\t21\n44\t45\t22\t22\n44\t45\t23\t23\n44\t45\t24\t24\n44\t45\t25\t25\n44\t45\t26\t26\n44\t45\t27\t27\n44\t45\t28\t28\n44\t45\t29\t29\n44\t45\t30\t30\n44\t45\t31\t31\n44\t45\t32\t32\n44\t45\t33\t33\n44\t45\t34\t34\n44\t45\t35\t35\n44\t45\t36\t36\n44\t45\t37\t37\n44\t45\t38\t38\n44\t45\t39\t39\n44\t45\t40\t40\n44\t45\t41\t41\n44\t45\t42\t42\n44\t45\t43\t43\n44\t45\t44\t44\n44\t45\t45\t45\n44\t45\t46\t46\n44\t45\t47\t47\n44\t45\t48\t48\n44\t45\t49\t49\n44\t45\t50\t50\n44\t45\t51\t51\n44\t45\t52\t52\n44\t45\t53\t53\n44\t45\t54\t54\n44\t45\t55\t55\n44\t45\t56\t56\n44\t45\t57\t57\n44\t45\t58\t58\n44\t45\t59\t59\n44\t45\t60\t60\n44\t45\t61\t61\n44\t45\t62\t62\n44\t45\t63\t63\n44\t45\t64\t64\n44\t45\t65\t65\n44\t45\t66\t66\n44\t45\t67\t67\n44\t45\t68\t68\n44\t45\t69\t69\n44\t45\t70\t70\n44\t45\t71\t71\n44\t45\t72\t72\n44\t45\t73\t73\n44\t45\t74\t74\n44\t45\t75\t75\n44\t45\t76\t76\n44\t45\t77\t77\n44\t45\t78\t78\n44\t45\t79\t79\n44\t45\t80\t80\n44\t45\t81\t81\n44\t45\t82\t82\n44\t45\t83\t83\n44\t45\t84\t84\n44\t45\t85\t85\n44\t45\t86\t86\n44\t45\t87\t87\n44\t45\t88\t88\n44\t45\t89\t89\n44\t45\t90\t90\n44\t45\t91\t91\n44\t45\t92\t92\n44\t45\t93\t93\n44\t45\t94\t94\n44\t45\t95\t95\n44\t45\t96\t96\n44\t45\t97\t97\n44\t45\t98\t98\n44\t45\t99\t99\n44\t45\t100\t100\n44\t45\t101\t101\n44\t45\t102\t102\n44\t45\t103\t103\n44\t45\t104\t104\n44\t45\t105\t105\n44\t45\t106\t106\n44\t45\t107\t107\n44\t45\t108\t108\n44\t45\t109\t109\n44\t45\t110\t110\n44\t45\t111\t111\n44\t45\t112\t112\n44\t45\t113\t113\n44\t45\t114\t114\n44\t45\t115\t115\n44\t45\t116\t116\n44\t45\t117\t117\n44\t45\t118\t118\n44\t45\t119\t119\n44\t45\t120\t120\n44\t45\t121\t121\n44\t45\t122\t122\n44\t45\t123\t123\n44\t45\t124\t124\n44\t45\t125\t125\n44\t45\t126\t126\n44\t45\t127\t127\n44\t45\t128\t128\n44\t45\t129\t129\n44\t45\t130\t130\n44\t45\t131\t131\n44\t45\t132\t132\n44\t45\t133\t133\n44\t45\t134\t134\n44\t45\t135\t135\n44\t45\t136\t136\n44\t45\t137\t137\n44\t45\t138\t138\n44\t45\t139\t139\n44\t45\t140\t140\n44\t45\t141\t141\n44\t45\t142\t142\n44\t45\t143\t143\n44\t45\t144\t144\n44\t45\t145\t145\n44\t45\t146\t146\n44\t45\t147\t147\n44\t45\t148\t148\n44\t45\t149\t149\n44\t45\t150\t150\n44\t45\t151\t151\n44\t45\t152\t152\n44\t45\t153\t153\n44\t45\t154\t154\n44\t45\t155\t155\n44\t45\t156\t156\n44\t45\t157\t157\n44\t45\t158\t158\n44\t45\t159\t159\n44\t45\t160\t160\n44\t45\t161\t161\n44\t45\t162\t162\n44\t45\t163\t163\n44\t45\t164\t164\n44\t45\t165\t165\n44\t45\t166\t166\n44\t45\t167\t167\n44\t45\t168\t168\n44\t45\t169\t169\n44\t45\t170\t170\n44\t45\t171\t171\n44\t45\t172\t172\n44\t45\t173\t173\n44\t45\t174\t174\n44\t45\t175\t175\n44\t45\t176\t176\n44\t45\t177\t177\n44\t45\t178\t178\n44\t45\t179\t179\n44\t45\t180\t180\n44\t45\t181\t181\n44\t45\t182\t182\n44\t45\t183\t183\n44\t45\t184\t184\n44\t45\t185\t185\n44\t45\t186\t186\n44\t45\t187\t187\n44\t45\t188\t188\n44\t45\t189\t189\n44\t45\t190\t190\n44\t45\t191\t191\n44\t45\t192\t192\n44\t45\t193\t193\n44\t45\t194\t194\n44\t45\t195\t195\n44\t45\t196\t196\n44\t45\t197\t197\n44\t45\t198\t198\n44\t45\t199\t199\n44\t45\t200\t200\n44\t45\t201\t201\n44\t45\t202\t202\n44\t45\t203\t203\n44\t45\t204\t204\n44\t45\t205\t205\n44\t45\t206\t206\n44\t45\t207\t207\n44\t45\t208\t208\n44\t45\t209\t209\n44\t45\t210\t210\n44\t45\t211\t211\n44\t45\t212\t212\n44\t45\t213\t213\n44\t45\t214\t214\n44\t45\t215\t215\n44\t45\t216\t216\n44\t45\t217\t217\n44\t45\t218\t218\n44\t45\t219\t219\n44\t45\t220\t220\n44\t45\t221\t221\n44\t45\t222\t222\n44\t45\t223\t223\n44\t45\t224\t224\n44\t45\t225\t225\n44\t45\t226\t226\n44\t45\t227\t227\n44\t45\t228\t228\n44\t45\t229\t229\n44\t45\t230\t230\n44\t45\t231\t231\n44\t45\t232\t232\n44\t45\t233\t233\n44\t45\t234\t234\n44\t45\t235\t235\n44\t45\t236\t236\n44\t45\t237\t237\n44\t45\t238\t238\n44\t45\t239\t239\n44\t45\t240\t240\n44\t45\t241\t241\n44\t45\t242\t242\n44\t45\t243\t243\n44\t45\t244\t244\n44\t45\t245\t245\n44\t45\t246\t246\n44\t45\t247\t247\n44\t45\t248\t248\n44\t45\t249\t249\n44\t45\t250\t250\n44\t45\t251\t251\n44\t45\t252\t252\n44\t45\t253\t253\n44\t45\t254\t254\n44\t45\t255\t255\n45\t46\t0\t0\n45\t46\t1\t1\n45\t46\t2\t2\n45\t46\t3\t3\n45\t46\t4\t4\n45\t46\t5\t5\n45\t46\t6\t6\n45\t46\t7\t7\n45\t46\t8\t8\n45\t46\t9\t9\n45\t46\t10\t10\n45\t46\t11\t11\n45\t46\t12\t12\n45\t46\t13\t13\n45\t46\t14\t14\n45\t46\t15\t15\n45\t46\t16\t16\n45\t46\t17\t17\n45\t46\t18\t18\n45\t46\t19\t19\n45\t46\t20\t20\n45\t46\t21\t21\n45\t46\t22\t22\n45\t46\t23\t23\n45\t46\t24\t24\n45\t46\t25\t25\n45\t46\t26\t26\n45\t46\t27\t27\n45\t46\t28\t28\n45\t46\t29\t29\n45\t46\t30\t30\n45\t46\t31\t31\n45\t46\t32\t32\n45\t46\t33\t33\n45\t46\t34\t34\n45\t46\t35\t35\n45\t46\t36\t36\n45\t46\t37\t37\n45\t46\t38\t38\n45\t46\t39\t39\n45\t46\t40\t40\n45\t46\t41\t41\n45\t46\t42\t42\n45\t46\t43\t43\n45\t46\t44\t44\n45\t46\t45\t45\n45\t46\t46\t46\n45\t46\t47\t47\n45\t46\t48\t48\n45\t46\t49\t49\n45\t46\t50\t50\n45\t46\t51\t51\n45\t46\t52\t52\n45\t46\t53\t53\n45\t46\t54\t54\n45\t46\t55\t55\n45\t46\t56\t56\n45\t46\t57\t57\n45\t46\t58\t58\n45\t46\t59\t59\n45\t46\t60\t60\n45\t46\t61\t61\n45\t46\t62\t62\n45\t46\t63\t63\n45\t46\t64\t64\n45\t46\t65\t65\n45\t46\t66\t66\n45\t46\t67\t67\n45\t46\t68\t68\n45\t46\t69\t69\n45\t46\t70\t70\n45\t46\t71\t71\n45\t46\t72\t72\n45\t46\t73\t73\n45\t46\t74\t74\n45\t46\t75\t75\n45\t46\t76\t76\n45\t46\t77\t77\n45\t46\t78\t78\n45\t46\t79\t79\n45\t46\t80\t80\n45\t46\t81\t81\n45\t46\t82\t82\n45\t46\t83\t83\n45\t46\t84\t84\n45\t46\t85\t85\n45\t46\t86\t86\n45\t46\t87\t87\n45\t46\t88\t88\n45\t46\t89\t89\n45\t46\t90\t90\n45\t46\t91\t91\n45\t46\t92\t92\n45\t46\t93\t93\n45\t46\t94\t94\n45\t46\t95\t95\n45\t46\t96\t96\n45\t46\t97\t97\n45\t46\t98\t98\n45\t46\t99\t99\n45\t46\t100\t100\n45\t46\t101\t101\n45\t46\t102\t102\n45\t46\t103\t103\n45\t46\t104\t104\n45\t46\t105\t105\n45\t46\t106\t106\n45\t46\t107\t107\n45\t46\t108\t108\n45\t46\t109\t109\n45\t46\t110\t110\n45\t46\t111\t111\n45\t46\t112\t112\n45\t46\t113\t113\n45\t46\t114\t114\n45\t46\t115\t115\n45\t46\t116\t116\n45\t46\t117\t117\n45\t46\t118\t118\n45\t46\t119\t119\n45\t46\t120\t120\n45\t46\t121\t121\n45\t46\t122\t122\n45\t46\t123\t123\n45\t46\t124\t124\n45\t46\t125\t125\n45\t46\t126\t126\n45\t46\t127\t127\n45\t46\t128\t128\n45\t46\t129\t129\n45\t46\t130\t130\n45\t46\t131\t131\n45\t46\t132\t132\n45\t46\t133\t133\n45\t46\t134\t134\n45\t46\t135\t135\n45\t46\t136\t136\n45\t46\t137\t137\n45\t46\t138\t138\n45\t46\t139\t139\n45\t46\t140\t140\n45\t46\t141\t141\n45\t46\t142\t142\n45\t46\t143\t143\n45\t46\t144\t144\n45\t46\t145\t145\n45\t46\t146\t146\n45\t46\t147\t147\n45\t46\t148\t148\n45\t46\t149\t149\n45\t46\t150\t150\n45\t46\t151\t151\n45\t46\t152\t152\n45\t46\t153\t153\n45\t46\t154\t154\n45\t46\t155\t155\n45\t46\t156\t156\n45\t46\t157\t157\n45\t46\t158\t158\n45\t46\t159\t159\n45\t46\t160\t160\n45\t46\t161\t161\n45\t46\t162\t162\n45\t46\t163\t163\n45\t46\t164\t164\n45\t46\t165\t165\n45\t46\t166\t166\n45\t46\t167\t167\n45\t46\t168\t168\n45\t46\t169\t169\n45\t46\t170\t170\n45\t46\t171\t171\n45\t46\t172\t172\n45\t46\t173\t173\n45\t46\t174\t174\n45\t46\t175\t175\n45\t46\t176\t176\n45\t46\t177\t177\n45\t46\t178\t178\n45\t46\t179\t179\n45\t46\t180\t180\n45\t46\t181\t181\n45\t46\t182\t182\n45\t46\t183\t183\n45\t46\t184\t184\n45\t46\t185\t185\n45\t46\t186\t186\n45\t46\t187\t187\n45\t46\t188\t188\n45\t46\t189\t189\n45\t46\t190\t190\n45\t46\t191\t191\n45\t46\t192\t192\n45\t46\t193\t193\n45\t46\t194\t194\n45\t46\t195\t195\n45\t46\t196\t196\n45\t46\t197\t197\n45\t46\t198\t198\n45\t46\t199\t199\n45\t46\t200\t200\n45\t46\t201\t201\n45\t46\t202\t202\n45\t46\t203\t203\n45\t46\t204\t204\n45\t46\t205\t205\n45\t46\t206\t206\n45\t46\t207\t207\n45\t46\t208\t208\n45\t46\t209\t209\n45\t46\t210\t210\n45\t46\t211\t211\n45\t46\t212\t212\n45\t46\t213\t213\n45\t46\t214\t214\n45\t46\t215\t215\n45\t46\t216\t216\n45\t46\t217\t217\n45\t46\t218\t218\n45\t46\t219\t219\n45\t46\t220\t220\n45\t46\t221\t221\n45\t46\t222\t222\n45\t46\t223\t223\n45\t46\t224\t224\n45\t46\t225\t225\n45\t46\t226\t226\n45\t46\t227\t227\n45\t46\t228\t228\n45\t46\t229\t229\n45\t46\t230\t230\n45\t46\t231\t231\n45\t46\t232\t232\n45\t46\t233\t233\n45\t46\t234\t234\n45\t46\t235\t235\n45\t46\t236\t236\n45\t46\t237\t237\n45\t46\t238\t238\n45\t46\t239\t239\n45\t46\t240\t240\n45\t46\t241\t241\n45\t46\t242\t242\n45\t46\t243\t243\n45\t46\t244\t244\n45\t46\t245\t245\n45\t46\t246\t246\n45\t46\t247\t247\n45\t46\t248\t248\n45\t46\t249\t249\n45\t46\t250\t250\n45\t46\t251\t251\n45\t46\t252\t252\n45\t46\t253\t253\n45\t46\t254\t254\n45\t46\t255\t255\n46\t47\t0\t0\n46\t47\t1\t1\n46\t47\t2\t2\n46\t47\t3\t3\n46\t47\t4\t4\n46\t47\t5\t5\n46\t47\t6\t6\n46\t47\t7\t7\n46\t47\t8\t8\n46\t47\t9\t9\n46\t47\t10\t10\n46\t47\t11\t11\n46\t47\t12\t12\n46\t47\t13\t13\n46\t47\t14\t14\n46\t47\t15\t15\n46\t47\t16\t16\n46\t47\t17\t17\n46\t47\t18\t18\n46\t47\t19\t19\n46\t47\t20\t20\n46\t47\t21\t21\n46\t47\t22\t22\n46\t47\t23\t23\n46\t47\t24\t24\n46\t47\t25\t25\n46\t47\t26\t26\n46\t47\t27\t27\n46\t47\t28\t28\n46\t47\t29\t29\n46\t47\t30\t30\n46\t47\t31\t31\n46\t47\t32\t32\n46\t47\t33\t33\n46\t47\t34\t34\n46\t47\t35\t35\n46\t47\t36\t36\n46\t47\t37\t37\n46\t47\t38\t38\n46\t47\t39\t39\n46\t47\t40\t40\n46\t47\t41\t41\n46\t47\t42\t42\n46\t47\t43\t43\n46\t47\t44\t44\n46\t47\t45\t45\n46\t47\t46\t46\n46\t47\t47\t47\n46\t47\t48\t48\n46\t47\t49\t49\n46\t47\t50\t50\n46\t47\t51\t51\n46\t47\t52\t52\n46\t47\t53\t53\n46\t47\t54\t54\n46\t47\t55\t55\n46\t47\t56\t56\n46\t47\t57\t57\n46\t47\t58\t58\n46\t47\t59\t59\n46\t47\t60\t60\n46\t47\t61\t61\n46\t47\t62\t62\n46\t47\t63\t63\n46\t47\t64\t64\n46\t47\t65\t65\n46\t47\t66\t66\n46\t47\t67\t67\n46\t47\t68\t68\n46\t47\t69\t69\n46\t47\t70\t70\n46\t47\t71\t71\n46\t47\t72\t72\n46\t47\t73\t73\n46\t47\t74\t74\n46\t47\t75\t75\n46\t47\t76\t76\n46\t47\t77\t77\n46\t47\t78\t78\n46\t47\t79\t79\n46\t47\t80\t80\n46\t47\t81\t81\n46\t47\t82\t82\n46\t47\t83\t83\n46\t47\t84\t84\n46\t47\t85\t85\n46\t47\t86\t86\n46\t47\t87\t87\n46\t47\t88\t88\n46\t47\t89\t89\n46\t47\t90\t90\n46\t47\t91\t91\n46\t47\t92\t92\n46\t47\t93\t93\n46\t47\t94\t94\n46\t47\t95\t95\n46\t47\t96\t96\n46\t47\t97\t97\n46\t47\t98\t98\n46\t47\t99\t99\n46\t47\t100\t100\n46\t47\t101\t101\n46\t47\t102\t102\n46\t47\t103\t103\n46\t47\t104\t104\n46\t47\t105\t105\n46\t47\t106\t106\n46\t47\t107\t107\n46\t47\t108\t108\n46\t47\t109\t109\n46\t47\t110\t110\n46\t47\t111\t111\n46\t47\t112\t112\n46\t47\t113\t113\n46\t47\t114\t114\n46\t47\t115\t115\n46\t47\t116\t116\n46\t47\t117\t117\n46\t47\t118\t118\n46\t47\t119\t119\n46\t47\t120\t120\n46\t47\t121\t121\n46\t47\t122\t122\n46\t47\t123\t123\n46\t47\t124\t124\n46\t47\t125\t125\n46\t47\t126\t126\n46\t47\t127\t127\n46\t47\t128\t128\n46\t47\t129\t129\n46\t47\t130\t130\n46\t47\t131\t131\n46\t47\t132\t132\n46\t47\t133\t133\n46\t47\t134\t134\n46\t47\t135\t135\n46\t47\t136\t136\n46\t47\t137\t137\n46\t47\t138\t138\n46\t47\t139\t139\n46\t47\t140\t140\n46\t47\t141\t141\n46\t47\t142\t142\n46\t47\t143\t143\n46\t47\t144\t144\n46\t47\t145\t145\n46\t47\t146\t146\n46\t47\t147\t147\n46\t47\t148\t148\n46\t47\t149\t149\n46\t47\t150\t150\n46\t47\t151\t151\n46\t47\t152\t152\n46\t47\t153\t153\n46\t47\t154\t154\n46\t47\t155\t155\n46\t47\t156\t156\n46\t47\t157\t157\n46\t47\t158\t158\n46\t47\t159\t159\n46\t47\t160\t160\n46\t47\t161\t161\n46\t47\t162\t162\n46\t47\t163\t163\n46\t47\t164\t164\n46\t47\t165\t165\n46\t47\t166\t166\n46\t47\t167\t167\n46\t47\t168\t168\n46\t47\t169\t169\n46\t47\t170\t170\n46\t47\t171\t171\n46\t47\t172\t172\n46\t47\t173\t173\n46\t47\t174\t174\n46\t47\t175\t175\n46\t47\t176\t176\n46\t47\t177\t177\n46\t47\t178\t178\n46\t47\t179\t179\n46\t47\t180\t180\n46\t47\t181\t181\n46\t47\t182\t182\n46\t47\t183\t183\n46\t47\t184\t184\n46\t47\t185\t185\n46\t47\t186\t186\n46\t47\t187\t187\n46\t47\t188\t188\n46\t47\t189\t189\n46\t47\t190\t190\n46\t47\t191\t191\n46\t47\t192\t192\n46\t47\t193\t193\n46\t47\t194\t194\n46\t47\t195\t195\n46\t47\t196\t196\n46\t47\t197\t197\n46\t47\t198\t198\n46\t47\t199\t199\n46\t47\t200\t200\n46\t47\t201\t201\n46\t47\t202\t202\n46\t47\t203\t203\n46\t47\t204\t204\n46\t47\t205\t205\n46\t47\t206\t206\n46\t47\t207\t207\n46\t47\t208\t208\n46\t47\t209\t209\n46\t47\t210\t210\n46\t47\t211\t211\n46\t47\t212\t212\n46\t47\t213\t213\n46\t47\t214\t214\n46\t47\t215\t215\n46\t47\t216\t216\n46\t47\t217\t217\n46\t47\t218\t218\n46\t47\t219\t219\n46\t47\t220\t220\n46\t47\t221\t221\n46\t47\t222\t222\n46\t47\t223\t223\n46\t47\t224\t224\n46\t47\t225\t225\n46\t47\t226\t226\n46\t47\t227\t227\n46\t47\t228\t228\n46\t47\t229\t229\n46\t47\t230\t230\n46\t47\t231\t231\n46\t47\t232\t232\n46\t47\t233\t233\n46\t47\t234\t234\n46\t47\t235\t235\n46\t47\t236\t236\n46\t47\t237\t237\n46\t47\t238\t238\n46\t47\t239\t239\n46\t47\t240\t240\n46\t47\t241\t241\n46\t47\t242\t242\n46\t47\t243\t243\n46\t47\t244\t244\n46\t47\t245\t245\n46\t47\t246\t246\n46\t47\t247\t247\n46\t47\t248\t248\n46\t47\t249\t249\n46\t47\t250\t250\n46\t47\t251\t251\n46\t47\t252\t252\n46\t47\t253\t253\n46\t47\t254\t254\n46\t47\t255\t255\n47\t48\t0\t0\n47\t48\t1\t1\n47\t48\t2\t2\n47\t48\t3\t3\n47\t48\t4\t4\n47\t48\t5\t5\n47\t48\t6\t6\n47\t48\t7\t7\n47\t48\t8\t8\n47\t48\t9\t9\n47\t48\t10\t10\n47\t48\t11\t11\n47\t48\t12\t12\n47\t48\t13\t13\n47\t48\t14\t14\n47\t48\t15\t15\n47\t48\t16\t16\n47\t48\t17\t17\n47\t48\t18\t18\n47\t48\t19\t19\n47\t48\t20\t20\n47\t48\t21\t21\n47\t48\t22\t22\n47\t48\t23\t23\n47\t48\t24\t24\n47\t48\t25\t25\n47\t48\t26\t26\n47\t48\t27\t27\n47\t48\t28\t28\n47\t48\t29\t29\n47\t48\t30\t30\n47\t48\t31\t31\n47\t48\t32\t32\n47\t48\t33\t33\n47\t48\t34\t34\n47\t48\t35\t35\n47\t48\t36\t36\n47\t48\t37\t37\n47\t48\t38\t38\n47\t48\t39\t39\n47\t48\t40\t40\n47\t48\t41\t41\n47\t48\t42\t42\n47\t48\t43\t43\n47\t48\t44\t44\n47\t48\t45\t45\n47\t48\t46\t46\n47\t48\t47\t47\n47\t48\t48\t48\n47\t48\t49\t49\n47\t48\t50\t50\n47\t48\t51\t51\n47\t48\t52\t52\n47\t48\t53\t53\n47\t48\t54\t54\n47\t48\t55\t55\n47\t48\t56\t56\n47\t48\t57\t57\n47\t48\t58\t58\n47\t48\t59\t59\n47\t48\t60\t60\n47\t48\t61\t61\n47\t48\t62\t62\n47\t48\t63\t63\n47\t48\t64\t64\n47\t48\t65\t65\n47\t48\t66\t66\n47\t48\t67\t67\n47\t48\t68\t68\n47\t48\t69\t69\n47\t48\t70\t70\n47\t48\t71\t71\n47\t48\t72\t72\n47\t48\t73\t73\n47\t48\t74\t74\n47\t48\t75\t75\n47\t48\t76\t76\n47\t48\t77\t77\n47\t48\t78\t78\n47\t48\t79\t79\n47\t48\t80\t80\n47\t48\t81\t81\n47\t48\t82\t82\n47\t48\t83\t83\n47\t48\t84\t84\n47\t48\t85\t85\n47\t48\t86\t86\n47\t48\t87\t87\n47\t48\t88\t88\n47\t48\t89\t89\n47\t48\t90\t90\n47\t48\t91\t91\n47\t48\t92\t92\n47\t48\t93\t93\n47\t48\t94\t94\n47\t48\t95\t95\n47\t48\t96\t96\n47\t48\t97\t97\n47\t48\t98\t98\n47\t48\t99\t99\n47\t48\t100\t100\n47\t48\t101\t101\n47\t48\t102\t102\n47\t48\t103\t103\n47\t48\t104\t104\n47\t48\t105\t105\n47\t48\t106\t106\n47\t48\t107\t107\n47\t48\t108\t108\n47\t48\t109\t109\n47\t48\t110\t110\n47\t48\t111\t111\n47\t48\t112\t112\n47\t48\t113\t113\n47\t48\t114\t114\n47\t48\t115\t115\n47\t48\t116\t116\n47\t48\t117\t117\n47\t48\t118\t118\n47\t48\t119\t119\n47\t48\t120\t120\n47\t48\t121\t121\n47\t48\t122\t122\n47\t48\t123\t123\n47\t48\t124\t124\n47\t48\t125\t125\n47\t48\t126\t126\n47\t48\t127\t127\n47\t48\t128\t128\n47\t48\t129\t129\n47\t48\t130\t130\n47\t48\t131\t131\n47\t48\t132\t132\n47\t48\t133\t133\n47\t48\t134\t134\n47\t48\t135\t135\n47\t48\t136\t136\n47\t48\t137\t137\n47\t48\t138\t138\n47\t48\t139\t139\n47\t48\t140\t140\n47\t48\t141\t141\n47\t48\t142\t142\n47\t48\t143\t143\n47\t48\t144\t144\n47\t48\t145\t145\n47\t48\t146\t146\n47\t48\t147\t147\n47\t48\t148\t148\n47\t48\t149\t149\n47\t48\t150\t150\n47\t48\t151\t151\n47\t48\t152\t152\n47\t48\t153\t153\n47\t48\t154\t154\n47\t48\t155\t155\n47\t48\t156\t156\n47\t48\t157\t157\n47\t48\t158\t158\n47\t48\t159\t159\n47\t48\t160\t160\n47\t48\t161\t161\n47\t48\t162\t162\n47\t48\t163\t163\n47\t48\t164\t164\n47\t48\t165\t165\n47\t48\t166\t166\n47\t48\t167\t167\n47\t48\t168\t168\n47\t48\t169\t169\n47\t48\t170\t170\n47\t48\t171\t171\n47\t48\t172\t172\n47\t48\t173\t173\n47\t48\t174\t174\n47\t48\t175\t175\n47\t48\t176\t176\n47\t48\t177\t177\n47\t48\t178\t178\n47\t48\t179\t179\n47\t48\t180\t180\n47\t48\t181\t181\n47\t48\t182\t182\n47\t48\t183\t183\n47\t48\t184\t184\n47\t48\t185\t185\n47\t48\t186\t186\n47\t48\t187\t187\n47\t48\t188\t188\n47\t48\t189\t189\n47\t48\t190\t190\n47\t48\t191\t191\n47\t48\t192\t192\n47\t48\t193\t193\n47\t48\t194\t194\n47\t48\t195\t195\n47\t48\t196\t196\n47\t48\t197\t197\n47\t48\t198\t198\n47\t48\t199\t199\n47\t48\t200\t200\n47\t48\t201\t201\n47\t48\t202\t202\n47\t48\t203\t203\n47\t48\t204\t204\n47\t48\t205\t205\n47\t48\t206\t206\n47\t48\t207\t207\n47\t48\t208\t208\n47\t48\t209\t209\n47\t48\t210\t210\n47\t48\t211\t211\n47\t48\t212\t212\n47\t48\t213\t213\n47\t48\t214\t214\n47\t48\t215\t215\n47\t48\t216\t216\n47\t48\t217\t217\n47\t48\t218\t218\n47\t48\t219\t219\n47\t48\t220\t220\n47\t48\t221\t221\n47\t48\t222\t222\n47\t48\t223\t223\n47\t48\t224\t224\n47\t48\t225\t225\n47\t48\t226\t226\n47\t48\t227\t227\n47\t48\t228\t228\n47\t48\t229\t229\n47\t48\t230\t230\n47\t48\t231\t231\n47\t48\t232\t232\n47\t48\t233\t233\n47\t48\t234\t234\n47\t48\t235\t235\n47\t48\t236\t236\n47\t48\t237\t237\n47\t48\t238\t238\n47\t48\t239\t239\n47\t48\t240\t240\n47\t48\t241\t241\n47\t48\t242\t242\n47\t48\t243\t243\n47\t48\t244\t244\n47\t48\t245\t245\n47\t48\t246\t246\n47\t48\t247\t247\n47\t48\t248\t248\n47\t48\t249\t249\n47\t48\t250\t250\n47\t48\t251\t251\n47\t48\t252\t252\n47\t48\t253\t253\n47\t48\t254\t254\n47\t48\t255\t255\n48\t49\t0\t0\n48\t49\t1\t1\n48\t49\t2\t2\n48\t49\t3\t3\n48\t49\t4\t4\n48\t49\t5\t5\n48\t49\t6\t6\n48\t49\t7\t7\n48\t49\t8\t8\n48\t49\t9\t9\n48\t49\t10\t10\n48\t49\t11\t11\n48\t49\t12\t12\n48\t49\t13\t13\n48\t49\t14\t14\n48\t49\t15\t15\n48\t49\t16\t16\n48\t49\t17\t17\n48\t49\t18\t18\n48\t49\t19\t19\n48\t49\t20\t20\n48\t49\t21\t21\n48\t49\t22\t22\n48\t49\t23\t23\n48\t49\t24\t24\n48\t49\t25\t25\n48\t49\t26\t26\n48\t49\t27\t27\n48\t49\t28\t28\n48\t49\t29\t29\n48\t49\t30\t30\n48\t49\t31\t31\n48\t49\t32\t32\n48\t49\t33\t33\n48\t49\t34\t34\n48\t49\t35\t35\n48\t49\t36\t36\n48\t49\t37\t37\n48\t49\t38\t38\n48\t49\t39\t39\n48\t49\t40\t40\n48\t49\t41\t41\n48\t49\t42\t42\n48\t49\t43\t43\n48\t49\t44\t44\n48\t49\t45\t45\n48\t49\t46\t46\n48\t49\t47\t47\n48\t49\t48\t48\n48\t49\t49\t49\n48\t49\t50\t50\n48\t49\t51\t51\n48\t49\t52\t52\n48\t49\t53\t53\n48\t49\t54\t54\n48\t49\t55\t55\n48\t49\t56\t56\n48\t49\t57\t57\n48\t49\t58\t58\n48\t49\t59\t59\n48\t49\t60\t60\n48\t49\t61\t61\n48\t49\t62\t62\n48\t49\t63\t63\n48\t49\t64\t64\n48\t49\t65\t65\n48\t49\t66\t66\n48\t49\t67\t67\n48\t49\t68\t68\n48\t49\t69\t69\n48\t49\t70\t70\n48\t49\t71\t71\n48\t49\t72\t72\n48\t49\t73\t73\n48\t49\t74\t74\n48\t49\t75\t75\n48\t49\t76\t76\n48\t49\t77\t77\n48\t49\t78\t78\n48\t49\t79\t79\n48\t49\t80\t80\n48\t49\t81\t81\n48\t49\t82\t82\n48\t49\t83\t83\n48\t49\t84\t84\n48\t49\t85\t85\n48\t49\t86\t86\n48\t49\t87\t87\n48\t49\t88\t88\n48\t49\t89\t89\n48\t49\t90\t90\n48\t49\t91\t91\n48\t49\t92\t92\n48\t49\t93\t93\n48\t49\t94\t94\n48\t49\t95\t95\n48\t49\t96\t96\n48\t49\t97\t97\n48\t49\t98\t98\n48\t49\t99\t99\n48\t49\t100\t100\n48\t49\t101\t101\n48\t49\t102\t102\n48\t49\t103\t103\n48\t49\t104\t104\n48\t49\t105\t105\n48\t49\t106\t106\n48\t49\t107\t107\n48\t49\t108\t108\n48\t49\t109\t109\n48\t49\t110\t110\n48\t49\t111\t111\n48\t49\t112\t112\n48\t49\t113\t113\n48\t49\t114\t114\n48\t49\t115\t115\n48\t49\t116\t116\n48\t49\t117\t117\n48\t49\t118\t118\n48\t49\t119\t119\n48\t49\t120\t120\n48\t49\t121\t121\n48\t49\t122\t122\n48\t49\t123\t123\n48\t49\t124\t124\n48\t49\t125\t125\n48\t49\t126\t126\n48\t49\t127\t127\n48\t49\t128\t128\n48\t49\t129\t129\n48\t49\t130\t130\n48\t49\t131\t131\n48\t49\t132\t132\n48\t49\t133\t133\n48\t49\t134\t134\n48\t49\t135\t135\n48\t49\t136\t136\n48\t49\t137\t137\n48\t49\t138\t138\n48\t49\t139\t139\n48\t49\t140\t140\n48\t49\t141\t141\n48\t49\t142\t142\n48\t49\t143\t143\n48\t49\t144\t144\n48\t49\t145\t145\n48\t49\t146\t146\n48\t49\t147\t147\n48\t49\t148\t148\n48\t49\t149\t149\n48\t49\t150\t150\n48\t49\t151\t151\n48\t49\t152\t152\n48\t49\t153\t153\n48\t49\t154\t154\n48\t49\t155\t155\n48\t49\t156\t156\n48\t49\t157\t157\n48\t49\t158\t158\n48\t49\t159\t159\n48\t49\t160\t160\n48\t49\t161\t161\n48\t49\t162\t162\n48\t49\t163\t163\n48\t49\t164\t164\n48\t49\t165\t165\n48\t49\t166\t166\n48\t49\t167\t167\n48\t49\t168\t168\n48\t49\t169\t169\n48\t49\t170\t170\n48\t49\t171\t171\n48\t49\t172\t172\n48\t49\t173\t173\n48\t49\t174\t174\n48\t49\t175\t175\n48\t49\t176\t176\n48\t49\t177\t177\n48\t49\t178\t178\n48\t49\t179\t179\n48\t49\t180\t180\n48\t49\t181\t181\n48\t49\t182\t182\n48\t49\t183\t183\n48\t49\t184\t184\n48\t49\t185\t185\n48\t49\t186\t186\n48\t49\t187\t187\n48\t49\t188\t188\n48\t49\t189\t189\n48\t49\t190\t190\n48\t49\t191\t191\n48\t49\t192\t192\n48\t49\t193\t193\n48\t49\t194\t194\n48\t49\t195\t195\n48\t49\t196\t196\n48\t49\t197\t197\n48\t49\t198\t198\n48\t49\t199\t199\n48\t49\t200\t200\n48\t49\t201\t201\n48\t49\t202\t202\n48\t49\t203\t203\n48\t49\t204\t204\n48\t49\t205\t205\n48\t49\t206\t206\n48\t49\t207\t207\n48\t49\t208\t208\n48\t49\t209\t209\n48\t49\t210\t210\n48\t49\t211\t211\n48\t49\t212\t212\n48\t49\t213\t213\n48\t49\t214\t214\n48\t49\t215\t215\n48\t49\t216\t216\n48\t49\t217\t217\n48\t49\t218\t218\n48\t49\t219\t219\n48\t49\t220\t220\n48\t49\t221\t221\n48\t49\t222\t222\n48\t49\t223\t223\n48\t49\t224\t224\n48\t49\t225\t225\n48\t49\t226\t226\n48\t49\t227\t227\n48\t49\t228\t228\n48\t49\t229\t229\n48\t49\t230\t230\n48\t49\t231\t231\n48\t49\t232\t232\n48\t49\t233\t233\n48\t49\t234\t234\n48\t49\t235\t235\n48\t49\t236\t236\n48\t49\t237\t237\n48\t49\t238\t238\n48\t49\t239\t239\n48\t49\t240\t240\n48\t49\t241\t241\n48\t49\t242\t242\n48\t49\t243\t243\n48\t49\t244\t244\n48\t49\t245\t245\n48\t49\t246\t246\n48\t49\t247\t247\n48\t49\t248\t248\n48\t49\t249\t249\n48\t49\t250\t250\n48\t49\t251\t251\n48\t49\t252\t252\n48\t49\t253\t253\n48\t49\t254\t254\n48\t49\t255\t255\n49\t50\t0\t0\n49\t50\t1\t1\n49\t50\t2\t2\n49\t50\t3\t3\n49\t50\t4\t4\n49\t50\t5\t5\n49\t50\t6\t6\n49\t50\t7\t7\n49\t50\t8\t8\n49\t50\t9\t9\n49\t50\t10\t10\n49\t50\t11\t11\n49\t50\t12\t12\n49\t50\t13\t13\n49\t50\t14\t14\n49\t50\t15\t15\n49\t50\t16\t16\n49\t50\t17\t17\n49\t50\t18\t18\n49\t50\t19\t19\n49\t50\t20\t20\n49\t50\t21\t21\n49\t50\t22\t22\n49\t50\t23\t23\n49\t50\t24\t24\n49\t50\t25\t25\n49\t50\t26\t26\n49\t50\t27\t27\n49\t50\t28\t28\n49\t50\t29\t29\n49\t50\t30\t30\n49\t50\t31\t31\n49\t50\t32\t32\n49\t50\t33\t33\n49\t50\t34\t34\n49\t50\t35\t35\n49\t50\t36\t36\n49\t50\t37\t37\n49\t50\t38\t38\n49\t50\t39\t39\n49\t50\t40\t40\n49\t50\t41\t41\n49\t50\t42\t42\n49\t50\t43\t43\n49\t50\t44\t44\n49\t50\t45\t45\n49\t50\t46\t46\n49\t50\t47\t47\n49\t50\t48\t48\n49\t50\t49\t49\n49\t50\t50\t50\n49\t50\t51\t51\n49\t50\t52\t52\n49\t50\t53\t53\n49\t50\t54\t54\n49\t50\t55\t55\n49\t50\t56\t56\n49\t50\t57\t57\n49\t50\t58\t58\n49\t50\t59\t59\n49\t50\t60\t60\n49\t50\t61\t61\n49\t50\t62\t62\n49\t50\t63\t63\n49\t50\t64\t64\n49\t50\t65\t65\n49\t50\t66\t66\n49\t50\t67\t67\n49\t50\t68\t68\n49\t50\t69\t69\n49\t50\t70\t70\n49\t50\t71\t71\n49\t50\t72\t72\n49\t50\t73\t73\n49\t50\t74\t74\n49\t50\t75\t75\n49\t50\t76\t76\n49\t50\t77\t77\n49\t50\t78\t78\n49\t50\t79\t79\n49\t50\t80\t80\n49\t50\t81\t81\n49\t50\t82\t82\n49\t50\t83\t83\n49\t50\t84\t84\n49\t50\t85\t85\n49\t50\t86\t86\n49\t50\t87\t87\n49\t50\t88\t88\n49\t50\t89\t89\n49\t50\t90\t90\n49\t50\t91\t91\n49\t50\t92\t92\n49\t50\t93\t93\n49\t50\t94\t94\n49\t50\t95\t95\n49\t50\t96\t96\n49\t50\t97\t97\n49\t50\t98\t98\n49\t50\t99\t99\n49\t50\t100\t100\n49\t50\t101\t101\n49\t50\t102\t102\n49\t50\t103\t103\n49\t50\t104\t104\n49\t50\t105\t105\n49\t50\t106\t106\n49\t50\t107\t107\n49\t50\t108\t108\n49\t50\t109\t109\n49\t50\t110\t110\n49\t50\t111\t111\n49\t50\t112\t112\n49\t50\t113\t113\n49\t50\t114\t114\n49\t50\t115\t115\n49\t50\t116\t116\n49\t50\t117\t117\n49\t50\t118\t118\n49\t50\t119\t119\n49\t50\t120\t120\n49\t50\t121\t121\n49\t50\t122\t122\n49\t50\t123\t123\n49\t50\t124\t124\n49\t50\t125\t125\n49\t50\t126\t126\n49\t50\t127\t127\n49\t50\t128\t128\n49\t50\t129\t129\n49\t50\t130\t130\n49\t50\t131\t131\n49\t50\t132\t132\n49\t50\t133\t133\n49\t50\t134\t134\n49\t50\t135\t135\n49\t50\t136\t136\n49\t50\t137\t137\n49\t50\t138\t138\n49\t50\t139\t139\n49\t50\t140\t140\n49\t50\t141\t141\n49\t50\t142\t142\n49\t50\t143\t143\n49\t50\t144\t144\n49\t50\t145\t145\n49\t50\t146\t146\n49\t50\t147\t147\n49\t50\t148\t148\n49\t50\t149\t149\n49\t50\t150\t150\n49\t50\t151\t151\n49\t50\t152\t152\n49\t50\t153\t153\n49\t50\t154\t154\n49\t50\t155\t155\n49\t50\t156\t156\n49\t50\t157\t157\n49\t50\t158\t158\n49\t50\t159\t159\n49\t50\t160\t160\n49\t50\t161\t161\n49\t50\t162\t162\n49\t50\t163\t163\n49\t50\t164\t164\n49\t50\t165\t165\n49\t50\t166\t166\n49\t50\t167\t167\n49\t50\t168\t168\n49\t50\t169\t169\n49\t50\t170\t170\n49\t50\t171\t171\n49\t50\t172\t172\n49\t50\t173\t173\n49\t50\t174\t174\n49\t50\t175\t175\n49\t50\t176\t176\n49\t50\t177\t177\n49\t50\t178\t178\n49\t50\t179\t179\n49\t50\t180\t180\n49\t50\t181\t181\n49\t50\t182\t182\n49\t50\t183\t183\n49\t50\t184\t184\n49\t50\t185\t185\n49\t50\t186\t186\n49\t50\t187\t187\n49\t50\t188\t188\n49\t50\t189\t189\n49\t50\t190\t190\n49\t50\t191\t191\n49\t50\t192\t192\n49\t50\t193\t193\n49\t50\t194\t194\n49\t50\t195\t195\n49\t50\t196\t196\n49\t50\t197\t197\n49\t50\t198\t198\n49\t50\t199\t199\n49\t50\t200\t200\n49\t50\t201\t201\n49\t50\t202\t202\n49\t50\t203\t203\n49\t50\t204\t204\n49\t50\t205\t205\n49\t50\t206\t206\n49\t50\t207\t207\n49\t50\t208\t208\n49\t50\t209\t209\n49\t50\t210\t210\n49\t50\t211\t211\n49\t50\t212\t212\n49\t50\t213\t213\n49\t50\t214\t214\n49\t50\t215\t215\n49\t50\t216\t216\n49\t50\t217\t217\n49\t50\t218\t218\n49\t50\t219\t219\n49\t50\t220\t220\n49\t50\t221\t221\n49\t50\t222\t222\n49\t50\t223\t223\n49\t50\t224\t224\n49\t50\t225\t225\n49\t50\t226\t226\n49\t50\t227\t227\n49\t50\t228\t228\n49\t50\t229\t229\n49\t50\t230\t230\n49\t50\t231\t231\n49\t50\t232\t232\n49\t50\t233\t233\n49\t50\t234\t234\n49\t50\t235\t235\n49\t50\t236\t236\n49\t50\t237\t237\n49\t50\t238\t238\n49\t50\t239\t239\n49\t50\t240\t240\n49\t50\t241\t241\n49\t50\t242\t242\n49\t50\t243\t243\n49\t50\t244\t244\n49\t50\t245\t245\n49\t50\t246\t246\n49\t50\t247\t247\n49\t50\t248\t248\n49\t50\t249\t249\n49\t50\t250\t250\n49\t50\t251\t251\n49\t50\t252\t252\n49\t50\t253\t253\n49\t50\t254\t254\n49\t50\t255\t255\n50\t51\t0\t0\n50\t51\t1\t1\n50\t51\t2\t2\n50\t51\t3\t3\n50\t51\t4\t4\n50\t51\t5\t5\n50\t51\t6\t6\n50\t51\t7\t7\n50\t51\t8\t8\n50\t51\t9\t9\n50\t51\t10\t10\n50\t51\t11\t11\n50\t51\t12\t12\n50\t51\t13\t13\n50\t51\t14\t14\n50\t51\t15\t15\n50\t51\t16\t16\n50\t51\t17\t17\n50\t51\t18\t18\n50\t51\t19\t19\n50\t51\t20\t20\n50\t51\t21\t21\n50\t51\t22\t22\n50\t51\t23\t23\n50\t51\t24\t24\n50\t51\t25\t25\n50\t51\t26\t26\n50\t51\t27\t27\n50\t51\t28\t28\n50\t51\t29\t29\n50\t51\t30\t30\n50\t51\t31\t31\n50\t51\t32\t32\n50\t51\t33\t33\n50\t51\t34\t34\n50\t51\t35\t35\n50\t51\t36\t36\n50\t51\t37\t37\n50\t51\t38\t38\n50\t51\t39\t39\n50\t51\t40\t40\n50\t51\t41\t41\n50\t51\t42\t42\n50\t51\t43\t43\n50\t51\t44\t44\n50\t51\t45\t45\n50\t51\t46\t46\n50\t51\t47\t47\n50\t51\t48\t48\n50\t51\t49\t49\n50\t51\t50\t50\n50\t51\t51\t51\n50\t51\t52\t52\n50\t51\t53\t53\n50\t51\t54\t54\n50\t51\t55\t55\n50\t51\t56\t56\n50\t51\t57\t57\n50\t51\t58\t58\n50\t51\t59\t59\n50\t51\t60\t60\n50\t51\t61\t61\n50\t51\t62\t62\n50\t51\t63\t63\n50\t51\t64\t64\n50\t51\t65\t65\n50\t51\t66\t66\n50\t51\t67\t67\n50\t51\t68\t68\n50\t51\t69\t69\n50\t51\t70\t70\n50\t51\t71\t71\n50\t51\t72\t72\n50\t51\t73\t73\n50\t51\t74\t74\n50\t51\t75\t75\n50\t51\t76\t76\n50\t51\t77\t77\n50\t51\t78\t78\n50\t51\t79\t79\n50\t51\t80\t80\n50\t51\t81\t81\n50\t51\t82\t82\n50\t51\t83\t83\n50\t51\t84\t84\n50\t51\t85\t85\n50\t51\t86\t86\n50\t51\t87\t87\n50\t51\t88\t88\n50\t51\t89\t89\n50\t51\t90\t90\n50\t51\t91\t91\n50\t51\t92\t92\n50\t51\t93\t93\n50\t51\t94\t94\n50\t51\t95\t95\n50\t51\t96\t96\n50\t51\t97\t97\n50\t51\t98\t98\n50\t51\t99\t99\n50\t51\t100\t100\n50\t51\t101\t101\n50\t51\t102\t102\n50\t51\t103\t103\n50\t51\t104\t104\n50\t51\t105\t105\n50\t51\t106\t106\n50\t51\t107\t107\n50\t51\t108\t108\n50\t51\t109\t109\n50\t51\t110\t110\n50\t51\t111\t111\n50\t51\t112\t112\n50\t51\t113\t113\n50\t51\t114\t114\n50\t51\t115\t115\n50\t51\t116\t116\n50\t51\t117\t117\n50\t51\t118\t118\n50\t51\t119\t119\n50\t51\t120\t120\n50\t51\t121\t121\n50\t51\t122\t122\n50\t51\t123\t123\n50\t51\t124\t124\n50\t51\t125\t125\n50\t51\t126\t126\n50\t51\t127\t127\n50\t51\t128\t128\n50\t51\t129\t129\n50\t51\t130\t130\n50\t51\t131\t131\n50\t51\t132\t132\n50\t51\t133\t133\n50\t51\t134\t134\n50\t51\t135\t135\n50\t51\t136\t136\n50\t51\t137\t137\n50\t51\t138\t138\n50\t51\t139\t139\n50\t51\t140\t140\n50\t51\t141\t141\n50\t51\t142\t142\n50\t51\t143\t143\n50\t51\t144\t144\n50\t51\t145\t145\n50\t51\t146\t146\n50\t51\t147\t147\n50\t51\t148\t148\n50\t51\t149\t149\n50\t51\t150\t150\n50\t51\t151\t151\n50\t51\t152\t152\n50\t51\t153\t153\n50\t51\t154\t154\n50\t51\t155\t155\n50\t51\t156\t156\n50\t51\t157\t157\n50\t51\t158\t158\n50\t51\t159\t159\n50\t51\t160\t160\n50\t51\t161\t161\n50\t51\t162\t162\n50\t51\t163\t163\n50\t51\t164\t164\n50\t51\t165\t165\n50\t51\t166\t166\n50\t51\t167\t167\n50\t51\t168\t168\n50\t51\t169\t169\n50\t51\t170\t170\n50\t51\t171\t171\n50\t51\t172\t172\n50\t51\t173\t173\n50\t51\t174\t174\n50\t51\t175\t175\n50\t51\t176\t176\n50\t51\t177\t177\n50\t51\t178\t178\n50\t51\t179\t179\n50\t51\t180\t180\n50\t51\t181\t181\n50\t51\t182\t182\n50\t51\t183\t183\n50\t51\t184\t184\n50\t51\t185\t185\n50\t51\t186\t186\n50\t51\t187\t187\n50\t51\t188\t188\n50\t51\t189\t189\n50\t51\t190\t190\n50\t51\t191\t191\n50\t51\t192\t192\n50\t51\t193\t193\n50\t51\t194\t194\n50\t51\t195\t195\n50\t51\t196\t196\n50\t51\t197\t197\n50\t51\t198\t198\n50\t51\t199\t199\n50\t51\t200\t200\n50\t51\t201\t201\n50\t51\t202\t202\n50\t51\t203\t203\n50\t51\t204\t204\n50\t51\t205\t205\n50\t51\t206\t206\n50\t51\t207\t207\n50\t51\t208\t208\n50\t51\t209\t209\n50\t51\t210\t210\n50\t51\t211\t211\n50\t51\t212\t212\n50\t51\t213\t213\n50\t51\t214\t214\n50\t51\t215\t215\n50\t51\t216\t216\n50\t51\t217\t217\n50\t51\t218\t218\n50\t51\t219\t219\n50\t51\t220\t220\n50\t51\t221\t221\n50\t51\t222\t222\n50\t51\t223\t223\n50\t51\t224\t224\n50\t51\t225\t225\n50\t51\t226\t226\n50\t51\t227\t227\n50\t51\t228\t228\n50\t51\t229\t229\n50\t51\t230\t230\n50\t51\t231\t231\n50\t51\t232\t232\n50\t51\t233\t233\n50\t51\t234\t234\n50\t51\t235\t235\n50\t51\t236\t236\n50\t51\t237\t237\n50\t51\t238\t238\n50\t51\t239\t239\n50\t51\t240\t240\n50\t51\t241\t241\n50\t51\t242\t242\n50\t51\t243\t243\n50\t51\t244\t244\n50\t51\t245\t245\n50\t51\t246\t246\n50\t51\t247\t247\n50\t51\t248\t248\n50\t51\t249\t249\n50\t51\t250\t250\n50\t51\t251\t251\n50\t51\t252\t252\n50\t51\t253\t253\n50\t51\t254\t254\n50\t51\t255\t255\n51\t52\t0\t0\n51\t52\t1\t1\n51\t52\t2\t2\n51\t52\t3\t3\n51\t52\t4\t4\n51\t52\t5\t5\n51\t52\t6\t6\n51\t52\t7\t7\n51\t52\t8\t8\n51\t52\t9\t9\n51\t52\t10\t10\n51\t52\t11\t11\n51\t52\t12\t12\n51\t52\t13\t13\n51\t52\t14\t14\n51\t52\t15\t15\n51\t52\t16\t16\n51\t52\t17\t17\n51\t52\t18\t18\n51\t52\t19\t19\n51\t52\t20\t20\n51\t52\t21\t21\n51\t52\t22\t22\n51\t52\t23\t23\n51\t52\t24\t24\n51\t52\t25\t25\n51\t52\t26\t26\n51\t52\t27\t27\n51\t52\t28\t28\n51\t52\t29\t29\n51\t52\t30\t30\n51\t52\t31\t31\n51\t52\t32\t32\n51\t52\t33\t33\n51\t52\t34\t34\n51\t52\t35\t35\n51\t52\t36\t36\n51\t52\t37\t37\n51\t52\t38\t38\n51\t52\t39\t39\n51\t52\t40\t40\n51\t52\t41\t41\n51\t52\t42\t42\n51\t52\t43\t43\n51\t52\t44\t44\n51\t52\t45\t45\n51\t52\t46\t46\n51\t52\t47\t47\n51\t52\t48\t48\n51\t52\t49\t49\n51\t52\t50\t50\n51\t52\t51\t51\n51\t52\t52\t52\n51\t52\t53\t53\n51\t52\t54\t54\n51\t52\t55\t55\n51\t52\t56\t56\n51\t52\t57\t57\n51\t52\t58\t58\n51\t52\t59\t59\n51\t52\t60\t60\n51\t52\t61\t61\n51\t52\t62\t62\n51\t52\t63\t63\n51\t52\t64\t64\n51\t52\t65\t65\n51\t52\t66\t66\n51\t52\t67\t67\n51\t52\t68\t68\n51\t52\t69\t69\n51\t52\t70\t70\n51\t52\t71\t71\n51\t52\t72\t72\n51\t52\t73\t73\n51\t52\t74\t74\n51\t52\t75\t75\n51\t52\t76\t76\n51\t52\t77\t77\n51\t52\t78\t78\n51\t52\t79\t79\n51\t52\t80\t80\n51\t52\t81\t81\n51\t52\t82\t82\n51\t52\t83\t83\n51\t52\t84\t84\n51\t52\t85\t85\n51\t52\t86\t86\n51\t52\t87\t87\n51\t52\t88\t88\n51\t52\t89\t89\n51\t52\t90\t90\n51\t52\t91\t91\n51\t52\t92\t92\n51\t52\t93\t93\n51\t52\t94\t94\n51\t52\t95\t95\n51\t52\t96\t96\n51\t52\t97\t97\n51\t52\t98\t98\n51\t52\t99\t99\n51\t52\t100\t100\n51\t52\t101\t101\n51\t52\t102\t102\n51\t52\t103\t103\n51\t52\t104\t104\n51\t52\t105\t105\n51\t52\t106\t106\n51\t52\t107\t107\n51\t52\t108\t108\n51\t52\t109\t109\n51\t52\t110\t110\n51\t52\t111\t111\n51\t52\t112\t112\n51\t52\t113\t113\n51\t52\t114\t114\n51\t52\t115\t115\n51\t52\t116\t116\n51\t52\t117\t117\n51\t52\t118\t118\n51\t52\t119\t119\n51\t52\t120\t120\n51\t52\t121\t121\n51\t52\t122\t122\n51\t52\t123\t123\n51\t52\t124\t124\n51\t52\t125\t125\n51\t52\t126\t126\n51\t52\t127\t127\n51\t52\t128\t128\n51\t52\t129\t129\n51\t52\t130\t130\n51\t52\t131\t131\n51\t52\t132\t132\n51\t52\t133\t133\n51\t52\t134\t134\n51\t52\t135\t135\n51\t52\t136\t136\n51\t52\t137\t137\n51\t52\t138\t138\n51\t52\t139\t139\n51\t52\t140\t140\n51\t52\t141\t141\n51\t52\t142\t142\n51\t52\t143\t143\n51\t52\t144\t144\n51\t52\t145\t145\n51\t52\t146\t146\n51\t52\t147\t147\n51\t52\t148\t148\n51\t52\t149\t149\n51\t52\t150\t150\n51\t52\t151\t151\n51\t52\t152\t152\n51\t52\t153\t153\n51\t52\t154\t154\n51\t52\t155\t155\n51\t52\t156\t156\n51\t52\t157\t157\n51\t52\t158\t158\n51\t52\t159\t159\n51\t52\t160\t160\n51\t52\t161\t161\n51\t52\t162\t162\n51\t52\t163\t163\n51\t52\t164\t164\n51\t52\t165\t165\n51\t52\t166\t166\n51\t52\t167\t167\n51\t52\t168\t168\n51\t52\t169\t169\n51\t52\t170\t170\n51\t52\t171\t171\n51\t52\t172\t172\n51\t52\t173\t173\n51\t52\t174\t174\n51\t52\t175\t175\n51\t52\t176\t176\n51\t52\t177\t177\n51\t52\t178\t178\n51\t52\t179\t179\n51\t52\t180\t180\n51\t52\t181\t181\n51\t52\t182\t182\n51\t52\t183\t183\n51\t52\t184\t184\n51\t52\t185\t185\n51\t52\t186\t186\n51\t52\t187\t187\n51\t52\t188\t188\n51\t52\t189\t189\n51\t52\t190\t190\n51\t52\t191\t191\n51\t52\t192\t192\n51\t52\t193\t193\n51\t52\t194\t194\n51\t52\t195\t195\n51\t52\t196\t196\n51\t52\t197\t197\n51\t52\t198\t198\n51\t52\t199\t199\n51\t52\t200\t200\n51\t52\t201\t201\n51\t52\t202\t202\n51\t52\t203\t203\n51\t52\t204\t204\n51\t52\t205\t205\n51\t52\t206\t206\n51\t52\t207\t207\n51\t52\t208\t208\n51\t52\t209\t209\n51\t52\t210\t210\n51\t52\t211\t211\n51\t52\t212\t212\n51\t52\t213\t213\n51\t52\t214\t214\n51\t52\t215\t215\n51\t52\t216\t216\n51\t52\t217\t217\n51\t52\t218\t218\n51\t52\t219\t219\n51\t52\t220\t220\n51\t52\t221\t221\n51\t52\t222\t222\n51\t52\t223\t223\n51\t52\t224\t224\n51\t52\t225\t225\n51\t52\t226\t226\n51\t52\t227\t227\n51\t52\t228\t228\n51\t52\t229\t229\n51\t52\t230\t230\n51\t52\t231\t231\n51\t52\t232\t232\n51\t52\t233\t233\n51\t52\t234\t234\n51\t52\t235\t235\n51\t52\t236\t236\n51\t52\t237\t237\n51\t52\t238\t238\n51\t52\t239\t239\n51\t52\t240\t240\n51\t52\t241\t241\n51\t52\t242\t242\n51\t52\t243\t243\n51\t52\t244\t244\n51\t52\t245\t245\n51\t52\t246\t246\n51\t52\t247\t247\n51\t52\t248\t248\n51\t52\t249\t249\n51\t52\t250\t250\n51\t52\t251\t251\n51\t52\t252\t252\n51\t52\t253\t253\n51\t52\t254\t254\n51\t52\t255\t255\n52\t53\t0\t0\n52\t53\t1\t1\n52\t53\t2\t2\n52\t53\t3\t3\n52\t53\t4\t4\n52\t53\t5\t5\n52\t53\t6\t6\n52\t53\t7\t7\n52\t53\t8\t8\n52\t53\t9\t9\n52\t53\t10\t10\n52\t53\t11\t11\n52\t53\t12\t12\n52\t53\t13\t13\n52\t53\t14\t14\n52\t53\t15\t15\n52\t53\t16\t16\n52\t53\t17\t17\n52\t53\t18\t18\n52\t53\t19\t19\n52\t53\t20\t20\n52\t53\t21\t21\n52\t53\t22\t22\n52\t53\t23\t23\n52\t53\t24\t24\n52\t53\t25\t25\n52\t53\t26\t26\n52\t53\t27\t27\n52\t53\t28\t28\n52\t53\t29\t29\n52\t53\t30\t30\n52\t53\t31\t31\n52\t53\t32\t32\n52\t53\t33\t33\n52\t53\t34\t34\n52\t53\t35\t35\n52\t53\t36\t36\n52\t53\t37\t37\n52\t53\t38\t38\n52\t53\t39\t39\n52\t53\t40\t40\n52\t53\t41\t41\n52\t53\t42\t42\n52\t53\t43\t43\n52\t53\t44\t44\n52\t53\t45\t45\n52\t53\t46\t46\n52\t53\t47\t47\n52\t53\t48\t48\n52\t53\t49\t49\n52\t53\t50\t50\n52\t53\t51\t51\n52\t53\t52\t52\n52\t53\t53\t53\n52\t53\t54\t54\n52\t53\t55\t55\n52\t53\t56\t56\n52\t53\t57\t57\n52\t53\t58\t58\n52\t53\t59\t59\n52\t53\t60\t60\n52\t53\t61\t61\n52\t53\t62\t62\n52\t53\t63\t63\n52\t53\t64\t64\n52\t53\t65\t65\n52\t53\t66\t66\n52\t53\t67\t67\n52\t53\t68\t68\n52\t53\t69\t69\n52\t53\t70\t70\n52\t53\t71\t71\n52\t53\t72\t72\n52\t53\t73\t73\n52\t53\t74\t74\n52\t53\t75\t75\n52\t53\t76\t76\n52\t53\t77\t77\n52\t53\t78\t78\n52\t53\t79\t79\n52\t53\t80\t80\n52\t53\t81\t81\n52\t53\t82\t82\n52\t53\t83\t83\n52\t53\t84\t84\n52\t53\t85\t85\n52\t53\t86\t86\n52\t53\t87\t87\n52\t53\t88\t88\n52\t53\t89\t89\n52\t53\t90\t90\n52\t53\t91\t91\n52\t53\t92\t92\n52\t53\t93\t93\n52\t53\t94\t94\n52\t53\t95\t95\n52\t53\t96\t96\n52\t53\t97\t97\n52\t53\t98\t98\n52\t53\t99\t99\n52\t53\t100\t100\n52\t53\t101\t101\n52\t53\t102\t102\n52\t53\t103\t103\n52\t53\t104\t104\n52\t53\t105\t105\n52\t53\t106\t106\n52\t53\t107\t107\n52\t53\t108\t108\n52\t53\t109\t109\n52\t53\t110\t110\n52\t53\t111\t111\n52\t53\t112\t112\n52\t53\t113\t113\n52\t53\t114\t114\n52\t53\t115\t115\n52\t53\t116\t116\n52\t53\t117\t117\n52\t53\t118\t118\n52\t53\t119\t119\n52\t53\t120\t120\n52\t53\t121\t121\n52\t53\t122\t122\n52\t53\t123\t123\n52\t53\t124\t124\n52\t53\t125\t125\n52\t53\t126\t126\n52\t53\t127\t127\n52\t53\t128\t128\n52\t53\t129\t129\n52\t53\t130\t130\n52\t53\t131\t131\n52\t53\t132\t132\n52\t53\t133\t133\n52\t53\t134\t134\n52\t53\t135\t135\n52\t53\t136\t136\n52\t53\t137\t137\n52\t53\t138\t138\n52\t53\t139\t139\n52\t53\t140\t140\n52\t53\t141\t141\n52\t53\t142\t142\n52\t53\t143\t143\n52\t53\t144\t144\n52\t53\t145\t145\n52\t53\t146\t146\n52\t53\t147\t147\n52\t53\t148\t148\n52\t53\t149\t149\n52\t53\t150\t150\n52\t53\t151\t151\n52\t53\t152\t152\n52\t53\t153\t153\n52\t53\t154\t154\n52\t53\t155\t155\n52\t53\t156\t156\n52\t53\t157\t157\n52\t53\t158\t158\n52\t53\t159\t159\n52\t53\t160\t160\n52\t53\t161\t161\n52\t53\t162\t162\n52\t53\t163\t163\n52\t53\t164\t164\n52\t53\t165\t165\n52\t53\t166\t166\n52\t53\t167\t167\n52\t53\t168\t168\n52\t53\t169\t169\n52\t53\t170\t170\n52\t53\t171\t171\n52\t53\t172\t172\n52\t53\t173\t173\n52\t53\t174\t174\n52\t53\t175\t175\n52\t53\t176\t176\n52\t53\t177\t177\n52\t53\t178\t178\n52\t53\t179\t179\n52\t53\t180\t180\n52\t53\t181\t181\n52\t53\t182\t182\n52\t53\t183\t183\n52\t53\t184\t184\n52\t53\t185\t185\n52\t53\t186\t186\n52\t53\t187\t187\n52\t53\t188\t188\n52\t53\t189\t189\n52\t53\t190\t190\n52\t53\t191\t191\n52\t53\t192\t192\n52\t53\t193\t193\n52\t53\t194\t194\n52\t53\t195\t195\n52\t53\t196\t196\n52\t53\t197\t197\n52\t53\t198\t198\n52\t53\t199\t199\n52\t53\t200\t200\n52\t53\t201\t201\n52\t53\t202\t202\n52\t53\t203\t203\n52\t53\t204\t204\n52\t53\t205\t205\n52\t53\t206\t206\n52\t53\t207\t207\n52\t53\t208\t208\n52\t53\t209\t209\n52\t53\t210\t210\n52\t53\t211\t211\n52\t53\t212\t212\n52\t53\t213\t213\n52\t53\t214\t214\n52\t53\t215\t215\n52\t53\t216\t216\n52\t53\t217\t217\n52\t53\t218\t218\n52\t53\t219\t219\n52\t53\t220\t220\n52\t53\t221\t221\n52\t53\t222\t222\n52\t53\t223\t223\n52\t53\t224\t224\n52\t53\t225\t225\n52\t53\t226\t226\n52\t53\t227\t227\n52\t53\t228\t228\n52\t53\t229\t229\n52\t53\t230\t230\n52\t53\t231\t231\n52\t53\t232\t232\n52\t53\t233\t233\n52\t53\t234\t234\n52\t53\t235\t235\n52\t53\t236\t236\n52\t53\t237\t237\n52\t53\t238\t238\n52\t53\t239\t239\n52\t53\t240\t240\n52\t53\t241\t241\n52\t53\t242\t242\n52\t53\t243\t243\n52\t53\t244\t244\n52\t53\t245\t245\n52\t53\t246\t246\n52\t53\t247\t247\n52\t53\t248\t248\n52\t53\t249\t249\n52\t53\t250\t250\n52\t53\t251\t251\n52\t53\t252\t252\n52\t53\t253\t253\n52\t53\t254\t254\n52\t53\t255\t255\n53\t54\t0\t0\n53\t54\t1\t1\n53\t54\t2\t2\n53\t54\t3\t3\n53\t54\t4\t4\n53\t54\t5\t5\n53\t54\t6\t6\n53\t54\t7\t7\n53\t54\t8\t8\n53\t54\t9\t9\n53\t54\t10\t10\n53\t54\t11\t11\n53\t54\t12\t12\n53\t54\t13\t13\n53\t54\t14\t14\n53\t54\t15\t15\n53\t54\t16\t16\n53\t54\t17\t17\n53\t54\t18\t18\n53\t54\t19\t19\n53\t54\t20\t20\n53\t54\t21\t21\n53\t54\t22\t22\n53\t54\t23\t23\n53\t54\t24\t24\n53\t54\t25\t25\n53\t54\t26\t26\n53\t54\t27\t27\n53\t54\t28\t28\n53\t54\t29\t29\n53\t54\t30\t30\n53\t54\t31\t31\n53\t54\t32\t32\n53\t54\t33\t33\n53\t54\t34\t34\n53\t54\t35\t35\n53\t54\t36\t36\n53\t54\t37\t37\n53\t54\t38\t38\n53\t54\t39\t39\n53\t54\t40\t40\n53\t54\t41\t41\n53\t54\t42\t42\n53\t54\t43\t43\n53\t54\t44\t44\n53\t54\t45\t45\n53\t54\t46\t46\n53\t54\t47\t47\n53\t54\t48\t48\n53\t54\t49\t49\n53\t54\t50\t50\n53\t54\t51\t51\n53\t54\t52\t52\n53\t54\t53\t53\n53\t54\t54\t54\n53\t54\t55\t55\n53\t54\t56\t56\n53\t54\t57\t57\n53\t54\t58\t58\n53\t54\t59\t59\n53\t54\t60\t60\n53\t54\t61\t61\n53\t54\t62\t62\n53\t54\t63\t63\n53\t54\t64\t64\n53\t54\t65\t65\n53\t54\t66\t66\n53\t54\t67\t67\n53\t54\t68\t68\n53\t54\t69\t69\n53\t54\t70\t70\n53\t54\t71\t71\n53\t54\t72\t72\n53\t54\t73\t73\n53\t54\t74\t74\n53\t54\t75\t75\n53\t54\t76\t76\n53\t54\t77\t77\n53\t54\t78\t78\n53\t54\t79\t79\n53\t54\t80\t80\n53\t54\t81\t81\n53\t54\t82\t82\n53\t54\t83\t83\n53\t54\t84\t84\n53\t54\t85\t85\n53\t54\t86\t86\n53\t54\t87\t87\n53\t54\t88\t88\n53\t54\t89\t89\n53\t54\t90\t90\n53\t54\t91\t91\n53\t54\t92\t92\n53\t54\t93\t93\n53\t54\t94\t94\n53\t54\t95\t95\n53\t54\t96\t96\n53\t54\t97\t97\n53\t54\t98\t98\n53\t54\t99\t99\n53\t54\t100\t100\n53\t54\t101\t101\n53\t54\t102\t102\n53\t54\t103\t103\n53\t54\t104\t104\n53\t54\t105\t105\n53\t54\t106\t106\n53\t54\t107\t107\n53\t54\t108\t108\n53\t54\t109\t109\n53\t54\t110\t110\n53\t54\t111\t111\n53\t54\t112\t112\n53\t54\t113\t113\n53\t54\t114\t114\n53\t54\t115\t115\n53\t54\t116\t116\n53\t54\t117\t117\n53\t54\t118\t118\n53\t54\t119\t119\n53\t54\t120\t120\n53\t54\t121\t121\n53\t54\t122\t122\n53\t54\t123\t123\n53\t54\t124\t124\n53\t54\t125\t125\n53\t54\t126\t126\n53\t54\t127\t127\n53\t54\t128\t128\n53\t54\t129\t129\n53\t54\t130\t130\n53\t54\t131\t131\n53\t54\t132\t132\n53\t54\t133\t133\n53\t54\t134\t134\n53\t54\t135\t135\n53\t54\t136\t136\n53\t54\t137\t137\n53\t54\t138\t138\n53\t54\t139\t139\n53\t54\t140\t140\n53\t54\t141\t141\n53\t54\t142\t142\n53\t54\t143\t143\n53\t54\t144\t144\n53\t54\t145\t145\n53\t54\t146\t146\n53\t54\t147\t147\n53\t54\t148\t148\n53\t54\t149\t149\n53\t54\t150\t150\n53\t54\t151\t151\n53\t54\t152\t152\n53\t54\t153\t153\n53\t54\t154\t154\n53\t54\t155\t155\n53\t54\t156\t156\n53\t54\t157\t157\n53\t54\t158\t158\n53\t54\t159\t159\n53\t54\t160\t160\n53\t54\t161\t161\n53\t54\t162\t162\n53\t54\t163\t163\n53\t54\t164\t164\n53\t54\t165\t165\n53\t54\t166\t166\n53\t54\t167\t167\n53\t54\t168\t168\n53\t54\t169\t169\n53\t54\t170\t170\n53\t54\t171\t171\n53\t54\t172\t172\n53\t54\t173\t173\n53\t54\t174\t174\n53\t54\t175\t175\n53\t54\t176\t176\n53\t54\t177\t177\n53\t54\t178\t178\n53\t54\t179\t179\n53\t54\t180\t180\n53\t54\t181\t181\n53\t54\t182\t182\n53\t54\t183\t183\n53\t54\t184\t184\n53\t54\t185\t185\n53\t54\t186\t186\n53\t54\t187\t187\n53\t54\t188\t188\n53\t54\t189\t189\n53\t54\t190\t190\n53\t54\t191\t191\n53\t54\t192\t192\n53\t54\t193\t193\n53\t54\t194\t194\n53\t54\t195\t195\n53\t54\t196\t196\n53\t54\t197\t197\n53\t54\t198\t198\n53\t54\t199\t199\n53\t54\t200\t200\n53\t54\t201\t201\n53\t54\t202\t202\n53\t54\t203\t203\n53\t54\t204\t204\n53\t54\t205\t205\n53\t54\t206\t206\n53\t54\t207\t207\n53\t54\t208\t208\n53\t54\t209\t209\n53\t54\t210\t210\n53\t54\t211\t211\n53\t54\t212\t212\n53\t54\t213\t213\n53\t54\t214\t214\n53\t54\t215\t215\n53\t54\t216\t216\n53\t54\t217\t217\n53\t54\t218\t218\n53\t54\t219\t219\n53\t54\t220\t220\n53\t54\t221\t221\n53\t54\t222\t222\n53\t54\t223\t223\n53\t54\t224\t224\n53\t54\t225\t225\n53\t54\t226\t226\n53\t54\t227\t227\n53\t54\t228\t228\n53\t54\t229\t229\n53\t54\t230\t230\n53\t54\t231\t231\n53\t54\t232\t232\n53\t54\t233\t233\n53\t54\t234\t234\n53\t54\t235\t235\n53\t54\t236\t236\n53\t54\t237\t237\n53\t54\t238\t238\n53\t54\t239\t239\n53\t54\t240\t240\n53\t54\t241\t241\n53\t54\t242\t242\n53\t54\t243\t243\n53\t54\t244\t244\n53\t54\t245\t245\n53\t54\t246\t246\n53\t54\t247\t247\n53\t54\t248\t248\n53\t54\t249\t249\n53\t54\t250\t250\n53\t54\t251\t251\n53\t54\t252\t252\n53\t54\t253\t253\n53\t54\t254\t254\n53\t54\t255\t255\n54\t55\t0\t0\n54\t55\t1\t1\n54\t55\t2\t2\n54\t55\t3\t3\n54\t55\t4\t4\n54\t55\t5\t5\n54\t55\t6\t6\n54\t55\t7\t7\n54\t55\t8\t8\n54\t55\t9\t9\n54\t55\t10\t10\n54\t55\t11\t11\n54\t55\t12\t12\n54\t55\t13\t13\n54\t55\t14\t14\n54\t55\t15\t15\n54\t55\t16\t16\n54\t55\t17\t17\n54\t55\t18\t18\n54\t55\t19\t19\n54\t55\t20\t20\n54\t55\t21\t21\n54\t55\t22\t22\n54\t55\t23\t23\n54\t55\t24\t24\n54\t55\t25\t25\n54\t55\t26\t26\n54\t55\t27\t27\n54\t55\t28\t28\n54\t55\t29\t29\n54\t55\t30\t30\n54\t55\t31\t31\n54\t55\t32\t32\n54\t55\t33\t33\n54\t55\t34\t34\n54\t55\t35\t35\n54\t55\t36\t36\n54\t55\t37\t37\n54\t55\t38\t38\n54\t55\t39\t39\n54\t55\t40\t40\n54\t55\t41\t41\n54\t55\t42\t42\n54\t55\t43\t43\n54\t55\t44\t44\n54\t55\t45\t45\n54\t55\t46\t46\n54\t55\t47\t47\n54\t55\t48\t48\n54\t55\t49\t49\n54\t55\t50\t50\n54\t55\t51\t51\n54\t55\t52\t52\n54\t55\t53\t53\n54\t55\t54\t54\n54\t55\t55\t55\n54\t55\t56\t56\n54\t55\t57\t57\n54\t55\t58\t58\n54\t55\t59\t59\n54\t55\t60\t60\n54\t55\t61\t61\n54\t55\t62\t62\n54\t55\t63\t63\n54\t55\t64\t64\n54\t55\t65\t65\n54\t55\t66\t66\n54\t55\t67\t67\n54\t55\t68\t68\n54\t55\t69\t69\n54\t55\t70\t70\n54\t55\t71\t71\n54\t55\t72\t72\n54\t55\t73\t73\n54\t55\t74\t74\n54\t55\t75\t75\n54\t55\t76\t76\n54\t55\t77\t77\n54\t55\t78\t78\n54\t55\t79\t79\n54\t55\t80\t80\n54\t55\t81\t81\n54\t55\t82\t82\n54\t55\t83\t83\n54\t55\t84\t84\n54\t55\t85\t85\n54\t55\t86\t86\n54\t55\t87\t87\n54\t55\t88\t88\n54\t55\t89\t89\n54\t55\t90\t90\n54\t55\t91\t91\n54\t55\t92\t92\n54\t55\t93\t93\n54\t55\t94\t94\n54\t55\t95\t95\n54\t55\t96\t96\n54\t55\t97\t97\n54\t55\t98\t98\n54\t55\t99\t99\n54\t55\t100\t100\n54\t55\t101\t101\n54\t55\t102\t102\n54\t55\t103\t103\n54\t55\t104\t104\n54\t55\t105\t105\n54\t55\t106\t106\n54\t55\t107\t107\n54\t55\t108\t108\n54\t55\t109\t109\n54\t55\t110\t110\n54\t55\t111\t111\n54\t55\t112\t112\n54\t55\t113\t113\n54\t55\t114\t114\n54\t55\t115\t115\n54\t55\t116\t116\n54\t55\t117\t117\n54\t55\t118\t118\n54\t55\t119\t119\n54\t55\t120\t120\n54\t55\t121\t121\n54\t55\t122\t122\n54\t55\t123\t123\n54\t55\t124\t124\n54\t55\t125\t125\n54\t55\t126\t126\n54\t55\t127\t127\n54\t55\t128\t128\n54\t55\t129\t129\n54\t55\t130\t130\n54\t55\t131\t131\n54\t55\t132\t132\n54\t55\t133\t133\n54\t55\t134\t134\n54\t55\t135\t135\n54\t55\t136\t136\n54\t55\t137\t137\n54\t55\t138\t138\n54\t55\t139\t139\n54\t55\t140\t140\n54\t55\t141\t141\n54\t55\t142\t142\n54\t55\t143\t143\n54\t55\t144\t144\n54\t55\t145\t145\n54\t55\t146\t146\n54\t55\t147\t147\n54\t55\t148\t148\n54\t55\t149\t149\n54\t55\t150\t150\n54\t55\t151\t151\n54\t55\t152\t152\n54\t55\t153\t153\n54\t55\t154\t154\n54\t55\t155\t155\n54\t55\t156\t156\n54\t55\t157\t157\n54\t55\t158\t158\n54\t55\t159\t159\n54\t55\t160\t160\n54\t55\t161\t161\n54\t55\t162\t162\n54\t55\t163\t163\n54\t55\t164\t164\n54\t55\t165\t165\n54\t55\t166\t166\n54\t55\t167\t167\n54\t55\t168\t168\n54\t55\t169\t169\n54\t55\t170\t170\n54\t55\t171\t171\n54\t55\t172\t172\n54\t55\t173\t173\n54\t55\t174\t174\n54\t55\t175\t175\n54\t55\t176\t176\n54\t55\t177\t177\n54\t55\t178\t178\n54\t55\t179\t179\n54\t55\t180\t180\n54\t55\t181\t181\n54\t55\t182\t182\n54\t55\t183\t183\n54\t55\t184\t184\n54\t55\t185\t185\n54\t55\t186\t186\n54\t55\t187\t187\n54\t55\t188\t188\n54\t55\t189\t189\n54\t55\t190\t190\n54\t55\t191\t191\n54\t55\t192\t192\n54\t55\t193\t193\n54\t55\t194\t194\n54\t55\t195\t195\n54\t55\t196\t196\n54\t55\t197\t197\n54\t55\t198\t198\n54\t55\t199\t199\n54\t55\t200\t200\n54\t55\t201\t201\n54\t55\t202\t202\n54\t55\t203\t203\n54\t55\t204\t204\n54\t55\t205\t205\n54\t55\t206\t206\n54\t55\t207\t207\n54\t55\t208\t208\n54\t55\t209\t209\n54\t55\t210\t210\n54\t55\t211\t211\n54\t55\t212\t212\n54\t55\t213\t213\n54\t55\t214\t214\n54\t55\t215\t215\n54\t55\t216\t216\n54\t55\t217\t217\n54\t55\t218\t218\n54\t55\t219\t219\n54\t55\t220\t220\n54\t55\t221\t221\n54\t55\t222\t222\n54\t55\t223\t223\n54\t55\t224\t224\n54\t55\t225\t225\n54\t55\t226\t226\n54\t55\t227\t227\n54\t55\t228\t228\n54\t55\t229\t229\n54\t55\t230\t230\n54\t55\t231\t231\n54\t55\t232\t232\n54\t55\t233\t233\n54\t55\t234\t234\n54\t55\t235\t235\n54\t55\t236\t236\n54\t55\t237\t237\n54\t55\t238\t238\n54\t55\t239\t239\n54\t55\t240\t240\n54\t55\t241\t241\n54\t55\t242\t242\n54\t55\t243\t243\n54\t55\t244\t244\n54\t55\t245\t245\n54\t55\t246\t246\n54\t55\t247\t247\n54\t55\t248\t248\n54\t55\t249\t249\n54\t55\t250\t250\n54\t55\t251\t251\n54\t55\t252\t252\n54\t55\t253\t253\n54\t55\t254\t254\n54\t55\t255\t255\n55\t56\t0\t0\n55\t56\t1\t1\n55\t56\t2\t2\n55\t56\t3\t3\n55\t56\t4\t4\n55\t56\t5\t5\n55\t56\t6\t6\n55\t56\t7\t7\n55\t56\t8\t8\n55\t56\t9\t9\n55\t56\t10\t10\n55\t56\t11\t11\n55\t56\t12\t12\n55\t56\t13\t13\n55\t56\t14\t14\n55\t56\t15\t15\n55\t56\t16\t16\n55\t56\t17\t17\n55\t56\t18\t18\n55\t56\t19\t19\n55\t56\t20\t20\n55\t56\t21\t21\n55\t56\t22\t22\n55\t56\t23\t23\n55\t56\t24\t24\n55\t56\t25\t25\n55\t56\t26\t26\n55\t56\t27\t27\n55\t56\t28\t28\n55\t56\t29\t29\n55\t56\t30\t30\n55\t56\t31\t31\n55\t56\t32\t32\n55\t56\t33\t33\n55\t56\t34\t34\n55\t56\t35\t35\n55\t56\t36\t36\n55\t56\t37\t37\n55\t56\t38\t38\n55\t56\t39\t39\n55\t56\t40\t40\n55\t56\t41\t41\n55\t56\t42\t42\n55\t56\t43\t43\n55\t56\t44\t44\n55\t56\t45\t45\n55\t56\t46\t46\n55\t56\t47\t47\n55\t56\t48\t48\n55\t56\t49\t49\n55\t56\t50\t50\n55\t56\t51\t51\n55\t56\t52\t52\n55\t56\t53\t53\n55\t56\t54\t54\n55\t56\t55\t55\n55\t56\t56\t56\n55\t56\t57\t57\n55\t56\t58\t58\n55\t56\t59\t59\n55\t56\t60\t60\n55\t56\t61\t61\n55\t56\t62\t62\n55\t56\t63\t63\n55\t56\t64\t64\n55\t56\t65\t65\n55\t56\t66\t66\n55\t56\t67\t67\n55\t56\t68\t68\n55\t56\t69\t69\n55\t56\t70\t70\n55\t56\t71\t71\n55\t56\t72\t72\n55\t56\t73\t73\n55\t56\t74\t74\n55\t56\t75\t75\n55\t56\t76\t76\n55\t56\t77\t77\n55\t56\t78\t78\n55\t56\t79\t79\n55\t56\t80\t80\n55\t56\t81\t81\n55\t56\t82\t82\n55\t56\t83\t83\n55\t56\t84\t84\n55\t56\t85\t85\n55\t56\t86\t86\n55\t56\t87\t87\n55\t56\t88\t88\n55\t56\t89\t89\n55\t56\t90\t90\n55\t56\t91\t91\n55\t56\t92\t92\n55\t56\t93\t93\n55\t56\t94\t94\n55\t56\t95\t95\n55\t56\t96\t96\n55\t56\t97\t97\n55\t56\t98\t98\n55\t56\t99\t99\n55\t56\t100\t100\n55\t56\t101\t101\n55\t56\t102\t102\n55\t56\t103\t103\n55\t56\t104\t104\n55\t56\t105\t105\n55\t56\t106\t106\n55\t56\t107\t107\n55\t56\t108\t108\n55\t56\t109\t109\n55\t56\t110\t110\n55\t56\t111\t111\n55\t56\t112\t112\n55\t56\t113\t113\n55\t56\t114\t114\n55\t56\t115\t115\n55\t56\t116\t116\n55\t56\t117\t117\n55\t56\t118\t118\n55\t56\t119\t119\n55\t56\t120\t120\n55\t56\t121\t121\n55\t56\t122\t122\n55\t56\t123\t123\n55\t56\t124\t124\n55\t56\t125\t125\n55\t56\t126\t126\n55\t56\t127\t127\n55\t56\t128\t128\n55\t56\t129\t129\n55\t56\t130\t130\n55\t56\t131\t131\n55\t56\t132\t132\n55\t56\t133\t133\n55\t56\t134\t134\n55\t56\t135\t135\n55\t56\t136\t136\n55\t56\t137\t137\n55\t56\t138\t138\n55\t56\t139\t139\n55\t56\t140\t140\n55\t56\t141\t141\n55\t56\t142\t142\n55\t56\t143\t143\n55\t56\t144\t144\n55\t56\t145\t145\n55\t56\t146\t146\n55\t56\t147\t147\n55\t56\t148\t148\n55\t56\t149\t149\n55\t56\t150\t150\n55\t56\t151\t151\n55\t56\t152\t152\n55\t56\t153\t153\n55\t56\t154\t154\n55\t56\t155\t155\n55\t56\t156\t156\n55\t56\t157\t157\n55\t56\t158\t158\n55\t56\t159\t159\n55\t56\t160\t160\n55\t56\t161\t161\n55\t56\t162\t162\n55\t56\t163\t163\n55\t56\t164\t164\n55\t56\t165\t165\n55\t56\t166\t166\n55\t56\t167\t167\n55\t56\t168\t168\n55\t56\t169\t169\n55\t56\t170\t170\n55\t56\t171\t171\n55\t56\t172\t172\n55\t56\t173\t173\n55\t56\t174\t174\n55\t56\t175\t175\n55\t56\t176\t176\n55\t56\t177\t177\n55\t56\t178\t178\n55\t56\t179\t179\n55\t56\t180\t180\n55\t56\t181\t181\n55\t56\t182\t182\n55\t56\t183\t183\n55\t56\t184\t184\n55\t56\t185\t185\n55\t56\t186\t186\n55\t56\t187\t187\n55\t56\t188\t188\n55\t56\t189\t189\n55\t56\t190\t190\n55\t56\t191\t191\n55\t56\t192\t192\n55\t56\t193\t193\n55\t56\t194\t194\n55\t56\t195\t195\n55\t56\t196\t196\n55\t56\t197\t197\n55\t56\t198\t198\n55\t56\t199\t199\n55\t56\t200\t200\n55\t56\t201\t201\n55\t56\t202\t202\n55\t56\t203\t203\n55\t56\t204\t204\n55\t56\t205\t205\n55\t56\t206\t206\n55\t56\t207\t207\n55\t56\t208\t208\n55\t56\t209\t209\n55\t56\t210\t210\n55\t56\t211\t211\n55\t56\t212\t212\n55\t56\t213\t213\n55\t56\t214\t214\n55\t56\t215\t215\n55\t56\t216\t216\n55\t56\t217\t217\n55\t56\t218\t218\n55\t56\t219\t219\n55\t56\t220\t220\n55\t56\t221\t221\n55\t56\t222\t222\n55\t56\t223\t223\n55\t56\t224\t224\n55\t56\t225\t225\n55\t56\t226\t226\n55\t56\t227\t227\n55\t56\t228\t228\n55\t56\t229\t229\n55\t56\t230\t230\n55\t56\t231\t231\n55\t56\t232\t232\n55\t56\t233\t233\n55\t56\t234\t234\n55\t56\t235\t235\n55\t56\t236\t236\n55\t56\t237\t237\n55\t56\t238\t238\n55\t56\t239\t239\n55\t56\t240\t240\n55\t56\t241\t241\n55\t56\t242\t242\n55\t56\t243\t243\n55\t56\t244\t244\n55\t56\t245\t245\n55\t56\t246\t246\n55\t56\t247\t247\n55\t56\t248\t248\n55\t56\t249\t249\n55\t56\t250\t250\n55\t56\t251\t251\n55\t56\t252\t252\n55\t56\t253\t253\n55\t56\t254\t254\n55\t56\t255\t255\n56\t57\t0\t0\n56\t57\t1\t1\n56\t57\t2\t2\n56\t57\t3\t3\n56\t57\t4\t4\n56\t57\t5\t5\n56\t57\t6\t6\n56\t57\t7\t7\n56\t57\t8\t8\n56\t57\t9\t9\n56\t57\t10\t10\n56\t57\t11\t11\n56\t57\t12\t12\n56\t57\t13\t13\n56\t57\t14\t14\n56\t57\t15\t15\n56\t57\t16\t16\n56\t57\t17\t17\n56\t57\t18\t18\n56\t57\t19\t19\n56\t57\t20\t20\n56\t57\t21\t21\n56\t57\t22\t22\n56\t57\t23\t23\n56\t57\t24\t24\n56\t57\t25\t25\n56\t57\t26\t26\n56\t57\t27\t27\n56\t57\t28\t28\n56\t57\t29\t29\n56\t57\t30\t30\n56\t57\t31\t31\n56\t57\t32\t32\n56\t57\t33\t33\n56\t57\t34\t34\n56\t57\t35\t35\n56\t57\t36\t36\n56\t57\t37\t37\n56\t57\t38\t38\n56\t57\t39\t39\n56\t57\t40\t40\n56\t57\t41\t41\n56\t57\t42\t42\n56\t57\t43\t43\n56\t57\t44\t44\n56\t57\t45\t45\n56\t57\t46\t46\n56\t57\t47\t47\n56\t57\t48\t48\n56\t57\t49\t49\n56\t57\t50\t50\n56\t57\t51\t51\n56\t57\t52\t52\n56\t57\t53\t53\n56\t57\t54\t54\n56\t57\t55\t55\n56\t57\t56\t56\n56\t57\t57\t57\n56\t57\t58\t58\n56\t57\t59\t59\n56\t57\t60\t60\n56\t57\t61\t61\n56\t57\t62\t62\n56\t57\t63\t63\n56\t57\t64\t64\n56\t57\t65\t65\n56\t57\t66\t66\n56\t57\t67\t67\n56\t57\t68\t68\n56\t57\t69\t69\n56\t57\t70\t70\n56\t57\t71\t71\n56\t57\t72\t72\n56\t57\t73\t73\n56\t57\t74\t74\n56\t57\t75\t75\n56\t57\t76\t76\n56\t57\t77\t77\n56\t57\t78\t78\n56\t57\t79\t79\n56\t57\t80\t80\n56\t57\t81\t81\n56\t57\t82\t82\n56\t57\t83\t83\n56\t57\t84\t84\n56\t57\t85\t85\n56\t57\t86\t86\n56\t57\t87\t87\n56\t57\t88\t88\n56\t57\t89\t89\n56\t57\t90\t90\n56\t57\t91\t91\n56\t57\t92\t92\n56\t57\t93\t93\n56\t57\t94\t94\n56\t57\t95\t95\n56\t57\t96\t96\n56\t57\t97\t97\n56\t57\t98\t98\n56\t57\t99\t99\n56\t57\t100\t100\n56\t57\t101\t101\n56\t57\t102\t102\n56\t57\t103\t103\n56\t57\t104\t104\n56\t57\t105\t105\n56\t57\t106\t106\n56\t57\t107\t107\n56\t57\t108\t108\n56\t57\t109\t109\n56\t57\t110\t110\n56\t57\t111\t111\n56\t57\t112\t112\n56\t57\t113\t113\n56\t57\t114\t114\n56\t57\t115\t115\n56\t57\t116\t116\n56\t57\t117\t117\n56\t57\t118\t118\n56\t57\t119\t119\n56\t57\t120\t120\n56\t57\t121\t121\n56\t57\t122\t122\n56\t57\t123\t123\n56\t57\t124\t124\n56\t57\t125\t125\n56\t57\t126\t126\n56\t57\t127\t127\n56\t57\t128\t128\n56\t57\t129\t129\n56\t57\t130\t130\n56\t57\t131\t131\n56\t57\t132\t132\n56\t57\t133\t133\n56\t57\t134\t134\n56\t57\t135\t135\n56\t57\t136\t136\n56\t57\t137\t137\n56\t57\t138\t138\n56\t57\t139\t139\n56\t57\t140\t140\n56\t57\t141\t141\n56\t57\t142\t142\n56\t57\t143\t143\n56\t57\t144\t144\n56\t57\t145\t145\n56\t57\t146\t146\n56\t57\t147\t147\n56\t57\t148\t148\n56\t57\t149\t149\n56\t57\t150\t150\n56\t57\t151\t151\n56\t57\t152\t152\n56\t57\t153\t153\n56\t57\t154\t154\n56\t57\t155\t155\n56\t57\t156\t156\n56\t57\t157\t157\n56\t57\t158\t158\n56\t57\t159\t159\n56\t57\t160\t160\n56\t57\t161\t161\n56\t57\t162\t162\n56\t57\t163\t163\n56\t57\t164\t164\n56\t57\t165\t165\n56\t57\t166\t166\n56\t57\t167\t167\n56\t57\t168\t168\n56\t57\t169\t169\n56\t57\t170\t170\n56\t57\t171\t171\n56\t57\t172\t172\n56\t57\t173\t173\n56\t57\t174\t174\n56\t57\t175\t175\n56\t57\t176\t176\n56\t57\t177\t177\n56\t57\t178\t178\n56\t57\t179\t179\n56\t57\t180\t180\n56\t57\t181\t181\n56\t57\t182\t182\n56\t57\t183\t183\n56\t57\t184\t184\n56\t57\t185\t185\n56\t57\t186\t186\n56\t57\t187\t187\n56\t57\t188\t188\n56\t57\t189\t189\n56\t57\t190\t190\n56\t57\t191\t191\n56\t57\t192\t192\n56\t57\t193\t193\n56\t57\t194\t194\n56\t57\t195\t195\n56\t57\t196\t196\n56\t57\t197\t197\n56\t57\t198\t198\n56\t57\t199\t199\n56\t57\t200\t200\n56\t57\t201\t201\n56\t57\t202\t202\n56\t57\t203\t203\n56\t57\t204\t204\n56\t57\t205\t205\n56\t57\t206\t206\n56\t57\t207\t207\n56\t57\t208\t208\n56\t57\t209\t209\n56\t57\t210\t210\n56\t57\t211\t211\n56\t57\t212\t212\n56\t57\t213\t213\n56\t57\t214\t214\n56\t57\t215\t215\n56\t57\t216\t216\n56\t57\t217\t217\n56\t57\t218\t218\n56\t57\t219\t219\n56\t57\t220\t220\n56\t57\t221\t221\n56\t57\t222\t222\n56\t57\t223\t223\n56\t57\t224\t224\n56\t57\t225\t225\n56\t57\t226\t226\n56\t57\t227\t227\n56\t57\t228\t228\n56\t57\t229\t229\n56\t57\t230\t230\n56\t57\t231\t231\n56\t57\t232\t232\n56\t57\t233\t233\n56\t57\t234\t234\n56\t57\t235\t235\n56\t57\t236\t236\n56\t57\t237\t237\n56\t57\t238\t238\n56\t57\t239\t239\n56\t57\t240\t240\n56\t57\t241\t241\n56\t57\t242\t242\n56\t57\t243\t243\n56\t57\t244\t244\n56\t57\t245\t245\n56\t57\t246\t246\n56\t57\t247\t247\n56\t57\t248\t248\n56\t57\t249\t249\n56\t57\t250\t250\n56\t57\t251\t251\n56\t57\t252\t252\n56\t57\t253\t253\n56\t57\t254\t254\n56\t57\t255\t255\n57\t58\t0\t0\n57\t58\t1\t1\n57\t58\t2\t2\n57\t58\t3\t3\n57\t58\t4\t4\n57\t58\t5\t5\n57\t58\t6\t6\n57\t58\t7\t7\n57\t58\t8\t8\n57\t58\t9\t9\n57\t58\t10\t10\n57\t58\t11\t11\n57\t58\t12\t12\n57\t58\t13\t13\n57\t58\t14\t14\n57\t58\t15\t15\n57\t58\t16\t16\n57\t58\t17\t17\n57\t58\t18\t18\n57\t58\t19\t19\n57\t58\t20\t20\n57\t58\t21\t21\n57\t58\t22\t22\n57\t58\t23\t23\n57\t58\t24\t24\n57\t58\t25\t25\n57\t58\t26\t26\n57\t58\t27\t27\n57\t58\t28\t28\n57\t58\t29\t29\n57\t58\t30\t30\n57\t58\t31\t31\n57\t58\t32\t32\n57\t58\t33\t33\n57\t58\t34\t34\n57\t58\t35\t35\n57\t58\t36\t36\n57\t58\t37\t37\n57\t58\t38\t38\n57\t58\t39\t39\n57\t58\t40\t40\n57\t58\t41\t41\n57\t58\t42\t42\n57\t58\t43\t43\n57\t58\t44\t44\n57\t58\t45\t45\n57\t58\t46\t46\n57\t58\t47\t47\n57\t58\t48\t48\n57\t58\t49\t49\n57\t58\t50\t50\n57\t58\t51\t51\n57\t58\t52\t52\n57\t58\t53\t53\n57\t58\t54\t54\n57\t58\t55\t55\n57\t58\t56\t56\n57\t58\t57\t57\n57\t58\t58\t58\n57\t58\t59\t59\n57\t58\t60\t60\n57\t58\t61\t61\n57\t58\t62\t62\n57\t58\t63\t63\n57\t58\t64\t64\n57\t58\t65\t65\n57\t58\t66\t66\n57\t58\t67\t67\n57\t58\t68\t68\n57\t58\t69\t69\n57\t58\t70\t70\n57\t58\t71\t71\n57\t58\t72\t72\n57\t58\t73\t73\n57\t58\t74\t74\n57\t58\t75\t75\n57\t58\t76\t76\n57\t58\t77\t77\n57\t58\t78\t78\n57\t58\t79\t79\n57\t58\t80\t80\n57\t58\t81\t81\n57\t58\t82\t82\n57\t58\t83\t83\n57\t58\t84\t84\n57\t58\t85\t85\n57\t58\t86\t86\n57\t58\t87\t87\n57\t58\t88\t88\n57\t58\t89\t89\n57\t58\t90\t90\n57\t58\t91\t91\n57\t58\t92\t92\n57\t58\t93\t93\n57\t58\t94\t94\n57\t58\t95\t95\n57\t58\t96\t96\n57\t58\t97\t97\n57\t58\t98\t98\n57\t58\t99\t99\n57\t58\t100\t100\n57\t58\t101\t101\n57\t58\t102\t102\n57\t58\t103\t103\n57\t58\t104\t104\n57\t58\t105\t105\n57\t58\t106\t106\n57\t58\t107\t107\n57\t58\t108\t108\n57\t58\t109\t109\n57\t58\t110\t110\n57\t58\t111\t111\n57\t58\t112\t112\n57\t58\t113\t113\n57\t58\t114\t114\n57\t58\t115\t115\n57\t58\t116\t116\n57\t58\t117\t117\n57\t58\t118\t118\n57\t58\t119\t119\n57\t58\t120\t120\n57\t58\t121\t121\n57\t58\t122\t122\n57\t58\t123\t123\n57\t58\t124\t124\n57\t58\t125\t125\n57\t58\t126\t126\n57\t58\t127\t127\n57\t58\t128\t128\n57\t58\t129\t129\n57\t58\t130\t130\n57\t58\t131\t131\n57\t58\t132\t132\n57\t58\t133\t133\n57\t58\t134\t134\n57\t58\t135\t135\n57\t58\t136\t136\n57\t58\t137\t137\n57\t58\t138\t138\n57\t58\t139\t139\n57\t58\t140\t140\n57\t58\t141\t141\n57\t58\t142\t142\n57\t58\t143\t143\n57\t58\t144\t144\n57\t58\t145\t145\n57\t58\t146\t146\n57\t58\t147\t147\n57\t58\t148\t148\n57\t58\t149\t149\n57\t58\t150\t150\n57\t58\t151\t151\n57\t58\t152\t152\n57\t58\t153\t153\n57\t58\t154\t154\n57\t58\t155\t155\n57\t58\t156\t156\n57\t58\t157\t157\n57\t58\t158\t158\n57\t58\t159\t159\n57\t58\t160\t160\n57\t58\t161\t161\n57\t58\t162\t162\n57\t58\t163\t163\n57\t58\t164\t164\n57\t58\t165\t165\n57\t58\t166\t166\n57\t58\t167\t167\n57\t58\t168\t168\n57\t58\t169\t169\n57\t58\t170\t170\n57\t58\t171\t171\n57\t58\t172\t172\n57\t58\t173\t173\n57\t58\t174\t174\n57\t58\t175\t175\n57\t58\t176\t176\n57\t58\t177\t177\n57\t58\t178\t178\n57\t58\t179\t179\n57\t58\t180\t180\n57\t58\t181\t181\n57\t58\t182\t182\n57\t58\t183\t183\n57\t58\t184\t184\n57\t58\t185\t185\n57\t58\t186\t186\n57\t58\t187\t187\n57\t58\t188\t188\n57\t58\t189\t189\n57\t58\t190\t190\n57\t58\t191\t191\n57\t58\t192\t192\n57\t58\t193\t193\n57\t58\t194\t194\n57\t58\t195\t195\n57\t58\t196\t196\n57\t58\t197\t197\n57\t58\t198\t198\n57\t58\t199\t199\n57\t58\t200\t200\n57\t58\t201\t201\n57\t58\t202\t202\n57\t58\t203\t203\n57\t58\t204\t204\n57\t58\t205\t205\n57\t58\t206\t206\n57\t58\t207\t207\n57\t58\t208\t208\n57\t58\t209\t209\n57\t58\t210\t210\n57\t58\t211\t211\n57\t58\t212\t212\n57\t58\t213\t213\n57\t58\t214\t214\n57\t58\t215\t215\n57\t58\t216\t216\n57\t58\t217\t217\n57\t58\t218\t218\n57\t58\t219\t219\n57\t58\t220\t220\n57\t58\t221\t221\n57\t58\t222\t222\n57\t58\t223\t223\n57\t58\t224\t224\n57\t58\t225\t225\n57\t58\t226\t226\n57\t58\t227\t227\n57\t58\t228\t228\n57\t58\t229\t229\n57\t58\t230\t230\n57\t58\t231\t231\n57\t58\t232\t232\n57\t58\t233\t233\n57\t58\t234\t234\n57\t58\t235\t235\n57\t58\t236\t236\n57\t58\t237\t237\n57\t58\t238\t238\n57\t58\t239\t239\n57\t58\t240\t240\n57\t58\t241\t241\n57\t58\t242\t242\n57\t58\t243\t243\n57\t58\t244\t244\n57\t58\t245\t245\n57\t58\t246\t246\n57\t58\t247\t247\n57\t58\t248\t248\n57\t58\t249\t249\n57\t58\t250\t250\n57\t58\t251\t251\n57\t58\t252\t252\n57\t58\t253\t253\n57\t58\t254\t254\n57\t58\t255\t255\n58\t59\t0\t0\n58\t59\t1\t1\n58\t59\t2\t2\n58\t59\t3\t3\n58\t59\t4\t4\n58\t59\t5\t5\n58\t59\t6\t6\n58\t59\t7\t7\n58\t59\t8\t8\n58\t59\t9\t9\n58\t59\t10\t10\n58\t59\t11\t11\n58\t59\t12\t12\n58\t59\t13\t13\n58\t59\t14\t14\n58\t59\t15\t15\n58\t59\t16\t16\n58\t59\t17\t17\n58\t59\t18\t18\n58\t59\t19\t19\n58\t59\t20\t20\n58\t59\t21\t21\n58\t59\t22\t22\n58\t59\t23\t23\n58\t59\t24\t24\n58\t59\t25\t25\n58\t59\t26\t26\n58\t59\t27\t27\n58\t59\t28\t28\n58\t59\t29\t29\n58\t59\t30\t30\n58\t59\t31\t31\n58\t59\t32\t32\n58\t59\t33\t33\n58\t59\t34\t34\n58\t59\t35\t35\n58\t59\t36\t36\n58\t59\t37\t37\n58\t59\t38\t38\n58\t59\t39\t39\n58\t59\t40\t40\n58\t59\t41\t41\n58\t59\t42\t42\n58\t59\t43\t43\n58\t59\t44\t44\n58\t59\t45\t45\n58\t59\t46\t46\n58\t59\t47\t47\n58\t59\t48\t48\n58\t59\t49\t49\n58\t59\t50\t50\n58\t59\t51\t51\n58\t59\t52\t52\n58\t59\t53\t53\n58\t59\t54\t54\n58\t59\t55\t55\n58\t59\t56\t56\n58\t59\t57\t57\n58\t59\t58\t58\n58\t59\t59\t59\n58\t59\t60\t60\n58\t59\t61\t61\n58\t59\t62\t62\n58\t59\t63\t63\n58\t59\t64\t64\n58\t59\t65\t65\n58\t59\t66\t66\n58\t59\t67\t67\n58\t59\t68\t68\n58\t59\t69\t69\n58\t59\t70\t70\n58\t59\t71\t71\n58\t59\t72\t72\n58\t59\t73\t73\n58\t59\t74\t74\n58\t59\t75\t75\n58\t59\t76\t76\n58\t59\t77\t77\n58\t59\t78\t78\n58\t59\t79\t79\n58\t59\t80\t80\n58\t59\t81\t81\n58\t59\t82\t82\n58\t59\t83\t83\n58\t59\t84\t84\n58\t59\t85\t85\n58\t59\t86\t86\n58\t59\t87\t87\n58\t59\t88\t88\n58\t59\t89\t89\n58\t59\t90\t90\n58\t59\t91\t91\n58\t59\t92\t92\n58\t59\t93\t93\n58\t59\t94\t94\n58\t59\t95\t95\n58\t59\t96\t96\n58\t59\t97\t97\n58\t59\t98\t98\n58\t59\t99\t99\n58\t59\t100\t100\n58\t59\t101\t101\n58\t59\t102\t102\n58\t59\t103\t103\n58\t59\t104\t104\n58\t59\t105\t105\n58\t59\t106\t106\n58\t59\t107\t107\n58\t59\t108\t108\n58\t59\t109\t109\n58\t59\t110\t110\n58\t59\t111\t111\n58\t59\t112\t112\n58\t59\t113\t113\n58\t59\t114\t114\n58\t59\t115\t115\n58\t59\t116\t116\n58\t59\t117\t117\n58\t59\t118\t118\n58\t59\t119\t119\n58\t59\t120\t120\n58\t59\t121\t121\n58\t59\t122\t122\n58\t59\t123\t123\n58\t59\t124\t124\n58\t59\t125\t125\n58\t59\t126\t126\n58\t59\t127\t127\n58\t59\t128\t128\n58\t59\t129\t129\n58\t59\t130\t130\n58\t59\t131\t131\n58\t59\t132\t132\n58\t59\t133\t133\n58\t59\t134\t134\n58\t59\t135\t135\n58\t59\t136\t136\n58\t59\t137\t137\n58\t59\t138\t138\n58\t59\t139\t139\n58\t59\t140\t140\n58\t59\t141\t141\n58\t59\t142\t142\n58\t59\t143\t143\n58\t59\t144\t144\n58\t59\t145\t145\n58\t59\t146\t146\n58\t59\t147\t147\n58\t59\t148\t148\n58\t59\t149\t149\n58\t59\t150\t150\n58\t59\t151\t151\n58\t59\t152\t152\n58\t59\t153\t153\n58\t59\t154\t154\n58\t59\t155\t155\n58\t59\t156\t156\n58\t59\t157\t157\n58\t59\t158\t158\n58\t59\t159\t159\n58\t59\t160\t160\n58\t59\t161\t161\n58\t59\t162\t162\n58\t59\t163\t163\n58\t59\t164\t164\n58\t59\t165\t165\n58\t59\t166\t166\n58\t59\t167\t167\n58\t59\t168\t168\n58\t59\t169\t169\n58\t59\t170\t170\n58\t59\t171\t171\n58\t59\t172\t172\n58\t59\t173\t173\n58\t59\t174\t174\n58\t59\t175\t175\n58\t59\t176\t176\n58\t59\t177\t177\n58\t59\t178\t178\n58\t59\t179\t179\n58\t59\t180\t180\n58\t59\t181\t181\n58\t59\t182\t182\n58\t59\t183\t183\n58\t59\t184\t184\n58\t59\t185\t185\n58\t59\t186\t186\n58\t59\t187\t187\n58\t59\t188\t188\n58\t59\t189\t189\n58\t59\t190\t190\n58\t59\t191\t191\n58\t59\t192\t192\n58\t59\t193\t193\n58\t59\t194\t194\n58\t59\t195\t195\n58\t59\t196\t196\n58\t59\t197\t197\n58\t59\t198\t198\n58\t59\t199\t199\n58\t59\t200\t200\n58\t59\t201\t201\n58\t59\t202\t202\n58\t59\t203\t203\n58\t59\t204\t204\n58\t59\t205\t205\n58\t59\t206\t206\n58\t59\t207\t207\n58\t59\t208\t208\n58\t59\t209\t209\n58\t59\t210\t210\n58\t59\t211\t211\n58\t59\t212\t212\n58\t59\t213\t213\n58\t59\t214\t214\n58\t59\t215\t215\n58\t59\t216\t216\n58\t59\t217\t217\n58\t59\t218\t218\n58\t59\t219\t219\n58\t59\t220\t220\n58\t59\t221\t221\n58\t59\t222\t222\n58\t59\t223\t223\n58\t59\t224\t224\n58\t59\t225\t225\n58\t59\t226\t226\n58\t59\t227\t227\n58\t59\t228\t228\n58\t59\t229\t229\n58\t59\t230\t230\n58\t59\t231\t231\n58\t59\t232\t232\n58\t59\t233\t233\n58\t59\t234\t234\n58\t59\t235\t235\n58\t59\t236\t236\n58\t59\t237\t237\n58\t59\t238\t238\n58\t59\t239\t239\n58\t59\t240\t240\n58\t59\t241\t241\n58\t59\t242\t242\n58\t59\t243\t243\n58\t59\t244\t244\n58\t59\t245\t245\n58\t59\t246\t246\n58\t59\t247\t247\n58\t59\t248\t248\n58\t59\t249\t249\n58\t59\t250\t250\n58\t59\t251\t251\n58\t59\t252\t252\n58\t59\t253\t253\n58\t59\t254\t254\n58\t59\t255\t255\n59\t60\t0\t0\n59\t60\t1\t1\n59\t60\t2\t2\n59\t60\t3\t3\n59\t60\t4\t4\n59\t60\t5\t5\n59\t60\t6\t6\n59\t60\t7\t7\n59\t60\t8\t8\n59\t60\t9\t9\n59\t60\t10\t10\n59\t60\t11\t11\n59\t60\t12\t12\n59\t60\t13\t13\n59\t60\t14\t14\n59\t60\t15\t15\n59\t60\t16\t16\n59\t60\t17\t17\n59\t60\t18\t18\n59\t60\t19\t19\n59\t60\t20\t20\n59\t60\t21\t21\n59\t60\t22\t22\n59\t60\t23\t23\n59\t60\t24\t24\n59\t60\t25\t25\n59\t60\t26\t26\n59\t60\t27\t27\n59\t60\t28\t28\n59\t60\t29\t29\n59\t60\t30\t30\n59\t60\t31\t31\n59\t60\t32\t32\n59\t60\t33\t33\n59\t60\t34\t34\n59\t60\t35\t35\n59\t60\t36\t36\n59\t60\t37\t37\n59\t60\t38\t38\n59\t60\t39\t39\n59\t60\t40\t40\n59\t60\t41\t41\n59\t60\t42\t42\n59\t60\t43\t43\n59\t60\t44\t44\n59\t60\t45\t45\n59\t60\t46\t46\n59\t60\t47\t47\n59\t60\t48\t48\n59\t60\t49\t49\n59\t60\t50\t50\n59\t60\t51\t51\n59\t60\t52\t52\n59\t60\t53\t53\n59\t60\t54\t54\n59\t60\t55\t55\n59\t60\t56\t56\n59\t60\t57\t57\n59\t60\t58\t58\n59\t60\t59\t59\n59\t60\t60\t60\n59\t60\t61\t61\n59\t60\t62\t62\n59\t60\t63\t63\n59\t60\t64\t64\n59\t60\t65\t65\n59\t60\t66\t66\n59\t60\t67\t67\n59\t60\t68\t68\n59\t60\t69\t69\n59\t60\t70\t70\n59\t60\t71\t71\n59\t60\t72\t72\n59\t60\t73\t73\n59\t60\t74\t74\n59\t60\t75\t75\n59\t60\t76\t76\n59\t60\t77\t77\n59\t60\t78\t78\n59\t60\t79\t79\n59\t60\t80\t80\n59\t60\t81\t81\n59\t60\t82\t82\n59\t60\t83\t83\n59\t60\t84\t84\n59\t60\t85\t85\n59\t60\t86\t86\n59\t60\t87\t87\n59\t60\t88\t88\n59\t60\t89\t89\n59\t60\t90\t90\n59\t60\t91\t91\n59\t60\t92\t92\n59\t60\t93\t93\n59\t60\t94\t94\n59\t60\t95\t95\n59\t60\t96\t96\n59\t60\t97\t97\n59\t60\t98\t98\n59\t60\t99\t99\n59\t60\t100\t100\n59\t60\t101\t101\n59\t60\t102\t102\n59\t60\t103\t103\n59\t60\t104\t104\n59\t60\t105\t105\n59\t60\t106\t106\n59\t60\t107\t107\n59\t60\t108\t108\n59\t60\t109\t109\n59\t60\t110\t110\n59\t60\t111\t111\n59\t60\t112\t112\n59\t60\t113\t113\n59\t60\t114\t114\n59\t60\t115\t115\n59\t60\t116\t116\n59\t60\t117\t117\n59\t60\t118\t118\n59\t60\t119\t119\n59\t60\t120\t120\n59\t60\t121\t121\n59\t60\t122\t122\n59\t60\t123\t123\n59\t60\t124\t124\n59\t60\t125\t125\n59\t60\t126\t126\n59\t60\t127\t127\n59\t60\t128\t128\n59\t60\t129\t129\n59\t60\t130\t130\n59\t60\t131\t131\n59\t60\t132\t132\n59\t60\t133\t133\n59\t60\t134\t134\n59\t60\t135\t135\n59\t60\t136\t136\n59\t60\t137\t137\n59\t60\t138\t138\n59\t60\t139\t139\n59\t60\t140\t140\n59\t60\t141\t141\n59\t60\t142\t142\n59\t60\t143\t143\n59\t60\t144\t144\n59\t60\t145\t145\n59\t60\t146\t146\n59\t60\t147\t147\n59\t60\t148\t148\n59\t60\t149\t149\n59\t60\t150\t150\n59\t60\t151\t151\n59\t60\t152\t152\n59\t60\t153\t153\n59\t60\t154\t154\n59\t60\t155\t155\n59\t60\t156\t156\n59\t60\t157\t157\n59\t60\t158\t158\n59\t60\t159\t159\n59\t60\t160\t160\n59\t60\t161\t161\n59\t60\t162\t162\n59\t60\t163\t163\n59\t60\t164\t164\n59\t60\t165\t165\n59\t60\t166\t166\n59\t60\t167\t167\n59\t60\t168\t168\n59\t60\t169\t169\n59\t60\t170\t170\n59\t60\t171\t171\n59\t60\t172\t172\n59\t60\t173\t173\n59\t60\t174\t174\n59\t60\t175\t175\n59\t60\t176\t176\n59\t60\t177\t177\n59\t60\t178\t178\n59\t60\t179\t179\n59\t60\t180\t180\n59\t60\t181\t181\n59\t60\t182\t182\n59\t60\t183\t183\n59\t60\t184\t184\n59\t60\t185\t185\n59\t60\t186\t186\n59\t60\t187\t187\n59\t60\t188\t188\n59\t60\t189\t189\n59\t60\t190\t190\n59\t60\t191\t191\n59\t60\t192\t192\n59\t60\t193\t193\n59\t60\t194\t194\n59\t60\t195\t195\n59\t60\t196\t196\n59\t60\t197\t197\n59\t60\t198\t198\n59\t60\t199\t199\n59\t60\t200\t200\n59\t60\t201\t201\n59\t60\t202\t202\n59\t60\t203\t203\n59\t60\t204\t204\n59\t60\t205\t205\n59\t60\t206\t206\n59\t60\t207\t207\n59\t60\t208\t208\n59\t60\t209\t209\n59\t60\t210\t210\n59\t60\t211\t211\n59\t60\t212\t212\n59\t60\t213\t213\n59\t60\t214\t214\n59\t60\t215\t215\n59\t60\t216\t216\n59\t60\t217\t217\n59\t60\t218\t218\n59\t60\t219\t219\n59\t60\t220\t220\n59\t60\t221\t221\n59\t60\t222\t222\n59\t60\t223\t223\n59\t60\t224\t224\n59\t60\t225\t225\n59\t60\t226\t226\n59\t60\t227\t227\n59\t60\t228\t228\n59\t60\t229\t229\n59\t60\t230\t230\n59\t60\t231\t231\n59\t60\t232\t232\n59\t60\t233\t233\n59\t60\t234\t234\n59\t60\t235\t235\n59\t60\t236\t236\n59\t60\t237\t237\n59\t60\t238\t238\n59\t60\t239\t239\n59\t60\t240\t240\n59\t60\t241\t241\n59\t60\t242\t242\n59\t60\t243\t243\n59\t60\t244\t244\n59\t60\t245\t245\n59\t60\t246\t246\n59\t60\t247\t247\n59\t60\t248\t248\n59\t60\t249\t249\n59\t60\t250\t250\n59\t60\t251\t251\n59\t60\t252\t252\n59\t60\t253\t253\n59\t60\t254\t254\n59\t60\t255\t255\n60\t61\t0\t0\n60\t61\t1\t1\n60\t61\t2\t2\n60\t61\t3\t3\n60\t61\t4\t4\n60\t61\t5\t5\n60\t61\t6\t6\n60\t61\t7\t7\n60\t61\t8\t8\n60\t61\t9\t9\n60\t61\t10\t10\n60\t61\t11\t11\n60\t61\t12\t12\n60\t61\t13\t13\n60\t61\t14\t14\n60\t61\t15\t15\n60\t61\t16\t16\n60\t61\t17\t17\n60\t61\t18\t18\n60\t61\t19\t19\n60\t61\t20\t20\n60\t61\t21\t21\n60\t61\t22\t22\n60\t61\t23\t23\n60\t61\t24\t24\n60\t61\t25\t25\n60\t61\t26\t26\n60\t61\t27\t27\n60\t61\t28\t28\n60\t61\t29\t29\n60\t61\t30\t30\n60\t61\t31\t31\n60\t61\t32\t32\n60\t61\t33\t33\n60\t61\t34\t34\n60\t61\t35\t35\n60\t61\t36\t36\n60\t61\t37\t37\n60\t61\t38\t38\n60\t61\t39\t39\n60\t61\t40\t40\n60\t61\t41\t41\n60\t61\t42\t42\n60\t61\t43\t43\n60\t61\t44\t44\n60\t61\t45\t45\n60\t61\t46\t46\n60\t61\t47\t47\n60\t61\t48\t48\n60\t61\t49\t49\n60\t61\t50\t50\n60\t61\t51\t51\n60\t61\t52\t52\n60\t61\t53\t53\n60\t61\t54\t54\n60\t61\t55\t55\n60\t61\t56\t56\n60\t61\t57\t57\n60\t61\t58\t58\n60\t61\t59\t59\n60\t61\t60\t60\n60\t61\t61\t61\n60\t61\t62\t62\n60\t61\t63\t63\n60\t61\t64\t64\n60\t61\t65\t65\n60\t61\t66\t66\n60\t61\t67\t67\n60\t61\t68\t68\n60\t61\t69\t69\n60\t61\t70\t70\n60\t61\t71\t71\n60\t61\t72\t72\n60\t61\t73\t73\n60\t61\t74\t74\n60\t61\t75\t75\n60\t61\t76\t76\n60\t61\t77\t77\n60\t61\t78\t78\n60\t61\t79\t79\n60\t61\t80\t80\n60\t61\t81\t81\n60\t61\t82\t82\n60\t61\t83\t83\n60\t61\t84\t84\n60\t61\t85\t85\n60\t61\t86\t86\n60\t61\t87\t87\n60\t61\t88\t88\n60\t61\t89\t89\n60\t61\t90\t90\n60\t61\t91\t91\n60\t61\t92\t92\n60\t61\t93\t93\n60\t61\t94\t94\n60\t61\t95\t95\n60\t61\t96\t96\n60\t61\t97\t97\n60\t61\t98\t98\n60\t61\t99\t99\n60\t61\t100\t100\n60\t61\t101\t101\n60\t61\t102\t102\n60\t61\t103\t103\n60\t61\t104\t104\n60\t61\t105\t105\n60\t61\t106\t106\n60\t61\t107\t107\n60\t61\t108\t108\n60\t61\t109\t109\n60\t61\t110\t110\n60\t61\t111\t111\n60\t61\t112\t112\n60\t61\t113\t113\n60\t61\t114\t114\n60\t61\t115\t115\n60\t61\t116\t116\n60\t61\t117\t117\n60\t61\t118\t118\n60\t61\t119\t119\n60\t61\t120\t120\n60\t61\t121\t121\n60\t61\t122\t122\n60\t61\t123\t123\n60\t61\t124\t124\n60\t61\t125\t125\n60\t61\t126\t126\n60\t61\t127\t127\n60\t61\t128\t128\n60\t61\t129\t129\n60\t61\t130\t130\n60\t61\t131\t131\n60\t61\t132\t132\n60\t61\t133\t133\n60\t61\t134\t134\n60\t61\t135\t135\n60\t61\t136\t136\n60\t61\t137\t137\n60\t61\t138\t138\n60\t61\t139\t139\n60\t61\t140\t140\n60\t61\t141\t141\n60\t61\t142\t142\n60\t61\t143\t143\n60\t61\t144\t144\n60\t61\t145\t145\n60\t61\t146\t146\n60\t61\t147\t147\n60\t61\t148\t148\n60\t61\t149\t149\n60\t61\t150\t150\n60\t61\t151\t151\n60\t61\t152\t152\n60\t61\t153\t153\n60\t61\t154\t154\n60\t61\t155\t155\n60\t61\t156\t156\n60\t61\t157\t157\n60\t61\t158\t158\n60\t61\t159\t159\n60\t61\t160\t160\n60\t61\t161\t161\n60\t61\t162\t162\n60\t61\t163\t163\n60\t61\t164\t164\n60\t61\t165\t165\n60\t61\t166\t166\n60\t61\t167\t167\n60\t61\t168\t168\n60\t61\t169\t169\n60\t61\t170\t170\n60\t61\t171\t171\n60\t61\t172\t172\n60\t61\t173\t173\n60\t61\t174\t174\n60\t61\t175\t175\n60\t61\t176\t176\n60\t61\t177\t177\n60\t61\t178\t178\n60\t61\t179\t179\n60\t61\t180\t180\n60\t61\t181\t181\n60\t61\t182\t182\n60\t61\t183\t183\n60\t61\t184\t184\n60\t61\t185\t185\n60\t61\t186\t186\n60\t61\t187\t187\n60\t61\t188\t188\n60\t61\t189\t189\n60\t61\t190\t190\n60\t61\t191\t191\n60\t61\t192\t192\n60\t61\t193\t193\n60\t61\t194\t194\n60\t61\t195\t195\n60\t61\t196\t196\n60\t61\t197\t197\n60\t61\t198\t198\n60\t61\t199\t199\n60\t61\t200\t200\n60\t61\t201\t201\n60\t61\t202\t202\n60\t61\t203\t203\n60\t61\t204\t204\n60\t61\t205\t205\n60\t61\t206\t206\n60\t61\t207\t207\n60\t61\t208\t208\n60\t61\t209\t209\n60\t61\t210\t210\n60\t61\t211\t211\n60\t61\t212\t212\n60\t61\t213\t213\n60\t61\t214\t214\n60\t61\t215\t215\n60\t61\t216\t216\n60\t61\t217\t217\n60\t61\t218\t218\n60\t61\t219\t219\n60\t61\t220\t220\n60\t61\t221\t221\n60\t61\t222\t222\n60\t61\t223\t223\n60\t61\t224\t224\n60\t61\t225\t225\n60\t61\t226\t226\n60\t61\t227\t227\n60\t61\t228\t228\n60\t61\t229\t229\n60\t61\t230\t230\n60\t61\t231\t231\n60\t61\t232\t232\n60\t61\t233\t233\n60\t61\t234\t234\n60\t61\t235\t235\n60\t61\t236\t236\n60\t61\t237\t237\n60\t61\t238\t238\n60\t61\t239\t239\n60\t61\t240\t240\n60\t61\t241\t241\n60\t61\t242\t242\n60\t61\t243\t243\n60\t61\t244\t244\n60\t61\t245\t245\n60\t61\t246\t246\n60\t61\t247\t247\n60\t61\t248\t248\n60\t61\t249\t249\n60\t61\t250\t250\n60\t61\t251\t251\n60\t61\t252\t252\n60\t61\t253\t253\n60\t61\t254\t254\n60\t61\t255\t255\n61\t62\t0\t0\n61\t62\t1\t1\n61\t62\t2\t2\n61\t62\t3\t3\n61\t62\t4\t4\n61\t62\t5\t5\n61\t62\t6\t6\n61\t62\t7\t7\n61\t62\t8\t8\n61\t62\t9\t9\n61\t62\t10\t10\n61\t62\t11\t11\n61\t62\t12\t12\n61\t62\t13\t13\n61\t62\t14\t14\n61\t62\t15\t15\n61\t62\t16\t16\n61\t62\t17\t17\n61\t62\t18\t18\n61\t62\t19\t19\n61\t62\t20\t20\n61\t62\t21\t21\n61\t62\t22\t22\n61\t62\t23\t23\n61\t62\t24\t24\n61\t62\t25\t25\n61\t62\t26\t26\n61\t62\t27\t27\n61\t62\t28\t28\n61\t62\t29\t29\n61\t62\t30\t30\n61\t62\t31\t31\n61\t62\t32\t32\n61\t62\t33\t33\n61\t62\t34\t34\n61\t62\t35\t35\n61\t62\t36\t36\n61\t62\t37\t37\n61\t62\t38\t38\n61\t62\t39\t39\n61\t62\t40\t40\n61\t62\t41\t41\n61\t62\t42\t42\n61\t62\t43\t43\n61\t62\t44\t44\n61\t62\t45\t45\n61\t62\t46\t46\n61\t62\t47\t47\n61\t62\t48\t48\n61\t62\t49\t49\n61\t62\t50\t50\n61\t62\t51\t51\n61\t62\t52\t52\n61\t62\t53\t53\n61\t62\t54\t54\n61\t62\t55\t55\n61\t62\t56\t56\n61\t62\t57\t57\n61\t62\t58\t58\n61\t62\t59\t59\n61\t62\t60\t60\n61\t62\t61\t61\n61\t62\t62\t62\n61\t62\t63\t63\n61\t62\t64\t64\n61\t62\t65\t65\n61\t62\t66\t66\n61\t62\t67\t67\n61\t62\t68\t68\n61\t62\t69\t69\n61\t62\t70\t70\n61\t62\t71\t71\n61\t62\t72\t72\n61\t62\t73\t73\n61\t62\t74\t74\n61\t62\t75\t75\n61\t62\t76\t76\n61\t62\t77\t77\n61\t62\t78\t78\n61\t62\t79\t79\n61\t62\t80\t80\n61\t62\t81\t81\n61\t62\t82\t82\n61\t62\t83\t83\n61\t62\t84\t84\n61\t62\t85\t85\n61\t62\t86\t86\n61\t62\t87\t87\n61\t62\t88\t88\n61\t62\t89\t89\n61\t62\t90\t90\n61\t62\t91\t91\n61\t62\t92\t92\n61\t62\t93\t93\n61\t62\t94\t94\n61\t62\t95\t95\n61\t62\t96\t96\n61\t62\t97\t97\n61\t62\t98\t98\n61\t62\t99\t99\n61\t62\t100\t100\n61\t62\t101\t101\n61\t62\t102\t102\n61\t62\t103\t103\n61\t62\t104\t104\n61\t62\t105\t105\n61\t62\t106\t106\n61\t62\t107\t107\n61\t62\t108\t108\n61\t62\t109\t109\n61\t62\t110\t110\n61\t62\t111\t111\n61\t62\t112\t112\n61\t62\t113\t113\n61\t62\t114\t114\n61\t62\t115\t115\n61\t62\t116\t116\n61\t62\t117\t117\n61\t62\t118\t118\n61\t62\t119\t119\n61\t62\t120\t120\n61\t62\t121\t121\n61\t62\t122\t122\n61\t62\t123\t123\n61\t62\t124\t124\n61\t62\t125\t125\n61\t62\t126\t126\n61\t62\t127\t127\n61\t62\t128\t128\n61\t62\t129\t129\n61\t62\t130\t130\n61\t62\t131\t131\n61\t62\t132\t132\n61\t62\t133\t133\n61\t62\t134\t134\n61\t62\t135\t135\n61\t62\t136\t136\n61\t62\t137\t137\n61\t62\t138\t138\n61\t62\t139\t139\n61\t62\t140\t140\n61\t62\t141\t141\n61\t62\t142\t142\n61\t62\t143\t143\n61\t62\t144\t144\n61\t62\t145\t145\n61\t62\t146\t146\n61\t62\t147\t147\n61\t62\t148\t148\n61\t62\t149\t149\n61\t62\t150\t150\n61\t62\t151\t151\n61\t62\t152\t152\n61\t62\t153\t153\n61\t62\t154\t154\n61\t62\t155\t155\n61\t62\t156\t156\n61\t62\t157\t157\n61\t62\t158\t158\n61\t62\t159\t159\n61\t62\t160\t160\n61\t62\t161\t161\n61\t62\t162\t162\n61\t62\t163\t163\n61\t62\t164\t164\n61\t62\t165\t165\n61\t62\t166\t166\n61\t62\t167\t167\n61\t62\t168\t168\n61\t62\t169\t169\n61\t62\t170\t170\n61\t62\t171\t171\n61\t62\t172\t172\n61\t62\t173\t173\n61\t62\t174\t174\n61\t62\t175\t175\n61\t62\t176\t176\n61\t62\t177\t177\n61\t62\t178\t178\n61\t62\t179\t179\n61\t62\t180\t180\n61\t62\t181\t181\n61\t62\t182\t182\n61\t62\t183\t183\n61\t62\t184\t184\n61\t62\t185\t185\n61\t62\t186\t186\n61\t62\t187\t187\n61\t62\t188\t188\n61\t62\t189\t189\n61\t62\t190\t190\n61\t62\t191\t191\n61\t62\t192\t192\n61\t62\t193\t193\n61\t62\t194\t194\n61\t62\t195\t195\n61\t62\t196\t196\n61\t62\t197\t197\n61\t62\t198\t198\n61\t62\t199\t199\n61\t62\t200\t200\n61\t62\t201\t201\n61\t62\t202\t202\n61\t62\t203\t203\n61\t62\t204\t204\n61\t62\t205\t205\n61\t62\t206\t206\n61\t62\t207\t207\n61\t62\t208\t208\n61\t62\t209\t209\n61\t62\t210\t210\n61\t62\t211\t211\n61\t62\t212\t212\n61\t62\t213\t213\n61\t62\t214\t214\n61\t62\t215\t215\n61\t62\t216\t216\n61\t62\t217\t217\n61\t62\t218\t218\n61\t62\t219\t219\n61\t62\t220\t220\n61\t62\t221\t221\n61\t62\t222\t222\n61\t62\t223\t223\n61\t62\t224\t224\n61\t62\t225\t225\n61\t62\t226\t226\n61\t62\t227\t227\n61\t62\t228\t228\n61\t62\t229\t229\n61\t62\t230\t230\n61\t62\t231\t231\n61\t62\t232\t232\n61\t62\t233\t233\n61\t62\t234\t234\n61\t62\t235\t235\n61\t62\t236\t236\n61\t62\t237\t237\n61\t62\t238\t238\n61\t62\t239\t239\n61\t62\t240\t240\n61\t62\t241\t241\n61\t62\t242\t242\n61\t62\t243\t243\n61\t62\t244\t244\n61\t62\t245\t245\n61\t62\t246\t246\n61\t62\t247\t247\n61\t62\t248\t248\n61\t62\t249\t249\n61\t62\t250\t250\n61\t62\t251\t251\n61\t62\t252\t252\n61\t62\t253\t253\n61\t62\t254\t254\n61\t62\t255\t255\n62\t63\t0\t0\n62\t63\t1\t1\n62\t63\t2\t2\n62\t63\t3\t3\n62\t63\t4\t4\n62\t63\t5\t5\n62\t63\t6\t6\n62\t63\t7\t7\n62\t63\t8\t8\n62\t63\t9\t9\n62\t63\t10\t10\n62\t63\t11\t11\n62\t63\t12\t12\n62\t63\t13\t13\n62\t63\t14\t14\n62\t63\t15\t15\n62\t63\t16\t16\n62\t63\t17\t17\n62\t63\t18\t18\n62\t63\t19\t19\n62\t63\t20\t20\n62\t63\t21\t21\n62\t63\t22\t22\n62\t63\t23\t23\n62\t63\t24\t24\n62\t63\t25\t25\n62\t63\t26\t26\n62\t63\t27\t27\n62\t63\t28\t28\n62\t63\t29\t29\n62\t63\t30\t30\n62\t63\t31\t31\n62\t63\t32\t32\n62\t63\t33\t33\n62\t63\t34\t34\n62\t63\t35\t35\n62\t63\t36\t36\n62\t63\t37\t37\n62\t63\t38\t38\n62\t63\t39\t39\n62\t63\t40\t40\n62\t63\t41\t41\n62\t63\t42\t42\n62\t63\t43\t43\n62\t63\t44\t44\n62\t63\t45\t45\n62\t63\t46\t46\n62\t63\t47\t47\n62\t63\t48\t48\n62\t63\t49\t49\n62\t63\t50\t50\n62\t63\t51\t51\n62\t63\t52\t52\n62\t63\t53\t53\n62\t63\t54\t54\n62\t63\t55\t55\n62\t63\t56\t56\n62\t63\t57\t57\n62\t63\t58\t58\n62\t63\t59\t59\n62\t63\t60\t60\n62\t63\t61\t61\n62\t63\t62\t62\n62\t63\t63\t63\n62\t63\t64\t64\n62\t63\t65\t65\n62\t63\t66\t66\n62\t63\t67\t67\n62\t63\t68\t68\n62\t63\t69\t69\n62\t63\t70\t70\n62\t63\t71\t71\n62\t63\t72\t72\n62\t63\t73\t73\n62\t63\t74\t74\n62\t63\t75\t75\n62\t63\t76\t76\n62\t63\t77\t77\n62\t63\t78\t78\n62\t63\t79\t79\n62\t63\t80\t80\n62\t63\t81\t81\n62\t63\t82\t82\n62\t63\t83\t83\n62\t63\t84\t84\n62\t63\t85\t85\n62\t63\t86\t86\n62\t63\t87\t87\n62\t63\t88\t88\n62\t63\t89\t89\n62\t63\t90\t90\n62\t63\t91\t91\n62\t63\t92\t92\n62\t63\t93\t93\n62\t63\t94\t94\n62\t63\t95\t95\n62\t63\t96\t96\n62\t63\t97\t97\n62\t63\t98\t98\n62\t63\t99\t99\n62\t63\t100\t100\n62\t63\t101\t101\n62\t63\t102\t102\n62\t63\t103\t103\n62\t63\t104\t104\n62\t63\t105\t105\n62\t63\t106\t106\n62\t63\t107\t107\n62\t63\t108\t108\n62\t63\t109\t109\n62\t63\t110\t110\n62\t63\t111\t111\n62\t63\t112\t112\n62\t63\t113\t113\n62\t63\t114\t114\n62\t63\t115\t115\n62\t63\t116\t116\n62\t63\t117\t117\n62\t63\t118\t118\n62\t63\t119\t119\n62\t63\t120\t120\n62\t63\t121\t121\n62\t63\t122\t122\n62\t63\t123\t123\n62\t63\t124\t124\n62\t63\t125\t125\n62\t63\t126\t126\n62\t63\t127\t127\n62\t63\t128\t128\n62\t63\t129\t129\n62\t63\t130\t130\n62\t63\t131\t131\n62\t63\t132\t132\n62\t63\t133\t133\n62\t63\t134\t134\n62\t63\t135\t135\n62\t63\t136\t136\n62\t63\t137\t137\n62\t63\t138\t138\n62\t63\t139\t139\n62\t63\t140\t140\n62\t63\t141\t141\n62\t63\t142\t142\n62\t63\t143\t143\n62\t63\t144\t144\n62\t63\t145\t145\n62\t63\t146\t146\n62\t63\t147\t147\n62\t63\t148\t148\n62\t63\t149\t149\n62\t63\t150\t150\n62\t63\t151\t151\n62\t63\t152\t152\n62\t63\t153\t153\n62\t63\t154\t154\n62\t63\t155\t155\n62\t63\t156\t156\n62\t63\t157\t157\n62\t63\t158\t158\n62\t63\t159\t159\n62\t63\t160\t160\n62\t63\t161\t161\n62\t63\t162\t162\n62\t63\t163\t163\n62\t63\t164\t164\n62\t63\t165\t165\n62\t63\t166\t166\n62\t63\t167\t167\n62\t63\t168\t168\n62\t63\t169\t169\n62\t63\t170\t170\n62\t63\t171\t171\n62\t63\t172\t172\n62\t63\t173\t173\n62\t63\t174\t174\n62\t63\t175\t175\n62\t63\t176\t176\n62\t63\t177\t177\n62\t63\t178\t178\n62\t63\t179\t179\n62\t63\t180\t180\n62\t63\t181\t181\n62\t63\t182\t182\n62\t63\t183\t183\n62\t63\t184\t184\n62\t63\t185\t185\n62\t63\t186\t186\n62\t63\t187\t187\n62\t63\t188\t188\n62\t63\t189\t189\n62\t63\t190\t190\n62\t63\t191\t191\n62\t63\t192\t192\n62\t63\t193\t193\n62\t63\t194\t194\n62\t63\t195\t195\n62\t63\t196\t196\n62\t63\t197\t197\n62\t63\t198\t198\n62\t63\t199\t199\n62\t63\t200\t200\n62\t63\t201\t201\n62\t63\t202\t202\n62\t63\t203\t203\n62\t63\t204\t204\n62\t63\t205\t205\n62\t63\t206\t206\n62\t63\t207\t207\n62\t63\t208\t208\n62\t63\t209\t209\n62\t63\t210\t210\n62\t63\t211\t211\n62\t63\t212\t212\n62\t63\t213\t213\n62\t63\t214\t214\n62\t63\t215\t215\n62\t63\t216\t216\n62\t63\t217\t217\n62\t63\t218\t218\n62\t63\t219\t219\n62\t63\t220\t220\n62\t63\t221\t221\n62\t63\t222\t222\n62\t63\t223\t223\n62\t63\t224\t224\n62\t63\t225\t225\n62\t63\t226\t226\n62\t63\t227\t227\n62\t63\t228\t228\n62\t63\t229\t229\n62\t63\t230\t230\n62\t63\t231\t231\n62\t63\t232\t232\n62\t63\t233\t233\n62\t63\t234\t234\n62\t63\t235\t235\n62\t63\t236\t236\n62\t63\t237\t237\n62\t63\t238\t238\n62\t63\t239\t239\n62\t63\t240\t240\n62\t63\t241\t241\n62\t63\t242\t242\n62\t63\t243\t243\n62\t63\t244\t244\n62\t63\t245\t245\n62\t63\t246\t246\n62\t63\t247\t247\n62\t63\t248\t248\n62\t63\t249\t249\n62\t63\t250\t250\n62\t63\t251\t251\n62\t63\t252\t252\n62\t63\t253\t253\n62\t63\t254\t254\n62\t63\t255\t255\n63\t64\t0\t0\n63\t64\t1\t1\n63\t64\t2\t2\n63\t64\t3\t3\n63\t64\t4\t4\n63\t64\t5\t5\n63\t64\t6\t6\n63\t64\t7\t7\n63\t64\t8\t8\n63\t64\t9\t9\n63\t64\t10\t10\n63\t64\t11\t11\n63\t64\t12\t12\n63\t64\t13\t13\n63\t64\t14\t14\n63\t64\t15\t15\n63\t64\t16\t16\n63\t64\t17\t17\n63\t64\t18\t18\n63\t64\t19\t19\n63\t64\t20\t20\n63\t64\t21\t21\n63\t64\t22\t22\n63\t64\t23\t23\n63\t64\t24\t24\n63\t64\t25\t25\n63\t64\t26\t26\n63\t64\t27\t27\n63\t64\t28\t28\n63\t64\t29\t29\n63\t64\t30\t30\n63\t64\t31\t31\n63\t64\t32\t32\n63\t64\t33\t33\n63\t64\t34\t34\n63\t64\t35\t35\n63\t64\t36\t36\n63\t64\t37\t37\n63\t64\t38\t38\n63\t64\t39\t39\n63\t64\t40\t40\n63\t64\t41\t41\n63\t64\t42\t42\n63\t64\t43\t43\n63\t64\t44\t44\n63\t64\t45\t45\n63\t64\t46\t46\n63\t64\t47\t47\n63\t64\t48\t48\n63\t64\t49\t49\n63\t64\t50\t50\n63\t64\t51\t51\n63\t64\t52\t52\n63\t64\t53\t53\n63\t64\t54\t54\n63\t64\t55\t55\n63\t64\t56\t56\n63\t64\t57\t57\n63\t64\t58\t58\n63\t64\t59\t59\n63\t64\t60\t60\n63\t64\t61\t61\n63\t64\t62\t62\n63\t64\t63\t63\n63\t64\t64\t64\n63\t64\t65\t65\n63\t64\t66\t66\n63\t64\t67\t67\n63\t64\t68\t68\n63\t64\t69\t69\n63\t64\t70\t70\n63\t64\t71\t71\n63\t64\t72\t72\n63\t64\t73\t73\n63\t64\t74\t74\n63\t64\t75\t75\n63\t64\t76\t76\n63\t64\t77\t77\n63\t64\t78\t78\n63\t64\t79\t79\n63\t64\t80\t80\n63\t64\t81\t81\n63\t64\t82\t82\n63\t64\t83\t83\n63\t64\t84\t84\n63\t64\t85\t85\n63\t64\t86\t86\n63\t64\t87\t87\n63\t64\t88\t88\n63\t64\t89\t89\n63\t64\t90\t90\n63\t64\t91\t91\n63\t64\t92\t92\n63\t64\t93\t93\n63\t64\t94\t94\n63\t64\t95\t95\n63\t64\t96\t96\n63\t64\t97\t97\n63\t64\t98\t98\n63\t64\t99\t99\n63\t64\t100\t100\n63\t64\t101\t101\n63\t64\t102\t102\n63\t64\t103\t103\n63\t64\t104\t104\n63\t64\t105\t105\n63\t64\t106\t106\n63\t64\t107\t107\n63\t64\t108\t108\n63\t64\t109\t109\n63\t64\t110\t110\n63\t64\t111\t111\n63\t64\t112\t112\n63\t64\t113\t113\n63\t64\t114\t114\n63\t64\t115\t115\n63\t64\t116\t116\n63\t64\t117\t117\n63\t64\t118\t118\n63\t64\t119\t119\n63\t64\t120\t120\n63\t64\t121\t121\n63\t64\t122\t122\n63\t64\t123\t123\n63\t64\t124\t124\n63\t64\t125\t125\n63\t64\t126\t126\n63\t64\t127\t127\n63\t64\t128\t128\n63\t64\t129\t129\n63\t64\t130\t130\n63\t64\t131\t131\n63\t64\t132\t132\n63\t64\t133\t133\n63\t64\t134\t134\n63\t64\t135\t135\n63\t64\t136\t136\n63\t64\t137\t137\n63\t64\t138\t138\n63\t64\t139\t139\n63\t64\t140\t140\n63\t64\t141\t141\n63\t64\t142\t142\n63\t64\t143\t143\n63\t64\t144\t144\n63\t64\t145\t145\n63\t64\t146\t146\n63\t64\t147\t147\n63\t64\t148\t148\n63\t64\t149\t149\n63\t64\t150\t150\n63\t64\t151\t151\n63\t64\t152\t152\n63\t64\t153\t153\n63\t64\t154\t154\n63\t64\t155\t155\n63\t64\t156\t156\n63\t64\t157\t157\n63\t64\t158\t158\n63\t64\t159\t159\n63\t64\t160\t160\n63\t64\t161\t161\n63\t64\t162\t162\n63\t64\t163\t163\n63\t64\t164\t164\n63\t64\t165\t165\n63\t64\t166\t166\n63\t64\t167\t167\n63\t64\t168\t168\n63\t64\t169\t169\n63\t64\t170\t170\n63\t64\t171\t171\n63\t64\t172\t172\n63\t64\t173\t173\n63\t64\t174\t174\n63\t64\t175\t175\n63\t64\t176\t176\n63\t64\t177\t177\n63\t64\t178\t178\n63\t64\t179\t179\n63\t64\t180\t180\n63\t64\t181\t181\n63\t64\t182\t182\n63\t64\t183\t183\n63\t64\t184\t184\n63\t64\t185\t185\n63\t64\t186\t186\n63\t64\t187\t187\n63\t64\t188\t188\n63\t64\t189\t189\n63\t64\t190\t190\n63\t64\t191\t191\n63\t64\t192\t192\n63\t64\t193\t193\n63\t64\t194\t194\n63\t64\t195\t195\n63\t64\t196\t196\n63\t64\t197\t197\n63\t64\t198\t198\n63\t64\t199\t199\n63\t64\t200\t200\n63\t64\t201\t201\n63\t64\t202\t202\n63\t64\t203\t203\n63\t64\t204\t204\n63\t64\t205\t205\n63\t64\t206\t206\n63\t64\t207\t207\n63\t64\t208\t208\n63\t64\t209\t209\n63\t64\t210\t210\n63\t64\t211\t211\n63\t64\t212\t212\n63\t64\t213\t213\n63\t64\t214\t214\n63\t64\t215\t215\n63\t64\t216\t216\n63\t64\t217\t217\n63\t64\t218\t218\n63\t64\t219\t219\n63\t64\t220\t220\n63\t64\t221\t221\n63\t64\t222\t222\n63\t64\t223\t223\n63\t64\t224\t224\n63\t64\t225\t225\n63\t64\t226\t226\n63\t64\t227\t227\n63\t64\t228\t228\n63\t64\t229\t229\n63\t64\t230\t230\n63\t64\t231\t231\n63\t64\t232\t232\n63\t64\t233\t233\n63\t64\t234\t234\n63\t64\t235\t235\n63\t64\t236\t236\n63\t64\t237\t237\n63\t64\t238\t238\n63\t64\t239\t239\n63\t64\t240\t240\n63\t64\t241\t241\n63\t64\t242\t242\n63\t64\t243\t243\n63\t64\t244\t244\n63\t64\t245\t245\n63\t64\t246\t246\n63\t64\t247\t247\n63\t64\t248\t248\n63\t64\t249\t249\n63\t64\t250\t250\n63\t64\t251\t251\n63\t64\t252\t252\n63\t64\t253\t253\n63\t64\t254\t254\n63\t64\t255\t255\n64\t65\t0\t0\n64\t65\t1\t1\n64\t65\t2\t2\n64\t65\t3\t3\n64\t65\t4\t4\n64\t65\t5\t5\n64\t65\t6\t6\n64\t65\t7\t7\n64\t65\t8\t8\n64\t65\t9\t9\n64\t65\t10\t10\n64\t65\t11\t11\n64\t65\t12\t12\n64\t65\t13\t13\n64\t65\t14\t14\n64\t65\t15\t15\n64\t65\t16\t16\n64\t65\t17\t17\n64\t65\t18\t18\n64\t65\t19\t19\n64\t65\t20\t20\n64\t65\t21\t21\n64\t65\t22\t22\n64\t65\t23\t23\n64\t65\t24\t24\n64\t65\t25\t25\n64\t65\t26\t26\n64\t65\t27\t27\n64\t65\t28\t28\n64\t65\t29\t29\n64\t65\t30\t30\n64\t65\t31\t31\n64\t65\t32\t32\n64\t65\t33\t33\n64\t65\t34\t34\n64\t65\t35\t35\n64\t65\t36\t36\n64\t65\t37\t37\n64\t65\t38\t38\n64\t65\t39\t39\n64\t65\t40\t40\n64\t65\t41\t41\n64\t65\t42\t42\n64\t65\t43\t43\n64\t65\t44\t44\n64\t65\t45\t45\n64\t65\t46\t46\n64\t65\t47\t47\n64\t65\t48\t48\n64\t65\t49\t49\n64\t65\t50\t50\n64\t65\t51\t51\n64\t65\t52\t52\n64\t65\t53\t53\n64\t65\t54\t54\n64\t65\t55\t55\n64\t65\t56\t56\n64\t65\t57\t57\n64\t65\t58\t58\n64\t65\t59\t59\n64\t65\t60\t60\n64\t65\t61\t61\n64\t65\t62\t62\n64\t65\t63\t63\n64\t65\t64\t64\n64\t65\t65\t65\n64\t65\t66\t66\n64\t65\t67\t67\n64\t65\t68\t68\n64\t65\t69\t69\n64\t65\t70\t70\n64\t65\t71\t71\n64\t65\t72\t72\n64\t65\t73\t73\n64\t65\t74\t74\n64\t65\t75\t75\n64\t65\t76\t76\n64\t65\t77\t77\n64\t65\t78\t78\n64\t65\t79\t79\n64\t65\t80\t80\n64\t65\t81\t81\n64\t65\t82\t82\n64\t65\t83\t83\n64\t65\t84\t84\n64\t65\t85\t85\n64\t65\t86\t86\n64\t65\t87\t87\n64\t65\t88\t88\n64\t65\t89\t89\n64\t65\t90\t90\n64\t65\t91\t91\n64\t65\t92\t92\n64\t65\t93\t93\n64\t65\t94\t94\n64\t65\t95\t95\n64\t65\t96\t96\n64\t65\t97\t97\n64\t65\t98\t98\n64\t65\t99\t99\n64\t65\t100\t100\n64\t65\t101\t101\n64\t65\t102\t102\n64\t65\t103\t103\n64\t65\t104\t104\n64\t65\t105\t105\n64\t65\t106\t106\n64\t65\t107\t107\n64\t65\t108\t108\n64\t65\t109\t109\n64\t65\t110\t110\n64\t65\t111\t111\n64\t65\t112\t112\n64\t65\t113\t113\n64\t65\t114\t114\n64\t65\t115\t115\n64\t65\t116\t116\n64\t65\t117\t117\n64\t65\t118\t118\n64\t65\t119\t119\n64\t65\t120\t120\n64\t65\t121\t121\n64\t65\t122\t122\n64\t65\t123\t123\n64\t65\t124\t124\n64\t65\t125\t125\n64\t65\t126\t126\n64\t65\t127\t127\n64\t65\t128\t128\n64\t65\t129\t129\n64\t65\t130\t130\n64\t65\t131\t131\n64\t65\t132\t132\n64\t65\t133\t133\n64\t65\t134\t134\n64\t65\t135\t135\n64\t65\t136\t136\n64\t65\t137\t137\n64\t65\t138\t138\n64\t65\t139\t139\n64\t65\t140\t140\n64\t65\t141\t141\n64\t65\t142\t142\n64\t65\t143\t143\n64\t65\t144\t144\n64\t65\t145\t145\n64\t65\t146\t146\n64\t65\t147\t147\n64\t65\t148\t148\n64\t65\t149\t149\n64\t65\t150\t150\n64\t65\t151\t151\n64\t65\t152\t152\n64\t65\t153\t153\n64\t65\t154\t154\n64\t65\t155\t155\n64\t65\t156\t156\n64\t65\t157\t157\n64\t65\t158\t158\n64\t65\t159\t159\n64\t65\t160\t160\n64\t65\t161\t161\n64\t65\t162\t162\n64\t65\t163\t163\n64\t65\t164\t164\n64\t65\t165\t165\n64\t65\t166\t166\n64\t65\t167\t167\n64\t65\t168\t168\n64\t65\t169\t169\n64\t65\t170\t170\n64\t65\t171\t171\n64\t65\t172\t172\n64\t65\t173\t173\n64\t65\t174\t174\n64\t65\t175\t175\n64\t65\t176\t176\n64\t65\t177\t177\n64\t65\t178\t178\n64\t65\t179\t179\n64\t65\t180\t180\n64\t65\t181\t181\n64\t65\t182\t182\n64\t65\t183\t183\n64\t65\t184\t184\n64\t65\t185\t185\n64\t65\t186\t186\n64\t65\t187\t187\n64\t65\t188\t188\n64\t65\t189\t189\n64\t65\t190\t190\n64\t65\t191\t191\n64\t65\t192\t192\n64\t65\t193\t193\n64\t65\t194\t194\n64\t65\t195\t195\n64\t65\t196\t196\n64\t65\t197\t197\n64\t65\t198\t198\n64\t65\t199\t199\n64\t65\t200\t200\n64\t65\t201\t201\n64\t65\t202\t202\n64\t65\t203\t203\n64\t65\t204\t204\n64\t65\t205\t205\n64\t65\t206\t206\n64\t65\t207\t207\n64\t65\t208\t208\n64\t65\t209\t209\n64\t65\t210\t210\n64\t65\t211\t211\n64\t65\t212\t212\n64\t65\t213\t213\n64\t65\t214\t214\n64\t65\t215\t215\n64\t65\t216\t216\n64\t65\t217\t217\n64\t65\t218\t218\n64\t65\t219\t219\n64\t65\t220\t220\n64\t65\t221\t221\n64\t65\t222\t222\n64\t65\t223\t223\n64\t65\t224\t224\n64\t65\t225\t225\n64\t65\t226\t226\n64\t65\t227\t227\n64\t65\t228\t228\n64\t65\t229\t229\n64\t65\t230\t230\n64\t65\t231\t231\n64\t65\t232\t232\n64\t65\t233\t233\n64\t65\t234\t234\n64\t65\t235\t235\n64\t65\t236\t236\n64\t65\t237\t237\n64\t65\t238\t238\n64\t65\t239\t239\n64\t65\t240\t240\n64\t65\t241\t241\n64\t65\t242\t242\n64\t65\t243\t243\n64\t65\t244\t244\n64\t65\t245\t245\n64\t65\t246\t246\n64\t65\t247\t247\n64\t65\t248\t248\n64\t65\t249\t249\n64\t65\t250\t250\n64\t65\t251\t251\n64\t65\t252\t252\n64\t65\t253\t253\n64\t65\t254\t254\n64\t65\t255\t255\n65\t66\t0\t0\n65\t66\t1\t1\n65\t66\t2\t2\n65\t66\t3\t3\n65\t66\t4\t4\n65\t66\t5\t5\n65\t66\t6\t6\n65\t66\t7\t7\n65\t66\t8\t8\n65\t66\t9\t9\n65\t66\t10\t10\n65\t66\t11\t11\n65\t66\t12\t12\n65\t66\t13\t13\n65\t66\t14\t14\n65\t66\t15\t15\n65\t66\t16\t16\n65\t66\t17\t17\n65\t66\t18\t18\n65\t66\t19\t19\n65\t66\t20\t20\n65\t66\t21\t21\n65\t66\t22\t22\n65\t66\t23\t23\n65\t66\t24\t24\n65\t66\t25\t25\n65\t66\t26\t26\n65\t66\t27\t27\n65\t66\t28\t28\n65\t66\t29\t29\n65\t66\t30\t30\n65\t66\t31\t31\n65\t66\t32\t32\n65\t66\t33\t33\n65\t66\t34\t34\n65\t66\t35\t35\n65\t66\t36\t36\n65\t66\t37\t37\n65\t66\t38\t38\n65\t66\t39\t39\n65\t66\t40\t40\n65\t66\t41\t41\n65\t66\t42\t42\n65\t66\t43\t43\n65\t66\t44\t44\n65\t66\t45\t45\n65\t66\t46\t46\n65\t66\t47\t47\n65\t66\t48\t48\n65\t66\t49\t49\n65\t66\t50\t50\n65\t66\t51\t51\n65\t66\t52\t52\n65\t66\t53\t53\n65\t66\t54\t54\n65\t66\t55\t55\n65\t66\t56\t56\n65\t66\t57\t57\n65\t66\t58\t58\n65\t66\t59\t59\n65\t66\t60\t60\n65\t66\t61\t61\n65\t66\t62\t62\n65\t66\t63\t63\n65\t66\t64\t64\n65\t66\t65\t65\n65\t66\t66\t66\n65\t66\t67\t67\n65\t66\t68\t68\n65\t66\t69\t69\n65\t66\t70\t70\n65\t66\t71\t71\n65\t66\t72\t72\n65\t66\t73\t73\n65\t66\t74\t74\n65\t66\t75\t75\n65\t66\t76\t76\n65\t66\t77\t77\n65\t66\t78\t78\n65\t66\t79\t79\n65\t66\t80\t80\n65\t66\t81\t81\n65\t66\t82\t82\n65\t66\t83\t83\n65\t66\t84\t84\n65\t66\t85\t85\n65\t66\t86\t86\n65\t66\t87\t87\n65\t66\t88\t88\n65\t66\t89\t89\n65\t66\t90\t90\n65\t66\t91\t91\n65\t66\t92\t92\n65\t66\t93\t93\n65\t66\t94\t94\n65\t66\t95\t95\n65\t66\t96\t96\n65\t66\t97\t97\n65\t66\t98\t98\n65\t66\t99\t99\n65\t66\t100\t100\n65\t66\t101\t101\n65\t66\t102\t102\n65\t66\t103\t103\n65\t66\t104\t104\n65\t66\t105\t105\n65\t66\t106\t106\n65\t66\t107\t107\n65\t66\t108\t108\n65\t66\t109\t109\n65\t66\t110\t110\n65\t66\t111\t111\n65\t66\t112\t112\n65\t66\t113\t113\n65\t66\t114\t114\n65\t66\t115\t115\n65\t66\t116\t116\n65\t66\t117\t117\n65\t66\t118\t118\n65\t66\t119\t119\n65\t66\t120\t120\n65\t66\t121\t121\n65\t66\t122\t122\n65\t66\t123\t123\n65\t66\t124\t124\n65\t66\t125\t125\n65\t66\t126\t126\n65\t66\t127\t127\n65\t66\t128\t128\n65\t66\t129\t129\n65\t66\t130\t130\n65\t66\t131\t131\n65\t66\t132\t132\n65\t66\t133\t133\n65\t66\t134\t134\n65\t66\t135\t135\n65\t66\t136\t136\n65\t66\t137\t137\n65\t66\t138\t138\n65\t66\t139\t139\n65\t66\t140\t140\n65\t66\t141\t141\n65\t66\t142\t142\n65\t66\t143\t143\n65\t66\t144\t144\n65\t66\t145\t145\n65\t66\t146\t146\n65\t66\t147\t147\n65\t66\t148\t148\n65\t66\t149\t149\n65\t66\t150\t150\n65\t66\t151\t151\n65\t66\t152\t152\n65\t66\t153\t153\n65\t66\t154\t154\n65\t66\t155\t155\n65\t66\t156\t156\n65\t66\t157\t157\n65\t66\t158\t158\n65\t66\t159\t159\n65\t66\t160\t160\n65\t66\t161\t161\n65\t66\t162\t162\n65\t66\t163\t163\n65\t66\t164\t164\n65\t66\t165\t165\n65\t66\t166\t166\n65\t66\t167\t167\n65\t66\t168\t168\n65\t66\t169\t169\n65\t66\t170\t170\n65\t66\t171\t171\n65\t66\t172\t172\n65\t66\t173\t173\n65\t66\t174\t174\n65\t66\t175\t175\n65\t66\t176\t176\n65\t66\t177\t177\n65\t66\t178\t178\n65\t66\t179\t179\n65\t66\t180\t180\n65\t66\t181\t181\n65\t66\t182\t182\n65\t66\t183\t183\n65\t66\t184\t184\n65\t66\t185\t185\n65\t66\t186\t186\n65\t66\t187\t187\n65\t66\t188\t188\n65\t66\t189\t189\n65\t66\t190\t190\n65\t66\t191\t191\n65\t66\t192\t192\n65\t66\t193\t193\n65\t66\t194\t194\n65\t66\t195\t195\n65\t66\t196\t196\n65\t66\t197\t197\n65\t66\t198\t198\n65\t66\t199\t199\n65\t66\t200\t200\n65\t66\t201\t201\n65\t66\t202\t202\n65\t66\t203\t203\n65\t66\t204\t204\n65\t66\t205\t205\n65\t66\t206\t206\n65\t66\t207\t207\n65\t66\t208\t208\n65\t66\t209\t209\n65\t66\t210\t210\n65\t66\t211\t211\n65\t66\t212\t212\n65\t66\t213\t213\n65\t66\t214\t214\n65\t66\t215\t215\n65\t66\t216\t216\n65\t66\t217\t217\n65\t66\t218\t218\n65\t66\t219\t219\n65\t66\t220\t220\n65\t66\t221\t221\n65\t66\t222\t222\n65\t66\t223\t223\n65\t66\t224\t224\n65\t66\t225\t225\n65\t66\t226\t226\n65\t66\t227\t227\n65\t66\t228\t228\n65\t66\t229\t229\n65\t66\t230\t230\n65\t66\t231\t231\n65\t66\t232\t232\n65\t66\t233\t233\n65\t66\t234\t234\n65\t66\t235\t235\n65\t66\t236\t236\n65\t66\t237\t237\n65\t66\t238\t238\n65\t66\t239\t239\n65\t66\t240\t240\n65\t66\t241\t241\n65\t66\t242\t242\n65\t66\t243\t243\n65\t66\t244\t244\n65\t66\t245\t245\n65\t66\t246\t246\n65\t66\t247\t247\n65\t66\t248\t248\n65\t66\t249\t249\n65\t66\t250\t250\n65\t66\t251\t251\n65\t66\t252\t252\n65\t66\t253\t253\n65\t66\t254\t254\n65\t66\t255\t255\n66\t67\t0\t0\n66\t67\t1\t1\n66\t67\t2\t2\n66\t67\t3\t3\n66\t67\t4\t4\n66\t67\t5\t5\n66\t67\t6\t6\n66\t67\t7\t7\n66\t67\t8\t8\n66\t67\t9\t9\n66\t67\t10\t10\n66\t67\t11\t11\n66\t67\t12\t12\n66\t67\t13\t13\n66\t67\t14\t14\n66\t67\t15\t15\n66\t67\t16\t16\n66\t67\t17\t17\n66\t67\t18\t18\n66\t67\t19\t19\n66\t67\t20\t20\n66\t67\t21\t21\n66\t67\t22\t22\n66\t67\t23\t23\n66\t67\t24\t24\n66\t67\t25\t25\n66\t67\t26\t26\n66\t67\t27\t27\n66\t67\t28\t28\n66\t67\t29\t29\n66\t67\t30\t30\n66\t67\t31\t31\n66\t67\t32\t32\n66\t67\t33\t33\n66\t67\t34\t34\n66\t67\t35\t35\n66\t67\t36\t36\n66\t67\t37\t37\n66\t67\t38\t38\n66\t67\t39\t39\n66\t67\t40\t40\n66\t67\t41\t41\n66\t67\t42\t42\n66\t67\t43\t43\n66\t67\t44\t44\n66\t67\t45\t45\n66\t67\t46\t46\n66\t67\t47\t47\n66\t67\t48\t48\n66\t67\t49\t49\n66\t67\t50\t50\n66\t67\t51\t51\n66\t67\t52\t52\n66\t67\t53\t53\n66\t67\t54\t54\n66\t67\t55\t55\n66\t67\t56\t56\n66\t67\t57\t57\n66\t67\t58\t58\n66\t67\t59\t59\n66\t67\t60\t60\n66\t67\t61\t61\n66\t67\t62\t62\n66\t67\t63\t63\n66\t67\t64\t64\n66\t67\t65\t65\n66\t67\t66\t66\n66\t67\t67\t67\n66\t67\t68\t68\n66\t67\t69\t69\n66\t67\t70\t70\n66\t67\t71\t71\n66\t67\t72\t72\n66\t67\t73\t73\n66\t67\t74\t74\n66\t67\t75\t75\n66\t67\t76\t76\n66\t67\t77\t77\n66\t67\t78\t78\n66\t67\t79\t79\n66\t67\t80\t80\n66\t67\t81\t81\n66\t67\t82\t82\n66\t67\t83\t83\n66\t67\t84\t84\n66\t67\t85\t85\n66\t67\t86\t86\n66\t67\t87\t87\n66\t67\t88\t88\n66\t67\t89\t89\n66\t67\t90\t90\n66\t67\t91\t91\n66\t67\t92\t92\n66\t67\t93\t93\n66\t67\t94\t94\n66\t67\t95\t95\n66\t67\t96\t96\n66\t67\t97\t97\n66\t67\t98\t98\n66\t67\t99\t99\n66\t67\t100\t100\n66\t67\t101\t101\n66\t67\t102\t102\n66\t67\t103\t103\n66\t67\t104\t104\n66\t67\t105\t105\n66\t67\t106\t106\n66\t67\t107\t107\n66\t67\t108\t108\n66\t67\t109\t109\n66\t67\t110\t110\n66\t67\t111\t111\n66\t67\t112\t112\n66\t67\t113\t113\n66\t67\t114\t114\n66\t67\t115\t115\n66\t67\t116\t116\n66\t67\t117\t117\n66\t67\t118\t118\n66\t67\t119\t119\n66\t67\t120\t120\n66\t67\t121\t121\n66\t67\t122\t122\n66\t67\t123\t123\n66\t67\t124\t124\n66\t67\t125\t125\n66\t67\t126\t126\n66\t67\t127\t127\n66\t67\t128\t128\n66\t67\t129\t129\n66\t67\t130\t130\n66\t67\t131\t131\n66\t67\t132\t132\n66\t67\t133\t133\n66\t67\t134\t134\n66\t67\t135\t135\n66\t67\t136\t136\n66\t67\t137\t137\n66\t67\t138\t138\n66\t67\t139\t139\n66\t67\t140\t140\n66\t67\t141\t141\n66\t67\t142\t142\n66\t67\t143\t143\n66\t67\t144\t144\n66\t67\t145\t145\n66\t67\t146\t146\n66\t67\t147\t147\n66\t67\t148\t148\n66\t67\t149\t149\n66\t67\t150\t150\n66\t67\t151\t151\n66\t67\t152\t152\n66\t67\t153\t153\n66\t67\t154\t154\n66\t67\t155\t155\n66\t67\t156\t156\n66\t67\t157\t157\n66\t67\t158\t158\n66\t67\t159\t159\n66\t67\t160\t160\n66\t67\t161\t161\n66\t67\t162\t162\n66\t67\t163\t163\n66\t67\t164\t164\n66\t67\t165\t165\n66\t67\t166\t166\n66\t67\t167\t167\n66\t67\t168\t168\n66\t67\t169\t169\n66\t67\t170\t170\n66\t67\t171\t171\n66\t67\t172\t172\n66\t67\t173\t173\n66\t67\t174\t174\n66\t67\t175\t175\n66\t67\t176\t176\n66\t67\t177\t177\n66\t67\t178\t178\n66\t67\t179\t179\n66\t67\t180\t180\n66\t67\t181\t181\n66\t67\t182\t182\n66\t67\t183\t183\n66\t67\t184\t184\n66\t67\t185\t185\n66\t67\t186\t186\n66\t67\t187\t187\n66\t67\t188\t188\n66\t67\t189\t189\n66\t67\t190\t190\n66\t67\t191\t191\n66\t67\t192\t192\n66\t67\t193\t193\n66\t67\t194\t194\n66\t67\t195\t195\n66\t67\t196\t196\n66\t67\t197\t197\n66\t67\t198\t198\n66\t67\t199\t199\n66\t67\t200\t200\n66\t67\t201\t201\n66\t67\t202\t202\n66\t67\t203\t203\n66\t67\t204\t204\n66\t67\t205\t205\n66\t67\t206\t206\n66\t67\t207\t207\n66\t67\t208\t208\n66\t67\t209\t209\n66\t67\t210\t210\n66\t67\t211\t211\n66\t67\t212\t212\n66\t67\t213\t213\n66\t67\t214\t214\n66\t67\t215\t215\n66\t67\t216\t216\n66\t67\t217\t217\n66\t67\t218\t218\n66\t67\t219\t219\n66\t67\t220\t220\n66\t67\t221\t221\n66\t67\t222\t222\n66\t67\t223\t223\n66\t67\t224\t224\n66\t67\t225\t225\n66\t67\t226\t226\n66\t67\t227\t227\n66\t67\t228\t228\n66\t67\t229\t229\n66\t67\t230\t230\n66\t67\t231\t231\n66\t67\t232\t232\n66\t67\t233\t233\n66\t67\t234\t234\n66\t67\t235\t235\n66\t67\t236\t236\n66\t67\t237\t237\n66\t67\t238\t238\n66\t67\t239\t239\n66\t67\t240\t240\n66\t67\t241\t241\n66\t67\t242\t242\n66\t67\t243\t243\n66\t67\t244\t244\n66\t67\t245\t245\n66\t67\t246\t246\n66\t67\t247\t247\n66\t67\t248\t248\n66\t67\t249\t249\n66\t67\t250\t250\n66\t67\t251\t251\n66\t67\t252\t252\n66\t67\t253\t253\n66\t67\t254\t254\n66\t67\t255\t255\n67\t68\t0\t0\n67\t68\t1\t1\n67\t68\t2\t2\n67\t68\t3\t3\n67\t68\t4\t4\n67\t68\t5\t5\n67\t68\t6\t6\n67\t68\t7\t7\n67\t68\t8\t8\n67\t68\t9\t9\n67\t68\t10\t10\n67\t68\t11\t11\n67\t68\t12\t12\n67\t68\t13\t13\n67\t68\t14\t14\n67\t68\t15\t15\n67\t68\t16\t16\n67\t68\t17\t17\n67\t68\t18\t18\n67\t68\t19\t19\n67\t68\t20\t20\n67\t68\t21\t21\n67\t68\t22\t22\n67\t68\t23\t23\n67\t68\t24\t24\n67\t68\t25\t25\n67\t68\t26\t26\n67\t68\t27\t27\n67\t68\t28\t28\n67\t68\t29\t29\n67\t68\t30\t30\n67\t68\t31\t31\n67\t68\t32\t32\n67\t68\t33\t33\n67\t68\t34\t34\n67\t68\t35\t35\n67\t68\t36\t36\n67\t68\t37\t37\n67\t68\t38\t38\n67\t68\t39\t39\n67\t68\t40\t40\n67\t68\t41\t41\n67\t68\t42\t42\n67\t68\t43\t43\n67\t68\t44\t44\n67\t68\t45\t45\n67\t68\t46\t46\n67\t68\t47\t47\n67\t68\t48\t48\n67\t68\t49\t49\n67\t68\t50\t50\n67\t68\t51\t51\n67\t68\t52\t52\n67\t68\t53\t53\n67\t68\t54\t54\n67\t68\t55\t55\n67\t68\t56\t56\n67\t68\t57\t57\n67\t68\t58\t58\n67\t68\t59\t59\n67\t68\t60\t60\n67\t68\t61\t61\n67\t68\t62\t62\n67\t68\t63\t63\n67\t68\t64\t64\n67\t68\t65\t65\n67\t68\t66\t66\n67\t68\t67\t67\n67\t68\t68\t68\n67\t68\t69\t69\n67\t68\t70\t70\n67\t68\t71\t71\n67\t68\t72\t72\n67\t68\t73\t73\n67\t68\t74\t74\n67\t68\t75\t75\n67\t68\t76\t76\n67\t68\t77\t77\n67\t68\t78\t78\n67\t68\t79\t79\n67\t68\t80\t80\n67\t68\t81\t81\n67\t68\t82\t82\n67\t68\t83\t83\n67\t68\t84\t84\n67\t68\t85\t85\n67\t68\t86\t86\n67\t68\t87\t87\n67\t68\t88\t88\n67\t68\t89\t89\n67\t68\t90\t90\n67\t68\t91\t91\n67\t68\t92\t92\n67\t68\t93\t93\n67\t68\t94\t94\n67\t68\t95\t95\n67\t68\t96\t96\n67\t68\t97\t97\n67\t68\t98\t98\n67\t68\t99\t99\n67\t68\t100\t100\n67\t68\t101\t101\n67\t68\t102\t102\n67\t68\t103\t103\n67\t68\t104\t104\n67\t68\t105\t105\n67\t68\t106\t106\n67\t68\t107\t107\n67\t68\t108\t108\n67\t68\t109\t109\n67\t68\t110\t110\n67\t68\t111\t111\n67\t68\t112\t112\n67\t68\t113\t113\n67\t68\t114\t114\n67\t68\t115\t115\n67\t68\t116\t116\n67\t68\t117\t117\n67\t68\t118\t118\n67\t68\t119\t119\n67\t68\t120\t120\n67\t68\t121\t121\n67\t68\t122\t122\n67\t68\t123\t123\n67\t68\t124\t124\n67\t68\t125\t125\n67\t68\t126\t126\n67\t68\t127\t127\n67\t68\t128\t128\n67\t68\t129\t129\n67\t68\t130\t130\n67\t68\t131\t131\n67\t68\t132\t132\n67\t68\t133\t133\n67\t68\t134\t134\n67\t68\t135\t135\n67\t68\t136\t136\n67\t68\t137\t137\n67\t68\t138\t138\n67\t68\t139\t139\n67\t68\t140\t140\n67\t68\t141\t141\n67\t68\t142\t142\n67\t68\t143\t143\n67\t68\t144\t144\n67\t68\t145\t145\n67\t68\t146\t146\n67\t68\t147\t147\n67\t68\t148\t148\n67\t68\t149\t149\n67\t68\t150\t150\n67\t68\t151\t151\n67\t68\t152\t152\n67\t68\t153\t153\n67\t68\t154\t154\n67\t68\t155\t155\n67\t68\t156\t156\n67\t68\t157\t157\n67\t68\t158\t158\n67\t68\t159\t159\n67\t68\t160\t160\n67\t68\t161\t161\n67\t68\t162\t162\n67\t68\t163\t163\n67\t68\t164\t164\n67\t68\t165\t165\n67\t68\t166\t166\n67\t68\t167\t167\n67\t68\t168\t168\n67\t68\t169\t169\n67\t68\t170\t170\n67\t68\t171\t171\n67\t68\t172\t172\n67\t68\t173\t173\n67\t68\t174\t174\n67\t68\t175\t175\n67\t68\t176\t176\n67\t68\t177\t177\n67\t68\t178\t178\n67\t68\t179\t179\n67\t68\t180\t180\n67\t68\t181\t181\n67\t68\t182\t182\n67\t68\t183\t183\n67\t68\t184\t184\n67\t68\t185\t185\n67\t68\t186\t186\n67\t68\t187\t187\n67\t68\t188\t188\n67\t68\t189\t189\n67\t68\t190\t190\n67\t68\t191\t191\n67\t68\t192\t192\n67\t68\t193\t193\n67\t68\t194\t194\n67\t68\t195\t195\n67\t68\t196\t196\n67\t68\t197\t197\n67\t68\t198\t198\n67\t68\t199\t199\n67\t68\t200\t200\n67\t68\t201\t201\n67\t68\t202\t202\n67\t68\t203\t203\n67\t68\t204\t204\n67\t68\t205\t205\n67\t68\t206\t206\n67\t68\t207\t207\n67\t68\t208\t208\n67\t68\t209\t209\n67\t68\t210\t210\n67\t68\t211\t211\n67\t68\t212\t212\n67\t68\t213\t213\n67\t68\t214\t214\n67\t68\t215\t215\n67\t68\t216\t216\n67\t68\t217\t217\n67\t68\t218\t218\n67\t68\t219\t219\n67\t68\t220\t220\n67\t68\t221\t221\n67\t68\t222\t222\n67\t68\t223\t223\n67\t68\t224\t224\n67\t68\t225\t225\n67\t68\t226\t226\n67\t68\t227\t227\n67\t68\t228\t228\n67\t68\t229\t229\n67\t68\t230\t230\n67\t68\t231\t231\n67\t68\t232\t232\n67\t68\t233\t233\n67\t68\t234\t234\n67\t68\t235\t235\n67\t68\t236\t236\n67\t68\t237\t237\n67\t68\t238\t238\n67\t68\t239\t239\n67\t68\t240\t240\n67\t68\t241\t241\n67\t68\t242\t242\n67\t68\t243\t243\n67\t68\t244\t244\n67\t68\t245\t245\n67\t68\t246\t246\n67\t68\t247\t247\n67\t68\t248\t248\n67\t68\t249\t249\n67\t68\t250\t250\n67\t68\t251\t251\n67\t68\t252\t252\n67\t68\t253\t253\n67\t68\t254\t254\n67\t68\t255\t255\n68\t69\t0\t0\n68\t69\t1\t1\n68\t69\t2\t2\n68\t69\t3\t3\n68\t69\t4\t4\n68\t69\t5\t5\n68\t69\t6\t6\n68\t69\t7\t7\n68\t69\t8\t8\n68\t69\t9\t9\n68\t69\t10\t10\n68\t69\t11\t11\n68\t69\t12\t12\n68\t69\t13\t13\n68\t69\t14\t14\n68\t69\t15\t15\n68\t69\t16\t16\n68\t69\t17\t17\n68\t69\t18\t18\n68\t69\t19\t19\n68\t69\t20\t20\n68\t69\t21\t21\n68\t69\t22\t22\n68\t69\t23\t23\n68\t69\t24\t24\n68\t69\t25\t25\n68\t69\t26\t26\n68\t69\t27\t27\n68\t69\t28\t28\n68\t69\t29\t29\n68\t69\t30\t30\n68\t69\t31\t31\n68\t69\t32\t32\n68\t69\t33\t33\n68\t69\t34\t34\n68\t69\t35\t35\n68\t69\t36\t36\n68\t69\t37\t37\n68\t69\t38\t38\n68\t69\t39\t39\n68\t69\t40\t40\n68\t69\t41\t41\n68\t69\t42\t42\n68\t69\t43\t43\n68\t69\t44\t44\n68\t69\t45\t45\n68\t69\t46\t46\n68\t69\t47\t47\n68\t69\t48\t48\n68\t69\t49\t49\n68\t69\t50\t50\n68\t69\t51\t51\n68\t69\t52\t52\n68\t69\t53\t53\n68\t69\t54\t54\n68\t69\t55\t55\n68\t69\t56\t56\n68\t69\t57\t57\n68\t69\t58\t58\n68\t69\t59\t59\n68\t69\t60\t60\n68\t69\t61\t61\n68\t69\t62\t62\n68\t69\t63\t63\n68\t69\t64\t64\n68\t69\t65\t65\n68\t69\t66\t66\n68\t69\t67\t67\n68\t69\t68\t68\n68\t69\t69\t69\n68\t69\t70\t70\n68\t69\t71\t71\n68\t69\t72\t72\n68\t69\t73\t73\n68\t69\t74\t74\n68\t69\t75\t75\n68\t69\t76\t76\n68\t69\t77\t77\n68\t69\t78\t78\n68\t69\t79\t79\n68\t69\t80\t80\n68\t69\t81\t81\n68\t69\t82\t82\n68\t69\t83\t83\n68\t69\t84\t84\n68\t69\t85\t85\n68\t69\t86\t86\n68\t69\t87\t87\n68\t69\t88\t88\n68\t69\t89\t89\n68\t69\t90\t90\n68\t69\t91\t91\n68\t69\t92\t92\n68\t69\t93\t93\n68\t69\t94\t94\n68\t69\t95\t95\n68\t69\t96\t96\n68\t69\t97\t97\n68\t69\t98\t98\n68\t69\t99\t99\n68\t69\t100\t100\n68\t69\t101\t101\n68\t69\t102\t102\n68\t69\t103\t103\n68\t69\t104\t104\n68\t69\t105\t105\n68\t69\t106\t106\n68\t69\t107\t107\n68\t69\t108\t108\n68\t69\t109\t109\n68\t69\t110\t110\n68\t69\t111\t111\n68\t69\t112\t112\n68\t69\t113\t113\n68\t69\t114\t114\n68\t69\t115\t115\n68\t69\t116\t116\n68\t69\t117\t117\n68\t69\t118\t118\n68\t69\t119\t119\n68\t69\t120\t120\n68\t69\t121\t121\n68\t69\t122\t122\n68\t69\t123\t123\n68\t69\t124\t124\n68\t69\t125\t125\n68\t69\t126\t126\n68\t69\t127\t127\n68\t69\t128\t128\n68\t69\t129\t129\n68\t69\t130\t130\n68\t69\t131\t131\n68\t69\t132\t132\n68\t69\t133\t133\n68\t69\t134\t134\n68\t69\t135\t135\n68\t69\t136\t136\n68\t69\t137\t137\n68\t69\t138\t138\n68\t69\t139\t139\n68\t69\t140\t140\n68\t69\t141\t141\n68\t69\t142\t142\n68\t69\t143\t143\n68\t69\t144\t144\n68\t69\t145\t145\n68\t69\t146\t146\n68\t69\t147\t147\n68\t69\t148\t148\n68\t69\t149\t149\n68\t69\t150\t150\n68\t69\t151\t151\n68\t69\t152\t152\n68\t69\t153\t153\n68\t69\t154\t154\n68\t69\t155\t155\n68\t69\t156\t156\n68\t69\t157\t157\n68\t69\t158\t158\n68\t69\t159\t159\n68\t69\t160\t160\n68\t69\t161\t161\n68\t69\t162\t162\n68\t69\t163\t163\n68\t69\t164\t164\n68\t69\t165\t165\n68\t69\t166\t166\n68\t69\t167\t167\n68\t69\t168\t168\n68\t69\t169\t169\n68\t69\t170\t170\n68\t69\t171\t171\n68\t69\t172\t172\n68\t69\t173\t173\n68\t69\t174\t174\n68\t69\t175\t175\n68\t69\t176\t176\n68\t69\t177\t177\n68\t69\t178\t178\n68\t69\t179\t179\n68\t69\t180\t180\n68\t69\t181\t181\n68\t69\t182\t182\n68\t69\t183\t183\n68\t69\t184\t184\n68\t69\t185\t185\n68\t69\t186\t186\n68\t69\t187\t187\n68\t69\t188\t188\n68\t69\t189\t189\n68\t69\t190\t190\n68\t69\t191\t191\n68\t69\t192\t192\n68\t69\t193\t193\n68\t69\t194\t194\n68\t69\t195\t195\n68\t69\t196\t196\n68\t69\t197\t197\n68\t69\t198\t198\n68\t69\t199\t199\n68\t69\t200\t200\n68\t69\t201\t201\n68\t69\t202\t202\n68\t69\t203\t203\n68\t69\t204\t204\n68\t69\t205\t205\n68\t69\t206\t206\n68\t69\t207\t207\n68\t69\t208\t208\n68\t69\t209\t209\n68\t69\t210\t210\n68\t69\t211\t211\n68\t69\t212\t212\n68\t69\t213\t213\n68\t69\t214\t214\n68\t69\t215\t215\n68\t69\t216\t216\n68\t69\t217\t217\n68\t69\t218\t218\n68\t69\t219\t219\n68\t69\t220\t220\n68\t69\t221\t221\n68\t69\t222\t222\n68\t69\t223\t223\n68\t69\t224\t224\n68\t69\t225\t225\n68\t69\t226\t226\n68\t69\t227\t227\n68\t69\t228\t228\n68\t69\t229\t229\n68\t69\t230\t230\n68\t69\t231\t231\n68\t69\t232\t232\n68\t69\t233\t233\n68\t69\t234\t234\n68\t69\t235\t235\n68\t69\t236\t236\n68\t69\t237\t237\n68\t69\t238\t238\n68\t69\t239\t239\n68\t69\t240\t240\n68\t69\t241\t241\n68\t69\t242\t242\n68\t69\t243\t243\n68\t69\t244\t244\n68\t69\t245\t245\n68\t69\t246\t246\n68\t69\t247\t247\n68\t69\t248\t248\n68\t69\t249\t249\n68\t69\t250\t250\n68\t69\t251\t251\n68\t69\t252\t252\n68\t69\t253\t253\n68\t69\t254\t254\n68\t69\t255\t255\n69\t70\t0\t0\n69\t70\t1\t1\n69\t70\t2\t2\n69\t70\t3\t3\n69\t70\t4\t4\n69\t70\t5\t5\n69\t70\t6\t6\n69\t70\t7\t7\n69\t70\t8\t8\n69\t70\t9\t9\n69\t70\t10\t10\n69\t70\t11\t11\n69\t70\t12\t12\n69\t70\t13\t13\n69\t70\t14\t14\n69\t70\t15\t15\n69\t70\t16\t16\n69\t70\t17\t17\n69\t70\t18\t18\n69\t70\t19\t19\n69\t70\t20\t20\n69\t70\t21\t21\n69\t70\t22\t22\n69\t70\t23\t23\n69\t70\t24\t24\n69\t70\t25\t25\n69\t70\t26\t26\n69\t70\t27\t27\n69\t70\t28\t28\n69\t70\t29\t29\n69\t70\t30\t30\n69\t70\t31\t31\n69\t70\t32\t32\n69\t70\t33\t33\n69\t70\t34\t34\n69\t70\t35\t35\n69\t70\t36\t36\n69\t70\t37\t37\n69\t70\t38\t38\n69\t70\t39\t39\n69\t70\t40\t40\n69\t70\t41\t41\n69\t70\t42\t42\n69\t70\t43\t43\n69\t70\t44\t44\n69\t70\t45\t45\n69\t70\t46\t46\n69\t70\t47\t47\n69\t70\t48\t48\n69\t70\t49\t49\n69\t70\t50\t50\n69\t70\t51\t51\n69\t70\t52\t52\n69\t70\t53\t53\n69\t70\t54\t54\n69\t70\t55\t55\n69\t70\t56\t56\n69\t70\t57\t57\n69\t70\t58\t58\n69\t70\t59\t59\n69\t70\t60\t60\n69\t70\t61\t61\n69\t70\t62\t62\n69\t70\t63\t63\n69\t70\t64\t64\n69\t70\t65\t65\n69\t70\t66\t66\n69\t70\t67\t67\n69\t70\t68\t68\n69\t70\t69\t69\n69\t70\t70\t70\n69\t70\t71\t71\n69\t70\t72\t72\n69\t70\t73\t73\n69\t70\t74\t74\n69\t70\t75\t75\n69\t70\t76\t76\n69\t70\t77\t77\n69\t70\t78\t78\n69\t70\t79\t79\n69\t70\t80\t80\n69\t70\t81\t81\n69\t70\t82\t82\n69\t70\t83\t83\n69\t70\t84\t84\n69\t70\t85\t85\n69\t70\t86\t86\n69\t70\t87\t87\n69\t70\t88\t88\n69\t70\t89\t89\n69\t70\t90\t90\n69\t70\t91\t91\n69\t70\t92\t92\n69\t70\t93\t93\n69\t70\t94\t94\n69\t70\t95\t95\n69\t70\t96\t96\n69\t70\t97\t97\n69\t70\t98\t98\n69\t70\t99\t99\n69\t70\t100\t100\n69\t70\t101\t101\n69\t70\t102\t102\n69\t70\t103\t103\n69\t70\t104\t104\n69\t70\t105\t105\n69\t70\t106\t106\n69\t70\t107\t107\n69\t70\t108\t108\n69\t70\t109\t109\n69\t70\t110\t110\n69\t70\t111\t111\n69\t70\t112\t112\n69\t70\t113\t113\n69\t70\t114\t114\n69\t70\t115\t115\n69\t70\t116\t116\n69\t70\t117\t117\n69\t70\t118\t118\n69\t70\t119\t119\n69\t70\t120\t120\n69\t70\t121\t121\n69\t70\t122\t122\n69\t70\t123\t123\n69\t70\t124\t124\n69\t70\t125\t125\n69\t70\t126\t126\n69\t70\t127\t127\n69\t70\t128\t128\n69\t70\t129\t129\n69\t70\t130\t130\n69\t70\t131\t131\n69\t70\t132\t132\n69\t70\t133\t133\n69\t70\t134\t134\n69\t70\t135\t135\n69\t70\t136\t136\n69\t70\t137\t137\n69\t70\t138\t138\n69\t70\t139\t139\n69\t70\t140\t140\n69\t70\t141\t141\n69\t70\t142\t142\n69\t70\t143\t143\n69\t70\t144\t144\n69\t70\t145\t145\n69\t70\t146\t146\n69\t70\t147\t147\n69\t70\t148\t148\n69\t70\t149\t149\n69\t70\t150\t150\n69\t70\t151\t151\n69\t70\t152\t152\n69\t70\t153\t153\n69\t70\t154\t154\n69\t70\t155\t155\n69\t70\t156\t156\n69\t70\t157\t157\n69\t70\t158\t158\n69\t70\t159\t159\n69\t70\t160\t160\n69\t70\t161\t161\n69\t70\t162\t162\n69\t70\t163\t163\n69\t70\t164\t164\n69\t70\t165\t165\n69\t70\t166\t166\n69\t70\t167\t167\n69\t70\t168\t168\n69\t70\t169\t169\n69\t70\t170\t170\n69\t70\t171\t171\n69\t70\t172\t172\n69\t70\t173\t173\n69\t70\t174\t174\n69\t70\t175\t175\n69\t70\t176\t176\n69\t70\t177\t177\n69\t70\t178\t178\n69\t70\t179\t179\n69\t70\t180\t180\n69\t70\t181\t181\n69\t70\t182\t182\n69\t70\t183\t183\n69\t70\t184\t184\n69\t70\t185\t185\n69\t70\t186\t186\n69\t70\t187\t187\n69\t70\t188\t188\n69\t70\t189\t189\n69\t70\t190\t190\n69\t70\t191\t191\n69\t70\t192\t192\n69\t70\t193\t193\n69\t70\t194\t194\n69\t70\t195\t195\n69\t70\t196\t196\n69\t70\t197\t197\n69\t70\t198\t198\n69\t70\t199\t199\n69\t70\t200\t200\n69\t70\t201\t201\n69\t70\t202\t202\n69\t70\t203\t203\n69\t70\t204\t204\n69\t70\t205\t205\n69\t70\t206\t206\n69\t70\t207\t207\n69\t70\t208\t208\n69\t70\t209\t209\n69\t70\t210\t210\n69\t70\t211\t211\n69\t70\t212\t212\n69\t70\t213\t213\n69\t70\t214\t214\n69\t70\t215\t215\n69\t70\t216\t216\n69\t70\t217\t217\n69\t70\t218\t218\n69\t70\t219\t219\n69\t70\t220\t220\n69\t70\t221\t221\n69\t70\t222\t222\n69\t70\t223\t223\n69\t70\t224\t224\n69\t70\t225\t225\n69\t70\t226\t226\n69\t70\t227\t227\n69\t70\t228\t228\n69\t70\t229\t229\n69\t70\t230\t230\n69\t70\t231\t231\n69\t70\t232\t232\n69\t70\t233\t233\n69\t70\t234\t234\n69\t70\t235\t235\n69\t70\t236\t236\n69\t70\t237\t237\n69\t70\t238\t238\n69\t70\t239\t239\n69\t70\t240\t240\n69\t70\t241\t241\n69\t70\t242\t242\n69\t70\t243\t243\n69\t70\t244\t244\n69\t70\t245\t245\n69\t70\t246\t246\n69\t70\t247\t247\n69\t70\t248\t248\n69\t70\t249\t249\n69\t70\t250\t250\n69\t70\t251\t251\n69\t70\t252\t252\n69\t70\t253\t253\n69\t70\t254\t254\n69\t70\t255\t255\n70\t71\t0\t0\n70\t71\t1\t1\n70\t71\t2\t2\n70\t71\t3\t3\n70\t71\t4\t4\n70\t71\t5\t5\n70\t71\t6\t6\n70\t71\t7\t7\n70\t71\t8\t8\n70\t71\t9\t9\n70\t71\t10\t10\n70\t71\t11\t11\n70\t71\t12\t12\n70\t71\t13\t13\n70\t71\t14\t14\n70\t71\t15\t15\n70\t71\t16\t16\n70\t71\t17\t17\n70\t71\t18\t18\n70\t71\t19\t19\n70\t71\t20\t20\n70\t71\t21\t21\n70\t71\t22\t22\n70\t71\t23\t23\n70\t71\t24\t24\n70\t71\t25\t25\n70\t71\t26\t26\n70\t71\t27\t27\n70\t71\t28\t28\n70\t71\t29\t29\n70\t71\t30\t30\n70\t71\t31\t31\n70\t71\t32\t32\n70\t71\t33\t33\n70\t71\t34\t34\n70\t71\t35\t35\n70\t71\t36\t36\n70\t71\t37\t37\n70\t71\t38\t38\n70\t71\t39\t39\n70\t71\t40\t40\n70\t71\t41\t41\n70\t71\t42\t42\n70\t71\t43\t43\n70\t71\t44\t44\n70\t71\t45\t45\n70\t71\t46\t46\n70\t71\t47\t47\n70\t71\t48\t48\n70\t71\t49\t49\n70\t71\t50\t50\n70\t71\t51\t51\n70\t71\t52\t52\n70\t71\t53\t53\n70\t71\t54\t54\n70\t71\t55\t55\n70\t71\t56\t56\n70\t71\t57\t57\n70\t71\t58\t58\n70\t71\t59\t59\n70\t71\t60\t60\n70\t71\t61\t61\n70\t71\t62\t62\n70\t71\t63\t63\n70\t71\t64\t64\n70\t71\t65\t65\n70\t71\t66\t66\n70\t71\t67\t67\n70\t71\t68\t68\n70\t71\t69\t69\n70\t71\t70\t70\n70\t71\t71\t71\n70\t71\t72\t72\n70\t71\t73\t73\n70\t71\t74\t74\n70\t71\t75\t75\n70\t71\t76\t76\n70\t71\t77\t77\n70\t71\t78\t78\n70\t71\t79\t79\n70\t71\t80\t80\n70\t71\t81\t81\n70\t71\t82\t82\n70\t71\t83\t83\n70\t71\t84\t84\n70\t71\t85\t85\n70\t71\t86\t86\n70\t71\t87\t87\n70\t71\t88\t88\n70\t71\t89\t89\n70\t71\t90\t90\n70\t71\t91\t91\n70\t71\t92\t92\n70\t71\t93\t93\n70\t71\t94\t94\n70\t71\t95\t95\n70\t71\t96\t96\n70\t71\t97\t97\n70\t71\t98\t98\n70\t71\t99\t99\n70\t71\t100\t100\n70\t71\t101\t101\n70\t71\t102\t102\n70\t71\t103\t103\n70\t71\t104\t104\n70\t71\t105\t105\n70\t71\t106\t106\n70\t71\t107\t107\n70\t71\t108\t108\n70\t71\t109\t109\n70\t71\t110\t110\n70\t71\t111\t111\n70\t71\t112\t112\n70\t71\t113\t113\n70\t71\t114\t114\n70\t71\t115\t115\n70\t71\t116\t116\n70\t71\t117\t117\n70\t71\t118\t118\n70\t71\t119\t119\n70\t71\t120\t120\n70\t71\t121\t121\n70\t71\t122\t122\n70\t71\t123\t123\n70\t71\t124\t124\n70\t71\t125\t125\n70\t71\t126\t126\n70\t71\t127\t127\n70\t71\t128\t128\n70\t71\t129\t129\n70\t71\t130\t130\n70\t71\t131\t131\n70\t71\t132\t132\n70\t71\t133\t133\n70\t71\t134\t134\n70\t71\t135\t135\n70\t71\t136\t136\n70\t71\t137\t137\n70\t71\t138\t138\n70\t71\t139\t139\n70\t71\t140\t140\n70\t71\t141\t141\n70\t71\t142\t142\n70\t71\t143\t143\n70\t71\t144\t144\n70\t71\t145\t145\n70\t71\t146\t146\n70\t71\t147\t147\n70\t71\t148\t148\n70\t71\t149\t149\n70\t71\t150\t150\n70\t71\t151\t151\n70\t71\t152\t152\n70\t71\t153\t153\n70\t71\t154\t154\n70\t71\t155\t155\n70\t71\t156\t156\n70\t71\t157\t157\n70\t71\t158\t158\n70\t71\t159\t159\n70\t71\t160\t160\n70\t71\t161\t161\n70\t71\t162\t162\n70\t71\t163\t163\n70\t71\t164\t164\n70\t71\t165\t165\n70\t71\t166\t166\n70\t71\t167\t167\n70\t71\t168\t168\n70\t71\t169\t169\n70\t71\t170\t170\n70\t71\t171\t171\n70\t71\t172\t172\n70\t71\t173\t173\n70\t71\t174\t174\n70\t71\t175\t175\n70\t71\t176\t176\n70\t71\t177\t177\n70\t71\t178\t178\n70\t71\t179\t179\n70\t71\t180\t180\n70\t71\t181\t181\n70\t71\t182\t182\n70\t71\t183\t183\n70\t71\t184\t184\n70\t71\t185\t185\n70\t71\t186\t186\n70\t71\t187\t187\n70\t71\t188\t188\n70\t71\t189\t189\n70\t71\t190\t190\n70\t71\t191\t191\n70\t71\t192\t192\n70\t71\t193\t193\n70\t71\t194\t194\n70\t71\t195\t195\n70\t71\t196\t196\n70\t71\t197\t197\n70\t71\t198\t198\n70\t71\t199\t199\n70\t71\t200\t200\n70\t71\t201\t201\n70\t71\t202\t202\n70\t71\t203\t203\n70\t71\t204\t204\n70\t71\t205\t205\n70\t71\t206\t206\n70\t71\t207\t207\n70\t71\t208\t208\n70\t71\t209\t209\n70\t71\t210\t210\n70\t71\t211\t211\n70\t71\t212\t212\n70\t71\t213\t213\n70\t71\t214\t214\n70\t71\t215\t215\n70\t71\t216\t216\n70\t71\t217\t217\n70\t71\t218\t218\n70\t71\t219\t219\n70\t71\t220\t220\n70\t71\t221\t221\n70\t71\t222\t222\n70\t71\t223\t223\n70\t71\t224\t224\n70\t71\t225\t225\n70\t71\t226\t226\n70\t71\t227\t227\n70\t71\t228\t228\n70\t71\t229\t229\n70\t71\t230\t230\n70\t71\t231\t231\n70\t71\t232\t232\n70\t71\t233\t233\n70\t71\t234\t234\n70\t71\t235\t235\n70\t71\t236\t236\n70\t71\t237\t237\n70\t71\t238\t238\n70\t71\t239\t239\n70\t71\t240\t240\n70\t71\t241\t241\n70\t71\t242\t242\n70\t71\t243\t243\n70\t71\t244\t244\n70\t71\t245\t245\n70\t71\t246\t246\n70\t71\t247\t247\n70\t71\t248\t248\n70\t71\t249\t249\n70\t71\t250\t250\n70\t71\t251\t251\n70\t71\t252\t252\n70\t71\t253\t253\n70\t71\t254\t254\n70\t71\t255\t255\n71\t72\t0\t0\n71\t72\t1\t1\n71\t72\t2\t2\n71\t72\t3\t3\n71\t72\t4\t4\n71\t72\t5\t5\n71\t72\t6\t6\n71\t72\t7\t7\n71\t72\t8\t8\n71\t72\t9\t9\n71\t72\t10\t10\n71\t72\t11\t11\n71\t72\t12\t12\n71\t72\t13\t13\n71\t72\t14\t14\n71\t72\t15\t15\n71\t72\t16\t16\n71\t72\t17\t17\n71\t72\t18\t18\n71\t72\t19\t19\n71\t72\t20\t20\n71\t72\t21\t21\n71\t72\t22\t22\n71\t72\t23\t23\n71\t72\t24\t24\n71\t72\t25\t25\n71\t72\t26\t26\n71\t72\t27\t27\n71\t72\t28\t28\n71\t72\t29\t29\n71\t72\t30\t30\n71\t72\t31\t31\n71\t72\t32\t32\n71\t72\t33\t33\n71\t72\t34\t34\n71\t72\t35\t35\n71\t72\t36\t36\n71\t72\t37\t37\n71\t72\t38\t38\n71\t72\t39\t39\n71\t72\t40\t40\n71\t72\t41\t41\n71\t72\t42\t42\n71\t72\t43\t43\n71\t72\t44\t44\n71\t72\t45\t45\n71\t72\t46\t46\n71\t72\t47\t47\n71\t72\t48\t48\n71\t72\t49\t49\n71\t72\t50\t50\n71\t72\t51\t51\n71\t72\t52\t52\n71\t72\t53\t53\n71\t72\t54\t54\n71\t72\t55\t55\n71\t72\t56\t56\n71\t72\t57\t57\n71\t72\t58\t58\n71\t72\t59\t59\n71\t72\t60\t60\n71\t72\t61\t61\n71\t72\t62\t62\n71\t72\t63\t63\n71\t72\t64\t64\n71\t72\t65\t65\n71\t72\t66\t66\n71\t72\t67\t67\n71\t72\t68\t68\n71\t72\t69\t69\n71\t72\t70\t70\n71\t72\t71\t71\n71\t72\t72\t72\n71\t72\t73\t73\n71\t72\t74\t74\n71\t72\t75\t75\n71\t72\t76\t76\n71\t72\t77\t77\n71\t72\t78\t78\n71\t72\t79\t79\n71\t72\t80\t80\n71\t72\t81\t81\n71\t72\t82\t82\n71\t72\t83\t83\n71\t72\t84\t84\n71\t72\t85\t85\n71\t72\t86\t86\n71\t72\t87\t87\n71\t72\t88\t88\n71\t72\t89\t89\n71\t72\t90\t90\n71\t72\t91\t91\n71\t72\t92\t92\n71\t72\t93\t93\n71\t72\t94\t94\n71\t72\t95\t95\n71\t72\t96\t96\n71\t72\t97\t97\n71\t72\t98\t98\n71\t72\t99\t99\n71\t72\t100\t100\n71\t72\t101\t101\n71\t72\t102\t102\n71\t72\t103\t103\n71\t72\t104\t104\n71\t72\t105\t105\n71\t72\t106\t106\n71\t72\t107\t107\n71\t72\t108\t108\n71\t72\t109\t109\n71\t72\t110\t110\n71\t72\t111\t111\n71\t72\t112\t112\n71\t72\t113\t113\n71\t72\t114\t114\n71\t72\t115\t115\n71\t72\t116\t116\n71\t72\t117\t117\n71\t72\t118\t118\n71\t72\t119\t119\n71\t72\t120\t120\n71\t72\t121\t121\n71\t72\t122\t122\n71\t72\t123\t123\n71\t72\t124\t124\n71\t72\t125\t125\n71\t72\t126\t126\n71\t72\t127\t127\n71\t72\t128\t128\n71\t72\t129\t129\n71\t72\t130\t130\n71\t72\t131\t131\n71\t72\t132\t132\n71\t72\t133\t133\n71\t72\t134\t134\n71\t72\t135\t135\n71\t72\t136\t136\n71\t72\t137\t137\n71\t72\t138\t138\n71\t72\t139\t139\n71\t72\t140\t140\n71\t72\t141\t141\n71\t72\t142\t142\n71\t72\t143\t143\n71\t72\t144\t144\n71\t72\t145\t145\n71\t72\t146\t146\n71\t72\t147\t147\n71\t72\t148\t148\n71\t72\t149\t149\n71\t72\t150\t150\n71\t72\t151\t151\n71\t72\t152\t152\n71\t72\t153\t153\n71\t72\t154\t154\n71\t72\t155\t155\n71\t72\t156\t156\n71\t72\t157\t157\n71\t72\t158\t158\n71\t72\t159\t159\n71\t72\t160\t160\n71\t72\t161\t161\n71\t72\t162\t162\n71\t72\t163\t163\n71\t72\t164\t164\n71\t72\t165\t165\n71\t72\t166\t166\n71\t72\t167\t167\n71\t72\t168\t168\n71\t72\t169\t169\n71\t72\t170\t170\n71\t72\t171\t171\n71\t72\t172\t172\n71\t72\t173\t173\n71\t72\t174\t174\n71\t72\t175\t175\n71\t72\t176\t176\n71\t72\t177\t177\n71\t72\t178\t178\n71\t72\t179\t179\n71\t72\t180\t180\n71\t72\t181\t181\n71\t72\t182\t182\n71\t72\t183\t183\n71\t72\t184\t184\n71\t72\t185\t185\n71\t72\t186\t186\n71\t72\t187\t187\n71\t72\t188\t188\n71\t72\t189\t189\n71\t72\t190\t190\n71\t72\t191\t191\n71\t72\t192\t192\n71\t72\t193\t193\n71\t72\t194\t194\n71\t72\t195\t195\n71\t72\t196\t196\n71\t72\t197\t197\n71\t72\t198\t198\n71\t72\t199\t199\n71\t72\t200\t200\n71\t72\t201\t201\n71\t72\t202\t202\n71\t72\t203\t203\n71\t72\t204\t204\n71\t72\t205\t205\n71\t72\t206\t206\n71\t72\t207\t207\n71\t72\t208\t208\n71\t72\t209\t209\n71\t72\t210\t210\n71\t72\t211\t211\n71\t72\t212\t212\n71\t72\t213\t213\n71\t72\t214\t214\n71\t72\t215\t215\n71\t72\t216\t216\n71\t72\t217\t217\n71\t72\t218\t218\n71\t72\t219\t219\n71\t72\t220\t220\n71\t72\t221\t221\n71\t72\t222\t222\n71\t72\t223\t223\n71\t72\t224\t224\n71\t72\t225\t225\n71\t72\t226\t226\n71\t72\t227\t227\n71\t72\t228\t228\n71\t72\t229\t229\n71\t72\t230\t230\n71\t72\t231\t231\n71\t72\t232\t232\n71\t72\t233\t233\n71\t72\t234\t234\n71\t72\t235\t235\n71\t72\t236\t236\n71\t72\t237\t237\n71\t72\t238\t238\n71\t72\t239\t239\n71\t72\t240\t240\n71\t72\t241\t241\n71\t72\t242\t242\n71\t72\t243\t243\n71\t72\t244\t244\n71\t72\t245\t245\n71\t72\t246\t246\n71\t72\t247\t247\n71\t72\t248\t248\n71\t72\t249\t249\n71\t72\t250\t250\n71\t72\t251\t251\n71\t72\t252\t252\n71\t72\t253\t253\n71\t72\t254\t254\n71\t72\t255\t255\n72\t73\t0\t0\n72\t73\t1\t1\n72\t73\t2\t2\n72\t73\t3\t3\n72\t73\t4\t4\n72\t73\t5\t5\n72\t73\t6\t6\n72\t73\t7\t7\n72\t73\t8\t8\n72\t73\t9\t9\n72\t73\t10\t10\n72\t73\t11\t11\n72\t73\t12\t12\n72\t73\t13\t13\n72\t73\t14\t14\n72\t73\t15\t15\n72\t73\t16\t16\n72\t73\t17\t17\n72\t73\t18\t18\n72\t73\t19\t19\n72\t73\t20\t20\n72\t73\t21\t21\n72\t73\t22\t22\n72\t73\t23\t23\n72\t73\t24\t24\n72\t73\t25\t25\n72\t73\t26\t26\n72\t73\t27\t27\n72\t73\t28\t28\n72\t73\t29\t29\n72\t73\t30\t30\n72\t73\t31\t31\n72\t73\t32\t32\n72\t73\t33\t33\n72\t73\t34\t34\n72\t73\t35\t35\n72\t73\t36\t36\n72\t73\t37\t37\n72\t73\t38\t38\n72\t73\t39\t39\n72\t73\t40\t40\n72\t73\t41\t41\n72\t73\t42\t42\n72\t73\t43\t43\n72\t73\t44\t44\n72\t73\t45\t45\n72\t73\t46\t46\n72\t73\t47\t47\n72\t73\t48\t48\n72\t73\t49\t49\n72\t73\t50\t50\n72\t73\t51\t51\n72\t73\t52\t52\n72\t73\t53\t53\n72\t73\t54\t54\n72\t73\t55\t55\n72\t73\t56\t56\n72\t73\t57\t57\n72\t73\t58\t58\n72\t73\t59\t59\n72\t73\t60\t60\n72\t73\t61\t61\n72\t73\t62\t62\n72\t73\t63\t63\n72\t73\t64\t64\n72\t73\t65\t65\n72\t73\t66\t66\n72\t73\t67\t67\n72\t73\t68\t68\n72\t73\t69\t69\n72\t73\t70\t70\n72\t73\t71\t71\n72\t73\t72\t72\n72\t73\t73\t73\n72\t73\t74\t74\n72\t73\t75\t75\n72\t73\t76\t76\n72\t73\t77\t77\n72\t73\t78\t78\n72\t73\t79\t79\n72\t73\t80\t80\n72\t73\t81\t81\n72\t73\t82\t82\n72\t73\t83\t83\n72\t73\t84\t84\n72\t73\t85\t85\n72\t73\t86\t86\n72\t73\t87\t87\n72\t73\t88\t88\n72\t73\t89\t89\n72\t73\t90\t90\n72\t73\t91\t91\n72\t73\t92\t92\n72\t73\t93\t93\n72\t73\t94\t94\n72\t73\t95\t95\n72\t73\t96\t96\n72\t73\t97\t97\n72\t73\t98\t98\n72\t73\t99\t99\n72\t73\t100\t100\n72\t73\t101\t101\n72\t73\t102\t102\n72\t73\t103\t103\n72\t73\t104\t104\n72\t73\t105\t105\n72\t73\t106\t106\n72\t73\t107\t107\n72\t73\t108\t108\n72\t73\t109\t109\n72\t73\t110\t110\n72\t73\t111\t111\n72\t73\t112\t112\n72\t73\t113\t113\n72\t73\t114\t114\n72\t73\t115\t115\n72\t73\t116\t116\n72\t73\t117\t117\n72\t73\t118\t118\n72\t73\t119\t119\n72\t73\t120\t120\n72\t73\t121\t121\n72\t73\t122\t122\n72\t73\t123\t123\n72\t73\t124\t124\n72\t73\t125\t125\n72\t73\t126\t126\n72\t73\t127\t127\n72\t73\t128\t128\n72\t73\t129\t129\n72\t73\t130\t130\n72\t73\t131\t131\n72\t73\t132\t132\n72\t73\t133\t133\n72\t73\t134\t134\n72\t73\t135\t135\n72\t73\t136\t136\n72\t73\t137\t137\n72\t73\t138\t138\n72\t73\t139\t139\n72\t73\t140\t140\n72\t73\t141\t141\n72\t73\t142\t142\n72\t73\t143\t143\n72\t73\t144\t144\n72\t73\t145\t145\n72\t73\t146\t146\n72\t73\t147\t147\n72\t73\t148\t148\n72\t73\t149\t149\n72\t73\t150\t150\n72\t73\t151\t151\n72\t73\t152\t152\n72\t73\t153\t153\n72\t73\t154\t154\n72\t73\t155\t155\n72\t73\t156\t156\n72\t73\t157\t157\n72\t73\t158\t158\n72\t73\t159\t159\n72\t73\t160\t160\n72\t73\t161\t161\n72\t73\t162\t162\n72\t73\t163\t163\n72\t73\t164\t164\n72\t73\t165\t165\n72\t73\t166\t166\n72\t73\t167\t167\n72\t73\t168\t168\n72\t73\t169\t169\n72\t73\t170\t170\n72\t73\t171\t171\n72\t73\t172\t172\n72\t73\t173\t173\n72\t73\t174\t174\n72\t73\t175\t175\n72\t73\t176\t176\n72\t73\t177\t177\n72\t73\t178\t178\n72\t73\t179\t179\n72\t73\t180\t180\n72\t73\t181\t181\n72\t73\t182\t182\n72\t73\t183\t183\n72\t73\t184\t184\n72\t73\t185\t185\n72\t73\t186\t186\n72\t73\t187\t187\n72\t73\t188\t188\n72\t73\t189\t189\n72\t73\t190\t190\n72\t73\t191\t191\n72\t73\t192\t192\n72\t73\t193\t193\n72\t73\t194\t194\n72\t73\t195\t195\n72\t73\t196\t196\n72\t73\t197\t197\n72\t73\t198\t198\n72\t73\t199\t199\n72\t73\t200\t200\n72\t73\t201\t201\n72\t73\t202\t202\n72\t73\t203\t203\n72\t73\t204\t204\n72\t73\t205\t205\n72\t73\t206\t206\n72\t73\t207\t207\n72\t73\t208\t208\n72\t73\t209\t209\n72\t73\t210\t210\n72\t73\t211\t211\n72\t73\t212\t212\n72\t73\t213\t213\n72\t73\t214\t214\n72\t73\t215\t215\n72\t73\t216\t216\n72\t73\t217\t217\n72\t73\t218\t218\n72\t73\t219\t219\n72\t73\t220\t220\n72\t73\t221\t221\n72\t73\t222\t222\n72\t73\t223\t223\n72\t73\t224\t224\n72\t73\t225\t225\n72\t73\t226\t226\n72\t73\t227\t227\n72\t73\t228\t228\n72\t73\t229\t229\n72\t73\t230\t230\n72\t73\t231\t231\n72\t73\t232\t232\n72\t73\t233\t233\n72\t73\t234\t234\n72\t73\t235\t235\n72\t73\t236\t236\n72\t73\t237\t237\n72\t73\t238\t238\n72\t73\t239\t239\n72\t73\t240\t240\n72\t73\t241\t241\n72\t73\t242\t242\n72\t73\t243\t243\n72\t73\t244\t244\n72\t73\t245\t245\n72\t73\t246\t246\n72\t73\t247\t247\n72\t73\t248\t248\n72\t73\t249\t249\n72\t73\t250\t250\n72\t73\t251\t251\n72\t73\t252\t252\n72\t73\t253\t253\n72\t73\t254\t254\n72\t73\t255\t255\n73\t74\t0\t0\n73\t74\t1\t1\n73\t74\t2\t2\n73\t74\t3\t3\n73\t74\t4\t4\n73\t74\t5\t5\n73\t74\t6\t6\n73\t74\t7\t7\n73\t74\t8\t8\n73\t74\t9\t9\n73\t74\t10\t10\n73\t74\t11\t11\n73\t74\t12\t12\n73\t74\t13\t13\n73\t74\t14\t14\n73\t74\t15\t15\n73\t74\t16\t16\n73\t74\t17\t17\n73\t74\t18\t18\n73\t74\t19\t19\n73\t74\t20\t20\n73\t74\t21\t21\n73\t74\t22\t22\n73\t74\t23\t23\n73\t74\t24\t24\n73\t74\t25\t25\n73\t74\t26\t26\n73\t74\t27\t27\n73\t74\t28\t28\n73\t74\t29\t29\n73\t74\t30\t30\n73\t74\t31\t31\n73\t74\t32\t32\n73\t74\t33\t33\n73\t74\t34\t34\n73\t74\t35\t35\n73\t74\t36\t36\n73\t74\t37\t37\n73\t74\t38\t38\n73\t74\t39\t39\n73\t74\t40\t40\n73\t74\t41\t41\n73\t74\t42\t42\n73\t74\t43\t43\n73\t74\t44\t44\n73\t74\t45\t45\n73\t74\t46\t46\n73\t74\t47\t47\n73\t74\t48\t48\n73\t74\t49\t49\n73\t74\t50\t50\n73\t74\t51\t51\n73\t74\t52\t52\n73\t74\t53\t53\n73\t74\t54\t54\n73\t74\t55\t55\n73\t74\t56\t56\n73\t74\t57\t57\n73\t74\t58\t58\n73\t74\t59\t59\n73\t74\t60\t60\n73\t74\t61\t61\n73\t74\t62\t62\n73\t74\t63\t63\n73\t74\t64\t64\n73\t74\t65\t65\n73\t74\t66\t66\n73\t74\t67\t67\n73\t74\t68\t68\n73\t74\t69\t69\n73\t74\t70\t70\n73\t74\t71\t71\n73\t74\t72\t72\n73\t74\t73\t73\n73\t74\t74\t74\n73\t74\t75\t75\n73\t74\t76\t76\n73\t74\t77\t77\n73\t74\t78\t78\n73\t74\t79\t79\n73\t74\t80\t80\n73\t74\t81\t81\n73\t74\t82\t82\n73\t74\t83\t83\n73\t74\t84\t84\n73\t74\t85\t85\n73\t74\t86\t86\n73\t74\t87\t87\n73\t74\t88\t88\n73\t74\t89\t89\n73\t74\t90\t90\n73\t74\t91\t91\n73\t74\t92\t92\n73\t74\t93\t93\n73\t74\t94\t94\n73\t74\t95\t95\n73\t74\t96\t96\n73\t74\t97\t97\n73\t74\t98\t98\n73\t74\t99\t99\n73\t74\t100\t100\n73\t74\t101\t101\n73\t74\t102\t102\n73\t74\t103\t103\n73\t74\t104\t104\n73\t74\t105\t105\n73\t74\t106\t106\n73\t74\t107\t107\n73\t74\t108\t108\n73\t74\t109\t109\n73\t74\t110\t110\n73\t74\t111\t111\n73\t74\t112\t112\n73\t74\t113\t113\n73\t74\t114\t114\n73\t74\t115\t115\n73\t74\t116\t116\n73\t74\t117\t117\n73\t74\t118\t118\n73\t74\t119\t119\n73\t74\t120\t120\n73\t74\t121\t121\n73\t74\t122\t122\n73\t74\t123\t123\n73\t74\t124\t124\n73\t74\t125\t125\n73\t74\t126\t126\n73\t74\t127\t127\n73\t74\t128\t128\n73\t74\t129\t129\n73\t74\t130\t130\n73\t74\t131\t131\n73\t74\t132\t132\n73\t74\t133\t133\n73\t74\t134\t134\n73\t74\t135\t135\n73\t74\t136\t136\n73\t74\t137\t137\n73\t74\t138\t138\n73\t74\t139\t139\n73\t74\t140\t140\n73\t74\t141\t141\n73\t74\t142\t142\n73\t74\t143\t143\n73\t74\t144\t144\n73\t74\t145\t145\n73\t74\t146\t146\n73\t74\t147\t147\n73\t74\t148\t148\n73\t74\t149\t149\n73\t74\t150\t150\n73\t74\t151\t151\n73\t74\t152\t152\n73\t74\t153\t153\n73\t74\t154\t154\n73\t74\t155\t155\n73\t74\t156\t156\n73\t74\t157\t157\n73\t74\t158\t158\n73\t74\t159\t159\n73\t74\t160\t160\n73\t74\t161\t161\n73\t74\t162\t162\n73\t74\t163\t163\n73\t74\t164\t164\n73\t74\t165\t165\n73\t74\t166\t166\n73\t74\t167\t167\n73\t74\t168\t168\n73\t74\t169\t169\n73\t74\t170\t170\n73\t74\t171\t171\n73\t74\t172\t172\n73\t74\t173\t173\n73\t74\t174\t174\n73\t74\t175\t175\n73\t74\t176\t176\n73\t74\t177\t177\n73\t74\t178\t178\n73\t74\t179\t179\n73\t74\t180\t180\n73\t74\t181\t181\n73\t74\t182\t182\n73\t74\t183\t183\n73\t74\t184\t184\n73\t74\t185\t185\n73\t74\t186\t186\n73\t74\t187\t187\n73\t74\t188\t188\n73\t74\t189\t189\n73\t74\t190\t190\n73\t74\t191\t191\n73\t74\t192\t192\n73\t74\t193\t193\n73\t74\t194\t194\n73\t74\t195\t195\n73\t74\t196\t196\n73\t74\t197\t197\n73\t74\t198\t198\n73\t74\t199\t199\n73\t74\t200\t200\n73\t74\t201\t201\n73\t74\t202\t202\n73\t74\t203\t203\n73\t74\t204\t204\n73\t74\t205\t205\n73\t74\t206\t206\n73\t74\t207\t207\n73\t74\t208\t208\n73\t74\t209\t209\n73\t74\t210\t210\n73\t74\t211\t211\n73\t74\t212\t212\n73\t74\t213\t213\n73\t74\t214\t214\n73\t74\t215\t215\n73\t74\t216\t216\n73\t74\t217\t217\n73\t74\t218\t218\n73\t74\t219\t219\n73\t74\t220\t220\n73\t74\t221\t221\n73\t74\t222\t222\n73\t74\t223\t223\n73\t74\t224\t224\n73\t74\t225\t225\n73\t74\t226\t226\n73\t74\t227\t227\n73\t74\t228\t228\n73\t74\t229\t229\n73\t74\t230\t230\n73\t74\t231\t231\n73\t74\t232\t232\n73\t74\t233\t233\n73\t74\t234\t234\n73\t74\t235\t235\n73\t74\t236\t236\n73\t74\t237\t237\n73\t74\t238\t238\n73\t74\t239\t239\n73\t74\t240\t240\n73\t74\t241\t241\n73\t74\t242\t242\n73\t74\t243\t243\n73\t74\t244\t244\n73\t74\t245\t245\n73\t74\t246\t246\n73\t74\t247\t247\n73\t74\t248\t248\n73\t74\t249\t249\n73\t74\t250\t250\n73\t74\t251\t251\n73\t74\t252\t252\n73\t74\t253\t253\n73\t74\t254\t254\n73\t74\t255\t255\n74\t75\t0\t0\n74\t75\t1\t1\n74\t75\t2\t2\n74\t75\t3\t3\n74\t75\t4\t4\n74\t75\t5\t5\n74\t75\t6\t6\n74\t75\t7\t7\n74\t75\t8\t8\n74\t75\t9\t9\n74\t75\t10\t10\n74\t75\t11\t11\n74\t75\t12\t12\n74\t75\t13\t13\n74\t75\t14\t14\n74\t75\t15\t15\n74\t75\t16\t16\n74\t75\t17\t17\n74\t75\t18\t18\n74\t75\t19\t19\n74\t75\t20\t20\n74\t75\t21\t21\n74\t75\t22\t22\n74\t75\t23\t23\n74\t75\t24\t24\n74\t75\t25\t25\n74\t75\t26\t26\n74\t75\t27\t27\n74\t75\t28\t28\n74\t75\t29\t29\n74\t75\t30\t30\n74\t75\t31\t31\n74\t75\t32\t32\n74\t75\t33\t33\n74\t75\t34\t34\n74\t75\t35\t35\n74\t75\t36\t36\n74\t75\t37\t37\n74\t75\t38\t38\n74\t75\t39\t39\n74\t75\t40\t40\n74\t75\t41\t41\n74\t75\t42\t42\n74\t75\t43\t43\n74\t75\t44\t44\n74\t75\t45\t45\n74\t75\t46\t46\n74\t75\t47\t47\n74\t75\t48\t48\n74\t75\t49\t49\n74\t75\t50\t50\n74\t75\t51\t51\n74\t75\t52\t52\n74\t75\t53\t53\n74\t75\t54\t54\n74\t75\t55\t55\n74\t75\t56\t56\n74\t75\t57\t57\n74\t75\t58\t58\n74\t75\t59\t59\n74\t75\t60\t60\n74\t75\t61\t61\n74\t75\t62\t62\n74\t75\t63\t63\n74\t75\t64\t64\n74\t75\t65\t65\n74\t75\t66\t66\n74\t75\t67\t67\n74\t75\t68\t68\n74\t75\t69\t69\n74\t75\t70\t70\n74\t75\t71\t71\n74\t75\t72\t72\n74\t75\t73\t73\n74\t75\t74\t74\n74\t75\t75\t75\n74\t75\t76\t76\n74\t75\t77\t77\n74\t75\t78\t78\n74\t75\t79\t79\n74\t75\t80\t80\n74\t75\t81\t81\n74\t75\t82\t82\n74\t75\t83\t83\n74\t75\t84\t84\n74\t75\t85\t85\n74\t75\t86\t86\n74\t75\t87\t87\n74\t75\t88\t88\n74\t75\t89\t89\n74\t75\t90\t90\n74\t75\t91\t91\n74\t75\t92\t92\n74\t75\t93\t93\n74\t75\t94\t94\n74\t75\t95\t95\n74\t75\t96\t96\n74\t75\t97\t97\n74\t75\t98\t98\n74\t75\t99\t99\n74\t75\t100\t100\n74\t75\t101\t101\n74\t75\t102\t102\n74\t75\t103\t103\n74\t75\t104\t104\n74\t75\t105\t105\n74\t75\t106\t106\n74\t75\t107\t107\n74\t75\t108\t108\n74\t75\t109\t109\n74\t75\t110\t110\n74\t75\t111\t111\n74\t75\t112\t112\n74\t75\t113\t113\n74\t75\t114\t114\n74\t75\t115\t115\n74\t75\t116\t116\n74\t75\t117\t117\n74\t75\t118\t118\n74\t75\t119\t119\n74\t75\t120\t120\n74\t75\t121\t121\n74\t75\t122\t122\n74\t75\t123\t123\n74\t75\t124\t124\n74\t75\t125\t125\n74\t75\t126\t126\n74\t75\t127\t127\n74\t75\t128\t128\n74\t75\t129\t129\n74\t75\t130\t130\n74\t75\t131\t131\n74\t75\t132\t132\n74\t75\t133\t133\n74\t75\t134\t134\n74\t75\t135\t135\n74\t75\t136\t136\n74\t75\t137\t137\n74\t75\t138\t138\n74\t75\t139\t139\n74\t75\t140\t140\n74\t75\t141\t141\n74\t75\t142\t142\n74\t75\t143\t143\n74\t75\t144\t144\n74\t75\t145\t145\n74\t75\t146\t146\n74\t75\t147\t147\n74\t75\t148\t148\n74\t75\t149\t149\n74\t75\t150\t150\n74\t75\t151\t151\n74\t75\t152\t152\n74\t75\t153\t153\n74\t75\t154\t154\n74\t75\t155\t155\n74\t75\t156\t156\n74\t75\t157\t157\n74\t75\t158\t158\n74\t75\t159\t159\n74\t75\t160\t160\n74\t75\t161\t161\n74\t75\t162\t162\n74\t75\t163\t163\n74\t75\t164\t164\n74\t75\t165\t165\n74\t75\t166\t166\n74\t75\t167\t167\n74\t75\t168\t168\n74\t75\t169\t169\n74\t75\t170\t170\n74\t75\t171\t171\n74\t75\t172\t172\n74\t75\t173\t173\n74\t75\t174\t174\n74\t75\t175\t175\n74\t75\t176\t176\n74\t75\t177\t177\n74\t75\t178\t178\n74\t75\t179\t179\n74\t75\t180\t180\n74\t75\t181\t181\n74\t75\t182\t182\n74\t75\t183\t183\n74\t75\t184\t184\n74\t75\t185\t185\n74\t75\t186\t186\n74\t75\t187\t187\n74\t75\t188\t188\n74\t75\t189\t189\n74\t75\t190\t190\n74\t75\t191\t191\n74\t75\t192\t192\n74\t75\t193\t193\n74\t75\t194\t194\n74\t75\t195\t195\n74\t75\t196\t196\n74\t75\t197\t197\n74\t75\t198\t198\n74\t75\t199\t199\n74\t75\t200\t200\n74\t75\t201\t201\n74\t75\t202\t202\n74\t75\t203\t203\n74\t75\t204\t204\n74\t75\t205\t205\n74\t75\t206\t206\n74\t75\t207\t207\n74\t75\t208\t208\n74\t75\t209\t209\n74\t75\t210\t210\n74\t75\t211\t211\n74\t75\t212\t212\n74\t75\t213\t213\n74\t75\t214\t214\n74\t75\t215\t215\n74\t75\t216\t216\n74\t75\t217\t217\n74\t75\t218\t218\n74\t75\t219\t219\n74\t75\t220\t220\n74\t75\t221\t221\n74\t75\t222\t222\n74\t75\t223\t223\n74\t75\t224\t224\n74\t75\t225\t225\n74\t75\t226\t226\n74\t75\t227\t227\n74\t75\t228\t228\n74\t75\t229\t229\n74\t75\t230\t230\n74\t75\t231\t231\n74\t75\t232\t232\n74\t75\t233\t233\n74\t75\t234\t234\n74\t75\t235\t235\n74\t75\t236\t236\n74\t75\t237\t237\n74\t75\t238\t238\n74\t75\t239\t239\n74\t75\t240\t240\n74\t75\t241\t241\n74\t75\t242\t242\n74\t75\t243\t243\n74\t75\t244\t244\n74\t75\t245\t245\n74\t75\t246\t246\n74\t75\t247\t247\n74\t75\t248\t248\n74\t75\t249\t249\n74\t75\t250\t250\n74\t75\t251\t251\n74\t75\t252\t252\n74\t75\t253\t253\n74\t75\t254\t254\n74\t75\t255\t255\n75\t76\t0\t0\n75\t76\t1\t1\n75\t76\t2\t2\n75\t76\t3\t3\n75\t76\t4\t4\n75\t76\t5\t5\n75\t76\t6\t6\n75\t76\t7\t7\n75\t76\t8\t8\n75\t76\t9\t9\n75\t76\t10\t10\n75\t76\t11\t11\n75\t76\t12\t12\n75\t76\t13\t13\n75\t76\t14\t14\n75\t76\t15\t15\n75\t76\t16\t16\n75\t76\t17\t17\n75\t76\t18\t18\n75\t76\t19\t19\n75\t76\t20\t20\n75\t76\t21\t21\n75\t76\t22\t22\n75\t76\t23\t23\n75\t76\t24\t24\n75\t76\t25\t25\n75\t76\t26\t26\n75\t76\t27\t27\n75\t76\t28\t28\n75\t76\t29\t29\n75\t76\t30\t30\n75\t76\t31\t31\n75\t76\t32\t32\n75\t76\t33\t33\n75\t76\t34\t34\n75\t76\t35\t35\n75\t76\t36\t36\n75\t76\t37\t37\n75\t76\t38\t38\n75\t76\t39\t39\n75\t76\t40\t40\n75\t76\t41\t41\n75\t76\t42\t42\n75\t76\t43\t43\n75\t76\t44\t44\n75\t76\t45\t45\n75\t76\t46\t46\n75\t76\t47\t47\n75\t76\t48\t48\n75\t76\t49\t49\n75\t76\t50\t50\n75\t76\t51\t51\n75\t76\t52\t52\n75\t76\t53\t53\n75\t76\t54\t54\n75\t76\t55\t55\n75\t76\t56\t56\n75\t76\t57\t57\n75\t76\t58\t58\n75\t76\t59\t59\n75\t76\t60\t60\n75\t76\t61\t61\n75\t76\t62\t62\n75\t76\t63\t63\n75\t76\t64\t64\n75\t76\t65\t65\n75\t76\t66\t66\n75\t76\t67\t67\n75\t76\t68\t68\n75\t76\t69\t69\n75\t76\t70\t70\n75\t76\t71\t71\n75\t76\t72\t72\n75\t76\t73\t73\n75\t76\t74\t74\n75\t76\t75\t75\n75\t76\t76\t76\n75\t76\t77\t77\n75\t76\t78\t78\n75\t76\t79\t79\n75\t76\t80\t80\n75\t76\t81\t81\n75\t76\t82\t82\n75\t76\t83\t83\n75\t76\t84\t84\n75\t76\t85\t85\n75\t76\t86\t86\n75\t76\t87\t87\n75\t76\t88\t88\n75\t76\t89\t89\n75\t76\t90\t90\n75\t76\t91\t91\n75\t76\t92\t92\n75\t76\t93\t93\n75\t76\t94\t94\n75\t76\t95\t95\n75\t76\t96\t96\n75\t76\t97\t97\n75\t76\t98\t98\n75\t76\t99\t99\n75\t76\t100\t100\n75\t76\t101\t101\n75\t76\t102\t102\n75\t76\t103\t103\n75\t76\t104\t104\n75\t76\t105\t105\n75\t76\t106\t106\n75\t76\t107\t107\n75\t76\t108\t108\n75\t76\t109\t109\n75\t76\t110\t110\n75\t76\t111\t111\n75\t76\t112\t112\n75\t76\t113\t113\n75\t76\t114\t114\n75\t76\t115\t115\n75\t76\t116\t116\n75\t76\t117\t117\n75\t76\t118\t118\n75\t76\t119\t119\n75\t76\t120\t120\n75\t76\t121\t121\n75\t76\t122\t122\n75\t76\t123\t123\n75\t76\t124\t124\n75\t76\t125\t125\n75\t76\t126\t126\n75\t76\t127\t127\n75\t76\t128\t128\n75\t76\t129\t129\n75\t76\t130\t130\n75\t76\t131\t131\n75\t76\t132\t132\n75\t76\t133\t133\n75\t76\t134\t134\n75\t76\t135\t135\n75\t76\t136\t136\n75\t76\t137\t137\n75\t76\t138\t138\n75\t76\t139\t139\n75\t76\t140\t140\n75\t76\t141\t141\n75\t76\t142\t142\n75\t76\t143\t143\n75\t76\t144\t144\n75\t76\t145\t145\n75\t76\t146\t146\n75\t76\t147\t147\n75\t76\t148\t148\n75\t76\t149\t149\n75\t76\t150\t150\n75\t76\t151\t151\n75\t76\t152\t152\n75\t76\t153\t153\n75\t76\t154\t154\n75\t76\t155\t155\n75\t76\t156\t156\n75\t76\t157\t157\n75\t76\t158\t158\n75\t76\t159\t159\n75\t76\t160\t160\n75\t76\t161\t161\n75\t76\t162\t162\n75\t76\t163\t163\n75\t76\t164\t164\n75\t76\t165\t165\n75\t76\t166\t166\n75\t76\t167\t167\n75\t76\t168\t168\n75\t76\t169\t169\n75\t76\t170\t170\n75\t76\t171\t171\n75\t76\t172\t172\n75\t76\t173\t173\n75\t76\t174\t174\n75\t76\t175\t175\n75\t76\t176\t176\n75\t76\t177\t177\n75\t76\t178\t178\n75\t76\t179\t179\n75\t76\t180\t180\n75\t76\t181\t181\n75\t76\t182\t182\n75\t76\t183\t183\n75\t76\t184\t184\n75\t76\t185\t185\n75\t76\t186\t186\n75\t76\t187\t187\n75\t76\t188\t188\n75\t76\t189\t189\n75\t76\t190\t190\n75\t76\t191\t191\n75\t76\t192\t192\n75\t76\t193\t193\n75\t76\t194\t194\n75\t76\t195\t195\n75\t76\t196\t196\n75\t76\t197\t197\n75\t76\t198\t198\n75\t76\t199\t199\n75\t76\t200\t200\n75\t76\t201\t201\n75\t76\t202\t202\n75\t76\t203\t203\n75\t76\t204\t204\n75\t76\t205\t205\n75\t76\t206\t206\n75\t76\t207\t207\n75\t76\t208\t208\n75\t76\t209\t209\n75\t76\t210\t210\n75\t76\t211\t211\n75\t76\t212\t212\n75\t76\t213\t213\n75\t76\t214\t214\n75\t76\t215\t215\n75\t76\t216\t216\n75\t76\t217\t217\n75\t76\t218\t218\n75\t76\t219\t219\n75\t76\t220\t220\n75\t76\t221\t221\n75\t76\t222\t222\n75\t76\t223\t223\n75\t76\t224\t224\n75\t76\t225\t225\n75\t76\t226\t226\n75\t76\t227\t227\n75\t76\t228\t228\n75\t76\t229\t229\n75\t76\t230\t230\n75\t76\t231\t231\n75\t76\t232\t232\n75\t76\t233\t233\n75\t76\t234\t234\n75\t76\t235\t235\n75\t76\t236\t236\n75\t76\t237\t237\n75\t76\t238\t238\n75\t76\t239\t239\n75\t76\t240\t240\n75\t76\t241\t241\n75\t76\t242\t242\n75\t76\t243\t243\n75\t76\t244\t244\n75\t76\t245\t245\n75\t76\t246\t246\n75\t76\t247\t247\n75\t76\t248\t248\n75\t76\t249\t249\n75\t76\t250\t250\n75\t76\t251\t251\n75\t76\t252\t252\n75\t76\t253\t253\n75\t76\t254\t254\n75\t76\t255\t255\n76\t77\t0\t0\n76\t77\t1\t1\n76\t77\t2\t2\n76\t77\t3\t3\n76\t77\t4\t4\n76\t77\t5\t5\n76\t77\t6\t6\n76\t77\t7\t7\n76\t77\t8\t8\n76\t77\t9\t9\n76\t77\t10\t10\n76\t77\t11\t11\n76\t77\t12\t12\n76\t77\t13\t13\n76\t77\t14\t14\n76\t77\t15\t15\n76\t77\t16\t16\n76\t77\t17\t17\n76\t77\t18\t18\n76\t77\t19\t19\n76\t77\t20\t20\n76\t77\t21\t21\n76\t77\t22\t22\n76\t77\t23\t23\n76\t77\t24\t24\n76\t77\t25\t25\n76\t77\t26\t26\n76\t77\t27\t27\n76\t77\t28\t28\n76\t77\t29\t29\n76\t77\t30\t30\n76\t77\t31\t31\n76\t77\t32\t32\n76\t77\t33\t33\n76\t77\t34\t34\n76\t77\t35\t35\n76\t77\t36\t36\n76\t77\t37\t37\n76\t77\t38\t38\n76\t77\t39\t39\n76\t77\t40\t40\n76\t77\t41\t41\n76\t77\t42\t42\n76\t77\t43\t43\n76\t77\t44\t44\n76\t77\t45\t45\n76\t77\t46\t46\n76\t77\t47\t47\n76\t77\t48\t48\n76\t77\t49\t49\n76\t77\t50\t50\n76\t77\t51\t51\n76\t77\t52\t52\n76\t77\t53\t53\n76\t77\t54\t54\n76\t77\t55\t55\n76\t77\t56\t56\n76\t77\t57\t57\n76\t77\t58\t58\n76\t77\t59\t59\n76\t77\t60\t60\n76\t77\t61\t61\n76\t77\t62\t62\n76\t77\t63\t63\n76\t77\t64\t64\n76\t77\t65\t65\n76\t77\t66\t66\n76\t77\t67\t67\n76\t77\t68\t68\n76\t77\t69\t69\n76\t77\t70\t70\n76\t77\t71\t71\n76\t77\t72\t72\n76\t77\t73\t73\n76\t77\t74\t74\n76\t77\t75\t75\n76\t77\t76\t76\n76\t77\t77\t77\n76\t77\t78\t78\n76\t77\t79\t79\n76\t77\t80\t80\n76\t77\t81\t81\n76\t77\t82\t82\n76\t77\t83\t83\n76\t77\t84\t84\n76\t77\t85\t85\n76\t77\t86\t86\n76\t77\t87\t87\n76\t77\t88\t88\n76\t77\t89\t89\n76\t77\t90\t90\n76\t77\t91\t91\n76\t77\t92\t92\n76\t77\t93\t93\n76\t77\t94\t94\n76\t77\t95\t95\n76\t77\t96\t96\n76\t77\t97\t97\n76\t77\t98\t98\n76\t77\t99\t99\n76\t77\t100\t100\n76\t77\t101\t101\n76\t77\t102\t102\n76\t77\t103\t103\n76\t77\t104\t104\n76\t77\t105\t105\n76\t77\t106\t106\n76\t77\t107\t107\n76\t77\t108\t108\n76\t77\t109\t109\n76\t77\t110\t110\n76\t77\t111\t111\n76\t77\t112\t112\n76\t77\t113\t113\n76\t77\t114\t114\n76\t77\t115\t115\n76\t77\t116\t116\n76\t77\t117\t117\n76\t77\t118\t118\n76\t77\t119\t119\n76\t77\t120\t120\n76\t77\t121\t121\n76\t77\t122\t122\n76\t77\t123\t123\n76\t77\t124\t124\n76\t77\t125\t125\n76\t77\t126\t126\n76\t77\t127\t127\n76\t77\t128\t128\n76\t77\t129\t129\n76\t77\t130\t130\n76\t77\t131\t131\n76\t77\t132\t132\n76\t77\t133\t133\n76\t77\t134\t134\n76\t77\t135\t135\n76\t77\t136\t136\n76\t77\t137\t137\n76\t77\t138\t138\n76\t77\t139\t139\n76\t77\t140\t140\n76\t77\t141\t141\n76\t77\t142\t142\n76\t77\t143\t143\n76\t77\t144\t144\n76\t77\t145\t145\n76\t77\t146\t146\n76\t77\t147\t147\n76\t77\t148\t148\n76\t77\t149\t149\n76\t77\t150\t150\n76\t77\t151\t151\n76\t77\t152\t152\n76\t77\t153\t153\n76\t77\t154\t154\n76\t77\t155\t155\n76\t77\t156\t156\n76\t77\t157\t157\n76\t77\t158\t158\n76\t77\t159\t159\n76\t77\t160\t160\n76\t77\t161\t161\n76\t77\t162\t162\n76\t77\t163\t163\n76\t77\t164\t164\n76\t77\t165\t165\n76\t77\t166\t166\n76\t77\t167\t167\n76\t77\t168\t168\n76\t77\t169\t169\n76\t77\t170\t170\n76\t77\t171\t171\n76\t77\t172\t172\n76\t77\t173\t173\n76\t77\t174\t174\n76\t77\t175\t175\n76\t77\t176\t176\n76\t77\t177\t177\n76\t77\t178\t178\n76\t77\t179\t179\n76\t77\t180\t180\n76\t77\t181\t181\n76\t77\t182\t182\n76\t77\t183\t183\n76\t77\t184\t184\n76\t77\t185\t185\n76\t77\t186\t186\n76\t77\t187\t187\n76\t77\t188\t188\n76\t77\t189\t189\n76\t77\t190\t190\n76\t77\t191\t191\n76\t77\t192\t192\n76\t77\t193\t193\n76\t77\t194\t194\n76\t77\t195\t195\n76\t77\t196\t196\n76\t77\t197\t197\n76\t77\t198\t198\n76\t77\t199\t199\n76\t77\t200\t200\n76\t77\t201\t201\n76\t77\t202\t202\n76\t77\t203\t203\n76\t77\t204\t204\n76\t77\t205\t205\n76\t77\t206\t206\n76\t77\t207\t207\n76\t77\t208\t208\n76\t77\t209\t209\n76\t77\t210\t210\n76\t77\t211\t211\n76\t77\t212\t212\n76\t77\t213\t213\n76\t77\t214\t214\n76\t77\t215\t215\n76\t77\t216\t216\n76\t77\t217\t217\n76\t77\t218\t218\n76\t77\t219\t219\n76\t77\t220\t220\n76\t77\t221\t221\n76\t77\t222\t222\n76\t77\t223\t223\n76\t77\t224\t224\n76\t77\t225\t225\n76\t77\t226\t226\n76\t77\t227\t227\n76\t77\t228\t228\n76\t77\t229\t229\n76\t77\t230\t230\n76\t77\t231\t231\n76\t77\t232\t232\n76\t77\t233\t233\n76\t77\t234\t234\n76\t77\t235\t235\n76\t77\t236\t236\n76\t77\t237\t237\n76\t77\t238\t238\n76\t77\t239\t239\n76\t77\t240\t240\n76\t77\t241\t241\n76\t77\t242\t242\n76\t77\t243\t243\n76\t77\t244\t244\n76\t77\t245\t245\n76\t77\t246\t246\n76\t77\t247\t247\n76\t77\t248\t248\n76\t77\t249\t249\n76\t77\t250\t250\n76\t77\t251\t251\n76\t77\t252\t252\n76\t77\t253\t253\n76\t77\t254\t254\n76\t77\t255\t255\n77\t78\t0\t0\n77\t78\t1\t1\n77\t78\t2\t2\n77\t78\t3\t3\n77\t78\t4\t4\n77\t78\t5\t5\n77\t78\t6\t6\n77\t78\t7\t7\n77\t78\t8\t8\n77\t78\t9\t9\n77\t78\t10\t10\n77\t78\t11\t11\n77\t78\t12\t12\n77\t78\t13\t13\n77\t78\t14\t14\n77\t78\t15\t15\n77\t78\t16\t16\n77\t78\t17\t17\n77\t78\t18\t18\n77\t78\t19\t19\n77\t78\t20\t20\n77\t78\t21\t21\n77\t78\t22\t22\n77\t78\t23\t23\n77\t78\t24\t24\n77\t78\t25\t25\n77\t78\t26\t26\n77\t78\t27\t27\n77\t78\t28\t28\n77\t78\t29\t29\n77\t78\t30\t30\n77\t78\t31\t31\n77\t78\t32\t32\n77\t78\t33\t33\n77\t78\t34\t34\n77\t78\t35\t35\n77\t78\t36\t36\n77\t78\t37\t37\n77\t78\t38\t38\n77\t78\t39\t39\n77\t78\t40\t40\n77\t78\t41\t41\n77\t78\t42\t42\n77\t78\t43\t43\n77\t78\t44\t44\n77\t78\t45\t45\n77\t78\t46\t46\n77\t78\t47\t47\n77\t78\t48\t48\n77\t78\t49\t49\n77\t78\t50\t50\n77\t78\t51\t51\n77\t78\t52\t52\n77\t78\t53\t53\n77\t78\t54\t54\n77\t78\t55\t55\n77\t78\t56\t56\n77\t78\t57\t57\n77\t78\t58\t58\n77\t78\t59\t59\n77\t78\t60\t60\n77\t78\t61\t61\n77\t78\t62\t62\n77\t78\t63\t63\n77\t78\t64\t64\n77\t78\t65\t65\n77\t78\t66\t66\n77\t78\t67\t67\n77\t78\t68\t68\n77\t78\t69\t69\n77\t78\t70\t70\n77\t78\t71\t71\n77\t78\t72\t72\n77\t78\t73\t73\n77\t78\t74\t74\n77\t78\t75\t75\n77\t78\t76\t76\n77\t78\t77\t77\n77\t78\t78\t78\n77\t78\t79\t79\n77\t78\t80\t80\n77\t78\t81\t81\n77\t78\t82\t82\n77\t78\t83\t83\n77\t78\t84\t84\n77\t78\t85\t85\n77\t78\t86\t86\n77\t78\t87\t87\n77\t78\t88\t88\n77\t78\t89\t89\n77\t78\t90\t90\n77\t78\t91\t91\n77\t78\t92\t92\n77\t78\t93\t93\n77\t78\t94\t94\n77\t78\t95\t95\n77\t78\t96\t96\n77\t78\t97\t97\n77\t78\t98\t98\n77\t78\t99\t99\n77\t78\t100\t100\n77\t78\t101\t101\n77\t78\t102\t102\n77\t78\t103\t103\n77\t78\t104\t104\n77\t78\t105\t105\n77\t78\t106\t106\n77\t78\t107\t107\n77\t78\t108\t108\n77\t78\t109\t109\n77\t78\t110\t110\n77\t78\t111\t111\n77\t78\t112\t112\n77\t78\t113\t113\n77\t78\t114\t114\n77\t78\t115\t115\n77\t78\t116\t116\n77\t78\t117\t117\n77\t78\t118\t118\n77\t78\t119\t119\n77\t78\t120\t120\n77\t78\t121\t121\n77\t78\t122\t122\n77\t78\t123\t123\n77\t78\t124\t124\n77\t78\t125\t125\n77\t78\t126\t126\n77\t78\t127\t127\n77\t78\t128\t128\n77\t78\t129\t129\n77\t78\t130\t130\n77\t78\t131\t131\n77\t78\t132\t132\n77\t78\t133\t133\n77\t78\t134\t134\n77\t78\t135\t135\n77\t78\t136\t136\n77\t78\t137\t137\n77\t78\t138\t138\n77\t78\t139\t139\n77\t78\t140\t140\n77\t78\t141\t141\n77\t78\t142\t142\n77\t78\t143\t143\n77\t78\t144\t144\n77\t78\t145\t145\n77\t78\t146\t146\n77\t78\t147\t147\n77\t78\t148\t148\n77\t78\t149\t149\n77\t78\t150\t150\n77\t78\t151\t151\n77\t78\t152\t152\n77\t78\t153\t153\n77\t78\t154\t154\n77\t78\t155\t155\n77\t78\t156\t156\n77\t78\t157\t157\n77\t78\t158\t158\n77\t78\t159\t159\n77\t78\t160\t160\n77\t78\t161\t161\n77\t78\t162\t162\n77\t78\t163\t163\n77\t78\t164\t164\n77\t78\t165\t165\n77\t78\t166\t166\n77\t78\t167\t167\n77\t78\t168\t168\n77\t78\t169\t169\n77\t78\t170\t170\n77\t78\t171\t171\n77\t78\t172\t172\n77\t78\t173\t173\n77\t78\t174\t174\n77\t78\t175\t175\n77\t78\t176\t176\n77\t78\t177\t177\n77\t78\t178\t178\n77\t78\t179\t179\n77\t78\t180\t180\n77\t78\t181\t181\n77\t78\t182\t182\n77\t78\t183\t183\n77\t78\t184\t184\n77\t78\t185\t185\n77\t78\t186\t186\n77\t78\t187\t187\n77\t78\t188\t188\n77\t78\t189\t189\n77\t78\t190\t190\n77\t78\t191\t191\n77\t78\t192\t192\n77\t78\t193\t193\n77\t78\t194\t194\n77\t78\t195\t195\n77\t78\t196\t196\n77\t78\t197\t197\n77\t78\t198\t198\n77\t78\t199\t199\n77\t78\t200\t200\n77\t78\t201\t201\n77\t78\t202\t202\n77\t78\t203\t203\n77\t78\t204\t204\n77\t78\t205\t205\n77\t78\t206\t206\n77\t78\t207\t207\n77\t78\t208\t208\n77\t78\t209\t209\n77\t78\t210\t210\n77\t78\t211\t211\n77\t78\t212\t212\n77\t78\t213\t213\n77\t78\t214\t214\n77\t78\t215\t215\n77\t78\t216\t216\n77\t78\t217\t217\n77\t78\t218\t218\n77\t78\t219\t219\n77\t78\t220\t220\n77\t78\t221\t221\n77\t78\t222\t222\n77\t78\t223\t223\n77\t78\t224\t224\n77\t78\t225\t225\n77\t78\t226\t226\n77\t78\t227\t227\n77\t78\t228\t228\n77\t78\t229\t229\n77\t78\t230\t230\n77\t78\t231\t231\n77\t78\t232\t232\n77\t78\t233\t233\n77\t78\t234\t234\n77\t78\t235\t235\n77\t78\t236\t236\n77\t78\t237\t237\n77\t78\t238\t238\n77\t78\t239\t239\n77\t78\t240\t240\n77\t78\t241\t241\n77\t78\t242\t242\n77\t78\t243\t243\n77\t78\t244\t244\n77\t78\t245\t245\n77\t78\t246\t246\n77\t78\t247\t247\n77\t78\t248\t248\n77\t78\t249\t249\n77\t78\t250\t250\n77\t78\t251\t251\n77\t78\t252\t252\n77\t78\t253\t253\n77\t78\t254\t254\n77\t78\t255\t255\n78\t79\t0\t0\n78\t79\t1\t1\n78\t79\t2\t2\n78\t79\t3\t3\n78\t79\t4\t4\n78\t79\t5\t5\n78\t79\t6\t6\n78\t79\t7\t7\n78\t79\t8\t8\n78\t79\t9\t9\n78\t79\t10\t10\n78\t79\t11\t11\n78\t79\t12\t12\n78\t79\t13\t13\n78\t79\t14\t14\n78\t79\t15\t15\n78\t79\t16\t16\n78\t79\t17\t17\n78\t79\t18\t18\n78\t79\t19\t19\n78\t79\t20\t20\n78\t79\t21\t21\n78\t79\t22\t22\n78\t79\t23\t23\n78\t79\t24\t24\n78\t79\t25\t25\n78\t79\t26\t26\n78\t79\t27\t27\n78\t79\t28\t28\n78\t79\t29\t29\n78\t79\t30\t30\n78\t79\t31\t31\n78\t79\t32\t32\n78\t79\t33\t33\n78\t79\t34\t34\n78\t79\t35\t35\n78\t79\t36\t36\n78\t79\t37\t37\n78\t79\t38\t38\n78\t79\t39\t39\n78\t79\t40\t40\n78\t79\t41\t41\n78\t79\t42\t42\n78\t79\t43\t43\n78\t79\t44\t44\n78\t79\t45\t45\n78\t79\t46\t46\n78\t79\t47\t47\n78\t79\t48\t48\n78\t79\t49\t49\n78\t79\t50\t50\n78\t79\t51\t51\n78\t79\t52\t52\n78\t79\t53\t53\n78\t79\t54\t54\n78\t79\t55\t55\n78\t79\t56\t56\n78\t79\t57\t57\n78\t79\t58\t58\n78\t79\t59\t59\n78\t79\t60\t60\n78\t79\t61\t61\n78\t79\t62\t62\n78\t79\t63\t63\n78\t79\t64\t64\n78\t79\t65\t65\n78\t79\t66\t66\n78\t79\t67\t67\n78\t79\t68\t68\n78\t79\t69\t69\n78\t79\t70\t70\n78\t79\t71\t71\n78\t79\t72\t72\n78\t79\t73\t73\n78\t79\t74\t74\n78\t79\t75\t75\n78\t79\t76\t76\n78\t79\t77\t77\n78\t79\t78\t78\n78\t79\t79\t79\n78\t79\t80\t80\n78\t79\t81\t81\n78\t79\t82\t82\n78\t79\t83\t83\n78\t79\t84\t84\n78\t79\t85\t85\n78\t79\t86\t86\n78\t79\t87\t87\n78\t79\t88\t88\n78\t79\t89\t89\n78\t79\t90\t90\n78\t79\t91\t91\n78\t79\t92\t92\n78\t79\t93\t93\n78\t79\t94\t94\n78\t79\t95\t95\n78\t79\t96\t96\n78\t79\t97\t97\n78\t79\t98\t98\n78\t79\t99\t99\n78\t79\t100\t100\n78\t79\t101\t101\n78\t79\t102\t102\n78\t79\t103\t103\n78\t79\t104\t104\n78\t79\t105\t105\n78\t79\t106\t106\n78\t79\t107\t107\n78\t79\t108\t108\n78\t79\t109\t109\n78\t79\t110\t110\n78\t79\t111\t111\n78\t79\t112\t112\n78\t79\t113\t113\n78\t79\t114\t114\n78\t79\t115\t115\n78\t79\t116\t116\n78\t79\t117\t117\n78\t79\t118\t118\n78\t79\t119\t119\n78\t79\t120\t120\n78\t79\t121\t121\n78\t79\t122\t122\n78\t79\t123\t123\n78\t79\t124\t124\n78\t79\t125\t125\n78\t79\t126\t126\n78\t79\t127\t127\n78\t79\t128\t128\n78\t79\t129\t129\n78\t79\t130\t130\n78\t79\t131\t131\n78\t79\t132\t132\n78\t79\t133\t133\n78\t79\t134\t134\n78\t79\t135\t135\n78\t79\t136\t136\n78\t79\t137\t137\n78\t79\t138\t138\n78\t79\t139\t139\n78\t79\t140\t140\n78\t79\t141\t141\n78\t79\t142\t142\n78\t79\t143\t143\n78\t79\t144\t144\n78\t79\t145\t145\n78\t79\t146\t146\n78\t79\t147\t147\n78\t79\t148\t148\n78\t79\t149\t149\n78\t79\t150\t150\n78\t79\t151\t151\n78\t79\t152\t152\n78\t79\t153\t153\n78\t79\t154\t154\n78\t79\t155\t155\n78\t79\t156\t156\n78\t79\t157\t157\n78\t79\t158\t158\n78\t79\t159\t159\n78\t79\t160\t160\n78\t79\t161\t161\n78\t79\t162\t162\n78\t79\t163\t163\n78\t79\t164\t164\n78\t79\t165\t165\n78\t79\t166\t166\n78\t79\t167\t167\n78\t79\t168\t168\n78\t79\t169\t169\n78\t79\t170\t170\n78\t79\t171\t171\n78\t79\t172\t172\n78\t79\t173\t173\n78\t79\t174\t174\n78\t79\t175\t175\n78\t79\t176\t176\n78\t79\t177\t177\n78\t79\t178\t178\n78\t79\t179\t179\n78\t79\t180\t180\n78\t79\t181\t181\n78\t79\t182\t182\n78\t79\t183\t183\n78\t79\t184\t184\n78\t79\t185\t185\n78\t79\t186\t186\n78\t79\t187\t187\n78\t79\t188\t188\n78\t79\t189\t189\n78\t79\t190\t190\n78\t79\t191\t191\n78\t79\t192\t192\n78\t79\t193\t193\n78\t79\t194\t194\n78\t79\t195\t195\n78\t79\t196\t196\n78\t79\t197\t197\n78\t79\t198\t198\n78\t79\t199\t199\n78\t79\t200\t200\n78\t79\t201\t201\n78\t79\t202\t202\n78\t79\t203\t203\n78\t79\t204\t204\n78\t79\t205\t205\n78\t79\t206\t206\n78\t79\t207\t207\n78\t79\t208\t208\n78\t79\t209\t209\n78\t79\t210\t210\n78\t79\t211\t211\n78\t79\t212\t212\n78\t79\t213\t213\n78\t79\t214\t214\n78\t79\t215\t215\n78\t79\t216\t216\n78\t79\t217\t217\n78\t79\t218\t218\n78\t79\t219\t219\n78\t79\t220\t220\n78\t79\t221\t221\n78\t79\t222\t222\n78\t79\t223\t223\n78\t79\t224\t224\n78\t79\t225\t225\n78\t79\t226\t226\n78\t79\t227\t227\n78\t79\t228\t228\n78\t79\t229\t229\n78\t79\t230\t230\n78\t79\t231\t231\n78\t79\t232\t232\n78\t79\t233\t233\n78\t79\t234\t234\n78\t79\t235\t235\n78\t79\t236\t236\n78\t79\t237\t237\n78\t79\t238\t238\n78\t79\t239\t239\n78\t79\t240\t240\n78\t79\t241\t241\n78\t79\t242\t242\n78\t79\t243\t243\n78\t79\t244\t244\n78\t79\t245\t245\n78\t79\t246\t246\n78\t79\t247\t247\n78\t79\t248\t248\n78\t79\t249\t249\n78\t79\t250\t250\n78\t79\t251\t251\n78\t79\t252\t252\n78\t79\t253\t253\n78\t79\t254\t254\n78\t79\t255\t255\n79\t80\t0\t0\n79\t80\t1\t1\n79\t80\t2\t2\n79\t80\t3\t3\n79\t80\t4\t4\n79\t80\t5\t5\n79\t80\t6\t6\n79\t80\t7\t7\n79\t80\t8\t8\n79\t80\t9\t9\n79\t80\t10\t10\n79\t80\t11\t11\n79\t80\t12\t12\n79\t80\t13\t13\n79\t80\t14\t14\n79\t80\t15\t15\n79\t80\t16\t16\n79\t80\t17\t17\n79\t80\t18\t18\n79\t80\t19\t19\n79\t80\t20\t20\n79\t80\t21\t21\n79\t80\t22\t22\n79\t80\t23\t23\n79\t80\t24\t24\n79\t80\t25\t25\n79\t80\t26\t26\n79\t80\t27\t27\n79\t80\t28\t28\n79\t80\t29\t29\n79\t80\t30\t30\n79\t80\t31\t31\n79\t80\t32\t32\n79\t80\t33\t33\n79\t80\t34\t34\n79\t80\t35\t35\n79\t80\t36\t36\n79\t80\t37\t37\n79\t80\t38\t38\n79\t80\t39\t39\n79\t80\t40\t40\n79\t80\t41\t41\n79\t80\t42\t42\n79\t80\t43\t43\n79\t80\t44\t44\n79\t80\t45\t45\n79\t80\t46\t46\n79\t80\t47\t47\n79\t80\t48\t48\n79\t80\t49\t49\n79\t80\t50\t50\n79\t80\t51\t51\n79\t80\t52\t52\n79\t80\t53\t53\n79\t80\t54\t54\n79\t80\t55\t55\n79\t80\t56\t56\n79\t80\t57\t57\n79\t80\t58\t58\n79\t80\t59\t59\n79\t80\t60\t60\n79\t80\t61\t61\n79\t80\t62\t62\n79\t80\t63\t63\n79\t80\t64\t64\n79\t80\t65\t65\n79\t80\t66\t66\n79\t80\t67\t67\n79\t80\t68\t68\n79\t80\t69\t69\n79\t80\t70\t70\n79\t80\t71\t71\n79\t80\t72\t72\n79\t80\t73\t73\n79\t80\t74\t74\n79\t80\t75\t75\n79\t80\t76\t76\n79\t80\t77\t77\n79\t80\t78\t78\n79\t80\t79\t79\n79\t80\t80\t80\n79\t80\t81\t81\n79\t80\t82\t82\n79\t80\t83\t83\n79\t80\t84\t84\n79\t80\t85\t85\n79\t80\t86\t86\n79\t80\t87\t87\n79\t80\t88\t88\n79\t80\t89\t89\n79\t80\t90\t90\n79\t80\t91\t91\n79\t80\t92\t92\n79\t80\t93\t93\n79\t80\t94\t94\n79\t80\t95\t95\n79\t80\t96\t96\n79\t80\t97\t97\n79\t80\t98\t98\n79\t80\t99\t99\n79\t80\t100\t100\n79\t80\t101\t101\n79\t80\t102\t102\n79\t80\t103\t103\n79\t80\t104\t104\n79\t80\t105\t105\n79\t80\t106\t106\n79\t80\t107\t107\n79\t80\t108\t108\n79\t80\t109\t109\n79\t80\t110\t110\n79\t80\t111\t111\n79\t80\t112\t112\n79\t80\t113\t113\n79\t80\t114\t114\n79\t80\t115\t115\n79\t80\t116\t116\n79\t80\t117\t117\n79\t80\t118\t118\n79\t80\t119\t119\n79\t80\t120\t120\n79\t80\t121\t121\n79\t80\t122\t122\n79\t80\t123\t123\n79\t80\t124\t124\n79\t80\t125\t125\n79\t80\t126\t126\n79\t80\t127\t127\n79\t80\t128\t128\n79\t80\t129\t129\n79\t80\t130\t130\n79\t80\t131\t131\n79\t80\t132\t132\n79\t80\t133\t133\n79\t80\t134\t134\n79\t80\t135\t135\n79\t80\t136\t136\n79\t80\t137\t137\n79\t80\t138\t138\n79\t80\t139\t139\n79\t80\t140\t140\n79\t80\t141\t141\n79\t80\t142\t142\n79\t80\t143\t143\n79\t80\t144\t144\n79\t80\t145\t145\n79\t80\t146\t146\n79\t80\t147\t147\n79\t80\t148\t148\n79\t80\t149\t149\n79\t80\t150\t150\n79\t80\t151\t151\n79\t80\t152\t152\n79\t80\t153\t153\n79\t80\t154\t154\n79\t80\t155\t155\n79\t80\t156\t156\n79\t80\t157\t157\n79\t80\t158\t158\n79\t80\t159\t159\n79\t80\t160\t160\n79\t80\t161\t161\n79\t80\t162\t162\n79\t80\t163\t163\n79\t80\t164\t164\n79\t80\t165\t165\n79\t80\t166\t166\n79\t80\t167\t167\n79\t80\t168\t168\n79\t80\t169\t169\n79\t80\t170\t170\n79\t80\t171\t171\n79\t80\t172\t172\n79\t80\t173\t173\n79\t80\t174\t174\n79\t80\t175\t175\n79\t80\t176\t176\n79\t80\t177\t177\n79\t80\t178\t178\n79\t80\t179\t179\n79\t80\t180\t180\n79\t80\t181\t181\n79\t80\t182\t182\n79\t80\t183\t183\n79\t80\t184\t184\n79\t80\t185\t185\n79\t80\t186\t186\n79\t80\t187\t187\n79\t80\t188\t188\n79\t80\t189\t189\n79\t80\t190\t190\n79\t80\t191\t191\n79\t80\t192\t192\n79\t80\t193\t193\n79\t80\t194\t194\n79\t80\t195\t195\n79\t80\t196\t196\n79\t80\t197\t197\n79\t80\t198\t198\n79\t80\t199\t199\n79\t80\t200\t200\n79\t80\t201\t201\n79\t80\t202\t202\n79\t80\t203\t203\n79\t80\t204\t204\n79\t80\t205\t205\n79\t80\t206\t206\n79\t80\t207\t207\n79\t80\t208\t208\n79\t80\t209\t209\n79\t80\t210\t210\n79\t80\t211\t211\n79\t80\t212\t212\n79\t80\t213\t213\n79\t80\t214\t214\n79\t80\t215\t215\n79\t80\t216\t216\n79\t80\t217\t217\n79\t80\t218\t218\n79\t80\t219\t219\n79\t80\t220\t220\n79\t80\t221\t221\n79\t80\t222\t222\n79\t80\t223\t223\n79\t80\t224\t224\n79\t80\t225\t225\n79\t80\t226\t226\n79\t80\t227\t227\n79\t80\t228\t228\n79\t80\t229\t229\n79\t80\t230\t230\n79\t80\t231\t231\n79\t80\t232\t232\n79\t80\t233\t233\n79\t80\t234\t234\n79\t80\t235\t235\n79\t80\t236\t236\n79\t80\t237\t237\n79\t80\t238\t238\n79\t80\t239\t239\n79\t80\t240\t240\n79\t80\t241\t241\n79\t80\t242\t242\n79\t80\t243\t243\n79\t80\t244\t244\n79\t80\t245\t245\n79\t80\t246\t246\n79\t80\t247\t247\n79\t80\t248\t248\n79\t80\t249\t249\n79\t80\t250\t250\n79\t80\t251\t251\n79\t80\t252\t252\n79\t80\t253\t253\n79\t80\t254\t254\n79\t80\t255\t255\n80\t81\t0\t0\n80\t81\t1\t1\n80\t81\t2\t2\n80\t81\t3\t3\n80\t81\t4\t4\n80\t81\t5\t5\n80\t81\t6\t6\n80\t81\t7\t7\n80\t81\t8\t8\n80\t81\t9\t9\n80\t81\t10\t10\n80\t81\t11\t11\n80\t81\t12\t12\n80\t81\t13\t13\n80\t81\t14\t14\n80\t81\t15\t15\n80\t81\t16\t16\n80\t81\t17\t17\n80\t81\t18\t18\n80\t81\t19\t19\n80\t81\t20\t20\n80\t81\t21\t21\n80\t81\t22\t22\n80\t81\t23\t23\n80\t81\t24\t24\n80\t81\t25\t25\n80\t81\t26\t26\n80\t81\t27\t27\n80\t81\t28\t28\n80\t81\t29\t29\n80\t81\t30\t30\n80\t81\t31\t31\n80\t81\t32\t32\n80\t81\t33\t33\n80\t81\t34\t34\n80\t81\t35\t35\n80\t81\t36\t36\n80\t81\t37\t37\n80\t81\t38\t38\n80\t81\t39\t39\n80\t81\t40\t40\n80\t81\t41\t41\n80\t81\t42\t42\n80\t81\t43\t43\n80\t81\t44\t44\n80\t81\t45\t45\n80\t81\t46\t46\n80\t81\t47\t47\n80\t81\t48\t48\n80\t81\t49\t49\n80\t81\t50\t50\n80\t81\t51\t51\n80\t81\t52\t52\n80\t81\t53\t53\n80\t81\t54\t54\n80\t81\t55\t55\n80\t81\t56\t56\n80\t81\t57\t57\n80\t81\t58\t58\n80\t81\t59\t59\n80\t81\t60\t60\n80\t81\t61\t61\n80\t81\t62\t62\n80\t81\t63\t63\n80\t81\t64\t64\n80\t81\t65\t65\n80\t81\t66\t66\n80\t81\t67\t67\n80\t81\t68\t68\n80\t81\t69\t69\n80\t81\t70\t70\n80\t81\t71\t71\n80\t81\t72\t72\n80\t81\t73\t73\n80\t81\t74\t74\n80\t81\t75\t75\n80\t81\t76\t76\n80\t81\t77\t77\n80\t81\t78\t78\n80\t81\t79\t79\n80\t81\t80\t80\n80\t81\t81\t81\n80\t81\t82\t82\n80\t81\t83\t83\n80\t81\t84\t84\n80\t81\t85\t85\n80\t81\t86\t86\n80\t81\t87\t87\n80\t81\t88\t88\n80\t81\t89\t89\n80\t81\t90\t90\n80\t81\t91\t91\n80\t81\t92\t92\n80\t81\t93\t93\n80\t81\t94\t94\n80\t81\t95\t95\n80\t81\t96\t96\n80\t81\t97\t97\n80\t81\t98\t98\n80\t81\t99\t99\n80\t81\t100\t100\n80\t81\t101\t101\n80\t81\t102\t102\n80\t81\t103\t103\n80\t81\t104\t104\n80\t81\t105\t105\n80\t81\t106\t106\n80\t81\t107\t107\n80\t81\t108\t108\n80\t81\t109\t109\n80\t81\t110\t110\n80\t81\t111\t111\n80\t81\t112\t112\n80\t81\t113\t113\n80\t81\t114\t114\n80\t81\t115\t115\n80\t81\t116\t116\n80\t81\t117\t117\n80\t81\t118\t118\n80\t81\t119\t119\n80\t81\t120\t120\n80\t81\t121\t121\n80\t81\t122\t122\n80\t81\t123\t123\n80\t81\t124\t124\n80\t81\t125\t125\n80\t81\t126\t126\n80\t81\t127\t127\n80\t81\t128\t128\n80\t81\t129\t129\n80\t81\t130\t130\n80\t81\t131\t131\n80\t81\t132\t132\n80\t81\t133\t133\n80\t81\t134\t134\n80\t81\t135\t135\n80\t81\t136\t136\n80\t81\t137\t137\n80\t81\t138\t138\n80\t81\t139\t139\n80\t81\t140\t140\n80\t81\t141\t141\n80\t81\t142\t142\n80\t81\t143\t143\n80\t81\t144\t144\n80\t81\t145\t145\n80\t81\t146\t146\n80\t81\t147\t147\n80\t81\t148\t148\n80\t81\t149\t149\n80\t81\t150\t150\n80\t81\t151\t151\n80\t81\t152\t152\n80\t81\t153\t153\n80\t81\t154\t154\n80\t81\t155\t155\n80\t81\t156\t156\n80\t81\t157\t157\n80\t81\t158\t158\n80\t81\t159\t159\n80\t81\t160\t160\n80\t81\t161\t161\n80\t81\t162\t162\n80\t81\t163\t163\n80\t81\t164\t164\n80\t81\t165\t165\n80\t81\t166\t166\n80\t81\t167\t167\n80\t81\t168\t168\n80\t81\t169\t169\n80\t81\t170\t170\n80\t81\t171\t171\n80\t81\t172\t172\n80\t81\t173\t173\n80\t81\t174\t174\n80\t81\t175\t175\n80\t81\t176\t176\n80\t81\t177\t177\n80\t81\t178\t178\n80\t81\t179\t179\n80\t81\t180\t180\n80\t81\t181\t181\n80\t81\t182\t182\n80\t81\t183\t183\n80\t81\t184\t184\n80\t81\t185\t185\n80\t81\t186\t186\n80\t81\t187\t187\n80\t81\t188\t188\n80\t81\t189\t189\n80\t81\t190\t190\n80\t81\t191\t191\n80\t81\t192\t192\n80\t81\t193\t193\n80\t81\t194\t194\n80\t81\t195\t195\n80\t81\t196\t196\n80\t81\t197\t197\n80\t81\t198\t198\n80\t81\t199\t199\n80\t81\t200\t200\n80\t81\t201\t201\n80\t81\t202\t202\n80\t81\t203\t203\n80\t81\t204\t204\n80\t81\t205\t205\n80\t81\t206\t206\n80\t81\t207\t207\n80\t81\t208\t208\n80\t81\t209\t209\n80\t81\t210\t210\n80\t81\t211\t211\n80\t81\t212\t212\n80\t81\t213\t213\n80\t81\t214\t214\n80\t81\t215\t215\n80\t81\t216\t216\n80\t81\t217\t217\n80\t81\t218\t218\n80\t81\t219\t219\n80\t81\t220\t220\n80\t81\t221\t221\n80\t81\t222\t222\n80\t81\t223\t223\n80\t81\t224\t224\n80\t81\t225\t225\n80\t81\t226\t226\n80\t81\t227\t227\n80\t81\t228\t228\n80\t81\t229\t229\n80\t81\t230\t230\n80\t81\t231\t231\n80\t81\t232\t232\n80\t81\t233\t233\n80\t81\t234\t234\n80\t81\t235\t235\n80\t81\t236\t236\n80\t81\t237\t237\n80\t81\t238\t238\n80\t81\t239\t239\n80\t81\t240\t240\n80\t81\t241\t241\n80\t81\t242\t242\n80\t81\t243\t243\n80\t81\t244\t244\n80\t81\t245\t245\n80\t81\t246\t246\n80\t81\t247\t247\n80\t81\t248\t248\n80\t81\t249\t249\n80\t81\t250\t250\n80\t81\t251\t251\n80\t81\t252\t252\n80\t81\t253\t253\n80\t81\t254\t254\n80\t81\t255\t255\n81\t82\t0\t0\n81\t82\t1\t1\n81\t82\t2\t2\n81\t82\t3\t3\n81\t82\t4\t4\n81\t82\t5\t5\n81\t82\t6\t6\n81\t82\t7\t7\n81\t82\t8\t8\n81\t82\t9\t9\n81\t82\t10\t10\n81\t82\t11\t11\n81\t82\t12\t12\n81\t82\t13\t13\n81\t82\t14\t14\n81\t82\t15\t15\n81\t82\t16\t16\n81\t82\t17\t17\n81\t82\t18\t18\n81\t82\t19\t19\n81\t82\t20\t20\n81\t82\t21\t21\n81\t82\t22\t22\n81\t82\t23\t23\n81\t82\t24\t24\n81\t82\t25\t25\n81\t82\t26\t26\n81\t82\t27\t27\n81\t82\t28\t28\n81\t82\t29\t29\n81\t82\t30\t30\n81\t82\t31\t31\n81\t82\t32\t32\n81\t82\t33\t33\n81\t82\t34\t34\n81\t82\t35\t35\n81\t82\t36\t36\n81\t82\t37\t37\n81\t82\t38\t38\n81\t82\t39\t39\n81\t82\t40\t40\n81\t82\t41\t41\n81\t82\t42\t42\n81\t82\t43\t43\n81\t82\t44\t44\n81\t82\t45\t45\n81\t82\t46\t46\n81\t82\t47\t47\n81\t82\t48\t48\n81\t82\t49\t49\n81\t82\t50\t50\n81\t82\t51\t51\n81\t82\t52\t52\n81\t82\t53\t53\n81\t82\t54\t54\n81\t82\t55\t55\n81\t82\t56\t56\n81\t82\t57\t57\n81\t82\t58\t58\n81\t82\t59\t59\n81\t82\t60\t60\n81\t82\t61\t61\n81\t82\t62\t62\n81\t82\t63\t63\n81\t82\t64\t64\n81\t82\t65\t65\n81\t82\t66\t66\n81\t82\t67\t67\n81\t82\t68\t68\n81\t82\t69\t69\n81\t82\t70\t70\n81\t82\t71\t71\n81\t82\t72\t72\n81\t82\t73\t73\n81\t82\t74\t74\n81\t82\t75\t75\n81\t82\t76\t76\n81\t82\t77\t77\n81\t82\t78\t78\n81\t82\t79\t79\n81\t82\t80\t80\n81\t82\t81\t81\n81\t82\t82\t82\n81\t82\t83\t83\n81\t82\t84\t84\n81\t82\t85\t85\n81\t82\t86\t86\n81\t82\t87\t87\n81\t82\t88\t88\n81\t82\t89\t89\n81\t82\t90\t90\n81\t82\t91\t91\n81\t82\t92\t92\n81\t82\t93\t93\n81\t82\t94\t94\n81\t82\t95\t95\n81\t82\t96\t96\n81\t82\t97\t97\n81\t82\t98\t98\n81\t82\t99\t99\n81\t82\t100\t100\n81\t82\t101\t101\n81\t82\t102\t102\n81\t82\t103\t103\n81\t82\t104\t104\n81\t82\t105\t105\n81\t82\t106\t106\n81\t82\t107\t107\n81\t82\t108\t108\n81\t82\t109\t109\n81\t82\t110\t110\n81\t82\t111\t111\n81\t82\t112\t112\n81\t82\t113\t113\n81\t82\t114\t114\n81\t82\t115\t115\n81\t82\t116\t116\n81\t82\t117\t117\n81\t82\t118\t118\n81\t82\t119\t119\n81\t82\t120\t120\n81\t82\t121\t121\n81\t82\t122\t122\n81\t82\t123\t123\n81\t82\t124\t124\n81\t82\t125\t125\n81\t82\t126\t126\n81\t82\t127\t127\n81\t82\t128\t128\n81\t82\t129\t129\n81\t82\t130\t130\n81\t82\t131\t131\n81\t82\t132\t132\n81\t82\t133\t133\n81\t82\t134\t134\n81\t82\t135\t135\n81\t82\t136\t136\n81\t82\t137\t137\n81\t82\t138\t138\n81\t82\t139\t139\n81\t82\t140\t140\n81\t82\t141\t141\n81\t82\t142\t142\n81\t82\t143\t143\n81\t82\t144\t144\n81\t82\t145\t145\n81\t82\t146\t146\n81\t82\t147\t147\n81\t82\t148\t148\n81\t82\t149\t149\n81\t82\t150\t150\n81\t82\t151\t151\n81\t82\t152\t152\n81\t82\t153\t153\n81\t82\t154\t154\n81\t82\t155\t155\n81\t82\t156\t156\n81\t82\t157\t157\n81\t82\t158\t158\n81\t82\t159\t159\n81\t82\t160\t160\n81\t82\t161\t161\n81\t82\t162\t162\n81\t82\t163\t163\n81\t82\t164\t164\n81\t82\t165\t165\n81\t82\t166\t166\n81\t82\t167\t167\n81\t82\t168\t168\n81\t82\t169\t169\n81\t82\t170\t170\n81\t82\t171\t171\n81\t82\t172\t172\n81\t82\t173\t173\n81\t82\t174\t174\n81\t82\t175\t175\n81\t82\t176\t176\n81\t82\t177\t177\n81\t82\t178\t178\n81\t82\t179\t179\n81\t82\t180\t180\n81\t82\t181\t181\n81\t82\t182\t182\n81\t82\t183\t183\n81\t82\t184\t184\n81\t82\t185\t185\n81\t82\t186\t186\n81\t82\t187\t187\n81\t82\t188\t188\n81\t82\t189\t189\n81\t82\t190\t190\n81\t82\t191\t191\n81\t82\t192\t192\n81\t82\t193\t193\n81\t82\t194\t194\n81\t82\t195\t195\n81\t82\t196\t196\n81\t82\t197\t197\n81\t82\t198\t198\n81\t82\t199\t199\n81\t82\t200\t200\n81\t82\t201\t201\n81\t82\t202\t202\n81\t82\t203\t203\n81\t82\t204\t204\n81\t82\t205\t205\n81\t82\t206\t206\n81\t82\t207\t207\n81\t82\t208\t208\n81\t82\t209\t209\n81\t82\t210\t210\n81\t82\t211\t211\n81\t82\t212\t212\n81\t82\t213\t213\n81\t82\t214\t214\n81\t82\t215\t215\n81\t82\t216\t216\n81\t82\t217\t217\n81\t82\t218\t218\n81\t82\t219\t219\n81\t82\t220\t220\n81\t82\t221\t221\n81\t82\t222\t222\n81\t82\t223\t223\n81\t82\t224\t224\n81\t82\t225\t225\n81\t82\t226\t226\n81\t82\t227\t227\n81\t82\t228\t228\n81\t82\t229\t229\n81\t82\t230\t230\n81\t82\t231\t231\n81\t82\t232\t232\n81\t82\t233\t233\n81\t82\t234\t234\n81\t82\t235\t235\n81\t82\t236\t236\n81\t82\t237\t237\n81\t82\t238\t238\n81\t82\t239\t239\n81\t82\t240\t240\n81\t82\t241\t241\n81\t82\t242\t242\n81\t82\t243\t243\n81\t82\t244\t244\n81\t82\t245\t245\n81\t82\t246\t246\n81\t82\t247\t247\n81\t82\t248\t248\n81\t82\t249\t249\n81\t82\t250\t250\n81\t82\t251\t251\n81\t82\t252\t252\n81\t82\t253\t253\n81\t82\t254\t254\n81\t82\t255\t255\n82\t83\t0\t0\n82\t83\t1\t1\n82\t83\t2\t2\n82\t83\t3\t3\n82\t83\t4\t4\n82\t83\t5\t5\n82\t83\t6\t6\n82\t83\t7\t7\n82\t83\t8\t8\n82\t83\t9\t9\n82\t83\t10\t10\n82\t83\t11\t11\n82\t83\t12\t12\n82\t83\t13\t13\n82\t83\t14\t14\n82\t83\t15\t15\n82\t83\t16\t16\n82\t83\t17\t17\n82\t83\t18\t18\n82\t83\t19\t19\n82\t83\t20\t20\n82\t83\t21\t21\n82\t83\t22\t22\n82\t83\t23\t23\n82\t83\t24\t24\n82\t83\t25\t25\n82\t83\t26\t26\n82\t83\t27\t27\n82\t83\t28\t28\n82\t83\t29\t29\n82\t83\t30\t30\n82\t83\t31\t31\n82\t83\t32\t32\n82\t83\t33\t33\n82\t83\t34\t34\n82\t83\t35\t35\n82\t83\t36\t36\n82\t83\t37\t37\n82\t83\t38\t38\n82\t83\t39\t39\n82\t83\t40\t40\n82\t83\t41\t41\n82\t83\t42\t42\n82\t83\t43\t43\n82\t83\t44\t44\n82\t83\t45\t45\n82\t83\t46\t46\n82\t83\t47\t47\n82\t83\t48\t48\n82\t83\t49\t49\n82\t83\t50\t50\n82\t83\t51\t51\n82\t83\t52\t52\n82\t83\t53\t53\n82\t83\t54\t54\n82\t83\t55\t55\n82\t83\t56\t56\n82\t83\t57\t57\n82\t83\t58\t58\n82\t83\t59\t59\n82\t83\t60\t60\n82\t83\t61\t61\n82\t83\t62\t62\n82\t83\t63\t63\n82\t83\t64\t64\n82\t83\t65\t65\n82\t83\t66\t66\n82\t83\t67\t67\n82\t83\t68\t68\n82\t83\t69\t69\n82\t83\t70\t70\n82\t83\t71\t71\n82\t83\t72\t72\n82\t83\t73\t73\n82\t83\t74\t74\n82\t83\t75\t75\n82\t83\t76\t76\n82\t83\t77\t77\n82\t83\t78\t78\n82\t83\t79\t79\n82\t83\t80\t80\n82\t83\t81\t81\n82\t83\t82\t82\n82\t83\t83\t83\n82\t83\t84\t84\n82\t83\t85\t85\n82\t83\t86\t86\n82\t83\t87\t87\n82\t83\t88\t88\n82\t83\t89\t89\n82\t83\t90\t90\n82\t83\t91\t91\n82\t83\t92\t92\n82\t83\t93\t93\n82\t83\t94\t94\n82\t83\t95\t95\n82\t83\t96\t96\n82\t83\t97\t97\n82\t83\t98\t98\n82\t83\t99\t99\n82\t83\t100\t100\n82\t83\t101\t101\n82\t83\t102\t102\n82\t83\t103\t103\n82\t83\t104\t104\n82\t83\t105\t105\n82\t83\t106\t106\n82\t83\t107\t107\n82\t83\t108\t108\n82\t83\t109\t109\n82\t83\t110\t110\n82\t83\t111\t111\n82\t83\t112\t112\n82\t83\t113\t113\n82\t83\t114\t114\n82\t83\t115\t115\n82\t83\t116\t116\n82\t83\t117\t117\n82\t83\t118\t118\n82\t83\t119\t119\n82\t83\t120\t120\n82\t83\t121\t121\n82\t83\t122\t122\n82\t83\t123\t123\n82\t83\t124\t124\n82\t83\t125\t125\n82\t83\t126\t126\n82\t83\t127\t127\n82\t83\t128\t128\n82\t83\t129\t129\n82\t83\t130\t130\n82\t83\t131\t131\n82\t83\t132\t132\n82\t83\t133\t133\n82\t83\t134\t134\n82\t83\t135\t135\n82\t83\t136\t136\n82\t83\t137\t137\n82\t83\t138\t138\n82\t83\t139\t139\n82\t83\t140\t140\n82\t83\t141\t141\n82\t83\t142\t142\n82\t83\t143\t143\n82\t83\t144\t144\n82\t83\t145\t145\n82\t83\t146\t146\n82\t83\t147\t147\n82\t83\t148\t148\n82\t83\t149\t149\n82\t83\t150\t150\n82\t83\t151\t151\n82\t83\t152\t152\n82\t83\t153\t153\n82\t83\t154\t154\n82\t83\t155\t155\n82\t83\t156\t156\n82\t83\t157\t157\n82\t83\t158\t158\n82\t83\t159\t159\n82\t83\t160\t160\n82\t83\t161\t161\n82\t83\t162\t162\n82\t83\t163\t163\n82\t83\t164\t164\n82\t83\t165\t165\n82\t83\t166\t166\n82\t83\t167\t167\n82\t83\t168\t168\n82\t83\t169\t169\n82\t83\t170\t170\n82\t83\t171\t171\n82\t83\t172\t172\n82\t83\t173\t173\n82\t83\t174\t174\n82\t83\t175\t175\n82\t83\t176\t176\n82\t83\t177\t177\n82\t83\t178\t178\n82\t83\t179\t179\n82\t83\t180\t180\n82\t83\t181\t181\n82\t83\t182\t182\n82\t83\t183\t183\n82\t83\t184\t184\n82\t83\t185\t185\n82\t83\t186\t186\n82\t83\t187\t187\n82\t83\t188\t188\n82\t83\t189\t189\n82\t83\t190\t190\n82\t83\t191\t191\n82\t83\t192\t192\n82\t83\t193\t193\n82\t83\t194\t194\n82\t83\t195\t195\n82\t83\t196\t196\n82\t83\t197\t197\n82\t83\t198\t198\n82\t83\t199\t199\n82\t83\t200\t200\n82\t83\t201\t201\n82\t83\t202\t202\n82\t83\t203\t203\n82\t83\t204\t204\n82\t83\t205\t205\n82\t83\t206\t206\n82\t83\t207\t207\n82\t83\t208\t208\n82\t83\t209\t209\n82\t83\t210\t210\n82\t83\t211\t211\n82\t83\t212\t212\n82\t83\t213\t213\n82\t83\t214\t214\n82\t83\t215\t215\n82\t83\t216\t216\n82\t83\t217\t217\n82\t83\t218\t218\n82\t83\t219\t219\n82\t83\t220\t220\n82\t83\t221\t221\n82\t83\t222\t222\n82\t83\t223\t223\n82\t83\t224\t224\n82\t83\t225\t225\n82\t83\t226\t226\n82\t83\t227\t227\n82\t83\t228\t228\n82\t83\t229\t229\n82\t83\t230\t230\n82\t83\t231\t231\n82\t83\t232\t232\n82\t83\t233\t233\n82\t83\t234\t234\n82\t83\t235\t235\n82\t83\t236\t236\n82\t83\t237\t237\n82\t83\t238\t238\n82\t83\t239\t239\n82\t83\t240\t240\n82\t83\t241\t241\n82\t83\t242\t242\n82\t83\t243\t243\n82\t83\t244\t244\n82\t83\t245\t245\n82\t83\t246\t246\n82\t83\t247\t247\n82\t83\t248\t248\n82\t83\t249\t249\n82\t83\t250\t250\n82\t83\t251\t251\n82\t83\t252\t252\n82\t83\t253\t253\n82\t83\t254\t254\n82\t83\t255\t255\n83\t84\t0\t0\n83\t84\t1\t1\n83\t84\t2\t2\n83\t84\t3\t3\n83\t84\t4\t4\n83\t84\t5\t5\n83\t84\t6\t6\n83\t84\t7\t7\n83\t84\t8\t8\n83\t84\t9\t9\n83\t84\t10\t10\n83\t84\t11\t11\n83\t84\t12\t12\n83\t84\t13\t13\n83\t84\t14\t14\n83\t84\t15\t15\n83\t84\t16\t16\n83\t84\t17\t17\n83\t84\t18\t18\n83\t84\t19\t19\n83\t84\t20\t20\n83\t84\t21\t21\n83\t84\t22\t22\n83\t84\t23\t23\n83\t84\t24\t24\n83\t84\t25\t25\n83\t84\t26\t26\n83\t84\t27\t27\n83\t84\t28\t28\n83\t84\t29\t29\n83\t84\t30\t30\n83\t84\t31\t31\n83\t84\t32\t32\n83\t84\t33\t33\n83\t84\t34\t34\n83\t84\t35\t35\n83\t84\t36\t36\n83\t84\t37\t37\n83\t84\t38\t38\n83\t84\t39\t39\n83\t84\t40\t40\n83\t84\t41\t41\n83\t84\t42\t42\n83\t84\t43\t43\n83\t84\t44\t44\n83\t84\t45\t45\n83\t84\t46\t46\n83\t84\t47\t47\n83\t84\t48\t48\n83\t84\t49\t49\n83\t84\t50\t50\n83\t84\t51\t51\n83\t84\t52\t52\n83\t84\t53\t53\n83\t84\t54\t54\n83\t84\t55\t55\n83\t84\t56\t56\n83\t84\t57\t57\n83\t84\t58\t58\n83\t84\t59\t59\n83\t84\t60\t60\n83\t84\t61\t61\n83\t84\t62\t62\n83\t84\t63\t63\n83\t84\t64\t64\n83\t84\t65\t65\n83\t84\t66\t66\n83\t84\t67\t67\n83\t84\t68\t68\n83\t84\t69\t69\n83\t84\t70\t70\n83\t84\t71\t71\n83\t84\t72\t72\n83\t84\t73\t73\n83\t84\t74\t74\n83\t84\t75\t75\n83\t84\t76\t76\n83\t84\t77\t77\n83\t84\t78\t78\n83\t84\t79\t79\n83\t84\t80\t80\n83\t84\t81\t81\n83\t84\t82\t82\n83\t84\t83\t83\n83\t84\t84\t84\n83\t84\t85\t85\n83\t84\t86\t86\n83\t84\t87\t87\n83\t84\t88\t88\n83\t84\t89\t89\n83\t84\t90\t90\n83\t84\t91\t91\n83\t84\t92\t92\n83\t84\t93\t93\n83\t84\t94\t94\n83\t84\t95\t95\n83\t84\t96\t96\n83\t84\t97\t97\n83\t84\t98\t98\n83\t84\t99\t99\n83\t84\t100\t100\n83\t84\t101\t101\n83\t84\t102\t102\n83\t84\t103\t103\n83\t84\t104\t104\n83\t84\t105\t105\n83\t84\t106\t106\n83\t84\t107\t107\n83\t84\t108\t108\n83\t84\t109\t109\n83\t84\t110\t110\n83\t84\t111\t111\n83\t84\t112\t112\n83\t84\t113\t113\n83\t84\t114\t114\n83\t84\t115\t115\n83\t84\t116\t116\n83\t84\t117\t117\n83\t84\t118\t118\n83\t84\t119\t119\n83\t84\t120\t120\n83\t84\t121\t121\n83\t84\t122\t122\n83\t84\t123\t123\n83\t84\t124\t124\n83\t84\t125\t125\n83\t84\t126\t126\n83\t84\t127\t127\n83\t84\t128\t128\n83\t84\t129\t129\n83\t84\t130\t130\n83\t84\t131\t131\n83\t84\t132\t132\n83\t84\t133\t133\n83\t84\t134\t134\n83\t84\t135\t135\n83\t84\t136\t136\n83\t84\t137\t137\n83\t84\t138\t138\n83\t84\t139\t139\n83\t84\t140\t140\n83\t84\t141\t141\n83\t84\t142\t142\n83\t84\t143\t143\n83\t84\t144\t144\n83\t84\t145\t145\n83\t84\t146\t146\n83\t84\t147\t147\n83\t84\t148\t148\n83\t84\t149\t149\n83\t84\t150\t150\n83\t84\t151\t151\n83\t84\t152\t152\n83\t84\t153\t153\n83\t84\t154\t154\n83\t84\t155\t155\n83\t84\t156\t156\n83\t84\t157\t157\n83\t84\t158\t158\n83\t84\t159\t159\n83\t84\t160\t160\n83\t84\t161\t161\n83\t84\t162\t162\n83\t84\t163\t163\n83\t84\t164\t164\n83\t84\t165\t165\n83\t84\t166\t166\n83\t84\t167\t167\n83\t84\t168\t168\n83\t84\t169\t169\n83\t84\t170\t170\n83\t84\t171\t171\n83\t84\t172\t172\n83\t84\t173\t173\n83\t84\t174\t174\n83\t84\t175\t175\n83\t84\t176\t176\n83\t84\t177\t177\n83\t84\t178\t178\n83\t84\t179\t179\n83\t84\t180\t180\n83\t84\t181\t181\n83\t84\t182\t182\n83\t84\t183\t183\n83\t84\t184\t184\n83\t84\t185\t185\n83\t84\t186\t186\n83\t84\t187\t187\n83\t84\t188\t188\n83\t84\t189\t189\n83\t84\t190\t190\n83\t84\t191\t191\n83\t84\t192\t192\n83\t84\t193\t193\n83\t84\t194\t194\n83\t84\t195\t195\n83\t84\t196\t196\n83\t84\t197\t197\n83\t84\t198\t198\n83\t84\t199\t199\n83\t84\t200\t200\n83\t84\t201\t201\n83\t84\t202\t202\n83\t84\t203\t203\n83\t84\t204\t204\n83\t84\t205\t205\n83\t84\t206\t206\n83\t84\t207\t207\n83\t84\t208\t208\n83\t84\t209\t209\n83\t84\t210\t210\n83\t84\t211\t211\n83\t84\t212\t212\n83\t84\t213\t213\n83\t84\t214\t214\n83\t84\t215\t215\n83\t84\t216\t216\n83\t84\t217\t217\n83\t84\t218\t218\n83\t84\t219\t219\n83\t84\t220\t220\n83\t84\t221\t221\n83\t84\t222\t222\n83\t84\t223\t223\n83\t84\t224\t224\n83\t84\t225\t225\n83\t84\t226\t226\n83\t84\t227\t227\n83\t84\t228\t228\n83\t84\t229\t229\n83\t84\t230\t230\n83\t84\t231\t231\n83\t84\t232\t232\n83\t84\t233\t233\n83\t84\t234\t234\n83\t84\t235\t235\n83\t84\t236\t236\n83\t84\t237\t237\n83\t84\t238\t238\n83\t84\t239\t239\n83\t84\t240\t240\n83\t84\t241\t241\n83\t84\t242\t242\n83\t84\t243\t243\n83\t84\t244\t244\n83\t84\t245\t245\n83\t84\t246\t246\n83\t84\t247\t247\n83\t84\t248\t248\n83\t84\t249\t249\n83\t84\t250\t250\n83\t84\t251\t251\n83\t84\t252\t252\n83\t84\t253\t253\n83\t84\t254\t254\n83\t84\t255\t255\n84\t85\t0\t0\n84\t85\t1\t1\n84\t85\t2\t2\n84\t85\t3\t3\n84\t85\t4\t4\n84\t85\t5\t5\n84\t85\t6\t6\n84\t85\t7\t7\n84\t85\t8\t8\n84\t85\t9\t9\n84\t85\t10\t10\n84\t85\t11\t11\n84\t85\t12\t12\n84\t85\t13\t13\n84\t85\t14\t14\n84\t85\t15\t15\n84\t85\t16\t16\n84\t85\t17\t17\n84\t85\t18\t18\n84\t85\t19\t19\n84\t85\t20\t20\n84\t85\t21\t21\n84\t85\t22\t22\n84\t85\t23\t23\n84\t85\t24\t24\n84\t85\t25\t25\n84\t85\t26\t26\n84\t85\t27\t27\n84\t85\t28\t28\n84\t85\t29\t29\n84\t85\t30\t30\n84\t85\t31\t31\n84\t85\t32\t32\n84\t85\t33\t33\n84\t85\t34\t34\n84\t85\t35\t35\n84\t85\t36\t36\n84\t85\t37\t37\n84\t85\t38\t38\n84\t85\t39\t39\n84\t85\t40\t40\n84\t85\t41\t41\n84\t85\t42\t42\n84\t85\t43\t43\n84\t85\t44\t44\n84\t85\t45\t45\n84\t85\t46\t46\n84\t85\t47\t47\n84\t85\t48\t48\n84\t85\t49\t49\n84\t85\t50\t50\n84\t85\t51\t51\n84\t85\t52\t52\n84\t85\t53\t53\n84\t85\t54\t54\n84\t85\t55\t55\n84\t85\t56\t56\n84\t85\t57\t57\n84\t85\t58\t58\n84\t85\t59\t59\n84\t85\t60\t60\n84\t85\t61\t61\n84\t85\t62\t62\n84\t85\t63\t63\n84\t85\t64\t64\n84\t85\t65\t65\n84\t85\t66\t66\n84\t85\t67\t67\n84\t85\t68\t68\n84\t85\t69\t69\n84\t85\t70\t70\n84\t85\t71\t71\n84\t85\t72\t72\n84\t85\t73\t73\n84\t85\t74\t74\n84\t85\t75\t75\n84\t85\t76\t76\n84\t85\t77\t77\n84\t85\t78\t78\n84\t85\t79\t79\n84\t85\t80\t80\n84\t85\t81\t81\n84\t85\t82\t82\n84\t85\t83\t83\n84\t85\t84\t84\n84\t85\t85\t85\n84\t85\t86\t86\n84\t85\t87\t87\n84\t85\t88\t88\n84\t85\t89\t89\n84\t85\t90\t90\n84\t85\t91\t91\n84\t85\t92\t92\n84\t85\t93\t93\n84\t85\t94\t94\n84\t85\t95\t95\n84\t85\t96\t96\n84\t85\t97\t97\n84\t85\t98\t98\n84\t85\t99\t99\n84\t85\t100\t100\n84\t85\t101\t101\n84\t85\t102\t102\n84\t85\t103\t103\n84\t85\t104\t104\n84\t85\t105\t105\n84\t85\t106\t106\n84\t85\t107\t107\n84\t85\t108\t108\n84\t85\t109\t109\n84\t85\t110\t110\n84\t85\t111\t111\n84\t85\t112\t112\n84\t85\t113\t113\n84\t85\t114\t114\n84\t85\t115\t115\n84\t85\t116\t116\n84\t85\t117\t117\n84\t85\t118\t118\n84\t85\t119\t119\n84\t85\t120\t120\n84\t85\t121\t121\n84\t85\t122\t122\n84\t85\t123\t123\n84\t85\t124\t124\n84\t85\t125\t125\n84\t85\t126\t126\n84\t85\t127\t127\n84\t85\t128\t128\n84\t85\t129\t129\n84\t85\t130\t130\n84\t85\t131\t131\n84\t85\t132\t132\n84\t85\t133\t133\n84\t85\t134\t134\n84\t85\t135\t135\n84\t85\t136\t136\n84\t85\t137\t137\n84\t85\t138\t138\n84\t85\t139\t139\n84\t85\t140\t140\n84\t85\t141\t141\n84\t85\t142\t142\n84\t85\t143\t143\n84\t85\t144\t144\n84\t85\t145\t145\n84\t85\t146\t146\n84\t85\t147\t147\n84\t85\t148\t148\n84\t85\t149\t149\n84\t85\t150\t150\n84\t85\t151\t151\n84\t85\t152\t152\n84\t85\t153\t153\n84\t85\t154\t154\n84\t85\t155\t155\n84\t85\t156\t156\n84\t85\t157\t157\n84\t85\t158\t158\n84\t85\t159\t159\n84\t85\t160\t160\n84\t85\t161\t161\n84\t85\t162\t162\n84\t85\t163\t163\n84\t85\t164\t164\n84\t85\t165\t165\n84\t85\t166\t166\n84\t85\t167\t167\n84\t85\t168\t168\n84\t85\t169\t169\n84\t85\t170\t170\n84\t85\t171\t171\n84\t85\t172\t172\n84\t85\t173\t173\n84\t85\t174\t174\n84\t85\t175\t175\n84\t85\t176\t176\n84\t85\t177\t177\n84\t85\t178\t178\n84\t85\t179\t179\n84\t85\t180\t180\n84\t85\t181\t181\n84\t85\t182\t182\n84\t85\t183\t183\n84\t85\t184\t184\n84\t85\t185\t185\n84\t85\t186\t186\n84\t85\t187\t187\n84\t85\t188\t188\n84\t85\t189\t189\n84\t85\t190\t190\n84\t85\t191\t191\n84\t85\t192\t192\n84\t85\t193\t193\n84\t85\t194\t194\n84\t85\t195\t195\n84\t85\t196\t196\n84\t85\t197\t197\n84\t85\t198\t198\n84\t85\t199\t199\n84\t85\t200\t200\n84\t85\t201\t201\n84\t85\t202\t202\n84\t85\t203\t203\n84\t85\t204\t204\n84\t85\t205\t205\n84\t85\t206\t206\n84\t85\t207\t207\n84\t85\t208\t208\n84\t85\t209\t209\n84\t85\t210\t210\n84\t85\t211\t211\n84\t85\t212\t212\n84\t85\t213\t213\n84\t85\t214\t214\n84\t85\t215\t215\n84\t85\t216\t216\n84\t85\t217\t217\n84\t85\t218\t218\n84\t85\t219\t219\n84\t85\t220\t220\n84\t85\t221\t221\n84\t85\t222\t222\n84\t85\t223\t223\n84\t85\t224\t224\n84\t85\t225\t225\n84\t85\t226\t226\n84\t85\t227\t227\n84\t85\t228\t228\n84\t85\t229\t229\n84\t85\t230\t230\n84\t85\t231\t231\n84\t85\t232\t232\n84\t85\t233\t233\n84\t85\t234\t234\n84\t85\t235\t235\n84\t85\t236\t236\n84\t85\t237\t237\n84\t85\t238\t238\n84\t85\t239\t239\n84\t85\t240\t240\n84\t85\t241\t241\n84\t85\t242\t242\n84\t85\t243\t243\n84\t85\t244\t244\n84\t85\t245\t245\n84\t85\t246\t246\n84\t85\t247\t247\n84\t85\t248\t248\n84\t85\t249\t249\n84\t85\t250\t250\n84\t85\t251\t251\n84\t85\t252\t252\n84\t85\t253\t253\n84\t85\t254\t254\n84\t85\t255\t255\n85\t86\t0\t0\n85\t86\t1\t1\n85\t86\t2\t2\n85\t86\t3\t3\n85\t86\t4\t4\n85\t86\t5\t5\n85\t86\t6\t6\n85\t86\t7\t7\n85\t86\t8\t8\n85\t86\t9\t9\n85\t86\t10\t10\n85\t86\t11\t11\n85\t86\t12\t12\n85\t86\t13\t13\n85\t86\t14\t14\n85\t86\t15\t15\n85\t86\t16\t16\n85\t86\t17\t17\n85\t86\t18\t18\n85\t86\t19\t19\n85\t86\t20\t20\n85\t86\t21\t21\n85\t86\t22\t22\n85\t86\t23\t23\n85\t86\t24\t24\n85\t86\t25\t25\n85\t86\t26\t26\n85\t86\t27\t27\n85\t86\t28\t28\n85\t86\t29\t29\n85\t86\t30\t30\n85\t86\t31\t31\n85\t86\t32\t32\n85\t86\t33\t33\n85\t86\t34\t34\n85\t86\t35\t35\n85\t86\t36\t36\n85\t86\t37\t37\n85\t86\t38\t38\n85\t86\t39\t39\n85\t86\t40\t40\n85\t86\t41\t41\n85\t86\t42\t42\n85\t86\t43\t43\n85\t86\t44\t44\n85\t86\t45\t45\n85\t86\t46\t46\n85\t86\t47\t47\n85\t86\t48\t48\n85\t86\t49\t49\n85\t86\t50\t50\n85\t86\t51\t51\n85\t86\t52\t52\n85\t86\t53\t53\n85\t86\t54\t54\n85\t86\t55\t55\n85\t86\t56\t56\n85\t86\t57\t57\n85\t86\t58\t58\n85\t86\t59\t59\n85\t86\t60\t60\n85\t86\t61\t61\n85\t86\t62\t62\n85\t86\t63\t63\n85\t86\t64\t64\n85\t86\t65\t65\n85\t86\t66\t66\n85\t86\t67\t67\n85\t86\t68\t68\n85\t86\t69\t69\n85\t86\t70\t70\n85\t86\t71\t71\n85\t86\t72\t72\n85\t86\t73\t73\n85\t86\t74\t74\n85\t86\t75\t75\n85\t86\t76\t76\n85\t86\t77\t77\n85\t86\t78\t78\n85\t86\t79\t79\n85\t86\t80\t80\n85\t86\t81\t81\n85\t86\t82\t82\n85\t86\t83\t83\n85\t86\t84\t84\n85\t86\t85\t85\n85\t86\t86\t86\n85\t86\t87\t87\n85\t86\t88\t88\n85\t86\t89\t89\n85\t86\t90\t90\n85\t86\t91\t91\n85\t86\t92\t92\n85\t86\t93\t93\n85\t86\t94\t94\n85\t86\t95\t95\n85\t86\t96\t96\n85\t86\t97\t97\n85\t86\t98\t98\n85\t86\t99\t99\n85\t86\t100\t100\n85\t86\t101\t101\n85\t86\t102\t102\n85\t86\t103\t103\n85\t86\t104\t104\n85\t86\t105\t105\n85\t86\t106\t106\n85\t86\t107\t107\n85\t86\t108\t108\n85\t86\t109\t109\n85\t86\t110\t110\n85\t86\t111\t111\n85\t86\t112\t112\n85\t86\t113\t113\n85\t86\t114\t114\n85\t86\t115\t115\n85\t86\t116\t116\n85\t86\t117\t117\n85\t86\t118\t118\n85\t86\t119\t119\n85\t86\t120\t120\n85\t86\t121\t121\n85\t86\t122\t122\n85\t86\t123\t123\n85\t86\t124\t124\n85\t86\t125\t125\n85\t86\t126\t126\n85\t86\t127\t127\n85\t86\t128\t128\n85\t86\t129\t129\n85\t86\t130\t130\n85\t86\t131\t131\n85\t86\t132\t132\n85\t86\t133\t133\n85\t86\t134\t134\n85\t86\t135\t135\n85\t86\t136\t136\n85\t86\t137\t137\n85\t86\t138\t138\n85\t86\t139\t139\n85\t86\t140\t140\n85\t86\t141\t141\n85\t86\t142\t142\n85\t86\t143\t143\n85\t86\t144\t144\n85\t86\t145\t145\n85\t86\t146\t146\n85\t86\t147\t147\n85\t86\t148\t148\n85\t86\t149\t149\n85\t86\t150\t150\n85\t86\t151\t151\n85\t86\t152\t152\n85\t86\t153\t153\n85\t86\t154\t154\n85\t86\t155\t155\n85\t86\t156\t156\n85\t86\t157\t157\n85\t86\t158\t158\n85\t86\t159\t159\n85\t86\t160\t160\n85\t86\t161\t161\n85\t86\t162\t162\n85\t86\t163\t163\n85\t86\t164\t164\n85\t86\t165\t165\n85\t86\t166\t166\n85\t86\t167\t167\n85\t86\t168\t168\n85\t86\t169\t169\n85\t86\t170\t170\n85\t86\t171\t171\n85\t86\t172\t172\n85\t86\t173\t173\n85\t86\t174\t174\n85\t86\t175\t175\n85\t86\t176\t176\n85\t86\t177\t177\n85\t86\t178\t178\n85\t86\t179\t179\n85\t86\t180\t180\n85\t86\t181\t181\n85\t86\t182\t182\n85\t86\t183\t183\n85\t86\t184\t184\n85\t86\t185\t185\n85\t86\t186\t186\n85\t86\t187\t187\n85\t86\t188\t188\n85\t86\t189\t189\n85\t86\t190\t190\n85\t86\t191\t191\n85\t86\t192\t192\n85\t86\t193\t193\n85\t86\t194\t194\n85\t86\t195\t195\n85\t86\t196\t196\n85\t86\t197\t197\n85\t86\t198\t198\n85\t86\t199\t199\n85\t86\t200\t200\n85\t86\t201\t201\n85\t86\t202\t202\n85\t86\t203\t203\n85\t86\t204\t204\n85\t86\t205\t205\n85\t86\t206\t206\n85\t86\t207\t207\n85\t86\t208\t208\n85\t86\t209\t209\n85\t86\t210\t210\n85\t86\t211\t211\n85\t86\t212\t212\n85\t86\t213\t213\n85\t86\t214\t214\n85\t86\t215\t215\n85\t86\t216\t216\n85\t86\t217\t217\n85\t86\t218\t218\n85\t86\t219\t219\n85\t86\t220\t220\n85\t86\t221\t221\n85\t86\t222\t222\n85\t86\t223\t223\n85\t86\t224\t224\n85\t86\t225\t225\n85\t86\t226\t226\n85\t86\t227\t227\n85\t86\t228\t228\n85\t86\t229\t229\n85\t86\t230\t230\n85\t86\t231\t231\n85\t86\t232\t232\n85\t86\t233\t233\n85\t86\t234\t234\n85\t86\t235\t235\n85\t86\t236\t236\n85\t86\t237\t237\n85\t86\t238\t238\n85\t86\t239\t239\n85\t86\t240\t240\n85\t86\t241\t241\n85\t86\t242\t242\n85\t86\t243\t243\n85\t86\t244\t244\n85\t86\t245\t245\n85\t86\t246\t246\n85\t86\t247\t247\n85\t86\t248\t248\n85\t86\t249\t249\n85\t86\t250\t250\n85\t86\t251\t251\n85\t86\t252\t252\n85\t86\t253\t253\n85\t86\t254\t254\n85\t86\t255\t255\n86\t87\t0\t0\n86\t87\t1\t1\n86\t87\t2\t2\n86\t87\t3\t3\n86\t87\t4\t4\n86\t87\t5\t5\n86\t87\t6\t6\n86\t87\t7\t7\n86\t87\t8\t8\n86\t87\t9\t9\n86\t87\t10\t10\n86\t87\t11\t11\n86\t87\t12\t12\n86\t87\t13\t13\n86\t87\t14\t14\n86\t87\t15\t15\n86\t87\t16\t16\n86\t87\t17\t17\n86\t87\t18\t18\n86\t87\t19\t19\n86\t87\t20\t20\n86\t87\t21\t21\n86\t87\t22\t22\n86\t87\t23\t23\n86\t87\t24\t24\n86\t87\t25\t25\n86\t87\t26\t26\n86\t87\t27\t27\n86\t87\t28\t28\n86\t87\t29\t29\n86\t87\t30\t30\n86\t87\t31\t31\n86\t87\t32\t32\n86\t87\t33\t33\n86\t87\t34\t34\n86\t87\t35\t35\n86\t87\t36\t36\n86\t87\t37\t37\n86\t87\t38\t38\n86\t87\t39\t39\n86\t87\t40\t40\n86\t87\t41\t41\n86\t87\t42\t42\n86\t87\t43\t43\n86\t87\t44\t44\n86\t87\t45\t45\n86\t87\t46\t46\n86\t87\t47\t47\n86\t87\t48\t48\n86\t87\t49\t49\n86\t87\t50\t50\n86\t87\t51\t51\n86\t87\t52\t52\n86\t87\t53\t53\n86\t87\t54\t54\n86\t87\t55\t55\n86\t87\t56\t56\n86\t87\t57\t57\n86\t87\t58\t58\n86\t87\t59\t59\n86\t87\t60\t60\n86\t87\t61\t61\n86\t87\t62\t62\n86\t87\t63\t63\n86\t87\t64\t64\n86\t87\t65\t65\n86\t87\t66\t66\n86\t87\t67\t67\n86\t87\t68\t68\n86\t87\t69\t69\n86\t87\t70\t70\n86\t87\t71\t71\n86\t87\t72\t72\n86\t87\t73\t73\n86\t87\t74\t74\n86\t87\t75\t75\n86\t87\t76\t76\n86\t87\t77\t77\n86\t87\t78\t78\n86\t87\t79\t79\n86\t87\t80\t80\n86\t87\t81\t81\n86\t87\t82\t82\n86\t87\t83\t83\n86\t87\t84\t84\n86\t87\t85\t85\n86\t87\t86\t86\n86\t87\t87\t87\n86\t87\t88\t88\n86\t87\t89\t89\n86\t87\t90\t90\n86\t87\t91\t91\n86\t87\t92\t92\n86\t87\t93\t93\n86\t87\t94\t94\n86\t87\t95\t95\n86\t87\t96\t96\n86\t87\t97\t97\n86\t87\t98\t98\n86\t87\t99\t99\n86\t87\t100\t100\n86\t87\t101\t101\n86\t87\t102\t102\n86\t87\t103\t103\n86\t87\t104\t104\n86\t87\t105\t105\n86\t87\t106\t106\n86\t87\t107\t107\n86\t87\t108\t108\n86\t87\t109\t109\n86\t87\t110\t110\n86\t87\t111\t111\n86\t87\t112\t112\n86\t87\t113\t113\n86\t87\t114\t114\n86\t87\t115\t115\n86\t87\t116\t116\n86\t87\t117\t117\n86\t87\t118\t118\n86\t87\t119\t119\n86\t87\t120\t120\n86\t87\t121\t121\n86\t87\t122\t122\n86\t87\t123\t123\n86\t87\t124\t124\n86\t87\t125\t125\n86\t87\t126\t126\n86\t87\t127\t127\n86\t87\t128\t128\n86\t87\t129\t129\n86\t87\t130\t130\n86\t87\t131\t131\n86\t87\t132\t132\n86\t87\t133\t133\n86\t87\t134\t134\n86\t87\t135\t135\n86\t87\t136\t136\n86\t87\t137\t137\n86\t87\t138\t138\n86\t87\t139\t139\n86\t87\t140\t140\n86\t87\t141\t141\n86\t87\t142\t142\n86\t87\t143\t143\n86\t87\t144\t144\n86\t87\t145\t145\n86\t87\t146\t146\n86\t87\t147\t147\n86\t87\t148\t148\n86\t87\t149\t149\n86\t87\t150\t150\n86\t87\t151\t151\n86\t87\t152\t152\n86\t87\t153\t153\n86\t87\t154\t154\n86\t87\t155\t155\n86\t87\t156\t156\n86\t87\t157\t157\n86\t87\t158\t158\n86\t87\t159\t159\n86\t87\t160\t160\n86\t87\t161\t161\n86\t87\t162\t162\n86\t87\t163\t163\n86\t87\t164\t164\n86\t87\t165\t165\n86\t87\t166\t166\n86\t87\t167\t167\n86\t87\t168\t168\n86\t87\t169\t169\n86\t87\t170\t170\n86\t87\t171\t171\n86\t87\t172\t172\n86\t87\t173\t173\n86\t87\t174\t174\n86\t87\t175\t175\n86\t87\t176\t176\n86\t87\t177\t177\n86\t87\t178\t178\n86\t87\t179\t179\n86\t87\t180\t180\n86\t87\t181\t181\n86\t87\t182\t182\n86\t87\t183\t183\n86\t87\t184\t184\n86\t87\t185\t185\n86\t87\t186\t186\n86\t87\t187\t187\n86\t87\t188\t188\n86\t87\t189\t189\n86\t87\t190\t190\n86\t87\t191\t191\n86\t87\t192\t192\n86\t87\t193\t193\n86\t87\t194\t194\n86\t87\t195\t195\n86\t87\t196\t196\n86\t87\t197\t197\n86\t87\t198\t198\n86\t87\t199\t199\n86\t87\t200\t200\n86\t87\t201\t201\n86\t87\t202\t202\n86\t87\t203\t203\n86\t87\t204\t204\n86\t87\t205\t205\n86\t87\t206\t206\n86\t87\t207\t207\n86\t87\t208\t208\n86\t87\t209\t209\n86\t87\t210\t210\n86\t87\t211\t211\n86\t87\t212\t212\n86\t87\t213\t213\n86\t87\t214\t214\n86\t87\t215\t215\n86\t87\t216\t216\n86\t87\t217\t217\n86\t87\t218\t218\n86\t87\t219\t219\n86\t87\t220\t220\n86\t87\t221\t221\n86\t87\t222\t222\n86\t87\t223\t223\n86\t87\t224\t224\n86\t87\t225\t225\n86\t87\t226\t226\n86\t87\t227\t227\n86\t87\t228\t228\n86\t87\t229\t229\n86\t87\t230\t230\n86\t87\t231\t231\n86\t87\t232\t232\n86\t87\t233\t233\n86\t87\t234\t234\n86\t87\t235\t235\n86\t87\t236\t236\n86\t87\t237\t237\n86\t87\t238\t238\n86\t87\t239\t239\n86\t87\t240\t240\n86\t87\t241\t241\n86\t87\t242\t242\n86\t87\t243\t243\n86\t87\t244\t244\n86\t87\t245\t245\n86\t87\t246\t246\n86\t87\t247\t247\n86\t87\t248\t248\n86\t87\t249\t249\n86\t87\t250\t250\n86\t87\t251\t251\n86\t87\t252\t252\n86\t87\t253\t253\n86\t87\t254\t254\n86\t87\t255\t255\n87\t88\t0\t0\n87\t88\t1\t1\n87\t88\t2\t2\n87\t88\t3\t3\n87\t88\t4\t4\n87\t88\t5\t5\n87\t88\t6\t6\n87\t88\t7\t7\n87\t88\t8\t8\n87\t88\t9\t9\n87\t88\t10\t10\n87\t88\t11\t11\n87\t88\t12\t12\n87\t88\t13\t13\n87\t88\t14\t14\n87\t88\t15\t15\n87\t88\t16\t16\n87\t88\t17\t17\n87\t88\t18\t18\n87\t88\t19\t19\n87\t88\t20\t20\n87\t88\t21\t21\n87\t88\t22\t22\n87\t88\t23\t23\n87\t88\t24\t24\n87\t88\t25\t25\n87\t88\t26\t26\n87\t88\t27\t27\n87\t88\t28\t28\n87\t88\t29\t29\n87\t88\t30\t30\n87\t88\t31\t31\n87\t88\t32\t32\n87\t88\t33\t33\n87\t88\t34\t34\n87\t88\t35\t35\n87\t88\t36\t36\n87\t88\t37\t37\n87\t88\t38\t38\n87\t88\t39\t39\n87\t88\t40\t40\n87\t88\t41\t41\n87\t88\t42\t42\n87\t88\t43\t43\n87\t88\t44\t44\n87\t88\t45\t45\n87\t88\t46\t46\n87\t88\t47\t47\n87\t88\t48\t48\n87\t88\t49\t49\n87\t88\t50\t50\n87\t88\t51\t51\n87\t88\t52\t52\n87\t88\t53\t53\n87\t88\t54\t54\n87\t88\t55\t55\n87\t88\t56\t56\n87\t88\t57\t57\n87\t88\t58\t58\n87\t88\t59\t59\n87\t88\t60\t60\n87\t88\t61\t61\n87\t88\t62\t62\n87\t88\t63\t63\n87\t88\t64\t64\n87\t88\t65\t65\n87\t88\t66\t66\n87\t88\t67\t67\n87\t88\t68\t68\n87\t88\t69\t69\n87\t88\t70\t70\n87\t88\t71\t71\n87\t88\t72\t72\n87\t88\t73\t73\n87\t88\t74\t74\n87\t88\t75\t75\n87\t88\t76\t76\n87\t88\t77\t77\n87\t88\t78\t78\n87\t88\t79\t79\n87\t88\t80\t80\n87\t88\t81\t81\n87\t88\t82\t82\n87\t88\t83\t83\n87\t88\t84\t84\n87\t88\t85\t85\n87\t88\t86\t86\n87\t88\t87\t87\n87\t88\t88\t88\n87\t88\t89\t89\n87\t88\t90\t90\n87\t88\t91\t91\n87\t88\t92\t92\n87\t88\t93\t93\n87\t88\t94\t94\n87\t88\t95\t95\n87\t88\t96\t96\n87\t88\t97\t97\n87\t88\t98\t98\n87\t88\t99\t99\n87\t88\t100\t100\n87\t88\t101\t101\n87\t88\t102\t102\n87\t88\t103\t103\n87\t88\t104\t104\n87\t88\t105\t105\n87\t88\t106\t106\n87\t88\t107\t107\n87\t88\t108\t108\n87\t88\t109\t109\n87\t88\t110\t110\n87\t88\t111\t111\n87\t88\t112\t112\n87\t88\t113\t113\n87\t88\t114\t114\n87\t88\t115\t115\n87\t88\t116\t116\n87\t88\t117\t117\n87\t88\t118\t118\n87\t88\t119\t119\n87\t88\t120\t120\n87\t88\t121\t121\n87\t88\t122\t122\n87\t88\t123\t123\n87\t88\t124\t124\n87\t88\t125\t125\n87\t88\t126\t126\n87\t88\t127\t127\n87\t88\t128\t128\n87\t88\t129\t129\n87\t88\t130\t130\n87\t88\t131\t131\n87\t88\t132\t132\n87\t88\t133\t133\n87\t88\t134\t134\n87\t88\t135\t135\n87\t88\t136\t136\n87\t88\t137\t137\n87\t88\t138\t138\n87\t88\t139\t139\n87\t88\t140\t140\n87\t88\t141\t141\n87\t88\t142\t142\n87\t88\t143\t143\n87\t88\t144\t144\n87\t88\t145\t145\n87\t88\t146\t146\n87\t88\t147\t147\n87\t88\t148\t148\n87\t88\t149\t149\n87\t88\t150\t150\n87\t88\t151\t151\n87\t88\t152\t152\n87\t88\t153\t153\n87\t88\t154\t154\n87\t88\t155\t155\n87\t88\t156\t156\n87\t88\t157\t157\n87\t88\t158\t158\n87\t88\t159\t159\n87\t88\t160\t160\n87\t88\t161\t161\n87\t88\t162\t162\n87\t88\t163\t163\n87\t88\t164\t164\n87\t88\t165\t165\n87\t88\t166\t166\n87\t88\t167\t167\n87\t88\t168\t168\n87\t88\t169\t169\n87\t88\t170\t170\n87\t88\t171\t171\n87\t88\t172\t172\n87\t88\t173\t173\n87\t88\t174\t174\n87\t88\t175\t175\n87\t88\t176\t176\n87\t88\t177\t177\n87\t88\t178\t178\n87\t88\t179\t179\n87\t88\t180\t180\n87\t88\t181\t181\n87\t88\t182\t182\n87\t88\t183\t183\n87\t88\t184\t184\n87\t88\t185\t185\n87\t88\t186\t186\n87\t88\t187\t187\n87\t88\t188\t188\n87\t88\t189\t189\n87\t88\t190\t190\n87\t88\t191\t191\n87\t88\t192\t192\n87\t88\t193\t193\n87\t88\t194\t194\n87\t88\t195\t195\n87\t88\t196\t196\n87\t88\t197\t197\n87\t88\t198\t198\n87\t88\t199\t199\n87\t88\t200\t200\n87\t88\t201\t201\n87\t88\t202\t202\n87\t88\t203\t203\n87\t88\t204\t204\n87\t88\t205\t205\n87\t88\t206\t206\n87\t88\t207\t207\n87\t88\t208\t208\n87\t88\t209\t209\n87\t88\t210\t210\n87\t88\t211\t211\n87\t88\t212\t212\n87\t88\t213\t213\n87\t88\t214\t214\n87\t88\t215\t215\n87\t88\t216\t216\n87\t88\t217\t217\n87\t88\t218\t218\n87\t88\t219\t219\n87\t88\t220\t220\n87\t88\t221\t221\n87\t88\t222\t222\n87\t88\t223\t223\n87\t88\t224\t224\n87\t88\t225\t225\n87\t88\t226\t226\n87\t88\t227\t227\n87\t88\t228\t228\n87\t88\t229\t229\n87\t88\t230\t230\n87\t88\t231\t231\n87\t88\t232\t232\n87\t88\t233\t233\n87\t88\t234\t234\n87\t88\t235\t235\n87\t88\t236\t236\n87\t88\t237\t237\n87\t88\t238\t238\n87\t88\t239\t239\n87\t88\t240\t240\n87\t88\t241\t241\n87\t88\t242\t242\n87\t88\t243\t243\n87\t88\t244\t244\n87\t88\t245\t245\n87\t88\t246\t246\n87\t88\t247\t247\n87\t88\t248\t248\n87\t88\t249\t249\n87\t88\t250\t250\n87\t88\t251\t251\n87\t88\t252\t252\n87\t88\t253\t253\n87\t88\t254\t254\n87\t88\t255\t255\n88\t89\t0\t0\n88\t89\t1\t1\n88\t89\t2\t2\n88\t89\t3\t3\n88\t89\t4\t4\n88\t89\t5\t5\n88\t89\t6\t6\n88\t89\t7\t7\n88\t89\t8\t8\n88\t89\t9\t9\n88\t89\t10\t10\n88\t89\t11\t11\n88\t89\t12\t12\n88\t89\t13\t13\n88\t89\t14\t14\n88\t89\t15\t15\n88\t89\t16\t16\n88\t89\t17\t17\n88\t89\t18\t18\n88\t89\t19\t19\n88\t89\t20\t20\n88\t89\t21\t21\n88\t89\t22\t22\n88\t89\t23\t23\n88\t89\t24\t24\n88\t89\t25\t25\n88\t89\t26\t26\n88\t89\t27\t27\n88\t89\t28\t28\n88\t89\t29\t29\n88\t89\t30\t30\n88\t89\t31\t31\n88\t89\t32\t32\n88\t89\t33\t33\n88\t89\t34\t34\n88\t89\t35\t35\n88\t89\t36\t36\n88\t89\t37\t37\n88\t89\t38\t38\n88\t89\t39\t39\n88\t89\t40\t40\n88\t89\t41\t41\n88\t89\t42\t42\n88\t89\t43\t43\n88\t89\t44\t44\n88\t89\t45\t45\n88\t89\t46\t46\n88\t89\t47\t47\n88\t89\t48\t48\n88\t89\t49\t49\n88\t89\t50\t50\n88\t89\t51\t51\n88\t89\t52\t52\n88\t89\t53\t53\n88\t89\t54\t54\n88\t89\t55\t55\n88\t89\t56\t56\n88\t89\t57\t57\n88\t89\t58\t58\n88\t89\t59\t59\n88\t89\t60\t60\n88\t89\t61\t61\n88\t89\t62\t62\n88\t89\t63\t63\n88\t89\t64\t64\n88\t89\t65\t65\n88\t89\t66\t66\n88\t89\t67\t67\n88\t89\t68\t68\n88\t89\t69\t69\n88\t89\t70\t70\n88\t89\t71\t71\n88\t89\t72\t72\n88\t89\t73\t73\n88\t89\t74\t74\n88\t89\t75\t75\n88\t89\t76\t76\n88\t89\t77\t77\n88\t89\t78\t78\n88\t89\t79\t79\n88\t89\t80\t80\n88\t89\t81\t81\n88\t89\t82\t82\n88\t89\t83\t83\n88\t89\t84\t84\n88\t89\t85\t85\n88\t89\t86\t86\n88\t89\t87\t87\n88\t89\t88\t88\n88\t89\t89\t89\n88\t89\t90\t90\n88\t89\t91\t91\n88\t89\t92\t92\n88\t89\t93\t93\n88\t89\t94\t94\n88\t89\t95\t95\n88\t89\t96\t96\n88\t89\t97\t97\n88\t89\t98\t98\n88\t89\t99\t99\n88\t89\t100\t100\n88\t89\t101\t101\n88\t89\t102\t102\n88\t89\t103\t103\n88\t89\t104\t104\n88\t89\t105\t105\n88\t89\t106\t106\n88\t89\t107\t107\n88\t89\t108\t108\n88\t89\t109\t109\n88\t89\t110\t110\n88\t89\t111\t111\n88\t89\t112\t112\n88\t89\t113\t113\n88\t89\t114\t114\n88\t89\t115\t115\n88\t89\t116\t116\n88\t89\t117\t117\n88\t89\t118\t118\n88\t89\t119\t119\n88\t89\t120\t120\n88\t89\t121\t121\n88\t89\t122\t122\n88\t89\t123\t123\n88\t89\t124\t124\n88\t89\t125\t125\n88\t89\t126\t126\n88\t89\t127\t127\n88\t89\t128\t128\n88\t89\t129\t129\n88\t89\t130\t130\n88\t89\t131\t131\n88\t89\t132\t132\n88\t89\t133\t133\n88\t89\t134\t134\n88\t89\t135\t135\n88\t89\t136\t136\n88\t89\t137\t137\n88\t89\t138\t138\n88\t89\t139\t139\n88\t89\t140\t140\n88\t89\t141\t141\n88\t89\t142\t142\n88\t89\t143\t143\n88\t89\t144\t144\n88\t89\t145\t145\n88\t89\t146\t146\n88\t89\t147\t147\n88\t89\t148\t148\n88\t89\t149\t149\n88\t89\t150\t150\n88\t89\t151\t151\n88\t89\t152\t152\n88\t89\t153\t153\n88\t89\t154\t154\n88\t89\t155\t155\n88\t89\t156\t156\n88\t89\t157\t157\n88\t89\t158\t158\n88\t89\t159\t159\n88\t89\t160\t160\n88\t89\t161\t161\n88\t89\t162\t162\n88\t89\t163\t163\n88\t89\t164\t164\n88\t89\t165\t165\n88\t89\t166\t166\n88\t89\t167\t167\n88\t89\t168\t168\n88\t89\t169\t169\n88\t89\t170\t170\n88\t89\t171\t171\n88\t89\t172\t172\n88\t89\t173\t173\n88\t89\t174\t174\n88\t89\t175\t175\n88\t89\t176\t176\n88\t89\t177\t177\n88\t89\t178\t178\n88\t89\t179\t179\n88\t89\t180\t180\n88\t89\t181\t181\n88\t89\t182\t182\n88\t89\t183\t183\n88\t89\t184\t184\n88\t89\t185\t185\n88\t89\t186\t186\n88\t89\t187\t187\n88\t89\t188\t188\n88\t89\t189\t189\n88\t89\t190\t190\n88\t89\t191\t191\n88\t89\t192\t192\n88\t89\t193\t193\n88\t89\t194\t194\n88\t89\t195\t195\n88\t89\t196\t196\n88\t89\t197\t197\n88\t89\t198\t198\n88\t89\t199\t199\n88\t89\t200\t200\n88\t89\t201\t201\n88\t89\t202\t202\n88\t89\t203\t203\n88\t89\t204\t204\n88\t89\t205\t205\n88\t89\t206\t206\n88\t89\t207\t207\n88\t89\t208\t208\n88\t89\t209\t209\n88\t89\t210\t210\n88\t89\t211\t211\n88\t89\t212\t212\n88\t89\t213\t213\n88\t89\t214\t214\n88\t89\t215\t215\n88\t89\t216\t216\n88\t89\t217\t217\n88\t89\t218\t218\n88\t89\t219\t219\n88\t89\t220\t220\n88\t89\t221\t221\n88\t89\t222\t222\n88\t89\t223\t223\n88\t89\t224\t224\n88\t89\t225\t225\n88\t89\t226\t226\n88\t89\t227\t227\n88\t89\t228\t228\n88\t89\t229\t229\n88\t89\t230\t230\n88\t89\t231\t231\n88\t89\t232\t232\n88\t89\t233\t233\n88\t89\t234\t234\n88\t89\t235\t235\n88\t89\t236\t236\n88\t89\t237\t237\n88\t89\t238\t238\n88\t89\t239\t239\n88\t89\t240\t240\n88\t89\t241\t241\n88\t89\t242\t242\n88\t89\t243\t243\n88\t89\t244\t244\n88\t89\t245\t245\n88\t89\t246\t246\n88\t89\t247\t247\n88\t89\t248\t248\n88\t89\t249\t249\n88\t89\t250\t250\n88\t89\t251\t251\n88\t89\t252\t252\n88\t89\t253\t253\n88\t89\t254\t254\n88\t89\t255\t255\n89\t90\t0\t0\n89\t90\t1\t1\n89\t90\t2\t2\n89\t90\t3\t3\n89\t90\t4\t4\n89\t90\t5\t5\n89\t90\t6\t6\n89\t90\t7\t7\n89\t90\t8\t8\n89\t90\t9\t9\n89\t90\t10\t10\n89\t90\t11\t11\n89\t90\t12\t12\n89\t90\t13\t13\n89\t90\t14\t14\n89\t90\t15\t15\n89\t90\t16\t16\n89\t90\t17\t17\n89\t90\t18\t18\n89\t90\t19\t19\n89\t90\t20\t20\n89\t90\t21\t21\n89\t90\t22\t22\n89\t90\t23\t23\n89\t90\t24\t24\n89\t90\t25\t25\n89\t90\t26\t26\n89\t90\t27\t27\n89\t90\t28\t28\n89\t90\t29\t29\n89\t90\t30\t30\n89\t90\t31\t31\n89\t90\t32\t32\n89\t90\t33\t33\n89\t90\t34\t34\n89\t90\t35\t35\n89\t90\t36\t36\n89\t90\t37\t37\n89\t90\t38\t38\n89\t90\t39\t39\n89\t90\t40\t40\n89\t90\t41\t41\n89\t90\t42\t42\n89\t90\t43\t43\n89\t90\t44\t44\n89\t90\t45\t45\n89\t90\t46\t46\n89\t90\t47\t47\n89\t90\t48\t48\n89\t90\t49\t49\n89\t90\t50\t50\n89\t90\t51\t51\n89\t90\t52\t52\n89\t90\t53\t53\n89\t90\t54\t54\n89\t90\t55\t55\n89\t90\t56\t56\n89\t90\t57\t57\n89\t90\t58\t58\n89\t90\t59\t59\n89\t90\t60\t60\n89\t90\t61\t61\n89\t90\t62\t62\n89\t90\t63\t63\n89\t90\t64\t64\n89\t90\t65\t65\n89\t90\t66\t66\n89\t90\t67\t67\n89\t90\t68\t68\n89\t90\t69\t69\n89\t90\t70\t70\n89\t90\t71\t71\n89\t90\t72\t72\n89\t90\t73\t73\n89\t90\t74\t74\n89\t90\t75\t75\n89\t90\t76\t76\n89\t90\t77\t77\n89\t90\t78\t78\n89\t90\t79\t79\n89\t90\t80\t80\n89\t90\t81\t81\n89\t90\t82\t82\n89\t90\t83\t83\n89\t90\t84\t84\n89\t90\t85\t85\n89\t90\t86\t86\n89\t90\t87\t87\n89\t90\t88\t88\n89\t90\t89\t89\n89\t90\t90\t90\n89\t90\t91\t91\n89\t90\t92\t92\n89\t90\t93\t93\n89\t90\t94\t94\n89\t90\t95\t95\n89\t90\t96\t96\n89\t90\t97\t97\n89\t90\t98\t98\n89\t90\t99\t99\n89\t90\t100\t100\n89\t90\t101\t101\n89\t90\t102\t102\n89\t90\t103\t103\n89\t90\t104\t104\n89\t90\t105\t105\n89\t90\t106\t106\n89\t90\t107\t107\n89\t90\t108\t108\n89\t90\t109\t109\n89\t90\t110\t110\n89\t90\t111\t111\n89\t90\t112\t112\n89\t90\t113\t113\n89\t90\t114\t114\n89\t90\t115\t115\n89\t90\t116\t116\n89\t90\t117\t117\n89\t90\t118\t118\n89\t90\t119\t119\n89\t90\t120\t120\n89\t90\t121\t121\n89\t90\t122\t122\n89\t90\t123\t123\n89\t90\t124\t124\n89\t90\t125\t125\n89\t90\t126\t126\n89\t90\t127\t127\n89\t90\t128\t128\n89\t90\t129\t129\n89\t90\t130\t130\n89\t90\t131\t131\n89\t90\t132\t132\n89\t90\t133\t133\n89\t90\t134\t134\n89\t90\t135\t135\n89\t90\t136\t136\n89\t90\t137\t137\n89\t90\t138\t138\n89\t90\t139\t139\n89\t90\t140\t140\n89\t90\t141\t141\n89\t90\t142\t142\n89\t90\t143\t143\n89\t90\t144\t144\n89\t90\t145\t145\n89\t90\t146\t146\n89\t90\t147\t147\n89\t90\t148\t148\n89\t90\t149\t149\n89\t90\t150\t150\n89\t90\t151\t151\n89\t90\t152\t152\n89\t90\t153\t153\n89\t90\t154\t154\n89\t90\t155\t155\n89\t90\t156\t156\n89\t90\t157\t157\n89\t90\t158\t158\n89\t90\t159\t159\n89\t90\t160\t160\n89\t90\t161\t161\n89\t90\t162\t162\n89\t90\t163\t163\n89\t90\t164\t164\n89\t90\t165\t165\n89\t90\t166\t166\n89\t90\t167\t167\n89\t90\t168\t168\n89\t90\t169\t169\n89\t90\t170\t170\n89\t90\t171\t171\n89\t90\t172\t172\n89\t90\t173\t173\n89\t90\t174\t174\n89\t90\t175\t175\n89\t90\t176\t176\n89\t90\t177\t177\n89\t90\t178\t178\n89\t90\t179\t179\n89\t90\t180\t180\n89\t90\t181\t181\n89\t90\t182\t182\n89\t90\t183\t183\n89\t90\t184\t184\n89\t90\t185\t185\n89\t90\t186\t186\n89\t90\t187\t187\n89\t90\t188\t188\n89\t90\t189\t189\n89\t90\t190\t190\n89\t90\t191\t191\n89\t90\t192\t192\n89\t90\t193\t193\n89\t90\t194\t194\n89\t90\t195\t195\n89\t90\t196\t196\n89\t90\t197\t197\n89\t90\t198\t198\n89\t90\t199\t199\n89\t90\t200\t200\n89\t90\t201\t201\n89\t90\t202\t202\n89\t90\t203\t203\n89\t90\t204\t204\n89\t90\t205\t205\n89\t90\t206\t206\n89\t90\t207\t207\n89\t90\t208\t208\n89\t90\t209\t209\n89\t90\t210\t210\n89\t90\t211\t211\n89\t90\t212\t212\n89\t90\t213\t213\n89\t90\t214\t214\n89\t90\t215\t215\n89\t90\t216\t216\n89\t90\t217\t217\n89\t90\t218\t218\n89\t90\t219\t219\n89\t90\t220\t220\n89\t90\t221\t221\n89\t90\t222\t222\n89\t90\t223\t223\n89\t90\t224\t224\n89\t90\t225\t225\n89\t90\t226\t226\n89\t90\t227\t227\n89\t90\t228\t228\n89\t90\t229\t229\n89\t90\t230\t230\n89\t90\t231\t231\n89\t90\t232\t232\n89\t90\t233\t233\n89\t90\t234\t234\n89\t90\t235\t235\n89\t90\t236\t236\n89\t90\t237\t237\n89\t90\t238\t238\n89\t90\t239\t239\n89\t90\t240\t240\n89\t90\t241\t241\n89\t90\t242\t242\n89\t90\t243\t243\n89\t90\t244\t244\n89\t90\t245\t245\n89\t90\t246\t246\n89\t90\t247\t247\n89\t90\t248\t248\n89\t90\t249\t249\n89\t90\t250\t250\n89\t90\t251\t251\n89\t90\t252\t252\n89\t90\t253\t253\n89\t90\t254\t254\n89\t90\t255\t255\n90\t91\t0\t0\n90\t91\t1\t1\n90\t91\t2\t2\n90\t91\t3\t3\n90\t91\t4\t4\n90\t91\t5\t5\n90\t91\t6\t6\n90\t91\t7\t7\n90\t91\t8\t8\n90\t91\t9\t9\n90\t91\t10\t10\n90\t91\t11\t11\n90\t91\t12\t12\n90\t91\t13\t13\n90\t91\t14\t14\n90\t91\t15\t15\n90\t91\t16\t16\n90\t91\t17\t17\n90\t91\t18\t18\n90\t91\t19\t19\n90\t91\t20\t20\n90\t91\t21\t21\n90\t91\t22\t22\n90\t91\t23\t23\n90\t91\t24\t24\n90\t91\t25\t25\n90\t91\t26\t26\n90\t91\t27\t27\n90\t91\t28\t28\n90\t91\t29\t29\n90\t91\t30\t30\n90\t91\t31\t31\n90\t91\t32\t32\n90\t91\t33\t33\n90\t91\t34\t34\n90\t91\t35\t35\n90\t91\t36\t36\n90\t91\t37\t37\n90\t91\t38\t38\n90\t91\t39\t39\n90\t91\t40\t40\n90\t91\t41\t41\n90\t91\t42\t42\n90\t91\t43\t43\n90\t91\t44\t44\n90\t91\t45\t45\n90\t91\t46\t46\n90\t91\t47\t47\n90\t91\t48\t48\n90\t91\t49\t49\n90\t91\t50\t50\n90\t91\t51\t51\n90\t91\t52\t52\n90\t91\t53\t53\n90\t91\t54\t54\n90\t91\t55\t55\n90\t91\t56\t56\n90\t91\t57\t57\n90\t91\t58\t58\n90\t91\t59\t59\n90\t91\t60\t60\n90\t91\t61\t61\n90\t91\t62\t62\n90\t91\t63\t63\n90\t91\t64\t64\n90\t91\t65\t65\n90\t91\t66\t66\n90\t91\t67\t67\n90\t91\t68\t68\n90\t91\t69\t69\n90\t91\t70\t70\n90\t91\t71\t71\n90\t91\t72\t72\n90\t91\t73\t73\n90\t91\t74\t74\n90\t91\t75\t75\n90\t91\t76\t76\n90\t91\t77\t77\n90\t91\t78\t78\n90\t91\t79\t79\n90\t91\t80\t80\n90\t91\t81\t81\n90\t91\t82\t82\n90\t91\t83\t83\n90\t91\t84\t84\n90\t91\t85\t85\n90\t91\t86\t86\n90\t91\t87\t87\n90\t91\t88\t88\n90\t91\t89\t89\n90\t91\t90\t90\n90\t91\t91\t91\n90\t91\t92\t92\n90\t91\t93\t93\n90\t91\t94\t94\n90\t91\t95\t95\n90\t91\t96\t96\n90\t91\t97\t97\n90\t91\t98\t98\n90\t91\t99\t99\n90\t91\t100\t100\n90\t91\t101\t101\n90\t91\t102\t102\n90\t91\t103\t103\n90\t91\t104\t104\n90\t91\t105\t105\n90\t91\t106\t106\n90\t91\t107\t107\n90\t91\t108\t108\n90\t91\t109\t109\n90\t91\t110\t110\n90\t91\t111\t111\n90\t91\t112\t112\n90\t91\t113\t113\n90\t91\t114\t114\n90\t91\t115\t115\n90\t91\t116\t116\n90\t91\t117\t117\n90\t91\t118\t118\n90\t91\t119\t119\n90\t91\t120\t120\n90\t91\t121\t121\n90\t91\t122\t122\n90\t91\t123\t123\n90\t91\t124\t124\n90\t91\t125\t125\n90\t91\t126\t126\n90\t91\t127\t127\n90\t91\t128\t128\n90\t91\t129\t129\n90\t91\t130\t130\n90\t91\t131\t131\n90\t91\t132\t132\n90\t91\t133\t133\n90\t91\t134\t134\n90\t91\t135\t135\n90\t91\t136\t136\n90\t91\t137\t137\n90\t91\t138\t138\n90\t91\t139\t139\n90\t91\t140\t140\n90\t91\t141\t141\n90\t91\t142\t142\n90\t91\t143\t143\n90\t91\t144\t144\n90\t91\t145\t145\n90\t91\t146\t146\n90\t91\t147\t147\n90\t91\t148\t148\n90\t91\t149\t149\n90\t91\t150\t150\n90\t91\t151\t151\n90\t91\t152\t152\n90\t91\t153\t153\n90\t91\t154\t154\n90\t91\t155\t155\n90\t91\t156\t156\n90\t91\t157\t157\n90\t91\t158\t158\n90\t91\t159\t159\n90\t91\t160\t160\n90\t91\t161\t161\n90\t91\t162\t162\n90\t91\t163\t163\n90\t91\t164\t164\n90\t91\t165\t165\n90\t91\t166\t166\n90\t91\t167\t167\n90\t91\t168\t168\n90\t91\t169\t169\n90\t91\t170\t170\n90\t91\t171\t171\n90\t91\t172\t172\n90\t91\t173\t173\n90\t91\t174\t174\n90\t91\t175\t175\n90\t91\t176\t176\n90\t91\t177\t177\n90\t91\t178\t178\n90\t91\t179\t179\n90\t91\t180\t180\n90\t91\t181\t181\n90\t91\t182\t182\n90\t91\t183\t183\n90\t91\t184\t184\n90\t91\t185\t185\n90\t91\t186\t186\n90\t91\t187\t187\n90\t91\t188\t188\n90\t91\t189\t189\n90\t91\t190\t190\n90\t91\t191\t191\n90\t91\t192\t192\n90\t91\t193\t193\n90\t91\t194\t194\n90\t91\t195\t195\n90\t91\t196\t196\n90\t91\t197\t197\n90\t91\t198\t198\n90\t91\t199\t199\n90\t91\t200\t200\n90\t91\t201\t201\n90\t91\t202\t202\n90\t91\t203\t203\n90\t91\t204\t204\n90\t91\t205\t205\n90\t91\t206\t206\n90\t91\t207\t207\n90\t91\t208\t208\n90\t91\t209\t209\n90\t91\t210\t210\n90\t91\t211\t211\n90\t91\t212\t212\n90\t91\t213\t213\n90\t91\t214\t214\n90\t91\t215\t215\n90\t91\t216\t216\n90\t91\t217\t217\n90\t91\t218\t218\n90\t91\t219\t219\n90\t91\t220\t220\n90\t91\t221\t221\n90\t91\t222\t222\n90\t91\t223\t223\n90\t91\t224\t224\n90\t91\t225\t225\n90\t91\t226\t226\n90\t91\t227\t227\n90\t91\t228\t228\n90\t91\t229\t229\n90\t91\t230\t230\n90\t91\t231\t231\n90\t91\t232\t232\n90\t91\t233\t233\n90\t91\t234\t234\n90\t91\t235\t235\n90\t91\t236\t236\n90\t91\t237\t237\n90\t91\t238\t238\n90\t91\t239\t239\n90\t91\t240\t240\n90\t91\t241\t241\n90\t91\t242\t242\n90\t91\t243\t243\n90\t91\t244\t244\n90\t91\t245\t245\n90\t91\t246\t246\n90\t91\t247\t247\n90\t91\t248\t248\n90\t91\t249\t249\n90\t91\t250\t250\n90\t91\t251\t251\n90\t91\t252\t252\n90\t91\t253\t253\n90\t91\t254\t254\n90\t91\t255\t255\n91\t92\t0\t0\n91\t92\t1\t1\n91\t92\t2\t2\n91\t92\t3\t3\n91\t92\t4\t4\n91\t92\t5\t5\n91\t92\t6\t6\n91\t92\t7\t7\n91\t92\t8\t8\n91\t92\t9\t9\n91\t92\t10\t10\n91\t92\t11\t11\n91\t92\t12\t12\n91\t92\t13\t13\n91\t92\t14\t14\n91\t92\t15\t15\n91\t92\t16\t16\n91\t92\t17\t17\n91\t92\t18\t18\n91\t92\t19\t19\n91\t92\t20\t20\n91\t92\t21\t21\n91\t92\t22\t22\n91\t92\t23\t23\n91\t92\t24\t24\n91\t92\t25\t25\n91\t92\t26\t26\n91\t92\t27\t27\n91\t92\t28\t28\n91\t92\t29\t29\n91\t92\t30\t30\n91\t92\t31\t31\n91\t92\t32\t32\n91\t92\t33\t33\n91\t92\t34\t34\n91\t92\t35\t35\n91\t92\t36\t36\n91\t92\t37\t37\n91\t92\t38\t38\n91\t92\t39\t39\n91\t92\t40\t40\n91\t92\t41\t41\n91\t92\t42\t42\n91\t92\t43\t43\n91\t92\t44\t44\n91\t92\t45\t45\n91\t92\t46\t46\n91\t92\t47\t47\n91\t92\t48\t48\n91\t92\t49\t49\n91\t92\t50\t50\n91\t92\t51\t51\n91\t92\t52\t52\n91\t92\t53\t53\n91\t92\t54\t54\n91\t92\t55\t55\n91\t92\t56\t56\n91\t92\t57\t57\n91\t92\t58\t58\n91\t92\t59\t59\n91\t92\t60\t60\n91\t92\t61\t61\n91\t92\t62\t62\n91\t92\t63\t63\n91\t92\t64\t64\n91\t92\t65\t65\n91\t92\t66\t66\n91\t92\t67\t67\n91\t92\t68\t68\n91\t92\t69\t69\n91\t92\t70\t70\n91\t92\t71\t71\n91\t92\t72\t72\n91\t92\t73\t73\n91\t92\t74\t74\n91\t92\t75\t75\n91\t92\t76\t76\n91\t92\t77\t77\n91\t92\t78\t78\n91\t92\t79\t79\n91\t92\t80\t80\n91\t92\t81\t81\n91\t92\t82\t82\n91\t92\t83\t83\n91\t92\t84\t84\n91\t92\t85\t85\n91\t92\t86\t86\n91\t92\t87\t87\n91\t92\t88\t88\n91\t92\t89\t89\n91\t92\t90\t90\n91\t92\t91\t91\n91\t92\t92\t92\n91\t92\t93\t93\n91\t92\t94\t94\n91\t92\t95\t95\n91\t92\t96\t96\n91\t92\t97\t97\n91\t92\t98\t98\n91\t92\t99\t99\n91\t92\t100\t100\n91\t92\t101\t101\n91\t92\t102\t102\n91\t92\t103\t103\n91\t92\t104\t104\n91\t92\t105\t105\n91\t92\t106\t106\n91\t92\t107\t107\n91\t92\t108\t108\n91\t92\t109\t109\n91\t92\t110\t110\n91\t92\t111\t111\n91\t92\t112\t112\n91\t92\t113\t113\n91\t92\t114\t114\n91\t92\t115\t115\n91\t92\t116\t116\n91\t92\t117\t117\n91\t92\t118\t118\n91\t92\t119\t119\n91\t92\t120\t120\n91\t92\t121\t121\n91\t92\t122\t122\n91\t92\t123\t123\n91\t92\t124\t124\n91\t92\t125\t125\n91\t92\t126\t126\n91\t92\t127\t127\n91\t92\t128\t128\n91\t92\t129\t129\n91\t92\t130\t130\n91\t92\t131\t131\n91\t92\t132\t132\n91\t92\t133\t133\n91\t92\t134\t134\n91\t92\t135\t135\n91\t92\t136\t136\n91\t92\t137\t137\n91\t92\t138\t138\n91\t92\t139\t139\n91\t92\t140\t140\n91\t92\t141\t141\n91\t92\t142\t142\n91\t92\t143\t143\n91\t92\t144\t144\n91\t92\t145\t145\n91\t92\t146\t146\n91\t92\t147\t147\n91\t92\t148\t148\n91\t92\t149\t149\n91\t92\t150\t150\n91\t92\t151\t151\n91\t92\t152\t152\n91\t92\t153\t153\n91\t92\t154\t154\n91\t92\t155\t155\n91\t92\t156\t156\n91\t92\t157\t157\n91\t92\t158\t158\n91\t92\t159\t159\n91\t92\t160\t160\n91\t92\t161\t161\n91\t92\t162\t162\n91\t92\t163\t163\n91\t92\t164\t164\n91\t92\t165\t165\n91\t92\t166\t166\n91\t92\t167\t167\n91\t92\t168\t168\n91\t92\t169\t169\n91\t92\t170\t170\n91\t92\t171\t171\n91\t92\t172\t172\n91\t92\t173\t173\n91\t92\t174\t174\n91\t92\t175\t175\n91\t92\t176\t176\n91\t92\t177\t177\n91\t92\t178\t178\n91\t92\t179\t179\n91\t92\t180\t180\n91\t92\t181\t181\n91\t92\t182\t182\n91\t92\t183\t183\n91\t92\t184\t184\n91\t92\t185\t185\n91\t92\t186\t186\n91\t92\t187\t187\n91\t92\t188\t188\n91\t92\t189\t189\n91\t92\t190\t190\n91\t92\t191\t191\n91\t92\t192\t192\n91\t92\t193\t193\n91\t92\t194\t194\n91\t92\t195\t195\n91\t92\t196\t196\n91\t92\t197\t197\n91\t92\t198\t198\n91\t92\t199\t199\n91\t92\t200\t200\n91\t92\t201\t201\n91\t92\t202\t202\n91\t92\t203\t203\n91\t92\t204\t204\n91\t92\t205\t205\n91\t92\t206\t206\n91\t92\t207\t207\n91\t92\t208\t208\n91\t92\t209\t209\n91\t92\t210\t210\n91\t92\t211\t211\n91\t92\t212\t212\n91\t92\t213\t213\n91\t92\t214\t214\n91\t92\t215\t215\n91\t92\t216\t216\n91\t92\t217\t217\n91\t92\t218\t218\n91\t92\t219\t219\n91\t92\t220\t220\n91\t92\t221\t221\n91\t92\t222\t222\n91\t92\t223\t223\n91\t92\t224\t224\n91\t92\t225\t225\n91\t92\t226\t226\n91\t92\t227\t227\n91\t92\t228\t228\n91\t92\t229\t229\n91\t92\t230\t230\n91\t92\t231\t231\n91\t92\t232\t232\n91\t92\t233\t233\n91\t92\t234\t234\n91\t92\t235\t235\n91\t92\t236\t236\n91\t92\t237\t237\n91\t92\t238\t238\n91\t92\t239\t239\n91\t92\t240\t240\n91\t92\t241\t241\n91\t92\t242\t242\n91\t92\t243\t243\n91\t92\t244\t244\n91\t92\t245\t245\n91\t92\t246\t246\n91\t92\t247\t247\n91\t92\t248\t248\n91\t92\t249\t249\n91\t92\t250\t250\n91\t92\t251\t251\n91\t92\t252\t252\n91\t92\t253\t253\n91\t92\t254\t254\n91\t92\t255\t255\n92\t93\t0\t0\n92\t93\t1\t1\n92\t93\t2\t2\n92\t93\t3\t3\n92\t93\t4\t4\n92\t93\t5\t5\n92\t93\t6\t6\n92\t93\t7\t7\n92\t93\t8\t8\n92\t93\t9\t9\n92\t93\t10\t10\n92\t93\t11\t11\n92\t93\t12\t12\n92\t93\t13\t13\n92\t93\t14\t14\n92\t93\t15\t15\n92\t93\t16\t16\n92\t93\t17\t17\n92\t93\t18\t18\n92\t93\t19\t19\n92\t93\t20\t20\n92\t93\t21\t21\n92\t93\t22\t22\n92\t93\t23\t23\n92\t93\t24\t24\n92\t93\t25\t25\n92\t93\t26\t26\n92\t93\t27\t27\n92\t93\t28\t28\n92\t93\t29\t29\n92\t93\t30\t30\n92\t93\t31\t31\n92\t93\t32\t32\n92\t93\t33\t33\n92\t93\t34\t34\n92\t93\t35\t35\n92\t93\t36\t36\n92\t93\t37\t37\n92\t93\t38\t38\n92\t93\t39\t39\n92\t93\t40\t40\n92\t93\t41\t41\n92\t93\t42\t42\n92\t93\t43\t43\n92\t93\t44\t44\n92\t93\t45\t45\n92\t93\t46\t46\n92\t93\t47\t47\n92\t93\t48\t48\n92\t93\t49\t49\n92\t93\t50\t50\n92\t93\t51\t51\n92\t93\t52\t52\n92\t93\t53\t53\n92\t93\t54\t54\n92\t93\t55\t55\n92\t93\t56\t56\n92\t93\t57\t57\n92\t93\t58\t58\n92\t93\t59\t59\n92\t93\t60\t60\n92\t93\t61\t61\n92\t93\t62\t62\n92\t93\t63\t63\n92\t93\t64\t64\n92\t93\t65\t65\n92\t93\t66\t66\n92\t93\t67\t67\n92\t93\t68\t68\n92\t93\t69\t69\n92\t93\t70\t70\n92\t93\t71\t71\n92\t93\t72\t72\n92\t93\t73\t73\n92\t93\t74\t74\n92\t93\t75\t75\n92\t93\t76\t76\n92\t93\t77\t77\n92\t93\t78\t78\n92\t93\t79\t79\n92\t93\t80\t80\n92\t93\t81\t81\n92\t93\t82\t82\n92\t93\t83\t83\n92\t93\t84\t84\n92\t93\t85\t85\n92\t93\t86\t86\n92\t93\t87\t87\n92\t93\t88\t88\n92\t93\t89\t89\n92\t93\t90\t90\n92\t93\t91\t91\n92\t93\t92\t92\n92\t93\t93\t93\n92\t93\t94\t94\n92\t93\t95\t95\n92\t93\t96\t96\n92\t93\t97\t97\n92\t93\t98\t98\n92\t93\t99\t99\n92\t93\t100\t100\n92\t93\t101\t101\n92\t93\t102\t102\n92\t93\t103\t103\n92\t93\t104\t104\n92\t93\t105\t105\n92\t93\t106\t106\n92\t93\t107\t107\n92\t93\t108\t108\n92\t93\t109\t109\n92\t93\t110\t110\n92\t93\t111\t111\n92\t93\t112\t112\n92\t93\t113\t113\n92\t93\t114\t114\n92\t93\t115\t115\n92\t93\t116\t116\n92\t93\t117\t117\n92\t93\t118\t118\n92\t93\t119\t119\n92\t93\t120\t120\n92\t93\t121\t121\n92\t93\t122\t122\n92\t93\t123\t123\n92\t93\t124\t124\n92\t93\t125\t125\n92\t93\t126\t126\n92\t93\t127\t127\n92\t93\t128\t128\n92\t93\t129\t129\n92\t93\t130\t130\n92\t93\t131\t131\n92\t93\t132\t132\n92\t93\t133\t133\n92\t93\t134\t134\n92\t93\t135\t135\n92\t93\t136\t136\n92\t93\t137\t137\n92\t93\t138\t138\n92\t93\t139\t139\n92\t93\t140\t140\n92\t93\t141\t141\n92\t93\t142\t142\n92\t93\t143\t143\n92\t93\t144\t144\n92\t93\t145\t145\n92\t93\t146\t146\n92\t93\t147\t147\n92\t93\t148\t148\n92\t93\t149\t149\n92\t93\t150\t150\n92\t93\t151\t151\n92\t93\t152\t152\n92\t93\t153\t153\n92\t93\t154\t154\n92\t93\t155\t155\n92\t93\t156\t156\n92\t93\t157\t157\n92\t93\t158\t158\n92\t93\t159\t159\n92\t93\t160\t160\n92\t93\t161\t161\n92\t93\t162\t162\n92\t93\t163\t163\n92\t93\t164\t164\n92\t93\t165\t165\n92\t93\t166\t166\n92\t93\t167\t167\n92\t93\t168\t168\n92\t93\t169\t169\n92\t93\t170\t170\n92\t93\t171\t171\n92\t93\t172\t172\n92\t93\t173\t173\n92\t93\t174\t174\n92\t93\t175\t175\n92\t93\t176\t176\n92\t93\t177\t177\n92\t93\t178\t178\n92\t93\t179\t179\n92\t93\t180\t180\n92\t93\t181\t181\n92\t93\t182\t182\n92\t93\t183\t183\n92\t93\t184\t184\n92\t93\t185\t185\n92\t93\t186\t186\n92\t93\t187\t187\n92\t93\t188\t188\n92\t93\t189\t189\n92\t93\t190\t190\n92\t93\t191\t191\n92\t93\t192\t192\n92\t93\t193\t193\n92\t93\t194\t194\n92\t93\t195\t195\n92\t93\t196\t196\n92\t93\t197\t197\n92\t93\t198\t198\n92\t93\t199\t199\n92\t93\t200\t200\n92\t93\t201\t201\n92\t93\t202\t202\n92\t93\t203\t203\n92\t93\t204\t204\n92\t93\t205\t205\n92\t93\t206\t206\n92\t93\t207\t207\n92\t93\t208\t208\n92\t93\t209\t209\n92\t93\t210\t210\n92\t93\t211\t211\n92\t93\t212\t212\n92\t93\t213\t213\n92\t93\t214\t214\n92\t93\t215\t215\n92\t93\t216\t216\n92\t93\t217\t217\n92\t93\t218\t218\n92\t93\t219\t219\n92\t93\t220\t220\n92\t93\t221\t221\n92\t93\t222\t222\n92\t93\t223\t223\n92\t93\t224\t224\n92\t93\t225\t225\n92\t93\t226\t226\n92\t93\t227\t227\n92\t93\t228\t228\n92\t93\t229\t229\n92\t93\t230\t230\n92\t93\t231\t231\n92\t93\t232\t232\n92\t93\t233\t233\n92\t93\t234\t234\n92\t93\t235\t235\n92\t93\t236\t236\n92\t93\t237\t237\n92\t93\t238\t238\n92\t93\t239\t239\n92\t93\t240\t240\n92\t93\t241\t241\n92\t93\t242\t242\n92\t93\t243\t243\n92\t93\t244\t244\n92\t93\t245\t245\n92\t93\t246\t246\n92\t93\t247\t247\n92\t93\t248\t248\n92\t93\t249\t249\n92\t93\t250\t250\n92\t93\t251\t251\n92\t93\t252\t252\n92\t93\t253\t253\n92\t93\t254\t254\n92\t93\t255\t255\n93\t94\t0\t0\n93\t94\t1\t1\n93\t94\t2\t2\n93\t94\t3\t3\n93\t94\t4\t4\n93\t94\t5\t5\n93\t94\t6\t6\n93\t94\t7\t7\n93\t94\t8\t8\n93\t94\t9\t9\n93\t94\t10\t10\n93\t94\t11\t11\n93\t94\t12\t12\n93\t94\t13\t13\n93\t94\t14\t14\n93\t94\t15\t15\n93\t94\t16\t16\n93\t94\t17\t17\n93\t94\t18\t18\n93\t94\t19\t19\n93\t94\t20\t20\n93\t94\t21\t21\n93\t94\t22\t22\n93\t94\t23\t23\n93\t94\t24\t24\n93\t94\t25\t25\n93\t94\t26\t26\n93\t94\t27\t27\n93\t94\t28\t28\n93\t94\t29\t29\n93\t94\t30\t30\n93\t94\t31\t31\n93\t94\t32\t32\n93\t94\t33\t33\n93\t94\t34\t34\n93\t94\t35\t35\n93\t94\t36\t36\n93\t94\t37\t37\n93\t94\t38\t38\n93\t94\t39\t39\n93\t94\t40\t40\n93\t94\t41\t41\n93\t94\t42\t42\n93\t94\t43\t43\n93\t94\t44\t44\n93\t94\t45\t45\n93\t94\t46\t46\n93\t94\t47\t47\n93\t94\t48\t48\n93\t94\t49\t49\n93\t94\t50\t50\n93\t94\t51\t51\n93\t94\t52\t52\n93\t94\t53\t53\n93\t94\t54\t54\n93\t94\t55\t55\n93\t94\t56\t56\n93\t94\t57\t57\n93\t94\t58\t58\n93\t94\t59\t59\n93\t94\t60\t60\n93\t94\t61\t61\n93\t94\t62\t62\n93\t94\t63\t63\n93\t94\t64\t64\n93\t94\t65\t65\n93\t94\t66\t66\n93\t94\t67\t67\n93\t94\t68\t68\n93\t94\t69\t69\n93\t94\t70\t70\n93\t94\t71\t71\n93\t94\t72\t72\n93\t94\t73\t73\n93\t94\t74\t74\n93\t94\t75\t75\n93\t94\t76\t76\n93\t94\t77\t77\n93\t94\t78\t78\n93\t94\t79\t79\n93\t94\t80\t80\n93\t94\t81\t81\n93\t94\t82\t82\n93\t94\t83\t83\n93\t94\t84\t84\n93\t94\t85\t85\n93\t94\t86\t86\n93\t94\t87\t87\n93\t94\t88\t88\n93\t94\t89\t89\n93\t94\t90\t90\n93\t94\t91\t91\n93\t94\t92\t92\n93\t94\t93\t93\n93\t94\t94\t94\n93\t94\t95\t95\n93\t94\t96\t96\n93\t94\t97\t97\n93\t94\t98\t98\n93\t94\t99\t99\n93\t94\t100\t100\n93\t94\t101\t101\n93\t94\t102\t102\n93\t94\t103\t103\n93\t94\t104\t104\n93\t94\t105\t105\n93\t94\t106\t106\n93\t94\t107\t107\n93\t94\t108\t108\n93\t94\t109\t109\n93\t94\t110\t110\n93\t94\t111\t111\n93\t94\t112\t112\n93\t94\t113\t113\n93\t94\t114\t114\n93\t94\t115\t115\n93\t94\t116\t116\n93\t94\t117\t117\n93\t94\t118\t118\n93\t94\t119\t119\n93\t94\t120\t120\n93\t94\t121\t121\n93\t94\t122\t122\n93\t94\t123\t123\n93\t94\t124\t124\n93\t94\t125\t125\n93\t94\t126\t126\n93\t94\t127\t127\n93\t94\t128\t128\n93\t94\t129\t129\n93\t94\t130\t130\n93\t94\t131\t131\n93\t94\t132\t132\n93\t94\t133\t133\n93\t94\t134\t134\n93\t94\t135\t135\n93\t94\t136\t136\n93\t94\t137\t137\n93\t94\t138\t138\n93\t94\t139\t139\n93\t94\t140\t140\n93\t94\t141\t141\n93\t94\t142\t142\n93\t94\t143\t143\n93\t94\t144\t144\n93\t94\t145\t145\n93\t94\t146\t146\n93\t94\t147\t147\n93\t94\t148\t148\n93\t94\t149\t149\n93\t94\t150\t150\n93\t94\t151\t151\n93\t94\t152\t152\n93\t94\t153\t153\n93\t94\t154\t154\n93\t94\t155\t155\n93\t94\t156\t156\n93\t94\t157\t157\n93\t94\t158\t158\n93\t94\t159\t159\n93\t94\t160\t160\n93\t94\t161\t161\n93\t94\t162\t162\n93\t94\t163\t163\n93\t94\t164\t164\n93\t94\t165\t165\n93\t94\t166\t166\n93\t94\t167\t167\n93\t94\t168\t168\n93\t94\t169\t169\n93\t94\t170\t170\n93\t94\t171\t171\n93\t94\t172\t172\n93\t94\t173\t173\n93\t94\t174\t174\n93\t94\t175\t175\n93\t94\t176\t176\n93\t94\t177\t177\n93\t94\t178\t178\n93\t94\t179\t179\n93\t94\t180\t180\n93\t94\t181\t181\n93\t94\t182\t182\n93\t94\t183\t183\n93\t94\t184\t184\n93\t94\t185\t185\n93\t94\t186\t186\n93\t94\t187\t187\n93\t94\t188\t188\n93\t94\t189\t189\n93\t94\t190\t190\n93\t94\t191\t191\n93\t94\t192\t192\n93\t94\t193\t193\n93\t94\t194\t194\n93\t94\t195\t195\n93\t94\t196\t196\n93\t94\t197\t197\n93\t94\t198\t198\n93\t94\t199\t199\n93\t94\t200\t200\n93\t94\t201\t201\n93\t94\t202\t202\n93\t94\t203\t203\n93\t94\t204\t204\n93\t94\t205\t205\n93\t94\t206\t206\n93\t94\t207\t207\n93\t94\t208\t208\n93\t94\t209\t209\n93\t94\t210\t210\n93\t94\t211\t211\n93\t94\t212\t212\n93\t94\t213\t213\n93\t94\t214\t214\n93\t94\t215\t215\n93\t94\t216\t216\n93\t94\t217\t217\n93\t94\t218\t218\n93\t94\t219\t219\n93\t94\t220\t220\n93\t94\t221\t221\n93\t94\t222\t222\n93\t94\t223\t223\n93\t94\t224\t224\n93\t94\t225\t225\n93\t94\t226\t226\n93\t94\t227\t227\n93\t94\t228\t228\n93\t94\t229\t229\n93\t94\t230\t230\n93\t94\t231\t231\n93\t94\t232\t232\n93\t94\t233\t233\n93\t94\t234\t234\n93\t94\t235\t235\n93\t94\t236\t236\n93\t94\t237\t237\n93\t94\t238\t238\n93\t94\t239\t239\n93\t94\t240\t240\n93\t94\t241\t241\n93\t94\t242\t242\n93\t94\t243\t243\n93\t94\t244\t244\n93\t94\t245\t245\n93\t94\t246\t246\n93\t94\t247\t247\n93\t94\t248\t248\n93\t94\t249\t249\n93\t94\t250\t250\n93\t94\t251\t251\n93\t94\t252\t252\n93\t94\t253\t253\n93\t94\t254\t254\n93\t94\t255\t255\n94\t95\t0\t0\n94\t95\t1\t1\n94\t95\t2\t2\n94\t95\t3\t3\n94\t95\t4\t4\n94\t95\t5\t5\n94\t95\t6\t6\n94\t95\t7\t7\n94\t95\t8\t8\n94\t95\t9\t9\n94\t95\t10\t10\n94\t95\t11\t11\n94\t95\t12\t12\n94\t95\t13\t13\n94\t95\t14\t14\n94\t95\t15\t15\n94\t95\t16\t16\n94\t95\t17\t17\n94\t95\t18\t18\n94\t95\t19\t19\n94\t95\t20\t20\n94\t95\t21\t21\n94\t95\t22\t22\n94\t95\t23\t23\n94\t95\t24\t24\n94\t95\t25\t25\n94\t95\t26\t26\n94\t95\t27\t27\n94\t95\t28\t28\n94\t95\t29\t29\n94\t95\t30\t30\n94\t95\t31\t31\n94\t95\t32\t32\n94\t95\t33\t33\n94\t95\t34\t34\n94\t95\t35\t35\n94\t95\t36\t36\n94\t95\t37\t37\n94\t95\t38\t38\n94\t95\t39\t39\n94\t95\t40\t40\n94\t95\t41\t41\n94\t95\t42\t42\n94\t95\t43\t43\n94\t95\t44\t44\n94\t95\t45\t45\n94\t95\t46\t46\n94\t95\t47\t47\n94\t95\t48\t48\n94\t95\t49\t49\n94\t95\t50\t50\n94\t95\t51\t51\n94\t95\t52\t52\n94\t95\t53\t53\n94\t95\t54\t54\n94\t95\t55\t55\n94\t95\t56\t56\n94\t95\t57\t57\n94\t95\t58\t58\n94\t95\t59\t59\n94\t95\t60\t60\n94\t95\t61\t61\n94\t95\t62\t62\n94\t95\t63\t63\n94\t95\t64\t64\n94\t95\t65\t65\n94\t95\t66\t66\n94\t95\t67\t67\n94\t95\t68\t68\n94\t95\t69\t69\n94\t95\t70\t70\n94\t95\t71\t71\n94\t95\t72\t72\n94\t95\t73\t73\n94\t95\t74\t74\n94\t95\t75\t75\n94\t95\t76\t76\n94\t95\t77\t77\n94\t95\t78\t78\n94\t95\t79\t79\n94\t95\t80\t80\n94\t95\t81\t81\n94\t95\t82\t82\n94\t95\t83\t83\n94\t95\t84\t84\n94\t95\t85\t85\n94\t95\t86\t86\n94\t95\t87\t87\n94\t95\t88\t88\n94\t95\t89\t89\n94\t95\t90\t90\n94\t95\t91\t91\n94\t95\t92\t92\n94\t95\t93\t93\n94\t95\t94\t94\n94\t95\t95\t95\n94\t95\t96\t96\n94\t95\t97\t97\n94\t95\t98\t98\n94\t95\t99\t99\n94\t95\t100\t100\n94\t95\t101\t101\n94\t95\t102\t102\n94\t95\t103\t103\n94\t95\t104\t104\n94\t95\t105\t105\n94\t95\t106\t106\n94\t95\t107\t107\n94\t95\t108\t108\n94\t95\t109\t109\n94\t95\t110\t110\n94\t95\t111\t111\n94\t95\t112\t112\n94\t95\t113\t113\n94\t95\t114\t114\n94\t95\t115\t115\n94\t95\t116\t116\n94\t95\t117\t117\n94\t95\t118\t118\n94\t95\t119\t119\n94\t95\t120\t120\n94\t95\t121\t121\n94\t95\t122\t122\n94\t95\t123\t123\n94\t95\t124\t124\n94\t95\t125\t125\n94\t95\t126\t126\n94\t95\t127\t127\n94\t95\t128\t128\n94\t95\t129\t129\n94\t95\t130\t130\n94\t95\t131\t131\n94\t95\t132\t132\n94\t95\t133\t133\n94\t95\t134\t134\n94\t95\t135\t135\n94\t95\t136\t136\n94\t95\t137\t137\n94\t95\t138\t138\n94\t95\t139\t139\n94\t95\t140\t140\n94\t95\t141\t141\n94\t95\t142\t142\n94\t95\t143\t143\n94\t95\t144\t144\n94\t95\t145\t145\n94\t95\t146\t146\n94\t95\t147\t147\n94\t95\t148\t148\n94\t95\t149\t149\n94\t95\t150\t150\n94\t95\t151\t151\n94\t95\t152\t152\n94\t95\t153\t153\n94\t95\t154\t154\n94\t95\t155\t155\n94\t95\t156\t156\n94\t95\t157\t157\n94\t95\t158\t158\n94\t95\t159\t159\n94\t95\t160\t160\n94\t95\t161\t161\n94\t95\t162\t162\n94\t95\t163\t163\n94\t95\t164\t164\n94\t95\t165\t165\n94\t95\t166\t166\n94\t95\t167\t167\n94\t95\t168\t168\n94\t95\t169\t169\n94\t95\t170\t170\n94\t95\t171\t171\n94\t95\t172\t172\n94\t95\t173\t173\n94\t95\t174\t174\n94\t95\t175\t175\n94\t95\t176\t176\n94\t95\t177\t177\n94\t95\t178\t178\n94\t95\t179\t179\n94\t95\t180\t180\n94\t95\t181\t181\n94\t95\t182\t182\n94\t95\t183\t183\n94\t95\t184\t184\n94\t95\t185\t185\n94\t95\t186\t186\n94\t95\t187\t187\n94\t95\t188\t188\n94\t95\t189\t189\n94\t95\t190\t190\n94\t95\t191\t191\n94\t95\t192\t192\n94\t95\t193\t193\n94\t95\t194\t194\n94\t95\t195\t195\n94\t95\t196\t196\n94\t95\t197\t197\n94\t95\t198\t198\n94\t95\t199\t199\n94\t95\t200\t200\n94\t95\t201\t201\n94\t95\t202\t202\n94\t95\t203\t203\n94\t95\t204\t204\n94\t95\t205\t205\n94\t95\t206\t206\n94\t95\t207\t207\n94\t95\t208\t208\n94\t95\t209\t209\n94\t95\t210\t210\n94\t95\t211\t211\n94\t95\t212\t212\n94\t95\t213\t213\n94\t95\t214\t214\n94\t95\t215\t215\n94\t95\t216\t216\n94\t95\t217\t217\n94\t95\t218\t218\n94\t95\t219\t219\n94\t95\t220\t220\n94\t95\t221\t221\n94\t95\t222\t222\n94\t95\t223\t223\n94\t95\t224\t224\n94\t95\t225\t225\n94\t95\t226\t226\n94\t95\t227\t227\n94\t95\t228\t228\n94\t95\t229\t229\n94\t95\t230\t230\n94\t95\t231\t231\n94\t95\t232\t232\n94\t95\t233\t233\n94\t95\t234\t234\n94\t95\t235\t235\n94\t95\t236\t236\n94\t95\t237\t237\n94\t95\t238\t238\n94\t95\t239\t239\n94\t95\t240\t240\n94\t95\t241\t241\n94\t95\t242\t242\n94\t95\t243\t243\n94\t95\t244\t244\n94\t95\t245\t245\n94\t95\t246\t246\n94\t95\t247\t247\n94\t95\t248\t248\n94\t95\t249\t249\n94\t95\t250\t250\n94\t95\t251\t251\n94\t95\t252\t252\n94\t95\t253\t253\n94\t95\t254\t254\n94\t95\t255\t255\n95\t96\t0\t0\n95\t96\t1\t1\n95\t96\t2\t2\n95\t96\t3\t3\n95\t96\t4\t4\n95\t96\t5\t5\n95\t96\t6\t6\n95\t96\t7\t7\n95\t96\t8\t8\n95\t96\t9\t9\n95\t96\t10\t10\n95\t96\t11\t11\n95\t96\t12\t12\n95\t96\t13\t13\n95\t96\t14\t14\n95\t96\t15\t15\n95\t96\t16\t16\n95\t96\t17\t17\n95\t96\t18\t18\n95\t96\t19\t19\n95\t96\t20\t20\n95\t96\t21\t21\n95\t96\t22\t22\n95\t96\t23\t23\n95\t96\t24\t24\n95\t96\t25\t25\n95\t96\t26\t26\n95\t96\t27\t27\n95\t96\t28\t28\n95\t96\t29\t29\n95\t96\t30\t30\n95\t96\t31\t31\n95\t96\t32\t32\n95\t96\t33\t33\n95\t96\t34\t34\n95\t96\t35\t35\n95\t96\t36\t36\n95\t96\t37\t37\n95\t96\t38\t38\n95\t96\t39\t39\n95\t96\t40\t40\n95\t96\t41\t41\n95\t96\t42\t42\n95\t96\t43\t43\n95\t96\t44\t44\n95\t96\t45\t45\n95\t96\t46\t46\n95\t96\t47\t47\n95\t96\t48\t48\n95\t96\t49\t49\n95\t96\t50\t50\n95\t96\t51\t51\n95\t96\t52\t52\n95\t96\t53\t53\n95\t96\t54\t54\n95\t96\t55\t55\n95\t96\t56\t56\n95\t96\t57\t57\n95\t96\t58\t58\n95\t96\t59\t59\n95\t96\t60\t60\n95\t96\t61\t61\n95\t96\t62\t62\n95\t96\t63\t63\n95\t96\t64\t64\n95\t96\t65\t65\n95\t96\t66\t66\n95\t96\t67\t67\n95\t96\t68\t68\n95\t96\t69\t69\n95\t96\t70\t70\n95\t96\t71\t71\n95\t96\t72\t72\n95\t96\t73\t73\n95\t96\t74\t74\n95\t96\t75\t75\n95\t96\t76\t76\n95\t96\t77\t77\n95\t96\t78\t78\n95\t96\t79\t79\n95\t96\t80\t80\n95\t96\t81\t81\n95\t96\t82\t82\n95\t96\t83\t83\n95\t96\t84\t84\n95\t96\t85\t85\n95\t96\t86\t86\n95\t96\t87\t87\n95\t96\t88\t88\n95\t96\t89\t89\n95\t96\t90\t90\n95\t96\t91\t91\n95\t96\t92\t92\n95\t96\t93\t93\n95\t96\t94\t94\n95\t96\t95\t95\n95\t96\t96\t96\n95\t96\t97\t97\n95\t96\t98\t98\n95\t96\t99\t99\n95\t96\t100\t100\n95\t96\t101\t101\n95\t96\t102\t102\n95\t96\t103\t103\n95\t96\t104\t104\n95\t96\t105\t105\n95\t96\t106\t106\n95\t96\t107\t107\n95\t96\t108\t108\n95\t96\t109\t109\n95\t96\t110\t110\n95\t96\t111\t111\n95\t96\t112\t112\n95\t96\t113\t113\n95\t96\t114\t114\n95\t96\t115\t115\n95\t96\t116\t116\n95\t96\t117\t117\n95\t96\t118\t118\n95\t96\t119\t119\n95\t96\t120\t120\n95\t96\t121\t121\n95\t96\t122\t122\n95\t96\t123\t123\n95\t96\t124\t124\n95\t96\t125\t125\n95\t96\t126\t126\n95\t96\t127\t127\n95\t96\t128\t128\n95\t96\t129\t129\n95\t96\t130\t130\n95\t96\t131\t131\n95\t96\t132\t132\n95\t96\t133\t133\n95\t96\t134\t134\n95\t96\t135\t135\n95\t96\t136\t136\n95\t96\t137\t137\n95\t96\t138\t138\n95\t96\t139\t139\n95\t96\t140\t140\n95\t96\t141\t141\n95\t96\t142\t142\n95\t96\t143\t143\n95\t96\t144\t144\n95\t96\t145\t145\n95\t96\t146\t146\n95\t96\t147\t147\n95\t96\t148\t148\n95\t96\t149\t149\n95\t96\t150\t150\n95\t96\t151\t151\n95\t96\t152\t152\n95\t96\t153\t153\n95\t96\t154\t154\n95\t96\t155\t155\n95\t96\t156\t156\n95\t96\t157\t157\n95\t96\t158\t158\n95\t96\t159\t159\n95\t96\t160\t160\n95\t96\t161\t161\n95\t96\t162\t162\n95\t96\t163\t163\n95\t96\t164\t164\n95\t96\t165\t165\n95\t96\t166\t166\n95\t96\t167\t167\n95\t96\t168\t168\n95\t96\t169\t169\n95\t96\t170\t170\n95\t96\t171\t171\n95\t96\t172\t172\n95\t96\t173\t173\n95\t96\t174\t174\n95\t96\t175\t175\n95\t96\t176\t176\n95\t96\t177\t177\n95\t96\t178\t178\n95\t96\t179\t179\n95\t96\t180\t180\n95\t96\t181\t181\n95\t96\t182\t182\n95\t96\t183\t183\n95\t96\t184\t184\n95\t96\t185\t185\n95\t96\t186\t186\n95\t96\t187\t187\n95\t96\t188\t188\n95\t96\t189\t189\n95\t96\t190\t190\n95\t96\t191\t191\n95\t96\t192\t192\n95\t96\t193\t193\n95\t96\t194\t194\n95\t96\t195\t195\n95\t96\t196\t196\n95\t96\t197\t197\n95\t96\t198\t198\n95\t96\t199\t199\n95\t96\t200\t200\n95\t96\t201\t201\n95\t96\t202\t202\n95\t96\t203\t203\n95\t96\t204\t204\n95\t96\t205\t205\n95\t96\t206\t206\n95\t96\t207\t207\n95\t96\t208\t208\n95\t96\t209\t209\n95\t96\t210\t210\n95\t96\t211\t211\n95\t96\t212\t212\n95\t96\t213\t213\n95\t96\t214\t214\n95\t96\t215\t215\n95\t96\t216\t216\n95\t96\t217\t217\n95\t96\t218\t218\n95\t96\t219\t219\n95\t96\t220\t220\n95\t96\t221\t221\n95\t96\t222\t222\n95\t96\t223\t223\n95\t96\t224\t224\n95\t96\t225\t225\n95\t96\t226\t226\n95\t96\t227\t227\n95\t96\t228\t228\n95\t96\t229\t229\n95\t96\t230\t230\n95\t96\t231\t231\n95\t96\t232\t232\n95\t96\t233\t233\n95\t96\t234\t234\n95\t96\t235\t235\n95\t96\t236\t236\n95\t96\t237\t237\n95\t96\t238\t238\n95\t96\t239\t239\n95\t96\t240\t240\n95\t96\t241\t241\n95\t96\t242\t242\n95\t96\t243\t243\n95\t96\t244\t244\n95\t96\t245\t245\n95\t96\t246\t246\n95\t96\t247\t247\n95\t96\t248\t248\n95\t96\t249\t249\n95\t96\t250\t250\n95\t96\t251\t251\n95\t96\t252\t252\n95\t96\t253\t253\n95\t96\t254\t254\n95\t96\t255\t255\n96\t97\t0\t0\n96\t97\t1\t1\n96\t97\t2\t2\n96\t97\t3\t3\n96\t97\t4\t4\n96\t97\t5\t5\n96\t97\t6\t6\n96\t97\t7\t7\n96\t97\t8\t8\n96\t97\t9\t9\n96\t97\t10\t10\n96\t97\t11\t11\n96\t97\t12\t12\n96\t97\t13\t13\n96\t97\t14\t14\n96\t97\t15\t15\n96\t97\t16\t16\n96\t97\t17\t17\n96\t97\t18\t18\n96\t97\t19\t19\n96\t97\t20\t20\n96\t97\t21\t21\n96\t97\t22\t22\n96\t97\t23\t23\n96\t97\t24\t24\n96\t97\t25\t25\n96\t97\t26\t26\n96\t97\t27\t27\n96\t97\t28\t28\n96\t97\t29\t29\n96\t97\t30\t30\n96\t97\t31\t31\n96\t97\t32\t32\n96\t97\t33\t33\n96\t97\t34\t34\n96\t97\t35\t35\n96\t97\t36\t36\n96\t97\t37\t37\n96\t97\t38\t38\n96\t97\t39\t39\n96\t97\t40\t40\n96\t97\t41\t41\n96\t97\t42\t42\n96\t97\t43\t43\n96\t97\t44\t44\n96\t97\t45\t45\n96\t97\t46\t46\n96\t97\t47\t47\n96\t97\t48\t48\n96\t97\t49\t49\n96\t97\t50\t50\n96\t97\t51\t51\n96\t97\t52\t52\n96\t97\t53\t53\n96\t97\t54\t54\n96\t97\t55\t55\n96\t97\t56\t56\n96\t97\t57\t57\n96\t97\t58\t58\n96\t97\t59\t59\n96\t97\t60\t60\n96\t97\t61\t61\n96\t97\t62\t62\n96\t97\t63\t63\n96\t97\t64\t64\n96\t97\t65\t65\n96\t97\t66\t66\n96\t97\t67\t67\n96\t97\t68\t68\n96\t97\t69\t69\n96\t97\t70\t70\n96\t97\t71\t71\n96\t97\t72\t72\n96\t97\t73\t73\n96\t97\t74\t74\n96\t97\t75\t75\n96\t97\t76\t76\n96\t97\t77\t77\n96\t97\t78\t78\n96\t97\t79\t79\n96\t97\t80\t80\n96\t97\t81\t81\n96\t97\t82\t82\n96\t97\t83\t83\n96\t97\t84\t84\n96\t97\t85\t85\n96\t97\t86\t86\n96\t97\t87\t87\n96\t97\t88\t88\n96\t97\t89\t89\n96\t97\t90\t90\n96\t97\t91\t91\n96\t97\t92\t92\n96\t97\t93\t93\n96\t97\t94\t94\n96\t97\t95\t95\n96\t97\t96\t96\n96\t97\t97\t97\n96\t97\t98\t98\n96\t97\t99\t99\n96\t97\t100\t100\n96\t97\t101\t101\n96\t97\t102\t102\n96\t97\t103\t103\n96\t97\t104\t104\n96\t97\t105\t105\n96\t97\t106\t106\n96\t97\t107\t107\n96\t97\t108\t108\n96\t97\t109\t109\n96\t97\t110\t110\n96\t97\t111\t111\n96\t97\t112\t112\n96\t97\t113\t113\n96\t97\t114\t114\n96\t97\t115\t115\n96\t97\t116\t116\n96\t97\t117\t117\n96\t97\t118\t118\n96\t97\t119\t119\n96\t97\t120\t120\n96\t97\t121\t121\n96\t97\t122\t122\n96\t97\t123\t123\n96\t97\t124\t124\n96\t97\t125\t125\n96\t97\t126\t126\n96\t97\t127\t127\n96\t97\t128\t128\n96\t97\t129\t129\n96\t97\t130\t130\n96\t97\t131\t131\n96\t97\t132\t132\n96\t97\t133\t133\n96\t97\t134\t134\n96\t97\t135\t135\n96\t97\t136\t136\n96\t97\t137\t137\n96\t97\t138\t138\n96\t97\t139\t139\n96\t97\t140\t140\n96\t97\t141\t141\n96\t97\t142\t142\n96\t97\t143\t143\n96\t97\t144\t144\n96\t97\t145\t145\n96\t97\t146\t146\n96\t97\t147\t147\n96\t97\t148\t148\n96\t97\t149\t149\n96\t97\t150\t150\n96\t97\t151\t151\n96\t97\t152\t152\n96\t97\t153\t153\n96\t97\t154\t154\n96\t97\t155\t155\n96\t97\t156\t156\n96\t97\t157\t157\n96\t97\t158\t158\n96\t97\t159\t159\n96\t97\t160\t160\n96\t97\t161\t161\n96\t97\t162\t162\n96\t97\t163\t163\n96\t97\t164\t164\n96\t97\t165\t165\n96\t97\t166\t166\n96\t97\t167\t167\n96\t97\t168\t168\n96\t97\t169\t169\n96\t97\t170\t170\n96\t97\t171\t171\n96\t97\t172\t172\n96\t97\t173\t173\n96\t97\t174\t174\n96\t97\t175\t175\n96\t97\t176\t176\n96\t97\t177\t177\n96\t97\t178\t178\n96\t97\t179\t179\n96\t97\t180\t180\n96\t97\t181\t181\n96\t97\t182\t182\n96\t97\t183\t183\n96\t97\t184\t184\n96\t97\t185\t185\n96\t97\t186\t186\n96\t97\t187\t187\n96\t97\t188\t188\n96\t97\t189\t189\n96\t97\t190\t190\n96\t97\t191\t191\n96\t97\t192\t192\n96\t97\t193\t193\n96\t97\t194\t194\n96\t97\t195\t195\n96\t97\t196\t196\n96\t97\t197\t197\n96\t97\t198\t198\n96\t97\t199\t199\n96\t97\t200\t200\n96\t97\t201\t201\n96\t97\t202\t202\n96\t97\t203\t203\n96\t97\t204\t204\n96\t97\t205\t205\n96\t97\t206\t206\n96\t97\t207\t207\n96\t97\t208\t208\n96\t97\t209\t209\n96\t97\t210\t210\n96\t97\t211\t211\n96\t97\t212\t212\n96\t97\t213\t213\n96\t97\t214\t214\n96\t97\t215\t215\n96\t97\t216\t216\n96\t97\t217\t217\n96\t97\t218\t218\n96\t97\t219\t219\n96\t97\t220\t220\n96\t97\t221\t221\n96\t97\t222\t222\n96\t97\t223\t223\n96\t97\t224\t224\n96\t97\t225\t225\n96\t97\t226\t226\n96\t97\t227\t227\n96\t97\t228\t228\n96\t97\t229\t229\n96\t97\t230\t230\n96\t97\t231\t231\n96\t97\t232\t232\n96\t97\t233\t233\n96\t97\t234\t234\n96\t97\t235\t235\n96\t97\t236\t236\n96\t97\t237\t237\n96\t97\t238\t238\n96\t97\t239\t239\n96\t97\t240\t240\n96\t97\t241\t241\n96\t97\t242\t242\n96\t97\t243\t243\n96\t97\t244\t244\n96\t97\t245\t245\n96\t97\t246\t246\n96\t97\t247\t247\n96\t97\t248\t248\n96\t97\t249\t249\n96\t97\t250\t250\n96\t97\t251\t251\n96\t97\t252\t252\n96\t97\t253\t253\n96\t97\t254\t254\n96\t97\t255\t255\n97\t98\t0\t0\n97\t98\t1\t1\n97\t98\t2\t2\n97\t98\t3\t3\n97\t98\t4\t4\n97\t98\t5\t5\n97\t98\t6\t6\n97\t98\t7\t7\n97\t98\t8\t8\n97\t98\t9\t9\n97\t98\t10\t10\n97\t98\t11\t11\n97\t98\t12\t12\n97\t98\t13\t13\n97\t98\t14\t14\n97\t98\t15\t15\n97\t98\t16\t16\n97\t98\t17\t17\n97\t98\t18\t18\n97\t98\t19\t19\n97\t98\t20\t20\n97\t98\t21\t21\n97\t98\t22\t22\n97\t98\t23\t23\n97\t98\t24\t24\n97\t98\t25\t25\n97\t98\t26\t26\n97\t98\t27\t27\n97\t98\t28\t28\n97\t98\t29\t29\n97\t98\t30\t30\n97\t98\t31\t31\n97\t98\t32\t32\n97\t98\t33\t33\n97\t98\t34\t34\n97\t98\t35\t35\n97\t98\t36\t36\n97\t98\t37\t37\n97\t98\t38\t38\n97\t98\t39\t39\n97\t98\t40\t40\n97\t98\t41\t41\n97\t98\t42\t42\n97\t98\t43\t43\n97\t98\t44\t44\n97\t98\t45\t45\n97\t98\t46\t46\n97\t98\t47\t47\n97\t98\t48\t48\n97\t98\t49\t49\n97\t98\t50\t50\n97\t98\t51\t51\n97\t98\t52\t52\n97\t98\t53\t53\n97\t98\t54\t54\n97\t98\t55\t55\n97\t98\t56\t56\n97\t98\t57\t57\n97\t98\t58\t58\n97\t98\t59\t59\n97\t98\t60\t60\n97\t98\t61\t61\n97\t98\t62\t62\n97\t98\t63\t63\n97\t98\t64\t64\n97\t98\t65\t65\n97\t98\t66\t66\n97\t98\t67\t67\n97\t98\t68\t68\n97\t98\t69\t69\n97\t98\t70\t70\n97\t98\t71\t71\n97\t98\t72\t72\n97\t98\t73\t73\n97\t98\t74\t74\n97\t98\t75\t75\n97\t98\t76\t76\n97\t98\t77\t77\n97\t98\t78\t78\n97\t98\t79\t79\n97\t98\t80\t80\n97\t98\t81\t81\n97\t98\t82\t82\n97\t98\t83\t83\n97\t98\t84\t84\n97\t98\t85\t85\n97\t98\t86\t86\n97\t98\t87\t87\n97\t98\t88\t88\n97\t98\t89\t89\n97\t98\t90\t90\n97\t98\t91\t91\n97\t98\t92\t92\n97\t98\t93\t93\n97\t98\t94\t94\n97\t98\t95\t95\n97\t98\t96\t96\n97\t98\t97\t97\n97\t98\t98\t98\n97\t98\t99\t99\n97\t98\t100\t100\n97\t98\t101\t101\n97\t98\t102\t102\n97\t98\t103\t103\n97\t98\t104\t104\n97\t98\t105\t105\n97\t98\t106\t106\n97\t98\t107\t107\n97\t98\t108\t108\n97\t98\t109\t109\n97\t98\t110\t110\n97\t98\t111\t111\n97\t98\t112\t112\n97\t98\t113\t113\n97\t98\t114\t114\n97\t98\t115\t115\n97\t98\t116\t116\n97\t98\t117\t117\n97\t98\t118\t118\n97\t98\t119\t119\n97\t98\t120\t120\n97\t98\t121\t121\n97\t98\t122\t122\n97\t98\t123\t123\n97\t98\t124\t124\n97\t98\t125\t125\n97\t98\t126\t126\n97\t98\t127\t127\n97\t98\t128\t128\n97\t98\t129\t129\n97\t98\t130\t130\n97\t98\t131\t131\n97\t98\t132\t132\n97\t98\t133\t133\n97\t98\t134\t134\n97\t98\t135\t135\n97\t98\t136\t136\n97\t98\t137\t137\n97\t98\t138\t138\n97\t98\t139\t139\n97\t98\t140\t140\n97\t98\t141\t141\n97\t98\t142\t142\n97\t98\t143\t143\n97\t98\t144\t144\n97\t98\t145\t145\n97\t98\t146\t146\n97\t98\t147\t147\n97\t98\t148\t148\n97\t98\t149\t149\n97\t98\t150\t150\n97\t98\t151\t151\n97\t98\t152\t152\n97\t98\t153\t153\n97\t98\t154\t154\n97\t98\t155\t155\n97\t98\t156\t156\n97\t98\t157\t157\n97\t98\t158\t158\n97\t98\t159\t159\n97\t98\t160\t160\n97\t98\t161\t161\n97\t98\t162\t162\n97\t98\t163\t163\n97\t98\t164\t164\n97\t98\t165\t165\n97\t98\t166\t166\n97\t98\t167\t167\n97\t98\t168\t168\n97\t98\t169\t169\n97\t98\t170\t170\n97\t98\t171\t171\n97\t98\t172\t172\n97\t98\t173\t173\n97\t98\t174\t174\n97\t98\t175\t175\n97\t98\t176\t176\n97\t98\t177\t177\n97\t98\t178\t178\n97\t98\t179\t179\n97\t98\t180\t180\n97\t98\t181\t181\n97\t98\t182\t182\n97\t98\t183\t183\n97\t98\t184\t184\n97\t98\t185\t185\n97\t98\t186\t186\n97\t98\t187\t187\n97\t98\t188\t188\n97\t98\t189\t189\n97\t98\t190\t190\n97\t98\t191\t191\n97\t98\t192\t192\n97\t98\t193\t193\n97\t98\t194\t194\n97\t98\t195\t195\n97\t98\t196\t196\n97\t98\t197\t197\n97\t98\t198\t198\n97\t98\t199\t199\n97\t98\t200\t200\n97\t98\t201\t201\n97\t98\t202\t202\n97\t98\t203\t203\n97\t98\t204\t204\n97\t98\t205\t205\n97\t98\t206\t206\n97\t98\t207\t207\n97\t98\t208\t208\n97\t98\t209\t209\n97\t98\t210\t210\n97\t98\t211\t211\n97\t98\t212\t212\n97\t98\t213\t213\n97\t98\t214\t214\n97\t98\t215\t215\n97\t98\t216\t216\n97\t98\t217\t217\n97\t98\t218\t218\n97\t98\t219\t219\n97\t98\t220\t220\n97\t98\t221\t221\n97\t98\t222\t222\n97\t98\t223\t223\n97\t98\t224\t224\n97\t98\t225\t225\n97\t98\t226\t226\n97\t98\t227\t227\n97\t98\t228\t228\n97\t98\t229\t229\n97\t98\t230\t230\n97\t98\t231\t231\n97\t98\t232\t232\n97\t98\t233\t233\n97\t98\t234\t234\n97\t98\t235\t235\n97\t98\t236\t236\n97\t98\t237\t237\n97\t98\t238\t238\n97\t98\t239\t239\n97\t98\t240\t240\n97\t98\t241\t241\n97\t98\t242\t242\n97\t98\t243\t243\n97\t98\t244\t244\n97\t98\t245\t245\n97\t98\t246\t246\n97\t98\t247\t247\n97\t98\t248\t248\n97\t98\t249\t249\n97\t98\t250\t250\n97\t98\t251\t251\n97\t98\t252\t252\n97\t98\t253\t253\n97\t98\t254\t254\n97\t98\t255\t255\n98\t99\t0\t0\n98\t99\t1\t1\n98\t99\t2\t2\n98\t99\t3\t3\n98\t99\t4\t4\n98\t99\t5\t5\n98\t99\t6\t6\n98\t99\t7\t7\n98\t99\t8\t8\n98\t99\t9\t9\n98\t99\t10\t10\n98\t99\t11\t11\n98\t99\t12\t12\n98\t99\t13\t13\n98\t99\t14\t14\n98\t99\t15\t15\n98\t99\t16\t16\n98\t99\t17\t17\n98\t99\t18\t18\n98\t99\t19\t19\n98\t99\t20\t20\n98\t99\t21\t21\n98\t99\t22\t22\n98\t99\t23\t23\n98\t99\t24\t24\n98\t99\t25\t25\n98\t99\t26\t26\n98\t99\t27\t27\n98\t99\t28\t28\n98\t99\t29\t29\n98\t99\t30\t30\n98\t99\t31\t31\n98\t99\t32\t32\n98\t99\t33\t33\n98\t99\t34\t34\n98\t99\t35\t35\n98\t99\t36\t36\n98\t99\t37\t37\n98\t99\t38\t38\n98\t99\t39\t39\n98\t99\t40\t40\n98\t99\t41\t41\n98\t99\t42\t42\n98\t99\t43\t43\n98\t99\t44\t44\n98\t99\t45\t45\n98\t99\t46\t46\n98\t99\t47\t47\n98\t99\t48\t48\n98\t99\t49\t49\n98\t99\t50\t50\n98\t99\t51\t51\n98\t99\t52\t52\n98\t99\t53\t53\n98\t99\t54\t54\n98\t99\t55\t55\n98\t99\t56\t56\n98\t99\t57\t57\n98\t99\t58\t58\n98\t99\t59\t59\n98\t99\t60\t60\n98\t99\t61\t61\n98\t99\t62\t62\n98\t99\t63\t63\n98\t99\t64\t64\n98\t99\t65\t65\n98\t99\t66\t66\n98\t99\t67\t67\n98\t99\t68\t68\n98\t99\t69\t69\n98\t99\t70\t70\n98\t99\t71\t71\n98\t99\t72\t72\n98\t99\t73\t73\n98\t99\t74\t74\n98\t99\t75\t75\n98\t99\t76\t76\n98\t99\t77\t77\n98\t99\t78\t78\n98\t99\t79\t79\n98\t99\t80\t80\n98\t99\t81\t81\n98\t99\t82\t82\n98\t99\t83\t83\n98\t99\t84\t84\n98\t99\t85\t85\n98\t99\t86\t86\n98\t99\t87\t87\n98\t99\t88\t88\n98\t99\t89\t89\n98\t99\t90\t90\n98\t99\t91\t91\n98\t99\t92\t92\n98\t99\t93\t93\n98\t99\t94\t94\n98\t99\t95\t95\n98\t99\t96\t96\n98\t99\t97\t97\n98\t99\t98\t98\n98\t99\t99\t99\n98\t99\t100\t100\n98\t99\t101\t101\n98\t99\t102\t102\n98\t99\t103\t103\n98\t99\t104\t104\n98\t99\t105\t105\n98\t99\t106\t106\n98\t99\t107\t107\n98\t99\t108\t108\n98\t99\t109\t109\n98\t99\t110\t110\n98\t99\t111\t111\n98\t99\t112\t112\n98\t99\t113\t113\n98\t99\t114\t114\n98\t99\t115\t115\n98\t99\t116\t116\n98\t99\t117\t117\n98\t99\t118\t118\n98\t99\t119\t119\n98\t99\t120\t120\n98\t99\t121\t121\n98\t99\t122\t122\n98\t99\t123\t123\n98\t99\t124\t124\n98\t99\t125\t125\n98\t99\t126\t126\n98\t99\t127\t127\n98\t99\t128\t128\n98\t99\t129\t129\n98\t99\t130\t130\n98\t99\t131\t131\n98\t99\t132\t132\n98\t99\t133\t133\n98\t99\t134\t134\n98\t99\t135\t135\n98\t99\t136\t136\n98\t99\t137\t137\n98\t99\t138\t138\n98\t99\t139\t139\n98\t99\t140\t140\n98\t99\t141\t141\n98\t99\t142\t142\n98\t99\t143\t143\n98\t99\t144\t144\n98\t99\t145\t145\n98\t99\t146\t146\n98\t99\t147\t147\n98\t99\t148\t148\n98\t99\t149\t149\n98\t99\t150\t150\n98\t99\t151\t151\n98\t99\t152\t152\n98\t99\t153\t153\n98\t99\t154\t154\n98\t99\t155\t155\n98\t99\t156\t156\n98\t99\t157\t157\n98\t99\t158\t158\n98\t99\t159\t159\n98\t99\t160\t160\n98\t99\t161\t161\n98\t99\t162\t162\n98\t99\t163\t163\n98\t99\t164\t164\n98\t99\t165\t165\n98\t99\t166\t166\n98\t99\t167\t167\n98\t99\t168\t168\n98\t99\t169\t169\n98\t99\t170\t170\n98\t99\t171\t171\n98\t99\t172\t172\n98\t99\t173\t173\n98\t99\t174\t174\n98\t99\t175\t175\n98\t99\t176\t176\n98\t99\t177\t177\n98\t99\t178\t178\n98\t99\t179\t179\n98\t99\t180\t180\n98\t99\t181\t181\n98\t99\t182\t182\n98\t99\t183\t183\n98\t99\t184\t184\n98\t99\t185\t185\n98\t99\t186\t186\n98\t99\t187\t187\n98\t99\t188\t188\n98\t99\t189\t189\n98\t99\t190\t190\n98\t99\t191\t191\n98\t99\t192\t192\n98\t99\t193\t193\n98\t99\t194\t194\n98\t99\t195\t195\n98\t99\t196\t196\n98\t99\t197\t197\n98\t99\t198\t198\n98\t99\t199\t199\n98\t99\t200\t200\n98\t99\t201\t201\n98\t99\t202\t202\n98\t99\t203\t203\n98\t99\t204\t204\n98\t99\t205\t205\n98\t99\t206\t206\n98\t99\t207\t207\n98\t99\t208\t208\n98\t99\t209\t209\n98\t99\t210\t210\n98\t99\t211\t211\n98\t99\t212\t212\n98\t99\t213\t213\n98\t99\t214\t214\n98\t99\t215\t215\n98\t99\t216\t216\n98\t99\t217\t217\n98\t99\t218\t218\n98\t99\t219\t219\n98\t99\t220\t220\n98\t99\t221\t221\n98\t99\t222\t222\n98\t99\t223\t223\n98\t99\t224\t224\n98\t99\t225\t225\n98\t99\t226\t226\n98\t99\t227\t227\n98\t99\t228\t228\n98\t99\t229\t229\n98\t99\t230\t230\n98\t99\t231\t231\n98\t99\t232\t232\n98\t99\t233\t233\n98\t99\t234\t234\n98\t99\t235\t235\n98\t99\t236\t236\n98\t99\t237\t237\n98\t99\t238\t238\n98\t99\t239\t239\n98\t99\t240\t240\n98\t99\t241\t241\n98\t99\t242\t242\n98\t99\t243\t243\n98\t99\t244\t244\n98\t99\t245\t245\n98\t99\t246\t246\n98\t99\t247\t247\n98\t99\t248\t248\n98\t99\t249\t249\n98\t99\t250\t250\n98\t99\t251\t251\n98\t99\t252\t252\n98\t99\t253\t253\n98\t99\t254\t254\n98\t99\t255\t255\n99\t100\t0\t0\n99\t100\t1\t1\n99\t100\t2\t2\n99\t100\t3\t3\n99\t100\t4\t4\n99\t100\t5\t5\n99\t100\t6\t6\n99\t100\t7\t7\n99\t100\t8\t8\n99\t100\t9\t9\n99\t100\t10\t10\n99\t100\t11\t11\n99\t100\t12\t12\n99\t100\t13\t13\n99\t100\t14\t14\n99\t100\t15\t15\n99\t100\t16\t16\n99\t100\t17\t17\n99\t100\t18\t18\n99\t100\t19\t19\n99\t100\t20\t20\n99\t100\t21\t21\n99\t100\t22\t22\n99\t100\t23\t23\n99\t100\t24\t24\n99\t100\t25\t25\n99\t100\t26\t26\n99\t100\t27\t27\n99\t100\t28\t28\n99\t100\t29\t29\n99\t100\t30\t30\n99\t100\t31\t31\n99\t100\t32\t32\n99\t100\t33\t33\n99\t100\t34\t34\n99\t100\t35\t35\n99\t100\t36\t36\n99\t100\t37\t37\n99\t100\t38\t38\n99\t100\t39\t39\n99\t100\t40\t40\n99\t100\t41\t41\n99\t100\t42\t42\n99\t100\t43\t43\n99\t100\t44\t44\n99\t100\t45\t45\n99\t100\t46\t46\n99\t100\t47\t47\n99\t100\t48\t48\n99\t100\t49\t49\n99\t100\t50\t50\n99\t100\t51\t51\n99\t100\t52\t52\n99\t100\t53\t53\n99\t100\t54\t54\n99\t100\t55\t55\n99\t100\t56\t56\n99\t100\t57\t57\n99\t100\t58\t58\n99\t100\t59\t59\n99\t100\t60\t60\n99\t100\t61\t61\n99\t100\t62\t62\n99\t100\t63\t63\n99\t100\t64\t64\n99\t100\t65\t65\n99\t100\t66\t66\n99\t100\t67\t67\n99\t100\t68\t68\n99\t100\t69\t69\n99\t100\t70\t70\n99\t100\t71\t71\n99\t100\t72\t72\n99\t100\t73\t73\n99\t100\t74\t74\n99\t100\t75\t75\n99\t100\t76\t76\n99\t100\t77\t77\n99\t100\t78\t78\n99\t100\t79\t79\n99\t100\t80\t80\n99\t100\t81\t81\n99\t100\t82\t82\n99\t100\t83\t83\n99\t100\t84\t84\n99\t100\t85\t85\n99\t100\t86\t86\n99\t100\t87\t87\n99\t100\t88\t88\n99\t100\t89\t89\n99\t100\t90\t90\n99\t100\t91\t91\n99\t100\t92\t92\n99\t100\t93\t93\n99\t100\t94\t94\n99\t100\t95\t95\n99\t100\t96\t96\n99\t100\t97\t97\n99\t100\t98\t98\n99\t100\t99\t99\n99\t100\t100\t100\n99\t100\t101\t101\n99\t100\t102\t102\n99\t100\t103\t103\n99\t100\t104\t104\n99\t100\t105\t105\n99\t100\t106\t106\n99\t100\t107\t107\n99\t100\t108\t108\n99\t100\t109\t109\n99\t100\t110\t110\n99\t100\t111\t111\n99\t100\t112\t112\n99\t100\t113\t113\n99\t100\t114\t114\n99\t100\t115\t115\n99\t100\t116\t116\n99\t100\t117\t117\n99\t100\t118\t118\n99\t100\t119\t119\n99\t100\t120\t120\n99\t100\t121\t121\n99\t100\t122\t122\n99\t100\t123\t123\n99\t100\t124\t124\n99\t100\t125\t125\n99\t100\t126\t126\n99\t100\t127\t127\n99\t100\t128\t128\n99\t100\t129\t129\n99\t100\t130\t130\n99\t100\t131\t131\n99\t100\t132\t132\n99\t100\t133\t133\n99\t100\t134\t134\n99\t100\t135\t135\n99\t100\t136\t136\n99\t100\t137\t137\n99\t100\t138\t138\n99\t100\t139\t139\n99\t100\t140\t140\n99\t100\t141\t141\n99\t100\t142\t142\n99\t100\t143\t143\n99\t100\t144\t144\n99\t100\t145\t145\n99\t100\t146\t146\n99\t100\t147\t147\n99\t100\t148\t148\n99\t100\t149\t149\n99\t100\t150\t150\n99\t100\t151\t151\n99\t100\t152\t152\n99\t100\t153\t153\n99\t100\t154\t154\n99\t100\t155\t155\n99\t100\t156\t156\n99\t100\t157\t157\n99\t100\t158\t158\n99\t100\t159\t159\n99\t100\t160\t160\n99\t100\t161\t161\n99\t100\t162\t162\n99\t100\t163\t163\n99\t100\t164\t164\n99\t100\t165\t165\n99\t100\t166\t166\n99\t100\t167\t167\n99\t100\t168\t168\n99\t100\t169\t169\n99\t100\t170\t170\n99\t100\t171\t171\n99\t100\t172\t172\n99\t100\t173\t173\n99\t100\t174\t174\n99\t100\t175\t175\n99\t100\t176\t176\n99\t100\t177\t177\n99\t100\t178\t178\n99\t100\t179\t179\n99\t100\t180\t180\n99\t100\t181\t181\n99\t100\t182\t182\n99\t100\t183\t183\n99\t100\t184\t184\n99\t100\t185\t185\n99\t100\t186\t186\n99\t100\t187\t187\n99\t100\t188\t188\n99\t100\t189\t189\n99\t100\t190\t190\n99\t100\t191\t191\n99\t100\t192\t192\n99\t100\t193\t193\n99\t100\t194\t194\n99\t100\t195\t195\n99\t100\t196\t196\n99\t100\t197\t197\n99\t100\t198\t198\n99\t100\t199\t199\n99\t100\t200\t200\n99\t100\t201\t201\n99\t100\t202\t202\n99\t100\t203\t203\n99\t100\t204\t204\n99\t100\t205\t205\n99\t100\t206\t206\n99\t100\t207\t207\n99\t100\t208\t208\n99\t100\t209\t209\n99\t100\t210\t210\n99\t100\t211\t211\n99\t100\t212\t212\n99\t100\t213\t213\n99\t100\t214\t214\n99\t100\t215\t215\n99\t100\t216\t216\n99\t100\t217\t217\n99\t100\t218\t218\n99\t100\t219\t219\n99\t100\t220\t220\n99\t100\t221\t221\n99\t100\t222\t222\n99\t100\t223\t223\n99\t100\t224\t224\n99\t100\t225\t225\n99\t100\t226\t226\n99\t100\t227\t227\n99\t100\t228\t228\n99\t100\t229\t229\n99\t100\t230\t230\n99\t100\t231\t231\n99\t100\t232\t232\n99\t100\t233\t233\n99\t100\t234\t234\n99\t100\t235\t235\n99\t100\t236\t236\n99\t100\t237\t237\n99\t100\t238\t238\n99\t100\t239\t239\n99\t100\t240\t240\n99\t100\t241\t241\n99\t100\t242\t242\n99\t100\t243\t243\n99\t100\t244\t244\n99\t100\t245\t245\n99\t100\t246\t246\n99\t100\t247\t247\n99\t100\t248\t248\n99\t100\t249\t249\n99\t100\t250\t250\n99\t100\t251\t251\n99\t100\t252\t252\n99\t100\t253\t253\n99\t100\t254\t254\n99\t100\t255\t255\n100\n",
+	"^SSH\\-2\\.0\\C*$":       "0\t1\t83\t83\n1\t2\t83\t83\n2\t3\t72\t72\n3\t4\t45\t45\n4\t5\t50\t50\n5\t6\t46\t46\n6\t7\t48\t48\n7\t7\t0\t0\n7\t7\t1\t1\n7\t7\t2\t2\n7\t7\t3\t3\n7\t7\t4\t4\n7\t7\t5\t5\n7\t7\t6\t6\n7\t7\t7\t7\n7\t7\t8\t8\n7\t7\t9\t9\n7\t7\t10\t10\n7\t7\t11\t11\n7\t7\t12\t12\n7\t7\t13\t13\n7\t7\t14\t14\n7\t7\t15\t15\n7\t7\t16\t16\n7\t7\t17\t17\n7\t7\t18\t18\n7\t7\t19\t19\n7\t7\t20\t20\n7\t7\t21\t21\n7\t7\t22\t22\n7\t7\t23\t23\n7\t7\t24\t24\n7\t7\t25\t25\n7\t7\t26\t26\n7\t7\t27\t27\n7\t7\t28\t28\n7\t7\t29\t29\n7\t7\t30\t30\n7\t7\t31\t31\n7\t7\t32\t32\n7\t7\t33\t33\n7\t7\t34\t34\n7\t7\t35\t35\n7\t7\t36\t36\n7\t7\t37\t37\n7\t7\t38\t38\n7\t7\t39\t39\n7\t7\t40\t40\n7\t7\t41\t41\n7\t7\t42\t42\n7\t7\t43\t43\n7\t7\t44\t44\n7\t7\t45\t45\n7\t7\t46\t46\n7\t7\t47\t47\n7\t7\t48\t48\n7\t7\t49\t49\n7\t7\t50\t50\n7\t7\t51\t51\n7\t7\t52\t52\n7\t7\t53\t53\n7\t7\t54\t54\n7\t7\t55\t55\n7\t7\t56\t56\n7\t7\t57\t57\n7\t7\t58\t58\n7\t7\t59\t59\n7\t7\t60\t60\n7\t7\t61\t61\n7\t7\t62\t62\n7\t7\t63\t63\n7\t7\t64\t64\n7\t7\t65\t65\n7\t7\t66\t66\n7\t7\t67\t67\n7\t7\t68\t68\n7\t7\t69\t69\n7\t7\t70\t70\n7\t7\t71\t71\n7\t7\t72\t72\n7\t7\t73\t73\n7\t7\t74\t74\n7\t7\t75\t75\n7\t7\t76\t76\n7\t7\t77\t77\n7\t7\t78\t78\n7\t7\t79\t79\n7\t7\t80\t80\n7\t7\t81\t81\n7\t7\t82\t82\n7\t7\t83\t83\n7\t7\t84\t84\n7\t7\t85\t85\n7\t7\t86\t86\n7\t7\t87\t87\n7\t7\t88\t88\n7\t7\t89\t89\n7\t7\t90\t90\n7\t7\t91\t91\n7\t7\t92\t92\n7\t7\t93\t93\n7\t7\t94\t94\n7\t7\t95\t95\n7\t7\t96\t96\n7\t7\t97\t97\n7\t7\t98\t98\n7\t7\t99\t99\n7\t7\t100\t100\n7\t7\t101\t101\n7\t7\t102\t102\n7\t7\t103\t103\n7\t7\t104\t104\n7\t7\t105\t105\n7\t7\t106\t106\n7\t7\t107\t107\n7\t7\t108\t108\n7\t7\t109\t109\n7\t7\t110\t110\n7\t7\t111\t111\n7\t7\t112\t112\n7\t7\t113\t113\n7\t7\t114\t114\n7\t7\t115\t115\n7\t7\t116\t116\n7\t7\t117\t117\n7\t7\t118\t118\n7\t7\t119\t119\n7\t7\t120\t120\n7\t7\t121\t121\n7\t7\t122\t122\n7\t7\t123\t123\n7\t7\t124\t124\n7\t7\t125\t125\n7\t7\t126\t126\n7\t7\t127\t127\n7\t7\t128\t128\n7\t7\t129\t129\n7\t7\t130\t130\n7\t7\t131\t131\n7\t7\t132\t132\n7\t7\t133\t133\n7\t7\t134\t134\n7\t7\t135\t135\n7\t7\t136\t136\n7\t7\t137\t137\n7\t7\t138\t138\n7\t7\t139\t139\n7\t7\t140\t140\n7\t7\t141\t141\n7\t7\t142\t142\n7\t7\t143\t143\n7\t7\t144\t144\n7\t7\t145\t145\n7\t7\t146\t146\n7\t7\t147\t147\n7\t7\t148\t148\n7\t7\t149\t149\n7\t7\t150\t150\n7\t7\t151\t151\n7\t7\t152\t152\n7\t7\t153\t153\n7\t7\t154\t154\n7\t7\t155\t155\n7\t7\t156\t156\n7\t7\t157\t157\n7\t7\t158\t158\n7\t7\t159\t159\n7\t7\t160\t160\n7\t7\t161\t161\n7\t7\t162\t162\n7\t7\t163\t163\n7\t7\t164\t164\n7\t7\t165\t165\n7\t7\t166\t166\n7\t7\t167\t167\n7\t7\t168\t168\n7\t7\t169\t169\n7\t7\t170\t170\n7\t7\t171\t171\n7\t7\t172\t172\n7\t7\t173\t173\n7\t7\t174\t174\n7\t7\t175\t175\n7\t7\t176\t176\n7\t7\t177\t177\n7\t7\t178\t178\n7\t7\t179\t179\n7\t7\t180\t180\n7\t7\t181\t181\n7\t7\t182\t182\n7\t7\t183\t183\n7\t7\t184\t184\n7\t7\t185\t185\n7\t7\t186\t186\n7\t7\t187\t187\n7\t7\t188\t188\n7\t7\t189\t189\n7\t7\t190\t190\n7\t7\t191\t191\n7\t7\t192\t192\n7\t7\t193\t193\n7\t7\t194\t194\n7\t7\t195\t195\n7\t7\t196\t196\n7\t7\t197\t197\n7\t7\t198\t198\n7\t7\t199\t199\n7\t7\t200\t200\n7\t7\t201\t201\n7\t7\t202\t202\n7\t7\t203\t203\n7\t7\t204\t204\n7\t7\t205\t205\n7\t7\t206\t206\n7\t7\t207\t207\n7\t7\t208\t208\n7\t7\t209\t209\n7\t7\t210\t210\n7\t7\t211\t211\n7\t7\t212\t212\n7\t7\t213\t213\n7\t7\t214\t214\n7\t7\t215\t215\n7\t7\t216\t216\n7\t7\t217\t217\n7\t7\t218\t218\n7\t7\t219\t219\n7\t7\t220\t220\n7\t7\t221\t221\n7\t7\t222\t222\n7\t7\t223\t223\n7\t7\t224\t224\n7\t7\t225\t225\n7\t7\t226\t226\n7\t7\t227\t227\n7\t7\t228\t228\n7\t7\t229\t229\n7\t7\t230\t230\n7\t7\t231\t231\n7\t7\t232\t232\n7\t7\t233\t233\n7\t7\t234\t234\n7\t7\t235\t235\n7\t7\t236\t236\n7\t7\t237\t237\n7\t7\t238\t238\n7\t7\t239\t239\n7\t7\t240\t240\n7\t7\t241\t241\n7\t7\t242\t242\n7\t7\t243\t243\n7\t7\t244\t244\n7\t7\t245\t245\n7\t7\t246\t246\n7\t7\t247\t247\n7\t7\t248\t248\n7\t7\t249\t249\n7\t7\t250\t250\n7\t7\t251\t251\n7\t7\t252\t252\n7\t7\t253\t253\n7\t7\t254\t254\n7\t7\t255\t255\n7\n",
+	"^TESTING FROM CLIENT.*$": "0\t1\t84\t84\n1\t2\t69\t69\n2\t3\t83\t83\n3\t4\t84\t84\n4\t5\t73\t73\n5\t6\t78\t78\n6\t7\t71\t71\n7\t8\t32\t32\n8\t9\t70\t70\n9\t10\t82\t82\n10\t11\t79\t79\n11\t12\t77\t77\n12\t13\t32\t32\n13\t14\t67\t67\n14\t15\t76\t76\n15\t16\t73\t73\n16\t17\t69\t69\n17\t18\t78\t78\n18\t19\t84\t84\n19\t19\t0\t0\n19\t19\t1\t1\n19\t19\t2\t2\n19\t19\t3\t3\n19\t19\t4\t4\n19\t19\t5\t5\n19\t19\t6\t6\n19\t19\t7\t7\n19\t19\t8\t8\n19\t19\t9\t9\n19\t19\t10\t10\n19\t19\t11\t11\n19\t19\t12\t12\n19\t19\t13\t13\n19\t19\t14\t14\n19\t19\t15\t15\n19\t19\t16\t16\n19\t19\t17\t17\n19\t19\t18\t18\n19\t19\t19\t19\n19\t19\t20\t20\n19\t19\t21\t21\n19\t19\t22\t22\n19\t19\t23\t23\n19\t19\t24\t24\n19\t19\t25\t25\n19\t19\t26\t26\n19\t19\t27\t27\n19\t19\t28\t28\n19\t19\t29\t29\n19\t19\t30\t30\n19\t19\t31\t31\n19\t19\t32\t32\n19\t19\t33\t33\n19\t19\t34\t34\n19\t19\t35\t35\n19\t19\t36\t36\n19\t19\t37\t37\n19\t19\t38\t38\n19\t19\t39\t39\n19\t19\t40\t40\n19\t19\t41\t41\n19\t19\t42\t42\n19\t19\t43\t43\n19\t19\t44\t44\n19\t19\t45\t45\n19\t19\t46\t46\n19\t19\t47\t47\n19\t19\t48\t48\n19\t19\t49\t49\n19\t19\t50\t50\n19\t19\t51\t51\n19\t19\t52\t52\n19\t19\t53\t53\n19\t19\t54\t54\n19\t19\t55\t55\n19\t19\t56\t56\n19\t19\t57\t57\n19\t19\t58\t58\n19\t19\t59\t59\n19\t19\t60\t60\n19\t19\t61\t61\n19\t19\t62\t62\n19\t19\t63\t63\n19\t19\t64\t64\n19\t19\t65\t65\n19\t19\t66\t66\n19\t19\t67\t67\n19\t19\t68\t68\n19\t19\t69\t69\n19\t19\t70\t70\n19\t19\t71\t71\n19\t19\t72\t72\n19\t19\t73\t73\n19\t19\t74\t74\n19\t19\t75\t75\n19\t19\t76\t76\n19\t19\t77\t77\n19\t19\t78\t78\n19\t19\t79\t79\n19\t19\t80\t80\n19\t19\t81\t81\n19\t19\t82\t82\n19\t19\t83\t83\n19\t19\t84\t84\n19\t19\t85\t85\n19\t19\t86\t86\n19\t19\t87\t87\n19\t19\t88\t88\n19\t19\t89\t89\n19\t19\t90\t90\n19\t19\t91\t91\n19\t19\t92\t92\n19\t19\t93\t93\n19\t19\t94\t94\n19\t19\t95\t95\n19\t19\t96\t96\n19\t19\t97\t97\n19\t19\t98\t98\n19\t19\t99\t99\n19\t19\t100\t100\n19\t19\t101\t101\n19\t19\t102\t102\n19\t19\t103\t103\n19\t19\t104\t104\n19\t19\t105\t105\n19\t19\t106\t106\n19\t19\t107\t107\n19\t19\t108\t108\n19\t19\t109\t109\n19\t19\t110\t110\n19\t19\t111\t111\n19\t19\t112\t112\n19\t19\t113\t113\n19\t19\t114\t114\n19\t19\t115\t115\n19\t19\t116\t116\n19\t19\t117\t117\n19\t19\t118\t118\n19\t19\t119\t119\n19\t19\t120\t120\n19\t19\t121\t121\n19\t19\t122\t122\n19\t19\t123\t123\n19\t19\t124\t124\n19\t19\t125\t125\n19\t19\t126\t126\n19\t19\t127\t127\n19\t19\t128\t128\n19\t19\t129\t129\n19\t19\t130\t130\n19\t19\t131\t131\n19\t19\t132\t132\n19\t19\t133\t133\n19\t19\t134\t134\n19\t19\t135\t135\n19\t19\t136\t136\n19\t19\t137\t137\n19\t19\t138\t138\n19\t19\t139\t139\n19\t19\t140\t140\n19\t19\t141\t141\n19\t19\t142\t142\n19\t19\t143\t143\n19\t19\t144\t144\n19\t19\t145\t145\n19\t19\t146\t146\n19\t19\t147\t147\n19\t19\t148\t148\n19\t19\t149\t149\n19\t19\t150\t150\n19\t19\t151\t151\n19\t19\t152\t152\n19\t19\t153\t153\n19\t19\t154\t154\n19\t19\t155\t155\n19\t19\t156\t156\n19\t19\t157\t157\n19\t19\t158\t158\n19\t19\t159\t159\n19\t19\t160\t160\n19\t19\t161\t161\n19\t19\t162\t162\n19\t19\t163\t163\n19\t19\t164\t164\n19\t19\t165\t165\n19\t19\t166\t166\n19\t19\t167\t167\n19\t19\t168\t168\n19\t19\t169\t169\n19\t19\t170\t170\n19\t19\t171\t171\n19\t19\t172\t172\n19\t19\t173\t173\n19\t19\t174\t174\n19\t19\t175\t175\n19\t19\t176\t176\n19\t19\t177\t177\n19\t19\t178\t178\n19\t19\t179\t179\n19\t19\t180\t180\n19\t19\t181\t181\n19\t19\t182\t182\n19\t19\t183\t183\n19\t19\t184\t184\n19\t19\t185\t185\n19\t19\t186\t186\n19\t19\t187\t187\n19\t19\t188\t188\n19\t19\t189\t189\n19\t19\t190\t190\n19\t19\t191\t191\n19\t19\t192\t192\n19\t19\t193\t193\n19\t19\t194\t194\n19\t19\t195\t195\n19\t19\t196\t196\n19\t19\t197\t197\n19\t19\t198\t198\n19\t19\t199\t199\n19\t19\t200\t200\n19\t19\t201\t201\n19\t19\t202\t202\n19\t19\t203\t203\n19\t19\t204\t204\n19\t19\t205\t205\n19\t19\t206\t206\n19\t19\t207\t207\n19\t19\t208\t208\n19\t19\t209\t209\n19\t19\t210\t210\n19\t19\t211\t211\n19\t19\t212\t212\n19\t19\t213\t213\n19\t19\t214\t214\n19\t19\t215\t215\n19\t19\t216\t216\n19\t19\t217\t217\n19\t19\t218\t218\n19\t19\t219\t219\n19\t19\t220\t220\n19\t19\t221\t221\n19\t19\t222\t222\n19\t19\t223\t223\n19\t19\t224\t224\n19\t19\t225\t225\n19\t19\t226\t226\n19\t19\t227\t227\n19\t19\t228\t228\n19\t19\t229\t229\n19\t19\t230\t230\n19\t19\t231\t231\n19\t19\t232\t232\n19\t19\t233\t233\n19\t19\t234\t234\n19\t19\t235\t235\n19\t19\t236\t236\n19\t19\t237\t237\n19\t19\t238\t238\n19\t19\t239\t239\n19\t19\t240\t240\n19\t19\t241\t241\n19\t19\t242\t242\n19\t19\t243\t243\n19\t19\t244\t244\n19\t19\t245\t245\n19\t19\t246\t246\n19\t19\t247\t247\n19\t19\t248\t248\n19\t19\t249\t249\n19\t19\t250\t250\n19\t19\t251\t251\n19\t19\t252\t252\n19\t19\t253\t253\n19\t19\t254\t254\n19\t19\t255\t255\n19\n",
+	"^TESTING FROM SERVER.*$": "0\t1\t84\t84\n1\t2\t69\t69\n2\t3\t83\t83\n3\t4\t84\t84\n4\t5\t73\t73\n5\t6\t78\t78\n6\t7\t71\t71\n7\t8\t32\t32\n8\t9\t70\t70\n9\t10\t82\t82\n10\t11\t79\t79\n11\t12\t77\t77\n12\t13\t32\t32\n13\t14\t83\t83\n14\t15\t69\t69\n15\t16\t82\t82\n16\t17\t86\t86\n17\t18\t69\t69\n18\t19\t82\t82\n19\t19\t0\t0\n19\t19\t1\t1\n19\t19\t2\t2\n19\t19\t3\t3\n19\t19\t4\t4\n19\t19\t5\t5\n19\t19\t6\t6\n19\t19\t7\t7\n19\t19\t8\t8\n19\t19\t9\t9\n19\t19\t10\t10\n19\t19\t11\t11\n19\t19\t12\t12\n19\t19\t13\t13\n19\t19\t14\t14\n19\t19\t15\t15\n19\t19\t16\t16\n19\t19\t17\t17\n19\t19\t18\t18\n19\t19\t19\t19\n19\t19\t20\t20\n19\t19\t21\t21\n19\t19\t22\t22\n19\t19\t23\t23\n19\t19\t24\t24\n19\t19\t25\t25\n19\t19\t26\t26\n19\t19\t27\t27\n19\t19\t28\t28\n19\t19\t29\t29\n19\t19\t30\t30\n19\t19\t31\t31\n19\t19\t32\t32\n19\t19\t33\t33\n19\t19\t34\t34\n19\t19\t35\t35\n19\t19\t36\t36\n19\t19\t37\t37\n19\t19\t38\t38\n19\t19\t39\t39\n19\t19\t40\t40\n19\t19\t41\t41\n19\t19\t42\t42\n19\t19\t43\t43\n19\t19\t44\t44\n19\t19\t45\t45\n19\t19\t46\t46\n19\t19\t47\t47\n19\t19\t48\t48\n19\t19\t49\t49\n19\t19\t50\t50\n19\t19\t51\t51\n19\t19\t52\t52\n19\t19\t53\t53\n19\t19\t54\t54\n19\t19\t55\t55\n19\t19\t56\t56\n19\t19\t57\t57\n19\t19\t58\t58\n19\t19\t59\t59\n19\t19\t60\t60\n19\t19\t61\t61\n19\t19\t62\t62\n19\t19\t63\t63\n19\t19\t64\t64\n19\t19\t65\t65\n19\t19\t66\t66\n19\t19\t67\t67\n19\t19\t68\t68\n19\t19\t69\t69\n19\t19\t70\t70\n19\t19\t71\t71\n19\t19\t72\t72\n19\t19\t73\t73\n19\t19\t74\t74\n19\t19\t75\t75\n19\t19\t76\t76\n19\t19\t77\t77\n19\t19\t78\t78\n19\t19\t79\t79\n19\t19\t80\t80\n19\t19\t81\t81\n19\t19\t82\t82\n19\t19\t83\t83\n19\t19\t84\t84\n19\t19\t85\t85\n19\t19\t86\t86\n19\t19\t87\t87\n19\t19\t88\t88\n19\t19\t89\t89\n19\t19\t90\t90\n19\t19\t91\t91\n19\t19\t92\t92\n19\t19\t93\t93\n19\t19\t94\t94\n19\t19\t95\t95\n19\t19\t96\t96\n19\t19\t97\t97\n19\t19\t98\t98\n19\t19\t99\t99\n19\t19\t100\t100\n19\t19\t101\t101\n19\t19\t102\t102\n19\t19\t103\t103\n19\t19\t104\t104\n19\t19\t105\t105\n19\t19\t106\t106\n19\t19\t107\t107\n19\t19\t108\t108\n19\t19\t109\t109\n19\t19\t110\t110\n19\t19\t111\t111\n19\t19\t112\t112\n19\t19\t113\t113\n19\t19\t114\t114\n19\t19\t115\t115\n19\t19\t116\t116\n19\t19\t117\t117\n19\t19\t118\t118\n19\t19\t119\t119\n19\t19\t120\t120\n19\t19\t121\t121\n19\t19\t122\t122\n19\t19\t123\t123\n19\t19\t124\t124\n19\t19\t125\t125\n19\t19\t126\t126\n19\t19\t127\t127\n19\t19\t128\t128\n19\t19\t129\t129\n19\t19\t130\t130\n19\t19\t131\t131\n19\t19\t132\t132\n19\t19\t133\t133\n19\t19\t134\t134\n19\t19\t135\t135\n19\t19\t136\t136\n19\t19\t137\t137\n19\t19\t138\t138\n19\t19\t139\t139\n19\t19\t140\t140\n19\t19\t141\t141\n19\t19\t142\t142\n19\t19\t143\t143\n19\t19\t144\t144\n19\t19\t145\t145\n19\t19\t146\t146\n19\t19\t147\t147\n19\t19\t148\t148\n19\t19\t149\t149\n19\t19\t150\t150\n19\t19\t151\t151\n19\t19\t152\t152\n19\t19\t153\t153\n19\t19\t154\t154\n19\t19\t155\t155\n19\t19\t156\t156\n19\t19\t157\t157\n19\t19\t158\t158\n19\t19\t159\t159\n19\t19\t160\t160\n19\t19\t161\t161\n19\t19\t162\t162\n19\t19\t163\t163\n19\t19\t164\t164\n19\t19\t165\t165\n19\t19\t166\t166\n19\t19\t167\t167\n19\t19\t168\t168\n19\t19\t169\t169\n19\t19\t170\t170\n19\t19\t171\t171\n19\t19\t172\t172\n19\t19\t173\t173\n19\t19\t174\t174\n19\t19\t175\t175\n19\t19\t176\t176\n19\t19\t177\t177\n19\t19\t178\t178\n19\t19\t179\t179\n19\t19\t180\t180\n19\t19\t181\t181\n19\t19\t182\t182\n19\t19\t183\t183\n19\t19\t184\t184\n19\t19\t185\t185\n19\t19\t186\t186\n19\t19\t187\t187\n19\t19\t188\t188\n19\t19\t189\t189\n19\t19\t190\t190\n19\t19\t191\t191\n19\t19\t192\t192\n19\t19\t193\t193\n19\t19\t194\t194\n19\t19\t195\t195\n19\t19\t196\t196\n19\t19\t197\t197\n19\t19\t198\t198\n19\t19\t199\t199\n19\t19\t200\t200\n19\t19\t201\t201\n19\t19\t202\t202\n19\t19\t203\t203\n19\t19\t204\t204\n19\t19\t205\t205\n19\t19\t206\t206\n19\t19\t207\t207\n19\t19\t208\t208\n19\t19\t209\t209\n19\t19\t210\t210\n19\t19\t211\t211\n19\t19\t212\t212\n19\t19\t213\t213\n19\t19\t214\t214\n19\t19\t215\t215\n19\t19\t216\t216\n19\t19\t217\t217\n19\t19\t218\t218\n19\t19\t219\t219\n19\t19\t220\t220\n19\t19\t221\t221\n19\t19\t222\t222\n19\t19\t223\t223\n19\t19\t224\t224\n19\t19\t225\t225\n19\t19\t226\t226\n19\t19\t227\t227\n19\t19\t228\t228\n19\t19\t229\t229\n19\t19\t230\t230\n19\t19\t231\t231\n19\t19\t232\t232\n19\t19\t233\t233\n19\t19\t234\t234\n19\t19\t235\t235\n19\t19\t236\t236\n19\t19\t237\t237\n19\t19\t238\t238\n19\t19\t239\t239\n19\t19\t240\t240\n19\t19\t241\t241\n19\t19\t242\t242\n19\t19\t243\t243\n19\t19\t244\t244\n19\t19\t245\t245\n19\t19\t246\t246\n19\t19\t247\t247\n19\t19\t248\t248\n19\t19\t249\t249\n19\t19\t250\t250\n19\t19\t251\t251\n19\t19\t252\t252\n19\t19\t253\t253\n19\t19\t254\t254\n19\t19\t255\t255\n19\n",
+	"^\\C+$":                  "0\t1\t0\t0\n0\t1\t1\t1\n0\t1\t2\t2\n0\t1\t3\t3\n0\t1\t4\t4\n0\t1\t5\t5\n0\t1\t6\t6\n0\t1\t7\t7\n0\t1\t8\t8\n0\t1\t9\t9\n0\t1\t10\t10\n0\t1\t11\t11\n0\t1\t12\t12\n0\t1\t13\t13\n0\t1\t14\t14\n0\t1\t15\t15\n0\t1\t16\t16\n0\t1\t17\t17\n0\t1\t18\t18\n0\t1\t19\t19\n0\t1\t20\t20\n0\t1\t21\t21\n0\t1\t22\t22\n0\t1\t23\t23\n0\t1\t24\t24\n0\t1\t25\t25\n0\t1\t26\t26\n0\t1\t27\t27\n0\t1\t28\t28\n0\t1\t29\t29\n0\t1\t30\t30\n0\t1\t31\t31\n0\t1\t32\t32\n0\t1\t33\t33\n0\t1\t34\t34\n0\t1\t35\t35\n0\t1\t36\t36\n0\t1\t37\t37\n0\t1\t38\t38\n0\t1\t39\t39\n0\t1\t40\t40\n0\t1\t41\t41\n0\t1\t42\t42\n0\t1\t43\t43\n0\t1\t44\t44\n0\t1\t45\t45\n0\t1\t46\t46\n0\t1\t47\t47\n0\t1\t48\t48\n0\t1\t49\t49\n0\t1\t50\t50\n0\t1\t51\t51\n0\t1\t52\t52\n0\t1\t53\t53\n0\t1\t54\t54\n0\t1\t55\t55\n0\t1\t56\t56\n0\t1\t57\t57\n0\t1\t58\t58\n0\t1\t59\t59\n0\t1\t60\t60\n0\t1\t61\t61\n0\t1\t62\t62\n0\t1\t63\t63\n0\t1\t64\t64\n0\t1\t65\t65\n0\t1\t66\t66\n0\t1\t67\t67\n0\t1\t68\t68\n0\t1\t69\t69\n0\t1\t70\t70\n0\t1\t71\t71\n0\t1\t72\t72\n0\t1\t73\t73\n0\t1\t74\t74\n0\t1\t75\t75\n0\t1\t76\t76\n0\t1\t77\t77\n0\t1\t78\t78\n0\t1\t79\t79\n0\t1\t80\t80\n0\t1\t81\t81\n0\t1\t82\t82\n0\t1\t83\t83\n0\t1\t84\t84\n0\t1\t85\t85\n0\t1\t86\t86\n0\t1\t87\t87\n0\t1\t88\t88\n0\t1\t89\t89\n0\t1\t90\t90\n0\t1\t91\t91\n0\t1\t92\t92\n0\t1\t93\t93\n0\t1\t94\t94\n0\t1\t95\t95\n0\t1\t96\t96\n0\t1\t97\t97\n0\t1\t98\t98\n0\t1\t99\t99\n0\t1\t100\t100\n0\t1\t101\t101\n0\t1\t102\t102\n0\t1\t103\t103\n0\t1\t104\t104\n0\t1\t105\t105\n0\t1\t106\t106\n0\t1\t107\t107\n0\t1\t108\t108\n0\t1\t109\t109\n0\t1\t110\t110\n0\t1\t111\t111\n0\t1\t112\t112\n0\t1\t113\t113\n0\t1\t114\t114\n0\t1\t115\t115\n0\t1\t116\t116\n0\t1\t117\t117\n0\t1\t118\t118\n0\t1\t119\t119\n0\t1\t120\t120\n0\t1\t121\t121\n0\t1\t122\t122\n0\t1\t123\t123\n0\t1\t124\t124\n0\t1\t125\t125\n0\t1\t126\t126\n0\t1\t127\t127\n0\t1\t128\t128\n0\t1\t129\t129\n0\t1\t130\t130\n0\t1\t131\t131\n0\t1\t132\t132\n0\t1\t133\t133\n0\t1\t134\t134\n0\t1\t135\t135\n0\t1\t136\t136\n0\t1\t137\t137\n0\t1\t138\t138\n0\t1\t139\t139\n0\t1\t140\t140\n0\t1\t141\t141\n0\t1\t142\t142\n0\t1\t143\t143\n0\t1\t144\t144\n0\t1\t145\t145\n0\t1\t146\t146\n0\t1\t147\t147\n0\t1\t148\t148\n0\t1\t149\t149\n0\t1\t150\t150\n0\t1\t151\t151\n0\t1\t152\t152\n0\t1\t153\t153\n0\t1\t154\t154\n0\t1\t155\t155\n0\t1\t156\t156\n0\t1\t157\t157\n0\t1\t158\t158\n0\t1\t159\t159\n0\t1\t160\t160\n0\t1\t161\t161\n0\t1\t162\t162\n0\t1\t163\t163\n0\t1\t164\t164\n0\t1\t165\t165\n0\t1\t166\t166\n0\t1\t167\t167\n0\t1\t168\t168\n0\t1\t169\t169\n0\t1\t170\t170\n0\t1\t171\t171\n0\t1\t172\t172\n0\t1\t173\t173\n0\t1\t174\t174\n0\t1\t175\t175\n0\t1\t176\t176\n0\t1\t177\t177\n0\t1\t178\t178\n0\t1\t179\t179\n0\t1\t180\t180\n0\t1\t181\t181\n0\t1\t182\t182\n0\t1\t183\t183\n0\t1\t184\t184\n0\t1\t185\t185\n0\t1\t186\t186\n0\t1\t187\t187\n0\t1\t188\t188\n0\t1\t189\t189\n0\t1\t190\t190\n0\t1\t191\t191\n0\t1\t192\t192\n0\t1\t193\t193\n0\t1\t194\t194\n0\t1\t195\t195\n0\t1\t196\t196\n0\t1\t197\t197\n0\t1\t198\t198\n0\t1\t199\t199\n0\t1\t200\t200\n0\t1\t201\t201\n0\t1\t202\t202\n0\t1\t203\t203\n0\t1\t204\t204\n0\t1\t205\t205\n0\t1\t206\t206\n0\t1\t207\t207\n0\t1\t208\t208\n0\t1\t209\t209\n0\t1\t210\t210\n0\t1\t211\t211\n0\t1\t212\t212\n0\t1\t213\t213\n0\t1\t214\t214\n0\t1\t215\t215\n0\t1\t216\t216\n0\t1\t217\t217\n0\t1\t218\t218\n0\t1\t219\t219\n0\t1\t220\t220\n0\t1\t221\t221\n0\t1\t222\t222\n0\t1\t223\t223\n0\t1\t224\t224\n0\t1\t225\t225\n0\t1\t226\t226\n0\t1\t227\t227\n0\t1\t228\t228\n0\t1\t229\t229\n0\t1\t230\t230\n0\t1\t231\t231\n0\t1\t232\t232\n0\t1\t233\t233\n0\t1\t234\t234\n0\t1\t235\t235\n0\t1\t236\t236\n0\t1\t237\t237\n0\t1\t238\t238\n0\t1\t239\t239\n0\t1\t240\t240\n0\t1\t241\t241\n0\t1\t242\t242\n0\t1\t243\t243\n0\t1\t244\t244\n0\t1\t245\t245\n0\t1\t246\t246\n0\t1\t247\t247\n0\t1\t248\t248\n0\t1\t249\t249\n0\t1\t250\t250\n0\t1\t251\t251\n0\t1\t252\t252\n0\t1\t253\t253\n0\t1\t254\t254\n0\t1\t255\t255\n1\t1\t0\t0\n1\t1\t1\t1\n1\t1\t2\t2\n1\t1\t3\t3\n1\t1\t4\t4\n1\t1\t5\t5\n1\t1\t6\t6\n1\t1\t7\t7\n1\t1\t8\t8\n1\t1\t9\t9\n1\t1\t10\t10\n1\t1\t11\t11\n1\t1\t12\t12\n1\t1\t13\t13\n1\t1\t14\t14\n1\t1\t15\t15\n1\t1\t16\t16\n1\t1\t17\t17\n1\t1\t18\t18\n1\t1\t19\t19\n1\t1\t20\t20\n1\t1\t21\t21\n1\t1\t22\t22\n1\t1\t23\t23\n1\t1\t24\t24\n1\t1\t25\t25\n1\t1\t26\t26\n1\t1\t27\t27\n1\t1\t28\t28\n1\t1\t29\t29\n1\t1\t30\t30\n1\t1\t31\t31\n1\t1\t32\t32\n1\t1\t33\t33\n1\t1\t34\t34\n1\t1\t35\t35\n1\t1\t36\t36\n1\t1\t37\t37\n1\t1\t38\t38\n1\t1\t39\t39\n1\t1\t40\t40\n1\t1\t41\t41\n1\t1\t42\t42\n1\t1\t43\t43\n1\t1\t44\t44\n1\t1\t45\t45\n1\t1\t46\t46\n1\t1\t47\t47\n1\t1\t48\t48\n1\t1\t49\t49\n1\t1\t50\t50\n1\t1\t51\t51\n1\t1\t52\t52\n1\t1\t53\t53\n1\t1\t54\t54\n1\t1\t55\t55\n1\t1\t56\t56\n1\t1\t57\t57\n1\t1\t58\t58\n1\t1\t59\t59\n1\t1\t60\t60\n1\t1\t61\t61\n1\t1\t62\t62\n1\t1\t63\t63\n1\t1\t64\t64\n1\t1\t65\t65\n1\t1\t66\t66\n1\t1\t67\t67\n1\t1\t68\t68\n1\t1\t69\t69\n1\t1\t70\t70\n1\t1\t71\t71\n1\t1\t72\t72\n1\t1\t73\t73\n1\t1\t74\t74\n1\t1\t75\t75\n1\t1\t76\t76\n1\t1\t77\t77\n1\t1\t78\t78\n1\t1\t79\t79\n1\t1\t80\t80\n1\t1\t81\t81\n1\t1\t82\t82\n1\t1\t83\t83\n1\t1\t84\t84\n1\t1\t85\t85\n1\t1\t86\t86\n1\t1\t87\t87\n1\t1\t88\t88\n1\t1\t89\t89\n1\t1\t90\t90\n1\t1\t91\t91\n1\t1\t92\t92\n1\t1\t93\t93\n1\t1\t94\t94\n1\t1\t95\t95\n1\t1\t96\t96\n1\t1\t97\t97\n1\t1\t98\t98\n1\t1\t99\t99\n1\t1\t100\t100\n1\t1\t101\t101\n1\t1\t102\t102\n1\t1\t103\t103\n1\t1\t104\t104\n1\t1\t105\t105\n1\t1\t106\t106\n1\t1\t107\t107\n1\t1\t108\t108\n1\t1\t109\t109\n1\t1\t110\t110\n1\t1\t111\t111\n1\t1\t112\t112\n1\t1\t113\t113\n1\t1\t114\t114\n1\t1\t115\t115\n1\t1\t116\t116\n1\t1\t117\t117\n1\t1\t118\t118\n1\t1\t119\t119\n1\t1\t120\t120\n1\t1\t121\t121\n1\t1\t122\t122\n1\t1\t123\t123\n1\t1\t124\t124\n1\t1\t125\t125\n1\t1\t126\t126\n1\t1\t127\t127\n1\t1\t128\t128\n1\t1\t129\t129\n1\t1\t130\t130\n1\t1\t131\t131\n1\t1\t132\t132\n1\t1\t133\t133\n1\t1\t134\t134\n1\t1\t135\t135\n1\t1\t136\t136\n1\t1\t137\t137\n1\t1\t138\t138\n1\t1\t139\t139\n1\t1\t140\t140\n1\t1\t141\t141\n1\t1\t142\t142\n1\t1\t143\t143\n1\t1\t144\t144\n1\t1\t145\t145\n1\t1\t146\t146\n1\t1\t147\t147\n1\t1\t148\t148\n1\t1\t149\t149\n1\t1\t150\t150\n1\t1\t151\t151\n1\t1\t152\t152\n1\t1\t153\t153\n1\t1\t154\t154\n1\t1\t155\t155\n1\t1\t156\t156\n1\t1\t157\t157\n1\t1\t158\t158\n1\t1\t159\t159\n1\t1\t160\t160\n1\t1\t161\t161\n1\t1\t162\t162\n1\t1\t163\t163\n1\t1\t164\t164\n1\t1\t165\t165\n1\t1\t166\t166\n1\t1\t167\t167\n1\t1\t168\t168\n1\t1\t169\t169\n1\t1\t170\t170\n1\t1\t171\t171\n1\t1\t172\t172\n1\t1\t173\t173\n1\t1\t174\t174\n1\t1\t175\t175\n1\t1\t176\t176\n1\t1\t177\t177\n1\t1\t178\t178\n1\t1\t179\t179\n1\t1\t180\t180\n1\t1\t181\t181\n1\t1\t182\t182\n1\t1\t183\t183\n1\t1\t184\t184\n1\t1\t185\t185\n1\t1\t186\t186\n1\t1\t187\t187\n1\t1\t188\t188\n1\t1\t189\t189\n1\t1\t190\t190\n1\t1\t191\t191\n1\t1\t192\t192\n1\t1\t193\t193\n1\t1\t194\t194\n1\t1\t195\t195\n1\t1\t196\t196\n1\t1\t197\t197\n1\t1\t198\t198\n1\t1\t199\t199\n1\t1\t200\t200\n1\t1\t201\t201\n1\t1\t202\t202\n1\t1\t203\t203\n1\t1\t204\t204\n1\t1\t205\t205\n1\t1\t206\t206\n1\t1\t207\t207\n1\t1\t208\t208\n1\t1\t209\t209\n1\t1\t210\t210\n1\t1\t211\t211\n1\t1\t212\t212\n1\t1\t213\t213\n1\t1\t214\t214\n1\t1\t215\t215\n1\t1\t216\t216\n1\t1\t217\t217\n1\t1\t218\t218\n1\t1\t219\t219\n1\t1\t220\t220\n1\t1\t221\t221\n1\t1\t222\t222\n1\t1\t223\t223\n1\t1\t224\t224\n1\t1\t225\t225\n1\t1\t226\t226\n1\t1\t227\t227\n1\t1\t228\t228\n1\t1\t229\t229\n1\t1\t230\t230\n1\t1\t231\t231\n1\t1\t232\t232\n1\t1\t233\t233\n1\t1\t234\t234\n1\t1\t235\t235\n1\t1\t236\t236\n1\t1\t237\t237\n1\t1\t238\t238\n1\t1\t239\t239\n1\t1\t240\t240\n1\t1\t241\t241\n1\t1\t242\t242\n1\t1\t243\t243\n1\t1\t244\t244\n1\t1\t245\t245\n1\t1\t246\t246\n1\t1\t247\t247\n1\t1\t248\t248\n1\t1\t249\t249\n1\t1\t250\t250\n1\t1\t251\t251\n1\t1\t252\t252\n1\t1\t253\t253\n1\t1\t254\t254\n1\t1\t255\t255\n1\n",
+	"ftp_pasv_transfer":       "0\t1\t102\t102\n1\t2\t116\t116\n2\t3\t112\t112\n3\t4\t95\t95\n4\t5\t112\t112\n5\t6\t97\t97\n6\t7\t115\t115\n7\t8\t118\t118\n8\t9\t95\t95\n9\t10\t116\t116\n10\t11\t114\t114\n11\t12\t97\t97\n12\t13\t110\t110\n13\t14\t115\t115\n14\t15\t102\t102\n15\t16\t101\t101\n16\t17\t114\t114\n17\n",
+	"ta/amzn_conn":            "0\t1\t116\t116\n1\t2\t97\t97\n2\t3\t47\t47\n3\t4\t97\t97\n4\t5\t109\t109\n5\t6\t122\t122\n6\t7\t110\t110\n7\t8\t95\t95\n8\t9\t99\t99\n9\t10\t111\t111\n10\t11\t110\t110\n11\t12\t110\t110\n12\n",
+	"web_conn":                "0\t1\t119\t119\n1\t2\t101\t101\n2\t3\t98\t98\n3\t4\t95\t95\n4\t5\t99\t99\n5\t6\t111\t111\n6\t7\t110\t110\n7\t8\t110\t110\n8\n",
+	"web_conn443":             "0\t1\t119\t119\n1\t2\t101\t101\n2\t3\t98\t98\n3\t4\t95\t95\n4\t5\t99\t99\n5\t6\t111\t111\n6\t7\t110\t110\n7\t8\t110\t110\n8\t9\t52\t52\n9\t10\t52\t52\n10\t11\t51\t51\n11\n",
+}
@@ -0,0 +1,55 @@
+package marionette
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// closeWriter is implemented by connections which support half-close, such
+// as *net.TCPConn and *Stream.
+type closeWriter interface {
+	CloseWrite() error
+}
+
+// closeWrite half-closes conn so its peer reads EOF while data can still be
+// read from conn. If half-close is not supported then conn is closed.
+func closeWrite(conn net.Conn) error {
+	if cw, ok := conn.(closeWriter); ok {
+		return cw.CloseWrite()
+	}
+	return conn.Close()
+}
+
+// relay copies data between a & b until both directions reach EOF. When one
+// side finishes sending, the other side is half-closed so protocols which
+// rely on half-close continue to work. If an error occurs then both sides are
+// closed. If ar is non-nil then it is read instead of a, such as to include
+// data already buffered from a.
+func relay(a net.Conn, ar io.Reader, b net.Conn) {
+	if ar == nil {
+		ar = a
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if _, err := io.Copy(b, ar); err != nil {
+			a.Close()
+			b.Close()
+			return
+		}
+		closeWrite(b)
+	}()
+	go func() {
+		defer wg.Done()
+		if _, err := io.Copy(a, b); err != nil {
+			a.Close()
+			b.Close()
+			return
+		}
+		closeWrite(a)
+	}()
+	wg.Wait()
+}
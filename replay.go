@@ -0,0 +1,167 @@
+package marionette
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ErrReplay is returned by the server when a connection's AUTH cell has been
+// received before, such as when a censor replays a captured client handshake.
+var ErrReplay = errors.New("marionette: replayed handshake")
+
+const (
+	// replayKeySize is the size, in bytes, of a key in a replay cache.
+	replayKeySize = authMACSize
+
+	// replayRecordSize is the size of a key & its expiry in a cache file.
+	replayRecordSize = replayKeySize + 8
+
+	// replayPruneInterval is the minimum time between removals of expired
+	// entries from a replay cache.
+	replayPruneInterval = 1 * time.Minute
+)
+
+// ReplayCache records the AUTH cells received by listeners so that replayed
+// handshakes are rejected. Each is kept until it would no longer be accepted.
+// A cache may be shared by listeners, and persisted to a file so that replays
+// are also rejected after a restart.
+type ReplayCache struct {
+	mu      sync.Mutex
+	entries map[[replayKeySize]byte]time.Time
+	pruned  time.Time
+	file    *os.File
+}
+
+// NewReplayCache returns a new in-memory instance of ReplayCache.
+func NewReplayCache() *ReplayCache {
+	return &ReplayCache{entries: make(map[[replayKeySize]byte]time.Time)}
+}
+
+// OpenReplayCache returns a cache persisted to the file at path, which is
+// created if it does not exist. Expired entries are removed from the file.
+func OpenReplayCache(path string) (*ReplayCache, error) {
+	c := NewReplayCache()
+	if err := c.load(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	} else if err := c.compact(path); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	c.file = f
+	return c, nil
+}
+
+// load reads the unexpired entries of the file at path. A partially written
+// final entry is ignored.
+func (c *ReplayCache) load(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	now := time.Now()
+	var buf [replayRecordSize]byte
+	for {
+		if _, err := io.ReadFull(f, buf[:]); err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		var key [replayKeySize]byte
+		copy(key[:], buf[:replayKeySize])
+		if expires := time.Unix(int64(binary.BigEndian.Uint64(buf[replayKeySize:])), 0); expires.After(now) {
+			c.entries[key] = expires
+		}
+	}
+}
+
+// compact replaces the file at path with the cache's current entries.
+func (c *ReplayCache) compact(path string) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	for key, expires := range c.entries {
+		if _, err := f.Write(encodeReplayRecord(key, expires)); err != nil {
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// Close closes the cache's file, if persisted.
+func (c *ReplayCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// which have not yet been removed.
+func (c *ReplayCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Seen returns true if key has been recorded and has not expired. Otherwise
+// key is recorded until expires.
+func (c *ReplayCache) Seen(key []byte, expires time.Time) (bool, error) {
+	var k [replayKeySize]byte
+	copy(k[:], key)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.pruned) >= replayPruneInterval {
+		for other, t := range c.entries {
+			if !t.After(now) {
+				delete(c.entries, other)
+			}
+		}
+		c.pruned = now
+	}
+
+	if t, ok := c.entries[k]; ok && t.After(now) {
+		return true, nil
+	}
+	c.entries[k] = expires
+
+	if c.file != nil {
+		if _, err := c.file.Write(encodeReplayRecord(k, expires)); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// encodeReplayRecord returns key & its expiry as written to a cache file.
+func encodeReplayRecord(key [replayKeySize]byte, expires time.Time) []byte {
+	buf := make([]byte, replayRecordSize)
+	copy(buf, key[:])
+	binary.BigEndian.PutUint64(buf[replayKeySize:], uint64(expires.Unix()))
+	return buf
+}
@@ -0,0 +1,156 @@
+package marionette_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+func TestReplayCache_Seen(t *testing.T) {
+	c := marionette.NewReplayCache()
+	key := bytes.Repeat([]byte{1}, 32)
+	if replay, err := c.Seen(key, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	} else if replay {
+		t.Fatal("expected new key")
+	}
+	if replay, err := c.Seen(key, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	} else if !replay {
+		t.Fatal("expected replay")
+	}
+
+	// Ensure an expired key is not a replay.
+	other := bytes.Repeat([]byte{2}, 32)
+	for i := 0; i < 2; i++ {
+		if replay, err := c.Seen(other, time.Now().Add(-time.Second)); err != nil {
+			t.Fatal(err)
+		} else if replay {
+			t.Fatal("expected expired key")
+		}
+	}
+}
+
+// Ensure keys are kept across restarts & expired keys are removed.
+func TestOpenReplayCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "marionette-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "replays")
+
+	c, err := marionette.OpenReplayCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := bytes.Repeat([]byte{1}, 32)
+	if _, err := c.Seen(key, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	} else if _, err := c.Seen(bytes.Repeat([]byte{2}, 32), time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	} else if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err = marionette.OpenReplayCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if n := c.Len(); n != 1 {
+		t.Fatalf("unexpected len: %d", n)
+	} else if replay, err := c.Seen(key, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	} else if !replay {
+		t.Fatal("expected replay")
+	}
+}
+
+// Ensure a replayed client first flight is relayed to the decoy.
+func TestListener_Replay(t *testing.T) {
+	decoy := mustEchoServer(t)
+	defer decoy.Close()
+
+	secret := mustAuthSecret(t)
+	ln := mustListen(t, mar.MustParse(marionette.PartyServer, []byte(decoyTestDoc)),
+		marionette.WithAuthSecret(secret),
+		marionette.WithDecoy(decoy.Addr().String(), 0),
+	)
+	defer ln.Close()
+
+	// Record the first message written by the client.
+	var mu sync.Mutex
+	var first []byte
+	dialer := marionette.NewDialer(mar.MustParse(marionette.PartyClient, []byte(decoyTestDoc)), "127.0.0.1", marionette.NewStreamSet())
+	dialer.AuthSecret = secret
+	dialer.DialFunc = func(ctx context.Context, network, address string) (net.Conn, error) {
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			return nil, err
+		}
+		return &recordingConn{Conn: conn, fn: func(b []byte) {
+			mu.Lock()
+			defer mu.Unlock()
+			if first == nil {
+				first = append([]byte(nil), b...)
+			}
+		}}, nil
+	}
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientStream.Close()
+	mustWrite(t, clientStream, []byte("foo"))
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverStream.Close()
+	mustRead(t, serverStream, []byte("foo"))
+
+	mu.Lock()
+	data := first
+	mu.Unlock()
+
+	conn := mustDial(t, ln)
+	defer conn.Close()
+	if _, err := conn.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, len(data))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(buf, data) {
+		t.Fatalf("unexpected data from decoy: %q", buf)
+	}
+}
+
+// recordingConn passes each write to fn.
+type recordingConn struct {
+	net.Conn
+	fn func([]byte)
+}
+
+func (c *recordingConn) Write(b []byte) (int, error) {
+	c.fn(b)
+	return c.Conn.Write(b)
+}
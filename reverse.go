@@ -0,0 +1,143 @@
+package marionette
+
+import (
+	"net"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// ReverseServerProxy exposes services of marionette clients, such as machines
+// behind NAT which dial out to the server, on a public listener. Each accepted
+// connection is relayed to a connected client over a new stream.
+type ReverseServerProxy struct {
+	ln        net.Listener
+	listeners []*Listener
+	wg        sync.WaitGroup
+
+	// Options applied to accepted TCP connections, if set.
+	SocketOptions *SocketOptions
+}
+
+// NewReverseServerProxy returns a new instance of ReverseServerProxy which
+// accepts connections on ln and relays them to clients of the listeners.
+func NewReverseServerProxy(ln net.Listener, listeners ...*Listener) *ReverseServerProxy {
+	return &ReverseServerProxy{
+		ln:        ln,
+		listeners: listeners,
+	}
+}
+
+func (p *ReverseServerProxy) Open() error {
+	p.wg.Add(1)
+	go func() { defer p.wg.Done(); p.run() }()
+
+	return nil
+}
+
+func (p *ReverseServerProxy) Close() error {
+	return nil
+}
+
+func (p *ReverseServerProxy) run() {
+	proxyLogger().Debug("reverse server proxy: listening")
+	defer proxyLogger().Debug("reverse server proxy: closed")
+
+	for {
+		conn, err := p.ln.Accept()
+		if err != nil {
+			proxyLogger().Debug("reverse server proxy: listener error", zap.Error(err))
+			return
+		}
+
+		applySocketOptions(p.SocketOptions, conn)
+
+		p.wg.Add(1)
+		go func() { defer p.wg.Done(); p.handleConn(conn) }()
+	}
+}
+
+func (p *ReverseServerProxy) handleConn(conn net.Conn) {
+	defer conn.Close()
+
+	proxyLogger().Debug("reverse server proxy: connection open")
+	defer proxyLogger().Debug("reverse server proxy: connection closed")
+
+	stream, err := p.dial()
+	if err != nil {
+		proxyLogger().Debug("reverse server proxy: cannot open stream to client", zap.Error(err))
+		return
+	}
+	defer stream.Close()
+
+	// Copy between incoming connection and stream until both sides close.
+	relay(conn, nil, stream)
+}
+
+// dial opens a stream to a client of the first listener with a client connected.
+func (p *ReverseServerProxy) dial() (net.Conn, error) {
+	err := ErrNoClients
+	for _, ln := range p.listeners {
+		var stream net.Conn
+		if stream, err = ln.Dial(); err == nil {
+			return stream, nil
+		}
+	}
+	return nil, err
+}
+
+// ReverseClientProxy connects streams opened by the server through a dialer
+// to a local service. This allows a machine behind NAT to offer the service
+// through a server's ReverseServerProxy. The dialer should not be used to
+// open streams of its own.
+type ReverseClientProxy struct {
+	dialer *Dialer
+	wg     sync.WaitGroup
+
+	// Host and port, or "unix:///path" socket, of the local service.
+	Addr string
+
+	// Options applied to TCP connections to the local service, if set.
+	SocketOptions *SocketOptions
+}
+
+// NewReverseClientProxy returns a new instance of ReverseClientProxy. It must
+// be created before the dialer is opened so that it receives the streams.
+func NewReverseClientProxy(dialer *Dialer) *ReverseClientProxy {
+	p := &ReverseClientProxy{dialer: dialer}
+	dialer.OnNewStream = p.onNewStream
+	return p
+}
+
+func (p *ReverseClientProxy) Open() error {
+	return nil
+}
+
+func (p *ReverseClientProxy) Close() error {
+	return nil
+}
+
+// onNewStream handles a stream opened by the server in a separate goroutine.
+func (p *ReverseClientProxy) onNewStream(stream *Stream) {
+	p.wg.Add(1)
+	go func() { defer p.wg.Done(); p.handleStream(stream) }()
+}
+
+func (p *ReverseClientProxy) handleStream(stream *Stream) {
+	defer stream.Close()
+
+	proxyLogger().Debug("reverse client proxy: stream open")
+	defer proxyLogger().Debug("reverse client proxy: stream closed")
+
+	network, addr := ParseNetworkAddr(p.Addr)
+	conn, err := net.Dial(network, addr)
+	if err != nil {
+		proxyLogger().Debug("reverse client proxy: cannot connect to local service", zap.String("address", p.Addr), zap.Error(err))
+		return
+	}
+	defer conn.Close()
+	applySocketOptions(p.SocketOptions, conn)
+
+	// Copy between stream and local service until both sides close.
+	relay(stream, nil, conn)
+}
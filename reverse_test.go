@@ -0,0 +1,61 @@
+package marionette_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// Ensure streams opened by the server are connected to the local service.
+func TestReverseClientProxy(t *testing.T) {
+	doc := mar.MustParse(marionette.PartyClient, []byte(blockingDoc))
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	var pd pipeDialer
+	defer pd.Close()
+
+	streamSet := marionette.NewStreamSet()
+	dialer := marionette.NewDialer(doc, "127.0.0.1", streamSet)
+	dialer.Dialer = &pd
+	proxy := marionette.NewReverseClientProxy(dialer)
+	proxy.Addr = ln.Addr().String()
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+	if err := proxy.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer proxy.Close()
+
+	// Simulate a stream opened by the server.
+	if err := streamSet.Enqueue(&marionette.Cell{Type: marionette.NORMAL, StreamID: 100, Payload: []byte("foo")}); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	mustRead(t, conn, []byte("foo"))
+
+	// Ensure data from the service is sent back on the stream.
+	mustWrite(t, conn, []byte("bar"))
+	for i := 0; ; i++ {
+		if i > 500 {
+			t.Fatal("expected cell")
+		} else if cell := streamSet.Dequeue(0); cell != nil && string(cell.Payload) == "bar" {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,187 @@
+package marionette
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/redjack/marionette/fte"
+	"github.com/redjack/marionette/mar"
+)
+
+// DefaultSelfTestTimeout is the default time a format's loopback handshake may take.
+const DefaultSelfTestTimeout = 30 * time.Second
+
+// Self-test statuses & the stages at which a format can fail.
+const (
+	SelfTestPassed  = "ok"
+	SelfTestFailed  = "failed"
+	SelfTestSkipped = "skipped"
+
+	SelfTestStageParse     = "parse"
+	SelfTestStageCipher    = "cipher"
+	SelfTestStageHandshake = "handshake"
+)
+
+// selfTestMessage is sent from the client to the server over the loopback.
+var selfTestMessage = []byte("marionette self-test")
+
+// FormatSelfTest is the result of a self-test of a single format. A format
+// is skipped if it needs the network, such as UDP formats or formats which
+// open additional ports, once it has been parsed & its ciphers built.
+type FormatSelfTest struct {
+	Format   string        `json:"format"`
+	Status   string        `json:"status"`
+	Stage    string        `json:"stage,omitempty"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// SelfTestFormats runs SelfTestFormat on each named format, or on every
+// built-in format if names is empty, allowing each DefaultSelfTestTimeout.
+// The plugins used by the formats must be registered, such as by importing
+// the plugins package.
+func SelfTestFormats(ctx context.Context, names []string) []FormatSelfTest {
+	if len(names) == 0 {
+		names = mar.Formats()
+	}
+	results := make([]FormatSelfTest, 0, len(names))
+	for _, name := range names {
+		formatCtx, cancel := context.WithTimeout(ctx, DefaultSelfTestTimeout)
+		results = append(results, SelfTestFormat(formatCtx, name))
+		cancel()
+	}
+	return results
+}
+
+// SelfTestFormat parses both parties of the named format, builds the FTE
+// ciphers its actions use, and sends a message from the client to the
+// server over an in-memory connection. The handshake must complete before
+// ctx is done.
+func SelfTestFormat(ctx context.Context, name string) FormatSelfTest {
+	start := time.Now()
+	result := FormatSelfTest{Format: name, Status: SelfTestPassed}
+	fail := func(stage string, err error) FormatSelfTest {
+		result.Status, result.Stage, result.Error = SelfTestFailed, stage, err.Error()
+		result.Duration = time.Since(start)
+		return result
+	}
+
+	data, err := mar.ReadFormat(name)
+	if err != nil {
+		return fail(SelfTestStageParse, err)
+	}
+	clientDoc, err := mar.Parse(PartyClient, data)
+	if err != nil {
+		return fail(SelfTestStageParse, err)
+	}
+	serverDoc, err := mar.Parse(PartyServer, data)
+	if err != nil {
+		return fail(SelfTestStageParse, err)
+	}
+
+	if err := selfTestCiphers(clientDoc); err != nil {
+		return fail(SelfTestStageCipher, err)
+	}
+
+	if reason := selfTestSkipReason(clientDoc); reason != "" {
+		result.Status, result.Error = SelfTestSkipped, reason
+	} else if err := selfTestHandshake(ctx, clientDoc, serverDoc); err != nil {
+		return fail(SelfTestStageHandshake, err)
+	}
+	result.Duration = time.Since(start)
+	return result
+}
+
+// selfTestCiphers builds the cipher of each distinct regex & message length
+// used by the document's fte actions.
+func selfTestCiphers(doc *mar.Document) error {
+	cache := fte.NewCache()
+	defer cache.Close()
+
+	for _, block := range doc.ActionBlocks {
+		for _, action := range block.Actions {
+			if action.Module != "fte" {
+				continue
+			}
+			args := action.ArgValues()
+			if len(args) < 2 {
+				continue
+			}
+			regex, ok0 := args[0].(string)
+			msgLen, ok1 := args[1].(int)
+			if !ok0 || !ok1 {
+				continue
+			}
+			if _, err := cache.Cipher(regex, msgLen); err != nil {
+				return fmt.Errorf("%s: %s", action.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// selfTestSkipReason returns why the document cannot run over an in-memory
+// connection, if it cannot.
+func selfTestSkipReason(doc *mar.Document) string {
+	if doc.Transport != "tcp" {
+		return fmt.Sprintf("%s transport requires the network", doc.Transport)
+	}
+	for _, block := range doc.ActionBlocks {
+		for _, action := range block.Actions {
+			switch action.Name() {
+			case "model.spawn", "channel.bind":
+				return action.Name() + " requires the network"
+			}
+		}
+	}
+	return ""
+}
+
+// selfTestHandshake connects both parties in memory and waits for the server
+// to receive a message sent by the client on a new stream.
+func selfTestHandshake(ctx context.Context, clientDoc, serverDoc *mar.Document) error {
+	dialer, ln := Pipe(clientDoc, serverDoc)
+	defer ln.Close()
+	if err := dialer.Open(); err != nil {
+		return err
+	}
+	defer dialer.Close()
+
+	errs := make(chan error, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			errs <- err
+			return
+		}
+		defer conn.Close()
+
+		buf := make([]byte, len(selfTestMessage))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			errs <- err
+		} else if string(buf) != string(selfTestMessage) {
+			errs <- errors.New("message corrupted")
+		} else {
+			errs <- nil
+		}
+	}()
+
+	stream, err := dialer.Dial()
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	if _, err := stream.Write(selfTestMessage); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return errors.New("timed out")
+	case err := <-errs:
+		return err
+	}
+}
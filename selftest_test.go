@@ -0,0 +1,31 @@
+package marionette_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestSelfTestFormat(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		result := marionette.SelfTestFormat(context.Background(), "http_simple_blocking:20150701")
+		if result.Status != marionette.SelfTestPassed {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	})
+
+	t.Run("Skipped", func(t *testing.T) {
+		result := marionette.SelfTestFormat(context.Background(), "dns_request:20150701")
+		if result.Status != marionette.SelfTestSkipped {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		result := marionette.SelfTestFormat(context.Background(), "no_such_format")
+		if result.Status != marionette.SelfTestFailed || result.Stage != marionette.SelfTestStageParse {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	})
+}
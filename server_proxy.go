@@ -1,25 +1,46 @@
 package marionette
 
 import (
-	"io"
+	"context"
 	"net"
 	"sync"
 
 	"github.com/armon/go-socks5"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // ServerProxy represents a proxy between a marionette listener and another server.
 type ServerProxy struct {
-	ln *Listener
-	wg sync.WaitGroup
+	ln    *Listener
+	wg    sync.WaitGroup
+	slots chan struct{} // holds a token per proxied stream if MaxStreams is set
 
-	// Host and port to proxy requests to.
+	// Host and port, or "unix:///path" socket, to proxy requests to.
 	// Ignored if a socks5 server is enabled.
 	Addr string
 
 	// Server used for proxying requests.
 	Socks5Server *socks5.Server
+
+	// If true, streams which specify a destination are connected to it.
+	// Otherwise streams with a destination are rejected.
+	AllowDestinations bool
+
+	// Restricts destinations requested by streams, including datagrams, if set.
+	ACL *ACL
+
+	// Options applied to TCP connections to proxied servers, if set.
+	SocketOptions *SocketOptions
+
+	// Maximum number of streams proxied at once. Additional streams are
+	// closed immediately. Zero is unlimited.
+	MaxStreams int
+
+	// PROXY protocol version (ProxyProtocolV1 or ProxyProtocolV2) of the
+	// header sent to Addr with the client's address. Zero sends no header.
+	// Destinations requested by clients never receive a header.
+	ProxyProtocol int
 }
 
 // NewServerProxy returns a new instance of ServerProxy.
@@ -28,6 +49,10 @@ func NewServerProxy(ln *Listener) *ServerProxy {
 }
 
 func (p *ServerProxy) Open() error {
+	if p.MaxStreams > 0 {
+		p.slots = make(chan struct{}, p.MaxStreams)
+	}
+
 	p.wg.Add(1)
 	go func() { defer p.wg.Done(); p.run() }()
 
@@ -39,54 +64,116 @@ func (p *ServerProxy) Close() error {
 }
 
 func (p *ServerProxy) run() {
-	Logger.Debug("server proxy: listening")
-	defer Logger.Debug("server proxy: closed")
+	proxyLogger().Debug("server proxy: listening")
+	defer proxyLogger().Debug("server proxy: closed")
 
 	for {
 		conn, err := p.ln.Accept()
 		if err != nil {
-			Logger.Debug("server proxy: listener error", zap.Error(err))
+			proxyLogger().Debug("server proxy: listener error", zap.Error(err))
 			return
 		}
 
+		if p.slots != nil {
+			select {
+			case p.slots <- struct{}{}:
+			default:
+				proxyLogger().Info("server proxy: stream limit reached, closing stream")
+				conn.Close()
+				continue
+			}
+		}
+
 		p.wg.Add(1)
-		go func() { defer p.wg.Done(); p.handleConn(conn) }()
+		go func() { defer p.wg.Done(); defer p.releaseSlot(); p.handleConn(conn) }()
+	}
+}
+
+// releaseSlot frees a finished stream's slot, if streams are limited.
+func (p *ServerProxy) releaseSlot() {
+	if p.slots != nil {
+		<-p.slots
 	}
 }
 
 func (p *ServerProxy) handleConn(conn net.Conn) {
 	defer conn.Close()
 
-	Logger.Debug("server proxy: connection open")
-	defer Logger.Debug("server proxy: connection closed")
+	proxyLogger().Debug("server proxy: connection open")
+	defer proxyLogger().Debug("server proxy: connection closed")
+
+	// Connect to the stream's destination, if specified.
+	network, dest := "tcp", ""
+	if stream, ok := conn.(*Stream); ok {
+		network, dest = stream.Network(), stream.Destination()
+	}
+	if (dest != "" || network != "tcp") && !p.AllowDestinations {
+		proxyLogger().Debug("server proxy: stream destinations not allowed", zap.String("network", network), zap.String("address", dest))
+		return
+	}
+
+	// Relay datagrams for udp streams.
+	if network == "udp" {
+		pc, err := net.ListenPacket("udp", "")
+		if err != nil {
+			proxyLogger().Debug("server proxy: cannot open udp socket", zap.Error(err))
+			return
+		}
+		relayDatagrams(conn, pc, p.ACL)
+		return
+	}
 
 	// If the proxy address is "socks5" then hand off to socks5 server.
-	if p.Socks5Server != nil {
+	if p.Socks5Server != nil && dest == "" {
 		if err := p.Socks5Server.ServeConn(conn); err != nil {
-			Logger.Debug("server proxy: socks5 error", zap.Error(err))
+			proxyLogger().Debug("server proxy: socks5 error", zap.Error(err))
 		}
 		return
 	}
 
-	// Connect to remote server.
-	proxyConn, err := net.Dial("tcp", p.Addr)
+	// Connect to remote server. Requested destinations must be allowed by the
+	// ACL and are always tcp so clients cannot reach local unix sockets.
+	network, addr := ParseNetworkAddr(p.Addr)
+	if dest != "" {
+		network, addr = "tcp", dest
+		if p.ACL != nil {
+			var err error
+			if addr, err = p.ACL.Resolve(context.Background(), dest); err != nil {
+				proxyLogger().Info("server proxy: destination not allowed", zap.String("address", dest), zap.Error(err))
+				return
+			}
+		}
+	}
+	proxyConn, err := p.dial(conn, network, addr)
 	if err != nil {
-		Logger.Debug("server proxy: cannot connect to remote server", zap.String("address", p.Addr))
+		proxyLogger().Debug("server proxy: cannot connect to remote server", zap.String("address", addr))
 		return
 	}
 	defer proxyConn.Close()
+	applySocketOptions(p.SocketOptions, proxyConn)
+
+	if p.ProxyProtocol != 0 && dest == "" {
+		if err := WriteProxyHeader(proxyConn, p.ProxyProtocol, conn.RemoteAddr(), conn.LocalAddr()); err != nil {
+			proxyLogger().Debug("server proxy: cannot write proxy protocol header", zap.Error(err))
+			return
+		}
+	}
+
+	// Copy between connection and proxy until both sides close.
+	relay(conn, nil, proxyConn)
+}
+
+// dial connects to the proxy address or destination of conn. The connection
+// time is recorded as a span of the stream, if conn is a stream.
+func (p *ServerProxy) dial(conn net.Conn, network, addr string) (net.Conn, error) {
+	ctx := context.Background()
+	if stream, ok := conn.(*Stream); ok && stream.span != nil {
+		ctx = trace.ContextWithSpan(ctx, stream.span)
+	}
+	_, span := tracer.Start(ctx, "marionette.proxy.dial", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrPeerAddr.String(addr)))
 
-	// Copy between connection and proxy until an error occurs.
-	var wg sync.WaitGroup
-	wg.Add(2)
-	go func() {
-		defer wg.Done()
-		io.Copy(proxyConn, conn)
-		proxyConn.Close()
-	}()
-	go func() {
-		defer wg.Done()
-		io.Copy(conn, proxyConn)
-	}()
-	wg.Wait()
+	proxyConn, err := net.Dial(network, addr)
+	endSpan(span, err)
+	return proxyConn, err
 }
@@ -0,0 +1,79 @@
+package marionette
+
+import (
+	"net"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SocketOptions configures TCP connections. Zero values leave the system
+// defaults in place.
+type SocketOptions struct {
+	// Period between TCP keepalive probes. Negative disables keepalives.
+	KeepAlive time.Duration
+
+	// If true, Nagle's algorithm is enabled so small writes are coalesced.
+	// Otherwise TCP_NODELAY is set so writes are sent immediately, which
+	// suits interactive traffic.
+	Nagle bool
+
+	// Sizes of the socket receive & send buffers (SO_RCVBUF & SO_SNDBUF).
+	ReadBuffer  int
+	WriteBuffer int
+
+	// Maximum segment size of sent TCP segments (TCP_MAXSEG), which keeps
+	// packets below the size used by the mimicked protocol. This is a hint
+	// which is ignored on platforms other than Linux.
+	MaxSegmentSize int
+}
+
+// Apply sets the options on conn. Connections which are not TCP, and nil
+// options, are ignored.
+func (o *SocketOptions) Apply(conn net.Conn) error {
+	tc, ok := conn.(*net.TCPConn)
+	if o == nil || !ok {
+		return nil
+	}
+
+	if err := tc.SetNoDelay(!o.Nagle); err != nil {
+		return err
+	}
+
+	if o.KeepAlive < 0 {
+		if err := tc.SetKeepAlive(false); err != nil {
+			return err
+		}
+	} else if o.KeepAlive > 0 {
+		if err := tc.SetKeepAlive(true); err != nil {
+			return err
+		} else if err := tc.SetKeepAlivePeriod(o.KeepAlive); err != nil {
+			return err
+		}
+	}
+
+	if o.ReadBuffer > 0 {
+		if err := tc.SetReadBuffer(o.ReadBuffer); err != nil {
+			return err
+		}
+	}
+	if o.WriteBuffer > 0 {
+		if err := tc.SetWriteBuffer(o.WriteBuffer); err != nil {
+			return err
+		}
+	}
+	if o.MaxSegmentSize > 0 {
+		if err := setMaxSegmentSize(tc, o.MaxSegmentSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applySocketOptions sets o on conn, logging rather than failing on error
+// since the connection remains usable with the system defaults.
+func applySocketOptions(o *SocketOptions, conn net.Conn) {
+	if err := o.Apply(conn); err != nil {
+		Logger.Debug("cannot set socket options", zap.Error(err))
+	}
+}
@@ -0,0 +1,20 @@
+package marionette
+
+import (
+	"net"
+	"syscall"
+)
+
+// setMaxSegmentSize clamps the TCP maximum segment size of conn (TCP_MAXSEG).
+func setMaxSegmentSize(conn *net.TCPConn, mss int) error {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+	if e := rawConn.Control(func(fd uintptr) {
+		err = syscall.SetsockoptInt(int(fd), syscall.IPPROTO_TCP, syscall.TCP_MAXSEG, mss)
+	}); e != nil {
+		return e
+	}
+	return err
+}
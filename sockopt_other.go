@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package marionette
+
+import "net"
+
+// setMaxSegmentSize is only supported on Linux. The hint is ignored elsewhere.
+func setMaxSegmentSize(conn *net.TCPConn, mss int) error {
+	return nil
+}
@@ -0,0 +1,57 @@
+package marionette_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestSocketOptions_Apply(t *testing.T) {
+	t.Run("TCP", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		for _, opt := range []*marionette.SocketOptions{
+			{KeepAlive: 30 * time.Second, ReadBuffer: 1 << 16, WriteBuffer: 1 << 16, MaxSegmentSize: 1200},
+			{KeepAlive: -1, Nagle: true},
+		} {
+			if err := opt.Apply(conn); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
+	// Ensure connections which are not TCP are ignored.
+	t.Run("Pipe", func(t *testing.T) {
+		conn, other := net.Pipe()
+		defer conn.Close()
+		defer other.Close()
+
+		opt := &marionette.SocketOptions{KeepAlive: 30 * time.Second}
+		if err := opt.Apply(conn); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		conn, other := net.Pipe()
+		defer conn.Close()
+		defer other.Close()
+
+		var opt *marionette.SocketOptions
+		if err := opt.Apply(conn); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,332 @@
+package marionette
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// SOCKS5 protocol constants from RFC 1928 & RFC 1929.
+const (
+	socks5Version      = 0x05
+	socks5AuthNone     = 0x00
+	socks5AuthPassword = 0x02
+	socks5AuthNoMethod = 0xFF
+
+	socks5Connect      = 0x01
+	socks5UDPAssociate = 0x03
+
+	socks5AddrIPv4   = 0x01
+	socks5AddrDomain = 0x03
+	socks5AddrIPv6   = 0x04
+
+	socks5ReplySucceeded           = 0x00
+	socks5ReplyGeneralFailure      = 0x01
+	socks5ReplyHostUnreachable     = 0x04
+	socks5ReplyCommandNotSupported = 0x07
+)
+
+// Socks5Handler serves SOCKS5 requests from local applications. CONNECT
+// requests are connected using Dial and UDP ASSOCIATE requests relay
+// datagrams through a connection returned by DialDatagram.
+type Socks5Handler struct {
+	// Usernames & passwords required from clients, if set.
+	Credentials map[string]string
+
+	// Opens a connection to a destination address.
+	Dial func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	// Opens a connection which relays datagrams framed by WriteDatagram().
+	// If nil then UDP ASSOCIATE requests are not supported.
+	DialDatagram func() (net.Conn, error)
+}
+
+// ServeConn handles a single SOCKS5 connection until it is closed.
+func (h *Socks5Handler) ServeConn(conn net.Conn) error {
+	br := bufio.NewReader(conn)
+	if err := h.authenticate(conn, br); err != nil {
+		return err
+	}
+
+	// Read request header & destination address.
+	var hdr [3]byte
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return err
+	} else if hdr[0] != socks5Version {
+		return fmt.Errorf("unsupported socks version: %d", hdr[0])
+	}
+	addr, err := readSocks5Addr(br)
+	if err != nil {
+		writeSocks5Reply(conn, socks5ReplyGeneralFailure, "")
+		return err
+	}
+
+	switch hdr[1] {
+	case socks5Connect:
+		return h.serveConnect(conn, br, addr)
+	case socks5UDPAssociate:
+		if h.DialDatagram == nil {
+			break
+		}
+		return h.serveUDPAssociate(conn, br)
+	}
+
+	writeSocks5Reply(conn, socks5ReplyCommandNotSupported, "")
+	return fmt.Errorf("unsupported socks command: %d", hdr[1])
+}
+
+// authenticate negotiates the authentication method with the client and
+// validates the username & password, if credentials are required.
+func (h *Socks5Handler) authenticate(conn net.Conn, br *bufio.Reader) error {
+	var hdr [2]byte
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return err
+	} else if hdr[0] != socks5Version {
+		return fmt.Errorf("unsupported socks version: %d", hdr[0])
+	}
+	methods := make([]byte, hdr[1])
+	if _, err := io.ReadFull(br, methods); err != nil {
+		return err
+	}
+
+	method := byte(socks5AuthNone)
+	if len(h.Credentials) > 0 {
+		method = socks5AuthPassword
+	}
+	if bytes.IndexByte(methods, method) == -1 {
+		conn.Write([]byte{socks5Version, socks5AuthNoMethod})
+		return errors.New("no acceptable socks authentication method")
+	} else if _, err := conn.Write([]byte{socks5Version, method}); err != nil {
+		return err
+	} else if method == socks5AuthNone {
+		return nil
+	}
+
+	// Read username & password subnegotiation.
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return err
+	}
+	username := make([]byte, hdr[1])
+	if _, err := io.ReadFull(br, username); err != nil {
+		return err
+	} else if _, err := io.ReadFull(br, hdr[:1]); err != nil {
+		return err
+	}
+	password := make([]byte, hdr[0])
+	if _, err := io.ReadFull(br, password); err != nil {
+		return err
+	}
+
+	if expected, ok := h.Credentials[string(username)]; !ok || expected != string(password) {
+		conn.Write([]byte{0x01, 0x01})
+		return errors.New("invalid socks username or password")
+	}
+	_, err := conn.Write([]byte{0x01, 0x00})
+	return err
+}
+
+// serveConnect connects to addr and copies data in both directions until
+// both sides close.
+func (h *Socks5Handler) serveConnect(conn net.Conn, br *bufio.Reader, addr string) error {
+	target, err := h.Dial(context.Background(), "tcp", addr)
+	if err != nil {
+		writeSocks5Reply(conn, socks5ReplyHostUnreachable, "")
+		return err
+	}
+	defer target.Close()
+
+	if err := writeSocks5Reply(conn, socks5ReplySucceeded, ""); err != nil {
+		return err
+	}
+
+	relay(conn, br, target)
+	return nil
+}
+
+// serveUDPAssociate opens a local UDP socket for the client and relays its
+// datagrams until the control connection is closed. Only datagrams from the
+// host of the control connection are accepted. Fragmented datagrams are dropped.
+func (h *Socks5Handler) serveUDPAssociate(conn net.Conn, br *bufio.Reader) error {
+	localHost, _, err := net.SplitHostPort(conn.LocalAddr().String())
+	if err != nil {
+		writeSocks5Reply(conn, socks5ReplyGeneralFailure, "")
+		return err
+	}
+	clientHost, _, err := net.SplitHostPort(conn.RemoteAddr().String())
+	if err != nil {
+		writeSocks5Reply(conn, socks5ReplyGeneralFailure, "")
+		return err
+	}
+
+	pc, err := net.ListenPacket("udp", net.JoinHostPort(localHost, "0"))
+	if err != nil {
+		writeSocks5Reply(conn, socks5ReplyGeneralFailure, "")
+		return err
+	}
+	defer pc.Close()
+
+	stream, err := h.DialDatagram()
+	if err != nil {
+		writeSocks5Reply(conn, socks5ReplyGeneralFailure, "")
+		return err
+	}
+	defer stream.Close()
+
+	if err := writeSocks5Reply(conn, socks5ReplySucceeded, pc.LocalAddr().String()); err != nil {
+		return err
+	}
+
+	// The client's address is learned from its first datagram.
+	var mu sync.Mutex
+	var clientAddr net.Addr
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		buf := make([]byte, MaxDatagramSize+262)
+		for {
+			n, from, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			} else if host, _, _ := net.SplitHostPort(from.String()); host != clientHost {
+				continue
+			}
+
+			// Parse header: reserved (2), fragment (1), address, data.
+			if n < 4 || buf[2] != 0 {
+				continue
+			}
+			r := bytes.NewReader(buf[3:n])
+			addr, err := readSocks5Addr(r)
+			if err != nil {
+				continue
+			}
+
+			mu.Lock()
+			clientAddr = from
+			mu.Unlock()
+
+			data, _ := ioutil.ReadAll(r)
+			if err := WriteDatagram(stream, addr, data); err == ErrDatagramTooLarge {
+				continue
+			} else if err != nil {
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for {
+			addr, data, err := ReadDatagram(stream)
+			if err != nil {
+				pc.Close()
+				return
+			}
+
+			mu.Lock()
+			to := clientAddr
+			mu.Unlock()
+			if to == nil {
+				continue
+			}
+
+			buf, err := appendSocks5Addr([]byte{0, 0, 0}, addr)
+			if err != nil {
+				proxyLogger().Debug("socks5: invalid datagram address", zap.String("address", addr))
+				continue
+			}
+			pc.WriteTo(append(buf, data...), to)
+		}
+	}()
+
+	// The association ends when the control connection closes.
+	io.Copy(ioutil.Discard, br)
+	pc.Close()
+	stream.Close()
+	wg.Wait()
+	return nil
+}
+
+// writeSocks5Reply writes a reply with the given code & bound address.
+func writeSocks5Reply(w io.Writer, code byte, addr string) error {
+	if addr == "" {
+		addr = "0.0.0.0:0"
+	}
+	buf, err := appendSocks5Addr([]byte{socks5Version, code, 0}, addr)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
+// readSocks5Addr reads an address type, address, and port from r.
+func readSocks5Addr(r io.Reader) (string, error) {
+	var typ [1]byte
+	if _, err := io.ReadFull(r, typ[:]); err != nil {
+		return "", err
+	}
+
+	var host string
+	switch typ[0] {
+	case socks5AddrIPv4, socks5AddrIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if typ[0] == socks5AddrIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(r, ip); err != nil {
+			return "", err
+		}
+		host = ip.String()
+	case socks5AddrDomain:
+		if _, err := io.ReadFull(r, typ[:]); err != nil {
+			return "", err
+		}
+		buf := make([]byte, typ[0])
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return "", err
+		}
+		host = string(buf)
+	default:
+		return "", fmt.Errorf("unsupported socks address type: %d", typ[0])
+	}
+
+	var port [2]byte
+	if _, err := io.ReadFull(r, port[:]); err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(host, strconv.Itoa(int(port[0])<<8|int(port[1]))), nil
+}
+
+// appendSocks5Addr appends the address type, address, and port of addr to buf.
+func appendSocks5Addr(buf []byte, addr string) ([]byte, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 0 || port > 65535 {
+		return nil, fmt.Errorf("invalid socks port: %q", portStr)
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.To4() != nil {
+		buf = append(append(buf, socks5AddrIPv4), ip.To4()...)
+	} else if ip != nil {
+		buf = append(append(buf, socks5AddrIPv6), ip.To16()...)
+	} else if len(host) > 255 {
+		return nil, fmt.Errorf("socks host too long: %d", len(host))
+	} else {
+		buf = append(append(buf, socks5AddrDomain, byte(len(host))), host...)
+	}
+	return append(buf, byte(port>>8), byte(port)), nil
+}
@@ -0,0 +1,202 @@
+package marionette_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestSocks5Handler_Connect(t *testing.T) {
+	var dialed string
+	h := &marionette.Socks5Handler{
+		Credentials: map[string]string{"user": "pass"},
+		Dial: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			dialed = addr
+			a, b := net.Pipe()
+			go func() { io.Copy(b, b) }()
+			return a, nil
+		},
+	}
+	conn := serveSocks5(t, h)
+	defer conn.Close()
+
+	// Negotiate username & password authentication.
+	mustWrite(t, conn, []byte{5, 1, 2})
+	mustRead(t, conn, []byte{5, 2})
+	mustWrite(t, conn, []byte{1, 4, 'u', 's', 'e', 'r', 4, 'p', 'a', 's', 's'})
+	mustRead(t, conn, []byte{1, 0})
+
+	// Connect to domain name & echo data.
+	mustWrite(t, conn, []byte{5, 1, 0, 3, 11, 'e', 'x', 'a', 'm', 'p', 'l', 'e', '.', 'c', 'o', 'm', 0, 80})
+	mustRead(t, conn, []byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+	mustWrite(t, conn, []byte("hello"))
+	mustRead(t, conn, []byte("hello"))
+
+	if dialed != "example.com:80" {
+		t.Fatalf("unexpected address: %s", dialed)
+	}
+}
+
+// Ensure a half-closed connection still receives the destination's response.
+func TestSocks5Handler_Connect_HalfClose(t *testing.T) {
+	// Start a destination which responds once its request has ended.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if buf, err := ioutil.ReadAll(conn); err == nil {
+			conn.Write(append([]byte("re:"), buf...))
+		}
+	}()
+
+	h := &marionette.Socks5Handler{
+		Dial: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return net.Dial(network, ln.Addr().String())
+		},
+	}
+	conn := serveSocks5(t, h)
+	defer conn.Close()
+
+	mustWrite(t, conn, []byte{5, 1, 0})
+	mustRead(t, conn, []byte{5, 0})
+	mustWrite(t, conn, []byte{5, 1, 0, 1, 127, 0, 0, 1, 0, 80})
+	mustRead(t, conn, []byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+
+	mustWrite(t, conn, []byte("foo"))
+	if err := conn.(*net.TCPConn).CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+	if buf, err := ioutil.ReadAll(conn); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "re:foo" {
+		t.Fatalf("unexpected response: %q", buf)
+	}
+}
+
+func TestSocks5Handler_ErrInvalidPassword(t *testing.T) {
+	h := &marionette.Socks5Handler{Credentials: map[string]string{"user": "pass"}}
+	conn := serveSocks5(t, h)
+	defer conn.Close()
+
+	mustWrite(t, conn, []byte{5, 1, 2})
+	mustRead(t, conn, []byte{5, 2})
+	mustWrite(t, conn, []byte{1, 4, 'u', 's', 'e', 'r', 3, 'b', 'a', 'd'})
+	mustRead(t, conn, []byte{1, 1})
+}
+
+func TestSocks5Handler_ErrNoAcceptableMethod(t *testing.T) {
+	h := &marionette.Socks5Handler{Credentials: map[string]string{"user": "pass"}}
+	conn := serveSocks5(t, h)
+	defer conn.Close()
+
+	mustWrite(t, conn, []byte{5, 1, 0})
+	mustRead(t, conn, []byte{5, 0xFF})
+}
+
+// Ensure datagrams are relayed through the datagram connection and replies
+// are returned to the client with the source address.
+func TestSocks5Handler_UDPAssociate(t *testing.T) {
+	h := &marionette.Socks5Handler{
+		DialDatagram: func() (net.Conn, error) {
+			a, b := net.Pipe()
+			go func() {
+				for {
+					addr, data, err := marionette.ReadDatagram(b)
+					if err != nil {
+						return
+					} else if err := marionette.WriteDatagram(b, addr, append([]byte("re:"), data...)); err != nil {
+						return
+					}
+				}
+			}()
+			return a, nil
+		},
+	}
+	conn := serveSocks5(t, h)
+	defer conn.Close()
+
+	mustWrite(t, conn, []byte{5, 1, 0})
+	mustRead(t, conn, []byte{5, 0})
+	mustWrite(t, conn, []byte{5, 3, 0, 1, 0, 0, 0, 0, 0, 0})
+
+	// Read bound address of the relay.
+	reply := make([]byte, 10)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		t.Fatal(err)
+	} else if reply[1] != 0 || reply[3] != 1 {
+		t.Fatalf("unexpected reply: %v", reply)
+	}
+	relayAddr := &net.UDPAddr{IP: net.IP(reply[4:8]), Port: int(reply[8])<<8 | int(reply[9])}
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+
+	// Send datagram to 8.8.8.8:53.
+	if _, err := pc.WriteTo([]byte{0, 0, 0, 1, 8, 8, 8, 8, 0, 53, 'f', 'o', 'o'}, relayAddr); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 100)
+	pc.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if n, _, err := pc.ReadFrom(buf); err != nil {
+		t.Fatal(err)
+	} else if got, want := string(buf[:n]), string([]byte{0, 0, 0, 1, 8, 8, 8, 8, 0, 53, 'r', 'e', ':', 'f', 'o', 'o'}); got != want {
+		t.Fatalf("unexpected datagram: %q", got)
+	}
+}
+
+// serveSocks5 starts a listener which serves a single connection using h
+// and returns a client connection to it.
+func serveSocks5(t *testing.T, h *marionette.Socks5Handler) net.Conn {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		defer ln.Close()
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		h.ServeConn(conn)
+	}()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func mustWrite(t *testing.T, w io.Writer, b []byte) {
+	t.Helper()
+	if _, err := w.Write(b); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustRead(t *testing.T, r io.Reader, exp []byte) {
+	t.Helper()
+	buf := make([]byte, len(exp))
+	if _, err := io.ReadFull(r, buf); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != string(exp) {
+		t.Fatalf("unexpected read: %v, expected %v", buf, exp)
+	}
+}
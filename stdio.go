@@ -0,0 +1,170 @@
+package marionette
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrConnUsed is returned when dialing a connection which was already used.
+var ErrConnUsed = errors.New("marionette: connection already used")
+
+// stdioNetwork is the network name reported by read-writer addresses.
+const stdioNetwork = "stdio"
+
+// ReadWriterConn is a net.Conn carrying a single cover connection over an
+// io.ReadWriter, such as stdin & stdout or a serial port, so that marionette
+// can run inside another tunnel. Deadlines are not supported.
+type ReadWriterConn struct {
+	r io.Reader
+	w io.Writer
+	c []io.Closer
+
+	closed chan struct{}
+	once   sync.Once
+
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+// NewReadWriterConn returns a connection over rw. If rw is an io.Closer then
+// it is closed with the connection.
+func NewReadWriterConn(rw io.ReadWriter) *ReadWriterConn {
+	c := &ReadWriterConn{r: rw, w: rw, closed: make(chan struct{}), done: make(chan struct{})}
+	if closer, ok := rw.(io.Closer); ok {
+		c.c = append(c.c, closer)
+	}
+	return c
+}
+
+// NewStdioConn returns a connection reading from stdin & writing to stdout,
+// which are closed with the connection. Nothing else may use them.
+func NewStdioConn() *ReadWriterConn {
+	return &ReadWriterConn{
+		r:      os.Stdin,
+		w:      os.Stdout,
+		c:      []io.Closer{os.Stdin, os.Stdout},
+		closed: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+// Read reads from the underlying reader. Returns net.ErrClosed once closed.
+func (c *ReadWriterConn) Read(p []byte) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, net.ErrClosed
+	default:
+	}
+
+	n, err := c.r.Read(p)
+	if err != nil {
+		c.markDone()
+	}
+	return n, err
+}
+
+// Write writes to the underlying writer. Returns net.ErrClosed once closed.
+func (c *ReadWriterConn) Write(p []byte) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, net.ErrClosed
+	default:
+	}
+	return c.w.Write(p)
+}
+
+// Close closes the underlying reader & writer, if they are closers.
+func (c *ReadWriterConn) Close() (err error) {
+	c.once.Do(func() {
+		close(c.closed)
+		c.markDone()
+		for _, closer := range c.c {
+			if e := closer.Close(); e != nil && err == nil {
+				err = e
+			}
+		}
+	})
+	return err
+}
+
+// Done returns a channel which is closed once the connection is closed or
+// reading fails, such as once the peer ends the connection.
+func (c *ReadWriterConn) Done() <-chan struct{} { return c.done }
+
+func (c *ReadWriterConn) markDone() {
+	c.doneOnce.Do(func() { close(c.done) })
+}
+
+func (c *ReadWriterConn) LocalAddr() net.Addr  { return stdioAddr{} }
+func (c *ReadWriterConn) RemoteAddr() net.Addr { return stdioAddr{} }
+
+func (c *ReadWriterConn) SetDeadline(t time.Time) error      { return nil }
+func (c *ReadWriterConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *ReadWriterConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// ConnDialFunc returns a function for Dialer.DialFunc which returns conn the
+// first time it is called, such as a ReadWriterConn, and ErrConnUsed after.
+// The dialer's Channels must be 1 & Resume disabled.
+func ConnDialFunc(conn net.Conn) func(ctx context.Context, network, address string) (net.Conn, error) {
+	var mu sync.Mutex
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if conn == nil {
+			return nil, ErrConnUsed
+		}
+		c := conn
+		conn = nil
+		return c, nil
+	}
+}
+
+// connListener implements net.Listener for a single connection.
+type connListener struct {
+	conns   chan net.Conn
+	closing chan struct{}
+	once    sync.Once
+}
+
+// NewConnListener returns a listener which accepts conn, such as a
+// ReadWriterConn, once and then blocks until closed. Pass it to NewListener()
+// or Serve() to run the server over conn.
+func NewConnListener(conn net.Conn) net.Listener {
+	ln := &connListener{
+		conns:   make(chan net.Conn, 1),
+		closing: make(chan struct{}),
+	}
+	ln.conns <- conn
+	return ln
+}
+
+// Accept returns the connection on the first call and waits for the listener
+// to close on later calls.
+func (ln *connListener) Accept() (net.Conn, error) {
+	select {
+	case <-ln.closing:
+		return nil, ErrListenerClosed
+	case conn := <-ln.conns:
+		return conn, nil
+	}
+}
+
+// Close stops accepting. The accepted connection is unaffected.
+func (ln *connListener) Close() error {
+	ln.once.Do(func() { close(ln.closing) })
+	return nil
+}
+
+// Addr returns a read-writer address.
+func (ln *connListener) Addr() net.Addr { return stdioAddr{} }
+
+// stdioAddr is the address of a read-writer connection.
+type stdioAddr struct{}
+
+func (stdioAddr) Network() string { return stdioNetwork }
+func (stdioAddr) String() string  { return stdioNetwork }
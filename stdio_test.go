@@ -0,0 +1,83 @@
+package marionette_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+)
+
+// Ensure a dialer & listener carry streams over read-writers, such as the
+// stdin & stdout of two processes connected by another tunnel.
+func TestReadWriterConn(t *testing.T) {
+	clientR, serverW := io.Pipe()
+	serverR, clientW := io.Pipe()
+	clientConn := marionette.NewReadWriterConn(&pipeReadWriter{clientR, clientW})
+	serverConn := marionette.NewReadWriterConn(&pipeReadWriter{serverR, serverW})
+
+	ln := marionette.NewListener(marionette.NewConnListener(serverConn), mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)))
+	defer ln.Close()
+
+	dialer := marionette.NewDialer(mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc)), "stdio", marionette.NewStreamSet())
+	dialer.DialFunc = marionette.ConnDialFunc(clientConn)
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer dialer.Close()
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientStream.Close()
+	mustWrite(t, clientStream, []byte("foo"))
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverStream.Close()
+	mustRead(t, serverStream, []byte("foo"))
+
+	mustWrite(t, serverStream, []byte("bar"))
+	mustRead(t, clientStream, []byte("bar"))
+
+	// Closing the client's read-writer ends the server's connection.
+	clientConn.Close()
+	select {
+	case <-serverConn.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for server connection to close")
+	}
+}
+
+func TestConnDialFunc(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	dial := marionette.ConnDialFunc(client)
+	if conn, err := dial(context.Background(), "tcp", "127.0.0.1:8080"); err != nil {
+		t.Fatal(err)
+	} else if conn != client {
+		t.Fatal("unexpected conn")
+	}
+	if _, err := dial(context.Background(), "tcp", "127.0.0.1:8080"); err != marionette.ErrConnUsed {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// pipeReadWriter joins the read & write ends of two pipes.
+type pipeReadWriter struct {
+	*io.PipeReader
+	*io.PipeWriter
+}
+
+func (rw *pipeReadWriter) Close() error {
+	rw.PipeReader.Close()
+	return rw.PipeWriter.Close()
+}
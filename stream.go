@@ -1,15 +1,18 @@
 package marionette
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -22,6 +25,15 @@ var (
 	ErrWriteTooLarge = errors.New("marionette: write too large")
 )
 
+// StreamWindowSize is the number of payload bytes a stream will buffer for
+// reading before its peer must wait for a WINDOW cell to send more. Both
+// peers assume this initial window so it must be the same on each side.
+const StreamWindowSize = 1 << 18
+
+// MaxResendBufferSize is the number of payload bytes of sent cells retained
+// by a resumable stream so they can be sent again after a reconnect.
+var MaxResendBufferSize = 1 << 20
+
 // Ensure type implements interface.
 var _ net.Conn = &Stream{}
 
@@ -48,6 +60,30 @@ type Stream struct {
 	localAddr  net.Addr
 	remoteAddr net.Addr
 
+	// Network & address the peer should connect the stream to, if
+	// specified. They are sent in a DESTINATION cell before any data.
+	network   string
+	dest      string
+	destSent  bool
+	destOnce  sync.Once
+	destReady chan struct{}
+
+	// Sent cells retained for resending if the connection is resumed.
+	resumable bool
+	sent      []*Cell
+	sentN     int
+	resend    []*Cell
+
+	// Flow control. Window limits are the total number of payload bytes that
+	// may be sent, which makes WINDOW cells safe to repeat after a reconnect.
+	wsent       int // payload bytes sent
+	wlimit      int // payload bytes the peer allows to be sent
+	rconsumed   int // payload bytes read by the application
+	radvertised int // receive limit last sent to the peer
+
+	// Limits bytes read & written by the application, if set.
+	limiter *RateLimiter
+
 	rbuf, wbuf []byte
 	rqueue     []*Cell
 	rnotify    chan struct{}
@@ -57,6 +93,9 @@ type Stream struct {
 
 	onWrite func() // callback when a new write buffer changes
 
+	// Spans the stream's lifetime, if created by a stream set.
+	span trace.Span
+
 	// Stream verbosely logs to trace writer when set.
 	TraceWriter io.Writer
 }
@@ -71,6 +110,9 @@ func NewStream(id int) *Stream {
 		rnotify:      make(chan struct{}),
 		wnotify:      make(chan struct{}),
 		modTime:      time.Now(),
+		destReady:    make(chan struct{}),
+		wlimit:       StreamWindowSize,
+		radvertised:  StreamWindowSize,
 
 		writeCloseNotifiedNotify: make(chan struct{}),
 	}
@@ -79,6 +121,48 @@ func NewStream(id int) *Stream {
 // ID returns the stream id.
 func (s *Stream) ID() int { return s.id }
 
+// Destination returns the address the stream should be connected to. Returns
+// a blank string if no destination was specified. Blocks until the first cell
+// from the peer has been received or the stream is closed for reads.
+func (s *Stream) Destination() string {
+	<-s.destReady
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.dest
+}
+
+// Network returns "udp" if the stream relays datagrams framed by
+// WriteDatagram(). Otherwise returns "tcp". Blocks until the first cell from
+// the peer has been received or the stream is closed for reads.
+func (s *Stream) Network() string {
+	<-s.destReady
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.network == "" {
+		return "tcp"
+	}
+	return s.network
+}
+
+// setDestination sets the network & address sent to the peer before any stream data.
+func (s *Stream) setDestination(network, addr string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.network, s.dest = network, addr
+	s.markDestinationReady()
+}
+
+func (s *Stream) markDestinationReady() {
+	s.destOnce.Do(func() { close(s.destReady) })
+}
+
+// DestinationPending returns true if the destination has not been sent yet.
+func (s *Stream) DestinationPending() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.network != "" && !s.destSent
+}
+
 // ModTime returns the last time a cell was added or removed from the stream.
 func (s *Stream) ModTime() time.Time {
 	s.mu.RLock()
@@ -112,6 +196,9 @@ func (s *Stream) Read(b []byte) (n int, err error) {
 		s.mu.Lock()
 		if n, err = s.read(b); n != 0 || err != nil {
 			s.mu.Unlock()
+			if s.limiter != nil {
+				s.limiter.wait(n, s.readClosing)
+			}
 			return n, err
 		} else if n == 0 && len(s.rqueue) == 0 && s.readClosed {
 			s.rbuf = nil
@@ -147,6 +234,12 @@ func (s *Stream) read(b []byte) (n int, err error) {
 	copy(s.rbuf, s.rbuf[n:])
 	s.rbuf = s.rbuf[:len(s.rbuf)-n]
 
+	// Notify the set once enough data is consumed to send a window update.
+	s.rconsumed += n
+	if s.windowUpdatePending() {
+		s.notifyWrite()
+	}
+
 	return n, nil
 }
 
@@ -164,6 +257,12 @@ func (s *Stream) Write(b []byte) (n int, err error) {
 		fmt.Fprintf(s.TraceWriter, "[Write] len=%d", len(b))
 	}
 
+	if s.limiter != nil {
+		if err := s.limiter.wait(len(b), s.writeClosing); err != nil {
+			return 0, ErrStreamClosed
+		}
+	}
+
 	for {
 		s.mu.Lock()
 		if s.writeClosed {
@@ -238,6 +337,25 @@ func (s *Stream) Enqueue(cell *Cell) error {
 		return nil // duplicate cell
 	}
 
+	// Cells may be resent after a connection is resumed so ignore any
+	// sequence which is already queued.
+	buffered := len(s.rbuf) + len(cell.Payload)
+	for _, other := range s.rqueue {
+		if other.SequenceID == cell.SequenceID {
+			return nil
+		} else if other.Type == NORMAL {
+			buffered += len(other.Payload)
+		}
+	}
+
+	// Close the stream if the peer sends more data than its window allows.
+	if cell.Type == NORMAL && buffered > StreamWindowSize {
+		s.logger().Warn("receive window exceeded", zap.Int("buffered", buffered))
+		s.closeRead()
+		s.closeWrite()
+		return nil
+	}
+
 	// Add to queue & sort.
 	s.rqueue = append(s.rqueue, cell)
 	sort.Sort(Cells(s.rqueue))
@@ -255,14 +373,19 @@ func (s *Stream) processReadQueue() {
 		cell := s.rqueue[0]
 		if cell.SequenceID != s.rseq {
 			break // out-of-order
+		} else if cell.Type == DESTINATION {
+			s.network, s.dest = parseDestination(cell.Payload)
 		} else if len(cell.Payload) > cap(s.rbuf)-len(s.rbuf) {
 			break // not enough space on buffer
+		} else {
+			// Extend buffer and copy cell payload.
+			s.rbuf = s.rbuf[:len(s.rbuf)+len(cell.Payload)]
+			copy(s.rbuf[len(s.rbuf)-len(cell.Payload):], cell.Payload)
+			notify = true
 		}
 
-		// Extend buffer and copy cell payload.
-		s.rbuf = s.rbuf[:len(s.rbuf)+len(cell.Payload)]
-		copy(s.rbuf[len(s.rbuf)-len(cell.Payload):], cell.Payload)
-		notify = true
+		// The destination can only be sent in the first cell.
+		s.markDestinationReady()
 
 		// Shift cell off queue and increment sequence.
 		s.rqueue[0] = nil
@@ -287,6 +410,7 @@ func (s *Stream) processReadQueue() {
 }
 
 // Dequeue reads n bytes from the write buffer and encodes it as a cell.
+// Cells queued for resending by rewind() are returned before new data.
 func (s *Stream) Dequeue(n int) *Cell {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -295,11 +419,28 @@ func (s *Stream) Dequeue(n int) *Cell {
 		fmt.Fprintf(s.TraceWriter, "[Dequeue] n=%d", n)
 	}
 
+	if len(s.resend) > 0 {
+		return s.dequeueResend(n)
+	}
+
+	cell := s.dequeue(n)
+	if cell != nil && s.resumable {
+		s.retain(cell)
+	}
+	return cell
+}
+
+func (s *Stream) dequeue(n int) *Cell {
 	// Exit immediately if stream has already notified that its writes are closed.
 	if s.writeCloseNotified {
 		return nil
 	}
 
+	// Wait for the peer to open its receive window before sending more data.
+	if len(s.wbuf) > 0 && s.wsent >= s.wlimit && (s.network == "" || s.destSent) {
+		return nil
+	}
+
 	// Determine the amount of data to read.
 	if n == 0 {
 		n = len(s.wbuf) + CellHeaderSize
@@ -312,6 +453,14 @@ func (s *Stream) Dequeue(n int) *Cell {
 	s.wseq++
 	s.modTime = time.Now()
 
+	// Send the destination before any data.
+	if s.network != "" && !s.destSent {
+		s.destSent = true
+		cell := NewCell(s.id, sequenceID, n, DESTINATION)
+		cell.Payload = []byte(s.network + " " + s.dest)
+		return cell
+	}
+
 	// End stream if there's no more data and it's marked as closed.
 	if len(s.wbuf) == 0 && s.writeClosed {
 		if s.TraceWriter != nil {
@@ -325,11 +474,15 @@ func (s *Stream) Dequeue(n int) *Cell {
 	// Build cell.
 	cell := NewCell(s.id, sequenceID, n, NORMAL)
 
-	// Determine payload size.
+	// Determine payload size, limited by the peer's receive window.
 	payloadN := n - CellHeaderSize
 	if payloadN > len(s.wbuf) {
 		payloadN = len(s.wbuf)
 	}
+	if payloadN > s.wlimit-s.wsent {
+		payloadN = s.wlimit - s.wsent
+	}
+	s.wsent += payloadN
 
 	// Copy buffer to payload
 	if payloadN > 0 {
@@ -348,6 +501,144 @@ func (s *Stream) Dequeue(n int) *Cell {
 	return cell
 }
 
+// dequeueResend returns the next cell to resend, resized to n bytes. Returns
+// nil if the cell's payload does not fit within n bytes.
+func (s *Stream) dequeueResend(n int) *Cell {
+	cell := *s.resend[0]
+	if n == 0 {
+		n = CellHeaderSize + len(cell.Payload)
+	} else if n > MaxCellLength {
+		n = MaxCellLength
+	}
+	if CellHeaderSize+len(cell.Payload) > n {
+		return nil
+	}
+
+	s.resend[0] = nil
+	s.resend = s.resend[1:]
+	s.modTime = time.Now()
+
+	cell.Length = n
+	return &cell
+}
+
+// retain adds a sent cell to the resend buffer and discards the oldest cells
+// once the buffer exceeds MaxResendBufferSize.
+func (s *Stream) retain(cell *Cell) {
+	s.sent = append(s.sent, cell)
+	s.sentN += len(cell.Payload)
+	for s.sentN > MaxResendBufferSize && len(s.sent) > 0 {
+		s.sentN -= len(s.sent[0].Payload)
+		s.sent[0] = nil
+		s.sent = s.sent[1:]
+	}
+}
+
+// rewind discards retained cells before seq, which the peer has received,
+// and queues the remaining cells to be sent again. Returns false if cells
+// the peer has not received were already discarded from the resend buffer.
+func (s *Stream) rewind(seq int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for len(s.sent) > 0 && s.sent[0].SequenceID < seq {
+		s.sentN -= len(s.sent[0].Payload)
+		s.sent[0] = nil
+		s.sent = s.sent[1:]
+	}
+
+	first := s.wseq
+	if len(s.sent) > 0 {
+		first = s.sent[0].SequenceID
+	}
+	if seq < first {
+		return false
+	}
+
+	s.resend = append(s.resend[:0], s.sent...)
+	s.notifyWrite()
+	return true
+}
+
+// sendPending returns true if Dequeue() would return a cell.
+func (s *Stream) sendPending() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	switch {
+	case len(s.resend) > 0:
+		return true
+	case s.writeCloseNotified:
+		return false
+	case s.network != "" && !s.destSent:
+		return true
+	case len(s.wbuf) > 0:
+		return s.wsent < s.wlimit
+	default:
+		return s.writeClosed
+	}
+}
+
+// windowUpdatePending returns true if the application has read at least half
+// a window of data since the receive limit was last sent to the peer.
+func (s *Stream) windowUpdatePending() bool {
+	return s.rconsumed+StreamWindowSize-s.radvertised >= StreamWindowSize/2
+}
+
+// windowCell returns a WINDOW cell with the current receive limit.
+func (s *Stream) windowCell() *Cell {
+	s.radvertised = s.rconsumed + StreamWindowSize
+	payload := make([]byte, 8)
+	binary.BigEndian.PutUint64(payload, uint64(s.radvertised))
+	return &Cell{Type: WINDOW, StreamID: s.id, Payload: payload}
+}
+
+// dequeueWindow returns a WINDOW cell if an update is pending. Otherwise nil.
+func (s *Stream) dequeueWindow() *Cell {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.windowUpdatePending() {
+		return nil
+	}
+	return s.windowCell()
+}
+
+// advertiseWindow returns a WINDOW cell with the current receive limit.
+func (s *Stream) advertiseWindow() *Cell {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.windowCell()
+}
+
+// handleWindow raises the send limit from a peer's WINDOW cell. Limits lower
+// than the current one, such as those repeated after a reconnect, are ignored.
+func (s *Stream) handleWindow(cell *Cell) {
+	if len(cell.Payload) < 8 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if limit := int(binary.BigEndian.Uint64(cell.Payload)); limit > s.wlimit {
+		s.wlimit = limit
+		s.notifyWrite()
+	}
+}
+
+// ResendPending returns true if cells are queued to be sent again.
+func (s *Stream) ResendPending() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.resend) > 0
+}
+
+// receiveSequence returns the sequence of the next cell expected from the peer.
+func (s *Stream) receiveSequence() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rseq
+}
+
 // Close marks the stream as closed for writes. The server will close the read side.
 func (s *Stream) Close() error {
 	return s.CloseWrite()
@@ -378,6 +669,15 @@ func (s *Stream) CloseRead() error {
 func (s *Stream) closeRead() {
 	s.readClosed = true
 	s.ronce.Do(func() { close(s.readClosing) })
+	s.markDestinationReady()
+}
+
+// closeStream closes both sides of the stream.
+func (s *Stream) closeStream() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeRead()
+	s.closeWrite()
 }
 
 // Closed returns true if the stream has been closed.
@@ -423,6 +723,16 @@ func (s *Stream) ReadWriteCloseNotified() bool {
 	return s.readClosed && s.writeCloseNotified
 }
 
+// parseDestination splits a DESTINATION cell payload into its network and
+// address. The payload is the network & address separated by a space.
+func parseDestination(payload []byte) (network, addr string) {
+	a := strings.SplitN(string(payload), " ", 2)
+	if len(a) < 2 {
+		return "tcp", a[0]
+	}
+	return a[0], a[1]
+}
+
 func (c *Stream) LocalAddr() net.Addr  { return c.localAddr }
 func (c *Stream) RemoteAddr() net.Addr { return c.remoteAddr }
 
@@ -1,16 +1,21 @@
 package marionette
 
 import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
 	"expvar"
 	"fmt"
 	"io"
 	"math/rand"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -19,6 +24,18 @@ const (
 	StreamCloseTimeout       = 5 * time.Second
 )
 
+// SessionTicketSize is the size, in bytes, of a session ticket.
+const SessionTicketSize = 16
+
+// MaxDuplicateCells is the number of duplicated cells queued on a path. The
+// oldest copies are dropped once a path falls further behind.
+const MaxDuplicateCells = 64
+
+// Flags sent after the ticket in a JOIN cell.
+const (
+	joinDuplicate = 0x1 // send each data cell on every path
+)
+
 var (
 	evStreams = expvar.NewInt("streams")
 )
@@ -33,10 +50,72 @@ type StreamSet struct {
 	once    sync.Once
 	wg      sync.WaitGroup
 
+	// Session ticket & resumption state. Control cells are sent before any
+	// stream data. Once a connection's session is matched to an earlier set,
+	// all cells are handled by that set instead.
+	ticket   []byte
+	control  []*Cell
+	resuming bool
+	resumed  map[int]struct{}
+	delegate *StreamSet
+
+	// Sets of the connections, or paths, which carry this set's cells once
+	// joined by a multipath peer. Cells are striped across the paths, and
+	// copied onto the queue of each other path if Duplicate is set.
+	paths     []*StreamSet
+	dups      []*Cell
+	Duplicate bool
+
+	// Bridge secret & format of the server's connection, if the client must
+	// authenticate. Every cell is rejected until an AUTH cell is verified.
+	authSecret    []byte
+	authUUID      int
+	authenticated bool
+	authUsers     *Credentials // users whose credentials are accepted, if set
+	authUserID    uint32       // user authenticated as, if authUsers is set
+	replays       *ReplayCache // rejects AUTH cells seen before, if set
+	onAuth        func()       // called once authenticated, if set
+
+	// Bridge secret known to both parties, if any. See SharedSecret().
+	sharedSecret []byte
+
+	// Addresses of the underlying connection, reported by each stream.
+	localAddr  net.Addr
+	remoteAddr net.Addr
+
 	OnNewStream func(*Stream)
 
+	// Called by the server when the peer identifies its session. Returns the
+	// set holding the session's streams, which is ss if the session is new.
+	OnSession func(ss *StreamSet, ticket []byte) *StreamSet
+
+	// Called by the server when the peer joins an additional connection to
+	// its session. Returns the set holding the session's streams, which is
+	// ss if the session is new.
+	OnJoin func(ss *StreamSet, ticket []byte) *StreamSet
+
 	// Directory for storing stream traces.
 	TracePath string
+
+	// Parent of the spans of new streams, which is the span of the
+	// connection carrying the set.
+	traceCtx context.Context
+
+	// Limits each stream's bytes read & written, combined, per second.
+	// Zero disables the limit.
+	StreamRateLimit int
+
+	// Streams with no cells sent or received for StreamIdleTimeout, or open
+	// for longer than StreamMaxLifetime, are closed and the peer is sent an
+	// end of stream. Zero disables a limit.
+	StreamIdleTimeout time.Duration
+	StreamMaxLifetime time.Duration
+
+	// Called when any stream is added to, or removed from, the set,
+	// including streams opened by either peer. Called from the stream's
+	// monitoring goroutine so the open always precedes the close.
+	OnStreamOpen  func(*Stream)
+	OnStreamClose func(*Stream)
 }
 
 // NewStreamSet returns a new instance of StreamSet.
@@ -49,9 +128,14 @@ func NewStreamSet() *StreamSet {
 	return ss
 }
 
-// Close closes all streams in the set.
+// Close closes all streams in the set. A set joined to another set is
+// removed from its paths.
 func (ss *StreamSet) Close() (err error) {
-	for _, stream := range ss.streams {
+	if target := ss.target(); target != ss {
+		target.detach(ss)
+	}
+
+	for _, stream := range ss.Streams() {
 		if e := stream.CloseWrite(); e != nil && err == nil {
 			err = e
 		} else if e := stream.CloseRead(); e != nil && err == nil {
@@ -66,10 +150,23 @@ func (ss *StreamSet) Close() (err error) {
 // monitorStream checks a stream until its read & write channels are closed
 // and then removes the stream from the set.
 func (ss *StreamSet) monitorStream(stream *Stream) {
+	if ss.OnStreamOpen != nil {
+		ss.OnStreamOpen(stream)
+	}
+
 	readCloseNotify := stream.ReadCloseNotify()
 	writeCloseNotifiedNotify := stream.WriteCloseNotifiedNotify()
 	var timeout <-chan time.Time
 
+	// Start idle & lifetime limits, if enabled.
+	var idle, lifetime <-chan time.Time
+	if ss.StreamIdleTimeout > 0 {
+		idle = time.After(ss.StreamIdleTimeout)
+	}
+	if ss.StreamMaxLifetime > 0 {
+		lifetime = time.After(ss.StreamMaxLifetime)
+	}
+
 LOOP:
 	for {
 		// Wait until stream closed state is changed or the set is closed.
@@ -78,6 +175,20 @@ LOOP:
 			break LOOP
 		case <-timeout:
 			break LOOP
+		case <-idle:
+			if d := ss.StreamIdleTimeout - time.Since(stream.ModTime()); d > 0 {
+				idle = time.After(d)
+				continue
+			}
+			stream.logger().Debug("stream idle timeout")
+			idle, lifetime = nil, nil
+			stream.closeStream()
+			continue
+		case <-lifetime:
+			stream.logger().Debug("stream max lifetime reached")
+			idle, lifetime = nil, nil
+			stream.closeStream()
+			continue
 		case <-readCloseNotify:
 			readCloseNotify = nil
 			timeout = time.After(StreamCloseTimeout)
@@ -99,6 +210,10 @@ LOOP:
 	ss.mu.Lock()
 	ss.remove(stream)
 	ss.mu.Unlock()
+
+	if ss.OnStreamClose != nil {
+		ss.OnStreamClose(stream)
+	}
 }
 
 // Stream returns a stream by id.
@@ -120,6 +235,319 @@ func (ss *StreamSet) Streams() []*Stream {
 	return streams
 }
 
+// Len returns the number of streams in the set.
+func (ss *StreamSet) Len() int {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	return len(ss.streams)
+}
+
+// Ticket returns the session ticket. Returns nil if no session has started.
+func (ss *StreamSet) Ticket() []byte {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	return ss.ticket
+}
+
+// StartSession queues the session ticket, generating one if needed, followed
+// by the receive sequence of each stream to be sent before any other cells.
+// This is called by the client at the start of each connection so that the
+// server can resume the set's streams after a reconnect.
+func (ss *StreamSet) StartSession() error {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if err := ss.ensureTicket(); err != nil {
+		return err
+	}
+
+	ss.control = append([]*Cell{{Type: SESSION, Payload: ss.ticket}}, ss.resumeCells()...)
+	ss.resuming, ss.resumed = len(ss.streamIDs) > 0, make(map[int]struct{})
+	return nil
+}
+
+// Join attaches ss, which carries a single connection, to target so that the
+// cells of target's streams are striped across all connections joined to it.
+// The join cell is queued to be sent first, followed by the receive sequence
+// of each stream so that the server resends cells lost on a failed path. A
+// session is started on target if needed.
+func (ss *StreamSet) Join(target *StreamSet) error {
+	target.mu.Lock()
+	if err := target.ensureTicket(); err != nil {
+		target.mu.Unlock()
+		return err
+	}
+
+	var flags byte
+	if target.Duplicate {
+		flags |= joinDuplicate
+	}
+	control := []*Cell{{Type: JOIN, Payload: append(append([]byte(nil), target.ticket...), flags)}}
+	control = append(control, target.resumeCells()...)
+	ticket := target.ticket
+	target.paths = append(target.paths, ss)
+	target.mu.Unlock()
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.ticket, ss.delegate, ss.control = ticket, target, control
+	return nil
+}
+
+// ensureTicket generates a session ticket if the set does not have one.
+func (ss *StreamSet) ensureTicket() error {
+	if ss.ticket != nil {
+		return nil
+	}
+	ticket := make([]byte, SessionTicketSize)
+	if _, err := crand.Read(ticket); err != nil {
+		return err
+	}
+	ss.ticket = ticket
+	return nil
+}
+
+// resumeCells returns a RESUME cell with the receive sequence of each stream,
+// followed by its current receive window.
+func (ss *StreamSet) resumeCells() []*Cell {
+	var cells []*Cell
+	for _, id := range ss.streamIDs {
+		cells = append(cells,
+			&Cell{Type: RESUME, StreamID: id, SequenceID: ss.streams[id].receiveSequence()},
+			ss.streams[id].advertiseWindow(),
+		)
+	}
+	return cells
+}
+
+// handleSession processes a SESSION cell. On the server, the connection is
+// attached to the session and the receive sequence of each existing stream
+// is sent back, followed by the ticket. On the client, the returned ticket
+// ends resumption and any streams the server did not resume are closed.
+func (ss *StreamSet) handleSession(cell *Cell) {
+	if ss.OnSession == nil {
+		if !ss.resuming {
+			return
+		}
+		for _, id := range ss.streamIDs {
+			if _, ok := ss.resumed[id]; !ok {
+				ss.streams[id].logger().Info("stream not resumed")
+				ss.streams[id].closeStream()
+			}
+		}
+		ss.resuming, ss.resumed = false, nil
+		return
+	}
+
+	ticket := append([]byte(nil), cell.Payload...)
+	target := ss.OnSession(ss, ticket)
+	ss.ticket = ticket
+	if target == ss {
+		ss.control = append(ss.control, &Cell{Type: SESSION, Payload: ticket})
+		return
+	}
+	ss.delegate = target
+
+	target.mu.Lock()
+	defer target.mu.Unlock()
+	target.control = append(target.control, target.resumeCells()...)
+	target.control = append(target.control, &Cell{Type: SESSION, Payload: ticket})
+}
+
+// handleJoin processes a JOIN cell on the server by adding the connection as
+// a path of the session. Other connections of the session remain open. The
+// receive sequence of each existing stream is sent back on the connection so
+// the client resends cells lost on a failed path.
+func (ss *StreamSet) handleJoin(cell *Cell) {
+	if ss.OnJoin == nil || ss.ticket != nil || len(cell.Payload) < SessionTicketSize {
+		return
+	}
+
+	ticket := append([]byte(nil), cell.Payload[:SessionTicketSize]...)
+	duplicate := len(cell.Payload) > SessionTicketSize && cell.Payload[SessionTicketSize]&joinDuplicate != 0
+	target := ss.OnJoin(ss, ticket)
+	ss.ticket = ticket
+	if target == ss {
+		ss.paths, ss.Duplicate = append(ss.paths, ss), duplicate
+		return
+	}
+	ss.delegate = target
+
+	target.mu.Lock()
+	defer target.mu.Unlock()
+	target.paths, target.Duplicate = append(target.paths, ss), duplicate
+	ss.control = append(ss.control, target.resumeCells()...)
+}
+
+// detach removes path from the set's paths once its connection is finished.
+func (ss *StreamSet) detach(path *StreamSet) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	for i := range ss.paths {
+		if ss.paths[i] == path {
+			ss.paths = append(ss.paths[:i], ss.paths[i+1:]...)
+			break
+		}
+	}
+}
+
+// duplicate queues a copy of a data cell sent on the path from onto each of
+// the set's other paths, if Duplicate is set. Receivers drop whichever copy
+// arrives second by its sequence.
+func (ss *StreamSet) duplicate(from *StreamSet, cell *Cell) {
+	switch cell.Type {
+	case NORMAL, END_OF_STREAM, DESTINATION:
+	default:
+		return
+	}
+
+	ss.mu.RLock()
+	if !ss.Duplicate {
+		ss.mu.RUnlock()
+		return
+	}
+	paths := make([]*StreamSet, len(ss.paths))
+	copy(paths, ss.paths)
+	ss.mu.RUnlock()
+
+	for _, path := range paths {
+		if path == from {
+			continue
+		}
+		other := *cell
+		path.mu.Lock()
+		if len(path.dups) >= MaxDuplicateCells {
+			path.dups[0] = nil
+			path.dups = path.dups[1:]
+		}
+		path.dups = append(path.dups, &other)
+		path.mu.Unlock()
+	}
+}
+
+// handleResume processes a RESUME cell by resending the stream's cells which
+// the peer has not received. The stream is closed if they are unavailable.
+func (ss *StreamSet) handleResume(cell *Cell) {
+	stream := ss.streams[cell.StreamID]
+	if stream == nil {
+		return
+	} else if ss.resumed != nil {
+		ss.resumed[cell.StreamID] = struct{}{}
+	}
+
+	if !stream.rewind(cell.SequenceID) {
+		stream.logger().Info("cannot resume stream, cells unavailable", zap.Int("seq", cell.SequenceID))
+		stream.closeStream()
+	}
+}
+
+// requireAuth rejects every cell until an AUTH cell made with secret for the
+// format with uuid, or with the credential of one of users if set, is
+// received. AUTH cells already in replays are rejected. This is called by the
+// server for each connection before it is executed.
+func (ss *StreamSet) requireAuth(secret []byte, uuid int, users *Credentials, replays *ReplayCache) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.authSecret, ss.authUUID, ss.authUsers, ss.replays = secret, uuid, users, replays
+	if users == nil {
+		ss.sharedSecret = secret
+	}
+}
+
+// queueAuth queues an AUTH cell made with secret for the format with uuid,
+// with padding random bytes, to be sent before any other cell. This is called
+// by the client at the start of each connection once it is open.
+func (ss *StreamSet) queueAuth(secret []byte, uuid int, padding int) error {
+	cell, err := newAuthCell(secret, uuid, time.Now(), padding)
+	if err != nil {
+		return err
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.control = append([]*Cell{cell}, ss.control...)
+	if len(secret) == AuthSecretSize {
+		ss.sharedSecret = secret
+	}
+	return nil
+}
+
+// SharedSecret returns the bridge secret of the connection if both parties
+// know it before the client authenticates, or nil. A user's credential is not
+// known to the server until its AUTH cell is verified so connections to
+// bridges with per-user credentials have none.
+func (ss *StreamSet) SharedSecret() []byte {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.sharedSecret
+}
+
+// checkAuth verifies the first cell of a connection which must authenticate.
+// Returns true if cell should be handled, or ErrUnauthenticated or ErrReplay if
+// the connection has not authenticated.
+func (ss *StreamSet) checkAuth(cell *Cell) (bool, error) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if ss.authSecret == nil {
+		return true, nil
+	} else if ss.authenticated {
+		return cell.Type != AUTH, nil
+	}
+
+	mac, expires, err := verifyAuthCell(ss.authSecret, ss.authUsers, ss.authUUID, cell, time.Now())
+	if err != nil {
+		return false, err
+	} else if ss.replays != nil {
+		if replay, err := ss.replays.Seen(mac, expires); err != nil {
+			return false, err
+		} else if replay {
+			return false, ErrReplay
+		}
+	}
+	ss.authenticated = true
+	if ss.authUsers != nil {
+		ss.authUserID = binary.BigEndian.Uint32(cell.Payload)
+	}
+	if ss.onAuth != nil {
+		ss.onAuth()
+	}
+	return false, nil
+}
+
+// authUser returns the ID of the user the connection authenticated as, if it
+// has authenticated with a user's credential.
+func (ss *StreamSet) authUser() (uint32, bool) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.authUserID, ss.authenticated && ss.authUsers != nil
+}
+
+// unauthenticated returns true if the connection must authenticate and has
+// not yet.
+func (ss *StreamSet) unauthenticated() bool {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.authSecret != nil && !ss.authenticated
+}
+
+// target returns the set that handles cells for ss.
+func (ss *StreamSet) target() *StreamSet {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	if ss.delegate != nil {
+		return ss.delegate
+	}
+	return ss
+}
+
+// setTraceContext sets the parent of the spans of new streams.
+func (ss *StreamSet) setTraceContext(ctx context.Context) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.traceCtx = ctx
+}
+
 // Create returns a new stream with a random stream id.
 func (ss *StreamSet) Create() *Stream {
 	ss.mu.Lock()
@@ -127,12 +555,57 @@ func (ss *StreamSet) Create() *Stream {
 	return ss.create(0)
 }
 
+// CreateDestination returns a new stream which asks the peer to connect it to
+// addr. The address is sent before any data written to the stream.
+func (ss *StreamSet) CreateDestination(addr string) *Stream {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	stream := ss.create(0)
+	stream.setDestination("tcp", addr)
+	return stream
+}
+
+// CreateDatagram returns a new stream which relays datagrams framed by
+// WriteDatagram() to & from their addresses through the peer.
+func (ss *StreamSet) CreateDatagram() *Stream {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	stream := ss.create(0)
+	stream.setDestination("udp", "")
+	return stream
+}
+
+// open returns a new stream with a random stream id without invoking the
+// OnNewStream callback. The server uses this to open streams to the client
+// which must not be accepted as streams from the client.
+func (ss *StreamSet) open() *Stream {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.add(0)
+}
+
 func (ss *StreamSet) create(id int) *Stream {
+	stream := ss.add(id)
+
+	// Execute callback, if exists.
+	if ss.OnNewStream != nil {
+		ss.OnNewStream(stream)
+	}
+	return stream
+}
+
+// add returns a new stream in the set. A zero id generates a random id.
+func (ss *StreamSet) add(id int) *Stream {
 	if id == 0 {
 		id = int(rand.Int31() + 1)
 	}
 
 	stream := NewStream(id)
+	stream.localAddr, stream.remoteAddr = ss.localAddr, ss.remoteAddr
+	stream.resumable = ss.ticket != nil
+	if ss.StreamRateLimit > 0 {
+		stream.limiter = NewRateLimiter(ss.StreamRateLimit)
+	}
 	if ss.TracePath != "" {
 		path := filepath.Join(ss.TracePath, strconv.Itoa(id))
 		if err := os.MkdirAll(ss.TracePath, 0777); err != nil {
@@ -146,6 +619,12 @@ func (ss *StreamSet) create(id int) *Stream {
 		stream.TraceWriter.Write([]byte("[create]"))
 	}
 
+	parent := ss.traceCtx
+	if parent == nil {
+		parent = context.Background()
+	}
+	_, stream.span = tracer.Start(parent, "marionette.stream", trace.WithAttributes(attrStreamID.Int(stream.id)))
+
 	ss.streams[stream.id] = stream
 	ss.streamIDs = append(ss.streamIDs, stream.id)
 
@@ -157,11 +636,7 @@ func (ss *StreamSet) create(id int) *Stream {
 	ss.wg.Add(1)
 	go func() { defer ss.wg.Done(); ss.handleStream(stream) }()
 
-	// Execute callback, if exists.
-	if ss.OnNewStream != nil {
-		ss.OnNewStream(stream)
-	}
-
+	publishStreamEvent(EventStreamOpened, stream)
 	return stream
 }
 
@@ -176,7 +651,17 @@ func (ss *StreamSet) remove(stream *Stream) {
 			traceWriter.Close()
 		}
 	}
+	if stream.span != nil {
+		stream.mu.RLock()
+		stream.span.SetAttributes(attrBytesRead.Int(stream.rconsumed), attrBytesWritten.Int(stream.wsent))
+		if stream.dest != "" {
+			stream.span.SetAttributes(attrDestination.String(stream.dest))
+		}
+		stream.mu.RUnlock()
+		stream.span.End()
+	}
 	delete(ss.streams, streamID)
+	publishStreamEvent(EventStreamClosed, stream)
 
 	for i, id := range ss.streamIDs {
 		if id == streamID {
@@ -188,9 +673,36 @@ func (ss *StreamSet) remove(stream *Stream) {
 // Enqueue pushes a cell onto a stream's read queue.
 // If the stream doesn't exist then it is created.
 func (ss *StreamSet) Enqueue(cell *Cell) error {
+	if ok, err := ss.checkAuth(cell); err != nil || !ok {
+		return err
+	}
+
+	if target := ss.target(); target != ss {
+		return target.Enqueue(cell)
+	}
+
 	ss.mu.Lock()
 	defer ss.mu.Unlock()
 
+	switch cell.Type {
+	case SESSION:
+		ss.handleSession(cell)
+		return nil
+	case JOIN:
+		ss.handleJoin(cell)
+		return nil
+	case RESUME:
+		ss.handleResume(cell)
+		return nil
+	case WINDOW:
+		if stream := ss.streams[cell.StreamID]; stream != nil {
+			stream.handleWindow(cell)
+		}
+		return nil
+	case AUTH:
+		return nil
+	}
+
 	// Ignore empty cells.
 	if cell.StreamID == 0 {
 		return nil
@@ -205,15 +717,81 @@ func (ss *StreamSet) Enqueue(cell *Cell) error {
 }
 
 // Dequeue returns a cell containing data for a random stream's write buffer.
+// Queued session control cells are returned first, followed by copies of
+// cells sent on the other paths of a duplicating set.
 func (ss *StreamSet) Dequeue(n int) *Cell {
+	if cell := ss.dequeueControl(n); cell != nil {
+		return cell
+	}
+
+	target := ss.target()
+	cell := target.dequeue(n)
+	if cell != nil {
+		target.duplicate(ss, cell)
+	}
+	return cell
+}
+
+// dequeueControl returns the next control cell queued for the set's own
+// connection, or the next duplicated cell. Duplicates which do not fit in n
+// bytes are dropped since the cell was already sent on another path.
+func (ss *StreamSet) dequeueControl(n int) *Cell {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if len(ss.control) > 0 {
+		cell := ss.control[0]
+		ss.control[0] = nil
+		ss.control = ss.control[1:]
+		cell.Length = n
+		return cell
+	}
+
+	for len(ss.dups) > 0 {
+		cell := ss.dups[0]
+		ss.dups[0] = nil
+		ss.dups = ss.dups[1:]
+
+		size := n
+		if size == 0 {
+			size = CellHeaderSize + len(cell.Payload)
+		} else if size > MaxCellLength {
+			size = MaxCellLength
+		}
+		if CellHeaderSize+len(cell.Payload) <= size {
+			cell.Length = size
+			return cell
+		}
+	}
+	return nil
+}
+
+// dequeue returns the next control cell, window update, or stream data cell.
+func (ss *StreamSet) dequeue(n int) *Cell {
 	ss.mu.Lock()
 	defer ss.mu.Unlock()
 
+	if len(ss.control) > 0 {
+		cell := ss.control[0]
+		ss.control[0] = nil
+		ss.control = ss.control[1:]
+		cell.Length = n
+		return cell
+	}
+
+	// Send window updates for streams whose data has been read.
+	for _, id := range ss.streamIDs {
+		if cell := ss.streams[id].dequeueWindow(); cell != nil {
+			cell.Length = n
+			return cell
+		}
+	}
+
 	// Choose a random stream with data.
 	var stream *Stream
 	for _, i := range rand.Perm(len(ss.streamIDs)) {
 		s := ss.streams[ss.streamIDs[i]]
-		if s.WriteBufferLen() > 0 || s.WriteClosed() {
+		if s.sendPending() {
 			stream = s
 			break
 		}
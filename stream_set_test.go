@@ -1,9 +1,11 @@
 package marionette_test
 
 import (
+	"io"
 	"io/ioutil"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/redjack/marionette"
@@ -77,6 +79,47 @@ func TestStreamSet_Enqueue(t *testing.T) {
 	})
 }
 
+// Ensure a stream's destination is sent before its data, even if no data
+// has been written yet.
+func TestStreamSet_CreateDestination(t *testing.T) {
+	client, server := marionette.NewStreamSet(), marionette.NewStreamSet()
+	defer client.Close()
+	defer server.Close()
+
+	stream := client.CreateDestination("example.com:80")
+	if cell := client.Dequeue(1000); cell == nil {
+		t.Fatal("expected cell")
+	} else if cell.Type != marionette.DESTINATION || string(cell.Payload) != "tcp example.com:80" {
+		t.Fatalf("unexpected cell: type=%d payload=%q", cell.Type, cell.Payload)
+	} else if err := server.Enqueue(cell); err != nil {
+		t.Fatal(err)
+	} else if cell := client.Dequeue(1000); cell != nil {
+		t.Fatalf("unexpected cell: %#v", cell)
+	}
+
+	if _, err := stream.Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	} else if cell := client.Dequeue(1000); cell == nil {
+		t.Fatal("expected cell")
+	} else if err := server.Enqueue(cell); err != nil {
+		t.Fatal(err)
+	}
+
+	other := server.Stream(stream.ID())
+	if other == nil {
+		t.Fatal("expected stream")
+	} else if dest := other.Destination(); dest != "example.com:80" {
+		t.Fatalf("unexpected destination: %q", dest)
+	}
+
+	buf := make([]byte, 3)
+	if _, err := other.Read(buf); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "foo" {
+		t.Fatalf("unexpected data: %q", buf)
+	}
+}
+
 func TestStreamSet_Dequeue(t *testing.T) {
 	t.Run("OK", func(t *testing.T) {
 		ss := marionette.NewStreamSet()
@@ -127,3 +170,340 @@ func TestStreamSet_Dequeue(t *testing.T) {
 		}
 	})
 }
+
+// Ensure cells lost with a connection are resent once the session resumes.
+func TestStreamSet_StartSession(t *testing.T) {
+	t.Run("Resume", func(t *testing.T) {
+		client := marionette.NewStreamSet()
+		defer client.Close()
+		if err := client.StartSession(); err != nil {
+			t.Fatal(err)
+		}
+
+		server := marionette.NewStreamSet()
+		defer server.Close()
+		server.OnSession = func(ss *marionette.StreamSet, ticket []byte) *marionette.StreamSet { return ss }
+
+		// Exchange data over the first connection.
+		cstream := client.Create()
+		mustWrite(t, cstream, []byte("foo"))
+		transferCells(client, server)
+		sstream := server.Stream(cstream.ID())
+		mustRead(t, sstream, []byte("foo"))
+
+		mustWrite(t, sstream, []byte("bar"))
+		transferCells(server, client)
+		mustRead(t, cstream, []byte("bar"))
+
+		// Drop cells sent in both directions.
+		mustWrite(t, cstream, []byte("baz"))
+		mustWrite(t, sstream, []byte("qux"))
+		for client.Dequeue(0) != nil {
+		}
+		for server.Dequeue(0) != nil {
+		}
+
+		// Resume over a new connection and ensure lost data is resent.
+		if err := client.StartSession(); err != nil {
+			t.Fatal(err)
+		}
+		conn2 := marionette.NewStreamSet()
+		defer conn2.Close()
+		conn2.OnSession = func(ss *marionette.StreamSet, ticket []byte) *marionette.StreamSet { return server }
+
+		transferCells(client, conn2)
+		transferCells(conn2, client)
+		transferCells(client, conn2)
+		mustRead(t, cstream, []byte("qux"))
+		mustRead(t, sstream, []byte("baz"))
+
+		if cstream.ReadClosed() || sstream.ReadClosed() {
+			t.Fatal("expected streams to remain open")
+		}
+	})
+
+	// Ensure streams are closed if the server does not recognize the session.
+	t.Run("Unknown", func(t *testing.T) {
+		client := marionette.NewStreamSet()
+		defer client.Close()
+		if err := client.StartSession(); err != nil {
+			t.Fatal(err)
+		}
+		stream := client.Create()
+
+		if err := client.StartSession(); err != nil {
+			t.Fatal(err)
+		}
+		server := marionette.NewStreamSet()
+		defer server.Close()
+		server.OnSession = func(ss *marionette.StreamSet, ticket []byte) *marionette.StreamSet { return ss }
+
+		transferCells(client, server)
+		transferCells(server, client)
+		if !stream.ReadClosed() || !stream.WriteClosed() {
+			t.Fatal("expected stream to be closed")
+		}
+	})
+}
+
+// Ensure cells of a multipath session are reordered across paths, duplicated
+// if requested, and resent once a failed path is replaced.
+func TestStreamSet_Join(t *testing.T) {
+	// joinServer returns a server set for each path which joins the session
+	// held by the first path's set.
+	joinServer := func(first *marionette.StreamSet) *marionette.StreamSet {
+		ss := marionette.NewStreamSet()
+		ss.OnJoin = func(ss *marionette.StreamSet, ticket []byte) *marionette.StreamSet {
+			if first == nil {
+				return ss
+			}
+			return first
+		}
+		return ss
+	}
+
+	t.Run("Stripe", func(t *testing.T) {
+		client := marionette.NewStreamSet()
+		defer client.Close()
+		path0, path1 := mustJoin(t, client), mustJoin(t, client)
+
+		server := joinServer(nil)
+		defer server.Close()
+		conn1 := joinServer(server)
+		defer conn1.Close()
+		transferCells(path0, server)
+		transferCells(path1, conn1)
+
+		// Deliver the second cell before the first.
+		cstream := client.Create()
+		mustWrite(t, cstream, []byte("foo"))
+		cell0 := path1.Dequeue(0)
+		mustWrite(t, cstream, []byte("bar"))
+		transferCells(path0, server)
+		if err := conn1.Enqueue(cell0); err != nil {
+			t.Fatal(err)
+		}
+		mustRead(t, server.Stream(cstream.ID()), []byte("foobar"))
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		client := marionette.NewStreamSet()
+		defer client.Close()
+		client.Duplicate = true
+		path0, path1 := mustJoin(t, client), mustJoin(t, client)
+
+		server := joinServer(nil)
+		defer server.Close()
+		conn1 := joinServer(server)
+		defer conn1.Close()
+		transferCells(path0, server)
+		transferCells(path1, conn1)
+
+		// Drop the cell sent on one path and ensure its copy is received.
+		cstream := client.Create()
+		mustWrite(t, cstream, []byte("foo"))
+		if path0.Dequeue(0) == nil {
+			t.Fatal("expected cell")
+		}
+		transferCells(path1, conn1)
+		sstream := server.Stream(cstream.ID())
+		mustRead(t, sstream, []byte("foo"))
+
+		// Ensure the server duplicates cells it sends.
+		mustWrite(t, sstream, []byte("bar"))
+		cell0, cell1 := server.Dequeue(0), conn1.Dequeue(0)
+		if cell0 == nil || cell1 == nil {
+			t.Fatal("expected cell on each path")
+		} else if cell0.SequenceID != cell1.SequenceID {
+			t.Fatalf("unexpected sequence: %d != %d", cell0.SequenceID, cell1.SequenceID)
+		}
+		path0.Enqueue(cell0)
+		path1.Enqueue(cell1)
+		mustRead(t, cstream, []byte("bar"))
+		if n := cstream.ReadBufferLen(); n != 0 {
+			t.Fatalf("unexpected read buffer length: %d", n)
+		}
+	})
+
+	t.Run("Rejoin", func(t *testing.T) {
+		client := marionette.NewStreamSet()
+		defer client.Close()
+		path0, path1 := mustJoin(t, client), mustJoin(t, client)
+
+		server := joinServer(nil)
+		defer server.Close()
+		conn1 := joinServer(server)
+		defer conn1.Close()
+		transferCells(path0, server)
+		transferCells(path1, conn1)
+
+		// Lose a cell with the second path.
+		cstream := client.Create()
+		mustWrite(t, cstream, []byte("foo"))
+		transferCells(path0, server)
+		sstream := server.Stream(cstream.ID())
+		mustRead(t, sstream, []byte("foo"))
+
+		mustWrite(t, cstream, []byte("bar"))
+		if path1.Dequeue(0) == nil {
+			t.Fatal("expected cell")
+		}
+		path1.Close()
+		conn1.Close()
+
+		// Replace the path and ensure the lost cell is resent.
+		path2 := mustJoin(t, client)
+		conn2 := joinServer(server)
+		defer conn2.Close()
+		transferCells(path2, conn2)
+		transferCells(conn2, path2)
+		transferCells(path2, conn2)
+		mustRead(t, sstream, []byte("bar"))
+	})
+}
+
+// mustJoin returns a new stream set joined to target.
+func mustJoin(t *testing.T, target *marionette.StreamSet) *marionette.StreamSet {
+	t.Helper()
+	ss := marionette.NewStreamSet()
+	if err := ss.Join(target); err != nil {
+		t.Fatal(err)
+	}
+	return ss
+}
+
+// transferCells moves all available cells from one stream set to another.
+func transferCells(from, to *marionette.StreamSet) {
+	for cell := from.Dequeue(0); cell != nil; cell = from.Dequeue(0) {
+		to.Enqueue(cell)
+	}
+}
+
+// Ensure data is only sent within the peer's receive window and that reading
+// data opens the window again.
+func TestStreamSet_Window(t *testing.T) {
+	client, server := marionette.NewStreamSet(), marionette.NewStreamSet()
+	defer client.Close()
+	defer server.Close()
+
+	// Write more than a window of data.
+	cstream := client.Create()
+	chunk := make([]byte, 16384)
+	for n := 0; n < marionette.StreamWindowSize+len(chunk); n += len(chunk) {
+		mustWrite(t, cstream, chunk)
+		transferCells(client, server)
+	}
+	if n := cstream.WriteBufferLen(); n != len(chunk) {
+		t.Fatalf("unexpected write buffer length: %d", n)
+	}
+
+	// Read half a window and ensure the remaining data is sent.
+	sstream := server.Stream(cstream.ID())
+	if _, err := io.ReadFull(sstream, make([]byte, marionette.StreamWindowSize/2)); err != nil {
+		t.Fatal(err)
+	}
+	transferCells(server, client)
+	transferCells(client, server)
+	if n := cstream.WriteBufferLen(); n != 0 {
+		t.Fatalf("unexpected write buffer length: %d", n)
+	}
+	if _, err := io.ReadFull(sstream, make([]byte, marionette.StreamWindowSize/2+len(chunk))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Ensure a stream closed for writes continues to receive data from its peer.
+func TestStreamSet_HalfClose(t *testing.T) {
+	client, server := marionette.NewStreamSet(), marionette.NewStreamSet()
+	defer client.Close()
+	defer server.Close()
+
+	cstream := client.Create()
+	mustWrite(t, cstream, []byte("foo"))
+	if err := cstream.CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+	transferCells(client, server)
+
+	sstream := server.Stream(cstream.ID())
+	if buf, err := ioutil.ReadAll(sstream); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "foo" {
+		t.Fatalf("unexpected data: %q", buf)
+	}
+
+	mustWrite(t, sstream, []byte("bar"))
+	if err := sstream.CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+	transferCells(server, client)
+
+	if buf, err := ioutil.ReadAll(cstream); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "bar" {
+		t.Fatalf("unexpected data: %q", buf)
+	}
+}
+
+// Ensure streams are closed, with an end of stream sent to the peer, once
+// idle or open for too long.
+func TestStreamSet_StreamLimits(t *testing.T) {
+	t.Run("Idle", func(t *testing.T) {
+		ss := marionette.NewStreamSet()
+		defer ss.Close()
+		ss.StreamIdleTimeout = 50 * time.Millisecond
+
+		stream := ss.Create()
+		mustWrite(t, stream, []byte("foo"))
+		if cell := ss.Dequeue(0); cell == nil || cell.Type != marionette.NORMAL {
+			t.Fatalf("unexpected cell: %#v", cell)
+		}
+
+		waitStreamClosed(t, stream)
+		if cell := ss.Dequeue(0); cell == nil || cell.Type != marionette.END_OF_STREAM {
+			t.Fatalf("unexpected cell: %#v", cell)
+		}
+	})
+
+	// Ensure activity extends the idle timeout.
+	t.Run("Active", func(t *testing.T) {
+		ss := marionette.NewStreamSet()
+		defer ss.Close()
+		ss.StreamIdleTimeout = 100 * time.Millisecond
+
+		stream := ss.Create()
+		for i := 0; i < 5; i++ {
+			time.Sleep(40 * time.Millisecond)
+			mustWrite(t, stream, []byte("foo"))
+			ss.Dequeue(0)
+		}
+		if stream.ReadClosed() {
+			t.Fatal("expected stream to remain open")
+		}
+	})
+
+	t.Run("Lifetime", func(t *testing.T) {
+		ss := marionette.NewStreamSet()
+		defer ss.Close()
+		ss.StreamMaxLifetime = 100 * time.Millisecond
+
+		stream := ss.Create()
+		for i := 0; i < 3; i++ {
+			time.Sleep(20 * time.Millisecond)
+			mustWrite(t, stream, []byte("foo"))
+			ss.Dequeue(0)
+		}
+		waitStreamClosed(t, stream)
+	})
+}
+
+// waitStreamClosed waits for both sides of stream to be closed.
+func waitStreamClosed(t *testing.T, stream *marionette.Stream) {
+	t.Helper()
+	for i := 0; !stream.ReadClosed() || !stream.WriteClosed(); i++ {
+		if i > 500 {
+			t.Fatal("expected stream to be closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -133,22 +133,26 @@ func TestStream_Enqueue(t *testing.T) {
 			defer wg.Done()
 			time.Sleep(100 * time.Millisecond)
 			if err := stream.Enqueue(&marionette.Cell{StreamID: 100, SequenceID: 0, Payload: []byte("foo")}); err != nil {
-				t.Fatal(err)
+				t.Error(err)
+				return
 			}
 
 			time.Sleep(100 * time.Millisecond)
 			if err := stream.Enqueue(&marionette.Cell{StreamID: 100, SequenceID: 2, Payload: []byte("baz")}); err != nil {
-				t.Fatal(err)
+				t.Error(err)
+				return
 			}
 
 			time.Sleep(100 * time.Millisecond)
 			if err := stream.Enqueue(&marionette.Cell{StreamID: 100, SequenceID: 1, Payload: []byte("bar")}); err != nil {
-				t.Fatal(err)
+				t.Error(err)
+				return
 			}
 
 			time.Sleep(100 * time.Millisecond)
 			if err := stream.CloseRead(); err != nil {
-				t.Fatal(err)
+				t.Error(err)
+				return
 			}
 		}()
 
@@ -423,6 +427,54 @@ func TestStream_Closed(t *testing.T) {
 	}
 }
 
+func TestStream_Destination(t *testing.T) {
+	// Ensure the destination is read from the first cell and not the data.
+	t.Run("OK", func(t *testing.T) {
+		stream := marionette.NewStream(100)
+		if err := stream.Enqueue(&marionette.Cell{SequenceID: 1, Payload: []byte("foo")}); err != nil {
+			t.Fatal(err)
+		} else if err := stream.Enqueue(&marionette.Cell{Type: marionette.DESTINATION, SequenceID: 0, Payload: []byte("127.0.0.1:80")}); err != nil {
+			t.Fatal(err)
+		} else if dest := stream.Destination(); dest != "127.0.0.1:80" {
+			t.Fatalf("unexpected destination: %q", dest)
+		} else if stream.ReadBufferLen() != 3 {
+			t.Fatalf("unexpected read buffer length: %d", stream.ReadBufferLen())
+		}
+	})
+
+	// Ensure the network is read from the destination payload.
+	t.Run("Network", func(t *testing.T) {
+		stream := marionette.NewStream(100)
+		if err := stream.Enqueue(&marionette.Cell{Type: marionette.DESTINATION, SequenceID: 0, Payload: []byte("udp ")}); err != nil {
+			t.Fatal(err)
+		} else if network := stream.Network(); network != "udp" {
+			t.Fatalf("unexpected network: %q", network)
+		} else if dest := stream.Destination(); dest != "" {
+			t.Fatalf("unexpected destination: %q", dest)
+		}
+	})
+
+	// Ensure streams without a destination return a blank address.
+	t.Run("None", func(t *testing.T) {
+		stream := marionette.NewStream(100)
+		if err := stream.Enqueue(&marionette.Cell{SequenceID: 0, Payload: []byte("foo")}); err != nil {
+			t.Fatal(err)
+		} else if dest := stream.Destination(); dest != "" {
+			t.Fatalf("unexpected destination: %q", dest)
+		}
+	})
+
+	// Ensure closing reads does not block waiting for a destination.
+	t.Run("Closed", func(t *testing.T) {
+		stream := marionette.NewStream(100)
+		if err := stream.CloseRead(); err != nil {
+			t.Fatal(err)
+		} else if dest := stream.Destination(); dest != "" {
+			t.Fatalf("unexpected destination: %q", dest)
+		}
+	})
+}
+
 func TestStream_LocalAddr(t *testing.T) {
 	stream := marionette.NewStream(100)
 	defer stream.Close()
@@ -462,3 +514,17 @@ func TestStream_SetWriteDeadline(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// Ensure a stream is closed if the peer sends more than the receive window.
+func TestStream_Enqueue_ErrWindowExceeded(t *testing.T) {
+	stream := marionette.NewStream(100)
+	payload := make([]byte, 16384)
+	for i := 0; i <= marionette.StreamWindowSize/len(payload); i++ {
+		if err := stream.Enqueue(&marionette.Cell{Type: marionette.NORMAL, SequenceID: i, Payload: payload}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !stream.ReadClosed() || !stream.WriteClosed() {
+		t.Fatal("expected stream to be closed")
+	}
+}
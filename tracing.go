@@ -0,0 +1,70 @@
+package marionette
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name of the spans created by marionette.
+const TracerName = "github.com/redjack/marionette"
+
+// tracer creates spans using the global OpenTelemetry tracer provider. No
+// spans are recorded unless a provider is set with otel.SetTracerProvider().
+var tracer = otel.Tracer(TracerName)
+
+// errHandshakeIncomplete is recorded on a handshake span if the connection
+// stops before a message is received from the peer.
+var errHandshakeIncomplete = errors.New("connection closed before handshake completed")
+
+// Span attribute keys. A client and server span of the same connection share
+// the format uuid & instance id, and streams share their stream id, so spans
+// can be correlated across hops.
+const (
+	attrParty        = attribute.Key("marionette.party")
+	attrFormatUUID   = attribute.Key("marionette.format.uuid")
+	attrInstanceID   = attribute.Key("marionette.instance_id")
+	attrConnID       = attribute.Key("marionette.conn.id")
+	attrState        = attribute.Key("marionette.state")
+	attrNextState    = attribute.Key("marionette.next_state")
+	attrStreamID     = attribute.Key("marionette.stream.id")
+	attrDestination  = attribute.Key("marionette.stream.destination")
+	attrPeerAddr     = attribute.Key("net.peer.addr")
+	attrBytesRead    = attribute.Key("marionette.bytes_read")
+	attrBytesWritten = attribute.Key("marionette.bytes_written")
+)
+
+// startConnSpan starts the span of a cover connection. It ends once the
+// connection's FSM stops.
+func startConnSpan(ctx context.Context, party string, uuid, id int) (context.Context, trace.Span) {
+	kind := trace.SpanKindServer
+	if party == PartyClient {
+		kind = trace.SpanKindClient
+	}
+	return tracer.Start(ctx, "marionette.conn", trace.WithSpanKind(kind), trace.WithAttributes(
+		attrParty.String(party),
+		attrFormatUUID.Int(uuid),
+		attrConnID.Int(id),
+	))
+}
+
+// setPeerAddr records the remote address of conn on span.
+func setPeerAddr(span trace.Span, conn net.Conn) {
+	if conn != nil && conn.RemoteAddr() != nil {
+		span.SetAttributes(attrPeerAddr.String(conn.RemoteAddr().String()))
+	}
+}
+
+// endSpan records err, if any, and ends span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
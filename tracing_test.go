@@ -0,0 +1,141 @@
+package marionette_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+	"github.com/redjack/marionette/mar"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// testTracerProvider records the spans of each test's span processors.
+var testTracerProvider = sdktrace.NewTracerProvider()
+
+func init() {
+	otel.SetTracerProvider(testTracerProvider)
+}
+
+// Ensure the connections, handshakes, states & streams of both parties are
+// recorded as spans which can be correlated.
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	testTracerProvider.RegisterSpanProcessor(recorder)
+	defer testTracerProvider.UnregisterSpanProcessor(recorder)
+
+	dialer, ln := marionette.Pipe(
+		mar.MustParse(marionette.PartyClient, []byte(pipeTestDoc)),
+		mar.MustParse(marionette.PartyServer, []byte(pipeTestDoc)),
+	)
+	if err := dialer.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	clientStream, err := dialer.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustWrite(t, clientStream, []byte("foo"))
+
+	serverStream, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustRead(t, serverStream, []byte("foo"))
+
+	// Both handshakes complete once each party has received a message.
+	waitForSpans(t, recorder, "marionette.handshake", 2)
+
+	// Stream spans end once both sides are closed & connection spans end
+	// once the connection stops.
+	clientStream.Close()
+	serverStream.Close()
+	waitForSpans(t, recorder, "marionette.stream", 2)
+
+	dialer.Close()
+	ln.Close()
+	spans := waitForSpans(t, recorder, "marionette.conn", 2)
+
+	t.Run("Conn", func(t *testing.T) {
+		var parties []string
+		for _, span := range spans {
+			if span.Name() == "marionette.conn" {
+				parties = append(parties, spanAttr(span, "marionette.party").AsString())
+			}
+		}
+		if len(parties) != 2 || parties[0] == parties[1] {
+			t.Fatalf("unexpected conn spans: %v", parties)
+		}
+	})
+
+	t.Run("Handshake", func(t *testing.T) {
+		var ids []int64
+		for _, span := range spans {
+			if span.Name() == "marionette.handshake" {
+				ids = append(ids, spanAttr(span, "marionette.instance_id").AsInt64())
+			}
+		}
+		if len(ids) != 2 || ids[0] == 0 || ids[0] != ids[1] {
+			t.Fatalf("unexpected handshake instance ids: %v", ids)
+		}
+	})
+
+	t.Run("State", func(t *testing.T) {
+		if countSpans(spans, "marionette.state") == 0 {
+			t.Fatal("expected state spans")
+		} else if countSpans(spans, "marionette.dial") != 1 {
+			t.Fatal("expected dial span")
+		}
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		var ids []int64
+		for _, span := range spans {
+			if span.Name() == "marionette.stream" {
+				if !span.Parent().IsValid() {
+					t.Fatal("expected stream span to have a conn parent")
+				}
+				ids = append(ids, spanAttr(span, "marionette.stream.id").AsInt64())
+			}
+		}
+		if len(ids) != 2 || ids[0] != ids[1] {
+			t.Fatalf("unexpected stream ids: %v", ids)
+		}
+	})
+}
+
+// waitForSpans waits until n spans with name have ended and returns all ended spans.
+func waitForSpans(tb testing.TB, recorder *tracetest.SpanRecorder, name string, n int) []sdktrace.ReadOnlySpan {
+	tb.Helper()
+	for deadline := time.Now().Add(10 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		if spans := recorder.Ended(); countSpans(spans, name) >= n {
+			return spans
+		} else if time.Now().After(deadline) {
+			tb.Fatalf("timeout waiting for %d %s spans", n, name)
+		}
+	}
+}
+
+// countSpans returns the number of spans with name.
+func countSpans(spans []sdktrace.ReadOnlySpan, name string) int {
+	var n int
+	for _, span := range spans {
+		if span.Name() == name {
+			n++
+		}
+	}
+	return n
+}
+
+// spanAttr returns the value of the attribute with key, if set.
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
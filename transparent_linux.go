@@ -0,0 +1,115 @@
+package marionette
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	// soOriginalDst is the netfilter socket option used to retrieve the
+	// destination of a connection before it was redirected.
+	soOriginalDst = 80
+
+	// ipTransparent allows a socket to accept connections for any address.
+	ipTransparent = 19
+)
+
+// OriginalDestination returns the address a connection was sent to before
+// being redirected to the local listener by an iptables REDIRECT rule.
+func OriginalDestination(conn net.Conn) (string, error) {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return "", errors.New("original destination requires tcp connection")
+	}
+	rawConn, err := tcpConn.SyscallConn()
+	if err != nil {
+		return "", err
+	}
+
+	var addr string
+	if e := rawConn.Control(func(fd uintptr) {
+		addr, err = originalDestination(int(fd))
+	}); e != nil {
+		return "", e
+	}
+	return addr, err
+}
+
+// originalDestination reads the original destination of fd. The socket
+// address is read using getsockopt() helpers for structures of a similar
+// size since the syscall package does not expose a generic version.
+func originalDestination(fd int) (string, error) {
+	// Try IPv4 first, which returns a sockaddr_in.
+	if mreq, err := syscall.GetsockoptIPv6Mreq(fd, syscall.IPPROTO_IP, soOriginalDst); err == nil {
+		sa := (*syscall.RawSockaddrInet4)(unsafe.Pointer(&mreq.Multiaddr[0]))
+		return net.JoinHostPort(net.IP(sa.Addr[:]).String(), strconv.Itoa(int(ntohs(sa.Port)))), nil
+	}
+
+	// Fall back to IPv6, which returns a sockaddr_in6.
+	info, err := syscall.GetsockoptIPv6MTUInfo(fd, syscall.IPPROTO_IPV6, soOriginalDst)
+	if err != nil {
+		return "", os.NewSyscallError("getsockopt", err)
+	}
+	sa := &info.Addr
+	return net.JoinHostPort(net.IP(sa.Addr[:]).String(), strconv.Itoa(int(ntohs(sa.Port)))), nil
+}
+
+// ListenTransparent returns a TCP listener with IP_TRANSPARENT set so it can
+// accept connections diverted by an iptables TPROXY rule. The original
+// destination of each connection is its local address. Requires CAP_NET_ADMIN.
+func ListenTransparent(addr string) (net.Listener, error) {
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var sa syscall.Sockaddr
+	family := syscall.AF_INET
+	if ip4 := tcpAddr.IP.To4(); ip4 != nil || tcpAddr.IP == nil {
+		sa4 := &syscall.SockaddrInet4{Port: tcpAddr.Port}
+		copy(sa4.Addr[:], ip4)
+		sa = sa4
+	} else {
+		sa6 := &syscall.SockaddrInet6{Port: tcpAddr.Port}
+		copy(sa6.Addr[:], tcpAddr.IP.To16())
+		sa, family = sa6, syscall.AF_INET6
+	}
+
+	fd, err := syscall.Socket(family, syscall.SOCK_STREAM|syscall.SOCK_CLOEXEC, syscall.IPPROTO_TCP)
+	if err != nil {
+		return nil, os.NewSyscallError("socket", err)
+	}
+
+	level := syscall.SOL_IP
+	if family == syscall.AF_INET6 {
+		level = syscall.SOL_IPV6
+	}
+	if err := syscall.SetsockoptInt(fd, syscall.SOL_SOCKET, syscall.SO_REUSEADDR, 1); err != nil {
+		syscall.Close(fd)
+		return nil, os.NewSyscallError("setsockopt", err)
+	} else if err := syscall.SetsockoptInt(fd, level, ipTransparent, 1); err != nil {
+		syscall.Close(fd)
+		return nil, fmt.Errorf("cannot enable transparent proxying: %s", os.NewSyscallError("setsockopt", err))
+	} else if err := syscall.Bind(fd, sa); err != nil {
+		syscall.Close(fd)
+		return nil, os.NewSyscallError("bind", err)
+	} else if err := syscall.Listen(fd, syscall.SOMAXCONN); err != nil {
+		syscall.Close(fd)
+		return nil, os.NewSyscallError("listen", err)
+	}
+
+	f := os.NewFile(uintptr(fd), "tproxy")
+	defer f.Close()
+	return net.FileListener(f)
+}
+
+// ntohs converts a port from network byte order.
+func ntohs(port uint16) uint16 {
+	b := (*[2]byte)(unsafe.Pointer(&port))
+	return uint16(b[0])<<8 | uint16(b[1])
+}
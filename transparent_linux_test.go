@@ -0,0 +1,37 @@
+package marionette_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+// Ensure connections which were not redirected return an error.
+func TestOriginalDestination_NotRedirected(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, err := marionette.OriginalDestination(conn); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestOriginalDestination_ErrNotTCP(t *testing.T) {
+	a, b := net.Pipe()
+	defer a.Close()
+	defer b.Close()
+
+	if _, err := marionette.OriginalDestination(a); err == nil || err.Error() != `original destination requires tcp connection` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+package marionette
+
+import "net"
+
+// OriginalDestination is only supported on Linux.
+func OriginalDestination(conn net.Conn) (string, error) {
+	return "", ErrTransparentNotSupported
+}
+
+// ListenTransparent is only supported on Linux.
+func ListenTransparent(addr string) (net.Listener, error) {
+	return nil, ErrTransparentNotSupported
+}
@@ -0,0 +1,115 @@
+// Package transport implements the Pluggable Transports 2.1 Go API so that
+// applications may use marionette as a library transport instead of running
+// the pt-client & pt-server commands as managed processes.
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/redjack/marionette"
+)
+
+// Name is the transport's method name.
+const Name = "marionette"
+
+// ErrFormatRequired is returned when a transport's arguments have no format.
+var ErrFormatRequired = errors.New("transport: format required")
+
+// Client opens connections to servers through a transport.
+type Client interface {
+	Dial(address string) (net.Conn, error)
+}
+
+// Server accepts connections from clients through a transport.
+type Server interface {
+	Listen(address string) (net.Listener, error)
+}
+
+// Transport is a client & server of a marionette format. Connections to the
+// same server are multiplexed over the same cover traffic.
+type Transport struct {
+	Format  string
+	Options *marionette.FormatOptions
+}
+
+// New returns a transport using the named format. A nil opts uses the defaults.
+func New(format string, opts *marionette.FormatOptions) *Transport {
+	return &Transport{Format: format, Options: opts}
+}
+
+// Name returns the transport's method name.
+func (t *Transport) Name() string { return Name }
+
+// Dial returns a connection to the server at address, which is a host name
+// or IP address and may include a port to use instead of the format's.
+func (t *Transport) Dial(address string) (net.Conn, error) {
+	return t.DialContext(context.Background(), address)
+}
+
+// DialContext returns a connection to the server at address. The context
+// limits the time spent connecting.
+func (t *Transport) DialContext(ctx context.Context, address string) (net.Conn, error) {
+	return marionette.DialFormat(ctx, t.Format, address, t.Options)
+}
+
+// Listen returns a listener on address which accepts the connections of
+// clients. A blank address listens on all interfaces & the format's port.
+func (t *Transport) Listen(address string) (net.Listener, error) {
+	var opts marionette.FormatOptions
+	if t.Options != nil {
+		opts = *t.Options
+	}
+	opts.Bind = address
+	return marionette.ListenFormat(t.Format, &opts)
+}
+
+// NewClient returns a client configured by the transport arguments args.
+// See NewTransport() for the supported arguments.
+func NewClient(args map[string]string) (Client, error) {
+	return NewTransport(args)
+}
+
+// NewServer returns a server configured by the transport arguments args.
+// See NewTransport() for the supported arguments.
+func NewServer(args map[string]string) (Server, error) {
+	return NewTransport(args)
+}
+
+// NewTransport returns a transport configured by the key/value arguments
+// which are passed to pluggable transports, such as by a bridge line:
+//
+//	format      Format name and version, required.
+//	channels    Number of parallel connections to a server.
+//	rate-limit  Bytes per second sent & received, combined.
+func NewTransport(args map[string]string) (*Transport, error) {
+	format := args["format"]
+	if format == "" {
+		return nil, ErrFormatRequired
+	}
+
+	opts := &marionette.FormatOptions{}
+	for key, value := range args {
+		switch key {
+		case "format":
+		case "channels":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("transport: invalid channels: %q", value)
+			}
+			opts.Channels = n
+		case "rate-limit":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("transport: invalid rate limit: %q", value)
+			}
+			opts.RateLimit = n
+		default:
+			return nil, fmt.Errorf("transport: unknown argument: %q", key)
+		}
+	}
+	return New(format, opts), nil
+}
@@ -0,0 +1,80 @@
+package transport_test
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	_ "github.com/redjack/marionette/plugins"
+	"github.com/redjack/marionette/transport"
+)
+
+func TestTransport(t *testing.T) {
+	server, err := transport.NewServer(map[string]string{"format": "http_simple_blocking:20150701"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := server.Listen("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	// Echo the first message of each accepted connection.
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 5)
+				if _, err := io.ReadFull(conn, buf); err == nil {
+					conn.Write(buf)
+				}
+			}()
+		}
+	}()
+
+	client, err := transport.NewClient(map[string]string{"format": "http_simple_blocking:20150701"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	conn, err := client.Dial(net.JoinHostPort("127.0.0.1", port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	} else if string(buf) != "hello" {
+		t.Fatalf("unexpected echo: %q", buf)
+	}
+}
+
+func TestNewTransport(t *testing.T) {
+	tr, err := transport.NewTransport(map[string]string{"format": "ftp_simple_blocking", "channels": "2", "rate-limit": "1000"})
+	if err != nil {
+		t.Fatal(err)
+	} else if tr.Format != "ftp_simple_blocking" || tr.Options.Channels != 2 || tr.Options.RateLimit != 1000 {
+		t.Fatalf("unexpected transport: %+v %+v", tr, tr.Options)
+	}
+
+	for _, args := range []map[string]string{
+		{},
+		{"format": "ftp_simple_blocking", "channels": "0"},
+		{"format": "ftp_simple_blocking", "rate-limit": "x"},
+		{"format": "ftp_simple_blocking", "bogus": "1"},
+	} {
+		if _, err := transport.NewTransport(args); err == nil {
+			t.Fatalf("expected error: %v", args)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package marionette
+
+import (
+	"net"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// UDPForwarder relays datagrams received on a local UDP socket to a single
+// destination through datagram streams, such as to encapsulate the packets
+// of a WireGuard or OpenVPN peer. Replies are sent back to the local sender.
+// Each local sender uses its own stream, which is reopened as needed.
+type UDPForwarder struct {
+	pc   net.PacketConn
+	dest string
+	dial func() (net.Conn, error)
+
+	mu     sync.Mutex
+	peers  map[string]net.Conn
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewUDPForwarder returns a forwarder relaying datagrams from pc to dest, an
+// address resolved by the server, over streams opened by dial, such as
+// Dialer.DialDatagram.
+func NewUDPForwarder(pc net.PacketConn, dest string, dial func() (net.Conn, error)) *UDPForwarder {
+	return &UDPForwarder{
+		pc:    pc,
+		dest:  dest,
+		dial:  dial,
+		peers: make(map[string]net.Conn),
+	}
+}
+
+// Open starts relaying datagrams in a separate goroutine.
+func (f *UDPForwarder) Open() error {
+	f.wg.Add(1)
+	go func() { defer f.wg.Done(); f.serve() }()
+	return nil
+}
+
+// Close closes the socket & open streams and waits for relaying to stop.
+func (f *UDPForwarder) Close() error {
+	err := f.pc.Close()
+
+	f.mu.Lock()
+	f.closed = true
+	for _, conn := range f.peers {
+		conn.Close()
+	}
+	f.mu.Unlock()
+
+	f.wg.Wait()
+	return err
+}
+
+// Addr returns the local address of the socket.
+func (f *UDPForwarder) Addr() net.Addr { return f.pc.LocalAddr() }
+
+func (f *UDPForwarder) serve() {
+	buf := make([]byte, MaxDatagramSize+1)
+	for {
+		n, addr, err := f.pc.ReadFrom(buf)
+		if err != nil {
+			return
+		} else if n > MaxDatagramSize {
+			proxyLogger().Debug("udp forwarder: datagram too large", zap.Int("n", n))
+			continue
+		}
+
+		conn, err := f.peer(addr)
+		if err != nil {
+			proxyLogger().Debug("udp forwarder: cannot open stream", zap.Error(err))
+			continue
+		} else if err := WriteDatagram(conn, f.dest, buf[:n]); err != nil {
+			proxyLogger().Debug("udp forwarder: cannot send", zap.Error(err))
+			f.removePeer(addr, conn)
+		}
+	}
+}
+
+// peer returns the stream of the local sender at addr, opening it if needed.
+func (f *UDPForwarder) peer(addr net.Addr) (net.Conn, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return nil, net.ErrClosed
+	} else if conn := f.peers[addr.String()]; conn != nil {
+		return conn, nil
+	}
+
+	conn, err := f.dial()
+	if err != nil {
+		return nil, err
+	}
+	f.peers[addr.String()] = conn
+
+	f.wg.Add(1)
+	go func() { defer f.wg.Done(); f.relayReplies(addr, conn) }()
+	return conn, nil
+}
+
+// relayReplies sends datagrams received on conn to the local sender at addr
+// until the stream closes, such as once the server's relay is idle.
+func (f *UDPForwarder) relayReplies(addr net.Addr, conn net.Conn) {
+	defer f.removePeer(addr, conn)
+	for {
+		_, data, err := ReadDatagram(conn)
+		if err != nil {
+			return
+		} else if _, err := f.pc.WriteTo(data, addr); err != nil {
+			return
+		}
+	}
+}
+
+// removePeer closes conn and removes it if it is still the sender's stream.
+func (f *UDPForwarder) removePeer(addr net.Addr, conn net.Conn) {
+	conn.Close()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.peers[addr.String()] == conn {
+		delete(f.peers, addr.String())
+	}
+}
+
+// DatagramMTU returns the size of the largest datagram to dest which fits in
+// a single message with payload capacity bytes, so that it is not split
+// across messages. Returns MaxDatagramSize if capacity is zero or large.
+func DatagramMTU(capacity int, dest string) int {
+	if capacity <= 0 {
+		return MaxDatagramSize
+	}
+
+	// Each datagram is framed by WriteDatagram() & sent in a cell.
+	n := capacity - CellHeaderSize - 3 - len(dest)
+	if n < 0 {
+		return 0
+	} else if n > MaxDatagramSize {
+		return MaxDatagramSize
+	}
+	return n
+}
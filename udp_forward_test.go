@@ -0,0 +1,72 @@
+package marionette_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestUDPForwarder(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Relay each datagram back with its address as the payload.
+	f := marionette.NewUDPForwarder(pc, "vpn.example.com:51820", func() (net.Conn, error) {
+		conn, other := net.Pipe()
+		go func() {
+			defer other.Close()
+			for {
+				addr, data, err := marionette.ReadDatagram(other)
+				if err != nil {
+					return
+				} else if err := marionette.WriteDatagram(other, addr, append([]byte(addr+" "), data...)); err != nil {
+					return
+				}
+			}
+		}()
+		return conn, nil
+	})
+	if err := f.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	conn, err := net.Dial("udp", f.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	for _, msg := range []string{"foo", "bar"} {
+		if _, err := conn.Write([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		buf := make([]byte, 100)
+		if n, err := conn.Read(buf); err != nil {
+			t.Fatal(err)
+		} else if got := string(buf[:n]); got != "vpn.example.com:51820 "+msg {
+			t.Fatalf("unexpected reply: %q", got)
+		}
+	}
+}
+
+func TestDatagramMTU(t *testing.T) {
+	for _, tt := range []struct {
+		capacity int
+		exp      int
+	}{
+		{0, marionette.MaxDatagramSize},
+		{1500, 1500 - marionette.CellHeaderSize - 3 - len("10.0.0.1:51820")},
+		{20, 0},
+		{1 << 20, marionette.MaxDatagramSize},
+	} {
+		if n := marionette.DatagramMTU(tt.capacity, "10.0.0.1:51820"); n != tt.exp {
+			t.Fatalf("DatagramMTU(%d)=%d, expected %d", tt.capacity, n, tt.exp)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package marionette
+
+import (
+	"net"
+	"os"
+	"strings"
+)
+
+// unixAddrPrefix is the prefix of addresses which refer to unix domain sockets.
+const unixAddrPrefix = "unix://"
+
+// ParseNetworkAddr returns the network & address for addr. Addresses in the
+// form "unix:///path/to/socket" refer to unix domain sockets and all other
+// addresses are tcp host & port pairs.
+func ParseNetworkAddr(addr string) (network, address string) {
+	if strings.HasPrefix(addr, unixAddrPrefix) {
+		return "unix", strings.TrimPrefix(addr, unixAddrPrefix)
+	}
+	return "tcp", addr
+}
+
+// ListenAddr listens on addr, which may refer to a unix domain socket as
+// described by ParseNetworkAddr(). A stale socket left at the path by a
+// process which has exited is removed first.
+func ListenAddr(addr string) (net.Listener, error) {
+	network, address := ParseNetworkAddr(addr)
+	if network == "unix" {
+		removeStaleSocket(address)
+	}
+	return net.Listen(network, address)
+}
+
+// removeStaleSocket removes the unix domain socket at path if nothing accepts
+// connections on it. Other file types are left in place.
+func removeStaleSocket(path string) {
+	if fi, err := os.Lstat(path); err != nil || fi.Mode()&os.ModeSocket == 0 {
+		return
+	}
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return
+	}
+	os.Remove(path)
+}
@@ -0,0 +1,81 @@
+package marionette_test
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestParseNetworkAddr(t *testing.T) {
+	for _, tt := range []struct {
+		addr    string
+		network string
+		address string
+	}{
+		{"127.0.0.1:8079", "tcp", "127.0.0.1:8079"},
+		{"[::1]:8079", "tcp", "[::1]:8079"},
+		{"unix:///var/run/app.sock", "unix", "/var/run/app.sock"},
+		{"unix://app.sock", "unix", "app.sock"},
+	} {
+		t.Run(tt.addr, func(t *testing.T) {
+			if network, address := marionette.ParseNetworkAddr(tt.addr); network != tt.network || address != tt.address {
+				t.Fatalf("unexpected network address: %s %s", network, address)
+			}
+		})
+	}
+}
+
+func TestListenAddr(t *testing.T) {
+	dir, err := ioutil.TempDir("", "marionette-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.sock")
+
+	// Ensure a stale socket left behind by an exited process is replaced.
+	t.Run("Stale", func(t *testing.T) {
+		ln, err := net.Listen("unix", path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ln.(*net.UnixListener).SetUnlinkOnClose(false)
+		ln.Close()
+
+		if ln, err = marionette.ListenAddr("unix://" + path); err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		go func() {
+			if conn, err := ln.Accept(); err == nil {
+				conn.Write([]byte("foo"))
+				conn.Close()
+			}
+		}()
+
+		conn, err := net.Dial("unix", path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		mustRead(t, conn, []byte("foo"))
+	})
+
+	// Ensure a socket which is in use is not removed.
+	t.Run("ErrInUse", func(t *testing.T) {
+		ln, err := marionette.ListenAddr("unix://" + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		if _, err := marionette.ListenAddr("unix://" + path); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
@@ -0,0 +1,251 @@
+package marionette
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// usageInterval is the time between additions of the bytes of open
+	// connections to their users' usage.
+	usageInterval = 5 * time.Second
+
+	// usageSaveInterval is the minimum time between writes of changed usage
+	// to its file.
+	usageSaveInterval = 1 * time.Minute
+)
+
+// UserUsage is the bytes transferred, in both directions, by a user of a
+// private bridge. The name & quota are set when listed by Credentials.
+type UserUsage struct {
+	ID          uint32    `json:"id"`
+	Name        string    `json:"name,omitempty"`
+	Bytes       int64     `json:"bytes"`       // within the quota window
+	TotalBytes  int64     `json:"total_bytes"` // since usage was last reset
+	WindowStart time.Time `json:"window_start"`
+	Quota       int64     `json:"quota,omitempty"`
+	QuotaWindow string    `json:"quota_window,omitempty"`
+}
+
+// Usage counts the bytes transferred by each user of a private bridge so that
+// their quotas can be enforced. Usage may be shared by listeners, and
+// persisted to a file so that it is kept across restarts.
+type Usage struct {
+	mu    sync.Mutex
+	users map[uint32]*UserUsage
+	dirty bool // changed since last saved
+
+	path    string
+	closing chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewUsage returns a new in-memory instance of Usage.
+func NewUsage() *Usage {
+	return &Usage{users: make(map[uint32]*UserUsage), closing: make(chan struct{})}
+}
+
+// OpenUsage returns usage persisted to the file at path, which is created if
+// it does not exist. Changes are written periodically and on Close().
+func OpenUsage(path string) (*Usage, error) {
+	u := NewUsage()
+	u.path = path
+
+	buf, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	} else if err == nil {
+		var a []*UserUsage
+		if err := json.Unmarshal(buf, &a); err != nil {
+			return nil, err
+		}
+		for _, uu := range a {
+			u.users[uu.ID] = uu
+		}
+	}
+
+	u.wg.Add(1)
+	go func() { defer u.wg.Done(); u.monitor() }()
+	return u, nil
+}
+
+// Close writes any changes to the usage's file, if persisted.
+func (u *Usage) Close() error {
+	if u.path == "" {
+		return nil
+	}
+	close(u.closing)
+	u.wg.Wait()
+	return u.save()
+}
+
+// monitor periodically writes changes to the file until closed.
+func (u *Usage) monitor() {
+	ticker := time.NewTicker(usageSaveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-u.closing:
+			return
+		case <-ticker.C:
+			if err := u.save(); err != nil {
+				Logger.Error("cannot save usage", zap.Error(err))
+			}
+		}
+	}
+}
+
+// save replaces the file with the current usage if it has changed.
+func (u *Usage) save() (err error) {
+	u.mu.Lock()
+	if !u.dirty {
+		u.mu.Unlock()
+		return nil
+	}
+	a := make([]*UserUsage, 0, len(u.users))
+	for _, uu := range u.users {
+		a = append(a, uu)
+	}
+	sort.Slice(a, func(i, j int) bool { return a[i].ID < a[j].ID })
+	buf, err := json.MarshalIndent(a, "", "\t")
+	u.dirty = false
+	u.mu.Unlock()
+
+	// Write again next time if this write fails.
+	defer func() {
+		if err != nil {
+			u.mu.Lock()
+			u.dirty = true
+			u.mu.Unlock()
+		}
+	}()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(u.path), filepath.Base(u.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := f.Write(append(buf, '\n')); err != nil {
+		return err
+	} else if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), u.path)
+}
+
+// Get returns the usage of the user with id at t within a quota window of
+// window. A zero window never resets.
+func (u *Usage) Get(id uint32, window time.Duration, t time.Time) UserUsage {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	uu := u.users[id]
+	if uu == nil {
+		return UserUsage{ID: id}
+	}
+	other := *uu
+	if window > 0 && !t.Before(uu.WindowStart.Add(window)) {
+		other.Bytes, other.WindowStart = 0, time.Time{}
+	}
+	return other
+}
+
+// Add adds n bytes to the usage of the user with id at t. The window's count
+// restarts once window has passed since its start. A zero window never resets.
+func (u *Usage) Add(id uint32, n int64, window time.Duration, t time.Time) {
+	if n <= 0 {
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	uu := u.users[id]
+	if uu == nil {
+		uu = &UserUsage{ID: id, WindowStart: t}
+		u.users[id] = uu
+	} else if window > 0 && !t.Before(uu.WindowStart.Add(window)) {
+		uu.Bytes, uu.WindowStart = 0, t
+	}
+	uu.Bytes += n
+	uu.TotalBytes += n
+	u.dirty = true
+}
+
+// Reset clears the usage of the user with id.
+func (u *Usage) Reset(id uint32) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if _, ok := u.users[id]; ok {
+		delete(u.users, id)
+		u.dirty = true
+	}
+}
+
+// meterUsage adds the bytes of connections authenticated with a user's
+// credential to the user's usage every usageInterval, until the listener is
+// closed. Connections of users no longer allowed, such as those over their
+// quota or revoked, are closed.
+func (l *Listener) meterUsage() {
+	ticker := time.NewTicker(usageInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.closing:
+			return
+		case <-ticker.C:
+		}
+
+		// Connections are closed once l.mu is released so a slow close
+		// doesn't block accepting & serving other connections.
+		var closing []*fsm
+		l.mu.Lock()
+		now := time.Now()
+		for f, c := range l.fsms {
+			f, ok := f.(*fsm)
+			if !ok {
+				continue
+			} else if id, ok := l.recordUsage(f, c); ok && !l.Credentials.Allowed(id, now) {
+				l.logger().Info("user no longer allowed, closing connection", zap.Uint32("user", id))
+				closing = append(closing, f)
+			}
+		}
+		l.mu.Unlock()
+
+		for _, f := range closing {
+			closeFSMConn(f)
+		}
+	}
+}
+
+// recordUsage adds the bytes of a connection since they were last recorded to
+// the usage of the user it authenticated as. Returns the user's ID, or false
+// if the connection has not authenticated as a user. Must hold l.mu.
+func (l *Listener) recordUsage(f *fsm, c *listenerConn) (uint32, bool) {
+	if l.Credentials == nil || c == nil {
+		return 0, false
+	}
+	id, ok := f.streamSet.authUser()
+	conn := f.Conn()
+	if !ok || conn == nil {
+		return 0, false
+	}
+
+	n := conn.BytesRead() + conn.BytesWritten()
+	l.Credentials.recordUsage(id, n-c.usage, time.Now())
+	c.usage = n
+	return id, true
+}
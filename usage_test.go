@@ -0,0 +1,102 @@
+package marionette_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/redjack/marionette"
+)
+
+func TestUsage_Add(t *testing.T) {
+	u := marionette.NewUsage()
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	u.Add(1, 100, time.Hour, t0)
+	u.Add(1, 50, time.Hour, t0.Add(30*time.Minute))
+	if uu := u.Get(1, time.Hour, t0.Add(59*time.Minute)); uu.Bytes != 150 || uu.TotalBytes != 150 || !uu.WindowStart.Equal(t0) {
+		t.Fatalf("unexpected usage: %+v", uu)
+	}
+
+	// The window's count restarts once the window has passed.
+	if uu := u.Get(1, time.Hour, t0.Add(time.Hour)); uu.Bytes != 0 || uu.TotalBytes != 150 {
+		t.Fatalf("unexpected usage: %+v", uu)
+	}
+	u.Add(1, 10, time.Hour, t0.Add(90*time.Minute))
+	if uu := u.Get(1, time.Hour, t0.Add(90*time.Minute)); uu.Bytes != 10 || uu.TotalBytes != 160 || !uu.WindowStart.Equal(t0.Add(90*time.Minute)) {
+		t.Fatalf("unexpected usage: %+v", uu)
+	}
+
+	// A zero window never resets.
+	u.Add(2, 100, 0, t0)
+	if uu := u.Get(2, 0, t0.Add(24*365*time.Hour)); uu.Bytes != 100 {
+		t.Fatalf("unexpected usage: %+v", uu)
+	}
+
+	u.Reset(1)
+	if uu := u.Get(1, time.Hour, t0.Add(90*time.Minute)); uu.Bytes != 0 || uu.TotalBytes != 0 {
+		t.Fatalf("unexpected usage after reset: %+v", uu)
+	}
+}
+
+// Ensure usage is kept across restarts.
+func TestOpenUsage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.json")
+	t0 := time.Now()
+
+	u, err := marionette.OpenUsage(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u.Add(1, 100, 0, t0)
+	if err := u.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := marionette.OpenUsage(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	if uu := other.Get(1, 0, t0); uu.Bytes != 100 || uu.TotalBytes != 100 {
+		t.Fatalf("unexpected usage: %+v", uu)
+	}
+}
+
+// Ensure users over their quota are not allowed until their window restarts.
+func TestCredentials_Quota(t *testing.T) {
+	var creds marionette.Credentials
+	if _, err := creds.Issue(mustAuthSecret(t), "alice", time.Time{}); err != nil {
+		t.Fatal(err)
+	} else if err := creds.SetQuota("alice", 100, time.Hour); err != nil {
+		t.Fatal(err)
+	} else if err := creds.SetQuota("bob", 100, time.Hour); err != marionette.ErrUserNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	id, t0 := creds.Users[0].ID, time.Now()
+
+	// Quotas are not enforced without usage.
+	creds.Usage = marionette.NewUsage()
+	creds.Usage.Add(id, 99, time.Hour, t0)
+	if !creds.Allowed(id, t0) {
+		t.Fatal("expected user within quota to be allowed")
+	}
+	creds.Usage.Add(id, 1, time.Hour, t0)
+	if creds.Allowed(id, t0) {
+		t.Fatal("expected user over quota not to be allowed")
+	} else if !creds.Allowed(id, t0.Add(time.Hour)) {
+		t.Fatal("expected user to be allowed once window restarts")
+	}
+
+	if a := creds.UsageStats(t0); len(a) != 1 || a[0].Name != "alice" || a[0].Bytes != 100 || a[0].Quota != 100 || a[0].QuotaWindow != "1h0m0s" {
+		t.Fatalf("unexpected usage: %+v", a)
+	}
+
+	if err := creds.ResetUsage("alice"); err != nil {
+		t.Fatal(err)
+	} else if !creds.Allowed(id, t0) {
+		t.Fatal("expected user to be allowed after reset")
+	} else if err := creds.ResetUsage("bob"); err != marionette.ErrUserNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,342 @@
+package marionette
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// websocketGUID is appended to a client's key to compute the accept key.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxWebSocketFrameLength is the largest frame payload accepted from a peer.
+const maxWebSocketFrameLength = 1 << 24
+
+// WebSocket frame opcodes.
+const (
+	wsOpContinuation = 0x0
+	wsOpText         = 0x1
+	wsOpBinary       = 0x2
+	wsOpClose        = 0x8
+	wsOpPing         = 0x9
+	wsOpPong         = 0xA
+)
+
+var (
+	// ErrWebSocketHandshake is returned when a WebSocket handshake fails.
+	ErrWebSocketHandshake = errors.New("marionette: websocket handshake failed")
+
+	// ErrWebSocketFrame is returned when a peer sends an invalid frame.
+	ErrWebSocketFrame = errors.New("marionette: invalid websocket frame")
+)
+
+// WebSocketDialer is a NetDialer which connects to a WebSocket URL, such as
+// "wss://example.com/http_simple_blocking", instead of the requested address.
+// It allows dialers to run where raw TCP is unavailable, such as in a browser.
+type WebSocketDialer struct {
+	URL string
+}
+
+// Dial connects to the dialer's URL. The network & address are ignored.
+func (d *WebSocketDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+// DialContext connects to the dialer's URL. The network & address are ignored.
+func (d *WebSocketDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	return DialWebSocket(ctx, d.URL)
+}
+
+// WebSocketListener is a net.Listener which accepts WebSocket connections
+// upgraded by its ServeHTTP() method, such as from browser clients. Accepted
+// connections carry binary messages as a byte stream.
+type WebSocketListener struct {
+	addr   net.Addr
+	conns  chan net.Conn
+	once   sync.Once
+	closed chan struct{}
+}
+
+// NewWebSocketListener returns a listener which reports addr as its address,
+// which is typically the address of the HTTP server serving the listener.
+func NewWebSocketListener(addr net.Addr) *WebSocketListener {
+	return &WebSocketListener{
+		addr:   addr,
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+// Accept waits for and returns the next upgraded connection.
+func (ln *WebSocketListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-ln.conns:
+		return conn, nil
+	case <-ln.closed:
+		return nil, &net.OpError{Op: "accept", Net: "websocket", Addr: ln.addr, Err: net.ErrClosed}
+	}
+}
+
+// Close stops accepting connections. Requests received afterward fail.
+func (ln *WebSocketListener) Close() error {
+	ln.once.Do(func() { close(ln.closed) })
+	return nil
+}
+
+// Addr returns the address passed to NewWebSocketListener().
+func (ln *WebSocketListener) Addr() net.Addr { return ln.addr }
+
+// ServeHTTP upgrades the request to a WebSocket connection returned by Accept().
+func (ln *WebSocketListener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet ||
+		!headerContainsToken(r.Header, "Connection", "upgrade") ||
+		!headerContainsToken(r.Header, "Upgrade", "websocket") {
+		http.Error(w, "websocket upgrade required", http.StatusUpgradeRequired)
+		return
+	} else if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "unsupported websocket version", http.StatusBadRequest)
+		return
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		http.Error(w, "websocket key required", http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case <-ln.closed:
+		http.Error(w, "listener closed", http.StatusServiceUnavailable)
+		return
+	default:
+	}
+
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "websocket unsupported", http.StatusInternalServerError)
+		return
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return
+	}
+
+	rw.WriteString("HTTP/1.1 101 Switching Protocols\r\n")
+	rw.WriteString("Upgrade: websocket\r\n")
+	rw.WriteString("Connection: Upgrade\r\n")
+	rw.WriteString("Sec-WebSocket-Accept: " + websocketAcceptKey(key) + "\r\n\r\n")
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return
+	}
+
+	select {
+	case ln.conns <- newWebSocketConn(conn, rw.Reader, false):
+	case <-ln.closed:
+		conn.Close()
+	}
+}
+
+// websocketAcceptKey returns the accept key for a client's key.
+func websocketAcceptKey(key string) string {
+	h := sha1.Sum([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+// headerContainsToken returns true if a comma-separated header contains token.
+func headerContainsToken(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, s := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(s), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// websocketConn is a net.Conn which reads & writes binary WebSocket frames.
+// Clients mask the frames they send, as required of them.
+type websocketConn struct {
+	net.Conn
+	br     *bufio.Reader
+	client bool
+
+	readMu    sync.Mutex
+	remaining int64   // unread payload of the current frame
+	mask      [4]byte // mask of the current frame, if masked
+	masked    bool
+	maskPos   int
+
+	writeMu sync.Mutex
+	once    sync.Once
+}
+
+func newWebSocketConn(conn net.Conn, br *bufio.Reader, client bool) *websocketConn {
+	return &websocketConn{Conn: conn, br: br, client: client}
+}
+
+// Read reads the payload of data frames. Control frames are handled as read.
+func (c *websocketConn) Read(p []byte) (int, error) {
+	c.readMu.Lock()
+	defer c.readMu.Unlock()
+
+	for c.remaining == 0 {
+		if err := c.readHeader(); err != nil {
+			return 0, err
+		}
+	}
+
+	if int64(len(p)) > c.remaining {
+		p = p[:c.remaining]
+	}
+	n, err := c.br.Read(p)
+	if c.masked {
+		for i := 0; i < n; i++ {
+			p[i] ^= c.mask[c.maskPos&3]
+			c.maskPos++
+		}
+	}
+	c.remaining -= int64(n)
+	return n, err
+}
+
+// readHeader reads the next frame header, handling any control frame. Sets
+// the remaining payload length if the frame carries data.
+func (c *websocketConn) readHeader() error {
+	var hdr [2]byte
+	if _, err := io.ReadFull(c.br, hdr[:]); err != nil {
+		return err
+	}
+	opcode := hdr[0] & 0x0F
+	masked := hdr[1]&0x80 != 0
+
+	length := int64(hdr[1] & 0x7F)
+	switch length {
+	case 126:
+		var b [2]byte
+		if _, err := io.ReadFull(c.br, b[:]); err != nil {
+			return err
+		}
+		length = int64(binary.BigEndian.Uint16(b[:]))
+	case 127:
+		var b [8]byte
+		if _, err := io.ReadFull(c.br, b[:]); err != nil {
+			return err
+		}
+		length = int64(binary.BigEndian.Uint64(b[:]))
+	}
+	if length < 0 || length > maxWebSocketFrameLength {
+		return ErrWebSocketFrame
+	} else if masked == c.client {
+		return ErrWebSocketFrame // only client frames are masked
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.br, mask[:]); err != nil {
+			return err
+		}
+	}
+
+	switch opcode {
+	case wsOpContinuation, wsOpText, wsOpBinary:
+		c.remaining, c.mask, c.masked, c.maskPos = length, mask, masked, 0
+		return nil
+	}
+
+	// Control frames are read whole and must be short.
+	if length > 125 {
+		return ErrWebSocketFrame
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.br, payload); err != nil {
+		return err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i&3]
+		}
+	}
+
+	switch opcode {
+	case wsOpClose:
+		c.writeClose()
+		return io.EOF
+	case wsOpPing:
+		return c.writeFrame(wsOpPong, payload)
+	case wsOpPong:
+		return nil
+	default:
+		return ErrWebSocketFrame
+	}
+}
+
+// Write sends p as a single binary frame.
+func (c *websocketConn) Write(p []byte) (int, error) {
+	if err := c.writeFrame(wsOpBinary, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// writeFrame writes a final frame with the opcode & payload.
+func (c *websocketConn) writeFrame(opcode byte, payload []byte) error {
+	buf := make([]byte, 0, 14+len(payload))
+	buf = append(buf, 0x80|opcode)
+
+	var maskBit byte
+	if c.client {
+		maskBit = 0x80
+	}
+	switch n := len(payload); {
+	case n <= 125:
+		buf = append(buf, maskBit|byte(n))
+	case n <= 0xFFFF:
+		buf = append(buf, maskBit|126)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(n))
+	default:
+		buf = append(buf, maskBit|127)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(n))
+	}
+
+	if !c.client {
+		buf = append(buf, payload...)
+	} else {
+		var mask [4]byte
+		if _, err := rand.Read(mask[:]); err != nil {
+			return err
+		}
+		buf = append(buf, mask[:]...)
+		for i, b := range payload {
+			buf = append(buf, b^mask[i&3])
+		}
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, err := c.Conn.Write(buf)
+	return err
+}
+
+// writeClose sends a close frame once.
+func (c *websocketConn) writeClose() {
+	c.once.Do(func() { c.writeFrame(wsOpClose, nil) })
+}
+
+// Close sends a close frame, if possible, and closes the connection.
+func (c *websocketConn) Close() error {
+	c.SetWriteDeadline(time.Now().Add(time.Second))
+	c.writeClose()
+	return c.Conn.Close()
+}
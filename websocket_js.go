@@ -0,0 +1,219 @@
+//go:build js
+// +build js
+
+package marionette
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"syscall/js"
+	"time"
+)
+
+// DialWebSocket connects to a ws:// or wss:// URL using the browser's
+// WebSocket API and returns a connection which carries its writes & reads
+// as binary messages.
+func DialWebSocket(ctx context.Context, rawurl string) (net.Conn, error) {
+	ctor := js.Global().Get("WebSocket")
+	if ctor.IsUndefined() {
+		return nil, errors.New("marionette: websocket unsupported")
+	}
+
+	c := &jsWebSocketConn{
+		url:    rawurl,
+		opened: make(chan struct{}),
+		data:   make(chan struct{}, 1),
+		closed: make(chan struct{}),
+	}
+
+	var ws js.Value
+	var err error
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err = errors.New("marionette: invalid websocket url")
+			}
+		}()
+		ws = ctor.New(rawurl)
+	}()
+	if err != nil {
+		return nil, err
+	}
+	ws.Set("binaryType", "arraybuffer")
+	c.ws = ws
+
+	c.funcs = []js.Func{
+		js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			close(c.opened)
+			return nil
+		}),
+		js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			buf := js.Global().Get("Uint8Array").New(args[0].Get("data"))
+			b := make([]byte, buf.Get("length").Int())
+			js.CopyBytesToGo(b, buf)
+			c.push(b)
+			return nil
+		}),
+		js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			c.shutdown()
+			return nil
+		}),
+	}
+	ws.Set("onopen", c.funcs[0])
+	ws.Set("onmessage", c.funcs[1])
+	ws.Set("onclose", c.funcs[2])
+	ws.Set("onerror", c.funcs[2])
+
+	select {
+	case <-c.opened:
+		return c, nil
+	case <-c.closed:
+		c.release()
+		return nil, ErrWebSocketHandshake
+	case <-ctx.Done():
+		c.Close()
+		return nil, ctx.Err()
+	}
+}
+
+// jsWebSocketConn is a net.Conn over a browser WebSocket.
+type jsWebSocketConn struct {
+	url   string
+	ws    js.Value
+	funcs []js.Func
+
+	mu           sync.Mutex
+	buf          []byte
+	readDeadline time.Time
+
+	opened chan struct{}
+	data   chan struct{} // signaled when buf is appended to
+	closed chan struct{}
+	once   sync.Once
+}
+
+// push appends a received message to the read buffer.
+func (c *jsWebSocketConn) push(b []byte) {
+	c.mu.Lock()
+	c.buf = append(c.buf, b...)
+	c.mu.Unlock()
+
+	select {
+	case c.data <- struct{}{}:
+	default:
+	}
+}
+
+// shutdown marks the connection as closed once.
+func (c *jsWebSocketConn) shutdown() {
+	c.once.Do(func() { close(c.closed) })
+}
+
+// release frees the callbacks registered with the WebSocket.
+func (c *jsWebSocketConn) release() {
+	for _, fn := range c.funcs {
+		fn.Release()
+	}
+}
+
+// Read reads received message data, waiting until data is available.
+func (c *jsWebSocketConn) Read(p []byte) (int, error) {
+	for {
+		c.mu.Lock()
+		if len(c.buf) > 0 {
+			n := copy(p, c.buf)
+			c.buf = c.buf[n:]
+			c.mu.Unlock()
+			return n, nil
+		}
+		deadline := c.readDeadline
+		c.mu.Unlock()
+
+		if err := c.wait(deadline); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// wait blocks until data is received, the connection closes or the deadline
+// passes. Returns io.EOF once closed with no data left to read.
+func (c *jsWebSocketConn) wait(deadline time.Time) error {
+	var timeout <-chan time.Time
+	if !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case <-c.data:
+		return nil
+	case <-c.closed:
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if len(c.buf) == 0 {
+			return io.EOF
+		}
+		return nil
+	case <-timeout:
+		return os.ErrDeadlineExceeded
+	}
+}
+
+// Write sends p as a single binary message.
+func (c *jsWebSocketConn) Write(p []byte) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, net.ErrClosed
+	default:
+	}
+
+	buf := js.Global().Get("Uint8Array").New(len(p))
+	js.CopyBytesToJS(buf, p)
+	c.ws.Call("send", buf)
+	return len(p), nil
+}
+
+// Close closes the WebSocket.
+func (c *jsWebSocketConn) Close() error {
+	c.ws.Call("close")
+	c.shutdown()
+	c.release()
+	return nil
+}
+
+// LocalAddr returns a placeholder as browsers do not expose the local address.
+func (c *jsWebSocketConn) LocalAddr() net.Addr { return websocketAddr("") }
+
+// RemoteAddr returns the URL of the WebSocket.
+func (c *jsWebSocketConn) RemoteAddr() net.Addr { return websocketAddr(c.url) }
+
+// SetDeadline sets the read deadline. Writes are queued by the browser and
+// do not block.
+func (c *jsWebSocketConn) SetDeadline(t time.Time) error { return c.SetReadDeadline(t) }
+
+// SetReadDeadline sets the time after which reads fail.
+func (c *jsWebSocketConn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	c.readDeadline = t
+	c.mu.Unlock()
+
+	select {
+	case c.data <- struct{}{}: // wake a blocked reader to apply the deadline
+	default:
+	}
+	return nil
+}
+
+// SetWriteDeadline is a no-op as writes do not block.
+func (c *jsWebSocketConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// websocketAddr is the address of a browser WebSocket.
+type websocketAddr string
+
+func (a websocketAddr) Network() string { return "websocket" }
+func (a websocketAddr) String() string  { return string(a) }
@@ -0,0 +1,97 @@
+//go:build !js
+// +build !js
+
+package marionette
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DialWebSocket connects to a ws:// or wss:// URL and returns a connection
+// which carries its writes & reads as binary messages.
+func DialWebSocket(ctx context.Context, rawurl string) (net.Conn, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := u.Host
+	var useTLS bool
+	switch u.Scheme {
+	case "ws":
+		if u.Port() == "" {
+			addr = net.JoinHostPort(u.Hostname(), "80")
+		}
+	case "wss":
+		useTLS = true
+		if u.Port() == "" {
+			addr = net.JoinHostPort(u.Hostname(), "443")
+		}
+	default:
+		return nil, fmt.Errorf("marionette: invalid websocket scheme: %q", u.Scheme)
+	}
+
+	var conn net.Conn
+	if useTLS {
+		conn, err = (&tls.Dialer{Config: &tls.Config{ServerName: u.Hostname()}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	wsConn, err := dialWebSocket(ctx, conn, u.Host, u.RequestURI())
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return wsConn, nil
+}
+
+// dialWebSocket connects to a ws:// or wss:// URL over conn, which must
+// already be connected to the URL's host.
+func dialWebSocket(ctx context.Context, conn net.Conn, host, path string) (net.Conn, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(b[:])
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	req := "GET " + path + " HTTP/1.1\r\n" +
+		"Host: " + host + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Key: " + key + "\r\n" +
+		"Sec-WebSocket-Version: 13\r\n\r\n"
+	if _, err := io.WriteString(conn, req); err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols ||
+		resp.Header.Get("Sec-WebSocket-Accept") != websocketAcceptKey(key) {
+		return nil, ErrWebSocketHandshake
+	}
+	return newWebSocketConn(conn, br, true), nil
+}
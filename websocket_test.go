@@ -0,0 +1,81 @@
+package marionette_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/redjack/marionette"
+)
+
+func TestWebSocketListener(t *testing.T) {
+	ln := marionette.NewWebSocketListener(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	defer ln.Close()
+	srv := httptest.NewServer(ln)
+	defer srv.Close()
+
+	// Echo data back to the client.
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		io.Copy(conn, conn)
+	}()
+
+	conn, err := marionette.DialWebSocket(context.Background(), "ws"+strings.TrimPrefix(srv.URL, "http")+"/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// Send messages spanning each frame length encoding.
+	for _, n := range []int{1, 125, 126, 70000} {
+		data := bytes.Repeat([]byte{byte(n)}, n)
+		if _, err := conn.Write(data); err != nil {
+			t.Fatal(err)
+		}
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(buf, data) {
+			t.Fatalf("unexpected echo of %d bytes", n)
+		}
+	}
+}
+
+func TestWebSocketListener_UpgradeRequired(t *testing.T) {
+	ln := marionette.NewWebSocketListener(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	defer ln.Close()
+	srv := httptest.NewServer(ln)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUpgradeRequired {
+		t.Fatalf("unexpected status: %d", resp.StatusCode)
+	}
+}
+
+func TestWebSocketListener_Close(t *testing.T) {
+	ln := marionette.NewWebSocketListener(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	srv := httptest.NewServer(ln)
+	defer srv.Close()
+	ln.Close()
+
+	if _, err := ln.Accept(); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := marionette.DialWebSocket(context.Background(), "ws"+strings.TrimPrefix(srv.URL, "http")); err != marionette.ErrWebSocketHandshake {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}